@@ -151,6 +151,7 @@ import (
 	spmodulekeeper "github.com/mocachain/moca/v2/x/sp/keeper"
 	spmoduletypes "github.com/mocachain/moca/v2/x/sp/types"
 	storagemodule "github.com/mocachain/moca/v2/x/storage"
+	storagerest "github.com/mocachain/moca/v2/x/storage/client/rest"
 	storagemodulekeeper "github.com/mocachain/moca/v2/x/storage/keeper"
 	storagemoduletypes "github.com/mocachain/moca/v2/x/storage/types"
 	virtualgroupmodule "github.com/mocachain/moca/v2/x/virtualgroup"
@@ -546,6 +547,7 @@ func NewMoca(
 		keys[paymentmoduletypes.StoreKey],
 		app.BankKeeper,
 		app.AccountKeeper,
+		app.SpKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	paymentModule := paymentmodule.NewAppModule(appCodec, app.PaymentKeeper, app.AccountKeeper, app.BankKeeper)
@@ -559,6 +561,9 @@ func NewMoca(
 		app.BankKeeper,
 		app.PaymentKeeper,
 	)
+	// the challenge keeper depends on the keepers below, so the virtual group keeper and its copies held by them refer
+	// to it by its address, which is filled in once it is created
+	app.VirtualgroupKeeper.SetChallengeKeeper(&app.ChallengeKeeper)
 
 	app.PermissionKeeper = *permissionmodulekeeper.NewKeeper(
 		appCodec,
@@ -593,6 +598,7 @@ func NewMoca(
 		app.SpKeeper,
 		app.StakingKeeper,
 		app.PaymentKeeper,
+		app.VirtualgroupKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	challengeModule := challengemodule.NewAppModule(appCodec, app.ChallengeKeeper, app.AccountKeeper, app.BankKeeper)
@@ -1120,6 +1126,8 @@ func (app *Moca) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfi
 
 	// Register legacy and grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register the bare ERC-721 metadata json of the storage NFTs for wallets and explorers.
+	storagerest.RegisterNFTMetadataRoutes(clientCtx, apiSvr.Router)
 
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
@@ -1226,8 +1234,13 @@ func (app *Moca) mocaStaticPrecompiles() map[common.Address]vm.PrecompiledContra
 			app.BankKeeper,
 			app.appCodec,
 		),
-		precompilespayment.GetAddress():    precompilespayment.NewPrecompile(paymentmodulekeeper.NewMsgServerImpl(app.PaymentKeeper), app.PaymentKeeper, app.BankKeeper),
-		precompilespermission.GetAddress(): precompilespermission.NewPrecompile(app.PermissionKeeper, app.BankKeeper),
+		precompilespayment.GetAddress(): precompilespayment.NewPrecompile(paymentmodulekeeper.NewMsgServerImpl(app.PaymentKeeper), app.PaymentKeeper, app.BankKeeper),
+		precompilespermission.GetAddress(): precompilespermission.NewPrecompile(
+			app.PermissionKeeper,
+			storagemodulekeeper.NewMsgServerImpl(app.StorageKeeper),
+			app.StorageKeeper,
+			app.BankKeeper,
+		),
 		precompilesstaking.GetAddress(): precompilesstaking.NewPrecompile(
 			stakingkeeper.NewMsgServerImpl(app.StakingKeeper),
 			stakingkeeper.Querier{Keeper: app.StakingKeeper},
@@ -1248,6 +1261,7 @@ func (app *Moca) mocaStaticPrecompiles() map[common.Address]vm.PrecompiledContra
 		precompilessp.GetAddress(): precompilessp.NewPrecompile(
 			spmodulekeeper.NewMsgServerImpl(app.SpKeeper),
 			app.SpKeeper,
+			app.ChallengeKeeper,
 			app.BankKeeper,
 		),
 	}
@@ -1480,6 +1494,17 @@ func (app *Moca) setupUpgradeHandlers() {
 		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	})
 
+	// v2.1.0: runs the storage migration to consensus version 2, which indexes
+	// the resource tags and the object names, opens the billing periods of the
+	// charged buckets and sets the lifecycle_expiration_max param, and the
+	// virtualgroup migration to consensus version 3, which sets the swap in
+	// auction params. It adds no store, so the store loader is left as is. The
+	// storage nft_metadata_base_url param is left empty, the base uri of the NFT
+	// contracts is set once governance sets it to the url of a public api server.
+	app.UpgradeKeeper.SetUpgradeHandler("v2.1.0", func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
 	// testnet only upgrade Handlers
 	app.UpgradeKeeper.SetUpgradeHandler(
 		"testnet-gov-param-fix",
@@ -84,7 +84,7 @@ func (app *Moca) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []st
 	}
 
 	/* Just to be safe, assert the invariants on current state. */
-	app.invariantChecker.AssertAll(ctx)
+	app.AssertInvariants(ctx)
 
 	/* Handle fee distribution state. */
 
@@ -58,3 +58,10 @@ func (r *exportInvariantRegistry) AssertAll(ctx sdk.Context) {
 		}
 	}
 }
+
+// AssertInvariants runs every registered module invariant against ctx and
+// panics on the first broken one. Export calls it before touching state, and
+// tests or debugging tools can call it to audit a live state on demand.
+func (app *Moca) AssertInvariants(ctx sdk.Context) {
+	app.invariantChecker.AssertAll(ctx)
+}
@@ -0,0 +1,24 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// Callback interface a bucket owner contract implements to learn about the objects of the bucket.
+var (
+	//go:embed compiled_contracts/IObjectCallback.json
+	ObjectCallbackJSON []byte //nolint: golint
+
+	// ObjectCallbackContract is the compiled object callback interface
+	ObjectCallbackContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(ObjectCallbackJSON, &ObjectCallbackContract)
+	if err != nil {
+		panic(err)
+	}
+}
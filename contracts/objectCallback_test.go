@@ -0,0 +1,27 @@
+package contracts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/contracts"
+)
+
+// TestObjectCallbackContract pins the embedded callback interface the storage
+// keeper notifies the registered bucket callback contracts through: the
+// methods must keep the exact signatures deployed callback contracts
+// implement, or the calls silently stop matching their selectors.
+func TestObjectCallbackContract(t *testing.T) {
+	abi := contracts.ObjectCallbackContract.ABI
+
+	for _, name := range []string{"onObjectSealed", "onObjectRejected", "onObjectDeleted"} {
+		method, ok := abi.Methods[name]
+		require.True(t, ok, "ABI must expose %s (used by the storage keeper)", name)
+		require.Equal(t, name+"(uint256,string,string)", method.Sig)
+		require.Empty(t, method.Outputs, "the keeper ignores the return data of the callback")
+	}
+
+	require.Empty(t, contracts.ObjectCallbackContract.Bin,
+		"artifact is an ABI-only interface; the callback contracts are deployed by the bucket owners")
+}
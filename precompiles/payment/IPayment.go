@@ -115,6 +115,16 @@ type StreamRecord struct {
 	FrozenNetflowRate *big.Int
 }
 
+// UserStream is an auto generated low-level Go binding around an user-defined struct.
+type UserStream struct {
+	Id        uint64
+	Sender    string
+	Recipient string
+	Rate      *big.Int
+	CreatedAt int64
+	UpdatedAt int64
+}
+
 // VersionedParams is an auto generated low-level Go binding around an user-defined struct.
 type VersionedParams struct {
 	ReserveTime      uint64
@@ -123,7 +133,7 @@ type VersionedParams struct {
 
 // IPaymentMetaData contains all meta data concerning the IPayment contract.
 var IPaymentMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"CreatePaymentAccount\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"DisableRefund\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"Withdraw\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"autoSettleRecords\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"timestamp\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"}],\"internalType\":\"structAutoSettleRecord[]\",\"name\":\"autoSettleRecords\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"createPaymentAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"delayedWithdrawal\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"from\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"unlockTimestamp\",\"type\":\"int64\"}],\"internalType\":\"structDelayedWithdrawalRecord\",\"name\":\"delayedWithdrawal\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"to\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"deposit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"}],\"name\":\"disableRefund\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"dynamicBalance\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"dynamicBalance\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"crudTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint256\",\"name\":\"netflowRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"staticBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bufferBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockBalance\",\"type\":\"uint256\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"},{\"internalType\":\"int64\",\"name\":\"settleTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"outFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"frozenNetflowRate\",\"type\":\"uint256\"}],\"internalType\":\"structStreamRecord\",\"name\":\"streamRecord\",\"type\":\"tuple\"},{\"internalType\":\"int64\",\"name\":\"currentTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint256\",\"name\":\"bankBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"availableBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockedFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"changeRate\",\"type\":\"uint256\"}],\"internalType\":\"structDynamicBalance\",\"name\":\"dynamicBalance\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"outFlows\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"toAddress\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"}],\"internalType\":\"structOutFlow[]\",\"name\":\"outFlows\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"params\",\"outputs\":[{\"components\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"reserveTime\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"validatorTaxRate\",\"type\":\"uint256\"}],\"internalType\":\"structVersionedParams\",\"name\":\"versionedParams\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"paymentAccountCountLimit\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"forcedSettleTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maxAutoSettleFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maxAutoResumeFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"feeDenom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"withdrawTimeLockThreshold\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"withdrawTimeLockDuration\",\"type\":\"uint64\"}],\"internalType\":\"structParams\",\"name\":\"params\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int64\",\"name\":\"timestamp\",\"type\":\"int64\"}],\"name\":\"paramsByTimestamp\",\"outputs\":[{\"components\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"reserveTime\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"validatorTaxRate\",\"type\":\"uint256\"}],\"internalType\":\"structVersionedParams\",\"name\":\"versionedParams\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"paymentAccountCountLimit\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"forcedSettleTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maxAutoSettleFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maxAutoResumeFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"feeDenom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"withdrawTimeLockThreshold\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"withdrawTimeLockDuration\",\"type\":\"uint64\"}],\"internalType\":\"structParams\",\"name\":\"params\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"}],\"name\":\"paymentAccount\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"refundable\",\"type\":\"bool\"}],\"internalType\":\"structPaymentAccount\",\"name\":\"paymentAccount\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"}],\"name\":\"paymentAccountCount\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"count\",\"type\":\"uint64\"}],\"internalType\":\"structPaymentAccountCount\",\"name\":\"paymentAccountCount\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"paymentAccountCounts\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"count\",\"type\":\"uint64\"}],\"internalType\":\"structPaymentAccountCount[]\",\"name\":\"paymentAccountCounts\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"paymentAccounts\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"refundable\",\"type\":\"bool\"}],\"internalType\":\"structPaymentAccount[]\",\"name\":\"paymentAccounts\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"}],\"name\":\"paymentAccountsByOwner\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"accounts\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"streamRecord\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"crudTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint256\",\"name\":\"netflowRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"staticBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bufferBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockBalance\",\"type\":\"uint256\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"},{\"internalType\":\"int64\",\"name\":\"settleTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"outFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"frozenNetflowRate\",\"type\":\"uint256\"}],\"internalType\":\"structStreamRecord\",\"name\":\"streamRecord\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"streamRecords\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"crudTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint256\",\"name\":\"netflowRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"staticBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bufferBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockBalance\",\"type\":\"uint256\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"},{\"internalType\":\"int64\",\"name\":\"settleTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"outFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"frozenNetflowRate\",\"type\":\"uint256\"}],\"internalType\":\"structStreamRecord[]\",\"name\":\"streamRecords\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"from\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"withdraw\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"streamId\",\"type\":\"uint64\"}],\"name\":\"CancelStream\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"CreatePaymentAccount\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"streamId\",\"type\":\"uint64\"}],\"name\":\"CreateStream\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"DisableRefund\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"SetAutoDepositAllowance\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"thresholdSeconds\",\"type\":\"uint64\"}],\"name\":\"SetRunwayAlert\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"streamId\",\"type\":\"uint64\"}],\"name\":\"UpdateStream\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"Withdraw\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"autoSettleRecords\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"timestamp\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"}],\"internalType\":\"structAutoSettleRecord[]\",\"name\":\"autoSettleRecords\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"streamId\",\"type\":\"uint64\"}],\"name\":\"cancelStream\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"createPaymentAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"recipient\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"}],\"name\":\"createStream\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"streamId\",\"type\":\"uint64\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"delayedWithdrawal\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"from\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"unlockTimestamp\",\"type\":\"int64\"}],\"internalType\":\"structDelayedWithdrawalRecord\",\"name\":\"delayedWithdrawal\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"to\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"deposit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"}],\"name\":\"disableRefund\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"dynamicBalance\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"dynamicBalance\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"crudTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint256\",\"name\":\"netflowRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"staticBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bufferBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockBalance\",\"type\":\"uint256\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"},{\"internalType\":\"int64\",\"name\":\"settleTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"outFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"frozenNetflowRate\",\"type\":\"uint256\"}],\"internalType\":\"structStreamRecord\",\"name\":\"streamRecord\",\"type\":\"tuple\"},{\"internalType\":\"int64\",\"name\":\"currentTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint256\",\"name\":\"bankBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"availableBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockedFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"changeRate\",\"type\":\"uint256\"}],\"internalType\":\"structDynamicBalance\",\"name\":\"dynamicBalance\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"outFlows\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"toAddress\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"}],\"internalType\":\"structOutFlow[]\",\"name\":\"outFlows\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"params\",\"outputs\":[{\"components\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"reserveTime\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"validatorTaxRate\",\"type\":\"uint256\"}],\"internalType\":\"structVersionedParams\",\"name\":\"versionedParams\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"paymentAccountCountLimit\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"forcedSettleTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maxAutoSettleFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maxAutoResumeFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"feeDenom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"withdrawTimeLockThreshold\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"withdrawTimeLockDuration\",\"type\":\"uint64\"}],\"internalType\":\"structParams\",\"name\":\"params\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int64\",\"name\":\"timestamp\",\"type\":\"int64\"}],\"name\":\"paramsByTimestamp\",\"outputs\":[{\"components\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"reserveTime\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"validatorTaxRate\",\"type\":\"uint256\"}],\"internalType\":\"structVersionedParams\",\"name\":\"versionedParams\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"paymentAccountCountLimit\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"forcedSettleTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maxAutoSettleFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maxAutoResumeFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"feeDenom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"withdrawTimeLockThreshold\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"withdrawTimeLockDuration\",\"type\":\"uint64\"}],\"internalType\":\"structParams\",\"name\":\"params\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"}],\"name\":\"paymentAccount\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"refundable\",\"type\":\"bool\"}],\"internalType\":\"structPaymentAccount\",\"name\":\"paymentAccount\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"}],\"name\":\"paymentAccountCount\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"count\",\"type\":\"uint64\"}],\"internalType\":\"structPaymentAccountCount\",\"name\":\"paymentAccountCount\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"paymentAccountCounts\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"count\",\"type\":\"uint64\"}],\"internalType\":\"structPaymentAccountCount[]\",\"name\":\"paymentAccountCounts\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"paymentAccounts\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"refundable\",\"type\":\"bool\"}],\"internalType\":\"structPaymentAccount[]\",\"name\":\"paymentAccounts\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"owner\",\"type\":\"string\"}],\"name\":\"paymentAccountsByOwner\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"accounts\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"maxAmountPerPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"periodSeconds\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"thresholdSeconds\",\"type\":\"uint64\"}],\"name\":\"setAutoDepositAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"addr\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"thresholdSeconds\",\"type\":\"uint64\"}],\"name\":\"setRunwayAlert\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"streamId\",\"type\":\"uint64\"}],\"name\":\"stream\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"id\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"recipient\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"},{\"internalType\":\"int64\",\"name\":\"createdAt\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"}],\"internalType\":\"structUserStream\",\"name\":\"stream\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"streamRecord\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"crudTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint256\",\"name\":\"netflowRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"staticBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bufferBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockBalance\",\"type\":\"uint256\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"},{\"internalType\":\"int64\",\"name\":\"settleTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"outFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"frozenNetflowRate\",\"type\":\"uint256\"}],\"internalType\":\"structStreamRecord\",\"name\":\"streamRecord\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"streamRecords\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"crudTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint256\",\"name\":\"netflowRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"staticBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bufferBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lockBalance\",\"type\":\"uint256\"},{\"internalType\":\"int32\",\"name\":\"status\",\"type\":\"int32\"},{\"internalType\":\"int64\",\"name\":\"settleTimestamp\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"outFlowCount\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"frozenNetflowRate\",\"type\":\"uint256\"}],\"internalType\":\"structStreamRecord[]\",\"name\":\"streamRecords\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"streamsBySender\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"id\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"recipient\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"},{\"internalType\":\"int64\",\"name\":\"createdAt\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"}],\"internalType\":\"structUserStream[]\",\"name\":\"streams\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"streamId\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"}],\"name\":\"updateStream\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"from\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"withdraw\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // IPaymentABI is the input ABI used to generate the binding from.
@@ -655,6 +665,37 @@ func (_IPayment *IPaymentCallerSession) PaymentAccountsByOwner(owner string) ([]
 	return _IPayment.Contract.PaymentAccountsByOwner(&_IPayment.CallOpts, owner)
 }
 
+// Stream is a free data retrieval call binding the contract method 0xde5c5a6a.
+//
+// Solidity: function stream(uint64 streamId) view returns((uint64,string,string,uint256,int64,int64) stream)
+func (_IPayment *IPaymentCaller) Stream(opts *bind.CallOpts, streamId uint64) (UserStream, error) {
+	var out []interface{}
+	err := _IPayment.contract.Call(opts, &out, "stream", streamId)
+
+	if err != nil {
+		return *new(UserStream), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(UserStream)).(*UserStream)
+
+	return out0, err
+
+}
+
+// Stream is a free data retrieval call binding the contract method 0xde5c5a6a.
+//
+// Solidity: function stream(uint64 streamId) view returns((uint64,string,string,uint256,int64,int64) stream)
+func (_IPayment *IPaymentSession) Stream(streamId uint64) (UserStream, error) {
+	return _IPayment.Contract.Stream(&_IPayment.CallOpts, streamId)
+}
+
+// Stream is a free data retrieval call binding the contract method 0xde5c5a6a.
+//
+// Solidity: function stream(uint64 streamId) view returns((uint64,string,string,uint256,int64,int64) stream)
+func (_IPayment *IPaymentCallerSession) Stream(streamId uint64) (UserStream, error) {
+	return _IPayment.Contract.Stream(&_IPayment.CallOpts, streamId)
+}
+
 // StreamRecord is a free data retrieval call binding the contract method 0x56965b8f.
 //
 // Solidity: function streamRecord(string account) view returns((string,int64,uint256,uint256,uint256,uint256,int32,int64,uint64,uint256) streamRecord)
@@ -731,6 +772,72 @@ func (_IPayment *IPaymentCallerSession) StreamRecords(pagination PageRequest) (s
 	return _IPayment.Contract.StreamRecords(&_IPayment.CallOpts, pagination)
 }
 
+// StreamsBySender is a free data retrieval call binding the contract method 0x012d9f87.
+//
+// Solidity: function streamsBySender(string sender, (bytes,uint64,uint64,bool,bool) pagination) view returns((uint64,string,string,uint256,int64,int64)[] streams, (bytes,uint64) pageResponse)
+func (_IPayment *IPaymentCaller) StreamsBySender(opts *bind.CallOpts, sender string, pagination PageRequest) (struct {
+	Streams      []UserStream
+	PageResponse PageResponse
+}, error) {
+	var out []interface{}
+	err := _IPayment.contract.Call(opts, &out, "streamsBySender", sender, pagination)
+
+	outstruct := new(struct {
+		Streams      []UserStream
+		PageResponse PageResponse
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Streams = *abi.ConvertType(out[0], new([]UserStream)).(*[]UserStream)
+	outstruct.PageResponse = *abi.ConvertType(out[1], new(PageResponse)).(*PageResponse)
+
+	return *outstruct, err
+
+}
+
+// StreamsBySender is a free data retrieval call binding the contract method 0x012d9f87.
+//
+// Solidity: function streamsBySender(string sender, (bytes,uint64,uint64,bool,bool) pagination) view returns((uint64,string,string,uint256,int64,int64)[] streams, (bytes,uint64) pageResponse)
+func (_IPayment *IPaymentSession) StreamsBySender(sender string, pagination PageRequest) (struct {
+	Streams      []UserStream
+	PageResponse PageResponse
+}, error) {
+	return _IPayment.Contract.StreamsBySender(&_IPayment.CallOpts, sender, pagination)
+}
+
+// StreamsBySender is a free data retrieval call binding the contract method 0x012d9f87.
+//
+// Solidity: function streamsBySender(string sender, (bytes,uint64,uint64,bool,bool) pagination) view returns((uint64,string,string,uint256,int64,int64)[] streams, (bytes,uint64) pageResponse)
+func (_IPayment *IPaymentCallerSession) StreamsBySender(sender string, pagination PageRequest) (struct {
+	Streams      []UserStream
+	PageResponse PageResponse
+}, error) {
+	return _IPayment.Contract.StreamsBySender(&_IPayment.CallOpts, sender, pagination)
+}
+
+// CancelStream is a paid mutator transaction binding the contract method 0xd9e8f780.
+//
+// Solidity: function cancelStream(uint64 streamId) returns(bool success)
+func (_IPayment *IPaymentTransactor) CancelStream(opts *bind.TransactOpts, streamId uint64) (*types.Transaction, error) {
+	return _IPayment.contract.Transact(opts, "cancelStream", streamId)
+}
+
+// CancelStream is a paid mutator transaction binding the contract method 0xd9e8f780.
+//
+// Solidity: function cancelStream(uint64 streamId) returns(bool success)
+func (_IPayment *IPaymentSession) CancelStream(streamId uint64) (*types.Transaction, error) {
+	return _IPayment.Contract.CancelStream(&_IPayment.TransactOpts, streamId)
+}
+
+// CancelStream is a paid mutator transaction binding the contract method 0xd9e8f780.
+//
+// Solidity: function cancelStream(uint64 streamId) returns(bool success)
+func (_IPayment *IPaymentTransactorSession) CancelStream(streamId uint64) (*types.Transaction, error) {
+	return _IPayment.Contract.CancelStream(&_IPayment.TransactOpts, streamId)
+}
+
 // CreatePaymentAccount is a paid mutator transaction binding the contract method 0xe3229ba5.
 //
 // Solidity: function createPaymentAccount() returns(bool success)
@@ -752,6 +859,27 @@ func (_IPayment *IPaymentTransactorSession) CreatePaymentAccount() (*types.Trans
 	return _IPayment.Contract.CreatePaymentAccount(&_IPayment.TransactOpts)
 }
 
+// CreateStream is a paid mutator transaction binding the contract method 0x101b2a1b.
+//
+// Solidity: function createStream(string sender, string recipient, uint256 rate) returns(uint64 streamId)
+func (_IPayment *IPaymentTransactor) CreateStream(opts *bind.TransactOpts, sender string, recipient string, rate *big.Int) (*types.Transaction, error) {
+	return _IPayment.contract.Transact(opts, "createStream", sender, recipient, rate)
+}
+
+// CreateStream is a paid mutator transaction binding the contract method 0x101b2a1b.
+//
+// Solidity: function createStream(string sender, string recipient, uint256 rate) returns(uint64 streamId)
+func (_IPayment *IPaymentSession) CreateStream(sender string, recipient string, rate *big.Int) (*types.Transaction, error) {
+	return _IPayment.Contract.CreateStream(&_IPayment.TransactOpts, sender, recipient, rate)
+}
+
+// CreateStream is a paid mutator transaction binding the contract method 0x101b2a1b.
+//
+// Solidity: function createStream(string sender, string recipient, uint256 rate) returns(uint64 streamId)
+func (_IPayment *IPaymentTransactorSession) CreateStream(sender string, recipient string, rate *big.Int) (*types.Transaction, error) {
+	return _IPayment.Contract.CreateStream(&_IPayment.TransactOpts, sender, recipient, rate)
+}
+
 // Deposit is a paid mutator transaction binding the contract method 0x8e27d719.
 //
 // Solidity: function deposit(string to, uint256 amount) returns(bool success)
@@ -794,6 +922,69 @@ func (_IPayment *IPaymentTransactorSession) DisableRefund(addr string) (*types.T
 	return _IPayment.Contract.DisableRefund(&_IPayment.TransactOpts, addr)
 }
 
+// SetAutoDepositAllowance is a paid mutator transaction binding the contract method 0xef1d9c24.
+//
+// Solidity: function setAutoDepositAllowance(string addr, uint256 maxAmountPerPeriod, uint64 periodSeconds, uint64 thresholdSeconds) returns(bool success)
+func (_IPayment *IPaymentTransactor) SetAutoDepositAllowance(opts *bind.TransactOpts, addr string, maxAmountPerPeriod *big.Int, periodSeconds uint64, thresholdSeconds uint64) (*types.Transaction, error) {
+	return _IPayment.contract.Transact(opts, "setAutoDepositAllowance", addr, maxAmountPerPeriod, periodSeconds, thresholdSeconds)
+}
+
+// SetAutoDepositAllowance is a paid mutator transaction binding the contract method 0xef1d9c24.
+//
+// Solidity: function setAutoDepositAllowance(string addr, uint256 maxAmountPerPeriod, uint64 periodSeconds, uint64 thresholdSeconds) returns(bool success)
+func (_IPayment *IPaymentSession) SetAutoDepositAllowance(addr string, maxAmountPerPeriod *big.Int, periodSeconds uint64, thresholdSeconds uint64) (*types.Transaction, error) {
+	return _IPayment.Contract.SetAutoDepositAllowance(&_IPayment.TransactOpts, addr, maxAmountPerPeriod, periodSeconds, thresholdSeconds)
+}
+
+// SetAutoDepositAllowance is a paid mutator transaction binding the contract method 0xef1d9c24.
+//
+// Solidity: function setAutoDepositAllowance(string addr, uint256 maxAmountPerPeriod, uint64 periodSeconds, uint64 thresholdSeconds) returns(bool success)
+func (_IPayment *IPaymentTransactorSession) SetAutoDepositAllowance(addr string, maxAmountPerPeriod *big.Int, periodSeconds uint64, thresholdSeconds uint64) (*types.Transaction, error) {
+	return _IPayment.Contract.SetAutoDepositAllowance(&_IPayment.TransactOpts, addr, maxAmountPerPeriod, periodSeconds, thresholdSeconds)
+}
+
+// SetRunwayAlert is a paid mutator transaction binding the contract method 0x3fce8c15.
+//
+// Solidity: function setRunwayAlert(string addr, uint64 thresholdSeconds) returns(bool success)
+func (_IPayment *IPaymentTransactor) SetRunwayAlert(opts *bind.TransactOpts, addr string, thresholdSeconds uint64) (*types.Transaction, error) {
+	return _IPayment.contract.Transact(opts, "setRunwayAlert", addr, thresholdSeconds)
+}
+
+// SetRunwayAlert is a paid mutator transaction binding the contract method 0x3fce8c15.
+//
+// Solidity: function setRunwayAlert(string addr, uint64 thresholdSeconds) returns(bool success)
+func (_IPayment *IPaymentSession) SetRunwayAlert(addr string, thresholdSeconds uint64) (*types.Transaction, error) {
+	return _IPayment.Contract.SetRunwayAlert(&_IPayment.TransactOpts, addr, thresholdSeconds)
+}
+
+// SetRunwayAlert is a paid mutator transaction binding the contract method 0x3fce8c15.
+//
+// Solidity: function setRunwayAlert(string addr, uint64 thresholdSeconds) returns(bool success)
+func (_IPayment *IPaymentTransactorSession) SetRunwayAlert(addr string, thresholdSeconds uint64) (*types.Transaction, error) {
+	return _IPayment.Contract.SetRunwayAlert(&_IPayment.TransactOpts, addr, thresholdSeconds)
+}
+
+// UpdateStream is a paid mutator transaction binding the contract method 0x03349c57.
+//
+// Solidity: function updateStream(uint64 streamId, uint256 rate) returns(bool success)
+func (_IPayment *IPaymentTransactor) UpdateStream(opts *bind.TransactOpts, streamId uint64, rate *big.Int) (*types.Transaction, error) {
+	return _IPayment.contract.Transact(opts, "updateStream", streamId, rate)
+}
+
+// UpdateStream is a paid mutator transaction binding the contract method 0x03349c57.
+//
+// Solidity: function updateStream(uint64 streamId, uint256 rate) returns(bool success)
+func (_IPayment *IPaymentSession) UpdateStream(streamId uint64, rate *big.Int) (*types.Transaction, error) {
+	return _IPayment.Contract.UpdateStream(&_IPayment.TransactOpts, streamId, rate)
+}
+
+// UpdateStream is a paid mutator transaction binding the contract method 0x03349c57.
+//
+// Solidity: function updateStream(uint64 streamId, uint256 rate) returns(bool success)
+func (_IPayment *IPaymentTransactorSession) UpdateStream(streamId uint64, rate *big.Int) (*types.Transaction, error) {
+	return _IPayment.Contract.UpdateStream(&_IPayment.TransactOpts, streamId, rate)
+}
+
 // Withdraw is a paid mutator transaction binding the contract method 0x30b39a62.
 //
 // Solidity: function withdraw(string from, uint256 amount) returns(bool success)
@@ -815,9 +1006,9 @@ func (_IPayment *IPaymentTransactorSession) Withdraw(from string, amount *big.In
 	return _IPayment.Contract.Withdraw(&_IPayment.TransactOpts, from, amount)
 }
 
-// IPaymentCreatePaymentAccountIterator is returned from FilterCreatePaymentAccount and is used to iterate over the raw logs and unpacked data for CreatePaymentAccount events raised by the IPayment contract.
-type IPaymentCreatePaymentAccountIterator struct {
-	Event *IPaymentCreatePaymentAccount // Event containing the contract specifics and raw log
+// IPaymentCancelStreamIterator is returned from FilterCancelStream and is used to iterate over the raw logs and unpacked data for CancelStream events raised by the IPayment contract.
+type IPaymentCancelStreamIterator struct {
+	Event *IPaymentCancelStream // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -831,7 +1022,7 @@ type IPaymentCreatePaymentAccountIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *IPaymentCreatePaymentAccountIterator) Next() bool {
+func (it *IPaymentCancelStreamIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -840,7 +1031,7 @@ func (it *IPaymentCreatePaymentAccountIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(IPaymentCreatePaymentAccount)
+			it.Event = new(IPaymentCancelStream)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -855,7 +1046,7 @@ func (it *IPaymentCreatePaymentAccountIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(IPaymentCreatePaymentAccount)
+		it.Event = new(IPaymentCancelStream)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -871,51 +1062,52 @@ func (it *IPaymentCreatePaymentAccountIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *IPaymentCreatePaymentAccountIterator) Error() error {
+func (it *IPaymentCancelStreamIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *IPaymentCreatePaymentAccountIterator) Close() error {
+func (it *IPaymentCancelStreamIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// IPaymentCreatePaymentAccount represents a CreatePaymentAccount event raised by the IPayment contract.
-type IPaymentCreatePaymentAccount struct {
-	Creator common.Address
-	Raw     types.Log // Blockchain specific contextual infos
+// IPaymentCancelStream represents a CancelStream event raised by the IPayment contract.
+type IPaymentCancelStream struct {
+	Creator  common.Address
+	StreamId uint64
+	Raw      types.Log // Blockchain specific contextual infos
 }
 
-// FilterCreatePaymentAccount is a free log retrieval operation binding the contract event 0xe51c69f2d31f4a4a9eb11e314f91e7edee9b6549fb95b8aba70df8b0ca3b502b.
+// FilterCancelStream is a free log retrieval operation binding the contract event 0x5afb42d1c159a5c669adc19c2e4dc4c2c0d86e7d56ffb23b62f35d9d5438fbca.
 //
-// Solidity: event CreatePaymentAccount(address indexed creator)
-func (_IPayment *IPaymentFilterer) FilterCreatePaymentAccount(opts *bind.FilterOpts, creator []common.Address) (*IPaymentCreatePaymentAccountIterator, error) {
+// Solidity: event CancelStream(address indexed creator, uint64 streamId)
+func (_IPayment *IPaymentFilterer) FilterCancelStream(opts *bind.FilterOpts, creator []common.Address) (*IPaymentCancelStreamIterator, error) {
 
 	var creatorRule []interface{}
 	for _, creatorItem := range creator {
 		creatorRule = append(creatorRule, creatorItem)
 	}
 
-	logs, sub, err := _IPayment.contract.FilterLogs(opts, "CreatePaymentAccount", creatorRule)
+	logs, sub, err := _IPayment.contract.FilterLogs(opts, "CancelStream", creatorRule)
 	if err != nil {
 		return nil, err
 	}
-	return &IPaymentCreatePaymentAccountIterator{contract: _IPayment.contract, event: "CreatePaymentAccount", logs: logs, sub: sub}, nil
+	return &IPaymentCancelStreamIterator{contract: _IPayment.contract, event: "CancelStream", logs: logs, sub: sub}, nil
 }
 
-// WatchCreatePaymentAccount is a free log subscription operation binding the contract event 0xe51c69f2d31f4a4a9eb11e314f91e7edee9b6549fb95b8aba70df8b0ca3b502b.
+// WatchCancelStream is a free log subscription operation binding the contract event 0x5afb42d1c159a5c669adc19c2e4dc4c2c0d86e7d56ffb23b62f35d9d5438fbca.
 //
-// Solidity: event CreatePaymentAccount(address indexed creator)
-func (_IPayment *IPaymentFilterer) WatchCreatePaymentAccount(opts *bind.WatchOpts, sink chan<- *IPaymentCreatePaymentAccount, creator []common.Address) (event.Subscription, error) {
+// Solidity: event CancelStream(address indexed creator, uint64 streamId)
+func (_IPayment *IPaymentFilterer) WatchCancelStream(opts *bind.WatchOpts, sink chan<- *IPaymentCancelStream, creator []common.Address) (event.Subscription, error) {
 
 	var creatorRule []interface{}
 	for _, creatorItem := range creator {
 		creatorRule = append(creatorRule, creatorItem)
 	}
 
-	logs, sub, err := _IPayment.contract.WatchLogs(opts, "CreatePaymentAccount", creatorRule)
+	logs, sub, err := _IPayment.contract.WatchLogs(opts, "CancelStream", creatorRule)
 	if err != nil {
 		return nil, err
 	}
@@ -925,8 +1117,8 @@ func (_IPayment *IPaymentFilterer) WatchCreatePaymentAccount(opts *bind.WatchOpt
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(IPaymentCreatePaymentAccount)
-				if err := _IPayment.contract.UnpackLog(event, "CreatePaymentAccount", log); err != nil {
+				event := new(IPaymentCancelStream)
+				if err := _IPayment.contract.UnpackLog(event, "CancelStream", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -947,21 +1139,21 @@ func (_IPayment *IPaymentFilterer) WatchCreatePaymentAccount(opts *bind.WatchOpt
 	}), nil
 }
 
-// ParseCreatePaymentAccount is a log parse operation binding the contract event 0xe51c69f2d31f4a4a9eb11e314f91e7edee9b6549fb95b8aba70df8b0ca3b502b.
+// ParseCancelStream is a log parse operation binding the contract event 0x5afb42d1c159a5c669adc19c2e4dc4c2c0d86e7d56ffb23b62f35d9d5438fbca.
 //
-// Solidity: event CreatePaymentAccount(address indexed creator)
-func (_IPayment *IPaymentFilterer) ParseCreatePaymentAccount(log types.Log) (*IPaymentCreatePaymentAccount, error) {
-	event := new(IPaymentCreatePaymentAccount)
-	if err := _IPayment.contract.UnpackLog(event, "CreatePaymentAccount", log); err != nil {
+// Solidity: event CancelStream(address indexed creator, uint64 streamId)
+func (_IPayment *IPaymentFilterer) ParseCancelStream(log types.Log) (*IPaymentCancelStream, error) {
+	event := new(IPaymentCancelStream)
+	if err := _IPayment.contract.UnpackLog(event, "CancelStream", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// IPaymentDepositIterator is returned from FilterDeposit and is used to iterate over the raw logs and unpacked data for Deposit events raised by the IPayment contract.
-type IPaymentDepositIterator struct {
-	Event *IPaymentDeposit // Event containing the contract specifics and raw log
+// IPaymentCreatePaymentAccountIterator is returned from FilterCreatePaymentAccount and is used to iterate over the raw logs and unpacked data for CreatePaymentAccount events raised by the IPayment contract.
+type IPaymentCreatePaymentAccountIterator struct {
+	Event *IPaymentCreatePaymentAccount // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -975,7 +1167,7 @@ type IPaymentDepositIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *IPaymentDepositIterator) Next() bool {
+func (it *IPaymentCreatePaymentAccountIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -984,7 +1176,7 @@ func (it *IPaymentDepositIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(IPaymentDeposit)
+			it.Event = new(IPaymentCreatePaymentAccount)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -999,7 +1191,7 @@ func (it *IPaymentDepositIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(IPaymentDeposit)
+		it.Event = new(IPaymentCreatePaymentAccount)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1015,51 +1207,51 @@ func (it *IPaymentDepositIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *IPaymentDepositIterator) Error() error {
+func (it *IPaymentCreatePaymentAccountIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *IPaymentDepositIterator) Close() error {
+func (it *IPaymentCreatePaymentAccountIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// IPaymentDeposit represents a Deposit event raised by the IPayment contract.
-type IPaymentDeposit struct {
-	Operator common.Address
-	Raw      types.Log // Blockchain specific contextual infos
+// IPaymentCreatePaymentAccount represents a CreatePaymentAccount event raised by the IPayment contract.
+type IPaymentCreatePaymentAccount struct {
+	Creator common.Address
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterDeposit is a free log retrieval operation binding the contract event 0x8ce0bd46ec50cf39f0d0ea8686a686eb226af5796dcda4231b26fb84b5ef1234.
+// FilterCreatePaymentAccount is a free log retrieval operation binding the contract event 0xe51c69f2d31f4a4a9eb11e314f91e7edee9b6549fb95b8aba70df8b0ca3b502b.
 //
-// Solidity: event Deposit(address indexed operator)
-func (_IPayment *IPaymentFilterer) FilterDeposit(opts *bind.FilterOpts, operator []common.Address) (*IPaymentDepositIterator, error) {
+// Solidity: event CreatePaymentAccount(address indexed creator)
+func (_IPayment *IPaymentFilterer) FilterCreatePaymentAccount(opts *bind.FilterOpts, creator []common.Address) (*IPaymentCreatePaymentAccountIterator, error) {
 
-	var operatorRule []interface{}
-	for _, operatorItem := range operator {
-		operatorRule = append(operatorRule, operatorItem)
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
 	}
 
-	logs, sub, err := _IPayment.contract.FilterLogs(opts, "Deposit", operatorRule)
+	logs, sub, err := _IPayment.contract.FilterLogs(opts, "CreatePaymentAccount", creatorRule)
 	if err != nil {
 		return nil, err
 	}
-	return &IPaymentDepositIterator{contract: _IPayment.contract, event: "Deposit", logs: logs, sub: sub}, nil
+	return &IPaymentCreatePaymentAccountIterator{contract: _IPayment.contract, event: "CreatePaymentAccount", logs: logs, sub: sub}, nil
 }
 
-// WatchDeposit is a free log subscription operation binding the contract event 0x8ce0bd46ec50cf39f0d0ea8686a686eb226af5796dcda4231b26fb84b5ef1234.
+// WatchCreatePaymentAccount is a free log subscription operation binding the contract event 0xe51c69f2d31f4a4a9eb11e314f91e7edee9b6549fb95b8aba70df8b0ca3b502b.
 //
-// Solidity: event Deposit(address indexed operator)
-func (_IPayment *IPaymentFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<- *IPaymentDeposit, operator []common.Address) (event.Subscription, error) {
+// Solidity: event CreatePaymentAccount(address indexed creator)
+func (_IPayment *IPaymentFilterer) WatchCreatePaymentAccount(opts *bind.WatchOpts, sink chan<- *IPaymentCreatePaymentAccount, creator []common.Address) (event.Subscription, error) {
 
-	var operatorRule []interface{}
-	for _, operatorItem := range operator {
-		operatorRule = append(operatorRule, operatorItem)
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
 	}
 
-	logs, sub, err := _IPayment.contract.WatchLogs(opts, "Deposit", operatorRule)
+	logs, sub, err := _IPayment.contract.WatchLogs(opts, "CreatePaymentAccount", creatorRule)
 	if err != nil {
 		return nil, err
 	}
@@ -1069,8 +1261,8 @@ func (_IPayment *IPaymentFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(IPaymentDeposit)
-				if err := _IPayment.contract.UnpackLog(event, "Deposit", log); err != nil {
+				event := new(IPaymentCreatePaymentAccount)
+				if err := _IPayment.contract.UnpackLog(event, "CreatePaymentAccount", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1091,21 +1283,21 @@ func (_IPayment *IPaymentFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<
 	}), nil
 }
 
-// ParseDeposit is a log parse operation binding the contract event 0x8ce0bd46ec50cf39f0d0ea8686a686eb226af5796dcda4231b26fb84b5ef1234.
+// ParseCreatePaymentAccount is a log parse operation binding the contract event 0xe51c69f2d31f4a4a9eb11e314f91e7edee9b6549fb95b8aba70df8b0ca3b502b.
 //
-// Solidity: event Deposit(address indexed operator)
-func (_IPayment *IPaymentFilterer) ParseDeposit(log types.Log) (*IPaymentDeposit, error) {
-	event := new(IPaymentDeposit)
-	if err := _IPayment.contract.UnpackLog(event, "Deposit", log); err != nil {
+// Solidity: event CreatePaymentAccount(address indexed creator)
+func (_IPayment *IPaymentFilterer) ParseCreatePaymentAccount(log types.Log) (*IPaymentCreatePaymentAccount, error) {
+	event := new(IPaymentCreatePaymentAccount)
+	if err := _IPayment.contract.UnpackLog(event, "CreatePaymentAccount", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// IPaymentDisableRefundIterator is returned from FilterDisableRefund and is used to iterate over the raw logs and unpacked data for DisableRefund events raised by the IPayment contract.
-type IPaymentDisableRefundIterator struct {
-	Event *IPaymentDisableRefund // Event containing the contract specifics and raw log
+// IPaymentCreateStreamIterator is returned from FilterCreateStream and is used to iterate over the raw logs and unpacked data for CreateStream events raised by the IPayment contract.
+type IPaymentCreateStreamIterator struct {
+	Event *IPaymentCreateStream // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1119,7 +1311,7 @@ type IPaymentDisableRefundIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *IPaymentDisableRefundIterator) Next() bool {
+func (it *IPaymentCreateStreamIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1128,7 +1320,7 @@ func (it *IPaymentDisableRefundIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(IPaymentDisableRefund)
+			it.Event = new(IPaymentCreateStream)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1143,7 +1335,7 @@ func (it *IPaymentDisableRefundIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(IPaymentDisableRefund)
+		it.Event = new(IPaymentCreateStream)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1159,51 +1351,52 @@ func (it *IPaymentDisableRefundIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *IPaymentDisableRefundIterator) Error() error {
+func (it *IPaymentCreateStreamIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *IPaymentDisableRefundIterator) Close() error {
+func (it *IPaymentCreateStreamIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// IPaymentDisableRefund represents a DisableRefund event raised by the IPayment contract.
-type IPaymentDisableRefund struct {
-	Owner common.Address
-	Raw   types.Log // Blockchain specific contextual infos
+// IPaymentCreateStream represents a CreateStream event raised by the IPayment contract.
+type IPaymentCreateStream struct {
+	Creator  common.Address
+	StreamId uint64
+	Raw      types.Log // Blockchain specific contextual infos
 }
 
-// FilterDisableRefund is a free log retrieval operation binding the contract event 0x5dab65934c4c1b887963b93f7d8505184b47126e065b2b1a1743a50699b5c48b.
+// FilterCreateStream is a free log retrieval operation binding the contract event 0xb0ad9d54af1d229d202426b2dfdb164e960231b841e378b4502d2efc402f0cd6.
 //
-// Solidity: event DisableRefund(address indexed owner)
-func (_IPayment *IPaymentFilterer) FilterDisableRefund(opts *bind.FilterOpts, owner []common.Address) (*IPaymentDisableRefundIterator, error) {
+// Solidity: event CreateStream(address indexed creator, uint64 streamId)
+func (_IPayment *IPaymentFilterer) FilterCreateStream(opts *bind.FilterOpts, creator []common.Address) (*IPaymentCreateStreamIterator, error) {
 
-	var ownerRule []interface{}
-	for _, ownerItem := range owner {
-		ownerRule = append(ownerRule, ownerItem)
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
 	}
 
-	logs, sub, err := _IPayment.contract.FilterLogs(opts, "DisableRefund", ownerRule)
+	logs, sub, err := _IPayment.contract.FilterLogs(opts, "CreateStream", creatorRule)
 	if err != nil {
 		return nil, err
 	}
-	return &IPaymentDisableRefundIterator{contract: _IPayment.contract, event: "DisableRefund", logs: logs, sub: sub}, nil
+	return &IPaymentCreateStreamIterator{contract: _IPayment.contract, event: "CreateStream", logs: logs, sub: sub}, nil
 }
 
-// WatchDisableRefund is a free log subscription operation binding the contract event 0x5dab65934c4c1b887963b93f7d8505184b47126e065b2b1a1743a50699b5c48b.
+// WatchCreateStream is a free log subscription operation binding the contract event 0xb0ad9d54af1d229d202426b2dfdb164e960231b841e378b4502d2efc402f0cd6.
 //
-// Solidity: event DisableRefund(address indexed owner)
-func (_IPayment *IPaymentFilterer) WatchDisableRefund(opts *bind.WatchOpts, sink chan<- *IPaymentDisableRefund, owner []common.Address) (event.Subscription, error) {
+// Solidity: event CreateStream(address indexed creator, uint64 streamId)
+func (_IPayment *IPaymentFilterer) WatchCreateStream(opts *bind.WatchOpts, sink chan<- *IPaymentCreateStream, creator []common.Address) (event.Subscription, error) {
 
-	var ownerRule []interface{}
-	for _, ownerItem := range owner {
-		ownerRule = append(ownerRule, ownerItem)
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
 	}
 
-	logs, sub, err := _IPayment.contract.WatchLogs(opts, "DisableRefund", ownerRule)
+	logs, sub, err := _IPayment.contract.WatchLogs(opts, "CreateStream", creatorRule)
 	if err != nil {
 		return nil, err
 	}
@@ -1213,8 +1406,8 @@ func (_IPayment *IPaymentFilterer) WatchDisableRefund(opts *bind.WatchOpts, sink
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(IPaymentDisableRefund)
-				if err := _IPayment.contract.UnpackLog(event, "DisableRefund", log); err != nil {
+				event := new(IPaymentCreateStream)
+				if err := _IPayment.contract.UnpackLog(event, "CreateStream", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1235,12 +1428,734 @@ func (_IPayment *IPaymentFilterer) WatchDisableRefund(opts *bind.WatchOpts, sink
 	}), nil
 }
 
-// ParseDisableRefund is a log parse operation binding the contract event 0x5dab65934c4c1b887963b93f7d8505184b47126e065b2b1a1743a50699b5c48b.
+// ParseCreateStream is a log parse operation binding the contract event 0xb0ad9d54af1d229d202426b2dfdb164e960231b841e378b4502d2efc402f0cd6.
 //
-// Solidity: event DisableRefund(address indexed owner)
-func (_IPayment *IPaymentFilterer) ParseDisableRefund(log types.Log) (*IPaymentDisableRefund, error) {
-	event := new(IPaymentDisableRefund)
-	if err := _IPayment.contract.UnpackLog(event, "DisableRefund", log); err != nil {
+// Solidity: event CreateStream(address indexed creator, uint64 streamId)
+func (_IPayment *IPaymentFilterer) ParseCreateStream(log types.Log) (*IPaymentCreateStream, error) {
+	event := new(IPaymentCreateStream)
+	if err := _IPayment.contract.UnpackLog(event, "CreateStream", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IPaymentDepositIterator is returned from FilterDeposit and is used to iterate over the raw logs and unpacked data for Deposit events raised by the IPayment contract.
+type IPaymentDepositIterator struct {
+	Event *IPaymentDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IPaymentDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IPaymentDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IPaymentDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IPaymentDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IPaymentDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IPaymentDeposit represents a Deposit event raised by the IPayment contract.
+type IPaymentDeposit struct {
+	Operator common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeposit is a free log retrieval operation binding the contract event 0x8ce0bd46ec50cf39f0d0ea8686a686eb226af5796dcda4231b26fb84b5ef1234.
+//
+// Solidity: event Deposit(address indexed operator)
+func (_IPayment *IPaymentFilterer) FilterDeposit(opts *bind.FilterOpts, operator []common.Address) (*IPaymentDepositIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IPayment.contract.FilterLogs(opts, "Deposit", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IPaymentDepositIterator{contract: _IPayment.contract, event: "Deposit", logs: logs, sub: sub}, nil
+}
+
+// WatchDeposit is a free log subscription operation binding the contract event 0x8ce0bd46ec50cf39f0d0ea8686a686eb226af5796dcda4231b26fb84b5ef1234.
+//
+// Solidity: event Deposit(address indexed operator)
+func (_IPayment *IPaymentFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<- *IPaymentDeposit, operator []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IPayment.contract.WatchLogs(opts, "Deposit", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IPaymentDeposit)
+				if err := _IPayment.contract.UnpackLog(event, "Deposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeposit is a log parse operation binding the contract event 0x8ce0bd46ec50cf39f0d0ea8686a686eb226af5796dcda4231b26fb84b5ef1234.
+//
+// Solidity: event Deposit(address indexed operator)
+func (_IPayment *IPaymentFilterer) ParseDeposit(log types.Log) (*IPaymentDeposit, error) {
+	event := new(IPaymentDeposit)
+	if err := _IPayment.contract.UnpackLog(event, "Deposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IPaymentDisableRefundIterator is returned from FilterDisableRefund and is used to iterate over the raw logs and unpacked data for DisableRefund events raised by the IPayment contract.
+type IPaymentDisableRefundIterator struct {
+	Event *IPaymentDisableRefund // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IPaymentDisableRefundIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IPaymentDisableRefund)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IPaymentDisableRefund)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IPaymentDisableRefundIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IPaymentDisableRefundIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IPaymentDisableRefund represents a DisableRefund event raised by the IPayment contract.
+type IPaymentDisableRefund struct {
+	Owner common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterDisableRefund is a free log retrieval operation binding the contract event 0x5dab65934c4c1b887963b93f7d8505184b47126e065b2b1a1743a50699b5c48b.
+//
+// Solidity: event DisableRefund(address indexed owner)
+func (_IPayment *IPaymentFilterer) FilterDisableRefund(opts *bind.FilterOpts, owner []common.Address) (*IPaymentDisableRefundIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _IPayment.contract.FilterLogs(opts, "DisableRefund", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IPaymentDisableRefundIterator{contract: _IPayment.contract, event: "DisableRefund", logs: logs, sub: sub}, nil
+}
+
+// WatchDisableRefund is a free log subscription operation binding the contract event 0x5dab65934c4c1b887963b93f7d8505184b47126e065b2b1a1743a50699b5c48b.
+//
+// Solidity: event DisableRefund(address indexed owner)
+func (_IPayment *IPaymentFilterer) WatchDisableRefund(opts *bind.WatchOpts, sink chan<- *IPaymentDisableRefund, owner []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _IPayment.contract.WatchLogs(opts, "DisableRefund", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IPaymentDisableRefund)
+				if err := _IPayment.contract.UnpackLog(event, "DisableRefund", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDisableRefund is a log parse operation binding the contract event 0x5dab65934c4c1b887963b93f7d8505184b47126e065b2b1a1743a50699b5c48b.
+//
+// Solidity: event DisableRefund(address indexed owner)
+func (_IPayment *IPaymentFilterer) ParseDisableRefund(log types.Log) (*IPaymentDisableRefund, error) {
+	event := new(IPaymentDisableRefund)
+	if err := _IPayment.contract.UnpackLog(event, "DisableRefund", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IPaymentSetAutoDepositAllowanceIterator is returned from FilterSetAutoDepositAllowance and is used to iterate over the raw logs and unpacked data for SetAutoDepositAllowance events raised by the IPayment contract.
+type IPaymentSetAutoDepositAllowanceIterator struct {
+	Event *IPaymentSetAutoDepositAllowance // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IPaymentSetAutoDepositAllowanceIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IPaymentSetAutoDepositAllowance)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IPaymentSetAutoDepositAllowance)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IPaymentSetAutoDepositAllowanceIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IPaymentSetAutoDepositAllowanceIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IPaymentSetAutoDepositAllowance represents a SetAutoDepositAllowance event raised by the IPayment contract.
+type IPaymentSetAutoDepositAllowance struct {
+	Creator common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetAutoDepositAllowance is a free log retrieval operation binding the contract event 0xff4e9ac8ca85746334f4c364364e77a202fa98876547eed8a631de3cad89ce2e.
+//
+// Solidity: event SetAutoDepositAllowance(address indexed creator)
+func (_IPayment *IPaymentFilterer) FilterSetAutoDepositAllowance(opts *bind.FilterOpts, creator []common.Address) (*IPaymentSetAutoDepositAllowanceIterator, error) {
+
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _IPayment.contract.FilterLogs(opts, "SetAutoDepositAllowance", creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IPaymentSetAutoDepositAllowanceIterator{contract: _IPayment.contract, event: "SetAutoDepositAllowance", logs: logs, sub: sub}, nil
+}
+
+// WatchSetAutoDepositAllowance is a free log subscription operation binding the contract event 0xff4e9ac8ca85746334f4c364364e77a202fa98876547eed8a631de3cad89ce2e.
+//
+// Solidity: event SetAutoDepositAllowance(address indexed creator)
+func (_IPayment *IPaymentFilterer) WatchSetAutoDepositAllowance(opts *bind.WatchOpts, sink chan<- *IPaymentSetAutoDepositAllowance, creator []common.Address) (event.Subscription, error) {
+
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _IPayment.contract.WatchLogs(opts, "SetAutoDepositAllowance", creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IPaymentSetAutoDepositAllowance)
+				if err := _IPayment.contract.UnpackLog(event, "SetAutoDepositAllowance", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetAutoDepositAllowance is a log parse operation binding the contract event 0xff4e9ac8ca85746334f4c364364e77a202fa98876547eed8a631de3cad89ce2e.
+//
+// Solidity: event SetAutoDepositAllowance(address indexed creator)
+func (_IPayment *IPaymentFilterer) ParseSetAutoDepositAllowance(log types.Log) (*IPaymentSetAutoDepositAllowance, error) {
+	event := new(IPaymentSetAutoDepositAllowance)
+	if err := _IPayment.contract.UnpackLog(event, "SetAutoDepositAllowance", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IPaymentSetRunwayAlertIterator is returned from FilterSetRunwayAlert and is used to iterate over the raw logs and unpacked data for SetRunwayAlert events raised by the IPayment contract.
+type IPaymentSetRunwayAlertIterator struct {
+	Event *IPaymentSetRunwayAlert // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IPaymentSetRunwayAlertIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IPaymentSetRunwayAlert)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IPaymentSetRunwayAlert)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IPaymentSetRunwayAlertIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IPaymentSetRunwayAlertIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IPaymentSetRunwayAlert represents a SetRunwayAlert event raised by the IPayment contract.
+type IPaymentSetRunwayAlert struct {
+	Owner            common.Address
+	ThresholdSeconds uint64
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetRunwayAlert is a free log retrieval operation binding the contract event 0xf2ccecee6af9066fe2b393d0aee6f8dd4b71d3984eb7512896ebd556e3105fdc.
+//
+// Solidity: event SetRunwayAlert(address indexed owner, uint64 thresholdSeconds)
+func (_IPayment *IPaymentFilterer) FilterSetRunwayAlert(opts *bind.FilterOpts, owner []common.Address) (*IPaymentSetRunwayAlertIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _IPayment.contract.FilterLogs(opts, "SetRunwayAlert", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IPaymentSetRunwayAlertIterator{contract: _IPayment.contract, event: "SetRunwayAlert", logs: logs, sub: sub}, nil
+}
+
+// WatchSetRunwayAlert is a free log subscription operation binding the contract event 0xf2ccecee6af9066fe2b393d0aee6f8dd4b71d3984eb7512896ebd556e3105fdc.
+//
+// Solidity: event SetRunwayAlert(address indexed owner, uint64 thresholdSeconds)
+func (_IPayment *IPaymentFilterer) WatchSetRunwayAlert(opts *bind.WatchOpts, sink chan<- *IPaymentSetRunwayAlert, owner []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _IPayment.contract.WatchLogs(opts, "SetRunwayAlert", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IPaymentSetRunwayAlert)
+				if err := _IPayment.contract.UnpackLog(event, "SetRunwayAlert", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetRunwayAlert is a log parse operation binding the contract event 0xf2ccecee6af9066fe2b393d0aee6f8dd4b71d3984eb7512896ebd556e3105fdc.
+//
+// Solidity: event SetRunwayAlert(address indexed owner, uint64 thresholdSeconds)
+func (_IPayment *IPaymentFilterer) ParseSetRunwayAlert(log types.Log) (*IPaymentSetRunwayAlert, error) {
+	event := new(IPaymentSetRunwayAlert)
+	if err := _IPayment.contract.UnpackLog(event, "SetRunwayAlert", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IPaymentUpdateStreamIterator is returned from FilterUpdateStream and is used to iterate over the raw logs and unpacked data for UpdateStream events raised by the IPayment contract.
+type IPaymentUpdateStreamIterator struct {
+	Event *IPaymentUpdateStream // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IPaymentUpdateStreamIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IPaymentUpdateStream)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IPaymentUpdateStream)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IPaymentUpdateStreamIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IPaymentUpdateStreamIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IPaymentUpdateStream represents a UpdateStream event raised by the IPayment contract.
+type IPaymentUpdateStream struct {
+	Creator  common.Address
+	StreamId uint64
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpdateStream is a free log retrieval operation binding the contract event 0x8eab5d26fad959ee1b686f70d3b00e4dac00e2562d8795e89bb0217ebd91f7fb.
+//
+// Solidity: event UpdateStream(address indexed creator, uint64 streamId)
+func (_IPayment *IPaymentFilterer) FilterUpdateStream(opts *bind.FilterOpts, creator []common.Address) (*IPaymentUpdateStreamIterator, error) {
+
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _IPayment.contract.FilterLogs(opts, "UpdateStream", creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IPaymentUpdateStreamIterator{contract: _IPayment.contract, event: "UpdateStream", logs: logs, sub: sub}, nil
+}
+
+// WatchUpdateStream is a free log subscription operation binding the contract event 0x8eab5d26fad959ee1b686f70d3b00e4dac00e2562d8795e89bb0217ebd91f7fb.
+//
+// Solidity: event UpdateStream(address indexed creator, uint64 streamId)
+func (_IPayment *IPaymentFilterer) WatchUpdateStream(opts *bind.WatchOpts, sink chan<- *IPaymentUpdateStream, creator []common.Address) (event.Subscription, error) {
+
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _IPayment.contract.WatchLogs(opts, "UpdateStream", creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IPaymentUpdateStream)
+				if err := _IPayment.contract.UnpackLog(event, "UpdateStream", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpdateStream is a log parse operation binding the contract event 0x8eab5d26fad959ee1b686f70d3b00e4dac00e2562d8795e89bb0217ebd91f7fb.
+//
+// Solidity: event UpdateStream(address indexed creator, uint64 streamId)
+func (_IPayment *IPaymentFilterer) ParseUpdateStream(log types.Log) (*IPaymentUpdateStream, error) {
+	event := new(IPaymentUpdateStream)
+	if err := _IPayment.contract.UnpackLog(event, "UpdateStream", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -18,6 +18,16 @@ const (
 	EventTypeDisableRefund = "DisableRefund"
 	// EventTypeWithdraw is the event emitted on a Withdraw transaction.
 	EventTypeWithdraw = "Withdraw"
+	// EventTypeCreateStream is the event emitted on a CreateStream transaction.
+	EventTypeCreateStream = "CreateStream"
+	// EventTypeUpdateStream is the event emitted on an UpdateStream transaction.
+	EventTypeUpdateStream = "UpdateStream"
+	// EventTypeCancelStream is the event emitted on a CancelStream transaction.
+	EventTypeCancelStream = "CancelStream"
+	// EventTypeSetRunwayAlert is the event emitted on a SetRunwayAlert transaction.
+	EventTypeSetRunwayAlert = "SetRunwayAlert"
+	// EventTypeSetAutoDepositAllowance is the event emitted on a SetAutoDepositAllowance transaction.
+	EventTypeSetAutoDepositAllowance = "SetAutoDepositAllowance"
 )
 
 // EmitCreatePaymentAccountEvent emits the CreatePaymentAccount event with the caller as the sole topic.
@@ -44,6 +54,36 @@ func (p Precompile) EmitWithdrawEvent(evm *vm.EVM, caller common.Address) error
 		[]common.Hash{common.BytesToHash(caller.Bytes())})
 }
 
+// EmitCreateStreamEvent emits the CreateStream event with the caller as the topic and the stream id as data.
+func (p Precompile) EmitCreateStreamEvent(evm *vm.EVM, caller common.Address, streamID uint64) error {
+	return p.AddLog(evm, MustEvent(EventTypeCreateStream),
+		[]common.Hash{common.BytesToHash(caller.Bytes())}, streamID)
+}
+
+// EmitUpdateStreamEvent emits the UpdateStream event with the caller as the topic and the stream id as data.
+func (p Precompile) EmitUpdateStreamEvent(evm *vm.EVM, caller common.Address, streamID uint64) error {
+	return p.AddLog(evm, MustEvent(EventTypeUpdateStream),
+		[]common.Hash{common.BytesToHash(caller.Bytes())}, streamID)
+}
+
+// EmitCancelStreamEvent emits the CancelStream event with the caller as the topic and the stream id as data.
+func (p Precompile) EmitCancelStreamEvent(evm *vm.EVM, caller common.Address, streamID uint64) error {
+	return p.AddLog(evm, MustEvent(EventTypeCancelStream),
+		[]common.Hash{common.BytesToHash(caller.Bytes())}, streamID)
+}
+
+// EmitSetRunwayAlertEvent emits the SetRunwayAlert event with the caller as the topic and the threshold as data.
+func (p Precompile) EmitSetRunwayAlertEvent(evm *vm.EVM, caller common.Address, thresholdSeconds uint64) error {
+	return p.AddLog(evm, MustEvent(EventTypeSetRunwayAlert),
+		[]common.Hash{common.BytesToHash(caller.Bytes())}, thresholdSeconds)
+}
+
+// EmitSetAutoDepositAllowanceEvent emits the SetAutoDepositAllowance event with the caller as the sole topic.
+func (p Precompile) EmitSetAutoDepositAllowanceEvent(evm *vm.EVM, caller common.Address) error {
+	return p.AddLog(evm, MustEvent(EventTypeSetAutoDepositAllowance),
+		[]common.Hash{common.BytesToHash(caller.Bytes())})
+}
+
 // AddLog packs the given event and appends it to the StateDB logs at the precompile address.
 func (p Precompile) AddLog(evm *vm.EVM, event abi.Event, topics []common.Hash, args ...interface{}) error {
 	data, packedTopics, err := types.PackTopicData(event, topics, args...)
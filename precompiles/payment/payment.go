@@ -110,6 +110,16 @@ func (p Precompile) Execute(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract,
 		bz, err = p.DisableRefund(ctx, evm, contract, method, args)
 	case WithdrawMethodName:
 		bz, err = p.Withdraw(ctx, evm, contract, method, args)
+	case CreateStreamMethodName:
+		bz, err = p.CreateStream(ctx, evm, contract, method, args)
+	case UpdateStreamMethodName:
+		bz, err = p.UpdateStream(ctx, evm, contract, method, args)
+	case CancelStreamMethodName:
+		bz, err = p.CancelStream(ctx, evm, contract, method, args)
+	case SetRunwayAlertMethodName:
+		bz, err = p.SetRunwayAlert(ctx, evm, contract, method, args)
+	case SetAutoDepositAllowanceMethodName:
+		bz, err = p.SetAutoDepositAllowance(ctx, evm, contract, method, args)
 	// Payment queries
 	case PaymentAccountsByOwnerMethodName:
 		bz, err = p.PaymentAccountsByOwner(ctx, method, args)
@@ -137,6 +147,10 @@ func (p Precompile) Execute(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract,
 		bz, err = p.AutoSettleRecords(ctx, method, args)
 	case DelayedWithdrawalMethodName:
 		bz, err = p.DelayedWithdrawal(ctx, method, args)
+	case StreamMethodName:
+		bz, err = p.Stream(ctx, method, args)
+	case StreamsBySenderMethodName:
+		bz, err = p.StreamsBySender(ctx, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -150,7 +164,12 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 	case CreatePaymentAccountMethodName,
 		DepositMethodName,
 		DisableRefundMethodName,
-		WithdrawMethodName:
+		WithdrawMethodName,
+		CreateStreamMethodName,
+		UpdateStreamMethodName,
+		CancelStreamMethodName,
+		SetRunwayAlertMethodName,
+		SetAutoDepositAllowanceMethodName:
 		return true
 	default:
 		return false
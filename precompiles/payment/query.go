@@ -34,6 +34,10 @@ const (
 	AutoSettleRecordsMethodName = "autoSettleRecords"
 	// DelayedWithdrawalMethodName is the ABI name for the delayedWithdrawal query.
 	DelayedWithdrawalMethodName = "delayedWithdrawal"
+	// StreamMethodName is the ABI name for the stream query.
+	StreamMethodName = "stream"
+	// StreamsBySenderMethodName is the ABI name for the streamsBySender query.
+	StreamsBySenderMethodName = "streamsBySender"
 )
 
 // PaymentAccountsByOwner queries all payment accounts by an owner.
@@ -297,6 +301,46 @@ func (p Precompile) DelayedWithdrawal(ctx sdk.Context, method *abi.Method, args
 	})
 }
 
+// Stream queries a user stream by id.
+func (p Precompile) Stream(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input StreamArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	res, err := p.paymentKeeper.Stream(ctx, &paymenttypes.QueryStreamRequest{
+		StreamId: input.StreamID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(outputsUserStream(res.Stream))
+}
+
+// StreamsBySender queries the user streams paid by a sender.
+func (p Precompile) StreamsBySender(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input StreamsBySenderArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	res, err := p.paymentKeeper.StreamsBySender(ctx, &paymenttypes.QueryStreamsBySenderRequest{
+		Sender:     input.Sender,
+		Pagination: pageRequest(input.Pagination),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	streams := make([]UserStream, 0, len(res.Streams))
+	for _, stream := range res.Streams {
+		streams = append(streams, outputsUserStream(stream))
+	}
+
+	return method.Outputs.Pack(streams, pageResponse(res.Pagination))
+}
+
 // outputsParams maps payment module params into the ABI tuple.
 func outputsParams(params paymenttypes.Params) Params {
 	return Params{
@@ -329,3 +373,15 @@ func outputsStreamRecord(streamRecord paymenttypes.StreamRecord) StreamRecord {
 		FrozenNetflowRate: streamRecord.FrozenNetflowRate.BigInt(),
 	}
 }
+
+// outputsUserStream maps a payment user stream into the ABI tuple.
+func outputsUserStream(stream paymenttypes.UserStream) UserStream {
+	return UserStream{
+		Id:        stream.Id,
+		Sender:    stream.Sender,
+		Recipient: stream.Recipient,
+		Rate:      stream.Rate.BigInt(),
+		CreatedAt: stream.CreatedAt,
+		UpdatedAt: stream.UpdatedAt,
+	}
+}
@@ -18,6 +18,16 @@ const (
 	DisableRefundMethodName = "disableRefund"
 	// WithdrawMethodName is the ABI name for the Withdraw transaction.
 	WithdrawMethodName = "withdraw"
+	// CreateStreamMethodName is the ABI name for the CreateStream transaction.
+	CreateStreamMethodName = "createStream"
+	// UpdateStreamMethodName is the ABI name for the UpdateStream transaction.
+	UpdateStreamMethodName = "updateStream"
+	// CancelStreamMethodName is the ABI name for the CancelStream transaction.
+	CancelStreamMethodName = "cancelStream"
+	// SetRunwayAlertMethodName is the ABI name for the SetRunwayAlert transaction.
+	SetRunwayAlertMethodName = "setRunwayAlert"
+	// SetAutoDepositAllowanceMethodName is the ABI name for the SetAutoDepositAllowance transaction.
+	SetAutoDepositAllowanceMethodName = "setAutoDepositAllowance"
 )
 
 // CreatePaymentAccount creates a new payment account owned by the caller.
@@ -119,3 +129,141 @@ func (p Precompile) Withdraw(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract
 
 	return method.Outputs.Pack(true)
 }
+
+// CreateStream opens a stream paid by the sender, the caller or a payment account it owns, to the recipient.
+func (p Precompile) CreateStream(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input CreateStreamArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	msg := &paymenttypes.MsgCreateStream{
+		Creator:   contract.Caller().String(),
+		Sender:    input.Sender,
+		Recipient: input.Recipient,
+		Rate:      math.NewIntFromBigInt(input.Rate),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.paymentMsgServer.CreateStream(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitCreateStreamEvent(evm, contract.Caller(), res.StreamId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.StreamId)
+}
+
+// UpdateStream changes the rate of a stream paid by the caller or a payment account it owns.
+func (p Precompile) UpdateStream(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input UpdateStreamArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	msg := &paymenttypes.MsgUpdateStream{
+		Creator:  contract.Caller().String(),
+		StreamId: input.StreamID,
+		Rate:     math.NewIntFromBigInt(input.Rate),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.paymentMsgServer.UpdateStream(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitUpdateStreamEvent(evm, contract.Caller(), input.StreamID); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CancelStream cancels a stream paid by the caller or a payment account it owns.
+func (p Precompile) CancelStream(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input CancelStreamArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	msg := &paymenttypes.MsgCancelStream{
+		Creator:  contract.Caller().String(),
+		StreamId: input.StreamID,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.paymentMsgServer.CancelStream(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCancelStreamEvent(evm, contract.Caller(), input.StreamID); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SetRunwayAlert sets the runway threshold below which the caller, or a payment account it owns, is alerted.
+func (p Precompile) SetRunwayAlert(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input SetRunwayAlertArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	msg := &paymenttypes.MsgSetRunwayAlert{
+		Owner:            contract.Caller().String(),
+		Addr:             input.Addr,
+		ThresholdSeconds: input.ThresholdSeconds,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.paymentMsgServer.SetRunwayAlert(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSetRunwayAlertEvent(evm, contract.Caller(), input.ThresholdSeconds); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SetAutoDepositAllowance authorizes the payment module to pull funds from the caller into a stream account it owns.
+func (p Precompile) SetAutoDepositAllowance(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input SetAutoDepositAllowanceArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	msg := &paymenttypes.MsgSetAutoDepositAllowance{
+		Creator:            contract.Caller().String(),
+		Addr:               input.Addr,
+		MaxAmountPerPeriod: math.NewIntFromBigInt(input.MaxAmountPerPeriod),
+		PeriodSeconds:      input.PeriodSeconds,
+		ThresholdSeconds:   input.ThresholdSeconds,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.paymentMsgServer.SetAutoDepositAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSetAutoDepositAllowanceEvent(evm, contract.Caller()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -77,6 +77,38 @@ type WithdrawArgs struct {
 	Amount *big.Int `abi:"amount"`
 }
 
+// CreateStreamArgs are the inputs to the createStream transaction.
+type CreateStreamArgs struct {
+	Sender    string   `abi:"sender"`
+	Recipient string   `abi:"recipient"`
+	Rate      *big.Int `abi:"rate"`
+}
+
+// UpdateStreamArgs are the inputs to the updateStream transaction.
+type UpdateStreamArgs struct {
+	StreamID uint64   `abi:"streamId"`
+	Rate     *big.Int `abi:"rate"`
+}
+
+// CancelStreamArgs are the inputs to the cancelStream transaction.
+type CancelStreamArgs struct {
+	StreamID uint64 `abi:"streamId"`
+}
+
+// SetRunwayAlertArgs are the inputs to the setRunwayAlert transaction.
+type SetRunwayAlertArgs struct {
+	Addr             string `abi:"addr"`
+	ThresholdSeconds uint64 `abi:"thresholdSeconds"`
+}
+
+// SetAutoDepositAllowanceArgs are the inputs to the setAutoDepositAllowance transaction.
+type SetAutoDepositAllowanceArgs struct {
+	Addr               string   `abi:"addr"`
+	MaxAmountPerPeriod *big.Int `abi:"maxAmountPerPeriod"`
+	PeriodSeconds      uint64   `abi:"periodSeconds"`
+	ThresholdSeconds   uint64   `abi:"thresholdSeconds"`
+}
+
 // PaymentAccountsByOwnerArgs are the inputs to the paymentAccountsByOwner query.
 type PaymentAccountsByOwnerArgs struct {
 	Owner string `abi:"owner"`
@@ -137,6 +169,17 @@ type DelayedWithdrawalArgs struct {
 	Account string `abi:"account"`
 }
 
+// StreamArgs are the inputs to the stream query.
+type StreamArgs struct {
+	StreamID uint64 `abi:"streamId"`
+}
+
+// StreamsBySenderArgs are the inputs to the streamsBySender query.
+type StreamsBySenderArgs struct {
+	Sender     string      `abi:"sender"`
+	Pagination PageRequest `abi:"pagination"`
+}
+
 // pageRequest builds a query.PageRequest from the ABI pagination tuple.
 func pageRequest(page PageRequest) *query.PageRequest {
 	return &query.PageRequest{
@@ -29,6 +29,20 @@ var (
 	_ = abi.ConvertType
 )
 
+// Condition is an auto generated low-level Go binding around an user-defined struct.
+type Condition struct {
+	Key    string
+	Values []string
+}
+
+// GroupMember is an auto generated low-level Go binding around an user-defined struct.
+type GroupMember struct {
+	Id             *big.Int
+	GroupId        *big.Int
+	Member         common.Address
+	ExpirationTime int64
+}
+
 // Params is an auto generated low-level Go binding around an user-defined struct.
 type Params struct {
 	MaximumStatementsNum                  uint64
@@ -36,9 +50,35 @@ type Params struct {
 	MaximumRemoveExpiredPoliciesIteration uint64
 }
 
+// Policy is an auto generated low-level Go binding around an user-defined struct.
+type Policy struct {
+	Id             *big.Int
+	Principal      Principal
+	ResourceType   int32
+	ResourceId     *big.Int
+	Statements     []Statement
+	ExpirationTime int64
+}
+
+// Principal is an auto generated low-level Go binding around an user-defined struct.
+type Principal struct {
+	PrincipalType int32
+	Value         string
+}
+
+// Statement is an auto generated low-level Go binding around an user-defined struct.
+type Statement struct {
+	Effect         int32
+	Actions        []int32
+	Resources      []string
+	ExpirationTime int64
+	LimitSize      uint64
+	Conditions     []Condition
+}
+
 // IPermissionMetaData contains all meta data concerning the IPermission contract.
 var IPermissionMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[],\"name\":\"params\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"maximumStatementsNum\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maximumGroupNum\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maximumRemoveExpiredPoliciesIteration\",\"type\":\"uint64\"}],\"internalType\":\"structParams\",\"name\":\"params\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"policyId\",\"type\":\"uint256\"}],\"name\":\"DeletePolicy\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"policyId\",\"type\":\"uint256\"}],\"name\":\"PutPolicy\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"}],\"name\":\"deletePolicy\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"policyId\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"groupId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"member\",\"type\":\"address\"}],\"name\":\"getGroupMember\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"groupId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"member\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"internalType\":\"structGroupMember\",\"name\":\"groupMember\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"policyId\",\"type\":\"uint256\"}],\"name\":\"getPolicyById\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"int32\",\"name\":\"resourceType\",\"type\":\"int32\"},{\"internalType\":\"uint256\",\"name\":\"resourceId\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"},{\"internalType\":\"int32[]\",\"name\":\"actions\",\"type\":\"int32[]\"},{\"internalType\":\"string[]\",\"name\":\"resources\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"limitSize\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"values\",\"type\":\"string[]\"}],\"internalType\":\"structCondition[]\",\"name\":\"conditions\",\"type\":\"tuple[]\"}],\"internalType\":\"structStatement[]\",\"name\":\"statements\",\"type\":\"tuple[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"internalType\":\"structPolicy\",\"name\":\"policy\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"}],\"name\":\"listPoliciesForResource\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"int32\",\"name\":\"resourceType\",\"type\":\"int32\"},{\"internalType\":\"uint256\",\"name\":\"resourceId\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"},{\"internalType\":\"int32[]\",\"name\":\"actions\",\"type\":\"int32[]\"},{\"internalType\":\"string[]\",\"name\":\"resources\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"limitSize\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"values\",\"type\":\"string[]\"}],\"internalType\":\"structCondition[]\",\"name\":\"conditions\",\"type\":\"tuple[]\"}],\"internalType\":\"structStatement[]\",\"name\":\"statements\",\"type\":\"tuple[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"internalType\":\"structPolicy[]\",\"name\":\"policies\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"params\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"maximumStatementsNum\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maximumGroupNum\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"maximumRemoveExpiredPoliciesIteration\",\"type\":\"uint64\"}],\"internalType\":\"structParams\",\"name\":\"params\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"},{\"internalType\":\"int32[]\",\"name\":\"actions\",\"type\":\"int32[]\"},{\"internalType\":\"string[]\",\"name\":\"resources\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"limitSize\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"values\",\"type\":\"string[]\"}],\"internalType\":\"structCondition[]\",\"name\":\"conditions\",\"type\":\"tuple[]\"}],\"internalType\":\"structStatement[]\",\"name\":\"statements\",\"type\":\"tuple[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"name\":\"putPolicy\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"policyId\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"int32\",\"name\":\"actionType\",\"type\":\"int32\"}],\"name\":\"verifyPermission\",\"outputs\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
 }
 
 // IPermissionABI is the input ABI used to generate the binding from.
@@ -187,6 +227,99 @@ func (_IPermission *IPermissionTransactorRaw) Transact(opts *bind.TransactOpts,
 	return _IPermission.Contract.contract.Transact(opts, method, params...)
 }
 
+// GetGroupMember is a free data retrieval call binding the contract method 0xbd3d6573.
+//
+// Solidity: function getGroupMember(uint256 groupId, address member) view returns((uint256,uint256,address,int64) groupMember)
+func (_IPermission *IPermissionCaller) GetGroupMember(opts *bind.CallOpts, groupId *big.Int, member common.Address) (GroupMember, error) {
+	var out []interface{}
+	err := _IPermission.contract.Call(opts, &out, "getGroupMember", groupId, member)
+
+	if err != nil {
+		return *new(GroupMember), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(GroupMember)).(*GroupMember)
+
+	return out0, err
+
+}
+
+// GetGroupMember is a free data retrieval call binding the contract method 0xbd3d6573.
+//
+// Solidity: function getGroupMember(uint256 groupId, address member) view returns((uint256,uint256,address,int64) groupMember)
+func (_IPermission *IPermissionSession) GetGroupMember(groupId *big.Int, member common.Address) (GroupMember, error) {
+	return _IPermission.Contract.GetGroupMember(&_IPermission.CallOpts, groupId, member)
+}
+
+// GetGroupMember is a free data retrieval call binding the contract method 0xbd3d6573.
+//
+// Solidity: function getGroupMember(uint256 groupId, address member) view returns((uint256,uint256,address,int64) groupMember)
+func (_IPermission *IPermissionCallerSession) GetGroupMember(groupId *big.Int, member common.Address) (GroupMember, error) {
+	return _IPermission.Contract.GetGroupMember(&_IPermission.CallOpts, groupId, member)
+}
+
+// GetPolicyById is a free data retrieval call binding the contract method 0x090d8ad2.
+//
+// Solidity: function getPolicyById(uint256 policyId) view returns((uint256,(int32,string),int32,uint256,(int32,int32[],string[],int64,uint64)[],int64) policy)
+func (_IPermission *IPermissionCaller) GetPolicyById(opts *bind.CallOpts, policyId *big.Int) (Policy, error) {
+	var out []interface{}
+	err := _IPermission.contract.Call(opts, &out, "getPolicyById", policyId)
+
+	if err != nil {
+		return *new(Policy), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(Policy)).(*Policy)
+
+	return out0, err
+
+}
+
+// GetPolicyById is a free data retrieval call binding the contract method 0x090d8ad2.
+//
+// Solidity: function getPolicyById(uint256 policyId) view returns((uint256,(int32,string),int32,uint256,(int32,int32[],string[],int64,uint64)[],int64) policy)
+func (_IPermission *IPermissionSession) GetPolicyById(policyId *big.Int) (Policy, error) {
+	return _IPermission.Contract.GetPolicyById(&_IPermission.CallOpts, policyId)
+}
+
+// GetPolicyById is a free data retrieval call binding the contract method 0x090d8ad2.
+//
+// Solidity: function getPolicyById(uint256 policyId) view returns((uint256,(int32,string),int32,uint256,(int32,int32[],string[],int64,uint64)[],int64) policy)
+func (_IPermission *IPermissionCallerSession) GetPolicyById(policyId *big.Int) (Policy, error) {
+	return _IPermission.Contract.GetPolicyById(&_IPermission.CallOpts, policyId)
+}
+
+// ListPoliciesForResource is a free data retrieval call binding the contract method 0x64b28175.
+//
+// Solidity: function listPoliciesForResource(string resource) view returns((uint256,(int32,string),int32,uint256,(int32,int32[],string[],int64,uint64)[],int64)[] policies)
+func (_IPermission *IPermissionCaller) ListPoliciesForResource(opts *bind.CallOpts, resource string) ([]Policy, error) {
+	var out []interface{}
+	err := _IPermission.contract.Call(opts, &out, "listPoliciesForResource", resource)
+
+	if err != nil {
+		return *new([]Policy), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Policy)).(*[]Policy)
+
+	return out0, err
+
+}
+
+// ListPoliciesForResource is a free data retrieval call binding the contract method 0x64b28175.
+//
+// Solidity: function listPoliciesForResource(string resource) view returns((uint256,(int32,string),int32,uint256,(int32,int32[],string[],int64,uint64)[],int64)[] policies)
+func (_IPermission *IPermissionSession) ListPoliciesForResource(resource string) ([]Policy, error) {
+	return _IPermission.Contract.ListPoliciesForResource(&_IPermission.CallOpts, resource)
+}
+
+// ListPoliciesForResource is a free data retrieval call binding the contract method 0x64b28175.
+//
+// Solidity: function listPoliciesForResource(string resource) view returns((uint256,(int32,string),int32,uint256,(int32,int32[],string[],int64,uint64)[],int64)[] policies)
+func (_IPermission *IPermissionCallerSession) ListPoliciesForResource(resource string) ([]Policy, error) {
+	return _IPermission.Contract.ListPoliciesForResource(&_IPermission.CallOpts, resource)
+}
+
 // Params is a free data retrieval call binding the contract method 0xcff0ab96.
 //
 // Solidity: function params() view returns((uint64,uint64,uint64) params)
@@ -217,3 +350,366 @@ func (_IPermission *IPermissionSession) Params() (Params, error) {
 func (_IPermission *IPermissionCallerSession) Params() (Params, error) {
 	return _IPermission.Contract.Params(&_IPermission.CallOpts)
 }
+
+// VerifyPermission is a free data retrieval call binding the contract method 0x46374d01.
+//
+// Solidity: function verifyPermission(address operator, string bucketName, string objectName, int32 actionType) view returns(int32 effect)
+func (_IPermission *IPermissionCaller) VerifyPermission(opts *bind.CallOpts, operator common.Address, bucketName string, objectName string, actionType int32) (int32, error) {
+	var out []interface{}
+	err := _IPermission.contract.Call(opts, &out, "verifyPermission", operator, bucketName, objectName, actionType)
+
+	if err != nil {
+		return *new(int32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(int32)).(*int32)
+
+	return out0, err
+
+}
+
+// VerifyPermission is a free data retrieval call binding the contract method 0x46374d01.
+//
+// Solidity: function verifyPermission(address operator, string bucketName, string objectName, int32 actionType) view returns(int32 effect)
+func (_IPermission *IPermissionSession) VerifyPermission(operator common.Address, bucketName string, objectName string, actionType int32) (int32, error) {
+	return _IPermission.Contract.VerifyPermission(&_IPermission.CallOpts, operator, bucketName, objectName, actionType)
+}
+
+// VerifyPermission is a free data retrieval call binding the contract method 0x46374d01.
+//
+// Solidity: function verifyPermission(address operator, string bucketName, string objectName, int32 actionType) view returns(int32 effect)
+func (_IPermission *IPermissionCallerSession) VerifyPermission(operator common.Address, bucketName string, objectName string, actionType int32) (int32, error) {
+	return _IPermission.Contract.VerifyPermission(&_IPermission.CallOpts, operator, bucketName, objectName, actionType)
+}
+
+// DeletePolicy is a paid mutator transaction binding the contract method 0x7ecda60e.
+//
+// Solidity: function deletePolicy((int32,string) principal, string resource) returns(uint256 policyId)
+func (_IPermission *IPermissionTransactor) DeletePolicy(opts *bind.TransactOpts, principal Principal, resource string) (*types.Transaction, error) {
+	return _IPermission.contract.Transact(opts, "deletePolicy", principal, resource)
+}
+
+// DeletePolicy is a paid mutator transaction binding the contract method 0x7ecda60e.
+//
+// Solidity: function deletePolicy((int32,string) principal, string resource) returns(uint256 policyId)
+func (_IPermission *IPermissionSession) DeletePolicy(principal Principal, resource string) (*types.Transaction, error) {
+	return _IPermission.Contract.DeletePolicy(&_IPermission.TransactOpts, principal, resource)
+}
+
+// DeletePolicy is a paid mutator transaction binding the contract method 0x7ecda60e.
+//
+// Solidity: function deletePolicy((int32,string) principal, string resource) returns(uint256 policyId)
+func (_IPermission *IPermissionTransactorSession) DeletePolicy(principal Principal, resource string) (*types.Transaction, error) {
+	return _IPermission.Contract.DeletePolicy(&_IPermission.TransactOpts, principal, resource)
+}
+
+// PutPolicy is a paid mutator transaction binding the contract method 0x49052a67.
+//
+// Solidity: function putPolicy((int32,string) principal, string resource, (int32,int32[],string[],int64,uint64)[] statements, int64 expirationTime) returns(uint256 policyId)
+func (_IPermission *IPermissionTransactor) PutPolicy(opts *bind.TransactOpts, principal Principal, resource string, statements []Statement, expirationTime int64) (*types.Transaction, error) {
+	return _IPermission.contract.Transact(opts, "putPolicy", principal, resource, statements, expirationTime)
+}
+
+// PutPolicy is a paid mutator transaction binding the contract method 0x49052a67.
+//
+// Solidity: function putPolicy((int32,string) principal, string resource, (int32,int32[],string[],int64,uint64)[] statements, int64 expirationTime) returns(uint256 policyId)
+func (_IPermission *IPermissionSession) PutPolicy(principal Principal, resource string, statements []Statement, expirationTime int64) (*types.Transaction, error) {
+	return _IPermission.Contract.PutPolicy(&_IPermission.TransactOpts, principal, resource, statements, expirationTime)
+}
+
+// PutPolicy is a paid mutator transaction binding the contract method 0x49052a67.
+//
+// Solidity: function putPolicy((int32,string) principal, string resource, (int32,int32[],string[],int64,uint64)[] statements, int64 expirationTime) returns(uint256 policyId)
+func (_IPermission *IPermissionTransactorSession) PutPolicy(principal Principal, resource string, statements []Statement, expirationTime int64) (*types.Transaction, error) {
+	return _IPermission.Contract.PutPolicy(&_IPermission.TransactOpts, principal, resource, statements, expirationTime)
+}
+
+// IPermissionDeletePolicyIterator is returned from FilterDeletePolicy and is used to iterate over the raw logs and unpacked data for DeletePolicy events raised by the IPermission contract.
+type IPermissionDeletePolicyIterator struct {
+	Event *IPermissionDeletePolicy // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IPermissionDeletePolicyIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IPermissionDeletePolicy)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IPermissionDeletePolicy)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IPermissionDeletePolicyIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IPermissionDeletePolicyIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IPermissionDeletePolicy represents a DeletePolicy event raised by the IPermission contract.
+type IPermissionDeletePolicy struct {
+	Operator common.Address
+	PolicyId *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeletePolicy is a free log retrieval operation binding the contract event 0xbc2a04557f53bd555fd057b66109a18918ff7e229dc2892eb9cc85d5f4f67d5a.
+//
+// Solidity: event DeletePolicy(address indexed operator, uint256 policyId)
+func (_IPermission *IPermissionFilterer) FilterDeletePolicy(opts *bind.FilterOpts, operator []common.Address) (*IPermissionDeletePolicyIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IPermission.contract.FilterLogs(opts, "DeletePolicy", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IPermissionDeletePolicyIterator{contract: _IPermission.contract, event: "DeletePolicy", logs: logs, sub: sub}, nil
+}
+
+// WatchDeletePolicy is a free log subscription operation binding the contract event 0xbc2a04557f53bd555fd057b66109a18918ff7e229dc2892eb9cc85d5f4f67d5a.
+//
+// Solidity: event DeletePolicy(address indexed operator, uint256 policyId)
+func (_IPermission *IPermissionFilterer) WatchDeletePolicy(opts *bind.WatchOpts, sink chan<- *IPermissionDeletePolicy, operator []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IPermission.contract.WatchLogs(opts, "DeletePolicy", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IPermissionDeletePolicy)
+				if err := _IPermission.contract.UnpackLog(event, "DeletePolicy", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeletePolicy is a log parse operation binding the contract event 0xbc2a04557f53bd555fd057b66109a18918ff7e229dc2892eb9cc85d5f4f67d5a.
+//
+// Solidity: event DeletePolicy(address indexed operator, uint256 policyId)
+func (_IPermission *IPermissionFilterer) ParseDeletePolicy(log types.Log) (*IPermissionDeletePolicy, error) {
+	event := new(IPermissionDeletePolicy)
+	if err := _IPermission.contract.UnpackLog(event, "DeletePolicy", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IPermissionPutPolicyIterator is returned from FilterPutPolicy and is used to iterate over the raw logs and unpacked data for PutPolicy events raised by the IPermission contract.
+type IPermissionPutPolicyIterator struct {
+	Event *IPermissionPutPolicy // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IPermissionPutPolicyIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IPermissionPutPolicy)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IPermissionPutPolicy)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IPermissionPutPolicyIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IPermissionPutPolicyIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IPermissionPutPolicy represents a PutPolicy event raised by the IPermission contract.
+type IPermissionPutPolicy struct {
+	Operator common.Address
+	PolicyId *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterPutPolicy is a free log retrieval operation binding the contract event 0xb4c8f65bda1b51739f7eabada17ff89aad107a12fe2689c99c23416925741a2f.
+//
+// Solidity: event PutPolicy(address indexed operator, uint256 policyId)
+func (_IPermission *IPermissionFilterer) FilterPutPolicy(opts *bind.FilterOpts, operator []common.Address) (*IPermissionPutPolicyIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IPermission.contract.FilterLogs(opts, "PutPolicy", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IPermissionPutPolicyIterator{contract: _IPermission.contract, event: "PutPolicy", logs: logs, sub: sub}, nil
+}
+
+// WatchPutPolicy is a free log subscription operation binding the contract event 0xb4c8f65bda1b51739f7eabada17ff89aad107a12fe2689c99c23416925741a2f.
+//
+// Solidity: event PutPolicy(address indexed operator, uint256 policyId)
+func (_IPermission *IPermissionFilterer) WatchPutPolicy(opts *bind.WatchOpts, sink chan<- *IPermissionPutPolicy, operator []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IPermission.contract.WatchLogs(opts, "PutPolicy", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IPermissionPutPolicy)
+				if err := _IPermission.contract.UnpackLog(event, "PutPolicy", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePutPolicy is a log parse operation binding the contract event 0xb4c8f65bda1b51739f7eabada17ff89aad107a12fe2689c99c23416925741a2f.
+//
+// Solidity: event PutPolicy(address indexed operator, uint256 policyId)
+func (_IPermission *IPermissionFilterer) ParsePutPolicy(log types.Log) (*IPermissionPutPolicy, error) {
+	event := new(IPermissionPutPolicy)
+	if err := _IPermission.contract.UnpackLog(event, "PutPolicy", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
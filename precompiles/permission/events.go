@@ -0,0 +1,48 @@
+package permission
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/mocachain/moca/v2/precompiles/types"
+)
+
+const (
+	// PutPolicyEventName is the event emitted on a putPolicy transaction.
+	PutPolicyEventName = "PutPolicy"
+	// DeletePolicyEventName is the event emitted on a deletePolicy transaction.
+	DeletePolicyEventName = "DeletePolicy"
+)
+
+// EmitPutPolicyEvent emits the PutPolicy event with the caller as the indexed
+// topic and the policy id as data.
+func (p Precompile) EmitPutPolicyEvent(evm *vm.EVM, caller common.Address, policyID *big.Int) error {
+	return p.AddLog(evm, MustEvent(PutPolicyEventName),
+		[]common.Hash{common.BytesToHash(caller.Bytes())}, policyID)
+}
+
+// EmitDeletePolicyEvent emits the DeletePolicy event with the caller as the
+// indexed topic and the policy id as data.
+func (p Precompile) EmitDeletePolicyEvent(evm *vm.EVM, caller common.Address, policyID *big.Int) error {
+	return p.AddLog(evm, MustEvent(DeletePolicyEventName),
+		[]common.Hash{common.BytesToHash(caller.Bytes())}, policyID)
+}
+
+// AddLog packs the given event and appends it to the StateDB logs at the precompile address.
+func (p Precompile) AddLog(evm *vm.EVM, event abi.Event, topics []common.Hash, args ...interface{}) error {
+	data, packedTopics, err := types.PackTopicData(event, topics, args...)
+	if err != nil {
+		return err
+	}
+	evm.StateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      packedTopics,
+		Data:        data,
+		BlockNumber: evm.Context.BlockNumber.Uint64(),
+	})
+	return nil
+}
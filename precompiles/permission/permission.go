@@ -13,26 +13,37 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 
 	"github.com/mocachain/moca/v2/precompiles/types"
-	permissiontypes "github.com/mocachain/moca/v2/x/permission/types"
+	permissionkeeper "github.com/mocachain/moca/v2/x/permission/keeper"
+	storagekeeper "github.com/mocachain/moca/v2/x/storage/keeper"
+	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
 
 // Precompile is the permission precompile. It follows the cosmos/evm precompile layout —
 // Run -> RunNativeAction -> Execute -> cmn.SetupABI dispatch. The moca-specific surface
-// is the hex (0x) address encoding and the non-payable RejectValue guard. The module
-// exposes only the params query (its tx methods were removed) and emits no events.
+// is the hex (0x) address encoding and the non-payable RejectValue guard. The permission
+// module has no tx methods of its own, so the policy writes go through the storage msg
+// server, which checks that the caller owns the resource.
 type Precompile struct {
 	cmn.Precompile
 	abi.ABI
 
-	permissionQuerier permissiontypes.QueryServer
+	permissionKeeper permissionkeeper.Keeper
+	storageMsgServer storagetypes.MsgServer
+	storageKeeper    storagekeeper.Keeper
 }
 
 // NewPrecompile creates a new permission Precompile as a vm.PrecompiledContract. The
-// querier serves the params query; the bank keeper drives the StateDB balance
-// reconciliation.
-func NewPrecompile(permissionQuerier permissiontypes.QueryServer, bankKeeper bankkeeper.Keeper) *Precompile {
+// permission keeper serves the policy and group member queries, the storage keeper
+// resolves resources and verifies permissions, and the bank keeper drives the StateDB
+// balance reconciliation.
+func NewPrecompile(
+	permissionKeeper permissionkeeper.Keeper,
+	storageMsgServer storagetypes.MsgServer,
+	storageKeeper storagekeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+) *Precompile {
 	return &Precompile{
 		Precompile: cmn.Precompile{
 			KvGasConfig:          storetypes.KVGasConfig(),
@@ -41,8 +52,10 @@ func NewPrecompile(permissionQuerier permissiontypes.QueryServer, bankKeeper ban
 			// Reconciles bank keeper coin moves with the EVM StateDB balances.
 			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
 		},
-		ABI:               permissionABI,
-		permissionQuerier: permissionQuerier,
+		ABI:              permissionABI,
+		permissionKeeper: permissionKeeper,
+		storageMsgServer: storageMsgServer,
+		storageKeeper:    storageKeeper,
 	}
 }
 
@@ -82,7 +95,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]by
 }
 
 // Execute parses the calldata against the ABI and routes to the matching handler.
-func (p Precompile) Execute(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+func (p Precompile) Execute(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
 	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
@@ -90,9 +103,22 @@ func (p Precompile) Execute(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, r
 
 	var bz []byte
 	switch method.Name {
+	// Permission transactions
+	case PutPolicyMethodName:
+		bz, err = p.PutPolicy(ctx, evm, contract, method, args)
+	case DeletePolicyMethodName:
+		bz, err = p.DeletePolicy(ctx, evm, contract, method, args)
 	// Permission queries
 	case ParamsMethodName:
 		bz, err = p.Params(ctx, method, args)
+	case GetPolicyByIDMethodName:
+		bz, err = p.GetPolicyByID(ctx, method, args)
+	case ListPoliciesForResourceMethodName:
+		bz, err = p.ListPoliciesForResource(ctx, method, args)
+	case GetGroupMemberMethodName:
+		bz, err = p.GetGroupMember(ctx, method, args)
+	case VerifyPermissionMethodName:
+		bz, err = p.VerifyPermission(ctx, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -101,7 +127,12 @@ func (p Precompile) Execute(ctx sdk.Context, _ *vm.EVM, contract *vm.Contract, r
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
-// The permission module has no transaction methods, so this is always false.
-func (Precompile) IsTransaction(_ *abi.Method) bool {
-	return false
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case PutPolicyMethodName,
+		DeletePolicyMethodName:
+		return true
+	default:
+		return false
+	}
 }
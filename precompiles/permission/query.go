@@ -1,20 +1,32 @@
 package permission
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 
+	mocatypes "github.com/mocachain/moca/v2/types"
 	permissiontypes "github.com/mocachain/moca/v2/x/permission/types"
+	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
 )
 
 const (
 	// ParamsMethodName is the ABI name for the Params query.
 	ParamsMethodName = "params"
+	// GetPolicyByIDMethodName is the ABI name for the getPolicyById query.
+	GetPolicyByIDMethodName = "getPolicyById"
+	// ListPoliciesForResourceMethodName is the ABI name for the listPoliciesForResource query.
+	ListPoliciesForResourceMethodName = "listPoliciesForResource"
+	// GetGroupMemberMethodName is the ABI name for the getGroupMember query.
+	GetGroupMemberMethodName = "getGroupMember"
+	// VerifyPermissionMethodName is the ABI name for the verifyPermission query.
+	VerifyPermissionMethodName = "verifyPermission"
 )
 
 // Params queries the parameters of the x/permission module.
 func (p Precompile) Params(ctx sdk.Context, method *abi.Method, _ []interface{}) ([]byte, error) {
-	res, err := p.permissionQuerier.Params(ctx, &permissiontypes.QueryParamsRequest{})
+	res, err := p.permissionKeeper.Params(ctx, &permissiontypes.QueryParamsRequest{})
 	if err != nil {
 		return nil, err
 	}
@@ -25,3 +37,129 @@ func (p Precompile) Params(ctx sdk.Context, method *abi.Method, _ []interface{})
 		MaximumRemoveExpiredPoliciesIteration: res.Params.MaximumRemoveExpiredPoliciesIteration,
 	})
 }
+
+// GetPolicyByID queries a policy by policy id.
+func (p Precompile) GetPolicyByID(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input GetPolicyByIDArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	policy, found := p.permissionKeeper.GetPolicyByID(ctx, math.NewUintFromBigInt(input.PolicyID))
+	if !found {
+		return nil, storagetypes.ErrNoSuchPolicy.Wrapf("policy id: %s", input.PolicyID)
+	}
+
+	return method.Outputs.Pack(outputPolicy(policy))
+}
+
+// ListPoliciesForResource queries the policies of the accounts and the groups enforced on a resource.
+func (p Precompile) ListPoliciesForResource(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input ListPoliciesForResourceArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	var grn mocatypes.GRN
+	if err := grn.ParseFromString(input.Resource, false); err != nil {
+		return nil, err
+	}
+	resourceID, err := p.storageKeeper.GetResourceIDFromGRN(ctx, grn)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := p.permissionKeeper.GetPoliciesForResource(ctx, resourceID, grn.ResourceType())
+	outputs := make([]Policy, 0, len(policies))
+	for _, policy := range policies {
+		outputs = append(outputs, outputPolicy(policy))
+	}
+
+	return method.Outputs.Pack(outputs)
+}
+
+// GetGroupMember queries the member of a group.
+func (p Precompile) GetGroupMember(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input GetGroupMemberArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	groupMember, found := p.permissionKeeper.GetGroupMember(ctx, math.NewUintFromBigInt(input.GroupID), input.Member.Bytes())
+	if !found {
+		return nil, storagetypes.ErrNoSuchGroupMember.Wrapf("group id: %s, member: %s", input.GroupID, input.Member)
+	}
+
+	var expirationTime int64
+	if groupMember.ExpirationTime != nil {
+		expirationTime = groupMember.ExpirationTime.Unix()
+	}
+
+	return method.Outputs.Pack(GroupMember{
+		Id:             groupMember.Id.BigInt(),
+		GroupId:        groupMember.GroupId.BigInt(),
+		Member:         common.HexToAddress(groupMember.Member),
+		ExpirationTime: expirationTime,
+	})
+}
+
+// VerifyPermission queries the effect of the action of an operator on a bucket or an object.
+func (p Precompile) VerifyPermission(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input VerifyPermissionArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	res, err := p.storageKeeper.VerifyPermission(ctx, &storagetypes.QueryVerifyPermissionRequest{
+		Operator:   input.Operator.String(),
+		BucketName: input.BucketName,
+		ObjectName: input.ObjectName,
+		ActionType: permissiontypes.ActionType(input.ActionType),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(int32(res.Effect))
+}
+
+// outputPolicy maps a permission Policy into the ABI tuple. The unset expiration
+// times and limit sizes are zero.
+func outputPolicy(policy *permissiontypes.Policy) Policy {
+	statements := make([]Statement, 0, len(policy.Statements))
+	for _, statement := range policy.Statements {
+		actions := make([]int32, 0, len(statement.Actions))
+		for _, action := range statement.Actions {
+			actions = append(actions, int32(action))
+		}
+		var expirationTime int64
+		if statement.ExpirationTime != nil {
+			expirationTime = statement.ExpirationTime.Unix()
+		}
+		conditions := make([]Condition, 0, len(statement.Conditions))
+		for _, condition := range statement.Conditions {
+			conditions = append(conditions, Condition{Key: condition.Key, Values: condition.Values})
+		}
+		statements = append(statements, Statement{
+			Effect:         int32(statement.Effect),
+			Actions:        actions,
+			Resources:      statement.Resources,
+			ExpirationTime: expirationTime,
+			LimitSize:      statement.GetLimitSize().GetValue(),
+			Conditions:     conditions,
+		})
+	}
+
+	var expirationTime int64
+	if policy.ExpirationTime != nil {
+		expirationTime = policy.ExpirationTime.Unix()
+	}
+	return Policy{
+		Id:             policy.Id.BigInt(),
+		Principal:      Principal{PrincipalType: int32(policy.Principal.Type), Value: policy.Principal.Value},
+		ResourceType:   int32(policy.ResourceType),
+		ResourceId:     policy.ResourceId.BigInt(),
+		Statements:     statements,
+		ExpirationTime: expirationTime,
+	}
+}
@@ -0,0 +1,117 @@
+package permission
+
+import (
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	mocacommon "github.com/mocachain/moca/v2/types/common"
+	permissiontypes "github.com/mocachain/moca/v2/x/permission/types"
+	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
+)
+
+const (
+	// PutPolicyMethodName is the ABI name for the putPolicy transaction.
+	PutPolicyMethodName = "putPolicy"
+	// DeletePolicyMethodName is the ABI name for the deletePolicy transaction.
+	DeletePolicyMethodName = "deletePolicy"
+)
+
+// PutPolicy attaches an access-control policy to a resource the caller owns and
+// returns the policy id. Putting the policy of the same principal again updates
+// its statements and expiration time in place.
+func (p Precompile) PutPolicy(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input PutPolicyArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	statements := make([]*permissiontypes.Statement, 0, len(input.Statements))
+	for _, statement := range input.Statements {
+		actions := make([]permissiontypes.ActionType, 0, len(statement.Actions))
+		for _, action := range statement.Actions {
+			actions = append(actions, permissiontypes.ActionType(action))
+		}
+		s := &permissiontypes.Statement{
+			Effect:    permissiontypes.Effect(statement.Effect),
+			Actions:   actions,
+			Resources: statement.Resources,
+		}
+		if statement.ExpirationTime != 0 {
+			tm := time.Unix(statement.ExpirationTime, 0)
+			s.ExpirationTime = &tm
+		}
+		if statement.LimitSize != 0 {
+			s.LimitSize = &mocacommon.UInt64Value{Value: statement.LimitSize}
+		}
+		for _, condition := range statement.Conditions {
+			s.Conditions = append(s.Conditions, permissiontypes.Condition{Key: condition.Key, Values: condition.Values})
+		}
+		statements = append(statements, s)
+	}
+
+	var expirationTime *time.Time
+	if input.ExpirationTime != 0 {
+		tm := time.Unix(input.ExpirationTime, 0)
+		expirationTime = &tm
+	}
+
+	msg := &storagetypes.MsgPutPolicy{
+		Operator:       contract.Caller().String(),
+		Principal:      &permissiontypes.Principal{Type: permissiontypes.PrincipalType(input.Principal.PrincipalType), Value: input.Principal.Value},
+		Resource:       input.Resource,
+		Statements:     statements,
+		ExpirationTime: expirationTime,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.storageMsgServer.PutPolicy(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitPutPolicyEvent(evm, contract.Caller(), res.PolicyId.BigInt()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.PolicyId.BigInt())
+}
+
+// DeletePolicy deletes the access-control policy of a principal on a resource the
+// caller owns and returns the id of the deleted policy, zero if there was none.
+func (p Precompile) DeletePolicy(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input DeletePolicyArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	msg := &storagetypes.MsgDeletePolicy{
+		Operator:  contract.Caller().String(),
+		Principal: &permissiontypes.Principal{Type: permissiontypes.PrincipalType(input.Principal.PrincipalType), Value: input.Principal.Value},
+		Resource:  input.Resource,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.storageMsgServer.DeletePolicy(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	// the policy id is left unset when the principal has no policy on the resource
+	policyID := new(big.Int)
+	if !res.PolicyId.IsNil() {
+		policyID = res.PolicyId.BigInt()
+	}
+
+	if err := p.EmitDeletePolicyEvent(evm, contract.Caller(), policyID); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(policyID)
+}
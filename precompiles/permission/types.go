@@ -1,6 +1,10 @@
 package permission
 
 import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/mocachain/moca/v2/types"
@@ -15,3 +19,63 @@ var (
 func GetAddress() common.Address {
 	return permissionAddress
 }
+
+// GetEvent resolves an ABI event by name.
+func GetEvent(name string) (abi.Event, error) {
+	event := permissionABI.Events[name]
+	if event.ID == (common.Hash{}) {
+		return abi.Event{}, fmt.Errorf("event %s is not exist", name)
+	}
+	return event, nil
+}
+
+// MustEvent resolves an ABI event by name and panics if it does not exist.
+func MustEvent(name string) abi.Event {
+	event, err := GetEvent(name)
+	if err != nil {
+		panic(err)
+	}
+	return event
+}
+
+// The arg structs below are decode targets for cmn.SetupABI's positional args via
+// abi.Arguments.Copy; their fields carry the ABI names. The storage msg server
+// validates the policy writes, so these carry no extra validation.
+
+// PutPolicyArgs is the decode target for the putPolicy calldata.
+type PutPolicyArgs struct {
+	Principal      Principal   `abi:"principal"`
+	Resource       string      `abi:"resource"`
+	Statements     []Statement `abi:"statements"`
+	ExpirationTime int64       `abi:"expirationTime"`
+}
+
+// DeletePolicyArgs is the decode target for the deletePolicy calldata.
+type DeletePolicyArgs struct {
+	Principal Principal `abi:"principal"`
+	Resource  string    `abi:"resource"`
+}
+
+// GetPolicyByIDArgs is the decode target for the getPolicyById calldata.
+type GetPolicyByIDArgs struct {
+	PolicyID *big.Int `abi:"policyId"`
+}
+
+// ListPoliciesForResourceArgs is the decode target for the listPoliciesForResource calldata.
+type ListPoliciesForResourceArgs struct {
+	Resource string `abi:"resource"`
+}
+
+// GetGroupMemberArgs is the decode target for the getGroupMember calldata.
+type GetGroupMemberArgs struct {
+	GroupID *big.Int       `abi:"groupId"`
+	Member  common.Address `abi:"member"`
+}
+
+// VerifyPermissionArgs is the decode target for the verifyPermission calldata.
+type VerifyPermissionArgs struct {
+	Operator   common.Address `abi:"operator"`
+	BucketName string         `abi:"bucketName"`
+	ObjectName string         `abi:"objectName"`
+	ActionType int32          `abi:"actionType"`
+}
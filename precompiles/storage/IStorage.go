@@ -260,6 +260,13 @@ type Tag struct {
 	Value string
 }
 
+// TaggedResource is an auto generated low-level Go binding around an user-defined struct.
+type TaggedResource struct {
+	ResourceType uint8
+	ResourceId   *big.Int
+	Grn          string
+}
+
 // Trait is an auto generated low-level Go binding around an user-defined struct.
 type Trait struct {
 	TraitType string
@@ -276,7 +283,7 @@ type VersionedParams struct {
 
 // IStorageMetaData contains all meta data concerning the IStorage contract.
 var IStorageMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"CancelCreateObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"CancelMigrateBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"objectName\",\"type\":\"bytes32\"}],\"name\":\"CancelUpdateObjectContent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"CompleteMigrateBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"CopyObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"primarySpAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"CreateBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"CreateGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"CreateObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"DelegateCreateObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"DelegateUpdateObjectContent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"DeleteBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"DeleteGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"DeleteObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"DeletePolicy\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"DiscontinueBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"DiscontinueObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"}],\"name\":\"LeaveGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"MigrateBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"PutPolicy\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"RejectMigrateBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"RejectSealObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"RenewGroupMember\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"SealObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"SealObjectV2\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"SetBucketFlowRateLimit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"SetTag\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"ToggleSPAsDelegatedAgent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"visibility\",\"type\":\"uint8\"}],\"name\":\"UpdateBucketInfo\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"UpdateGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"UpdateGroupExtra\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"UpdateObjectContent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"UpdateObjectInfo\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"cancelCreateObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"cancelMigrateBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"cancelUpdateObjectContent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"components\":[{\"internalType\":\"uint32\",\"name\":\"srcGlobalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"dstGlobalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"secondarySpBlsSignature\",\"type\":\"bytes\"}],\"internalType\":\"structGVGMapping[]\",\"name\":\"gvgMappings\",\"type\":\"tuple[]\"}],\"name\":\"completeMigrateBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"srcBucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"dstBucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"srcObjectName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"dstObjectName\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"expiredHeight\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"sig\",\"type\":\"bytes\"}],\"internalType\":\"structApproval\",\"name\":\"dstPrimarySpApproval\",\"type\":\"tuple\"}],\"name\":\"copyObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primarySpAddress\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"expiredHeight\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"sig\",\"type\":\"bytes\"}],\"internalType\":\"structApproval\",\"name\":\"primarySpApproval\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"chargedReadQuota\",\"type\":\"uint64\"}],\"name\":\"createBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"extra\",\"type\":\"string\"}],\"name\":\"createGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"expiredHeight\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"sig\",\"type\":\"bytes\"}],\"internalType\":\"structApproval\",\"name\":\"primarySpApproval\",\"type\":\"tuple\"},{\"internalType\":\"string[]\",\"name\":\"expectChecksums\",\"type\":\"string[]\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"}],\"name\":\"createObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"creator\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string[]\",\"name\":\"expectChecksums\",\"type\":\"string[]\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"}],\"name\":\"delegateCreateObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"updater\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"expectChecksums\",\"type\":\"string[]\"}],\"name\":\"delegateUpdateObjectContent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"deleteBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"}],\"name\":\"deleteGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"deleteObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"}],\"name\":\"deletePolicy\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"name\":\"discontinueBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"uint256[]\",\"name\":\"objectIds\",\"type\":\"uint256[]\"},{\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"name\":\"discontinueObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"headBucket\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"chargedReadQuota\",\"type\":\"uint64\"},{\"internalType\":\"enumBucketStatus\",\"name\":\"bucketStatus\",\"type\":\"uint8\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"spAsDelegatedAgentDisabled\",\"type\":\"bool\"}],\"internalType\":\"structBucketInfo\",\"name\":\"bucketInfo\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"bool\",\"name\":\"isRateLimited\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"flowRateLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentFlowRate\",\"type\":\"uint256\"}],\"internalType\":\"structBucketExtraInfo\",\"name\":\"bucketExtraInfo\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketId\",\"type\":\"string\"}],\"name\":\"headBucketById\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"chargedReadQuota\",\"type\":\"uint64\"},{\"internalType\":\"enumBucketStatus\",\"name\":\"bucketStatus\",\"type\":\"uint8\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"spAsDelegatedAgentDisabled\",\"type\":\"bool\"}],\"internalType\":\"structBucketInfo\",\"name\":\"bucketInfo\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"bool\",\"name\":\"isRateLimited\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"flowRateLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentFlowRate\",\"type\":\"uint256\"}],\"internalType\":\"structBucketExtraInfo\",\"name\":\"bucketExtraInfo\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"headBucketExtra\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"priceTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"totalChargeSize\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"storedSize\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"totalChargeSize\",\"type\":\"uint64\"}],\"internalType\":\"structLocalVirtualGroup[]\",\"name\":\"localVirtualGroups\",\"type\":\"tuple[]\"},{\"internalType\":\"uint32\",\"name\":\"nextLocalVirtualGroupId\",\"type\":\"uint32\"}],\"internalType\":\"structInternalBucketInfo\",\"name\":\"extraInfo\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"tokenId\",\"type\":\"string\"}],\"name\":\"headBucketNFT\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"externalUrl\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"image\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"traitType\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTrait[]\",\"name\":\"attributes\",\"type\":\"tuple[]\"}],\"internalType\":\"structBucketMetaData\",\"name\":\"bucketMetaData\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"}],\"name\":\"headGroup\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"extra\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"}],\"internalType\":\"structGroupInfo\",\"name\":\"groupInfo\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"member\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"}],\"name\":\"headGroupMember\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"groupId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"member\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"internalType\":\"structGroupMember\",\"name\":\"groupMember\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"tokenId\",\"type\":\"string\"}],\"name\":\"headGroupNFT\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"externalUrl\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"image\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"traitType\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTrait[]\",\"name\":\"attributes\",\"type\":\"tuple[]\"}],\"internalType\":\"structGroupMetaData\",\"name\":\"groupMetaData\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"headObject\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"localVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"enumObjectStatus\",\"name\":\"objectStatus\",\"type\":\"uint8\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"string[]\",\"name\":\"checksums\",\"type\":\"string[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"isUpdating\",\"type\":\"bool\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"updatedBy\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"version\",\"type\":\"int64\"}],\"internalType\":\"structObjectInfo\",\"name\":\"objectInfo\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"familyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"primarySpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"secondarySpIds\",\"type\":\"uint32[]\"},{\"internalType\":\"uint64\",\"name\":\"storedSize\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"virtualPaymentAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"totalDeposit\",\"type\":\"string\"}],\"internalType\":\"structGlobalVirtualGroup\",\"name\":\"globalVirtualGroup\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"objectId\",\"type\":\"string\"}],\"name\":\"headObjectById\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"localVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"enumObjectStatus\",\"name\":\"objectStatus\",\"type\":\"uint8\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"string[]\",\"name\":\"checksums\",\"type\":\"string[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"isUpdating\",\"type\":\"bool\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"updatedBy\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"version\",\"type\":\"int64\"}],\"internalType\":\"structObjectInfo\",\"name\":\"objectInfo\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"familyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"primarySpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"secondarySpIds\",\"type\":\"uint32[]\"},{\"internalType\":\"uint64\",\"name\":\"storedSize\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"virtualPaymentAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"totalDeposit\",\"type\":\"string\"}],\"internalType\":\"structGlobalVirtualGroup\",\"name\":\"globalVirtualGroup\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"tokenId\",\"type\":\"string\"}],\"name\":\"headObjectNFT\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"externalUrl\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"image\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"traitType\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTrait[]\",\"name\":\"attributes\",\"type\":\"tuple[]\"}],\"internalType\":\"structObjectMetaData\",\"name\":\"objectMetaData\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"headShadowObject\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"operator\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string[]\",\"name\":\"checksums\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"version\",\"type\":\"int64\"}],\"internalType\":\"structShadowObjectInfo\",\"name\":\"objectInfo\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"}],\"name\":\"leaveGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"listBuckets\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"chargedReadQuota\",\"type\":\"uint64\"},{\"internalType\":\"enumBucketStatus\",\"name\":\"bucketStatus\",\"type\":\"uint8\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"spAsDelegatedAgentDisabled\",\"type\":\"bool\"}],\"internalType\":\"structBucketInfo[]\",\"name\":\"bucketInfos\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"},{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"}],\"name\":\"listGroups\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"extra\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"}],\"internalType\":\"structGroupInfo[]\",\"name\":\"groupInfos\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"listObjects\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"localVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"enumObjectStatus\",\"name\":\"objectStatus\",\"type\":\"uint8\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"string[]\",\"name\":\"checksums\",\"type\":\"string[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"isUpdating\",\"type\":\"bool\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"updatedBy\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"version\",\"type\":\"int64\"}],\"internalType\":\"structObjectInfo[]\",\"name\":\"objectInfos\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"bucketId\",\"type\":\"string\"}],\"name\":\"listObjectsByBucketId\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"localVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"enumObjectStatus\",\"name\":\"objectStatus\",\"type\":\"uint8\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"string[]\",\"name\":\"checksums\",\"type\":\"string[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"isUpdating\",\"type\":\"bool\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"updatedBy\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"version\",\"type\":\"int64\"}],\"internalType\":\"structObjectInfo[]\",\"name\":\"objectInfos\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"dstPrimarySpId\",\"type\":\"uint32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"expiredHeight\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"sig\",\"type\":\"bytes\"}],\"internalType\":\"structApproval\",\"name\":\"dstPrimarySpApproval\",\"type\":\"tuple\"}],\"name\":\"migrateBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"params\",\"outputs\":[{\"components\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"maxSegmentSize\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"redundantDataChunkNum\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"redundantParityChunkNum\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"minChargeSize\",\"type\":\"uint64\"}],\"internalType\":\"structVersionedParams\",\"name\":\"versionedParams\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"maxPayloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"bscMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorBucketAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorObjectRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorObjectAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorGroupRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorGroupAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"maxBucketsPerAccount\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"discontinueCountingWindow\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueObjectMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueBucketMax\",\"type\":\"uint64\"},{\"internalType\":\"int64\",\"name\":\"discontinueConfirmPeriod\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueDeletionMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"stalePolicyCleanupMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"minQuotaUpdateInterval\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"maxLocalVirtualGroupNumPerBucket\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"opMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorBucketAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorObjectRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorObjectAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorGroupRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorGroupAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"polygonMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"polygonMirrorBucketAckRelayerFee\",\"type\":\"string\"}],\"internalType\":\"structParams\",\"name\":\"params\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"},{\"internalType\":\"int32[]\",\"name\":\"actions\",\"type\":\"int32[]\"},{\"internalType\":\"string[]\",\"name\":\"resources\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"limitSize\",\"type\":\"uint64\"}],\"internalType\":\"structStatement[]\",\"name\":\"statements\",\"type\":\"tuple[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"name\":\"putPolicy\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"groupId\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"members\",\"type\":\"string[]\"}],\"name\":\"queryGroupMembersExist\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"checkMembers\",\"type\":\"string[]\"},{\"internalType\":\"bool[]\",\"name\":\"exists\",\"type\":\"bool[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"groupOwner\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"groupNames\",\"type\":\"string[]\"}],\"name\":\"queryGroupsExist\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"checkGroupNames\",\"type\":\"string[]\"},{\"internalType\":\"bool[]\",\"name\":\"exists\",\"type\":\"bool[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"groupIds\",\"type\":\"string[]\"}],\"name\":\"queryGroupsExistById\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"checkGroupIds\",\"type\":\"string[]\"},{\"internalType\":\"bool[]\",\"name\":\"exists\",\"type\":\"bool[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"queryIsPriceChanged\",\"outputs\":[{\"components\":[{\"internalType\":\"bool\",\"name\":\"changed\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"currentReadPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentPrimaryStorePrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentSecondaryStorePrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentValidatorTaxRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"newReadPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"newPrimaryStorePrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"newSecondaryStorePrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"newValidatorTaxRate\",\"type\":\"uint256\"}],\"internalType\":\"structIsPriceChanged\",\"name\":\"isPriceChanged\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"primarySpAddress\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"}],\"name\":\"queryLockFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int64\",\"name\":\"timestamp\",\"type\":\"int64\"}],\"name\":\"queryParamsByTimestamp\",\"outputs\":[{\"components\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"maxSegmentSize\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"redundantDataChunkNum\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"redundantParityChunkNum\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"minChargeSize\",\"type\":\"uint64\"}],\"internalType\":\"structVersionedParams\",\"name\":\"versionedParams\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"maxPayloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"bscMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorBucketAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorObjectRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorObjectAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorGroupRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorGroupAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"maxBucketsPerAccount\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"discontinueCountingWindow\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueObjectMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueBucketMax\",\"type\":\"uint64\"},{\"internalType\":\"int64\",\"name\":\"discontinueConfirmPeriod\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueDeletionMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"stalePolicyCleanupMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"minQuotaUpdateInterval\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"maxLocalVirtualGroupNumPerBucket\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"opMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorBucketAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorObjectRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorObjectAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorGroupRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorGroupAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"polygonMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"polygonMirrorBucketAckRelayerFee\",\"type\":\"string\"}],\"internalType\":\"structParams\",\"name\":\"params\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"paymentAccount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketOwner\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"queryPaymentAccountBucketFlowRateLimit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"isSet\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"flowRateLimit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"policyId\",\"type\":\"string\"}],\"name\":\"queryPolicyById\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"int32\",\"name\":\"resourceType\",\"type\":\"int32\"},{\"internalType\":\"uint256\",\"name\":\"resourceId\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"},{\"internalType\":\"int32[]\",\"name\":\"actions\",\"type\":\"int32[]\"},{\"internalType\":\"string[]\",\"name\":\"resources\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"limitSize\",\"type\":\"uint64\"}],\"internalType\":\"structStatement[]\",\"name\":\"statements\",\"type\":\"tuple[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"internalType\":\"structPolicy\",\"name\":\"policy\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"principalAddr\",\"type\":\"string\"}],\"name\":\"queryPolicyForAccount\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"int32\",\"name\":\"resourceType\",\"type\":\"int32\"},{\"internalType\":\"uint256\",\"name\":\"resourceId\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"},{\"internalType\":\"int32[]\",\"name\":\"actions\",\"type\":\"int32[]\"},{\"internalType\":\"string[]\",\"name\":\"resources\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"limitSize\",\"type\":\"uint64\"}],\"internalType\":\"structStatement[]\",\"name\":\"statements\",\"type\":\"tuple[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"internalType\":\"structPolicy\",\"name\":\"policy\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"groupId\",\"type\":\"uint256\"}],\"name\":\"queryPolicyForGroup\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"int32\",\"name\":\"resourceType\",\"type\":\"int32\"},{\"internalType\":\"uint256\",\"name\":\"resourceId\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"},{\"internalType\":\"int32[]\",\"name\":\"actions\",\"type\":\"int32[]\"},{\"internalType\":\"string[]\",\"name\":\"resources\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"limitSize\",\"type\":\"uint64\"}],\"internalType\":\"structStatement[]\",\"name\":\"statements\",\"type\":\"tuple[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"internalType\":\"structPolicy\",\"name\":\"policy\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"queryQuotaUpdateTime\",\"outputs\":[{\"internalType\":\"int64\",\"name\":\"updateAt\",\"type\":\"int64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"rejectMigrateBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"rejectSealObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"address[]\",\"name\":\"members\",\"type\":\"address[]\"},{\"internalType\":\"int64[]\",\"name\":\"expirationTime\",\"type\":\"int64[]\"}],\"name\":\"renewGroupMember\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"secondarySpBlsAggSignatures\",\"type\":\"string\"}],\"name\":\"sealObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"secondarySpBlsAggSignatures\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"expectChecksums\",\"type\":\"string[]\"}],\"name\":\"sealObjectV2\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketOwner\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"paymentAddress\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"flowRateLimit\",\"type\":\"uint256\"}],\"name\":\"setBucketFlowRateLimit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"}],\"name\":\"setTag\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"toggleSPAsDelegatedAgent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"internalType\":\"int128\",\"name\":\"chargedReadQuota\",\"type\":\"int128\"}],\"name\":\"updateBucketInfo\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"address[]\",\"name\":\"membersToAdd\",\"type\":\"address[]\"},{\"internalType\":\"int64[]\",\"name\":\"expirationTime\",\"type\":\"int64[]\"},{\"internalType\":\"address[]\",\"name\":\"membersToDelete\",\"type\":\"address[]\"}],\"name\":\"updateGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"extra\",\"type\":\"string\"}],\"name\":\"updateGroupExtra\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"expectChecksums\",\"type\":\"string[]\"}],\"name\":\"updateObjectContent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"}],\"name\":\"updateObjectInfo\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"int32\",\"name\":\"actionType\",\"type\":\"int32\"}],\"name\":\"verifyPermission\",\"outputs\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"AcceptBucketOwnership\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"CancelCreateObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"CancelMigrateBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"objectName\",\"type\":\"bytes32\"}],\"name\":\"CancelUpdateObjectContent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"CompleteMigrateBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"CopyObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"primarySpAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"CreateBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"CreateGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"CreateObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"DelegateCreateObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"DelegateUpdateObjectContent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"DeleteBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"DeleteGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"DeleteObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"DeletePolicy\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"DiscontinueBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"DiscontinueObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"}],\"name\":\"LeaveGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"MigrateBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"PutPolicy\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"RejectMigrateBucket\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"RejectSealObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"RenewGroupMember\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"SealObject\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"SealObjectV2\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"SetBucketCallback\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"SetBucketFlowRateLimit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"SetTag\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"ToggleSPAsDelegatedAgent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"TransferBucketOwnership\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"visibility\",\"type\":\"uint8\"}],\"name\":\"UpdateBucketInfo\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"UpdateGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"UpdateGroupExtra\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"UpdateObjectContent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"UpdateObjectInfo\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"}],\"name\":\"acceptBucketOwnership\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"cancelCreateObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"cancelMigrateBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"cancelUpdateObjectContent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"components\":[{\"internalType\":\"uint32\",\"name\":\"srcGlobalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"dstGlobalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"secondarySpBlsSignature\",\"type\":\"bytes\"}],\"internalType\":\"structGVGMapping[]\",\"name\":\"gvgMappings\",\"type\":\"tuple[]\"}],\"name\":\"completeMigrateBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"srcBucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"dstBucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"srcObjectName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"dstObjectName\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"expiredHeight\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"sig\",\"type\":\"bytes\"}],\"internalType\":\"structApproval\",\"name\":\"dstPrimarySpApproval\",\"type\":\"tuple\"}],\"name\":\"copyObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primarySpAddress\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"expiredHeight\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"sig\",\"type\":\"bytes\"}],\"internalType\":\"structApproval\",\"name\":\"primarySpApproval\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"chargedReadQuota\",\"type\":\"uint64\"}],\"name\":\"createBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"extra\",\"type\":\"string\"}],\"name\":\"createGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"expiredHeight\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"sig\",\"type\":\"bytes\"}],\"internalType\":\"structApproval\",\"name\":\"primarySpApproval\",\"type\":\"tuple\"},{\"internalType\":\"string[]\",\"name\":\"expectChecksums\",\"type\":\"string[]\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"}],\"name\":\"createObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"creator\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string[]\",\"name\":\"expectChecksums\",\"type\":\"string[]\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"}],\"name\":\"delegateCreateObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"updater\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"expectChecksums\",\"type\":\"string[]\"}],\"name\":\"delegateUpdateObjectContent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"deleteBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"}],\"name\":\"deleteGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"deleteObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"}],\"name\":\"deletePolicy\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"name\":\"discontinueBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"uint256[]\",\"name\":\"objectIds\",\"type\":\"uint256[]\"},{\"internalType\":\"string\",\"name\":\"reason\",\"type\":\"string\"}],\"name\":\"discontinueObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"headBucket\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"chargedReadQuota\",\"type\":\"uint64\"},{\"internalType\":\"enumBucketStatus\",\"name\":\"bucketStatus\",\"type\":\"uint8\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"spAsDelegatedAgentDisabled\",\"type\":\"bool\"}],\"internalType\":\"structBucketInfo\",\"name\":\"bucketInfo\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"bool\",\"name\":\"isRateLimited\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"flowRateLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentFlowRate\",\"type\":\"uint256\"}],\"internalType\":\"structBucketExtraInfo\",\"name\":\"bucketExtraInfo\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketId\",\"type\":\"string\"}],\"name\":\"headBucketById\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"chargedReadQuota\",\"type\":\"uint64\"},{\"internalType\":\"enumBucketStatus\",\"name\":\"bucketStatus\",\"type\":\"uint8\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"spAsDelegatedAgentDisabled\",\"type\":\"bool\"}],\"internalType\":\"structBucketInfo\",\"name\":\"bucketInfo\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"bool\",\"name\":\"isRateLimited\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"flowRateLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentFlowRate\",\"type\":\"uint256\"}],\"internalType\":\"structBucketExtraInfo\",\"name\":\"bucketExtraInfo\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"headBucketExtra\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"priceTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"totalChargeSize\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"storedSize\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"totalChargeSize\",\"type\":\"uint64\"}],\"internalType\":\"structLocalVirtualGroup[]\",\"name\":\"localVirtualGroups\",\"type\":\"tuple[]\"},{\"internalType\":\"uint32\",\"name\":\"nextLocalVirtualGroupId\",\"type\":\"uint32\"}],\"internalType\":\"structInternalBucketInfo\",\"name\":\"extraInfo\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"tokenId\",\"type\":\"string\"}],\"name\":\"headBucketNFT\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"externalUrl\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"image\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"traitType\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTrait[]\",\"name\":\"attributes\",\"type\":\"tuple[]\"}],\"internalType\":\"structBucketMetaData\",\"name\":\"bucketMetaData\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"}],\"name\":\"headGroup\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"extra\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"}],\"internalType\":\"structGroupInfo\",\"name\":\"groupInfo\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"member\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"}],\"name\":\"headGroupMember\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"groupId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"member\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"internalType\":\"structGroupMember\",\"name\":\"groupMember\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"tokenId\",\"type\":\"string\"}],\"name\":\"headGroupNFT\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"externalUrl\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"image\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"traitType\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTrait[]\",\"name\":\"attributes\",\"type\":\"tuple[]\"}],\"internalType\":\"structGroupMetaData\",\"name\":\"groupMetaData\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"headObject\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"localVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"enumObjectStatus\",\"name\":\"objectStatus\",\"type\":\"uint8\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"string[]\",\"name\":\"checksums\",\"type\":\"string[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"isUpdating\",\"type\":\"bool\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"updatedBy\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"version\",\"type\":\"int64\"}],\"internalType\":\"structObjectInfo\",\"name\":\"objectInfo\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"familyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"primarySpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"secondarySpIds\",\"type\":\"uint32[]\"},{\"internalType\":\"uint64\",\"name\":\"storedSize\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"virtualPaymentAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"totalDeposit\",\"type\":\"string\"}],\"internalType\":\"structGlobalVirtualGroup\",\"name\":\"globalVirtualGroup\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"objectId\",\"type\":\"string\"}],\"name\":\"headObjectById\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"localVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"enumObjectStatus\",\"name\":\"objectStatus\",\"type\":\"uint8\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"string[]\",\"name\":\"checksums\",\"type\":\"string[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"isUpdating\",\"type\":\"bool\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"updatedBy\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"version\",\"type\":\"int64\"}],\"internalType\":\"structObjectInfo\",\"name\":\"objectInfo\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"familyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"primarySpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"secondarySpIds\",\"type\":\"uint32[]\"},{\"internalType\":\"uint64\",\"name\":\"storedSize\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"virtualPaymentAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"totalDeposit\",\"type\":\"string\"}],\"internalType\":\"structGlobalVirtualGroup\",\"name\":\"globalVirtualGroup\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"tokenId\",\"type\":\"string\"}],\"name\":\"headObjectNFT\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"externalUrl\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"image\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"traitType\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTrait[]\",\"name\":\"attributes\",\"type\":\"tuple[]\"}],\"internalType\":\"structObjectMetaData\",\"name\":\"objectMetaData\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"headShadowObject\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"operator\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string[]\",\"name\":\"checksums\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"version\",\"type\":\"int64\"}],\"internalType\":\"structShadowObjectInfo\",\"name\":\"objectInfo\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"}],\"name\":\"leaveGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"listBuckets\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"chargedReadQuota\",\"type\":\"uint64\"},{\"internalType\":\"enumBucketStatus\",\"name\":\"bucketStatus\",\"type\":\"uint8\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"spAsDelegatedAgentDisabled\",\"type\":\"bool\"}],\"internalType\":\"structBucketInfo[]\",\"name\":\"bucketInfos\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"},{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"}],\"name\":\"listGroups\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"extra\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"}],\"internalType\":\"structGroupInfo[]\",\"name\":\"groupInfos\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"listObjects\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"localVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"enumObjectStatus\",\"name\":\"objectStatus\",\"type\":\"uint8\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"string[]\",\"name\":\"checksums\",\"type\":\"string[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"isUpdating\",\"type\":\"bool\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"updatedBy\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"version\",\"type\":\"int64\"}],\"internalType\":\"structObjectInfo[]\",\"name\":\"objectInfos\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"bucketId\",\"type\":\"string\"}],\"name\":\"listObjectsByBucketId\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"localVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"enumObjectStatus\",\"name\":\"objectStatus\",\"type\":\"uint8\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"string[]\",\"name\":\"checksums\",\"type\":\"string[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"isUpdating\",\"type\":\"bool\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"updatedBy\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"version\",\"type\":\"int64\"}],\"internalType\":\"structObjectInfo[]\",\"name\":\"objectInfos\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"prefix\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"delimiter\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"startAfter\",\"type\":\"string\"}],\"name\":\"listObjectsV2\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"localVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"enumObjectStatus\",\"name\":\"objectStatus\",\"type\":\"uint8\"},{\"internalType\":\"enumRedundancyType\",\"name\":\"redundancyType\",\"type\":\"uint8\"},{\"internalType\":\"enumSourceType\",\"name\":\"sourceType\",\"type\":\"uint8\"},{\"internalType\":\"string[]\",\"name\":\"checksums\",\"type\":\"string[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"},{\"internalType\":\"bool\",\"name\":\"isUpdating\",\"type\":\"bool\"},{\"internalType\":\"int64\",\"name\":\"updatedAt\",\"type\":\"int64\"},{\"internalType\":\"address\",\"name\":\"updatedBy\",\"type\":\"address\"},{\"internalType\":\"int64\",\"name\":\"version\",\"type\":\"int64\"}],\"internalType\":\"structObjectInfo[]\",\"name\":\"objectInfos\",\"type\":\"tuple[]\"},{\"internalType\":\"string[]\",\"name\":\"commonPrefixes\",\"type\":\"string[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"tagKey\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"tagValue\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"resourceType\",\"type\":\"uint8\"}],\"name\":\"listResourcesByTag\",\"outputs\":[{\"components\":[{\"internalType\":\"uint8\",\"name\":\"resourceType\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"resourceId\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"grn\",\"type\":\"string\"}],\"internalType\":\"structTaggedResource[]\",\"name\":\"resources\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"dstPrimarySpId\",\"type\":\"uint32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"expiredHeight\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"sig\",\"type\":\"bytes\"}],\"internalType\":\"structApproval\",\"name\":\"dstPrimarySpApproval\",\"type\":\"tuple\"}],\"name\":\"migrateBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"params\",\"outputs\":[{\"components\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"maxSegmentSize\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"redundantDataChunkNum\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"redundantParityChunkNum\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"minChargeSize\",\"type\":\"uint64\"}],\"internalType\":\"structVersionedParams\",\"name\":\"versionedParams\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"maxPayloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"bscMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorBucketAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorObjectRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorObjectAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorGroupRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorGroupAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"maxBucketsPerAccount\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"discontinueCountingWindow\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueObjectMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueBucketMax\",\"type\":\"uint64\"},{\"internalType\":\"int64\",\"name\":\"discontinueConfirmPeriod\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueDeletionMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"stalePolicyCleanupMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"minQuotaUpdateInterval\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"maxLocalVirtualGroupNumPerBucket\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"opMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorBucketAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorObjectRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorObjectAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorGroupRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorGroupAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"polygonMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"polygonMirrorBucketAckRelayerFee\",\"type\":\"string\"}],\"internalType\":\"structParams\",\"name\":\"params\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"},{\"internalType\":\"int32[]\",\"name\":\"actions\",\"type\":\"int32[]\"},{\"internalType\":\"string[]\",\"name\":\"resources\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"limitSize\",\"type\":\"uint64\"}],\"internalType\":\"structStatement[]\",\"name\":\"statements\",\"type\":\"tuple[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"name\":\"putPolicy\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"queryBucketCallback\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"callbackAddress\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"gasLimit\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"groupId\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"members\",\"type\":\"string[]\"}],\"name\":\"queryGroupMembersExist\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"checkMembers\",\"type\":\"string[]\"},{\"internalType\":\"bool[]\",\"name\":\"exists\",\"type\":\"bool[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"groupOwner\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"groupNames\",\"type\":\"string[]\"}],\"name\":\"queryGroupsExist\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"checkGroupNames\",\"type\":\"string[]\"},{\"internalType\":\"bool[]\",\"name\":\"exists\",\"type\":\"bool[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"groupIds\",\"type\":\"string[]\"}],\"name\":\"queryGroupsExistById\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"checkGroupIds\",\"type\":\"string[]\"},{\"internalType\":\"bool[]\",\"name\":\"exists\",\"type\":\"bool[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"queryIsPriceChanged\",\"outputs\":[{\"components\":[{\"internalType\":\"bool\",\"name\":\"changed\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"currentReadPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentPrimaryStorePrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentSecondaryStorePrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"currentValidatorTaxRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"newReadPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"newPrimaryStorePrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"newSecondaryStorePrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"newValidatorTaxRate\",\"type\":\"uint256\"}],\"internalType\":\"structIsPriceChanged\",\"name\":\"isPriceChanged\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"primarySpAddress\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"createAt\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"}],\"name\":\"queryLockFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int64\",\"name\":\"timestamp\",\"type\":\"int64\"}],\"name\":\"queryParamsByTimestamp\",\"outputs\":[{\"components\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"maxSegmentSize\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"redundantDataChunkNum\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"redundantParityChunkNum\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"minChargeSize\",\"type\":\"uint64\"}],\"internalType\":\"structVersionedParams\",\"name\":\"versionedParams\",\"type\":\"tuple\"},{\"internalType\":\"uint64\",\"name\":\"maxPayloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"bscMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorBucketAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorObjectRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorObjectAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorGroupRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bscMirrorGroupAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"maxBucketsPerAccount\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"discontinueCountingWindow\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueObjectMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueBucketMax\",\"type\":\"uint64\"},{\"internalType\":\"int64\",\"name\":\"discontinueConfirmPeriod\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"discontinueDeletionMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"stalePolicyCleanupMax\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"minQuotaUpdateInterval\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"maxLocalVirtualGroupNumPerBucket\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"opMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorBucketAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorObjectRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorObjectAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorGroupRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"opMirrorGroupAckRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"polygonMirrorBucketRelayerFee\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"polygonMirrorBucketAckRelayerFee\",\"type\":\"string\"}],\"internalType\":\"structParams\",\"name\":\"params\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"paymentAccount\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketOwner\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"queryPaymentAccountBucketFlowRateLimit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"isSet\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"flowRateLimit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"policyId\",\"type\":\"string\"}],\"name\":\"queryPolicyById\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"int32\",\"name\":\"resourceType\",\"type\":\"int32\"},{\"internalType\":\"uint256\",\"name\":\"resourceId\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"},{\"internalType\":\"int32[]\",\"name\":\"actions\",\"type\":\"int32[]\"},{\"internalType\":\"string[]\",\"name\":\"resources\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"limitSize\",\"type\":\"uint64\"}],\"internalType\":\"structStatement[]\",\"name\":\"statements\",\"type\":\"tuple[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"internalType\":\"structPolicy\",\"name\":\"policy\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"principalAddr\",\"type\":\"string\"}],\"name\":\"queryPolicyForAccount\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"int32\",\"name\":\"resourceType\",\"type\":\"int32\"},{\"internalType\":\"uint256\",\"name\":\"resourceId\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"},{\"internalType\":\"int32[]\",\"name\":\"actions\",\"type\":\"int32[]\"},{\"internalType\":\"string[]\",\"name\":\"resources\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"limitSize\",\"type\":\"uint64\"}],\"internalType\":\"structStatement[]\",\"name\":\"statements\",\"type\":\"tuple[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"internalType\":\"structPolicy\",\"name\":\"policy\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"groupId\",\"type\":\"uint256\"}],\"name\":\"queryPolicyForGroup\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"principalType\",\"type\":\"int32\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structPrincipal\",\"name\":\"principal\",\"type\":\"tuple\"},{\"internalType\":\"int32\",\"name\":\"resourceType\",\"type\":\"int32\"},{\"internalType\":\"uint256\",\"name\":\"resourceId\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"},{\"internalType\":\"int32[]\",\"name\":\"actions\",\"type\":\"int32[]\"},{\"internalType\":\"string[]\",\"name\":\"resources\",\"type\":\"string[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"},{\"internalType\":\"uint64\",\"name\":\"limitSize\",\"type\":\"uint64\"}],\"internalType\":\"structStatement[]\",\"name\":\"statements\",\"type\":\"tuple[]\"},{\"internalType\":\"int64\",\"name\":\"expirationTime\",\"type\":\"int64\"}],\"internalType\":\"structPolicy\",\"name\":\"policy\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"queryQuotaUpdateTime\",\"outputs\":[{\"internalType\":\"int64\",\"name\":\"updateAt\",\"type\":\"int64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"rejectMigrateBucket\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"}],\"name\":\"rejectSealObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"address[]\",\"name\":\"members\",\"type\":\"address[]\"},{\"internalType\":\"int64[]\",\"name\":\"expirationTime\",\"type\":\"int64[]\"}],\"name\":\"renewGroupMember\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"secondarySpBlsAggSignatures\",\"type\":\"string\"}],\"name\":\"sealObject\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"secondarySpBlsAggSignatures\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"expectChecksums\",\"type\":\"string[]\"}],\"name\":\"sealObjectV2\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"callbackAddress\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"gasLimit\",\"type\":\"uint64\"}],\"name\":\"setBucketCallback\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"bucketOwner\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"paymentAddress\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"flowRateLimit\",\"type\":\"uint256\"}],\"name\":\"setBucketFlowRateLimit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"resource\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"}],\"internalType\":\"structTag[]\",\"name\":\"tags\",\"type\":\"tuple[]\"}],\"name\":\"setTag\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"}],\"name\":\"toggleSPAsDelegatedAgent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"tokenContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"tokenURI\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferBucketOwnership\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"paymentAddress\",\"type\":\"address\"},{\"internalType\":\"int128\",\"name\":\"chargedReadQuota\",\"type\":\"int128\"}],\"name\":\"updateBucketInfo\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"address[]\",\"name\":\"membersToAdd\",\"type\":\"address[]\"},{\"internalType\":\"int64[]\",\"name\":\"expirationTime\",\"type\":\"int64[]\"},{\"internalType\":\"address[]\",\"name\":\"membersToDelete\",\"type\":\"address[]\"}],\"name\":\"updateGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"groupOwner\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"groupName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"extra\",\"type\":\"string\"}],\"name\":\"updateGroupExtra\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"payloadSize\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"contentType\",\"type\":\"string\"},{\"internalType\":\"string[]\",\"name\":\"expectChecksums\",\"type\":\"string[]\"}],\"name\":\"updateObjectContent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"enumVisibilityType\",\"name\":\"visibility\",\"type\":\"uint8\"}],\"name\":\"updateObjectInfo\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"bucketName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"objectName\",\"type\":\"string\"},{\"internalType\":\"int32\",\"name\":\"actionType\",\"type\":\"int32\"}],\"name\":\"verifyPermission\",\"outputs\":[{\"internalType\":\"int32\",\"name\":\"effect\",\"type\":\"int32\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
 }
 
 // IStorageABI is the input ABI used to generate the binding from.
@@ -1002,6 +1009,101 @@ func (_IStorage *IStorageCallerSession) ListObjectsByBucketId(pagination PageReq
 	return _IStorage.Contract.ListObjectsByBucketId(&_IStorage.CallOpts, pagination, bucketId)
 }
 
+// ListObjectsV2 is a free data retrieval call binding the contract method 0xa54ff0c4.
+//
+// Solidity: function listObjectsV2((bytes,uint64,uint64,bool,bool) pagination, string bucketName, string prefix, string delimiter, string startAfter) view returns((address,address,string,string,uint256,uint32,uint64,uint8,string,int64,uint8,uint8,uint8,string[],(string,string)[],bool,int64,address,int64)[] objectInfos, string[] commonPrefixes, (bytes,uint64) pageResponse)
+func (_IStorage *IStorageCaller) ListObjectsV2(opts *bind.CallOpts, pagination PageRequest, bucketName string, prefix string, delimiter string, startAfter string) (struct {
+	ObjectInfos    []ObjectInfo
+	CommonPrefixes []string
+	PageResponse   PageResponse
+}, error) {
+	var out []interface{}
+	err := _IStorage.contract.Call(opts, &out, "listObjectsV2", pagination, bucketName, prefix, delimiter, startAfter)
+
+	outstruct := new(struct {
+		ObjectInfos    []ObjectInfo
+		CommonPrefixes []string
+		PageResponse   PageResponse
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.ObjectInfos = *abi.ConvertType(out[0], new([]ObjectInfo)).(*[]ObjectInfo)
+	outstruct.CommonPrefixes = *abi.ConvertType(out[1], new([]string)).(*[]string)
+	outstruct.PageResponse = *abi.ConvertType(out[2], new(PageResponse)).(*PageResponse)
+
+	return *outstruct, err
+
+}
+
+// ListObjectsV2 is a free data retrieval call binding the contract method 0xa54ff0c4.
+//
+// Solidity: function listObjectsV2((bytes,uint64,uint64,bool,bool) pagination, string bucketName, string prefix, string delimiter, string startAfter) view returns((address,address,string,string,uint256,uint32,uint64,uint8,string,int64,uint8,uint8,uint8,string[],(string,string)[],bool,int64,address,int64)[] objectInfos, string[] commonPrefixes, (bytes,uint64) pageResponse)
+func (_IStorage *IStorageSession) ListObjectsV2(pagination PageRequest, bucketName string, prefix string, delimiter string, startAfter string) (struct {
+	ObjectInfos    []ObjectInfo
+	CommonPrefixes []string
+	PageResponse   PageResponse
+}, error) {
+	return _IStorage.Contract.ListObjectsV2(&_IStorage.CallOpts, pagination, bucketName, prefix, delimiter, startAfter)
+}
+
+// ListObjectsV2 is a free data retrieval call binding the contract method 0xa54ff0c4.
+//
+// Solidity: function listObjectsV2((bytes,uint64,uint64,bool,bool) pagination, string bucketName, string prefix, string delimiter, string startAfter) view returns((address,address,string,string,uint256,uint32,uint64,uint8,string,int64,uint8,uint8,uint8,string[],(string,string)[],bool,int64,address,int64)[] objectInfos, string[] commonPrefixes, (bytes,uint64) pageResponse)
+func (_IStorage *IStorageCallerSession) ListObjectsV2(pagination PageRequest, bucketName string, prefix string, delimiter string, startAfter string) (struct {
+	ObjectInfos    []ObjectInfo
+	CommonPrefixes []string
+	PageResponse   PageResponse
+}, error) {
+	return _IStorage.Contract.ListObjectsV2(&_IStorage.CallOpts, pagination, bucketName, prefix, delimiter, startAfter)
+}
+
+// ListResourcesByTag is a free data retrieval call binding the contract method 0x59a7d3ee.
+//
+// Solidity: function listResourcesByTag((bytes,uint64,uint64,bool,bool) pagination, string tagKey, string tagValue, uint8 resourceType) view returns((uint8,uint256,string)[] resources, (bytes,uint64) pageResponse)
+func (_IStorage *IStorageCaller) ListResourcesByTag(opts *bind.CallOpts, pagination PageRequest, tagKey string, tagValue string, resourceType uint8) (struct {
+	Resources    []TaggedResource
+	PageResponse PageResponse
+}, error) {
+	var out []interface{}
+	err := _IStorage.contract.Call(opts, &out, "listResourcesByTag", pagination, tagKey, tagValue, resourceType)
+
+	outstruct := new(struct {
+		Resources    []TaggedResource
+		PageResponse PageResponse
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Resources = *abi.ConvertType(out[0], new([]TaggedResource)).(*[]TaggedResource)
+	outstruct.PageResponse = *abi.ConvertType(out[1], new(PageResponse)).(*PageResponse)
+
+	return *outstruct, err
+
+}
+
+// ListResourcesByTag is a free data retrieval call binding the contract method 0x59a7d3ee.
+//
+// Solidity: function listResourcesByTag((bytes,uint64,uint64,bool,bool) pagination, string tagKey, string tagValue, uint8 resourceType) view returns((uint8,uint256,string)[] resources, (bytes,uint64) pageResponse)
+func (_IStorage *IStorageSession) ListResourcesByTag(pagination PageRequest, tagKey string, tagValue string, resourceType uint8) (struct {
+	Resources    []TaggedResource
+	PageResponse PageResponse
+}, error) {
+	return _IStorage.Contract.ListResourcesByTag(&_IStorage.CallOpts, pagination, tagKey, tagValue, resourceType)
+}
+
+// ListResourcesByTag is a free data retrieval call binding the contract method 0x59a7d3ee.
+//
+// Solidity: function listResourcesByTag((bytes,uint64,uint64,bool,bool) pagination, string tagKey, string tagValue, uint8 resourceType) view returns((uint8,uint256,string)[] resources, (bytes,uint64) pageResponse)
+func (_IStorage *IStorageCallerSession) ListResourcesByTag(pagination PageRequest, tagKey string, tagValue string, resourceType uint8) (struct {
+	Resources    []TaggedResource
+	PageResponse PageResponse
+}, error) {
+	return _IStorage.Contract.ListResourcesByTag(&_IStorage.CallOpts, pagination, tagKey, tagValue, resourceType)
+}
+
 // Params is a free data retrieval call binding the contract method 0xcff0ab96.
 //
 // Solidity: function params() view returns(((uint64,uint32,uint32,uint64),uint64,string,string,string,string,string,string,uint32,uint64,uint64,uint64,int64,uint64,uint64,uint64,uint32,string,string,string,string,string,string,string,string) params)
@@ -1033,6 +1135,51 @@ func (_IStorage *IStorageCallerSession) Params() (Params, error) {
 	return _IStorage.Contract.Params(&_IStorage.CallOpts)
 }
 
+// QueryBucketCallback is a free data retrieval call binding the contract method 0x141ce391.
+//
+// Solidity: function queryBucketCallback(string bucketName) view returns(address callbackAddress, uint64 gasLimit)
+func (_IStorage *IStorageCaller) QueryBucketCallback(opts *bind.CallOpts, bucketName string) (struct {
+	CallbackAddress common.Address
+	GasLimit        uint64
+}, error) {
+	var out []interface{}
+	err := _IStorage.contract.Call(opts, &out, "queryBucketCallback", bucketName)
+
+	outstruct := new(struct {
+		CallbackAddress common.Address
+		GasLimit        uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.CallbackAddress = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.GasLimit = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// QueryBucketCallback is a free data retrieval call binding the contract method 0x141ce391.
+//
+// Solidity: function queryBucketCallback(string bucketName) view returns(address callbackAddress, uint64 gasLimit)
+func (_IStorage *IStorageSession) QueryBucketCallback(bucketName string) (struct {
+	CallbackAddress common.Address
+	GasLimit        uint64
+}, error) {
+	return _IStorage.Contract.QueryBucketCallback(&_IStorage.CallOpts, bucketName)
+}
+
+// QueryBucketCallback is a free data retrieval call binding the contract method 0x141ce391.
+//
+// Solidity: function queryBucketCallback(string bucketName) view returns(address callbackAddress, uint64 gasLimit)
+func (_IStorage *IStorageCallerSession) QueryBucketCallback(bucketName string) (struct {
+	CallbackAddress common.Address
+	GasLimit        uint64
+}, error) {
+	return _IStorage.Contract.QueryBucketCallback(&_IStorage.CallOpts, bucketName)
+}
+
 // QueryGroupMembersExist is a free data retrieval call binding the contract method 0x4645d454.
 //
 // Solidity: function queryGroupMembersExist(string groupId, string[] members) view returns(string[] checkMembers, bool[] exists)
@@ -1430,6 +1577,37 @@ func (_IStorage *IStorageCallerSession) QueryQuotaUpdateTime(bucketName string)
 	return _IStorage.Contract.QueryQuotaUpdateTime(&_IStorage.CallOpts, bucketName)
 }
 
+// TokenURI is a free data retrieval call binding the contract method 0xe9dc6375.
+//
+// Solidity: function tokenURI(address tokenContract, uint256 tokenId) view returns(string tokenURI)
+func (_IStorage *IStorageCaller) TokenURI(opts *bind.CallOpts, tokenContract common.Address, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _IStorage.contract.Call(opts, &out, "tokenURI", tokenContract, tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xe9dc6375.
+//
+// Solidity: function tokenURI(address tokenContract, uint256 tokenId) view returns(string tokenURI)
+func (_IStorage *IStorageSession) TokenURI(tokenContract common.Address, tokenId *big.Int) (string, error) {
+	return _IStorage.Contract.TokenURI(&_IStorage.CallOpts, tokenContract, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xe9dc6375.
+//
+// Solidity: function tokenURI(address tokenContract, uint256 tokenId) view returns(string tokenURI)
+func (_IStorage *IStorageCallerSession) TokenURI(tokenContract common.Address, tokenId *big.Int) (string, error) {
+	return _IStorage.Contract.TokenURI(&_IStorage.CallOpts, tokenContract, tokenId)
+}
+
 // VerifyPermission is a free data retrieval call binding the contract method 0xc9640758.
 //
 // Solidity: function verifyPermission(string bucketName, string objectName, int32 actionType) view returns(int32 effect)
@@ -1461,6 +1639,27 @@ func (_IStorage *IStorageCallerSession) VerifyPermission(bucketName string, obje
 	return _IStorage.Contract.VerifyPermission(&_IStorage.CallOpts, bucketName, objectName, actionType)
 }
 
+// AcceptBucketOwnership is a paid mutator transaction binding the contract method 0x6c97a435.
+//
+// Solidity: function acceptBucketOwnership(string bucketName, address paymentAddress) returns(bool success)
+func (_IStorage *IStorageTransactor) AcceptBucketOwnership(opts *bind.TransactOpts, bucketName string, paymentAddress common.Address) (*types.Transaction, error) {
+	return _IStorage.contract.Transact(opts, "acceptBucketOwnership", bucketName, paymentAddress)
+}
+
+// AcceptBucketOwnership is a paid mutator transaction binding the contract method 0x6c97a435.
+//
+// Solidity: function acceptBucketOwnership(string bucketName, address paymentAddress) returns(bool success)
+func (_IStorage *IStorageSession) AcceptBucketOwnership(bucketName string, paymentAddress common.Address) (*types.Transaction, error) {
+	return _IStorage.Contract.AcceptBucketOwnership(&_IStorage.TransactOpts, bucketName, paymentAddress)
+}
+
+// AcceptBucketOwnership is a paid mutator transaction binding the contract method 0x6c97a435.
+//
+// Solidity: function acceptBucketOwnership(string bucketName, address paymentAddress) returns(bool success)
+func (_IStorage *IStorageTransactorSession) AcceptBucketOwnership(bucketName string, paymentAddress common.Address) (*types.Transaction, error) {
+	return _IStorage.Contract.AcceptBucketOwnership(&_IStorage.TransactOpts, bucketName, paymentAddress)
+}
+
 // CancelCreateObject is a paid mutator transaction binding the contract method 0xa0cd9e20.
 //
 // Solidity: function cancelCreateObject(string bucketName, string objectName) returns(bool success)
@@ -1965,6 +2164,27 @@ func (_IStorage *IStorageTransactorSession) SealObjectV2(bucketName string, obje
 	return _IStorage.Contract.SealObjectV2(&_IStorage.TransactOpts, bucketName, objectName, globalVirtualGroupId, secondarySpBlsAggSignatures, expectChecksums)
 }
 
+// SetBucketCallback is a paid mutator transaction binding the contract method 0xd636ec87.
+//
+// Solidity: function setBucketCallback(string bucketName, address callbackAddress, uint64 gasLimit) returns(bool success)
+func (_IStorage *IStorageTransactor) SetBucketCallback(opts *bind.TransactOpts, bucketName string, callbackAddress common.Address, gasLimit uint64) (*types.Transaction, error) {
+	return _IStorage.contract.Transact(opts, "setBucketCallback", bucketName, callbackAddress, gasLimit)
+}
+
+// SetBucketCallback is a paid mutator transaction binding the contract method 0xd636ec87.
+//
+// Solidity: function setBucketCallback(string bucketName, address callbackAddress, uint64 gasLimit) returns(bool success)
+func (_IStorage *IStorageSession) SetBucketCallback(bucketName string, callbackAddress common.Address, gasLimit uint64) (*types.Transaction, error) {
+	return _IStorage.Contract.SetBucketCallback(&_IStorage.TransactOpts, bucketName, callbackAddress, gasLimit)
+}
+
+// SetBucketCallback is a paid mutator transaction binding the contract method 0xd636ec87.
+//
+// Solidity: function setBucketCallback(string bucketName, address callbackAddress, uint64 gasLimit) returns(bool success)
+func (_IStorage *IStorageTransactorSession) SetBucketCallback(bucketName string, callbackAddress common.Address, gasLimit uint64) (*types.Transaction, error) {
+	return _IStorage.Contract.SetBucketCallback(&_IStorage.TransactOpts, bucketName, callbackAddress, gasLimit)
+}
+
 // SetBucketFlowRateLimit is a paid mutator transaction binding the contract method 0xf9c523d0.
 //
 // Solidity: function setBucketFlowRateLimit(string bucketName, string bucketOwner, string paymentAddress, uint256 flowRateLimit) returns(bool success)
@@ -2028,6 +2248,27 @@ func (_IStorage *IStorageTransactorSession) ToggleSPAsDelegatedAgent(bucketName
 	return _IStorage.Contract.ToggleSPAsDelegatedAgent(&_IStorage.TransactOpts, bucketName)
 }
 
+// TransferBucketOwnership is a paid mutator transaction binding the contract method 0xfcb54f02.
+//
+// Solidity: function transferBucketOwnership(string bucketName, address newOwner) returns(bool success)
+func (_IStorage *IStorageTransactor) TransferBucketOwnership(opts *bind.TransactOpts, bucketName string, newOwner common.Address) (*types.Transaction, error) {
+	return _IStorage.contract.Transact(opts, "transferBucketOwnership", bucketName, newOwner)
+}
+
+// TransferBucketOwnership is a paid mutator transaction binding the contract method 0xfcb54f02.
+//
+// Solidity: function transferBucketOwnership(string bucketName, address newOwner) returns(bool success)
+func (_IStorage *IStorageSession) TransferBucketOwnership(bucketName string, newOwner common.Address) (*types.Transaction, error) {
+	return _IStorage.Contract.TransferBucketOwnership(&_IStorage.TransactOpts, bucketName, newOwner)
+}
+
+// TransferBucketOwnership is a paid mutator transaction binding the contract method 0xfcb54f02.
+//
+// Solidity: function transferBucketOwnership(string bucketName, address newOwner) returns(bool success)
+func (_IStorage *IStorageTransactorSession) TransferBucketOwnership(bucketName string, newOwner common.Address) (*types.Transaction, error) {
+	return _IStorage.Contract.TransferBucketOwnership(&_IStorage.TransactOpts, bucketName, newOwner)
+}
+
 // UpdateBucketInfo is a paid mutator transaction binding the contract method 0x7a5c5d77.
 //
 // Solidity: function updateBucketInfo(string bucketName, uint8 visibility, address paymentAddress, int128 chargedReadQuota) returns(bool success)
@@ -2133,6 +2374,150 @@ func (_IStorage *IStorageTransactorSession) UpdateObjectInfo(bucketName string,
 	return _IStorage.Contract.UpdateObjectInfo(&_IStorage.TransactOpts, bucketName, objectName, visibility)
 }
 
+// IStorageAcceptBucketOwnershipIterator is returned from FilterAcceptBucketOwnership and is used to iterate over the raw logs and unpacked data for AcceptBucketOwnership events raised by the IStorage contract.
+type IStorageAcceptBucketOwnershipIterator struct {
+	Event *IStorageAcceptBucketOwnership // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IStorageAcceptBucketOwnershipIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IStorageAcceptBucketOwnership)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IStorageAcceptBucketOwnership)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IStorageAcceptBucketOwnershipIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IStorageAcceptBucketOwnershipIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IStorageAcceptBucketOwnership represents a AcceptBucketOwnership event raised by the IStorage contract.
+type IStorageAcceptBucketOwnership struct {
+	Operator common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterAcceptBucketOwnership is a free log retrieval operation binding the contract event 0x837d074d19e9c9d1d983e939081fb818adc722ca1bee3c63f9ca1283ce582adc.
+//
+// Solidity: event AcceptBucketOwnership(address indexed operator)
+func (_IStorage *IStorageFilterer) FilterAcceptBucketOwnership(opts *bind.FilterOpts, operator []common.Address) (*IStorageAcceptBucketOwnershipIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IStorage.contract.FilterLogs(opts, "AcceptBucketOwnership", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IStorageAcceptBucketOwnershipIterator{contract: _IStorage.contract, event: "AcceptBucketOwnership", logs: logs, sub: sub}, nil
+}
+
+// WatchAcceptBucketOwnership is a free log subscription operation binding the contract event 0x837d074d19e9c9d1d983e939081fb818adc722ca1bee3c63f9ca1283ce582adc.
+//
+// Solidity: event AcceptBucketOwnership(address indexed operator)
+func (_IStorage *IStorageFilterer) WatchAcceptBucketOwnership(opts *bind.WatchOpts, sink chan<- *IStorageAcceptBucketOwnership, operator []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IStorage.contract.WatchLogs(opts, "AcceptBucketOwnership", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IStorageAcceptBucketOwnership)
+				if err := _IStorage.contract.UnpackLog(event, "AcceptBucketOwnership", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAcceptBucketOwnership is a log parse operation binding the contract event 0x837d074d19e9c9d1d983e939081fb818adc722ca1bee3c63f9ca1283ce582adc.
+//
+// Solidity: event AcceptBucketOwnership(address indexed operator)
+func (_IStorage *IStorageFilterer) ParseAcceptBucketOwnership(log types.Log) (*IStorageAcceptBucketOwnership, error) {
+	event := new(IStorageAcceptBucketOwnership)
+	if err := _IStorage.contract.UnpackLog(event, "AcceptBucketOwnership", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // IStorageCancelCreateObjectIterator is returned from FilterCancelCreateObject and is used to iterate over the raw logs and unpacked data for CancelCreateObject events raised by the IStorage contract.
 type IStorageCancelCreateObjectIterator struct {
 	Event *IStorageCancelCreateObject // Event containing the contract specifics and raw log
@@ -5691,6 +6076,150 @@ func (_IStorage *IStorageFilterer) ParseSealObjectV2(log types.Log) (*IStorageSe
 	return event, nil
 }
 
+// IStorageSetBucketCallbackIterator is returned from FilterSetBucketCallback and is used to iterate over the raw logs and unpacked data for SetBucketCallback events raised by the IStorage contract.
+type IStorageSetBucketCallbackIterator struct {
+	Event *IStorageSetBucketCallback // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IStorageSetBucketCallbackIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IStorageSetBucketCallback)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IStorageSetBucketCallback)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IStorageSetBucketCallbackIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IStorageSetBucketCallbackIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IStorageSetBucketCallback represents a SetBucketCallback event raised by the IStorage contract.
+type IStorageSetBucketCallback struct {
+	Operator common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetBucketCallback is a free log retrieval operation binding the contract event 0x4b0988d37c7f05bc2534f18ec40721b0d03bf9c07d5477608c0cc5f3f307d431.
+//
+// Solidity: event SetBucketCallback(address indexed operator)
+func (_IStorage *IStorageFilterer) FilterSetBucketCallback(opts *bind.FilterOpts, operator []common.Address) (*IStorageSetBucketCallbackIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IStorage.contract.FilterLogs(opts, "SetBucketCallback", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IStorageSetBucketCallbackIterator{contract: _IStorage.contract, event: "SetBucketCallback", logs: logs, sub: sub}, nil
+}
+
+// WatchSetBucketCallback is a free log subscription operation binding the contract event 0x4b0988d37c7f05bc2534f18ec40721b0d03bf9c07d5477608c0cc5f3f307d431.
+//
+// Solidity: event SetBucketCallback(address indexed operator)
+func (_IStorage *IStorageFilterer) WatchSetBucketCallback(opts *bind.WatchOpts, sink chan<- *IStorageSetBucketCallback, operator []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IStorage.contract.WatchLogs(opts, "SetBucketCallback", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IStorageSetBucketCallback)
+				if err := _IStorage.contract.UnpackLog(event, "SetBucketCallback", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetBucketCallback is a log parse operation binding the contract event 0x4b0988d37c7f05bc2534f18ec40721b0d03bf9c07d5477608c0cc5f3f307d431.
+//
+// Solidity: event SetBucketCallback(address indexed operator)
+func (_IStorage *IStorageFilterer) ParseSetBucketCallback(log types.Log) (*IStorageSetBucketCallback, error) {
+	event := new(IStorageSetBucketCallback)
+	if err := _IStorage.contract.UnpackLog(event, "SetBucketCallback", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // IStorageSetBucketFlowRateLimitIterator is returned from FilterSetBucketFlowRateLimit and is used to iterate over the raw logs and unpacked data for SetBucketFlowRateLimit events raised by the IStorage contract.
 type IStorageSetBucketFlowRateLimitIterator struct {
 	Event *IStorageSetBucketFlowRateLimit // Event containing the contract specifics and raw log
@@ -6285,6 +6814,150 @@ func (_IStorage *IStorageFilterer) ParseTransfer(log types.Log) (*IStorageTransf
 	return event, nil
 }
 
+// IStorageTransferBucketOwnershipIterator is returned from FilterTransferBucketOwnership and is used to iterate over the raw logs and unpacked data for TransferBucketOwnership events raised by the IStorage contract.
+type IStorageTransferBucketOwnershipIterator struct {
+	Event *IStorageTransferBucketOwnership // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IStorageTransferBucketOwnershipIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IStorageTransferBucketOwnership)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IStorageTransferBucketOwnership)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IStorageTransferBucketOwnershipIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IStorageTransferBucketOwnershipIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IStorageTransferBucketOwnership represents a TransferBucketOwnership event raised by the IStorage contract.
+type IStorageTransferBucketOwnership struct {
+	Operator common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferBucketOwnership is a free log retrieval operation binding the contract event 0x993778fee7f1052a47d17d030c322df508b0d1e6aeeac09ff3a59fc7448102b2.
+//
+// Solidity: event TransferBucketOwnership(address indexed operator)
+func (_IStorage *IStorageFilterer) FilterTransferBucketOwnership(opts *bind.FilterOpts, operator []common.Address) (*IStorageTransferBucketOwnershipIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IStorage.contract.FilterLogs(opts, "TransferBucketOwnership", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IStorageTransferBucketOwnershipIterator{contract: _IStorage.contract, event: "TransferBucketOwnership", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferBucketOwnership is a free log subscription operation binding the contract event 0x993778fee7f1052a47d17d030c322df508b0d1e6aeeac09ff3a59fc7448102b2.
+//
+// Solidity: event TransferBucketOwnership(address indexed operator)
+func (_IStorage *IStorageFilterer) WatchTransferBucketOwnership(opts *bind.WatchOpts, sink chan<- *IStorageTransferBucketOwnership, operator []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IStorage.contract.WatchLogs(opts, "TransferBucketOwnership", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IStorageTransferBucketOwnership)
+				if err := _IStorage.contract.UnpackLog(event, "TransferBucketOwnership", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferBucketOwnership is a log parse operation binding the contract event 0x993778fee7f1052a47d17d030c322df508b0d1e6aeeac09ff3a59fc7448102b2.
+//
+// Solidity: event TransferBucketOwnership(address indexed operator)
+func (_IStorage *IStorageFilterer) ParseTransferBucketOwnership(log types.Log) (*IStorageTransferBucketOwnership, error) {
+	event := new(IStorageTransferBucketOwnership)
+	if err := _IStorage.contract.UnpackLog(event, "TransferBucketOwnership", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // IStorageUpdateBucketInfoIterator is returned from FilterUpdateBucketInfo and is used to iterate over the raw logs and unpacked data for UpdateBucketInfo events raised by the IStorage contract.
 type IStorageUpdateBucketInfoIterator struct {
 	Event *IStorageUpdateBucketInfo // Event containing the contract specifics and raw log
@@ -33,6 +33,12 @@ const (
 	CancelMigrateBucketEventName = "CancelMigrateBucket"
 	// SetBucketFlowRateLimitEventName is the event emitted on a setBucketFlowRateLimit transaction.
 	SetBucketFlowRateLimitEventName = "SetBucketFlowRateLimit"
+	// SetBucketCallbackEventName is the event emitted on a setBucketCallback transaction.
+	SetBucketCallbackEventName = "SetBucketCallback"
+	// TransferBucketOwnershipEventName is the event emitted on a transferBucketOwnership transaction.
+	TransferBucketOwnershipEventName = "TransferBucketOwnership"
+	// AcceptBucketOwnershipEventName is the event emitted on an acceptBucketOwnership transaction.
+	AcceptBucketOwnershipEventName = "AcceptBucketOwnership"
 	// CreateObjectEventName is the event emitted on a createObject transaction.
 	CreateObjectEventName = "CreateObject"
 	// CopyObjectEventName is the event emitted on a copyObject transaction.
@@ -161,6 +167,24 @@ func (p Precompile) EmitSetBucketFlowRateLimitEvent(evm *vm.EVM, caller common.A
 		[]common.Hash{common.BytesToHash(caller.Bytes())})
 }
 
+// EmitSetBucketCallbackEvent emits the SetBucketCallback event with the caller as the sole indexed topic.
+func (p Precompile) EmitSetBucketCallbackEvent(evm *vm.EVM, caller common.Address) error {
+	return p.AddLog(evm, MustEvent(SetBucketCallbackEventName),
+		[]common.Hash{common.BytesToHash(caller.Bytes())})
+}
+
+// EmitTransferBucketOwnershipEvent emits the TransferBucketOwnership event with the caller as the sole indexed topic.
+func (p Precompile) EmitTransferBucketOwnershipEvent(evm *vm.EVM, caller common.Address) error {
+	return p.AddLog(evm, MustEvent(TransferBucketOwnershipEventName),
+		[]common.Hash{common.BytesToHash(caller.Bytes())})
+}
+
+// EmitAcceptBucketOwnershipEvent emits the AcceptBucketOwnership event with the caller as the sole indexed topic.
+func (p Precompile) EmitAcceptBucketOwnershipEvent(evm *vm.EVM, caller common.Address) error {
+	return p.AddLog(evm, MustEvent(AcceptBucketOwnershipEventName),
+		[]common.Hash{common.BytesToHash(caller.Bytes())})
+}
+
 // EmitCreateObjectEvent emits the CreateObject event with the caller as an indexed
 // topic and the object id as data.
 func (p Precompile) EmitCreateObjectEvent(evm *vm.EVM, caller common.Address, objectID *big.Int) error {
@@ -334,30 +358,37 @@ func (p Precompile) EmitCancelUpdateObjectContentEvent(evm *vm.EVM, caller commo
 // EmitBucketTransferEvent mirrors the bucket-NFT mint as an ERC721 Transfer log on the
 // bucket token contract: from the zero address to the owner, with the token id.
 func (p Precompile) EmitBucketTransferEvent(evm *vm.EVM, owner string, tokenID *big.Int) error {
-	return p.emitNFTTransferEvent(evm, contracts.BucketERC721TokenAddress, owner, tokenID)
+	return p.emitNFTTransferEvent(evm, contracts.BucketERC721TokenAddress, gtypes.EmptyEvmAddress, owner, tokenID)
 }
 
 // EmitObjectTransferEvent mirrors the object-NFT mint as an ERC721 Transfer log on the
 // object token contract: from the zero address to the owner, with the token id.
 func (p Precompile) EmitObjectTransferEvent(evm *vm.EVM, owner string, tokenID *big.Int) error {
-	return p.emitNFTTransferEvent(evm, contracts.ObjectERC721TokenAddress, owner, tokenID)
+	return p.emitNFTTransferEvent(evm, contracts.ObjectERC721TokenAddress, gtypes.EmptyEvmAddress, owner, tokenID)
 }
 
 // EmitGroupTransferEvent mirrors the group-NFT mint as an ERC721 Transfer log on the
 // group token contract: from the zero address to the owner, with the token id.
 func (p Precompile) EmitGroupTransferEvent(evm *vm.EVM, owner string, tokenID *big.Int) error {
-	return p.emitNFTTransferEvent(evm, contracts.GroupERC721TokenAddress, owner, tokenID)
+	return p.emitNFTTransferEvent(evm, contracts.GroupERC721TokenAddress, gtypes.EmptyEvmAddress, owner, tokenID)
+}
+
+// EmitBucketOwnershipTransferEvent mirrors the move of the bucket NFT on an accepted
+// ownership transfer as an ERC721 Transfer log on the bucket token contract: from the
+// previous owner to the new owner, with the token id.
+func (p Precompile) EmitBucketOwnershipTransferEvent(evm *vm.EVM, previousOwner, newOwner string, tokenID *big.Int) error {
+	return p.emitNFTTransferEvent(evm, contracts.BucketERC721TokenAddress, previousOwner, newOwner, tokenID)
 }
 
 // emitNFTTransferEvent emits the ERC721 Transfer event (from, to, tokenId all indexed)
-// on the given NFT token contract address via AddOtherLog. The mint is always from the
-// empty EVM address; the token id is packed as its big-endian bytes, matching the
-// original inline emission.
-func (p Precompile) emitNFTTransferEvent(evm *vm.EVM, tokenContract common.Address, owner string, tokenID *big.Int) error {
+// on the given NFT token contract address via AddOtherLog. A mint is from the empty EVM
+// address; the token id is packed as its big-endian bytes, matching the original inline
+// emission.
+func (p Precompile) emitNFTTransferEvent(evm *vm.EVM, tokenContract common.Address, from, to string, tokenID *big.Int) error {
 	return p.AddOtherLog(evm, MustEvent(TransferEventName), tokenContract,
 		[]common.Hash{
-			common.BytesToHash(common.HexToAddress(gtypes.EmptyEvmAddress).Bytes()),
-			common.BytesToHash(common.HexToAddress(owner).Bytes()),
+			common.BytesToHash(common.HexToAddress(from).Bytes()),
+			common.BytesToHash(common.HexToAddress(to).Bytes()),
 			common.BytesToHash(tokenID.Bytes()),
 		})
 }
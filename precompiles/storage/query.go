@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
+	"fmt"
 
 	cmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	"github.com/mocachain/moca/v2/contracts"
+	"github.com/mocachain/moca/v2/types/resource"
 	permissiontypes "github.com/mocachain/moca/v2/x/permission/types"
 	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
 	vgtypes "github.com/mocachain/moca/v2/x/virtualgroup/types"
@@ -26,6 +29,10 @@ const (
 	ListGroupsMethodName = "listGroups"
 	// ListObjectsByBucketIDMethodName is the ABI name for the listObjectsByBucketId query.
 	ListObjectsByBucketIDMethodName = "listObjectsByBucketId"
+	// ListObjectsV2MethodName is the ABI name for the listObjectsV2 query.
+	ListObjectsV2MethodName = "listObjectsV2"
+	// ListResourcesByTagMethodName is the ABI name for the listResourcesByTag query.
+	ListResourcesByTagMethodName = "listResourcesByTag"
 	// HeadBucketMethodName is the ABI name for the headBucket query.
 	HeadBucketMethodName = "headBucket"
 	// HeadGroupMethodName is the ABI name for the headGroup query.
@@ -71,6 +78,10 @@ const (
 	// QueryPaymentAccountBucketFlowRateLimitMethodName is the ABI name for the
 	// queryPaymentAccountBucketFlowRateLimit query.
 	QueryPaymentAccountBucketFlowRateLimitMethodName = "queryPaymentAccountBucketFlowRateLimit"
+	// QueryBucketCallbackMethodName is the ABI name for the queryBucketCallback query.
+	QueryBucketCallbackMethodName = "queryBucketCallback"
+	// TokenURIMethodName is the ABI name for the tokenURI query.
+	TokenURIMethodName = "tokenURI"
 	// ParamsMethodName is the ABI name for the params query.
 	ParamsMethodName = "params"
 	// VerifyPermissionMethodName is the ABI name for the verifyPermission query.
@@ -216,6 +227,75 @@ func (p Precompile) ListObjectsByBucketID(ctx sdk.Context, method *abi.Method, a
 	return method.Outputs.Pack(objectInfos, outputPageResponse(res.Pagination))
 }
 
+// ListObjectsV2 queries the objects by prefix and groups their names into common prefixes by delimiter.
+func (p Precompile) ListObjectsV2(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input ListObjectsV2Args
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+	if bytes.Equal(input.Pagination.Key, []byte{0}) {
+		input.Pagination.Key = nil
+	}
+	msg := &storagetypes.QueryListObjectsRequest{
+		Pagination: &query.PageRequest{
+			Key:        input.Pagination.Key,
+			Offset:     input.Pagination.Offset,
+			Limit:      input.Pagination.Limit,
+			CountTotal: input.Pagination.CountTotal,
+			Reverse:    input.Pagination.Reverse,
+		},
+		BucketName: input.BucketName,
+		Prefix:     input.Prefix,
+		Delimiter:  input.Delimiter,
+		StartAfter: input.StartAfter,
+	}
+	res, err := p.storageKeeper.ListObjects(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	objectInfos := make([]ObjectInfo, 0, len(res.ObjectInfos))
+	for _, objectInfo := range res.ObjectInfos {
+		objectInfos = append(objectInfos, *outputObjectInfo(objectInfo))
+	}
+	return method.Outputs.Pack(objectInfos, res.CommonPrefixes, outputPageResponse(res.Pagination))
+}
+
+// ListResourcesByTag queries the buckets, objects and groups which have the tag.
+func (p Precompile) ListResourcesByTag(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input ListResourcesByTagArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+	if bytes.Equal(input.Pagination.Key, []byte{0}) {
+		input.Pagination.Key = nil
+	}
+	msg := &storagetypes.QueryListResourcesByTagRequest{
+		Pagination: &query.PageRequest{
+			Key:        input.Pagination.Key,
+			Offset:     input.Pagination.Offset,
+			Limit:      input.Pagination.Limit,
+			CountTotal: input.Pagination.CountTotal,
+			Reverse:    input.Pagination.Reverse,
+		},
+		TagKey:       input.TagKey,
+		TagValue:     input.TagValue,
+		ResourceType: resource.ResourceType(input.ResourceType),
+	}
+	res, err := p.storageKeeper.ListResourcesByTag(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]TaggedResource, 0, len(res.Resources))
+	for _, r := range res.Resources {
+		resources = append(resources, TaggedResource{
+			ResourceType: uint8(r.ResourceType),
+			ResourceId:   r.ResourceId.BigInt(),
+			Grn:          r.Grn,
+		})
+	}
+	return method.Outputs.Pack(resources, outputPageResponse(res.Pagination))
+}
+
 // HeadBucket queries the bucket's info.
 func (p Precompile) HeadBucket(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
 	var input HeadBucketArgs
@@ -748,6 +828,61 @@ func (p Precompile) QueryPaymentAccountBucketFlowRateLimit(ctx sdk.Context, meth
 	return method.Outputs.Pack(res.IsSet, res.FlowRateLimit.BigInt())
 }
 
+// QueryBucketCallback queries the contract notified about the objects of a bucket, the zero
+// address if none is registered.
+func (p Precompile) QueryBucketCallback(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input QueryBucketCallbackArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+	msg := &storagetypes.QueryBucketCallbackRequest{
+		BucketName: input.BucketName,
+	}
+	res, err := p.storageKeeper.BucketCallback(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Callback == nil {
+		return method.Outputs.Pack(common.Address{}, uint64(0))
+	}
+	return method.Outputs.Pack(common.HexToAddress(res.Callback.CallbackAddress), res.Callback.GasLimit)
+}
+
+// TokenURI queries the ERC-721 metadata URI of a bucket, object or group NFT. The tokenURI
+// of the NFT contracts resolves to the same metadata through the REST route once the
+// nft_metadata_base_url param of the storage module is set.
+func (p Precompile) TokenURI(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input TokenURIArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+	var resourceType resource.ResourceType
+	switch input.TokenContract {
+	case contracts.BucketERC721TokenAddress:
+		resourceType = resource.RESOURCE_TYPE_BUCKET
+	case contracts.ObjectERC721TokenAddress:
+		resourceType = resource.RESOURCE_TYPE_OBJECT
+	case contracts.GroupERC721TokenAddress:
+		resourceType = resource.RESOURCE_TYPE_GROUP
+	default:
+		return nil, fmt.Errorf("unknown token contract: %s", input.TokenContract)
+	}
+	if input.TokenID == nil || input.TokenID.Sign() < 0 {
+		return nil, errors.New("invalid token id")
+	}
+	msg := &storagetypes.QueryNFTTokenURIRequest{
+		ResourceType: resourceType,
+		TokenId:      input.TokenID.String(),
+	}
+	res, err := p.storageKeeper.NFTTokenURI(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.TokenUri)
+}
+
 // QueryParamsByTimestamp queries the parameters of the module by timestamp.
 func (p Precompile) QueryParamsByTimestamp(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
 	var input QueryParamsByTimestampArgs
@@ -121,6 +121,12 @@ func (p Precompile) Execute(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract,
 		bz, err = p.CancelMigrateBucket(ctx, evm, contract, method, args)
 	case SetBucketFlowRateLimitMethodName:
 		bz, err = p.SetBucketFlowRateLimit(ctx, evm, contract, method, args)
+	case SetBucketCallbackMethodName:
+		bz, err = p.SetBucketCallback(ctx, evm, contract, method, args)
+	case TransferBucketOwnershipMethodName:
+		bz, err = p.TransferBucketOwnership(ctx, evm, contract, method, args)
+	case AcceptBucketOwnershipMethodName:
+		bz, err = p.AcceptBucketOwnership(ctx, evm, contract, method, args)
 	case CreateObjectMethodName:
 		bz, err = p.CreateObject(ctx, evm, contract, method, args)
 	case CopyObjectMethodName:
@@ -178,6 +184,10 @@ func (p Precompile) Execute(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract,
 		bz, err = p.ListGroups(ctx, method, args)
 	case ListObjectsByBucketIDMethodName:
 		bz, err = p.ListObjectsByBucketID(ctx, method, args)
+	case ListObjectsV2MethodName:
+		bz, err = p.ListObjectsV2(ctx, method, args)
+	case ListResourcesByTagMethodName:
+		bz, err = p.ListResourcesByTag(ctx, method, args)
 	case HeadBucketMethodName:
 		bz, err = p.HeadBucket(ctx, method, args)
 	case HeadGroupMethodName:
@@ -222,6 +232,10 @@ func (p Precompile) Execute(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract,
 		bz, err = p.QueryGroupsExistByID(ctx, method, args)
 	case QueryPaymentAccountBucketFlowRateLimitMethodName:
 		bz, err = p.QueryPaymentAccountBucketFlowRateLimit(ctx, method, args)
+	case QueryBucketCallbackMethodName:
+		bz, err = p.QueryBucketCallback(ctx, method, args)
+	case TokenURIMethodName:
+		bz, err = p.TokenURI(ctx, method, args)
 	case ParamsMethodName:
 		bz, err = p.Params(ctx, method, args)
 	case VerifyPermissionMethodName:
@@ -244,6 +258,9 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		RejectMigrateBucketMethodName,
 		CancelMigrateBucketMethodName,
 		SetBucketFlowRateLimitMethodName,
+		SetBucketCallbackMethodName,
+		TransferBucketOwnershipMethodName,
+		AcceptBucketOwnershipMethodName,
 		CreateObjectMethodName,
 		CopyObjectMethodName,
 		DeleteObjectMethodName,
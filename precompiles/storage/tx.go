@@ -36,6 +36,12 @@ const (
 	CancelMigrateBucketMethodName = "cancelMigrateBucket"
 	// SetBucketFlowRateLimitMethodName is the ABI name for the setBucketFlowRateLimit transaction.
 	SetBucketFlowRateLimitMethodName = "setBucketFlowRateLimit"
+	// SetBucketCallbackMethodName is the ABI name for the setBucketCallback transaction.
+	SetBucketCallbackMethodName = "setBucketCallback"
+	// TransferBucketOwnershipMethodName is the ABI name for the transferBucketOwnership transaction.
+	TransferBucketOwnershipMethodName = "transferBucketOwnership"
+	// AcceptBucketOwnershipMethodName is the ABI name for the acceptBucketOwnership transaction.
+	AcceptBucketOwnershipMethodName = "acceptBucketOwnership"
 	// CreateObjectMethodName is the ABI name for the createObject transaction.
 	CreateObjectMethodName = "createObject"
 	// CopyObjectMethodName is the ABI name for the copyObject transaction.
@@ -374,6 +380,108 @@ func (p Precompile) SetBucketFlowRateLimit(ctx sdk.Context, evm *vm.EVM, contrac
 	return method.Outputs.Pack(true)
 }
 
+// SetBucketCallback registers the contract notified when the objects of the caller's bucket are
+// sealed, rejected or deleted; the zero callback address removes it.
+func (p Precompile) SetBucketCallback(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input SetBucketCallbackArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	callback := storagetypes.BucketCallback{}
+	if input.CallbackAddress != (common.Address{}) {
+		callback.CallbackAddress = input.CallbackAddress.String()
+		callback.GasLimit = input.GasLimit
+	}
+	msg := &storagetypes.MsgSetBucketCallback{
+		Operator:   contract.Caller().String(),
+		BucketName: input.BucketName,
+		Callback:   callback,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.storageMsgServer.SetBucketCallback(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSetBucketCallbackEvent(evm, contract.Caller()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// TransferBucketOwnership proposes to transfer the ownership of the caller's bucket to the new owner,
+// it takes effect once the new owner accepts it; the zero new owner cancels the pending transfer.
+func (p Precompile) TransferBucketOwnership(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input TransferBucketOwnershipArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	msg := &storagetypes.MsgTransferBucketOwnership{
+		Operator:   contract.Caller().String(),
+		BucketName: input.BucketName,
+	}
+	if input.NewOwner != (common.Address{}) {
+		msg.NewOwner = input.NewOwner.String()
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.storageMsgServer.TransferBucketOwnership(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitTransferBucketOwnershipEvent(evm, contract.Caller()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// AcceptBucketOwnership accepts the pending transfer of the ownership of a bucket to the caller, the
+// bucket is charged from the payment address afterwards, the caller itself if it is the zero address.
+func (p Precompile) AcceptBucketOwnership(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input AcceptBucketOwnershipArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	msg := &storagetypes.MsgAcceptBucketOwnership{
+		Operator:   contract.Caller().String(),
+		BucketName: input.BucketName,
+	}
+	if input.PaymentAddress != (common.Address{}) {
+		msg.PaymentAddress = input.PaymentAddress.String()
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	bucketInfo, found := p.storageKeeper.GetBucketInfo(ctx, input.BucketName)
+	if !found {
+		return nil, storagetypes.ErrNoSuchBucket
+	}
+	previousOwner := bucketInfo.Owner
+
+	if _, err := p.storageMsgServer.AcceptBucketOwnership(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitAcceptBucketOwnershipEvent(evm, contract.Caller()); err != nil {
+		return nil, err
+	}
+	if err := p.EmitBucketOwnershipTransferEvent(evm, previousOwner, contract.Caller().String(), bucketInfo.Id.BigInt()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
 // CreateObject creates a new object in a bucket owned/authorized to the caller.
 func (p Precompile) CreateObject(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
 	var input CreateObjectArgs
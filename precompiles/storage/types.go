@@ -167,6 +167,25 @@ type SetBucketFlowRateLimitArgs struct {
 	FlowRateLimit  *big.Int `abi:"flowRateLimit"`
 }
 
+// SetBucketCallbackArgs is the decode target for the setBucketCallback calldata.
+type SetBucketCallbackArgs struct {
+	BucketName      string         `abi:"bucketName"`
+	CallbackAddress common.Address `abi:"callbackAddress"`
+	GasLimit        uint64         `abi:"gasLimit"`
+}
+
+// TransferBucketOwnershipArgs is the decode target for the transferBucketOwnership calldata.
+type TransferBucketOwnershipArgs struct {
+	BucketName string         `abi:"bucketName"`
+	NewOwner   common.Address `abi:"newOwner"`
+}
+
+// AcceptBucketOwnershipArgs is the decode target for the acceptBucketOwnership calldata.
+type AcceptBucketOwnershipArgs struct {
+	BucketName     string         `abi:"bucketName"`
+	PaymentAddress common.Address `abi:"paymentAddress"`
+}
+
 // CreateObjectArgs is the decode target for the createObject calldata.
 type CreateObjectArgs struct {
 	BucketName        string   `abi:"bucketName"`
@@ -218,6 +237,23 @@ type ListObjectsByBucketIDArgs struct {
 	BucketID   string      `abi:"bucketId"`
 }
 
+// ListObjectsV2Args is the decode target for the listObjectsV2 calldata.
+type ListObjectsV2Args struct {
+	Pagination PageRequest `abi:"pagination"`
+	BucketName string      `abi:"bucketName"`
+	Prefix     string      `abi:"prefix"`
+	Delimiter  string      `abi:"delimiter"`
+	StartAfter string      `abi:"startAfter"`
+}
+
+// ListResourcesByTagArgs is the decode target for the listResourcesByTag calldata.
+type ListResourcesByTagArgs struct {
+	Pagination   PageRequest `abi:"pagination"`
+	TagKey       string      `abi:"tagKey"`
+	TagValue     string      `abi:"tagValue"`
+	ResourceType uint8       `abi:"resourceType"`
+}
+
 // SealObjectArgs is the decode target for the sealObject calldata.
 type SealObjectArgs struct {
 	BucketName                  string `abi:"bucketName"`
@@ -447,6 +483,17 @@ type QueryPaymentAccountBucketFlowRateLimitArgs struct {
 	BucketName     string `abi:"bucketName"`
 }
 
+// QueryBucketCallbackArgs is the decode target for the queryBucketCallback calldata.
+type QueryBucketCallbackArgs struct {
+	BucketName string `abi:"bucketName"`
+}
+
+// TokenURIArgs is the decode target for the tokenURI calldata.
+type TokenURIArgs struct {
+	TokenContract common.Address `abi:"tokenContract"`
+	TokenID       *big.Int       `abi:"tokenId"`
+}
+
 // QueryParamsByTimestampArgs is the decode target for the queryParamsByTimestamp calldata.
 type QueryParamsByTimestampArgs struct {
 	Timestamp int64 `abi:"timestamp"`
@@ -53,6 +53,17 @@ type PageResponse struct {
 	Total   uint64
 }
 
+// SpChallengeStats is an auto generated low-level Go binding around an user-defined struct.
+type SpChallengeStats struct {
+	SpId                uint32
+	ChallengesReceived  uint64
+	ChallengesSucceeded uint64
+	ChallengesFailed    uint64
+	Heartbeats          uint64
+	TotalSlashed        *big.Int
+	ReliabilityScore    *big.Int
+}
+
 // SpStoragePrice is an auto generated low-level Go binding around an user-defined struct.
 type SpStoragePrice struct {
 	SpId          uint32
@@ -80,7 +91,7 @@ type StorageProvider struct {
 
 // IStorageProviderMetaData contains all meta data concerning the IStorageProvider contract.
 var IStorageProviderMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"UpdateSPPrice\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"}],\"name\":\"storageProvider\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"operator_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"funding_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"seal_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"approval_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"gc_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"maintenance_address\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"total_deposit\",\"type\":\"uint256\"},{\"internalType\":\"enumStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"endpoint\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"moniker\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"identity\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"website\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"security_contact\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"details\",\"type\":\"string\"}],\"internalType\":\"structDescription\",\"name\":\"description\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"bls_key\",\"type\":\"string\"}],\"internalType\":\"structStorageProvider\",\"name\":\"storageProvider\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operatorAddress\",\"type\":\"address\"}],\"name\":\"storageProviderByOperatorAddress\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"operator_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"funding_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"seal_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"approval_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"gc_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"maintenance_address\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"total_deposit\",\"type\":\"uint256\"},{\"internalType\":\"enumStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"endpoint\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"moniker\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"identity\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"website\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"security_contact\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"details\",\"type\":\"string\"}],\"internalType\":\"structDescription\",\"name\":\"description\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"bls_key\",\"type\":\"string\"}],\"internalType\":\"structStorageProvider\",\"name\":\"storageProvider\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operatorAddress\",\"type\":\"address\"}],\"name\":\"storageProviderPrice\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"sp_id\",\"type\":\"uint32\"},{\"internalType\":\"uint256\",\"name\":\"update_time_sec\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"read_price\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"free_read_quota\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"store_price\",\"type\":\"uint256\"}],\"internalType\":\"structSpStoragePrice\",\"name\":\"spStoragePrice\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"storageProviders\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"operator_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"funding_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"seal_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"approval_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"gc_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"maintenance_address\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"total_deposit\",\"type\":\"uint256\"},{\"internalType\":\"enumStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"endpoint\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"moniker\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"identity\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"website\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"security_contact\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"details\",\"type\":\"string\"}],\"internalType\":\"structDescription\",\"name\":\"description\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"bls_key\",\"type\":\"string\"}],\"internalType\":\"structStorageProvider[]\",\"name\":\"storageProviders\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"readPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"freeReadQuota\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"storePrice\",\"type\":\"uint256\"}],\"name\":\"updateSPPrice\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"UpdateSPPrice\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"spId\",\"type\":\"uint32\"}],\"name\":\"spChallengeStats\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"sp_id\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"challenges_received\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"challenges_succeeded\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"challenges_failed\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"heartbeats\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"total_slashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"reliability_score\",\"type\":\"uint256\"}],\"internalType\":\"structSpChallengeStats\",\"name\":\"spChallengeStats\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"}],\"name\":\"storageProvider\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"operator_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"funding_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"seal_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"approval_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"gc_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"maintenance_address\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"total_deposit\",\"type\":\"uint256\"},{\"internalType\":\"enumStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"endpoint\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"moniker\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"identity\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"website\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"security_contact\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"details\",\"type\":\"string\"}],\"internalType\":\"structDescription\",\"name\":\"description\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"bls_key\",\"type\":\"string\"}],\"internalType\":\"structStorageProvider\",\"name\":\"storageProvider\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operatorAddress\",\"type\":\"address\"}],\"name\":\"storageProviderByOperatorAddress\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"operator_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"funding_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"seal_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"approval_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"gc_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"maintenance_address\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"total_deposit\",\"type\":\"uint256\"},{\"internalType\":\"enumStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"endpoint\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"moniker\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"identity\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"website\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"security_contact\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"details\",\"type\":\"string\"}],\"internalType\":\"structDescription\",\"name\":\"description\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"bls_key\",\"type\":\"string\"}],\"internalType\":\"structStorageProvider\",\"name\":\"storageProvider\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operatorAddress\",\"type\":\"address\"}],\"name\":\"storageProviderPrice\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"sp_id\",\"type\":\"uint32\"},{\"internalType\":\"uint256\",\"name\":\"update_time_sec\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"read_price\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"free_read_quota\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"store_price\",\"type\":\"uint256\"}],\"internalType\":\"structSpStoragePrice\",\"name\":\"spStoragePrice\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"storageProviders\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"string\",\"name\":\"operator_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"funding_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"seal_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"approval_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"gc_address\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"maintenance_address\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"total_deposit\",\"type\":\"uint256\"},{\"internalType\":\"enumStatus\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"endpoint\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"moniker\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"identity\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"website\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"security_contact\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"details\",\"type\":\"string\"}],\"internalType\":\"structDescription\",\"name\":\"description\",\"type\":\"tuple\"},{\"internalType\":\"string\",\"name\":\"bls_key\",\"type\":\"string\"}],\"internalType\":\"structStorageProvider[]\",\"name\":\"storageProviders\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"readPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"freeReadQuota\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"storePrice\",\"type\":\"uint256\"}],\"name\":\"updateSPPrice\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // IStorageProviderABI is the input ABI used to generate the binding from.
@@ -229,6 +240,37 @@ func (_IStorageProvider *IStorageProviderTransactorRaw) Transact(opts *bind.Tran
 	return _IStorageProvider.Contract.contract.Transact(opts, method, params...)
 }
 
+// SpChallengeStats is a free data retrieval call binding the contract method 0x922ade70.
+//
+// Solidity: function spChallengeStats(uint32 spId) view returns((uint32,uint64,uint64,uint64,uint64,uint256,uint256) spChallengeStats)
+func (_IStorageProvider *IStorageProviderCaller) SpChallengeStats(opts *bind.CallOpts, spId uint32) (SpChallengeStats, error) {
+	var out []interface{}
+	err := _IStorageProvider.contract.Call(opts, &out, "spChallengeStats", spId)
+
+	if err != nil {
+		return *new(SpChallengeStats), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(SpChallengeStats)).(*SpChallengeStats)
+
+	return out0, err
+
+}
+
+// SpChallengeStats is a free data retrieval call binding the contract method 0x922ade70.
+//
+// Solidity: function spChallengeStats(uint32 spId) view returns((uint32,uint64,uint64,uint64,uint64,uint256,uint256) spChallengeStats)
+func (_IStorageProvider *IStorageProviderSession) SpChallengeStats(spId uint32) (SpChallengeStats, error) {
+	return _IStorageProvider.Contract.SpChallengeStats(&_IStorageProvider.CallOpts, spId)
+}
+
+// SpChallengeStats is a free data retrieval call binding the contract method 0x922ade70.
+//
+// Solidity: function spChallengeStats(uint32 spId) view returns((uint32,uint64,uint64,uint64,uint64,uint256,uint256) spChallengeStats)
+func (_IStorageProvider *IStorageProviderCallerSession) SpChallengeStats(spId uint32) (SpChallengeStats, error) {
+	return _IStorageProvider.Contract.SpChallengeStats(&_IStorageProvider.CallOpts, spId)
+}
+
 // StorageProvider is a free data retrieval call binding the contract method 0x163c507d.
 //
 // Solidity: function storageProvider(uint32 id) view returns((uint32,string,string,string,string,string,string,uint256,uint8,string,(string,string,string,string,string),string) storageProvider)
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
+	challengetypes "github.com/mocachain/moca/v2/x/challenge/types"
 	sptypes "github.com/mocachain/moca/v2/x/sp/types"
 )
 
@@ -21,6 +22,8 @@ const (
 	StorageProviderByOperatorAddressMethodName = "storageProviderByOperatorAddress"
 	// StorageProviderPriceMethodName is the ABI name for the StorageProviderPrice query.
 	StorageProviderPriceMethodName = "storageProviderPrice"
+	// SpChallengeStatsMethodName is the ABI name for the SpChallengeStats query.
+	SpChallengeStatsMethodName = "spChallengeStats"
 )
 
 // StorageProvider queries a storage provider with specify id.
@@ -109,6 +112,23 @@ func (p Precompile) QuerySpStoragePrice(ctx sdk.Context, method *abi.Method, arg
 	return method.Outputs.Pack(outputStoragePrice(&res.SpStoragePrice))
 }
 
+// SpChallengeStats queries the challenge statistics and the reliability score of a storage provider.
+func (p Precompile) SpChallengeStats(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input SpChallengeStatsArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	res, err := p.challengeQuerier.SpChallengeStats(ctx, &challengetypes.QuerySpChallengeStatsRequest{
+		SpId: input.SpID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(outputSpChallengeStats(res))
+}
+
 func outputStorageProviderInfo(sp *sptypes.StorageProvider) *StorageProvider {
 	n := &StorageProvider{
 		Id:                 sp.Id,
@@ -145,3 +165,16 @@ func outputStoragePrice(sp *sptypes.SpStoragePrice) *SpStoragePrice {
 
 	return n
 }
+
+// outputSpChallengeStats converts the challenge statistics, the reliability score is scaled by 1e18.
+func outputSpChallengeStats(res *challengetypes.QuerySpChallengeStatsResponse) *SpChallengeStats {
+	return &SpChallengeStats{
+		SpId:                res.Stats.SpId,
+		ChallengesReceived:  res.Stats.ChallengesReceived,
+		ChallengesSucceeded: res.Stats.ChallengesSucceeded,
+		ChallengesFailed:    res.Stats.ChallengesFailed,
+		Heartbeats:          res.Stats.Heartbeats,
+		TotalSlashed:        res.Stats.TotalSlashed.BigInt(),
+		ReliabilityScore:    res.ReliabilityScore.BigInt(),
+	}
+}
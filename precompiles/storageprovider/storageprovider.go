@@ -13,6 +13,7 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 
 	"github.com/mocachain/moca/v2/precompiles/types"
+	challengetypes "github.com/mocachain/moca/v2/x/challenge/types"
 	sptypes "github.com/mocachain/moca/v2/x/sp/types"
 )
 
@@ -26,16 +27,19 @@ type Precompile struct {
 	cmn.Precompile
 	abi.ABI
 
-	spMsgServer sptypes.MsgServer
-	spQuerier   sptypes.QueryServer
+	spMsgServer      sptypes.MsgServer
+	spQuerier        sptypes.QueryServer
+	challengeQuerier challengetypes.QueryServer
 }
 
 // NewPrecompile creates a new storage provider Precompile as a vm.PrecompiledContract.
-// The msg server and querier are built from the sp keeper at wiring time; the bank
-// keeper drives the StateDB balance reconciliation.
+// The msg server and querier are built from the sp keeper at wiring time, the challenge
+// querier serves the challenge track records of the sps; the bank keeper drives the
+// StateDB balance reconciliation.
 func NewPrecompile(
 	spMsgServer sptypes.MsgServer,
 	spQuerier sptypes.QueryServer,
+	challengeQuerier challengetypes.QueryServer,
 	bankKeeper bankkeeper.Keeper,
 ) *Precompile {
 	return &Precompile{
@@ -46,9 +50,10 @@ func NewPrecompile(
 			// Reconciles bank keeper coin moves with the EVM StateDB balances.
 			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
 		},
-		ABI:         spABI,
-		spMsgServer: spMsgServer,
-		spQuerier:   spQuerier,
+		ABI:              spABI,
+		spMsgServer:      spMsgServer,
+		spQuerier:        spQuerier,
+		challengeQuerier: challengeQuerier,
 	}
 }
 
@@ -112,6 +117,8 @@ func (p Precompile) Execute(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract,
 		bz, err = p.StorageProviderByOperatorAddress(ctx, method, args)
 	case StorageProviderPriceMethodName:
 		bz, err = p.QuerySpStoragePrice(ctx, method, args)
+	case SpChallengeStatsMethodName:
+		bz, err = p.SpChallengeStats(ctx, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
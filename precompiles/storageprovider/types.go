@@ -67,3 +67,7 @@ type StorageProviderByOperatorAddressArgs struct {
 type StorageProviderPriceArgs struct {
 	OperatorAddress common.Address `abi:"operatorAddress"`
 }
+
+type SpChallengeStatsArgs struct {
+	SpID uint32 `abi:"spId"`
+}
@@ -65,9 +65,16 @@ type PageResponse struct {
 	Total   uint64
 }
 
+// RebalanceMove is an auto generated low-level Go binding around an user-defined struct.
+type RebalanceMove struct {
+	GlobalVirtualGroupId uint32
+	FromSpId             uint32
+	ToSpId               uint32
+}
+
 // IVirtualGroupMetaData contains all meta data concerning the IVirtualGroup contract.
 var IVirtualGroupMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"CancelSwapIn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"CompleteSPExit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"CompleteSwapIn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"familyId\",\"type\":\"uint256\"}],\"name\":\"CompleteSwapOut\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"familyId\",\"type\":\"uint256\"}],\"name\":\"CreateGlobalVirtualGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"DeleteGlobalVirtualGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"ReserveSwapIn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"SPExit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"familyId\",\"type\":\"uint256\"}],\"name\":\"SwapOut\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"}],\"name\":\"cancelSwapIn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"operator\",\"type\":\"string\"}],\"name\":\"completeSPExit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"}],\"name\":\"completeSwapIn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"gvgIds\",\"type\":\"uint32[]\"}],\"name\":\"completeSwapOut\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"familyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"secondarySpIds\",\"type\":\"uint32[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structCoin\",\"name\":\"deposit\",\"type\":\"tuple\"}],\"name\":\"createGlobalVirtualGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"}],\"name\":\"deleteGlobalVirtualGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structCoin\",\"name\":\"deposit\",\"type\":\"tuple\"}],\"name\":\"deposit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"globalVirtualGroupFamilies\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"primarySpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"globalVirtualGroupIds\",\"type\":\"uint32[]\"},{\"internalType\":\"address\",\"name\":\"virtualPaymentAddress\",\"type\":\"address\"}],\"internalType\":\"structGlobalVirtualGroupFamily[]\",\"name\":\"gvgFamilies\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"familyId\",\"type\":\"uint32\"}],\"name\":\"globalVirtualGroupFamily\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"primarySpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"globalVirtualGroupIds\",\"type\":\"uint32[]\"},{\"internalType\":\"address\",\"name\":\"virtualPaymentAddress\",\"type\":\"address\"}],\"internalType\":\"structGlobalVirtualGroupFamily\",\"name\":\"gvgfamily\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"targetSpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"}],\"name\":\"reserveSwapIn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"spExit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"gvgIds\",\"type\":\"uint32[]\"},{\"internalType\":\"uint32\",\"name\":\"successorSpId\",\"type\":\"uint32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"expiredHeight\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"sig\",\"type\":\"bytes\"}],\"internalType\":\"structApproval\",\"name\":\"successorSpApproval\",\"type\":\"tuple\"}],\"name\":\"swapOut\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"BidSwapIn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"CancelSwapIn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"CompleteSPExit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"CompleteSwapIn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"familyId\",\"type\":\"uint256\"}],\"name\":\"CompleteSwapOut\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"familyId\",\"type\":\"uint256\"}],\"name\":\"CreateGlobalVirtualGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"DeleteGlobalVirtualGroup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"ReserveSwapIn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"}],\"name\":\"SPExit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"submitter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"planId\",\"type\":\"uint256\"}],\"name\":\"SubmitRebalancePlan\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"storageProvider\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"familyId\",\"type\":\"uint256\"}],\"name\":\"SwapOut\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"targetSpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint64\",\"name\":\"capacity\",\"type\":\"uint64\"}],\"name\":\"bidSwapIn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"}],\"name\":\"cancelSwapIn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"operator\",\"type\":\"string\"}],\"name\":\"completeSPExit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"}],\"name\":\"completeSwapIn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"gvgIds\",\"type\":\"uint32[]\"}],\"name\":\"completeSwapOut\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"familyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"secondarySpIds\",\"type\":\"uint32[]\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structCoin\",\"name\":\"deposit\",\"type\":\"tuple\"}],\"name\":\"createGlobalVirtualGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"}],\"name\":\"deleteGlobalVirtualGroup\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structCoin\",\"name\":\"deposit\",\"type\":\"tuple\"}],\"name\":\"deposit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes\",\"name\":\"key\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"offset\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"limit\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"countTotal\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"reverse\",\"type\":\"bool\"}],\"internalType\":\"structPageRequest\",\"name\":\"pagination\",\"type\":\"tuple\"}],\"name\":\"globalVirtualGroupFamilies\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"primarySpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"globalVirtualGroupIds\",\"type\":\"uint32[]\"},{\"internalType\":\"address\",\"name\":\"virtualPaymentAddress\",\"type\":\"address\"}],\"internalType\":\"structGlobalVirtualGroupFamily[]\",\"name\":\"gvgFamilies\",\"type\":\"tuple[]\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"nextKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"total\",\"type\":\"uint64\"}],\"internalType\":\"structPageResponse\",\"name\":\"pageResponse\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"familyId\",\"type\":\"uint32\"}],\"name\":\"globalVirtualGroupFamily\",\"outputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"id\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"primarySpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"globalVirtualGroupIds\",\"type\":\"uint32[]\"},{\"internalType\":\"address\",\"name\":\"virtualPaymentAddress\",\"type\":\"address\"}],\"internalType\":\"structGlobalVirtualGroupFamily\",\"name\":\"gvgfamily\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"targetSpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"}],\"name\":\"reserveSwapIn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"spExit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"fromSpId\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"toSpId\",\"type\":\"uint32\"}],\"internalType\":\"structRebalanceMove[]\",\"name\":\"moves\",\"type\":\"tuple[]\"}],\"name\":\"submitRebalancePlan\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"gvgFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"uint32[]\",\"name\":\"gvgIds\",\"type\":\"uint32[]\"},{\"internalType\":\"uint32\",\"name\":\"successorSpId\",\"type\":\"uint32\"},{\"components\":[{\"internalType\":\"uint64\",\"name\":\"expiredHeight\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"globalVirtualGroupFamilyId\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"sig\",\"type\":\"bytes\"}],\"internalType\":\"structApproval\",\"name\":\"successorSpApproval\",\"type\":\"tuple\"}],\"name\":\"swapOut\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // IVirtualGroupABI is the input ABI used to generate the binding from.
@@ -292,6 +299,27 @@ func (_IVirtualGroup *IVirtualGroupCallerSession) GlobalVirtualGroupFamily(famil
 	return _IVirtualGroup.Contract.GlobalVirtualGroupFamily(&_IVirtualGroup.CallOpts, familyId)
 }
 
+// BidSwapIn is a paid mutator transaction binding the contract method 0x14793fe5.
+//
+// Solidity: function bidSwapIn(uint32 targetSpId, uint32 gvgFamilyId, uint32 globalVirtualGroupId, uint64 capacity) returns(bool success)
+func (_IVirtualGroup *IVirtualGroupTransactor) BidSwapIn(opts *bind.TransactOpts, targetSpId uint32, gvgFamilyId uint32, globalVirtualGroupId uint32, capacity uint64) (*types.Transaction, error) {
+	return _IVirtualGroup.contract.Transact(opts, "bidSwapIn", targetSpId, gvgFamilyId, globalVirtualGroupId, capacity)
+}
+
+// BidSwapIn is a paid mutator transaction binding the contract method 0x14793fe5.
+//
+// Solidity: function bidSwapIn(uint32 targetSpId, uint32 gvgFamilyId, uint32 globalVirtualGroupId, uint64 capacity) returns(bool success)
+func (_IVirtualGroup *IVirtualGroupSession) BidSwapIn(targetSpId uint32, gvgFamilyId uint32, globalVirtualGroupId uint32, capacity uint64) (*types.Transaction, error) {
+	return _IVirtualGroup.Contract.BidSwapIn(&_IVirtualGroup.TransactOpts, targetSpId, gvgFamilyId, globalVirtualGroupId, capacity)
+}
+
+// BidSwapIn is a paid mutator transaction binding the contract method 0x14793fe5.
+//
+// Solidity: function bidSwapIn(uint32 targetSpId, uint32 gvgFamilyId, uint32 globalVirtualGroupId, uint64 capacity) returns(bool success)
+func (_IVirtualGroup *IVirtualGroupTransactorSession) BidSwapIn(targetSpId uint32, gvgFamilyId uint32, globalVirtualGroupId uint32, capacity uint64) (*types.Transaction, error) {
+	return _IVirtualGroup.Contract.BidSwapIn(&_IVirtualGroup.TransactOpts, targetSpId, gvgFamilyId, globalVirtualGroupId, capacity)
+}
+
 // CancelSwapIn is a paid mutator transaction binding the contract method 0x460b4ce3.
 //
 // Solidity: function cancelSwapIn(uint32 gvgFamilyId, uint32 globalVirtualGroupId) returns(bool success)
@@ -481,6 +509,27 @@ func (_IVirtualGroup *IVirtualGroupTransactorSession) SpExit() (*types.Transacti
 	return _IVirtualGroup.Contract.SpExit(&_IVirtualGroup.TransactOpts)
 }
 
+// SubmitRebalancePlan is a paid mutator transaction binding the contract method 0x77c0571e.
+//
+// Solidity: function submitRebalancePlan((uint32,uint32,uint32)[] moves) returns(bool success)
+func (_IVirtualGroup *IVirtualGroupTransactor) SubmitRebalancePlan(opts *bind.TransactOpts, moves []RebalanceMove) (*types.Transaction, error) {
+	return _IVirtualGroup.contract.Transact(opts, "submitRebalancePlan", moves)
+}
+
+// SubmitRebalancePlan is a paid mutator transaction binding the contract method 0x77c0571e.
+//
+// Solidity: function submitRebalancePlan((uint32,uint32,uint32)[] moves) returns(bool success)
+func (_IVirtualGroup *IVirtualGroupSession) SubmitRebalancePlan(moves []RebalanceMove) (*types.Transaction, error) {
+	return _IVirtualGroup.Contract.SubmitRebalancePlan(&_IVirtualGroup.TransactOpts, moves)
+}
+
+// SubmitRebalancePlan is a paid mutator transaction binding the contract method 0x77c0571e.
+//
+// Solidity: function submitRebalancePlan((uint32,uint32,uint32)[] moves) returns(bool success)
+func (_IVirtualGroup *IVirtualGroupTransactorSession) SubmitRebalancePlan(moves []RebalanceMove) (*types.Transaction, error) {
+	return _IVirtualGroup.Contract.SubmitRebalancePlan(&_IVirtualGroup.TransactOpts, moves)
+}
+
 // SwapOut is a paid mutator transaction binding the contract method 0x2cb3f1d6.
 //
 // Solidity: function swapOut(uint32 gvgFamilyId, uint32[] gvgIds, uint32 successorSpId, (uint64,uint32,bytes) successorSpApproval) returns(bool success)
@@ -502,6 +551,150 @@ func (_IVirtualGroup *IVirtualGroupTransactorSession) SwapOut(gvgFamilyId uint32
 	return _IVirtualGroup.Contract.SwapOut(&_IVirtualGroup.TransactOpts, gvgFamilyId, gvgIds, successorSpId, successorSpApproval)
 }
 
+// IVirtualGroupBidSwapInIterator is returned from FilterBidSwapIn and is used to iterate over the raw logs and unpacked data for BidSwapIn events raised by the IVirtualGroup contract.
+type IVirtualGroupBidSwapInIterator struct {
+	Event *IVirtualGroupBidSwapIn // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVirtualGroupBidSwapInIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVirtualGroupBidSwapIn)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVirtualGroupBidSwapIn)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVirtualGroupBidSwapInIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVirtualGroupBidSwapInIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVirtualGroupBidSwapIn represents a BidSwapIn event raised by the IVirtualGroup contract.
+type IVirtualGroupBidSwapIn struct {
+	StorageProvider common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterBidSwapIn is a free log retrieval operation binding the contract event 0xd61f0a6984446073f69e20eb04eddf7ac5ab697f24bb31b1aac8ca1e96df2a26.
+//
+// Solidity: event BidSwapIn(address indexed storageProvider)
+func (_IVirtualGroup *IVirtualGroupFilterer) FilterBidSwapIn(opts *bind.FilterOpts, storageProvider []common.Address) (*IVirtualGroupBidSwapInIterator, error) {
+
+	var storageProviderRule []interface{}
+	for _, storageProviderItem := range storageProvider {
+		storageProviderRule = append(storageProviderRule, storageProviderItem)
+	}
+
+	logs, sub, err := _IVirtualGroup.contract.FilterLogs(opts, "BidSwapIn", storageProviderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVirtualGroupBidSwapInIterator{contract: _IVirtualGroup.contract, event: "BidSwapIn", logs: logs, sub: sub}, nil
+}
+
+// WatchBidSwapIn is a free log subscription operation binding the contract event 0xd61f0a6984446073f69e20eb04eddf7ac5ab697f24bb31b1aac8ca1e96df2a26.
+//
+// Solidity: event BidSwapIn(address indexed storageProvider)
+func (_IVirtualGroup *IVirtualGroupFilterer) WatchBidSwapIn(opts *bind.WatchOpts, sink chan<- *IVirtualGroupBidSwapIn, storageProvider []common.Address) (event.Subscription, error) {
+
+	var storageProviderRule []interface{}
+	for _, storageProviderItem := range storageProvider {
+		storageProviderRule = append(storageProviderRule, storageProviderItem)
+	}
+
+	logs, sub, err := _IVirtualGroup.contract.WatchLogs(opts, "BidSwapIn", storageProviderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVirtualGroupBidSwapIn)
+				if err := _IVirtualGroup.contract.UnpackLog(event, "BidSwapIn", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBidSwapIn is a log parse operation binding the contract event 0xd61f0a6984446073f69e20eb04eddf7ac5ab697f24bb31b1aac8ca1e96df2a26.
+//
+// Solidity: event BidSwapIn(address indexed storageProvider)
+func (_IVirtualGroup *IVirtualGroupFilterer) ParseBidSwapIn(log types.Log) (*IVirtualGroupBidSwapIn, error) {
+	event := new(IVirtualGroupBidSwapIn)
+	if err := _IVirtualGroup.contract.UnpackLog(event, "BidSwapIn", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // IVirtualGroupCancelSwapInIterator is returned from FilterCancelSwapIn and is used to iterate over the raw logs and unpacked data for CancelSwapIn events raised by the IVirtualGroup contract.
 type IVirtualGroupCancelSwapInIterator struct {
 	Event *IVirtualGroupCancelSwapIn // Event containing the contract specifics and raw log
@@ -1809,6 +2002,151 @@ func (_IVirtualGroup *IVirtualGroupFilterer) ParseSPExit(log types.Log) (*IVirtu
 	return event, nil
 }
 
+// IVirtualGroupSubmitRebalancePlanIterator is returned from FilterSubmitRebalancePlan and is used to iterate over the raw logs and unpacked data for SubmitRebalancePlan events raised by the IVirtualGroup contract.
+type IVirtualGroupSubmitRebalancePlanIterator struct {
+	Event *IVirtualGroupSubmitRebalancePlan // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVirtualGroupSubmitRebalancePlanIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVirtualGroupSubmitRebalancePlan)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVirtualGroupSubmitRebalancePlan)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVirtualGroupSubmitRebalancePlanIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVirtualGroupSubmitRebalancePlanIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVirtualGroupSubmitRebalancePlan represents a SubmitRebalancePlan event raised by the IVirtualGroup contract.
+type IVirtualGroupSubmitRebalancePlan struct {
+	Submitter common.Address
+	PlanId    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterSubmitRebalancePlan is a free log retrieval operation binding the contract event 0x17ae3469cb5bda70bdb6b0cf496e648ed17764a975887ddb61ed96ae2c055594.
+//
+// Solidity: event SubmitRebalancePlan(address indexed submitter, uint256 planId)
+func (_IVirtualGroup *IVirtualGroupFilterer) FilterSubmitRebalancePlan(opts *bind.FilterOpts, submitter []common.Address) (*IVirtualGroupSubmitRebalancePlanIterator, error) {
+
+	var submitterRule []interface{}
+	for _, submitterItem := range submitter {
+		submitterRule = append(submitterRule, submitterItem)
+	}
+
+	logs, sub, err := _IVirtualGroup.contract.FilterLogs(opts, "SubmitRebalancePlan", submitterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVirtualGroupSubmitRebalancePlanIterator{contract: _IVirtualGroup.contract, event: "SubmitRebalancePlan", logs: logs, sub: sub}, nil
+}
+
+// WatchSubmitRebalancePlan is a free log subscription operation binding the contract event 0x17ae3469cb5bda70bdb6b0cf496e648ed17764a975887ddb61ed96ae2c055594.
+//
+// Solidity: event SubmitRebalancePlan(address indexed submitter, uint256 planId)
+func (_IVirtualGroup *IVirtualGroupFilterer) WatchSubmitRebalancePlan(opts *bind.WatchOpts, sink chan<- *IVirtualGroupSubmitRebalancePlan, submitter []common.Address) (event.Subscription, error) {
+
+	var submitterRule []interface{}
+	for _, submitterItem := range submitter {
+		submitterRule = append(submitterRule, submitterItem)
+	}
+
+	logs, sub, err := _IVirtualGroup.contract.WatchLogs(opts, "SubmitRebalancePlan", submitterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVirtualGroupSubmitRebalancePlan)
+				if err := _IVirtualGroup.contract.UnpackLog(event, "SubmitRebalancePlan", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSubmitRebalancePlan is a log parse operation binding the contract event 0x17ae3469cb5bda70bdb6b0cf496e648ed17764a975887ddb61ed96ae2c055594.
+//
+// Solidity: event SubmitRebalancePlan(address indexed submitter, uint256 planId)
+func (_IVirtualGroup *IVirtualGroupFilterer) ParseSubmitRebalancePlan(log types.Log) (*IVirtualGroupSubmitRebalancePlan, error) {
+	event := new(IVirtualGroupSubmitRebalancePlan)
+	if err := _IVirtualGroup.contract.UnpackLog(event, "SubmitRebalancePlan", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // IVirtualGroupSwapOutIterator is returned from FilterSwapOut and is used to iterate over the raw logs and unpacked data for SwapOut events raised by the IVirtualGroup contract.
 type IVirtualGroupSwapOutIterator struct {
 	Event *IVirtualGroupSwapOut // Event containing the contract specifics and raw log
@@ -32,6 +32,10 @@ const (
 	CompleteSwapInEventName = "CompleteSwapIn"
 	// CancelSwapInEventName is the event emitted on a cancelSwapIn transaction.
 	CancelSwapInEventName = "CancelSwapIn"
+	// BidSwapInEventName is the event emitted on a bidSwapIn transaction.
+	BidSwapInEventName = "BidSwapIn"
+	// SubmitRebalancePlanEventName is the event emitted on a submitRebalancePlan transaction.
+	SubmitRebalancePlanEventName = "SubmitRebalancePlan"
 )
 
 // EmitCreateGlobalVirtualGroupEvent emits the CreateGlobalVirtualGroup event with the
@@ -102,6 +106,19 @@ func (p Precompile) EmitCancelSwapInEvent(evm *vm.EVM, caller common.Address) er
 		[]common.Hash{common.BytesToHash(caller.Bytes())})
 }
 
+// EmitBidSwapInEvent emits the BidSwapIn event with the caller as the sole indexed topic.
+func (p Precompile) EmitBidSwapInEvent(evm *vm.EVM, caller common.Address) error {
+	return p.AddLog(evm, MustEvent(BidSwapInEventName),
+		[]common.Hash{common.BytesToHash(caller.Bytes())})
+}
+
+// EmitSubmitRebalancePlanEvent emits the SubmitRebalancePlan event with the
+// caller as an indexed topic and the plan id as data.
+func (p Precompile) EmitSubmitRebalancePlanEvent(evm *vm.EVM, caller common.Address, planID *big.Int) error {
+	return p.AddLog(evm, MustEvent(SubmitRebalancePlanEventName),
+		[]common.Hash{common.BytesToHash(caller.Bytes())}, planID)
+}
+
 // AddLog packs the given event and appends it to the StateDB logs at the precompile address.
 func (p Precompile) AddLog(evm *vm.EVM, event abi.Event, topics []common.Hash, args ...interface{}) error {
 	data, packedTopics, err := types.PackTopicData(event, topics, args...)
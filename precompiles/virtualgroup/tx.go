@@ -33,6 +33,10 @@ const (
 	CompleteSwapInMethodName = "completeSwapIn"
 	// CancelSwapInMethodName is the ABI name for the cancelSwapIn transaction.
 	CancelSwapInMethodName = "cancelSwapIn"
+	// BidSwapInMethodName is the ABI name for the bidSwapIn transaction.
+	BidSwapInMethodName = "bidSwapIn"
+	// SubmitRebalancePlanMethodName is the ABI name for the submitRebalancePlan transaction.
+	SubmitRebalancePlanMethodName = "submitRebalancePlan"
 )
 
 // CreateGlobalVirtualGroup defines a method for sp create a global virtual group.
@@ -325,3 +329,69 @@ func (p Precompile) CancelSwapIn(ctx sdk.Context, evm *vm.EVM, contract *vm.Cont
 
 	return method.Outputs.Pack(true)
 }
+
+// BidSwapIn defines a method for sp to bid for the swap in auction of a forced exiting sp.
+func (p Precompile) BidSwapIn(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input BidSwapInArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	msg := &virtualgrouptypes.MsgBidSwapIn{
+		StorageProvider:            contract.Caller().String(),
+		TargetSpId:                 input.TargetSpID,
+		GlobalVirtualGroupFamilyId: input.GvgFamilyID,
+		GlobalVirtualGroupId:       input.GlobalVirtualGroupID,
+		Capacity:                   input.Capacity,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.virtualGroupMsgServer.BidSwapIn(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitBidSwapInEvent(evm, contract.Caller()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SubmitRebalancePlan defines a method for sp to submit a plan of moving gvgs between secondary sps.
+func (p Precompile) SubmitRebalancePlan(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	var input SubmitRebalancePlanArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, err
+	}
+
+	moves := make([]virtualgrouptypes.RebalanceMove, 0, len(input.Moves))
+	for _, move := range input.Moves {
+		moves = append(moves, virtualgrouptypes.RebalanceMove{
+			GlobalVirtualGroupId: move.GlobalVirtualGroupId,
+			FromSpId:             move.FromSpId,
+			ToSpId:               move.ToSpId,
+		})
+	}
+	msg := &virtualgrouptypes.MsgSubmitRebalancePlan{
+		Submitter: contract.Caller().String(),
+		Moves:     moves,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.virtualGroupMsgServer.SubmitRebalancePlan(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitSubmitRebalancePlanEvent(evm, contract.Caller(), new(big.Int).SetUint64(res.PlanId)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
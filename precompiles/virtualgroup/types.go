@@ -123,6 +123,19 @@ type CancelSwapInArgs struct {
 	GlobalVirtualGroupID uint32 `abi:"globalVirtualGroupId"`
 }
 
+// BidSwapInArgs is the decode target for the bidSwapIn calldata.
+type BidSwapInArgs struct {
+	TargetSpID           uint32 `abi:"targetSpId"`
+	GvgFamilyID          uint32 `abi:"gvgFamilyId"`
+	GlobalVirtualGroupID uint32 `abi:"globalVirtualGroupId"`
+	Capacity             uint64 `abi:"capacity"`
+}
+
+// SubmitRebalancePlanArgs is the decode target for the submitRebalancePlan calldata.
+type SubmitRebalancePlanArgs struct {
+	Moves []RebalanceMove `abi:"moves"`
+}
+
 // GlobalVirtualGroupFamilyArgs is the decode target for the globalVirtualGroupFamily calldata.
 type GlobalVirtualGroupFamilyArgs struct {
 	FamilyID uint32 `abi:"familyId"`
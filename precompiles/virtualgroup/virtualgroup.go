@@ -125,6 +125,10 @@ func (p Precompile) Execute(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract,
 		bz, err = p.CompleteSwapIn(ctx, evm, contract, method, args)
 	case CancelSwapInMethodName:
 		bz, err = p.CancelSwapIn(ctx, evm, contract, method, args)
+	case BidSwapInMethodName:
+		bz, err = p.BidSwapIn(ctx, evm, contract, method, args)
+	case SubmitRebalancePlanMethodName:
+		bz, err = p.SubmitRebalancePlan(ctx, evm, contract, method, args)
 	// Virtualgroup queries
 	case GlobalVirtualGroupFamiliesMethodName:
 		bz, err = p.GlobalVirtualGroupFamilies(ctx, method, args)
@@ -149,7 +153,9 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		DepositMethodName,
 		ReserveSwapInMethodName,
 		CompleteSwapInMethodName,
-		CancelSwapInMethodName:
+		CancelSwapInMethodName,
+		BidSwapInMethodName,
+		SubmitRebalancePlanMethodName:
 		return true
 	default:
 		return false
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+
+	"github.com/mocachain/moca/v2/sdk/keys"
+	"github.com/mocachain/moca/v2/types/common"
+	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// Approver gets the approval of the primary sp for the bucket and object creations. The expired height of the
+// approval is set on the msg before it is approved, the approver sets the signed approval on the msg.
+type Approver interface {
+	// ApproveCreateBucket sets the approval of the primary sp for the bucket creation.
+	ApproveCreateBucket(ctx context.Context, msg *storagetypes.MsgCreateBucket) error
+	// ApproveCreateObject sets the approval of the primary sp for the object creation.
+	ApproveCreateObject(ctx context.Context, msg *storagetypes.MsgCreateObject) error
+}
+
+// KeyManagerApprover signs the approvals with the approval key of the primary sp, it is meant for the sp itself and
+// for the local networks, the other clients get the approvals from the sp.
+type KeyManagerApprover struct {
+	// ApprovalKey is the key of the approval address of the primary sp.
+	ApprovalKey keys.KeyManager
+	// GlobalVirtualGroupFamilyID is the global virtual group family the buckets are created in.
+	GlobalVirtualGroupFamilyID uint32
+}
+
+// NewKeyManagerApprover returns an Approver which signs the approvals with the approval key of the primary sp.
+func NewKeyManagerApprover(approvalKey keys.KeyManager, familyID uint32) *KeyManagerApprover {
+	return &KeyManagerApprover{
+		ApprovalKey:                approvalKey,
+		GlobalVirtualGroupFamilyID: familyID,
+	}
+}
+
+// ApproveCreateBucket implements the Approver interface.
+func (a *KeyManagerApprover) ApproveCreateBucket(_ context.Context, msg *storagetypes.MsgCreateBucket) error {
+	msg.PrimarySpApproval = &common.Approval{
+		ExpiredHeight:              msg.PrimarySpApproval.GetExpiredHeight(),
+		GlobalVirtualGroupFamilyId: a.GlobalVirtualGroupFamilyID,
+	}
+	sig, err := a.ApprovalKey.Sign(msg.GetApprovalBytes())
+	if err != nil {
+		return err
+	}
+	msg.PrimarySpApproval.Sig = sig
+	return nil
+}
+
+// ApproveCreateObject implements the Approver interface.
+func (a *KeyManagerApprover) ApproveCreateObject(_ context.Context, msg *storagetypes.MsgCreateObject) error {
+	msg.PrimarySpApproval = &common.Approval{
+		ExpiredHeight: msg.PrimarySpApproval.GetExpiredHeight(),
+	}
+	sig, err := a.ApprovalKey.Sign(msg.GetApprovalBytes())
+	if err != nil {
+		return err
+	}
+	msg.PrimarySpApproval.Sig = sig
+	return nil
+}
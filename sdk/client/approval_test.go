@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mocachain/moca/v2/sdk/client/test"
+	"github.com/mocachain/moca/v2/sdk/keys"
+	gnfdtypes "github.com/mocachain/moca/v2/types"
+	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func TestKeyManagerApprover(t *testing.T) {
+	km, err := keys.NewPrivateKeyManager(test.TestPrivateKey)
+	assert.NoError(t, err)
+	approver := NewKeyManagerApprover(km, 3)
+
+	bucketMsg := storagetypes.NewMsgCreateBucket(km.GetAddr(), "bucket", storagetypes.VISIBILITY_TYPE_PRIVATE,
+		km.GetAddr(), nil, 100, nil, 0)
+	assert.NoError(t, approver.ApproveCreateBucket(context.Background(), bucketMsg))
+	assert.Equal(t, uint64(100), bucketMsg.PrimarySpApproval.ExpiredHeight)
+	assert.Equal(t, uint32(3), bucketMsg.PrimarySpApproval.GlobalVirtualGroupFamilyId)
+	assert.NoError(t, gnfdtypes.VerifySignature(km.GetAddr(), crypto.Keccak256(bucketMsg.GetApprovalBytes()),
+		bucketMsg.PrimarySpApproval.Sig))
+
+	objectMsg := storagetypes.NewMsgCreateObject(km.GetAddr(), "bucket", "object", 10,
+		storagetypes.VISIBILITY_TYPE_INHERIT, nil, "", storagetypes.REDUNDANCY_EC_TYPE, 100, nil)
+	assert.NoError(t, approver.ApproveCreateObject(context.Background(), objectMsg))
+	assert.Equal(t, uint64(100), objectMsg.PrimarySpApproval.ExpiredHeight)
+	assert.NoError(t, gnfdtypes.VerifySignature(km.GetAddr(), crypto.Keccak256(objectMsg.GetApprovalBytes()),
+		objectMsg.PrimarySpApproval.Sig))
+
+	// the approval is bound to the msg
+	objectMsg.PayloadSize = 11
+	assert.Error(t, gnfdtypes.VerifySignature(km.GetAddr(), crypto.Keccak256(objectMsg.GetApprovalBytes()),
+		objectMsg.PrimarySpApproval.Sig))
+}
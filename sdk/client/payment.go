@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/sdk/types"
+	paymenttypes "github.com/mocachain/moca/v2/x/payment/types"
+)
+
+// Deposit deposits the amount of amoca to the stream account, which is either the sender or one of its payment
+// accounts. It returns the tx hash once the tx is committed.
+func (c *MocaClient) Deposit(ctx context.Context, to sdk.AccAddress, amount sdkmath.Int, txOpt *types.TxOption) (string, error) {
+	creator, err := c.senderAddr(txOpt)
+	if err != nil {
+		return "", err
+	}
+	msg := paymenttypes.NewMsgDeposit(creator.String(), to.String(), amount)
+	return c.broadcastAndWait(ctx, []sdk.Msg{msg}, txOpt)
+}
+
+// Withdraw withdraws the amount of amoca from the stream account to the sender. It returns the tx hash once the tx is
+// committed.
+func (c *MocaClient) Withdraw(ctx context.Context, from sdk.AccAddress, amount sdkmath.Int, txOpt *types.TxOption) (string, error) {
+	creator, err := c.senderAddr(txOpt)
+	if err != nil {
+		return "", err
+	}
+	msg := paymenttypes.NewMsgWithdraw(creator.String(), from.String(), amount)
+	return c.broadcastAndWait(ctx, []sdk.Msg{msg}, txOpt)
+}
+
+// GetStreamRecord returns the stream record of the account.
+func (c *MocaClient) GetStreamRecord(ctx context.Context, account sdk.AccAddress) (*paymenttypes.StreamRecord, error) {
+	resp, err := c.PaymentQueryClient.StreamRecord(ctx, &paymenttypes.QueryGetStreamRecordRequest{
+		Account: account.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	streamRecord := resp.GetStreamRecord()
+	return &streamRecord, nil
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/sdk/client/test"
+	"github.com/mocachain/moca/v2/sdk/keys"
+	paymenttypes "github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func TestDeposit(t *testing.T) {
+	c, txClient := newFakeMocaClient(t)
+	km, err := keys.NewPrivateKeyManager(test.TestPrivateKey)
+	require.NoError(t, err)
+	to := sdk.MustAccAddressFromHex(test.TestAddr)
+
+	txHash, err := c.Deposit(context.Background(), to, sdkmath.NewInt(100), fakeTxOption())
+	require.NoError(t, err)
+	assert.Contains(t, txClient.txs, txHash)
+
+	msgs := txClient.msgs(t)
+	require.Len(t, msgs, 1)
+	msg, ok := msgs[0].(*paymenttypes.MsgDeposit)
+	require.True(t, ok)
+	assert.Equal(t, km.GetAddr().String(), msg.Creator)
+	assert.Equal(t, to.String(), msg.To)
+	assert.True(t, sdkmath.NewInt(100).Equal(msg.Amount))
+
+	// the invalid amount is not broadcast
+	_, err = c.Deposit(context.Background(), to, sdkmath.ZeroInt(), fakeTxOption())
+	assert.Error(t, err)
+	assert.Len(t, txClient.txs, 1)
+
+	// the tx failed on chain is returned with the error of the payment module
+	txClient.err = &sdk.TxResponse{
+		Codespace: paymenttypes.ModuleName,
+		Code:      paymenttypes.ErrInsufficientBalance.ABCICode(),
+	}
+	txHash, err = c.Deposit(context.Background(), to, sdkmath.NewInt(200), fakeTxOption())
+	assert.ErrorIs(t, err, paymenttypes.ErrInsufficientBalance)
+	assert.Contains(t, txClient.txs, txHash)
+}
@@ -18,8 +18,11 @@ import (
 	"github.com/mocachain/moca/v2/precompiles/distribution"
 	"github.com/mocachain/moca/v2/precompiles/gov"
 	"github.com/mocachain/moca/v2/precompiles/payment"
+	"github.com/mocachain/moca/v2/precompiles/permission"
 	"github.com/mocachain/moca/v2/precompiles/staking"
 	"github.com/mocachain/moca/v2/precompiles/storage"
+	"github.com/mocachain/moca/v2/precompiles/storageprovider"
+	"github.com/mocachain/moca/v2/precompiles/virtualgroup"
 )
 
 func CreateTxOpts(ctx context.Context, client *ethclient.Client, hexPrivateKey string, chain *big.Int, gasLimit uint64, nonce uint64) (*bind.TransactOpts, error) {
@@ -153,6 +156,51 @@ func CreateStakingSession(client *ethclient.Client, txOpts bind.TransactOpts, co
 	return session, nil
 }
 
+func CreateStorageProviderSession(client *ethclient.Client, txOpts bind.TransactOpts, contractAddress string) (*storageprovider.IStorageProviderSession, error) {
+	storageProviderContract, err := storageprovider.NewIStorageProvider(common.HexToAddress(contractAddress), client)
+	if err != nil {
+		return nil, err
+	}
+	session := &storageprovider.IStorageProviderSession{
+		Contract: storageProviderContract,
+		CallOpts: bind.CallOpts{
+			Pending: false,
+		},
+		TransactOpts: txOpts,
+	}
+	return session, nil
+}
+
+func CreateVirtualGroupSession(client *ethclient.Client, txOpts bind.TransactOpts, contractAddress string) (*virtualgroup.IVirtualGroupSession, error) {
+	virtualGroupContract, err := virtualgroup.NewIVirtualGroup(common.HexToAddress(contractAddress), client)
+	if err != nil {
+		return nil, err
+	}
+	session := &virtualgroup.IVirtualGroupSession{
+		Contract: virtualGroupContract,
+		CallOpts: bind.CallOpts{
+			Pending: false,
+		},
+		TransactOpts: txOpts,
+	}
+	return session, nil
+}
+
+func CreatePermissionSession(client *ethclient.Client, txOpts bind.TransactOpts, contractAddress string) (*permission.IPermissionSession, error) {
+	permissionContract, err := permission.NewIPermission(common.HexToAddress(contractAddress), client)
+	if err != nil {
+		return nil, err
+	}
+	session := &permission.IPermissionSession{
+		Contract: permissionContract,
+		CallOpts: bind.CallOpts{
+			Pending: false,
+		},
+		TransactOpts: txOpts,
+	}
+	return session, nil
+}
+
 // GetLatestBlockHeight - Get the height of the latest block from the chain.
 //
 // - ctx: Context variables for the current API call.
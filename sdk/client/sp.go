@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/sdk/types"
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+)
+
+// GetStorageProvider returns the storage provider of the operator address.
+func (c *MocaClient) GetStorageProvider(ctx context.Context, operatorAddress sdk.AccAddress) (*sptypes.StorageProvider, error) {
+	resp, err := c.SpQueryClient.StorageProviderByOperatorAddress(ctx, &sptypes.QueryStorageProviderByOperatorAddressRequest{
+		OperatorAddress: operatorAddress.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.StorageProvider, nil
+}
+
+// DepositToStorageProvider deposits the coin to the storage provider from the sender, which is the funding address of
+// the sp. It returns the tx hash once the tx is committed.
+func (c *MocaClient) DepositToStorageProvider(ctx context.Context, operatorAddress sdk.AccAddress, deposit sdk.Coin,
+	txOpt *types.TxOption,
+) (string, error) {
+	fundAddress, err := c.senderAddr(txOpt)
+	if err != nil {
+		return "", err
+	}
+	msg := sptypes.NewMsgDeposit(fundAddress, operatorAddress, deposit)
+	return c.broadcastAndWait(ctx, []sdk.Msg{msg}, txOpt)
+}
+
+// UpdateStorageProviderStatus updates the status of the storage provider operated by the sender, the duration in
+// seconds only applies to the maintenance status. It returns the tx hash once the tx is committed.
+func (c *MocaClient) UpdateStorageProviderStatus(ctx context.Context, status sptypes.Status, duration int64,
+	txOpt *types.TxOption,
+) (string, error) {
+	operator, err := c.senderAddr(txOpt)
+	if err != nil {
+		return "", err
+	}
+	msg := sptypes.NewMsgUpdateStorageProviderStatus(operator, status, duration)
+	return c.broadcastAndWait(ctx, []sdk.Msg{msg}, txOpt)
+}
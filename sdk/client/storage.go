@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/sdk/types"
+	permtypes "github.com/mocachain/moca/v2/x/permission/types"
+	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// CreateBucketOptions indicates the options of the bucket creation.
+type CreateBucketOptions struct {
+	// Visibility of the bucket, VISIBILITY_TYPE_PRIVATE if unspecified.
+	Visibility storagetypes.VisibilityType
+	// PaymentAddress is the payment account of the bucket, the creator if empty.
+	PaymentAddress sdk.AccAddress
+	// ChargedReadQuota is the read quota of the bucket in bytes.
+	ChargedReadQuota uint64
+	// ExpiredHeight is the height the approval of the primary sp expires at, the latest height plus
+	// types.DefaultApprovalExpiredHeightGap if zero.
+	ExpiredHeight uint64
+	// TxOpts is the options of the tx.
+	TxOpts *types.TxOption
+}
+
+// CreateObjectOptions indicates the options of the object creation.
+type CreateObjectOptions struct {
+	// Visibility of the object, VISIBILITY_TYPE_INHERIT if unspecified.
+	Visibility storagetypes.VisibilityType
+	// ContentType of the object.
+	ContentType string
+	// RedundancyType of the object, REDUNDANCY_EC_TYPE by default.
+	RedundancyType storagetypes.RedundancyType
+	// ExpiredHeight is the height the approval of the primary sp expires at, the latest height plus
+	// types.DefaultApprovalExpiredHeightGap if zero.
+	ExpiredHeight uint64
+	// TxOpts is the options of the tx.
+	TxOpts *types.TxOption
+}
+
+// PutPolicyOptions indicates the options of putting a policy.
+type PutPolicyOptions struct {
+	// ExpirationTime of the policy, the policy never expires if nil.
+	ExpirationTime *time.Time
+	// TxOpts is the options of the tx.
+	TxOpts *types.TxOption
+}
+
+// CreateBucket creates a bucket on the primary sp, the approval of the primary sp is got from the approver. It returns
+// the tx hash once the tx is committed.
+func (c *MocaClient) CreateBucket(ctx context.Context, bucketName string, primarySpAddress sdk.AccAddress,
+	approver Approver, opts CreateBucketOptions,
+) (string, error) {
+	if approver == nil {
+		return "", types.ErrApproverNotProvided
+	}
+	creator, err := c.senderAddr(opts.TxOpts)
+	if err != nil {
+		return "", err
+	}
+	expiredHeight, err := c.approvalExpiredHeight(ctx, opts.ExpiredHeight)
+	if err != nil {
+		return "", err
+	}
+	visibility := opts.Visibility
+	if visibility == storagetypes.VISIBILITY_TYPE_UNSPECIFIED {
+		visibility = storagetypes.VISIBILITY_TYPE_PRIVATE
+	}
+
+	msg := storagetypes.NewMsgCreateBucket(creator, bucketName, visibility, primarySpAddress, opts.PaymentAddress,
+		expiredHeight, nil, opts.ChargedReadQuota)
+	if err = approver.ApproveCreateBucket(ctx, msg); err != nil {
+		return "", err
+	}
+	return c.broadcastAndWait(ctx, []sdk.Msg{msg}, opts.TxOpts)
+}
+
+// DeleteBucket deletes an empty bucket. It returns the tx hash once the tx is committed.
+func (c *MocaClient) DeleteBucket(ctx context.Context, bucketName string, txOpt *types.TxOption) (string, error) {
+	operator, err := c.senderAddr(txOpt)
+	if err != nil {
+		return "", err
+	}
+	msg := storagetypes.NewMsgDeleteBucket(operator, bucketName)
+	return c.broadcastAndWait(ctx, []sdk.Msg{msg}, txOpt)
+}
+
+// CreateObject creates an object in the bucket, the approval of the primary sp of the bucket is got from the
+// approver. The object is sealed by the sp after the payload is uploaded, see WaitForObjectSealed. It returns the tx
+// hash once the tx is committed.
+func (c *MocaClient) CreateObject(ctx context.Context, bucketName, objectName string, payloadSize uint64,
+	expectChecksums [][]byte, approver Approver, opts CreateObjectOptions,
+) (string, error) {
+	if approver == nil {
+		return "", types.ErrApproverNotProvided
+	}
+	creator, err := c.senderAddr(opts.TxOpts)
+	if err != nil {
+		return "", err
+	}
+	expiredHeight, err := c.approvalExpiredHeight(ctx, opts.ExpiredHeight)
+	if err != nil {
+		return "", err
+	}
+	visibility := opts.Visibility
+	if visibility == storagetypes.VISIBILITY_TYPE_UNSPECIFIED {
+		visibility = storagetypes.VISIBILITY_TYPE_INHERIT
+	}
+
+	msg := storagetypes.NewMsgCreateObject(creator, bucketName, objectName, payloadSize, visibility, expectChecksums,
+		opts.ContentType, opts.RedundancyType, expiredHeight, nil)
+	if err = approver.ApproveCreateObject(ctx, msg); err != nil {
+		return "", err
+	}
+	return c.broadcastAndWait(ctx, []sdk.Msg{msg}, opts.TxOpts)
+}
+
+// DeleteObject deletes an object. It returns the tx hash once the tx is committed.
+func (c *MocaClient) DeleteObject(ctx context.Context, bucketName, objectName string, txOpt *types.TxOption) (string, error) {
+	operator, err := c.senderAddr(txOpt)
+	if err != nil {
+		return "", err
+	}
+	msg := storagetypes.NewMsgDeleteObject(operator, bucketName, objectName)
+	return c.broadcastAndWait(ctx, []sdk.Msg{msg}, txOpt)
+}
+
+// PutPolicy puts a policy of the principal on the resource, which is the GRN string of a bucket, an object or a
+// group. It returns the tx hash once the tx is committed.
+func (c *MocaClient) PutPolicy(ctx context.Context, resource string, principal *permtypes.Principal,
+	statements []*permtypes.Statement, opts PutPolicyOptions,
+) (string, error) {
+	operator, err := c.senderAddr(opts.TxOpts)
+	if err != nil {
+		return "", err
+	}
+	msg := storagetypes.NewMsgPutPolicy(operator, resource, principal, statements, opts.ExpirationTime)
+	return c.broadcastAndWait(ctx, []sdk.Msg{msg}, opts.TxOpts)
+}
+
+// WaitForObjectSealed waits until the object is sealed by its primary sp and returns the sealed object. It returns
+// types.ErrObjectNotSealed if the object is discontinued, and the error of the query if the object is gone, e.g.
+// the seal is rejected.
+func (c *MocaClient) WaitForObjectSealed(ctx context.Context, bucketName, objectName string) (*storagetypes.ObjectInfo, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		resp, err := c.StorageQueryClient.HeadObject(ctx, &storagetypes.QueryHeadObjectRequest{
+			BucketName: bucketName,
+			ObjectName: objectName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		switch resp.GetObjectInfo().GetObjectStatus() {
+		case storagetypes.OBJECT_STATUS_SEALED:
+			return resp.ObjectInfo, nil
+		case storagetypes.OBJECT_STATUS_DISCONTINUED:
+			return nil, fmt.Errorf("object %s/%s is discontinued: %w", bucketName, objectName, types.ErrObjectNotSealed)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout exceeded waiting for object %s/%s to be sealed: %w", bucketName,
+				objectName, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// approvalExpiredHeight returns the expired height of the primary sp approval, the given one if not zero.
+func (c *MocaClient) approvalExpiredHeight(ctx context.Context, expiredHeight uint64) (uint64, error) {
+	if expiredHeight != 0 {
+		return expiredHeight, nil
+	}
+	height, err := GetLatestBlockHeight(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(height) + types.DefaultApprovalExpiredHeightGap, nil
+}
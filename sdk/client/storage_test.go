@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/sdk/client/test"
+	"github.com/mocachain/moca/v2/sdk/keys"
+	"github.com/mocachain/moca/v2/sdk/types"
+	gnfdtypes "github.com/mocachain/moca/v2/types"
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+	permtypes "github.com/mocachain/moca/v2/x/permission/types"
+	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// failingApprover refuses all the approvals, as the sp does for a bucket it does not serve.
+type failingApprover struct{}
+
+var errNotApproved = errors.New("not approved")
+
+func (failingApprover) ApproveCreateBucket(_ context.Context, _ *storagetypes.MsgCreateBucket) error {
+	return errNotApproved
+}
+
+func (failingApprover) ApproveCreateObject(_ context.Context, _ *storagetypes.MsgCreateObject) error {
+	return errNotApproved
+}
+
+func TestCreateBucket(t *testing.T) {
+	c, txClient := newFakeMocaClient(t)
+	km, err := keys.NewPrivateKeyManager(test.TestPrivateKey)
+	require.NoError(t, err)
+	approver := NewKeyManagerApprover(km, 3)
+
+	_, err = c.CreateBucket(context.Background(), "bucket", km.GetAddr(), nil, CreateBucketOptions{ExpiredHeight: 100})
+	assert.ErrorIs(t, err, types.ErrApproverNotProvided)
+	_, err = c.CreateBucket(context.Background(), "bucket", km.GetAddr(), failingApprover{},
+		CreateBucketOptions{ExpiredHeight: 100, TxOpts: fakeTxOption()})
+	assert.ErrorIs(t, err, errNotApproved)
+	assert.Empty(t, txClient.txs)
+
+	txHash, err := c.CreateBucket(context.Background(), "bucket", km.GetAddr(), approver, CreateBucketOptions{
+		ChargedReadQuota: 1024,
+		ExpiredHeight:    100,
+		TxOpts:           fakeTxOption(),
+	})
+	require.NoError(t, err)
+	assert.Contains(t, txClient.txs, txHash)
+
+	msgs := txClient.msgs(t)
+	require.Len(t, msgs, 1)
+	msg, ok := msgs[0].(*storagetypes.MsgCreateBucket)
+	require.True(t, ok)
+	assert.Equal(t, km.GetAddr().String(), msg.Creator)
+	assert.Equal(t, "bucket", msg.BucketName)
+	assert.Equal(t, storagetypes.VISIBILITY_TYPE_PRIVATE, msg.Visibility)
+	assert.Equal(t, km.GetAddr().String(), msg.PrimarySpAddress)
+	assert.Equal(t, uint64(1024), msg.ChargedReadQuota)
+	assert.Equal(t, uint64(100), msg.PrimarySpApproval.ExpiredHeight)
+	assert.Equal(t, uint32(3), msg.PrimarySpApproval.GlobalVirtualGroupFamilyId)
+	assert.NotEmpty(t, msg.PrimarySpApproval.Sig)
+}
+
+func TestCreateObject(t *testing.T) {
+	c, txClient := newFakeMocaClient(t)
+	km, err := keys.NewPrivateKeyManager(test.TestPrivateKey)
+	require.NoError(t, err)
+	checksums := [][]byte{make([]byte, 32), make([]byte, 32)}
+
+	_, err = c.CreateObject(context.Background(), "bucket", "object", 10, checksums, nil,
+		CreateObjectOptions{ExpiredHeight: 100})
+	assert.ErrorIs(t, err, types.ErrApproverNotProvided)
+	_, err = c.CreateObject(context.Background(), "bucket", "object", 10, checksums, failingApprover{},
+		CreateObjectOptions{ExpiredHeight: 100, TxOpts: fakeTxOption()})
+	assert.ErrorIs(t, err, errNotApproved)
+	assert.Empty(t, txClient.txs)
+
+	txHash, err := c.CreateObject(context.Background(), "bucket", "object", 10, checksums,
+		NewKeyManagerApprover(km, 0), CreateObjectOptions{
+			ContentType:   "text/plain",
+			ExpiredHeight: 100,
+			TxOpts:        fakeTxOption(),
+		})
+	require.NoError(t, err)
+	assert.Contains(t, txClient.txs, txHash)
+
+	msgs := txClient.msgs(t)
+	require.Len(t, msgs, 1)
+	msg, ok := msgs[0].(*storagetypes.MsgCreateObject)
+	require.True(t, ok)
+	assert.Equal(t, km.GetAddr().String(), msg.Creator)
+	assert.Equal(t, "bucket", msg.BucketName)
+	assert.Equal(t, "object", msg.ObjectName)
+	assert.Equal(t, uint64(10), msg.PayloadSize)
+	assert.Equal(t, storagetypes.VISIBILITY_TYPE_INHERIT, msg.Visibility)
+	assert.Equal(t, "text/plain", msg.ContentType)
+	assert.Equal(t, checksums, msg.ExpectChecksums)
+	assert.Equal(t, uint64(100), msg.PrimarySpApproval.ExpiredHeight)
+	assert.NotEmpty(t, msg.PrimarySpApproval.Sig)
+}
+
+func TestPutPolicy(t *testing.T) {
+	c, txClient := newFakeMocaClient(t)
+	km, err := keys.NewPrivateKeyManager(test.TestPrivateKey)
+	require.NoError(t, err)
+	resource := gnfdtypes.NewBucketGRN("bucket").String()
+	principal := permtypes.NewPrincipalWithAccount(km.GetAddr())
+	statements := []*permtypes.Statement{{
+		Effect:  permtypes.EFFECT_ALLOW,
+		Actions: []permtypes.ActionType{permtypes.ACTION_UPDATE_BUCKET_INFO},
+	}}
+	expirationTime := time.Unix(1700000000, 0).UTC()
+
+	txHash, err := c.PutPolicy(context.Background(), resource, principal, statements, PutPolicyOptions{
+		ExpirationTime: &expirationTime,
+		TxOpts:         fakeTxOption(),
+	})
+	require.NoError(t, err)
+	assert.Contains(t, txClient.txs, txHash)
+
+	msgs := txClient.msgs(t)
+	require.Len(t, msgs, 1)
+	msg, ok := msgs[0].(*storagetypes.MsgPutPolicy)
+	require.True(t, ok)
+	assert.Equal(t, km.GetAddr().String(), msg.Operator)
+	assert.Equal(t, resource, msg.Resource)
+	assert.Equal(t, principal, msg.Principal)
+	assert.Equal(t, statements, msg.Statements)
+	require.NotNil(t, msg.ExpirationTime)
+	assert.True(t, expirationTime.Equal(*msg.ExpirationTime))
+
+	// the invalid policy is not broadcast
+	_, err = c.PutPolicy(context.Background(), "bucket", principal, statements, PutPolicyOptions{TxOpts: fakeTxOption()})
+	assert.ErrorIs(t, err, gnfderrors.ErrInvalidGRN)
+	assert.Len(t, txClient.txs, 1)
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mocachain/moca/v2/sdk/keys"
+	"github.com/mocachain/moca/v2/sdk/types"
+)
+
+// TxResponseError returns the error of a failed tx, nil if the tx succeeded. The error is the one registered by the
+// module that failed the tx, so it can be matched with errors.Is against the errors in x/*/types/errors.go, e.g.
+// storagetypes.ErrNoSuchBucket.
+func TxResponseError(resp *sdk.TxResponse) error {
+	if resp == nil || resp.Code == 0 {
+		return nil
+	}
+	return fmt.Errorf("tx %s failed: %w", resp.TxHash, errorsmod.ABCIError(resp.Codespace, resp.Code, resp.RawLog))
+}
+
+// WaitForTx waits until the tx is committed and returns its response. A tx failed on chain is returned along with
+// the error converted by TxResponseError.
+func (c *MocaClient) WaitForTx(ctx context.Context, txHash string) (*sdk.TxResponse, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		resp, err := c.TxClient.GetTx(ctx, &tx.GetTxRequest{Hash: txHash})
+		if err == nil {
+			return resp.TxResponse, TxResponseError(resp.TxResponse)
+		}
+		// the tx is not found until it is committed
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout exceeded waiting for tx %s: %w", txHash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// broadcastAndWait broadcasts the msgs and waits until the tx is committed, the tx hash is returned along with the
+// error if the tx failed.
+func (c *MocaClient) broadcastAndWait(ctx context.Context, msgs []sdk.Msg, txOpt *types.TxOption) (string, error) {
+	resp, err := c.BroadcastTx(ctx, msgs, txOpt)
+	if err != nil {
+		return "", err
+	}
+	if err = TxResponseError(resp.TxResponse); err != nil {
+		return resp.TxResponse.TxHash, err
+	}
+	if _, err = c.WaitForTx(ctx, resp.TxResponse.TxHash); err != nil {
+		return resp.TxResponse.TxHash, err
+	}
+	return resp.TxResponse.TxHash, nil
+}
+
+// senderAddr returns the address that signs the tx built with the txOpt.
+func (c *MocaClient) senderAddr(txOpt *types.TxOption) (sdk.AccAddress, error) {
+	var km keys.KeyManager
+	var err error
+	if txOpt != nil && txOpt.OverrideKeyManager != nil {
+		km = *txOpt.OverrideKeyManager
+	} else {
+		km, err = c.GetKeyManager()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return km.GetAddr(), nil
+}
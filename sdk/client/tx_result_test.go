@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mocachain/moca/v2/sdk/client/test"
+	"github.com/mocachain/moca/v2/sdk/keys"
+	"github.com/mocachain/moca/v2/sdk/types"
+	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// fakeTxClient keeps the broadcast txs in memory. A tx is not found until it was looked up pending times, as if it
+// is committed some blocks later, and it is committed with the given error, if any.
+type fakeTxClient struct {
+	tx.ServiceClient
+	txConfig sdkclient.TxConfig
+	txs      map[string]sdk.Tx
+	pending  int
+	err      *sdk.TxResponse
+}
+
+func (f *fakeTxClient) BroadcastTx(_ context.Context, req *tx.BroadcastTxRequest, _ ...grpc.CallOption) (*tx.BroadcastTxResponse, error) {
+	decoded, err := f.txConfig.TxDecoder()(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+	txHash := fmt.Sprintf("%X", tmhash.Sum(req.TxBytes))
+	f.txs[txHash] = decoded
+	return &tx.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: txHash}}, nil
+}
+
+func (f *fakeTxClient) GetTx(_ context.Context, req *tx.GetTxRequest, _ ...grpc.CallOption) (*tx.GetTxResponse, error) {
+	if _, found := f.txs[req.Hash]; !found || f.pending > 0 {
+		f.pending--
+		return nil, status.Errorf(codes.NotFound, "tx not found: %s", req.Hash)
+	}
+	resp := &sdk.TxResponse{TxHash: req.Hash}
+	if f.err != nil {
+		resp.Codespace, resp.Code, resp.RawLog = f.err.Codespace, f.err.Code, f.err.RawLog
+	}
+	return &tx.GetTxResponse{TxResponse: resp}, nil
+}
+
+// msgs returns the msgs of the only tx broadcast.
+func (f *fakeTxClient) msgs(t *testing.T) []sdk.Msg {
+	require.Len(t, f.txs, 1)
+	for _, decoded := range f.txs {
+		return decoded.GetMsgs()
+	}
+	return nil
+}
+
+// fakeAuthQueryClient returns the same account for any address.
+type fakeAuthQueryClient struct {
+	authtypes.QueryClient
+	account *authtypes.BaseAccount
+}
+
+func (f *fakeAuthQueryClient) Account(_ context.Context, _ *authtypes.QueryAccountRequest, _ ...grpc.CallOption) (*authtypes.QueryAccountResponse, error) {
+	account, err := codectypes.NewAnyWithValue(f.account)
+	if err != nil {
+		return nil, err
+	}
+	return &authtypes.QueryAccountResponse{Account: account}, nil
+}
+
+// newFakeMocaClient returns a client signing with the test key, whose txs are kept by the returned tx client.
+func newFakeMocaClient(t *testing.T) (*MocaClient, *fakeTxClient) {
+	km, err := keys.NewPrivateKeyManager(test.TestPrivateKey)
+	require.NoError(t, err)
+	cdc := types.Codec()
+	txClient := &fakeTxClient{
+		txConfig: newMocaTxConfig(cdc, []signing.SignMode{signing.SignMode_SIGN_MODE_EIP_712}),
+		txs:      make(map[string]sdk.Tx),
+	}
+	c := &MocaClient{
+		AuthQueryClient: &fakeAuthQueryClient{account: authtypes.NewBaseAccount(km.GetAddr(), nil, 1, 0)},
+		TxClient:        txClient,
+		keyManager:      km,
+		chainID:         test.TestChainID,
+		codec:           cdc,
+	}
+	return c, txClient
+}
+
+// fakeTxOption skips the simulation, which the fake tx client does not serve.
+func fakeTxOption() *types.TxOption {
+	return &types.TxOption{
+		NoSimulate: true,
+		GasLimit:   1000,
+		FeeAmount:  sdk.NewCoins(sdk.NewCoin(test.TestTokenName, sdkmath.NewInt(1000))),
+	}
+}
+
+func TestTxResponseError(t *testing.T) {
+	assert.NoError(t, TxResponseError(nil))
+	assert.NoError(t, TxResponseError(&sdk.TxResponse{TxHash: "AA"}))
+
+	err := TxResponseError(&sdk.TxResponse{
+		TxHash:    "AA",
+		Codespace: storagetypes.ModuleName,
+		Code:      storagetypes.ErrNoSuchBucket.ABCICode(),
+		RawLog:    "failed to execute message",
+	})
+	assert.True(t, errors.Is(err, storagetypes.ErrNoSuchBucket))
+	assert.False(t, errors.Is(err, storagetypes.ErrNoSuchObject))
+	assert.Contains(t, err.Error(), "tx AA failed")
+
+	// the errors not registered are still returned
+	err = TxResponseError(&sdk.TxResponse{TxHash: "BB", Codespace: "unknown", Code: 1})
+	assert.Error(t, err)
+}
+
+func TestWaitForTx(t *testing.T) {
+	c, txClient := newFakeMocaClient(t)
+	txClient.txs["AA"] = nil
+
+	// the tx is waited for until it is committed
+	txClient.pending = 1
+	resp, err := c.WaitForTx(context.Background(), "AA")
+	require.NoError(t, err)
+	assert.Equal(t, "AA", resp.TxHash)
+
+	// the tx failed on chain is returned along with its error
+	txClient.err = &sdk.TxResponse{Codespace: storagetypes.ModuleName, Code: storagetypes.ErrNoSuchBucket.ABCICode()}
+	resp, err = c.WaitForTx(context.Background(), "AA")
+	assert.ErrorIs(t, err, storagetypes.ErrNoSuchBucket)
+	assert.Equal(t, "AA", resp.TxHash)
+
+	// the tx not committed before the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = c.WaitForTx(ctx, "BB")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the other errors are returned at once, even if they read not found
+	c.TxClient = &unavailableTxClient{}
+	_, err = c.WaitForTx(context.Background(), "AA")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+// unavailableTxClient fails all lookups as if the node can not be reached.
+type unavailableTxClient struct {
+	tx.ServiceClient
+}
+
+func (unavailableTxClient) GetTx(_ context.Context, _ *tx.GetTxRequest, _ ...grpc.CallOption) (*tx.GetTxResponse, error) {
+	return nil, status.Error(codes.Unavailable, "node not found")
+}
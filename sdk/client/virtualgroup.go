@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/sdk/types"
+	virtualgroupmoduletypes "github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+// GetGlobalVirtualGroup returns the global virtual group of the id.
+func (c *MocaClient) GetGlobalVirtualGroup(ctx context.Context, gvgID uint32) (*virtualgroupmoduletypes.GlobalVirtualGroup, error) {
+	resp, err := c.VirtualGroupQueryClient.GlobalVirtualGroup(ctx, &virtualgroupmoduletypes.QueryGlobalVirtualGroupRequest{
+		GlobalVirtualGroupId: gvgID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GlobalVirtualGroup, nil
+}
+
+// GetGlobalVirtualGroupFamily returns the global virtual group family of the id.
+func (c *MocaClient) GetGlobalVirtualGroupFamily(ctx context.Context, familyID uint32) (*virtualgroupmoduletypes.GlobalVirtualGroupFamily, error) {
+	resp, err := c.VirtualGroupQueryClient.GlobalVirtualGroupFamily(ctx, &virtualgroupmoduletypes.QueryGlobalVirtualGroupFamilyRequest{
+		FamilyId: familyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GlobalVirtualGroupFamily, nil
+}
+
+// CreateGlobalVirtualGroup creates a global virtual group in the family with the sender as the primary sp, a new
+// family is created if the family id is zero. It returns the tx hash once the tx is committed.
+func (c *MocaClient) CreateGlobalVirtualGroup(ctx context.Context, familyID uint32, secondarySpIDs []uint32,
+	deposit sdk.Coin, txOpt *types.TxOption,
+) (string, error) {
+	primarySpAddress, err := c.senderAddr(txOpt)
+	if err != nil {
+		return "", err
+	}
+	msg := virtualgroupmoduletypes.NewMsgCreateGlobalVirtualGroup(primarySpAddress, familyID, secondarySpIDs, deposit)
+	return c.broadcastAndWait(ctx, []sdk.Msg{msg}, txOpt)
+}
+
+// DepositToGlobalVirtualGroup deposits the coin to the global virtual group from the sender, which is the primary sp
+// of the group. It returns the tx hash once the tx is committed.
+func (c *MocaClient) DepositToGlobalVirtualGroup(ctx context.Context, gvgID uint32, deposit sdk.Coin,
+	txOpt *types.TxOption,
+) (string, error) {
+	fundingAddress, err := c.senderAddr(txOpt)
+	if err != nil {
+		return "", err
+	}
+	msg := virtualgroupmoduletypes.NewMsgDeposit(fundingAddress, gvgID, deposit)
+	return c.broadcastAndWait(ctx, []sdk.Msg{msg}, txOpt)
+}
@@ -11,4 +11,6 @@ var (
 	ErrFeeAmountNotValid     = errors.New("fee Amount coin should only be amoca")
 	ErrGasInfoNotProvided    = errors.New("gas limit and(or) Fee Amount missing in txOpt")
 	ErrRPCAddressNotProvided = errors.New("rpc address is not provided")
+	ErrApproverNotProvided   = errors.New("approver of the primary sp is not provided")
+	ErrObjectNotSealed       = errors.New("object is not sealed")
 )
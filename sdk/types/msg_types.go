@@ -45,10 +45,15 @@ type (
 	MsgGrantAllowance  = feegranttypes.MsgGrantAllowance
 	MsgRevokeAllowance = feegranttypes.MsgRevokeAllowance
 
-	MsgCreatePaymentAccount = paymenttypes.MsgCreatePaymentAccount
-	MsgPaymentDeposit       = paymenttypes.MsgDeposit
-	MsgWithdraw             = paymenttypes.MsgWithdraw
-	MsgDisableRefund        = paymenttypes.MsgDisableRefund
+	MsgCreatePaymentAccount    = paymenttypes.MsgCreatePaymentAccount
+	MsgPaymentDeposit          = paymenttypes.MsgDeposit
+	MsgWithdraw                = paymenttypes.MsgWithdraw
+	MsgDisableRefund           = paymenttypes.MsgDisableRefund
+	MsgCreateStream            = paymenttypes.MsgCreateStream
+	MsgUpdateStream            = paymenttypes.MsgUpdateStream
+	MsgCancelStream            = paymenttypes.MsgCancelStream
+	MsgSetRunwayAlert          = paymenttypes.MsgSetRunwayAlert
+	MsgSetAutoDepositAllowance = paymenttypes.MsgSetAutoDepositAllowance
 
 	MsgCreateStorageProvider = sptypes.MsgCreateStorageProvider
 	MsgSpDeposit             = sptypes.MsgDeposit
@@ -29,6 +29,10 @@ const (
 	DefaultGasPrice = 20_000_000_000
 	DefaultChainId  = 5151
 	ChainID         = "moca_5151-1"
+
+	// DefaultApprovalExpiredHeightGap is the number of blocks the primary sp approval is valid for, if the expired
+	// height is not specified when creating buckets and objects.
+	DefaultApprovalExpiredHeightGap = 100
 	// EvmUrl          = "http://localhost:8545"
 	// EvmPort = 8545
 	// Endpoint        = "http://localhost:26657"
@@ -72,6 +72,15 @@ struct DelayedWithdrawalRecord {
     int64 unlockTimestamp;
 }
 
+struct UserStream {
+    uint64 id;
+    string sender;
+    string recipient;
+    uint256 rate;
+    int64 createdAt;
+    int64 updatedAt;
+}
+
 interface IPayment {
     /**
      * @dev createPaymentAccount defines a method for create a payment account.
@@ -99,6 +108,46 @@ interface IPayment {
         uint256 amount
     ) external returns (bool success);
 
+    /**
+     * @dev createStream defines a method for opening a stream from the sender to the recipient at a rate per second.
+     */
+    function createStream(
+        string memory sender,
+        string memory recipient,
+        uint256 rate
+    ) external returns (uint64 streamId);
+
+    /**
+     * @dev updateStream defines a method for changing the rate of a stream.
+     */
+    function updateStream(
+        uint64 streamId,
+        uint256 rate
+    ) external returns (bool success);
+
+    /**
+     * @dev cancelStream defines a method for canceling a stream.
+     */
+    function cancelStream(uint64 streamId) external returns (bool success);
+
+    /**
+     * @dev setRunwayAlert defines a method for setting the runway below which a stream account is alerted.
+     */
+    function setRunwayAlert(
+        string memory addr,
+        uint64 thresholdSeconds
+    ) external returns (bool success);
+
+    /**
+     * @dev setAutoDepositAllowance defines a method for authorizing the pulls from the caller into a stream account.
+     */
+    function setAutoDepositAllowance(
+        string memory addr,
+        uint256 maxAmountPerPeriod,
+        uint64 periodSeconds,
+        uint64 thresholdSeconds
+    ) external returns (bool success);
+
     /**
      * @dev paymentAccountsByOwner defines a method for queries all payment accounts by a owner.
      */
@@ -215,6 +264,27 @@ interface IPayment {
         view
         returns (DelayedWithdrawalRecord calldata delayedWithdrawal);
 
+    /**
+     * @dev stream defines a method for queries a user stream by id.
+     */
+    function stream(
+        uint64 streamId
+    ) external view returns (UserStream calldata stream);
+
+    /**
+     * @dev streamsBySender defines a method for queries the user streams paid by a sender.
+     */
+    function streamsBySender(
+        string memory sender,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            UserStream[] memory streams,
+            PageResponse calldata pageResponse
+        );
+
     /**
      * @dev CreatePaymentAccount defines an Event emitted when a user create a payment account
      */
@@ -234,4 +304,29 @@ interface IPayment {
      * @dev Withdraw defines an Event emitted when a user withdraw
      */
     event Withdraw(address indexed creator);
+
+    /**
+     * @dev CreateStream defines an Event emitted when a user create a stream
+     */
+    event CreateStream(address indexed creator, uint64 streamId);
+
+    /**
+     * @dev UpdateStream defines an Event emitted when a user update a stream
+     */
+    event UpdateStream(address indexed creator, uint64 streamId);
+
+    /**
+     * @dev CancelStream defines an Event emitted when a user cancel a stream
+     */
+    event CancelStream(address indexed creator, uint64 streamId);
+
+    /**
+     * @dev SetRunwayAlert defines an Event emitted when a user set the runway alert of a stream account
+     */
+    event SetRunwayAlert(address indexed owner, uint64 thresholdSeconds);
+
+    /**
+     * @dev SetAutoDepositAllowance defines an Event emitted when a user set the auto deposit allowance of a stream account
+     */
+    event SetAutoDepositAllowance(address indexed creator);
 }
@@ -8,9 +8,116 @@ struct Params {
     uint64 maximumRemoveExpiredPoliciesIteration;
 }
 
+struct Principal {
+    int32 principalType;
+    // When the type is an account, its value is sdk.AccAddress().String();
+    // when the type is a group, its value is math.Uint().String()
+    string value;
+}
+
+struct Condition {
+    // key is one of block_time_before, block_time_after, object_size_lte, object_name_prefix,
+    // content_type_in and tag_equals
+    string key;
+    string[] values;
+}
+
+struct Statement {
+    int32 effect;
+    int32[] actions;
+    string[] resources;
+    // expirationTime is the timestamp(UNIX) the statement expires at, 0 if it never expires
+    int64 expirationTime;
+    uint64 limitSize;
+    // conditions must all hold for the statement to apply
+    Condition[] conditions;
+}
+
+struct Policy {
+    uint256 id;
+    Principal principal;
+    int32 resourceType;
+    uint256 resourceId;
+    Statement[] statements;
+    // expirationTime is the timestamp(UNIX) the policy expires at, 0 if it never expires
+    int64 expirationTime;
+}
+
+struct GroupMember {
+    // id is an unique u256 sequence for each group member. It also be used as NFT tokenID
+    uint256 id;
+    // group_id is the unique id of the group
+    uint256 groupId;
+    // member is the account address of the member
+    address member;
+    // expiration_time defines the timestamp(UNIX) of the member expiration
+    int64 expirationTime;
+}
+
 interface IPermission {
     /**
      * @dev params defines a method for queries the parameters of the module.
      */
     function params() external view returns (Params calldata params);
+
+    /**
+     * @dev putPolicy defines a method for put a policy to a bucket/object/group the caller owns,
+     * putting the policy of the same principal again updates its statements and expiration time.
+     */
+    function putPolicy(
+        Principal memory principal,
+        string memory resource,
+        Statement[] memory statements,
+        int64 expirationTime
+    ) external returns (uint256 policyId);
+
+    /**
+     * @dev deletePolicy defines a method for delete the policy of principal on a resource the caller owns.
+     */
+    function deletePolicy(
+        Principal memory principal,
+        string memory resource
+    ) external returns (uint256 policyId);
+
+    /**
+     * @dev getPolicyById queries a policy by policy id.
+     */
+    function getPolicyById(
+        uint256 policyId
+    ) external view returns (Policy calldata policy);
+
+    /**
+     * @dev listPoliciesForResource queries the policies of the accounts and the groups enforced on a resource.
+     */
+    function listPoliciesForResource(
+        string memory resource
+    ) external view returns (Policy[] calldata policies);
+
+    /**
+     * @dev getGroupMember queries the member of a group.
+     */
+    function getGroupMember(
+        uint256 groupId,
+        address member
+    ) external view returns (GroupMember calldata groupMember);
+
+    /**
+     * @dev verifyPermission queries the effect of the action of an operator on a bucket or an object.
+     */
+    function verifyPermission(
+        address operator,
+        string memory bucketName,
+        string memory objectName,
+        int32 actionType
+    ) external view returns (int32 effect);
+
+    /**
+     * @dev PutPolicy defines an Event emitted when a user put a policy
+     */
+    event PutPolicy(address indexed operator, uint256 policyId);
+
+    /**
+     * @dev DeletePolicy defines an Event emitted when a user delete a policy
+     */
+    event DeletePolicy(address indexed operator, uint256 policyId);
 }
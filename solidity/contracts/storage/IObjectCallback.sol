@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+
+pragma solidity ^0.8.0;
+
+/**
+ * @dev IObjectCallback is implemented by the callback contract a bucket owner registers with
+ * setBucketCallback. The storage module calls it from the storage precompile address when an
+ * object of the bucket is sealed, rejected or deleted, within the gas limit registered along.
+ * A callback which reverts or runs out of gas does not revert the storage operation.
+ */
+interface IObjectCallback {
+    /**
+     * @dev onObjectSealed is called after the primary SP sealed the object.
+     */
+    function onObjectSealed(
+        uint256 objectId,
+        string calldata bucketName,
+        string calldata objectName
+    ) external;
+
+    /**
+     * @dev onObjectRejected is called after the primary SP rejected to seal the object.
+     */
+    function onObjectRejected(
+        uint256 objectId,
+        string calldata bucketName,
+        string calldata objectName
+    ) external;
+
+    /**
+     * @dev onObjectDeleted is called after the object was deleted.
+     */
+    function onObjectDeleted(
+        uint256 objectId,
+        string calldata bucketName,
+        string calldata objectName
+    ) external;
+}
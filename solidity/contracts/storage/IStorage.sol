@@ -312,6 +312,12 @@ struct InternalBucketInfo {
     uint32 nextLocalVirtualGroupId;
 }
 
+struct TaggedResource {
+    uint8 resourceType;
+    uint256 resourceId;
+    string grn;
+}
+
 struct IsPriceChanged {
     bool changed;
     uint256 currentReadPrice;
@@ -404,6 +410,37 @@ interface IStorage {
         uint256 flowRateLimit
     ) external returns (bool success);
 
+    /**
+     * @dev setBucketCallback defines a method for the bucket owner to register the IObjectCallback
+     * contract notified when the objects of the bucket are sealed, rejected or deleted. The zero
+     * callbackAddress removes the callback. A failing callback does not revert the storage operation.
+     */
+    function setBucketCallback(
+        string memory bucketName,
+        address callbackAddress,
+        uint64 gasLimit
+    ) external returns (bool success);
+
+    /**
+     * @dev transferBucketOwnership defines a method for the bucket owner to propose to transfer the
+     * ownership of the bucket to newOwner, which takes effect once newOwner accepts it. The zero
+     * newOwner cancels the pending transfer.
+     */
+    function transferBucketOwnership(
+        string memory bucketName,
+        address newOwner
+    ) external returns (bool success);
+
+    /**
+     * @dev acceptBucketOwnership defines a method for the new owner to accept the pending transfer
+     * of the ownership of a bucket. The bucket, its objects and their NFTs move to the caller, and the
+     * bucket is charged from paymentAddress, the caller itself if it is the zero address.
+     */
+    function acceptBucketOwnership(
+        string memory bucketName,
+        address paymentAddress
+    ) external returns (bool success);
+
     /**
      * @dev createObject defines a method for create a object.
      */
@@ -494,6 +531,42 @@ interface IStorage {
             PageResponse calldata pageResponse
         );
 
+    /**
+     * @dev listObjectsV2 queries the objects in lexicographical order of their names,
+     * the names which contain the delimiter after the prefix are grouped into commonPrefixes.
+     */
+    function listObjectsV2(
+        PageRequest calldata pagination,
+        string memory bucketName,
+        string memory prefix,
+        string memory delimiter,
+        string memory startAfter
+    )
+        external
+        view
+        returns (
+            ObjectInfo[] memory objectInfos,
+            string[] memory commonPrefixes,
+            PageResponse calldata pageResponse
+        );
+
+    /**
+     * @dev listResourcesByTag queries the buckets, objects and groups which have the tag,
+     * resources of all types are listed if resourceType is 0.
+     */
+    function listResourcesByTag(
+        PageRequest calldata pagination,
+        string memory tagKey,
+        string memory tagValue,
+        uint8 resourceType
+    )
+        external
+        view
+        returns (
+            TaggedResource[] memory resources,
+            PageResponse calldata pageResponse
+        );
+
     /**
      * @dev listGroups queries all the groups.
      */
@@ -778,6 +851,23 @@ interface IStorage {
         string memory bucketName
     ) external view returns (bool isSet, uint256 flowRateLimit);
 
+    /**
+     * @dev queryBucketCallback queries the callback contract of a bucket, the zero address if none is registered.
+     */
+    function queryBucketCallback(
+        string memory bucketName
+    ) external view returns (address callbackAddress, uint64 gasLimit);
+
+    /**
+     * @dev tokenURI queries the data URI of the ERC-721 metadata of a bucket, object or group NFT.
+     * The tokenURI of the NFT contracts resolves to the same metadata through the REST route once the
+     * nft_metadata_base_url param of the storage module is set.
+     */
+    function tokenURI(
+        address tokenContract,
+        uint256 tokenId
+    ) external view returns (string memory tokenURI);
+
     /**
      * @dev verifyPermission queries a list of VerifyPermission items.
      */
@@ -958,6 +1048,21 @@ interface IStorage {
      */
     event SetBucketFlowRateLimit(address indexed operator);
 
+    /**
+     * @dev SetBucketCallback defines an Event emitted when a user set the bucket callback
+     */
+    event SetBucketCallback(address indexed operator);
+
+    /**
+     * @dev TransferBucketOwnership defines an Event emitted when a user propose to transfer the ownership of a bucket
+     */
+    event TransferBucketOwnership(address indexed operator);
+
+    /**
+     * @dev AcceptBucketOwnership defines an Event emitted when a user accept the ownership of a bucket
+     */
+    event AcceptBucketOwnership(address indexed operator);
+
     /**
      * @dev CreateObject defines an Event emitted when a user create a object
      */
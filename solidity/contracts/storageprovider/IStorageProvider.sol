@@ -68,6 +68,23 @@ struct SpStoragePrice {
     uint256 store_price;
 }
 
+struct SpChallengeStats {
+    // Storage Provider ID
+    uint32 sp_id;
+    // Number of challenges raised against the storage provider
+    uint64 challenges_received;
+    // Number of challenges attested as succeeded, for which the storage provider is slashed
+    uint64 challenges_succeeded;
+    // Number of challenges the storage provider passed
+    uint64 challenges_failed;
+    // Number of heartbeat attestations
+    uint64 heartbeats;
+    // Total amount slashed in wei
+    uint256 total_slashed;
+    // Share of the resolved challenges the storage provider passed, scaled by 1e18
+    uint256 reliability_score;
+}
+
 interface IStorageProvider {
     /**
      * @dev updateSPPrice defines a method for sp update storage-provider price info.
@@ -112,6 +129,13 @@ interface IStorageProvider {
         address operatorAddress
     ) external view returns (SpStoragePrice calldata spStoragePrice);
 
+    /**
+     * @dev spChallengeStats queries the challenge statistics and the reliability score of a storage provider.
+     */
+    function spChallengeStats(
+        uint32 spId
+    ) external view returns (SpChallengeStats calldata spChallengeStats);
+
     /**
      * @dev UpdateSPPrice defines an Event emitted when a sp update storage-provider price info.
      */
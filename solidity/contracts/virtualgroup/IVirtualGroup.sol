@@ -17,6 +17,16 @@ struct GlobalVirtualGroupFamily {
     address virtualPaymentAddress;
 }
 
+// RebalanceMove describes moving the secondary replica of a global virtual group from one sp to another.
+struct RebalanceMove {
+    // globalVirtualGroupId is the identifier of the global virtual group to move.
+    uint32 globalVirtualGroupId;
+    // fromSpId is the id of the secondary sp giving up the global virtual group.
+    uint32 fromSpId;
+    // toSpId is the id of the sp taking over the global virtual group.
+    uint32 toSpId;
+}
+
 interface IVirtualGroup {
     /**
      * @dev createGlobalVirtualGroup defines a method for sp create a global virtual group.
@@ -117,6 +127,23 @@ interface IVirtualGroup {
         uint32 globalVirtualGroupId
     ) external returns (bool success);
 
+    /**
+     * @dev bidSwapIn defines a method to bid for the swap in auction of a forced exiting sp.
+     */
+    function bidSwapIn(
+        uint32 targetSpId,
+        uint32 gvgFamilyId,
+        uint32 globalVirtualGroupId,
+        uint64 capacity
+    ) external returns (bool success);
+
+    /**
+     * @dev submitRebalancePlan defines a method to submit a plan of moving gvgs between secondary sps.
+     */
+    function submitRebalancePlan(
+        RebalanceMove[] calldata moves
+    ) external returns (bool success);
+
     /**
      * @dev CreateGlobalVirtualGroup defines an Event emitted when a sp create a global virtual group.
      */
@@ -172,4 +199,14 @@ interface IVirtualGroup {
      * @dev CancelSwapIn defines an Event emitted when a sp to cancel swap in.
      */
     event CancelSwapIn(address indexed storageProvider);
+
+    /**
+     * @dev BidSwapIn defines an Event emitted when a sp to bid for swap in.
+     */
+    event BidSwapIn(address indexed storageProvider);
+
+    /**
+     * @dev SubmitRebalancePlan defines an Event emitted when a rebalance plan is submitted.
+     */
+    event SubmitRebalancePlan(address indexed submitter, uint256 planId);
 }
@@ -49,9 +49,12 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) error {
 
 	expiredHeight := params.ChallengeKeepAlivePeriod + uint64(ctx.BlockHeight())
 
-	events := make([]proto.Message, 0)                      // for events
-	objectMap := make(map[string]struct{})                  // for de-duplication
-	iteration, maxIteration := uint64(0), 10*(needed-count) // to prevent endless loop
+	// the weighted selection rejects some of the objects drawn, so more objects are drawn
+	selector := keeper.GetChallengeSelector(ctx)
+
+	events := make([]proto.Message, 0)                                              // for events
+	objectMap := make(map[string]struct{})                                          // for de-duplication
+	iteration, maxIteration := uint64(0), 10*(needed-count)*selector.Oversampling() // to prevent endless loop
 	for count < needed && iteration < maxIteration {
 		iteration++
 		seed := k.SeedFromRandaoMix(ctx.BlockHeader().RandaoMix, iteration)
@@ -79,7 +82,10 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) error {
 		if !found {
 			continue
 		}
-		redundancyIndex := k.RandomRedundancyIndex(seed, uint64(len(gvg.SecondarySpIds)+1))
+		if !selector.AcceptObject(ctx, seed, objectInfo, gvg) {
+			continue
+		}
+		redundancyIndex := selector.SelectRedundancyIndex(ctx, seed, gvg)
 		if redundancyIndex == types.RedundancyIndexPrimary { // primary sp
 			spOperatorID = gvg.PrimarySpId
 		} else {
@@ -122,6 +128,7 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) error {
 			ExpiredHeight: expiredHeight,
 		})
 		keeper.SaveChallengeSpID(ctx, challengeID, sp.Id)
+		keeper.RecordChallengeReceived(ctx, sp.Id)
 		keeper.SpKeeper.SetDepositLockUntil(ctx, sp.Id, expiredHeight)
 		events = append(events, &types.EventStartChallenge{
 			ChallengeId:       challengeID,
@@ -31,11 +31,12 @@ type TestSuite struct {
 	challengeKeeper *keeper.Keeper
 	storeKey        storetypes.StoreKey
 
-	bankKeeper    *types.MockBankKeeper
-	storageKeeper *types.MockStorageKeeper
-	spKeeper      *types.MockSpKeeper
-	stakingKeeper *types.MockStakingKeeper
-	paymentKeeper *types.MockPaymentKeeper
+	bankKeeper         *types.MockBankKeeper
+	storageKeeper      *types.MockStorageKeeper
+	spKeeper           *types.MockSpKeeper
+	stakingKeeper      *types.MockStakingKeeper
+	paymentKeeper      *types.MockPaymentKeeper
+	virtualGroupKeeper *types.MockVirtualGroupKeeper
 
 	ctx         sdk.Context
 	queryClient types.QueryClient
@@ -67,6 +68,7 @@ func (s *TestSuite) SetupTest() {
 	spKeeper := types.NewMockSpKeeper(ctrl)
 	stakingKeeper := types.NewMockStakingKeeper(ctrl)
 	paymentKeeper := types.NewMockPaymentKeeper(ctrl)
+	virtualGroupKeeper := types.NewMockVirtualGroupKeeper(ctrl)
 
 	s.challengeKeeper = keeper.NewKeeper(
 		encCfg.Codec,
@@ -77,6 +79,7 @@ func (s *TestSuite) SetupTest() {
 		spKeeper,
 		stakingKeeper,
 		paymentKeeper,
+		virtualGroupKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -87,6 +90,7 @@ func (s *TestSuite) SetupTest() {
 	s.spKeeper = spKeeper
 	s.stakingKeeper = stakingKeeper
 	s.paymentKeeper = paymentKeeper
+	s.virtualGroupKeeper = virtualGroupKeeper
 
 	err := s.challengeKeeper.SetParams(s.ctx, types.DefaultParams())
 	s.Require().NoError(err)
@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdLatestAttestedChallenges())
 	cmd.AddCommand(CmdAttestedChallenge())
 	cmd.AddCommand(CmdInturnChallenger())
+	cmd.AddCommand(CmdSpChallengeStats())
+	cmd.AddCommand(CmdAllSpChallengeStats())
 
 	return cmd
 }
@@ -144,3 +146,66 @@ func CmdInturnChallenger() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSpChallengeStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sp-challenge-stats [sp-id]",
+		Short: "Query the challenge statistics and the reliability score of a storage provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argSpID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("sp-id %s not a valid uint32, please input a valid sp-id", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SpChallengeStats(cmd.Context(), &types.QuerySpChallengeStatsRequest{SpId: uint32(argSpID)})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAllSpChallengeStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-sp-challenge-stats",
+		Short: "Query the challenge statistics of all the storage providers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllSpChallengeStats(cmd.Context(), &types.QueryAllSpChallengeStatsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
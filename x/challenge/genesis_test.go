@@ -45,6 +45,7 @@ func makeKeeper(t *testing.T) (*keeper.Keeper, sdk.Context) {
 		&types.MockSpKeeper{},
 		&types.MockStakingKeeper{},
 		&types.MockPaymentKeeper{},
+		&types.MockVirtualGroupKeeper{},
 		authtypes.NewModuleAddress(types.ModuleName).String(),
 	)
 
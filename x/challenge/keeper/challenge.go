@@ -75,13 +75,14 @@ func (k Keeper) RemoveChallengeUntil(ctx sdk.Context, height uint64) {
 
 	// Record the sps in the order the iterator yields them, which is the same on every node,
 	// and use the set only to skip repeats: ranging a map would visit them in a random order.
-	var affected []uint32
+	var affected, expired []uint32
 	seen := make(map[uint32]struct{})
 	for ; iterator.Valid(); iterator.Next() {
 		expiredHeight := binary.BigEndian.Uint64(iterator.Value())
 		if expiredHeight <= height {
 			if bz := spStore.Get(iterator.Key()); bz != nil {
 				spID := binary.BigEndian.Uint32(bz)
+				expired = append(expired, spID)
 				if _, ok := seen[spID]; !ok {
 					seen[spID] = struct{}{}
 					affected = append(affected, spID)
@@ -94,6 +95,10 @@ func (k Keeper) RemoveChallengeUntil(ctx sdk.Context, height uint64) {
 	for _, spID := range affected {
 		k.releaseDepositLock(ctx, spID)
 	}
+	// a challenge expired without a succeeded attestation is passed by the sp
+	for _, spID := range expired {
+		k.recordChallengeFailed(ctx, spID, false)
+	}
 }
 
 // RemoveChallenge retires an attested challenge from the active set, making re-attestation idempotent.
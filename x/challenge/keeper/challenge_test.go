@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -92,6 +93,7 @@ func makeKeeper(t *testing.T) (*keeper.Keeper, sdk.Context) {
 		&types.MockSpKeeper{},
 		&types.MockStakingKeeper{},
 		&types.MockPaymentKeeper{},
+		&types.MockVirtualGroupKeeper{},
 		authtypes.NewModuleAddress(types.ModuleName).String(),
 	)
 
@@ -116,3 +118,39 @@ func (s *TestSuite) TestDepositLockFollowsOpenChallenges() {
 	s.spKeeper.EXPECT().ReleaseDepositLockUntil(gomock.Any(), gomock.Eq(spID), gomock.Eq(uint64(0))).Times(1)
 	s.challengeKeeper.RemoveChallenge(s.ctx, 2)
 }
+
+func (s *TestSuite) TestSpChallengeStats() {
+	const spID = uint32(7)
+
+	s.challengeKeeper.SaveChallenge(s.ctx, types.Challenge{Id: 1, ExpiredHeight: 100})
+	s.challengeKeeper.SaveChallengeSpID(s.ctx, 1, spID)
+	s.challengeKeeper.RecordChallengeReceived(s.ctx, spID)
+	s.challengeKeeper.SaveChallenge(s.ctx, types.Challenge{Id: 2, ExpiredHeight: 100})
+	s.challengeKeeper.SaveChallengeSpID(s.ctx, 2, spID)
+	s.challengeKeeper.RecordChallengeReceived(s.ctx, spID)
+
+	// no challenge is resolved yet
+	res, err := s.queryClient.SpChallengeStats(s.ctx, &types.QuerySpChallengeStatsRequest{SpId: spID})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.Stats.ChallengesReceived)
+	s.Require().True(res.ReliabilityScore.Equal(sdkmath.LegacyOneDec()))
+
+	// the expired challenges are passed by the sp
+	s.spKeeper.EXPECT().ReleaseDepositLockUntil(gomock.Any(), gomock.Eq(spID), gomock.Any()).AnyTimes()
+	s.challengeKeeper.RemoveChallengeUntil(s.ctx, 100)
+	stats := s.challengeKeeper.GetSpChallengeStats(s.ctx, spID)
+	s.Require().Equal(uint64(2), stats.ChallengesFailed)
+	s.Require().Equal(uint64(0), stats.Heartbeats)
+
+	stats.ChallengesSucceeded = 2
+	stats.TotalSlashed = sdkmath.NewInt(100)
+	s.challengeKeeper.SetSpChallengeStats(s.ctx, stats)
+	res, err = s.queryClient.SpChallengeStats(s.ctx, &types.QuerySpChallengeStatsRequest{SpId: spID})
+	s.Require().NoError(err)
+	s.Require().True(res.ReliabilityScore.Equal(sdkmath.LegacyNewDecWithPrec(5, 1)))
+
+	all, err := s.queryClient.AllSpChallengeStats(s.ctx, &types.QueryAllSpChallengeStatsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(all.Stats, 1)
+	s.Require().Equal(stats, all.Stats[0])
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/hex"
 
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -78,3 +80,41 @@ func (k Keeper) InturnAttestationSubmitter(goCtx context.Context, req *types.Que
 		SubmitInterval: interval,
 	}, nil
 }
+
+func (k Keeper) SpChallengeStats(goCtx context.Context, req *types.QuerySpChallengeStatsRequest) (*types.QuerySpChallengeStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stats := k.GetSpChallengeStats(ctx, req.SpId)
+	return &types.QuerySpChallengeStatsResponse{
+		Stats:            stats,
+		ReliabilityScore: stats.ReliabilityScore(),
+	}, nil
+}
+
+func (k Keeper) AllSpChallengeStats(goCtx context.Context, req *types.QueryAllSpChallengeStatsRequest) (*types.QueryAllSpChallengeStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var stats []types.SpChallengeStats
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpChallengeStatsKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var s types.SpChallengeStats
+		if err := k.cdc.Unmarshal(value, &s); err != nil {
+			return err
+		}
+		stats = append(stats, s)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSpChallengeStatsResponse{Stats: stats, Pagination: pageRes}, nil
+}
@@ -138,6 +138,7 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 		}
 		k.SaveSlash(ctx, slash)
 		k.SetSpSlashAmount(ctx, sp.Id, slashedAmount.Add(toSlashAmount))
+		k.recordChallengeSucceeded(ctx, sp.Id, toSlashAmount)
 	} else {
 		// check whether it is a heartbeat attest
 		heartbeatInterval := k.GetParams(ctx).HeartbeatInterval
@@ -150,6 +151,7 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 		if err != nil {
 			return nil, err
 		}
+		k.recordChallengeFailed(ctx, sp.Id, true)
 	}
 
 	// Retire the attested challenge so duplicate submissions fail the ExistsChallenge gate above.
@@ -100,6 +100,7 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 		ExpiredHeight: expiredHeight,
 	})
 	k.SaveChallengeSpID(ctx, challengeID, challengedSpID)
+	k.RecordChallengeReceived(ctx, challengedSpID)
 	k.SpKeeper.SetDepositLockUntil(ctx, challengedSpID, expiredHeight)
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventStartChallenge{
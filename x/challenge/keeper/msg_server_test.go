@@ -26,11 +26,12 @@ type TestSuite struct {
 	cdc             codec.Codec
 	challengeKeeper *keeper.Keeper
 
-	bankKeeper    *types.MockBankKeeper
-	storageKeeper *types.MockStorageKeeper
-	spKeeper      *types.MockSpKeeper
-	stakingKeeper *types.MockStakingKeeper
-	paymentKeeper *types.MockPaymentKeeper
+	bankKeeper         *types.MockBankKeeper
+	storageKeeper      *types.MockStorageKeeper
+	spKeeper           *types.MockSpKeeper
+	stakingKeeper      *types.MockStakingKeeper
+	paymentKeeper      *types.MockPaymentKeeper
+	virtualGroupKeeper *types.MockVirtualGroupKeeper
 
 	ctx         sdk.Context
 	queryClient types.QueryClient
@@ -62,6 +63,7 @@ func (s *TestSuite) SetupTest() {
 	spKeeper := types.NewMockSpKeeper(ctrl)
 	stakingKeeper := types.NewMockStakingKeeper(ctrl)
 	paymentKeeper := types.NewMockPaymentKeeper(ctrl)
+	virtualGroupKeeper := types.NewMockVirtualGroupKeeper(ctrl)
 
 	s.challengeKeeper = keeper.NewKeeper(
 		encCfg.Codec,
@@ -72,6 +74,7 @@ func (s *TestSuite) SetupTest() {
 		spKeeper,
 		stakingKeeper,
 		paymentKeeper,
+		virtualGroupKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -81,6 +84,7 @@ func (s *TestSuite) SetupTest() {
 	s.spKeeper = spKeeper
 	s.stakingKeeper = stakingKeeper
 	s.paymentKeeper = paymentKeeper
+	s.virtualGroupKeeper = virtualGroupKeeper
 
 	// raising a challenge locks the deposit of the challenged sp
 	spKeeper.EXPECT().SetDepositLockUntil(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
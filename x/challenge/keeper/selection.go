@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/mocachain/moca/v2/x/challenge/types"
+	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
+	virtualgrouptypes "github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+// BaseSelectionWeight is the weight every object and storage provider has, the challenge selection weights are extra
+// weights in percent of it.
+const BaseSelectionWeight = 100
+
+// ChallengeSelector decides which objects and storage providers the random challenges go to. The decisions should
+// only depend on the seed and the chain state, so that all the validators raise the same challenges.
+type ChallengeSelector interface {
+	// AcceptObject returns whether the object randomly drawn by the seed is challenged.
+	AcceptObject(ctx sdk.Context, seed []byte, objectInfo *storagetypes.ObjectInfo, gvg *virtualgrouptypes.GlobalVirtualGroup) bool
+	// SelectRedundancyIndex returns the redundancy index of the storage provider challenged for the object.
+	SelectRedundancyIndex(ctx sdk.Context, seed []byte, gvg *virtualgrouptypes.GlobalVirtualGroup) int32
+	// Oversampling returns how many times the objects are drawn compared to the uniform selection, so that enough
+	// objects are accepted in a block.
+	Oversampling() uint64
+}
+
+// GetChallengeSelector returns the challenge selector of the block, the objects and storage providers are selected
+// uniformly unless any challenge selection weight is set.
+func (k Keeper) GetChallengeSelector(ctx sdk.Context) ChallengeSelector {
+	params := k.GetParams(ctx)
+	if params.ChallengeSelection.TotalWeight() == 0 {
+		return uniformSelector{}
+	}
+	return &WeightedSelector{
+		keeper:           k,
+		params:           params.ChallengeSelection,
+		spSlashMaxAmount: params.SpSlashMaxAmount,
+		spWeights:        make(map[uint32]uint64),
+	}
+}
+
+// uniformSelector challenges every object drawn and picks the storage providers uniformly.
+type uniformSelector struct{}
+
+func (uniformSelector) AcceptObject(sdk.Context, []byte, *storagetypes.ObjectInfo, *virtualgrouptypes.GlobalVirtualGroup) bool {
+	return true
+}
+
+func (uniformSelector) SelectRedundancyIndex(_ sdk.Context, seed []byte, gvg *virtualgrouptypes.GlobalVirtualGroup) int32 {
+	return RandomRedundancyIndex(seed, uint64(len(gvg.SecondarySpIds)+1))
+}
+
+func (uniformSelector) Oversampling() uint64 {
+	return 1
+}
+
+// WeightedSelector challenges the objects drawn in proportion to their weights, by rejection sampling, and picks the
+// storage providers in proportion to theirs. Large objects, objects in new global virtual groups and objects stored
+// by storage providers recently slashed, swapped in or back from maintenance are challenged more often.
+type WeightedSelector struct {
+	keeper           Keeper
+	params           types.ChallengeSelectionParams
+	spSlashMaxAmount sdkmath.Int
+
+	spWeights map[uint32]uint64 // the cached extra weights of the storage providers in the block
+}
+
+func (s *WeightedSelector) AcceptObject(ctx sdk.Context, seed []byte, objectInfo *storagetypes.ObjectInfo,
+	gvg *virtualgrouptypes.GlobalVirtualGroup,
+) bool {
+	maxWeight := BaseSelectionWeight + s.params.TotalWeight()
+	number := new(big.Int).SetBytes(crypto.Keccak256(seed, []byte("challenge-selection")))
+	return new(big.Int).Mod(number, new(big.Int).SetUint64(maxWeight)).Uint64() < s.ObjectWeight(ctx, objectInfo, gvg)
+}
+
+func (s *WeightedSelector) SelectRedundancyIndex(ctx sdk.Context, seed []byte, gvg *virtualgrouptypes.GlobalVirtualGroup) int32 {
+	weights := make([]uint64, 0, len(gvg.SecondarySpIds)+1)
+	weights = append(weights, BaseSelectionWeight+s.SpWeight(ctx, gvg.PrimarySpId))
+	for _, spID := range gvg.SecondarySpIds {
+		weights = append(weights, BaseSelectionWeight+s.SpWeight(ctx, spID))
+	}
+	return int32(RandomWeightedIndex(seed[32:], weights)) - 1
+}
+
+func (s *WeightedSelector) Oversampling() uint64 {
+	return (BaseSelectionWeight + s.params.TotalWeight() + BaseSelectionWeight - 1) / BaseSelectionWeight
+}
+
+// ObjectWeight returns the weight of the object, which is the base weight plus its size and global virtual group age
+// weights and the largest extra weight of the storage providers storing it.
+func (s *WeightedSelector) ObjectWeight(ctx sdk.Context, objectInfo *storagetypes.ObjectInfo,
+	gvg *virtualgrouptypes.GlobalVirtualGroup,
+) uint64 {
+	weight := uint64(BaseSelectionWeight)
+	if s.params.SizeWeight > 0 {
+		size := min(objectInfo.PayloadSize, s.params.LargeObjectSize)
+		weight += uint64(s.params.SizeWeight) * size / s.params.LargeObjectSize
+	}
+	if s.params.GvgAgeWeight > 0 {
+		if createTime, found := s.keeper.virtualGroupKeeper.GetGVGCreateTime(ctx, gvg.Id); found {
+			weight += s.recencyWeight(ctx, s.params.GvgAgeWeight, createTime)
+		}
+	}
+
+	spWeight := s.SpWeight(ctx, gvg.PrimarySpId)
+	for _, spID := range gvg.SecondarySpIds {
+		spWeight = max(spWeight, s.SpWeight(ctx, spID))
+	}
+	return weight + spWeight
+}
+
+// SpWeight returns the extra weight of the storage provider from its slash amount in the counting window, its last
+// swap in and its last maintenance.
+func (s *WeightedSelector) SpWeight(ctx sdk.Context, spID uint32) uint64 {
+	if weight, ok := s.spWeights[spID]; ok {
+		return weight
+	}
+
+	weight := uint64(0)
+	if s.params.SlashWeight > 0 && s.spSlashMaxAmount.IsPositive() {
+		slashed := sdkmath.MinInt(s.keeper.GetSpSlashAmount(ctx, spID), s.spSlashMaxAmount)
+		weight += sdkmath.NewIntFromUint64(uint64(s.params.SlashWeight)).Mul(slashed).Quo(s.spSlashMaxAmount).Uint64()
+	}
+	if s.params.SwapInWeight > 0 {
+		if swapInTime, found := s.keeper.virtualGroupKeeper.GetSpSwapInTime(ctx, spID); found {
+			weight += s.recencyWeight(ctx, s.params.SwapInWeight, swapInTime)
+		}
+	}
+	if s.params.MaintenanceWeight > 0 {
+		if sp, found := s.keeper.SpKeeper.GetStorageProvider(ctx, spID); found {
+			if endTime, found := s.keeper.SpKeeper.GetMaintenanceEndTime(ctx, sp); found {
+				weight += s.recencyWeight(ctx, s.params.MaintenanceWeight, endTime)
+			}
+		}
+	}
+
+	s.spWeights[spID] = weight
+	return weight
+}
+
+// recencyWeight returns the weight fading linearly from the given time to the end of the recency window.
+func (s *WeightedSelector) recencyWeight(ctx sdk.Context, weight uint32, since int64) uint64 {
+	elapsed := ctx.BlockTime().Unix() - since
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	if uint64(elapsed) >= s.params.RecencyWindow {
+		return 0
+	}
+	return uint64(weight) * (s.params.RecencyWindow - uint64(elapsed)) / s.params.RecencyWindow
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/x/challenge/keeper"
+	"github.com/mocachain/moca/v2/x/challenge/types"
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
+	virtualgrouptypes "github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestChallengeSelector() {
+	// the objects are selected uniformly by default
+	selector := s.challengeKeeper.GetChallengeSelector(s.ctx)
+	s.Require().Equal(uint64(1), selector.Oversampling())
+
+	params := s.challengeKeeper.GetParams(s.ctx)
+	params.ChallengeSelection = types.ChallengeSelectionParams{
+		SizeWeight:        100,
+		LargeObjectSize:   1000,
+		SlashWeight:       200,
+		GvgAgeWeight:      100,
+		SwapInWeight:      100,
+		MaintenanceWeight: 100,
+		RecencyWindow:     1000,
+	}
+	s.Require().NoError(s.challengeKeeper.SetParams(s.ctx, params))
+	ctx := s.ctx.WithBlockTime(time.Unix(10000, 0))
+
+	// sp 1 is slashed half of the max amount, sp 2 swapped in 100 seconds ago, sp 3 is back from maintenance too long ago
+	s.challengeKeeper.SetSpSlashAmount(ctx, 1, params.SpSlashMaxAmount.QuoRaw(2))
+	s.virtualGroupKeeper.EXPECT().GetSpSwapInTime(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, spID uint32) (int64, bool) {
+			if spID == 2 {
+				return 9900, true
+			}
+			return 0, false
+		}).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, spID uint32) (*sptypes.StorageProvider, bool) {
+			return &sptypes.StorageProvider{Id: spID, Status: sptypes.STATUS_IN_SERVICE}, true
+		}).AnyTimes()
+	s.spKeeper.EXPECT().GetMaintenanceEndTime(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, sp *sptypes.StorageProvider) (int64, bool) {
+			if sp.Id == 3 {
+				return 9000, true
+			}
+			return 0, false
+		}).AnyTimes()
+	// the gvg was created 500 seconds ago
+	s.virtualGroupKeeper.EXPECT().GetGVGCreateTime(gomock.Any(), uint32(1)).Return(int64(9500), true).AnyTimes()
+
+	selector = s.challengeKeeper.GetChallengeSelector(ctx)
+	s.Require().Equal(uint64(7), selector.Oversampling())
+	weighted, ok := selector.(*keeper.WeightedSelector)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(100), weighted.SpWeight(ctx, 1))
+	s.Require().Equal(uint64(90), weighted.SpWeight(ctx, 2))
+	s.Require().Equal(uint64(0), weighted.SpWeight(ctx, 3))
+
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{Id: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3}}
+	objectInfo := &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1), PayloadSize: 500}
+	// base 100 + size 50 + gvg age 50 + the largest sp weight 100
+	s.Require().Equal(uint64(300), weighted.ObjectWeight(ctx, objectInfo, gvg))
+
+	// the selection is deterministic from the seed
+	seed := keeper.SeedFromRandaoMix(ctx.BlockHeader().RandaoMix, 1)
+	s.Require().Equal(selector.AcceptObject(ctx, seed, objectInfo, gvg), selector.AcceptObject(ctx, seed, objectInfo, gvg))
+	s.Require().Equal(selector.SelectRedundancyIndex(ctx, seed, gvg), selector.SelectRedundancyIndex(ctx, seed, gvg))
+}
+
+func (s *TestSuite) TestRandomWeightedIndex() {
+	for i := uint64(0); i < 10; i++ {
+		seed := keeper.SeedFromRandaoMix(s.ctx.BlockHeader().RandaoMix, i)
+		s.Require().Equal(1, keeper.RandomWeightedIndex(seed, []uint64{0, 5, 0}))
+	}
+	s.Require().Equal(0, keeper.RandomWeightedIndex([]byte{1}, []uint64{0, 0}))
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/challenge/types"
+)
+
+// GetSpChallengeStats returns the challenge statistics of a sp, which are empty if the sp is never challenged.
+func (k Keeper) GetSpChallengeStats(ctx sdk.Context, spID uint32) types.SpChallengeStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpChallengeStatsKeyPrefix)
+	bz := store.Get(getSpChallengeStatsKeyBytes(spID))
+	if bz == nil {
+		return types.NewSpChallengeStats(spID)
+	}
+
+	var stats types.SpChallengeStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// SetSpChallengeStats sets the challenge statistics of a sp.
+func (k Keeper) SetSpChallengeStats(ctx sdk.Context, stats types.SpChallengeStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpChallengeStatsKeyPrefix)
+	store.Set(getSpChallengeStatsKeyBytes(stats.SpId), k.cdc.MustMarshal(&stats))
+}
+
+// RecordChallengeReceived counts a challenge raised against a sp.
+func (k Keeper) RecordChallengeReceived(ctx sdk.Context, spID uint32) {
+	stats := k.GetSpChallengeStats(ctx, spID)
+	stats.ChallengesReceived++
+	k.SetSpChallengeStats(ctx, stats)
+}
+
+// recordChallengeSucceeded counts a challenge attested as succeeded against a sp, along with the slashed amount.
+func (k Keeper) recordChallengeSucceeded(ctx sdk.Context, spID uint32, slashAmount sdkmath.Int) {
+	stats := k.GetSpChallengeStats(ctx, spID)
+	stats.ChallengesSucceeded++
+	stats.TotalSlashed = stats.TotalSlashed.Add(slashAmount)
+	k.SetSpChallengeStats(ctx, stats)
+}
+
+// recordChallengeFailed counts a challenge a sp passed, which is a heartbeat if it is attested.
+func (k Keeper) recordChallengeFailed(ctx sdk.Context, spID uint32, heartbeat bool) {
+	stats := k.GetSpChallengeStats(ctx, spID)
+	stats.ChallengesFailed++
+	if heartbeat {
+		stats.Heartbeats++
+	}
+	k.SetSpChallengeStats(ctx, stats)
+}
+
+// getSpChallengeStatsKeyBytes returns the byte representation of sp challenge stats key
+func getSpChallengeStatsKeyBytes(spID uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, spID)
+	return bz
+}
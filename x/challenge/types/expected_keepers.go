@@ -19,6 +19,7 @@ type SpKeeper interface {
 	Slash(ctx sdk.Context, spID uint32, rewardInfos []sp.RewardInfo) error
 	SetDepositLockUntil(ctx sdk.Context, spID uint32, height uint64)
 	ReleaseDepositLockUntil(ctx sdk.Context, spID uint32, height uint64)
+	GetMaintenanceEndTime(ctx sdk.Context, sp *sp.StorageProvider) (int64, bool)
 }
 
 type StakingKeeper interface {
@@ -36,6 +37,11 @@ type StorageKeeper interface {
 	MustGetPrimarySPForBucket(ctx sdk.Context, bucketInfo *storage.BucketInfo) *sp.StorageProvider
 }
 
+type VirtualGroupKeeper interface {
+	GetGVGCreateTime(ctx sdk.Context, gvgID uint32) (int64, bool)
+	GetSpSwapInTime(ctx sdk.Context, spID uint32) (int64, bool)
+}
+
 type PaymentKeeper interface {
 	QueryDynamicBalance(ctx sdk.Context, addr sdk.AccAddress) (amount sdkmath.Int, err error)
 	Withdraw(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amount sdkmath.Int) error
@@ -60,6 +60,21 @@ func (mr *MockSpKeeperMockRecorder) DepositDenomForSP(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositDenomForSP", reflect.TypeOf((*MockSpKeeper)(nil).DepositDenomForSP), ctx)
 }
 
+// GetMaintenanceEndTime mocks base method.
+func (m *MockSpKeeper) GetMaintenanceEndTime(ctx types.Context, sp *types1.StorageProvider) (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceEndTime", ctx, sp)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetMaintenanceEndTime indicates an expected call of GetMaintenanceEndTime.
+func (mr *MockSpKeeperMockRecorder) GetMaintenanceEndTime(ctx, sp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceEndTime", reflect.TypeOf((*MockSpKeeper)(nil).GetMaintenanceEndTime), ctx, sp)
+}
+
 // GetStorageProvider mocks base method.
 func (m *MockSpKeeper) GetStorageProvider(ctx types.Context, id uint32) (*types1.StorageProvider, bool) {
 	m.ctrl.T.Helper()
@@ -309,6 +324,60 @@ func (mr *MockStorageKeeperMockRecorder) MustGetPrimarySPForBucket(ctx, bucketIn
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustGetPrimarySPForBucket", reflect.TypeOf((*MockStorageKeeper)(nil).MustGetPrimarySPForBucket), ctx, bucketInfo)
 }
 
+// MockVirtualGroupKeeper is a mock of VirtualGroupKeeper interface.
+type MockVirtualGroupKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualGroupKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockVirtualGroupKeeperMockRecorder is the mock recorder for MockVirtualGroupKeeper.
+type MockVirtualGroupKeeperMockRecorder struct {
+	mock *MockVirtualGroupKeeper
+}
+
+// NewMockVirtualGroupKeeper creates a new mock instance.
+func NewMockVirtualGroupKeeper(ctrl *gomock.Controller) *MockVirtualGroupKeeper {
+	mock := &MockVirtualGroupKeeper{ctrl: ctrl}
+	mock.recorder = &MockVirtualGroupKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirtualGroupKeeper) EXPECT() *MockVirtualGroupKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetGVGCreateTime mocks base method.
+func (m *MockVirtualGroupKeeper) GetGVGCreateTime(ctx types.Context, gvgID uint32) (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGVGCreateTime", ctx, gvgID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetGVGCreateTime indicates an expected call of GetGVGCreateTime.
+func (mr *MockVirtualGroupKeeperMockRecorder) GetGVGCreateTime(ctx, gvgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGVGCreateTime", reflect.TypeOf((*MockVirtualGroupKeeper)(nil).GetGVGCreateTime), ctx, gvgID)
+}
+
+// GetSpSwapInTime mocks base method.
+func (m *MockVirtualGroupKeeper) GetSpSwapInTime(ctx types.Context, spID uint32) (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpSwapInTime", ctx, spID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSpSwapInTime indicates an expected call of GetSpSwapInTime.
+func (mr *MockVirtualGroupKeeperMockRecorder) GetSpSwapInTime(ctx, spID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpSwapInTime", reflect.TypeOf((*MockVirtualGroupKeeper)(nil).GetSpSwapInTime), ctx, spID)
+}
+
 // MockPaymentKeeper is a mock of PaymentKeeper interface.
 type MockPaymentKeeper struct {
 	ctrl     *gomock.Controller
@@ -52,4 +52,7 @@ var (
 	// ChallengeSpKeyPrefix is the prefix to retrieve the id of the sp a challenge was raised
 	// against, so the attestation does not have to re-derive it from live state.
 	ChallengeSpKeyPrefix = []byte{0x19}
+
+	// SpChallengeStatsKeyPrefix is the prefix to retrieve the challenge statistics of a sp, which are never pruned.
+	SpChallengeStatsKeyPrefix = []byte{0x1A}
 )
@@ -79,6 +79,19 @@ var (
 	DefaultSpSlashCountingWindow = uint64(43200) // about one day
 )
 
+var (
+	KeyChallengeSelection = []byte("ChallengeSelection")
+	// DefaultChallengeSelection selects the challenged objects uniformly, the weights are left to the governance
+	DefaultChallengeSelection = ChallengeSelectionParams{
+		LargeObjectSize: 1 << 30,           // 1 GiB
+		RecencyWindow:   30 * 24 * 60 * 60, // 30 days
+	}
+)
+
+// MaxChallengeSelectionWeight is the max sum of the challenge selection weights, which keeps the random challenges
+// from drawing too many objects to find a heavy one.
+const MaxChallengeSelectionWeight = 10000
+
 // NewParams creates a new Params instance
 func NewParams(
 	challengeCountPerBlock uint64,
@@ -95,6 +108,7 @@ func NewParams(
 	attestationKeptCount uint64,
 	spSlashMaxAmount math.Int,
 	spSlashCountingWindow uint64,
+	challengeSelection ChallengeSelectionParams,
 ) Params {
 	return Params{
 		ChallengeCountPerBlock:    challengeCountPerBlock,
@@ -111,6 +125,7 @@ func NewParams(
 		AttestationKeptCount:      attestationKeptCount,
 		SpSlashMaxAmount:          spSlashMaxAmount,
 		SpSlashCountingWindow:     spSlashCountingWindow,
+		ChallengeSelection:        challengeSelection,
 	}
 }
 
@@ -131,6 +146,7 @@ func DefaultParams() Params {
 		DefaultAttestationKeptCount,
 		DefaultSpSlashMaxAmount,
 		DefaultSpSlashCountingWindow,
+		DefaultChallengeSelection,
 	)
 }
 
@@ -200,6 +216,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateChallengeSelection(p.ChallengeSelection); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -421,3 +441,29 @@ func validateSpSlashCountingWindow(v interface{}) error {
 
 	return nil
 }
+
+// validateChallengeSelection validates the ChallengeSelection param
+func validateChallengeSelection(v interface{}) error {
+	selection, ok := v.(ChallengeSelectionParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if selection.SizeWeight > 0 && selection.LargeObjectSize == 0 {
+		return errors.New("large object size cannot be zero when the size weight is set")
+	}
+	if (selection.GvgAgeWeight > 0 || selection.SwapInWeight > 0 || selection.MaintenanceWeight > 0) && selection.RecencyWindow == 0 {
+		return errors.New("recency window cannot be zero when the gvg age, swap in or maintenance weight is set")
+	}
+	if selection.TotalWeight() > MaxChallengeSelectionWeight {
+		return fmt.Errorf("the sum of challenge selection weights cannot be greater than %d", MaxChallengeSelectionWeight)
+	}
+
+	return nil
+}
+
+// TotalWeight returns the sum of the weights, which is the max extra weight an object can get.
+func (p ChallengeSelectionParams) TotalWeight() uint64 {
+	return uint64(p.SizeWeight) + uint64(p.SlashWeight) + uint64(p.GvgAgeWeight) + uint64(p.SwapInWeight) +
+		uint64(p.MaintenanceWeight)
+}
@@ -56,6 +56,8 @@ type Params struct {
 	SpSlashMaxAmount cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=sp_slash_max_amount,json=spSlashMaxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"sp_slash_max_amount"`
 	// The number of blocks to count how much a sp had been slashed.
 	SpSlashCountingWindow uint64 `protobuf:"varint,14,opt,name=sp_slash_counting_window,json=spSlashCountingWindow,proto3" json:"sp_slash_counting_window,omitempty" yaml:"sp_slash_counting_window"`
+	// The weighting of the objects and storage providers selected by random challenges, all zero for a uniform selection.
+	ChallengeSelection ChallengeSelectionParams `protobuf:"bytes,15,opt,name=challenge_selection,json=challengeSelection,proto3" json:"challenge_selection" yaml:"challenge_selection"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -139,60 +141,185 @@ func (m *Params) GetSpSlashCountingWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetChallengeSelection() ChallengeSelectionParams {
+	if m != nil {
+		return m.ChallengeSelection
+	}
+	return ChallengeSelectionParams{}
+}
+
+// ChallengeSelectionParams defines how the random challenges are weighted towards the risky data. Each weight is the
+// extra chance of being challenged, in percent of a plain object or storage provider, given to the riskiest ones.
+type ChallengeSelectionParams struct {
+	// The extra weight of the objects as large as the large object size.
+	SizeWeight uint32 `protobuf:"varint,1,opt,name=size_weight,json=sizeWeight,proto3" json:"size_weight,omitempty" yaml:"size_weight"`
+	// The payload size from which an object gets the full size weight, the weight is linear below it.
+	LargeObjectSize uint64 `protobuf:"varint,2,opt,name=large_object_size,json=largeObjectSize,proto3" json:"large_object_size,omitempty" yaml:"large_object_size"`
+	// The extra weight of the storage providers slashed up to the sp slash max amount in the counting window.
+	SlashWeight uint32 `protobuf:"varint,3,opt,name=slash_weight,json=slashWeight,proto3" json:"slash_weight,omitempty" yaml:"slash_weight"`
+	// The extra weight of the objects in a global virtual group just created, fading over the recency window.
+	GvgAgeWeight uint32 `protobuf:"varint,4,opt,name=gvg_age_weight,json=gvgAgeWeight,proto3" json:"gvg_age_weight,omitempty" yaml:"gvg_age_weight"`
+	// The extra weight of the storage providers which just took over a family or global virtual group by swap in or
+	// swap out, fading over the recency window.
+	SwapInWeight uint32 `protobuf:"varint,5,opt,name=swap_in_weight,json=swapInWeight,proto3" json:"swap_in_weight,omitempty" yaml:"swap_in_weight"`
+	// The extra weight of the storage providers just back from maintenance, fading over the recency window.
+	MaintenanceWeight uint32 `protobuf:"varint,6,opt,name=maintenance_weight,json=maintenanceWeight,proto3" json:"maintenance_weight,omitempty" yaml:"maintenance_weight"`
+	// The period in seconds over which the gvg age, swap in and maintenance weights fade out.
+	RecencyWindow uint64 `protobuf:"varint,7,opt,name=recency_window,json=recencyWindow,proto3" json:"recency_window,omitempty" yaml:"recency_window"`
+}
+
+func (m *ChallengeSelectionParams) Reset()         { *m = ChallengeSelectionParams{} }
+func (m *ChallengeSelectionParams) String() string { return proto.CompactTextString(m) }
+func (*ChallengeSelectionParams) ProtoMessage()    {}
+func (*ChallengeSelectionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b972801e25bdd9d0, []int{1}
+}
+func (m *ChallengeSelectionParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChallengeSelectionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChallengeSelectionParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChallengeSelectionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeSelectionParams.Merge(m, src)
+}
+func (m *ChallengeSelectionParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChallengeSelectionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeSelectionParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeSelectionParams proto.InternalMessageInfo
+
+func (m *ChallengeSelectionParams) GetSizeWeight() uint32 {
+	if m != nil {
+		return m.SizeWeight
+	}
+	return 0
+}
+
+func (m *ChallengeSelectionParams) GetLargeObjectSize() uint64 {
+	if m != nil {
+		return m.LargeObjectSize
+	}
+	return 0
+}
+
+func (m *ChallengeSelectionParams) GetSlashWeight() uint32 {
+	if m != nil {
+		return m.SlashWeight
+	}
+	return 0
+}
+
+func (m *ChallengeSelectionParams) GetGvgAgeWeight() uint32 {
+	if m != nil {
+		return m.GvgAgeWeight
+	}
+	return 0
+}
+
+func (m *ChallengeSelectionParams) GetSwapInWeight() uint32 {
+	if m != nil {
+		return m.SwapInWeight
+	}
+	return 0
+}
+
+func (m *ChallengeSelectionParams) GetMaintenanceWeight() uint32 {
+	if m != nil {
+		return m.MaintenanceWeight
+	}
+	return 0
+}
+
+func (m *ChallengeSelectionParams) GetRecencyWindow() uint64 {
+	if m != nil {
+		return m.RecencyWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "moca.challenge.Params")
+	proto.RegisterType((*ChallengeSelectionParams)(nil), "moca.challenge.ChallengeSelectionParams")
 }
 
 func init() { proto.RegisterFile("moca/challenge/params.proto", fileDescriptor_b972801e25bdd9d0) }
 
 var fileDescriptor_b972801e25bdd9d0 = []byte{
-	// 723 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0xbb, 0xbf, 0x1f, 0xa2, 0x0c, 0x4a, 0x60, 0x81, 0x3a, 0xa5, 0xa1, 0x5b, 0x57, 0x63,
-	0x08, 0x89, 0xad, 0xd1, 0x1b, 0x37, 0x8a, 0x07, 0x2b, 0x10, 0xc9, 0x62, 0x24, 0x21, 0x26, 0x9b,
-	0xe9, 0x76, 0xda, 0x9d, 0x74, 0x77, 0x66, 0xb3, 0x3b, 0x2d, 0x85, 0x83, 0x7a, 0xf6, 0xe4, 0xd1,
-	0xa3, 0x47, 0x8f, 0x1c, 0xfc, 0x23, 0x88, 0x27, 0xe2, 0xc9, 0x78, 0xd8, 0x18, 0x38, 0x70, 0xef,
-	0x5f, 0x60, 0x66, 0x66, 0xbb, 0x94, 0x16, 0x8c, 0x21, 0x5e, 0x36, 0xbb, 0xf3, 0x79, 0xef, 0xfb,
-	0x7d, 0x33, 0x79, 0xfb, 0x06, 0xe4, 0x7d, 0xe6, 0xa0, 0xb2, 0xe3, 0x22, 0xcf, 0xc3, 0xb4, 0x89,
-	0xcb, 0x01, 0x0a, 0x91, 0x1f, 0x95, 0x82, 0x90, 0x71, 0xa6, 0x4f, 0x09, 0x58, 0x4a, 0xe1, 0xc2,
-	0x0c, 0xf2, 0x09, 0x65, 0x65, 0xf9, 0x54, 0x21, 0x0b, 0x39, 0x87, 0x45, 0x3e, 0x8b, 0x6c, 0xf9,
-	0x55, 0x56, 0x1f, 0x09, 0x9a, 0x6b, 0xb2, 0x26, 0x53, 0xeb, 0xe2, 0x4d, 0xad, 0x9a, 0xdf, 0x26,
-	0xc1, 0xf8, 0x96, 0x34, 0xd1, 0x6d, 0x90, 0x4b, 0xb5, 0x6d, 0x87, 0xb5, 0x29, 0xb7, 0x03, 0x1c,
-	0xda, 0x35, 0x8f, 0x39, 0x2d, 0xa8, 0x15, 0xb5, 0xa5, 0xb1, 0xca, 0x83, 0x5e, 0x6c, 0x14, 0xf7,
-	0x91, 0xef, 0xad, 0x98, 0x57, 0x86, 0x9a, 0x56, 0x36, 0x65, 0x6b, 0x02, 0x6d, 0xe1, 0xb0, 0x22,
-	0x80, 0x8e, 0x41, 0xfe, 0x3c, 0xab, 0x85, 0x71, 0x60, 0x23, 0x8f, 0x74, 0xb0, 0x48, 0x25, 0xac,
-	0x0e, 0xff, 0x93, 0x16, 0x0f, 0x7b, 0xb1, 0x61, 0x0e, 0x5b, 0x8c, 0x04, 0x9b, 0x16, 0x4c, 0xe9,
-	0x3a, 0xc6, 0xc1, 0xaa, 0x60, 0x5b, 0x12, 0xe9, 0x6f, 0x00, 0x8c, 0x3c, 0x14, 0xb9, 0xb6, 0xc3,
-	0x98, 0x47, 0x68, 0xd3, 0x66, 0x8d, 0x46, 0xdf, 0xe3, 0x7f, 0xe9, 0x71, 0xbf, 0x17, 0x1b, 0x86,
-	0xf2, 0xb8, 0x2a, 0xd2, 0xb4, 0xe6, 0x25, 0x5a, 0x53, 0xe4, 0x65, 0xa3, 0x91, 0xa8, 0xbf, 0x05,
-	0x59, 0x95, 0x83, 0x7c, 0xb9, 0xef, 0x88, 0x1c, 0x60, 0x3b, 0x44, 0x1c, 0xc3, 0xb1, 0xa2, 0xb6,
-	0x34, 0x51, 0xa9, 0x1e, 0xc5, 0x46, 0xe6, 0x67, 0x6c, 0xe4, 0xd5, 0xe1, 0x47, 0xf5, 0x56, 0x89,
-	0xb0, 0xb2, 0x8f, 0xb8, 0x5b, 0xda, 0xc0, 0x4d, 0xe4, 0xec, 0x3f, 0xc3, 0x4e, 0x2f, 0x36, 0x16,
-	0x07, 0xed, 0x87, 0xa5, 0xcc, 0x2f, 0x67, 0x87, 0xcb, 0x9a, 0x35, 0x2b, 0xe9, 0xaa, 0x84, 0xdb,
-	0xe4, 0x00, 0x5b, 0x88, 0x63, 0x7d, 0x17, 0x4c, 0x5f, 0x48, 0xf2, 0x09, 0x85, 0x37, 0xa4, 0xf3,
-	0xe3, 0xc4, 0x79, 0x7e, 0xd4, 0xb9, 0x4a, 0xf9, 0xf7, 0xaf, 0x8f, 0x40, 0xd2, 0x0f, 0x55, 0xca,
-	0x95, 0xc1, 0xd4, 0x80, 0xc1, 0x26, 0xa1, 0xa3, 0xda, 0xa8, 0x0b, 0xc7, 0xff, 0x85, 0x36, 0xea,
-	0xea, 0xef, 0x40, 0x36, 0xc4, 0x7b, 0x28, 0xac, 0xdb, 0x1d, 0xe4, 0x91, 0x3a, 0xe2, 0x2c, 0x14,
-	0x7b, 0x25, 0x0c, 0xde, 0xbc, 0xc6, 0xb9, 0x5d, 0x2e, 0x95, 0x9c, 0xdb, 0x9c, 0xa2, 0xaf, 0xfb,
-	0xd0, 0x12, 0x4c, 0x7f, 0xaf, 0xa5, 0x15, 0x44, 0xed, 0x9a, 0x4f, 0x38, 0xc7, 0xfd, 0x0a, 0x6e,
-	0xc9, 0x0a, 0x5e, 0xfc, 0x5d, 0x05, 0x85, 0x0b, 0x15, 0xa4, 0x5d, 0x78, 0x69, 0x09, 0xdb, 0x7d,
-	0x23, 0x55, 0x02, 0x05, 0x0b, 0x23, 0x15, 0x70, 0x37, 0xc4, 0x91, 0xcb, 0xbc, 0x3a, 0x9c, 0xb8,
-	0xe6, 0x49, 0xc3, 0x21, 0xaf, 0x57, 0x7d, 0x45, 0x7d, 0x03, 0xe8, 0x2e, 0x46, 0x21, 0xaf, 0x61,
-	0xc4, 0x6d, 0x42, 0x39, 0x0e, 0x3b, 0xc8, 0x83, 0x40, 0xfe, 0x03, 0x8b, 0xbd, 0xd8, 0xc8, 0xa9,
-	0xad, 0x8c, 0xc6, 0x98, 0xd6, 0x4c, 0xba, 0x58, 0x4d, 0xd6, 0xf4, 0x06, 0xc8, 0x23, 0xce, 0x71,
-	0xc4, 0xc5, 0x5e, 0xa8, 0x88, 0x6d, 0x87, 0xf4, 0x5c, 0x76, 0x72, 0xf8, 0xf7, 0xfd, 0x43, 0xb0,
-	0x69, 0xe5, 0x06, 0x68, 0x55, 0xc2, 0xd4, 0x67, 0x07, 0x64, 0x07, 0x53, 0x5b, 0x38, 0xe0, 0x6a,
-	0xc6, 0xc0, 0xdb, 0xd2, 0xe2, 0xde, 0x79, 0x1b, 0x5c, 0x1e, 0x67, 0x5a, 0x73, 0x03, 0x60, 0x1d,
-	0x07, 0x5c, 0xce, 0x21, 0xdd, 0x06, 0xb3, 0x51, 0x60, 0xab, 0x0e, 0xf7, 0x51, 0x37, 0xe9, 0x72,
-	0x78, 0xe7, 0x9a, 0xe7, 0x3e, 0x1d, 0x05, 0xdb, 0x42, 0x6b, 0x13, 0x75, 0x55, 0x9b, 0xcb, 0xc9,
-	0xd3, 0x37, 0x90, 0x95, 0x88, 0x99, 0xb2, 0x47, 0x68, 0x9d, 0xed, 0xc1, 0xa9, 0x91, 0xc9, 0x73,
-	0x45, 0xa4, 0x98, 0x3c, 0x4a, 0x78, 0x2d, 0x01, 0x3b, 0x72, 0x7d, 0xa5, 0xf8, 0xe9, 0xb3, 0x91,
-	0xf9, 0x70, 0x76, 0xb8, 0x7c, 0x57, 0x5e, 0x12, 0xdd, 0x81, 0x6b, 0x42, 0x4d, 0xf0, 0xca, 0xf3,
-	0xa3, 0x93, 0x82, 0x76, 0x7c, 0x52, 0xd0, 0x7e, 0x9d, 0x14, 0xb4, 0x8f, 0xa7, 0x85, 0xcc, 0xf1,
-	0x69, 0x21, 0xf3, 0xe3, 0xb4, 0x90, 0xd9, 0x2d, 0x35, 0x09, 0x77, 0xdb, 0xb5, 0x92, 0xc3, 0xfc,
-	0xb2, 0xc8, 0x76, 0x5c, 0x44, 0xa8, 0x7c, 0x2b, 0x77, 0x9e, 0x5c, 0x90, 0xe2, 0xfb, 0x01, 0x8e,
-	0x6a, 0xe3, 0xf2, 0x76, 0x78, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x54, 0xcd, 0xe8, 0x71, 0x90,
-	0x06, 0x00, 0x00,
+	// 966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3f, 0x6f, 0xdb, 0xc6,
+	0x1b, 0x96, 0x7e, 0x76, 0x9c, 0x5f, 0xce, 0xb2, 0x12, 0x9f, 0x6d, 0x85, 0xb2, 0x1b, 0x51, 0xbd,
+	0x16, 0x85, 0x11, 0xa0, 0x52, 0x91, 0x0e, 0x05, 0xbc, 0xb4, 0x96, 0x3b, 0x44, 0x8d, 0x83, 0x18,
+	0x74, 0x51, 0x03, 0x41, 0x01, 0xe2, 0x44, 0x9d, 0xa8, 0xab, 0xc9, 0x3b, 0x82, 0x3c, 0x4b, 0x72,
+	0x80, 0xfe, 0x99, 0x3b, 0x75, 0xec, 0xd8, 0xb1, 0x63, 0x86, 0x7e, 0x88, 0x74, 0x0b, 0x3a, 0x15,
+	0x1d, 0x88, 0xc2, 0x1e, 0x32, 0x74, 0xe3, 0x27, 0x28, 0xee, 0x8e, 0xa4, 0x28, 0xc9, 0x2e, 0x0a,
+	0xa3, 0x8b, 0xc0, 0x7b, 0x9f, 0xf7, 0x7d, 0x9e, 0xe7, 0x0e, 0xef, 0xbd, 0x27, 0xb0, 0xe3, 0x73,
+	0x07, 0xb7, 0x9d, 0x21, 0xf6, 0x3c, 0xc2, 0x5c, 0xd2, 0x0e, 0x70, 0x88, 0xfd, 0xa8, 0x15, 0x84,
+	0x5c, 0x70, 0x58, 0x95, 0x60, 0x2b, 0x07, 0xb7, 0xd7, 0xb1, 0x4f, 0x19, 0x6f, 0xab, 0x5f, 0x9d,
+	0xb2, 0x5d, 0x77, 0x78, 0xe4, 0xf3, 0xc8, 0x56, 0xab, 0xb6, 0x5e, 0xa4, 0xd0, 0xa6, 0xcb, 0x5d,
+	0xae, 0xe3, 0xf2, 0x4b, 0x47, 0xd1, 0xaf, 0x15, 0xb0, 0x72, 0xa4, 0x44, 0xa0, 0x0d, 0xea, 0x39,
+	0xb7, 0xed, 0xf0, 0x33, 0x26, 0xec, 0x80, 0x84, 0x76, 0xcf, 0xe3, 0xce, 0xa9, 0x51, 0x6e, 0x96,
+	0x77, 0x97, 0x3b, 0xef, 0x26, 0xb1, 0xd9, 0x3c, 0xc7, 0xbe, 0xb7, 0x87, 0xae, 0x4d, 0x45, 0x56,
+	0x2d, 0xc7, 0x0e, 0x24, 0x74, 0x44, 0xc2, 0x8e, 0x04, 0x20, 0x01, 0x3b, 0xd3, 0xaa, 0x53, 0x42,
+	0x02, 0x1b, 0x7b, 0x74, 0x44, 0x64, 0x29, 0xe5, 0x7d, 0xe3, 0x7f, 0x4a, 0xe2, 0xbd, 0x24, 0x36,
+	0xd1, 0xbc, 0xc4, 0x42, 0x32, 0xb2, 0x8c, 0x1c, 0x7d, 0x42, 0x48, 0xb0, 0x2f, 0xb1, 0x23, 0x05,
+	0xc1, 0x2f, 0x81, 0x11, 0x79, 0x38, 0x1a, 0xda, 0x0e, 0xe7, 0x1e, 0x65, 0xae, 0xcd, 0x07, 0x83,
+	0x4c, 0x63, 0x49, 0x69, 0xbc, 0x93, 0xc4, 0xa6, 0xa9, 0x35, 0xae, 0xcb, 0x44, 0xd6, 0x96, 0x82,
+	0x0e, 0x34, 0xf2, 0x6c, 0x30, 0x48, 0xd9, 0xbf, 0x01, 0x35, 0x5d, 0x83, 0x7d, 0xb5, 0xef, 0x88,
+	0xbe, 0x20, 0x76, 0x88, 0x05, 0x31, 0x96, 0x9b, 0xe5, 0xdd, 0x3b, 0x9d, 0xee, 0xab, 0xd8, 0x2c,
+	0xfd, 0x11, 0x9b, 0x3b, 0xfa, 0xf0, 0xa3, 0xfe, 0x69, 0x8b, 0xf2, 0xb6, 0x8f, 0xc5, 0xb0, 0x75,
+	0x48, 0x5c, 0xec, 0x9c, 0x7f, 0x4a, 0x9c, 0x24, 0x36, 0x1f, 0x14, 0xe5, 0xe7, 0xa9, 0xd0, 0xcf,
+	0x6f, 0x5e, 0x3e, 0x2c, 0x5b, 0x1b, 0x0a, 0xdd, 0x57, 0xe0, 0x31, 0x7d, 0x41, 0x2c, 0x2c, 0x08,
+	0x7c, 0x0e, 0xee, 0xcd, 0x14, 0xf9, 0x94, 0x19, 0xb7, 0x94, 0xf2, 0x07, 0xa9, 0xf2, 0xd6, 0xa2,
+	0x72, 0x97, 0x89, 0xdf, 0x7e, 0x79, 0x1f, 0xa4, 0xfd, 0xd0, 0x65, 0x42, 0x0b, 0x54, 0x0b, 0x02,
+	0x4f, 0x29, 0x5b, 0xe4, 0xc6, 0x13, 0x63, 0xe5, 0xbf, 0xe0, 0xc6, 0x13, 0xf8, 0x2d, 0xa8, 0x85,
+	0x64, 0x8c, 0xc3, 0xbe, 0x3d, 0xc2, 0x1e, 0xed, 0x63, 0xc1, 0x43, 0xb9, 0x57, 0xca, 0x8d, 0xdb,
+	0x37, 0x38, 0xb7, 0xab, 0xa9, 0xd2, 0x73, 0xdb, 0xd4, 0xe8, 0x17, 0x19, 0x68, 0x49, 0x0c, 0x7e,
+	0x57, 0xce, 0x1d, 0x44, 0x67, 0x3d, 0x9f, 0x0a, 0x41, 0x32, 0x07, 0xff, 0x57, 0x0e, 0x3e, 0xfb,
+	0x77, 0x0e, 0x1a, 0x33, 0x0e, 0xf2, 0x2e, 0xbc, 0xd2, 0xc2, 0x71, 0x26, 0xa4, 0x2d, 0x30, 0xb0,
+	0xbd, 0xe0, 0x40, 0x0c, 0x43, 0x12, 0x0d, 0xb9, 0xd7, 0x37, 0xee, 0xdc, 0xf0, 0xa4, 0x8d, 0x39,
+	0xad, 0xcf, 0x33, 0x46, 0x78, 0x08, 0xe0, 0x90, 0xe0, 0x50, 0xf4, 0x08, 0x16, 0x36, 0x65, 0x82,
+	0x84, 0x23, 0xec, 0x19, 0x40, 0xdd, 0x81, 0x07, 0x49, 0x6c, 0xd6, 0xf5, 0x56, 0x16, 0x73, 0x90,
+	0xb5, 0x9e, 0x07, 0xbb, 0x69, 0x0c, 0x0e, 0xc0, 0x0e, 0x16, 0x82, 0x44, 0x42, 0xee, 0x85, 0xc9,
+	0xdc, 0xb3, 0x90, 0x4d, 0x69, 0x57, 0xe7, 0xaf, 0xef, 0x3f, 0x24, 0x23, 0xab, 0x5e, 0x40, 0xbb,
+	0x0a, 0xcc, 0x75, 0x4e, 0x40, 0xad, 0x58, 0x7a, 0x4a, 0x02, 0xa1, 0x67, 0x8c, 0x51, 0x51, 0x12,
+	0x6f, 0x4f, 0xdb, 0xe0, 0xea, 0x3c, 0x64, 0x6d, 0x16, 0x80, 0x27, 0x24, 0x10, 0x6a, 0x0e, 0x41,
+	0x1b, 0x6c, 0x44, 0x81, 0xad, 0x3b, 0xdc, 0xc7, 0x93, 0xb4, 0xcb, 0x8d, 0xb5, 0x1b, 0x9e, 0xfb,
+	0xbd, 0x28, 0x38, 0x96, 0x5c, 0x4f, 0xf1, 0x44, 0xb7, 0xb9, 0x9a, 0x3c, 0x99, 0x80, 0x72, 0x22,
+	0x67, 0xca, 0x98, 0xb2, 0x3e, 0x1f, 0x1b, 0xd5, 0x85, 0xc9, 0x73, 0x4d, 0xa6, 0x9c, 0x3c, 0x9a,
+	0xf8, 0x20, 0x05, 0x4e, 0x54, 0x1c, 0x7e, 0x0d, 0x36, 0xa6, 0x13, 0x31, 0x22, 0x1e, 0x71, 0xe4,
+	0xf6, 0x8c, 0xbb, 0xcd, 0xf2, 0xee, 0xea, 0xa3, 0xdd, 0xd6, 0xec, 0xe3, 0xd0, 0x3a, 0xc8, 0xbe,
+	0x8e, 0xb3, 0x4c, 0x3d, 0xe6, 0x3b, 0x48, 0x6e, 0x34, 0x89, 0xcd, 0xed, 0xf9, 0x21, 0x9b, 0x53,
+	0x22, 0x0b, 0x3a, 0x0b, 0xd5, 0x7b, 0xcd, 0x1f, 0x7f, 0x32, 0x4b, 0xdf, 0xbf, 0x79, 0xf9, 0xf0,
+	0xbe, 0x7a, 0xa3, 0x26, 0x85, 0x57, 0x4a, 0x33, 0xa3, 0xbf, 0x96, 0x80, 0x71, 0x9d, 0x2c, 0xfc,
+	0x08, 0xac, 0xaa, 0xf9, 0x36, 0x26, 0xd4, 0x1d, 0x0a, 0xf5, 0x9e, 0xac, 0x75, 0x6a, 0x49, 0x6c,
+	0xc2, 0xf4, 0x38, 0xa6, 0x20, 0xb2, 0x80, 0x5c, 0x9d, 0xa8, 0x05, 0x7c, 0x0c, 0xd6, 0x3d, 0x1c,
+	0xba, 0xc4, 0xe6, 0xbd, 0xaf, 0x88, 0xa3, 0xa7, 0x64, 0xfa, 0x56, 0xbc, 0x95, 0xc4, 0xa6, 0xa1,
+	0xcb, 0x17, 0x52, 0x90, 0x75, 0x57, 0xc5, 0x9e, 0xa9, 0x90, 0x1c, 0x9f, 0x70, 0x0f, 0x54, 0xf4,
+	0x89, 0xa7, 0x1e, 0x96, 0x94, 0x87, 0xfb, 0x49, 0x6c, 0x6e, 0x14, 0xa7, 0x71, 0x66, 0x62, 0x55,
+	0x2d, 0x53, 0x17, 0x1f, 0x83, 0xaa, 0x3b, 0x72, 0x6d, 0xec, 0xe6, 0x3b, 0x58, 0x56, 0xd5, 0xf5,
+	0x24, 0x36, 0xb7, 0x74, 0xf5, 0x2c, 0x8e, 0xac, 0x8a, 0x3b, 0x72, 0xf7, 0x5d, 0x32, 0x25, 0x88,
+	0xc6, 0x38, 0xb0, 0x29, 0xcb, 0x08, 0x6e, 0xcd, 0x13, 0xcc, 0xe2, 0xc8, 0xaa, 0xc8, 0x40, 0x97,
+	0xa5, 0x04, 0x87, 0x00, 0xfa, 0x58, 0x5e, 0x20, 0x86, 0x99, 0x93, 0xbb, 0x58, 0x51, 0x24, 0x85,
+	0xcb, 0xbc, 0x98, 0x83, 0xac, 0xf5, 0x42, 0x30, 0x65, 0xfb, 0x04, 0x54, 0x43, 0xe2, 0x10, 0xe6,
+	0x9c, 0x67, 0x0d, 0x7a, 0x5b, 0x1d, 0x69, 0xc1, 0xce, 0x2c, 0x8e, 0xac, 0xb5, 0x34, 0xa0, 0xdb,
+	0xb1, 0xf3, 0xf8, 0xd5, 0x45, 0xa3, 0xfc, 0xfa, 0xa2, 0x51, 0xfe, 0xf3, 0xa2, 0x51, 0xfe, 0xe1,
+	0xb2, 0x51, 0x7a, 0x7d, 0xd9, 0x28, 0xfd, 0x7e, 0xd9, 0x28, 0x3d, 0x6f, 0xb9, 0x54, 0x0c, 0xcf,
+	0x7a, 0x2d, 0x87, 0xfb, 0x6d, 0xd9, 0x2b, 0xce, 0x10, 0x53, 0xa6, 0xbe, 0xda, 0xa3, 0x47, 0x33,
+	0x8d, 0x23, 0xce, 0x03, 0x12, 0xf5, 0x56, 0xd4, 0x5f, 0x91, 0x0f, 0xff, 0x1e, 0x00, 0x12, 0xf8,
+	0x57, 0x57, 0xfd, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -215,6 +342,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ChallengeSelection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
 	if m.SpSlashCountingWindow != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SpSlashCountingWindow))
 		i--
@@ -323,6 +460,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChallengeSelectionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChallengeSelectionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChallengeSelectionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecencyWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RecencyWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaintenanceWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaintenanceWeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SwapInWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SwapInWeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GvgAgeWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GvgAgeWeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SlashWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashWeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LargeObjectSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LargeObjectSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SizeWeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SizeWeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -375,6 +570,38 @@ func (m *Params) Size() (n int) {
 	if m.SpSlashCountingWindow != 0 {
 		n += 1 + sovParams(uint64(m.SpSlashCountingWindow))
 	}
+	l = m.ChallengeSelection.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ChallengeSelectionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SizeWeight != 0 {
+		n += 1 + sovParams(uint64(m.SizeWeight))
+	}
+	if m.LargeObjectSize != 0 {
+		n += 1 + sovParams(uint64(m.LargeObjectSize))
+	}
+	if m.SlashWeight != 0 {
+		n += 1 + sovParams(uint64(m.SlashWeight))
+	}
+	if m.GvgAgeWeight != 0 {
+		n += 1 + sovParams(uint64(m.GvgAgeWeight))
+	}
+	if m.SwapInWeight != 0 {
+		n += 1 + sovParams(uint64(m.SwapInWeight))
+	}
+	if m.MaintenanceWeight != 0 {
+		n += 1 + sovParams(uint64(m.MaintenanceWeight))
+	}
+	if m.RecencyWindow != 0 {
+		n += 1 + sovParams(uint64(m.RecencyWindow))
+	}
 	return n
 }
 
@@ -784,6 +1011,222 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeSelection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChallengeSelection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChallengeSelectionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChallengeSelectionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChallengeSelectionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeWeight", wireType)
+			}
+			m.SizeWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeWeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeObjectSize", wireType)
+			}
+			m.LargeObjectSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LargeObjectSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWeight", wireType)
+			}
+			m.SlashWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GvgAgeWeight", wireType)
+			}
+			m.GvgAgeWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GvgAgeWeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapInWeight", wireType)
+			}
+			m.SwapInWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapInWeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWeight", wireType)
+			}
+			m.MaintenanceWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceWeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecencyWindow", wireType)
+			}
+			m.RecencyWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecencyWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -66,8 +66,18 @@ func Test_validateParams(t *testing.T) {
 	params.AttestationKeptCount = 0
 	require.Error(t, params.Validate())
 
-	// no error
+	// validate challenge selection
 	params.AttestationKeptCount = 100
+	params.ChallengeSelection.SizeWeight = 100
+	params.ChallengeSelection.LargeObjectSize = 0
+	require.Error(t, params.Validate())
+
+	params.ChallengeSelection.LargeObjectSize = 1 << 30
+	params.ChallengeSelection.SlashWeight = types.MaxChallengeSelectionWeight
+	require.Error(t, params.Validate())
+
+	// no error
+	params.ChallengeSelection.SlashWeight = 200
 	require.NoError(t, params.Validate())
 }
 
@@ -89,6 +99,7 @@ func TestParamKeysAreUnique(t *testing.T) {
 		"AttestationKeptCount":      string(types.KeyAttestationKeptCount),
 		"SpSlashMaxAmount":          string(types.KeySpSlashMaxAmount),
 		"SpSlashCountingWindow":     string(types.KeySpSlashCountingWindow),
+		"ChallengeSelection":        string(types.KeyChallengeSelection),
 	}
 
 	seen := make(map[string]string, len(keys))
@@ -5,9 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -382,6 +383,197 @@ func (m *QueryInturnAttestationSubmitterResponse) GetSubmitInterval() *SubmitInt
 	return nil
 }
 
+// QuerySpChallengeStatsRequest is request type for the Query/SpChallengeStats RPC method.
+type QuerySpChallengeStatsRequest struct {
+	// The id of the storage provider.
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+}
+
+func (m *QuerySpChallengeStatsRequest) Reset()         { *m = QuerySpChallengeStatsRequest{} }
+func (m *QuerySpChallengeStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpChallengeStatsRequest) ProtoMessage()    {}
+func (*QuerySpChallengeStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46c2dc6307b2a83a, []int{8}
+}
+func (m *QuerySpChallengeStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpChallengeStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpChallengeStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpChallengeStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpChallengeStatsRequest.Merge(m, src)
+}
+func (m *QuerySpChallengeStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpChallengeStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpChallengeStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpChallengeStatsRequest proto.InternalMessageInfo
+
+func (m *QuerySpChallengeStatsRequest) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+// QuerySpChallengeStatsResponse is response type for the Query/SpChallengeStats RPC method.
+type QuerySpChallengeStatsResponse struct {
+	Stats SpChallengeStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+	// The share of the resolved challenges the storage provider passed, one if no challenge is resolved yet.
+	ReliabilityScore cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=reliability_score,json=reliabilityScore,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reliability_score"`
+}
+
+func (m *QuerySpChallengeStatsResponse) Reset()         { *m = QuerySpChallengeStatsResponse{} }
+func (m *QuerySpChallengeStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpChallengeStatsResponse) ProtoMessage()    {}
+func (*QuerySpChallengeStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46c2dc6307b2a83a, []int{9}
+}
+func (m *QuerySpChallengeStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpChallengeStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpChallengeStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpChallengeStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpChallengeStatsResponse.Merge(m, src)
+}
+func (m *QuerySpChallengeStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpChallengeStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpChallengeStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpChallengeStatsResponse proto.InternalMessageInfo
+
+func (m *QuerySpChallengeStatsResponse) GetStats() SpChallengeStats {
+	if m != nil {
+		return m.Stats
+	}
+	return SpChallengeStats{}
+}
+
+// QueryAllSpChallengeStatsRequest is request type for the Query/AllSpChallengeStats RPC method.
+type QueryAllSpChallengeStatsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllSpChallengeStatsRequest) Reset()         { *m = QueryAllSpChallengeStatsRequest{} }
+func (m *QueryAllSpChallengeStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSpChallengeStatsRequest) ProtoMessage()    {}
+func (*QueryAllSpChallengeStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46c2dc6307b2a83a, []int{10}
+}
+func (m *QueryAllSpChallengeStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSpChallengeStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSpChallengeStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSpChallengeStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSpChallengeStatsRequest.Merge(m, src)
+}
+func (m *QueryAllSpChallengeStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSpChallengeStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSpChallengeStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSpChallengeStatsRequest proto.InternalMessageInfo
+
+func (m *QueryAllSpChallengeStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllSpChallengeStatsResponse is response type for the Query/AllSpChallengeStats RPC method.
+type QueryAllSpChallengeStatsResponse struct {
+	Stats      []SpChallengeStats  `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllSpChallengeStatsResponse) Reset()         { *m = QueryAllSpChallengeStatsResponse{} }
+func (m *QueryAllSpChallengeStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSpChallengeStatsResponse) ProtoMessage()    {}
+func (*QueryAllSpChallengeStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46c2dc6307b2a83a, []int{11}
+}
+func (m *QueryAllSpChallengeStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSpChallengeStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSpChallengeStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSpChallengeStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSpChallengeStatsResponse.Merge(m, src)
+}
+func (m *QueryAllSpChallengeStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSpChallengeStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSpChallengeStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSpChallengeStatsResponse proto.InternalMessageInfo
+
+func (m *QueryAllSpChallengeStatsResponse) GetStats() []SpChallengeStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *QueryAllSpChallengeStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // SubmitInterval holds start and end (exclusive) (i.e., [start, end)) time of in turn attestation.
 type SubmitInterval struct {
 	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
@@ -392,7 +584,7 @@ func (m *SubmitInterval) Reset()         { *m = SubmitInterval{} }
 func (m *SubmitInterval) String() string { return proto.CompactTextString(m) }
 func (*SubmitInterval) ProtoMessage()    {}
 func (*SubmitInterval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46c2dc6307b2a83a, []int{8}
+	return fileDescriptor_46c2dc6307b2a83a, []int{12}
 }
 func (m *SubmitInterval) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,53 +636,73 @@ func init() {
 	proto.RegisterType((*QueryLatestAttestedChallengesResponse)(nil), "moca.challenge.QueryLatestAttestedChallengesResponse")
 	proto.RegisterType((*QueryInturnAttestationSubmitterRequest)(nil), "moca.challenge.QueryInturnAttestationSubmitterRequest")
 	proto.RegisterType((*QueryInturnAttestationSubmitterResponse)(nil), "moca.challenge.QueryInturnAttestationSubmitterResponse")
+	proto.RegisterType((*QuerySpChallengeStatsRequest)(nil), "moca.challenge.QuerySpChallengeStatsRequest")
+	proto.RegisterType((*QuerySpChallengeStatsResponse)(nil), "moca.challenge.QuerySpChallengeStatsResponse")
+	proto.RegisterType((*QueryAllSpChallengeStatsRequest)(nil), "moca.challenge.QueryAllSpChallengeStatsRequest")
+	proto.RegisterType((*QueryAllSpChallengeStatsResponse)(nil), "moca.challenge.QueryAllSpChallengeStatsResponse")
 	proto.RegisterType((*SubmitInterval)(nil), "moca.challenge.SubmitInterval")
 }
 
 func init() { proto.RegisterFile("moca/challenge/query.proto", fileDescriptor_46c2dc6307b2a83a) }
 
 var fileDescriptor_46c2dc6307b2a83a = []byte{
-	// 638 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3d, 0x6f, 0xd4, 0x40,
-	0x10, 0x3d, 0xe7, 0x4b, 0xba, 0x09, 0x0a, 0x64, 0x89, 0xa2, 0xc3, 0x80, 0x21, 0x26, 0x84, 0xe8,
-	0x14, 0x6c, 0xe5, 0x08, 0x5f, 0x15, 0x4a, 0x28, 0x20, 0x82, 0x22, 0x98, 0x8e, 0xc6, 0x5a, 0xdf,
-	0xad, 0x1c, 0x83, 0xbd, 0xeb, 0x78, 0xd7, 0x11, 0xd7, 0xf2, 0x0b, 0x10, 0xf4, 0xd0, 0x52, 0xd2,
-	0x53, 0xd2, 0xa4, 0x8c, 0x44, 0x43, 0x85, 0x50, 0x82, 0xc4, 0xdf, 0x40, 0xde, 0xdd, 0x33, 0x9c,
-	0xef, 0x8e, 0x4b, 0x1a, 0xcb, 0x3b, 0xf3, 0xde, 0x9b, 0x37, 0xb3, 0x63, 0x83, 0x99, 0xb0, 0x36,
-	0x76, 0xdb, 0xbb, 0x38, 0x8e, 0x09, 0x0d, 0x89, 0xbb, 0x97, 0x93, 0xac, 0xeb, 0xa4, 0x19, 0x13,
-	0x0c, 0xcd, 0x15, 0x39, 0xa7, 0xcc, 0x99, 0xf3, 0x38, 0x89, 0x28, 0x73, 0xe5, 0x53, 0x41, 0xcc,
-	0x66, 0x9b, 0xf1, 0x84, 0x71, 0x37, 0xc0, 0x5c, 0x73, 0xdd, 0xfd, 0xf5, 0x80, 0x08, 0xbc, 0xee,
-	0xa6, 0x38, 0x8c, 0x28, 0x16, 0x11, 0xa3, 0x1a, 0x7b, 0x41, 0x61, 0x7d, 0x79, 0x72, 0xd5, 0x41,
-	0xa7, 0x16, 0x42, 0x16, 0x32, 0x15, 0x2f, 0xde, 0x74, 0xf4, 0x52, 0xc8, 0x58, 0x18, 0x13, 0x17,
-	0xa7, 0x91, 0x8b, 0x29, 0x65, 0x42, 0xaa, 0xf5, 0x38, 0x17, 0x2b, 0xce, 0x53, 0x9c, 0xe1, 0xa4,
-	0x97, 0xac, 0xb6, 0x25, 0xba, 0x29, 0xd1, 0x39, 0x7b, 0x01, 0xd0, 0xb3, 0xc2, 0xe9, 0x8e, 0x24,
-	0x78, 0x64, 0x2f, 0x27, 0x5c, 0xd8, 0x3b, 0x70, 0xbe, 0x2f, 0xca, 0x53, 0x46, 0x39, 0x41, 0xf7,
-	0x61, 0x46, 0x09, 0x37, 0x8c, 0xab, 0xc6, 0xea, 0x6c, 0x6b, 0xd1, 0xe9, 0x1f, 0x8a, 0xa3, 0xf0,
-	0x5b, 0xf5, 0x83, 0x1f, 0x57, 0x6a, 0x9f, 0x7e, 0x7f, 0x6e, 0x1a, 0x9e, 0x26, 0xd8, 0x5b, 0x70,
-	0x59, 0x2a, 0x6e, 0x0a, 0x41, 0xb8, 0x20, 0x9d, 0x87, 0x3d, 0x8e, 0x2e, 0x89, 0x96, 0xe0, 0x4c,
-	0xa9, 0xe3, 0x47, 0x1d, 0x59, 0x61, 0xca, 0x9b, 0x2d, 0x63, 0xdb, 0x1d, 0x1b, 0x83, 0x35, 0x4a,
-	0x43, 0x1b, 0x7c, 0x00, 0xf5, 0x92, 0xa0, 0x3d, 0x2e, 0x55, 0x3d, 0x0e, 0xb2, 0xff, 0x72, 0xec,
-	0x15, 0x58, 0x96, 0x25, 0x9e, 0xe2, 0x02, 0x34, 0x00, 0x2d, 0x07, 0xf4, 0x12, 0xae, 0x8f, 0xc1,
-	0x69, 0x47, 0x9b, 0x00, 0xa5, 0x7a, 0x31, 0xb6, 0xc9, 0x93, 0x59, 0xfa, 0x87, 0x64, 0xaf, 0xc2,
-	0x8a, 0xac, 0xb5, 0x4d, 0x45, 0x9e, 0x51, 0x85, 0x95, 0x97, 0xff, 0x3c, 0x0f, 0x92, 0x48, 0x08,
-	0x92, 0xf5, 0x5c, 0xbd, 0x33, 0xe0, 0xc6, 0x58, 0xa8, 0x36, 0x66, 0xc1, 0x6c, 0x10, 0x73, 0x3f,
-	0xcd, 0x03, 0xff, 0x15, 0xe9, 0xca, 0x61, 0xd5, 0xbd, 0x7a, 0x10, 0xf3, 0x9d, 0x3c, 0x78, 0x42,
-	0xba, 0xe8, 0x11, 0x9c, 0xe5, 0x92, 0xe4, 0x47, 0x54, 0x90, 0x6c, 0x1f, 0xc7, 0x8d, 0x09, 0x39,
-	0x50, 0xab, 0xea, 0x5e, 0x69, 0x6f, 0x6b, 0x94, 0x37, 0xc7, 0xfb, 0xce, 0xf6, 0x3d, 0x98, 0xeb,
-	0x47, 0xa0, 0x05, 0x98, 0xe6, 0x02, 0x67, 0x42, 0xdf, 0xb1, 0x3a, 0xa0, 0x73, 0x30, 0x49, 0x68,
-	0x47, 0x16, 0x99, 0xf2, 0x8a, 0xd7, 0xd6, 0xc7, 0x69, 0x98, 0x96, 0xed, 0xa0, 0x3d, 0x98, 0x51,
-	0xab, 0x85, 0xec, 0x6a, 0xf5, 0xc1, 0xed, 0x35, 0xaf, 0xfd, 0x17, 0xa3, 0xfa, 0xb7, 0xad, 0x37,
-	0xdf, 0x7e, 0xbd, 0x9f, 0x68, 0xa0, 0x45, 0x77, 0xe8, 0xa7, 0x83, 0x3e, 0x18, 0x30, 0x3f, 0x70,
-	0x2f, 0xe8, 0xe6, 0x50, 0xe9, 0x51, 0x4b, 0x6d, 0x3a, 0x27, 0x85, 0x6b, 0x53, 0x4d, 0x69, 0x6a,
-	0x19, 0xd9, 0x55, 0x53, 0x58, 0x53, 0xfc, 0x32, 0x84, 0xbe, 0x18, 0xd0, 0x18, 0xb5, 0x7e, 0x68,
-	0x63, 0x68, 0xe1, 0x31, 0x5b, 0x6d, 0xde, 0x3e, 0x25, 0x4b, 0xbb, 0x6e, 0x49, 0xd7, 0x6b, 0xa8,
-	0x59, 0x75, 0x1d, 0x4b, 0xa6, 0x3f, 0x68, 0x9e, 0xa3, 0xaf, 0x06, 0x98, 0xa3, 0xb7, 0x14, 0xdd,
-	0x19, 0xea, 0x64, 0xec, 0x17, 0x60, 0xde, 0x3d, 0x35, 0x4f, 0xf7, 0xb0, 0x21, 0x7b, 0x70, 0xd0,
-	0x5a, 0xb5, 0x87, 0x48, 0x72, 0x75, 0x0f, 0x92, 0xec, 0xf3, 0x1e, 0x7b, 0xeb, 0xf1, 0xc1, 0x91,
-	0x65, 0x1c, 0x1e, 0x59, 0xc6, 0xcf, 0x23, 0xcb, 0x78, 0x7b, 0x6c, 0xd5, 0x0e, 0x8f, 0xad, 0xda,
-	0xf7, 0x63, 0xab, 0xf6, 0xc2, 0x09, 0x23, 0xb1, 0x9b, 0x07, 0x4e, 0x9b, 0x25, 0x52, 0xb1, 0xbd,
-	0x8b, 0x23, 0xaa, 0xb4, 0xf7, 0x5b, 0xee, 0xeb, 0xea, 0xdf, 0x38, 0x98, 0x91, 0xbf, 0xe3, 0x5b,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xde, 0x8e, 0x8e, 0x22, 0x83, 0x06, 0x00, 0x00,
+	// 881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0x6d, 0x5a, 0x94, 0x57, 0x28, 0xed, 0xb4, 0x5a, 0x05, 0xef, 0xae, 0xdb, 0x35,
+	0x4b, 0x77, 0x15, 0xb5, 0x36, 0xcd, 0x2e, 0xbf, 0x24, 0x24, 0xb4, 0x61, 0xc5, 0x12, 0xb1, 0x87,
+	0xe0, 0xdc, 0x38, 0x60, 0x8d, 0x9d, 0x91, 0x63, 0xd6, 0xf1, 0xb8, 0x9e, 0x49, 0x45, 0x84, 0xb8,
+	0xf0, 0x17, 0x20, 0xb8, 0x73, 0x81, 0x03, 0xdc, 0x38, 0x70, 0x43, 0x9c, 0xb8, 0xec, 0x71, 0x05,
+	0x17, 0xc4, 0x61, 0x85, 0x5a, 0x24, 0x2e, 0xfc, 0x11, 0xc8, 0x33, 0x13, 0xa7, 0xb1, 0x63, 0x92,
+	0x5e, 0xa2, 0xcc, 0xcc, 0xfb, 0xbe, 0xf7, 0x79, 0x2f, 0x33, 0x5f, 0x05, 0xf4, 0x21, 0xf5, 0xb1,
+	0xed, 0x0f, 0x70, 0x14, 0x91, 0x38, 0x20, 0xf6, 0xc9, 0x88, 0xa4, 0x63, 0x2b, 0x49, 0x29, 0xa7,
+	0x68, 0x33, 0x3b, 0xb3, 0xf2, 0x33, 0x7d, 0x1b, 0x0f, 0xc3, 0x98, 0xda, 0xe2, 0x53, 0x86, 0xe8,
+	0x4d, 0x9f, 0xb2, 0x21, 0x65, 0xb6, 0x87, 0x99, 0xd2, 0xda, 0xa7, 0xc7, 0x1e, 0xe1, 0xf8, 0xd8,
+	0x4e, 0x70, 0x10, 0xc6, 0x98, 0x87, 0x34, 0x56, 0xb1, 0x2f, 0xc9, 0x58, 0x57, 0xac, 0x6c, 0xb9,
+	0x50, 0x47, 0xbb, 0x01, 0x0d, 0xa8, 0xdc, 0xcf, 0xbe, 0xa9, 0xdd, 0xeb, 0x01, 0xa5, 0x41, 0x44,
+	0x6c, 0x9c, 0x84, 0x36, 0x8e, 0x63, 0xca, 0x45, 0xb6, 0x89, 0xe6, 0x5a, 0x81, 0x3c, 0xc1, 0x29,
+	0x1e, 0x4e, 0x0e, 0x8b, 0x6d, 0xf1, 0x71, 0x42, 0xd4, 0x99, 0xb9, 0x0b, 0xe8, 0xc3, 0x8c, 0xb4,
+	0x2b, 0x04, 0x0e, 0x39, 0x19, 0x11, 0xc6, 0xcd, 0x2e, 0xec, 0xcc, 0xec, 0xb2, 0x84, 0xc6, 0x8c,
+	0xa0, 0xb7, 0x60, 0x5d, 0x26, 0x6e, 0x68, 0xfb, 0xda, 0x9d, 0x8d, 0xd6, 0x55, 0x6b, 0x76, 0x28,
+	0x96, 0x8c, 0x6f, 0xd7, 0x9f, 0x3c, 0xdb, 0x5b, 0xf9, 0xfe, 0x9f, 0x1f, 0x9b, 0x9a, 0xa3, 0x04,
+	0x66, 0x1b, 0x6e, 0x88, 0x8c, 0xf7, 0x39, 0x27, 0x8c, 0x93, 0xfe, 0xbb, 0x13, 0x8d, 0x2a, 0x89,
+	0x6e, 0xc2, 0xf3, 0x79, 0x1e, 0x37, 0xec, 0x8b, 0x0a, 0x35, 0x67, 0x23, 0xdf, 0xeb, 0xf4, 0x4d,
+	0x0c, 0x46, 0x55, 0x0e, 0x05, 0xf8, 0x0e, 0xd4, 0x73, 0x81, 0x62, 0xbc, 0x59, 0x64, 0x2c, 0xab,
+	0xa7, 0x1a, 0xf3, 0x00, 0x6e, 0x89, 0x12, 0x8f, 0x70, 0x16, 0x54, 0x0a, 0xcd, 0x07, 0xf4, 0x09,
+	0xbc, 0xb2, 0x20, 0x4e, 0x11, 0xdd, 0x07, 0xc8, 0xb3, 0x67, 0x63, 0x5b, 0x5d, 0x0e, 0xe9, 0x82,
+	0xc8, 0xbc, 0x03, 0x07, 0xa2, 0x56, 0x27, 0xe6, 0xa3, 0x34, 0x96, 0xb1, 0xe2, 0xc7, 0xef, 0x8d,
+	0xbc, 0x61, 0xc8, 0x39, 0x49, 0x27, 0x54, 0x5f, 0x69, 0x70, 0x7b, 0x61, 0xa8, 0x02, 0x33, 0x60,
+	0xc3, 0x8b, 0x98, 0x9b, 0x8c, 0x3c, 0xf7, 0x31, 0x19, 0x8b, 0x61, 0xd5, 0x9d, 0xba, 0x17, 0xb1,
+	0xee, 0xc8, 0xfb, 0x80, 0x8c, 0xd1, 0x43, 0x78, 0x91, 0x09, 0x91, 0x1b, 0xc6, 0x9c, 0xa4, 0xa7,
+	0x38, 0x6a, 0x5c, 0x11, 0x03, 0x35, 0x8a, 0xf4, 0x32, 0x77, 0x47, 0x45, 0x39, 0x9b, 0x6c, 0x66,
+	0x6d, 0xde, 0x85, 0xeb, 0x82, 0xa9, 0x97, 0xe4, 0xed, 0xf5, 0x38, 0xe6, 0x93, 0x51, 0xa2, 0x1d,
+	0x58, 0x63, 0xc9, 0xe4, 0x17, 0x7f, 0xc1, 0xa9, 0xb1, 0xa4, 0xd3, 0x37, 0x7f, 0xd1, 0xe0, 0x46,
+	0x85, 0x4a, 0xf1, 0xbf, 0x0d, 0x6b, 0x59, 0x6f, 0x93, 0xab, 0xb8, 0x5f, 0xa2, 0x2a, 0x08, 0xdb,
+	0xb5, 0xec, 0x52, 0x3a, 0x52, 0x84, 0x3e, 0x86, 0xed, 0x94, 0x44, 0x21, 0xf6, 0xc2, 0x28, 0xe4,
+	0x63, 0x97, 0xf9, 0x34, 0x25, 0xa2, 0xbf, 0x7a, 0xfb, 0x38, 0x8b, 0xfb, 0xf3, 0xd9, 0xde, 0x35,
+	0xf9, 0x28, 0x59, 0xff, 0xb1, 0x15, 0x52, 0x7b, 0x88, 0xf9, 0xc0, 0x7a, 0x44, 0x02, 0xec, 0x8f,
+	0x1f, 0x10, 0xff, 0xb7, 0x9f, 0x8e, 0x40, 0x1e, 0x5b, 0x0f, 0x88, 0xef, 0x6c, 0x5d, 0xc8, 0xd5,
+	0xcb, 0x52, 0x99, 0x21, 0xec, 0xc9, 0xab, 0x1a, 0x45, 0x55, 0x7d, 0xbf, 0x07, 0x30, 0x75, 0x05,
+	0xd5, 0xc5, 0x81, 0xa5, 0xb2, 0x66, 0x16, 0x62, 0x49, 0xfb, 0x51, 0x16, 0x62, 0x75, 0x71, 0xfe,
+	0x58, 0x9c, 0x0b, 0x4a, 0xf3, 0x07, 0x0d, 0xf6, 0xab, 0x6b, 0x95, 0xa7, 0xb5, 0x7a, 0xf9, 0x69,
+	0x3d, 0x9c, 0x41, 0x95, 0xd7, 0xe0, 0xf6, 0x42, 0x54, 0x59, 0x7a, 0x86, 0xf5, 0x4d, 0xd8, 0x9c,
+	0xbd, 0x2d, 0x68, 0x57, 0x80, 0xa5, 0x5c, 0xbd, 0x77, 0xb9, 0x40, 0x5b, 0xb0, 0x4a, 0xe2, 0xbe,
+	0xa8, 0x54, 0x73, 0xb2, 0xaf, 0xad, 0x7f, 0x9f, 0x83, 0x35, 0xd1, 0x25, 0x3a, 0x81, 0x75, 0x69,
+	0x33, 0xc8, 0x2c, 0x76, 0x51, 0x76, 0x32, 0xfd, 0xe5, 0xff, 0x8d, 0x91, 0x88, 0xa6, 0xf1, 0xc5,
+	0xef, 0x7f, 0x7f, 0x7d, 0xa5, 0x81, 0xae, 0xda, 0x73, 0x6d, 0x14, 0x7d, 0xa3, 0xc1, 0x76, 0xe9,
+	0x8d, 0xa2, 0xa3, 0xb9, 0xa9, 0xab, 0x0c, 0x4e, 0xb7, 0x96, 0x0d, 0x57, 0x50, 0x4d, 0x01, 0x75,
+	0x0b, 0x99, 0x45, 0x28, 0xac, 0x24, 0x6e, 0xbe, 0x85, 0x7e, 0xd6, 0xa0, 0x51, 0x65, 0x45, 0xe8,
+	0xde, 0xdc, 0xc2, 0x0b, 0x1c, 0x4e, 0x7f, 0xed, 0x92, 0x2a, 0x45, 0xdd, 0x12, 0xd4, 0x87, 0xa8,
+	0x59, 0xa4, 0x8e, 0x84, 0xd2, 0x2d, 0xc3, 0x33, 0xf4, 0xab, 0x06, 0x7a, 0xb5, 0x63, 0xa1, 0xd7,
+	0xe7, 0x92, 0x2c, 0x74, 0x43, 0xfd, 0x8d, 0x4b, 0xeb, 0x54, 0x0f, 0xf7, 0x44, 0x0f, 0x16, 0x3a,
+	0x2c, 0xf6, 0x10, 0x0a, 0xad, 0x8b, 0xa7, 0x62, 0x97, 0xe5, 0x98, 0xdf, 0x6a, 0xb0, 0x55, 0x7c,
+	0x46, 0xe8, 0x70, 0x2e, 0x43, 0x85, 0x25, 0xe8, 0x47, 0x4b, 0x46, 0x2f, 0x9a, 0x35, 0x4b, 0xa6,
+	0xe3, 0x75, 0xc5, 0x13, 0xb6, 0x3f, 0x13, 0x1e, 0xfb, 0x39, 0xfa, 0x4e, 0x83, 0x9d, 0x39, 0x46,
+	0x81, 0xec, 0xf9, 0xb7, 0xb3, 0xd2, 0xbe, 0xf4, 0x57, 0x97, 0x17, 0x2c, 0xba, 0xd0, 0x65, 0xdc,
+	0xf6, 0xfb, 0x4f, 0xce, 0x0c, 0xed, 0xe9, 0x99, 0xa1, 0xfd, 0x75, 0x66, 0x68, 0x5f, 0x9e, 0x1b,
+	0x2b, 0x4f, 0xcf, 0x8d, 0x95, 0x3f, 0xce, 0x8d, 0x95, 0x8f, 0xac, 0x20, 0xe4, 0x83, 0x91, 0x67,
+	0xf9, 0x74, 0x28, 0xf2, 0xf8, 0x03, 0x1c, 0xc6, 0x32, 0xe3, 0x69, 0xcb, 0xfe, 0xb4, 0xf8, 0x37,
+	0xc7, 0x5b, 0x17, 0xff, 0x73, 0xee, 0xfe, 0x37, 0x00, 0x7e, 0x61, 0x81, 0x31, 0xdc, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -513,6 +725,10 @@ type QueryClient interface {
 	LatestAttestedChallenges(ctx context.Context, in *QueryLatestAttestedChallengesRequest, opts ...grpc.CallOption) (*QueryLatestAttestedChallengesResponse, error)
 	// Queries the inturn challenger.
 	InturnAttestationSubmitter(ctx context.Context, in *QueryInturnAttestationSubmitterRequest, opts ...grpc.CallOption) (*QueryInturnAttestationSubmitterResponse, error)
+	// Queries the challenge statistics and the reliability score of a storage provider.
+	SpChallengeStats(ctx context.Context, in *QuerySpChallengeStatsRequest, opts ...grpc.CallOption) (*QuerySpChallengeStatsResponse, error)
+	// Queries the challenge statistics of all the storage providers.
+	AllSpChallengeStats(ctx context.Context, in *QueryAllSpChallengeStatsRequest, opts ...grpc.CallOption) (*QueryAllSpChallengeStatsResponse, error)
 }
 
 type queryClient struct {
@@ -559,6 +775,24 @@ func (c *queryClient) InturnAttestationSubmitter(ctx context.Context, in *QueryI
 	return out, nil
 }
 
+func (c *queryClient) SpChallengeStats(ctx context.Context, in *QuerySpChallengeStatsRequest, opts ...grpc.CallOption) (*QuerySpChallengeStatsResponse, error) {
+	out := new(QuerySpChallengeStatsResponse)
+	err := c.cc.Invoke(ctx, "/moca.challenge.Query/SpChallengeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllSpChallengeStats(ctx context.Context, in *QueryAllSpChallengeStatsRequest, opts ...grpc.CallOption) (*QueryAllSpChallengeStatsResponse, error) {
+	out := new(QueryAllSpChallengeStatsResponse)
+	err := c.cc.Invoke(ctx, "/moca.challenge.Query/AllSpChallengeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -569,6 +803,10 @@ type QueryServer interface {
 	LatestAttestedChallenges(context.Context, *QueryLatestAttestedChallengesRequest) (*QueryLatestAttestedChallengesResponse, error)
 	// Queries the inturn challenger.
 	InturnAttestationSubmitter(context.Context, *QueryInturnAttestationSubmitterRequest) (*QueryInturnAttestationSubmitterResponse, error)
+	// Queries the challenge statistics and the reliability score of a storage provider.
+	SpChallengeStats(context.Context, *QuerySpChallengeStatsRequest) (*QuerySpChallengeStatsResponse, error)
+	// Queries the challenge statistics of all the storage providers.
+	AllSpChallengeStats(context.Context, *QueryAllSpChallengeStatsRequest) (*QueryAllSpChallengeStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -587,6 +825,12 @@ func (*UnimplementedQueryServer) LatestAttestedChallenges(ctx context.Context, r
 func (*UnimplementedQueryServer) InturnAttestationSubmitter(ctx context.Context, req *QueryInturnAttestationSubmitterRequest) (*QueryInturnAttestationSubmitterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InturnAttestationSubmitter not implemented")
 }
+func (*UnimplementedQueryServer) SpChallengeStats(ctx context.Context, req *QuerySpChallengeStatsRequest) (*QuerySpChallengeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpChallengeStats not implemented")
+}
+func (*UnimplementedQueryServer) AllSpChallengeStats(ctx context.Context, req *QueryAllSpChallengeStatsRequest) (*QueryAllSpChallengeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllSpChallengeStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -664,6 +908,42 @@ func _Query_InturnAttestationSubmitter_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SpChallengeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpChallengeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpChallengeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.challenge.Query/SpChallengeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpChallengeStats(ctx, req.(*QuerySpChallengeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllSpChallengeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSpChallengeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllSpChallengeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.challenge.Query/AllSpChallengeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllSpChallengeStats(ctx, req.(*QueryAllSpChallengeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "moca.challenge.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -684,6 +964,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InturnAttestationSubmitter",
 			Handler:    _Query_InturnAttestationSubmitter_Handler,
 		},
+		{
+			MethodName: "SpChallengeStats",
+			Handler:    _Query_SpChallengeStats_Handler,
+		},
+		{
+			MethodName: "AllSpChallengeStats",
+			Handler:    _Query_AllSpChallengeStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "moca/challenge/query.proto",
@@ -933,7 +1221,7 @@ func (m *QueryInturnAttestationSubmitterResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *SubmitInterval) Marshal() (dAtA []byte, err error) {
+func (m *QuerySpChallengeStatsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -943,102 +1231,257 @@ func (m *SubmitInterval) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubmitInterval) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySpChallengeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubmitInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySpChallengeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.End != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.End))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Start != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpChallengeStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySpChallengeStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAttestedChallengeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpChallengeStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChallengeId != 0 {
-		n += 1 + sovQuery(uint64(m.ChallengeId))
+	{
+		size := m.ReliabilityScore.Size()
+		i -= size
+		if _, err := m.ReliabilityScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAttestedChallengeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Challenge != nil {
-		l = m.Challenge.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllSpChallengeStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLatestAttestedChallengesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllSpChallengeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLatestAttestedChallengesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllSpChallengeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Challenges) > 0 {
-		for _, e := range m.Challenges {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSpChallengeStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSpChallengeStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSpChallengeStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttestedChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovQuery(uint64(m.ChallengeId))
+	}
+	return n
+}
+
+func (m *QueryAttestedChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Challenge != nil {
+		l = m.Challenge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestAttestedChallengesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestAttestedChallengesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -1072,6 +1515,63 @@ func (m *QueryInturnAttestationSubmitterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySpChallengeStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	return n
+}
+
+func (m *QuerySpChallengeStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReliabilityScore.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSpChallengeStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSpChallengeStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *SubmitInterval) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1683,6 +2183,398 @@ func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySpChallengeStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpChallengeStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpChallengeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpChallengeStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpChallengeStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpChallengeStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReliabilityScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReliabilityScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSpChallengeStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSpChallengeStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSpChallengeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSpChallengeStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSpChallengeStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSpChallengeStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, SpChallengeStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SubmitInterval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,3 +14,22 @@ const RedundancyIndexPrimary = int32(-1)
 
 // BlsSignatureLength defines the length of bls signature
 const BlsSignatureLength = 64
+
+// NewSpChallengeStats returns the empty challenge statistics of a storage provider.
+func NewSpChallengeStats(spID uint32) SpChallengeStats {
+	return SpChallengeStats{
+		SpId:         spID,
+		TotalSlashed: sdkmath.ZeroInt(),
+	}
+}
+
+// ReliabilityScore returns the share of the resolved challenges the storage provider passed, one if no challenge is
+// resolved yet.
+func (s SpChallengeStats) ReliabilityScore() sdkmath.LegacyDec {
+	resolved := s.ChallengesSucceeded + s.ChallengesFailed
+	if resolved == 0 {
+		return sdkmath.LegacyOneDec()
+	}
+	return sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(s.ChallengesFailed)).
+		QuoInt(sdkmath.NewIntFromUint64(resolved))
+}
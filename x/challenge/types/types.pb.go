@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -285,46 +286,139 @@ func (m *AttestedChallengeIds) GetCursor() int64 {
 	return 0
 }
 
+// SpChallengeStats records the track record of a storage provider in the challenges, which is never pruned.
+type SpChallengeStats struct {
+	// The id of the storage provider.
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The number of challenges raised against the storage provider.
+	ChallengesReceived uint64 `protobuf:"varint,2,opt,name=challenges_received,json=challengesReceived,proto3" json:"challenges_received,omitempty"`
+	// The number of challenges attested as succeeded, for which the storage provider is slashed.
+	ChallengesSucceeded uint64 `protobuf:"varint,3,opt,name=challenges_succeeded,json=challengesSucceeded,proto3" json:"challenges_succeeded,omitempty"`
+	// The number of challenges the storage provider passed, which are attested as failed or expired.
+	ChallengesFailed uint64 `protobuf:"varint,4,opt,name=challenges_failed,json=challengesFailed,proto3" json:"challenges_failed,omitempty"`
+	// The number of heartbeat attestations of the challenges raised against the storage provider.
+	Heartbeats uint64 `protobuf:"varint,5,opt,name=heartbeats,proto3" json:"heartbeats,omitempty"`
+	// The total amount slashed from the storage provider by the challenges.
+	TotalSlashed cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=total_slashed,json=totalSlashed,proto3,customtype=cosmossdk.io/math.Int" json:"total_slashed"`
+}
+
+func (m *SpChallengeStats) Reset()         { *m = SpChallengeStats{} }
+func (m *SpChallengeStats) String() string { return proto.CompactTextString(m) }
+func (*SpChallengeStats) ProtoMessage()    {}
+func (*SpChallengeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53edf923bff61dd, []int{4}
+}
+func (m *SpChallengeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpChallengeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpChallengeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpChallengeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpChallengeStats.Merge(m, src)
+}
+func (m *SpChallengeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpChallengeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpChallengeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpChallengeStats proto.InternalMessageInfo
+
+func (m *SpChallengeStats) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *SpChallengeStats) GetChallengesReceived() uint64 {
+	if m != nil {
+		return m.ChallengesReceived
+	}
+	return 0
+}
+
+func (m *SpChallengeStats) GetChallengesSucceeded() uint64 {
+	if m != nil {
+		return m.ChallengesSucceeded
+	}
+	return 0
+}
+
+func (m *SpChallengeStats) GetChallengesFailed() uint64 {
+	if m != nil {
+		return m.ChallengesFailed
+	}
+	return 0
+}
+
+func (m *SpChallengeStats) GetHeartbeats() uint64 {
+	if m != nil {
+		return m.Heartbeats
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("moca.challenge.VoteResult", VoteResult_name, VoteResult_value)
 	proto.RegisterType((*Slash)(nil), "moca.challenge.Slash")
 	proto.RegisterType((*Challenge)(nil), "moca.challenge.Challenge")
 	proto.RegisterType((*AttestedChallenge)(nil), "moca.challenge.AttestedChallenge")
 	proto.RegisterType((*AttestedChallengeIds)(nil), "moca.challenge.AttestedChallengeIds")
+	proto.RegisterType((*SpChallengeStats)(nil), "moca.challenge.SpChallengeStats")
 }
 
 func init() { proto.RegisterFile("moca/challenge/types.proto", fileDescriptor_d53edf923bff61dd) }
 
 var fileDescriptor_d53edf923bff61dd = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xce, 0xa4, 0xd9, 0x62, 0xdf, 0xb2, 0xa5, 0x1d, 0xab, 0xd4, 0x1c, 0xb2, 0xb1, 0x20, 0x94,
-	0x05, 0x13, 0xa8, 0x67, 0x91, 0xb6, 0x1b, 0x6d, 0xa1, 0x78, 0x98, 0x65, 0x15, 0xbc, 0x94, 0x34,
-	0x19, 0x92, 0x91, 0x36, 0x13, 0x32, 0x53, 0x59, 0xbd, 0x0b, 0x1e, 0xfd, 0x0f, 0x5e, 0x3c, 0x7a,
-	0xf0, 0x47, 0xec, 0x71, 0xf1, 0x24, 0x1e, 0x16, 0x69, 0x0f, 0xfe, 0x0d, 0xc9, 0x64, 0xdc, 0xd5,
-	0x2d, 0x5e, 0x86, 0xf7, 0x7d, 0xdf, 0x7b, 0xdf, 0x7b, 0xf3, 0x78, 0x60, 0xaf, 0x78, 0x14, 0xfa,
-	0x51, 0x1a, 0x2e, 0x97, 0x34, 0x4b, 0xa8, 0x2f, 0xdf, 0xe6, 0x54, 0x78, 0x79, 0xc1, 0x25, 0xc7,
-	0xcd, 0x52, 0xf3, 0xae, 0x34, 0xbb, 0x1d, 0xae, 0x58, 0xc6, 0x7d, 0xf5, 0x56, 0x29, 0xf6, 0xbd,
-	0x88, 0x8b, 0x15, 0x17, 0x73, 0x85, 0xfc, 0x0a, 0x68, 0xa9, 0x93, 0xf0, 0x84, 0x57, 0x7c, 0x19,
-	0x55, 0x6c, 0x4f, 0xc0, 0xde, 0xc9, 0x32, 0x14, 0x29, 0xbe, 0x0d, 0x7b, 0x22, 0x9f, 0xb3, 0xb8,
-	0x8b, 0x5c, 0xd4, 0x3f, 0x20, 0x96, 0xc8, 0xa7, 0x31, 0x7e, 0x0c, 0x0d, 0xbe, 0x78, 0x4d, 0x23,
-	0x59, 0x0a, 0xa6, 0x8b, 0xfa, 0x8d, 0x91, 0x7b, 0x7e, 0x79, 0x68, 0xfc, 0xb8, 0x3c, 0xb4, 0x4e,
-	0x59, 0x26, 0xbf, 0x7d, 0x7d, 0xb8, 0xaf, 0x9b, 0x94, 0xf0, 0xf3, 0xaf, 0x2f, 0x47, 0x88, 0xdc,
-	0xaa, 0x4a, 0xa6, 0x31, 0xbe, 0x0b, 0xf5, 0x94, 0xb2, 0x24, 0x95, 0xdd, 0x9a, 0x8b, 0xfa, 0x16,
-	0xd1, 0xa8, 0x37, 0x82, 0xc6, 0xf8, 0xcf, 0x2f, 0x70, 0x13, 0x4c, 0xdd, 0xd5, 0x22, 0x26, 0x8b,
-	0xf1, 0x03, 0x68, 0xd2, 0xb3, 0x9c, 0x15, 0x34, 0x9e, 0xeb, 0x62, 0x53, 0x69, 0x07, 0x9a, 0x9d,
-	0x54, 0x1e, 0x2f, 0xa1, 0x3d, 0x94, 0x92, 0x0a, 0x49, 0xe3, 0xff, 0x7b, 0x0d, 0xa0, 0x5e, 0x50,
-	0xb1, 0x5e, 0x56, 0x1e, 0xcd, 0x81, 0xed, 0xfd, 0xbb, 0x42, 0xef, 0x05, 0x97, 0x94, 0xa8, 0x0c,
-	0xa2, 0x33, 0x7b, 0xef, 0x11, 0x74, 0x76, 0x9c, 0xa7, 0xb1, 0xc0, 0x18, 0x2c, 0xc1, 0xde, 0x51,
-	0x6d, 0xaf, 0x62, 0x3c, 0x04, 0xb8, 0x32, 0x13, 0x5d, 0xd3, 0xad, 0xf5, 0xf7, 0x07, 0xf7, 0x6f,
-	0x36, 0xd9, 0x71, 0x23, 0x7f, 0x15, 0x95, 0x4b, 0x8a, 0xd6, 0x85, 0xe0, 0x85, 0x5a, 0x52, 0x8d,
-	0x68, 0x74, 0xf4, 0x04, 0xe0, 0x7a, 0x3a, 0xdc, 0x81, 0xd6, 0x78, 0x32, 0x9c, 0xcd, 0x82, 0xe7,
-	0xcf, 0x82, 0xf9, 0xd3, 0xe1, 0x74, 0x16, 0x1c, 0xb7, 0x0c, 0x7c, 0x07, 0xda, 0xd7, 0xec, 0xc9,
-	0xe9, 0x78, 0x1c, 0x04, 0xc7, 0x2d, 0x64, 0x5b, 0x1f, 0x3e, 0x39, 0xc6, 0x68, 0x72, 0xbe, 0x71,
-	0xd0, 0xc5, 0xc6, 0x41, 0x3f, 0x37, 0x0e, 0xfa, 0xb8, 0x75, 0x8c, 0x8b, 0xad, 0x63, 0x7c, 0xdf,
-	0x3a, 0xc6, 0x2b, 0x2f, 0x61, 0x32, 0x5d, 0x2f, 0xbc, 0x88, 0xaf, 0xfc, 0x72, 0xd6, 0x28, 0x0d,
-	0x59, 0xa6, 0x22, 0xff, 0xcd, 0xc0, 0x3f, 0xbb, 0x79, 0x7e, 0x8b, 0xba, 0xba, 0x95, 0x47, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xb5, 0x49, 0x06, 0x9d, 0x02, 0x00, 0x00,
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xb6, 0x5d, 0x37, 0xa2, 0xaf, 0x34, 0x4a, 0xae, 0x29, 0x32, 0x19, 0xdc, 0x10, 0x09, 0x29,
+	0x2a, 0xc2, 0x86, 0x30, 0x23, 0x94, 0xa6, 0x29, 0xb5, 0x54, 0x31, 0xd8, 0x2a, 0x48, 0x2c, 0x96,
+	0xe3, 0x3b, 0xe2, 0x03, 0x27, 0x67, 0xf9, 0x2e, 0x55, 0x61, 0x47, 0x62, 0xe4, 0x7f, 0x60, 0x61,
+	0x83, 0x81, 0x3f, 0xa2, 0x63, 0xc5, 0x84, 0x18, 0x2a, 0xd4, 0x0e, 0xfc, 0x1b, 0xc8, 0xe7, 0xcb,
+	0x0f, 0x5a, 0x58, 0xac, 0x7b, 0xdf, 0xf7, 0xbe, 0xef, 0xe4, 0xf7, 0xbe, 0x83, 0xe6, 0x98, 0xc5,
+	0x91, 0x1b, 0x27, 0x51, 0x9a, 0x92, 0xc9, 0x88, 0xb8, 0xe2, 0x6d, 0x46, 0xb8, 0x93, 0xe5, 0x4c,
+	0x30, 0x54, 0x2d, 0x38, 0x67, 0xce, 0x35, 0xeb, 0xd1, 0x98, 0x4e, 0x98, 0x2b, 0xbf, 0x65, 0x4b,
+	0xf3, 0x76, 0xcc, 0xf8, 0x98, 0xf1, 0x50, 0x56, 0x6e, 0x59, 0x28, 0xaa, 0x31, 0x62, 0x23, 0x56,
+	0xe2, 0xc5, 0xa9, 0x44, 0xdb, 0x1c, 0x56, 0x83, 0x34, 0xe2, 0x09, 0xda, 0x84, 0x55, 0x9e, 0x85,
+	0x14, 0x5b, 0x7a, 0x4b, 0xef, 0x6c, 0xf8, 0x26, 0xcf, 0x3c, 0x8c, 0x1e, 0xc3, 0x1a, 0x1b, 0xbe,
+	0x26, 0xb1, 0x28, 0x08, 0xa3, 0xa5, 0x77, 0xd6, 0x76, 0x5b, 0xa7, 0xe7, 0xdb, 0xda, 0xcf, 0xf3,
+	0x6d, 0xf3, 0x88, 0x4e, 0xc4, 0xf7, 0x6f, 0xf7, 0xd7, 0xd5, 0x25, 0x45, 0xf9, 0xf9, 0xf7, 0xd7,
+	0x1d, 0xdd, 0xbf, 0x51, 0x4a, 0x3c, 0x8c, 0x6e, 0x41, 0x25, 0x21, 0x74, 0x94, 0x08, 0x6b, 0xa5,
+	0xa5, 0x77, 0x4c, 0x5f, 0x55, 0xed, 0x5d, 0x58, 0xeb, 0xcf, 0xfe, 0x02, 0x55, 0xc1, 0x50, 0xb7,
+	0x9a, 0xbe, 0x41, 0x31, 0xba, 0x0b, 0x55, 0x72, 0x92, 0xd1, 0x9c, 0xe0, 0x50, 0x89, 0x0d, 0xc9,
+	0x6d, 0x28, 0xf4, 0xa0, 0xf4, 0x78, 0x01, 0xf5, 0x9e, 0x10, 0x84, 0x0b, 0x82, 0xff, 0xef, 0xd5,
+	0x85, 0x4a, 0x4e, 0xf8, 0x34, 0x2d, 0x3d, 0xaa, 0xdd, 0xa6, 0xf3, 0xf7, 0x08, 0x9d, 0xe7, 0x4c,
+	0x10, 0x5f, 0x76, 0xf8, 0xaa, 0xb3, 0xfd, 0x5e, 0x87, 0xc6, 0x35, 0x67, 0x0f, 0x73, 0x84, 0xc0,
+	0xe4, 0xf4, 0x1d, 0x51, 0xf6, 0xf2, 0x8c, 0x7a, 0x00, 0x73, 0x33, 0x6e, 0x19, 0xad, 0x95, 0xce,
+	0x7a, 0xf7, 0xce, 0xd5, 0x4b, 0xae, 0xb9, 0xf9, 0x4b, 0xa2, 0x62, 0x48, 0xf1, 0x34, 0xe7, 0x2c,
+	0x97, 0x43, 0x5a, 0xf1, 0x55, 0xd5, 0xfe, 0x62, 0x40, 0x2d, 0xc8, 0xe6, 0x9a, 0x40, 0x44, 0x82,
+	0xff, 0x7b, 0x4b, 0x2e, 0x6c, 0x2e, 0xfc, 0xc2, 0x9c, 0xc4, 0x84, 0x1e, 0x13, 0xac, 0xc6, 0x86,
+	0x16, 0x94, 0xaf, 0x18, 0xf4, 0x10, 0x1a, 0x4b, 0x02, 0x3e, 0x8d, 0x63, 0x42, 0x30, 0xc1, 0x6a,
+	0x4b, 0x4b, 0x66, 0xc1, 0x8c, 0x42, 0xf7, 0xa0, 0xbe, 0x24, 0x79, 0x15, 0xd1, 0x94, 0x60, 0xcb,
+	0x94, 0xfd, 0xb5, 0x05, 0xb1, 0x2f, 0x71, 0x64, 0x03, 0x24, 0x24, 0xca, 0xc5, 0x90, 0x44, 0x82,
+	0x5b, 0xab, 0xb2, 0x6b, 0x09, 0x41, 0x47, 0xb0, 0x21, 0x98, 0x88, 0xd2, 0x90, 0x17, 0xd1, 0x23,
+	0xd8, 0xaa, 0xc8, 0x68, 0x3d, 0x50, 0xd1, 0xda, 0x2a, 0x23, 0xc5, 0xf1, 0x1b, 0x87, 0x32, 0x77,
+	0x1c, 0x89, 0xc4, 0xf1, 0x64, 0xd6, 0x40, 0x65, 0xcd, 0x9b, 0x45, 0xed, 0xa6, 0xb4, 0x09, 0x4a,
+	0x97, 0x9d, 0x27, 0x00, 0x8b, 0x7d, 0xa2, 0x06, 0xd4, 0xfa, 0x07, 0xbd, 0xc3, 0xc3, 0xc1, 0xb3,
+	0xa7, 0x83, 0x70, 0xbf, 0xe7, 0x1d, 0x0e, 0xf6, 0x6a, 0x1a, 0xda, 0x82, 0xfa, 0x02, 0x0d, 0x8e,
+	0xfa, 0xfd, 0xc1, 0x60, 0xaf, 0xa6, 0x37, 0xcd, 0x0f, 0x9f, 0x6c, 0x6d, 0xf7, 0xe0, 0xf4, 0xc2,
+	0xd6, 0xcf, 0x2e, 0x6c, 0xfd, 0xd7, 0x85, 0xad, 0x7f, 0xbc, 0xb4, 0xb5, 0xb3, 0x4b, 0x5b, 0xfb,
+	0x71, 0x69, 0x6b, 0x2f, 0x9d, 0x11, 0x15, 0xc9, 0x74, 0xe8, 0xc4, 0x6c, 0xec, 0x16, 0xdb, 0x8d,
+	0x93, 0x88, 0x4e, 0xe4, 0xc9, 0x3d, 0xee, 0xba, 0x27, 0x57, 0x1f, 0xec, 0xb0, 0x22, 0x5f, 0xd7,
+	0xa3, 0x3f, 0x03, 0x00, 0x98, 0x5f, 0xcf, 0xf5, 0xcf, 0x03, 0x00, 0x00,
 }
 
 func (m *Slash) Marshal() (dAtA []byte, err error) {
@@ -483,6 +577,64 @@ func (m *AttestedChallengeIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpChallengeStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpChallengeStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpChallengeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSlashed.Size()
+		i -= size
+		if _, err := m.TotalSlashed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Heartbeats != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Heartbeats))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChallengesFailed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengesFailed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChallengesSucceeded != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengesSucceeded))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChallengesReceived != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengesReceived))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -562,6 +714,32 @@ func (m *AttestedChallengeIds) Size() (n int) {
 	return n
 }
 
+func (m *SpChallengeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.ChallengesReceived != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengesReceived))
+	}
+	if m.ChallengesSucceeded != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengesSucceeded))
+	}
+	if m.ChallengesFailed != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengesFailed))
+	}
+	if m.Heartbeats != 0 {
+		n += 1 + sovTypes(uint64(m.Heartbeats))
+	}
+	l = m.TotalSlashed.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -988,6 +1166,185 @@ func (m *AttestedChallengeIds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SpChallengeStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpChallengeStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpChallengeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengesReceived", wireType)
+			}
+			m.ChallengesReceived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengesReceived |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengesSucceeded", wireType)
+			}
+			m.ChallengesSucceeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengesSucceeded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengesFailed", wireType)
+			}
+			m.ChallengesFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengesFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeats", wireType)
+			}
+			m.Heartbeats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Heartbeats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSlashed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -88,6 +88,21 @@ func ToAutoSettleRecord(p *payment.AutoSettleRecord) *types.AutoSettleRecord {
 	return s
 }
 
+func ToUserStream(p *payment.UserStream) *types.UserStream {
+	if p == nil {
+		return nil
+	}
+	s := &types.UserStream{
+		Id:        p.Id,
+		Sender:    p.Sender,
+		Recipient: p.Recipient,
+		Rate:      math.NewIntFromBigInt(p.Rate),
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+	return s
+}
+
 // GetQueryCmd returns the cli query commands for this module
 func GetEvmQueryCmd() *cobra.Command {
 	// Group payment queries under a subcommand
@@ -109,6 +124,8 @@ func GetEvmQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdEvmDynamicBalance())
 	cmd.AddCommand(CmdEvmGetPaymentAccountsByOwner())
 	cmd.AddCommand(CmdEvmListAutoSettleRecord())
+	cmd.AddCommand(CmdEvmShowStream())
+	cmd.AddCommand(CmdEvmListStreamsBySender())
 
 	return cmd
 }
@@ -134,6 +151,11 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdDynamicBalance())
 	cmd.AddCommand(CmdGetPaymentAccountsByOwner())
 	cmd.AddCommand(CmdListAutoSettleRecord())
+	cmd.AddCommand(CmdShowStream())
+	cmd.AddCommand(CmdListStreamsBySender())
+	cmd.AddCommand(CmdAccountRunway())
+	cmd.AddCommand(CmdAutoDepositAllowance())
+	cmd.AddCommand(CmdListAutoDepositRecords())
 
 	return cmd
 }
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func CmdAutoDepositAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-deposit-allowance [account]",
+		Short: "Query the auto deposit allowance of a stream account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAutoDepositAllowanceRequest{
+				Account: args[0],
+			}
+
+			res, err := queryClient.AutoDepositAllowance(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListAutoDepositRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-auto-deposit-records [account]",
+		Short: "list the pulls of the auto deposit allowance of a stream account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAutoDepositRecordsRequest{
+				Account:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AutoDepositRecords(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
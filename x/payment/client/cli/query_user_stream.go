@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/precompiles/payment"
+	mocatypes "github.com/mocachain/moca/v2/types"
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func CmdEvmShowStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-stream [stream-id]",
+		Short: "shows a user stream",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			argStreamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			contract, err := payment.NewIPayment(common.HexToAddress(mocatypes.PaymentAddress), clientCtx.EvmClient)
+			if err != nil {
+				return err
+			}
+			result, err := contract.Stream(&bind.CallOpts{}, argStreamID)
+			if err != nil {
+				return err
+			}
+
+			res := &types.QueryStreamResponse{
+				Stream: *ToUserStream(&result),
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEvmListStreamsBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-streams-by-sender [sender]",
+		Short: "list the user streams paid by a sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			contract, err := payment.NewIPayment(common.HexToAddress(mocatypes.PaymentAddress), clientCtx.EvmClient)
+			if err != nil {
+				return err
+			}
+			result, err := contract.StreamsBySender(&bind.CallOpts{}, args[0], *ToPaymentPageReq(pageReq))
+			if err != nil {
+				return err
+			}
+
+			streams := make([]types.UserStream, 0, len(result.Streams))
+			for _, stream := range result.Streams {
+				streams = append(streams, *ToUserStream(&stream))
+			}
+			res := &types.QueryStreamsBySenderResponse{
+				Streams:    streams,
+				Pagination: ToPageResp(&result.PageResponse),
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-stream [stream-id]",
+		Short: "shows a user stream",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argStreamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryStreamRequest{
+				StreamId: argStreamID,
+			}
+
+			res, err := queryClient.Stream(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListStreamsBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-streams-by-sender [sender]",
+		Short: "list the user streams paid by a sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryStreamsBySenderRequest{
+				Sender:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.StreamsBySender(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
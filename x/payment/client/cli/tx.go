@@ -32,6 +32,11 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdDeposit())
 	cmd.AddCommand(CmdWithdraw())
 	cmd.AddCommand(CmdDisableRefund())
+	cmd.AddCommand(CmdCreateStream())
+	cmd.AddCommand(CmdUpdateStream())
+	cmd.AddCommand(CmdCancelStream())
+	cmd.AddCommand(CmdSetRunwayAlert())
+	cmd.AddCommand(CmdSetAutoDepositAllowance())
 
 	return cmd
 }
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/precompiles/payment"
+)
+
+func CmdSetAutoDepositAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-deposit-allowance [addr] [max-amount-per-period] [period-seconds] [threshold-seconds] --privatekey xxx",
+		Short: "Authorize pulling funds from the signer into a stream account when its runway falls below the threshold, 0 max amount revokes it",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAddr := args[0]
+			argMaxAmount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max amount per period %s", args[1])
+			}
+			argPeriod, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argThreshold, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return sendPaymentTx(cmd, func(session *payment.IPaymentSession) (*ethtypes.Transaction, error) {
+				return session.SetAutoDepositAllowance(argAddr, argMaxAmount.BigInt(), argPeriod, argThreshold)
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagPrivateKey, "", "The privatekey of the stream account or the owner of the payment account, the funds are pulled from it")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/precompiles/payment"
+)
+
+func CmdSetRunwayAlert() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-runway-alert [addr] [threshold-seconds] --privatekey xxx",
+		Short: "Set the runway in seconds below which a stream account is alerted, 0 disables the alert",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAddr := args[0]
+			argThreshold, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return sendPaymentTx(cmd, func(session *payment.IPaymentSession) (*ethtypes.Transaction, error) {
+				return session.SetRunwayAlert(argAddr, argThreshold)
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagPrivateKey, "", "The privatekey of the stream account or the owner of the payment account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/precompiles/payment"
+	sdkclient "github.com/mocachain/moca/v2/sdk/client"
+	"github.com/mocachain/moca/v2/sdk/keys"
+	gnfdSdkTypes "github.com/mocachain/moca/v2/sdk/types"
+	types2 "github.com/mocachain/moca/v2/types"
+)
+
+func CmdCreateStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-stream [sender] [recipient] [rate] --privatekey xxx",
+		Short: "Open a stream from the sender to the recipient at the rate per second",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSender := args[0]
+			argRecipient := args[1]
+			argRate, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid rate %s", args[2])
+			}
+
+			return sendPaymentTx(cmd, func(session *payment.IPaymentSession) (*ethtypes.Transaction, error) {
+				return session.CreateStream(argSender, argRecipient, argRate.BigInt())
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagPrivateKey, "", "The privatekey of the sender or the owner of the sender payment account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-stream [stream-id] [rate] --privatekey xxx",
+		Short: "Change the rate per second of a stream",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argStreamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argRate, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid rate %s", args[1])
+			}
+
+			return sendPaymentTx(cmd, func(session *payment.IPaymentSession) (*ethtypes.Transaction, error) {
+				return session.UpdateStream(argStreamID, argRate.BigInt())
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagPrivateKey, "", "The privatekey of the sender or the owner of the sender payment account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-stream [stream-id] --privatekey xxx",
+		Short: "Cancel a stream",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argStreamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			return sendPaymentTx(cmd, func(session *payment.IPaymentSession) (*ethtypes.Transaction, error) {
+				return session.CancelStream(argStreamID)
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagPrivateKey, "", "The privatekey of the sender or the owner of the sender payment account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// sendPaymentTx sends a transaction through the payment precompile and waits for it to be included.
+func sendPaymentTx(cmd *cobra.Command, send func(session *payment.IPaymentSession) (*ethtypes.Transaction, error)) error {
+	argPrivateKey, _ := cmd.Flags().GetString(FlagPrivateKey)
+
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	km, err := keys.NewPrivateKeyManager(argPrivateKey)
+	if err != nil {
+		return err
+	}
+	gnfdCli, err := sdkclient.NewMocaClient(clientCtx.NodeURI, clientCtx.EvmNodeURI, gnfdSdkTypes.ChainID, sdkclient.WithKeyManager(km))
+	if err != nil {
+		return err
+	}
+	nonce, err := gnfdCli.GetNonce(context.Background())
+	if err != nil {
+		return err
+	}
+	txOpts, err := sdkclient.CreateTxOpts(context.Background(), clientCtx.EvmClient, argPrivateKey, big.NewInt(gnfdSdkTypes.DefaultChainId), gnfdSdkTypes.DefaultGasLimit, nonce)
+	if err != nil {
+		return err
+	}
+
+	session, err := sdkclient.CreatePaymentSession(clientCtx.EvmClient, *txOpts, types2.PaymentAddress)
+	if err != nil {
+		return err
+	}
+
+	txRsp, err := send(session)
+	if err != nil {
+		return err
+	}
+
+	_, err = sdkclient.WaitForEvmTx(context.Background(), clientCtx.EvmClient, gnfdCli, txRsp.Hash())
+	if err != nil {
+		return fmt.Errorf("failed to send the payment tx: %v", err.Error())
+	}
+	return clientCtx.PrintObjectLegacy(txRsp.Hash().String())
+}
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+// GetAutoDepositAllowance returns the auto deposit allowance of the stream account
+func (k Keeper) GetAutoDepositAllowance(ctx sdk.Context, addr sdk.AccAddress) (*types.AutoDepositAllowance, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoDepositAllowanceKeyPrefix)
+	b := store.Get(types.AutoDepositAllowanceKey(addr))
+	if b == nil {
+		return nil, false
+	}
+
+	allowance := &types.AutoDepositAllowance{}
+	k.cdc.MustUnmarshal(b, allowance)
+	return allowance, true
+}
+
+// setAutoDepositAllowance stores the auto deposit allowance and moves its check in the queue to the check time, a
+// zero check time removes it from the queue.
+func (k Keeper) setAutoDepositAllowance(ctx sdk.Context, addr sdk.AccAddress, allowance *types.AutoDepositAllowance, checkTime int64) {
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoDepositQueueKeyPrefix)
+	if allowance.NextCheckTime != 0 && allowance.NextCheckTime != checkTime {
+		queue.Delete(types.AutoDepositQueueKey(allowance.NextCheckTime, addr))
+	}
+	if checkTime != 0 {
+		queue.Set(types.AutoDepositQueueKey(checkTime, addr), []byte{})
+	}
+	allowance.NextCheckTime = checkTime
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoDepositAllowanceKeyPrefix)
+	store.Set(types.AutoDepositAllowanceKey(addr), k.cdc.MustMarshal(allowance))
+}
+
+// removeAutoDepositAllowance removes the auto deposit allowance and its check in the queue
+func (k Keeper) removeAutoDepositAllowance(ctx sdk.Context, addr sdk.AccAddress, allowance *types.AutoDepositAllowance) {
+	if allowance.NextCheckTime != 0 {
+		queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoDepositQueueKeyPrefix)
+		queue.Delete(types.AutoDepositQueueKey(allowance.NextCheckTime, addr))
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoDepositAllowanceKeyPrefix)
+	store.Delete(types.AutoDepositAllowanceKey(addr))
+}
+
+// SetAutoDepositAllowance authorizes the payment module to pull funds from the bank account of the operator into
+// the stream account, a zero max amount revokes the allowance. The operator should be the stream account or the
+// owner of the payment account, and a new allowance replaces the previous one along with its period.
+func (k Keeper) SetAutoDepositAllowance(ctx sdk.Context, operator, addr sdk.AccAddress, maxAmountPerPeriod sdkmath.Int,
+	periodSeconds, thresholdSeconds uint64,
+) error {
+	if !k.IsPaymentAccountOwner(ctx, addr, operator) {
+		return errorsmod.Wrapf(types.ErrNotPaymentAccountOwner, "%s can not set the auto deposit allowance of %s", operator, addr)
+	}
+	streamRecord, found := k.GetStreamRecord(ctx, addr)
+	if !found {
+		return errorsmod.Wrapf(types.ErrStreamRecordNotFound, "stream account: %s", addr)
+	}
+
+	allowance, found := k.GetAutoDepositAllowance(ctx, addr)
+	switch {
+	case maxAmountPerPeriod.IsZero() && found:
+		k.removeAutoDepositAllowance(ctx, addr, allowance)
+	case !maxAmountPerPeriod.IsZero():
+		if !found {
+			allowance = &types.AutoDepositAllowance{Addr: addr.String()}
+		}
+		allowance.From = operator.String()
+		allowance.MaxAmountPerPeriod = maxAmountPerPeriod
+		allowance.PeriodSeconds = periodSeconds
+		allowance.ThresholdSeconds = thresholdSeconds
+		allowance.PeriodStart = ctx.BlockTime().Unix()
+		allowance.PulledInPeriod = sdkmath.ZeroInt()
+		checkTime := int64(0)
+		if streamRecord.SettleTimestamp != 0 {
+			checkTime = runwayCheckTime(ctx.BlockTime().Unix(), streamRecord.SettleTimestamp, thresholdSeconds)
+		}
+		k.setAutoDepositAllowance(ctx, addr, allowance, checkTime)
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSetAutoDepositAllowance{
+		Addr:               addr.String(),
+		From:               operator.String(),
+		MaxAmountPerPeriod: maxAmountPerPeriod,
+		PeriodSeconds:      periodSeconds,
+		ThresholdSeconds:   thresholdSeconds,
+	})
+}
+
+// rescheduleAutoDeposit moves the auto deposit check of the stream account as its settle timestamp changes.
+func (k Keeper) rescheduleAutoDeposit(ctx sdk.Context, addr sdk.AccAddress, settleTimestamp int64) {
+	allowance, found := k.GetAutoDepositAllowance(ctx, addr)
+	if !found {
+		return
+	}
+	checkTime := int64(0)
+	if settleTimestamp != 0 {
+		checkTime = runwayCheckTime(ctx.BlockTime().Unix(), settleTimestamp, allowance.ThresholdSeconds)
+	}
+	if checkTime == allowance.NextCheckTime {
+		return
+	}
+	k.setAutoDepositAllowance(ctx, addr, allowance, checkTime)
+}
+
+// AutoDeposit tops up the active stream accounts queued up to now, whose runways fall below the thresholds of their
+// auto deposit allowances. At most MaxAutoSettleFlowCount accounts are checked in a block, the rest are left for the
+// following blocks. An account whose allowance of the period is used up is checked again in the next period.
+func (k Keeper) AutoDeposit(ctx sdk.Context) {
+	now := ctx.BlockTime().Unix()
+	max := k.GetParams(ctx).MaxAutoSettleFlowCount
+
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoDepositQueueKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(queue, []byte{})
+	due := make([]sdk.AccAddress, 0)
+	for ; iterator.Valid() && uint64(len(due)) < max; iterator.Next() {
+		timestamp, addr := types.ParseAutoDepositQueueKey(iterator.Key())
+		if timestamp > now {
+			break
+		}
+		due = append(due, addr)
+	}
+	iterator.Close()
+
+	for _, addr := range due {
+		allowance, found := k.GetAutoDepositAllowance(ctx, addr)
+		if !found { // should not happen
+			continue
+		}
+		k.setAutoDepositAllowance(ctx, addr, allowance, 0)
+
+		streamRecord, found := k.GetStreamRecord(ctx, addr)
+		if !found || streamRecord.Status != types.STREAM_ACCOUNT_STATUS_ACTIVE || streamRecord.SettleTimestamp == 0 {
+			continue
+		}
+		if checkTime := runwayCheckTime(now, streamRecord.SettleTimestamp, allowance.ThresholdSeconds); checkTime > now {
+			k.setAutoDepositAllowance(ctx, addr, allowance, checkTime)
+			continue
+		}
+		if k.autoDeposit(ctx, streamRecord) {
+			continue
+		}
+		// the allowance may be used up, check again in the next period if it is not frozen before that
+		allowance, _ = k.GetAutoDepositAllowance(ctx, addr)
+		if uint64(now-allowance.PeriodStart) < allowance.PeriodSeconds && streamRecord.SettleTimestamp > allowance.PeriodStart &&
+			allowance.PeriodSeconds < uint64(streamRecord.SettleTimestamp-allowance.PeriodStart) {
+			k.setAutoDepositAllowance(ctx, addr, allowance, allowance.PeriodStart+int64(allowance.PeriodSeconds))
+		}
+	}
+}
+
+// autoDeposit pulls the funds covering the threshold of the allowance at the current netflow rate from the auto
+// deposit source into the static balance of the active stream account, up to the allowance left in the period. The
+// stream record is updated and stored if any funds are pulled, and whether the funds are pulled is returned. The
+// pull is only kept if it restores the account above the forced settle time, otherwise the account is left to be
+// settled as if nothing was pulled.
+func (k Keeper) autoDeposit(ctx sdk.Context, streamRecord *types.StreamRecord) bool {
+	addr := sdk.MustAccAddressFromHex(streamRecord.Account)
+	allowance, found := k.GetAutoDepositAllowance(ctx, addr)
+	if !found || !streamRecord.NetflowRate.IsNegative() {
+		return false
+	}
+
+	now := ctx.BlockTime().Unix()
+	if uint64(now-allowance.PeriodStart) >= allowance.PeriodSeconds {
+		allowance.PeriodStart = now
+		allowance.PulledInPeriod = sdkmath.ZeroInt()
+	}
+	amount := streamRecord.NetflowRate.Abs().Mul(sdkmath.NewIntFromUint64(allowance.ThresholdSeconds))
+	amount = sdkmath.MinInt(amount, allowance.MaxAmountPerPeriod.Sub(allowance.PulledInPeriod))
+	if !amount.IsPositive() {
+		return false
+	}
+	allowance.PulledInPeriod = allowance.PulledInPeriod.Add(amount)
+
+	// pull in a cached context, so that a failed pull leaves neither the allowance nor the stream record changed.
+	// The stream record is updated without the forced update of the end blocker, which would force settle the
+	// account instead of failing the pull if the funds do not restore it.
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithValue(types.ForceUpdateStreamRecordKey, false)
+	from := sdk.MustAccAddressFromHex(allowance.From)
+	coins := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).FeeDenom, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, from, types.ModuleName, coins); err != nil {
+		ctx.Logger().Info("auto deposit failed", "account", streamRecord.Account, "from", allowance.From, "err", err.Error())
+		return false
+	}
+	k.setAutoDepositAllowance(cacheCtx, addr, allowance, allowance.NextCheckTime)
+	updated := *streamRecord
+	change := types.NewDefaultStreamRecordChangeWithAddr(addr).WithStaticBalanceChange(amount)
+	if err := k.UpdateStreamRecord(cacheCtx, &updated, change); err != nil {
+		ctx.Logger().Info("auto deposit failed", "account", streamRecord.Account, "from", allowance.From, "err", err.Error())
+		return false
+	}
+	k.SetStreamRecord(cacheCtx, &updated)
+	k.setAutoDepositRecord(cacheCtx, &types.AutoDepositRecord{
+		Addr:      streamRecord.Account,
+		From:      allowance.From,
+		Amount:    amount,
+		Timestamp: now,
+	})
+	write()
+	*streamRecord = updated
+
+	_ = ctx.EventManager().EmitTypedEvents(&types.EventAutoDeposit{
+		Addr:   streamRecord.Account,
+		From:   allowance.From,
+		Amount: amount,
+	})
+	return true
+}
+
+// setAutoDepositRecord records a pull of the auto deposit allowance, the pulls in the same block are merged.
+func (k Keeper) setAutoDepositRecord(ctx sdk.Context, record *types.AutoDepositRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoDepositRecordKeyPrefix)
+	key := types.AutoDepositRecordKey(sdk.MustAccAddressFromHex(record.Addr), record.Timestamp)
+	if b := store.Get(key); b != nil {
+		var prev types.AutoDepositRecord
+		k.cdc.MustUnmarshal(b, &prev)
+		record.Amount = record.Amount.Add(prev.Amount)
+	}
+	store.Set(key, k.cdc.MustMarshal(record))
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func TestAutoDeposit(t *testing.T) {
+	keeper, ctx, deepKeepers := makePaymentKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	addr := sample.RandAccAddress()
+	streamRecord := types.NewStreamRecord(addr, ctx.BlockTime().Unix())
+	streamRecord.NetflowRate = sdkmath.NewInt(-1)
+	streamRecord.StaticBalance = sdkmath.NewInt(100000)
+	streamRecord.BufferBalance = sdkmath.NewIntFromUint64(types.DefaultReserveTime)
+	streamRecord.SettleTimestamp = 1000 - int64(types.DefaultForcedSettleTime) + 100000 + int64(types.DefaultReserveTime)
+	keeper.SetStreamRecord(ctx, streamRecord)
+
+	// only the account or the owner of the payment account can authorize the pulls
+	err := keeper.SetAutoDepositAllowance(ctx, sample.RandAccAddress(), addr, sdkmath.NewInt(1e6), 3600, 200*24*3600)
+	require.ErrorIs(t, err, types.ErrNotPaymentAccountOwner)
+
+	// the runway is below the threshold of 200 days, so the funds are pulled at once
+	err = keeper.SetAutoDepositAllowance(ctx, addr, addr, sdkmath.NewInt(1e6), 3600, 200*24*3600)
+	require.NoError(t, err)
+	deepKeepers.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr, types.ModuleName,
+		sdk.NewCoins(sdk.NewCoin(types.DefaultFeeDenom, sdkmath.NewInt(1e6)))).Return(nil).Times(1)
+	keeper.AutoDeposit(ctx)
+
+	streamRecord, _ = keeper.GetStreamRecord(ctx, addr)
+	require.Equal(t, sdkmath.NewInt(1100000), streamRecord.StaticBalance)
+	res, err := keeper.AutoDepositRecords(ctx, &types.QueryAutoDepositRecordsRequest{Account: addr.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, sdkmath.NewInt(1e6), res.Records[0].Amount)
+	require.Equal(t, int64(1000), res.Records[0].Timestamp)
+
+	// the allowance of the period is used up, the account is checked again in the next period
+	ctx = ctx.WithBlockTime(time.Unix(1001, 0))
+	keeper.AutoDeposit(ctx)
+	allowance, found := keeper.GetAutoDepositAllowance(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(1e6), allowance.PulledInPeriod)
+	require.Equal(t, int64(1000+3600), allowance.NextCheckTime)
+
+	// revoke the allowance
+	err = keeper.SetAutoDepositAllowance(ctx, addr, addr, sdkmath.ZeroInt(), 0, 0)
+	require.NoError(t, err)
+	_, found = keeper.GetAutoDepositAllowance(ctx, addr)
+	require.False(t, found)
+}
+
+func TestAutoDeposit_AutoSettle(t *testing.T) {
+	keeper, ctx, deepKeepers := makePaymentKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	deepKeepers.AccountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).
+		Return(false).AnyTimes()
+
+	// both accounts are due to be settled, the allowance of the first one can not restore it
+	rate := sdkmath.NewInt(100)
+	gvg := sample.RandAccAddress()
+	gvgRecord := types.NewStreamRecord(gvg, ctx.BlockTime().Unix())
+	gvgRecord.NetflowRate = rate
+	keeper.SetStreamRecord(ctx, gvgRecord)
+	short, restored := sample.RandAccAddress(), sample.RandAccAddress()
+	for _, addr := range []sdk.AccAddress{short, restored} {
+		streamRecord := types.NewStreamRecord(addr, ctx.BlockTime().Unix())
+		streamRecord.NetflowRate = rate.Neg()
+		streamRecord.OutFlowCount = 1
+		streamRecord.SettleTimestamp = ctx.BlockTime().Unix()
+		keeper.SetStreamRecord(ctx, streamRecord)
+		keeper.SetOutFlow(ctx, addr, &types.OutFlow{
+			ToAddress: gvg.String(),
+			Rate:      rate,
+			Status:    types.OUT_FLOW_STATUS_ACTIVE,
+		})
+		keeper.SetAutoSettleRecord(ctx, &types.AutoSettleRecord{
+			Timestamp: ctx.BlockTime().Unix(),
+			Addr:      addr.String(),
+		})
+	}
+	err := keeper.SetAutoDepositAllowance(ctx, short, short, sdkmath.NewInt(1e6), 3600, 200*24*3600)
+	require.NoError(t, err)
+	err = keeper.SetAutoDepositAllowance(ctx, restored, restored, sdkmath.NewInt(1e8), 3600, 200*24*3600)
+	require.NoError(t, err)
+	deepKeepers.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), short, types.ModuleName,
+		sdk.NewCoins(sdk.NewCoin(types.DefaultFeeDenom, sdkmath.NewInt(1e6)))).Return(nil).Times(1)
+	deepKeepers.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), restored, types.ModuleName,
+		sdk.NewCoins(sdk.NewCoin(types.DefaultFeeDenom, sdkmath.NewInt(1e8)))).Return(nil).Times(1)
+
+	keeper.AutoSettle(ctx.WithValue(types.ForceUpdateStreamRecordKey, true))
+
+	// the pull covering less than the forced settle time is dropped, and the account is settled
+	streamRecord, _ := keeper.GetStreamRecord(ctx, short)
+	require.Equal(t, types.STREAM_ACCOUNT_STATUS_FROZEN, streamRecord.Status)
+	allowance, found := keeper.GetAutoDepositAllowance(ctx, short)
+	require.True(t, found)
+	require.True(t, allowance.PulledInPeriod.IsZero())
+	res, err := keeper.AutoDepositRecords(ctx, &types.QueryAutoDepositRecordsRequest{Account: short.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+
+	streamRecord, _ = keeper.GetStreamRecord(ctx, restored)
+	require.Equal(t, types.STREAM_ACCOUNT_STATUS_ACTIVE, streamRecord.Status)
+	require.Greater(t, streamRecord.SettleTimestamp, ctx.BlockTime().Unix())
+	allowance, found = keeper.GetAutoDepositAllowance(ctx, restored)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(1e8), allowance.PulledInPeriod)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+// GetFrozenTime returns the total seconds the stream account has been frozen for up to the block time. It never
+// decreases, so the seconds the account is frozen during a time range is the difference of it at the two ends.
+func (k Keeper) GetFrozenTime(ctx sdk.Context, addr sdk.AccAddress) int64 {
+	total, since := k.getFrozenTime(ctx, addr)
+	if now := ctx.BlockTime().Unix(); since > 0 && now > since {
+		total += now - since
+	}
+	return total
+}
+
+// getFrozenTime returns the total seconds the stream account was frozen for before it is frozen the last time, and
+// the timestamp it is frozen since, zero if it is active.
+func (k Keeper) getFrozenTime(ctx sdk.Context, addr sdk.AccAddress) (total, since int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FrozenTimeKeyPrefix)
+	b := store.Get(types.FrozenTimeKey(addr))
+	if b == nil {
+		// the accounts frozen before their frozen time is kept are taken as frozen since they were settled
+		streamRecord, found := k.GetStreamRecord(ctx, addr)
+		if found && streamRecord.Status == types.STREAM_ACCOUNT_STATUS_FROZEN && streamRecord.SettleTimestamp > 0 {
+			return 0, streamRecord.SettleTimestamp
+		}
+		return 0, 0
+	}
+	return int64(sdk.BigEndianToUint64(b[:8])), int64(sdk.BigEndianToUint64(b[8:]))
+}
+
+func (k Keeper) setFrozenTime(ctx sdk.Context, addr sdk.AccAddress, total, since int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FrozenTimeKeyPrefix)
+	store.Set(types.FrozenTimeKey(addr), append(sdk.Uint64ToBigEndian(uint64(total)), sdk.Uint64ToBigEndian(uint64(since))...))
+}
+
+// markStreamRecordFrozen starts counting the frozen time of the stream account, it should be called before the
+// frozen status of the stream record is stored.
+func (k Keeper) markStreamRecordFrozen(ctx sdk.Context, addr sdk.AccAddress) {
+	total, since := k.getFrozenTime(ctx, addr)
+	if since == 0 {
+		k.setFrozenTime(ctx, addr, total, ctx.BlockTime().Unix())
+	}
+}
+
+// markStreamRecordResumed adds the seconds the stream account has been frozen for to its frozen time, it should be
+// called before the active status of the stream record is stored.
+func (k Keeper) markStreamRecordResumed(ctx sdk.Context, addr sdk.AccAddress) {
+	total, since := k.getFrozenTime(ctx, addr)
+	if since == 0 {
+		return
+	}
+	if now := ctx.BlockTime().Unix(); now > since {
+		total += now - since
+	}
+	k.setFrozenTime(ctx, addr, total, 0)
+}
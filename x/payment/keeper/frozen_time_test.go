@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func TestGetFrozenTime(t *testing.T) {
+	keeper, ctx, depKeepers := makePaymentKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+	ctx = ctx.WithValue(types.ForceUpdateStreamRecordKey, true)
+	depKeepers.AccountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	keeper.SetStreamRecord(ctx, types.NewStreamRecord(types.GovernanceAddress, ctx.BlockTime().Unix()))
+
+	user := sample.RandAccAddress()
+	userRecord := types.NewStreamRecord(user, ctx.BlockTime().Unix())
+	userRecord.NetflowRate = sdkmath.NewInt(-100)
+	keeper.SetStreamRecord(ctx, userRecord)
+	require.Zero(t, keeper.GetFrozenTime(ctx, user))
+
+	// the account is force settled, and frozen from then on
+	_, err := keeper.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(user))
+	require.NoError(t, err)
+	userRecord, _ = keeper.GetStreamRecord(ctx, user)
+	require.Equal(t, types.STREAM_ACCOUNT_STATUS_FROZEN, userRecord.Status)
+	ctx = ctx.WithBlockTime(time.Unix(130, 0))
+	require.Equal(t, int64(30), keeper.GetFrozenTime(ctx, user))
+
+	// the frozen time stops growing once the account is resumed
+	ctx = ctx.WithBlockTime(time.Unix(150, 0))
+	params := keeper.GetParams(ctx)
+	err = keeper.TryResumeStreamRecord(ctx, userRecord, sdkmath.NewInt(100).MulRaw(int64(params.VersionedParams.ReserveTime)))
+	require.NoError(t, err)
+	userRecord, _ = keeper.GetStreamRecord(ctx, user)
+	require.Equal(t, types.STREAM_ACCOUNT_STATUS_ACTIVE, userRecord.Status)
+	ctx = ctx.WithBlockTime(time.Unix(200, 0))
+	require.Equal(t, int64(50), keeper.GetFrozenTime(ctx, user))
+
+	// an account frozen before its frozen time is kept is taken as frozen since it was settled
+	legacy := sample.RandAccAddress()
+	legacyRecord := types.NewStreamRecord(legacy, 120)
+	legacyRecord.Status = types.STREAM_ACCOUNT_STATUS_FROZEN
+	legacyRecord.SettleTimestamp = 120
+	keeper.SetStreamRecord(ctx, legacyRecord)
+	require.Equal(t, int64(80), keeper.GetFrozenTime(ctx, legacy))
+}
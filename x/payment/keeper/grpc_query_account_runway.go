@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func (k Keeper) AccountRunway(c context.Context, req *types.QueryAccountRunwayRequest) (*types.QueryAccountRunwayResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, err := sdk.AccAddressFromHexUnsafe(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account")
+	}
+	if _, found := k.GetStreamRecord(ctx, account); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return k.GetAccountRunway(ctx, account)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func (k Keeper) AutoDepositAllowance(c context.Context, req *types.QueryAutoDepositAllowanceRequest) (*types.QueryAutoDepositAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, err := sdk.AccAddressFromHexUnsafe(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account")
+	}
+	allowance, found := k.GetAutoDepositAllowance(ctx, account)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryAutoDepositAllowanceResponse{Allowance: *allowance}, nil
+}
+
+func (k Keeper) AutoDepositRecords(c context.Context, req *types.QueryAutoDepositRecordsRequest) (*types.QueryAutoDepositRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	account, err := sdk.AccAddressFromHexUnsafe(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account")
+	}
+
+	var records []types.AutoDepositRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoDepositRecordKeyPrefix)
+	accountStore := prefix.NewStore(store, account.Bytes())
+
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(_ []byte, value []byte) error {
+		var record types.AutoDepositRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAutoDepositRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func (k Keeper) Stream(c context.Context, req *types.QueryStreamRequest) (*types.QueryStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stream, found := k.GetUserStream(ctx, req.StreamId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryStreamResponse{Stream: *stream}, nil
+}
+
+func (k Keeper) StreamsBySender(c context.Context, req *types.QueryStreamsBySenderRequest) (*types.QueryStreamsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromHexUnsafe(req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid sender")
+	}
+
+	var streams []types.UserStream
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserStreamBySenderKeyPrefix)
+	senderStore := prefix.NewStore(store, sender.Bytes())
+
+	pageRes, err := query.Paginate(senderStore, req.Pagination, func(key []byte, _ []byte) error {
+		stream, found := k.GetUserStream(ctx, sdk.BigEndianToUint64(key))
+		if found {
+			streams = append(streams, *stream)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamsBySenderResponse{Streams: streams, Pagination: pageRes}, nil
+}
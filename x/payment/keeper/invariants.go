@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+// RegisterInvariants registers all payment invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "netflow-rate", NetflowRateInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "stream-record-balance", StreamRecordBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the payment module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := NetflowRateInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return StreamRecordBalanceInvariant(k)(ctx)
+	}
+}
+
+// NetflowRateInvariant checks that the netflow rates of all stream records sum up to zero.
+// Every out flow is applied to the payer and the receiver in pairs, and freezing an out flow
+// moves the same amount back on both sides, so any non-zero sum means amoca is created or lost.
+// A frozen account may be in the middle of a forced settle or a resume spanning several blocks,
+// so its own netflow rate is skipped and the active out flows between it and the other accounts
+// stand in for it.
+func NetflowRateInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		frozen := make(map[string]bool)
+		total := sdkmath.ZeroInt()
+		for _, record := range k.GetAllStreamRecord(ctx) {
+			if record.Status == types.STREAM_ACCOUNT_STATUS_FROZEN {
+				frozen[string(sdk.MustAccAddressFromHex(record.Account))] = true
+				continue
+			}
+			total = total.Add(record.NetflowRate)
+		}
+
+		if len(frozen) > 0 {
+			flowStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutFlowKeyPrefix)
+			iterator := flowStore.Iterator(nil, nil)
+			defer iterator.Close()
+			for ; iterator.Valid(); iterator.Next() {
+				addr, outFlow := types.ParseOutFlowKey(iterator.Key())
+				if outFlow.Status != types.OUT_FLOW_STATUS_ACTIVE {
+					continue
+				}
+				payerFrozen := frozen[string(addr)]
+				receiverFrozen := frozen[string(sdk.MustAccAddressFromHex(outFlow.ToAddress))]
+				switch {
+				case payerFrozen && !receiverFrozen:
+					total = total.Sub(types.ParseOutFlowValue(iterator.Value()))
+				case !payerFrozen && receiverFrozen:
+					total = total.Add(types.ParseOutFlowValue(iterator.Value()))
+				}
+			}
+		}
+
+		broken := !total.IsZero()
+		return sdk.FormatInvariant(types.ModuleName, "netflow rate",
+			fmt.Sprintf("\tsum of stream record netflow rates: %s\n", total)), broken
+	}
+}
+
+// StreamRecordBalanceInvariant checks that no stream record carries a negative lock or buffer balance,
+// and that a frozen netflow rate only shows up together with out flows.
+func StreamRecordBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		for _, record := range k.GetAllStreamRecord(ctx) {
+			if record.LockBalance.IsNegative() || record.BufferBalance.IsNegative() {
+				count++
+				msg += fmt.Sprintf("\t%s has negative balance, lock: %s, buffer: %s\n",
+					record.Account, record.LockBalance, record.BufferBalance)
+			}
+			if !record.FrozenNetflowRate.IsNil() && record.FrozenNetflowRate.IsNegative() && record.OutFlowCount == 0 {
+				count++
+				msg += fmt.Sprintf("\t%s has frozen netflow rate %s without out flows\n",
+					record.Account, record.FrozenNetflowRate)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "stream record balance",
+			fmt.Sprintf("found %d invalid stream records\n%s", count, msg)), broken
+	}
+}
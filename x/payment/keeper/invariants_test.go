@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	"github.com/mocachain/moca/v2/x/payment/keeper"
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func TestNetflowRateInvariant(t *testing.T) {
+	k, ctx, _ := makePaymentKeeper(t)
+
+	payer := types.NewStreamRecord(sample.RandAccAddress(), ctx.BlockTime().Unix())
+	payer.NetflowRate = sdkmath.NewInt(-100)
+	payer.OutFlowCount = 1
+	receiver := types.NewStreamRecord(sample.RandAccAddress(), ctx.BlockTime().Unix())
+	receiver.NetflowRate = sdkmath.NewInt(100)
+	k.SetStreamRecord(ctx, payer)
+	k.SetStreamRecord(ctx, receiver)
+
+	_, broken := keeper.NetflowRateInvariant(*k)(ctx)
+	require.False(t, broken)
+
+	// a receiver without a matching payer breaks the invariant
+	receiver.NetflowRate = sdkmath.NewInt(150)
+	k.SetStreamRecord(ctx, receiver)
+
+	msg, broken := keeper.NetflowRateInvariant(*k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "50")
+
+	// the netflow rate of a frozen payer in the middle of a forced settle is covered by its active out flows
+	payer.Status = types.STREAM_ACCOUNT_STATUS_FROZEN
+	payer.NetflowRate = sdkmath.NewInt(-300)
+	receiver.NetflowRate = sdkmath.NewInt(40)
+	k.SetStreamRecord(ctx, payer)
+	k.SetStreamRecord(ctx, receiver)
+	k.SetOutFlow(ctx, sdk.MustAccAddressFromHex(payer.Account), &types.OutFlow{
+		ToAddress: receiver.Account,
+		Rate:      sdkmath.NewInt(40),
+		Status:    types.OUT_FLOW_STATUS_ACTIVE,
+	})
+
+	_, broken = keeper.NetflowRateInvariant(*k)(ctx)
+	require.False(t, broken)
+}
+
+func TestStreamRecordBalanceInvariant(t *testing.T) {
+	k, ctx, _ := makePaymentKeeper(t)
+
+	record := types.NewStreamRecord(sample.RandAccAddress(), ctx.BlockTime().Unix())
+	record.Status = types.STREAM_ACCOUNT_STATUS_FROZEN
+	record.FrozenNetflowRate = sdkmath.NewInt(-100)
+	record.OutFlowCount = 1
+	k.SetStreamRecord(ctx, record)
+
+	_, broken := keeper.AllInvariants(*k)(ctx)
+	require.False(t, broken)
+
+	record.OutFlowCount = 0
+	k.SetStreamRecord(ctx, record)
+
+	_, broken = keeper.StreamRecordBalanceInvariant(*k)(ctx)
+	require.True(t, broken)
+}
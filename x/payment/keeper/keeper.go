@@ -22,6 +22,7 @@ type (
 
 		bankKeeper    types.BankKeeper
 		accountKeeper types.AccountKeeper
+		spKeeper      types.SpKeeper
 		authority     string
 	}
 )
@@ -31,6 +32,7 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
+	spKeeper types.SpKeeper,
 	authority string,
 ) *Keeper {
 	return &Keeper{
@@ -38,6 +40,7 @@ func NewKeeper(
 		storeKey:      storeKey,
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
+		spKeeper:      spKeeper,
 		authority:     authority,
 	}
 }
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func (k msgServer) SetAutoDepositAllowance(goCtx context.Context, msg *types.MsgSetAutoDepositAllowance) (*types.MsgSetAutoDepositAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.SetAutoDepositAllowance(ctx, sdk.MustAccAddressFromHex(msg.Creator), sdk.MustAccAddressFromHex(msg.Addr),
+		msg.MaxAmountPerPeriod, msg.PeriodSeconds, msg.ThresholdSeconds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetAutoDepositAllowanceResponse{}, nil
+}
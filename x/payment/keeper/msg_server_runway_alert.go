@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func (k msgServer) SetRunwayAlert(goCtx context.Context, msg *types.MsgSetRunwayAlert) (*types.MsgSetRunwayAlertResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.SetRunwayAlert(ctx, sdk.MustAccAddressFromHex(msg.Owner), sdk.MustAccAddressFromHex(msg.Addr), msg.ThresholdSeconds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetRunwayAlertResponse{}, nil
+}
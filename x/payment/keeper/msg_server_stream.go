@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func (k msgServer) CreateStream(goCtx context.Context, msg *types.MsgCreateStream) (*types.MsgCreateStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	streamID, err := k.Keeper.CreateStream(ctx, sdk.MustAccAddressFromHex(msg.Creator),
+		sdk.MustAccAddressFromHex(msg.Sender), sdk.MustAccAddressFromHex(msg.Recipient), msg.Rate)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateStreamResponse{StreamId: streamID}, nil
+}
+
+func (k msgServer) UpdateStream(goCtx context.Context, msg *types.MsgUpdateStream) (*types.MsgUpdateStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.UpdateStream(ctx, sdk.MustAccAddressFromHex(msg.Creator), msg.StreamId, msg.Rate)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateStreamResponse{}, nil
+}
+
+func (k msgServer) CancelStream(goCtx context.Context, msg *types.MsgCancelStream) (*types.MsgCancelStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.CancelStream(ctx, sdk.MustAccAddressFromHex(msg.Creator), msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelStreamResponse{}, nil
+}
@@ -45,12 +45,14 @@ func (s *TestSuite) SetupTest() {
 
 	bankKeeper := types.NewMockBankKeeper(ctrl)
 	accountKeeper := types.NewMockAccountKeeper(ctrl)
+	spKeeper := types.NewMockSpKeeper(ctrl)
 
 	s.paymentKeeper = keeper.NewKeeper(
 		encCfg.Codec,
 		key,
 		bankKeeper,
 		accountKeeper,
+		spKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
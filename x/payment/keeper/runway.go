@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+// GetRunwayAlert returns the runway alert set by the stream account
+func (k Keeper) GetRunwayAlert(ctx sdk.Context, addr sdk.AccAddress) (*types.RunwayAlert, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RunwayAlertKeyPrefix)
+	b := store.Get(types.RunwayAlertKey(addr))
+	if b == nil {
+		return nil, false
+	}
+
+	alert := &types.RunwayAlert{}
+	k.cdc.MustUnmarshal(b, alert)
+	return alert, true
+}
+
+// setRunwayAlert stores the runway alert and moves its check in the queue to the check time, a zero check time
+// removes it from the queue.
+func (k Keeper) setRunwayAlert(ctx sdk.Context, addr sdk.AccAddress, alert *types.RunwayAlert, checkTime int64) {
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.RunwayAlertQueueKeyPrefix)
+	if alert.NextCheckTime != 0 && alert.NextCheckTime != checkTime {
+		queue.Delete(types.RunwayAlertQueueKey(alert.NextCheckTime, addr))
+	}
+	if checkTime != 0 {
+		queue.Set(types.RunwayAlertQueueKey(checkTime, addr), []byte{})
+	}
+	alert.NextCheckTime = checkTime
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RunwayAlertKeyPrefix)
+	store.Set(types.RunwayAlertKey(addr), k.cdc.MustMarshal(alert))
+}
+
+// removeRunwayAlert removes the runway alert and its check in the queue
+func (k Keeper) removeRunwayAlert(ctx sdk.Context, addr sdk.AccAddress, alert *types.RunwayAlert) {
+	if alert.NextCheckTime != 0 {
+		queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.RunwayAlertQueueKeyPrefix)
+		queue.Delete(types.RunwayAlertQueueKey(alert.NextCheckTime, addr))
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RunwayAlertKeyPrefix)
+	store.Delete(types.RunwayAlertKey(addr))
+}
+
+// SetRunwayAlert sets the runway below which an EventLowRunway is emitted for the stream account, a zero threshold
+// disables the alert. The runway is checked at the end of the block.
+func (k Keeper) SetRunwayAlert(ctx sdk.Context, operator, addr sdk.AccAddress, thresholdSeconds uint64) error {
+	if !k.IsPaymentAccountOwner(ctx, addr, operator) {
+		return errorsmod.Wrapf(types.ErrNotPaymentAccountOwner, "%s can not set the runway alert of %s", operator, addr)
+	}
+	if _, found := k.GetStreamRecord(ctx, addr); !found {
+		return errorsmod.Wrapf(types.ErrStreamRecordNotFound, "stream account: %s", addr)
+	}
+
+	alert, found := k.GetRunwayAlert(ctx, addr)
+	switch {
+	case thresholdSeconds == 0 && found:
+		k.removeRunwayAlert(ctx, addr, alert)
+	case thresholdSeconds != 0:
+		if !found {
+			alert = &types.RunwayAlert{}
+		}
+		alert.ThresholdSeconds = thresholdSeconds
+		alert.Alerted = false
+		k.setRunwayAlert(ctx, addr, alert, ctx.BlockTime().Unix())
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSetRunwayAlert{
+		Addr:             addr.String(),
+		ThresholdSeconds: thresholdSeconds,
+	})
+}
+
+// rescheduleRunwayAlert moves the runway check of the stream account as its settle timestamp changes. The settle
+// timestamp leaves the bank balance out, so the check may come earlier than needed, in which case it is queued
+// again by CheckRunwayAlerts.
+func (k Keeper) rescheduleRunwayAlert(ctx sdk.Context, addr sdk.AccAddress, settleTimestamp int64) {
+	alert, found := k.GetRunwayAlert(ctx, addr)
+	if !found {
+		return
+	}
+	checkTime := int64(0)
+	alerted := alert.Alerted
+	if settleTimestamp != 0 {
+		checkTime = runwayCheckTime(ctx.BlockTime().Unix(), settleTimestamp, alert.ThresholdSeconds)
+	} else {
+		alerted = false
+	}
+	if checkTime == alert.NextCheckTime && alerted == alert.Alerted {
+		return
+	}
+	alert.Alerted = alerted
+	k.setRunwayAlert(ctx, addr, alert, checkTime)
+}
+
+// runwayCheckTime returns when the runway ending at the depletion timestamp falls to the threshold, it is no earlier
+// than now.
+func runwayCheckTime(now, depletionTimestamp int64, thresholdSeconds uint64) int64 {
+	if depletionTimestamp <= now || uint64(depletionTimestamp-now) <= thresholdSeconds {
+		return now
+	}
+	return depletionTimestamp - int64(thresholdSeconds)
+}
+
+// CheckRunwayAlerts checks the runways of the stream accounts queued up to now, and emits an EventLowRunway for the
+// ones falling below their thresholds. An account is alerted once until its runway is above the threshold again.
+// At most MaxAutoSettleFlowCount accounts are checked in a block, the rest are left for the following blocks.
+func (k Keeper) CheckRunwayAlerts(ctx sdk.Context) {
+	now := ctx.BlockTime().Unix()
+	max := k.GetParams(ctx).MaxAutoSettleFlowCount
+
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.RunwayAlertQueueKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(queue, []byte{})
+	due := make([]sdk.AccAddress, 0)
+	for ; iterator.Valid() && uint64(len(due)) < max; iterator.Next() {
+		timestamp, addr := types.ParseRunwayAlertQueueKey(iterator.Key())
+		if timestamp > now {
+			break
+		}
+		due = append(due, addr)
+	}
+	iterator.Close()
+
+	for _, addr := range due {
+		alert, found := k.GetRunwayAlert(ctx, addr)
+		if !found { // should not happen
+			continue
+		}
+		runway, err := k.GetAccountRunway(ctx, addr)
+		if err != nil {
+			k.removeRunwayAlert(ctx, addr, alert)
+			continue
+		}
+
+		if runway.DepletionTimestamp == 0 {
+			alert.Alerted = false
+			k.setRunwayAlert(ctx, addr, alert, 0)
+			continue
+		}
+		if runway.RunwaySeconds >= int64(alert.ThresholdSeconds) {
+			alert.Alerted = false
+			checkTime := runwayCheckTime(now, runway.DepletionTimestamp, alert.ThresholdSeconds)
+			if checkTime <= now {
+				checkTime = now + 1
+			}
+			k.setRunwayAlert(ctx, addr, alert, checkTime)
+			continue
+		}
+
+		if !alert.Alerted {
+			alert.Alerted = true
+			_ = ctx.EventManager().EmitTypedEvents(&types.EventLowRunway{
+				Addr:               addr.String(),
+				RunwaySeconds:      runway.RunwaySeconds,
+				DepletionTimestamp: runway.DepletionTimestamp,
+				ThresholdSeconds:   alert.ThresholdSeconds,
+			})
+		}
+		k.setRunwayAlert(ctx, addr, alert, 0)
+	}
+}
+
+// GetAccountRunway projects when the stream account will be frozen for lack of balance. The static balance, the
+// buffer balance and the balance of the bank account, which is transferred automatically, are paid out at the
+// netflow rate until the forced settle time is reached. The lock balance is held for the pending objects and is not
+// counted. If the global store price of x/sp is going to change, the outflows for storage are estimated to change
+// in the same proportion from then on, assuming the buckets are repriced at that time.
+func (k Keeper) GetAccountRunway(ctx sdk.Context, addr sdk.AccAddress) (*types.QueryAccountRunwayResponse, error) {
+	streamRecord, found := k.GetStreamRecord(ctx, addr)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrStreamRecordNotFound, "stream account: %s", addr)
+	}
+	now := ctx.BlockTime().Unix()
+	params := k.GetParams(ctx)
+
+	staticBalance := streamRecord.StaticBalance
+	if now > streamRecord.CrudTimestamp {
+		staticBalance = staticBalance.Add(streamRecord.NetflowRate.MulRaw(now - streamRecord.CrudTimestamp))
+	}
+	bankBalance := sdkmath.ZeroInt()
+	if k.accountKeeper.HasAccount(ctx, addr) {
+		bankBalance = k.bankKeeper.GetBalance(ctx, addr, params.FeeDenom).Amount
+	}
+
+	res := &types.QueryAccountRunwayResponse{
+		Status:               streamRecord.Status,
+		NetflowRate:          streamRecord.NetflowRate,
+		StaticBalance:        staticBalance,
+		BufferBalance:        streamRecord.BufferBalance,
+		LockBalance:          streamRecord.LockBalance,
+		BankBalance:          bankBalance,
+		ReserveTime:          params.VersionedParams.ReserveTime,
+		ForcedSettleTime:     params.ForcedSettleTime,
+		ProjectedNetflowRate: streamRecord.NetflowRate,
+	}
+	if alert, found := k.GetRunwayAlert(ctx, addr); found {
+		res.AlertThresholdSeconds = alert.ThresholdSeconds
+	}
+	if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_FROZEN {
+		res.DepletionTimestamp = now
+		return res, nil
+	}
+	if !streamRecord.NetflowRate.IsNegative() {
+		return res, nil
+	}
+
+	funds := staticBalance.Add(streamRecord.BufferBalance).Add(bankBalance)
+	depletion := depletionTimestamp(now, funds, streamRecord.NetflowRate, params.ForcedSettleTime)
+	if changeTime, projectedRate, changed := k.projectStorePriceChange(ctx, addr, streamRecord.NetflowRate); changed {
+		res.PriceChangeTime = changeTime
+		res.ProjectedNetflowRate = projectedRate
+		if depletion > changeTime {
+			funds = funds.Add(streamRecord.NetflowRate.MulRaw(changeTime - now))
+			depletion = depletionTimestamp(changeTime, funds, projectedRate, params.ForcedSettleTime)
+		}
+	}
+	res.DepletionTimestamp = depletion
+	if depletion != 0 {
+		res.RunwaySeconds = depletion - now
+	}
+	return res, nil
+}
+
+// depletionTimestamp returns when the funds paid out at the rate from the start are left for the forced settle time
+// only, saturated at MaxInt64. Zero is returned if the rate is not negative.
+func depletionTimestamp(start int64, funds, rate sdkmath.Int, forcedSettleTime uint64) int64 {
+	if !rate.IsNegative() {
+		return 0
+	}
+	timestamp := sdkmath.NewInt(start).
+		Add(funds.Quo(rate.Abs())).
+		Sub(sdkmath.NewIntFromUint64(forcedSettleTime))
+	switch {
+	case timestamp.GT(settleTimestampMax):
+		return settleTimestampMax.Int64()
+	case timestamp.LT(sdkmath.NewInt(start)):
+		return start
+	default:
+		return timestamp.Int64()
+	}
+}
+
+// projectStorePriceChange returns when the global store price of x/sp changes next and the netflow rate of the
+// stream account from then on. The outflows for storage are the active outflows other than the user streams.
+func (k Keeper) projectStorePriceChange(ctx sdk.Context, addr sdk.AccAddress, rate sdkmath.Int) (int64, sdkmath.Int, bool) {
+	next, found := k.spKeeper.GetNextGlobalSpStorePrice(ctx)
+	if !found {
+		return 0, rate, false
+	}
+	current, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, ctx.BlockTime().Unix()+1)
+	if err != nil || !current.PrimaryStorePrice.IsPositive() || next.PrimaryStorePrice.Equal(current.PrimaryStorePrice) {
+		return 0, rate, false
+	}
+
+	storageRate := sdkmath.ZeroInt()
+	for _, outFlow := range k.GetOutFlows(ctx, addr) {
+		if outFlow.Status == types.OUT_FLOW_STATUS_ACTIVE {
+			storageRate = storageRate.Add(outFlow.Rate)
+		}
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserStreamBySenderKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, addr.Bytes()), []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if stream, found := k.GetUserStream(ctx, sdk.BigEndianToUint64(iterator.Key())); found {
+			storageRate = storageRate.Sub(stream.Rate)
+		}
+	}
+	if !storageRate.IsPositive() {
+		return 0, rate, false
+	}
+
+	// round the projected outflow up, so that the depletion is not projected too late
+	projectedStorageRate := sdkmath.LegacyNewDecFromInt(storageRate).
+		Mul(next.PrimaryStorePrice).
+		Quo(current.PrimaryStorePrice).
+		Ceil().TruncateInt()
+	return next.UpdateTimeSec, rate.Add(storageRate).Sub(projectedStorageRate), true
+}
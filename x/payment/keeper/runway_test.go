@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	"github.com/mocachain/moca/v2/x/payment/keeper"
+	"github.com/mocachain/moca/v2/x/payment/types"
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+)
+
+// setRunwayStreamRecord sets a stream account paying 1 amoca per second with 10 days of static balance and
+// 1 day of bank balance, so that it is frozen at 16417000 if the current time is 1000.
+func setRunwayStreamRecord(ctx sdk.Context, k *keeper.Keeper, deepKeepers DepKeepers) sdk.AccAddress {
+	deepKeepers.AccountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).
+		Return(true).AnyTimes()
+	deepKeepers.BankKeeper.EXPECT().GetBalance(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(sdk.NewCoin(types.DefaultFeeDenom, sdkmath.NewInt(86400))).AnyTimes()
+
+	addr := sample.RandAccAddress()
+	streamRecord := types.NewStreamRecord(addr, ctx.BlockTime().Unix())
+	streamRecord.NetflowRate = sdkmath.NewInt(-1)
+	streamRecord.StaticBalance = sdkmath.NewInt(864000)
+	streamRecord.BufferBalance = sdkmath.NewIntFromUint64(types.DefaultReserveTime)
+	k.SetStreamRecord(ctx, streamRecord)
+	return addr
+}
+
+func TestAccountRunway(t *testing.T) {
+	keeper, ctx, deepKeepers := makePaymentKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	addr := setRunwayStreamRecord(ctx, keeper, deepKeepers)
+	keeper.SetOutFlow(ctx, addr, &types.OutFlow{
+		ToAddress: sample.RandAccAddress().String(),
+		Rate:      sdkmath.NewInt(1),
+		Status:    types.OUT_FLOW_STATUS_ACTIVE,
+	})
+
+	// the store price doubles 1000 seconds later
+	deepKeepers.SpKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(sptypes.GlobalSpStorePrice{PrimaryStorePrice: sdkmath.LegacyNewDec(1)}, nil).AnyTimes()
+	deepKeepers.SpKeeper.EXPECT().GetNextGlobalSpStorePrice(gomock.Any()).
+		Return(sptypes.GlobalSpStorePrice{UpdateTimeSec: 2000, PrimaryStorePrice: sdkmath.LegacyNewDec(2)}, true).AnyTimes()
+
+	res, err := keeper.AccountRunway(ctx, &types.QueryAccountRunwayRequest{Account: addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(86400), res.BankBalance)
+	require.Equal(t, types.DefaultReserveTime, res.ReserveTime)
+	require.Equal(t, int64(2000), res.PriceChangeTime)
+	require.Equal(t, sdkmath.NewInt(-2), res.ProjectedNetflowRate)
+	// 16501400 left at the price change, paid out at 2 per second until the forced settle time
+	require.Equal(t, int64(2000+16501400/2-86400), res.DepletionTimestamp)
+	require.Equal(t, res.DepletionTimestamp-1000, res.RunwaySeconds)
+
+	_, err = keeper.AccountRunway(ctx, &types.QueryAccountRunwayRequest{Account: sample.RandAccAddress().String()})
+	require.Error(t, err)
+}
+
+func TestRunwayAlert(t *testing.T) {
+	keeper, ctx, deepKeepers := makePaymentKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	addr := setRunwayStreamRecord(ctx, keeper, deepKeepers)
+	deepKeepers.SpKeeper.EXPECT().GetNextGlobalSpStorePrice(gomock.Any()).
+		Return(sptypes.GlobalSpStorePrice{}, false).AnyTimes()
+
+	// only the account or the owner of the payment account can set the alert
+	err := keeper.SetRunwayAlert(ctx, sample.RandAccAddress(), addr, 100)
+	require.ErrorIs(t, err, types.ErrNotPaymentAccountOwner)
+
+	lowRunwayEvents := func(ctx sdk.Context) int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "moca.payment.EventLowRunway" {
+				count++
+			}
+		}
+		return count
+	}
+
+	// the runway of 16416000 seconds is below the threshold
+	err = keeper.SetRunwayAlert(ctx, addr, addr, 20000000)
+	require.NoError(t, err)
+	keeper.CheckRunwayAlerts(ctx)
+	require.Equal(t, 1, lowRunwayEvents(ctx))
+	alert, found := keeper.GetRunwayAlert(ctx, addr)
+	require.True(t, found)
+	require.True(t, alert.Alerted)
+	require.Zero(t, alert.NextCheckTime)
+
+	// the account is alerted once until its runway is above the threshold again
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0)).WithEventManager(sdk.NewEventManager())
+	streamRecord, _ := keeper.GetStreamRecord(ctx, addr)
+	err = keeper.UpdateStreamRecord(ctx, streamRecord, types.NewDefaultStreamRecordChangeWithAddr(addr))
+	require.NoError(t, err)
+	keeper.SetStreamRecord(ctx, streamRecord)
+	keeper.CheckRunwayAlerts(ctx)
+	require.Equal(t, 0, lowRunwayEvents(ctx))
+
+	// a lower threshold is checked again when the runway reaches it
+	err = keeper.SetRunwayAlert(ctx, addr, addr, 1000)
+	require.NoError(t, err)
+	keeper.CheckRunwayAlerts(ctx)
+	require.Equal(t, 0, lowRunwayEvents(ctx))
+	alert, _ = keeper.GetRunwayAlert(ctx, addr)
+	require.False(t, alert.Alerted)
+	require.Equal(t, int64(16417000-1000), alert.NextCheckTime)
+
+	err = keeper.SetRunwayAlert(ctx, addr, addr, 0)
+	require.NoError(t, err)
+	_, found = keeper.GetRunwayAlert(ctx, addr)
+	require.False(t, found)
+}
@@ -48,6 +48,17 @@ func (k Keeper) ApplyStreamRecordChanges(ctx sdk.Context, streamRecordChanges []
 	return nil
 }
 
+// applyRecipientRateChanges applies the rate changes of the recipients of the out flows, see updateRecipientStreamRecord.
+func (k Keeper) applyRecipientRateChanges(ctx sdk.Context, rateChanges []types.StreamRecordChange) error {
+	rateChanges = k.MergeStreamRecordChanges(rateChanges)
+	for i := range rateChanges {
+		if _, err := k.updateRecipientStreamRecord(ctx, &rateChanges[i]); err != nil {
+			return fmt.Errorf("update stream record failed: %w", err)
+		}
+	}
+	return nil
+}
+
 // ApplyUserFlowsList
 func (k Keeper) ApplyUserFlowsList(ctx sdk.Context, userFlowsList []types.UserFlows) (err error) {
 	userFlowsList = k.MergeUserFlows(userFlowsList)
@@ -108,7 +119,7 @@ func (k Keeper) applyActiveUserFlows(ctx sdk.Context, userFlows types.UserFlows,
 	streamRecord.OutFlowCount = uint64(int64(streamRecord.OutFlowCount) + int64(deltaFlowCount))
 
 	k.SetStreamRecord(ctx, streamRecord)
-	err = k.ApplyStreamRecordChanges(ctx, rateChanges)
+	err = k.applyRecipientRateChanges(ctx, rateChanges)
 	if err != nil {
 		return fmt.Errorf("apply stream record changes failed: %w", err)
 	}
@@ -164,7 +175,7 @@ func (k Keeper) applyFrozenUserFlows(ctx sdk.Context, userFlows types.UserFlows,
 
 	k.SetStreamRecord(ctx, streamRecord)
 	// only apply activeRateChanges, for frozen rate changes, the out flow to gvg & gvg family had been deducted when settling
-	err = k.ApplyStreamRecordChanges(ctx, activeRateChanges)
+	err = k.applyRecipientRateChanges(ctx, activeRateChanges)
 	if err != nil {
 		return fmt.Errorf("apply stream record changes failed: %w", err)
 	}
@@ -126,6 +126,7 @@ func (k Keeper) UpdateFrozenStreamRecord(ctx sdk.Context, streamRecord *types.St
 	if !forced {
 		return fmt.Errorf("stream record %s is frozen", streamRecord.Account)
 	}
+	k.accrueFrozenStreamRecord(ctx, streamRecord)
 	// update lock balance
 	if !change.LockBalanceChange.IsZero() {
 		streamRecord.LockBalance = streamRecord.LockBalance.Add(change.LockBalanceChange)
@@ -144,6 +145,29 @@ func (k Keeper) UpdateFrozenStreamRecord(ctx sdk.Context, streamRecord *types.St
 	return nil
 }
 
+// accrueFrozenStreamRecord credits a frozen stream record with its incoming flows since the last update, e.g. the user
+// streams to it. Its out flows are frozen, so only a positive netflow rate is accrued.
+func (k Keeper) accrueFrozenStreamRecord(ctx sdk.Context, streamRecord *types.StreamRecord) {
+	currentTimestamp := ctx.BlockTime().Unix()
+	if currentTimestamp <= streamRecord.CrudTimestamp {
+		return
+	}
+	if streamRecord.NetflowRate.IsPositive() {
+		streamRecord.StaticBalance = streamRecord.StaticBalance.Add(streamRecord.NetflowRate.MulRaw(currentTimestamp - streamRecord.CrudTimestamp))
+	}
+	streamRecord.CrudTimestamp = currentTimestamp
+}
+
+// updateRecipientStreamRecord applies the rate change of a recipient of the out flows. The rate change of a frozen
+// recipient is only booked, so it is applied with a forced update, and the recipient keeps accruing its incoming
+// flows until it is resumed.
+func (k Keeper) updateRecipientStreamRecord(ctx sdk.Context, change *types.StreamRecordChange) (*types.StreamRecord, error) {
+	if streamRecord, found := k.GetStreamRecord(ctx, change.Addr); found && streamRecord.Status == types.STREAM_ACCOUNT_STATUS_FROZEN {
+		ctx = ctx.WithValue(types.ForceUpdateStreamRecordKey, true)
+	}
+	return k.UpdateStreamRecordByAddr(ctx, change)
+}
+
 func (k Keeper) UpdateStreamRecord(ctx sdk.Context, streamRecord *types.StreamRecord, change *types.StreamRecordChange) error {
 	if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_FROZEN {
 		return k.UpdateFrozenStreamRecord(ctx, streamRecord, change)
@@ -253,7 +277,10 @@ func (k Keeper) UpdateStreamRecord(ctx sdk.Context, streamRecord *types.StreamRe
 			settleTimestamp = settleTimestampFull.Int64()
 		}
 	}
-	k.UpdateAutoSettleRecord(ctx, sdk.MustAccAddressFromHex(streamRecord.Account), streamRecord.SettleTimestamp, settleTimestamp)
+	account := sdk.MustAccAddressFromHex(streamRecord.Account)
+	k.UpdateAutoSettleRecord(ctx, account, streamRecord.SettleTimestamp, settleTimestamp)
+	k.rescheduleRunwayAlert(ctx, account, settleTimestamp)
+	k.rescheduleAutoDeposit(ctx, account, settleTimestamp)
 	streamRecord.SettleTimestamp = settleTimestamp
 	return nil
 }
@@ -287,6 +314,7 @@ func (k Keeper) ForceSettle(ctx sdk.Context, streamRecord *types.StreamRecord) e
 	// force settle
 	streamRecord.StaticBalance = sdkmath.ZeroInt()
 	streamRecord.BufferBalance = sdkmath.ZeroInt()
+	k.markStreamRecordFrozen(ctx, sdk.MustAccAddressFromHex(streamRecord.Account))
 	streamRecord.Status = types.STREAM_ACCOUNT_STATUS_FROZEN
 	// emit event
 	_ = ctx.EventManager().EmitTypedEvents(&types.EventForceSettle{
@@ -379,6 +407,10 @@ func (k Keeper) AutoSettle(ctx sdk.Context) {
 
 		if streamRecord.Status == types.STREAM_ACCOUNT_STATUS_ACTIVE {
 			count++ // add one for a stream record
+			// pull from the auto deposit source before the stream account is frozen
+			if k.autoDeposit(ctx, streamRecord) && streamRecord.SettleTimestamp > currentTimestamp {
+				continue
+			}
 			err := k.UpdateStreamRecord(ctx, streamRecord, types.NewDefaultStreamRecordChangeWithAddr(addr))
 			if err != nil {
 				ctx.Logger().Error("auto settle, settle stream record failed", "err", err.Error())
@@ -410,11 +442,13 @@ func (k Keeper) AutoSettle(ctx sdk.Context) {
 			k.SetOutFlow(ctx, addr, &outFlow)
 		}
 
+		k.accrueFrozenStreamRecord(ctx, streamRecord)
 		streamRecord.NetflowRate = streamRecord.NetflowRate.Add(totalRate)
 		streamRecord.FrozenNetflowRate = streamRecord.FrozenNetflowRate.Add(totalRate.Neg())
 
 		if fullySettled {
-			if !streamRecord.NetflowRate.IsZero() {
+			// only the incoming flows, e.g. the user streams to the account, are left
+			if streamRecord.NetflowRate.IsNegative() {
 				ctx.Logger().Error("should not happen, stream netflow rate is not zero", "address", streamRecord.Account)
 				panic("should not happen")
 			}
@@ -490,10 +524,12 @@ func (k Keeper) TryResumeStreamRecord(ctx sdk.Context, streamRecord *types.Strea
 	forcedSettleTime := params.ForcedSettleTime
 
 	now := ctx.BlockTime().Unix()
+	k.accrueFrozenStreamRecord(ctx, streamRecord)
 	totalRate := streamRecord.NetflowRate.Add(streamRecord.FrozenNetflowRate)
 	streamRecord.StaticBalance = streamRecord.StaticBalance.Add(depositBalance)
 
 	if totalRate.IsZero() {
+		k.markStreamRecordResumed(ctx, sdk.MustAccAddressFromHex(streamRecord.Account))
 		streamRecord.Status = types.STREAM_ACCOUNT_STATUS_ACTIVE
 		streamRecord.CrudTimestamp = now
 		streamRecord.SettleTimestamp = 0
@@ -530,6 +566,7 @@ func (k Keeper) TryResumeStreamRecord(ctx sdk.Context, streamRecord *types.Strea
 
 	ctx.Logger().Debug("try to resume stream account", "streamRecord.OutFlowCount", streamRecord.OutFlowCount, "params.MaxAutoResumeFlowCount", params.MaxAutoResumeFlowCount)
 	if streamRecord.OutFlowCount <= params.MaxAutoResumeFlowCount { // only rough judgement, resume directly
+		k.markStreamRecordResumed(ctx, sdk.MustAccAddressFromHex(streamRecord.Account))
 		streamRecord.Status = types.STREAM_ACCOUNT_STATUS_ACTIVE
 		streamRecord.NetflowRate = totalRate
 		streamRecord.FrozenNetflowRate = sdkmath.ZeroInt()
@@ -551,7 +588,7 @@ func (k Keeper) TryResumeStreamRecord(ctx sdk.Context, streamRecord *types.Strea
 
 			toAddr := sdk.MustAccAddressFromHex(outFlow.ToAddress)
 			change := types.NewDefaultStreamRecordChangeWithAddr(toAddr).WithRateChange(outFlow.Rate)
-			_, err := k.UpdateStreamRecordByAddr(ctx, change)
+			_, err := k.updateRecipientStreamRecord(ctx, change)
 			if err != nil {
 				return fmt.Errorf("try resume, update receiver stream record failed: %w", err)
 			}
@@ -660,6 +697,7 @@ func (k Keeper) AutoResume(ctx sdk.Context) {
 				ctx.Logger().Error("should not happen, stream frozen netflow rate is not zero", "address", streamRecord.Account)
 				panic("should not happen")
 			}
+			k.markStreamRecordResumed(ctx, addr)
 			streamRecord.Status = types.STREAM_ACCOUNT_STATUS_ACTIVE
 			change := types.NewDefaultStreamRecordChangeWithAddr(addr)
 			err := k.UpdateStreamRecord(ctx, streamRecord, change)
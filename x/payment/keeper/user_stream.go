@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+// SetUserStream set a specific user stream in the store from its id, and index it by its sender
+func (k Keeper) SetUserStream(ctx sdk.Context, stream *types.UserStream) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserStreamKeyPrefix)
+	store.Set(types.UserStreamKey(stream.Id), k.cdc.MustMarshal(stream))
+
+	senderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserStreamBySenderKeyPrefix)
+	senderStore.Set(types.UserStreamBySenderKey(sdk.MustAccAddressFromHex(stream.Sender), stream.Id), []byte{})
+}
+
+// GetUserStream returns a user stream from its id
+func (k Keeper) GetUserStream(ctx sdk.Context, id uint64) (*types.UserStream, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserStreamKeyPrefix)
+	b := store.Get(types.UserStreamKey(id))
+	if b == nil {
+		return nil, false
+	}
+
+	stream := &types.UserStream{}
+	k.cdc.MustUnmarshal(b, stream)
+	return stream, true
+}
+
+// GetAllUserStream returns all user streams ordered by id
+func (k Keeper) GetAllUserStream(ctx sdk.Context) (list []types.UserStream) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserStreamKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.UserStream
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveUserStream removes a user stream and its sender index from the store
+func (k Keeper) RemoveUserStream(ctx sdk.Context, stream *types.UserStream) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserStreamKeyPrefix)
+	store.Delete(types.UserStreamKey(stream.Id))
+
+	senderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserStreamBySenderKeyPrefix)
+	senderStore.Delete(types.UserStreamBySenderKey(sdk.MustAccAddressFromHex(stream.Sender), stream.Id))
+}
+
+// nextUserStreamID returns the id for a new user stream, the ids start from 1
+func (k Keeper) nextUserStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(types.UserStreamSequenceKey); bz != nil {
+		id = binary.BigEndian.Uint64(bz) + 1
+	}
+	store.Set(types.UserStreamSequenceKey, sdk.Uint64ToBigEndian(id))
+	return id
+}
+
+// checkUserStreamOperator checks whether the operator can manage the streams paid by the sender,
+// which is the sender itself or the owner of the sender payment account
+func (k Keeper) checkUserStreamOperator(ctx sdk.Context, operator, sender sdk.AccAddress) error {
+	if !k.IsPaymentAccountOwner(ctx, sender, operator) {
+		return errorsmod.Wrapf(types.ErrNotPaymentAccountOwner, "%s can not manage the streams of %s", operator, sender)
+	}
+	return nil
+}
+
+// CreateStream opens a stream from the sender to the recipient at the given rate per second. The stream is
+// merged into the outflows of the sender, so it is settled, buffered and frozen together with the storage fees.
+// A frozen recipient keeps being credited by the stream, it is accrued when its stream record is updated.
+func (k Keeper) CreateStream(ctx sdk.Context, operator, sender, recipient sdk.AccAddress, rate sdkmath.Int) (uint64, error) {
+	if err := k.checkUserStreamOperator(ctx, operator, sender); err != nil {
+		return 0, err
+	}
+	if sender.Equals(recipient) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidUserStream, "the sender and the recipient are the same account")
+	}
+	if rate.IsNil() || !rate.IsPositive() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidUserStream, "the rate should be positive")
+	}
+	if _, isPaymentAccount := k.GetPaymentAccount(ctx, recipient); !isPaymentAccount && !k.accountKeeper.HasAccount(ctx, recipient) {
+		return 0, types.ErrReceiveAccountNotExist
+	}
+
+	err := k.ApplyUserFlowsList(ctx, []types.UserFlows{{
+		From:  sender,
+		Flows: []types.OutFlow{{ToAddress: recipient.String(), Rate: rate}},
+	}})
+	if err != nil {
+		return 0, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	stream := &types.UserStream{
+		Id:        k.nextUserStreamID(ctx),
+		Sender:    sender.String(),
+		Recipient: recipient.String(),
+		Rate:      rate,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	k.SetUserStream(ctx, stream)
+
+	return stream.Id, ctx.EventManager().EmitTypedEvents(&types.EventCreateStream{
+		StreamId:  stream.Id,
+		Sender:    stream.Sender,
+		Recipient: stream.Recipient,
+		Rate:      stream.Rate,
+	})
+}
+
+// UpdateStream changes the rate of the stream, the difference is applied to the outflow of the sender.
+func (k Keeper) UpdateStream(ctx sdk.Context, operator sdk.AccAddress, id uint64, rate sdkmath.Int) error {
+	stream, found := k.GetUserStream(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUserStreamNotFound, "stream id: %d", id)
+	}
+	sender := sdk.MustAccAddressFromHex(stream.Sender)
+	if err := k.checkUserStreamOperator(ctx, operator, sender); err != nil {
+		return err
+	}
+	if rate.IsNil() || !rate.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidUserStream, "the rate should be positive")
+	}
+
+	previousRate := stream.Rate
+	if delta := rate.Sub(previousRate); !delta.IsZero() {
+		err := k.ApplyUserFlowsList(ctx, []types.UserFlows{{
+			From:  sender,
+			Flows: []types.OutFlow{{ToAddress: stream.Recipient, Rate: delta}},
+		}})
+		if err != nil {
+			return err
+		}
+	}
+
+	stream.Rate = rate
+	stream.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetUserStream(ctx, stream)
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventUpdateStream{
+		StreamId:     stream.Id,
+		PreviousRate: previousRate,
+		Rate:         rate,
+	})
+}
+
+// CancelStream stops the stream and removes its rate from the outflow of the sender. A stream can be canceled
+// even if the sender is frozen, in which case the frozen outflow is reduced instead.
+func (k Keeper) CancelStream(ctx sdk.Context, operator sdk.AccAddress, id uint64) error {
+	stream, found := k.GetUserStream(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUserStreamNotFound, "stream id: %d", id)
+	}
+	sender := sdk.MustAccAddressFromHex(stream.Sender)
+	if err := k.checkUserStreamOperator(ctx, operator, sender); err != nil {
+		return err
+	}
+
+	streamRecord, found := k.GetStreamRecord(ctx, sender)
+	if found && streamRecord.Status == types.STREAM_ACCOUNT_STATUS_FROZEN {
+		ctx = ctx.WithValue(types.ForceUpdateStreamRecordKey, true)
+	}
+	err := k.ApplyUserFlowsList(ctx, []types.UserFlows{{
+		From:  sender,
+		Flows: []types.OutFlow{{ToAddress: stream.Recipient, Rate: stream.Rate.Neg()}},
+	}})
+	if err != nil {
+		return err
+	}
+	k.RemoveUserStream(ctx, stream)
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventCancelStream{
+		StreamId:  stream.Id,
+		Sender:    stream.Sender,
+		Recipient: stream.Recipient,
+	})
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	"github.com/mocachain/moca/v2/x/payment/types"
+)
+
+func TestUserStream(t *testing.T) {
+	keeper, ctx, deepKeepers := makePaymentKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+	deepKeepers.AccountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).
+		Return(true).AnyTimes()
+
+	sender := sample.RandAccAddress()
+	recipient := sample.RandAccAddress()
+	senderRecord := types.NewStreamRecord(sender, ctx.BlockTime().Unix())
+	senderRecord.StaticBalance = sdkmath.NewInt(1e18)
+	keeper.SetStreamRecord(ctx, senderRecord)
+
+	// only the sender or the owner of the sender payment account can open a stream
+	_, err := keeper.CreateStream(ctx, sample.RandAccAddress(), sender, recipient, sdkmath.NewInt(100))
+	require.ErrorIs(t, err, types.ErrNotPaymentAccountOwner)
+
+	_, err = keeper.CreateStream(ctx, sender, sender, sender, sdkmath.NewInt(100))
+	require.ErrorIs(t, err, types.ErrInvalidUserStream)
+
+	streamID, err := keeper.CreateStream(ctx, sender, sender, recipient, sdkmath.NewInt(100))
+	require.NoError(t, err)
+
+	senderRecord, _ = keeper.GetStreamRecord(ctx, sender)
+	require.Equal(t, sdkmath.NewInt(-100), senderRecord.NetflowRate)
+	require.True(t, senderRecord.BufferBalance.IsPositive())
+	recipientRecord, _ := keeper.GetStreamRecord(ctx, recipient)
+	require.Equal(t, sdkmath.NewInt(100), recipientRecord.NetflowRate)
+
+	// a second stream to the same recipient shares the outflow
+	secondID, err := keeper.CreateStream(ctx, sender, sender, recipient, sdkmath.NewInt(50))
+	require.NoError(t, err)
+	outFlow := keeper.GetOutFlow(ctx, sender, types.OUT_FLOW_STATUS_ACTIVE, recipient)
+	require.Equal(t, sdkmath.NewInt(150), outFlow.Rate)
+
+	err = keeper.UpdateStream(ctx, sender, streamID, sdkmath.NewInt(300))
+	require.NoError(t, err)
+	stream, found := keeper.GetUserStream(ctx, streamID)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(300), stream.Rate)
+	recipientRecord, _ = keeper.GetStreamRecord(ctx, recipient)
+	require.Equal(t, sdkmath.NewInt(350), recipientRecord.NetflowRate)
+
+	// the recipient accrues the stream as time goes by
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Second))
+	err = keeper.CancelStream(ctx, sender, streamID)
+	require.NoError(t, err)
+	_, found = keeper.GetUserStream(ctx, streamID)
+	require.False(t, found)
+
+	recipientRecord, _ = keeper.GetStreamRecord(ctx, recipient)
+	require.Equal(t, sdkmath.NewInt(50), recipientRecord.NetflowRate)
+	require.Equal(t, sdkmath.NewInt(3500), recipientRecord.StaticBalance)
+
+	res, err := keeper.StreamsBySender(ctx, &types.QueryStreamsBySenderRequest{Sender: sender.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Streams, 1)
+	require.Equal(t, secondID, res.Streams[0].Id)
+
+	err = keeper.CancelStream(ctx, sender, secondID)
+	require.NoError(t, err)
+	senderRecord, _ = keeper.GetStreamRecord(ctx, sender)
+	require.True(t, senderRecord.NetflowRate.IsZero())
+	require.True(t, senderRecord.BufferBalance.IsZero())
+	require.Nil(t, keeper.GetOutFlow(ctx, sender, types.OUT_FLOW_STATUS_ACTIVE, recipient))
+
+	err = keeper.CancelStream(ctx, sender, secondID)
+	require.ErrorIs(t, err, types.ErrUserStreamNotFound)
+}
+
+func TestUserStream_FrozenRecipient(t *testing.T) {
+	keeper, ctx, deepKeepers := makePaymentKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+	deepKeepers.AccountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).
+		Return(true).AnyTimes()
+
+	sender := sample.RandAccAddress()
+	recipient := sample.RandAccAddress()
+	senderRecord := types.NewStreamRecord(sender, ctx.BlockTime().Unix())
+	senderRecord.StaticBalance = sdkmath.NewInt(1e18)
+	keeper.SetStreamRecord(ctx, senderRecord)
+	recipientRecord := types.NewStreamRecord(recipient, ctx.BlockTime().Unix())
+	recipientRecord.Status = types.STREAM_ACCOUNT_STATUS_FROZEN
+	keeper.SetStreamRecord(ctx, recipientRecord)
+
+	// a frozen recipient neither blocks the stream nor misses its income
+	streamID, err := keeper.CreateStream(ctx, sender, sender, recipient, sdkmath.NewInt(100))
+	require.NoError(t, err)
+	recipientRecord, _ = keeper.GetStreamRecord(ctx, recipient)
+	require.Equal(t, sdkmath.NewInt(100), recipientRecord.NetflowRate)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Second))
+	err = keeper.UpdateStream(ctx, sender, streamID, sdkmath.NewInt(200))
+	require.NoError(t, err)
+	recipientRecord, _ = keeper.GetStreamRecord(ctx, recipient)
+	require.Equal(t, sdkmath.NewInt(200), recipientRecord.NetflowRate)
+	require.Equal(t, sdkmath.NewInt(1000), recipientRecord.StaticBalance)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Second))
+	err = keeper.CancelStream(ctx, sender, streamID)
+	require.NoError(t, err)
+	recipientRecord, _ = keeper.GetStreamRecord(ctx, recipient)
+	require.Equal(t, types.STREAM_ACCOUNT_STATUS_FROZEN, recipientRecord.Status)
+	require.True(t, recipientRecord.NetflowRate.IsZero())
+	require.Equal(t, sdkmath.NewInt(3000), recipientRecord.StaticBalance)
+}
+
+func TestUserStream_AutoSettleRecipient(t *testing.T) {
+	keeper, ctx, deepKeepers := makePaymentKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+	deepKeepers.AccountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).
+		Return(true).AnyTimes()
+	deepKeepers.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("fail to transfer")).AnyTimes()
+
+	sender := sample.RandAccAddress()
+	recipient := sample.RandAccAddress()
+	senderRecord := types.NewStreamRecord(sender, ctx.BlockTime().Unix())
+	senderRecord.StaticBalance = sdkmath.NewInt(1e18)
+	keeper.SetStreamRecord(ctx, senderRecord)
+	streamID, err := keeper.CreateStream(ctx, sender, sender, recipient, sdkmath.NewInt(300))
+	require.NoError(t, err)
+
+	// the recipient pays more than the stream brings in and runs out of balance
+	gvg := sample.RandAccAddress()
+	recipientRecord, _ := keeper.GetStreamRecord(ctx, recipient)
+	recipientRecord.NetflowRate = sdkmath.NewInt(-200)
+	recipientRecord.OutFlowCount = 1
+	keeper.SetStreamRecord(ctx, recipientRecord)
+	keeper.SetOutFlow(ctx, recipient, &types.OutFlow{
+		ToAddress: gvg.String(),
+		Rate:      sdkmath.NewInt(500),
+		Status:    types.OUT_FLOW_STATUS_ACTIVE,
+	})
+	keeper.SetAutoSettleRecord(ctx, &types.AutoSettleRecord{
+		Timestamp: ctx.BlockTime().Unix(),
+		Addr:      recipient.String(),
+	})
+
+	// the stream is left as the only flow of the settled recipient
+	keeper.AutoSettle(ctx.WithValue(types.ForceUpdateStreamRecordKey, true))
+	recipientRecord, _ = keeper.GetStreamRecord(ctx, recipient)
+	require.Equal(t, types.STREAM_ACCOUNT_STATUS_FROZEN, recipientRecord.Status)
+	require.Equal(t, sdkmath.NewInt(300), recipientRecord.NetflowRate)
+	require.Equal(t, sdkmath.NewInt(-500), recipientRecord.FrozenNetflowRate)
+	for _, record := range keeper.GetAllAutoSettleRecord(ctx) {
+		require.NotEqual(t, recipient.String(), record.Addr)
+	}
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Second))
+	err = keeper.UpdateStream(ctx, sender, streamID, sdkmath.NewInt(400))
+	require.NoError(t, err)
+	recipientRecord, _ = keeper.GetStreamRecord(ctx, recipient)
+	require.Equal(t, sdkmath.NewInt(400), recipientRecord.NetflowRate)
+	require.Equal(t, sdkmath.NewInt(3000), recipientRecord.StaticBalance)
+}
@@ -126,7 +126,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
@@ -158,7 +160,9 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	// set ForceUpdateStreamRecordKey to true in context to force update frozen stream record
 	c := sdk.UnwrapSDKContext(ctx).WithValue(types.ForceUpdateStreamRecordKey, true)
 	am.keeper.AutoResume(c)
+	am.keeper.AutoDeposit(c)
 	am.keeper.AutoSettle(c)
+	am.keeper.CheckRunwayAlerts(c)
 	return nil
 }
 
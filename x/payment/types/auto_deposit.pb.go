@@ -0,0 +1,942 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: moca/payment/auto_deposit.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AutoDepositAllowance authorizes the payment module to pull funds from a bank account into a stream account
+// when the runway of the stream account falls below the threshold, so that it is not frozen for lack of balance.
+type AutoDepositAllowance struct {
+	// addr is the address of the stream account to be topped up
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// from is the address of the bank account the funds are pulled from
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// max_amount_per_period is the maximum amount pulled within a period
+	MaxAmountPerPeriod cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_amount_per_period,json=maxAmountPerPeriod,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_per_period"`
+	// period_seconds is the length of a period
+	PeriodSeconds uint64 `protobuf:"varint,4,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	// threshold_seconds is the runway below which the funds are pulled, each pull covers the threshold at the
+	// current netflow rate
+	ThresholdSeconds uint64 `protobuf:"varint,5,opt,name=threshold_seconds,json=thresholdSeconds,proto3" json:"threshold_seconds,omitempty"`
+	// period_start is the unix timestamp when the current period started
+	PeriodStart int64 `protobuf:"varint,6,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// pulled_in_period is the amount pulled in the current period
+	PulledInPeriod cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=pulled_in_period,json=pulledInPeriod,proto3,customtype=cosmossdk.io/math.Int" json:"pulled_in_period"`
+	// next_check_time is the unix timestamp when the runway will be checked by the EndBlocker, zero if not queued
+	NextCheckTime int64 `protobuf:"varint,8,opt,name=next_check_time,json=nextCheckTime,proto3" json:"next_check_time,omitempty"`
+}
+
+func (m *AutoDepositAllowance) Reset()         { *m = AutoDepositAllowance{} }
+func (m *AutoDepositAllowance) String() string { return proto.CompactTextString(m) }
+func (*AutoDepositAllowance) ProtoMessage()    {}
+func (*AutoDepositAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be7e69b482dbe92, []int{0}
+}
+func (m *AutoDepositAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoDepositAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoDepositAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoDepositAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoDepositAllowance.Merge(m, src)
+}
+func (m *AutoDepositAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoDepositAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoDepositAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoDepositAllowance proto.InternalMessageInfo
+
+func (m *AutoDepositAllowance) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *AutoDepositAllowance) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *AutoDepositAllowance) GetPeriodSeconds() uint64 {
+	if m != nil {
+		return m.PeriodSeconds
+	}
+	return 0
+}
+
+func (m *AutoDepositAllowance) GetThresholdSeconds() uint64 {
+	if m != nil {
+		return m.ThresholdSeconds
+	}
+	return 0
+}
+
+func (m *AutoDepositAllowance) GetPeriodStart() int64 {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+func (m *AutoDepositAllowance) GetNextCheckTime() int64 {
+	if m != nil {
+		return m.NextCheckTime
+	}
+	return 0
+}
+
+// AutoDepositRecord records a pull of an auto deposit allowance.
+type AutoDepositRecord struct {
+	// addr is the address of the topped up stream account
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// from is the address of the bank account the funds were pulled from
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// amount is the amount pulled
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// timestamp is the unix timestamp of the pull
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *AutoDepositRecord) Reset()         { *m = AutoDepositRecord{} }
+func (m *AutoDepositRecord) String() string { return proto.CompactTextString(m) }
+func (*AutoDepositRecord) ProtoMessage()    {}
+func (*AutoDepositRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8be7e69b482dbe92, []int{1}
+}
+func (m *AutoDepositRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoDepositRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoDepositRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoDepositRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoDepositRecord.Merge(m, src)
+}
+func (m *AutoDepositRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoDepositRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoDepositRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoDepositRecord proto.InternalMessageInfo
+
+func (m *AutoDepositRecord) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *AutoDepositRecord) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *AutoDepositRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AutoDepositAllowance)(nil), "moca.payment.AutoDepositAllowance")
+	proto.RegisterType((*AutoDepositRecord)(nil), "moca.payment.AutoDepositRecord")
+}
+
+func init() { proto.RegisterFile("moca/payment/auto_deposit.proto", fileDescriptor_8be7e69b482dbe92) }
+
+var fileDescriptor_8be7e69b482dbe92 = []byte{
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x5a, 0x0a, 0x33, 0xdb, 0x58, 0xa3, 0x4e, 0x0a, 0x13, 0x4a, 0xcb, 0x24, 0x50,
+	0x05, 0x5b, 0x82, 0xe0, 0x09, 0x3a, 0x10, 0xa2, 0xb7, 0x29, 0xe3, 0xb4, 0x4b, 0xe4, 0xd9, 0xa6,
+	0xb1, 0x16, 0xfb, 0x8b, 0x6c, 0x07, 0xba, 0xb7, 0xe0, 0x31, 0x38, 0x72, 0xd8, 0x43, 0xec, 0x38,
+	0xf5, 0x02, 0xe2, 0x30, 0xa1, 0xf6, 0xc0, 0x6b, 0x20, 0xdb, 0xe9, 0xc6, 0x0d, 0x69, 0x12, 0x87,
+	0x44, 0xf6, 0xdf, 0x3f, 0xff, 0x92, 0x4f, 0x9f, 0x8d, 0x06, 0x02, 0x08, 0x4e, 0x2b, 0x7c, 0x26,
+	0x98, 0x34, 0x29, 0xae, 0x0d, 0xe4, 0x94, 0x55, 0xa0, 0xb9, 0x49, 0x2a, 0x05, 0x06, 0xc2, 0x75,
+	0x0b, 0x24, 0x0d, 0xb0, 0xd3, 0xc3, 0x82, 0x4b, 0x48, 0xdd, 0xdb, 0x03, 0x3b, 0x8f, 0x08, 0x68,
+	0x01, 0x3a, 0x77, 0xb3, 0xd4, 0x4f, 0x9a, 0xa5, 0xfe, 0x14, 0xa6, 0xe0, 0x73, 0x3b, 0xf2, 0xe9,
+	0xee, 0xbc, 0x8d, 0xfa, 0xe3, 0xda, 0xc0, 0x5b, 0xff, 0x9d, 0x71, 0x59, 0xc2, 0x67, 0x2c, 0x09,
+	0x0b, 0xf7, 0x50, 0x07, 0x53, 0xaa, 0xa2, 0x60, 0x18, 0x8c, 0xd6, 0x0e, 0xa2, 0xf9, 0xf9, 0x7e,
+	0xbf, 0xd1, 0x8d, 0x29, 0x55, 0x4c, 0xeb, 0x23, 0xa3, 0xb8, 0x9c, 0x66, 0x8e, 0xb2, 0xf4, 0x47,
+	0x05, 0x22, 0xba, 0xf3, 0x2f, 0xda, 0x52, 0x21, 0x41, 0xdb, 0x02, 0xcf, 0x72, 0x2c, 0xa0, 0x96,
+	0x26, 0xaf, 0x98, 0xb2, 0x0f, 0x07, 0x1a, 0xb5, 0xdd, 0xf6, 0x97, 0x17, 0x57, 0x83, 0xd6, 0xcf,
+	0xab, 0xc1, 0xb6, 0x57, 0x68, 0x7a, 0x9a, 0x70, 0x48, 0x05, 0x36, 0x45, 0x32, 0x91, 0x66, 0x7e,
+	0xbe, 0x8f, 0x1a, 0xf7, 0x44, 0x9a, 0xaf, 0xbf, 0xbf, 0x3d, 0x0f, 0xb2, 0x50, 0xe0, 0xd9, 0xd8,
+	0xd9, 0x0e, 0x99, 0x3a, 0x74, 0xae, 0xf0, 0x29, 0xda, 0xf4, 0xd6, 0x5c, 0x33, 0x02, 0x92, 0xea,
+	0xa8, 0x33, 0x0c, 0x46, 0x9d, 0x6c, 0xc3, 0xa7, 0x47, 0x3e, 0x0c, 0x5f, 0xa0, 0x9e, 0x29, 0x14,
+	0xd3, 0x05, 0x94, 0x37, 0xe4, 0x5d, 0x47, 0x6e, 0x5d, 0x2f, 0xac, 0xe0, 0x27, 0x68, 0x7d, 0xe5,
+	0x34, 0x58, 0x99, 0xa8, 0x3b, 0x0c, 0x46, 0xed, 0xec, 0x41, 0x63, 0xb4, 0x51, 0x78, 0x8c, 0xb6,
+	0xaa, 0xba, 0x2c, 0x19, 0xcd, 0xb9, 0x5c, 0x95, 0x75, 0xef, 0x96, 0x65, 0x6d, 0x7a, 0xd3, 0x44,
+	0x36, 0x25, 0x3d, 0x43, 0x0f, 0x25, 0x9b, 0x99, 0x9c, 0x14, 0x8c, 0x9c, 0xe6, 0x86, 0x0b, 0x16,
+	0xdd, 0x77, 0x7f, 0xb0, 0x61, 0xe3, 0x37, 0x36, 0xfd, 0xc0, 0x05, 0xdb, 0xfd, 0x1e, 0xa0, 0xde,
+	0x5f, 0x4d, 0xcd, 0x18, 0x01, 0x45, 0xff, 0x6b, 0x47, 0xdf, 0xa3, 0xae, 0xef, 0xe6, 0xad, 0x5b,
+	0xd8, 0xec, 0x0f, 0x1f, 0xa3, 0x35, 0x5b, 0x98, 0x36, 0x58, 0x54, 0xae, 0x63, 0xed, 0xec, 0x26,
+	0x38, 0x78, 0x77, 0xb1, 0x88, 0x83, 0xcb, 0x45, 0x1c, 0xfc, 0x5a, 0xc4, 0xc1, 0x97, 0x65, 0xdc,
+	0xba, 0x5c, 0xc6, 0xad, 0x1f, 0xcb, 0xb8, 0x75, 0xbc, 0x37, 0xe5, 0xa6, 0xa8, 0x4f, 0x12, 0x02,
+	0x22, 0xb5, 0xb7, 0x84, 0x14, 0x98, 0x4b, 0x37, 0x4a, 0x3f, 0xbd, 0x4a, 0x67, 0xd7, 0xb7, 0xca,
+	0x9c, 0x55, 0x4c, 0x9f, 0x74, 0xdd, 0xe9, 0x7f, 0xfd, 0x67, 0x00, 0x9c, 0x1b, 0x89, 0x20, 0x72,
+	0x03, 0x00, 0x00,
+}
+
+func (m *AutoDepositAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoDepositAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoDepositAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextCheckTime != 0 {
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(m.NextCheckTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.PulledInPeriod.Size()
+		i -= size
+		if _, err := m.PulledInPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.PeriodStart != 0 {
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(m.PeriodStart))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ThresholdSeconds != 0 {
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(m.ThresholdSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PeriodSeconds != 0 {
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(m.PeriodSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxAmountPerPeriod.Size()
+		i -= size
+		if _, err := m.MaxAmountPerPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoDepositRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoDepositRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoDepositRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintAutoDeposit(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAutoDeposit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAutoDeposit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AutoDepositAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovAutoDeposit(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovAutoDeposit(uint64(l))
+	}
+	l = m.MaxAmountPerPeriod.Size()
+	n += 1 + l + sovAutoDeposit(uint64(l))
+	if m.PeriodSeconds != 0 {
+		n += 1 + sovAutoDeposit(uint64(m.PeriodSeconds))
+	}
+	if m.ThresholdSeconds != 0 {
+		n += 1 + sovAutoDeposit(uint64(m.ThresholdSeconds))
+	}
+	if m.PeriodStart != 0 {
+		n += 1 + sovAutoDeposit(uint64(m.PeriodStart))
+	}
+	l = m.PulledInPeriod.Size()
+	n += 1 + l + sovAutoDeposit(uint64(l))
+	if m.NextCheckTime != 0 {
+		n += 1 + sovAutoDeposit(uint64(m.NextCheckTime))
+	}
+	return n
+}
+
+func (m *AutoDepositRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovAutoDeposit(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovAutoDeposit(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAutoDeposit(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovAutoDeposit(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func sovAutoDeposit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAutoDeposit(x uint64) (n int) {
+	return sovAutoDeposit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AutoDepositAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutoDeposit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoDepositAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoDepositAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountPerPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountPerPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+			}
+			m.PeriodSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdSeconds", wireType)
+			}
+			m.ThresholdSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			m.PeriodStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulledInPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PulledInPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCheckTime", wireType)
+			}
+			m.NextCheckTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCheckTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutoDeposit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoDepositRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutoDeposit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoDepositRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoDepositRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutoDeposit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutoDeposit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAutoDeposit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAutoDeposit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutoDeposit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAutoDeposit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAutoDeposit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAutoDeposit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAutoDeposit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAutoDeposit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAutoDeposit = fmt.Errorf("proto: unexpected end of group")
+)
@@ -12,6 +12,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "payment/Deposit", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "payment/Withdraw", nil)
 	cdc.RegisterConcrete(&MsgDisableRefund{}, "payment/DisableRefund", nil)
+	cdc.RegisterConcrete(&MsgCreateStream{}, "payment/CreateStream", nil)
+	cdc.RegisterConcrete(&MsgUpdateStream{}, "payment/UpdateStream", nil)
+	cdc.RegisterConcrete(&MsgCancelStream{}, "payment/CancelStream", nil)
+	cdc.RegisterConcrete(&MsgSetRunwayAlert{}, "payment/SetRunwayAlert", nil)
+	cdc.RegisterConcrete(&MsgSetAutoDepositAllowance{}, "payment/SetAutoDepositAllowance", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -30,6 +35,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateStream{},
+		&MsgUpdateStream{},
+		&MsgCancelStream{},
+		&MsgSetRunwayAlert{},
+		&MsgSetAutoDepositAllowance{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
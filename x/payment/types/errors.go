@@ -21,4 +21,7 @@ var (
 	ErrNotReachTimeLockDuration           = errorsmod.Register(ModuleName, 1212, "the withdrawal does not reach to the delayed duration")
 	ErrExistsDelayedWithdrawal            = errorsmod.Register(ModuleName, 1213, "delayed withdrawal already exists")
 	ErrSettleTimestampOverflow            = errorsmod.Register(ModuleName, 1214, "settle timestamp overflow: deposit would fund the account beyond the representable future")
+	ErrUserStreamNotFound                 = errorsmod.Register(ModuleName, 1215, "user stream not found")
+	ErrInvalidUserStream                  = errorsmod.Register(ModuleName, 1216, "invalid user stream")
+	ErrInvalidAutoDepositAllowance        = errorsmod.Register(ModuleName, 1217, "invalid auto deposit allowance")
 )
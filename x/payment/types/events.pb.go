@@ -362,6 +362,441 @@ func (m *EventWithdraw) GetFrom() string {
 	return ""
 }
 
+type EventCreateStream struct {
+	// stream_id is the id of the created stream
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// sender is the address of the stream account which pays the stream
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the account which receives the stream
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// rate is the amount streamed to the recipient per second
+	Rate cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=rate,proto3,customtype=cosmossdk.io/math.Int" json:"rate"`
+}
+
+func (m *EventCreateStream) Reset()         { *m = EventCreateStream{} }
+func (m *EventCreateStream) String() string { return proto.CompactTextString(m) }
+func (*EventCreateStream) ProtoMessage()    {}
+func (*EventCreateStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355e2d381620e82e, []int{5}
+}
+func (m *EventCreateStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateStream.Merge(m, src)
+}
+func (m *EventCreateStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateStream proto.InternalMessageInfo
+
+func (m *EventCreateStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *EventCreateStream) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventCreateStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type EventUpdateStream struct {
+	// stream_id is the id of the updated stream
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// previous_rate is the rate of the stream before the update
+	PreviousRate cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=previous_rate,json=previousRate,proto3,customtype=cosmossdk.io/math.Int" json:"previous_rate"`
+	// rate is the rate of the stream after the update
+	Rate cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.Int" json:"rate"`
+}
+
+func (m *EventUpdateStream) Reset()         { *m = EventUpdateStream{} }
+func (m *EventUpdateStream) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateStream) ProtoMessage()    {}
+func (*EventUpdateStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355e2d381620e82e, []int{6}
+}
+func (m *EventUpdateStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateStream.Merge(m, src)
+}
+func (m *EventUpdateStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateStream proto.InternalMessageInfo
+
+func (m *EventUpdateStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+type EventCancelStream struct {
+	// stream_id is the id of the canceled stream
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// sender is the address of the stream account which paid the stream
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the account which received the stream
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *EventCancelStream) Reset()         { *m = EventCancelStream{} }
+func (m *EventCancelStream) String() string { return proto.CompactTextString(m) }
+func (*EventCancelStream) ProtoMessage()    {}
+func (*EventCancelStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355e2d381620e82e, []int{7}
+}
+func (m *EventCancelStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelStream.Merge(m, src)
+}
+func (m *EventCancelStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelStream proto.InternalMessageInfo
+
+func (m *EventCancelStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *EventCancelStream) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventCancelStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type EventSetRunwayAlert struct {
+	// addr is the address of the stream account
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// threshold_seconds is the runway below which the account is alerted, zero if the alert is disabled
+	ThresholdSeconds uint64 `protobuf:"varint,2,opt,name=threshold_seconds,json=thresholdSeconds,proto3" json:"threshold_seconds,omitempty"`
+}
+
+func (m *EventSetRunwayAlert) Reset()         { *m = EventSetRunwayAlert{} }
+func (m *EventSetRunwayAlert) String() string { return proto.CompactTextString(m) }
+func (*EventSetRunwayAlert) ProtoMessage()    {}
+func (*EventSetRunwayAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355e2d381620e82e, []int{8}
+}
+func (m *EventSetRunwayAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetRunwayAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetRunwayAlert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetRunwayAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetRunwayAlert.Merge(m, src)
+}
+func (m *EventSetRunwayAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetRunwayAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetRunwayAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetRunwayAlert proto.InternalMessageInfo
+
+func (m *EventSetRunwayAlert) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EventSetRunwayAlert) GetThresholdSeconds() uint64 {
+	if m != nil {
+		return m.ThresholdSeconds
+	}
+	return 0
+}
+
+// EventLowRunway is emitted when the runway of a stream account falls below the threshold it set.
+type EventLowRunway struct {
+	// addr is the address of the stream account
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// runway_seconds is the time left before the account is frozen for lack of balance
+	RunwaySeconds int64 `protobuf:"varint,2,opt,name=runway_seconds,json=runwaySeconds,proto3" json:"runway_seconds,omitempty"`
+	// depletion_timestamp is the unix timestamp when the account is projected to be frozen
+	DepletionTimestamp int64 `protobuf:"varint,3,opt,name=depletion_timestamp,json=depletionTimestamp,proto3" json:"depletion_timestamp,omitempty"`
+	// threshold_seconds is the threshold set by the account
+	ThresholdSeconds uint64 `protobuf:"varint,4,opt,name=threshold_seconds,json=thresholdSeconds,proto3" json:"threshold_seconds,omitempty"`
+}
+
+func (m *EventLowRunway) Reset()         { *m = EventLowRunway{} }
+func (m *EventLowRunway) String() string { return proto.CompactTextString(m) }
+func (*EventLowRunway) ProtoMessage()    {}
+func (*EventLowRunway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355e2d381620e82e, []int{9}
+}
+func (m *EventLowRunway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLowRunway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLowRunway.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLowRunway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLowRunway.Merge(m, src)
+}
+func (m *EventLowRunway) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLowRunway) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLowRunway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLowRunway proto.InternalMessageInfo
+
+func (m *EventLowRunway) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EventLowRunway) GetRunwaySeconds() int64 {
+	if m != nil {
+		return m.RunwaySeconds
+	}
+	return 0
+}
+
+func (m *EventLowRunway) GetDepletionTimestamp() int64 {
+	if m != nil {
+		return m.DepletionTimestamp
+	}
+	return 0
+}
+
+func (m *EventLowRunway) GetThresholdSeconds() uint64 {
+	if m != nil {
+		return m.ThresholdSeconds
+	}
+	return 0
+}
+
+type EventSetAutoDepositAllowance struct {
+	// addr is the address of the stream account
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// from is the address of the bank account the funds are pulled from
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// max_amount_per_period is the maximum amount pulled within a period, zero if the allowance is revoked
+	MaxAmountPerPeriod cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_amount_per_period,json=maxAmountPerPeriod,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_per_period"`
+	// period_seconds is the length of a period
+	PeriodSeconds uint64 `protobuf:"varint,4,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	// threshold_seconds is the runway below which the funds are pulled
+	ThresholdSeconds uint64 `protobuf:"varint,5,opt,name=threshold_seconds,json=thresholdSeconds,proto3" json:"threshold_seconds,omitempty"`
+}
+
+func (m *EventSetAutoDepositAllowance) Reset()         { *m = EventSetAutoDepositAllowance{} }
+func (m *EventSetAutoDepositAllowance) String() string { return proto.CompactTextString(m) }
+func (*EventSetAutoDepositAllowance) ProtoMessage()    {}
+func (*EventSetAutoDepositAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355e2d381620e82e, []int{10}
+}
+func (m *EventSetAutoDepositAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetAutoDepositAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetAutoDepositAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetAutoDepositAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetAutoDepositAllowance.Merge(m, src)
+}
+func (m *EventSetAutoDepositAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetAutoDepositAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetAutoDepositAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetAutoDepositAllowance proto.InternalMessageInfo
+
+func (m *EventSetAutoDepositAllowance) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EventSetAutoDepositAllowance) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EventSetAutoDepositAllowance) GetPeriodSeconds() uint64 {
+	if m != nil {
+		return m.PeriodSeconds
+	}
+	return 0
+}
+
+func (m *EventSetAutoDepositAllowance) GetThresholdSeconds() uint64 {
+	if m != nil {
+		return m.ThresholdSeconds
+	}
+	return 0
+}
+
+// EventAutoDeposit is emitted when the funds are pulled from the auto deposit source of a stream account.
+type EventAutoDeposit struct {
+	// addr is the address of the topped up stream account
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// from is the address of the bank account the funds were pulled from
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// amount is the amount pulled
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *EventAutoDeposit) Reset()         { *m = EventAutoDeposit{} }
+func (m *EventAutoDeposit) String() string { return proto.CompactTextString(m) }
+func (*EventAutoDeposit) ProtoMessage()    {}
+func (*EventAutoDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_355e2d381620e82e, []int{11}
+}
+func (m *EventAutoDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutoDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutoDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAutoDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoDeposit.Merge(m, src)
+}
+func (m *EventAutoDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutoDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutoDeposit proto.InternalMessageInfo
+
+func (m *EventAutoDeposit) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EventAutoDeposit) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
 // emit when upload/cancel/delete object, used for frontend to preview the fee changed
 // only emit in tx simulation
 type EventFeePreview struct {
@@ -374,7 +809,7 @@ func (m *EventFeePreview) Reset()         { *m = EventFeePreview{} }
 func (m *EventFeePreview) String() string { return proto.CompactTextString(m) }
 func (*EventFeePreview) ProtoMessage()    {}
 func (*EventFeePreview) Descriptor() ([]byte, []int) {
-	return fileDescriptor_355e2d381620e82e, []int{5}
+	return fileDescriptor_355e2d381620e82e, []int{12}
 }
 func (m *EventFeePreview) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,59 +859,84 @@ func init() {
 	proto.RegisterType((*EventForceSettle)(nil), "moca.payment.EventForceSettle")
 	proto.RegisterType((*EventDeposit)(nil), "moca.payment.EventDeposit")
 	proto.RegisterType((*EventWithdraw)(nil), "moca.payment.EventWithdraw")
+	proto.RegisterType((*EventCreateStream)(nil), "moca.payment.EventCreateStream")
+	proto.RegisterType((*EventUpdateStream)(nil), "moca.payment.EventUpdateStream")
+	proto.RegisterType((*EventCancelStream)(nil), "moca.payment.EventCancelStream")
+	proto.RegisterType((*EventSetRunwayAlert)(nil), "moca.payment.EventSetRunwayAlert")
+	proto.RegisterType((*EventLowRunway)(nil), "moca.payment.EventLowRunway")
+	proto.RegisterType((*EventSetAutoDepositAllowance)(nil), "moca.payment.EventSetAutoDepositAllowance")
+	proto.RegisterType((*EventAutoDeposit)(nil), "moca.payment.EventAutoDeposit")
 	proto.RegisterType((*EventFeePreview)(nil), "moca.payment.EventFeePreview")
 }
 
 func init() { proto.RegisterFile("moca/payment/events.proto", fileDescriptor_355e2d381620e82e) }
 
 var fileDescriptor_355e2d381620e82e = []byte{
-	// 730 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0x3b, 0x05, 0x0a, 0x8c, 0xa5, 0x94, 0x15, 0x63, 0x41, 0x5d, 0x4a, 0x13, 0x93, 0x4a,
-	0x60, 0xd7, 0xd4, 0x93, 0x47, 0x2a, 0xdb, 0x48, 0x34, 0xd8, 0x6c, 0x41, 0x82, 0x89, 0x59, 0xa7,
-	0xbb, 0xb3, 0xed, 0x86, 0xee, 0xce, 0x66, 0x76, 0x4a, 0xc5, 0x4f, 0xe0, 0xd1, 0xa3, 0xc6, 0xa3,
-	0x17, 0x8f, 0x98, 0xf0, 0x21, 0x38, 0x12, 0x2e, 0x1a, 0x0f, 0xc4, 0xc0, 0xc1, 0xaf, 0x61, 0x76,
-	0x66, 0x5a, 0xdb, 0x78, 0x28, 0x36, 0x5c, 0x9a, 0x9d, 0xf7, 0xfe, 0xfb, 0x7f, 0xbf, 0x79, 0x79,
-	0xaf, 0x0b, 0x17, 0x7c, 0x62, 0x23, 0x3d, 0x44, 0x87, 0x3e, 0x0e, 0x98, 0x8e, 0x0f, 0x70, 0xc0,
-	0x22, 0x2d, 0xa4, 0x84, 0x11, 0x25, 0x1d, 0xa7, 0x34, 0x99, 0x5a, 0x9c, 0x43, 0xbe, 0x17, 0x10,
-	0x9d, 0xff, 0x0a, 0xc1, 0xe2, 0x82, 0x4d, 0x22, 0x9f, 0x44, 0x16, 0x3f, 0xe9, 0xe2, 0x20, 0x53,
-	0xf3, 0x0d, 0xd2, 0x20, 0x22, 0x1e, 0x3f, 0xc9, 0xe8, 0x9d, 0x81, 0x62, 0xa4, 0xcd, 0x2c, 0xb7,
-	0x45, 0x3a, 0x32, 0x99, 0x1f, 0x48, 0x46, 0x8c, 0x62, 0xe4, 0x5b, 0x14, 0xdb, 0x84, 0x3a, 0x42,
-	0x51, 0xf8, 0x04, 0xe0, 0x82, 0x11, 0x13, 0x56, 0x85, 0x68, 0xdd, 0xb6, 0x49, 0x3b, 0x60, 0x3b,
-	0xa1, 0x83, 0x18, 0x56, 0x56, 0xe1, 0x38, 0x72, 0x1c, 0x9a, 0x03, 0x79, 0x50, 0x9c, 0x2e, 0xe7,
-	0xce, 0x8e, 0xd7, 0xe6, 0x25, 0xd2, 0xba, 0xe3, 0x50, 0x1c, 0x45, 0x35, 0x46, 0xbd, 0xa0, 0x61,
-	0x72, 0x95, 0xa2, 0xc1, 0x09, 0xd2, 0x09, 0x30, 0xcd, 0x25, 0x87, 0xc8, 0x85, 0x4c, 0x51, 0x21,
-	0xa4, 0xd8, 0x6d, 0x07, 0x0e, 0xaa, 0xb7, 0x70, 0x6e, 0x2c, 0x0f, 0x8a, 0x53, 0x66, 0x5f, 0xa4,
-	0xf0, 0x71, 0x02, 0xde, 0xe6, 0x6c, 0x35, 0x0e, 0x6e, 0x72, 0x6e, 0x49, 0x56, 0x82, 0x93, 0x48,
-	0xa0, 0x0e, 0x85, 0xeb, 0x0a, 0x95, 0xfb, 0x30, 0x63, 0xd3, 0xb6, 0x63, 0x31, 0xcf, 0xc7, 0x11,
-	0x43, 0x7e, 0xc8, 0x41, 0xc7, 0xcc, 0x99, 0x38, 0xba, 0xdd, 0x0d, 0x2a, 0x35, 0x98, 0x0e, 0x30,
-	0x8b, 0xbb, 0x68, 0x51, 0xc4, 0x04, 0xd8, 0x74, 0xf9, 0xe1, 0xc9, 0xf9, 0x52, 0xe2, 0xe7, 0xf9,
-	0xd2, 0x2d, 0x51, 0x23, 0x72, 0xf6, 0x35, 0x8f, 0xe8, 0x3e, 0x62, 0x4d, 0x6d, 0x33, 0x60, 0x67,
-	0xc7, 0x6b, 0x50, 0x16, 0xdf, 0x0c, 0xd8, 0xd7, 0xdf, 0x47, 0x2b, 0xc0, 0xbc, 0x21, 0x5d, 0xcc,
-	0x98, 0xf7, 0x0d, 0xbc, 0xe9, 0x52, 0xf2, 0x0e, 0x07, 0xd6, 0x80, 0xf7, 0xf8, 0x88, 0xde, 0x73,
-	0xc2, 0x6c, 0xab, 0xaf, 0xc2, 0x2e, 0xcc, 0x44, 0x0c, 0x31, 0xcf, 0xb6, 0xea, 0xa8, 0x85, 0x02,
-	0x1b, 0xe7, 0x26, 0x46, 0x34, 0x9f, 0x11, 0x3e, 0x65, 0x61, 0x13, 0x1b, 0xd7, 0xdb, 0xae, 0x8b,
-	0x69, 0xcf, 0x38, 0x35, 0xaa, 0xb1, 0xf0, 0xe9, 0x1a, 0xd7, 0x60, 0xba, 0x45, 0xec, 0xfd, 0x9e,
-	0xed, 0xe4, 0xa8, 0x8d, 0x8e, 0x5d, 0xba, 0xa6, 0x8f, 0x61, 0x2a, 0xc6, 0x6f, 0x47, 0xb9, 0xa9,
-	0x3c, 0x28, 0x66, 0x4a, 0xcb, 0x5a, 0xff, 0xca, 0x69, 0x62, 0x94, 0xe4, 0x94, 0xd7, 0xb8, 0xd0,
-	0x94, 0x2f, 0x28, 0x0f, 0x60, 0x36, 0xc2, 0x8c, 0xb5, 0x70, 0xdf, 0x84, 0x4c, 0xf3, 0x09, 0x99,
-	0x15, 0xf1, 0xde, 0x8c, 0x14, 0x3e, 0x03, 0x98, 0xe5, 0xa3, 0x59, 0x21, 0xd4, 0xc6, 0x35, 0x9e,
-	0xfd, 0xcf, 0x6d, 0xd9, 0x83, 0xd2, 0xd5, 0xe9, 0x35, 0x20, 0x39, 0x62, 0x03, 0x32, 0xd2, 0x48,
-	0xf6, 0xa0, 0x70, 0x04, 0x60, 0x9a, 0xd3, 0x6d, 0xe0, 0x90, 0x44, 0x1e, 0x8b, 0xc9, 0x5c, 0x4a,
-	0xfc, 0xe1, 0x64, 0xb1, 0x4a, 0x29, 0xc2, 0x24, 0x23, 0x43, 0x97, 0x38, 0xc9, 0x88, 0xf2, 0x14,
-	0xa6, 0x90, 0xcf, 0x97, 0x70, 0xd4, 0x25, 0x91, 0xef, 0x17, 0xbe, 0x01, 0x38, 0xc3, 0x91, 0x77,
-	0x3d, 0xd6, 0x74, 0x28, 0xea, 0x48, 0x0a, 0x70, 0x05, 0x8a, 0xee, 0xed, 0x92, 0x57, 0xba, 0xdd,
-	0xf5, 0x31, 0x7f, 0x07, 0x70, 0x56, 0x0c, 0x01, 0xc6, 0x55, 0x8a, 0x0f, 0x3c, 0xdc, 0x19, 0xe9,
-	0x7f, 0xa9, 0x02, 0xb3, 0x2e, 0xc6, 0x56, 0x28, 0x2c, 0x2c, 0x76, 0x18, 0x8a, 0x51, 0xc8, 0x94,
-	0xee, 0x0e, 0x0e, 0xef, 0xdf, 0x3a, 0xdb, 0x87, 0x21, 0x36, 0x33, 0xee, 0xc0, 0xf9, 0xfa, 0x6e,
-	0xb6, 0xf2, 0x1a, 0x66, 0x06, 0x6b, 0x29, 0x05, 0xa8, 0x56, 0x0c, 0xc3, 0xaa, 0x9a, 0xc6, 0xcb,
-	0x4d, 0x63, 0xd7, 0xda, 0xde, 0xab, 0xf2, 0xc3, 0xf3, 0x17, 0x4f, 0x9e, 0x19, 0x1b, 0x56, 0xc5,
-	0x30, 0xb2, 0x09, 0x65, 0x19, 0xde, 0xfb, 0x47, 0xb3, 0xb3, 0xd5, 0x27, 0x01, 0x8b, 0xe3, 0xef,
-	0xbf, 0xa8, 0x89, 0x72, 0xe5, 0xe4, 0x42, 0x05, 0xa7, 0x17, 0x2a, 0xf8, 0x75, 0xa1, 0x82, 0x0f,
-	0x97, 0x6a, 0xe2, 0xf4, 0x52, 0x4d, 0xfc, 0xb8, 0x54, 0x13, 0xaf, 0x56, 0x1b, 0x1e, 0x6b, 0xb6,
-	0xeb, 0x9a, 0x4d, 0x7c, 0x3d, 0xbe, 0xba, 0xdd, 0x44, 0x5e, 0xc0, 0x9f, 0xf4, 0x83, 0x92, 0xfe,
-	0xb6, 0xf7, 0x29, 0x8b, 0x7b, 0x14, 0xd5, 0x53, 0xfc, 0x1b, 0xf6, 0xe8, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x42, 0xb8, 0xc8, 0x4b, 0x71, 0x07, 0x00, 0x00,
+	// 1021 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x8e, 0x1b, 0x3f, 0x6c, 0xd7, 0xd9, 0xb4, 0xc2, 0x49, 0x8b, 0x9b, 0x5a, 0xaa,
+	0x14, 0x4a, 0x6b, 0x57, 0x41, 0x42, 0xe2, 0xe8, 0x34, 0xb6, 0x88, 0xa8, 0x8a, 0xb5, 0x4e, 0x88,
+	0x8a, 0x84, 0x96, 0xf1, 0xee, 0x73, 0xbc, 0xea, 0xee, 0xce, 0x6a, 0x76, 0x36, 0x4e, 0xf8, 0x0b,
+	0x38, 0x72, 0xe4, 0xc7, 0x91, 0x0b, 0xc7, 0x22, 0x7a, 0xe0, 0xce, 0xa5, 0x17, 0xa4, 0xaa, 0x17,
+	0x10, 0x87, 0xaa, 0x4a, 0x0e, 0xfc, 0x1b, 0x68, 0x67, 0x66, 0x1d, 0x1b, 0x1a, 0x25, 0xde, 0x06,
+	0x89, 0x43, 0x22, 0xcf, 0x7b, 0x6f, 0xbe, 0xf7, 0xbd, 0xb7, 0x6f, 0xbe, 0xd9, 0x85, 0x65, 0x8f,
+	0x5a, 0xa4, 0x19, 0x90, 0x43, 0x0f, 0x7d, 0xde, 0xc4, 0x7d, 0xf4, 0x79, 0xd8, 0x08, 0x18, 0xe5,
+	0x54, 0x2f, 0xc6, 0xae, 0x86, 0x72, 0xad, 0x2c, 0x12, 0xcf, 0xf1, 0x69, 0x53, 0xfc, 0x97, 0x01,
+	0x2b, 0xcb, 0x16, 0x0d, 0x3d, 0x1a, 0x9a, 0x62, 0xd5, 0x94, 0x0b, 0xe5, 0xba, 0xb2, 0x47, 0xf7,
+	0xa8, 0xb4, 0xc7, 0xbf, 0x94, 0xf5, 0xda, 0x54, 0x32, 0x1a, 0x71, 0x73, 0xe0, 0xd2, 0x91, 0x72,
+	0xae, 0x4e, 0x39, 0x43, 0xce, 0x90, 0x78, 0x26, 0x43, 0x8b, 0x32, 0x5b, 0x46, 0xd4, 0xbf, 0xd5,
+	0x60, 0xb9, 0x1d, 0x33, 0xec, 0xca, 0xa0, 0x96, 0x65, 0xd1, 0xc8, 0xe7, 0x3b, 0x81, 0x4d, 0x38,
+	0xea, 0x77, 0x20, 0x47, 0x6c, 0x9b, 0x55, 0xb5, 0x55, 0x6d, 0xad, 0xb0, 0x51, 0x7d, 0xf1, 0xf4,
+	0xee, 0x15, 0x45, 0xa9, 0x65, 0xdb, 0x0c, 0xc3, 0xb0, 0xc7, 0x99, 0xe3, 0xef, 0x19, 0x22, 0x4a,
+	0x6f, 0xc0, 0x3c, 0x1d, 0xf9, 0xc8, 0xaa, 0xd9, 0x33, 0xc2, 0x65, 0x98, 0x5e, 0x03, 0x60, 0x38,
+	0x88, 0x7c, 0x9b, 0xf4, 0x5d, 0xac, 0xce, 0xad, 0x6a, 0x6b, 0x0b, 0xc6, 0x84, 0xa5, 0xfe, 0xcd,
+	0x3c, 0xbc, 0x2d, 0xb8, 0xf5, 0x04, 0x71, 0x43, 0xf0, 0x56, 0xcc, 0xd6, 0xe1, 0x12, 0x91, 0x54,
+	0xcf, 0x24, 0x97, 0x04, 0xea, 0xb7, 0xa0, 0x6c, 0xb1, 0xc8, 0x36, 0xb9, 0xe3, 0x61, 0xc8, 0x89,
+	0x17, 0x08, 0xa2, 0x73, 0x46, 0x29, 0xb6, 0x6e, 0x27, 0x46, 0xbd, 0x07, 0x45, 0x1f, 0x79, 0xdc,
+	0x45, 0x93, 0x11, 0x2e, 0x89, 0x15, 0x36, 0xee, 0x3d, 0x7b, 0x79, 0x23, 0xf3, 0xe7, 0xcb, 0x1b,
+	0x57, 0x65, 0x8e, 0xd0, 0x7e, 0xdc, 0x70, 0x68, 0xd3, 0x23, 0x7c, 0xd8, 0xd8, 0xf2, 0xf9, 0x8b,
+	0xa7, 0x77, 0x41, 0x25, 0xdf, 0xf2, 0xf9, 0x8f, 0x7f, 0x3d, 0xb9, 0xad, 0x19, 0x6f, 0x29, 0x14,
+	0x23, 0xe6, 0xfb, 0x05, 0x2c, 0x0d, 0x18, 0xfd, 0x12, 0x7d, 0x73, 0x0a, 0x3b, 0x97, 0x12, 0x7b,
+	0x51, 0x82, 0x3d, 0x9c, 0xc8, 0xb0, 0x0b, 0xe5, 0x90, 0x13, 0xee, 0x58, 0x66, 0x9f, 0xb8, 0xc4,
+	0xb7, 0xb0, 0x3a, 0x9f, 0x12, 0xbc, 0x24, 0x71, 0x36, 0x24, 0x4c, 0x0c, 0xdc, 0x8f, 0x06, 0x03,
+	0x64, 0x63, 0xe0, 0x7c, 0x5a, 0x60, 0x89, 0x93, 0x00, 0xf7, 0xa0, 0xe8, 0x52, 0xeb, 0xf1, 0x18,
+	0xf6, 0x52, 0xda, 0x46, 0xc7, 0x28, 0x09, 0xe8, 0x87, 0x90, 0x8f, 0xe9, 0x47, 0x61, 0x75, 0x61,
+	0x55, 0x5b, 0x2b, 0xaf, 0xdf, 0x6c, 0x4c, 0x1e, 0xb9, 0x86, 0x1c, 0x25, 0x35, 0xe5, 0x3d, 0x11,
+	0x68, 0xa8, 0x0d, 0xfa, 0xbb, 0x50, 0x09, 0x91, 0x73, 0x17, 0x27, 0x26, 0xa4, 0x20, 0x26, 0xe4,
+	0xb2, 0xb4, 0x8f, 0x67, 0xa4, 0xfe, 0xbd, 0x06, 0x15, 0x31, 0x9a, 0x1d, 0xca, 0x2c, 0xec, 0x09,
+	0xef, 0x8c, 0xa7, 0xe5, 0x11, 0x28, 0x54, 0x7b, 0xdc, 0x80, 0x6c, 0xca, 0x06, 0x94, 0x15, 0x90,
+	0xea, 0x41, 0xfd, 0x89, 0x06, 0x45, 0xc1, 0x6e, 0x13, 0x03, 0x1a, 0x3a, 0x3c, 0x66, 0x36, 0x60,
+	0xd4, 0x3b, 0x9b, 0x59, 0x1c, 0xa5, 0xaf, 0x41, 0x96, 0xd3, 0x33, 0x0f, 0x71, 0x96, 0x53, 0xfd,
+	0x23, 0xc8, 0x13, 0x4f, 0x1c, 0xc2, 0xb4, 0x87, 0x44, 0xed, 0xaf, 0xff, 0xa4, 0x41, 0x49, 0x50,
+	0xde, 0x75, 0xf8, 0xd0, 0x66, 0x64, 0xa4, 0x58, 0x68, 0xe7, 0x60, 0x91, 0x54, 0x97, 0x3d, 0x57,
+	0x75, 0x17, 0xc7, 0xf9, 0x95, 0x06, 0x8b, 0x82, 0xf3, 0x7d, 0x86, 0x84, 0xa3, 0x1c, 0x2d, 0xfd,
+	0x1a, 0x14, 0x94, 0xd0, 0x3a, 0xb6, 0xa0, 0x9f, 0x33, 0x16, 0xa4, 0x61, 0xcb, 0xd6, 0xef, 0x41,
+	0x3e, 0x44, 0xdf, 0x3e, 0x87, 0x46, 0xaa, 0x38, 0xfd, 0x03, 0x28, 0x30, 0xb4, 0x9c, 0xc0, 0xc1,
+	0x31, 0xe3, 0xd3, 0x37, 0x9d, 0x84, 0xea, 0x9b, 0x90, 0x7b, 0x23, 0x85, 0x11, 0xbb, 0xeb, 0xbf,
+	0x25, 0x25, 0x4a, 0xd9, 0x3d, 0x4f, 0x89, 0x3b, 0x50, 0x0a, 0x18, 0xee, 0x3b, 0x34, 0x0a, 0xa5,
+	0xc6, 0xa5, 0x9d, 0xea, 0x62, 0x02, 0x23, 0xe4, 0x2d, 0xa9, 0x67, 0xee, 0x8d, 0xea, 0xf9, 0x6e,
+	0xfc, 0xc8, 0xe2, 0x83, 0xe2, 0xfe, 0xaf, 0x1e, 0x59, 0x3d, 0x80, 0x25, 0x79, 0xdd, 0x21, 0x37,
+	0x22, 0x7f, 0x44, 0x0e, 0x5b, 0x2e, 0x32, 0x3e, 0xa3, 0xac, 0xbc, 0x07, 0x8b, 0x7c, 0xc8, 0x30,
+	0x1c, 0x52, 0xd7, 0x36, 0x43, 0xb4, 0xa8, 0x6f, 0x87, 0x82, 0x79, 0xce, 0xa8, 0x8c, 0x1d, 0x3d,
+	0x69, 0xaf, 0xff, 0xaa, 0x41, 0x59, 0xa4, 0x7c, 0x40, 0x47, 0x32, 0xe5, 0x8c, 0xd9, 0x6e, 0x41,
+	0x99, 0x89, 0x7d, 0x53, 0xa9, 0xe6, 0x8c, 0x92, 0xb4, 0xaa, 0x3c, 0x7a, 0x13, 0x96, 0x6c, 0x0c,
+	0x5c, 0xe4, 0x0e, 0xf5, 0x27, 0xc4, 0x75, 0x4e, 0xc4, 0xea, 0x63, 0xd7, 0xc9, 0x1d, 0xfc, 0xda,
+	0x2a, 0x72, 0xa7, 0x54, 0xf1, 0x73, 0x16, 0xae, 0x27, 0x8d, 0x6b, 0x45, 0x9c, 0x2a, 0xd5, 0x6b,
+	0xb9, 0x2e, 0x1d, 0x89, 0x3b, 0x61, 0xb6, 0x9a, 0x66, 0x93, 0x13, 0x0b, 0xae, 0x7a, 0xe4, 0xc0,
+	0x94, 0x92, 0x60, 0x06, 0xc8, 0xe2, 0x3f, 0x87, 0xda, 0xa9, 0x07, 0x55, 0xf7, 0xc8, 0x41, 0x4b,
+	0xa0, 0x75, 0x91, 0x75, 0x05, 0x56, 0xdc, 0x66, 0x89, 0xfa, 0x8f, 0x5e, 0x94, 0xa4, 0x35, 0x69,
+	0xf3, 0x6b, 0xbb, 0x36, 0x7f, 0x4a, 0xd7, 0x7e, 0x49, 0xae, 0xb0, 0x89, 0x96, 0xfd, 0xa7, 0x9d,
+	0xba, 0x38, 0xe1, 0xfd, 0x5d, 0x83, 0xcb, 0xf2, 0xf6, 0x45, 0xec, 0xc6, 0x22, 0x81, 0xa3, 0x54,
+	0x2f, 0x84, 0x1d, 0xa8, 0x0c, 0x10, 0xcd, 0x40, 0x42, 0x98, 0xfc, 0x30, 0x90, 0x6a, 0x55, 0x5e,
+	0xbf, 0x3e, 0xfd, 0xd6, 0x70, 0x92, 0x67, 0xfb, 0x30, 0x40, 0xa3, 0x3c, 0x98, 0x5a, 0x5f, 0x5c,
+	0x65, 0xb7, 0x3f, 0x87, 0xf2, 0x74, 0x2e, 0xbd, 0x0e, 0xb5, 0x4e, 0xbb, 0x6d, 0x76, 0x8d, 0xf6,
+	0xa7, 0x5b, 0xed, 0x5d, 0x73, 0xfb, 0x51, 0x57, 0x2c, 0x1e, 0x7c, 0x72, 0xff, 0xe3, 0xf6, 0xa6,
+	0xd9, 0x69, 0xb7, 0x2b, 0x19, 0xfd, 0x26, 0xbc, 0xf3, 0xaf, 0x98, 0x9d, 0x87, 0x13, 0x21, 0xda,
+	0x4a, 0xee, 0xab, 0x1f, 0x6a, 0x99, 0x8d, 0xce, 0xb3, 0xa3, 0x9a, 0xf6, 0xfc, 0xa8, 0xa6, 0xbd,
+	0x3a, 0xaa, 0x69, 0x5f, 0x1f, 0xd7, 0x32, 0xcf, 0x8f, 0x6b, 0x99, 0x3f, 0x8e, 0x6b, 0x99, 0xcf,
+	0xee, 0xec, 0x39, 0x7c, 0x18, 0xf5, 0x1b, 0x16, 0xf5, 0x9a, 0x71, 0xe9, 0xd6, 0x90, 0x38, 0xbe,
+	0xf8, 0xd5, 0xdc, 0x5f, 0x6f, 0x1e, 0x8c, 0xbf, 0x21, 0xe2, 0x1e, 0x85, 0xfd, 0xbc, 0xf8, 0x78,
+	0x78, 0xff, 0xef, 0x01, 0x00, 0xe2, 0x24, 0x80, 0x07, 0xea, 0x0c, 0x00, 0x00,
 }
 
 func (m *EventPaymentAccountUpdate) Marshal() (dAtA []byte, err error) {
@@ -755,7 +1215,333 @@ func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventFeePreview) Marshal() (dAtA []byte, err error) {
+func (m *EventCreateStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PreviousRate.Size()
+		i -= size
+		if _, err := m.PreviousRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StreamId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetRunwayAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetRunwayAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetRunwayAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ThresholdSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLowRunway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLowRunway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLowRunway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ThresholdSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DepletionTimestamp != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DepletionTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RunwaySeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RunwaySeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetAutoDepositAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetAutoDepositAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetAutoDepositAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ThresholdSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PeriodSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PeriodSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxAmountPerPeriod.Size()
+		i -= size
+		if _, err := m.MaxAmountPerPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAutoDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAutoDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAutoDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeePreview) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -911,36 +1697,883 @@ func (m *EventWithdraw) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCreateStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovEvents(uint64(m.StreamId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUpdateStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovEvents(uint64(m.StreamId))
+	}
+	l = m.PreviousRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCancelStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovEvents(uint64(m.StreamId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetRunwayAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ThresholdSeconds != 0 {
+		n += 1 + sovEvents(uint64(m.ThresholdSeconds))
+	}
+	return n
+}
+
+func (m *EventLowRunway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RunwaySeconds != 0 {
+		n += 1 + sovEvents(uint64(m.RunwaySeconds))
+	}
+	if m.DepletionTimestamp != 0 {
+		n += 1 + sovEvents(uint64(m.DepletionTimestamp))
+	}
+	if m.ThresholdSeconds != 0 {
+		n += 1 + sovEvents(uint64(m.ThresholdSeconds))
+	}
+	return n
+}
+
+func (m *EventSetAutoDepositAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.MaxAmountPerPeriod.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PeriodSeconds != 0 {
+		n += 1 + sovEvents(uint64(m.PeriodSeconds))
+	}
+	if m.ThresholdSeconds != 0 {
+		n += 1 + sovEvents(uint64(m.ThresholdSeconds))
+	}
+	return n
+}
+
+func (m *EventAutoDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventFeePreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.FeePreviewType != 0 {
+		n += 1 + sovEvents(uint64(m.FeePreviewType))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPaymentAccountUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPaymentAccountUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refundable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Refundable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStreamRecordUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStreamRecordUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrudTimestamp", wireType)
+			}
+			m.CrudTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrudTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetflowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenNetflowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenNetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BufferBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StreamAccountStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleTimestamp", wireType)
+			}
+			m.SettleTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettleTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForceSettle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForceSettle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettledBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventFeePreview) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.FeePreviewType != 0 {
-		n += 1 + sovEvents(uint64(m.FeePreviewType))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -963,15 +2596,15 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPaymentAccountUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPaymentAccountUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -999,11 +2632,11 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1031,13 +2664,13 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Refundable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1047,12 +2680,26 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Refundable = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1074,7 +2721,7 @@ func (m *EventPaymentAccountUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventCreateStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1097,15 +2744,34 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStreamRecordUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreateStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStreamRecordUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreateStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1133,13 +2799,129 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrudTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			m.CrudTimestamp = 0
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1149,14 +2931,14 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CrudTimestamp |= int64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetflowRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1184,13 +2966,13 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrozenNetflowRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1218,13 +3000,82 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FrozenNetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaticBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1252,13 +3103,11 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StaticBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1286,13 +3135,61 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BufferBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetRunwayAlert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetRunwayAlert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetRunwayAlert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1320,34 +3217,13 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= StreamAccountStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettleTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdSeconds", wireType)
 			}
-			m.SettleTimestamp = 0
+			m.ThresholdSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1357,7 +3233,7 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettleTimestamp |= int64(b&0x7F) << shift
+				m.ThresholdSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1383,7 +3259,7 @@ func (m *EventStreamRecordUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
+func (m *EventLowRunway) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1406,10 +3282,10 @@ func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventForceSettle: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLowRunway: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventForceSettle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLowRunway: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1445,10 +3321,10 @@ func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
 			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledBalance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunwaySeconds", wireType)
 			}
-			var stringLen uint64
+			m.RunwaySeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1458,26 +3334,49 @@ func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RunwaySeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepletionTimestamp", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.DepletionTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepletionTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdSeconds", wireType)
 			}
-			if err := m.SettledBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ThresholdSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1499,7 +3398,7 @@ func (m *EventForceSettle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventSetAutoDepositAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1522,15 +3421,15 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetAutoDepositAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetAutoDepositAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1558,11 +3457,11 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1590,11 +3489,11 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountPerPeriod", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1622,10 +3521,48 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxAmountPerPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+			}
+			m.PeriodSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdSeconds", wireType)
+			}
+			m.ThresholdSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1647,7 +3584,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventAutoDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1670,15 +3607,15 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAutoDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAutoDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1706,7 +3643,7 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -24,3 +26,9 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
+
+// SpKeeper defines the expected interface needed to project the storage prices.
+type SpKeeper interface {
+	GetGlobalSpStorePriceByTime(ctx sdk.Context, time int64) (val sptypes.GlobalSpStorePrice, err error)
+	GetNextGlobalSpStorePrice(ctx sdk.Context) (sptypes.GlobalSpStorePrice, bool)
+}
@@ -13,6 +13,7 @@ import (
 	reflect "reflect"
 
 	types "github.com/cosmos/cosmos-sdk/types"
+	types0 "github.com/mocachain/moca/v2/x/sp/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -213,3 +214,56 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
+
+// MockSpKeeper is a mock of SpKeeper interface.
+type MockSpKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpKeeperMockRecorder
+}
+
+// MockSpKeeperMockRecorder is the mock recorder for MockSpKeeper.
+type MockSpKeeperMockRecorder struct {
+	mock *MockSpKeeper
+}
+
+// NewMockSpKeeper creates a new mock instance.
+func NewMockSpKeeper(ctrl *gomock.Controller) *MockSpKeeper {
+	mock := &MockSpKeeper{ctrl: ctrl}
+	mock.recorder = &MockSpKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpKeeper) EXPECT() *MockSpKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetGlobalSpStorePriceByTime mocks base method.
+func (m *MockSpKeeper) GetGlobalSpStorePriceByTime(ctx types.Context, time int64) (types0.GlobalSpStorePrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGlobalSpStorePriceByTime", ctx, time)
+	ret0, _ := ret[0].(types0.GlobalSpStorePrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGlobalSpStorePriceByTime indicates an expected call of GetGlobalSpStorePriceByTime.
+func (mr *MockSpKeeperMockRecorder) GetGlobalSpStorePriceByTime(ctx, time any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalSpStorePriceByTime", reflect.TypeOf((*MockSpKeeper)(nil).GetGlobalSpStorePriceByTime), ctx, time)
+}
+
+// GetNextGlobalSpStorePrice mocks base method.
+func (m *MockSpKeeper) GetNextGlobalSpStorePrice(ctx types.Context) (types0.GlobalSpStorePrice, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextGlobalSpStorePrice", ctx)
+	ret0, _ := ret[0].(types0.GlobalSpStorePrice)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetNextGlobalSpStorePrice indicates an expected call of GetNextGlobalSpStorePrice.
+func (mr *MockSpKeeperMockRecorder) GetNextGlobalSpStorePrice(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextGlobalSpStorePrice", reflect.TypeOf((*MockSpKeeper)(nil).GetNextGlobalSpStorePrice), ctx)
+}
@@ -22,15 +22,24 @@ const (
 )
 
 var (
-	AutoSettleRecordKeyPrefix    = []byte{0x01}
-	AutoResumeRecordKeyPrefix    = []byte{0x02}
-	StreamRecordKeyPrefix        = []byte{0x03}
-	PaymentAccountCountKeyPrefix = []byte{0x04}
-	PaymentAccountKeyPrefix      = []byte{0x05}
-	OutFlowKeyPrefix             = []byte{0x06}
-	ParamsKey                    = []byte{0x07}
-	VersionedParamsKeyPrefix     = []byte{0x08}
-	DelayedWithdrawalKeyPrefix   = []byte{0x09}
+	AutoSettleRecordKeyPrefix     = []byte{0x01}
+	AutoResumeRecordKeyPrefix     = []byte{0x02}
+	StreamRecordKeyPrefix         = []byte{0x03}
+	PaymentAccountCountKeyPrefix  = []byte{0x04}
+	PaymentAccountKeyPrefix       = []byte{0x05}
+	OutFlowKeyPrefix              = []byte{0x06}
+	ParamsKey                     = []byte{0x07}
+	VersionedParamsKeyPrefix      = []byte{0x08}
+	DelayedWithdrawalKeyPrefix    = []byte{0x09}
+	UserStreamKeyPrefix           = []byte{0x0A}
+	UserStreamBySenderKeyPrefix   = []byte{0x0B}
+	UserStreamSequenceKey         = []byte{0x0C}
+	RunwayAlertKeyPrefix          = []byte{0x0D}
+	RunwayAlertQueueKeyPrefix     = []byte{0x0E}
+	AutoDepositAllowanceKeyPrefix = []byte{0x0F}
+	AutoDepositQueueKeyPrefix     = []byte{0x10}
+	AutoDepositRecordKeyPrefix    = []byte{0x11}
+	FrozenTimeKeyPrefix           = []byte{0x12}
 )
 
 // AutoSettleRecordKey returns the store key to retrieve a AutoSettleRecord from the index fields
@@ -149,3 +158,57 @@ func DelayedWithdrawalKey(
 ) []byte {
 	return account
 }
+
+// UserStreamKey returns the store key to retrieve a UserStream from its id
+func UserStreamKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// UserStreamBySenderKey returns the store key indexing a UserStream by its sender
+func UserStreamBySenderKey(sender sdk.AccAddress, id uint64) []byte {
+	key := append([]byte{}, sender.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// RunwayAlertKey returns the store key to retrieve the RunwayAlert of a stream account
+func RunwayAlertKey(addr sdk.AccAddress) []byte {
+	return addr
+}
+
+// RunwayAlertQueueKey returns the store key queueing the runway check of a stream account at the timestamp
+func RunwayAlertQueueKey(timestamp int64, addr sdk.AccAddress) []byte {
+	key := sdk.Uint64ToBigEndian(uint64(timestamp))
+	return append(key, addr.Bytes()...)
+}
+
+// ParseRunwayAlertQueueKey parses the timestamp and the stream account from a runway check queue key
+func ParseRunwayAlertQueueKey(key []byte) (int64, sdk.AccAddress) {
+	return int64(binary.BigEndian.Uint64(key[0:8])), sdk.AccAddress(key[8:])
+}
+
+// AutoDepositAllowanceKey returns the store key to retrieve the AutoDepositAllowance of a stream account
+func AutoDepositAllowanceKey(addr sdk.AccAddress) []byte {
+	return addr
+}
+
+// AutoDepositQueueKey returns the store key queueing the auto deposit check of a stream account at the timestamp
+func AutoDepositQueueKey(timestamp int64, addr sdk.AccAddress) []byte {
+	key := sdk.Uint64ToBigEndian(uint64(timestamp))
+	return append(key, addr.Bytes()...)
+}
+
+// ParseAutoDepositQueueKey parses the timestamp and the stream account from an auto deposit check queue key
+func ParseAutoDepositQueueKey(key []byte) (int64, sdk.AccAddress) {
+	return int64(binary.BigEndian.Uint64(key[0:8])), sdk.AccAddress(key[8:])
+}
+
+// AutoDepositRecordKey returns the store key to retrieve an AutoDepositRecord of a stream account by its timestamp
+func AutoDepositRecordKey(addr sdk.AccAddress, timestamp int64) []byte {
+	key := append([]byte{}, addr.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(timestamp))...)
+}
+
+// FrozenTimeKey returns the store key to retrieve the frozen time of a stream account
+func FrozenTimeKey(addr sdk.AccAddress) []byte {
+	return addr
+}
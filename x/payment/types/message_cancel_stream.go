@@ -0,0 +1,47 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelStream = "cancel_stream"
+
+var _ sdk.Msg = &MsgCancelStream{}
+
+func NewMsgCancelStream(creator string, streamID uint64) *MsgCancelStream {
+	return &MsgCancelStream{
+		Creator:  creator,
+		StreamId: streamID,
+	}
+}
+
+func (msg *MsgCancelStream) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelStream) Type() string {
+	return TypeMsgCancelStream
+}
+
+func (msg *MsgCancelStream) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelStream) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
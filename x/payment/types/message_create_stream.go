@@ -0,0 +1,64 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateStream = "create_stream"
+
+var _ sdk.Msg = &MsgCreateStream{}
+
+func NewMsgCreateStream(creator, sender, recipient string, rate sdkmath.Int) *MsgCreateStream {
+	return &MsgCreateStream{
+		Creator:   creator,
+		Sender:    sender,
+		Recipient: recipient,
+		Rate:      rate,
+	}
+}
+
+func (msg *MsgCreateStream) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateStream) Type() string {
+	return TypeMsgCreateStream
+}
+
+func (msg *MsgCreateStream) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateStream) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	sender, err := sdk.AccAddressFromHexUnsafe(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	recipient, err := sdk.AccAddressFromHexUnsafe(msg.Recipient)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if sender.Equals(recipient) {
+		return errors.Wrapf(ErrInvalidUserStream, "the sender and the recipient are the same account")
+	}
+	if msg.Rate.IsNil() || !msg.Rate.IsPositive() {
+		return errors.Wrapf(ErrInvalidUserStream, "invalid rate (%s)", msg.Rate)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetAutoDepositAllowance = "set_auto_deposit_allowance"
+
+var _ sdk.Msg = &MsgSetAutoDepositAllowance{}
+
+func NewMsgSetAutoDepositAllowance(creator string, addr string, maxAmountPerPeriod sdkmath.Int, periodSeconds, thresholdSeconds uint64) *MsgSetAutoDepositAllowance {
+	return &MsgSetAutoDepositAllowance{
+		Creator:            creator,
+		Addr:               addr,
+		MaxAmountPerPeriod: maxAmountPerPeriod,
+		PeriodSeconds:      periodSeconds,
+		ThresholdSeconds:   thresholdSeconds,
+	}
+}
+
+func (msg *MsgSetAutoDepositAllowance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAutoDepositAllowance) Type() string {
+	return TypeMsgSetAutoDepositAllowance
+}
+
+func (msg *MsgSetAutoDepositAllowance) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetAutoDepositAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAutoDepositAllowance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.Addr)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid stream account address (%s)", err)
+	}
+	if msg.MaxAmountPerPeriod.IsNil() || msg.MaxAmountPerPeriod.IsNegative() {
+		return errors.Wrapf(ErrInvalidAutoDepositAllowance, "the max amount per period should not be negative")
+	}
+	if msg.MaxAmountPerPeriod.IsZero() { // revoke the allowance
+		return nil
+	}
+	if msg.PeriodSeconds == 0 {
+		return errors.Wrapf(ErrInvalidAutoDepositAllowance, "the period should be positive")
+	}
+	if msg.ThresholdSeconds == 0 {
+		return errors.Wrapf(ErrInvalidAutoDepositAllowance, "the threshold should be positive")
+	}
+	return nil
+}
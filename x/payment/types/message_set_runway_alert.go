@@ -0,0 +1,52 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetRunwayAlert = "set_runway_alert"
+
+var _ sdk.Msg = &MsgSetRunwayAlert{}
+
+func NewMsgSetRunwayAlert(owner string, addr string, thresholdSeconds uint64) *MsgSetRunwayAlert {
+	return &MsgSetRunwayAlert{
+		Owner:            owner,
+		Addr:             addr,
+		ThresholdSeconds: thresholdSeconds,
+	}
+}
+
+func (msg *MsgSetRunwayAlert) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRunwayAlert) Type() string {
+	return TypeMsgSetRunwayAlert
+}
+
+func (msg *MsgSetRunwayAlert) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgSetRunwayAlert) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRunwayAlert) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.Addr)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid stream account address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateStream = "update_stream"
+
+var _ sdk.Msg = &MsgUpdateStream{}
+
+func NewMsgUpdateStream(creator string, streamID uint64, rate sdkmath.Int) *MsgUpdateStream {
+	return &MsgUpdateStream{
+		Creator:  creator,
+		StreamId: streamID,
+		Rate:     rate,
+	}
+}
+
+func (msg *MsgUpdateStream) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateStream) Type() string {
+	return TypeMsgUpdateStream
+}
+
+func (msg *MsgUpdateStream) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateStream) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Rate.IsNil() || !msg.Rate.IsPositive() {
+		return errors.Wrapf(ErrInvalidUserStream, "invalid rate (%s)", msg.Rate)
+	}
+	return nil
+}
@@ -1228,1178 +1228,1473 @@ func (m *QueryDelayedWithdrawalResponse) GetDelayedWithdrawal() DelayedWithdrawa
 	return DelayedWithdrawalRecord{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "moca.payment.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "moca.payment.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "moca.payment.QueryParamsByTimestampRequest")
-	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "moca.payment.QueryParamsByTimestampResponse")
-	proto.RegisterType((*QueryOutFlowsRequest)(nil), "moca.payment.QueryOutFlowsRequest")
-	proto.RegisterType((*QueryOutFlowsResponse)(nil), "moca.payment.QueryOutFlowsResponse")
-	proto.RegisterType((*QueryGetStreamRecordRequest)(nil), "moca.payment.QueryGetStreamRecordRequest")
-	proto.RegisterType((*QueryGetStreamRecordResponse)(nil), "moca.payment.QueryGetStreamRecordResponse")
-	proto.RegisterType((*QueryStreamRecordsRequest)(nil), "moca.payment.QueryStreamRecordsRequest")
-	proto.RegisterType((*QueryStreamRecordsResponse)(nil), "moca.payment.QueryStreamRecordsResponse")
-	proto.RegisterType((*QueryPaymentAccountCountRequest)(nil), "moca.payment.QueryPaymentAccountCountRequest")
-	proto.RegisterType((*QueryPaymentAccountCountResponse)(nil), "moca.payment.QueryPaymentAccountCountResponse")
-	proto.RegisterType((*QueryPaymentAccountCountsRequest)(nil), "moca.payment.QueryPaymentAccountCountsRequest")
-	proto.RegisterType((*QueryPaymentAccountCountsResponse)(nil), "moca.payment.QueryPaymentAccountCountsResponse")
-	proto.RegisterType((*QueryPaymentAccountRequest)(nil), "moca.payment.QueryPaymentAccountRequest")
-	proto.RegisterType((*QueryPaymentAccountResponse)(nil), "moca.payment.QueryPaymentAccountResponse")
-	proto.RegisterType((*QueryPaymentAccountsRequest)(nil), "moca.payment.QueryPaymentAccountsRequest")
-	proto.RegisterType((*QueryPaymentAccountsResponse)(nil), "moca.payment.QueryPaymentAccountsResponse")
-	proto.RegisterType((*QueryDynamicBalanceRequest)(nil), "moca.payment.QueryDynamicBalanceRequest")
-	proto.RegisterType((*QueryDynamicBalanceResponse)(nil), "moca.payment.QueryDynamicBalanceResponse")
-	proto.RegisterType((*QueryPaymentAccountsByOwnerRequest)(nil), "moca.payment.QueryPaymentAccountsByOwnerRequest")
-	proto.RegisterType((*QueryPaymentAccountsByOwnerResponse)(nil), "moca.payment.QueryPaymentAccountsByOwnerResponse")
-	proto.RegisterType((*QueryAutoSettleRecordsRequest)(nil), "moca.payment.QueryAutoSettleRecordsRequest")
-	proto.RegisterType((*QueryAutoSettleRecordsResponse)(nil), "moca.payment.QueryAutoSettleRecordsResponse")
-	proto.RegisterType((*QueryDelayedWithdrawalRequest)(nil), "moca.payment.QueryDelayedWithdrawalRequest")
-	proto.RegisterType((*QueryDelayedWithdrawalResponse)(nil), "moca.payment.QueryDelayedWithdrawalResponse")
+type QueryStreamRequest struct {
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("moca/payment/query.proto", fileDescriptor_21c3accf5c96eb28) }
-
-var fileDescriptor_21c3accf5c96eb28 = []byte{
-	// 1431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xf6, 0x23, 0x6d, 0x5e, 0xd2, 0xa4, 0x9e, 0x38, 0x55, 0xea, 0x06, 0x37, 0xd9, 0x7e,
-	0xc4, 0x49, 0x13, 0x6f, 0x92, 0x4a, 0x44, 0x20, 0xf5, 0xd0, 0x50, 0xa5, 0x2a, 0x42, 0xa4, 0x75,
-	0x11, 0xa8, 0x48, 0x68, 0x19, 0xaf, 0xa7, 0x8e, 0x1b, 0x7b, 0xc7, 0xf5, 0x8e, 0x1b, 0xac, 0x28,
-	0x48, 0x20, 0x0e, 0x1c, 0x2b, 0x15, 0x21, 0xb8, 0x22, 0x21, 0xc1, 0x0d, 0x09, 0xc4, 0x85, 0x13,
-	0x12, 0x87, 0x72, 0xab, 0xe0, 0x82, 0x38, 0x54, 0xa8, 0x45, 0xe2, 0xdf, 0x40, 0x9e, 0x7d, 0xeb,
-	0xec, 0xec, 0xce, 0xda, 0x6e, 0x08, 0x17, 0xdb, 0xbb, 0xf3, 0xde, 0xef, 0xfd, 0xde, 0x7b, 0xf3,
-	0x66, 0xde, 0x33, 0x4c, 0xd6, 0xb8, 0x43, 0xad, 0x3a, 0x6d, 0xd5, 0x98, 0x2b, 0xac, 0xfb, 0x4d,
-	0xd6, 0x68, 0xe5, 0xeb, 0x0d, 0x2e, 0x38, 0x19, 0x69, 0xaf, 0xe4, 0x71, 0x25, 0x93, 0xa2, 0xb5,
-	0x8a, 0xcb, 0x2d, 0xf9, 0xe9, 0x0b, 0x64, 0xe6, 0x1d, 0xee, 0xd5, 0xb8, 0x67, 0x15, 0xa9, 0xc7,
-	0x7c, 0x4d, 0xeb, 0xc1, 0x72, 0x91, 0x09, 0xba, 0x6c, 0xd5, 0x69, 0xb9, 0xe2, 0x52, 0x51, 0xe1,
-	0x2e, 0xca, 0x9e, 0xf6, 0x65, 0x6d, 0xf9, 0x64, 0xf9, 0x0f, 0xb8, 0x94, 0x2e, 0xf3, 0x32, 0xf7,
-	0xdf, 0xb7, 0x7f, 0xe1, 0xdb, 0xa9, 0x32, 0xe7, 0xe5, 0x2a, 0xb3, 0x68, 0xbd, 0x62, 0x51, 0xd7,
-	0xe5, 0x42, 0xa2, 0x05, 0x3a, 0x17, 0x14, 0xd6, 0xb4, 0x29, 0xb8, 0xed, 0x31, 0x21, 0xaa, 0xcc,
-	0x6e, 0x30, 0x87, 0x37, 0x4a, 0x28, 0xb6, 0xa0, 0x88, 0x95, 0x58, 0x95, 0xb6, 0x58, 0xc9, 0xde,
-	0xae, 0x88, 0xcd, 0x52, 0x83, 0x6e, 0xd3, 0xaa, 0x2a, 0x7d, 0x46, 0x91, 0xe6, 0x4d, 0x61, 0xdf,
-	0xad, 0xf2, 0xed, 0xc0, 0x01, 0x65, 0xb1, 0x4e, 0x1b, 0xb4, 0x16, 0x90, 0x31, 0x23, 0x4b, 0xf2,
-	0xdb, 0xa6, 0x8e, 0xc3, 0x9b, 0xae, 0x40, 0x99, 0x5c, 0x37, 0x19, 0x3b, 0x2c, 0x39, 0xad, 0x48,
-	0x7a, 0xa2, 0xc1, 0x68, 0x4d, 0xe1, 0x69, 0xa6, 0x81, 0xdc, 0x6a, 0x47, 0xfb, 0xa6, 0x24, 0x51,
-	0x60, 0xf7, 0x9b, 0xcc, 0x13, 0xe6, 0x9b, 0x30, 0xae, 0xbc, 0xf5, 0xea, 0xdc, 0xf5, 0x18, 0x59,
-	0x85, 0x41, 0x9f, 0xec, 0xa4, 0x31, 0x6d, 0xe4, 0x86, 0x57, 0xd2, 0xf9, 0x70, 0x5a, 0xf3, 0xbe,
-	0xf4, 0xda, 0xd0, 0xe3, 0xa7, 0x67, 0x07, 0xbe, 0xf9, 0xe7, 0xbb, 0x79, 0xa3, 0x80, 0xe2, 0xe6,
-	0x15, 0x78, 0x29, 0x84, 0xb7, 0xd6, 0x7a, 0xab, 0x52, 0x63, 0x9e, 0xa0, 0xb5, 0x3a, 0x1a, 0x24,
-	0x53, 0x30, 0x24, 0x82, 0x77, 0x12, 0xfc, 0x70, 0x61, 0xef, 0x85, 0x79, 0x07, 0xb2, 0x49, 0xea,
-	0xff, 0x95, 0xd9, 0x12, 0xa4, 0x25, 0xf4, 0x46, 0x53, 0xac, 0x57, 0xf9, 0x76, 0x10, 0x01, 0x32,
-	0x09, 0xc7, 0x30, 0xa0, 0x12, 0x71, 0xa8, 0x10, 0x3c, 0x9a, 0x6f, 0xc3, 0x44, 0x44, 0x03, 0x39,
-	0x5c, 0x81, 0xa1, 0x20, 0xcf, 0x6d, 0x1a, 0x87, 0x73, 0xc3, 0x2b, 0x13, 0x2a, 0x0d, 0x54, 0x09,
-	0xf3, 0x38, 0xce, 0x11, 0xc6, 0x5c, 0x85, 0x33, 0x12, 0xf7, 0x3a, 0x13, 0xb7, 0x65, 0xa2, 0x0a,
-	0x32, 0x4f, 0xbd, 0x09, 0xdd, 0x83, 0x29, 0xbd, 0x22, 0xf2, 0x7a, 0x1d, 0x4e, 0x28, 0x99, 0xc7,
-	0x10, 0x65, 0x54, 0x6e, 0x61, 0xd5, 0x30, 0xc1, 0x11, 0x2f, 0xb4, 0x60, 0x3a, 0x70, 0x5a, 0xda,
-	0x0a, 0x4b, 0x77, 0x62, 0xb6, 0x0e, 0xb0, 0x57, 0xab, 0x68, 0xe5, 0x62, 0x1e, 0xeb, 0xb3, 0x5d,
-	0xd8, 0x79, 0xff, 0x48, 0xc0, 0xc2, 0xce, 0xdf, 0xa4, 0x65, 0x86, 0xba, 0x85, 0x90, 0xa6, 0xf9,
-	0xbd, 0x01, 0x19, 0x9d, 0x15, 0xf4, 0xe7, 0x0d, 0x18, 0x55, 0xfc, 0x09, 0x82, 0xdd, 0xa7, 0x43,
-	0x27, 0xc2, 0x0e, 0x79, 0xe4, 0xba, 0x42, 0xfa, 0x90, 0x24, 0x3d, 0xdb, 0x93, 0xb4, 0x4f, 0x45,
-	0x61, 0xbd, 0x0a, 0x67, 0x71, 0x93, 0x4a, 0xfb, 0x57, 0xfd, 0xec, 0xbc, 0xd6, 0xfe, 0x08, 0x02,
-	0x94, 0x86, 0xa3, 0x7c, 0xdb, 0x65, 0x0d, 0xcc, 0xa0, 0xff, 0x60, 0x7e, 0x62, 0xc0, 0x74, 0xb2,
-	0x26, 0x3a, 0xfd, 0x3e, 0x4c, 0x68, 0x0b, 0x1d, 0xc3, 0x3c, 0x13, 0xdd, 0xef, 0x31, 0xa4, 0x70,
-	0x08, 0xc6, 0xeb, 0xf1, 0x75, 0xf3, 0x5e, 0x32, 0x8b, 0x03, 0xcf, 0xf0, 0x13, 0x03, 0x66, 0xba,
-	0x18, 0x43, 0x9f, 0x8b, 0x70, 0x4a, 0xeb, 0x73, 0x90, 0xf0, 0x17, 0x73, 0x3a, 0xad, 0x71, 0xfa,
-	0x00, 0xd3, 0xbf, 0x84, 0x7b, 0x56, 0x65, 0x11, 0x04, 0x8e, 0xc0, 0x11, 0x5a, 0x2a, 0x05, 0x89,
-	0x97, 0xbf, 0x4d, 0x8e, 0x05, 0x1f, 0xd5, 0x40, 0xef, 0x6f, 0xc2, 0x58, 0xc4, 0x7b, 0x0c, 0xf8,
-	0x54, 0x37, 0xb7, 0xc3, 0x1e, 0x8f, 0xaa, 0x1e, 0x9b, 0x4c, 0x6b, 0xf0, 0xc0, 0x93, 0xfb, 0x93,
-	0x81, 0x07, 0x52, 0xcc, 0x0e, 0x7a, 0x56, 0x80, 0x93, 0x11, 0xcf, 0x82, 0x8c, 0xf6, 0xed, 0xda,
-	0x98, 0xea, 0xda, 0x01, 0xe6, 0xf1, 0x65, 0xcc, 0xe3, 0xb5, 0x96, 0x4b, 0x6b, 0x15, 0x67, 0x8d,
-	0x56, 0xa9, 0xeb, 0xb0, 0xde, 0xa7, 0xf0, 0xaf, 0x47, 0x30, 0xba, 0x51, 0x45, 0x74, 0xfa, 0x0e,
-	0x8c, 0x95, 0xfc, 0x15, 0xbb, 0xe8, 0x2f, 0xf9, 0x08, 0x6b, 0x4b, 0x6d, 0xaf, 0xfe, 0x7c, 0x7a,
-	0x76, 0xc2, 0x27, 0xeb, 0x95, 0xb6, 0xf2, 0x15, 0x6e, 0xd5, 0xa8, 0xd8, 0xcc, 0xdf, 0x70, 0xc5,
-	0x6f, 0x3f, 0x2c, 0x02, 0x7a, 0x71, 0xc3, 0x15, 0x98, 0xd7, 0x92, 0x62, 0x22, 0x7e, 0xc0, 0x1f,
-	0xda, 0xf7, 0x01, 0x4f, 0x2e, 0x41, 0xca, 0x69, 0x36, 0x1a, 0xed, 0xdc, 0xec, 0x5d, 0xc8, 0x87,
-	0xe5, 0x85, 0x7c, 0x12, 0x17, 0x3a, 0xb7, 0x2f, 0xb9, 0x0d, 0x23, 0x45, 0xea, 0x6e, 0x75, 0x1c,
-	0x3a, 0xb2, 0x4f, 0x87, 0x86, 0xdb, 0x28, 0x81, 0x37, 0xef, 0x41, 0x8a, 0x3e, 0xa0, 0x95, 0x2a,
-	0x2d, 0x56, 0x59, 0x07, 0xf9, 0xe8, 0x3e, 0x91, 0x4f, 0x76, 0xa0, 0x02, 0xf8, 0x0d, 0x80, 0x2a,
-	0x77, 0xb6, 0x58, 0xc9, 0xbe, 0xcb, 0xd8, 0xe4, 0xe0, 0x3e, 0x71, 0x87, 0x7c, 0x8c, 0x75, 0xc6,
-	0xc8, 0x2d, 0x18, 0x76, 0x36, 0xa9, 0x5b, 0x66, 0x76, 0x83, 0x0a, 0x36, 0x79, 0x6c, 0x9f, 0x88,
-	0xe0, 0x83, 0x14, 0xa8, 0x60, 0xe6, 0xab, 0x60, 0xea, 0x0a, 0x68, 0xad, 0xb5, 0xd1, 0xbe, 0x30,
-	0xba, 0xdf, 0x26, 0x1b, 0x70, 0xae, 0xab, 0x2e, 0x6e, 0xc7, 0x1c, 0x44, 0x4b, 0x48, 0x96, 0xe0,
-	0x50, 0xac, 0xb2, 0xcc, 0x32, 0xf6, 0x6e, 0x57, 0x9b, 0x82, 0xdf, 0x96, 0x7d, 0xf1, 0xff, 0x74,
-	0xed, 0xff, 0x62, 0x60, 0x9b, 0xa7, 0xb1, 0xd4, 0x29, 0xa2, 0xf1, 0x78, 0x7f, 0x1e, 0x1c, 0x1e,
-	0x59, 0x75, 0xbf, 0x47, 0x51, 0xc2, 0x7b, 0x3e, 0x45, 0xa3, 0x26, 0x0e, 0xee, 0x00, 0x79, 0x05,
-	0xe3, 0x75, 0xcd, 0x9f, 0x10, 0xde, 0xe9, 0x0c, 0x08, 0xbd, 0xcf, 0x90, 0x8f, 0x82, 0x08, 0x68,
-	0x74, 0x31, 0x02, 0x36, 0x90, 0xf8, 0xe8, 0x81, 0x41, 0xbf, 0xa0, 0x06, 0x40, 0x03, 0x12, 0x8b,
-	0x43, 0x29, 0x2a, 0xb3, 0xf2, 0xf3, 0x18, 0x1c, 0x95, 0x1c, 0xc8, 0x16, 0x0c, 0xfa, 0x7d, 0x33,
-	0x99, 0x56, 0x81, 0xe3, 0x03, 0x43, 0x66, 0xa6, 0x8b, 0x84, 0xcf, 0xdc, 0x9c, 0xfa, 0xf8, 0xf7,
-	0xbf, 0x1f, 0x1d, 0x3a, 0x45, 0xd2, 0x96, 0x66, 0xfa, 0x21, 0x5f, 0x18, 0x90, 0x8a, 0xb5, 0xf7,
-	0xe4, 0x52, 0x22, 0x6c, 0x7c, 0x86, 0xc8, 0x2c, 0xf4, 0x27, 0x8c, 0x74, 0x72, 0x92, 0x8e, 0x49,
-	0xa6, 0x75, 0x74, 0xac, 0x9d, 0xce, 0xe1, 0xb7, 0x4b, 0x3e, 0x84, 0xe3, 0x41, 0xaf, 0x4f, 0x4c,
-	0x8d, 0x8d, 0xc8, 0xe8, 0x90, 0x39, 0xd7, 0x55, 0x06, 0xcd, 0xcf, 0x49, 0xf3, 0xe7, 0xc8, 0x8c,
-	0xa5, 0x1d, 0x14, 0x3d, 0x6b, 0x07, 0x37, 0xc5, 0x2e, 0xf9, 0xcc, 0x80, 0x91, 0xf0, 0xe1, 0x4d,
-	0xe6, 0x34, 0x06, 0xf4, 0x53, 0x43, 0x66, 0xbe, 0x1f, 0x51, 0xa4, 0xb4, 0x28, 0x29, 0xcd, 0x92,
-	0x0b, 0x56, 0xf2, 0xd4, 0x18, 0xa2, 0xf5, 0xa9, 0x01, 0x27, 0x94, 0x06, 0x9d, 0xcc, 0x6a, 0x8c,
-	0xe9, 0x06, 0x85, 0x4c, 0xae, 0xb7, 0x20, 0x72, 0x3a, 0x2f, 0x39, 0x65, 0xc9, 0x54, 0x17, 0x4e,
-	0x1e, 0xf9, 0xd6, 0x80, 0x71, 0x4d, 0xf7, 0x47, 0x16, 0xb5, 0x3b, 0x22, 0xa9, 0x3d, 0xcf, 0xe4,
-	0xfb, 0x15, 0x47, 0x72, 0x97, 0x25, 0xb9, 0x45, 0x72, 0xc9, 0xea, 0x3d, 0x90, 0x5b, 0x3b, 0xf2,
-	0x78, 0xde, 0x25, 0x5f, 0x1b, 0x90, 0xd6, 0x75, 0xbd, 0xa4, 0x4f, 0xeb, 0x9d, 0x20, 0x5a, 0x7d,
-	0xcb, 0x23, 0xdd, 0x05, 0x49, 0xf7, 0x22, 0x39, 0xdf, 0x07, 0x5d, 0x8f, 0x3c, 0x32, 0x60, 0x54,
-	0x85, 0x23, 0xb9, 0x9e, 0x16, 0x03, 0x6e, 0x73, 0x7d, 0x48, 0xbe, 0x10, 0x2b, 0x6b, 0xa7, 0xdd,
-	0x32, 0xef, 0x92, 0x87, 0x06, 0x8c, 0x45, 0x6e, 0x36, 0xd2, 0xdb, 0x98, 0xd7, 0xad, 0x1c, 0x12,
-	0xba, 0x54, 0xf3, 0xa2, 0x24, 0x36, 0x4d, 0xb2, 0x5d, 0x89, 0x79, 0xe4, 0x73, 0x03, 0x46, 0xd5,
-	0x9e, 0x4f, 0x1b, 0x28, 0x6d, 0x3f, 0xa9, 0x0d, 0x94, 0xbe, 0x81, 0x34, 0x2d, 0xc9, 0x67, 0x8e,
-	0xcc, 0xaa, 0x7c, 0x22, 0x4d, 0x65, 0xa8, 0x40, 0x7f, 0x34, 0xe0, 0x94, 0xbe, 0x0b, 0x20, 0x4b,
-	0xbd, 0xe3, 0xa0, 0x36, 0x1b, 0x99, 0xe5, 0x17, 0xd0, 0x40, 0xc2, 0xab, 0x92, 0xf0, 0x32, 0xb1,
-	0xba, 0x07, 0xd0, 0x2e, 0xb6, 0x6c, 0x59, 0x1b, 0x9d, 0x12, 0xf9, 0xd2, 0x80, 0x54, 0xac, 0x07,
-	0xd0, 0xde, 0x05, 0x49, 0x3d, 0x89, 0xf6, 0x2e, 0x48, 0x6c, 0x2b, 0x92, 0x0e, 0x63, 0x4d, 0xab,
-	0x41, 0xbe, 0x32, 0x20, 0x15, 0xbb, 0x58, 0xb5, 0xdc, 0x92, 0xee, 0x7f, 0x2d, 0xb7, 0xc4, 0x0b,
-	0xdf, 0x5c, 0x91, 0xdc, 0x16, 0xc8, 0xbc, 0xd5, 0xe3, 0xff, 0xc7, 0xbd, 0xcc, 0xaf, 0xad, 0x3f,
-	0x7e, 0x96, 0x35, 0x9e, 0x3c, 0xcb, 0x1a, 0x7f, 0x3d, 0xcb, 0x1a, 0x0f, 0x9f, 0x67, 0x07, 0x9e,
-	0x3c, 0xcf, 0x0e, 0xfc, 0xf1, 0x3c, 0x3b, 0xf0, 0xee, 0x42, 0xb9, 0x22, 0x36, 0x9b, 0xc5, 0xbc,
-	0xc3, 0x6b, 0x12, 0xcf, 0xd9, 0xa4, 0x15, 0xd7, 0x47, 0x7e, 0xb0, 0x62, 0x7d, 0xd0, 0x81, 0x17,
-	0xad, 0x3a, 0xf3, 0x8a, 0x83, 0xf2, 0x3f, 0xc2, 0xcb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x5f,
-	0x85, 0x89, 0xda, 0xd8, 0x15, 0x00, 0x00,
+func (m *QueryStreamRequest) Reset()         { *m = QueryStreamRequest{} }
+func (m *QueryStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamRequest) ProtoMessage()    {}
+func (*QueryStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21c3accf5c96eb28, []int{26}
+}
+func (m *QueryStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamRequest.Merge(m, src)
+}
+func (m *QueryStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryStreamRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ParamsByTimestamp queries the parameter of the module by timestamp.
-	ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
-	// Queries our flows by account.
-	OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error)
-	// Queries a stream record by account.
-	StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error)
-	// Queries all stream records.
-	StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error)
-	// Queries the count of payment account by owner.
-	PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error)
-	// Queries all counts of payment account for all owners.
-	PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error)
-	// Queries a payment account by payment account address.
-	PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error)
-	// Queries all payment accounts.
-	PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error)
-	// Queries dynamic balance of a payment account.
-	DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error)
-	// Queries all payment accounts by a owner.
-	PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error)
-	// Queries all auto settle records.
-	AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error)
-	// Queries delayed withdrawal of a account.
-	DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error)
+func (m *QueryStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryStreamResponse struct {
+	Stream UserStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryStreamResponse) Reset()         { *m = QueryStreamResponse{} }
+func (m *QueryStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamResponse) ProtoMessage()    {}
+func (*QueryStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21c3accf5c96eb28, []int{27}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamResponse.Merge(m, src)
+}
+func (m *QueryStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
-	out := new(QueryParamsByTimestampResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/ParamsByTimestamp", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStreamResponse proto.InternalMessageInfo
+
+func (m *QueryStreamResponse) GetStream() UserStream {
+	if m != nil {
+		return m.Stream
 	}
-	return out, nil
+	return UserStream{}
 }
 
-func (c *queryClient) OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error) {
-	out := new(QueryOutFlowsResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/OutFlows", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryStreamsBySenderRequest struct {
+	Sender     string             `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error) {
-	out := new(QueryGetStreamRecordResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/StreamRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStreamsBySenderRequest) Reset()         { *m = QueryStreamsBySenderRequest{} }
+func (m *QueryStreamsBySenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsBySenderRequest) ProtoMessage()    {}
+func (*QueryStreamsBySenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21c3accf5c96eb28, []int{28}
+}
+func (m *QueryStreamsBySenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsBySenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsBySenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryStreamsBySenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsBySenderRequest.Merge(m, src)
+}
+func (m *QueryStreamsBySenderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsBySenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsBySenderRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error) {
-	out := new(QueryStreamRecordsResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/StreamRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStreamsBySenderRequest proto.InternalMessageInfo
+
+func (m *QueryStreamsBySenderRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error) {
-	out := new(QueryPaymentAccountCountResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/PaymentAccountCount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStreamsBySenderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error) {
-	out := new(QueryPaymentAccountCountsResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/PaymentAccountCounts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryStreamsBySenderResponse struct {
+	Streams    []UserStream        `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error) {
-	out := new(QueryPaymentAccountResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/PaymentAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStreamsBySenderResponse) Reset()         { *m = QueryStreamsBySenderResponse{} }
+func (m *QueryStreamsBySenderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsBySenderResponse) ProtoMessage()    {}
+func (*QueryStreamsBySenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21c3accf5c96eb28, []int{29}
+}
+func (m *QueryStreamsBySenderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsBySenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsBySenderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryStreamsBySenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsBySenderResponse.Merge(m, src)
+}
+func (m *QueryStreamsBySenderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsBySenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsBySenderResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error) {
-	out := new(QueryPaymentAccountsResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/PaymentAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStreamsBySenderResponse proto.InternalMessageInfo
+
+func (m *QueryStreamsBySenderResponse) GetStreams() []UserStream {
+	if m != nil {
+		return m.Streams
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error) {
-	out := new(QueryDynamicBalanceResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/DynamicBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStreamsBySenderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error) {
-	out := new(QueryPaymentAccountsByOwnerResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/PaymentAccountsByOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAccountRunwayRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (c *queryClient) AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error) {
-	out := new(QueryAutoSettleRecordsResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/AutoSettleRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountRunwayRequest) Reset()         { *m = QueryAccountRunwayRequest{} }
+func (m *QueryAccountRunwayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRunwayRequest) ProtoMessage()    {}
+func (*QueryAccountRunwayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21c3accf5c96eb28, []int{30}
+}
+func (m *QueryAccountRunwayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRunwayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRunwayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountRunwayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRunwayRequest.Merge(m, src)
+}
+func (m *QueryAccountRunwayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRunwayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRunwayRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error) {
-	out := new(QueryDelayedWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/moca.payment.Query/DelayedWithdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountRunwayRequest proto.InternalMessageInfo
+
+func (m *QueryAccountRunwayRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ParamsByTimestamp queries the parameter of the module by timestamp.
-	ParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
-	// Queries our flows by account.
-	OutFlows(context.Context, *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error)
-	// Queries a stream record by account.
-	StreamRecord(context.Context, *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error)
-	// Queries all stream records.
-	StreamRecords(context.Context, *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error)
-	// Queries the count of payment account by owner.
-	PaymentAccountCount(context.Context, *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error)
-	// Queries all counts of payment account for all owners.
-	PaymentAccountCounts(context.Context, *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error)
-	// Queries a payment account by payment account address.
-	PaymentAccount(context.Context, *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error)
-	// Queries all payment accounts.
-	PaymentAccounts(context.Context, *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error)
-	// Queries dynamic balance of a payment account.
-	DynamicBalance(context.Context, *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error)
-	// Queries all payment accounts by a owner.
-	PaymentAccountsByOwner(context.Context, *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error)
-	// Queries all auto settle records.
-	AutoSettleRecords(context.Context, *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error)
-	// Queries delayed withdrawal of a account.
-	DelayedWithdrawal(context.Context, *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error)
+// QueryAccountRunwayResponse is the projection of when the stream account will be frozen for lack of balance. The
+// balance of the bank account is included as it is transferred automatically when the static balance runs out.
+type QueryAccountRunwayResponse struct {
+	// status is the status of the stream account
+	Status StreamAccountStatus `protobuf:"varint,1,opt,name=status,proto3,enum=moca.payment.StreamAccountStatus" json:"status,omitempty"`
+	// netflow_rate is the current netflow rate per second of the stream account
+	NetflowRate cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=netflow_rate,json=netflowRate,proto3,customtype=cosmossdk.io/math.Int" json:"netflow_rate"`
+	// static_balance is the dynamic balance of the stream account at the current time
+	StaticBalance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=static_balance,json=staticBalance,proto3,customtype=cosmossdk.io/math.Int" json:"static_balance"`
+	// buffer_balance is the balance reserved for the reserve time
+	BufferBalance cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=buffer_balance,json=bufferBalance,proto3,customtype=cosmossdk.io/math.Int" json:"buffer_balance"`
+	// lock_balance is the balance locked for the pending objects, it is not available to the outflows
+	LockBalance cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=lock_balance,json=lockBalance,proto3,customtype=cosmossdk.io/math.Int" json:"lock_balance"`
+	// bank_balance is the balance of the bank account which can be transferred automatically
+	BankBalance cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=bank_balance,json=bankBalance,proto3,customtype=cosmossdk.io/math.Int" json:"bank_balance"`
+	// reserve_time is the time the buffer balance is reserved for
+	ReserveTime uint64 `protobuf:"varint,7,opt,name=reserve_time,json=reserveTime,proto3" json:"reserve_time,omitempty"`
+	// forced_settle_time is the time before depletion when the account is settled and frozen
+	ForcedSettleTime uint64 `protobuf:"varint,8,opt,name=forced_settle_time,json=forcedSettleTime,proto3" json:"forced_settle_time,omitempty"`
+	// projected_netflow_rate is the netflow rate after the scheduled change of the storage price
+	ProjectedNetflowRate cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=projected_netflow_rate,json=projectedNetflowRate,proto3,customtype=cosmossdk.io/math.Int" json:"projected_netflow_rate"`
+	// price_change_time is the unix timestamp when the storage price changes, zero if no change is scheduled
+	PriceChangeTime int64 `protobuf:"varint,10,opt,name=price_change_time,json=priceChangeTime,proto3" json:"price_change_time,omitempty"`
+	// depletion_timestamp is the unix timestamp when the account is projected to be frozen, zero if it never is
+	DepletionTimestamp int64 `protobuf:"varint,11,opt,name=depletion_timestamp,json=depletionTimestamp,proto3" json:"depletion_timestamp,omitempty"`
+	// runway_seconds is the time left before depletion_timestamp
+	RunwaySeconds int64 `protobuf:"varint,12,opt,name=runway_seconds,json=runwaySeconds,proto3" json:"runway_seconds,omitempty"`
+	// alert_threshold_seconds is the runway threshold set by the account, zero if not set
+	AlertThresholdSeconds uint64 `protobuf:"varint,13,opt,name=alert_threshold_seconds,json=alertThresholdSeconds,proto3" json:"alert_threshold_seconds,omitempty"`
+}
+
+func (m *QueryAccountRunwayResponse) Reset()         { *m = QueryAccountRunwayResponse{} }
+func (m *QueryAccountRunwayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRunwayResponse) ProtoMessage()    {}
+func (*QueryAccountRunwayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21c3accf5c96eb28, []int{31}
+}
+func (m *QueryAccountRunwayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRunwayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRunwayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountRunwayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRunwayResponse.Merge(m, src)
+}
+func (m *QueryAccountRunwayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRunwayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRunwayResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryAccountRunwayResponse proto.InternalMessageInfo
+
+func (m *QueryAccountRunwayResponse) GetStatus() StreamAccountStatus {
+	if m != nil {
+		return m.Status
+	}
+	return STREAM_ACCOUNT_STATUS_ACTIVE
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAccountRunwayResponse) GetReserveTime() uint64 {
+	if m != nil {
+		return m.ReserveTime
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsByTimestamp not implemented")
+
+func (m *QueryAccountRunwayResponse) GetForcedSettleTime() uint64 {
+	if m != nil {
+		return m.ForcedSettleTime
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) OutFlows(ctx context.Context, req *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutFlows not implemented")
+
+func (m *QueryAccountRunwayResponse) GetPriceChangeTime() int64 {
+	if m != nil {
+		return m.PriceChangeTime
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) StreamRecord(ctx context.Context, req *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StreamRecord not implemented")
+
+func (m *QueryAccountRunwayResponse) GetDepletionTimestamp() int64 {
+	if m != nil {
+		return m.DepletionTimestamp
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) StreamRecords(ctx context.Context, req *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StreamRecords not implemented")
+
+func (m *QueryAccountRunwayResponse) GetRunwaySeconds() int64 {
+	if m != nil {
+		return m.RunwaySeconds
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) PaymentAccountCount(ctx context.Context, req *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCount not implemented")
+
+func (m *QueryAccountRunwayResponse) GetAlertThresholdSeconds() uint64 {
+	if m != nil {
+		return m.AlertThresholdSeconds
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) PaymentAccountCounts(ctx context.Context, req *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCounts not implemented")
+
+type QueryAutoDepositAllowanceRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
-func (*UnimplementedQueryServer) PaymentAccount(ctx context.Context, req *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccount not implemented")
+
+func (m *QueryAutoDepositAllowanceRequest) Reset()         { *m = QueryAutoDepositAllowanceRequest{} }
+func (m *QueryAutoDepositAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoDepositAllowanceRequest) ProtoMessage()    {}
+func (*QueryAutoDepositAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21c3accf5c96eb28, []int{32}
 }
-func (*UnimplementedQueryServer) PaymentAccounts(ctx context.Context, req *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccounts not implemented")
+func (m *QueryAutoDepositAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DynamicBalance(ctx context.Context, req *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DynamicBalance not implemented")
+func (m *QueryAutoDepositAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoDepositAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PaymentAccountsByOwner(ctx context.Context, req *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountsByOwner not implemented")
+func (m *QueryAutoDepositAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoDepositAllowanceRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AutoSettleRecords(ctx context.Context, req *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AutoSettleRecords not implemented")
+func (m *QueryAutoDepositAllowanceRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DelayedWithdrawal(ctx context.Context, req *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelayedWithdrawal not implemented")
+func (m *QueryAutoDepositAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoDepositAllowanceRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAutoDepositAllowanceRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAutoDepositAllowanceRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByTimestampRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsByTimestamp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/ParamsByTimestamp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAutoDepositAllowanceResponse struct {
+	Allowance AutoDepositAllowance `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance"`
 }
 
-func _Query_OutFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutFlowsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutFlows(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/OutFlows",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutFlows(ctx, req.(*QueryOutFlowsRequest))
+func (m *QueryAutoDepositAllowanceResponse) Reset()         { *m = QueryAutoDepositAllowanceResponse{} }
+func (m *QueryAutoDepositAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoDepositAllowanceResponse) ProtoMessage()    {}
+func (*QueryAutoDepositAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21c3accf5c96eb28, []int{33}
+}
+func (m *QueryAutoDepositAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoDepositAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoDepositAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAutoDepositAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoDepositAllowanceResponse.Merge(m, src)
+}
+func (m *QueryAutoDepositAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoDepositAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoDepositAllowanceResponse.DiscardUnknown(m)
 }
 
-func _Query_StreamRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStreamRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StreamRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/StreamRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StreamRecord(ctx, req.(*QueryGetStreamRecordRequest))
+var xxx_messageInfo_QueryAutoDepositAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryAutoDepositAllowanceResponse) GetAllowance() AutoDepositAllowance {
+	if m != nil {
+		return m.Allowance
 	}
-	return interceptor(ctx, in, info, handler)
+	return AutoDepositAllowance{}
 }
 
-func _Query_StreamRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStreamRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StreamRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/StreamRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StreamRecords(ctx, req.(*QueryStreamRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAutoDepositRecordsRequest struct {
+	Account    string             `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PaymentAccountCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccountCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/PaymentAccountCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccountCount(ctx, req.(*QueryPaymentAccountCountRequest))
+func (m *QueryAutoDepositRecordsRequest) Reset()         { *m = QueryAutoDepositRecordsRequest{} }
+func (m *QueryAutoDepositRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoDepositRecordsRequest) ProtoMessage()    {}
+func (*QueryAutoDepositRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21c3accf5c96eb28, []int{34}
+}
+func (m *QueryAutoDepositRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoDepositRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoDepositRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAutoDepositRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoDepositRecordsRequest.Merge(m, src)
+}
+func (m *QueryAutoDepositRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoDepositRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoDepositRecordsRequest.DiscardUnknown(m)
 }
 
-func _Query_PaymentAccountCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountCountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccountCounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/PaymentAccountCounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccountCounts(ctx, req.(*QueryPaymentAccountCountsRequest))
+var xxx_messageInfo_QueryAutoDepositRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryAutoDepositRecordsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_PaymentAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryAutoDepositRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccount(ctx, in)
+	return nil
+}
+
+type QueryAutoDepositRecordsResponse struct {
+	Records    []AutoDepositRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoDepositRecordsResponse) Reset()         { *m = QueryAutoDepositRecordsResponse{} }
+func (m *QueryAutoDepositRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoDepositRecordsResponse) ProtoMessage()    {}
+func (*QueryAutoDepositRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21c3accf5c96eb28, []int{35}
+}
+func (m *QueryAutoDepositRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoDepositRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoDepositRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/PaymentAccount",
+}
+func (m *QueryAutoDepositRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoDepositRecordsResponse.Merge(m, src)
+}
+func (m *QueryAutoDepositRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoDepositRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoDepositRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoDepositRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryAutoDepositRecordsResponse) GetRecords() []AutoDepositRecord {
+	if m != nil {
+		return m.Records
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccount(ctx, req.(*QueryPaymentAccountRequest))
+	return nil
+}
+
+func (m *QueryAutoDepositRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PaymentAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountsRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "moca.payment.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "moca.payment.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "moca.payment.QueryParamsByTimestampRequest")
+	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "moca.payment.QueryParamsByTimestampResponse")
+	proto.RegisterType((*QueryOutFlowsRequest)(nil), "moca.payment.QueryOutFlowsRequest")
+	proto.RegisterType((*QueryOutFlowsResponse)(nil), "moca.payment.QueryOutFlowsResponse")
+	proto.RegisterType((*QueryGetStreamRecordRequest)(nil), "moca.payment.QueryGetStreamRecordRequest")
+	proto.RegisterType((*QueryGetStreamRecordResponse)(nil), "moca.payment.QueryGetStreamRecordResponse")
+	proto.RegisterType((*QueryStreamRecordsRequest)(nil), "moca.payment.QueryStreamRecordsRequest")
+	proto.RegisterType((*QueryStreamRecordsResponse)(nil), "moca.payment.QueryStreamRecordsResponse")
+	proto.RegisterType((*QueryPaymentAccountCountRequest)(nil), "moca.payment.QueryPaymentAccountCountRequest")
+	proto.RegisterType((*QueryPaymentAccountCountResponse)(nil), "moca.payment.QueryPaymentAccountCountResponse")
+	proto.RegisterType((*QueryPaymentAccountCountsRequest)(nil), "moca.payment.QueryPaymentAccountCountsRequest")
+	proto.RegisterType((*QueryPaymentAccountCountsResponse)(nil), "moca.payment.QueryPaymentAccountCountsResponse")
+	proto.RegisterType((*QueryPaymentAccountRequest)(nil), "moca.payment.QueryPaymentAccountRequest")
+	proto.RegisterType((*QueryPaymentAccountResponse)(nil), "moca.payment.QueryPaymentAccountResponse")
+	proto.RegisterType((*QueryPaymentAccountsRequest)(nil), "moca.payment.QueryPaymentAccountsRequest")
+	proto.RegisterType((*QueryPaymentAccountsResponse)(nil), "moca.payment.QueryPaymentAccountsResponse")
+	proto.RegisterType((*QueryDynamicBalanceRequest)(nil), "moca.payment.QueryDynamicBalanceRequest")
+	proto.RegisterType((*QueryDynamicBalanceResponse)(nil), "moca.payment.QueryDynamicBalanceResponse")
+	proto.RegisterType((*QueryPaymentAccountsByOwnerRequest)(nil), "moca.payment.QueryPaymentAccountsByOwnerRequest")
+	proto.RegisterType((*QueryPaymentAccountsByOwnerResponse)(nil), "moca.payment.QueryPaymentAccountsByOwnerResponse")
+	proto.RegisterType((*QueryAutoSettleRecordsRequest)(nil), "moca.payment.QueryAutoSettleRecordsRequest")
+	proto.RegisterType((*QueryAutoSettleRecordsResponse)(nil), "moca.payment.QueryAutoSettleRecordsResponse")
+	proto.RegisterType((*QueryDelayedWithdrawalRequest)(nil), "moca.payment.QueryDelayedWithdrawalRequest")
+	proto.RegisterType((*QueryDelayedWithdrawalResponse)(nil), "moca.payment.QueryDelayedWithdrawalResponse")
+	proto.RegisterType((*QueryStreamRequest)(nil), "moca.payment.QueryStreamRequest")
+	proto.RegisterType((*QueryStreamResponse)(nil), "moca.payment.QueryStreamResponse")
+	proto.RegisterType((*QueryStreamsBySenderRequest)(nil), "moca.payment.QueryStreamsBySenderRequest")
+	proto.RegisterType((*QueryStreamsBySenderResponse)(nil), "moca.payment.QueryStreamsBySenderResponse")
+	proto.RegisterType((*QueryAccountRunwayRequest)(nil), "moca.payment.QueryAccountRunwayRequest")
+	proto.RegisterType((*QueryAccountRunwayResponse)(nil), "moca.payment.QueryAccountRunwayResponse")
+	proto.RegisterType((*QueryAutoDepositAllowanceRequest)(nil), "moca.payment.QueryAutoDepositAllowanceRequest")
+	proto.RegisterType((*QueryAutoDepositAllowanceResponse)(nil), "moca.payment.QueryAutoDepositAllowanceResponse")
+	proto.RegisterType((*QueryAutoDepositRecordsRequest)(nil), "moca.payment.QueryAutoDepositRecordsRequest")
+	proto.RegisterType((*QueryAutoDepositRecordsResponse)(nil), "moca.payment.QueryAutoDepositRecordsResponse")
+}
+
+func init() { proto.RegisterFile("moca/payment/query.proto", fileDescriptor_21c3accf5c96eb28) }
+
+var fileDescriptor_21c3accf5c96eb28 = []byte{
+	// 2049 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdd, 0x6f, 0x1b, 0x59,
+	0x15, 0xef, 0xf4, 0xc3, 0xad, 0x4f, 0xbe, 0x9a, 0x1b, 0x27, 0x64, 0x27, 0xc1, 0x49, 0xa6, 0xdb,
+	0xd6, 0x49, 0x1d, 0x4f, 0x92, 0x85, 0x56, 0x0b, 0xec, 0x43, 0xb3, 0x55, 0x56, 0x05, 0xb4, 0xcd,
+	0x3a, 0x0b, 0xd5, 0x22, 0xa1, 0xe1, 0xda, 0x73, 0xe3, 0xb8, 0xb5, 0x67, 0xbc, 0x33, 0xe3, 0x06,
+	0x2b, 0x0a, 0x12, 0x2b, 0x1e, 0x78, 0x5c, 0xb1, 0x08, 0x01, 0x8f, 0x48, 0x7c, 0x3d, 0xb1, 0x12,
+	0x88, 0x17, 0x5e, 0x79, 0x28, 0x6f, 0x15, 0xbc, 0x20, 0x1e, 0x56, 0xa8, 0x45, 0xe2, 0xaf, 0x40,
+	0x42, 0x73, 0xef, 0x19, 0x67, 0xee, 0xf8, 0x7a, 0xec, 0xba, 0xde, 0x97, 0x24, 0x73, 0xef, 0xef,
+	0x9c, 0xf3, 0x3b, 0xf7, 0xe3, 0x9c, 0x7b, 0x4e, 0x60, 0xb1, 0xe9, 0x56, 0xa9, 0xd9, 0xa2, 0x9d,
+	0x26, 0x73, 0x02, 0xf3, 0xc3, 0x36, 0xf3, 0x3a, 0xa5, 0x96, 0xe7, 0x06, 0x2e, 0x99, 0x0c, 0x67,
+	0x4a, 0x38, 0xa3, 0xcf, 0xd2, 0x66, 0xdd, 0x71, 0x4d, 0xfe, 0x53, 0x00, 0xf4, 0x8d, 0xaa, 0xeb,
+	0x37, 0x5d, 0xdf, 0xac, 0x50, 0x9f, 0x09, 0x49, 0xf3, 0xc9, 0x76, 0x85, 0x05, 0x74, 0xdb, 0x6c,
+	0xd1, 0x5a, 0xdd, 0xa1, 0x41, 0xdd, 0x75, 0x10, 0xfb, 0x9a, 0xc0, 0x5a, 0xfc, 0xcb, 0x14, 0x1f,
+	0x38, 0x95, 0xab, 0xb9, 0x35, 0x57, 0x8c, 0x87, 0x7f, 0xe1, 0xe8, 0x72, 0xcd, 0x75, 0x6b, 0x0d,
+	0x66, 0xd2, 0x56, 0xdd, 0xa4, 0x8e, 0xe3, 0x06, 0x5c, 0x5b, 0x24, 0xb3, 0x22, 0xb1, 0xa6, 0xed,
+	0xc0, 0xb5, 0x6c, 0xd6, 0x72, 0xfd, 0x7a, 0x80, 0x80, 0xeb, 0xbd, 0x00, 0x9f, 0x05, 0x41, 0x83,
+	0x59, 0x1e, 0xab, 0xba, 0x9e, 0x8d, 0xb0, 0xa2, 0x04, 0xb3, 0x59, 0x83, 0x76, 0x98, 0x6d, 0x1d,
+	0xd7, 0x83, 0x23, 0xdb, 0xa3, 0xc7, 0xb4, 0x21, 0xa3, 0x97, 0x24, 0xb4, 0xdb, 0x0e, 0xac, 0xc3,
+	0x86, 0x7b, 0x1c, 0x79, 0x28, 0x4d, 0xb6, 0xa8, 0x47, 0x9b, 0x11, 0x5b, 0x23, 0x31, 0xc5, 0x7f,
+	0x5b, 0xb4, 0x5a, 0x75, 0xdb, 0x4e, 0x44, 0xb8, 0x90, 0x86, 0xb1, 0xe2, 0xc8, 0x55, 0x09, 0xe9,
+	0x07, 0x1e, 0xa3, 0x4d, 0x99, 0x67, 0x5e, 0x42, 0xb4, 0x7d, 0xe6, 0x59, 0x02, 0x26, 0xe6, 0x8d,
+	0x1c, 0x90, 0xf7, 0xc2, 0xed, 0xda, 0xe7, 0x24, 0xcb, 0xec, 0xc3, 0x36, 0xf3, 0x03, 0xe3, 0x5d,
+	0x98, 0x93, 0x46, 0xfd, 0x96, 0xeb, 0xf8, 0x8c, 0xdc, 0x81, 0x8c, 0x70, 0x66, 0x51, 0x5b, 0xd5,
+	0x0a, 0x13, 0x3b, 0xb9, 0x52, 0xfc, 0x5c, 0x94, 0x04, 0x7a, 0x37, 0xfb, 0xf4, 0xb3, 0x95, 0x73,
+	0xbf, 0xfb, 0xef, 0xa7, 0x1b, 0x5a, 0x19, 0xe1, 0xc6, 0x5b, 0xf0, 0xc5, 0x98, 0xbe, 0xdd, 0xce,
+	0xfb, 0xf5, 0x26, 0xf3, 0x03, 0xda, 0x6c, 0xa1, 0x41, 0xb2, 0x0c, 0xd9, 0x20, 0x1a, 0xe3, 0xca,
+	0x2f, 0x94, 0xcf, 0x06, 0x8c, 0x0f, 0x20, 0xdf, 0x4f, 0xfc, 0x55, 0x99, 0x6d, 0x41, 0x8e, 0xab,
+	0x7e, 0xd0, 0x0e, 0xf6, 0x1a, 0xee, 0x71, 0xb4, 0x02, 0x64, 0x11, 0x2e, 0xe3, 0x82, 0x73, 0x8d,
+	0xd9, 0x72, 0xf4, 0x69, 0x7c, 0x1b, 0xe6, 0x13, 0x12, 0xc8, 0xe1, 0x2d, 0xc8, 0x46, 0xe7, 0x20,
+	0xa4, 0x71, 0xa1, 0x30, 0xb1, 0x33, 0x2f, 0xd3, 0x40, 0x91, 0x38, 0x8f, 0x2b, 0x2e, 0xaa, 0x31,
+	0xee, 0xc0, 0x12, 0xd7, 0xfb, 0x0e, 0x0b, 0x0e, 0xf8, 0x0e, 0x95, 0xf9, 0x3e, 0x0e, 0x26, 0xf4,
+	0x08, 0x96, 0xd5, 0x82, 0xc8, 0xeb, 0xeb, 0x30, 0x25, 0x9d, 0x0c, 0x5c, 0x22, 0x5d, 0xe6, 0x16,
+	0x17, 0x8d, 0x13, 0x9c, 0xf4, 0x63, 0x13, 0x46, 0x15, 0x5e, 0xe3, 0xb6, 0xe2, 0xe8, 0xee, 0x9a,
+	0xed, 0x01, 0x9c, 0x5d, 0x76, 0xb4, 0x72, 0xa3, 0x84, 0x17, 0x3c, 0x8c, 0x0c, 0x25, 0x11, 0x53,
+	0x30, 0x32, 0x94, 0xf6, 0x69, 0x8d, 0xa1, 0x6c, 0x39, 0x26, 0x69, 0xfc, 0x51, 0x03, 0x5d, 0x65,
+	0x05, 0xfd, 0xf9, 0x26, 0x4c, 0x4b, 0xfe, 0x44, 0x8b, 0x3d, 0xa4, 0x43, 0x53, 0x71, 0x87, 0x7c,
+	0xf2, 0x8e, 0x44, 0xfa, 0x3c, 0x27, 0x7d, 0x73, 0x20, 0x69, 0x41, 0x45, 0x62, 0x7d, 0x07, 0x56,
+	0xf0, 0x90, 0x72, 0xfb, 0x77, 0xc5, 0xee, 0xbc, 0x1d, 0xfe, 0x88, 0x16, 0x28, 0x07, 0x97, 0xdc,
+	0x63, 0x87, 0x79, 0xb8, 0x83, 0xe2, 0xc3, 0xf8, 0x91, 0x06, 0xab, 0xfd, 0x25, 0xd1, 0xe9, 0xef,
+	0xc1, 0xbc, 0x32, 0x10, 0xe0, 0x32, 0xaf, 0x25, 0xcf, 0x7b, 0x8f, 0xa6, 0xf8, 0x12, 0xcc, 0xb5,
+	0x7a, 0xe7, 0x8d, 0x47, 0xfd, 0x59, 0x8c, 0x7d, 0x87, 0x9f, 0x69, 0xb0, 0x96, 0x62, 0x0c, 0x7d,
+	0xae, 0xc0, 0x82, 0xd2, 0xe7, 0x68, 0xc3, 0x5f, 0xce, 0xe9, 0x9c, 0xc2, 0xe9, 0x31, 0x6e, 0xff,
+	0x16, 0x9e, 0x59, 0x99, 0x45, 0xb4, 0x70, 0x04, 0x2e, 0x52, 0xdb, 0x8e, 0x36, 0x9e, 0xff, 0x6d,
+	0xb8, 0xb0, 0xa4, 0x94, 0x40, 0xef, 0xf7, 0x61, 0x26, 0xe1, 0x3d, 0x2e, 0xf8, 0x72, 0x9a, 0xdb,
+	0x71, 0x8f, 0xa7, 0x65, 0x8f, 0x0d, 0xa6, 0x34, 0x38, 0xf6, 0xcd, 0xfd, 0x8b, 0x06, 0xcb, 0x6a,
+	0x3b, 0xe8, 0x59, 0x19, 0xae, 0x26, 0x3c, 0x8b, 0x76, 0x74, 0x68, 0xd7, 0x66, 0x64, 0xd7, 0xc6,
+	0xb8, 0x8f, 0xb7, 0x71, 0x1f, 0xef, 0x75, 0x1c, 0xda, 0xac, 0x57, 0x77, 0x69, 0x83, 0x3a, 0x55,
+	0x36, 0x38, 0x0a, 0xff, 0xed, 0x22, 0x2c, 0x29, 0x05, 0xd1, 0xe9, 0x0f, 0x60, 0xc6, 0x16, 0x33,
+	0x56, 0x45, 0x4c, 0x09, 0x0d, 0xbb, 0x5b, 0xa1, 0x57, 0xff, 0xfa, 0x6c, 0x65, 0x5e, 0x90, 0xf5,
+	0xed, 0xc7, 0xa5, 0xba, 0x6b, 0x36, 0x69, 0x70, 0x54, 0xba, 0xef, 0x04, 0x7f, 0xff, 0xd3, 0x26,
+	0xa0, 0x17, 0xf7, 0x9d, 0x00, 0xf7, 0xd5, 0x96, 0x4c, 0xf4, 0x06, 0xf8, 0xf3, 0x23, 0x07, 0x78,
+	0x72, 0x0b, 0x66, 0xab, 0x6d, 0xcf, 0x0b, 0xf7, 0xe6, 0x2c, 0x21, 0x5f, 0xe0, 0x09, 0xf9, 0x2a,
+	0x4e, 0x74, 0xb3, 0x2f, 0x39, 0x80, 0xc9, 0x0a, 0x75, 0x1e, 0x77, 0x1d, 0xba, 0x38, 0xa2, 0x43,
+	0x13, 0xa1, 0x96, 0xc8, 0x9b, 0xef, 0xc2, 0x2c, 0x7d, 0x42, 0xeb, 0x0d, 0x5a, 0x69, 0xb0, 0xae,
+	0xe6, 0x4b, 0x23, 0x6a, 0xbe, 0xda, 0x55, 0x15, 0xa9, 0x7f, 0x00, 0xd0, 0x70, 0xab, 0x8f, 0x99,
+	0x6d, 0x1d, 0x32, 0xb6, 0x98, 0x19, 0x51, 0x6f, 0x56, 0xe8, 0xd8, 0x63, 0x8c, 0xbc, 0x07, 0x13,
+	0xd5, 0x23, 0xea, 0xd4, 0x98, 0xe5, 0xd1, 0x80, 0x2d, 0x5e, 0x1e, 0x51, 0x23, 0x08, 0x25, 0x65,
+	0x1a, 0x30, 0xe3, 0x2b, 0x60, 0xa8, 0x2e, 0xd0, 0x6e, 0xe7, 0x41, 0x98, 0x30, 0xd2, 0xb3, 0xc9,
+	0x03, 0xb8, 0x96, 0x2a, 0x8b, 0xc7, 0xb1, 0x00, 0xc9, 0x2b, 0xc4, 0xaf, 0x60, 0xb6, 0xe7, 0x66,
+	0x19, 0x35, 0x7c, 0xbb, 0xdd, 0x6d, 0x07, 0xee, 0x01, 0x7f, 0x37, 0x7f, 0x4e, 0x69, 0xff, 0xaf,
+	0x1a, 0xe4, 0xfb, 0x59, 0xea, 0x5e, 0xa2, 0xb9, 0xde, 0xf7, 0x7b, 0x14, 0x3c, 0xf2, 0xf2, 0x79,
+	0x4f, 0x6a, 0x89, 0x9f, 0xf9, 0x59, 0x9a, 0x34, 0x31, 0xbe, 0x00, 0xf2, 0x26, 0xae, 0xd7, 0x3d,
+	0x51, 0x41, 0x3c, 0xec, 0x16, 0x10, 0x83, 0x63, 0xc8, 0x0f, 0xa3, 0x15, 0x50, 0xc8, 0xe2, 0x0a,
+	0x58, 0x40, 0x7a, 0x4b, 0x13, 0x5c, 0xf4, 0xeb, 0xf2, 0x02, 0x28, 0x94, 0xf4, 0xac, 0x83, 0x9d,
+	0xc4, 0x18, 0xdb, 0x58, 0x10, 0x44, 0xe1, 0x42, 0x70, 0x5e, 0x82, 0x2c, 0x86, 0x98, 0xba, 0x78,
+	0x3f, 0x5e, 0x2c, 0x5f, 0x11, 0x03, 0xf7, 0x6d, 0xa3, 0x0c, 0x73, 0x92, 0x08, 0x52, 0xfd, 0x2a,
+	0x64, 0x04, 0x04, 0xe9, 0x2d, 0xca, 0xf4, 0xbe, 0xe5, 0x33, 0x4f, 0x48, 0x48, 0xef, 0x72, 0x21,
+	0x62, 0x9c, 0x62, 0x34, 0x15, 0x08, 0x7f, 0xb7, 0x73, 0xc0, 0x1c, 0xfb, 0xec, 0xec, 0x2f, 0x40,
+	0xc6, 0xe7, 0x03, 0xb8, 0x84, 0xf8, 0x45, 0xf6, 0x14, 0xbb, 0x38, 0xca, 0x59, 0xfc, 0x4d, 0x94,
+	0xc3, 0x7a, 0xec, 0x77, 0x1f, 0xfb, 0x97, 0x05, 0xd3, 0xe8, 0xf4, 0x0d, 0xe5, 0x5d, 0x24, 0x33,
+	0xbe, 0xd3, 0xf6, 0x65, 0x7c, 0x90, 0x47, 0xaf, 0x87, 0xb6, 0x73, 0x4c, 0x3b, 0x83, 0x4f, 0xda,
+	0xff, 0x32, 0xa0, 0xab, 0xe4, 0xd0, 0xbb, 0x37, 0xc3, 0xad, 0xa3, 0x41, 0x5b, 0x94, 0x53, 0xd3,
+	0xc9, 0x97, 0x96, 0x70, 0x0c, 0x45, 0x0f, 0x38, 0xb0, 0x8c, 0x02, 0x61, 0x4e, 0x70, 0x58, 0x10,
+	0x16, 0x41, 0x22, 0x1e, 0x9e, 0x1f, 0x35, 0x27, 0xa0, 0x96, 0x30, 0x20, 0x92, 0x87, 0xe1, 0x93,
+	0x9f, 0x06, 0xb1, 0xdc, 0x79, 0x61, 0x44, 0xb5, 0x53, 0x42, 0x4f, 0x94, 0x0d, 0x1e, 0xc2, 0x74,
+	0xa5, 0x7d, 0x78, 0xc8, 0xbc, 0x57, 0xce, 0x61, 0x53, 0x42, 0x4f, 0xa4, 0xf8, 0x00, 0x26, 0xc3,
+	0x14, 0xf1, 0xca, 0x09, 0x6c, 0x22, 0xd4, 0x12, 0x53, 0x2a, 0xe5, 0xdb, 0xcc, 0x38, 0xf2, 0xed,
+	0x1a, 0x4c, 0x7a, 0xcc, 0x67, 0xde, 0x13, 0xc6, 0x33, 0x3e, 0x4f, 0x60, 0x17, 0xcb, 0x13, 0x38,
+	0x16, 0x26, 0x7b, 0x52, 0x04, 0x72, 0xe8, 0x7a, 0x55, 0x66, 0x47, 0x81, 0x97, 0x03, 0xaf, 0x70,
+	0xe0, 0x55, 0x31, 0x23, 0x82, 0x29, 0x47, 0x1f, 0xc2, 0x42, 0xcb, 0x73, 0x1f, 0xb1, 0x6a, 0xc0,
+	0x6c, 0x4b, 0x3a, 0x0b, 0xd9, 0x11, 0xf9, 0xe6, 0xba, 0xfa, 0xde, 0x8d, 0x1d, 0x8a, 0x0d, 0x98,
+	0x6d, 0x79, 0xf5, 0x2a, 0xb3, 0x30, 0xfd, 0x72, 0x52, 0xc0, 0x9f, 0x2a, 0x33, 0x7c, 0xe2, 0x6d,
+	0x3e, 0xce, 0x39, 0x99, 0x30, 0x67, 0xb3, 0x56, 0x83, 0x85, 0x77, 0x26, 0xf6, 0xb0, 0x99, 0xe0,
+	0x68, 0xd2, 0x9d, 0x3a, 0x7b, 0xda, 0x5c, 0x87, 0x69, 0x8f, 0xdf, 0x09, 0xcb, 0x67, 0x55, 0xd7,
+	0xb1, 0xfd, 0xc5, 0x49, 0x8e, 0x9d, 0x12, 0xa3, 0x07, 0x62, 0x90, 0xdc, 0x86, 0x2f, 0xd0, 0x06,
+	0xf3, 0x02, 0x2b, 0x38, 0xf2, 0x98, 0x7f, 0xe4, 0x36, 0xec, 0x2e, 0x7e, 0x8a, 0x2f, 0xcf, 0x3c,
+	0x9f, 0x7e, 0x3f, 0x9a, 0x45, 0x39, 0xe3, 0x6b, 0x58, 0x6c, 0x85, 0x49, 0xea, 0x9e, 0xe8, 0x56,
+	0xdd, 0x6d, 0x34, 0xdc, 0xe3, 0xe1, 0xde, 0x9a, 0x2d, 0x58, 0x4b, 0x91, 0xc6, 0x3b, 0xfc, 0x0d,
+	0xc8, 0xd2, 0x68, 0x10, 0x23, 0xb0, 0xd1, 0x9b, 0x21, 0x93, 0xe2, 0xf1, 0x68, 0x75, 0x26, 0x6f,
+	0x7c, 0x14, 0xcf, 0xcd, 0x28, 0x93, 0x78, 0x06, 0xf4, 0xa5, 0x3b, 0xb6, 0xa0, 0xfc, 0xa9, 0x06,
+	0x2b, 0x7d, 0x49, 0xa0, 0xd7, 0xf7, 0xe0, 0xb2, 0xfc, 0x2a, 0x58, 0xe9, 0xeb, 0x73, 0x6f, 0x3a,
+	0x8c, 0x44, 0xc7, 0x16, 0x9e, 0x77, 0x9e, 0xce, 0xc3, 0x25, 0x4e, 0x99, 0x3c, 0x86, 0x8c, 0xe8,
+	0x42, 0x91, 0x55, 0x99, 0x51, 0x6f, 0xfb, 0x4d, 0x5f, 0x4b, 0x41, 0x08, 0x23, 0xc6, 0xf2, 0x47,
+	0xff, 0xf8, 0xcf, 0x27, 0xe7, 0x17, 0x48, 0xce, 0x54, 0xf4, 0x1a, 0xc9, 0xcf, 0x35, 0x98, 0xed,
+	0x69, 0x96, 0x91, 0x5b, 0x7d, 0xd5, 0xf6, 0x76, 0xe4, 0xf4, 0xe2, 0x70, 0x60, 0xa4, 0x53, 0xe0,
+	0x74, 0x0c, 0xb2, 0xaa, 0xa2, 0x63, 0x9e, 0x74, 0x6f, 0xdc, 0x29, 0xf9, 0x01, 0x5c, 0x89, 0x3a,
+	0x67, 0xc4, 0x50, 0xd8, 0x48, 0x34, 0xe2, 0xf4, 0x6b, 0xa9, 0x18, 0x34, 0xbf, 0xce, 0xcd, 0x5f,
+	0x23, 0x6b, 0xa6, 0xb2, 0x2d, 0xeb, 0x9b, 0x27, 0x78, 0x16, 0x4f, 0xc9, 0x4f, 0x35, 0x98, 0x8c,
+	0x97, 0x42, 0x64, 0x5d, 0x61, 0x40, 0xdd, 0x83, 0xd3, 0x37, 0x86, 0x81, 0x22, 0xa5, 0x4d, 0x4e,
+	0xe9, 0x26, 0xb9, 0x6e, 0xf6, 0xef, 0xd1, 0xc6, 0x68, 0xfd, 0x58, 0x83, 0xa9, 0x03, 0xa9, 0x31,
+	0x75, 0x53, 0x61, 0x4c, 0xd5, 0x76, 0xd3, 0x0b, 0x83, 0x81, 0xc8, 0xe9, 0x75, 0xce, 0x29, 0x4f,
+	0x96, 0x53, 0x38, 0xf9, 0xe4, 0xf7, 0x1a, 0xcc, 0x29, 0x7a, 0x29, 0x64, 0x53, 0x79, 0x22, 0xfa,
+	0x35, 0xbb, 0xf4, 0xd2, 0xb0, 0x70, 0x24, 0xf7, 0x06, 0x27, 0xb7, 0x49, 0x6e, 0x99, 0x83, 0xdb,
+	0xdf, 0xe6, 0x09, 0x2f, 0x76, 0x4e, 0xc9, 0xaf, 0x35, 0xc8, 0xed, 0xab, 0xfa, 0x3a, 0x43, 0x5a,
+	0xef, 0x2e, 0xa2, 0x39, 0x34, 0x1e, 0xe9, 0x16, 0x39, 0xdd, 0x1b, 0xe4, 0xf5, 0x21, 0xe8, 0xfa,
+	0xe4, 0x13, 0x0d, 0xa6, 0x65, 0x75, 0xa4, 0x30, 0xd0, 0x62, 0xc4, 0x6d, 0x7d, 0x08, 0xe4, 0x4b,
+	0xb1, 0x32, 0x4f, 0xc2, 0x06, 0xd4, 0x29, 0xf9, 0x58, 0x83, 0x99, 0xfd, 0x44, 0x23, 0x65, 0xb0,
+	0x31, 0x3f, 0xed, 0x3a, 0xf4, 0xe9, 0xf9, 0x18, 0x37, 0x38, 0xb1, 0x55, 0x92, 0x4f, 0x25, 0xe6,
+	0x93, 0x9f, 0x69, 0x30, 0x2d, 0x77, 0x50, 0x94, 0x0b, 0xa5, 0xec, 0xce, 0xe8, 0xeb, 0x43, 0x20,
+	0x91, 0x8f, 0xc9, 0xf9, 0xac, 0x93, 0x9b, 0x32, 0x9f, 0x44, 0x8b, 0x26, 0x76, 0x41, 0xff, 0xac,
+	0xc1, 0x82, 0xba, 0xa6, 0x26, 0x5b, 0x83, 0xd7, 0x41, 0x2e, 0xdd, 0xf5, 0xed, 0x97, 0x90, 0x40,
+	0xc2, 0x77, 0x38, 0xe1, 0x6d, 0x62, 0xa6, 0x2f, 0xa0, 0x55, 0xe9, 0x58, 0xfc, 0x6e, 0x74, 0xaf,
+	0xc8, 0x2f, 0x34, 0x98, 0xed, 0xa9, 0xa8, 0x95, 0xb9, 0xa0, 0x5f, 0x85, 0xaf, 0x17, 0x87, 0x03,
+	0xa7, 0x07, 0x63, 0x45, 0xe1, 0x4e, 0x7e, 0xa5, 0xc1, 0x6c, 0x4f, 0x99, 0xaa, 0xe4, 0xd6, 0xaf,
+	0x9a, 0xd6, 0x8b, 0xc3, 0x81, 0x91, 0xdb, 0x0e, 0xe7, 0x56, 0x24, 0x1b, 0xe6, 0x80, 0xff, 0xf6,
+	0xc5, 0x76, 0xfe, 0x18, 0x32, 0x22, 0x9c, 0x2a, 0x33, 0xb7, 0x54, 0x27, 0xeb, 0x6b, 0x29, 0x88,
+	0xf4, 0x54, 0x29, 0x82, 0xb0, 0x79, 0xd2, 0x2d, 0xb3, 0x4f, 0xc9, 0x2f, 0x35, 0x98, 0x49, 0xd4,
+	0x9f, 0xca, 0xeb, 0xa9, 0xae, 0x91, 0xf5, 0x8d, 0x61, 0xa0, 0x48, 0x6a, 0x8b, 0x93, 0xda, 0x20,
+	0x05, 0x15, 0x29, 0x7e, 0xa8, 0x44, 0x81, 0x6d, 0x9e, 0x88, 0xdf, 0xa7, 0xe4, 0x27, 0x1a, 0x4c,
+	0x49, 0xc5, 0xa3, 0x32, 0x61, 0xa9, 0xca, 0x52, 0xbd, 0x30, 0x18, 0x88, 0xb4, 0x4a, 0x9c, 0x56,
+	0x81, 0xdc, 0x48, 0x1c, 0x25, 0x0c, 0xae, 0xe2, 0x2d, 0x1e, 0xdb, 0xaa, 0x3f, 0x68, 0x90, 0x53,
+	0xbd, 0x6a, 0x95, 0xe9, 0x20, 0xe5, 0xed, 0xad, 0x9b, 0x43, 0xe3, 0x91, 0xe9, 0x6d, 0xce, 0x74,
+	0x8b, 0x94, 0xcc, 0xbe, 0xff, 0x8e, 0xb6, 0xba, 0xcf, 0xe9, 0x18, 0xe3, 0xdf, 0x6a, 0x40, 0x7a,
+	0x9f, 0xb3, 0xa4, 0x98, 0x6e, 0x3f, 0x71, 0x3f, 0x37, 0x87, 0x44, 0x23, 0xd7, 0x2f, 0x71, 0xae,
+	0x25, 0x52, 0x4c, 0xe1, 0x8a, 0x37, 0xf4, 0x8c, 0xe9, 0xee, 0xde, 0xd3, 0xe7, 0x79, 0xed, 0xd9,
+	0xf3, 0xbc, 0xf6, 0xef, 0xe7, 0x79, 0xed, 0xe3, 0x17, 0xf9, 0x73, 0xcf, 0x5e, 0xe4, 0xcf, 0xfd,
+	0xf3, 0x45, 0xfe, 0xdc, 0x77, 0x8a, 0xb5, 0x7a, 0x70, 0xd4, 0xae, 0x94, 0xaa, 0x6e, 0x93, 0x6b,
+	0xac, 0x1e, 0xd1, 0xba, 0x23, 0x74, 0x3f, 0xd9, 0x31, 0xbf, 0xdf, 0x35, 0x10, 0x74, 0x5a, 0xcc,
+	0xaf, 0x64, 0xf8, 0x3f, 0x9e, 0xdf, 0xf8, 0xff, 0x00, 0x49, 0xd3, 0x06, 0x6d, 0x6e, 0x20, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsByTimestamp queries the parameter of the module by timestamp.
+	ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
+	// Queries our flows by account.
+	OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error)
+	// Queries a stream record by account.
+	StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error)
+	// Queries all stream records.
+	StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error)
+	// Queries the count of payment account by owner.
+	PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error)
+	// Queries all counts of payment account for all owners.
+	PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error)
+	// Queries a payment account by payment account address.
+	PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error)
+	// Queries all payment accounts.
+	PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error)
+	// Queries dynamic balance of a payment account.
+	DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error)
+	// Queries all payment accounts by a owner.
+	PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error)
+	// Queries all auto settle records.
+	AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error)
+	// Queries delayed withdrawal of a account.
+	DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error)
+	// Queries a user stream by id.
+	Stream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (*QueryStreamResponse, error)
+	// Queries the user streams paid by a sender.
+	StreamsBySender(ctx context.Context, in *QueryStreamsBySenderRequest, opts ...grpc.CallOption) (*QueryStreamsBySenderResponse, error)
+	// Queries the projected depletion time of a stream account.
+	AccountRunway(ctx context.Context, in *QueryAccountRunwayRequest, opts ...grpc.CallOption) (*QueryAccountRunwayResponse, error)
+	// Queries the auto deposit allowance of a stream account.
+	AutoDepositAllowance(ctx context.Context, in *QueryAutoDepositAllowanceRequest, opts ...grpc.CallOption) (*QueryAutoDepositAllowanceResponse, error)
+	// Queries the pulls of the auto deposit allowance of a stream account.
+	AutoDepositRecords(ctx context.Context, in *QueryAutoDepositRecordsRequest, opts ...grpc.CallOption) (*QueryAutoDepositRecordsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/PaymentAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccounts(ctx, req.(*QueryPaymentAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_DynamicBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDynamicBalanceRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
+	out := new(QueryParamsByTimestampResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/ParamsByTimestamp", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DynamicBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/DynamicBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DynamicBalance(ctx, req.(*QueryDynamicBalanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PaymentAccountsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountsByOwnerRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error) {
+	out := new(QueryOutFlowsResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/OutFlows", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccountsByOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/PaymentAccountsByOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccountsByOwner(ctx, req.(*QueryPaymentAccountsByOwnerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AutoSettleRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAutoSettleRecordsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error) {
+	out := new(QueryGetStreamRecordResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/StreamRecord", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AutoSettleRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/AutoSettleRecords",
+	return out, nil
+}
+
+func (c *queryClient) StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error) {
+	out := new(QueryStreamRecordsResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/StreamRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AutoSettleRecords(ctx, req.(*QueryAutoSettleRecordsRequest))
+	return out, nil
+}
+
+func (c *queryClient) PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error) {
+	out := new(QueryPaymentAccountCountResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/PaymentAccountCount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_DelayedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelayedWithdrawalRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error) {
+	out := new(QueryPaymentAccountCountsResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/PaymentAccountCounts", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelayedWithdrawal(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error) {
+	out := new(QueryPaymentAccountResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/PaymentAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.payment.Query/DelayedWithdrawal",
+	return out, nil
+}
+
+func (c *queryClient) PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error) {
+	out := new(QueryPaymentAccountsResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/PaymentAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelayedWithdrawal(ctx, req.(*QueryDelayedWithdrawalRequest))
+	return out, nil
+}
+
+func (c *queryClient) DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error) {
+	out := new(QueryDynamicBalanceResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/DynamicBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "moca.payment.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ParamsByTimestamp",
-			Handler:    _Query_ParamsByTimestamp_Handler,
-		},
-		{
-			MethodName: "OutFlows",
-			Handler:    _Query_OutFlows_Handler,
-		},
-		{
-			MethodName: "StreamRecord",
-			Handler:    _Query_StreamRecord_Handler,
-		},
-		{
-			MethodName: "StreamRecords",
-			Handler:    _Query_StreamRecords_Handler,
-		},
-		{
-			MethodName: "PaymentAccountCount",
-			Handler:    _Query_PaymentAccountCount_Handler,
-		},
-		{
-			MethodName: "PaymentAccountCounts",
-			Handler:    _Query_PaymentAccountCounts_Handler,
-		},
-		{
-			MethodName: "PaymentAccount",
-			Handler:    _Query_PaymentAccount_Handler,
-		},
-		{
-			MethodName: "PaymentAccounts",
-			Handler:    _Query_PaymentAccounts_Handler,
-		},
-		{
-			MethodName: "DynamicBalance",
-			Handler:    _Query_DynamicBalance_Handler,
-		},
-		{
-			MethodName: "PaymentAccountsByOwner",
-			Handler:    _Query_PaymentAccountsByOwner_Handler,
-		},
-		{
-			MethodName: "AutoSettleRecords",
-			Handler:    _Query_AutoSettleRecords_Handler,
-		},
-		{
-			MethodName: "DelayedWithdrawal",
-			Handler:    _Query_DelayedWithdrawal_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "moca/payment/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error) {
+	out := new(QueryPaymentAccountsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/PaymentAccountsByOwner", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error) {
+	out := new(QueryAutoSettleRecordsResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/AutoSettleRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error) {
+	out := new(QueryDelayedWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/DelayedWithdrawal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Stream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (*QueryStreamResponse, error) {
+	out := new(QueryStreamResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/Stream", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) StreamsBySender(ctx context.Context, in *QueryStreamsBySenderRequest, opts ...grpc.CallOption) (*QueryStreamsBySenderResponse, error) {
+	out := new(QueryStreamsBySenderResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/StreamsBySender", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOutFlowsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountRunway(ctx context.Context, in *QueryAccountRunwayRequest, opts ...grpc.CallOption) (*QueryAccountRunwayResponse, error) {
+	out := new(QueryAccountRunwayResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/AccountRunway", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOutFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryOutFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AutoDepositAllowance(ctx context.Context, in *QueryAutoDepositAllowanceRequest, opts ...grpc.CallOption) (*QueryAutoDepositAllowanceResponse, error) {
+	out := new(QueryAutoDepositAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/AutoDepositAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOutFlowsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AutoDepositRecords(ctx context.Context, in *QueryAutoDepositRecordsRequest, opts ...grpc.CallOption) (*QueryAutoDepositRecordsResponse, error) {
+	out := new(QueryAutoDepositRecordsResponse)
+	err := c.cc.Invoke(ctx, "/moca.payment.Query/AutoDepositRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOutFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsByTimestamp queries the parameter of the module by timestamp.
+	ParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
+	// Queries our flows by account.
+	OutFlows(context.Context, *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error)
+	// Queries a stream record by account.
+	StreamRecord(context.Context, *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error)
+	// Queries all stream records.
+	StreamRecords(context.Context, *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error)
+	// Queries the count of payment account by owner.
+	PaymentAccountCount(context.Context, *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error)
+	// Queries all counts of payment account for all owners.
+	PaymentAccountCounts(context.Context, *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error)
+	// Queries a payment account by payment account address.
+	PaymentAccount(context.Context, *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error)
+	// Queries all payment accounts.
+	PaymentAccounts(context.Context, *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error)
+	// Queries dynamic balance of a payment account.
+	DynamicBalance(context.Context, *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error)
+	// Queries all payment accounts by a owner.
+	PaymentAccountsByOwner(context.Context, *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error)
+	// Queries all auto settle records.
+	AutoSettleRecords(context.Context, *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error)
+	// Queries delayed withdrawal of a account.
+	DelayedWithdrawal(context.Context, *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error)
+	// Queries a user stream by id.
+	Stream(context.Context, *QueryStreamRequest) (*QueryStreamResponse, error)
+	// Queries the user streams paid by a sender.
+	StreamsBySender(context.Context, *QueryStreamsBySenderRequest) (*QueryStreamsBySenderResponse, error)
+	// Queries the projected depletion time of a stream account.
+	AccountRunway(context.Context, *QueryAccountRunwayRequest) (*QueryAccountRunwayResponse, error)
+	// Queries the auto deposit allowance of a stream account.
+	AutoDepositAllowance(context.Context, *QueryAutoDepositAllowanceRequest) (*QueryAutoDepositAllowanceResponse, error)
+	// Queries the pulls of the auto deposit allowance of a stream account.
+	AutoDepositRecords(context.Context, *QueryAutoDepositRecordsRequest) (*QueryAutoDepositRecordsResponse, error)
 }
 
-func (m *QueryOutFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OutFlows) > 0 {
-		for iNdEx := len(m.OutFlows) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetStreamRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryGetStreamRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsByTimestamp not implemented")
 }
-
-func (m *QueryGetStreamRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) OutFlows(ctx context.Context, req *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutFlows not implemented")
 }
-
-func (m *QueryGetStreamRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) StreamRecord(ctx context.Context, req *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamRecord not implemented")
 }
-
-func (m *QueryGetStreamRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) StreamRecords(ctx context.Context, req *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamRecords not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccountCount(ctx context.Context, req *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCount not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccountCounts(ctx context.Context, req *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCounts not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccount(ctx context.Context, req *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccount not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccounts(ctx context.Context, req *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccounts not implemented")
+}
+func (*UnimplementedQueryServer) DynamicBalance(ctx context.Context, req *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamicBalance not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccountsByOwner(ctx context.Context, req *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) AutoSettleRecords(ctx context.Context, req *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoSettleRecords not implemented")
+}
+func (*UnimplementedQueryServer) DelayedWithdrawal(ctx context.Context, req *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedWithdrawal not implemented")
+}
+func (*UnimplementedQueryServer) Stream(ctx context.Context, req *QueryStreamRequest) (*QueryStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (*UnimplementedQueryServer) StreamsBySender(ctx context.Context, req *QueryStreamsBySenderRequest) (*QueryStreamsBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamsBySender not implemented")
+}
+func (*UnimplementedQueryServer) AccountRunway(ctx context.Context, req *QueryAccountRunwayRequest) (*QueryAccountRunwayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRunway not implemented")
+}
+func (*UnimplementedQueryServer) AutoDepositAllowance(ctx context.Context, req *QueryAutoDepositAllowanceRequest) (*QueryAutoDepositAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoDepositAllowance not implemented")
+}
+func (*UnimplementedQueryServer) AutoDepositRecords(ctx context.Context, req *QueryAutoDepositRecordsRequest) (*QueryAutoDepositRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoDepositRecords not implemented")
 }
 
-func (m *QueryGetStreamRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryStreamRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryStreamRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStreamRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_ParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsByTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/ParamsByTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStreamRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OutFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutFlowsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OutFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/OutFlows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutFlows(ctx, req.(*QueryOutFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStreamRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_StreamRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStreamRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/StreamRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamRecord(ctx, req.(*QueryGetStreamRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStreamRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_StreamRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.StreamRecords) > 0 {
-		for iNdEx := len(m.StreamRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StreamRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamRecords(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/StreamRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamRecords(ctx, req.(*QueryStreamRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PaymentAccountCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountCountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccountCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/PaymentAccountCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccountCount(ctx, req.(*QueryPaymentAccountCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPaymentAccountCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func _Query_PaymentAccountCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccountCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/PaymentAccountCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccountCounts(ctx, req.(*QueryPaymentAccountCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PaymentAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/PaymentAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccount(ctx, req.(*QueryPaymentAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PaymentAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/PaymentAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccounts(ctx, req.(*QueryPaymentAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PaymentAccountCount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_DynamicBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDynamicBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DynamicBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/DynamicBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DynamicBalance(ctx, req.(*QueryDynamicBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountCountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PaymentAccountsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountsByOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccountsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/PaymentAccountsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccountsByOwner(ctx, req.(*QueryPaymentAccountsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountCountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AutoSettleRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoSettleRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoSettleRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/AutoSettleRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoSettleRecords(ctx, req.(*QueryAutoSettleRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_DelayedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/DelayedWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedWithdrawal(ctx, req.(*QueryDelayedWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountCountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Stream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Stream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/Stream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stream(ctx, req.(*QueryStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountCountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_StreamsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/StreamsBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamsBySender(ctx, req.(*QueryStreamsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_AccountRunway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRunwayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PaymentAccountCounts) > 0 {
-		for iNdEx := len(m.PaymentAccountCounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PaymentAccountCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountRunway(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/AccountRunway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountRunway(ctx, req.(*QueryAccountRunwayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AutoDepositAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoDepositAllowanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AutoDepositAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/AutoDepositAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoDepositAllowance(ctx, req.(*QueryAutoDepositAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AutoDepositRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoDepositRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoDepositRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.payment.Query/AutoDepositRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoDepositRecords(ctx, req.(*QueryAutoDepositRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "moca.payment.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsByTimestamp",
+			Handler:    _Query_ParamsByTimestamp_Handler,
+		},
+		{
+			MethodName: "OutFlows",
+			Handler:    _Query_OutFlows_Handler,
+		},
+		{
+			MethodName: "StreamRecord",
+			Handler:    _Query_StreamRecord_Handler,
+		},
+		{
+			MethodName: "StreamRecords",
+			Handler:    _Query_StreamRecords_Handler,
+		},
+		{
+			MethodName: "PaymentAccountCount",
+			Handler:    _Query_PaymentAccountCount_Handler,
+		},
+		{
+			MethodName: "PaymentAccountCounts",
+			Handler:    _Query_PaymentAccountCounts_Handler,
+		},
+		{
+			MethodName: "PaymentAccount",
+			Handler:    _Query_PaymentAccount_Handler,
+		},
+		{
+			MethodName: "PaymentAccounts",
+			Handler:    _Query_PaymentAccounts_Handler,
+		},
+		{
+			MethodName: "DynamicBalance",
+			Handler:    _Query_DynamicBalance_Handler,
+		},
+		{
+			MethodName: "PaymentAccountsByOwner",
+			Handler:    _Query_PaymentAccountsByOwner_Handler,
+		},
+		{
+			MethodName: "AutoSettleRecords",
+			Handler:    _Query_AutoSettleRecords_Handler,
+		},
+		{
+			MethodName: "DelayedWithdrawal",
+			Handler:    _Query_DelayedWithdrawal_Handler,
+		},
+		{
+			MethodName: "Stream",
+			Handler:    _Query_Stream_Handler,
+		},
+		{
+			MethodName: "StreamsBySender",
+			Handler:    _Query_StreamsBySender_Handler,
+		},
+		{
+			MethodName: "AccountRunway",
+			Handler:    _Query_AccountRunway_Handler,
+		},
+		{
+			MethodName: "AutoDepositAllowance",
+			Handler:    _Query_AutoDepositAllowance_Handler,
+		},
+		{
+			MethodName: "AutoDepositRecords",
+			Handler:    _Query_AutoDepositRecords_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "moca/payment/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2409,18 +2704,18 @@ func (m *QueryPaymentAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.PaymentAccount.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2432,7 +2727,7 @@ func (m *QueryPaymentAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2442,32 +2737,25 @@ func (m *QueryPaymentAccountsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2477,32 +2765,83 @@ func (m *QueryPaymentAccountsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutFlowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	if len(m.PaymentAccounts) > 0 {
-		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutFlowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutFlows) > 0 {
+		for iNdEx := len(m.OutFlows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PaymentAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OutFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2516,7 +2855,7 @@ func (m *QueryPaymentAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDynamicBalanceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetStreamRecordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2526,12 +2865,12 @@ func (m *QueryDynamicBalanceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDynamicBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDynamicBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2546,7 +2885,7 @@ func (m *QueryDynamicBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDynamicBalanceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetStreamRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2556,85 +2895,114 @@ func (m *QueryDynamicBalanceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDynamicBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDynamicBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.ChangeRate.Size()
-		i -= size
-		if _, err := m.ChangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.LockedFee.Size()
-		i -= size
-		if _, err := m.LockedFee.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.AvailableBalance.Size()
 		i -= size
-		if _, err := m.AvailableBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.BankBalance.Size()
-		i -= size
-		if _, err := m.BankBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.CurrentTimestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTimestamp))
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xa
 	}
-	{
-		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.DynamicBalance.Size()
-		i -= size
-		if _, err := m.DynamicBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.StreamRecords) > 0 {
+		for iNdEx := len(m.StreamRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPaymentAccountCountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2644,12 +3012,12 @@ func (m *QueryPaymentAccountsByOwnerRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountCountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2664,7 +3032,7 @@ func (m *QueryPaymentAccountsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPaymentAccountCountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2674,29 +3042,30 @@ func (m *QueryPaymentAccountsByOwnerResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountCountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PaymentAccounts) > 0 {
-		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PaymentAccounts[iNdEx])
-			copy(dAtA[i:], m.PaymentAccounts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccounts[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.PaymentAccountCount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAutoSettleRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPaymentAccountCountsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2706,12 +3075,12 @@ func (m *QueryAutoSettleRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAutoSettleRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountCountsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAutoSettleRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2731,7 +3100,7 @@ func (m *QueryAutoSettleRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAutoSettleRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPaymentAccountCountsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2741,12 +3110,12 @@ func (m *QueryAutoSettleRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAutoSettleRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountCountsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAutoSettleRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2763,10 +3132,10 @@ func (m *QueryAutoSettleRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.AutoSettleRecords) > 0 {
-		for iNdEx := len(m.AutoSettleRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PaymentAccountCounts) > 0 {
+		for iNdEx := len(m.PaymentAccountCounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AutoSettleRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PaymentAccountCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2780,7 +3149,7 @@ func (m *QueryAutoSettleRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelayedWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPaymentAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2790,27 +3159,27 @@ func (m *QueryDelayedWithdrawalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelayedWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelayedWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelayedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPaymentAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2820,18 +3189,18 @@ func (m *QueryDelayedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelayedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPaymentAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.DelayedWithdrawal.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.PaymentAccount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2843,384 +3212,2616 @@ func (m *QueryDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPaymentAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsByTimestampRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovQuery(uint64(m.Timestamp))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsByTimestampResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOutFlowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPaymentAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOutFlowsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OutFlows) > 0 {
-		for _, e := range m.OutFlows {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.PaymentAccounts) > 0 {
+		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStreamRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDynamicBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetStreamRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.StreamRecord.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDynamicBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStreamRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDynamicBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStreamRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDynamicBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.StreamRecords) > 0 {
-		for _, e := range m.StreamRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.ChangeRate.Size()
+		i -= size
+		if _, err := m.ChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LockedFee.Size()
+		i -= size
+		if _, err := m.LockedFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryPaymentAccountCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AvailableBalance.Size()
+		i -= size
+		if _, err := m.AvailableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BankBalance.Size()
+		i -= size
+		if _, err := m.BankBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	if m.CurrentTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DynamicBalance.Size()
+		i -= size
+		if _, err := m.DynamicBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PaymentAccountCount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountCountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPaymentAccountsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountCountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PaymentAccountCounts) > 0 {
-		for _, e := range m.PaymentAccountCounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentAccountsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.PaymentAccount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.PaymentAccounts) > 0 {
+		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PaymentAccounts[iNdEx])
+			copy(dAtA[i:], m.PaymentAccounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAutoSettleRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoSettleRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoSettleRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAutoSettleRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoSettleRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoSettleRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PaymentAccounts) > 0 {
-		for _, e := range m.PaymentAccounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AutoSettleRecords) > 0 {
+		for iNdEx := len(m.AutoSettleRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoSettleRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDynamicBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelayedWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDynamicBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelayedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.DynamicBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.StreamRecord.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.CurrentTimestamp != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTimestamp))
+	{
+		size, err := m.DelayedWithdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.BankBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AvailableBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LockedFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ChangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PaymentAccounts) > 0 {
-		for _, s := range m.PaymentAccounts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAutoSettleRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamsBySenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsBySenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsBySenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAutoSettleRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamsBySenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsBySenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsBySenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AutoSettleRecords) > 0 {
-		for _, e := range m.AutoSettleRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountRunwayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRunwayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRunwayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountRunwayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRunwayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRunwayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AlertThresholdSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AlertThresholdSeconds))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.RunwaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RunwaySeconds))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.DepletionTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DepletionTimestamp))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PriceChangeTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PriceChangeTime))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.ProjectedNetflowRate.Size()
+		i -= size
+		if _, err := m.ProjectedNetflowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ForcedSettleTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ForcedSettleTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReserveTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReserveTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.BankBalance.Size()
+		i -= size
+		if _, err := m.BankBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LockBalance.Size()
+		i -= size
+		if _, err := m.LockBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BufferBalance.Size()
+		i -= size
+		if _, err := m.BufferBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.StaticBalance.Size()
+		i -= size
+		if _, err := m.StaticBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NetflowRate.Size()
+		i -= size
+		if _, err := m.NetflowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoDepositAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoDepositAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoDepositAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoDepositAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoDepositAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoDepositAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoDepositRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoDepositRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoDepositRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoDepositRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoDepositRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoDepositRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByTimestampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryParamsByTimestampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOutFlowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutFlowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutFlows) > 0 {
+		for _, e := range m.OutFlows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetStreamRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStreamRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StreamRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StreamRecords) > 0 {
+		for _, e := range m.StreamRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentAccountCount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentAccountCountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentAccountCounts) > 0 {
+		for _, e := range m.PaymentAccountCounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentAccounts) > 0 {
+		for _, e := range m.PaymentAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDynamicBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDynamicBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DynamicBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StreamRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CurrentTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTimestamp))
+	}
+	l = m.BankBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AvailableBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ChangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentAccountsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentAccounts) > 0 {
+		for _, s := range m.PaymentAccounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAutoSettleRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoSettleRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AutoSettleRecords) > 0 {
+		for _, e := range m.AutoSettleRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DelayedWithdrawal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamsBySenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamsBySenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRunwayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRunwayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = m.NetflowRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StaticBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BufferBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BankBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ReserveTime != 0 {
+		n += 1 + sovQuery(uint64(m.ReserveTime))
+	}
+	if m.ForcedSettleTime != 0 {
+		n += 1 + sovQuery(uint64(m.ForcedSettleTime))
+	}
+	l = m.ProjectedNetflowRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PriceChangeTime != 0 {
+		n += 1 + sovQuery(uint64(m.PriceChangeTime))
+	}
+	if m.DepletionTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.DepletionTimestamp))
+	}
+	if m.RunwaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.RunwaySeconds))
+	}
+	if m.AlertThresholdSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.AlertThresholdSeconds))
+	}
+	return n
+}
+
+func (m *QueryAutoDepositAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoDepositAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allowance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAutoDepositRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoDepositRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutFlowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutFlowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutFlows = append(m.OutFlows, OutFlow{})
+			if err := m.OutFlows[len(m.OutFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStreamRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStreamRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStreamRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStreamRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamRecords = append(m.StreamRecords, StreamRecord{})
+			if err := m.StreamRecords[len(m.StreamRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaymentAccountCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAccountCounts = append(m.PaymentAccountCounts, PaymentAccountCount{})
+			if err := m.PaymentAccountCounts[len(m.PaymentAccountCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryDelayedWithdrawalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryDelayedWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.DelayedWithdrawal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3243,12 +5844,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3270,7 +5903,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3293,15 +5926,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3328,7 +5961,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PaymentAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3353,7 +5986,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3376,17 +6009,17 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3396,11 +6029,28 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3422,7 +6072,7 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3445,15 +6095,15 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3480,7 +6130,44 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PaymentAccounts = append(m.PaymentAccounts, PaymentAccount{})
+			if err := m.PaymentAccounts[len(m.PaymentAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3505,7 +6192,7 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3528,10 +6215,10 @@ func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutFlowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDynamicBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDynamicBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3587,7 +6274,7 @@ func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3610,17 +6297,17 @@ func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutFlowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDynamicBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDynamicBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutFlows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBalance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3630,79 +6317,81 @@ func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutFlows = append(m.OutFlows, OutFlow{})
-			if err := m.OutFlows[len(m.OutFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DynamicBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTimestamp", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CurrentTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStreamRecordRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStreamRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3730,63 +6419,15 @@ func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.BankBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStreamRecordResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStreamRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableBalance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3796,80 +6437,65 @@ func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AvailableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedFee", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LockedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamRecordsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3879,25 +6505,23 @@ func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3922,7 +6546,7 @@ func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3945,51 +6569,17 @@ func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecords", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StreamRecords = append(m.StreamRecords, StreamRecord{})
-			if err := m.StreamRecords[len(m.StreamRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3999,27 +6589,23 @@ func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4042,7 +6628,7 @@ func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4065,15 +6651,15 @@ func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4101,7 +6687,7 @@ func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.PaymentAccounts = append(m.PaymentAccounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4124,7 +6710,7 @@ func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4147,15 +6733,15 @@ func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4182,7 +6768,10 @@ func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PaymentAccountCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4207,7 +6796,7 @@ func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4230,13 +6819,47 @@ func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettleRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoSettleRecords = append(m.AutoSettleRecords, AutoSettleRecord{})
+			if err := m.AutoSettleRecords[len(m.AutoSettleRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4266,7 +6889,7 @@ func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4293,7 +6916,7 @@ func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4316,17 +6939,17 @@ func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4336,29 +6959,77 @@ func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAccountCounts = append(m.PaymentAccountCounts, PaymentAccountCount{})
-			if err := m.PaymentAccountCounts[len(m.PaymentAccountCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4385,10 +7056,7 @@ func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelayedWithdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4413,7 +7081,7 @@ func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4436,17 +7104,17 @@ func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var stringLen uint64
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4456,24 +7124,11 @@ func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4495,7 +7150,7 @@ func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4518,15 +7173,15 @@ func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4553,7 +7208,7 @@ func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PaymentAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4578,7 +7233,7 @@ func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamsBySenderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4601,13 +7256,45 @@ func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamsBySenderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamsBySenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4664,7 +7351,7 @@ func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamsBySenderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4687,15 +7374,15 @@ func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamsBySenderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamsBySenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4722,8 +7409,8 @@ func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAccounts = append(m.PaymentAccounts, PaymentAccount{})
-			if err := m.PaymentAccounts[len(m.PaymentAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Streams = append(m.Streams, UserStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4784,7 +7471,7 @@ func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountRunwayRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4807,10 +7494,10 @@ func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDynamicBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountRunwayRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDynamicBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountRunwayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4866,7 +7553,7 @@ func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountRunwayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4889,17 +7576,17 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDynamicBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountRunwayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDynamicBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountRunwayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBalance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4909,31 +7596,16 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= StreamAccountStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DynamicBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetflowRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4943,30 +7615,31 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticBalance", wireType)
 			}
-			m.CurrentTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4976,14 +7649,29 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5011,13 +7699,13 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BankBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BufferBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5045,13 +7733,13 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AvailableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5079,13 +7767,51 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BankBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveTime", wireType)
+			}
+			m.ReserveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReserveTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcedSettleTime", wireType)
+			}
+			m.ForcedSettleTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForcedSettleTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedNetflowRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5113,65 +7839,53 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProjectedNetflowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceChangeTime", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.PriceChangeTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceChangeTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepletionTimestamp", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DepletionTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepletionTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunwaySeconds", wireType)
 			}
-			var stringLen uint64
+			m.RunwaySeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5181,24 +7895,30 @@ func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RunwaySeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlertThresholdSeconds", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.AlertThresholdSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AlertThresholdSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5220,7 +7940,7 @@ func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoDepositAllowanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5243,15 +7963,15 @@ func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoDepositAllowanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoDepositAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5279,7 +7999,7 @@ func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAccounts = append(m.PaymentAccounts, string(dAtA[iNdEx:postIndex]))
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5302,7 +8022,7 @@ func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoDepositAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5325,15 +8045,15 @@ func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoDepositAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoDepositAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5360,10 +8080,7 @@ func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5388,7 +8105,7 @@ func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoDepositRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5411,17 +8128,17 @@ func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoDepositRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoDepositRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettleRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5431,25 +8148,23 @@ func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AutoSettleRecords = append(m.AutoSettleRecords, AutoSettleRecord{})
-			if err := m.AutoSettleRecords[len(m.AutoSettleRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5481,7 +8196,7 @@ func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5508,7 +8223,7 @@ func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoDepositRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5531,17 +8246,17 @@ func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoDepositRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoDepositRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5551,77 +8266,29 @@ func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Records = append(m.Records, AutoDepositRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5648,7 +8315,10 @@ func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelayedWithdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,383 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: moca/payment/runway_alert.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RunwayAlert is the threshold a stream account set for itself to be alerted when its runway, the time left
+// before it is frozen for lack of balance, falls below.
+type RunwayAlert struct {
+	// threshold_seconds is the runway below which an EventLowRunway is emitted
+	ThresholdSeconds uint64 `protobuf:"varint,1,opt,name=threshold_seconds,json=thresholdSeconds,proto3" json:"threshold_seconds,omitempty"`
+	// next_check_time is the unix timestamp when the runway will be checked by the EndBlocker, zero if not queued
+	NextCheckTime int64 `protobuf:"varint,2,opt,name=next_check_time,json=nextCheckTime,proto3" json:"next_check_time,omitempty"`
+	// alerted is whether the EventLowRunway has been emitted since the runway fell below the threshold
+	Alerted bool `protobuf:"varint,3,opt,name=alerted,proto3" json:"alerted,omitempty"`
+}
+
+func (m *RunwayAlert) Reset()         { *m = RunwayAlert{} }
+func (m *RunwayAlert) String() string { return proto.CompactTextString(m) }
+func (*RunwayAlert) ProtoMessage()    {}
+func (*RunwayAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11fc382f8911312b, []int{0}
+}
+func (m *RunwayAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RunwayAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunwayAlert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RunwayAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunwayAlert.Merge(m, src)
+}
+func (m *RunwayAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunwayAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunwayAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunwayAlert proto.InternalMessageInfo
+
+func (m *RunwayAlert) GetThresholdSeconds() uint64 {
+	if m != nil {
+		return m.ThresholdSeconds
+	}
+	return 0
+}
+
+func (m *RunwayAlert) GetNextCheckTime() int64 {
+	if m != nil {
+		return m.NextCheckTime
+	}
+	return 0
+}
+
+func (m *RunwayAlert) GetAlerted() bool {
+	if m != nil {
+		return m.Alerted
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*RunwayAlert)(nil), "moca.payment.RunwayAlert")
+}
+
+func init() { proto.RegisterFile("moca/payment/runway_alert.proto", fileDescriptor_11fc382f8911312b) }
+
+var fileDescriptor_11fc382f8911312b = []byte{
+	// 224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0xcd, 0x4f, 0x4e,
+	0xd4, 0x2f, 0x48, 0xac, 0xcc, 0x4d, 0xcd, 0x2b, 0xd1, 0x2f, 0x2a, 0xcd, 0x2b, 0x4f, 0xac, 0x8c,
+	0x4f, 0xcc, 0x49, 0x2d, 0x2a, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0x29, 0xd0,
+	0x83, 0x2a, 0x50, 0xaa, 0xe1, 0xe2, 0x0e, 0x02, 0xab, 0x71, 0x04, 0x29, 0x11, 0xd2, 0xe6, 0x12,
+	0x2c, 0xc9, 0x28, 0x4a, 0x2d, 0xce, 0xc8, 0xcf, 0x49, 0x89, 0x2f, 0x4e, 0x4d, 0xce, 0xcf, 0x4b,
+	0x29, 0x96, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09, 0x12, 0x80, 0x4b, 0x04, 0x43, 0xc4, 0x85, 0xd4,
+	0xb8, 0xf8, 0xf3, 0x52, 0x2b, 0x4a, 0xe2, 0x93, 0x33, 0x52, 0x93, 0xb3, 0xe3, 0x4b, 0x32, 0x73,
+	0x53, 0x25, 0x98, 0x14, 0x18, 0x35, 0x98, 0x83, 0x78, 0x41, 0xc2, 0xce, 0x20, 0xd1, 0x90, 0xcc,
+	0xdc, 0x54, 0x21, 0x09, 0x2e, 0x76, 0xb0, 0x03, 0x52, 0x53, 0x24, 0x98, 0x15, 0x18, 0x35, 0x38,
+	0x82, 0x60, 0x5c, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48,
+	0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2,
+	0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x39, 0x38, 0x39, 0x23,
+	0x31, 0x33, 0x0f, 0xcc, 0xd2, 0x2f, 0x33, 0xd2, 0xaf, 0x80, 0x7b, 0xb0, 0xa4, 0xb2, 0x20, 0xb5,
+	0x38, 0x89, 0x0d, 0xec, 0x35, 0x63, 0xc0, 0x00, 0x8a, 0x81, 0xc5, 0x58, 0xfd, 0x00, 0x00, 0x00,
+}
+
+func (m *RunwayAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunwayAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunwayAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Alerted {
+		i--
+		if m.Alerted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextCheckTime != 0 {
+		i = encodeVarintRunwayAlert(dAtA, i, uint64(m.NextCheckTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ThresholdSeconds != 0 {
+		i = encodeVarintRunwayAlert(dAtA, i, uint64(m.ThresholdSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRunwayAlert(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRunwayAlert(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RunwayAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdSeconds != 0 {
+		n += 1 + sovRunwayAlert(uint64(m.ThresholdSeconds))
+	}
+	if m.NextCheckTime != 0 {
+		n += 1 + sovRunwayAlert(uint64(m.NextCheckTime))
+	}
+	if m.Alerted {
+		n += 2
+	}
+	return n
+}
+
+func sovRunwayAlert(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRunwayAlert(x uint64) (n int) {
+	return sovRunwayAlert(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RunwayAlert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRunwayAlert
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunwayAlert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunwayAlert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdSeconds", wireType)
+			}
+			m.ThresholdSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRunwayAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCheckTime", wireType)
+			}
+			m.NextCheckTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRunwayAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCheckTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alerted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRunwayAlert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Alerted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRunwayAlert(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRunwayAlert
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRunwayAlert(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRunwayAlert
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRunwayAlert
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRunwayAlert
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRunwayAlert
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRunwayAlert
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRunwayAlert
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRunwayAlert        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRunwayAlert          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRunwayAlert = fmt.Errorf("proto: unexpected end of group")
+)
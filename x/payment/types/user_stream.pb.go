@@ -0,0 +1,539 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: moca/payment/user_stream.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UserStream is a user-defined outflow from the sender stream account to the recipient,
+// it is settled with the other outflows of the sender.
+type UserStream struct {
+	// id is the unique identifier of the stream
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address of the stream account which pays the stream
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the account which receives the stream
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// rate is the amount streamed to the recipient per second
+	Rate cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=rate,proto3,customtype=cosmossdk.io/math.Int" json:"rate"`
+	// created_at is the unix timestamp when the stream is created
+	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the unix timestamp when the rate of the stream is updated lastly
+	UpdatedAt int64 `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *UserStream) Reset()         { *m = UserStream{} }
+func (m *UserStream) String() string { return proto.CompactTextString(m) }
+func (*UserStream) ProtoMessage()    {}
+func (*UserStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dadce51967430c67, []int{0}
+}
+func (m *UserStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserStream.Merge(m, src)
+}
+func (m *UserStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserStream proto.InternalMessageInfo
+
+func (m *UserStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UserStream) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *UserStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *UserStream) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *UserStream) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*UserStream)(nil), "moca.payment.UserStream")
+}
+
+func init() { proto.RegisterFile("moca/payment/user_stream.proto", fileDescriptor_dadce51967430c67) }
+
+var fileDescriptor_dadce51967430c67 = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xbf, 0x4a, 0x2b, 0x41,
+	0x18, 0xc5, 0x77, 0x36, 0xb9, 0x81, 0x0c, 0x97, 0x5b, 0x2c, 0xb9, 0xb0, 0x06, 0x9c, 0x04, 0xab,
+	0x80, 0x66, 0x47, 0x14, 0x6c, 0x25, 0x29, 0x84, 0xb4, 0x1b, 0x6c, 0x6c, 0xc2, 0x64, 0xe7, 0x63,
+	0x33, 0xc8, 0xce, 0x2c, 0x33, 0x5f, 0xc4, 0x3c, 0x80, 0xbd, 0x0f, 0x93, 0x87, 0x48, 0x19, 0x52,
+	0x89, 0x45, 0x90, 0xe4, 0x45, 0x64, 0xff, 0xa8, 0xa5, 0xdd, 0x37, 0xe7, 0x77, 0xce, 0x29, 0xce,
+	0x50, 0x96, 0x99, 0x44, 0xf0, 0x5c, 0xac, 0x32, 0xd0, 0xc8, 0x97, 0x0e, 0xec, 0xcc, 0xa1, 0x05,
+	0x91, 0x45, 0xb9, 0x35, 0x68, 0x82, 0xbf, 0x05, 0x8f, 0x6a, 0xde, 0x3d, 0x49, 0x8c, 0xcb, 0x8c,
+	0x9b, 0x95, 0x8c, 0x57, 0x8f, 0xca, 0xd8, 0xed, 0xa4, 0x26, 0x35, 0x95, 0x5e, 0x5c, 0x95, 0x7a,
+	0xf6, 0xe2, 0x53, 0x7a, 0xef, 0xc0, 0x4e, 0xcb, 0xce, 0xe0, 0x1f, 0xf5, 0x95, 0x0c, 0x49, 0x9f,
+	0x0c, 0x9a, 0xb1, 0xaf, 0x64, 0x70, 0x49, 0x5b, 0x0e, 0xb4, 0x04, 0x1b, 0xfa, 0x7d, 0x32, 0x68,
+	0x8f, 0xc3, 0xdd, 0x7a, 0xd8, 0xa9, 0x6b, 0x47, 0x52, 0x5a, 0x70, 0x6e, 0x8a, 0x56, 0xe9, 0x34,
+	0xae, 0x7d, 0xc1, 0x0d, 0x6d, 0x5b, 0x48, 0x54, 0xae, 0x40, 0x63, 0xd8, 0xf8, 0x25, 0xf4, 0x63,
+	0x0d, 0x6e, 0x69, 0xd3, 0x0a, 0x84, 0xb0, 0x59, 0x46, 0xce, 0x37, 0xfb, 0x9e, 0xf7, 0xbe, 0xef,
+	0xfd, 0xaf, 0x62, 0x4e, 0x3e, 0x46, 0xca, 0xf0, 0x4c, 0xe0, 0x22, 0x9a, 0x68, 0xdc, 0xad, 0x87,
+	0xb4, 0xee, 0x9b, 0x68, 0x8c, 0xcb, 0x60, 0x70, 0x4a, 0x69, 0x62, 0x41, 0x20, 0xc8, 0x99, 0xc0,
+	0xf0, 0x4f, 0x9f, 0x0c, 0x1a, 0x71, 0xbb, 0x56, 0x46, 0x58, 0xe0, 0x65, 0x2e, 0xbf, 0x70, 0xab,
+	0xc2, 0xb5, 0x32, 0xc2, 0xf1, 0xdd, 0xe6, 0xc0, 0xc8, 0xf6, 0xc0, 0xc8, 0xc7, 0x81, 0x91, 0xd7,
+	0x23, 0xf3, 0xb6, 0x47, 0xe6, 0xbd, 0x1d, 0x99, 0xf7, 0x70, 0x91, 0x2a, 0x5c, 0x2c, 0xe7, 0x51,
+	0x62, 0x32, 0x5e, 0x6c, 0x9d, 0x2c, 0x84, 0xd2, 0xe5, 0xc5, 0x9f, 0xae, 0xf8, 0xf3, 0xf7, 0xd7,
+	0xe0, 0x2a, 0x07, 0x37, 0x6f, 0x95, 0xb3, 0x5e, 0x7f, 0x0e, 0x00, 0xf6, 0x17, 0x08, 0x28, 0xb7,
+	0x01, 0x00, 0x00,
+}
+
+func (m *UserStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintUserStream(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintUserStream(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUserStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintUserStream(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintUserStream(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintUserStream(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUserStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUserStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovUserStream(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovUserStream(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovUserStream(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovUserStream(uint64(l))
+	if m.CreatedAt != 0 {
+		n += 1 + sovUserStream(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovUserStream(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func sovUserStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUserStream(x uint64) (n int) {
+	return sovUserStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUserStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUserStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUserStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUserStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUserStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUserStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUserStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUserStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUserStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUserStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUserStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUserStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUserStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUserStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUserStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUserStream = fmt.Errorf("proto: unexpected end of group")
+)
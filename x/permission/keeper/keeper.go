@@ -307,6 +307,36 @@ func (k Keeper) GetPolicyGroupForResource(ctx sdk.Context, resourceID math.Uint,
 	return &policyGroup, true
 }
 
+// GetPoliciesForResource returns the policies enforced on the resource, the account policies first in the order of
+// the account address, and then the group policies in the order they were put.
+func (k Keeper) GetPoliciesForResource(ctx sdk.Context, resourceID math.Uint, resourceType resource.ResourceType) []*types.Policy {
+	policies := make([]*types.Policy, 0)
+	if resourceType == resource.RESOURCE_TYPE_UNSPECIFIED {
+		return policies
+	}
+	store := ctx.KVStore(k.storeKey)
+	resourceAccountsPolicyStore := prefix.NewStore(store, types.PolicyForAccountPrefix(resourceID, resourceType, true))
+	iterator := resourceAccountsPolicyStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if policy, found := k.GetPolicyByID(ctx, k.policySeq.DecodeSequence(iterator.Value())); found {
+			policies = append(policies, policy)
+		}
+	}
+
+	if resourceType == resource.RESOURCE_TYPE_GROUP {
+		return policies
+	}
+	if policyGroup, found := k.GetPolicyGroupForResource(ctx, resourceID, resourceType); found {
+		for _, item := range policyGroup.Items {
+			if policy, found := k.GetPolicyByID(ctx, item.PolicyId); found {
+				policies = append(policies, policy)
+			}
+		}
+	}
+	return policies
+}
+
 func (k Keeper) GetPolicyForGroup(ctx sdk.Context, resourceID math.Uint,
 	resourceType resource.ResourceType, groupID math.Uint) (policy *types.Policy,
 	isFound bool,
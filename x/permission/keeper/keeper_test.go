@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/mocachain/moca/v2/testutil/sample"
+	"github.com/mocachain/moca/v2/types/resource"
 	"github.com/mocachain/moca/v2/x/permission/types"
 )
 
@@ -349,3 +350,51 @@ func (s *TestSuite) TestPutPolicy_StatementsCapDoesNotBrickStoredPolicies() {
 	s.Require().ErrorIs(err, types.ErrLimitExceeded,
 		"growing an over-cap policy further must still be rejected")
 }
+
+func (s *TestSuite) TestGetPoliciesForResource() {
+	resourceID := math.NewUint(rand.Uint64()) //nolint: gosec
+	accounts := []string{sample.RandAccAddressHex(), sample.RandAccAddressHex()}
+	groupIDs := []math.Uint{math.NewUint(2), math.NewUint(1)}
+
+	policyIDs := make(map[string]bool)
+	putPolicy := func(principal *types.Principal, resourceID math.Uint) {
+		policyID, err := s.permissionKeeper.PutPolicy(s.ctx, &types.Policy{
+			Principal:    principal,
+			ResourceType: resource.RESOURCE_TYPE_BUCKET,
+			ResourceId:   resourceID,
+		})
+		s.Require().NoError(err)
+		policyIDs[policyID.String()] = true
+	}
+	for _, account := range accounts {
+		putPolicy(&types.Principal{Type: types.PRINCIPAL_TYPE_GNFD_ACCOUNT, Value: account}, resourceID)
+	}
+	for _, groupID := range groupIDs {
+		putPolicy(&types.Principal{Type: types.PRINCIPAL_TYPE_GNFD_GROUP, Value: groupID.String()}, resourceID)
+	}
+	// the policy of another resource is not listed
+	otherPolicyID, err := s.permissionKeeper.PutPolicy(s.ctx, &types.Policy{
+		Principal:    &types.Principal{Type: types.PRINCIPAL_TYPE_GNFD_ACCOUNT, Value: accounts[0]},
+		ResourceType: resource.RESOURCE_TYPE_BUCKET,
+		ResourceId:   resourceID.AddUint64(1),
+	})
+	s.Require().NoError(err)
+
+	policies := s.permissionKeeper.GetPoliciesForResource(s.ctx, resourceID, resource.RESOURCE_TYPE_BUCKET)
+	s.Require().Len(policies, 4)
+	for _, policy := range policies {
+		s.Require().True(policyIDs[policy.Id.String()])
+		s.Require().False(policy.Id.Equal(otherPolicyID))
+	}
+	// the group policies follow the account policies in the order they were put
+	s.Require().Equal(types.PRINCIPAL_TYPE_GNFD_ACCOUNT, policies[1].Principal.Type)
+	s.Require().Equal(groupIDs[0].String(), policies[2].Principal.Value)
+	s.Require().Equal(groupIDs[1].String(), policies[3].Principal.Value)
+
+	// the deleted policy is not listed anymore
+	_, err = s.permissionKeeper.DeletePolicy(s.ctx, &types.Principal{Type: types.PRINCIPAL_TYPE_GNFD_GROUP, Value: groupIDs[0].String()},
+		resource.RESOURCE_TYPE_BUCKET, resourceID)
+	s.Require().NoError(err)
+	s.Require().Len(s.permissionKeeper.GetPoliciesForResource(s.ctx, resourceID, resource.RESOURCE_TYPE_BUCKET), 3)
+	s.Require().Empty(s.permissionKeeper.GetPoliciesForResource(s.ctx, resourceID, resource.RESOURCE_TYPE_OBJECT))
+}
@@ -172,6 +172,8 @@ type Statement struct {
 	ExpirationTime *time.Time `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
 	// limit_size defines the total data size that is allowed to operate. If not explicitly specified, it means it will not limit.
 	LimitSize *common.UInt64Value `protobuf:"bytes,5,opt,name=limit_size,json=limitSize,proto3" json:"limit_size,omitempty"`
+	// conditions define when the statement applies, the statement is skipped unless all of its conditions hold.
+	Conditions []Condition `protobuf:"bytes,6,rep,name=conditions,proto3" json:"conditions"`
 }
 
 func (m *Statement) Reset()         { *m = Statement{} }
@@ -242,6 +244,73 @@ func (m *Statement) GetLimitSize() *common.UInt64Value {
 	return nil
 }
 
+func (m *Statement) GetConditions() []Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+// Condition is a key evaluated on chain against the block time and the object operated.
+type Condition struct {
+	// key defines what the condition checks, it can be one of block_time_before, block_time_after,
+	// object_size_lte, object_name_prefix, content_type_in and tag_equals.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// values define what the key is compared with. block_time_before and block_time_after take one unix timestamp
+	// and object_size_lte takes one size in bytes. For the other keys the condition holds if any of the values
+	// matches, the values of tag_equals are `key=value` pairs. tag_equals is evaluated against the tags of the object
+	// operated, or of the bucket for the actions on the bucket itself. An object has no tags before it is created, so
+	// the tags of the bucket are evaluated for CreateObject and CopyObject as well.
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *Condition) Reset()         { *m = Condition{} }
+func (m *Condition) String() string { return proto.CompactTextString(m) }
+func (*Condition) ProtoMessage()    {}
+func (*Condition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ba066bc2a84777, []int{1}
+}
+func (m *Condition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Condition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Condition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Condition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Condition.Merge(m, src)
+}
+func (m *Condition) XXX_Size() int {
+	return m.Size()
+}
+func (m *Condition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Condition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Condition proto.InternalMessageInfo
+
+func (m *Condition) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Condition) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 // Principal define the roles that can be grant permissions to. Currently, it can be account or group.
 type Principal struct {
 	Type PrincipalType `protobuf:"varint,1,opt,name=type,proto3,enum=moca.permission.PrincipalType" json:"type,omitempty"`
@@ -254,7 +323,7 @@ func (m *Principal) Reset()         { *m = Principal{} }
 func (m *Principal) String() string { return proto.CompactTextString(m) }
 func (*Principal) ProtoMessage()    {}
 func (*Principal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e2ba066bc2a84777, []int{1}
+	return fileDescriptor_e2ba066bc2a84777, []int{2}
 }
 func (m *Principal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,57 +371,61 @@ func init() {
 	proto.RegisterEnum("moca.permission.Effect", Effect_name, Effect_value)
 	proto.RegisterEnum("moca.permission.PrincipalType", PrincipalType_name, PrincipalType_value)
 	proto.RegisterType((*Statement)(nil), "moca.permission.Statement")
+	proto.RegisterType((*Condition)(nil), "moca.permission.Condition")
 	proto.RegisterType((*Principal)(nil), "moca.permission.Principal")
 }
 
 func init() { proto.RegisterFile("moca/permission/common.proto", fileDescriptor_e2ba066bc2a84777) }
 
 var fileDescriptor_e2ba066bc2a84777 = []byte{
-	// 703 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0x93, 0x10, 0xc8, 0x05, 0x12, 0xbf, 0x01, 0x1e, 0x4e, 0xc8, 0x73, 0x22, 0x56, 0x11,
-	0x8b, 0x58, 0xca, 0x7b, 0xaf, 0xbb, 0x2e, 0x1c, 0x67, 0x82, 0xdc, 0x06, 0xdb, 0x72, 0x9c, 0x16,
-	0xba, 0xb1, 0x8c, 0x35, 0x04, 0x4b, 0x71, 0x6c, 0xd9, 0x0e, 0x05, 0xbe, 0xa0, 0x4b, 0xfe, 0xa0,
-	0x8b, 0x7e, 0x4b, 0x25, 0x96, 0x2c, 0xbb, 0x6a, 0x2b, 0xf8, 0x91, 0x6a, 0x6c, 0x87, 0x24, 0x84,
-	0x9d, 0xe7, 0x9c, 0x73, 0xcf, 0xdc, 0x73, 0x67, 0xc6, 0x50, 0x73, 0x3d, 0xdb, 0x12, 0x7c, 0x12,
-	0xb8, 0x4e, 0x18, 0x3a, 0xde, 0x44, 0xb0, 0x3d, 0xd7, 0xf5, 0x26, 0x2d, 0x3f, 0xf0, 0x22, 0x0f,
-	0x95, 0x29, 0xdb, 0x9a, 0xb3, 0xd5, 0x8a, 0xed, 0x85, 0xae, 0x17, 0x9a, 0x31, 0x2d, 0x24, 0x8b,
-	0x44, 0x5b, 0xdd, 0x1d, 0x79, 0x23, 0x2f, 0xc1, 0xe9, 0x57, 0x8a, 0xd6, 0x47, 0x9e, 0x37, 0x1a,
-	0x13, 0x21, 0x5e, 0x9d, 0x4f, 0x2f, 0x84, 0xc8, 0x71, 0x49, 0x18, 0x59, 0xae, 0x9f, 0x0a, 0x2a,
-	0x71, 0x03, 0xc9, 0xae, 0xc2, 0xe7, 0xc0, 0xf2, 0x7d, 0x12, 0x24, 0xd4, 0xe1, 0xd7, 0x2c, 0x14,
-	0x07, 0x91, 0x15, 0x11, 0x97, 0x4c, 0x22, 0x24, 0x40, 0x81, 0x5c, 0x5c, 0x10, 0x3b, 0xe2, 0x98,
-	0x06, 0xd3, 0x2c, 0xb5, 0xf7, 0x5b, 0x2f, 0x9a, 0x6b, 0xe1, 0x98, 0xd6, 0x53, 0x19, 0xfa, 0x1f,
-	0xd6, 0x2d, 0x3b, 0x72, 0xbc, 0x49, 0xc8, 0x65, 0x1b, 0xb9, 0x66, 0xa9, 0x7d, 0xb0, 0x52, 0x21,
-	0xc6, 0xbc, 0x71, 0xe3, 0x13, 0x7d, 0xa6, 0x45, 0x35, 0x28, 0x06, 0x24, 0xf4, 0xa6, 0x81, 0x4d,
-	0x42, 0x2e, 0xd7, 0xc8, 0x35, 0x8b, 0xfa, 0x1c, 0x40, 0x27, 0x50, 0x26, 0xd7, 0xbe, 0x13, 0x58,
-	0x54, 0x6c, 0xd2, 0x30, 0x5c, 0xbe, 0xc1, 0x34, 0x37, 0xdb, 0xd5, 0x56, 0x92, 0xb4, 0x35, 0x4b,
-	0xda, 0x32, 0x66, 0x49, 0x3b, 0x1b, 0xf7, 0x3f, 0xeb, 0xcc, 0xdd, 0xaf, 0x3a, 0xa3, 0x97, 0xe6,
-	0xc5, 0x94, 0x46, 0x6f, 0x01, 0xc6, 0x8e, 0xeb, 0x44, 0x66, 0xe8, 0xdc, 0x12, 0x6e, 0x2d, 0x76,
-	0xe2, 0x92, 0x36, 0xd3, 0x83, 0x18, 0xca, 0x93, 0xe8, 0xcd, 0x7f, 0x1f, 0xac, 0xf1, 0x94, 0x74,
-	0xf2, 0xd4, 0x47, 0x2f, 0xc6, 0x15, 0x03, 0xe7, 0x96, 0x1c, 0x0e, 0xa1, 0xa8, 0x05, 0xce, 0xc4,
-	0x76, 0x7c, 0x6b, 0x8c, 0xda, 0x90, 0x8f, 0x6e, 0x7c, 0x92, 0x8e, 0x87, 0x5f, 0x09, 0xfb, 0xac,
-	0x8c, 0xf3, 0xc6, 0x5a, 0xb4, 0x0b, 0x6b, 0x57, 0xd4, 0x9a, 0xcb, 0x36, 0x98, 0x66, 0x51, 0x4f,
-	0x16, 0x47, 0xdf, 0x73, 0x00, 0xf3, 0xd1, 0xa0, 0xbf, 0x01, 0x89, 0x92, 0x21, 0xab, 0x8a, 0x39,
-	0x54, 0x06, 0x1a, 0x96, 0xe4, 0x9e, 0x8c, 0xbb, 0x6c, 0x06, 0xfd, 0x03, 0x95, 0x19, 0xae, 0x75,
-	0x45, 0x03, 0x9b, 0x9d, 0xa1, 0xf4, 0x1e, 0x1b, 0xa6, 0xac, 0xf4, 0x54, 0x96, 0x41, 0x1c, 0xec,
-	0xa6, 0x74, 0x17, 0xf7, 0xf1, 0x33, 0xcd, 0x66, 0x17, 0x18, 0x49, 0xc7, 0xb4, 0x50, 0xed, 0xbc,
-	0xc3, 0x92, 0xc1, 0xe6, 0x56, 0x6b, 0x52, 0x26, 0xbf, 0xd0, 0x84, 0xa4, 0x6a, 0x67, 0x33, 0x7c,
-	0x0d, 0xed, 0xc1, 0x5f, 0x29, 0x7e, 0x8c, 0x8d, 0x19, 0x5c, 0x40, 0x15, 0xd8, 0x4b, 0x61, 0x7c,
-	0x8a, 0xa5, 0xe1, 0xdc, 0x69, 0x7d, 0xc1, 0xa9, 0x2f, 0x0f, 0x9e, 0x4b, 0x36, 0x10, 0x0f, 0xd5,
-	0xe5, 0x38, 0xc7, 0xba, 0x3a, 0xd4, 0xcc, 0x13, 0x7c, 0xd2, 0xc1, 0x3a, 0x5b, 0x44, 0xfb, 0xb0,
-	0xb3, 0xdc, 0x5b, 0xcc, 0xb3, 0xb0, 0x3a, 0x87, 0xc4, 0x32, 0x99, 0xc3, 0xe6, 0x2a, 0x9d, 0xf8,
-	0xe2, 0x53, 0x43, 0x17, 0xd9, 0x2d, 0x54, 0x03, 0xee, 0x35, 0x3a, 0x2e, 0xde, 0x46, 0x0d, 0xa8,
-	0xbd, 0xea, 0x2d, 0xa9, 0x8a, 0x81, 0x15, 0x83, 0x2d, 0xa1, 0x1d, 0x28, 0xa7, 0x0a, 0xe3, 0x4c,
-	0xc3, 0xa6, 0xd8, 0xef, 0xb3, 0x76, 0x35, 0xff, 0xe5, 0x1b, 0x9f, 0x39, 0x92, 0xa1, 0x90, 0xbc,
-	0x09, 0x9a, 0x19, 0xf7, 0x7a, 0xb4, 0x70, 0xf9, 0x08, 0x59, 0xd8, 0x4a, 0x71, 0xb1, 0xdf, 0x57,
-	0x3f, 0xb2, 0x0c, 0x2a, 0xc3, 0x66, 0x8a, 0x74, 0xb1, 0x72, 0xc6, 0x66, 0x53, 0xab, 0x29, 0x6c,
-	0x2f, 0xdd, 0x1f, 0x3a, 0x2d, 0x4d, 0x97, 0x15, 0x49, 0xd6, 0xc4, 0x7e, 0xb2, 0xf3, 0xb2, 0x73,
-	0x1d, 0x0e, 0x5e, 0xf0, 0xc7, 0x4a, 0xaf, 0x6b, 0x8a, 0x92, 0xa4, 0x0e, 0x15, 0x83, 0x65, 0xe8,
-	0x58, 0x5e, 0x13, 0x24, 0x43, 0x4d, 0xb7, 0xed, 0xc8, 0xf7, 0x8f, 0x3c, 0xf3, 0xf0, 0xc8, 0x33,
-	0xbf, 0x1f, 0x79, 0xe6, 0xee, 0x89, 0xcf, 0x3c, 0x3c, 0xf1, 0x99, 0x1f, 0x4f, 0x7c, 0xe6, 0x93,
-	0x30, 0x72, 0xa2, 0xcb, 0xe9, 0x39, 0x7d, 0x26, 0x02, 0xbd, 0xe9, 0xf6, 0xa5, 0xe5, 0x4c, 0xe2,
-	0x2f, 0xe1, 0xaa, 0x2d, 0x5c, 0x2f, 0xfe, 0xd2, 0xe8, 0x4d, 0x0f, 0xcf, 0x0b, 0xf1, 0xc3, 0xfc,
-	0xf7, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39, 0x58, 0x2b, 0x4d, 0xf2, 0x04, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x15, 0x25, 0x59, 0x09, 0xaf, 0x13, 0x9b, 0x9d, 0x38, 0x09, 0xa5, 0xa8, 0x94, 0xe0, 0x95,
+	0x90, 0x85, 0x08, 0xa8, 0x4d, 0x77, 0x05, 0x4a, 0x51, 0x23, 0x83, 0xad, 0x4c, 0x12, 0x14, 0xd5,
+	0xc6, 0xdd, 0x10, 0x34, 0x3b, 0x56, 0x88, 0x8a, 0x0f, 0x90, 0x54, 0x1a, 0xe7, 0x0b, 0xba, 0xcc,
+	0x3f, 0xf4, 0x5b, 0x0a, 0x64, 0xe9, 0x65, 0x57, 0x7d, 0xd8, 0x3f, 0x52, 0xcc, 0x0c, 0xf5, 0xb2,
+	0xbc, 0x9b, 0x39, 0xe7, 0xdc, 0x73, 0x5f, 0x43, 0x42, 0x3b, 0x4a, 0x02, 0x5f, 0x4d, 0x49, 0x16,
+	0x85, 0x79, 0x1e, 0x26, 0xb1, 0x1a, 0x24, 0x51, 0x94, 0xc4, 0xfd, 0x34, 0x4b, 0x8a, 0x04, 0x1d,
+	0x53, 0xb6, 0xbf, 0x61, 0x5b, 0xcd, 0x20, 0xc9, 0xa3, 0x24, 0xf7, 0x18, 0xad, 0xf2, 0x0b, 0xd7,
+	0xb6, 0x4e, 0xe6, 0xc9, 0x3c, 0xe1, 0x38, 0x3d, 0x95, 0x68, 0x67, 0x9e, 0x24, 0xf3, 0x05, 0x51,
+	0xd9, 0xed, 0x72, 0x79, 0xa5, 0x16, 0x61, 0x44, 0xf2, 0xc2, 0x8f, 0xd2, 0x52, 0xd0, 0x64, 0x05,
+	0xf0, 0xac, 0xea, 0x6f, 0x99, 0x9f, 0xa6, 0x24, 0xe3, 0xd4, 0xe9, 0x7f, 0x55, 0x10, 0xa7, 0x85,
+	0x5f, 0x90, 0x88, 0xc4, 0x05, 0x52, 0xa1, 0x41, 0xae, 0xae, 0x48, 0x50, 0xc8, 0x42, 0x57, 0xe8,
+	0x1d, 0x0d, 0x5e, 0xf6, 0xef, 0x15, 0xd7, 0xc7, 0x8c, 0x76, 0x4a, 0x19, 0x7a, 0x03, 0x8f, 0xfc,
+	0xa0, 0x08, 0x93, 0x38, 0x97, 0xab, 0xdd, 0x5a, 0xef, 0x68, 0xf0, 0x6a, 0x2f, 0x42, 0x63, 0xbc,
+	0x7b, 0x9d, 0x12, 0x67, 0xa5, 0x45, 0x6d, 0x10, 0x33, 0x92, 0x27, 0xcb, 0x2c, 0x20, 0xb9, 0x5c,
+	0xeb, 0xd6, 0x7a, 0xa2, 0xb3, 0x01, 0xd0, 0x39, 0x1c, 0x93, 0x0f, 0x69, 0x98, 0xf9, 0x54, 0xec,
+	0xd1, 0x66, 0xe4, 0x7a, 0x57, 0xe8, 0x1d, 0x0e, 0x5a, 0x7d, 0xde, 0x69, 0x7f, 0xd5, 0x69, 0xdf,
+	0x5d, 0x75, 0x3a, 0x7c, 0xfc, 0xf9, 0xef, 0x8e, 0xf0, 0xe9, 0x9f, 0x8e, 0xe0, 0x1c, 0x6d, 0x82,
+	0x29, 0x8d, 0xbe, 0x05, 0x58, 0x84, 0x51, 0x58, 0x78, 0x79, 0xf8, 0x91, 0xc8, 0x07, 0xcc, 0x49,
+	0xe6, 0x65, 0x96, 0x8b, 0x98, 0x19, 0x71, 0xf1, 0xcd, 0xd7, 0x3f, 0xfa, 0x8b, 0x25, 0x19, 0xd6,
+	0xa9, 0x8f, 0x23, 0xb2, 0x88, 0x69, 0xf8, 0x91, 0xa0, 0xef, 0x00, 0x82, 0x24, 0xfe, 0x25, 0xe4,
+	0x5d, 0x36, 0xba, 0x35, 0x56, 0xc8, 0xfd, 0x2e, 0xf5, 0x95, 0x84, 0x19, 0x54, 0x9c, 0xad, 0x98,
+	0xd3, 0x37, 0x20, 0xae, 0x69, 0x24, 0x41, 0xed, 0x57, 0x72, 0xcd, 0xe6, 0x2b, 0x3a, 0xf4, 0x88,
+	0x5e, 0x40, 0xe3, 0x3d, 0x4d, 0xcd, 0x47, 0x28, 0x3a, 0xe5, 0xed, 0x74, 0x06, 0xa2, 0x9d, 0x85,
+	0x71, 0x10, 0xa6, 0xfe, 0x02, 0x0d, 0xa0, 0x5e, 0x5c, 0xa7, 0xa4, 0xdc, 0x8b, 0xb2, 0x97, 0x7f,
+	0xad, 0x64, 0x83, 0x66, 0x5a, 0x74, 0x02, 0x07, 0xcc, 0x4a, 0xae, 0xb2, 0x64, 0xfc, 0xf2, 0xfa,
+	0xcf, 0x1a, 0xc0, 0x66, 0x27, 0xe8, 0x05, 0x20, 0x4d, 0x77, 0x0d, 0xcb, 0xf4, 0x66, 0xe6, 0xd4,
+	0xc6, 0xba, 0x31, 0x36, 0xf0, 0x48, 0xaa, 0xa0, 0x2f, 0xa1, 0xb9, 0xc2, 0xed, 0x91, 0xe6, 0x62,
+	0x6f, 0x38, 0xd3, 0x7f, 0xc0, 0xae, 0x67, 0x98, 0x63, 0x4b, 0x12, 0x90, 0x0c, 0x27, 0x25, 0x3d,
+	0xc2, 0x13, 0xbc, 0xa6, 0xa5, 0xea, 0x16, 0xa3, 0x3b, 0x98, 0x06, 0x5a, 0xc3, 0xef, 0xb1, 0xee,
+	0x4a, 0xb5, 0xfd, 0x98, 0x92, 0xa9, 0x6f, 0x15, 0xa1, 0x5b, 0xf6, 0xc5, 0x0a, 0x3f, 0x40, 0xcf,
+	0xe1, 0x8b, 0x12, 0x3f, 0xc3, 0xee, 0x0a, 0x6e, 0xa0, 0x26, 0x3c, 0x2f, 0x61, 0xfc, 0x16, 0xeb,
+	0xb3, 0x8d, 0xd3, 0xa3, 0x2d, 0xa7, 0x89, 0x31, 0x5d, 0x87, 0x3c, 0x46, 0x0a, 0xb4, 0x76, 0xdb,
+	0x39, 0x73, 0xac, 0x99, 0xed, 0x9d, 0xe3, 0xf3, 0x21, 0x76, 0x24, 0x11, 0xbd, 0x84, 0x67, 0xbb,
+	0xb5, 0x31, 0x5e, 0x82, 0xfd, 0x39, 0x70, 0x4b, 0x3e, 0x87, 0xc3, 0x7d, 0x9a, 0xfb, 0xe2, 0xb7,
+	0xae, 0xa3, 0x49, 0x4f, 0x50, 0x1b, 0xe4, 0x87, 0x68, 0x16, 0xfc, 0x14, 0x75, 0xa1, 0xfd, 0xa0,
+	0xb7, 0x6e, 0x99, 0x2e, 0x36, 0x5d, 0xe9, 0x08, 0x3d, 0x83, 0xe3, 0x52, 0xe1, 0x5e, 0xd8, 0xd8,
+	0xd3, 0x26, 0x13, 0x29, 0x68, 0xd5, 0x7f, 0xff, 0x43, 0xa9, 0xbc, 0x36, 0xa0, 0xc1, 0x3f, 0x46,
+	0xda, 0x33, 0x1e, 0x8f, 0x69, 0xe0, 0xee, 0x0a, 0x25, 0x78, 0x52, 0xe2, 0xda, 0x64, 0x62, 0xfd,
+	0x24, 0x09, 0xe8, 0x18, 0x0e, 0x4b, 0x64, 0x84, 0xcd, 0x0b, 0xa9, 0x5a, 0x5a, 0x2d, 0xe1, 0xe9,
+	0xce, 0xfb, 0xa1, 0xd3, 0xb2, 0x1d, 0xc3, 0xd4, 0x0d, 0x5b, 0x9b, 0xf0, 0xcc, 0xbb, 0xce, 0x1d,
+	0x78, 0x75, 0x8f, 0x3f, 0x33, 0xc7, 0x23, 0x4f, 0xd3, 0x75, 0x6b, 0x66, 0xba, 0x92, 0x40, 0xc7,
+	0xf2, 0x90, 0x80, 0x0f, 0xb5, 0x4c, 0x3b, 0x34, 0x3e, 0xdf, 0x2a, 0xc2, 0xcd, 0xad, 0x22, 0xfc,
+	0x7b, 0xab, 0x08, 0x9f, 0xee, 0x94, 0xca, 0xcd, 0x9d, 0x52, 0xf9, 0xeb, 0x4e, 0xa9, 0xfc, 0xac,
+	0xce, 0xc3, 0xe2, 0xdd, 0xf2, 0x92, 0x7e, 0x9f, 0x2a, 0x7d, 0xe9, 0xc1, 0x3b, 0x3f, 0x8c, 0xd9,
+	0x49, 0x7d, 0x3f, 0x50, 0x3f, 0x6c, 0xff, 0x4b, 0xe9, 0x4b, 0xcf, 0x2f, 0x1b, 0xec, 0x8f, 0xf0,
+	0xd5, 0xff, 0x03, 0x00, 0x98, 0x10, 0x4d, 0x13, 0x6b, 0x05, 0x00, 0x00,
 }
 
 func (m *Statement) Marshal() (dAtA []byte, err error) {
@@ -375,6 +448,20 @@ func (m *Statement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.LimitSize != nil {
 		{
 			size, err := m.LimitSize.MarshalToSizedBuffer(dAtA[:i])
@@ -432,6 +519,45 @@ func (m *Statement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Condition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintCommon(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Principal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -508,6 +634,31 @@ func (m *Statement) Size() (n int) {
 		l = m.LimitSize.Size()
 		n += 1 + l + sovCommon(uint64(l))
 	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Condition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -754,6 +905,154 @@ func (m *Statement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Condition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
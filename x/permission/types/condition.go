@@ -0,0 +1,115 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ConditionKeyBlockTimeBefore  = "block_time_before"
+	ConditionKeyBlockTimeAfter   = "block_time_after"
+	ConditionKeyObjectSizeLte    = "object_size_lte"
+	ConditionKeyObjectNamePrefix = "object_name_prefix"
+	ConditionKeyContentTypeIn    = "content_type_in"
+	ConditionKeyTagEquals        = "tag_equals"
+
+	MaxConditionsPerStatement = 8
+	MaxConditionValues        = 16
+)
+
+func (c *Condition) ValidateBasic() error {
+	if len(c.Values) == 0 {
+		return ErrInvalidStatement.Wrapf("The condition %s has no values.", c.Key)
+	}
+	if len(c.Values) > MaxConditionValues {
+		return ErrInvalidStatement.Wrapf("The condition %s has more than %d values.", c.Key, MaxConditionValues)
+	}
+	switch c.Key {
+	case ConditionKeyBlockTimeBefore, ConditionKeyBlockTimeAfter, ConditionKeyObjectSizeLte:
+		if len(c.Values) != 1 {
+			return ErrInvalidStatement.Wrapf("The condition %s takes exactly one value.", c.Key)
+		}
+		if c.Key == ConditionKeyObjectSizeLte {
+			if _, err := strconv.ParseUint(c.Values[0], 10, 64); err != nil {
+				return ErrInvalidStatement.Wrapf("The condition %s takes a size in bytes, err: %s", c.Key, err)
+			}
+		} else if _, err := strconv.ParseInt(c.Values[0], 10, 64); err != nil {
+			return ErrInvalidStatement.Wrapf("The condition %s takes a unix timestamp, err: %s", c.Key, err)
+		}
+	case ConditionKeyObjectNamePrefix, ConditionKeyContentTypeIn:
+	case ConditionKeyTagEquals:
+		for _, v := range c.Values {
+			if key, _, ok := strings.Cut(v, "="); !ok || key == "" {
+				return ErrInvalidStatement.Wrapf("The condition %s takes key=value pairs, got %s", c.Key, v)
+			}
+		}
+	default:
+		return ErrInvalidStatement.Wrapf("Unknown condition key %s.", c.Key)
+	}
+	return nil
+}
+
+// Holds reports whether the condition is met at the block time for the resource described by opts. A condition on
+// the object or its tags does not hold when opts lacks what it checks, so that the statement never applies beyond it.
+func (c *Condition) Holds(blockTime time.Time, opts *VerifyOptions) bool {
+	switch c.Key {
+	case ConditionKeyBlockTimeBefore, ConditionKeyBlockTimeAfter:
+		if len(c.Values) != 1 {
+			return false
+		}
+		timestamp, err := strconv.ParseInt(c.Values[0], 10, 64)
+		if err != nil {
+			return false
+		}
+		if c.Key == ConditionKeyBlockTimeBefore {
+			return blockTime.Unix() < timestamp
+		}
+		return blockTime.Unix() > timestamp
+	case ConditionKeyObjectSizeLte:
+		if len(c.Values) != 1 || opts == nil || opts.WantedSize == nil {
+			return false
+		}
+		size, err := strconv.ParseUint(c.Values[0], 10, 64)
+		return err == nil && *opts.WantedSize <= size
+	case ConditionKeyObjectNamePrefix:
+		if opts == nil || opts.ObjectName == "" {
+			return false
+		}
+		for _, prefix := range c.Values {
+			if strings.HasPrefix(opts.ObjectName, prefix) {
+				return true
+			}
+		}
+	case ConditionKeyContentTypeIn:
+		if opts == nil {
+			return false
+		}
+		for _, contentType := range c.Values {
+			if opts.ContentType == contentType {
+				return true
+			}
+		}
+	case ConditionKeyTagEquals:
+		if opts == nil {
+			return false
+		}
+		for _, v := range c.Values {
+			key, value, _ := strings.Cut(v, "=")
+			if tagValue, ok := opts.Tags[key]; ok && tagValue == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ConditionsHold reports whether all conditions of the statement are met.
+func (s *Statement) ConditionsHold(blockTime time.Time, opts *VerifyOptions) bool {
+	for i := range s.Conditions {
+		if !s.Conditions[i].Holds(blockTime, opts) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,144 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/types/resource"
+	"github.com/mocachain/moca/v2/x/permission/types"
+)
+
+func TestPolicy_Conditions(t *testing.T) {
+	blockTime := time.Unix(1_700_000_000, 0)
+	size := func(s uint64) *uint64 { return &s }
+
+	// the partner can only upload objects under 100 MB into uploads/partner-a/
+	policy := &types.Policy{
+		Statements: []*types.Statement{{
+			Effect:  types.EFFECT_ALLOW,
+			Actions: []types.ActionType{types.ACTION_CREATE_OBJECT},
+			Conditions: []types.Condition{
+				{Key: types.ConditionKeyObjectNamePrefix, Values: []string{"uploads/partner-a/"}},
+				{Key: types.ConditionKeyObjectSizeLte, Values: []string{"104857600"}},
+				{Key: types.ConditionKeyBlockTimeBefore, Values: []string{"1800000000"}},
+			},
+		}},
+	}
+
+	tests := []struct {
+		name         string
+		blockTime    time.Time
+		opts         *types.VerifyOptions
+		expectEffect types.Effect
+	}{
+		{
+			name:         "all conditions hold",
+			blockTime:    blockTime,
+			opts:         &types.VerifyOptions{ObjectName: "uploads/partner-a/a.png", WantedSize: size(1024)},
+			expectEffect: types.EFFECT_ALLOW,
+		},
+		{
+			name:         "object name out of the prefix",
+			blockTime:    blockTime,
+			opts:         &types.VerifyOptions{ObjectName: "uploads/partner-b/a.png", WantedSize: size(1024)},
+			expectEffect: types.EFFECT_UNSPECIFIED,
+		},
+		{
+			name:         "object too large",
+			blockTime:    blockTime,
+			opts:         &types.VerifyOptions{ObjectName: "uploads/partner-a/a.iso", WantedSize: size(104857601)},
+			expectEffect: types.EFFECT_UNSPECIFIED,
+		},
+		{
+			name:         "block time out of the window",
+			blockTime:    time.Unix(1_800_000_000, 0),
+			opts:         &types.VerifyOptions{ObjectName: "uploads/partner-a/a.png", WantedSize: size(1024)},
+			expectEffect: types.EFFECT_UNSPECIFIED,
+		},
+		{
+			name:         "no object to evaluate with",
+			blockTime:    blockTime,
+			opts:         nil,
+			expectEffect: types.EFFECT_UNSPECIFIED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			effect, _ := policy.Eval(types.ACTION_CREATE_OBJECT, tt.blockTime, tt.opts)
+			require.Equal(t, tt.expectEffect, effect)
+		})
+	}
+}
+
+func TestCondition_Holds(t *testing.T) {
+	opts := &types.VerifyOptions{ContentType: "image/png", Tags: map[string]string{"env": "prod"}}
+
+	contentType := types.Condition{Key: types.ConditionKeyContentTypeIn, Values: []string{"image/jpeg", "image/png"}}
+	require.True(t, contentType.Holds(time.Now(), opts))
+	contentType.Values = []string{"text/plain"}
+	require.False(t, contentType.Holds(time.Now(), opts))
+
+	tag := types.Condition{Key: types.ConditionKeyTagEquals, Values: []string{"env=dev", "env=prod"}}
+	require.True(t, tag.Holds(time.Now(), opts))
+	tag.Values = []string{"env=dev"}
+	require.False(t, tag.Holds(time.Now(), opts))
+
+	after := types.Condition{Key: types.ConditionKeyBlockTimeAfter, Values: []string{"100"}}
+	require.True(t, after.Holds(time.Unix(101, 0), nil))
+	require.False(t, after.Holds(time.Unix(100, 0), nil))
+}
+
+func TestStatement_ValidateConditions(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []types.Condition
+		expectErr  bool
+	}{
+		{
+			name:       "valid",
+			conditions: []types.Condition{{Key: types.ConditionKeyTagEquals, Values: []string{"env=prod"}}},
+		},
+		{
+			name:       "unknown key",
+			conditions: []types.Condition{{Key: "source_ip", Values: []string{"127.0.0.1"}}},
+			expectErr:  true,
+		},
+		{
+			name:       "no values",
+			conditions: []types.Condition{{Key: types.ConditionKeyObjectNamePrefix}},
+			expectErr:  true,
+		},
+		{
+			name:       "invalid size",
+			conditions: []types.Condition{{Key: types.ConditionKeyObjectSizeLte, Values: []string{"100MB"}}},
+			expectErr:  true,
+		},
+		{
+			name:       "more than one timestamp",
+			conditions: []types.Condition{{Key: types.ConditionKeyBlockTimeBefore, Values: []string{"1", "2"}}},
+			expectErr:  true,
+		},
+		{
+			name:       "invalid tag pair",
+			conditions: []types.Condition{{Key: types.ConditionKeyTagEquals, Values: []string{"prod"}}},
+			expectErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement := &types.Statement{
+				Effect:     types.EFFECT_ALLOW,
+				Actions:    []types.ActionType{types.ACTION_DELETE_GROUP},
+				Conditions: tt.conditions,
+			}
+			err := statement.ValidateBasic(resource.RESOURCE_TYPE_GROUP)
+			if tt.expectErr {
+				require.ErrorIs(t, err, types.ErrInvalidStatement)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -21,6 +21,10 @@ type (
 type VerifyOptions struct {
 	Resource   string
 	WantedSize *uint64
+	// ObjectName, ContentType and Tags describe the resource operated for the statement conditions
+	ObjectName  string
+	ContentType string
+	Tags        map[string]string
 }
 
 var (
@@ -98,6 +102,9 @@ func (p *Policy) Eval(action ActionType, blockTime time.Time, opts *VerifyOption
 		if s.ExpirationTime != nil && s.ExpirationTime.Before(blockTime) {
 			continue
 		}
+		if !s.ConditionsHold(blockTime, opts) {
+			continue
+		}
 		e, updatedStatement := s.Eval(action, opts)
 		// statement need to be updated
 		if updatedStatement != nil {
@@ -194,6 +201,14 @@ func (s *Statement) ValidateBasic(resType resource.ResourceType) error {
 	if s.Effect == EFFECT_UNSPECIFIED {
 		return ErrInvalidStatement.Wrap("Please specify the Effect explicitly. Not allowed set EFFECT_UNSPECIFIED")
 	}
+	if len(s.Conditions) > MaxConditionsPerStatement {
+		return ErrInvalidStatement.Wrapf("A statement can have at most %d conditions.", MaxConditionsPerStatement)
+	}
+	for i := range s.Conditions {
+		if err := s.Conditions[i].ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	switch resType {
 	case resource.RESOURCE_TYPE_UNSPECIFIED:
 		return ErrInvalidStatement.Wrap("Please specify the ResourceType explicitly. Not allowed set RESOURCE_TYPE_UNSPECIFIED")
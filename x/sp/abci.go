@@ -13,6 +13,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	if ctx.BlockHeight()%types.MaintenanceRecordsGCFrequencyInBlocks == 0 {
 		k.ForceUpdateMaintenanceRecords(ctx)
 	}
+	k.ApplyPendingSpStoragePrices(ctx)
 
 	needUpdate := false
 	price, err := k.GetGlobalSpStorePriceByTime(ctx, ctx.BlockTime().Unix()+1)
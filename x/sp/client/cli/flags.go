@@ -27,6 +27,7 @@ const (
 	FlagReadPrice     = "read-price"
 	FlagStorePrice    = "store-price"
 	FlagFreeReadQuota = "free-read-quota"
+	FlagEffectiveTime = "effective-time"
 
 	FlagSecurityContact = "security-contact"
 
@@ -30,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 		CmdMaintenanceRecordsBySPOperatorAddress(),
 		CmdStorageProviderPrice(),
 		CmdStorageProviderGlobalPrice(),
+		CmdPendingStorageProviderPrices(),
+		CmdNextStorageProviderGlobalPrice(),
 	)
 
 	return cmd
@@ -229,3 +231,54 @@ func CmdStorageProviderGlobalPrice() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdPendingStorageProviderPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-prices",
+		Short: "Query the prices the storage providers scheduled to take effect later",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).
+				PendingSpStoragePrices(cmd.Context(), &types.QueryPendingSpStoragePricesRequest{
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	return cmd
+}
+
+func CmdNextStorageProviderGlobalPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-global-price",
+		Short: "Query the global price projected to take effect at the next update, including the scheduled sp prices",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).
+				NextGlobalSpStorePrice(cmd.Context(), &types.QueryNextGlobalSpStorePriceRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -103,6 +103,14 @@ func (c *QueryClientEVM) StorageProviderMaintenanceRecordsByOperatorAddress(ctx
 	return nil, nil
 }
 
+func (c *QueryClientEVM) PendingSpStoragePrices(ctx context.Context, in *types.QueryPendingSpStoragePricesRequest, opts ...grpc.CallOption) (*types.QueryPendingSpStoragePricesResponse, error) {
+	return nil, nil
+}
+
+func (c *QueryClientEVM) NextGlobalSpStorePrice(ctx context.Context, in *types.QueryNextGlobalSpStorePriceRequest, opts ...grpc.CallOption) (*types.QueryNextGlobalSpStorePriceResponse, error) {
+	return nil, nil
+}
+
 func toPbSP(p *spp.StorageProvider) *types.StorageProvider {
 	if p == nil {
 		return nil
@@ -731,8 +731,11 @@ E.g. the price is 0.02183945725, means approximately $0.018 / GB / Month.
 
 The free-read-quota unit is bytes, for 1GB free quota, it is 1073741824.
 
+The prices take effect at --effective-time (in unix), which must give at least the minimum price change notice
+in the params. If it is not set, the prices take effect as soon as the notice allows.
+
 Examples:
- $ %s tx %s update-price 0x... 0.1469890427 0.02183945725 1073741824
+ $ %s tx %s update-price 0x... 0.1469890427 0.02183945725 1073741824 --effective-time 1704067200
 	`, version.AppName, types.ModuleName),
 		),
 		Args: cobra.ExactArgs(4),
@@ -757,11 +760,16 @@ Examples:
 			if err != nil {
 				return err
 			}
+			effectiveTime, err := cmd.Flags().GetInt64(FlagEffectiveTime)
+			if err != nil {
+				return err
+			}
 			msg := types.MsgUpdateSpStoragePrice{
 				SpAddress:     spAddress.String(),
 				ReadPrice:     readPrice,
 				StorePrice:    storePrice,
 				FreeReadQuota: quota,
+				EffectiveTime: effectiveTime,
 			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -770,5 +778,6 @@ Examples:
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagEffectiveTime, 0, "The time the prices take effect, in unix seconds")
 	return cmd
 }
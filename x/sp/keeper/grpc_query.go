@@ -78,6 +78,42 @@ func (k Keeper) QueryGlobalSpStorePriceByTime(goCtx context.Context, req *types.
 	return &types.QueryGlobalSpStorePriceByTimeResponse{GlobalSpStorePrice: price}, nil
 }
 
+func (k Keeper) PendingSpStoragePrices(goCtx context.Context, req *types.QueryPendingSpStoragePricesRequest) (*types.QueryPendingSpStoragePricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var prices []types.SpStoragePrice
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpStoragePriceKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var price types.SpStoragePrice
+		if err := k.cdc.Unmarshal(value, &price); err != nil {
+			return err
+		}
+		price.SpId = types.ParseSpStoragePriceKey(key)
+		prices = append(prices, price)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPendingSpStoragePricesResponse{PendingPrices: prices, Pagination: pageRes}, nil
+}
+
+func (k Keeper) NextGlobalSpStorePrice(goCtx context.Context, req *types.QueryNextGlobalSpStorePriceRequest) (*types.QueryNextGlobalSpStorePriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	price, found := k.GetNextGlobalSpStorePrice(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "the next global store price can not be projected")
+	}
+	return &types.QueryNextGlobalSpStorePriceResponse{GlobalSpStorePrice: price}, nil
+}
+
 func (k Keeper) StorageProvider(goCtx context.Context, req *types.QueryStorageProviderRequest) (*types.QueryStorageProviderResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
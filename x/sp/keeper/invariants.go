@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/sp/types"
+)
+
+// RegisterInvariants registers all sp invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "deposit-pool", DepositPoolInvariant(k))
+}
+
+// AllInvariants runs all invariants of the sp module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return DepositPoolInvariant(k)
+}
+
+// DepositPoolInvariant checks that the sp module account holds at least the sum of the
+// deposits of all storage providers, so every deposit can be slashed or refunded on exit.
+func DepositPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalDeposit := math.ZeroInt()
+		for _, sp := range k.GetAllStorageProviders(ctx) {
+			totalDeposit = totalDeposit.Add(sp.TotalDeposit)
+		}
+
+		poolBalance := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), k.DepositDenomForSP(ctx))
+
+		broken := poolBalance.Amount.LT(totalDeposit)
+		return sdk.FormatInvariant(types.ModuleName, "deposit pool",
+			fmt.Sprintf("\tsp deposit pool balance: %s\n\tsum of sp deposits: %s\n", poolBalance.Amount, totalDeposit)), broken
+	}
+}
@@ -379,14 +379,33 @@ func (k msgServer) UpdateSpStoragePrice(goCtx context.Context, msg *types.MsgUpd
 	}
 
 	current := ctx.BlockTime().Unix()
+	earliest := current + int64(params.MinPriceChangeNotice)
+	effectiveTime := msg.EffectiveTime
+	if effectiveTime == 0 {
+		effectiveTime = earliest
+	} else if effectiveTime < earliest {
+		return nil, errors.Wrapf(types.ErrStorageProviderPriceUpdateNotAllow, "price cannot take effect earlier than %d, %d seconds of notice is required",
+			earliest, params.MinPriceChangeNotice)
+	}
+	// the scheduled price would otherwise take effect within the days it can not be updated in
+	if params.UpdateGlobalPriceInterval == 0 && IsLastDaysOfTheMonth(time.Unix(effectiveTime, 0), int(params.UpdatePriceDisallowedDays)) {
+		return nil, errors.Wrapf(types.ErrStorageProviderPriceUpdateNotAllow, "price cannot take effect in the last %d days of the month",
+			params.UpdatePriceDisallowedDays)
+	}
+
 	spStorePrice := types.SpStoragePrice{
-		UpdateTimeSec: current,
+		UpdateTimeSec: effectiveTime,
 		SpId:          sp.Id,
 		ReadPrice:     msg.ReadPrice,
 		StorePrice:    msg.StorePrice,
 		FreeReadQuota: msg.FreeReadQuota,
 	}
-	k.SetSpStoragePrice(ctx, spStorePrice)
+	if effectiveTime > current {
+		k.SetPendingSpStoragePrice(ctx, spStorePrice)
+	} else {
+		k.SetSpStoragePrice(ctx, spStorePrice)
+		k.DeletePendingSpStoragePrice(ctx, sp.Id)
+	}
 
 	return &types.MsgUpdateSpStoragePriceResponse{}, nil
 }
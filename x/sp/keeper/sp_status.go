@@ -66,6 +66,47 @@ func (k Keeper) UpdateToInService(ctx sdk.Context, sp *types.StorageProvider) {
 	sp.Status = types.STATUS_IN_SERVICE
 }
 
+// GetMaintenanceEndTime returns the time the SP came back in service from its latest maintenance, it is not found if
+// the SP is still in maintenance or has no maintenance record kept.
+func (k Keeper) GetMaintenanceEndTime(ctx sdk.Context, sp *types.StorageProvider) (int64, bool) {
+	if sp.Status == types.STATUS_IN_MAINTENANCE {
+		return 0, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStorageProviderMaintenanceRecordsKey(sdk.MustAccAddressFromHex(sp.OperatorAddress)))
+	if bz == nil {
+		return 0, false
+	}
+	var stats types.SpMaintenanceStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	if len(stats.Records) == 0 {
+		return 0, false
+	}
+	lastRecord := stats.Records[len(stats.Records)-1]
+	return lastRecord.RequestAt + lastRecord.ActualDuration, true
+}
+
+// GetMaintenanceDuration returns the total time in seconds the SP is in maintenance within its kept maintenance records,
+// including the ongoing maintenance up to now.
+func (k Keeper) GetMaintenanceDuration(ctx sdk.Context, sp *types.StorageProvider) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStorageProviderMaintenanceRecordsKey(sdk.MustAccAddressFromHex(sp.OperatorAddress)))
+	if bz == nil {
+		return 0
+	}
+	var stats types.SpMaintenanceStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	duration := int64(0)
+	for i, record := range stats.Records {
+		if i == len(stats.Records)-1 && sp.Status == types.STATUS_IN_MAINTENANCE {
+			duration += ctx.BlockTime().Unix() - record.RequestAt
+			continue
+		}
+		duration += record.ActualDuration
+	}
+	return duration
+}
+
 func (k Keeper) ForceUpdateMaintenanceRecords(ctx sdk.Context) {
 	params := k.GetParams(ctx)
 	store := ctx.KVStore(k.storeKey)
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
@@ -64,6 +65,79 @@ func (k Keeper) GetAllSpStoragePrice(ctx sdk.Context) (list []types.SpStoragePri
 	return
 }
 
+// SetPendingSpStoragePrice schedules the prices of a sp to take effect at their update time, in place of the prices
+// scheduled before.
+func (k Keeper) SetPendingSpStoragePrice(ctx sdk.Context, spStoragePrice types.SpStoragePrice) {
+	event := &types.EventSpStoragePriceScheduled{
+		SpId:          spStoragePrice.SpId,
+		EffectiveTime: spStoragePrice.UpdateTimeSec,
+		ReadPrice:     spStoragePrice.ReadPrice,
+		StorePrice:    spStoragePrice.StorePrice,
+		FreeReadQuota: spStoragePrice.FreeReadQuota,
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpStoragePriceKeyPrefix)
+	key := types.SpStoragePriceKey(spStoragePrice.SpId)
+	spStoragePrice.SpId = 0
+	store.Set(key, k.cdc.MustMarshal(&spStoragePrice))
+	_ = ctx.EventManager().EmitTypedEvents(event)
+}
+
+// GetPendingSpStoragePrice returns the prices the sp scheduled to take effect later, if any.
+func (k Keeper) GetPendingSpStoragePrice(ctx sdk.Context, spID uint32) (val types.SpStoragePrice, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpStoragePriceKeyPrefix)
+
+	b := store.Get(types.SpStoragePriceKey(spID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	val.SpId = spID
+	return val, true
+}
+
+// DeletePendingSpStoragePrice cancels the prices the sp scheduled to take effect later.
+func (k Keeper) DeletePendingSpStoragePrice(ctx sdk.Context, spID uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpStoragePriceKeyPrefix)
+	store.Delete(types.SpStoragePriceKey(spID))
+}
+
+// GetAllPendingSpStoragePrices returns the prices all the sps scheduled to take effect later.
+func (k Keeper) GetAllPendingSpStoragePrices(ctx sdk.Context) (list []types.SpStoragePrice) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSpStoragePriceKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SpStoragePrice
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		val.SpId = types.ParseSpStoragePriceKey(iterator.Key())
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ApplyPendingSpStoragePrices makes the scheduled prices, which take effect by the block time, the current prices of the sps.
+func (k Keeper) ApplyPendingSpStoragePrices(ctx sdk.Context) {
+	for _, price := range k.GetAllPendingSpStoragePrices(ctx) {
+		if price.UpdateTimeSec > ctx.BlockTime().Unix() {
+			continue
+		}
+		k.SetSpStoragePrice(ctx, price)
+		k.DeletePendingSpStoragePrice(ctx, price.SpId)
+	}
+}
+
+// getSpStoragePriceByTime returns the prices of the sp in effect at the time, taking the scheduled prices into account.
+func (k Keeper) getSpStoragePriceByTime(ctx sdk.Context, spID uint32, timestamp int64) (types.SpStoragePrice, bool) {
+	if pending, found := k.GetPendingSpStoragePrice(ctx, spID); found && pending.UpdateTimeSec <= timestamp {
+		return pending, true
+	}
+	return k.GetSpStoragePrice(ctx, spID)
+}
+
 func (k Keeper) SetGlobalSpStorePrice(ctx sdk.Context, globalSpStorePrice types.GlobalSpStorePrice) {
 	event := &types.EventGlobalSpStorePriceUpdate{
 		UpdateTimeSec:       globalSpStorePrice.UpdateTimeSec,
@@ -83,15 +157,26 @@ func (k Keeper) SetGlobalSpStorePrice(ctx sdk.Context, globalSpStorePrice types.
 
 // UpdateGlobalSpStorePrice calculate the global prices by the median price of all sp store price
 func (k Keeper) UpdateGlobalSpStorePrice(ctx sdk.Context) error {
+	globalSpStorePrice, found, err := k.calculateGlobalSpStorePrice(ctx, ctx.BlockTime().Unix())
+	if err != nil || !found {
+		return err
+	}
+	globalSpStorePrice.UpdateTimeSec = ctx.BlockTime().Unix()
+	k.SetGlobalSpStorePrice(ctx, globalSpStorePrice)
+	return nil
+}
+
+// calculateGlobalSpStorePrice calculates the global prices from the prices of the sps in service or in maintenance in
+// effect at the time, it returns false if there is no such sp.
+func (k Keeper) calculateGlobalSpStorePrice(ctx sdk.Context, timestamp int64) (types.GlobalSpStorePrice, bool, error) {
 	sps := k.GetAllStorageProviders(ctx)
-	current := ctx.BlockTime().Unix()
 	storePrices := make([]math.LegacyDec, 0)
 	readPrices := make([]math.LegacyDec, 0)
 	for _, sp := range sps {
 		if sp.Status == types.STATUS_IN_SERVICE || sp.Status == types.STATUS_IN_MAINTENANCE {
-			price, found := k.GetSpStoragePrice(ctx, sp.Id)
+			price, found := k.getSpStoragePriceByTime(ctx, sp.Id, timestamp)
 			if !found {
-				return fmt.Errorf("cannot find price for storage provider %d", sp.Id)
+				return types.GlobalSpStorePrice{}, false, fmt.Errorf("cannot find price for storage provider %d", sp.Id)
 			}
 			storePrices = append(storePrices, price.StorePrice)
 			readPrices = append(readPrices, price.ReadPrice)
@@ -99,21 +184,46 @@ func (k Keeper) UpdateGlobalSpStorePrice(ctx sdk.Context) error {
 	}
 	l := len(storePrices)
 	if l == 0 {
-		return nil
+		return types.GlobalSpStorePrice{}, false, nil
 	}
 
 	primaryStorePrice := k.calculateMedian(storePrices)
 	secondaryStorePrice := k.SecondarySpStorePriceRatio(ctx).Mul(primaryStorePrice)
 	readPrice := k.calculateMedian(readPrices)
 
-	globalSpStorePrice := types.GlobalSpStorePrice{
+	return types.GlobalSpStorePrice{
 		PrimaryStorePrice:   primaryStorePrice,
 		SecondaryStorePrice: secondaryStorePrice,
 		ReadPrice:           readPrice,
-		UpdateTimeSec:       current,
+	}, true, nil
+}
+
+// GetNextGlobalSpStorePrice projects the global prices which will take effect at the next update of the EndBlocker,
+// from the prices of the sps in effect by then, including the scheduled ones. The update time of the returned prices
+// is the time of the next update, and false is returned if the prices can not be projected.
+func (k Keeper) GetNextGlobalSpStorePrice(ctx sdk.Context) (types.GlobalSpStorePrice, bool) {
+	current := ctx.BlockTime().Unix()
+	updateTime := current // no global price yet, it is updated in this block
+	if price, err := k.GetGlobalSpStorePriceByTime(ctx, current+1); err == nil {
+		params := k.GetParams(ctx)
+		if params.UpdateGlobalPriceInterval > 0 {
+			updateTime = price.UpdateTimeSec + int64(params.UpdateGlobalPriceInterval) + 1
+		} else {
+			lastUpdateTime := time.Unix(price.UpdateTimeSec, 0).UTC()
+			nextMonth := time.Date(lastUpdateTime.Year(), lastUpdateTime.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			updateTime = nextMonth.Unix()
+		}
+		if updateTime < current {
+			updateTime = current
+		}
 	}
-	k.SetGlobalSpStorePrice(ctx, globalSpStorePrice)
-	return nil
+
+	globalSpStorePrice, found, err := k.calculateGlobalSpStorePrice(ctx, updateTime)
+	if err != nil || !found {
+		return types.GlobalSpStorePrice{}, false
+	}
+	globalSpStorePrice.UpdateTimeSec = updateTime
+	return globalSpStorePrice, true
 }
 
 func (k Keeper) calculateMedian(prices []math.LegacyDec) math.LegacyDec {
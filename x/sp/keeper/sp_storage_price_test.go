@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 
+	"github.com/mocachain/moca/v2/testutil/sample"
 	"github.com/mocachain/moca/v2/x/sp/types"
 )
 
@@ -87,3 +88,73 @@ func (s *KeeperTestSuite) TestGetGlobalSpStorePriceByTime() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestScheduleSpStoragePrice() {
+	ctx := s.ctx.WithBlockTime(time.Unix(1691625600, 0)) // 2023-08-10 UTC
+	now := ctx.BlockTime().Unix()
+	params := s.spKeeper.GetParams(ctx)
+	params.MinPriceChangeNotice = 3600
+	s.Require().NoError(s.spKeeper.SetParams(ctx, params))
+
+	operator := sample.RandAccAddress()
+	sp := &types.StorageProvider{Id: 1, OperatorAddress: operator.String(), Status: types.STATUS_IN_SERVICE}
+	s.spKeeper.SetStorageProvider(ctx, sp)
+	s.spKeeper.SetStorageProviderByOperatorAddr(ctx, sp)
+	s.spKeeper.SetSpStoragePrice(ctx, types.SpStoragePrice{
+		SpId:          1,
+		UpdateTimeSec: now - 100,
+		ReadPrice:     math.LegacyNewDec(10),
+		StorePrice:    math.LegacyNewDec(100),
+	})
+	s.spKeeper.SetGlobalSpStorePrice(ctx, types.GlobalSpStorePrice{
+		UpdateTimeSec:       now - 10,
+		ReadPrice:           math.LegacyNewDec(10),
+		PrimaryStorePrice:   math.LegacyNewDec(100),
+		SecondaryStorePrice: math.LegacyNewDec(12),
+	})
+
+	msg := &types.MsgUpdateSpStoragePrice{
+		SpAddress:     operator.String(),
+		ReadPrice:     math.LegacyNewDec(10),
+		StorePrice:    math.LegacyNewDec(200),
+		EffectiveTime: now + 100,
+	}
+	_, err := s.msgServer.UpdateSpStoragePrice(ctx, msg)
+	s.Require().ErrorIs(err, types.ErrStorageProviderPriceUpdateNotAllow)
+
+	// nor in the last days of the month, in which the prices can not be updated
+	msg.EffectiveTime = 1693396800 // 2023-08-30 12:00 UTC
+	_, err = s.msgServer.UpdateSpStoragePrice(ctx, msg)
+	s.Require().ErrorIs(err, types.ErrStorageProviderPriceUpdateNotAllow)
+
+	// the prices take effect after the notice
+	msg.EffectiveTime = 0
+	_, err = s.msgServer.UpdateSpStoragePrice(ctx, msg)
+	s.Require().NoError(err)
+	price, found := s.spKeeper.GetSpStoragePrice(ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(math.LegacyNewDec(100), price.StorePrice)
+	res, err := s.queryClient.PendingSpStoragePrices(ctx, &types.QueryPendingSpStoragePricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.PendingPrices, 1)
+	s.Require().Equal(now+3600, res.PendingPrices[0].UpdateTimeSec)
+
+	// the global price of the next month picks up the scheduled prices
+	next, found := s.spKeeper.GetNextGlobalSpStorePrice(ctx)
+	s.Require().True(found)
+	s.Require().Equal(int64(1693526400), next.UpdateTimeSec) // 2023-09-01 UTC
+	s.Require().Equal(math.LegacyNewDec(200), next.PrimaryStorePrice)
+
+	s.spKeeper.ApplyPendingSpStoragePrices(ctx)
+	_, found = s.spKeeper.GetPendingSpStoragePrice(ctx, 1)
+	s.Require().True(found)
+
+	ctx = ctx.WithBlockTime(time.Unix(now+3600, 0))
+	s.spKeeper.ApplyPendingSpStoragePrices(ctx)
+	price, found = s.spKeeper.GetSpStoragePrice(ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(math.LegacyNewDec(200), price.StorePrice)
+	s.Require().Equal(now+3600, price.UpdateTimeSec)
+	_, found = s.spKeeper.GetPendingSpStoragePrice(ctx, 1)
+	s.Require().False(found)
+}
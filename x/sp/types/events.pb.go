@@ -421,6 +421,74 @@ func (m *EventSpStoragePriceUpdate) GetFreeReadQuota() uint64 {
 	return 0
 }
 
+// EventSpStoragePriceScheduled is emitted when a sp schedules its prices to take effect later
+type EventSpStoragePriceScheduled struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// the time the prices take effect, in unix timestamp
+	EffectiveTime int64 `protobuf:"varint,2,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+	// read price, in amoca wei per charge byte
+	ReadPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=read_price,json=readPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"read_price"`
+	// free read quota, in byte
+	FreeReadQuota uint64 `protobuf:"varint,4,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
+	// store price, in amoca wei per charge byte
+	StorePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=store_price,json=storePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"store_price"`
+}
+
+func (m *EventSpStoragePriceScheduled) Reset()         { *m = EventSpStoragePriceScheduled{} }
+func (m *EventSpStoragePriceScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventSpStoragePriceScheduled) ProtoMessage()    {}
+func (*EventSpStoragePriceScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60032ef247df0d3b, []int{4}
+}
+func (m *EventSpStoragePriceScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSpStoragePriceScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSpStoragePriceScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSpStoragePriceScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSpStoragePriceScheduled.Merge(m, src)
+}
+func (m *EventSpStoragePriceScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSpStoragePriceScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSpStoragePriceScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSpStoragePriceScheduled proto.InternalMessageInfo
+
+func (m *EventSpStoragePriceScheduled) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventSpStoragePriceScheduled) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return 0
+}
+
+func (m *EventSpStoragePriceScheduled) GetFreeReadQuota() uint64 {
+	if m != nil {
+		return m.FreeReadQuota
+	}
+	return 0
+}
+
 type EventGlobalSpStorePriceUpdate struct {
 	// update time, in unix timestamp
 	UpdateTimeSec int64 `protobuf:"varint,1,opt,name=update_time_sec,json=updateTimeSec,proto3" json:"update_time_sec,omitempty"`
@@ -436,7 +504,7 @@ func (m *EventGlobalSpStorePriceUpdate) Reset()         { *m = EventGlobalSpStor
 func (m *EventGlobalSpStorePriceUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventGlobalSpStorePriceUpdate) ProtoMessage()    {}
 func (*EventGlobalSpStorePriceUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60032ef247df0d3b, []int{4}
+	return fileDescriptor_60032ef247df0d3b, []int{5}
 }
 func (m *EventGlobalSpStorePriceUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -488,7 +556,7 @@ func (m *EventUpdateStorageProviderStatus) Reset()         { *m = EventUpdateSto
 func (m *EventUpdateStorageProviderStatus) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateStorageProviderStatus) ProtoMessage()    {}
 func (*EventUpdateStorageProviderStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60032ef247df0d3b, []int{5}
+	return fileDescriptor_60032ef247df0d3b, []int{6}
 }
 func (m *EventUpdateStorageProviderStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,6 +618,7 @@ func init() {
 	proto.RegisterType((*EventEditStorageProvider)(nil), "moca.sp.EventEditStorageProvider")
 	proto.RegisterType((*EventDeposit)(nil), "moca.sp.EventDeposit")
 	proto.RegisterType((*EventSpStoragePriceUpdate)(nil), "moca.sp.EventSpStoragePriceUpdate")
+	proto.RegisterType((*EventSpStoragePriceScheduled)(nil), "moca.sp.EventSpStoragePriceScheduled")
 	proto.RegisterType((*EventGlobalSpStorePriceUpdate)(nil), "moca.sp.EventGlobalSpStorePriceUpdate")
 	proto.RegisterType((*EventUpdateStorageProviderStatus)(nil), "moca.sp.EventUpdateStorageProviderStatus")
 }
@@ -557,60 +626,62 @@ func init() {
 func init() { proto.RegisterFile("moca/sp/events.proto", fileDescriptor_60032ef247df0d3b) }
 
 var fileDescriptor_60032ef247df0d3b = []byte{
-	// 838 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6b, 0x1b, 0x47,
-	0x14, 0xf7, 0xda, 0xfa, 0x63, 0x3d, 0xd9, 0x71, 0xb3, 0x72, 0xe9, 0xda, 0xc5, 0x8a, 0x50, 0xa1,
-	0x31, 0x85, 0xec, 0x12, 0x17, 0x9a, 0x43, 0xa1, 0x60, 0x5b, 0xa1, 0x84, 0xf6, 0xd0, 0xae, 0x1a,
-	0x0a, 0xbd, 0x2c, 0xa3, 0xdd, 0xe7, 0xf5, 0x34, 0xda, 0x99, 0xe9, 0xce, 0x48, 0xa9, 0xbe, 0x45,
-	0x0e, 0xfd, 0x10, 0x3d, 0x95, 0x1c, 0xf2, 0x21, 0x72, 0x0c, 0x39, 0x95, 0x1e, 0x42, 0xb1, 0xa1,
-	0xbd, 0xf7, 0x13, 0x94, 0x9d, 0x99, 0x5d, 0xcb, 0xc2, 0x20, 0x1a, 0xa5, 0x17, 0xb1, 0xf3, 0xde,
-	0xfc, 0x7e, 0x6f, 0xde, 0x6f, 0x7e, 0xfb, 0xb4, 0xb0, 0x9b, 0xf1, 0x98, 0x04, 0x52, 0x04, 0x38,
-	0x45, 0xa6, 0xa4, 0x2f, 0x72, 0xae, 0xb8, 0xdb, 0x2c, 0xa2, 0xbe, 0x14, 0xfb, 0xb7, 0x49, 0x46,
-	0x19, 0x0f, 0xf4, 0xaf, 0xc9, 0xed, 0x77, 0x63, 0x2e, 0x33, 0x2e, 0x83, 0x11, 0x91, 0x18, 0x4c,
-	0xef, 0x8f, 0x50, 0x91, 0xfb, 0x41, 0xcc, 0x29, 0xb3, 0xf9, 0x3d, 0x93, 0x8f, 0xf4, 0x2a, 0x30,
-	0x0b, 0x9b, 0xda, 0x4d, 0x79, 0xca, 0x4d, 0xbc, 0x78, 0xb2, 0xd1, 0x4e, 0x79, 0x04, 0x35, 0x13,
-	0x68, 0xb7, 0xf6, 0x7f, 0xa9, 0xc3, 0xfe, 0xc3, 0xe2, 0x48, 0xa7, 0x39, 0x12, 0x85, 0x43, 0xc5,
-	0x73, 0x92, 0xe2, 0x37, 0x39, 0x9f, 0xd2, 0x04, 0x73, 0xb7, 0x03, 0x75, 0x29, 0x22, 0x9a, 0x78,
-	0x4e, 0xcf, 0x39, 0xdc, 0x0e, 0x6b, 0x52, 0x3c, 0x4a, 0xdc, 0x07, 0x00, 0x52, 0x44, 0x24, 0x49,
-	0x72, 0x94, 0xd2, 0x5b, 0xef, 0x39, 0x87, 0xad, 0x13, 0xef, 0xf5, 0x8b, 0x7b, 0xbb, 0xf6, 0x10,
-	0xc7, 0x26, 0x33, 0x54, 0x39, 0x65, 0x69, 0xd8, 0x92, 0xc2, 0x06, 0xdc, 0x63, 0xd8, 0x39, 0x9b,
-	0xb0, 0x84, 0xb2, 0xb4, 0x42, 0x6f, 0x2c, 0x41, 0xdf, 0xb2, 0x80, 0x92, 0xe2, 0x73, 0xd8, 0x92,
-	0x48, 0xc6, 0x15, 0xbe, 0xb6, 0x04, 0xdf, 0x2e, 0x76, 0x97, 0xe0, 0x53, 0x78, 0x8f, 0x08, 0x91,
-	0xf3, 0xe9, 0x1c, 0x41, 0x7d, 0x09, 0xc1, 0x4e, 0x89, 0x28, 0x49, 0x1e, 0x00, 0xa4, 0x71, 0x05,
-	0x6f, 0x2c, 0xeb, 0x3e, 0x8d, 0x4b, 0xe0, 0x23, 0xe8, 0x64, 0x84, 0x32, 0x85, 0x8c, 0xb0, 0x18,
-	0x2b, 0x86, 0xe6, 0x12, 0x06, 0x77, 0x0e, 0x54, 0x52, 0xed, 0xc3, 0x26, 0xb2, 0x44, 0x70, 0xca,
-	0x94, 0xb7, 0x59, 0xe0, 0xc3, 0x6a, 0xed, 0x7e, 0x01, 0xdb, 0x8a, 0x2b, 0x32, 0x8e, 0x12, 0x14,
-	0x5c, 0x52, 0xe5, 0xb5, 0x7a, 0xce, 0x61, 0xfb, 0x68, 0xcf, 0xb7, 0xec, 0x85, 0x9f, 0x7c, 0xeb,
-	0x27, 0xff, 0x94, 0x53, 0x16, 0x6e, 0xe9, 0xfd, 0x03, 0xb3, 0xdd, 0xbd, 0x0b, 0x0d, 0xa9, 0x88,
-	0x9a, 0x48, 0x0f, 0x7a, 0xce, 0xe1, 0xad, 0xa3, 0x1d, 0xdf, 0x9a, 0xd4, 0x1f, 0xea, 0x70, 0x68,
-	0xd3, 0xee, 0x31, 0xb4, 0x13, 0x94, 0x71, 0x4e, 0x85, 0xa2, 0x9c, 0x79, 0x6d, 0x5d, 0x66, 0xb7,
-	0xda, 0x3d, 0xb8, 0xca, 0x9d, 0xb4, 0x5e, 0xbe, 0xb9, 0xb3, 0xf6, 0xeb, 0xdf, 0xcf, 0x3f, 0x71,
-	0xc2, 0x79, 0x8c, 0xfb, 0x01, 0x34, 0x47, 0x63, 0x19, 0x3d, 0xc1, 0x99, 0xb7, 0xa5, 0xdb, 0x68,
-	0x8c, 0xc6, 0xf2, 0x2b, 0x9c, 0xf5, 0xff, 0xda, 0x00, 0x4f, 0xdb, 0xf2, 0x61, 0x42, 0xd5, 0xff,
-	0x6b, 0xca, 0x79, 0x2d, 0x37, 0x16, 0xb4, 0x5c, 0x68, 0xb1, 0xf6, 0x16, 0x2d, 0x2e, 0x1a, 0xb6,
-	0xbe, 0xaa, 0x61, 0x1b, 0xab, 0x19, 0xb6, 0xb9, 0xb2, 0x61, 0x37, 0xdf, 0xc2, 0xb0, 0x73, 0x17,
-	0xdd, 0xba, 0x76, 0xd1, 0xcf, 0x1c, 0xd8, 0xd2, 0x17, 0x5d, 0xda, 0xef, 0x86, 0x19, 0xe1, 0xfc,
-	0xc7, 0x19, 0xe1, 0x41, 0xb3, 0xf4, 0xbe, 0xf6, 0x41, 0x58, 0x2e, 0xdd, 0x8f, 0x16, 0xdf, 0x0d,
-	0x73, 0xe1, 0xd7, 0x5e, 0x80, 0xfe, 0xf3, 0x75, 0xd8, 0xd3, 0x47, 0x1a, 0x8a, 0xca, 0x79, 0x34,
-	0xc6, 0xc7, 0x22, 0x21, 0x0a, 0x6f, 0x36, 0xdf, 0xc7, 0xb0, 0x33, 0xd1, 0xe9, 0x48, 0xd1, 0x0c,
-	0x23, 0x89, 0xb1, 0xae, 0xbc, 0x11, 0x6e, 0x9b, 0xf0, 0x77, 0x34, 0xc3, 0x21, 0xc6, 0xee, 0x63,
-	0x80, 0x1c, 0x49, 0x12, 0x89, 0x82, 0xd0, 0xce, 0xbe, 0xcf, 0x0a, 0xe3, 0xfc, 0xf1, 0xe6, 0xce,
-	0x87, 0xa6, 0x37, 0x99, 0x3c, 0xf1, 0x29, 0x0f, 0x32, 0xa2, 0xce, 0xfd, 0xaf, 0x31, 0x25, 0xf1,
-	0x6c, 0x80, 0xf1, 0xeb, 0x17, 0xf7, 0xc0, 0xb6, 0x3e, 0xc0, 0xd8, 0xb8, 0xac, 0x55, 0x30, 0xe9,
-	0x93, 0x15, 0xe5, 0xcf, 0x72, 0xc4, 0x48, 0x73, 0xff, 0x34, 0xe1, 0x8a, 0x68, 0xab, 0xd6, 0xc2,
-	0xed, 0x22, 0x1c, 0x22, 0x49, 0xbe, 0x2d, 0x82, 0xee, 0xf7, 0xd0, 0x96, 0x8a, 0xe7, 0x68, 0xeb,
-	0xd7, 0x57, 0xaa, 0x0f, 0x9a, 0x4a, 0x1f, 0xa0, 0xff, 0xcf, 0x3a, 0x1c, 0x68, 0xc9, 0xbe, 0x1c,
-	0xf3, 0x11, 0x19, 0x1b, 0xe1, 0xae, 0xc9, 0x76, 0x83, 0x42, 0xce, 0x72, 0x85, 0xd6, 0xdf, 0x95,
-	0x42, 0x67, 0xd0, 0x11, 0x39, 0xcd, 0x48, 0x3e, 0x8b, 0xe6, 0x15, 0x58, 0xed, 0x06, 0x6e, 0x5b,
-	0xca, 0xab, 0x66, 0xdd, 0x1f, 0xe1, 0x7d, 0x89, 0x31, 0x67, 0xc9, 0x62, 0xa5, 0xda, 0x4a, 0x95,
-	0x3a, 0x15, 0xe9, 0x55, 0xad, 0xfe, 0x6f, 0x0e, 0xf4, 0xb4, 0xe8, 0x46, 0xe2, 0x85, 0x29, 0x69,
-	0x86, 0xf5, 0x3b, 0x9e, 0x95, 0x07, 0x00, 0x22, 0xc7, 0xc8, 0xfe, 0x3f, 0x98, 0x97, 0xa7, 0x25,
-	0x72, 0xb4, 0xc5, 0x0e, 0x00, 0x18, 0x3e, 0x2d, 0xd3, 0x35, 0x93, 0x66, 0xf8, 0xd4, 0xa4, 0x4f,
-	0x8e, 0x5f, 0x5e, 0x74, 0x9d, 0x57, 0x17, 0x5d, 0xe7, 0xcf, 0x8b, 0xae, 0xf3, 0xec, 0xb2, 0xbb,
-	0xf6, 0xea, 0xb2, 0xbb, 0xf6, 0xfb, 0x65, 0x77, 0xed, 0x87, 0xbb, 0x29, 0x55, 0xe7, 0x93, 0x91,
-	0x1f, 0xf3, 0x2c, 0x28, 0x86, 0x6b, 0x7c, 0x4e, 0x28, 0xd3, 0x4f, 0xc1, 0xf4, 0x28, 0xf8, 0xb9,
-	0xfa, 0x68, 0x19, 0x35, 0xf4, 0x57, 0xcb, 0xa7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x65, 0xbe,
-	0x88, 0x0e, 0x4f, 0x09, 0x00, 0x00,
+	// 872 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x8f, 0xdb, 0x44,
+	0x18, 0x5e, 0x67, 0xf3, 0xb1, 0x79, 0xb3, 0x1f, 0xd4, 0x59, 0x84, 0x77, 0x61, 0xd3, 0x28, 0x08,
+	0xba, 0x42, 0xaa, 0xad, 0x2e, 0x12, 0x3d, 0x20, 0x21, 0xed, 0x47, 0x85, 0x2a, 0x38, 0x80, 0x43,
+	0x85, 0xc4, 0xc5, 0x9a, 0x8c, 0xdf, 0x78, 0x87, 0xc6, 0x9e, 0xc1, 0x33, 0x49, 0xc9, 0xbf, 0xe8,
+	0x81, 0x1f, 0xc1, 0x09, 0x55, 0xa8, 0x3f, 0xa2, 0xc7, 0xaa, 0x27, 0xc4, 0xa1, 0x42, 0xbb, 0x12,
+	0xdc, 0xf9, 0x05, 0xc8, 0xe3, 0xb1, 0x37, 0x1b, 0x45, 0x8a, 0x68, 0x96, 0x0b, 0x17, 0xcb, 0xf3,
+	0xbe, 0xf3, 0x3c, 0xef, 0xbc, 0xcf, 0x3c, 0x9e, 0x31, 0xec, 0xc6, 0x9c, 0x12, 0x4f, 0x0a, 0x0f,
+	0x27, 0x98, 0x28, 0xe9, 0x8a, 0x94, 0x2b, 0x6e, 0x37, 0xb2, 0xa8, 0x2b, 0xc5, 0xfe, 0x2d, 0x12,
+	0xb3, 0x84, 0x7b, 0xfa, 0x99, 0xe7, 0xf6, 0x3b, 0x94, 0xcb, 0x98, 0x4b, 0x6f, 0x40, 0x24, 0x7a,
+	0x93, 0x7b, 0x03, 0x54, 0xe4, 0x9e, 0x47, 0x39, 0x4b, 0x4c, 0x7e, 0x2f, 0xcf, 0x07, 0x7a, 0xe4,
+	0xe5, 0x03, 0x93, 0xda, 0x8d, 0x78, 0xc4, 0xf3, 0x78, 0xf6, 0x66, 0xa2, 0xed, 0x62, 0x09, 0x6a,
+	0x2a, 0xd0, 0x4c, 0xed, 0xfd, 0x54, 0x83, 0xfd, 0x07, 0xd9, 0x92, 0x4e, 0x53, 0x24, 0x0a, 0xfb,
+	0x8a, 0xa7, 0x24, 0xc2, 0xaf, 0x52, 0x3e, 0x61, 0x21, 0xa6, 0x76, 0x1b, 0x6a, 0x52, 0x04, 0x2c,
+	0x74, 0xac, 0xae, 0x75, 0xb8, 0xe5, 0x57, 0xa5, 0x78, 0x18, 0xda, 0xf7, 0x01, 0xa4, 0x08, 0x48,
+	0x18, 0xa6, 0x28, 0xa5, 0x53, 0xe9, 0x5a, 0x87, 0xcd, 0x13, 0xe7, 0xd5, 0xf3, 0xbb, 0xbb, 0x66,
+	0x11, 0xc7, 0x79, 0xa6, 0xaf, 0x52, 0x96, 0x44, 0x7e, 0x53, 0x0a, 0x13, 0xb0, 0x8f, 0x61, 0x67,
+	0x38, 0x4e, 0x42, 0x96, 0x44, 0x25, 0x7a, 0x7d, 0x09, 0x7a, 0xdb, 0x00, 0x0a, 0x8a, 0x4f, 0x61,
+	0x53, 0x22, 0x19, 0x95, 0xf8, 0xea, 0x12, 0x7c, 0x2b, 0x9b, 0x5d, 0x80, 0x4f, 0xe1, 0x2d, 0x22,
+	0x44, 0xca, 0x27, 0x33, 0x04, 0xb5, 0x25, 0x04, 0x3b, 0x05, 0xa2, 0x20, 0xb9, 0x0f, 0x10, 0xd1,
+	0x12, 0x5e, 0x5f, 0xd6, 0x7d, 0x44, 0x0b, 0xe0, 0x43, 0x68, 0xc7, 0x84, 0x25, 0x0a, 0x13, 0x92,
+	0x50, 0x2c, 0x19, 0x1a, 0x4b, 0x18, 0xec, 0x19, 0x50, 0x41, 0xb5, 0x0f, 0x1b, 0x98, 0x84, 0x82,
+	0xb3, 0x44, 0x39, 0x1b, 0x19, 0xde, 0x2f, 0xc7, 0xf6, 0x67, 0xb0, 0xa5, 0xb8, 0x22, 0xa3, 0x20,
+	0x44, 0xc1, 0x25, 0x53, 0x4e, 0xb3, 0x6b, 0x1d, 0xb6, 0x8e, 0xf6, 0x5c, 0xc3, 0x9e, 0xf9, 0xc9,
+	0x35, 0x7e, 0x72, 0x4f, 0x39, 0x4b, 0xfc, 0x4d, 0x3d, 0xff, 0x2c, 0x9f, 0x6e, 0xdf, 0x81, 0xba,
+	0x54, 0x44, 0x8d, 0xa5, 0x03, 0x5d, 0xeb, 0x70, 0xfb, 0x68, 0xc7, 0x35, 0x26, 0x75, 0xfb, 0x3a,
+	0xec, 0x9b, 0xb4, 0x7d, 0x0c, 0xad, 0x10, 0x25, 0x4d, 0x99, 0x50, 0x8c, 0x27, 0x4e, 0x4b, 0x97,
+	0xd9, 0x2d, 0x67, 0x9f, 0x5d, 0xe5, 0x4e, 0x9a, 0x2f, 0x5e, 0xdf, 0x5e, 0xfb, 0xf9, 0xaf, 0x67,
+	0x1f, 0x59, 0xfe, 0x2c, 0xc6, 0x7e, 0x07, 0x1a, 0x83, 0x91, 0x0c, 0x1e, 0xe3, 0xd4, 0xd9, 0xd4,
+	0x6d, 0xd4, 0x07, 0x23, 0xf9, 0x05, 0x4e, 0x7b, 0x7f, 0xae, 0x83, 0xa3, 0x6d, 0xf9, 0x20, 0x64,
+	0xea, 0xbf, 0x35, 0xe5, 0xac, 0x96, 0xeb, 0x73, 0x5a, 0xce, 0xb5, 0x58, 0x7d, 0x83, 0x16, 0xe7,
+	0x0d, 0x5b, 0x5b, 0xd5, 0xb0, 0xf5, 0xd5, 0x0c, 0xdb, 0x58, 0xd9, 0xb0, 0x1b, 0x6f, 0x60, 0xd8,
+	0x99, 0x8d, 0x6e, 0x5e, 0xdb, 0xe8, 0xa7, 0x16, 0x6c, 0xea, 0x8d, 0x2e, 0xec, 0xb7, 0xe0, 0x8c,
+	0xb0, 0xfe, 0xe5, 0x19, 0xe1, 0x40, 0xa3, 0xf0, 0xbe, 0xf6, 0x81, 0x5f, 0x0c, 0xed, 0xf7, 0xe7,
+	0xbf, 0x8d, 0x7c, 0xc3, 0xaf, 0x7d, 0x00, 0xbd, 0x67, 0x15, 0xd8, 0xd3, 0x4b, 0xea, 0x8b, 0xd2,
+	0x79, 0x8c, 0xe2, 0x23, 0x11, 0x12, 0x85, 0x8b, 0xcd, 0xf7, 0x21, 0xec, 0x8c, 0x75, 0x3a, 0x50,
+	0x2c, 0xc6, 0x40, 0x22, 0xd5, 0x95, 0xd7, 0xfd, 0xad, 0x3c, 0xfc, 0x0d, 0x8b, 0xb1, 0x8f, 0xd4,
+	0x7e, 0x04, 0x90, 0x22, 0x09, 0x03, 0x91, 0x11, 0x9a, 0xb3, 0xef, 0x93, 0xcc, 0x38, 0xbf, 0xbf,
+	0xbe, 0xfd, 0x6e, 0xde, 0x9b, 0x0c, 0x1f, 0xbb, 0x8c, 0x7b, 0x31, 0x51, 0xe7, 0xee, 0x97, 0x18,
+	0x11, 0x3a, 0x3d, 0x43, 0xfa, 0xea, 0xf9, 0x5d, 0x30, 0xad, 0x9f, 0x21, 0xcd, 0x5d, 0xd6, 0xcc,
+	0x98, 0xf4, 0xca, 0xb2, 0xf2, 0xc3, 0x14, 0x31, 0xd0, 0xdc, 0x3f, 0x8c, 0xb9, 0x22, 0xda, 0xaa,
+	0x55, 0x7f, 0x2b, 0x0b, 0xfb, 0x48, 0xc2, 0xaf, 0xb3, 0xa0, 0xfd, 0x2d, 0xb4, 0xa4, 0xe2, 0x29,
+	0x9a, 0xfa, 0xb5, 0x95, 0xea, 0x83, 0xa6, 0xd2, 0x0b, 0xe8, 0xfd, 0x5a, 0x81, 0xf7, 0x16, 0x48,
+	0xd6, 0xa7, 0xe7, 0x18, 0x8e, 0x47, 0x18, 0x2e, 0x56, 0xed, 0x03, 0xd8, 0xc6, 0xe1, 0x10, 0xa9,
+	0x62, 0x93, 0x5c, 0xb8, 0x42, 0xb4, 0x32, 0x9a, 0xe9, 0xf6, 0xbf, 0x15, 0xed, 0xef, 0x0a, 0x1c,
+	0x68, 0xd1, 0x3e, 0x1f, 0xf1, 0x01, 0x19, 0xe5, 0xd2, 0x5d, 0xf3, 0xda, 0x02, 0x5b, 0x59, 0xcb,
+	0x6d, 0x55, 0xb9, 0x29, 0x85, 0x86, 0xd0, 0x16, 0x29, 0x8b, 0x49, 0x3a, 0x0d, 0x66, 0x15, 0x58,
+	0x6d, 0x07, 0x6e, 0x19, 0xca, 0xab, 0x66, 0xed, 0xef, 0xe1, 0x6d, 0x89, 0x94, 0x27, 0xe1, 0x7c,
+	0xa5, 0xea, 0x4a, 0x95, 0xda, 0x25, 0xe9, 0x55, 0xad, 0xde, 0x2f, 0x16, 0x74, 0xb5, 0xe8, 0xb9,
+	0xc4, 0x73, 0x57, 0x4b, 0x7e, 0xc3, 0xdd, 0xf0, 0x05, 0x73, 0x00, 0x20, 0x52, 0x0c, 0xcc, 0xa5,
+	0x9a, 0x9f, 0x38, 0x4d, 0x91, 0xa2, 0x29, 0x76, 0x00, 0x90, 0xe0, 0x93, 0x22, 0x5d, 0xcd, 0xd3,
+	0x09, 0x3e, 0xc9, 0xd3, 0x27, 0xc7, 0x2f, 0x2e, 0x3a, 0xd6, 0xcb, 0x8b, 0x8e, 0xf5, 0xc7, 0x45,
+	0xc7, 0x7a, 0x7a, 0xd9, 0x59, 0x7b, 0x79, 0xd9, 0x59, 0xfb, 0xed, 0xb2, 0xb3, 0xf6, 0xdd, 0x9d,
+	0x88, 0xa9, 0xf3, 0xf1, 0xc0, 0xa5, 0x3c, 0xf6, 0xb2, 0x1b, 0x89, 0x9e, 0x13, 0x96, 0xe8, 0x37,
+	0x6f, 0x72, 0xe4, 0xfd, 0x58, 0xfe, 0xe9, 0x0d, 0xea, 0xfa, 0x57, 0xef, 0xe3, 0x7f, 0x06, 0x00,
+	0x6c, 0xed, 0xa6, 0xdd, 0x84, 0x0a, 0x00, 0x00,
 }
 
 func (m *EventCreateStorageProvider) Marshal() (dAtA []byte, err error) {
@@ -913,6 +984,64 @@ func (m *EventSpStoragePriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSpStoragePriceScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSpStoragePriceScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSpStoragePriceScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StorePrice.Size()
+		i -= size
+		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.FreeReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FreeReadQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ReadPrice.Size()
+		i -= size
+		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EffectiveTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EffectiveTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventGlobalSpStorePriceUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1169,6 +1298,28 @@ func (m *EventSpStoragePriceUpdate) Size() (n int) {
 	return n
 }
 
+func (m *EventSpStoragePriceScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	if m.EffectiveTime != 0 {
+		n += 1 + sovEvents(uint64(m.EffectiveTime))
+	}
+	l = m.ReadPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.FreeReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.FreeReadQuota))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func (m *EventGlobalSpStorePriceUpdate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2277,6 +2428,181 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSpStoragePriceScheduled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSpStoragePriceScheduled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSpStoragePriceScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			m.EffectiveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
+			}
+			m.FreeReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
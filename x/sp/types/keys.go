@@ -40,6 +40,9 @@ var (
 	// DepositLockKeyPrefix is the prefix for the height until which a storage provider's
 	// deposit must stay in the module account so a pending challenge can still slash it.
 	DepositLockKeyPrefix = []byte{0x42}
+
+	// PendingSpStoragePriceKeyPrefix is the prefix for the prices a storage provider scheduled to take effect later.
+	PendingSpStoragePriceKeyPrefix = []byte{0x43}
 )
 
 // GetDepositLockKey creates the key holding the deposit lock height of a storage provider.
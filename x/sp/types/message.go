@@ -303,6 +303,9 @@ func (msg *MsgUpdateSpStoragePrice) ValidateBasic() error {
 	if msg.StorePrice.IsNil() || msg.StorePrice.IsNegative() {
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid store price (%s)", msg.StorePrice)
 	}
+	if msg.EffectiveTime < 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid effective time (%d)", msg.EffectiveTime)
+	}
 	return nil
 }
 
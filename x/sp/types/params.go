@@ -27,6 +27,10 @@ const (
 	DefaultUpdateGlobalPriceInterval uint64 = 0 // 0 means the global price will be updated at the first day of each month
 	// UpdatePriceDisallowedDays defines the days, counting backward from the end of a month, in which sp is not allowed to update its price
 	DefaultUpdatePriceDisallowedDays uint32 = 2
+	// DefaultMinPriceChangeNotice defines the default minimum notice in seconds before the new price of a sp takes effect
+	DefaultMinPriceChangeNotice uint64 = 0 // 0 means the new price takes effect right away
+	// MaxMinPriceChangeNotice is the longest notice in seconds a new price of a sp can be required to wait for
+	MaxMinPriceChangeNotice uint64 = 365 * 24 * 60 * 60 // 1 year
 )
 
 var (
@@ -45,12 +49,13 @@ var (
 	KeyNumOfLockUpBlocksForMaintenance            = []byte("NumOfLockUpBlocksForMaintenance")
 	KeyUpdateGlobalPriceInterval                  = []byte("UpdateGlobalPriceInterval")
 	KeyUpdatePriceDisallowedDays                  = []byte("UpdatePriceDisallowedDays")
+	KeyMinPriceChangeNotice                       = []byte("MinPriceChangeNotice")
 )
 
 // NewParams creates a new Params instance
 func NewParams(depositDenom string, minDeposit math.Int, secondarySpStorePriceRatio math.LegacyDec,
 	historicalBlocksForMaintenanceRecords, maintenanceDurationQuota, lockUpBlocksForMaintenance int64,
-	updateGlobalPriceInterval uint64, updatePriceDisallowedDays uint32,
+	updateGlobalPriceInterval uint64, updatePriceDisallowedDays uint32, minPriceChangeNotice uint64,
 ) Params {
 	return Params{
 		DepositDenom:               depositDenom,
@@ -61,6 +66,7 @@ func NewParams(depositDenom string, minDeposit math.Int, secondarySpStorePriceRa
 		NumOfLockupBlocksForMaintenance:            lockUpBlocksForMaintenance,
 		UpdateGlobalPriceInterval:                  updateGlobalPriceInterval,
 		UpdatePriceDisallowedDays:                  updatePriceDisallowedDays,
+		MinPriceChangeNotice:                       minPriceChangeNotice,
 	}
 }
 
@@ -68,7 +74,7 @@ func NewParams(depositDenom string, minDeposit math.Int, secondarySpStorePriceRa
 func DefaultParams() Params {
 	return NewParams(DefaultDepositDenom, DefaultMinDeposit, DefaultSecondarySpStorePriceRatio,
 		DefaultNumOfHistoricalBlocksForMaintenanceRecords, DefaultMaintenanceDurationQuota, DefaultNumOfLockUpBlocksForMaintenance,
-		DefaultUpdateGlobalPriceInterval, DefaultUpdatePriceDisallowedDays)
+		DefaultUpdateGlobalPriceInterval, DefaultUpdatePriceDisallowedDays, DefaultMinPriceChangeNotice)
 }
 
 // Validate validates the set of params
@@ -99,6 +105,9 @@ func (p Params) Validate() error {
 	if err := validateUpdatePriceDisallowedDays(p.UpdatePriceDisallowedDays); err != nil {
 		return err
 	}
+	if err := validateMinPriceChangeNotice(p.MinPriceChangeNotice); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -208,3 +217,15 @@ func validateUpdatePriceDisallowedDays(i interface{}) error {
 	}
 	return nil
 }
+
+func validateMinPriceChangeNotice(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// the notice is added to the block time in seconds as an int64, so it is bounded well below its range
+	if v > MaxMinPriceChangeNotice {
+		return fmt.Errorf("min price change notice too large: %d, the max is %d", v, MaxMinPriceChangeNotice)
+	}
+	return nil
+}
@@ -44,6 +44,8 @@ type Params struct {
 	UpdateGlobalPriceInterval uint64 `protobuf:"varint,7,opt,name=update_global_price_interval,json=updateGlobalPriceInterval,proto3" json:"update_global_price_interval,omitempty" yaml:"update_global_price_interval"`
 	// the days counting backwards from end of a month in which a sp cannot update its price
 	UpdatePriceDisallowedDays uint32 `protobuf:"varint,8,opt,name=update_price_disallowed_days,json=updatePriceDisallowedDays,proto3" json:"update_price_disallowed_days,omitempty" yaml:"update_price_disallowed_days"`
+	// the minimum notice in seconds a sp must give before its new price takes effect, prices take effect right away if it is 0, at most a year
+	MinPriceChangeNotice uint64 `protobuf:"varint,9,opt,name=min_price_change_notice,json=minPriceChangeNotice,proto3" json:"min_price_change_notice,omitempty" yaml:"min_price_change_notice"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -120,6 +122,13 @@ func (m *Params) GetUpdatePriceDisallowedDays() uint32 {
 	return 0
 }
 
+func (m *Params) GetMinPriceChangeNotice() uint64 {
+	if m != nil {
+		return m.MinPriceChangeNotice
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "moca.sp.Params")
 }
@@ -127,45 +136,47 @@ func init() {
 func init() { proto.RegisterFile("moca/sp/params.proto", fileDescriptor_603ad4299af4cfb2) }
 
 var fileDescriptor_603ad4299af4cfb2 = []byte{
-	// 593 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xb1, 0x6f, 0xd4, 0x3e,
-	0x18, 0xbd, 0xfc, 0xda, 0xdf, 0x15, 0x02, 0x95, 0x20, 0x2a, 0x52, 0x7a, 0x40, 0x72, 0xa4, 0xa0,
-	0x9e, 0x8a, 0xb8, 0x54, 0x20, 0x81, 0x54, 0x26, 0x4e, 0x11, 0x50, 0xa9, 0x88, 0x36, 0xdd, 0x58,
-	0x2c, 0x9f, 0xe3, 0xde, 0x59, 0x8d, 0xed, 0x10, 0x3b, 0x85, 0x30, 0xf0, 0x07, 0x30, 0x31, 0x32,
-	0x96, 0x8d, 0xb1, 0x03, 0x7f, 0x44, 0xc7, 0x8a, 0x09, 0x31, 0x44, 0xa8, 0x1d, 0xca, 0x7c, 0x13,
-	0x23, 0xb2, 0x1d, 0x1d, 0x87, 0x68, 0x2b, 0xb1, 0x44, 0xf6, 0x7b, 0xef, 0x7b, 0xef, 0xfb, 0x62,
-	0x7d, 0xf6, 0x1c, 0xe5, 0x08, 0x86, 0x22, 0x0b, 0x33, 0x98, 0x43, 0x2a, 0xba, 0x59, 0xce, 0x25,
-	0x77, 0x66, 0x14, 0xda, 0x15, 0x59, 0xeb, 0x32, 0xa4, 0x84, 0xf1, 0x50, 0x7f, 0x0d, 0xd7, 0x9a,
-	0x47, 0x5c, 0x50, 0x2e, 0x80, 0xbe, 0x85, 0xe6, 0x52, 0x53, 0x73, 0x03, 0x3e, 0xe0, 0x06, 0x57,
-	0x27, 0x83, 0x06, 0x3f, 0x9b, 0x76, 0x73, 0x5d, 0xbb, 0x3b, 0x0b, 0xf6, 0x6c, 0x82, 0x33, 0x2e,
-	0x88, 0x04, 0x09, 0x66, 0x9c, 0xba, 0x56, 0xdb, 0xea, 0x9c, 0x8f, 0x2f, 0xd6, 0x60, 0xa4, 0x30,
-	0x67, 0xc3, 0xbe, 0x40, 0x09, 0x03, 0x35, 0xe6, 0xfe, 0xa7, 0x24, 0xbd, 0xe5, 0xfd, 0xca, 0x6f,
-	0x7c, 0xab, 0xfc, 0x2b, 0x26, 0x50, 0x24, 0xdb, 0x5d, 0xc2, 0x43, 0x0a, 0xe5, 0xb0, 0xbb, 0xca,
-	0xe4, 0x97, 0xcf, 0x77, 0xec, 0xba, 0x93, 0x55, 0x26, 0x3f, 0x1d, 0xef, 0x2d, 0x59, 0xb1, 0x4d,
-	0x09, 0x8b, 0x8c, 0x87, 0xf3, 0xc6, 0xf6, 0x04, 0x46, 0x9c, 0x25, 0x30, 0x2f, 0x81, 0xc8, 0x80,
-	0x90, 0x3c, 0xc7, 0x20, 0xcb, 0x09, 0xc2, 0x20, 0x87, 0x92, 0x70, 0x77, 0x4a, 0xa7, 0xdc, 0xaf,
-	0x53, 0xae, 0xfe, 0x9d, 0xb2, 0x86, 0x07, 0x10, 0x95, 0x11, 0x46, 0x13, 0x59, 0x11, 0x46, 0x26,
-	0xab, 0x35, 0x76, 0xdf, 0xcc, 0x36, 0x95, 0xf7, 0xba, 0xb2, 0x8e, 0x95, 0xb3, 0xf3, 0xd1, 0xb2,
-	0x97, 0x59, 0x41, 0x01, 0xdf, 0x02, 0x43, 0xa2, 0x82, 0x09, 0x82, 0x29, 0xe8, 0xa7, 0x1c, 0x6d,
-	0x0b, 0xb0, 0xc5, 0x73, 0x40, 0x21, 0x61, 0x12, 0x33, 0xc8, 0x54, 0x33, 0x18, 0xf1, 0x3c, 0x11,
-	0xee, 0x74, 0xdb, 0xea, 0x4c, 0xf5, 0x1e, 0x8e, 0x2a, 0xff, 0x41, 0x09, 0x69, 0xba, 0x12, 0xfc,
-	0xab, 0x43, 0x10, 0x2f, 0xb1, 0x82, 0x3e, 0xdf, 0x7a, 0x3a, 0x2e, 0xe8, 0x69, 0xfd, 0x63, 0x9e,
-	0x3f, 0xfb, 0xad, 0x8e, 0x8d, 0xd8, 0x41, 0x76, 0x6b, 0xd2, 0x23, 0x29, 0xf4, 0x4f, 0x61, 0xe0,
-	0x65, 0xc1, 0x25, 0x74, 0xff, 0xd7, 0xcd, 0xdc, 0x1a, 0x55, 0xfe, 0x0d, 0xd3, 0xcc, 0xe9, 0xda,
-	0x20, 0x76, 0x27, 0xc8, 0xa8, 0xe6, 0x36, 0x14, 0xe5, 0xbc, 0xb5, 0x6f, 0xd6, 0x53, 0xa8, 0x4e,
-	0x8a, 0xec, 0x94, 0x09, 0xdc, 0xa6, 0x8e, 0x0b, 0x47, 0x95, 0x7f, 0xfb, 0x8f, 0xd9, 0xcf, 0xac,
-	0x0a, 0x62, 0x5f, 0xcf, 0xbb, 0xa6, 0x45, 0x27, 0xcd, 0xea, 0x0c, 0xed, 0x6b, 0x45, 0x96, 0x40,
-	0x89, 0xc1, 0x20, 0xe5, 0x7d, 0x98, 0xd6, 0xef, 0xaf, 0x04, 0xf9, 0x0e, 0x4c, 0xdd, 0x99, 0xb6,
-	0xd5, 0x99, 0xee, 0x2d, 0x8e, 0x2a, 0x7f, 0xc1, 0xe4, 0x9e, 0xa5, 0x0e, 0xe2, 0x79, 0x43, 0x3f,
-	0xd1, 0xac, 0x7e, 0xef, 0xd5, 0x9a, 0x9b, 0x48, 0x32, 0x45, 0x09, 0x11, 0x30, 0x4d, 0xf9, 0x2b,
-	0x9c, 0x80, 0x04, 0x96, 0xc2, 0x3d, 0xd7, 0xb6, 0x3a, 0xb3, 0x27, 0x24, 0x9d, 0xa8, 0x1e, 0x27,
-	0xe9, 0x8c, 0x68, 0x4c, 0x46, 0xb0, 0x14, 0x2b, 0xd7, 0x3f, 0xec, 0xfa, 0x8d, 0x1f, 0xbb, 0xbe,
-	0xf5, 0xee, 0x78, 0x6f, 0xe9, 0x92, 0xde, 0xe5, 0xd7, 0x6a, 0x9b, 0xcd, 0xbe, 0xf5, 0x1e, 0xed,
-	0x1f, 0x7a, 0xd6, 0xc1, 0xa1, 0x67, 0x7d, 0x3f, 0xf4, 0xac, 0xf7, 0x47, 0x5e, 0xe3, 0xe0, 0xc8,
-	0x6b, 0x7c, 0x3d, 0xf2, 0x1a, 0x2f, 0x16, 0x07, 0x44, 0x0e, 0x8b, 0x7e, 0x17, 0x71, 0x1a, 0xaa,
-	0x32, 0x34, 0x84, 0x84, 0xe9, 0x53, 0xb8, 0x73, 0xd7, 0x78, 0xc8, 0x32, 0xc3, 0xa2, 0xdf, 0xd4,
-	0x4b, 0x7c, 0xef, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x0a, 0xcb, 0xb6, 0x29, 0x04, 0x00,
-	0x00,
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x31, 0x6f, 0xd4, 0x3c,
+	0x18, 0xbe, 0x7c, 0xed, 0xd7, 0x7e, 0xcd, 0x47, 0x25, 0x88, 0x8a, 0x48, 0x0f, 0x48, 0x8e, 0x14,
+	0xd4, 0x53, 0x11, 0x97, 0x0a, 0x24, 0x90, 0xca, 0xc4, 0x11, 0x01, 0x95, 0x0a, 0xb4, 0xe9, 0x04,
+	0x8b, 0xe5, 0x73, 0xdc, 0x3b, 0xab, 0xb1, 0x1d, 0x62, 0xa7, 0x70, 0x0c, 0xfc, 0x00, 0x26, 0x46,
+	0xc6, 0xb2, 0x31, 0x76, 0xe0, 0x47, 0x74, 0xac, 0x60, 0x41, 0x0c, 0x11, 0x6a, 0x87, 0x32, 0xdf,
+	0x2f, 0x40, 0xb6, 0xa3, 0xe3, 0x10, 0xd7, 0x4a, 0x2c, 0x91, 0xfd, 0x3c, 0xcf, 0xfb, 0x3c, 0xef,
+	0x1b, 0xcb, 0xb6, 0xe7, 0x28, 0x47, 0x30, 0x14, 0x59, 0x98, 0xc1, 0x1c, 0x52, 0xd1, 0xca, 0x72,
+	0x2e, 0xb9, 0x33, 0xad, 0xd0, 0x96, 0xc8, 0xea, 0xe7, 0x20, 0x25, 0x8c, 0x87, 0xfa, 0x6b, 0xb8,
+	0xfa, 0x3c, 0xe2, 0x82, 0x72, 0x01, 0xf4, 0x2e, 0x34, 0x9b, 0x8a, 0x9a, 0xeb, 0xf2, 0x2e, 0x37,
+	0xb8, 0x5a, 0x19, 0x34, 0xf8, 0x32, 0x6d, 0x4f, 0xad, 0x6b, 0x77, 0x67, 0xc1, 0x9e, 0x4d, 0x70,
+	0xc6, 0x05, 0x91, 0x20, 0xc1, 0x8c, 0x53, 0xd7, 0x6a, 0x58, 0xcd, 0x99, 0xf8, 0x4c, 0x05, 0x46,
+	0x0a, 0x73, 0x36, 0xec, 0xff, 0x29, 0x61, 0xa0, 0xc2, 0xdc, 0x7f, 0x94, 0xa4, 0xbd, 0xbc, 0x5f,
+	0xfa, 0xb5, 0x6f, 0xa5, 0x7f, 0xde, 0x04, 0x8a, 0x64, 0xbb, 0x45, 0x78, 0x48, 0xa1, 0xec, 0xb5,
+	0x56, 0x99, 0xfc, 0xfc, 0xe9, 0x86, 0x5d, 0x75, 0xb2, 0xca, 0xe4, 0xc7, 0xe3, 0xbd, 0x25, 0x2b,
+	0xb6, 0x29, 0x61, 0x91, 0xf1, 0x70, 0x5e, 0xdb, 0x9e, 0xc0, 0x88, 0xb3, 0x04, 0xe6, 0x7d, 0x20,
+	0x32, 0x20, 0x24, 0xcf, 0x31, 0xc8, 0x72, 0x82, 0x30, 0xc8, 0xa1, 0x24, 0xdc, 0x9d, 0xd0, 0x29,
+	0xb7, 0xab, 0x94, 0x8b, 0x7f, 0xa6, 0xac, 0xe1, 0x2e, 0x44, 0xfd, 0x08, 0xa3, 0x91, 0xac, 0x08,
+	0x23, 0x93, 0x55, 0x1f, 0xba, 0x6f, 0x66, 0x9b, 0xca, 0x7b, 0x5d, 0x59, 0xc7, 0xca, 0xd9, 0xf9,
+	0x60, 0xd9, 0xcb, 0xac, 0xa0, 0x80, 0x6f, 0x81, 0x1e, 0x51, 0xc1, 0x04, 0xc1, 0x14, 0x74, 0x52,
+	0x8e, 0xb6, 0x05, 0xd8, 0xe2, 0x39, 0xa0, 0x90, 0x30, 0x89, 0x19, 0x64, 0xaa, 0x19, 0x8c, 0x78,
+	0x9e, 0x08, 0x77, 0xb2, 0x61, 0x35, 0x27, 0xda, 0x77, 0x07, 0xa5, 0x7f, 0xa7, 0x0f, 0x69, 0xba,
+	0x12, 0xfc, 0xad, 0x43, 0x10, 0x2f, 0xb1, 0x82, 0x3e, 0xdd, 0x7a, 0x34, 0x2c, 0x68, 0x6b, 0xfd,
+	0x03, 0x9e, 0x3f, 0xfe, 0xa5, 0x8e, 0x8d, 0xd8, 0x41, 0x76, 0x7d, 0xd4, 0x23, 0x29, 0xf4, 0x4f,
+	0x61, 0xe0, 0x45, 0xc1, 0x25, 0x74, 0xff, 0xd5, 0xcd, 0x5c, 0x1b, 0x94, 0xfe, 0x15, 0xd3, 0xcc,
+	0xc9, 0xda, 0x20, 0x76, 0x47, 0xc8, 0xa8, 0xe2, 0x36, 0x14, 0xe5, 0xbc, 0xb1, 0xaf, 0x56, 0x53,
+	0xa8, 0x4e, 0x8a, 0xec, 0x84, 0x09, 0xdc, 0x29, 0x1d, 0x17, 0x0e, 0x4a, 0xff, 0xfa, 0x6f, 0xb3,
+	0x9f, 0x5a, 0x15, 0xc4, 0xbe, 0x9e, 0x77, 0x4d, 0x8b, 0xc6, 0xcd, 0xea, 0xf4, 0xec, 0x4b, 0x45,
+	0x96, 0x40, 0x89, 0x41, 0x37, 0xe5, 0x1d, 0x98, 0x56, 0xe7, 0xaf, 0x04, 0xf9, 0x0e, 0x4c, 0xdd,
+	0xe9, 0x86, 0xd5, 0x9c, 0x6c, 0x2f, 0x0e, 0x4a, 0x7f, 0xc1, 0xe4, 0x9e, 0xa6, 0x0e, 0xe2, 0x79,
+	0x43, 0x3f, 0xd4, 0xac, 0x3e, 0xef, 0xd5, 0x8a, 0x1b, 0x49, 0x32, 0x45, 0x09, 0x11, 0x30, 0x4d,
+	0xf9, 0x4b, 0x9c, 0x80, 0x04, 0xf6, 0x85, 0xfb, 0x5f, 0xc3, 0x6a, 0xce, 0x8e, 0x49, 0x1a, 0xab,
+	0x1e, 0x26, 0xe9, 0x8c, 0x68, 0x48, 0x46, 0xb0, 0x2f, 0x9c, 0x67, 0xf6, 0x05, 0x75, 0x57, 0x4c,
+	0x21, 0xea, 0x41, 0xd6, 0xc5, 0x80, 0x71, 0x49, 0x10, 0x76, 0x67, 0xf4, 0x38, 0xc1, 0xa0, 0xf4,
+	0xbd, 0xea, 0xd4, 0xc6, 0x0b, 0x83, 0x78, 0x8e, 0x12, 0xa6, 0xcd, 0xef, 0x6b, 0xfc, 0x89, 0x86,
+	0x57, 0x2e, 0xbf, 0xdf, 0xf5, 0x6b, 0x3f, 0x76, 0x7d, 0xeb, 0xed, 0xf1, 0xde, 0xd2, 0x59, 0xfd,
+	0x4c, 0xbc, 0x52, 0x0f, 0x85, 0xb9, 0xca, 0xed, 0x7b, 0xfb, 0x87, 0x9e, 0x75, 0x70, 0xe8, 0x59,
+	0xdf, 0x0f, 0x3d, 0xeb, 0xdd, 0x91, 0x57, 0x3b, 0x38, 0xf2, 0x6a, 0x5f, 0x8f, 0xbc, 0xda, 0xf3,
+	0xc5, 0x2e, 0x91, 0xbd, 0xa2, 0xd3, 0x42, 0x9c, 0x86, 0xaa, 0x0c, 0xf5, 0x20, 0x61, 0x7a, 0x15,
+	0xee, 0xdc, 0x34, 0x1e, 0xb2, 0x9f, 0x61, 0xd1, 0x99, 0xd2, 0xef, 0xc3, 0xad, 0x9f, 0x03, 0x00,
+	0xbc, 0x7d, 0xe0, 0xe9, 0x84, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -211,6 +222,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.UpdatePriceDisallowedDays != that1.UpdatePriceDisallowedDays {
 		return false
 	}
+	if this.MinPriceChangeNotice != that1.MinPriceChangeNotice {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -233,6 +247,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinPriceChangeNotice != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinPriceChangeNotice))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.UpdatePriceDisallowedDays != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.UpdatePriceDisallowedDays))
 		i--
@@ -328,6 +347,9 @@ func (m *Params) Size() (n int) {
 	if m.UpdatePriceDisallowedDays != 0 {
 		n += 1 + sovParams(uint64(m.UpdatePriceDisallowedDays))
 	}
+	if m.MinPriceChangeNotice != 0 {
+		n += 1 + sovParams(uint64(m.MinPriceChangeNotice))
+	}
 	return n
 }
 
@@ -561,6 +583,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceChangeNotice", wireType)
+			}
+			m.MinPriceChangeNotice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPriceChangeNotice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -42,3 +42,35 @@ func TestValidateParams_UpdateGlobalPriceIntervalBound(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateParams_MinPriceChangeNoticeBound covers the bound on the notice.
+// UpdateSpStoragePrice adds it to the block time as an int64, which would
+// overflow for a notice near that range and let a price take effect at once.
+func TestValidateParams_MinPriceChangeNoticeBound(t *testing.T) {
+	tests := []struct {
+		name    string
+		notice  uint64
+		wantErr bool
+	}{
+		{"default", types.DefaultMinPriceChangeNotice, false},
+		{"a week", 7 * 24 * 60 * 60, false},
+		{"the max", types.MaxMinPriceChangeNotice, false},
+		{"one past the max", types.MaxMinPriceChangeNotice + 1, true},
+		{"max int64", stdmath.MaxInt64, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MinPriceChangeNotice = tc.notice
+
+			err := params.Validate()
+			if tc.wantErr {
+				require.Error(t, err, "a notice that can overflow the effective time must be rejected")
+				require.Contains(t, err.Error(), "min price change notice too large")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -302,6 +302,186 @@ func (m *QuerySpStoragePriceResponse) GetSpStoragePrice() SpStoragePrice {
 	return SpStoragePrice{}
 }
 
+type QueryPendingSpStoragePricesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingSpStoragePricesRequest) Reset()         { *m = QueryPendingSpStoragePricesRequest{} }
+func (m *QueryPendingSpStoragePricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSpStoragePricesRequest) ProtoMessage()    {}
+func (*QueryPendingSpStoragePricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fe56b2e7adad6bb, []int{6}
+}
+func (m *QueryPendingSpStoragePricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSpStoragePricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSpStoragePricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSpStoragePricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSpStoragePricesRequest.Merge(m, src)
+}
+func (m *QueryPendingSpStoragePricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSpStoragePricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSpStoragePricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSpStoragePricesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingSpStoragePricesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingSpStoragePricesResponse struct {
+	// the scheduled prices, the update time of which is the time they take effect
+	PendingPrices []SpStoragePrice `protobuf:"bytes,1,rep,name=pending_prices,json=pendingPrices,proto3" json:"pending_prices"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingSpStoragePricesResponse) Reset()         { *m = QueryPendingSpStoragePricesResponse{} }
+func (m *QueryPendingSpStoragePricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSpStoragePricesResponse) ProtoMessage()    {}
+func (*QueryPendingSpStoragePricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fe56b2e7adad6bb, []int{7}
+}
+func (m *QueryPendingSpStoragePricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSpStoragePricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSpStoragePricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSpStoragePricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSpStoragePricesResponse.Merge(m, src)
+}
+func (m *QueryPendingSpStoragePricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSpStoragePricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSpStoragePricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSpStoragePricesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingSpStoragePricesResponse) GetPendingPrices() []SpStoragePrice {
+	if m != nil {
+		return m.PendingPrices
+	}
+	return nil
+}
+
+func (m *QueryPendingSpStoragePricesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryNextGlobalSpStorePriceRequest struct {
+}
+
+func (m *QueryNextGlobalSpStorePriceRequest) Reset()         { *m = QueryNextGlobalSpStorePriceRequest{} }
+func (m *QueryNextGlobalSpStorePriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextGlobalSpStorePriceRequest) ProtoMessage()    {}
+func (*QueryNextGlobalSpStorePriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fe56b2e7adad6bb, []int{8}
+}
+func (m *QueryNextGlobalSpStorePriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextGlobalSpStorePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextGlobalSpStorePriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextGlobalSpStorePriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextGlobalSpStorePriceRequest.Merge(m, src)
+}
+func (m *QueryNextGlobalSpStorePriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextGlobalSpStorePriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextGlobalSpStorePriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextGlobalSpStorePriceRequest proto.InternalMessageInfo
+
+type QueryNextGlobalSpStorePriceResponse struct {
+	// the projected price, the update time of which is the time it takes effect
+	GlobalSpStorePrice GlobalSpStorePrice `protobuf:"bytes,1,opt,name=global_sp_store_price,json=globalSpStorePrice,proto3" json:"global_sp_store_price"`
+}
+
+func (m *QueryNextGlobalSpStorePriceResponse) Reset()         { *m = QueryNextGlobalSpStorePriceResponse{} }
+func (m *QueryNextGlobalSpStorePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextGlobalSpStorePriceResponse) ProtoMessage()    {}
+func (*QueryNextGlobalSpStorePriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fe56b2e7adad6bb, []int{9}
+}
+func (m *QueryNextGlobalSpStorePriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextGlobalSpStorePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextGlobalSpStorePriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextGlobalSpStorePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextGlobalSpStorePriceResponse.Merge(m, src)
+}
+func (m *QueryNextGlobalSpStorePriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextGlobalSpStorePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextGlobalSpStorePriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextGlobalSpStorePriceResponse proto.InternalMessageInfo
+
+func (m *QueryNextGlobalSpStorePriceResponse) GetGlobalSpStorePrice() GlobalSpStorePrice {
+	if m != nil {
+		return m.GlobalSpStorePrice
+	}
+	return GlobalSpStorePrice{}
+}
+
 type QueryGlobalSpStorePriceByTimeRequest struct {
 	// unix timestamp in seconds. If it's 0, it will return the latest price.
 	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -311,7 +491,7 @@ func (m *QueryGlobalSpStorePriceByTimeRequest) Reset()         { *m = QueryGloba
 func (m *QueryGlobalSpStorePriceByTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalSpStorePriceByTimeRequest) ProtoMessage()    {}
 func (*QueryGlobalSpStorePriceByTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4fe56b2e7adad6bb, []int{6}
+	return fileDescriptor_4fe56b2e7adad6bb, []int{10}
 }
 func (m *QueryGlobalSpStorePriceByTimeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +535,7 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Reset()         { *m = QueryGlob
 func (m *QueryGlobalSpStorePriceByTimeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalSpStorePriceByTimeResponse) ProtoMessage()    {}
 func (*QueryGlobalSpStorePriceByTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4fe56b2e7adad6bb, []int{7}
+	return fileDescriptor_4fe56b2e7adad6bb, []int{11}
 }
 func (m *QueryGlobalSpStorePriceByTimeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,7 +579,7 @@ func (m *QueryStorageProviderRequest) Reset()         { *m = QueryStorageProvide
 func (m *QueryStorageProviderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStorageProviderRequest) ProtoMessage()    {}
 func (*QueryStorageProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4fe56b2e7adad6bb, []int{8}
+	return fileDescriptor_4fe56b2e7adad6bb, []int{12}
 }
 func (m *QueryStorageProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +623,7 @@ func (m *QueryStorageProviderResponse) Reset()         { *m = QueryStorageProvid
 func (m *QueryStorageProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStorageProviderResponse) ProtoMessage()    {}
 func (*QueryStorageProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4fe56b2e7adad6bb, []int{9}
+	return fileDescriptor_4fe56b2e7adad6bb, []int{13}
 }
 func (m *QueryStorageProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +671,7 @@ func (m *QueryStorageProviderByOperatorAddressRequest) String() string {
 }
 func (*QueryStorageProviderByOperatorAddressRequest) ProtoMessage() {}
 func (*QueryStorageProviderByOperatorAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4fe56b2e7adad6bb, []int{10}
+	return fileDescriptor_4fe56b2e7adad6bb, []int{14}
 }
 func (m *QueryStorageProviderByOperatorAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,7 +719,7 @@ func (m *QueryStorageProviderByOperatorAddressResponse) String() string {
 }
 func (*QueryStorageProviderByOperatorAddressResponse) ProtoMessage() {}
 func (*QueryStorageProviderByOperatorAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4fe56b2e7adad6bb, []int{11}
+	return fileDescriptor_4fe56b2e7adad6bb, []int{15}
 }
 func (m *QueryStorageProviderByOperatorAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +767,7 @@ func (m *QueryStorageProviderMaintenanceRecordsRequest) String() string {
 }
 func (*QueryStorageProviderMaintenanceRecordsRequest) ProtoMessage() {}
 func (*QueryStorageProviderMaintenanceRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4fe56b2e7adad6bb, []int{12}
+	return fileDescriptor_4fe56b2e7adad6bb, []int{16}
 }
 func (m *QueryStorageProviderMaintenanceRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +815,7 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) String() string {
 }
 func (*QueryStorageProviderMaintenanceRecordsResponse) ProtoMessage() {}
 func (*QueryStorageProviderMaintenanceRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4fe56b2e7adad6bb, []int{13}
+	return fileDescriptor_4fe56b2e7adad6bb, []int{17}
 }
 func (m *QueryStorageProviderMaintenanceRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,6 +858,10 @@ func init() {
 	proto.RegisterType((*QueryStorageProvidersResponse)(nil), "moca.sp.QueryStorageProvidersResponse")
 	proto.RegisterType((*QuerySpStoragePriceRequest)(nil), "moca.sp.QuerySpStoragePriceRequest")
 	proto.RegisterType((*QuerySpStoragePriceResponse)(nil), "moca.sp.QuerySpStoragePriceResponse")
+	proto.RegisterType((*QueryPendingSpStoragePricesRequest)(nil), "moca.sp.QueryPendingSpStoragePricesRequest")
+	proto.RegisterType((*QueryPendingSpStoragePricesResponse)(nil), "moca.sp.QueryPendingSpStoragePricesResponse")
+	proto.RegisterType((*QueryNextGlobalSpStorePriceRequest)(nil), "moca.sp.QueryNextGlobalSpStorePriceRequest")
+	proto.RegisterType((*QueryNextGlobalSpStorePriceResponse)(nil), "moca.sp.QueryNextGlobalSpStorePriceResponse")
 	proto.RegisterType((*QueryGlobalSpStorePriceByTimeRequest)(nil), "moca.sp.QueryGlobalSpStorePriceByTimeRequest")
 	proto.RegisterType((*QueryGlobalSpStorePriceByTimeResponse)(nil), "moca.sp.QueryGlobalSpStorePriceByTimeResponse")
 	proto.RegisterType((*QueryStorageProviderRequest)(nil), "moca.sp.QueryStorageProviderRequest")
@@ -691,62 +875,70 @@ func init() {
 func init() { proto.RegisterFile("moca/sp/query.proto", fileDescriptor_4fe56b2e7adad6bb) }
 
 var fileDescriptor_4fe56b2e7adad6bb = []byte{
-	// 880 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x18, 0x8d, 0x53, 0x91, 0xd2, 0x59, 0xb1, 0xe9, 0xce, 0x2e, 0xca, 0xae, 0x9b, 0xcd, 0xae, 0xdc,
-	0x66, 0x77, 0x29, 0xc4, 0x56, 0x43, 0x0b, 0x12, 0xb7, 0x06, 0x44, 0x55, 0x09, 0x44, 0x31, 0x5c,
-	0x5a, 0x21, 0x59, 0x93, 0x78, 0xea, 0x5a, 0xd4, 0x9e, 0xa9, 0xc7, 0xad, 0x88, 0xaa, 0x5e, 0xca,
-	0x19, 0x09, 0x09, 0xfe, 0x08, 0x8e, 0x9c, 0xf9, 0x0b, 0x7a, 0xac, 0xc4, 0x85, 0x13, 0x42, 0x2d,
-	0x12, 0x57, 0xee, 0x5c, 0x90, 0x67, 0x3e, 0x3b, 0xb5, 0xe3, 0x24, 0x2d, 0xe2, 0x52, 0x35, 0xdf,
-	0xaf, 0xf7, 0xde, 0x37, 0x33, 0x4f, 0x46, 0x0f, 0x03, 0x36, 0x20, 0x96, 0xe0, 0xd6, 0xd1, 0x31,
-	0x8d, 0x86, 0x26, 0x8f, 0x58, 0xcc, 0xf0, 0x7c, 0x12, 0x34, 0x05, 0xd7, 0x1f, 0x90, 0xc0, 0x0f,
-	0x99, 0x25, 0xff, 0xaa, 0x9c, 0xbe, 0x3a, 0x60, 0x22, 0x60, 0xc2, 0xea, 0x13, 0x41, 0x55, 0x93,
-	0x75, 0xb2, 0xd6, 0xa7, 0x31, 0x59, 0xb3, 0x38, 0xf1, 0xfc, 0x90, 0xc4, 0x3e, 0x0b, 0xa1, 0xf6,
-	0x89, 0xaa, 0x75, 0xe4, 0x2f, 0x4b, 0xfd, 0x80, 0xd4, 0x23, 0x8f, 0x79, 0x4c, 0xc5, 0x93, 0xff,
-	0x20, 0xda, 0xf4, 0x18, 0xf3, 0x0e, 0xa9, 0x45, 0xb8, 0x6f, 0x91, 0x30, 0x64, 0xb1, 0x9c, 0x96,
-	0xf5, 0xa4, 0x5c, 0x39, 0x89, 0x48, 0x90, 0x46, 0x33, 0x05, 0xf1, 0x90, 0x53, 0x08, 0x1a, 0x8f,
-	0x10, 0xfe, 0x3c, 0xe1, 0xb6, 0x23, 0x2b, 0x6d, 0x7a, 0x74, 0x4c, 0x45, 0x6c, 0x6c, 0xa3, 0x87,
-	0xb9, 0xa8, 0xe0, 0x2c, 0x14, 0x14, 0x77, 0x51, 0x4d, 0x4d, 0x7c, 0xac, 0x3d, 0xd7, 0x5e, 0xdd,
-	0xeb, 0xd6, 0x4d, 0xd0, 0x6f, 0xaa, 0xc2, 0xde, 0xc2, 0xc5, 0xef, 0xcf, 0x2a, 0x3f, 0xfd, 0xf5,
-	0xf3, 0xaa, 0x66, 0x43, 0xa5, 0xb1, 0x8f, 0x9a, 0x72, 0xd4, 0x17, 0x31, 0x8b, 0x88, 0x47, 0x77,
-	0x22, 0x76, 0xe2, 0xbb, 0x34, 0x4a, 0xa1, 0xf0, 0xc7, 0x08, 0x8d, 0xd6, 0x01, 0x73, 0x5f, 0x98,
-	0xb0, 0x82, 0x64, 0x77, 0xa6, 0x5a, 0x38, 0xec, 0xce, 0xdc, 0x21, 0x1e, 0x85, 0x5e, 0xfb, 0x46,
-	0xa7, 0xf1, 0xa3, 0x86, 0x9e, 0x4e, 0x00, 0x02, 0xf6, 0xab, 0x68, 0x4e, 0xf0, 0x84, 0xfa, 0xdc,
-	0xab, 0x7b, 0xdd, 0xc7, 0x19, 0xf5, 0x42, 0xbd, 0x9d, 0x14, 0xe1, 0xad, 0x1c, 0xab, 0xaa, 0x64,
-	0xf5, 0x72, 0x26, 0x2b, 0x05, 0x94, 0xa3, 0xb5, 0x81, 0x74, 0xc5, 0x8a, 0x67, 0x38, 0xfe, 0x20,
-	0x15, 0x80, 0x1b, 0x68, 0x5e, 0x70, 0x87, 0xb8, 0x6e, 0x24, 0x95, 0x2f, 0xd8, 0x35, 0xc1, 0x37,
-	0x5d, 0x37, 0x32, 0xbe, 0x46, 0x4b, 0xa5, 0x6d, 0x20, 0xe5, 0x13, 0xb4, 0x28, 0xb8, 0x23, 0x54,
-	0xca, 0xe1, 0x49, 0x0e, 0x56, 0xd7, 0x18, 0xe9, 0xca, 0xb5, 0xde, 0x3c, 0x9a, 0xfb, 0x22, 0x97,
-	0x32, 0x3e, 0x42, 0x2b, 0x12, 0x6c, 0xeb, 0x90, 0xf5, 0xc9, 0xa1, 0xea, 0x83, 0xae, 0xe1, 0x97,
-	0x7e, 0x90, 0xb1, 0x6d, 0xa2, 0x85, 0xd8, 0x0f, 0xa8, 0x88, 0x49, 0xc0, 0x25, 0xdc, 0x9c, 0x3d,
-	0x0a, 0x18, 0xe7, 0x1a, 0x6a, 0xcf, 0x18, 0x03, 0xec, 0x77, 0xd1, 0x9b, 0x9e, 0xac, 0x71, 0x40,
-	0x44, 0x5e, 0xc2, 0x52, 0x26, 0xa1, 0x64, 0xd2, 0x0d, 0x19, 0xd8, 0x1b, 0x4b, 0x1b, 0x9d, 0x74,
-	0x6f, 0x85, 0x43, 0x05, 0x05, 0xf7, 0x51, 0xd5, 0x77, 0x25, 0xcc, 0x1b, 0x76, 0xd5, 0x77, 0x8d,
-	0x7e, 0xf9, 0xe5, 0xcc, 0x98, 0xf6, 0x50, 0x5d, 0xe4, 0x53, 0xc0, 0x71, 0xf2, 0xf5, 0x29, 0x36,
-	0x18, 0xbb, 0xe8, 0x9d, 0x32, 0x8c, 0xde, 0xf0, 0x33, 0x4e, 0x23, 0x12, 0xb3, 0x28, 0x39, 0x70,
-	0x2a, 0xb2, 0x07, 0xf1, 0x16, 0x5a, 0x64, 0x90, 0x91, 0x37, 0x83, 0x0a, 0x01, 0x97, 0xa3, 0xce,
-	0xf2, 0x1d, 0x86, 0x40, 0x9d, 0x5b, 0x8e, 0xfe, 0x1f, 0xf5, 0xec, 0x95, 0x83, 0x7e, 0x4a, 0xfc,
-	0x30, 0xa6, 0x21, 0x09, 0x93, 0x6b, 0x3a, 0x60, 0x91, 0xfb, 0x5f, 0x04, 0xed, 0x23, 0xf3, 0xb6,
-	0xb3, 0x41, 0xd1, 0x3a, 0x9a, 0x8f, 0x54, 0x08, 0x1e, 0xb6, 0x9e, 0x29, 0x19, 0xeb, 0xb2, 0xd3,
-	0xd2, 0xee, 0x3f, 0xaf, 0xa3, 0xd7, 0x24, 0x10, 0xfe, 0x0a, 0xd5, 0x94, 0x77, 0xe1, 0xd1, 0xb5,
-	0x1b, 0x37, 0x44, 0xbd, 0x59, 0x9e, 0x54, 0x24, 0x8c, 0xc6, 0xf9, 0xaf, 0x7f, 0xfe, 0x50, 0x7d,
-	0x80, 0xeb, 0x56, 0xde, 0x78, 0xf1, 0xb9, 0x86, 0x16, 0x8b, 0x7e, 0x84, 0xdb, 0xf9, 0x59, 0x13,
-	0x8c, 0x51, 0x7f, 0x31, 0xab, 0x0c, 0xc0, 0x9f, 0x49, 0xf0, 0x27, 0xb8, 0x01, 0xe0, 0x99, 0x29,
-	0xa4, 0x78, 0xdf, 0x69, 0xe0, 0xe6, 0x79, 0x47, 0xc0, 0xcb, 0x05, 0x80, 0x32, 0x87, 0xd2, 0x57,
-	0xa6, 0x17, 0x01, 0x87, 0xb7, 0x25, 0x87, 0x36, 0x5e, 0xce, 0x16, 0x50, 0xb4, 0x27, 0xeb, 0x14,
-	0x8c, 0xee, 0x0c, 0xff, 0x92, 0x3a, 0xf5, 0x24, 0xa3, 0xc0, 0x9d, 0x3c, 0xe8, 0x0c, 0x5f, 0xd2,
-	0xcd, 0xdb, 0x96, 0x03, 0xdb, 0x0f, 0x24, 0xdb, 0x75, 0xdc, 0xcd, 0xd8, 0x96, 0xda, 0x91, 0xd3,
-	0x1f, 0x3a, 0x89, 0xbd, 0x59, 0xa7, 0x99, 0xc9, 0x9d, 0xe1, 0x6f, 0x35, 0x54, 0x2f, 0x1c, 0x05,
-	0x5e, 0x99, 0x7a, 0x52, 0x29, 0xcb, 0xf6, 0x8c, 0x2a, 0x20, 0xb7, 0x2c, 0xc9, 0x3d, 0xc5, 0x4b,
-	0xe5, 0xc7, 0x69, 0x9d, 0xfa, 0xee, 0x19, 0xbe, 0xd0, 0xd0, 0xf3, 0x59, 0x8f, 0x1e, 0x6f, 0x4c,
-	0x05, 0x9c, 0xe4, 0x3f, 0xfa, 0x7b, 0x77, 0x6d, 0x03, 0xe2, 0x1b, 0x92, 0xb8, 0x85, 0x3b, 0xa3,
-	0x3b, 0x50, 0xe0, 0x9e, 0x2c, 0xb4, 0xe8, 0x04, 0xf8, 0x6f, 0x0d, 0x75, 0x67, 0x3e, 0xf7, 0x71,
-	0x71, 0xd3, 0x59, 0x4e, 0x34, 0x23, 0xfd, 0xfd, 0x3b, 0xf7, 0x81, 0xbc, 0x6d, 0x29, 0xef, 0x43,
-	0xbc, 0x39, 0x59, 0x5e, 0x30, 0xea, 0x76, 0xc0, 0x69, 0xca, 0x24, 0xf7, 0x36, 0x2f, 0xae, 0x5a,
-	0xda, 0xe5, 0x55, 0x4b, 0xfb, 0xe3, 0xaa, 0xa5, 0x7d, 0x7f, 0xdd, 0xaa, 0x5c, 0x5e, 0xb7, 0x2a,
-	0xbf, 0x5d, 0xb7, 0x2a, 0x7b, 0x2f, 0x3d, 0x3f, 0x3e, 0x38, 0xee, 0x9b, 0x03, 0x16, 0x48, 0x98,
-	0xc1, 0x01, 0xf1, 0x43, 0x05, 0x78, 0xd2, 0xb5, 0xbe, 0xc9, 0x3e, 0xde, 0xfa, 0x35, 0xf9, 0xf5,
-	0xf6, 0xee, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x30, 0x77, 0x45, 0x96, 0x0a, 0x00, 0x00,
+	// 1001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x13, 0x91, 0x28, 0x2f, 0x6a, 0x36, 0x9d, 0x96, 0xa6, 0x75, 0xd2, 0x6d, 0xe5, 0x6c,
+	0xda, 0x92, 0x26, 0xb6, 0xba, 0x34, 0x20, 0x71, 0x4b, 0x40, 0x54, 0x91, 0xf8, 0x11, 0x16, 0x2e,
+	0xad, 0x90, 0xac, 0xd9, 0xf5, 0xd4, 0xb5, 0x58, 0x7b, 0x5c, 0x8f, 0x13, 0x65, 0x15, 0x45, 0x42,
+	0xe1, 0x8c, 0x84, 0x04, 0x77, 0x38, 0x72, 0xe4, 0x0a, 0x27, 0x8e, 0x39, 0x56, 0xe2, 0xc2, 0x09,
+	0xa1, 0x04, 0x89, 0x2b, 0x7f, 0x02, 0xf2, 0xcc, 0xb3, 0x37, 0xf6, 0xda, 0xbb, 0x1b, 0x14, 0x2e,
+	0x51, 0x76, 0xde, 0x8f, 0xef, 0xfb, 0xde, 0xcc, 0x7b, 0x6f, 0x17, 0xae, 0xf9, 0xbc, 0x43, 0x2d,
+	0x11, 0x5a, 0x2f, 0xf7, 0x58, 0xd4, 0x33, 0xc3, 0x88, 0xc7, 0x9c, 0xcc, 0x24, 0x87, 0xa6, 0x08,
+	0xf5, 0xab, 0xd4, 0xf7, 0x02, 0x6e, 0xc9, 0xbf, 0xca, 0xa6, 0xaf, 0x75, 0xb8, 0xf0, 0xb9, 0xb0,
+	0xda, 0x54, 0x30, 0x15, 0x64, 0xed, 0x3f, 0x6a, 0xb3, 0x98, 0x3e, 0xb2, 0x42, 0xea, 0x7a, 0x01,
+	0x8d, 0x3d, 0x1e, 0xa0, 0xef, 0x2d, 0xe5, 0x6b, 0xcb, 0x4f, 0x96, 0xfa, 0x80, 0xa6, 0xeb, 0x2e,
+	0x77, 0xb9, 0x3a, 0x4f, 0xfe, 0xc3, 0xd3, 0x65, 0x97, 0x73, 0xb7, 0xcb, 0x2c, 0x1a, 0x7a, 0x16,
+	0x0d, 0x02, 0x1e, 0xcb, 0x6c, 0x59, 0x4c, 0xca, 0x35, 0xa4, 0x11, 0xf5, 0xd3, 0xd3, 0x4c, 0x41,
+	0xdc, 0x0b, 0x19, 0x1e, 0x1a, 0xd7, 0x81, 0x7c, 0x92, 0x70, 0xdb, 0x95, 0x9e, 0x2d, 0xf6, 0x72,
+	0x8f, 0x89, 0xd8, 0xd8, 0x81, 0x6b, 0xb9, 0x53, 0x11, 0xf2, 0x40, 0x30, 0xd2, 0x84, 0x69, 0x95,
+	0xf1, 0xa6, 0x76, 0x57, 0x7b, 0x30, 0xd7, 0xac, 0x99, 0xa8, 0xdf, 0x54, 0x8e, 0xdb, 0xb3, 0x27,
+	0x7f, 0xdc, 0x99, 0xf8, 0xf1, 0xef, 0x9f, 0xd6, 0xb4, 0x16, 0x7a, 0x1a, 0xcf, 0x61, 0x59, 0xa6,
+	0xfa, 0x34, 0xe6, 0x11, 0x75, 0xd9, 0x6e, 0xc4, 0xf7, 0x3d, 0x87, 0x45, 0x29, 0x14, 0x79, 0x1f,
+	0xa0, 0x5f, 0x0e, 0xcc, 0x7b, 0xcf, 0xc4, 0x12, 0x24, 0xb5, 0x33, 0x55, 0xc1, 0xb1, 0x76, 0xe6,
+	0x2e, 0x75, 0x19, 0xc6, 0xb6, 0xce, 0x45, 0x1a, 0xdf, 0x69, 0x70, 0xbb, 0x02, 0x08, 0xd9, 0xaf,
+	0xc1, 0x94, 0x08, 0x13, 0xea, 0x53, 0x0f, 0xe6, 0x9a, 0x37, 0x33, 0xea, 0x05, 0xff, 0x56, 0xe2,
+	0x44, 0x9e, 0xe4, 0x58, 0x4d, 0x4a, 0x56, 0xf7, 0x47, 0xb2, 0x52, 0x40, 0x39, 0x5a, 0x9b, 0xa0,
+	0x2b, 0x56, 0x61, 0x86, 0xe3, 0x75, 0x52, 0x01, 0x64, 0x11, 0x66, 0x44, 0x68, 0x53, 0xc7, 0x89,
+	0xa4, 0xf2, 0xd9, 0xd6, 0xb4, 0x08, 0xb7, 0x1c, 0x27, 0x32, 0xbe, 0x80, 0xa5, 0xd2, 0x30, 0x94,
+	0xf2, 0x01, 0x2c, 0x88, 0xd0, 0x16, 0xca, 0x64, 0x87, 0x89, 0x0d, 0x4b, 0xb7, 0xd8, 0xd7, 0x95,
+	0x0b, 0x3d, 0x7f, 0x35, 0xf3, 0x22, 0x67, 0x32, 0xba, 0x60, 0xa8, 0xdb, 0x66, 0x81, 0xe3, 0x05,
+	0x6e, 0x3e, 0xf0, 0xd2, 0x2f, 0xea, 0x67, 0x0d, 0x56, 0x86, 0xc2, 0xa1, 0xc6, 0x1d, 0x98, 0x0f,
+	0x95, 0x87, 0x12, 0x98, 0xde, 0xdc, 0x38, 0x0a, 0xaf, 0x60, 0xa4, 0x4a, 0x79, 0x79, 0xb7, 0xd9,
+	0xc0, 0x4a, 0x7d, 0xc4, 0x0e, 0xe2, 0x27, 0x5d, 0xde, 0xa6, 0x5d, 0xc5, 0x21, 0x77, 0xab, 0xc6,
+	0x97, 0xa9, 0xc2, 0x2a, 0x37, 0x54, 0xf8, 0x14, 0x5e, 0x77, 0xa5, 0xd5, 0xc6, 0xcb, 0xcc, 0x5f,
+	0xe5, 0x52, 0x26, 0x74, 0x30, 0xc7, 0x79, 0xb1, 0xc4, 0x1d, 0x30, 0x1b, 0xef, 0x41, 0x43, 0x32,
+	0x28, 0x89, 0xec, 0x7d, 0xe6, 0xf9, 0xd9, 0x03, 0x5c, 0x86, 0xd9, 0xd8, 0xf3, 0x99, 0x88, 0xa9,
+	0x1f, 0x4a, 0xd8, 0xa9, 0x56, 0xff, 0xc0, 0x38, 0xd6, 0x60, 0x75, 0x44, 0x9a, 0xff, 0x5f, 0xca,
+	0x46, 0xda, 0x0a, 0x85, 0x3e, 0x45, 0x05, 0xf3, 0x30, 0xe9, 0x39, 0x12, 0xe6, 0x4a, 0x6b, 0xd2,
+	0x73, 0x8c, 0x76, 0xf9, 0xbc, 0xc9, 0x98, 0x6e, 0x43, 0x4d, 0xe4, 0x4d, 0xc8, 0xb1, 0x7a, 0x22,
+	0x14, 0x03, 0x8c, 0xa7, 0xb0, 0x5e, 0x86, 0xb1, 0xdd, 0xfb, 0x38, 0x64, 0x11, 0x8d, 0x79, 0x94,
+	0xf4, 0x30, 0x13, 0x59, 0xeb, 0xbc, 0x01, 0x0b, 0x1c, 0x2d, 0xb2, 0xd9, 0x99, 0x10, 0xd8, 0xef,
+	0x35, 0x9e, 0x8f, 0x30, 0x04, 0x6c, 0x8c, 0x99, 0xfa, 0x12, 0xf5, 0x3c, 0x2b, 0x07, 0xfd, 0x90,
+	0x7a, 0x41, 0xcc, 0x02, 0x1a, 0x24, 0x6f, 0xb6, 0xc3, 0x23, 0xe7, 0xbf, 0x08, 0x7a, 0x0e, 0xe6,
+	0xb8, 0xb9, 0x51, 0xd1, 0x63, 0x98, 0x89, 0xd4, 0x11, 0x76, 0xbc, 0x9e, 0x29, 0x19, 0x88, 0x6a,
+	0xa5, 0xae, 0xcd, 0x5f, 0xe7, 0xe0, 0x35, 0x09, 0x44, 0x3e, 0x87, 0x69, 0xb5, 0x8e, 0x48, 0xff,
+	0xd9, 0x0d, 0xee, 0x38, 0x7d, 0xb9, 0xdc, 0xa8, 0x48, 0x18, 0x8b, 0xc7, 0xbf, 0xfd, 0xf5, 0xed,
+	0xe4, 0x55, 0x52, 0xb3, 0xf2, 0xbb, 0x94, 0x1c, 0x6b, 0xb0, 0x50, 0x5c, 0x31, 0x64, 0x35, 0x9f,
+	0xab, 0x62, 0xd7, 0xe9, 0xf7, 0x46, 0xb9, 0x21, 0xf8, 0x1d, 0x09, 0x7e, 0x8b, 0x2c, 0x22, 0x78,
+	0x36, 0xe7, 0x53, 0xbc, 0xaf, 0x35, 0x5c, 0xd0, 0xf9, 0x11, 0x48, 0x56, 0x0a, 0x00, 0x65, 0x4b,
+	0x47, 0x6f, 0x0c, 0x77, 0x42, 0x0e, 0x0f, 0x25, 0x87, 0x55, 0xb2, 0x92, 0x15, 0xa0, 0xb8, 0x71,
+	0xac, 0x43, 0xdc, 0x5d, 0x47, 0xe4, 0x7b, 0x0d, 0x6e, 0x94, 0x8f, 0x73, 0xf2, 0xb0, 0x50, 0xe6,
+	0x61, 0x3b, 0x46, 0x5f, 0x1f, 0xcf, 0x19, 0x29, 0xae, 0x49, 0x8a, 0x0d, 0x62, 0xf4, 0xef, 0x08,
+	0x17, 0x46, 0x91, 0xaa, 0x20, 0x3f, 0x68, 0x70, 0xa3, 0x7c, 0x1c, 0x17, 0x19, 0x0e, 0x9d, 0xed,
+	0xfa, 0xfa, 0x78, 0xce, 0x95, 0x45, 0x0c, 0xd8, 0x41, 0x6c, 0x97, 0x8e, 0x4a, 0xf2, 0x4b, 0xfa,
+	0x0d, 0xa6, 0x6a, 0xda, 0x92, 0x8d, 0x3c, 0xf8, 0x88, 0xe1, 0xae, 0x9b, 0xe3, 0xba, 0x23, 0xdb,
+	0x77, 0x24, 0xdb, 0xc7, 0xa4, 0x99, 0xb1, 0x2d, 0x25, 0x6a, 0xb7, 0x7b, 0x76, 0xb2, 0x23, 0xac,
+	0xc3, 0x6c, 0x53, 0x1c, 0x91, 0xaf, 0x34, 0xa8, 0x15, 0xde, 0x33, 0x69, 0x0c, 0x7d, 0xee, 0x29,
+	0xcb, 0xd5, 0x11, 0x5e, 0x48, 0x6e, 0x45, 0x92, 0xbb, 0x4d, 0x96, 0xca, 0x7b, 0xc2, 0x3a, 0xf4,
+	0x9c, 0x23, 0x72, 0xa2, 0xc1, 0xdd, 0x51, 0x93, 0x93, 0x6c, 0x0e, 0x05, 0xac, 0x1a, 0xe2, 0xfa,
+	0x5b, 0x17, 0x0d, 0x43, 0xe2, 0x9b, 0x92, 0xb8, 0x45, 0x36, 0xfa, 0x8d, 0x54, 0xe0, 0x9e, 0x14,
+	0xb4, 0x38, 0x4e, 0xc9, 0x3f, 0x1a, 0x34, 0x47, 0xce, 0xcc, 0x41, 0x71, 0xc3, 0x59, 0x56, 0x4e,
+	0x74, 0xfd, 0xed, 0x0b, 0xc7, 0xa1, 0xbc, 0x1d, 0x29, 0xef, 0x5d, 0xb2, 0x55, 0x2d, 0xcf, 0xef,
+	0x47, 0xdb, 0x38, 0xae, 0xcb, 0x24, 0x6f, 0x6f, 0x9d, 0x9c, 0xd6, 0xb5, 0x57, 0xa7, 0x75, 0xed,
+	0xcf, 0xd3, 0xba, 0xf6, 0xcd, 0x59, 0x7d, 0xe2, 0xd5, 0x59, 0x7d, 0xe2, 0xf7, 0xb3, 0xfa, 0xc4,
+	0xb3, 0xfb, 0xae, 0x17, 0xbf, 0xd8, 0x6b, 0x9b, 0x1d, 0xee, 0x4b, 0x98, 0xce, 0x0b, 0xea, 0x05,
+	0x0a, 0x70, 0xbf, 0x69, 0x1d, 0x64, 0x3f, 0x6a, 0xda, 0xd3, 0xf2, 0x57, 0xcd, 0x9b, 0xff, 0x0e,
+	0x00, 0x3f, 0x4b, 0x70, 0xe9, 0xae, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -767,6 +959,10 @@ type QueryClient interface {
 	StorageProviders(ctx context.Context, in *QueryStorageProvidersRequest, opts ...grpc.CallOption) (*QueryStorageProvidersResponse, error)
 	// get the latest storage price of specific sp
 	QuerySpStoragePrice(ctx context.Context, in *QuerySpStoragePriceRequest, opts ...grpc.CallOption) (*QuerySpStoragePriceResponse, error)
+	// get the prices the sps scheduled to take effect later
+	PendingSpStoragePrices(ctx context.Context, in *QueryPendingSpStoragePricesRequest, opts ...grpc.CallOption) (*QueryPendingSpStoragePricesResponse, error)
+	// get the global store price projected to take effect at the next update
+	NextGlobalSpStorePrice(ctx context.Context, in *QueryNextGlobalSpStorePriceRequest, opts ...grpc.CallOption) (*QueryNextGlobalSpStorePriceResponse, error)
 	// get global store price by time
 	QueryGlobalSpStorePriceByTime(ctx context.Context, in *QueryGlobalSpStorePriceByTimeRequest, opts ...grpc.CallOption) (*QueryGlobalSpStorePriceByTimeResponse, error)
 	// Queries a storage provider with specify id
@@ -812,6 +1008,24 @@ func (c *queryClient) QuerySpStoragePrice(ctx context.Context, in *QuerySpStorag
 	return out, nil
 }
 
+func (c *queryClient) PendingSpStoragePrices(ctx context.Context, in *QueryPendingSpStoragePricesRequest, opts ...grpc.CallOption) (*QueryPendingSpStoragePricesResponse, error) {
+	out := new(QueryPendingSpStoragePricesResponse)
+	err := c.cc.Invoke(ctx, "/moca.sp.Query/PendingSpStoragePrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextGlobalSpStorePrice(ctx context.Context, in *QueryNextGlobalSpStorePriceRequest, opts ...grpc.CallOption) (*QueryNextGlobalSpStorePriceResponse, error) {
+	out := new(QueryNextGlobalSpStorePriceResponse)
+	err := c.cc.Invoke(ctx, "/moca.sp.Query/NextGlobalSpStorePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) QueryGlobalSpStorePriceByTime(ctx context.Context, in *QueryGlobalSpStorePriceByTimeRequest, opts ...grpc.CallOption) (*QueryGlobalSpStorePriceByTimeResponse, error) {
 	out := new(QueryGlobalSpStorePriceByTimeResponse)
 	err := c.cc.Invoke(ctx, "/moca.sp.Query/QueryGlobalSpStorePriceByTime", in, out, opts...)
@@ -856,6 +1070,10 @@ type QueryServer interface {
 	StorageProviders(context.Context, *QueryStorageProvidersRequest) (*QueryStorageProvidersResponse, error)
 	// get the latest storage price of specific sp
 	QuerySpStoragePrice(context.Context, *QuerySpStoragePriceRequest) (*QuerySpStoragePriceResponse, error)
+	// get the prices the sps scheduled to take effect later
+	PendingSpStoragePrices(context.Context, *QueryPendingSpStoragePricesRequest) (*QueryPendingSpStoragePricesResponse, error)
+	// get the global store price projected to take effect at the next update
+	NextGlobalSpStorePrice(context.Context, *QueryNextGlobalSpStorePriceRequest) (*QueryNextGlobalSpStorePriceResponse, error)
 	// get global store price by time
 	QueryGlobalSpStorePriceByTime(context.Context, *QueryGlobalSpStorePriceByTimeRequest) (*QueryGlobalSpStorePriceByTimeResponse, error)
 	// Queries a storage provider with specify id
@@ -879,6 +1097,12 @@ func (*UnimplementedQueryServer) StorageProviders(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) QuerySpStoragePrice(ctx context.Context, req *QuerySpStoragePriceRequest) (*QuerySpStoragePriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuerySpStoragePrice not implemented")
 }
+func (*UnimplementedQueryServer) PendingSpStoragePrices(ctx context.Context, req *QueryPendingSpStoragePricesRequest) (*QueryPendingSpStoragePricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSpStoragePrices not implemented")
+}
+func (*UnimplementedQueryServer) NextGlobalSpStorePrice(ctx context.Context, req *QueryNextGlobalSpStorePriceRequest) (*QueryNextGlobalSpStorePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextGlobalSpStorePrice not implemented")
+}
 func (*UnimplementedQueryServer) QueryGlobalSpStorePriceByTime(ctx context.Context, req *QueryGlobalSpStorePriceByTimeRequest) (*QueryGlobalSpStorePriceByTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryGlobalSpStorePriceByTime not implemented")
 }
@@ -950,6 +1174,42 @@ func _Query_QuerySpStoragePrice_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingSpStoragePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSpStoragePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSpStoragePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.sp.Query/PendingSpStoragePrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSpStoragePrices(ctx, req.(*QueryPendingSpStoragePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextGlobalSpStorePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextGlobalSpStorePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextGlobalSpStorePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.sp.Query/NextGlobalSpStorePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextGlobalSpStorePrice(ctx, req.(*QueryNextGlobalSpStorePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_QueryGlobalSpStorePriceByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGlobalSpStorePriceByTimeRequest)
 	if err := dec(in); err != nil {
@@ -1038,6 +1298,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QuerySpStoragePrice",
 			Handler:    _Query_QuerySpStoragePrice_Handler,
 		},
+		{
+			MethodName: "PendingSpStoragePrices",
+			Handler:    _Query_PendingSpStoragePrices_Handler,
+		},
+		{
+			MethodName: "NextGlobalSpStorePrice",
+			Handler:    _Query_NextGlobalSpStorePrice_Handler,
+		},
 		{
 			MethodName: "QueryGlobalSpStorePriceByTime",
 			Handler:    _Query_QueryGlobalSpStorePriceByTime_Handler,
@@ -1262,7 +1530,7 @@ func (m *QuerySpStoragePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingSpStoragePricesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1272,25 +1540,32 @@ func (m *QueryGlobalSpStorePriceByTimeRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingSpStoragePricesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGlobalSpStorePriceByTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingSpStoragePricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingSpStoragePricesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1300,30 +1575,46 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryGlobalSpStorePriceByTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingSpStoragePricesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGlobalSpStorePriceByTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingSpStoragePricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.GlobalSpStorePrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingPrices) > 0 {
+		for iNdEx := len(m.PendingPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageProviderRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryNextGlobalSpStorePriceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1333,25 +1624,20 @@ func (m *QueryStorageProviderRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryStorageProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNextGlobalSpStorePriceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorageProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNextGlobalSpStorePriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageProviderResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryNextGlobalSpStorePriceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1361,32 +1647,30 @@ func (m *QueryStorageProviderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryStorageProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNextGlobalSpStorePriceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorageProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNextGlobalSpStorePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StorageProvider != nil {
-		{
-			size, err := m.StorageProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.GlobalSpStorePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageProviderByOperatorAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGlobalSpStorePriceByTimeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1396,17 +1680,141 @@ func (m *QueryStorageProviderByOperatorAddressRequest) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *QueryStorageProviderByOperatorAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGlobalSpStorePriceByTimeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorageProviderByOperatorAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGlobalSpStorePriceByTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OperatorAddress) > 0 {
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalSpStorePriceByTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalSpStorePriceByTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalSpStorePriceByTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GlobalSpStorePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StorageProvider != nil {
+		{
+			size, err := m.StorageProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderByOperatorAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderByOperatorAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderByOperatorAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
 		i -= len(m.OperatorAddress)
 		copy(dAtA[i:], m.OperatorAddress)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
@@ -1605,6 +2013,58 @@ func (m *QuerySpStoragePriceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingSpStoragePricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSpStoragePricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingPrices) > 0 {
+		for _, e := range m.PendingPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextGlobalSpStorePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextGlobalSpStorePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GlobalSpStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryGlobalSpStorePriceByTimeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2217,6 +2677,345 @@ func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingSpStoragePricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSpStoragePricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSpStoragePricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSpStoragePricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSpStoragePricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSpStoragePricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPrices = append(m.PendingPrices, SpStoragePrice{})
+			if err := m.PendingPrices[len(m.PendingPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextGlobalSpStorePriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextGlobalSpStorePriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextGlobalSpStorePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextGlobalSpStorePriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextGlobalSpStorePriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextGlobalSpStorePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSpStorePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalSpStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
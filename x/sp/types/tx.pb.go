@@ -489,6 +489,10 @@ type MsgUpdateSpStoragePrice struct {
 	FreeReadQuota uint64 `protobuf:"varint,3,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
 	// store price, in amoca wei per charge byte
 	StorePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=store_price,json=storePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"store_price"`
+	// the unix timestamp in seconds the prices take effect, at least min_price_change_notice later than the block time.
+	// If it's 0, the prices take effect as soon as the notice allows. When the global price is updated monthly, it can not
+	// fall in the last update_price_disallowed_days days of the month.
+	EffectiveTime int64 `protobuf:"varint,5,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
 }
 
 func (m *MsgUpdateSpStoragePrice) Reset()         { *m = MsgUpdateSpStoragePrice{} }
@@ -538,6 +542,13 @@ func (m *MsgUpdateSpStoragePrice) GetFreeReadQuota() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateSpStoragePrice) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return 0
+}
+
 type MsgUpdateSpStoragePriceResponse struct {
 }
 
@@ -790,74 +801,75 @@ func init() {
 func init() { proto.RegisterFile("moca/sp/tx.proto", fileDescriptor_210aeadf15458402) }
 
 var fileDescriptor_210aeadf15458402 = []byte{
-	// 1060 bytes of a gzipped FileDescriptorProto
+	// 1078 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xae, 0x9b, 0x6c, 0xd2, 0xbc, 0xed, 0x36, 0x8b, 0x1b, 0xa8, 0xeb, 0x4a, 0x69, 0x6a, 0x89,
-	0x6d, 0xa8, 0x58, 0x5b, 0xcd, 0x42, 0x57, 0xca, 0x22, 0xa4, 0x7e, 0x70, 0xe0, 0x23, 0x52, 0x49,
-	0xb5, 0x42, 0xe2, 0x12, 0x4d, 0xec, 0xa9, 0x6b, 0xb6, 0xf1, 0x18, 0x8f, 0x13, 0x6d, 0x6e, 0x88,
-	0x23, 0x27, 0x6e, 0xfc, 0x05, 0x24, 0x84, 0x54, 0xa1, 0xfd, 0x11, 0x7b, 0x5c, 0xed, 0x09, 0x21,
-	0xb4, 0x5a, 0xda, 0x43, 0xff, 0x06, 0x1a, 0x7b, 0x3c, 0xb5, 0x83, 0x83, 0xab, 0x14, 0x2e, 0x6d,
-	0xe6, 0xfd, 0x78, 0x66, 0xde, 0x79, 0xdf, 0xe7, 0xb1, 0x0d, 0xf7, 0x06, 0xc4, 0x44, 0x06, 0xf5,
-	0x8c, 0xe0, 0x99, 0xee, 0xf9, 0x24, 0x20, 0x72, 0x99, 0x59, 0x74, 0xea, 0xa9, 0x6f, 0xa1, 0x81,
-	0xe3, 0x12, 0x23, 0xfc, 0x1b, 0xf9, 0xd4, 0xba, 0x49, 0xe8, 0x80, 0x50, 0xa3, 0x8f, 0x28, 0x36,
-	0x46, 0x3b, 0x7d, 0x1c, 0xa0, 0x1d, 0xc3, 0x24, 0x8e, 0xcb, 0xfd, 0xab, 0xdc, 0x3f, 0xa0, 0xb6,
-	0x31, 0xda, 0x61, 0xff, 0xb8, 0x63, 0x2d, 0x72, 0xf4, 0xc2, 0x95, 0x11, 0x2d, 0xb8, 0xab, 0x66,
-	0x13, 0x9b, 0x44, 0x76, 0xf6, 0x2b, 0xb6, 0xc6, 0xe7, 0xf2, 0x90, 0x8f, 0x06, 0x71, 0xec, 0x8a,
-	0x38, 0xed, 0xd8, 0xc3, 0xdc, 0xa8, 0xfd, 0x56, 0x06, 0xa5, 0x43, 0xed, 0x03, 0x1f, 0xa3, 0x00,
-	0x1f, 0x07, 0xc4, 0x47, 0x36, 0x3e, 0xf2, 0xc9, 0xc8, 0xb1, 0xb0, 0x2f, 0xb7, 0xa0, 0x6c, 0x32,
-	0x07, 0xf1, 0x15, 0xa9, 0x21, 0x35, 0x2b, 0xfb, 0xca, 0xab, 0xe7, 0x0f, 0x6a, 0xfc, 0x00, 0x7b,
-	0x96, 0xe5, 0x63, 0x4a, 0x8f, 0x03, 0xdf, 0x71, 0xed, 0x6e, 0x1c, 0x28, 0xef, 0xc1, 0xa2, 0x85,
-	0xa9, 0xe9, 0x3b, 0x5e, 0xe0, 0x10, 0x57, 0x99, 0x6f, 0x48, 0xcd, 0xc5, 0x56, 0x4d, 0xe7, 0xf7,
-	0xa2, 0x1f, 0x5e, 0xfb, 0xf6, 0x2b, 0x2f, 0x5e, 0x6f, 0xcc, 0xfd, 0x7c, 0x75, 0xbe, 0x2d, 0x75,
-	0x93, 0x39, 0xf2, 0x23, 0x00, 0xea, 0xf5, 0x50, 0x84, 0xaf, 0x14, 0x72, 0x76, 0xae, 0x50, 0x8f,
-	0x1b, 0xe4, 0x3d, 0xa8, 0x9e, 0x0c, 0x5d, 0xcb, 0x71, 0x6d, 0x91, 0x5d, 0xcc, 0xc9, 0x5e, 0xe6,
-	0x09, 0x31, 0xc4, 0x63, 0x58, 0xa2, 0x18, 0x9d, 0x89, 0xfc, 0x3b, 0x39, 0xf9, 0x8b, 0x2c, 0x3a,
-	0x4e, 0x3e, 0x80, 0x7b, 0xc8, 0xf3, 0x7c, 0x32, 0x4a, 0x00, 0x94, 0x72, 0x00, 0xaa, 0x71, 0x46,
-	0x0c, 0xf2, 0x08, 0xc0, 0x36, 0x45, 0x7a, 0x39, 0xaf, 0x7a, 0xdb, 0x8c, 0x13, 0x3f, 0x85, 0x95,
-	0x01, 0x72, 0xdc, 0x00, 0xbb, 0xc8, 0x35, 0xb1, 0x40, 0x58, 0xc8, 0x41, 0x90, 0x13, 0x49, 0x31,
-	0x94, 0x0a, 0x0b, 0xd8, 0xb5, 0x3c, 0xe2, 0xb8, 0x81, 0x52, 0x61, 0xf9, 0x5d, 0xb1, 0x96, 0x3f,
-	0x86, 0xb2, 0x85, 0x3d, 0x42, 0x9d, 0x40, 0x81, 0xb0, 0xb9, 0x6b, 0x3a, 0xc7, 0x65, 0x83, 0xad,
-	0xf3, 0xc1, 0xd6, 0x0f, 0x88, 0x93, 0xea, 0x70, 0x9c, 0x24, 0x3f, 0x01, 0xf0, 0x31, 0xb2, 0x7a,
-	0x9e, 0xef, 0x98, 0x58, 0x59, 0x0c, 0x4f, 0xb7, 0xcb, 0xe2, 0xfe, 0x78, 0xbd, 0xb1, 0x1e, 0x21,
-	0x51, 0xeb, 0xa9, 0xee, 0x10, 0x63, 0x80, 0x82, 0x53, 0xfd, 0x0b, 0x6c, 0x23, 0x73, 0x7c, 0x88,
-	0xcd, 0x57, 0xcf, 0x1f, 0x00, 0xdf, 0xe8, 0x10, 0x9b, 0x11, 0x68, 0x85, 0x21, 0x1d, 0x31, 0x20,
-	0xf9, 0x3e, 0x54, 0x4f, 0x7c, 0x8c, 0x7b, 0x21, 0xf6, 0xb7, 0x43, 0x12, 0x20, 0x65, 0xa9, 0x21,
-	0x35, 0x8b, 0xdd, 0xbb, 0xcc, 0xdc, 0xc5, 0xc8, 0xfa, 0x92, 0x19, 0xe5, 0xaf, 0x60, 0x91, 0x06,
-	0xc4, 0xc7, 0x7c, 0xff, 0xbb, 0xb7, 0xda, 0x1f, 0x42, 0xa8, 0xe8, 0x00, 0xab, 0x50, 0xee, 0x9f,
-	0xd1, 0xde, 0x53, 0x3c, 0x56, 0x96, 0xc3, 0x2b, 0x2b, 0xf5, 0xcf, 0xe8, 0xe7, 0x78, 0x2c, 0xaf,
-	0x43, 0x85, 0x39, 0x3c, 0x9f, 0x90, 0x13, 0xa5, 0x1a, 0xdd, 0x66, 0xff, 0x8c, 0x1e, 0xb1, 0x75,
-	0xfb, 0xe1, 0xf7, 0x57, 0xe7, 0xdb, 0x31, 0x79, 0x7e, 0xb8, 0x3a, 0xdf, 0xd6, 0x42, 0x96, 0x3e,
-	0x63, 0x3c, 0x9d, 0xc6, 0x4b, 0x4d, 0x83, 0xc6, 0x34, 0x5f, 0x17, 0x53, 0x8f, 0xb8, 0x14, 0x6b,
-	0x6f, 0x24, 0x80, 0x0e, 0xb5, 0x0f, 0xf9, 0xad, 0xcf, 0x42, 0xe5, 0x34, 0x0f, 0xe7, 0x6f, 0xce,
-	0xc3, 0xc4, 0x88, 0x14, 0x66, 0x18, 0x91, 0xf6, 0xbb, 0x93, 0x97, 0x52, 0x4b, 0x5d, 0x0a, 0xaf,
-	0x49, 0xab, 0x81, 0x7c, 0xbd, 0x12, 0x85, 0xff, 0x54, 0x84, 0x77, 0x3a, 0xd4, 0xfe, 0xc4, 0x72,
-	0x82, 0x49, 0x3d, 0x4b, 0x17, 0x24, 0xdd, 0xbc, 0xa0, 0x24, 0x1f, 0xe6, 0x27, 0xf8, 0xb0, 0x9b,
-	0x16, 0xbc, 0xc2, 0x74, 0xc1, 0x4b, 0xab, 0xdc, 0xa4, 0xd2, 0x14, 0x6f, 0xab, 0x34, 0x77, 0x6e,
-	0xa7, 0x34, 0xa5, 0x5b, 0x2b, 0x4d, 0x79, 0x06, 0xa5, 0x49, 0xb0, 0x66, 0x61, 0x3a, 0x6b, 0x2a,
-	0x13, 0xac, 0xf9, 0x80, 0x0d, 0x48, 0xa2, 0x97, 0x6c, 0x46, 0x1a, 0xa9, 0x19, 0xc9, 0x68, 0xbf,
-	0xd6, 0x80, 0x7a, 0xb6, 0x47, 0xcc, 0xce, 0x5f, 0xf3, 0xb0, 0xda, 0xa1, 0xf6, 0x13, 0xcf, 0x62,
-	0xcc, 0xf2, 0x44, 0x18, 0xe3, 0xf7, 0xcc, 0xc3, 0x93, 0x16, 0xbc, 0xf9, 0xff, 0x51, 0xf0, 0x0a,
-	0x37, 0x10, 0xbc, 0xe2, 0x7f, 0x25, 0x78, 0xed, 0x0f, 0x33, 0x9a, 0xb0, 0x99, 0x6a, 0x42, 0xd6,
-	0x3d, 0x6a, 0x9b, 0xb0, 0x31, 0xc5, 0x25, 0xda, 0xf0, 0x8b, 0x04, 0x55, 0x11, 0x73, 0x14, 0xbe,
-	0xc3, 0xc8, 0xbb, 0x50, 0x41, 0xc3, 0xe0, 0x94, 0xf8, 0x4e, 0x30, 0xce, 0xbf, 0x7d, 0x11, 0x2a,
-	0xb7, 0xa0, 0x14, 0xbd, 0x05, 0xf1, 0x57, 0x91, 0xaa, 0x60, 0x66, 0x04, 0x9c, 0x14, 0x20, 0x1e,
-	0xd9, 0x7e, 0x9f, 0x55, 0x76, 0x8d, 0xc1, 0x0a, 0x5b, 0xcb, 0x28, 0x2c, 0x02, 0xd0, 0xd6, 0x12,
-	0x33, 0x13, 0x99, 0x44, 0x21, 0x7f, 0x4a, 0xe1, 0xc8, 0xf1, 0x62, 0xd3, 0x43, 0x77, 0x1c, 0xa0,
-	0x60, 0x48, 0x67, 0x1f, 0xab, 0x2d, 0x28, 0xd1, 0x10, 0x22, 0x2c, 0x6c, 0x39, 0x51, 0x58, 0x84,
-	0xdc, 0xe5, 0x6e, 0x26, 0x5e, 0xd6, 0xd0, 0x47, 0x42, 0x9d, 0x0a, 0x5d, 0xb1, 0x6e, 0x7f, 0x94,
-	0xd1, 0xc3, 0x66, 0x56, 0x0f, 0xb3, 0xce, 0xae, 0x35, 0xe1, 0xfe, 0xbf, 0x47, 0xc4, 0x17, 0xd1,
-	0xfa, 0xb5, 0x08, 0x85, 0x0e, 0xb5, 0x65, 0x0c, 0x6f, 0x67, 0xbf, 0x6a, 0x6e, 0x8a, 0xd3, 0x4f,
-	0x7b, 0xb2, 0xa9, 0xef, 0xe5, 0x86, 0xc4, 0xdb, 0xc9, 0x8f, 0xa1, 0x1c, 0x3f, 0xf8, 0x56, 0x92,
-	0x59, 0xdc, 0xa8, 0xae, 0x67, 0x18, 0x45, 0x72, 0x0f, 0x56, 0xb2, 0x1e, 0x1e, 0x1b, 0xc9, 0x9c,
-	0x8c, 0x00, 0x75, 0x2b, 0x27, 0x40, 0x6c, 0xd0, 0x87, 0x5a, 0xa6, 0xc2, 0x34, 0x92, 0x00, 0x59,
-	0x11, 0x6a, 0x33, 0x2f, 0x42, 0xec, 0xf1, 0x0d, 0x2c, 0x5f, 0xfb, 0xc3, 0x31, 0xd8, 0xca, 0xc8,
-	0xcd, 0xea, 0x99, 0x6a, 0xdc, 0x30, 0x50, 0xec, 0xf5, 0x19, 0x2c, 0xa5, 0xa8, 0xaa, 0xfc, 0x13,
-	0x20, 0xf2, 0xa8, 0x8d, 0x69, 0x9e, 0x18, 0x4b, 0xbd, 0xf3, 0x1d, 0x63, 0xe2, 0xfe, 0xde, 0x8b,
-	0x8b, 0xba, 0xf4, 0xf2, 0xa2, 0x2e, 0xbd, 0xb9, 0xa8, 0x4b, 0x3f, 0x5e, 0xd6, 0xe7, 0x5e, 0x5e,
-	0xd6, 0xe7, 0x7e, 0xbf, 0xac, 0xcf, 0x7d, 0xbd, 0x65, 0x3b, 0xc1, 0xe9, 0xb0, 0xaf, 0x9b, 0x64,
-	0x60, 0x30, 0x30, 0xf3, 0x14, 0x39, 0x6e, 0xf8, 0xcb, 0x18, 0xb5, 0xa2, 0xa9, 0x0d, 0xbf, 0x6f,
-	0xfa, 0xa5, 0xf0, 0x03, 0xe7, 0xe1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xb8, 0xe2, 0x24,
-	0xa5, 0x0d, 0x00, 0x00,
+	0x18, 0xae, 0x9b, 0x34, 0x69, 0xde, 0x7e, 0x64, 0x71, 0x03, 0x75, 0x53, 0x29, 0x4d, 0x23, 0xed,
+	0x36, 0x54, 0xac, 0xad, 0x76, 0xa1, 0x2b, 0x75, 0x11, 0x52, 0x3f, 0x38, 0xf0, 0x51, 0xa9, 0xa4,
+	0xac, 0x90, 0xb8, 0x44, 0x13, 0x7b, 0xea, 0x0e, 0x5b, 0x7b, 0x8c, 0x67, 0x12, 0xb5, 0x37, 0xc4,
+	0x09, 0x71, 0xe2, 0xc6, 0x5f, 0x40, 0x42, 0x48, 0x15, 0xda, 0x1f, 0xb1, 0xc7, 0xd5, 0x9e, 0x10,
+	0x42, 0xab, 0x55, 0x7b, 0xe8, 0xdf, 0x40, 0xe3, 0x8f, 0xa9, 0x1d, 0x1c, 0x5c, 0xa5, 0x70, 0x69,
+	0x33, 0xef, 0xc7, 0x33, 0xf3, 0xce, 0xfb, 0x3e, 0x8f, 0x6d, 0xb8, 0xe7, 0x50, 0x13, 0x19, 0xcc,
+	0x33, 0xf8, 0x99, 0xee, 0xf9, 0x94, 0x53, 0xb5, 0x2c, 0x2c, 0x3a, 0xf3, 0xea, 0x6f, 0x21, 0x87,
+	0xb8, 0xd4, 0x08, 0xfe, 0x86, 0xbe, 0x7a, 0xc3, 0xa4, 0xcc, 0xa1, 0xcc, 0xe8, 0x21, 0x86, 0x8d,
+	0xc1, 0x46, 0x0f, 0x73, 0xb4, 0x61, 0x98, 0x94, 0xb8, 0x91, 0x7f, 0x31, 0xf2, 0x3b, 0xcc, 0x36,
+	0x06, 0x1b, 0xe2, 0x5f, 0xe4, 0x58, 0x0a, 0x1d, 0xdd, 0x60, 0x65, 0x84, 0x8b, 0xc8, 0x55, 0xb3,
+	0xa9, 0x4d, 0x43, 0xbb, 0xf8, 0x15, 0x5b, 0xe3, 0x73, 0x79, 0xc8, 0x47, 0x4e, 0x1c, 0xbb, 0x20,
+	0x4f, 0x7b, 0xee, 0xe1, 0xc8, 0xd8, 0xfa, 0xbd, 0x0c, 0xda, 0x01, 0xb3, 0xf7, 0x7c, 0x8c, 0x38,
+	0x3e, 0xe2, 0xd4, 0x47, 0x36, 0x3e, 0xf4, 0xe9, 0x80, 0x58, 0xd8, 0x57, 0x37, 0xa1, 0x6c, 0x0a,
+	0x07, 0xf5, 0x35, 0xa5, 0xa9, 0xb4, 0x2b, 0xbb, 0xda, 0xab, 0xe7, 0x0f, 0x6b, 0xd1, 0x01, 0x76,
+	0x2c, 0xcb, 0xc7, 0x8c, 0x1d, 0x71, 0x9f, 0xb8, 0x76, 0x27, 0x0e, 0x54, 0x77, 0x60, 0xc6, 0xc2,
+	0xcc, 0xf4, 0x89, 0xc7, 0x09, 0x75, 0xb5, 0xc9, 0xa6, 0xd2, 0x9e, 0xd9, 0xac, 0xe9, 0xd1, 0xbd,
+	0xe8, 0xfb, 0x37, 0xbe, 0xdd, 0xca, 0x8b, 0xd7, 0x2b, 0x13, 0xbf, 0x5c, 0x5f, 0xac, 0x2b, 0x9d,
+	0x64, 0x8e, 0xfa, 0x18, 0x80, 0x79, 0x5d, 0x14, 0xe2, 0x6b, 0x85, 0x9c, 0x9d, 0x2b, 0xcc, 0x8b,
+	0x0c, 0xea, 0x0e, 0x54, 0x8f, 0xfb, 0xae, 0x45, 0x5c, 0x5b, 0x66, 0x17, 0x73, 0xb2, 0xe7, 0xa3,
+	0x84, 0x18, 0xe2, 0x09, 0xcc, 0x32, 0x8c, 0x4e, 0x65, 0xfe, 0x54, 0x4e, 0xfe, 0x8c, 0x88, 0x8e,
+	0x93, 0xf7, 0xe0, 0x1e, 0xf2, 0x3c, 0x9f, 0x0e, 0x12, 0x00, 0xa5, 0x1c, 0x80, 0x6a, 0x9c, 0x11,
+	0x83, 0x3c, 0x06, 0xb0, 0x4d, 0x99, 0x5e, 0xce, 0xab, 0xde, 0x36, 0xe3, 0xc4, 0x4f, 0x60, 0xc1,
+	0x41, 0xc4, 0xe5, 0xd8, 0x45, 0xae, 0x89, 0x25, 0xc2, 0x74, 0x0e, 0x82, 0x9a, 0x48, 0x8a, 0xa1,
+	0xea, 0x30, 0x8d, 0x5d, 0xcb, 0xa3, 0xc4, 0xe5, 0x5a, 0x45, 0xe4, 0x77, 0xe4, 0x5a, 0xfd, 0x08,
+	0xca, 0x16, 0xf6, 0x28, 0x23, 0x5c, 0x83, 0xa0, 0xb9, 0x4b, 0x7a, 0x84, 0x2b, 0x06, 0x5b, 0x8f,
+	0x06, 0x5b, 0xdf, 0xa3, 0x24, 0xd5, 0xe1, 0x38, 0x49, 0x7d, 0x0a, 0xe0, 0x63, 0x64, 0x75, 0x3d,
+	0x9f, 0x98, 0x58, 0x9b, 0x09, 0x4e, 0xb7, 0x25, 0xe2, 0xfe, 0x7c, 0xbd, 0xb2, 0x1c, 0x22, 0x31,
+	0xeb, 0x99, 0x4e, 0xa8, 0xe1, 0x20, 0x7e, 0xa2, 0x7f, 0x8e, 0x6d, 0x64, 0x9e, 0xef, 0x63, 0xf3,
+	0xd5, 0xf3, 0x87, 0x10, 0x6d, 0xb4, 0x8f, 0xcd, 0x10, 0xb4, 0x22, 0x90, 0x0e, 0x05, 0x90, 0xfa,
+	0x00, 0xaa, 0xc7, 0x3e, 0xc6, 0xdd, 0x00, 0xfb, 0xdb, 0x3e, 0xe5, 0x48, 0x9b, 0x6d, 0x2a, 0xed,
+	0x62, 0x67, 0x4e, 0x98, 0x3b, 0x18, 0x59, 0x5f, 0x08, 0xa3, 0xfa, 0x15, 0xcc, 0x30, 0x4e, 0x7d,
+	0x1c, 0xed, 0x3f, 0x77, 0xa7, 0xfd, 0x21, 0x80, 0x0a, 0x0f, 0xb0, 0x08, 0xe5, 0xde, 0x29, 0xeb,
+	0x3e, 0xc3, 0xe7, 0xda, 0x7c, 0x70, 0x65, 0xa5, 0xde, 0x29, 0xfb, 0x0c, 0x9f, 0xab, 0xcb, 0x50,
+	0x11, 0x0e, 0xcf, 0xa7, 0xf4, 0x58, 0xab, 0x86, 0xb7, 0xd9, 0x3b, 0x65, 0x87, 0x62, 0xbd, 0xfd,
+	0xe8, 0xfb, 0xeb, 0x8b, 0xf5, 0x98, 0x3c, 0x3f, 0x5e, 0x5f, 0xac, 0xb7, 0x02, 0x96, 0x9e, 0x09,
+	0x9e, 0x8e, 0xe2, 0x65, 0xab, 0x05, 0xcd, 0x51, 0xbe, 0x0e, 0x66, 0x1e, 0x75, 0x19, 0x6e, 0xbd,
+	0x51, 0x00, 0x0e, 0x98, 0xbd, 0x1f, 0xdd, 0xfa, 0x38, 0x54, 0x4e, 0xf3, 0x70, 0xf2, 0xf6, 0x3c,
+	0x4c, 0x8c, 0x48, 0x61, 0x8c, 0x11, 0xd9, 0xbe, 0x3f, 0x7c, 0x29, 0xb5, 0xd4, 0xa5, 0x44, 0x35,
+	0xb5, 0x6a, 0xa0, 0xde, 0xac, 0x64, 0xe1, 0x3f, 0x17, 0xe1, 0x9d, 0x03, 0x66, 0x7f, 0x6c, 0x11,
+	0x3e, 0xac, 0x67, 0xe9, 0x82, 0x94, 0xdb, 0x17, 0x94, 0xe4, 0xc3, 0xe4, 0x10, 0x1f, 0xb6, 0xd2,
+	0x82, 0x57, 0x18, 0x2d, 0x78, 0x69, 0x95, 0x1b, 0x56, 0x9a, 0xe2, 0x5d, 0x95, 0x66, 0xea, 0x6e,
+	0x4a, 0x53, 0xba, 0xb3, 0xd2, 0x94, 0xc7, 0x50, 0x9a, 0x04, 0x6b, 0xa6, 0x47, 0xb3, 0xa6, 0x32,
+	0xc4, 0x9a, 0xf7, 0xc5, 0x80, 0x24, 0x7a, 0x29, 0x66, 0xa4, 0x99, 0x9a, 0x91, 0x8c, 0xf6, 0xb7,
+	0x9a, 0xd0, 0xc8, 0xf6, 0xc8, 0xd9, 0xf9, 0xa1, 0x00, 0x8b, 0x07, 0xcc, 0x7e, 0xea, 0x59, 0x82,
+	0x59, 0x9e, 0x0c, 0x13, 0xfc, 0x1e, 0x7b, 0x78, 0xd2, 0x82, 0x37, 0xf9, 0x3f, 0x0a, 0x5e, 0xe1,
+	0x16, 0x82, 0x57, 0xfc, 0xcf, 0x04, 0xef, 0x3e, 0xcc, 0xe3, 0xe3, 0x63, 0x6c, 0x72, 0x32, 0xc0,
+	0x5d, 0x4e, 0x1c, 0x1c, 0x4c, 0x60, 0xa1, 0x33, 0x27, 0xad, 0x5f, 0x12, 0x07, 0x6f, 0x7f, 0x90,
+	0xd1, 0xab, 0xd5, 0x54, 0xaf, 0xb2, 0xae, 0xbb, 0xb5, 0x0a, 0x2b, 0x23, 0x5c, 0xb2, 0x5b, 0xbf,
+	0x2a, 0x50, 0x95, 0x31, 0x87, 0xc1, 0xab, 0x8e, 0xba, 0x05, 0x15, 0xd4, 0xe7, 0x27, 0xd4, 0x27,
+	0xfc, 0x3c, 0xbf, 0x49, 0x32, 0x54, 0xdd, 0x84, 0x52, 0xf8, 0xb2, 0x14, 0xbd, 0xb1, 0x54, 0x25,
+	0x81, 0x43, 0xe0, 0xa4, 0x4e, 0x45, 0x91, 0xdb, 0xef, 0x89, 0xca, 0x6e, 0x30, 0x44, 0x61, 0x4b,
+	0x19, 0x85, 0x85, 0x00, 0xad, 0x25, 0x58, 0x1c, 0x32, 0xc9, 0x42, 0xfe, 0x52, 0xa0, 0x21, 0x7d,
+	0x43, 0xb3, 0x79, 0xc4, 0x11, 0xef, 0xb3, 0xf1, 0xa7, 0x6f, 0x0d, 0x4a, 0x2c, 0x80, 0x08, 0x0a,
+	0x9b, 0x4f, 0x14, 0x16, 0x22, 0x77, 0x22, 0xb7, 0xd0, 0x38, 0xab, 0xef, 0x23, 0x29, 0x62, 0x85,
+	0x8e, 0x5c, 0x6f, 0x7f, 0x98, 0xd1, 0xc3, 0x76, 0x56, 0x0f, 0xb3, 0xce, 0xde, 0x6a, 0xc3, 0x83,
+	0x7f, 0x8f, 0x88, 0x2f, 0x62, 0xf3, 0xb7, 0x22, 0x14, 0x0e, 0x98, 0xad, 0x62, 0x78, 0x3b, 0xfb,
+	0x8d, 0x74, 0x55, 0x9e, 0x7e, 0xd4, 0x03, 0xb0, 0xfe, 0x6e, 0x6e, 0x48, 0xbc, 0x9d, 0xfa, 0x04,
+	0xca, 0xf1, 0xf3, 0x71, 0x21, 0x99, 0x15, 0x19, 0xeb, 0xcb, 0x19, 0x46, 0x99, 0xdc, 0x85, 0x85,
+	0xac, 0x67, 0xcc, 0x4a, 0x32, 0x27, 0x23, 0xa0, 0xbe, 0x96, 0x13, 0x20, 0x37, 0xe8, 0x41, 0x2d,
+	0x53, 0x88, 0x9a, 0x49, 0x80, 0xac, 0x88, 0x7a, 0x3b, 0x2f, 0x42, 0xee, 0xf1, 0x0d, 0xcc, 0xdf,
+	0xf8, 0x83, 0x31, 0x58, 0xcb, 0xc8, 0xcd, 0xea, 0x59, 0xdd, 0xb8, 0x65, 0xa0, 0xdc, 0xeb, 0x53,
+	0x98, 0x4d, 0x51, 0x55, 0xfb, 0x27, 0x40, 0xe8, 0xa9, 0x37, 0x47, 0x79, 0x62, 0xac, 0xfa, 0xd4,
+	0x77, 0x82, 0x89, 0xbb, 0x3b, 0x2f, 0x2e, 0x1b, 0xca, 0xcb, 0xcb, 0x86, 0xf2, 0xe6, 0xb2, 0xa1,
+	0xfc, 0x74, 0xd5, 0x98, 0x78, 0x79, 0xd5, 0x98, 0xf8, 0xe3, 0xaa, 0x31, 0xf1, 0xf5, 0x9a, 0x4d,
+	0xf8, 0x49, 0xbf, 0xa7, 0x9b, 0xd4, 0x31, 0x04, 0x98, 0x79, 0x82, 0x88, 0x1b, 0xfc, 0x32, 0x06,
+	0x9b, 0xc6, 0x99, 0xfc, 0x0c, 0xea, 0x95, 0x82, 0xef, 0xa0, 0x47, 0x7f, 0x0f, 0x00, 0x41, 0x19,
+	0x7d, 0x8c, 0xcc, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1493,6 +1505,11 @@ func (m *MsgUpdateSpStoragePrice) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.EffectiveTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EffectiveTime))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.StorePrice.Size()
 		i -= size
@@ -1856,6 +1873,9 @@ func (m *MsgUpdateSpStoragePrice) Size() (n int) {
 	}
 	l = m.StorePrice.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.EffectiveTime != 0 {
+		n += 1 + sovTx(uint64(m.EffectiveTime))
+	}
 	return n
 }
 
@@ -3236,6 +3256,25 @@ func (m *MsgUpdateSpStoragePrice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			m.EffectiveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
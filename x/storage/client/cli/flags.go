@@ -33,6 +33,13 @@ const (
 	FlagTags                 = "tags"
 	FlagPrivateKey           = "privatekey"
 	FlagGVGFamilyID          = "gvgfamily-id"
+	FlagPrefix               = "prefix"
+	FlagDelimiter            = "delimiter"
+	FlagStartAfter           = "start-after"
+	FlagResourceType         = "resource-type"
+	FlagStartTime            = "start-time"
+	FlagEndTime              = "end-time"
+	FlagCSV                  = "csv"
 )
 
 func GetVisibilityType(str string) (storagetypes.VisibilityType, error) {
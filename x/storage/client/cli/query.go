@@ -31,14 +31,25 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryParams(),
 		CmdHeadBucket(),
 		CmdHeadObject(),
+		CmdHeadObjectVersion(),
+		CmdListObjectVersions(),
 		CmdListBuckets(),
 		CmdListObjects(),
+		CmdListResourcesByTag(),
+		CmdBucketReadQuota(),
+		CmdBucketCallback(),
+		CmdBucketOwnershipTransfer(),
+		CmdNFTTokenURI(),
+		CmdBillingStatements(),
+		CmdBucketBillImpact(),
 		CmdVerifyPermission(),
+		CmdExplainPermission(),
 		CmdHeadGroup(),
 		CmdListGroups(),
 		CmdHeadGroupMember(),
 		CmdQueryAccountPolicy(),
 		CmdQueryGroupPolicy(),
+		CmdQueryPaymentAudit(),
 	)
 
 	return storageQueryCmd
@@ -156,10 +167,22 @@ func CmdListObjects() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			prefix, _ := cmd.Flags().GetString(FlagPrefix)
+			delimiter, _ := cmd.Flags().GetString(FlagDelimiter)
+			startAfter, _ := cmd.Flags().GetString(FlagStartAfter)
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryListObjectsRequest{
 				BucketName: reqBucketName,
+				Pagination: pageReq,
+				Prefix:     prefix,
+				Delimiter:  delimiter,
+				StartAfter: startAfter,
 			}
 
 			res, err := queryClient.ListObjects(cmd.Context(), params)
@@ -171,7 +194,11 @@ func CmdListObjects() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagPrefix, "", "Only list the objects whose names begin with the prefix")
+	cmd.Flags().String(FlagDelimiter, "", "Group the object names which contain the delimiter after the prefix into common prefixes")
+	cmd.Flags().String(FlagStartAfter, "", "Only list the object names after it in lexicographical order")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 
 	return cmd
 }
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+var billingStatementCSVHeader = []string{
+	"payment_address", "bucket_id", "bucket_name", "start_time", "end_time", "settled", "frozen_time", "charge_size",
+	"charged_read_quota", "primary_store_price", "secondary_store_price", "read_price", "validator_tax_rate",
+	"store_flow_rate", "read_flow_rate", "validator_tax_flow_rate", "store_fee", "read_fee", "validator_tax", "total",
+}
+
+func CmdBillingStatements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "billing-statements [payment-address]",
+		Short: "Query the billing statements of a payment account in a time range",
+		Long: fmt.Sprintf(`Query the billing statements of a payment account whose billing periods end in the time range,
+one statement per bucket and billing period, along with the periods not settled yet overlapping it, which are charged
+up to the latest block. The seconds the payment account is frozen for are not charged, and the statements are kept for
+400 days after their periods end.
+Use --csv to export all the statements in the time range as CSV, ignoring the pagination flags.
+
+Example:
+$ %s query %s billing-statements 0x... --start-time 1704067200 --end-time 1706745600 --csv > bill.csv
+`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			startTime, err := cmd.Flags().GetInt64(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			endTime, err := cmd.Flags().GetInt64(FlagEndTime)
+			if err != nil {
+				return err
+			}
+			exportCSV, err := cmd.Flags().GetBool(FlagCSV)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryBillingStatementsRequest{
+				PaymentAddress: args[0],
+				StartTime:      startTime,
+				EndTime:        endTime,
+				Pagination:     pageReq,
+			}
+			if !exportCSV {
+				res, err := queryClient.BillingStatements(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			w := csv.NewWriter(cmd.OutOrStdout())
+			if err = w.Write(billingStatementCSVHeader); err != nil {
+				return err
+			}
+			req.Pagination = nil
+			for {
+				res, err := queryClient.BillingStatements(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				for _, statement := range res.Statements {
+					if err = w.Write(billingStatementCSVRecord(&statement, true)); err != nil {
+						return err
+					}
+				}
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					for _, statement := range res.OpenStatements {
+						if err = w.Write(billingStatementCSVRecord(&statement, false)); err != nil {
+							return err
+						}
+					}
+					break
+				}
+				req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+			w.Flush()
+			return w.Error()
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "The inclusive start of the time range, in unix seconds")
+	cmd.Flags().Int64(FlagEndTime, 0, "The exclusive end of the time range, in unix seconds, up to the latest block if zero")
+	cmd.Flags().Bool(FlagCSV, false, "Export the statements as CSV")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func billingStatementCSVRecord(statement *types.BillingStatement, settled bool) []string {
+	return []string{
+		statement.PaymentAddress,
+		statement.BucketId.String(),
+		statement.BucketName,
+		strconv.FormatInt(statement.StartTime, 10),
+		strconv.FormatInt(statement.EndTime, 10),
+		strconv.FormatBool(settled),
+		strconv.FormatInt(statement.FrozenTime, 10),
+		strconv.FormatUint(statement.ChargeSize, 10),
+		strconv.FormatUint(statement.ChargedReadQuota, 10),
+		statement.PrimaryStorePrice.String(),
+		statement.SecondaryStorePrice.String(),
+		statement.ReadPrice.String(),
+		statement.ValidatorTaxRate.String(),
+		statement.StoreFlowRate.String(),
+		statement.ReadFlowRate.String(),
+		statement.ValidatorTaxFlowRate.String(),
+		statement.StoreFee.String(),
+		statement.ReadFee.String(),
+		statement.ValidatorTax.String(),
+		statement.Total.String(),
+	}
+}
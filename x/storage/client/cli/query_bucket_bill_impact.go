@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func CmdBucketBillImpact() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bucket-bill-impact [bucket-name]",
+		Short: "Query how the flow rate of a bucket changes once it is charged by the global store price taking effect next",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqBucketName := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBucketBillImpactRequest{
+				BucketName: reqBucketName,
+			}
+
+			res, err := queryClient.BucketBillImpact(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
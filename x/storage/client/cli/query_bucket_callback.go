@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func CmdBucketCallback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bucket-callback [bucket-name]",
+		Short: "Query the contract notified when the objects of a bucket are sealed, rejected or deleted",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqBucketName := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBucketCallbackRequest{
+				BucketName: reqBucketName,
+			}
+
+			res, err := queryClient.BucketCallback(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
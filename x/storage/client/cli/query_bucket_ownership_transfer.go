@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func CmdBucketOwnershipTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bucket-ownership-transfer [bucket-name]",
+		Short: "Query the pending transfer of the ownership of a bucket",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqBucketName := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBucketOwnershipTransferRequest{
+				BucketName: reqBucketName,
+			}
+
+			res, err := queryClient.BucketOwnershipTransfer(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func CmdExplainPermission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain-permission [operator] [bucket-name] [object-name] [action-type]",
+		Short: "Query how the permission of the operator for the bucket/object's action is evaluated",
+		Long: `Query how the permission of the operator for the bucket/object's action is evaluated.
+The steps are listed in the order they are checked: the public visibility, the owner, then every policy considered
+with the evaluation of its statements and why a group policy is taken into account or not.
+Pass an empty object name to explain the permission for the bucket.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqOperator := args[0]
+			reqBucketName := args[1]
+			reqObjectName := args[2]
+			reqActionType := args[3]
+
+			actionType, err := GetActionType(reqActionType)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryExplainPermissionRequest{
+				Operator:   reqOperator,
+				BucketName: reqBucketName,
+				ObjectName: reqObjectName,
+				ActionType: actionType,
+			}
+
+			res, err := queryClient.ExplainPermission(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
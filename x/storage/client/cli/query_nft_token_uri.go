@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/types/resource"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func CmdNFTTokenURI() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-token-uri [bucket|object|group] [token-id]",
+		Short: "Query the ERC-721 metadata uri of a bucket, object or group NFT",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			resourceType, ok := resource.ResourceType_value["RESOURCE_TYPE_"+strings.ToUpper(args[0])]
+			if !ok {
+				return fmt.Errorf("invalid resource type: %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryNFTTokenURIRequest{
+				ResourceType: resource.ResourceType(resourceType),
+				TokenId:      args[1],
+			}
+
+			res, err := queryClient.NFTTokenURI(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func CmdHeadObjectVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "head-object-version [bucket-name] [object-name] [version]",
+		Short: "Query a version of an object by bucket-name, object-name and version",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqBucketName := args[0]
+			reqObjectName := args[1]
+			reqVersion, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryHeadObjectVersionRequest{
+				BucketName: reqBucketName,
+				ObjectName: reqObjectName,
+				Version:    reqVersion,
+			}
+
+			res, err := queryClient.HeadObjectVersion(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListObjectVersions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-object-versions [bucket-name] [object-name]",
+		Short: "Query the prior versions of an object",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqBucketName := args[0]
+			reqObjectName := args[1]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryListObjectVersionsRequest{
+				BucketName: reqBucketName,
+				ObjectName: reqObjectName,
+			}
+
+			res, err := queryClient.ListObjectVersions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func CmdQueryPaymentAudit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payment-audit",
+		Short: "Query the stream records whose lock balance or net flow rate mismatch the buckets and objects",
+		Long: fmt.Sprintf(`Query the payment audit report, which compares the lock balance and net flow rate of all
+stream records with the ones expected from the buckets and objects. Use --height to audit a historical block.
+The whole audit is run for each page, so query a node you operate; chains with more than %d buckets are
+left to the payment check of the node.
+
+Example:
+$ %s query %s payment-audit --height 100000 --limit 50
+`, types.MaxPaymentAuditBuckets, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryPaymentAudit(cmd.Context(), &types.QueryPaymentAuditRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+	"github.com/mocachain/moca/v2/types/resource"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func CmdListResourcesByTag() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-resources-by-tag [tag-key] [tag-value]",
+		Short: "Query the buckets, objects and groups which have the tag",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqTagKey := args[0]
+			reqTagValue := args[1]
+
+			resourceType := resource.RESOURCE_TYPE_UNSPECIFIED
+			resourceTypeStr, _ := cmd.Flags().GetString(FlagResourceType)
+			if resourceTypeStr != "" {
+				v, ok := resource.ResourceType_value[resourceTypeStr]
+				if !ok {
+					return gnfderrors.ErrInvalidParameter.Wrapf("invalid resource type: %s", resourceTypeStr)
+				}
+				resourceType = resource.ResourceType(v)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryListResourcesByTagRequest{
+				Pagination:   pageReq,
+				TagKey:       reqTagKey,
+				TagValue:     reqTagValue,
+				ResourceType: resourceType,
+			}
+
+			res, err := queryClient.ListResourcesByTag(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagResourceType, "", "Only list one type of resources, e.g. RESOURCE_TYPE_BUCKET, RESOURCE_TYPE_OBJECT or RESOURCE_TYPE_GROUP")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
@@ -45,6 +45,8 @@ func GetTxCmd() *cobra.Command {
 		CmdMigrateBucket(),
 		CmdCancelMigrateBucket(),
 		CmdSetBucketFlowRateLimit(),
+		CmdTransferBucketOwnership(),
+		CmdAcceptBucketOwnership(),
 		CmdToggleSPAsDelegatedAgent(),
 	)
 
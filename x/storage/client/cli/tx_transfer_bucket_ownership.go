@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	sdkclient "github.com/mocachain/moca/v2/sdk/client"
+	"github.com/mocachain/moca/v2/sdk/keys"
+	gnfdSdkTypes "github.com/mocachain/moca/v2/sdk/types"
+	types2 "github.com/mocachain/moca/v2/types"
+)
+
+func CmdTransferBucketOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-bucket-ownership [bucket-name] [new-owner] --privatekey xxx",
+		Short: "Propose to transfer the ownership of a bucket, which takes effect once the new owner accepts it",
+		Long:  "Propose to transfer the ownership of a bucket, which takes effect once the new owner accepts it. Omitting the new owner cancels the pending transfer.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPrivateKey, _ := cmd.Flags().GetString(FlagPrivateKey)
+			argBucketName := args[0]
+			var newOwner common.Address
+			if len(args) == 2 {
+				acc, err := sdk.AccAddressFromHexUnsafe(args[1])
+				if err != nil {
+					return err
+				}
+				newOwner = common.BytesToAddress(acc)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			km, err := keys.NewPrivateKeyManager(argPrivateKey)
+			if err != nil {
+				return err
+			}
+			gnfdCli, err := sdkclient.NewMocaClient(clientCtx.NodeURI, clientCtx.EvmNodeURI, gnfdSdkTypes.ChainID, sdkclient.WithKeyManager(km))
+			if err != nil {
+				return err
+			}
+			nonce, err := gnfdCli.GetNonce(context.Background())
+			if err != nil {
+				return err
+			}
+			txOpts, err := sdkclient.CreateTxOpts(context.Background(), clientCtx.EvmClient, argPrivateKey, big.NewInt(gnfdSdkTypes.DefaultChainId), gnfdSdkTypes.DefaultGasLimit, nonce)
+			if err != nil {
+				return err
+			}
+
+			session, err := sdkclient.CreateStorageSession(clientCtx.EvmClient, *txOpts, types2.StorageAddress)
+			if err != nil {
+				return err
+			}
+
+			txRsp, err := session.TransferBucketOwnership(argBucketName, newOwner)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdkclient.WaitForEvmTx(context.Background(), clientCtx.EvmClient, gnfdCli, txRsp.Hash())
+			if err != nil {
+				return fmt.Errorf("failed to transfer bucket ownership.%v", err.Error())
+			}
+			return clientCtx.PrintObjectLegacy(txRsp.Hash().String())
+		},
+	}
+
+	cmd.Flags().String(FlagPrivateKey, "", "The privatekey of the bucket owner")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAcceptBucketOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-bucket-ownership [bucket-name] [payment-account] --privatekey xxx",
+		Short: "Accept the pending transfer of the ownership of a bucket",
+		Long:  "Accept the pending transfer of the ownership of a bucket. The bucket is charged from the payment account afterwards, the new owner itself if it is omitted.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPrivateKey, _ := cmd.Flags().GetString(FlagPrivateKey)
+			argBucketName := args[0]
+			var paymentAddress common.Address
+			if len(args) == 2 {
+				acc, err := sdk.AccAddressFromHexUnsafe(args[1])
+				if err != nil {
+					return err
+				}
+				paymentAddress = common.BytesToAddress(acc)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			km, err := keys.NewPrivateKeyManager(argPrivateKey)
+			if err != nil {
+				return err
+			}
+			gnfdCli, err := sdkclient.NewMocaClient(clientCtx.NodeURI, clientCtx.EvmNodeURI, gnfdSdkTypes.ChainID, sdkclient.WithKeyManager(km))
+			if err != nil {
+				return err
+			}
+			nonce, err := gnfdCli.GetNonce(context.Background())
+			if err != nil {
+				return err
+			}
+			txOpts, err := sdkclient.CreateTxOpts(context.Background(), clientCtx.EvmClient, argPrivateKey, big.NewInt(gnfdSdkTypes.DefaultChainId), gnfdSdkTypes.DefaultGasLimit, nonce)
+			if err != nil {
+				return err
+			}
+
+			session, err := sdkclient.CreateStorageSession(clientCtx.EvmClient, *txOpts, types2.StorageAddress)
+			if err != nil {
+				return err
+			}
+
+			txRsp, err := session.AcceptBucketOwnership(argBucketName, paymentAddress)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdkclient.WaitForEvmTx(context.Background(), clientCtx.EvmClient, gnfdCli, txRsp.Hash())
+			if err != nil {
+				return fmt.Errorf("failed to accept bucket ownership.%v", err.Error())
+			}
+			return clientCtx.PrintObjectLegacy(txRsp.Hash().String())
+		},
+	}
+
+	cmd.Flags().String(FlagPrivateKey, "", "The privatekey of the new owner")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,74 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// NFTMetadataRoute is the route serving the ERC-721 metadata json of the bucket, object and group NFTs, the base uri
+// of the NFT contracts points at it once the nft_metadata_base_url param is set.
+const NFTMetadataRoute = types.NFTMetadataPath + "{resource_type}/{token_id}"
+
+// RegisterNFTMetadataRoutes registers the NFT metadata route on the API server. Unlike the grpc-gateway routes, it
+// serves the bare metadata json which wallets and explorers expect at the token uri.
+func RegisterNFTMetadataRoutes(clientCtx client.Context, rtr *mux.Router) {
+	rtr.HandleFunc(NFTMetadataRoute, nftMetadataHandler(clientCtx)).Methods(http.MethodGet)
+}
+
+func nftMetadataHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		queryClient := types.NewQueryClient(clientCtx)
+		req := &types.QueryNFTRequest{TokenId: vars["token_id"]}
+
+		var (
+			metadata *types.ERC721Metadata
+			err      error
+		)
+		switch vars["resource_type"] {
+		case "bucket":
+			var res *types.QueryBucketNFTResponse
+			if res, err = queryClient.HeadBucketNFT(r.Context(), req); err == nil {
+				metadata = res.MetaData.ToERC721Metadata()
+			}
+		case "object":
+			var res *types.QueryObjectNFTResponse
+			if res, err = queryClient.HeadObjectNFT(r.Context(), req); err == nil {
+				metadata = res.MetaData.ToERC721Metadata()
+			}
+		case "group":
+			var res *types.QueryGroupNFTResponse
+			if res, err = queryClient.HeadGroupNFT(r.Context(), req); err == nil {
+				metadata = res.MetaData.ToERC721Metadata()
+			}
+		default:
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("no NFT of the resource type %s", vars["resource_type"]))
+			return
+		}
+		if err != nil {
+			code := http.StatusNotFound
+			if status.Code(err) == codes.InvalidArgument {
+				code = http.StatusBadRequest
+			}
+			writeError(w, code, err.Error())
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(metadata)
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
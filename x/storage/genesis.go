@@ -13,6 +13,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err != nil {
 		panic(err)
 	}
+	// the NFT contracts are set up by the evm genesis, which is initialized before
+	if err = k.SetNFTBaseURIs(ctx); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
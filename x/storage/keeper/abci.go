@@ -5,6 +5,7 @@ import (
 
 	"github.com/mocachain/moca/v2/utils"
 	paymenttypes "github.com/mocachain/moca/v2/x/payment/types"
+	"github.com/mocachain/moca/v2/x/storage/types"
 )
 
 func BeginBlocker(ctx sdk.Context, keeper Keeper) error {
@@ -23,6 +24,12 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) error {
 	// relied on the transient store being discarded at the end of the block.
 	defer keeper.ClearCurrentBlockDeleteInfo(ctx)
 
+	// move the objects of the buckets whose ownership has been accepted to their new owners
+	if _, err := keeper.TransferPendingBucketObjects(ctx, types.MaxTransferBucketObjects); err != nil {
+		ctx.Logger().Error("should not happen, fail to transfer bucket objects, err " + err.Error())
+		panic("should not happen")
+	}
+
 	deletionMax := keeper.DiscontinueDeletionMax(ctx)
 	if deletionMax == 0 {
 		return nil
@@ -33,6 +40,14 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) error {
 	// set ForceUpdateStreamRecordKey to true in context to force update frozen stream record
 	ctx = ctx.WithValue(paymenttypes.ForceUpdateStreamRecordKey, true)
 
+	// expire objects by bucket lifecycle rules, they are deleted right below with the discontinued objects
+	if expirationMax := keeper.LifecycleExpirationMax(ctx); expirationMax > 0 {
+		if _, err := keeper.ExpireObjectsByLifecycle(ctx, expirationMax); err != nil {
+			ctx.Logger().Error("should not happen, fail to expire objects, err " + err.Error())
+			panic("should not happen")
+		}
+	}
+
 	// delete objects
 	deleted, err := keeper.DeleteDiscontinueObjectsUntil(ctx, blockTime, deletionMax)
 	if err != nil {
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/internal/sequence"
+	paymenttypes "github.com/mocachain/moca/v2/x/payment/types"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// recordBucketBill settles the open billing period of the bucket into a billing statement of its payment account, and
+// opens a new one with the bill the bucket is charged from now on. It should be called once the bill is applied.
+func (k Keeper) recordBucketBill(ctx sdk.Context, bucketInfo *types.BucketInfo,
+	internalBucketInfo *types.InternalBucketInfo,
+) error {
+	k.closeBucketBillingPeriod(ctx, bucketInfo.Id)
+
+	period, err := k.newBillingPeriod(ctx, bucketInfo, internalBucketInfo)
+	if err != nil {
+		return fmt.Errorf("record bucket bill failed: %s %w", bucketInfo.BucketName, err)
+	}
+	if period.StoreFlowRate.IsZero() && period.ReadFlowRate.IsZero() && period.ValidatorTaxFlowRate.IsZero() {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBillingPeriodKey(bucketInfo.Id), k.cdc.MustMarshal(period))
+	store.Set(types.GetOpenBillingPeriodIndexKey(sdk.MustAccAddressFromHex(period.PaymentAddress), bucketInfo.Id), []byte{})
+	return nil
+}
+
+// closeBucketBillingPeriod settles the open billing period of the bucket at the block time, if any, and keeps it as a
+// billing statement of the payment account it is charged to. A period opened in the same block is dropped.
+func (k Keeper) closeBucketBillingPeriod(ctx sdk.Context, bucketID sdkmath.Uint) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBillingPeriodKey(bucketID))
+	if bz == nil {
+		return
+	}
+
+	var period types.BillingStatement
+	k.cdc.MustUnmarshal(bz, &period)
+	paymentAddress := sdk.MustAccAddressFromHex(period.PaymentAddress)
+	store.Delete(types.GetBillingPeriodKey(bucketID))
+	store.Delete(types.GetOpenBillingPeriodIndexKey(paymentAddress, bucketID))
+
+	now := ctx.BlockTime().Unix()
+	if now <= period.StartTime {
+		return
+	}
+	settleBillingPeriod(&period, now, k.paymentKeeper.GetFrozenTime(ctx, paymentAddress))
+	store.Set(types.GetBillingStatementKey(paymentAddress, now, bucketID), k.cdc.MustMarshal(&period))
+	k.pruneBillingStatements(ctx, paymentAddress)
+}
+
+// pruneBillingStatements removes the billing statements of the payment account whose periods ended longer than
+// types.BillingStatementRetention ago, at most types.MaxPrunedBillingStatements of them each time. More statements are
+// pruned than settled, so the expired ones never pile up.
+func (k Keeper) pruneBillingStatements(ctx sdk.Context, paymentAccount sdk.AccAddress) {
+	expireTime := ctx.BlockTime().Unix() - types.BillingStatementRetention
+	if expireTime <= 0 {
+		return
+	}
+	statementStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBillingStatementPrefix(paymentAccount))
+	iterator := statementStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(expireTime)))
+
+	var expiredKeys [][]byte
+	for ; iterator.Valid() && len(expiredKeys) < types.MaxPrunedBillingStatements; iterator.Next() {
+		expiredKeys = append(expiredKeys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		statementStore.Delete(key)
+	}
+}
+
+// newBillingPeriod returns a billing period starting at the block time with the bill the bucket is charged.
+func (k Keeper) newBillingPeriod(ctx sdk.Context, bucketInfo *types.BucketInfo,
+	internalBucketInfo *types.InternalBucketInfo,
+) (*types.BillingStatement, error) {
+	period := &types.BillingStatement{
+		PaymentAddress:       bucketInfo.PaymentAddress,
+		BucketId:             bucketInfo.Id,
+		BucketName:           bucketInfo.BucketName,
+		StartTime:            ctx.BlockTime().Unix(),
+		ChargeSize:           internalBucketInfo.TotalChargeSize,
+		ChargedReadQuota:     bucketInfo.ChargedReadQuota,
+		StoreFlowRate:        sdkmath.ZeroInt(),
+		ReadFlowRate:         sdkmath.ZeroInt(),
+		ValidatorTaxFlowRate: sdkmath.ZeroInt(),
+		StoreFee:             sdkmath.ZeroInt(),
+		ReadFee:              sdkmath.ZeroInt(),
+		ValidatorTax:         sdkmath.ZeroInt(),
+		Total:                sdkmath.ZeroInt(),
+	}
+
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, internalBucketInfo.PriceTime)
+	if err != nil {
+		return nil, fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
+	}
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
+	}
+	// the period keeps the frozen time of the payment account at its start until it is settled
+	period.FrozenTime = k.paymentKeeper.GetFrozenTime(ctx, sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress))
+	period.PrimaryStorePrice = price.PrimaryStorePrice
+	period.SecondaryStorePrice = price.SecondaryStorePrice
+	period.ReadPrice = price.ReadPrice
+	period.ValidatorTaxRate = versionedParams.ValidatorTaxRate
+
+	bill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
+	if err != nil {
+		return nil, err
+	}
+	// the read and store flows are both paid to the GVG family, so the read flow rate is calculated the same way as the bill
+	spFlowRate := sdkmath.ZeroInt()
+	for _, flow := range bill.Flows {
+		if flow.ToAddress == paymenttypes.ValidatorTaxPoolAddress.String() {
+			period.ValidatorTaxFlowRate = period.ValidatorTaxFlowRate.Add(flow.Rate)
+		} else {
+			spFlowRate = spFlowRate.Add(flow.Rate)
+		}
+	}
+	period.ReadFlowRate = price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(bucketInfo.ChargedReadQuota)).TruncateInt()
+	period.StoreFlowRate = spFlowRate.Sub(period.ReadFlowRate)
+	return period, nil
+}
+
+// settleBillingPeriod ends the billing period at the end time and fills in the amounts charged during the period. The
+// frozen time is the one of the payment account at the end time, the seconds it is frozen for during the period are not
+// charged.
+func settleBillingPeriod(period *types.BillingStatement, endTime, frozenTime int64) {
+	period.EndTime = endTime
+	period.FrozenTime = frozenTime - period.FrozenTime
+	duration := sdkmath.NewInt(max(endTime-period.StartTime-period.FrozenTime, 0))
+	period.StoreFee = period.StoreFlowRate.Mul(duration)
+	period.ReadFee = period.ReadFlowRate.Mul(duration)
+	period.ValidatorTax = period.ValidatorTaxFlowRate.Mul(duration)
+	period.Total = period.StoreFee.Add(period.ReadFee).Add(period.ValidatorTax)
+}
+
+// GetOpenBillingPeriods returns the billing periods of the payment account not settled yet, charged up to the block time.
+func (k Keeper) GetOpenBillingPeriods(ctx sdk.Context, paymentAccount sdk.AccAddress) []types.BillingStatement {
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetOpenBillingPeriodIndexPrefix(paymentAccount))
+	iterator := storetypes.KVStorePrefixIterator(indexStore, []byte{})
+	defer iterator.Close()
+
+	var seq sequence.Sequence[sdkmath.Uint]
+	frozenTime := k.paymentKeeper.GetFrozenTime(ctx, paymentAccount)
+	periods := make([]types.BillingStatement, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		bz := store.Get(types.GetBillingPeriodKey(seq.DecodeSequence(iterator.Key())))
+		if bz == nil { // should not happen
+			continue
+		}
+		var period types.BillingStatement
+		k.cdc.MustUnmarshal(bz, &period)
+		settleBillingPeriod(&period, ctx.BlockTime().Unix(), frozenTime)
+		periods = append(periods, period)
+	}
+	return periods
+}
+
+// openAllBillingPeriods opens the billing periods of the buckets charged before the billing statements were introduced.
+func (k Keeper) openAllBillingPeriods(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	var err error
+	k.iterateBuckets(ctx, func(bucket *types.BucketInfo) {
+		// the rate limited buckets are not charged until the limit is lifted
+		if err != nil || store.Has(types.GetBillingPeriodKey(bucket.Id)) || k.IsBucketRateLimited(ctx, bucket.BucketName) {
+			return
+		}
+		internalBucketInfo, found := k.GetInternalBucketInfo(ctx, bucket.Id)
+		// the buckets with nothing charged have no period to open
+		if !found || (internalBucketInfo.TotalChargeSize == 0 && bucket.ChargedReadQuota == 0) {
+			return
+		}
+		err = k.recordBucketBill(ctx, bucket, internalBucketInfo)
+	})
+	return err
+}
+
+// ProjectBucketBill projects the total flow rate of the bucket once it is charged by the global store price taking
+// effect next, which includes the prices the sps scheduled, along with its current total flow rate.
+func (k Keeper) ProjectBucketBill(ctx sdk.Context, bucketInfo *types.BucketInfo) (*types.QueryBucketBillImpactResponse, error) {
+	internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+	currentPrice, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, internalBucketInfo.PriceTime)
+	if err != nil {
+		return nil, fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
+	}
+	projectedPrice, found := k.spKeeper.GetNextGlobalSpStorePrice(ctx)
+	if !found {
+		return nil, fmt.Errorf("the next global store price can not be projected")
+	}
+
+	impact := &types.QueryBucketBillImpactResponse{
+		EffectiveTime:     projectedPrice.UpdateTimeSec,
+		CurrentPrice:      currentPrice,
+		ProjectedPrice:    projectedPrice,
+		CurrentFlowRate:   sdkmath.ZeroInt(),
+		ProjectedFlowRate: sdkmath.ZeroInt(),
+	}
+	if internalBucketInfo.TotalChargeSize == 0 && bucketInfo.ChargedReadQuota == 0 {
+		return impact, nil
+	}
+
+	currentBill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
+	if err != nil {
+		return nil, err
+	}
+	gvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
+	if !found {
+		return nil, fmt.Errorf("get GVG family failed: %d", bucketInfo.GlobalVirtualGroupFamilyId)
+	}
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, projectedPrice.UpdateTimeSec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator tax rate: %d %w", projectedPrice.UpdateTimeSec, err)
+	}
+	projectedBill, err := k.getBucketReadStoreBillByPrice(ctx, bucketInfo, internalBucketInfo, gvgFamily, projectedPrice, versionedParams)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, flow := range currentBill.Flows {
+		impact.CurrentFlowRate = impact.CurrentFlowRate.Add(flow.Rate)
+	}
+	for _, flow := range projectedBill.Flows {
+		impact.ProjectedFlowRate = impact.ProjectedFlowRate.Add(flow.Rate)
+	}
+	return impact, nil
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	paymenttypes "github.com/mocachain/moca/v2/x/payment/types"
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/storage/types"
+	virtualgroupmoduletypes "github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestBillingStatements() {
+	owner := sample.RandAccAddress()
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gomock.Any()).Return(&virtualgroupmoduletypes.GlobalVirtualGroupFamily{
+		Id:                    1,
+		PrimarySpId:           100,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}, true).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdkmath.LegacyNewDec(100),
+		PrimaryStorePrice:   sdkmath.LegacyNewDec(1000),
+		SecondaryStorePrice: sdkmath.LegacyNewDec(500),
+	}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(paymenttypes.DefaultParams().VersionedParams, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		Owner:                      owner.String(),
+		BucketName:                 "billed-bucket",
+		Id:                         sdkmath.NewUint(1),
+		PaymentAddress:             owner.String(),
+		GlobalVirtualGroupFamilyId: 1,
+		ChargedReadQuota:           1000,
+		BucketStatus:               types.BUCKET_STATUS_CREATED,
+	}
+	internalBucketInfo := &types.InternalBucketInfo{}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.Require().NoError(s.storageKeeper.ChargeBucketReadFee(s.ctx, bucketInfo, internalBucketInfo))
+	startTime := s.ctx.BlockTime().Unix()
+
+	// raising the read quota 100 seconds later settles the billing period
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(100 * time.Second))
+	s.Require().NoError(s.storageKeeper.UpdateBucketInfoAndCharge(ctx, bucketInfo, internalBucketInfo, owner.String(), 2000))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Second))
+	res, err := s.storageKeeper.BillingStatements(ctx, &types.QueryBillingStatementsRequest{PaymentAddress: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Statements, 1)
+	statement := res.Statements[0]
+	s.Require().Equal(startTime, statement.StartTime)
+	s.Require().Equal(startTime+100, statement.EndTime)
+	s.Require().Equal(uint64(1000), statement.ChargedReadQuota)
+	s.Require().Equal(sdkmath.NewInt(100000), statement.ReadFlowRate)
+	s.Require().Equal(sdkmath.NewInt(1000), statement.ValidatorTaxFlowRate)
+	s.Require().Equal(sdkmath.NewInt(10000000), statement.ReadFee)
+	s.Require().Equal(sdkmath.NewInt(100000), statement.ValidatorTax)
+	s.Require().Equal(sdkmath.NewInt(10100000), statement.Total)
+
+	// the current billing period is charged up to the block time
+	s.Require().Len(res.OpenStatements, 1)
+	s.Require().Equal(uint64(2000), res.OpenStatements[0].ChargedReadQuota)
+	s.Require().Equal(sdkmath.NewInt(2000000), res.OpenStatements[0].ReadFee)
+
+	// the settled period is out of a later time range
+	res, err = s.storageKeeper.BillingStatements(ctx, &types.QueryBillingStatementsRequest{
+		PaymentAddress: owner.String(),
+		StartTime:      startTime + 100,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Statements, 0)
+	s.Require().Len(res.OpenStatements, 1)
+}
+
+func (s *TestSuite) TestBillingStatements_FrozenTimeAndRetention() {
+	owner := sample.RandAccAddress()
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gomock.Any()).Return(&virtualgroupmoduletypes.GlobalVirtualGroupFamily{
+		Id:                    1,
+		PrimarySpId:           100,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}, true).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdkmath.LegacyNewDec(100),
+		PrimaryStorePrice:   sdkmath.LegacyNewDec(1000),
+		SecondaryStorePrice: sdkmath.LegacyNewDec(500),
+	}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(paymenttypes.DefaultParams().VersionedParams, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		Owner:                      owner.String(),
+		BucketName:                 "frozen-bucket",
+		Id:                         sdkmath.NewUint(2),
+		PaymentAddress:             owner.String(),
+		GlobalVirtualGroupFamilyId: 1,
+		ChargedReadQuota:           1000,
+		BucketStatus:               types.BUCKET_STATUS_CREATED,
+	}
+	internalBucketInfo := &types.InternalBucketInfo{}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.Require().NoError(s.storageKeeper.ChargeBucketReadFee(s.ctx, bucketInfo, internalBucketInfo))
+	startTime := s.ctx.BlockTime().Unix()
+
+	// the payment account is frozen for 30 of the 100 seconds of the first period, which are not charged
+	s.frozenTime[owner.String()] = 30
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(100 * time.Second))
+	s.Require().NoError(s.storageKeeper.UpdateBucketInfoAndCharge(ctx, bucketInfo, internalBucketInfo, owner.String(), 2000))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(100 * time.Second))
+	s.Require().NoError(s.storageKeeper.UpdateBucketInfoAndCharge(ctx, bucketInfo, internalBucketInfo, owner.String(), 3000))
+
+	// the statements are paged by their end time
+	res, err := s.storageKeeper.BillingStatements(ctx, &types.QueryBillingStatementsRequest{
+		PaymentAddress: owner.String(),
+		Pagination:     &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Statements, 1)
+	statement := res.Statements[0]
+	s.Require().Equal(startTime+100, statement.EndTime)
+	s.Require().Equal(int64(30), statement.FrozenTime)
+	s.Require().Equal(sdkmath.NewInt(7000000), statement.ReadFee)
+	s.Require().Equal(sdkmath.NewInt(70000), statement.ValidatorTax)
+	s.Require().NotEmpty(res.Pagination.NextKey)
+
+	res, err = s.storageKeeper.BillingStatements(ctx, &types.QueryBillingStatementsRequest{
+		PaymentAddress: owner.String(),
+		Pagination:     &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Statements, 1)
+	s.Require().Equal(startTime+200, res.Statements[0].EndTime)
+	s.Require().Zero(res.Statements[0].FrozenTime)
+	s.Require().Empty(res.Pagination.NextKey)
+
+	// the statements are pruned once they are kept for longer than the retention
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add((types.BillingStatementRetention + 1) * time.Second))
+	s.Require().NoError(s.storageKeeper.UpdateBucketInfoAndCharge(ctx, bucketInfo, internalBucketInfo, owner.String(), 4000))
+	res, err = s.storageKeeper.BillingStatements(ctx, &types.QueryBillingStatementsRequest{PaymentAddress: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Statements, 1)
+	s.Require().Equal(ctx.BlockTime().Unix(), res.Statements[0].EndTime)
+	s.Require().Equal(uint64(3000), res.Statements[0].ChargedReadQuota)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	ecommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/mocachain/moca/v2/contracts"
+	mocatypes "github.com/mocachain/moca/v2/types"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// the methods of IObjectCallback the bucket callback contract is notified through
+const (
+	objectSealedCallback   = "onObjectSealed"
+	objectRejectedCallback = "onObjectRejected"
+	objectDeletedCallback  = "onObjectDeleted"
+)
+
+// SetBucketCallback registers the contract notified when the objects of the bucket are sealed, rejected or deleted,
+// only the bucket owner can set it. An empty callback address removes the callback.
+func (k Keeper) SetBucketCallback(ctx sdk.Context, operator sdk.AccAddress, bucketName string,
+	callback types.BucketCallback,
+) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return err
+	}
+	if !operator.Equals(sdk.MustAccAddressFromHex(bucketInfo.Owner)) {
+		return types.ErrAccessDenied.Wrapf("Only the bucket owner(%s) can set the callback of the bucket(%s)",
+			bucketInfo.Owner, bucketName)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if callback.CallbackAddress == "" {
+		callback = types.BucketCallback{}
+		store.Delete(types.GetBucketCallbackKey(bucketInfo.Id))
+	} else {
+		store.Set(types.GetBucketCallbackKey(bucketInfo.Id), k.cdc.MustMarshal(&callback))
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSetBucketCallback{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		BucketId:   bucketInfo.Id,
+		Callback:   callback,
+	})
+}
+
+// GetBucketCallback returns the contract notified about the objects of the bucket.
+func (k Keeper) GetBucketCallback(ctx sdk.Context, bucketID sdkmath.Uint) (*types.BucketCallback, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBucketCallbackKey(bucketID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var callback types.BucketCallback
+	k.cdc.MustUnmarshal(bz, &callback)
+	return &callback, true
+}
+
+// invokeBucketCallback notifies the callback contract of the bucket about the object, if the bucket has one. The
+// EVM gas used by the callback is charged to the storage operation, and a callback which fails or runs out of its
+// gas limit is charged the whole gas limit, but it does not fail the operation: the state changes of the callback
+// are dropped and the failure is reported by the event. The callback is skipped if the gas left to the storage
+// operation can not cover its gas limit.
+func (k Keeper) invokeBucketCallback(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo,
+	method string,
+) error {
+	callback, found := k.GetBucketCallback(ctx, bucketInfo.Id)
+	if !found {
+		return nil
+	}
+
+	data, err := contracts.ObjectCallbackContract.ABI.Pack(method, objectInfo.Id.BigInt(), bucketInfo.BucketName,
+		objectInfo.ObjectName)
+	if err != nil {
+		return err
+	}
+
+	var gasUsed uint64
+	if gasLeft := ctx.GasMeter().GasRemaining(); gasLeft < callback.GasLimit {
+		err = fmt.Errorf("not enough gas left for the callback, gas left: %d, gas limit: %d", gasLeft, callback.GasLimit)
+	} else {
+		gasUsed, err = k.callBucketCallback(ctx, callback, data)
+		ctx.GasMeter().ConsumeGas(gasUsed, "bucket callback")
+	}
+
+	event := &types.EventObjectCallback{
+		BucketName:      bucketInfo.BucketName,
+		ObjectName:      objectInfo.ObjectName,
+		ObjectId:        objectInfo.Id,
+		CallbackAddress: callback.CallbackAddress,
+		Method:          method,
+		Success:         err == nil,
+		GasUsed:         gasUsed,
+	}
+	if err != nil {
+		event.Error = err.Error()
+		k.Logger(ctx).Info("bucket callback failed", "bucket", bucketInfo.BucketName, "object", objectInfo.ObjectName,
+			"method", method, "err", err)
+	}
+	return ctx.EventManager().EmitTypedEvents(event)
+}
+
+// callBucketCallback calls the callback contract from the storage precompile address in a cached context metered by
+// the gas limit of the callback, the cached state is written only if the call succeeds. It returns the EVM gas used
+// by a successful call, and the gas limit of the callback if the call fails.
+func (k Keeper) callBucketCallback(ctx sdk.Context, callback *types.BucketCallback, data []byte) (gasUsed uint64, err error) {
+	gasMeter := storetypes.NewGasMeter(callback.GasLimit)
+	cacheCtx, write := ctx.WithGasMeter(gasMeter).CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			gasUsed, err = callback.GasLimit, fmt.Errorf("out of gas in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	contract := ecommon.HexToAddress(callback.CallbackAddress)
+	stateDB := statedb.New(cacheCtx, k.evmKeeper, statedb.NewEmptyTxConfig())
+	res, err := k.evmKeeper.CallEVMWithData(cacheCtx, stateDB, ecommon.HexToAddress(mocatypes.StorageAddress), &contract,
+		data, true, false, new(big.Int).SetUint64(callback.GasLimit))
+	if err != nil {
+		return callback.GasLimit, err
+	}
+	if res.Failed() {
+		return callback.GasLimit, fmt.Errorf("callback failed: %s", res.VmError)
+	}
+	write()
+	return min(res.GasUsed, callback.GasLimit), nil
+}
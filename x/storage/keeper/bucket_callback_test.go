@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/contracts"
+	"github.com/mocachain/moca/v2/testutil/sample"
+	mocatypes "github.com/mocachain/moca/v2/types"
+	paymenttypes "github.com/mocachain/moca/v2/x/payment/types"
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/storage/types"
+	types2 "github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func (s *BurnTestSuite) TestBucketCallback() {
+	owner := sample.RandAccAddress()
+	bucketName := "bucket-callback"
+	bucket := &types.BucketInfo{
+		Owner:          owner.String(),
+		BucketName:     bucketName,
+		Id:             sdkmath.NewUint(60),
+		BucketStatus:   types.BUCKET_STATUS_CREATED,
+		PaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucket)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucket.Id, &types.InternalBucketInfo{
+		PriceTime:          s.ctx.BlockTime().Unix(),
+		LocalVirtualGroups: []*types.LocalVirtualGroup{{Id: 0, GlobalVirtualGroupId: 0}},
+	})
+	for i, objectName := range []string{"obj-reverted", "obj-out-of-gas", "obj-succeeded", "obj-skipped"} {
+		s.storageKeeper.StoreObjectInfo(s.ctx, &types.ObjectInfo{
+			Id:           sdkmath.NewUint(uint64(600 + i)),
+			BucketName:   bucketName,
+			ObjectName:   objectName,
+			Owner:        owner.String(),
+			ObjectStatus: types.OBJECT_STATUS_SEALED,
+			PayloadSize:  1,
+			CreateAt:     s.ctx.BlockTime().Unix(),
+			UpdatedAt:    s.ctx.BlockTime().Unix(),
+		})
+	}
+	s.seedVersionedParams()
+
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gomock.Any()).Return(&types2.GlobalVirtualGroupFamily{Id: 0, PrimarySpId: 0}, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gomock.Any()).Return(&types2.GlobalVirtualGroup{
+		Id: 0, FamilyId: 0, PrimarySpId: 0, SecondarySpIds: []uint32{}, VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}, true).AnyTimes()
+	spAddress, _, _ := sample.RandSignBytes()
+	s.spKeeper.EXPECT().MustGetStorageProvider(gomock.Any(), gomock.Any()).Return(&sptypes.StorageProvider{Id: 0, OperatorAddress: spAddress.String()}).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).Return(&sptypes.StorageProvider{Id: 0, OperatorAddress: spAddress.String()}, true).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdkmath.LegacyNewDec(1),
+		PrimaryStorePrice:   sdkmath.LegacyNewDec(2),
+		SecondaryStorePrice: sdkmath.LegacyNewDec(1),
+	}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).Return(paymenttypes.VersionedParams{ReserveTime: 10000, ValidatorTaxRate: sdkmath.LegacyNewDec(1)}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().UpdateStreamRecordByAddr(gomock.Any(), gomock.Any()).Return(&paymenttypes.StreamRecord{StaticBalance: sdkmath.NewInt(100)}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.paymentKeeper.EXPECT().MergeOutFlows(gomock.Any()).Return([]paymenttypes.OutFlow{}).AnyTimes()
+	s.permissionKeeper.EXPECT().ExistAccountPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	s.permissionKeeper.EXPECT().ExistGroupPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	s.permissionKeeper.EXPECT().ExistGroupMemberForGroup(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	s.accountKeeper.EXPECT().GetSequence(gomock.Any(), gomock.Any()).Return(uint64(0), nil).AnyTimes()
+
+	// only the bucket owner can set the callback
+	callbackAddress := common.BytesToAddress(sample.RandAccAddress())
+	callback := types.BucketCallback{CallbackAddress: callbackAddress.String(), GasLimit: 100_000}
+	err := s.storageKeeper.SetBucketCallback(s.ctx, sample.RandAccAddress(), bucketName, callback)
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+	s.Require().NoError(s.storageKeeper.SetBucketCallback(s.ctx, owner, bucketName, callback))
+	res, err := s.storageKeeper.BucketCallback(s.ctx, &types.QueryBucketCallbackRequest{BucketName: bucketName})
+	s.Require().NoError(err)
+	s.Require().Equal(&callback, res.Callback)
+
+	// the nft burns succeed, the callback reverts first, then runs out of gas after writing to the store, then
+	// succeeds, at last the burn leaves less gas than the gas limit of the callback. The callback is charged the gas
+	// used reported by the EVM, its gas limit if it fails.
+	callbackCalls := 0
+	leaveGas := uint64(0)
+	s.evmKeeper.EXPECT().CallEVMWithData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx sdk.Context, _ *statedb.StateDB, from common.Address, contract *common.Address, data []byte, _, _ bool, gasCap *big.Int) (*evmtypes.MsgEthereumTxResponse, error) {
+			if *contract != callbackAddress {
+				if leaveGas > 0 {
+					ctx.GasMeter().ConsumeGas(ctx.GasMeter().GasRemaining()-leaveGas, "burn")
+				}
+				return &evmtypes.MsgEthereumTxResponse{}, nil
+			}
+			s.Require().Equal(common.HexToAddress(mocatypes.StorageAddress), from)
+			s.Require().Equal(contracts.ObjectCallbackContract.ABI.Methods["onObjectDeleted"].ID, data[:4])
+			s.Require().Equal(uint64(100_000), gasCap.Uint64())
+			callbackCalls++
+			switch callbackCalls {
+			case 1:
+				return nil, errors.New("execution reverted")
+			case 2:
+				ctx.KVStore(s.storeKey).Set([]byte("callback"), []byte{1})
+				return &evmtypes.MsgEthereumTxResponse{VmError: "out of gas", GasUsed: gasCap.Uint64()}, nil
+			default:
+				ctx.KVStore(s.storeKey).Set([]byte("callback-succeeded"), []byte{1})
+				return &evmtypes.MsgEthereumTxResponse{GasUsed: 30_000}, nil
+			}
+		}).AnyTimes()
+
+	for _, objectName := range []string{"obj-reverted", "obj-out-of-gas", "obj-succeeded", "obj-skipped"} {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		if objectName == "obj-skipped" {
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+			leaveGas = 80_000
+		}
+		s.Require().NoError(s.storageKeeper.DeleteObject(ctx, owner, bucketName, objectName, types.DeleteObjectOptions{}))
+		_, found := s.storageKeeper.GetObjectInfo(ctx, bucketName, objectName)
+		s.Require().False(found)
+
+		var event *types.EventObjectCallback
+		for _, e := range ctx.EventManager().ABCIEvents() {
+			msg, err := sdk.ParseTypedEvent(e)
+			if err != nil {
+				continue
+			}
+			if callbackEvent, ok := msg.(*types.EventObjectCallback); ok {
+				event = callbackEvent
+			}
+		}
+		s.Require().NotNil(event)
+		s.Require().Equal(objectName, event.ObjectName)
+		s.Require().Equal("onObjectDeleted", event.Method)
+		switch objectName {
+		case "obj-reverted", "obj-out-of-gas":
+			s.Require().False(event.Success)
+			s.Require().NotEmpty(event.Error)
+			s.Require().Equal(uint64(100_000), event.GasUsed)
+			s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(100_000))
+		case "obj-succeeded":
+			s.Require().True(event.Success)
+			s.Require().Empty(event.Error)
+			s.Require().Equal(uint64(30_000), event.GasUsed)
+			s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(30_000))
+		case "obj-skipped":
+			s.Require().False(event.Success)
+			s.Require().Zero(event.GasUsed)
+			s.Require().Contains(event.Error, "not enough gas left")
+		}
+	}
+	s.Require().Equal(3, callbackCalls)
+	s.Require().False(s.ctx.KVStore(s.storeKey).Has([]byte("callback")), "the state changes of a failed callback are dropped")
+	s.Require().True(s.ctx.KVStore(s.storeKey).Has([]byte("callback-succeeded")))
+
+	// an empty callback address removes the callback
+	s.Require().NoError(s.storageKeeper.SetBucketCallback(s.ctx, owner, bucketName, types.BucketCallback{}))
+	_, found := s.storageKeeper.GetBucketCallback(s.ctx, bucket.Id)
+	s.Require().False(found)
+}
@@ -0,0 +1,347 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ecommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/mocachain/moca/v2/contracts"
+	"github.com/mocachain/moca/v2/internal/sequence"
+	"github.com/mocachain/moca/v2/types/resource"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// TransferBucketOwnership proposes to transfer the ownership of the bucket to the new owner, it takes effect once the
+// new owner accepts it. Only the bucket owner can propose it, an empty new owner cancels the pending transfer.
+func (k Keeper) TransferBucketOwnership(ctx sdk.Context, operator sdk.AccAddress, bucketName string,
+	newOwner sdk.AccAddress,
+) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return err
+	}
+	if !operator.Equals(sdk.MustAccAddressFromHex(bucketInfo.Owner)) {
+		return types.ErrAccessDenied.Wrapf("Only the bucket owner(%s) can transfer the ownership of the bucket(%s)",
+			bucketInfo.Owner, bucketName)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	event := &types.EventTransferBucketOwnership{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		BucketId:   bucketInfo.Id,
+	}
+	if newOwner.Empty() {
+		if !store.Has(types.GetBucketOwnershipTransferKey(bucketInfo.Id)) {
+			return types.ErrNoSuchOwnershipTransfer
+		}
+		store.Delete(types.GetBucketOwnershipTransferKey(bucketInfo.Id))
+	} else {
+		if newOwner.Equals(operator) {
+			return types.ErrInvalidBucketOwner.Wrapf("the new owner is the bucket owner itself")
+		}
+		transfer := types.BucketOwnershipTransfer{
+			NewOwner:   newOwner.String(),
+			ProposedAt: ctx.BlockTime().Unix(),
+		}
+		store.Set(types.GetBucketOwnershipTransferKey(bucketInfo.Id), k.cdc.MustMarshal(&transfer))
+		event.NewOwner = transfer.NewOwner
+	}
+
+	return ctx.EventManager().EmitTypedEvents(event)
+}
+
+// GetBucketOwnershipTransfer returns the pending transfer of the ownership of the bucket.
+func (k Keeper) GetBucketOwnershipTransfer(ctx sdk.Context, bucketID sdkmath.Uint) (*types.BucketOwnershipTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBucketOwnershipTransferKey(bucketID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var transfer types.BucketOwnershipTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return &transfer, true
+}
+
+// AcceptBucketOwnership completes the pending transfer of the ownership of the bucket to the operator. The bucket,
+// its objects and their NFTs are moved to the operator, at most types.MaxTransferBucketObjects objects along with the
+// acceptance and the rest in the following end blocks, and the bucket is charged from the given payment account, the
+// operator itself if empty, from then on. The callback and the read quota auto topup set by the previous owner
+// are removed. So are the policies of the bucket, the ones of each object are removed once it is moved.
+func (k Keeper) AcceptBucketOwnership(ctx sdk.Context, operator sdk.AccAddress, bucketName, paymentAddress string) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return err
+	}
+	transfer, found := k.GetBucketOwnershipTransfer(ctx, bucketInfo.Id)
+	if !found {
+		return types.ErrNoSuchOwnershipTransfer
+	}
+	if !operator.Equals(sdk.MustAccAddressFromHex(transfer.NewOwner)) {
+		return types.ErrAccessDenied.Wrapf("The bucket(%s) is transferred to %s, not the operator(%s)",
+			bucketName, transfer.NewOwner, operator.String())
+	}
+
+	maxBuckets := k.MaxBucketsPerAccount(ctx)
+	if maxBuckets > 0 {
+		currentCount := k.GetBucketCountByOwner(ctx, operator)
+		if currentCount >= uint64(maxBuckets) {
+			return types.ErrAccessDenied.Wrapf(
+				"account %s has reached max bucket limit: %d/%d",
+				operator.String(), currentCount, maxBuckets)
+		}
+	}
+
+	paymentAcc, err := k.VerifyPaymentAccount(ctx, paymentAddress, operator)
+	if err != nil {
+		return err
+	}
+	if !paymentAcc.Equals(sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)) && k.GetLockedObjectCount(ctx, bucketInfo.Id) != 0 {
+		return types.ErrUpdatePaymentAccountFailed.Wrapf("The bucket %s has unseald objects", bucketInfo.BucketName)
+	}
+
+	// the policies granted by the previous owner must not outlive its ownership, the acceptance fails rather than
+	// leaving some of them behind
+	if _, done := k.deleteResourcePolicies(ctx, resource.RESOURCE_TYPE_BUCKET, bucketInfo.Id,
+		k.StalePolicyCleanupMax(ctx)); !done {
+		return types.ErrTooManyPolicies.Wrapf("The bucket(%s) has more than %d policies, the owner has to delete some "+
+			"of them before the transfer is accepted", bucketName, k.StalePolicyCleanupMax(ctx))
+	}
+
+	// the flow rate limit of the new payment account is checked against the new owner
+	previousOwner := sdk.MustAccAddressFromHex(bucketInfo.Owner)
+	internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+	err = k.ChargeViaBucketChange(ctx, bucketInfo, internalBucketInfo, func(bi *types.BucketInfo, _ *types.InternalBucketInfo) error {
+		bi.Owner = operator.String()
+		bi.PaymentAddress = paymentAcc.String()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBucketByIDKey(bucketInfo.Id), k.cdc.MustMarshal(bucketInfo))
+	k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
+	store.Delete(types.GetBucketOwnershipTransferKey(bucketInfo.Id))
+	store.Delete(types.GetBucketCallbackKey(bucketInfo.Id))
+	store.Delete(types.GetBucketReadQuotaAutoTopupKey(bucketInfo.Id))
+
+	if err = k.moveNFT(ctx, contracts.BucketControlHubAddress, contracts.BucketERC721TokenAddress,
+		operator, bucketInfo.Id.BigInt()); err != nil {
+		return err
+	}
+	// the objects are moved in bounded batches, the ones left here are moved in the following end blocks. A pending
+	// move of a previous acceptance starts over for the new owner.
+	store.Set(types.GetBucketObjectsTransferKey(bucketInfo.Id), sdk.Uint64ToBigEndian(0))
+	objectCount, err := k.transferBucketObjects(ctx, bucketInfo, types.MaxTransferBucketObjects)
+	if err != nil {
+		return err
+	}
+
+	k.DecrementBucketCount(ctx, previousOwner)
+	k.IncrementBucketCount(ctx, operator)
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventAcceptBucketOwnership{
+		PreviousOwner:  previousOwner.String(),
+		NewOwner:       operator.String(),
+		BucketName:     bucketName,
+		BucketId:       bucketInfo.Id,
+		PaymentAddress: bucketInfo.PaymentAddress,
+		ObjectCount:    objectCount,
+	})
+}
+
+// TransferPendingBucketObjects moves the objects of the buckets whose ownership has been accepted to their new
+// owners, resuming from where the acceptance or the previous end block stopped. At most maxCount objects are moved,
+// it returns the number of the objects moved.
+func (k Keeper) TransferPendingBucketObjects(ctx sdk.Context, maxCount uint64) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.BucketObjectsTransferPrefix)
+	u256Seq := sequence.Sequence[sdkmath.Uint]{}
+
+	// every bucket costs one at least, so no more than maxCount of them are visited
+	var bucketIDs []sdkmath.Uint
+	for ; iter.Valid() && uint64(len(bucketIDs)) < maxCount; iter.Next() {
+		bucketIDs = append(bucketIDs, u256Seq.DecodeSequence(iter.Key()[len(types.BucketObjectsTransferPrefix):]))
+	}
+	iter.Close()
+
+	var moved, spent uint64
+	for _, bucketID := range bucketIDs {
+		if spent >= maxCount {
+			break
+		}
+		bucketInfo, found := k.GetBucketInfoById(ctx, bucketID)
+		if !found {
+			// the bucket is deleted along with all its objects
+			store.Delete(types.GetBucketObjectsTransferKey(bucketID))
+			continue
+		}
+		count, err := k.transferBucketObjects(ctx, bucketInfo, maxCount-spent)
+		if err != nil {
+			return moved, err
+		}
+		moved += count
+		spent += count + 1
+	}
+	return moved, nil
+}
+
+// transferBucketObjects moves at most limit objects of the bucket and their NFTs to the bucket owner, resuming after
+// the last object moved before, and deletes the policies granted on them by the previous owner. The batch stops early
+// once StalePolicyCleanupMax policies are deleted. The pending transfer is removed once all the objects are moved. It
+// returns the number of the objects moved.
+func (k Keeper) transferBucketObjects(ctx sdk.Context, bucketInfo *types.BucketInfo, limit uint64) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	transferKey := types.GetBucketObjectsTransferKey(bucketInfo.Id)
+	bz := store.Get(transferKey)
+	if bz == nil {
+		return 0, nil
+	}
+	total := sdk.BigEndianToUint64(bz[:8])
+	var start []byte
+	if len(bz) > 8 {
+		// the object keys are of the same length, so the next key after the last object is the one padded with zero
+		start = append(append([]byte{}, bz[8:]...), 0x00)
+	}
+
+	objectPrefixStore := prefix.NewStore(store, types.GetObjectKeyOnlyBucketPrefix(bucketInfo.BucketName))
+	iter := objectPrefixStore.Iterator(start, nil)
+	u256Seq := sequence.Sequence[sdkmath.Uint]{}
+
+	// collect the ids first, the objects are not written while the store is iterated
+	var objectIDs []sdkmath.Uint
+	var objectKeys [][]byte
+	done := true
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(objectIDs)) >= limit {
+			done = false
+			break
+		}
+		objectIDs = append(objectIDs, u256Seq.DecodeSequence(iter.Value()))
+		objectKeys = append(objectKeys, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+
+	var lastKey []byte
+	if len(bz) > 8 {
+		lastKey = bz[8:]
+	}
+	var count, deletedPolicies uint64
+	maxDelete := k.StalePolicyCleanupMax(ctx)
+	for i, objectID := range objectIDs {
+		objectInfo, found := k.GetObjectInfoById(ctx, objectID)
+		if !found {
+			return 0, types.ErrNoSuchObject.Wrapf("object id: %s", objectID)
+		}
+		// the objects created or moved since the acceptance are owned by the bucket owner already
+		if objectInfo.Owner == bucketInfo.Owner {
+			lastKey = objectKeys[i]
+			count++
+			continue
+		}
+		// the object is left to the next batch if its policies are not all deleted, it resumes the deletion
+		var policiesDone bool
+		deletedPolicies, policiesDone = k.deleteResourcePolicies(ctx, resource.RESOURCE_TYPE_OBJECT, objectInfo.Id,
+			maxDelete-deletedPolicies)
+		if !policiesDone {
+			done = false
+			break
+		}
+		objectInfo.Owner = bucketInfo.Owner
+		k.SetObjectInfo(ctx, objectInfo)
+
+		// the NFT is only minted for the sealed non-empty objects
+		if objectInfo.ObjectStatus == types.OBJECT_STATUS_SEALED && objectInfo.PayloadSize > 0 {
+			if err := k.moveNFT(ctx, contracts.ObjectControlHubAddress, contracts.ObjectERC721TokenAddress,
+				sdk.MustAccAddressFromHex(bucketInfo.Owner), objectInfo.Id.BigInt()); err != nil {
+				return 0, err
+			}
+		}
+		lastKey = objectKeys[i]
+		count++
+	}
+
+	total += count
+	if !done {
+		store.Set(transferKey, append(sdk.Uint64ToBigEndian(total), lastKey...))
+		return count, nil
+	}
+	store.Delete(transferKey)
+	return count, ctx.EventManager().EmitTypedEvents(&types.EventTransferBucketObjects{
+		Owner:       bucketInfo.Owner,
+		BucketName:  bucketInfo.BucketName,
+		BucketId:    bucketInfo.Id,
+		ObjectCount: total,
+	})
+}
+
+// deleteResourcePolicies deletes the account and group policies granted on the resource, at most maxDelete of
+// them. It returns the number of the policies deleted and whether all of them are deleted.
+func (k Keeper) deleteResourcePolicies(ctx sdk.Context, resourceType resource.ResourceType, resourceID sdkmath.Uint,
+	maxDelete uint64,
+) (uint64, bool) {
+	deleted, done := k.permKeeper.ForceDeleteAccountPolicyForResource(ctx, maxDelete, 0, resourceType, resourceID)
+	if !done {
+		return deleted, false
+	}
+	return k.permKeeper.ForceDeleteGroupPolicyForResource(ctx, maxDelete, deleted, resourceType, resourceID)
+}
+
+// hasPreviousOwnerPolicies reports whether the object is still being moved to the new owner of its bucket, so its
+// policies were granted by the previous owner and are ignored until they are deleted along with the move.
+func (k Keeper) hasPreviousOwnerPolicies(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo) bool {
+	return objectInfo.Owner != bucketInfo.Owner &&
+		ctx.KVStore(k.storeKey).Has(types.GetBucketObjectsTransferKey(bucketInfo.Id))
+}
+
+// objectOwner returns the owner of the object. The objects still being moved to the new owner of their bucket are
+// owned by the new owner already.
+func (k Keeper) objectOwner(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo) sdk.AccAddress {
+	if ctx.KVStore(k.storeKey).Has(types.GetBucketObjectsTransferKey(bucketInfo.Id)) {
+		return sdk.MustAccAddressFromHex(bucketInfo.Owner)
+	}
+	return sdk.MustAccAddressFromHex(objectInfo.Owner)
+}
+
+// moveNFT moves the NFT of a resource to its new owner. The NFT contracts are non-transferable, so the token is
+// burned and minted to the new owner again under the same id.
+func (k Keeper) moveNFT(ctx sdk.Context, controlHub, tokenContract ecommon.Address, newOwner sdk.AccAddress,
+	tokenID *big.Int,
+) error {
+	if _, err := k.CallEVM(
+		ctx,
+		contracts.ERC721NonTransferableContract.ABI,
+		controlHub,
+		tokenContract,
+		true,
+		"burn",
+		tokenID,
+	); err != nil {
+		return err
+	}
+
+	_, err := k.CallEVM(
+		ctx,
+		contracts.ERC721NonTransferableContract.ABI,
+		controlHub,
+		tokenContract,
+		true,
+		"mint",
+		ecommon.BytesToAddress(newOwner),
+		tokenID,
+	)
+	return err
+}
@@ -0,0 +1,324 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/contracts"
+	"github.com/mocachain/moca/v2/testutil/sample"
+	"github.com/mocachain/moca/v2/types/resource"
+	paymenttypes "github.com/mocachain/moca/v2/x/payment/types"
+	permtypes "github.com/mocachain/moca/v2/x/permission/types"
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/storage/types"
+	types2 "github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func (s *BurnTestSuite) TestBucketOwnershipTransfer() {
+	owner := sample.RandAccAddress()
+	newOwner := sample.RandAccAddress()
+	bucketName := "bucket-ownership"
+	bucket := &types.BucketInfo{
+		Owner:          owner.String(),
+		BucketName:     bucketName,
+		Id:             sdkmath.NewUint(70),
+		BucketStatus:   types.BUCKET_STATUS_CREATED,
+		PaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucket)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucket.Id, &types.InternalBucketInfo{
+		PriceTime:          s.ctx.BlockTime().Unix(),
+		LocalVirtualGroups: []*types.LocalVirtualGroup{{Id: 0, GlobalVirtualGroupId: 0}},
+	})
+	s.storageKeeper.IncrementBucketCount(s.ctx, owner)
+	// the sealed object has an NFT, the empty one does not
+	for i, objectName := range []string{"obj-sealed", "obj-empty"} {
+		s.storageKeeper.StoreObjectInfo(s.ctx, &types.ObjectInfo{
+			Id:           sdkmath.NewUint(uint64(700 + i)),
+			BucketName:   bucketName,
+			ObjectName:   objectName,
+			Owner:        owner.String(),
+			ObjectStatus: types.OBJECT_STATUS_SEALED,
+			PayloadSize:  uint64(1 - i),
+			CreateAt:     s.ctx.BlockTime().Unix(),
+			UpdatedAt:    s.ctx.BlockTime().Unix(),
+		})
+	}
+	s.seedVersionedParams()
+
+	s.expectBucketOwnershipTransfer()
+
+	// the previous owner has granted policies on the bucket and on the sealed object
+	policyKey := func(resourceType resource.ResourceType, resourceID sdkmath.Uint) string {
+		return fmt.Sprintf("%s/%s", resourceType, resourceID)
+	}
+	accountPolicies := map[string]uint64{
+		policyKey(resource.RESOURCE_TYPE_BUCKET, bucket.Id):            1,
+		policyKey(resource.RESOURCE_TYPE_OBJECT, sdkmath.NewUint(700)): 1,
+	}
+	s.permissionKeeper.EXPECT().ForceDeleteAccountPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, maxDelete, deleted uint64, resourceType resource.ResourceType, resourceID sdkmath.Uint) (uint64, bool) {
+			key := policyKey(resourceType, resourceID)
+			for ; accountPolicies[key] > 0; accountPolicies[key]-- {
+				if deleted >= maxDelete {
+					return deleted, false
+				}
+				deleted++
+			}
+			return deleted, true
+		}).AnyTimes()
+	s.permissionKeeper.EXPECT().ForceDeleteGroupPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _, deleted uint64, _ resource.ResourceType, _ sdkmath.Uint) (uint64, bool) {
+			return deleted, true
+		}).AnyTimes()
+
+	type nftCall struct {
+		token  common.Address
+		method string
+	}
+	var nftCalls []nftCall
+	s.evmKeeper.EXPECT().CallEVMWithData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ *statedb.StateDB, _ common.Address, contract *common.Address, data []byte, _, _ bool, _ *big.Int) (*evmtypes.MsgEthereumTxResponse, error) {
+			method, err := contracts.ERC721NonTransferableContract.ABI.MethodById(data[:4])
+			s.Require().NoError(err)
+			if method.Name == "mint" {
+				args, err := method.Inputs.Unpack(data[4:])
+				s.Require().NoError(err)
+				s.Require().Equal(common.BytesToAddress(newOwner), args[0])
+			}
+			nftCalls = append(nftCalls, nftCall{token: *contract, method: method.Name})
+			return &evmtypes.MsgEthereumTxResponse{}, nil
+		}).AnyTimes()
+
+	// only the bucket owner can propose a transfer, which has to be proposed before it is accepted
+	err := s.storageKeeper.TransferBucketOwnership(s.ctx, newOwner, bucketName, newOwner)
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+	err = s.storageKeeper.AcceptBucketOwnership(s.ctx, newOwner, bucketName, "")
+	s.Require().ErrorIs(err, types.ErrNoSuchOwnershipTransfer)
+
+	// an empty new owner cancels the pending transfer
+	s.Require().NoError(s.storageKeeper.TransferBucketOwnership(s.ctx, owner, bucketName, sample.RandAccAddress()))
+	s.Require().NoError(s.storageKeeper.TransferBucketOwnership(s.ctx, owner, bucketName, nil))
+	_, found := s.storageKeeper.GetBucketOwnershipTransfer(s.ctx, bucket.Id)
+	s.Require().False(found)
+	err = s.storageKeeper.TransferBucketOwnership(s.ctx, owner, bucketName, nil)
+	s.Require().ErrorIs(err, types.ErrNoSuchOwnershipTransfer)
+
+	s.Require().NoError(s.storageKeeper.TransferBucketOwnership(s.ctx, owner, bucketName, newOwner))
+	res, err := s.storageKeeper.BucketOwnershipTransfer(s.ctx, &types.QueryBucketOwnershipTransferRequest{BucketName: bucketName})
+	s.Require().NoError(err)
+	s.Require().Equal(newOwner.String(), res.Transfer.NewOwner)
+
+	// only the new owner can accept it
+	err = s.storageKeeper.AcceptBucketOwnership(s.ctx, sample.RandAccAddress(), bucketName, "")
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+
+	s.Require().NoError(s.storageKeeper.SetBucketCallback(s.ctx, owner, bucketName, types.BucketCallback{
+		CallbackAddress: sample.RandAccAddress().String(), GasLimit: 100_000,
+	}))
+	// the acceptance fails rather than leaving some of the bucket policies behind
+	accountPolicies[policyKey(resource.RESOURCE_TYPE_BUCKET, bucket.Id)] = s.storageKeeper.StalePolicyCleanupMax(s.ctx) + 1
+	err = s.storageKeeper.AcceptBucketOwnership(s.ctx, newOwner, bucketName, "")
+	s.Require().ErrorIs(err, types.ErrTooManyPolicies)
+	accountPolicies[policyKey(resource.RESOURCE_TYPE_BUCKET, bucket.Id)] = 1
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.storageKeeper.AcceptBucketOwnership(ctx, newOwner, bucketName, ""))
+
+	bucketInfo, found := s.storageKeeper.GetBucketInfo(s.ctx, bucketName)
+	s.Require().True(found)
+	s.Require().Equal(newOwner.String(), bucketInfo.Owner)
+	for key, count := range accountPolicies {
+		s.Require().Zero(count, "the policies of the previous owner on %s are deleted", key)
+	}
+	s.Require().Equal(newOwner.String(), bucketInfo.PaymentAddress)
+	for _, objectName := range []string{"obj-sealed", "obj-empty"} {
+		objectInfo, found := s.storageKeeper.GetObjectInfo(s.ctx, bucketName, objectName)
+		s.Require().True(found)
+		s.Require().Equal(newOwner.String(), objectInfo.Owner)
+	}
+	s.Require().Equal(uint64(0), s.storageKeeper.GetBucketCountByOwner(s.ctx, owner))
+	s.Require().Equal(uint64(1), s.storageKeeper.GetBucketCountByOwner(s.ctx, newOwner))
+	_, found = s.storageKeeper.GetBucketOwnershipTransfer(s.ctx, bucket.Id)
+	s.Require().False(found)
+	_, found = s.storageKeeper.GetBucketCallback(s.ctx, bucket.Id)
+	s.Require().False(found, "the callback of the previous owner is removed")
+
+	// the NFTs of the bucket and the sealed object are moved to the new owner
+	s.Require().Equal([]nftCall{
+		{token: contracts.BucketERC721TokenAddress, method: "burn"},
+		{token: contracts.BucketERC721TokenAddress, method: "mint"},
+		{token: contracts.ObjectERC721TokenAddress, method: "burn"},
+		{token: contracts.ObjectERC721TokenAddress, method: "mint"},
+	}, nftCalls)
+
+	var event *types.EventAcceptBucketOwnership
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(e)
+		if err != nil {
+			continue
+		}
+		if acceptEvent, ok := msg.(*types.EventAcceptBucketOwnership); ok {
+			event = acceptEvent
+		}
+	}
+	s.Require().NotNil(event)
+	s.Require().Equal(owner.String(), event.PreviousOwner)
+	s.Require().Equal(uint64(2), event.ObjectCount)
+}
+
+func (s *BurnTestSuite) TestBucketOwnershipTransfer_ManyObjects() {
+	owner := sample.RandAccAddress()
+	newOwner := sample.RandAccAddress()
+	bucketName := "bucket-ownership-many"
+	bucket := &types.BucketInfo{
+		Owner:          owner.String(),
+		BucketName:     bucketName,
+		Id:             sdkmath.NewUint(71),
+		BucketStatus:   types.BUCKET_STATUS_CREATED,
+		PaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucket)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucket.Id, &types.InternalBucketInfo{
+		PriceTime:          s.ctx.BlockTime().Unix(),
+		LocalVirtualGroups: []*types.LocalVirtualGroup{{Id: 0, GlobalVirtualGroupId: 0}},
+	})
+	s.storageKeeper.IncrementBucketCount(s.ctx, owner)
+	objectCount := 2*types.MaxTransferBucketObjects + 5
+	for i := 0; i < objectCount; i++ {
+		s.storageKeeper.StoreObjectInfo(s.ctx, &types.ObjectInfo{
+			Id:           sdkmath.NewUint(uint64(7100 + i)),
+			BucketName:   bucketName,
+			ObjectName:   fmt.Sprintf("obj-%03d", i),
+			Owner:        owner.String(),
+			ObjectStatus: types.OBJECT_STATUS_SEALED,
+			PayloadSize:  1,
+			CreateAt:     s.ctx.BlockTime().Unix(),
+			UpdatedAt:    s.ctx.BlockTime().Unix(),
+		})
+	}
+	s.seedVersionedParams()
+	s.expectBucketOwnershipTransfer()
+	s.permissionKeeper.EXPECT().ForceDeleteAccountPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _, deleted uint64, _ resource.ResourceType, _ sdkmath.Uint) (uint64, bool) {
+			return deleted, true
+		}).AnyTimes()
+	s.permissionKeeper.EXPECT().ForceDeleteGroupPolicyForResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _, deleted uint64, _ resource.ResourceType, _ sdkmath.Uint) (uint64, bool) {
+			return deleted, true
+		}).AnyTimes()
+	// the previous owner has granted itself the deletion of the objects
+	s.permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), resource.RESOURCE_TYPE_OBJECT, owner).Return(&permtypes.Policy{
+		Id:           sdkmath.NewUint(1),
+		ResourceType: resource.RESOURCE_TYPE_OBJECT,
+		Statements:   []*permtypes.Statement{{Effect: permtypes.EFFECT_ALLOW, Actions: []permtypes.ActionType{permtypes.ACTION_DELETE_OBJECT}}},
+	}, true).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyGroupForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+
+	objectMints := 0
+	s.evmKeeper.EXPECT().CallEVMWithData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ *statedb.StateDB, _ common.Address, contract *common.Address, data []byte, _, _ bool, _ *big.Int) (*evmtypes.MsgEthereumTxResponse, error) {
+			method, err := contracts.ERC721NonTransferableContract.ABI.MethodById(data[:4])
+			s.Require().NoError(err)
+			if method.Name == "mint" && *contract == contracts.ObjectERC721TokenAddress {
+				objectMints++
+			}
+			return &evmtypes.MsgEthereumTxResponse{}, nil
+		}).AnyTimes()
+	movedObjects := func() int {
+		moved := 0
+		for i := 0; i < objectCount; i++ {
+			objectInfo, found := s.storageKeeper.GetObjectInfo(s.ctx, bucketName, fmt.Sprintf("obj-%03d", i))
+			s.Require().True(found)
+			if objectInfo.Owner == newOwner.String() {
+				moved++
+			}
+		}
+		return moved
+	}
+
+	s.Require().NoError(s.storageKeeper.TransferBucketOwnership(s.ctx, owner, bucketName, newOwner))
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.storageKeeper.AcceptBucketOwnership(ctx, newOwner, bucketName, ""))
+
+	// only a batch of the objects is moved along with the acceptance
+	s.Require().Equal(types.MaxTransferBucketObjects, movedObjects())
+	s.Require().Equal(types.MaxTransferBucketObjects, objectMints)
+	var acceptEvent *types.EventAcceptBucketOwnership
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		if msg, err := sdk.ParseTypedEvent(e); err == nil {
+			if event, ok := msg.(*types.EventAcceptBucketOwnership); ok {
+				acceptEvent = event
+			}
+		}
+	}
+	s.Require().NotNil(acceptEvent)
+	s.Require().Equal(uint64(types.MaxTransferBucketObjects), acceptEvent.ObjectCount)
+
+	// the objects not moved yet are owned by the new owner already, the policies of the previous owner are ignored
+	bucketInfo, found := s.storageKeeper.GetBucketInfo(s.ctx, bucketName)
+	s.Require().True(found)
+	objectInfo, found := s.storageKeeper.GetObjectInfo(s.ctx, bucketName, fmt.Sprintf("obj-%03d", objectCount-1))
+	s.Require().True(found)
+	s.Require().Equal(owner.String(), objectInfo.Owner)
+	s.Require().Equal(permtypes.EFFECT_ALLOW, s.storageKeeper.VerifyObjectPermission(s.ctx, bucketInfo, objectInfo, newOwner, permtypes.ACTION_DELETE_OBJECT))
+	s.Require().Equal(permtypes.EFFECT_DENY, s.storageKeeper.VerifyObjectPermission(s.ctx, bucketInfo, objectInfo, owner, permtypes.ACTION_DELETE_OBJECT))
+
+	// the rest are moved in the following end blocks, the bucket costs one of the budget of each block
+	moved, err := s.storageKeeper.TransferPendingBucketObjects(s.ctx, types.MaxTransferBucketObjects)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(types.MaxTransferBucketObjects-1), moved)
+	s.Require().Equal(2*types.MaxTransferBucketObjects-1, movedObjects())
+
+	ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	moved, err = s.storageKeeper.TransferPendingBucketObjects(ctx, types.MaxTransferBucketObjects)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(6), moved)
+	s.Require().Equal(objectCount, movedObjects())
+	s.Require().Equal(objectCount, objectMints)
+	var transferEvent *types.EventTransferBucketObjects
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		if msg, err := sdk.ParseTypedEvent(e); err == nil {
+			if event, ok := msg.(*types.EventTransferBucketObjects); ok {
+				transferEvent = event
+			}
+		}
+	}
+	s.Require().NotNil(transferEvent)
+	s.Require().Equal(newOwner.String(), transferEvent.Owner)
+	s.Require().Equal(uint64(objectCount), transferEvent.ObjectCount)
+
+	// nothing is left to move
+	moved, err = s.storageKeeper.TransferPendingBucketObjects(s.ctx, types.MaxTransferBucketObjects)
+	s.Require().NoError(err)
+	s.Require().Zero(moved)
+	s.Require().Equal(objectCount, objectMints)
+}
+
+// expectBucketOwnershipTransfer mocks the keepers charging the bucket from the payment account of the new owner.
+func (s *BurnTestSuite) expectBucketOwnershipTransfer() {
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gomock.Any()).Return(&types2.GlobalVirtualGroupFamily{Id: 0, PrimarySpId: 0}, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gomock.Any()).Return(&types2.GlobalVirtualGroup{
+		Id: 0, FamilyId: 0, PrimarySpId: 0, SecondarySpIds: []uint32{}, VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}, true).AnyTimes()
+	spAddress, _, _ := sample.RandSignBytes()
+	s.spKeeper.EXPECT().MustGetStorageProvider(gomock.Any(), gomock.Any()).Return(&sptypes.StorageProvider{Id: 0, OperatorAddress: spAddress.String()}).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).Return(&sptypes.StorageProvider{Id: 0, OperatorAddress: spAddress.String()}, true).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdkmath.LegacyNewDec(1),
+		PrimaryStorePrice:   sdkmath.LegacyNewDec(2),
+		SecondaryStorePrice: sdkmath.LegacyNewDec(1),
+	}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).Return(paymenttypes.VersionedParams{ReserveTime: 10000, ValidatorTaxRate: sdkmath.LegacyNewDec(1)}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.paymentKeeper.EXPECT().MergeOutFlows(gomock.Any()).Return([]paymenttypes.OutFlow{}).AnyTimes()
+}
@@ -55,6 +55,7 @@ func (k Keeper) unChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *types.Bu
 	if err != nil {
 		return fmt.Errorf("apply user flows list failed: %s %w", bucketInfo.BucketName, err)
 	}
+	k.closeBucketBillingPeriod(ctx, bucketInfo.Id)
 	return nil
 }
 
@@ -71,7 +72,7 @@ func (k Keeper) chargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *types.Buck
 		return fmt.Errorf("apply user flows list failed: %s %w", bucketInfo.BucketName, err)
 	}
 	k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
-	return nil
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo)
 }
 
 func getTotalOutFlowRate(flows []paymenttypes.OutFlow) sdkmath.Int {
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -15,6 +18,7 @@ import (
 	"github.com/mocachain/moca/v2/internal/sequence"
 	gnfd "github.com/mocachain/moca/v2/types"
 	"github.com/mocachain/moca/v2/types/errors"
+	"github.com/mocachain/moca/v2/types/resource"
 	permtypes "github.com/mocachain/moca/v2/x/permission/types"
 	sptypes "github.com/mocachain/moca/v2/x/sp/types"
 	"github.com/mocachain/moca/v2/x/storage/types"
@@ -220,6 +224,10 @@ func (k Keeper) ListObjects(goCtx context.Context, req *types.QueryListObjectsRe
 		return nil, err
 	}
 
+	if req.Prefix != "" || req.Delimiter != "" || req.StartAfter != "" {
+		return k.listObjectsByPrefix(ctx, req)
+	}
+
 	var objectInfos []*types.ObjectInfo
 	store := ctx.KVStore(k.storeKey)
 	objectPrefixStore := prefix.NewStore(store, types.GetObjectKeyOnlyBucketPrefix(req.BucketName))
@@ -237,6 +245,88 @@ func (k Keeper) ListObjects(goCtx context.Context, req *types.QueryListObjectsRe
 	return &types.QueryListObjectsResponse{ObjectInfos: objectInfos, Pagination: pageRes}, nil
 }
 
+// listObjectsByPrefix lists the objects of the bucket in lexicographical order of their names like S3 ListObjectsV2.
+// It seeks the object name index from the prefix or the start_after, whichever is later, and seeks past a common
+// prefix once it is met instead of visiting the objects within it. The next key of the pagination is the last returned
+// name or common prefix, and a common prefix is skipped once the start_after falls within it, so that it is returned
+// only once across the pages. The offset is not supported, the next key is used to page instead.
+func (k Keeper) listObjectsByPrefix(ctx sdk.Context, req *types.QueryListObjectsRequest) (*types.QueryListObjectsResponse, error) {
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &query.PageRequest{}
+	}
+	if pagination.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "reverse is not supported when listing by prefix, delimiter or start after")
+	}
+	if pagination.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset is not supported when listing by prefix, delimiter or start after")
+	}
+	startAfter := req.StartAfter
+	if len(pagination.Key) > 0 {
+		startAfter = string(pagination.Key)
+	}
+	limit := pagination.Limit
+	if limit == 0 {
+		limit = types.MaxPaginationLimit
+	}
+
+	start := []byte(req.Prefix)
+	if startAfter != "" && startAfter+"\x00" > req.Prefix {
+		start = []byte(startAfter + "\x00")
+	}
+	var end []byte
+	if req.Prefix != "" {
+		end = storetypes.PrefixEndBytes([]byte(req.Prefix))
+	}
+
+	res := &types.QueryListObjectsResponse{Pagination: &query.PageResponse{}}
+	var (
+		count    uint64 // the entries met so far, the ones after the limit are only counted
+		lastName string
+	)
+	nameStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetObjectByNameKeyOnlyBucketPrefix(req.BucketName))
+	for start != nil && (count <= limit || pagination.CountTotal) {
+		it := nameStore.Iterator(start, end)
+		start = nil
+		for ; it.Valid() && (count <= limit || pagination.CountTotal); it.Next() {
+			name := string(it.Key())
+			if req.Delimiter != "" {
+				if i := strings.Index(name[len(req.Prefix):], req.Delimiter); i >= 0 {
+					commonPrefix := name[:len(req.Prefix)+i+len(req.Delimiter)]
+					if !strings.HasPrefix(startAfter, commonPrefix) {
+						if count++; count <= limit {
+							res.CommonPrefixes = append(res.CommonPrefixes, commonPrefix)
+							lastName = commonPrefix
+						}
+					}
+					// the objects within the common prefix are skipped by seeking past it
+					start = storetypes.PrefixEndBytes([]byte(commonPrefix))
+					break
+				}
+			}
+			if count >= limit {
+				count++
+				continue
+			}
+			objectInfo, found := k.GetObjectInfoById(ctx, k.objectSeq.DecodeSequence(it.Value()))
+			if found {
+				count++
+				res.ObjectInfos = append(res.ObjectInfos, objectInfo)
+				lastName = name
+			}
+		}
+		it.Close()
+	}
+
+	if pagination.CountTotal {
+		res.Pagination.Total = count
+	}
+	if count > limit {
+		res.Pagination.NextKey = []byte(lastName)
+	}
+	return res, nil
+}
+
 func (k Keeper) ListObjectsByBucketId(goCtx context.Context, req *types.QueryListObjectsByBucketIdRequest) (*types.QueryListObjectsResponse, error) { //nolint
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -321,6 +411,43 @@ func (k Keeper) HeadGroupNFT(goCtx context.Context, req *types.QueryNFTRequest)
 	}, nil
 }
 
+func (k Keeper) NFTTokenURI(goCtx context.Context, req *types.QueryNFTTokenURIRequest) (*types.QueryNFTTokenURIResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	nftReq := &types.QueryNFTRequest{TokenId: req.TokenId}
+
+	var metadata *types.ERC721Metadata
+	switch req.ResourceType {
+	case resource.RESOURCE_TYPE_BUCKET:
+		res, err := k.HeadBucketNFT(goCtx, nftReq)
+		if err != nil {
+			return nil, err
+		}
+		metadata = res.MetaData.ToERC721Metadata()
+	case resource.RESOURCE_TYPE_OBJECT:
+		res, err := k.HeadObjectNFT(goCtx, nftReq)
+		if err != nil {
+			return nil, err
+		}
+		metadata = res.MetaData.ToERC721Metadata()
+	case resource.RESOURCE_TYPE_GROUP:
+		res, err := k.HeadGroupNFT(goCtx, nftReq)
+		if err != nil {
+			return nil, err
+		}
+		metadata = res.MetaData.ToERC721Metadata()
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "no NFT of the resource type %s", req.ResourceType)
+	}
+
+	tokenURI, err := metadata.TokenURI()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryNFTTokenURIResponse{TokenUri: tokenURI}, nil
+}
+
 func (k Keeper) QueryLockFee(c context.Context, req *types.QueryLockFeeRequest) (*types.QueryLockFeeResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -518,6 +645,47 @@ func (k Keeper) VerifyPermission(goCtx context.Context, req *types.QueryVerifyPe
 	}, nil
 }
 
+// ExplainPermission evaluates the permission in the same way as VerifyPermission, returning every check it takes.
+func (k Keeper) ExplainPermission(goCtx context.Context, req *types.QueryExplainPermissionRequest) (*types.QueryExplainPermissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromHexUnsafe(req.Operator)
+	if err != nil && err != sdk.ErrEmptyHexAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+
+	if req.BucketName == "" {
+		return nil, errorsmod.Wrapf(errors.ErrInvalidParameter, "No bucket specified")
+	}
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+
+	trace := &permissionTrace{}
+	var effect permtypes.Effect
+	if req.ObjectName == "" {
+		effect = k.verifyBucketPermission(ctx, bucketInfo, operator, req.ActionType, nil, trace)
+	} else {
+		objectInfo, found := k.GetObjectInfo(ctx, req.BucketName, req.ObjectName)
+		if !found {
+			return nil, types.ErrNoSuchObject
+		}
+		effect = k.verifyObjectPermission(ctx, bucketInfo, objectInfo, operator, req.ActionType,
+			objectVerifyOptions(objectInfo), trace)
+	}
+
+	return &types.QueryExplainPermissionResponse{
+		Effect: effect,
+		Steps:  trace.steps,
+	}, nil
+}
+
 func (k Keeper) HeadGroup(goCtx context.Context, req *types.QueryHeadGroupRequest) (*types.QueryHeadGroupResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -707,3 +875,273 @@ func (k Keeper) QueryPaymentAccountBucketFlowRateLimit(goCtx context.Context, re
 		FlowRateLimit: flowRateLimit.FlowRateLimit,
 	}, nil
 }
+
+// QueryPaymentAudit runs the whole payment audit on each page, so it is an expensive query meant for node
+// operators. It refuses to audit more than types.MaxPaymentAuditBuckets buckets, the payment check enabled
+// on the node audits the larger chains.
+func (k Keeper) QueryPaymentAudit(goCtx context.Context, req *types.QueryPaymentAuditRequest) (*types.QueryPaymentAuditResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxPaginationLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "exceed pagination limit %d", types.MaxPaginationLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	discrepancies, err := k.AuditPayment(ctx, types.MaxPaymentAuditBuckets)
+	if errorsmod.IsOf(err, ErrTooManyBucketsToAudit) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	discrepancies, pageRes, err := paginatePaymentDiscrepancies(discrepancies, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPaymentAuditResponse{Discrepancies: discrepancies, Pagination: pageRes}, nil
+}
+
+func (k Keeper) HeadObjectVersion(goCtx context.Context, req *types.QueryHeadObjectVersionRequest) (*types.QueryHeadObjectVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	objectInfo, found := k.GetObjectInfo(ctx, req.BucketName, req.ObjectName)
+	if !found {
+		return nil, types.ErrNoSuchObject
+	}
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+
+	var objectVersion *types.ObjectVersion
+	if objectInfo.Version == req.Version && objectInfo.ObjectStatus == types.OBJECT_STATUS_SEALED {
+		objectVersion = &types.ObjectVersion{
+			Version:             objectInfo.Version,
+			PayloadSize:         objectInfo.PayloadSize,
+			Checksums:           objectInfo.Checksums,
+			ContentType:         objectInfo.ContentType,
+			UpdatedAt:           objectInfo.GetLatestUpdatedTime(),
+			UpdatedBy:           objectInfo.UpdatedBy,
+			LocalVirtualGroupId: objectInfo.LocalVirtualGroupId,
+		}
+	} else {
+		for _, v := range k.GetObjectVersions(ctx, objectInfo.Id) {
+			if v.Version == req.Version {
+				objectVersion = &v
+				break
+			}
+		}
+	}
+	if objectVersion == nil {
+		return nil, types.ErrNoSuchObjectVersion
+	}
+
+	gvg, found := k.GetObjectGVG(ctx, bucketInfo.Id, objectVersion.LocalVirtualGroupId)
+	if !found {
+		return nil, types.ErrInvalidGlobalVirtualGroup.Wrapf("gvg not found. lvg: %d", objectVersion.LocalVirtualGroupId)
+	}
+	return &types.QueryHeadObjectVersionResponse{
+		ObjectVersion:      objectVersion,
+		GlobalVirtualGroup: gvg,
+	}, nil
+}
+
+func (k Keeper) ListObjectVersions(goCtx context.Context, req *types.QueryListObjectVersionsRequest) (*types.QueryListObjectVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	objectInfo, found := k.GetObjectInfo(ctx, req.BucketName, req.ObjectName)
+	if !found {
+		return nil, types.ErrNoSuchObject
+	}
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+
+	return &types.QueryListObjectVersionsResponse{
+		Versions:    k.GetObjectVersions(ctx, objectInfo.Id),
+		MaxVersions: k.GetBucketMaxObjectVersions(ctx, bucketInfo.Id),
+	}, nil
+}
+
+func (k Keeper) ListResourcesByTag(goCtx context.Context, req *types.QueryListResourcesByTagRequest) (*types.QueryListResourcesByTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.TagKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "tag key should not be empty")
+	}
+	if _, ok := resource.ResourceType_name[int32(req.ResourceType)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resource type %d", req.ResourceType)
+	}
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxPaginationLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "exceed pagination limit %d", types.MaxPaginationLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	var resources []*types.TaggedResource
+	u256Seq := sequence.Sequence[math.Uint]{}
+	tagStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTagIndexPrefix(req.TagKey, req.TagValue, req.ResourceType))
+	pageRes, err := query.Paginate(tagStore, req.Pagination, func(key, value []byte) error {
+		// the resource type is a part of the key unless it is in the prefix
+		if req.ResourceType == resource.RESOURCE_TYPE_UNSPECIFIED {
+			key = key[1:]
+		}
+		taggedResource, found := k.getTaggedResource(ctx, resource.ResourceType(value[0]), u256Seq.DecodeSequence(key))
+		if found {
+			resources = append(resources, taggedResource)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryListResourcesByTagResponse{Resources: resources, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BucketReadQuota(goCtx context.Context, req *types.QueryBucketReadQuotaRequest) (*types.QueryBucketReadQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+
+	autoTopup, _ := k.GetBucketReadQuotaAutoTopup(ctx, bucketInfo.Id)
+	consumption, _ := k.GetBucketReadQuotaConsumption(ctx, bucketInfo.Id)
+	return &types.QueryBucketReadQuotaResponse{
+		ChargedReadQuota: bucketInfo.ChargedReadQuota,
+		AutoTopup:        autoTopup,
+		Consumption:      consumption,
+	}, nil
+}
+
+func (k Keeper) BillingStatements(goCtx context.Context, req *types.QueryBillingStatementsRequest) (*types.QueryBillingStatementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	paymentAccount, err := sdk.AccAddressFromHexUnsafe(req.PaymentAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payment address: %s", err)
+	}
+	if req.Pagination != nil && req.Pagination.Limit > types.MaxPaginationLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "exceed pagination limit %d", types.MaxPaginationLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err = query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+	endTime := req.EndTime
+	if endTime == 0 {
+		endTime = ctx.BlockTime().Unix() + 1
+	}
+	if endTime <= req.StartTime {
+		return nil, status.Error(codes.InvalidArgument, "end time should be after start time")
+	}
+	overlaps := func(statement *types.BillingStatement) bool {
+		return statement.StartTime < endTime && statement.EndTime > req.StartTime
+	}
+
+	// the statements are keyed by their end time, so the ones whose last charged second is in the time range are
+	// seeked directly, resuming from the next key of the previous page
+	startKey := sdk.Uint64ToBigEndian(uint64(max(req.StartTime+1, 0)))
+	if req.Pagination != nil && bytes.Compare(req.Pagination.Key, startKey) > 0 {
+		startKey = req.Pagination.Key
+	}
+	limit := uint64(query.DefaultLimit)
+	if req.Pagination != nil && req.Pagination.Limit > 0 {
+		limit = req.Pagination.Limit
+	}
+
+	var statements []types.BillingStatement
+	pageRes := &query.PageResponse{}
+	statementStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBillingStatementPrefix(paymentAccount))
+	iterator := statementStore.Iterator(startKey, sdk.Uint64ToBigEndian(uint64(endTime+1)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(statements)) == limit {
+			pageRes.NextKey = append([]byte{}, iterator.Key()...)
+			break
+		}
+		var statement types.BillingStatement
+		k.cdc.MustUnmarshal(iterator.Value(), &statement)
+		statements = append(statements, statement)
+	}
+
+	openStatements := make([]types.BillingStatement, 0)
+	for _, statement := range k.GetOpenBillingPeriods(ctx, paymentAccount) {
+		if overlaps(&statement) {
+			openStatements = append(openStatements, statement)
+		}
+	}
+	return &types.QueryBillingStatementsResponse{Statements: statements, OpenStatements: openStatements, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BucketBillImpact(goCtx context.Context, req *types.QueryBucketBillImpactRequest) (*types.QueryBucketBillImpactResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+	return k.ProjectBucketBill(ctx, bucketInfo)
+}
+
+func (k Keeper) BucketCallback(goCtx context.Context, req *types.QueryBucketCallbackRequest) (*types.QueryBucketCallbackResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+
+	callback, _ := k.GetBucketCallback(ctx, bucketInfo.Id)
+	return &types.QueryBucketCallbackResponse{Callback: callback}, nil
+}
+
+func (k Keeper) BucketOwnershipTransfer(goCtx context.Context, req *types.QueryBucketOwnershipTransferRequest) (*types.QueryBucketOwnershipTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+
+	transfer, _ := k.GetBucketOwnershipTransfer(ctx, bucketInfo.Id)
+	return &types.QueryBucketOwnershipTransferResponse{Transfer: transfer}, nil
+}
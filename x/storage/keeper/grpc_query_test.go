@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -311,6 +312,85 @@ func TestListObjects(t *testing.T) {
 	require.ErrorContains(t, err, "exceed pagination limit")
 }
 
+func TestListObjectsByPrefix(t *testing.T) {
+	k, ctx := makeKeeper(t)
+	names := []string{"a.txt", "docs/a.md", "docs/b.md", "docs/img/c.png", "logs/1.log", "logs/2.log", "z.txt"}
+	for i, name := range names {
+		k.StoreObjectInfo(ctx, &types.ObjectInfo{
+			Id:         sdkmath.NewUint(uint64(i + 1)),
+			BucketName: "bucket",
+			ObjectName: name,
+		})
+	}
+	objectNames := func(res *types.QueryListObjectsResponse) []string {
+		var names []string
+		for _, objectInfo := range res.ObjectInfos {
+			names = append(names, objectInfo.ObjectName)
+		}
+		return names
+	}
+
+	// the delimiter groups the names into common prefixes
+	res, err := k.ListObjects(ctx, &types.QueryListObjectsRequest{BucketName: "bucket", Delimiter: "/"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a.txt", "z.txt"}, objectNames(res))
+	require.Equal(t, []string{"docs/", "logs/"}, res.CommonPrefixes)
+
+	res, err = k.ListObjects(ctx, &types.QueryListObjectsRequest{BucketName: "bucket", Prefix: "docs/", Delimiter: "/"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"docs/a.md", "docs/b.md"}, objectNames(res))
+	require.Equal(t, []string{"docs/img/"}, res.CommonPrefixes)
+
+	res, err = k.ListObjects(ctx, &types.QueryListObjectsRequest{BucketName: "bucket", Prefix: "logs/", StartAfter: "logs/1.log"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"logs/2.log"}, objectNames(res))
+	require.Empty(t, res.CommonPrefixes)
+
+	// page through the bucket with the next key
+	res, err = k.ListObjects(ctx, &types.QueryListObjectsRequest{
+		BucketName: "bucket",
+		Delimiter:  "/",
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a.txt"}, objectNames(res))
+	require.Equal(t, []string{"docs/"}, res.CommonPrefixes)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+	require.Equal(t, []byte("docs/"), res.Pagination.NextKey)
+
+	res, err = k.ListObjects(ctx, &types.QueryListObjectsRequest{
+		BucketName: "bucket",
+		Delimiter:  "/",
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"z.txt"}, objectNames(res))
+	require.Equal(t, []string{"logs/"}, res.CommonPrefixes)
+	require.Nil(t, res.Pagination.NextKey)
+
+	_, err = k.ListObjects(ctx, &types.QueryListObjectsRequest{
+		BucketName: "bucket",
+		Prefix:     "docs/",
+		Pagination: &query.PageRequest{Reverse: true},
+	})
+	require.ErrorContains(t, err, "reverse is not supported")
+
+	_, err = k.ListObjects(ctx, &types.QueryListObjectsRequest{
+		BucketName: "bucket",
+		Prefix:     "docs/",
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	require.ErrorContains(t, err, "offset is not supported")
+
+	// the deleted objects and the objects of the other buckets are not listed
+	k.DeleteObjectInfo(ctx, &types.ObjectInfo{Id: sdkmath.NewUint(2), BucketName: "bucket", ObjectName: "docs/a.md"})
+	k.StoreObjectInfo(ctx, &types.ObjectInfo{Id: sdkmath.NewUint(8), BucketName: "other", ObjectName: "docs/c.md"})
+	res, err = k.ListObjects(ctx, &types.QueryListObjectsRequest{BucketName: "bucket", Prefix: "docs/", Delimiter: "/"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"docs/b.md"}, objectNames(res))
+	require.Equal(t, []string{"docs/img/"}, res.CommonPrefixes)
+}
+
 func TestListObjectsByBucketId(t *testing.T) {
 	// invalid argument
 	k, ctx := makeKeeper(t)
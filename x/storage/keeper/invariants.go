@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/internal/sequence"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// RegisterInvariants registers all storage invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "lock-balance", LockBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "gvg-stored-size", GVGStoredSizeInvariant(k))
+}
+
+// AllInvariants runs all invariants of the storage module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := LockBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return GVGStoredSizeInvariant(k)(ctx)
+	}
+}
+
+// LockBalanceInvariant checks that the lock balance of every stream record equals the store fee
+// locked by the created or updating objects of the buckets it pays for.
+func LockBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		expected := make(map[string]sdkmath.Int) // payment address -> lock balance
+
+		k.iterateBuckets(ctx, func(bucket *types.BucketInfo) {
+			lockBalance, err := k.getBucketLockBalance(ctx, bucket)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tbucket %s: %s\n", bucket.BucketName, err)
+				return
+			}
+			if !lockBalance.IsPositive() {
+				return
+			}
+			if _, ok := expected[bucket.PaymentAddress]; !ok {
+				expected[bucket.PaymentAddress] = sdkmath.ZeroInt()
+			}
+			expected[bucket.PaymentAddress] = expected[bucket.PaymentAddress].Add(lockBalance)
+		})
+
+		for _, record := range k.paymentKeeper.GetAllStreamRecord(ctx) {
+			expectedLockBalance, ok := expected[record.Account]
+			if !ok {
+				expectedLockBalance = sdkmath.ZeroInt()
+			}
+			delete(expected, record.Account)
+			if !record.LockBalance.Equal(expectedLockBalance) {
+				count++
+				msg += fmt.Sprintf("\t%s lock balance: %s, expected: %s\n", record.Account, record.LockBalance, expectedLockBalance)
+			}
+		}
+		// the left payment addresses lock fees without a stream record
+		for address, expectedLockBalance := range expected {
+			count++
+			msg += fmt.Sprintf("\t%s has no stream record, expected lock balance: %s\n", address, expectedLockBalance)
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "lock balance",
+			fmt.Sprintf("found %d mismatched lock balances\n%s", count, msg)), broken
+	}
+}
+
+// GVGStoredSizeInvariant checks that the stored size of every local virtual group equals the payload
+// size of the sealed objects and of their prior versions on it, and that the stored size of every global virtual group equals the
+// sum of the local virtual groups bound to it.
+func GVGStoredSizeInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		expected := make(map[uint32]uint64) // gvg id -> stored size
+
+		k.iterateBuckets(ctx, func(bucket *types.BucketInfo) {
+			internalBucketInfo, found := k.GetInternalBucketInfo(ctx, bucket.Id)
+			if !found {
+				count++
+				msg += fmt.Sprintf("\tbucket %s has no internal bucket info\n", bucket.BucketName)
+				return
+			}
+
+			objectSize := k.getBucketStoredSizeByLVG(ctx, bucket)
+			for _, lvg := range internalBucketInfo.LocalVirtualGroups {
+				if lvg.StoredSize != objectSize[lvg.Id] {
+					count++
+					msg += fmt.Sprintf("\tbucket %s lvg %d stored size: %d, sealed objects and versions: %d\n",
+						bucket.BucketName, lvg.Id, lvg.StoredSize, objectSize[lvg.Id])
+				}
+				delete(objectSize, lvg.Id)
+				expected[lvg.GlobalVirtualGroupId] += lvg.StoredSize
+			}
+			for lvgID, size := range objectSize {
+				count++
+				msg += fmt.Sprintf("\tbucket %s has %d bytes sealed on unknown lvg %d\n", bucket.BucketName, size, lvgID)
+			}
+		})
+
+		for _, gvg := range k.virtualGroupKeeper.GetAllGVGs(ctx) {
+			if gvg.StoredSize != expected[gvg.Id] {
+				count++
+				msg += fmt.Sprintf("\tgvg %d stored size: %d, bound lvgs: %d\n", gvg.Id, gvg.StoredSize, expected[gvg.Id])
+			}
+			delete(expected, gvg.Id)
+		}
+		for gvgID, size := range expected {
+			count++
+			msg += fmt.Sprintf("\t%d bytes are bound to unknown gvg %d\n", size, gvgID)
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "gvg stored size",
+			fmt.Sprintf("found %d mismatched stored sizes\n%s", count, msg)), broken
+	}
+}
+
+// iterateBuckets calls cb for every bucket in the store.
+func (k Keeper) iterateBuckets(ctx sdk.Context, cb func(bucket *types.BucketInfo)) {
+	bucketStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketByIDPrefix)
+	it := bucketStore.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var bucket types.BucketInfo
+		k.cdc.MustUnmarshal(it.Value(), &bucket)
+		if bucket.Id.IsZero() {
+			continue
+		}
+		cb(&bucket)
+	}
+}
+
+// getBucketStoredSizeByLVG sums up the payload size of the objects sealed in the bucket per local virtual group,
+// along with the prior versions of the objects, which stay stored on their local virtual groups.
+func (k Keeper) getBucketStoredSizeByLVG(ctx sdk.Context, bucket *types.BucketInfo) map[uint32]uint64 {
+	objectPrefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetObjectKeyOnlyBucketPrefix(bucket.BucketName))
+	it := objectPrefixStore.Iterator(nil, nil)
+	defer it.Close()
+
+	storedSize := make(map[uint32]uint64)
+	for ; it.Valid(); it.Next() {
+		u256Seq := sequence.Sequence[sdkmath.Uint]{}
+		objectInfo, found := k.GetObjectInfoById(ctx, u256Seq.DecodeSequence(it.Value()))
+		if !found {
+			continue
+		}
+		for _, version := range k.GetObjectVersions(ctx, objectInfo.Id) {
+			storedSize[version.LocalVirtualGroupId] += version.PayloadSize
+		}
+		// objects which are not sealed yet are not bound to any lvg
+		if objectInfo.LocalVirtualGroupId == 0 {
+			continue
+		}
+		storedSize[objectInfo.LocalVirtualGroupId] += objectInfo.PayloadSize
+	}
+	return storedSize
+}
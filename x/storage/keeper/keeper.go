@@ -163,7 +163,13 @@ func (k Keeper) CreateBucket(
 		return sdkmath.ZeroUint(), errors.Wrap(err, "failed to verify primary sp approval signature")
 	}
 
-	gvgFamily, err := k.virtualGroupKeeper.GetAndCheckGVGFamilyAvailableForNewBucket(ctx, opts.PrimarySpApproval.GlobalVirtualGroupFamilyId)
+	var gvgFamily *virtualgroupmoduletypes.GlobalVirtualGroupFamily
+	if opts.PrimarySpApproval.GlobalVirtualGroupFamilyId == virtualgroupmoduletypes.NoSpecifiedFamilyID {
+		// the primary sp leaves the family to the chain, which picks one of its families by the strategy
+		gvgFamily, err = k.virtualGroupKeeper.PickGVGFamilyForNewBucket(ctx, sp.Id, opts.GVGFamilyStrategy)
+	} else {
+		gvgFamily, err = k.virtualGroupKeeper.GetAndCheckGVGFamilyAvailableForNewBucket(ctx, opts.PrimarySpApproval.GlobalVirtualGroupFamilyId)
+	}
 	if err != nil {
 		return sdkmath.ZeroUint(), err
 	}
@@ -197,6 +203,7 @@ func (k Keeper) CreateBucket(
 	bz := k.cdc.MustMarshal(&bucketInfo)
 	store.Set(bucketKey, k.bucketSeq.EncodeSequence(bucketInfo.Id))
 	store.Set(storagetypes.GetBucketByIDKey(bucketInfo.Id), bz)
+	k.updateTagIndex(ctx, resource.RESOURCE_TYPE_BUCKET, bucketInfo.Id, nil, bucketInfo.Tags)
 	k.SetInternalBucketInfo(ctx, bucketInfo.Id, &internalBucketInfo)
 
 	// emit CreateBucket Event
@@ -292,6 +299,12 @@ func (k Keeper) doDeleteBucket(ctx sdk.Context, operator sdk.AccAddress, bucketI
 	store.Delete(storagetypes.GetQuotaKey(bucketInfo.Id))
 	store.Delete(storagetypes.GetInternalBucketInfoKey(bucketInfo.Id))
 	store.Delete(storagetypes.GetMigrationBucketKey(bucketInfo.Id))
+	store.Delete(storagetypes.GetBucketLifecycleKey(bucketInfo.Id))
+	store.Delete(storagetypes.GetBucketReadQuotaAutoTopupKey(bucketInfo.Id))
+	store.Delete(storagetypes.GetBucketReadQuotaConsumptionKey(bucketInfo.Id))
+	store.Delete(storagetypes.GetBucketCallbackKey(bucketInfo.Id))
+	store.Delete(storagetypes.GetBucketOwnershipTransferKey(bucketInfo.Id))
+	k.updateTagIndex(ctx, resource.RESOURCE_TYPE_BUCKET, bucketInfo.Id, bucketInfo.Tags, nil)
 
 	store.Delete(storagetypes.GetLockedObjectCountKey(bucketInfo.Id))
 
@@ -693,9 +706,11 @@ func (k Keeper) CreateObject(
 		}
 	}
 
-	// verify permission
+	// verify permission, the object has no tags yet, so the tag conditions are evaluated with the bucket tags
 	verifyOpts := &permtypes.VerifyOptions{
-		WantedSize: &payloadSize,
+		WantedSize:  &payloadSize,
+		ObjectName:  objectName,
+		ContentType: opts.ContentType,
 	}
 	effect := k.VerifyBucketPermission(ctx, bucketInfo, creator, permtypes.ACTION_CREATE_OBJECT, verifyOpts)
 	if effect != permtypes.EFFECT_ALLOW {
@@ -766,7 +781,9 @@ func (k Keeper) CreateObject(
 
 	obz := k.cdc.MustMarshal(&objectInfo)
 	store.Set(objectKey, k.objectSeq.EncodeSequence(objectInfo.Id))
+	store.Set(storagetypes.GetObjectByNameKey(objectInfo.BucketName, objectInfo.ObjectName), k.objectSeq.EncodeSequence(objectInfo.Id))
 	store.Set(storagetypes.GetObjectByIDKey(objectInfo.Id), obz)
+	k.updateTagIndex(ctx, resource.RESOURCE_TYPE_OBJECT, objectInfo.Id, nil, objectInfo.Tags)
 
 	if err = ctx.EventManager().EmitTypedEvents(&storagetypes.EventCreateObject{
 		Creator:             creator.String(),
@@ -800,6 +817,7 @@ func (k Keeper) StoreObjectInfo(ctx sdk.Context, objectInfo *storagetypes.Object
 
 	obz := k.cdc.MustMarshal(objectInfo)
 	store.Set(objectKey, k.objectSeq.EncodeSequence(objectInfo.Id))
+	store.Set(storagetypes.GetObjectByNameKey(objectInfo.BucketName, objectInfo.ObjectName), k.objectSeq.EncodeSequence(objectInfo.Id))
 	store.Set(storagetypes.GetObjectByIDKey(objectInfo.Id), obz)
 }
 
@@ -811,9 +829,22 @@ func (k Keeper) DeleteObjectInfo(ctx sdk.Context, objectInfo *storagetypes.Objec
 	objectKey := storagetypes.GetObjectKey(objectInfo.BucketName, objectInfo.ObjectName)
 
 	store.Delete(objectKey)
+	store.Delete(storagetypes.GetObjectByNameKey(objectInfo.BucketName, objectInfo.ObjectName))
 	store.Delete(storagetypes.GetObjectByIDKey(objectInfo.Id))
 }
 
+// indexAllObjectNames adds all objects to the object name index.
+func (k Keeper) indexAllObjectNames(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	it := prefix.NewStore(store, storagetypes.ObjectByIDPrefix).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var objectInfo storagetypes.ObjectInfo
+		k.cdc.MustUnmarshal(it.Value(), &objectInfo)
+		store.Set(storagetypes.GetObjectByNameKey(objectInfo.BucketName, objectInfo.ObjectName), k.objectSeq.EncodeSequence(objectInfo.Id))
+	}
+}
+
 func (k Keeper) SetObjectInfo(ctx sdk.Context, objectInfo *storagetypes.ObjectInfo) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -919,13 +950,7 @@ func (k Keeper) SealObject(
 
 	// an object might be set to OBJECT_STATUS_DISCONTINUED
 	if isUpdate && objectInfo.ObjectStatus == storagetypes.OBJECT_STATUS_SEALED {
-		internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
-		err := k.UnChargeObjectStoreFee(ctx, bucketInfo, internalBucketInfo, objectInfo)
-		if err != nil {
-			return err
-		}
-		k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
-		err = k.DeleteObjectFromVirtualGroup(ctx, bucketInfo, objectInfo)
+		err := k.releaseOrArchiveObjectContent(ctx, bucketInfo, objectInfo)
 		if err != nil {
 			return err
 		}
@@ -1030,7 +1055,7 @@ func (k Keeper) SealObject(
 		return err
 	}
 
-	return nil
+	return k.invokeBucketCallback(ctx, bucketInfo, objectInfo, objectSealedCallback)
 }
 
 func (k Keeper) CancelCreateObject(
@@ -1082,7 +1107,9 @@ func (k Keeper) CancelCreateObject(
 	}
 
 	store.Delete(storagetypes.GetObjectKey(bucketName, objectName))
+	store.Delete(storagetypes.GetObjectByNameKey(bucketName, objectName))
 	store.Delete(storagetypes.GetObjectByIDKey(objectInfo.Id))
+	k.updateTagIndex(ctx, resource.RESOURCE_TYPE_OBJECT, objectInfo.Id, objectInfo.Tags, nil)
 
 	if err := ctx.EventManager().EmitTypedEvents(&storagetypes.EventCancelCreateObject{
 		Operator:    operator.String(),
@@ -1148,7 +1175,7 @@ func (k Keeper) DeleteObject(
 	if err != nil {
 		return err
 	}
-	return nil
+	return k.invokeBucketCallback(ctx, bucketInfo, objectInfo, objectDeletedCallback)
 }
 
 func (k Keeper) doDeleteObject(ctx sdk.Context, operator sdk.AccAddress, bucketInfo *storagetypes.BucketInfo, objectInfo *storagetypes.ObjectInfo, originalStatus storagetypes.ObjectStatus) error {
@@ -1158,7 +1185,9 @@ func (k Keeper) doDeleteObject(ctx sdk.Context, operator sdk.AccAddress, bucketI
 	store.Set(storagetypes.GetBucketByIDKey(bucketInfo.Id), bbz)
 
 	store.Delete(storagetypes.GetObjectKey(bucketInfo.BucketName, objectInfo.ObjectName))
+	store.Delete(storagetypes.GetObjectByNameKey(bucketInfo.BucketName, objectInfo.ObjectName))
 	store.Delete(storagetypes.GetObjectByIDKey(objectInfo.Id))
+	k.updateTagIndex(ctx, resource.RESOURCE_TYPE_OBJECT, objectInfo.Id, objectInfo.Tags, nil)
 
 	// when object was not sealed, the lvg id is 0 by default.
 	if objectInfo.LocalVirtualGroupId != 0 {
@@ -1168,6 +1197,12 @@ func (k Keeper) doDeleteObject(ctx sdk.Context, operator sdk.AccAddress, bucketI
 		}
 	}
 
+	// the object is uncharged by the caller, so its payload must leave the lvg before the prior versions are
+	// released, otherwise releasing the last version on the same lvg finds it uncharged but not empty.
+	if err := k.deleteObjectVersions(ctx, bucketInfo, objectInfo); err != nil {
+		return err
+	}
+
 	err := k.appendResourceIDForGarbageCollection(ctx, resource.RESOURCE_TYPE_OBJECT, objectInfo.Id)
 	if err != nil {
 		return err
@@ -1324,7 +1359,11 @@ func (k Keeper) CopyObject(
 	// above only covers reading the source object.
 	dstWantedSize := srcObjectInfo.PayloadSize
 	dstEffect := k.VerifyBucketPermission(ctx, dstBucketInfo, operator, permtypes.ACTION_CREATE_OBJECT,
-		&permtypes.VerifyOptions{WantedSize: &dstWantedSize})
+		&permtypes.VerifyOptions{
+			WantedSize:  &dstWantedSize,
+			ObjectName:  dstObjectName,
+			ContentType: srcObjectInfo.ContentType,
+		})
 	if dstEffect != permtypes.EFFECT_ALLOW {
 		return sdkmath.ZeroUint(), storagetypes.ErrAccessDenied.Wrapf(
 			"The operator(%s) has no CreateObject permission of the dst bucket(%s)",
@@ -1399,7 +1438,9 @@ func (k Keeper) CopyObject(
 
 	obz := k.cdc.MustMarshal(&objectInfo)
 	store.Set(dstObjectKey, k.objectSeq.EncodeSequence(objectInfo.Id))
+	store.Set(storagetypes.GetObjectByNameKey(dstBucketName, dstObjectName), k.objectSeq.EncodeSequence(objectInfo.Id))
 	store.Set(storagetypes.GetObjectByIDKey(objectInfo.Id), obz)
+	k.updateTagIndex(ctx, resource.RESOURCE_TYPE_OBJECT, objectInfo.Id, nil, objectInfo.Tags)
 
 	if err := ctx.EventManager().EmitTypedEvents(&storagetypes.EventCopyObject{
 		Operator:            operator.String(),
@@ -1460,18 +1501,23 @@ func (k Keeper) RejectSealObject(ctx sdk.Context, operator sdk.AccAddress, bucke
 		bbz := k.cdc.MustMarshal(bucketInfo)
 		store.Set(storagetypes.GetBucketByIDKey(bucketInfo.Id), bbz)
 		store.Delete(storagetypes.GetObjectKey(bucketName, objectName))
+		store.Delete(storagetypes.GetObjectByNameKey(bucketName, objectName))
 		store.Delete(storagetypes.GetObjectByIDKey(objectInfo.Id))
+		k.updateTagIndex(ctx, resource.RESOURCE_TYPE_OBJECT, objectInfo.Id, objectInfo.Tags, nil)
 	}
 
 	k.DecreaseLockedObjectCount(ctx, bucketInfo.Id)
 
-	return ctx.EventManager().EmitTypedEvents(&storagetypes.EventRejectSealObject{
+	if err := ctx.EventManager().EmitTypedEvents(&storagetypes.EventRejectSealObject{
 		Operator:   operator.String(),
 		BucketName: bucketInfo.BucketName,
 		ObjectName: objectInfo.ObjectName,
 		ObjectId:   objectInfo.Id,
 		ForUpdate:  forUpdate,
-	})
+	}); err != nil {
+		return err
+	}
+	return k.invokeBucketCallback(ctx, bucketInfo, objectInfo, objectRejectedCallback)
 }
 
 func (k Keeper) DiscontinueObject(ctx sdk.Context, operator sdk.AccAddress, bucketName string, objectIDs []sdkmath.Uint, reason string) error {
@@ -1605,6 +1651,7 @@ func (k Keeper) CreateGroup(
 	gbz := k.cdc.MustMarshal(&groupInfo)
 	store.Set(groupKey, k.groupSeq.EncodeSequence(groupInfo.Id))
 	store.Set(storagetypes.GetGroupByIDKey(groupInfo.Id), gbz)
+	k.updateTagIndex(ctx, resource.RESOURCE_TYPE_GROUP, groupInfo.Id, nil, groupInfo.Tags)
 
 	if err := ctx.EventManager().EmitTypedEvents(&storagetypes.EventCreateGroup{
 		Owner:      groupInfo.Owner,
@@ -1687,6 +1734,7 @@ func (k Keeper) DeleteGroup(ctx sdk.Context, operator sdk.AccAddress, groupName
 	// Note: Delete group does not require the group is empty. The group member will be deleted by on-chain GC.
 	store.Delete(storagetypes.GetGroupKey(operator, groupName))
 	store.Delete(storagetypes.GetGroupByIDKey(groupInfo.Id))
+	k.updateTagIndex(ctx, resource.RESOURCE_TYPE_GROUP, groupInfo.Id, groupInfo.Tags, nil)
 
 	if err := k.appendResourceIDForGarbageCollection(ctx, resource.RESOURCE_TYPE_GROUP, groupInfo.Id); err != nil {
 		return err
@@ -2535,6 +2583,23 @@ func (k Keeper) DeleteMigrationBucketInfo(ctx sdk.Context, bucketID sdkmath.Uint
 	store.Delete(storagetypes.GetMigrationBucketKey(bucketID))
 }
 
+// GetMigratingBucketIDsBySP returns the ids of the buckets migrating from or to the sp.
+func (k Keeper) GetMigratingBucketIDsBySP(ctx sdk.Context, spID uint32) []sdkmath.Uint {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storagetypes.MigrateBucketPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var bucketIDs []sdkmath.Uint
+	for ; iterator.Valid(); iterator.Next() {
+		var migrationBucketInfo storagetypes.MigrationBucketInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &migrationBucketInfo)
+		if migrationBucketInfo.SrcSpId == spID || migrationBucketInfo.DstSpId == spID {
+			bucketIDs = append(bucketIDs, migrationBucketInfo.BucketId)
+		}
+	}
+	return bucketIDs
+}
+
 func (k Keeper) setQuotaUpdateTime(ctx sdk.Context, bucketID storagetypes.Uint, timestamp uint64) {
 	store := ctx.KVStore(k.storeKey)
 	bz := make([]byte, 8)
@@ -2614,6 +2679,7 @@ func (k Keeper) SetTag(ctx sdk.Context, operator sdk.AccAddress, grn types.GRN,
 				operator.String(), bucketName)
 		}
 
+		k.updateTagIndex(ctx, resource.RESOURCE_TYPE_BUCKET, bucketInfo.Id, bucketInfo.Tags, tags)
 		bucketInfo.Tags = tags
 		bz := k.cdc.MustMarshal(bucketInfo)
 		store.Set(storagetypes.GetBucketByIDKey(bucketInfo.Id), bz)
@@ -2638,6 +2704,7 @@ func (k Keeper) SetTag(ctx sdk.Context, operator sdk.AccAddress, grn types.GRN,
 				operator.String(), bucketName, objectName)
 		}
 
+		k.updateTagIndex(ctx, resource.RESOURCE_TYPE_OBJECT, objectInfo.Id, objectInfo.Tags, tags)
 		objectInfo.Tags = tags
 		obz := k.cdc.MustMarshal(objectInfo)
 		store.Set(storagetypes.GetObjectByIDKey(objectInfo.Id), obz)
@@ -2658,6 +2725,7 @@ func (k Keeper) SetTag(ctx sdk.Context, operator sdk.AccAddress, grn types.GRN,
 				operator.String(), groupInfo.GroupName, groupInfo.Owner)
 		}
 
+		k.updateTagIndex(ctx, resource.RESOURCE_TYPE_GROUP, groupInfo.Id, groupInfo.Tags, tags)
 		groupInfo.Tags = tags
 		gbz := k.cdc.MustMarshal(groupInfo)
 		store.Set(storagetypes.GetGroupByIDKey(groupInfo.Id), gbz)
@@ -2710,7 +2778,11 @@ func (k Keeper) UpdateObjectContent(
 	} else {
 		updater = operator
 	}
-	effect := k.VerifyObjectPermission(ctx, bucketInfo, objectInfo, updater, permtypes.ACTION_UPDATE_OBJECT_CONTENT)
+	// the conditions on the object size and content type apply to the new content
+	conditionOpts := objectVerifyOptions(objectInfo)
+	conditionOpts.WantedSize = &payloadSize
+	conditionOpts.ContentType = opts.ContentType
+	effect := k.verifyObjectPermission(ctx, bucketInfo, objectInfo, updater, permtypes.ACTION_UPDATE_OBJECT_CONTENT, conditionOpts, nil)
 	if effect != permtypes.EFFECT_ALLOW {
 		return storagetypes.ErrAccessDenied.Wrapf(
 			"The updater(%s) has no updateObjectContent permission of the bucket(%s), object(%s)",
@@ -2739,13 +2811,7 @@ func (k Keeper) UpdateObjectContent(
 	nextVersion := objectInfo.Version + 1
 
 	if payloadSize == 0 {
-		internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
-		err := k.UnChargeObjectStoreFee(ctx, bucketInfo, internalBucketInfo, objectInfo)
-		if err != nil {
-			return err
-		}
-		k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
-		err = k.DeleteObjectFromVirtualGroup(ctx, bucketInfo, objectInfo)
+		err := k.releaseOrArchiveObjectContent(ctx, bucketInfo, objectInfo)
 		if err != nil {
 			return err
 		}
@@ -2827,7 +2893,7 @@ func (k Keeper) CancelUpdateObjectContent(
 		return err
 	}
 	updater := sdk.MustAccAddressFromHex(shadowObjectInfo.Operator)
-	owner := sdk.MustAccAddressFromHex(objectInfo.Owner)
+	owner := k.objectOwner(ctx, bucketInfo, objectInfo)
 	if !operator.Equals(owner) && !operator.Equals(updater) {
 		return errors.Wrapf(storagetypes.ErrAccessDenied, "Only allowed owner/updater to do cancel update object")
 	}
@@ -66,6 +66,8 @@ func (s *BurnTestSuite) SetupTest() {
 	paymentKeeper := types.NewMockPaymentKeeper(ctrl)
 	virtualGroupKeeper := types.NewMockVirtualGroupKeeper(ctrl)
 	evmKeeper := types.NewMockEVMKeeper(ctrl)
+	// the payment accounts are never frozen in these tests
+	paymentKeeper.EXPECT().GetFrozenTime(gomock.Any(), gomock.Any()).Return(int64(0)).AnyTimes()
 
 	// cosmos/evm v0.6.0 migration: the production burn path now really routes the
 	// ERC-721 burn through the EVM keeper. The storage keeper's CallEVM packs the
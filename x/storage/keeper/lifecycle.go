@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/mocachain/moca/v2/internal/sequence"
+	permtypes "github.com/mocachain/moca/v2/x/permission/types"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// SetBucketLifecycle replaces the lifecycle rules of the bucket, empty rules remove its lifecycle.
+func (k Keeper) SetBucketLifecycle(ctx sdk.Context, operator sdk.AccAddress, bucketName string, rules []types.LifecycleRule) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if bucketInfo.BucketStatus == types.BUCKET_STATUS_DISCONTINUED {
+		return types.ErrInvalidBucketStatus
+	}
+
+	effect := k.VerifyBucketPermission(ctx, bucketInfo, operator, permtypes.ACTION_UPDATE_BUCKET_INFO, nil)
+	if effect != permtypes.EFFECT_ALLOW {
+		return types.ErrAccessDenied.Wrapf("The operator(%s) has no UpdateBucketInfo permission of the bucket(%s)",
+			operator.String(), bucketName)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if len(rules) == 0 {
+		store.Delete(types.GetBucketLifecycleKey(bucketInfo.Id))
+	} else {
+		store.Set(types.GetBucketLifecycleKey(bucketInfo.Id), k.cdc.MustMarshal(&types.BucketLifecycle{Rules: rules}))
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSetBucketLifecycle{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		BucketId:   bucketInfo.Id,
+		Rules:      rules,
+	})
+}
+
+// GetBucketLifecycle returns the lifecycle rules of the bucket.
+func (k Keeper) GetBucketLifecycle(ctx sdk.Context, bucketID sdkmath.Uint) (*types.BucketLifecycle, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBucketLifecycleKey(bucketID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var lifecycle types.BucketLifecycle
+	k.cdc.MustUnmarshal(bz, &lifecycle)
+	return &lifecycle, true
+}
+
+// ExpireObjectsByLifecycle discontinues up to maxObjectsToExpire objects matched by the expired lifecycle rules of
+// their buckets, and queues them for deletion at the current block time. The objects are then deleted by
+// DeleteDiscontinueObjectsUntil, which unlocks or uncharges their fees like any other discontinued object.
+// Buckets are visited in id order and at most LifecycleScanFactor times maxObjectsToExpire objects are scanned in
+// a block, the scan resumes from the object where the previous block stopped.
+func (k Keeper) ExpireObjectsByLifecycle(ctx sdk.Context, maxObjectsToExpire uint64) (expired uint64, err error) {
+	store := ctx.KVStore(k.storeKey)
+	scanBudget := maxObjectsToExpire * types.LifecycleScanFactor
+	bucketKey, objectKey := k.getLifecycleCursor(ctx)
+
+	blockTime := ctx.BlockTime().Unix()
+	expiredIDs := make([]types.Uint, 0)
+	events := make([]proto.Message, 0)
+	scanned := uint64(0)
+	visitedAll := false
+	for expired < maxObjectsToExpire && scanned < scanBudget {
+		bucketID, lifecycle, found := k.nextBucketLifecycle(ctx, bucketKey)
+		if !found {
+			visitedAll = true
+			break
+		}
+		var seq sequence.Sequence[sdkmath.Uint]
+		if nextBucketKey := seq.EncodeSequence(bucketID); !bytes.Equal(nextBucketKey, bucketKey) {
+			// the cursor bucket is passed or its lifecycle is removed, scan the bucket from its first object
+			bucketKey, objectKey = nextBucketKey, nil
+		}
+		scanned++ // add one for a bucket, so that the buckets without objects are bounded too
+
+		bucketInfo, found := k.GetBucketInfoById(ctx, bucketID)
+		if found && bucketInfo.BucketStatus == types.BUCKET_STATUS_CREATED {
+			var objects []lifecycleExpiredObject
+			var objectScanned uint64
+			objects, objectKey, objectScanned = k.getLifecycleExpiredObjects(ctx, bucketInfo, lifecycle, blockTime,
+				objectKey, maxObjectsToExpire-expired, scanBudget-scanned)
+			scanned += objectScanned
+			for _, objectInfo := range objects {
+				k.saveDiscontinueObjectStatus(ctx, objectInfo.object)
+				objectInfo.object.ObjectStatus = types.OBJECT_STATUS_DISCONTINUED
+				store.Set(types.GetObjectByIDKey(objectInfo.object.Id), k.cdc.MustMarshal(objectInfo.object))
+
+				expiredIDs = append(expiredIDs, objectInfo.object.Id)
+				events = append(events, &types.EventExpireObject{
+					BucketName: bucketInfo.BucketName,
+					ObjectId:   objectInfo.object.Id,
+					RuleId:     objectInfo.ruleID,
+				})
+				expired++
+			}
+		} else {
+			objectKey = nil
+		}
+
+		if objectKey == nil {
+			// the bucket is scanned through, move on to the next one
+			bucketKey = append(bucketKey, 0x00)
+		}
+	}
+	if visitedAll {
+		// all buckets are visited, start over in the next block
+		store.Delete(types.LifecycleCursorKey)
+	} else {
+		k.setLifecycleCursor(ctx, bucketKey, objectKey)
+	}
+
+	if len(expiredIDs) == 0 {
+		return 0, nil
+	}
+	k.AppendDiscontinueObjectIds(ctx, blockTime, expiredIDs)
+	return expired, ctx.EventManager().EmitTypedEvents(events...)
+}
+
+// getLifecycleCursor returns the lifecycle key of the bucket and the object key in the bucket from which the scan
+// resumes, both are nil if the scan starts over.
+func (k Keeper) getLifecycleCursor(ctx sdk.Context) (bucketKey, objectKey []byte) {
+	bz := ctx.KVStore(k.storeKey).Get(types.LifecycleCursorKey)
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	// copy the keys out of the store value, the bucket key is appended to when the bucket is scanned through
+	bucketKeyLen := int(bz[0])
+	bucketKey = append([]byte{}, bz[1:1+bucketKeyLen]...)
+	if len(bz) > 1+bucketKeyLen {
+		objectKey = append([]byte{}, bz[1+bucketKeyLen:]...)
+	}
+	return bucketKey, objectKey
+}
+
+// setLifecycleCursor keeps the lifecycle key of the bucket and the object key in the bucket, the bucket key is
+// prefixed by its length as the bucket ids are not encoded in a fixed length.
+func (k Keeper) setLifecycleCursor(ctx sdk.Context, bucketKey, objectKey []byte) {
+	bz := make([]byte, 0, 1+len(bucketKey)+len(objectKey))
+	bz = append(bz, byte(len(bucketKey)))
+	bz = append(bz, bucketKey...)
+	bz = append(bz, objectKey...)
+	ctx.KVStore(k.storeKey).Set(types.LifecycleCursorKey, bz)
+}
+
+// nextBucketLifecycle returns the first bucket lifecycle from the lifecycle key of the bucket on.
+func (k Keeper) nextBucketLifecycle(ctx sdk.Context, bucketKey []byte) (sdkmath.Uint, types.BucketLifecycle, bool) {
+	lifecycleStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketLifecyclePrefix)
+	it := lifecycleStore.Iterator(bucketKey, nil)
+	defer it.Close()
+
+	var lifecycle types.BucketLifecycle
+	if !it.Valid() {
+		return sdkmath.ZeroUint(), lifecycle, false
+	}
+	k.cdc.MustUnmarshal(it.Value(), &lifecycle)
+	u256Seq := sequence.Sequence[sdkmath.Uint]{}
+	return u256Seq.DecodeSequence(it.Key()), lifecycle, true
+}
+
+type lifecycleExpiredObject struct {
+	object *types.ObjectInfo
+	ruleID string
+}
+
+// getLifecycleExpiredObjects returns up to limit created or sealed objects of the bucket which are expired by any
+// of its lifecycle rules at blockTime, scanning at most budget objects from the start key on. It also returns the
+// key of the next object to scan, nil if the bucket is scanned through, and the number of objects scanned.
+func (k Keeper) getLifecycleExpiredObjects(ctx sdk.Context, bucketInfo *types.BucketInfo, lifecycle types.BucketLifecycle,
+	blockTime int64, start []byte, limit, budget uint64,
+) (objects []lifecycleExpiredObject, next []byte, scanned uint64) {
+	objectPrefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetObjectKeyOnlyBucketPrefix(bucketInfo.BucketName))
+	it := objectPrefixStore.Iterator(start, nil)
+	defer it.Close()
+
+	objects = make([]lifecycleExpiredObject, 0)
+	for ; it.Valid() && uint64(len(objects)) < limit && scanned < budget; it.Next() {
+		scanned++
+		u256Seq := sequence.Sequence[sdkmath.Uint]{}
+		objectInfo, found := k.GetObjectInfoById(ctx, u256Seq.DecodeSequence(it.Value()))
+		if !found {
+			continue
+		}
+		if objectInfo.ObjectStatus != types.OBJECT_STATUS_CREATED && objectInfo.ObjectStatus != types.OBJECT_STATUS_SEALED {
+			continue
+		}
+		for _, rule := range lifecycle.Rules {
+			if rule.Matches(objectInfo) && rule.ExpireAt(objectInfo.CreateAt) <= blockTime {
+				objects = append(objects, lifecycleExpiredObject{object: objectInfo, ruleID: rule.Id})
+				break
+			}
+		}
+	}
+	if it.Valid() {
+		next = append([]byte{}, it.Key()...)
+	}
+	return objects, next, scanned
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func (s *TestSuite) TestExpireObjectsByLifecycle() {
+	owner := sample.RandAccAddress()
+	bucketInfo := &types.BucketInfo{
+		Owner:      owner.String(),
+		BucketName: "lifecycle-bucket",
+		Id:         sdkmath.NewUint(1),
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+
+	blockTime := s.ctx.BlockTime().Unix()
+	objects := []*types.ObjectInfo{
+		{ObjectName: "logs/expired-1", CreateAt: blockTime - 31*86400},
+		{ObjectName: "logs/expired-2", CreateAt: blockTime - 31*86400},
+		{ObjectName: "logs/fresh", CreateAt: blockTime - 29*86400},
+		{ObjectName: "data/untagged", CreateAt: blockTime - 31*86400},
+		{
+			ObjectName: "data/tagged",
+			CreateAt:   blockTime - 2*86400,
+			Tags:       &types.ResourceTags{Tags: []types.ResourceTags_Tag{{Key: "tmp", Value: "true"}}},
+		},
+	}
+	for i, object := range objects {
+		object.Id = sdkmath.NewUint(uint64(i + 1))
+		object.Owner = owner.String()
+		object.BucketName = bucketInfo.BucketName
+		object.ObjectStatus = types.OBJECT_STATUS_SEALED
+		s.storageKeeper.StoreObjectInfo(s.ctx, object)
+	}
+
+	rules := []types.LifecycleRule{
+		{Id: "logs", Prefix: "logs/", ExpirationDays: 30},
+		{Id: "tmp", Tag: &types.ResourceTags_Tag{Key: "tmp", Value: "true"}, ExpirationDays: 1},
+	}
+	// the random operator is granted no account or group policy
+	s.permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyGroupForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+	err := s.storageKeeper.SetBucketLifecycle(s.ctx, sample.RandAccAddress(), bucketInfo.BucketName, rules)
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+	err = s.storageKeeper.SetBucketLifecycle(s.ctx, owner, bucketInfo.BucketName, rules)
+	s.Require().NoError(err)
+
+	// the per-block cap stops the scan after two objects
+	expired, err := s.storageKeeper.ExpireObjectsByLifecycle(s.ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), expired)
+	expired, err = s.storageKeeper.ExpireObjectsByLifecycle(s.ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), expired)
+	expired, err = s.storageKeeper.ExpireObjectsByLifecycle(s.ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), expired)
+
+	for _, object := range objects {
+		objectInfo, found := s.storageKeeper.GetObjectInfoById(s.ctx, object.Id)
+		s.Require().True(found)
+		expectedStatus := types.OBJECT_STATUS_SEALED
+		if object.ObjectName == "logs/expired-1" || object.ObjectName == "logs/expired-2" || object.ObjectName == "data/tagged" {
+			expectedStatus = types.OBJECT_STATUS_DISCONTINUED
+		}
+		s.Require().Equal(expectedStatus, objectInfo.ObjectStatus, fmt.Sprintf("object %s", object.ObjectName))
+	}
+
+	// clearing the rules removes the lifecycle of the bucket
+	err = s.storageKeeper.SetBucketLifecycle(s.ctx, owner, bucketInfo.BucketName, nil)
+	s.Require().NoError(err)
+	_, found := s.storageKeeper.GetBucketLifecycle(s.ctx, bucketInfo.Id)
+	s.Require().False(found)
+}
+
+func (s *TestSuite) TestExpireObjectsByLifecycle_ScanBudget() {
+	owner := sample.RandAccAddress()
+	bucketInfo := &types.BucketInfo{
+		Owner:      owner.String(),
+		BucketName: "lifecycle-budget-bucket",
+		Id:         sdkmath.NewUint(1),
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+
+	objectCount := 25
+	for i := 0; i < objectCount; i++ {
+		s.storageKeeper.StoreObjectInfo(s.ctx, &types.ObjectInfo{
+			Id:           sdkmath.NewUint(uint64(i + 1)),
+			Owner:        owner.String(),
+			BucketName:   bucketInfo.BucketName,
+			ObjectName:   fmt.Sprintf("logs/%d", i),
+			ObjectStatus: types.OBJECT_STATUS_SEALED,
+			CreateAt:     s.ctx.BlockTime().Unix(),
+		})
+	}
+	err := s.storageKeeper.SetBucketLifecycle(s.ctx, owner, bucketInfo.BucketName,
+		[]types.LifecycleRule{{Id: "logs", Prefix: "logs/", ExpirationDays: 30}})
+	s.Require().NoError(err)
+
+	// nothing is expired yet, each block scans the bucket and nine objects and resumes from the next object
+	store := s.ctx.KVStore(s.storeKey)
+	for i := 0; i < 2; i++ {
+		expired, err := s.storageKeeper.ExpireObjectsByLifecycle(s.ctx, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(0), expired)
+		s.Require().NotNil(store.Get(types.LifecycleCursorKey))
+	}
+	expired, err := s.storageKeeper.ExpireObjectsByLifecycle(s.ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), expired)
+	s.Require().Nil(store.Get(types.LifecycleCursorKey))
+
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(31 * 24 * time.Hour))
+	expired, err = s.storageKeeper.ExpireObjectsByLifecycle(ctx, uint64(objectCount))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(objectCount), expired)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateV1toV2 builds the tag index and the object name index from the tags and the objects set before they were
+// introduced, opens the billing periods of the buckets charged before the billing statements were introduced, and
+// enables expiring objects by bucket lifecycle rules, whose param reads zero from the params stored before it was
+// introduced.
+func (m Migrator) MigrateV1toV2(ctx sdk.Context) error {
+	m.keeper.indexAllTags(ctx)
+	m.keeper.indexAllObjectNames(ctx)
+	if err := m.keeper.openAllBillingPeriods(ctx); err != nil {
+		return err
+	}
+
+	params := m.keeper.GetParams(ctx)
+	params.LifecycleExpirationMax = types.DefaultLifecycleExpirationMax
+	store := ctx.KVStore(m.keeper.storeKey)
+	store.Set(types.ParamsKey, m.keeper.cdc.MustMarshal(&params))
+	return nil
+}
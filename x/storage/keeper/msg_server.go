@@ -44,6 +44,7 @@ func (k msgServer) CreateBucket(goCtx context.Context, msg *types.MsgCreateBucke
 		SourceType:        types.SOURCE_TYPE_ORIGIN,
 		PrimarySpApproval: msg.PrimarySpApproval,
 		ApprovalMsgBytes:  msg.GetApprovalBytes(),
+		GVGFamilyStrategy: msg.GvgFamilyStrategy,
 	})
 	if err != nil {
 		return nil, err
@@ -445,9 +446,15 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	previousBaseURL := k.GetParams(ctx).NftMetadataBaseUrl
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
+	if req.Params.NftMetadataBaseUrl != previousBaseURL {
+		if err := k.SetNFTBaseURIs(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	params := k.GetParams(ctx)
 	_ = ctx.EventManager().EmitTypedEvents(&params)
@@ -647,3 +654,112 @@ func (k msgServer) SetBucketFlowRateLimit(goCtx context.Context, msg *types.MsgS
 
 	return &types.MsgSetBucketFlowRateLimitResponse{}, nil
 }
+
+func (k msgServer) SetBucketLifecycle(goCtx context.Context, msg *types.MsgSetBucketLifecycle) (*types.MsgSetBucketLifecycleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.SetBucketLifecycle(ctx, operatorAddr, msg.BucketName, msg.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBucketLifecycleResponse{}, nil
+}
+
+func (k msgServer) SetBucketVersioning(goCtx context.Context, msg *types.MsgSetBucketVersioning) (*types.MsgSetBucketVersioningResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.SetBucketVersioning(ctx, operatorAddr, msg.BucketName, msg.MaxVersions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBucketVersioningResponse{}, nil
+}
+
+func (k msgServer) RestoreObjectVersion(goCtx context.Context, msg *types.MsgRestoreObjectVersion) (*types.MsgRestoreObjectVersionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.RestoreObjectVersion(ctx, operatorAddr, msg.BucketName, msg.ObjectName, msg.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRestoreObjectVersionResponse{}, nil
+}
+
+func (k msgServer) SetBucketReadQuotaAutoTopup(goCtx context.Context, msg *types.MsgSetBucketReadQuotaAutoTopup) (*types.MsgSetBucketReadQuotaAutoTopupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.SetBucketReadQuotaAutoTopup(ctx, operatorAddr, msg.BucketName, msg.AutoTopup)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBucketReadQuotaAutoTopupResponse{}, nil
+}
+
+func (k msgServer) ReportReadQuotaConsumption(goCtx context.Context, msg *types.MsgReportReadQuotaConsumption) (*types.MsgReportReadQuotaConsumptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.ReportReadQuotaConsumption(ctx, operatorAddr, msg.BucketName, msg.ConsumedReadQuota)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReportReadQuotaConsumptionResponse{}, nil
+}
+
+func (k msgServer) SetBucketCallback(goCtx context.Context, msg *types.MsgSetBucketCallback) (*types.MsgSetBucketCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.SetBucketCallback(ctx, operatorAddr, msg.BucketName, msg.Callback)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBucketCallbackResponse{}, nil
+}
+
+func (k msgServer) TransferBucketOwnership(goCtx context.Context, msg *types.MsgTransferBucketOwnership) (*types.MsgTransferBucketOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.Operator)
+
+	var newOwner sdk.AccAddress
+	if msg.NewOwner != "" {
+		newOwner = sdk.MustAccAddressFromHex(msg.NewOwner)
+	}
+
+	err := k.Keeper.TransferBucketOwnership(ctx, operatorAddr, msg.BucketName, newOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferBucketOwnershipResponse{}, nil
+}
+
+func (k msgServer) AcceptBucketOwnership(goCtx context.Context, msg *types.MsgAcceptBucketOwnership) (*types.MsgAcceptBucketOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.AcceptBucketOwnership(ctx, operatorAddr, msg.BucketName, msg.PaymentAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptBucketOwnershipResponse{}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/mocachain/moca/v2/contracts"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// SetNFTBaseURIs points the base uri of the bucket, object and group NFT contracts at the metadata route of the api
+// server set by the nft_metadata_base_url param, so that their tokenURI resolves to the metadata json of the
+// resource. It does nothing while the param is empty.
+func (k Keeper) SetNFTBaseURIs(ctx sdk.Context) error {
+	baseURL := k.GetParams(ctx).NftMetadataBaseUrl
+	if baseURL == "" {
+		return nil
+	}
+
+	for _, nft := range []struct {
+		resourceType             string
+		controlHub, tokenAddress common.Address
+	}{
+		{"bucket", contracts.BucketControlHubAddress, contracts.BucketERC721TokenAddress},
+		{"object", contracts.ObjectControlHubAddress, contracts.ObjectERC721TokenAddress},
+		{"group", contracts.GroupControlHubAddress, contracts.GroupERC721TokenAddress},
+	} {
+		if _, err := k.CallEVM(
+			ctx,
+			contracts.ERC721NonTransferableContract.ABI,
+			nft.controlHub,
+			nft.tokenAddress,
+			true,
+			"setBaseURI",
+			types.NFTBaseURI(baseURL, nft.resourceType),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
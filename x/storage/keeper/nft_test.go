@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/contracts"
+	"github.com/mocachain/moca/v2/x/storage/keeper"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func (s *BurnTestSuite) TestUpdateParams_NFTBaseURIs() {
+	msgServer := keeper.NewMsgServerImpl(*s.storageKeeper)
+	baseURIs := make(map[common.Address]string)
+	s.evmKeeper.EXPECT().CallEVMWithData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ *statedb.StateDB, from common.Address, contract *common.Address, data []byte, _, _ bool, _ *big.Int) (*evmtypes.MsgEthereumTxResponse, error) {
+			method, err := contracts.ERC721NonTransferableContract.ABI.MethodById(data[:4])
+			s.Require().NoError(err)
+			s.Require().Equal("setBaseURI", method.Name)
+			s.Require().Equal(contracts.BucketControlHubAddress, from)
+			args, err := method.Inputs.Unpack(data[4:])
+			s.Require().NoError(err)
+			baseURIs[*contract] = args[0].(string)
+			return &evmtypes.MsgEthereumTxResponse{}, nil
+		}).AnyTimes()
+
+	// the base uri is left as is while the url is not set or not changed
+	params := s.storageKeeper.GetParams(s.ctx)
+	_, err := msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.storageKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	s.Require().Empty(baseURIs)
+
+	params.NftMetadataBaseUrl = "https://api.example.com/"
+	_, err = msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.storageKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(map[common.Address]string{
+		contracts.BucketERC721TokenAddress: "https://api.example.com/moca/storage/nft_metadata/bucket/",
+		contracts.ObjectERC721TokenAddress: "https://api.example.com/moca/storage/nft_metadata/object/",
+		contracts.GroupERC721TokenAddress:  "https://api.example.com/moca/storage/nft_metadata/group/",
+	}, baseURIs)
+
+	baseURIs = make(map[common.Address]string)
+	_, err = msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.storageKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	s.Require().Empty(baseURIs)
+
+	// the url has to be of an api server
+	params.NftMetadataBaseUrl = "ftp://api.example.com"
+	_, err = msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.storageKeeper.GetAuthority(), Params: params})
+	s.Require().Error(err)
+}
@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	permtypes "github.com/mocachain/moca/v2/x/permission/types"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// SetBucketVersioning sets how many prior versions are kept for each object of the bucket, 0 disables versioning.
+// Disabling versioning keeps the existing versions until their objects are deleted.
+func (k Keeper) SetBucketVersioning(ctx sdk.Context, operator sdk.AccAddress, bucketName string, maxVersions uint32) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return err
+	}
+
+	effect := k.VerifyBucketPermission(ctx, bucketInfo, operator, permtypes.ACTION_UPDATE_BUCKET_INFO, nil)
+	if effect != permtypes.EFFECT_ALLOW {
+		return types.ErrAccessDenied.Wrapf("The operator(%s) has no UpdateBucketInfo permission of the bucket(%s)",
+			operator.String(), bucketName)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if maxVersions == 0 {
+		store.Delete(types.GetBucketVersioningKey(bucketInfo.Id))
+	} else {
+		bz := make([]byte, 4)
+		binary.BigEndian.PutUint32(bz, maxVersions)
+		store.Set(types.GetBucketVersioningKey(bucketInfo.Id), bz)
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSetBucketVersioning{
+		Operator:    operator.String(),
+		BucketName:  bucketName,
+		BucketId:    bucketInfo.Id,
+		MaxVersions: maxVersions,
+	})
+}
+
+// GetBucketMaxObjectVersions returns how many prior versions are kept for each object of the bucket.
+func (k Keeper) GetBucketMaxObjectVersions(ctx sdk.Context, bucketID sdkmath.Uint) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBucketVersioningKey(bucketID))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(bz)
+}
+
+// GetObjectVersions returns the prior versions of the object, ordered from the oldest to the latest.
+func (k Keeper) GetObjectVersions(ctx sdk.Context, objectID sdkmath.Uint) []types.ObjectVersion {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetObjectVersionsKey(objectID))
+	if bz == nil {
+		return nil
+	}
+
+	var versions types.ObjectVersions
+	k.cdc.MustUnmarshal(bz, &versions)
+	return versions.Versions
+}
+
+func (k Keeper) setObjectVersions(ctx sdk.Context, objectID sdkmath.Uint, versions []types.ObjectVersion) {
+	store := ctx.KVStore(k.storeKey)
+	if len(versions) == 0 {
+		store.Delete(types.GetObjectVersionsKey(objectID))
+		return
+	}
+	store.Set(types.GetObjectVersionsKey(objectID), k.cdc.MustMarshal(&types.ObjectVersions{Versions: versions}))
+}
+
+// archiveObjectVersion keeps the current content of the sealed object as a prior version before it is replaced.
+// The content stays charged and stored on its local virtual group, so the caller must only release it when
+// versioning is disabled, i.e. when false is returned. The oldest versions beyond the bucket limit are released
+// and dropped.
+func (k Keeper) archiveObjectVersion(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo) (bool, error) {
+	maxVersions := k.GetBucketMaxObjectVersions(ctx, bucketInfo.Id)
+	if maxVersions == 0 {
+		return false, nil
+	}
+
+	versions := append(k.GetObjectVersions(ctx, objectInfo.Id), types.ObjectVersion{
+		Version:             objectInfo.Version,
+		PayloadSize:         objectInfo.PayloadSize,
+		Checksums:           objectInfo.Checksums,
+		ContentType:         objectInfo.ContentType,
+		UpdatedAt:           objectInfo.GetLatestUpdatedTime(),
+		UpdatedBy:           objectInfo.UpdatedBy,
+		LocalVirtualGroupId: objectInfo.LocalVirtualGroupId,
+	})
+	for uint32(len(versions)) > maxVersions {
+		if err := k.releaseObjectVersion(ctx, bucketInfo, objectInfo, versions[0]); err != nil {
+			return false, err
+		}
+		versions = versions[1:]
+	}
+	k.setObjectVersions(ctx, objectInfo.Id, versions)
+	return true, nil
+}
+
+// releaseOrArchiveObjectContent releases the charge and the stored size of the current content of the sealed object
+// which is about to be replaced, unless the content is kept as a prior version.
+func (k Keeper) releaseOrArchiveObjectContent(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo) error {
+	archived, err := k.archiveObjectVersion(ctx, bucketInfo, objectInfo)
+	if err != nil || archived {
+		return err
+	}
+	return k.releaseObjectContent(ctx, bucketInfo, objectInfo)
+}
+
+// deleteObjectVersions releases and drops all prior versions of the object.
+func (k Keeper) deleteObjectVersions(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo) error {
+	for _, version := range k.GetObjectVersions(ctx, objectInfo.Id) {
+		if err := k.releaseObjectVersion(ctx, bucketInfo, objectInfo, version); err != nil {
+			return err
+		}
+	}
+	k.setObjectVersions(ctx, objectInfo.Id, nil)
+	return nil
+}
+
+func (k Keeper) releaseObjectVersion(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo, version types.ObjectVersion) error {
+	return k.releaseObjectContent(ctx, bucketInfo, versionObjectInfo(objectInfo, version))
+}
+
+// releaseObjectContent uncharges the content of the object and removes it from the stored size of its local
+// virtual group, which is deleted once nothing is charged on it.
+func (k Keeper) releaseObjectContent(ctx sdk.Context, bucketInfo *types.BucketInfo, objectInfo *types.ObjectInfo) error {
+	internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+	err := k.UnChargeObjectStoreFee(ctx, bucketInfo, internalBucketInfo, objectInfo)
+	if err != nil {
+		return err
+	}
+	k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
+	return k.DeleteObjectFromVirtualGroup(ctx, bucketInfo, objectInfo)
+}
+
+// versionObjectInfo returns the object with the content of the version, the charge of a version is calculated in
+// the same way as the one of an object.
+func versionObjectInfo(objectInfo *types.ObjectInfo, version types.ObjectVersion) *types.ObjectInfo {
+	return &types.ObjectInfo{
+		Owner:               objectInfo.Owner,
+		BucketName:          objectInfo.BucketName,
+		ObjectName:          objectInfo.ObjectName,
+		Id:                  objectInfo.Id,
+		PayloadSize:         version.PayloadSize,
+		CreateAt:            objectInfo.CreateAt,
+		UpdatedAt:           version.UpdatedAt,
+		LocalVirtualGroupId: version.LocalVirtualGroupId,
+		Checksums:           version.Checksums,
+		ContentType:         version.ContentType,
+		Version:             version.Version,
+	}
+}
+
+// RestoreObjectVersion makes the content of a prior version the current content of the object under a new version.
+// The replaced content is kept as a prior version if the bucket has versioning enabled.
+func (k Keeper) RestoreObjectVersion(ctx sdk.Context, operator sdk.AccAddress, bucketName, objectName string, version int64) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return err
+	}
+	objectInfo, found := k.GetObjectInfo(ctx, bucketName, objectName)
+	if !found {
+		return types.ErrNoSuchObject
+	}
+	if objectInfo.ObjectStatus != types.OBJECT_STATUS_SEALED {
+		return types.ErrUpdateObjectNotAllowed.Wrapf("The object is not sealed yet")
+	}
+	if objectInfo.IsUpdating {
+		return types.ErrObjectIsUpdating.Wrapf("The object is being updated")
+	}
+	effect := k.VerifyObjectPermission(ctx, bucketInfo, objectInfo, operator, permtypes.ACTION_UPDATE_OBJECT_CONTENT)
+	if effect != permtypes.EFFECT_ALLOW {
+		return types.ErrAccessDenied.Wrapf(
+			"The operator(%s) has no updateObjectContent permission of the bucket(%s), object(%s)",
+			operator.String(), bucketName, objectName)
+	}
+
+	versions := k.GetObjectVersions(ctx, objectInfo.Id)
+	index := -1
+	for i, v := range versions {
+		if v.Version == version {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return types.ErrNoSuchObjectVersion.Wrapf("object: %s, version: %d", objectName, version)
+	}
+	restored := versions[index]
+	k.setObjectVersions(ctx, objectInfo.Id, append(versions[:index:index], versions[index+1:]...))
+
+	// replace the current content, the charge and the stored size of the restored version move to the object
+	err := k.releaseOrArchiveObjectContent(ctx, bucketInfo, objectInfo)
+	if err != nil {
+		return err
+	}
+
+	// the updated_at of the restored version is kept, so that the object is uncharged with the same charge size
+	newVersion := objectInfo.Version + 1
+	objectInfo.Version = newVersion
+	objectInfo.PayloadSize = restored.PayloadSize
+	objectInfo.Checksums = restored.Checksums
+	objectInfo.ContentType = restored.ContentType
+	objectInfo.UpdatedAt = restored.UpdatedAt
+	objectInfo.UpdatedBy = operator.String()
+	objectInfo.LocalVirtualGroupId = restored.LocalVirtualGroupId
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetObjectByIDKey(objectInfo.Id), k.cdc.MustMarshal(objectInfo))
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventRestoreObjectVersion{
+		Operator:        operator.String(),
+		BucketName:      bucketName,
+		ObjectName:      objectName,
+		ObjectId:        objectInfo.Id,
+		RestoredVersion: version,
+		Version:         newVersion,
+	})
+}
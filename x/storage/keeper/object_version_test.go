@@ -0,0 +1,334 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/cometbft/cometbft/votepool"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	paymenttypes "github.com/mocachain/moca/v2/x/payment/types"
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/storage/keeper"
+	"github.com/mocachain/moca/v2/x/storage/types"
+	virtualgroupmoduletypes "github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+// versionedObject is a sealed object in a bucket which keeps prior versions of its objects. The gvgs of the bucket
+// family are kept in memory, so that their stored sizes can be checked.
+type versionedObject struct {
+	owner      sdk.AccAddress
+	bucketInfo *types.BucketInfo
+	objectInfo *types.ObjectInfo
+	gvgs       map[uint32]*virtualgroupmoduletypes.GlobalVirtualGroup
+	sealAddr   sdk.AccAddress
+	blsKey     *bls.PrivateKey
+}
+
+// setupVersionedObject stores a sealed object of the payload size on the lvg 1 of a bucket keeping maxVersions prior
+// versions. The objects are charged by their payload size and sealed on gvgs with a single secondary sp.
+func (s *TestSuite) setupVersionedObject(maxVersions uint32, payloadSize uint64) *versionedObject {
+	// the versioned params are looked up strictly before the given time, so the object is created after them
+	createTime := s.ctx.BlockTime().Unix() + 1
+	err := s.storageKeeper.SetVersionedParamsWithTS(s.ctx, types.VersionedParams{
+		MaxSegmentSize:          1,
+		RedundantDataChunkNum:   1,
+		RedundantParityChunkNum: 0,
+		MinChargeSize:           0,
+	})
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(10 * time.Second))
+
+	blsKey, err := bls.GenerateBlsKey()
+	s.Require().NoError(err)
+	v := &versionedObject{
+		owner:    sample.RandAccAddress(),
+		sealAddr: sample.RandAccAddress(),
+		blsKey:   blsKey,
+		gvgs: map[uint32]*virtualgroupmoduletypes.GlobalVirtualGroup{
+			1: {Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2}, StoredSize: payloadSize},
+			2: {Id: 2, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2}},
+		},
+	}
+	v.bucketInfo = &types.BucketInfo{
+		Owner:                      v.owner.String(),
+		BucketName:                 "versioned-bucket",
+		Id:                         sdkmath.NewUint(1),
+		GlobalVirtualGroupFamilyId: 1,
+		PaymentAddress:             v.owner.String(),
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, v.bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, v.bucketInfo.Id, &types.InternalBucketInfo{
+		TotalChargeSize: payloadSize,
+		PriceTime:       createTime,
+		LocalVirtualGroups: []*types.LocalVirtualGroup{
+			{Id: 1, GlobalVirtualGroupId: 1, TotalChargeSize: payloadSize, StoredSize: payloadSize},
+		},
+	})
+	v.objectInfo = &types.ObjectInfo{
+		Id:                  sdkmath.NewUint(1),
+		Owner:               v.owner.String(),
+		BucketName:          v.bucketInfo.BucketName,
+		ObjectName:          "versioned-object",
+		PayloadSize:         payloadSize,
+		ObjectStatus:        types.OBJECT_STATUS_SEALED,
+		CreateAt:            createTime,
+		UpdatedAt:           createTime,
+		LocalVirtualGroupId: 1,
+		Checksums:           [][]byte{sample.Checksum()},
+		Version:             1,
+	}
+	s.storageKeeper.StoreObjectInfo(s.ctx, v.objectInfo)
+
+	primarySp := &sptypes.StorageProvider{Id: 1, Status: sptypes.STATUS_IN_SERVICE, OperatorAddress: sample.RandAccAddressHex()}
+	secondarySp := &sptypes.StorageProvider{Id: 2, Status: sptypes.STATUS_IN_SERVICE, BlsKey: blsKey.PublicKey().Marshal()}
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), uint32(1)).Return(primarySp, true).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), uint32(2)).Return(secondarySp, true).AnyTimes()
+	s.spKeeper.EXPECT().MustGetStorageProvider(gomock.Any(), uint32(1)).Return(primarySp).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProviderBySealAddr(gomock.Any(), v.sealAddr).Return(primarySp, true).AnyTimes()
+	price := sptypes.GlobalSpStorePrice{
+		PrimaryStorePrice:   sdkmath.LegacyNewDec(1),
+		SecondaryStorePrice: sdkmath.LegacyNewDec(1),
+		ReadPrice:           sdkmath.LegacyNewDec(1),
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(price, nil).AnyTimes()
+
+	family := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
+		Id:                    1,
+		PrimarySpId:           1,
+		GlobalVirtualGroupIds: []uint32{1, 2},
+		VirtualPaymentAddress: sample.RandAccAddressHex(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), uint32(1)).Return(family, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, id uint32) (*virtualgroupmoduletypes.GlobalVirtualGroup, bool) {
+			gvg, found := v.gvgs[id]
+			return gvg, found
+		}).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGlobalVirtualGroupIfAvailable(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, id uint32, _ uint64) (*virtualgroupmoduletypes.GlobalVirtualGroup, error) {
+			return v.gvgs[id], nil
+		}).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().SetGVGAndEmitUpdateEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(paymenttypes.VersionedParams{ValidatorTaxRate: sdkmath.LegacyZeroDec()}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.paymentKeeper.EXPECT().MergeOutFlows(gomock.Any()).Return([]paymenttypes.OutFlow{}).AnyTimes()
+	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().UpdateStreamRecordByAddr(gomock.Any(), gomock.Any()).
+		Return(&paymenttypes.StreamRecord{StaticBalance: sdkmath.NewInt(1e18)}, nil).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyGroupForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+
+	s.Require().NoError(s.storageKeeper.SetBucketVersioning(s.ctx, v.owner, v.bucketInfo.BucketName, maxVersions))
+	return v
+}
+
+// updateAndSeal replaces the content of the object with a new one of the payload size, sealed on the gvg.
+func (s *TestSuite) updateAndSeal(v *versionedObject, payloadSize uint64, gvgID uint32) {
+	checksums := [][]byte{sample.Checksum()}
+	err := s.storageKeeper.UpdateObjectContent(s.ctx, v.owner, v.bucketInfo.BucketName, v.objectInfo.ObjectName,
+		payloadSize, types.UpdateObjectOptions{Checksums: checksums})
+	s.Require().NoError(err)
+
+	signHash := types.NewSecondarySpSealObjectSignDoc(s.ctx.ChainID(), gvgID, v.objectInfo.Id,
+		types.GenerateHash(checksums)).GetBlsSignHash()
+	sig, err := v.blsKey.Sign(signHash[:], votepool.DST)
+	s.Require().NoError(err)
+	sigBz, err := sig.Marshal()
+	s.Require().NoError(err)
+	err = s.storageKeeper.SealObject(s.ctx, v.sealAddr, v.bucketInfo.BucketName, v.objectInfo.ObjectName,
+		keeper.SealObjectOptions{GlobalVirtualGroupID: gvgID, SecondarySpBlsSignatures: sigBz})
+	s.Require().NoError(err)
+}
+
+// requireStoredSize checks the total charge size of the bucket, and the charge and stored size of its lvgs.
+func (s *TestSuite) requireStoredSize(v *versionedObject, totalChargeSize uint64, lvgs map[uint32]uint64) {
+	internalBucketInfo := s.storageKeeper.MustGetInternalBucketInfo(s.ctx, v.bucketInfo.Id)
+	s.Require().Equal(totalChargeSize, internalBucketInfo.TotalChargeSize)
+	s.Require().Len(internalBucketInfo.LocalVirtualGroups, len(lvgs))
+	for id, size := range lvgs {
+		lvg, found := internalBucketInfo.GetLVG(id)
+		s.Require().True(found, "lvg %d", id)
+		s.Require().Equal(size, lvg.TotalChargeSize, "charge size of lvg %d", id)
+		s.Require().Equal(size, lvg.StoredSize, "stored size of lvg %d", id)
+	}
+}
+
+func (s *TestSuite) TestSetBucketVersioning() {
+	owner := sample.RandAccAddress()
+	bucketInfo := &types.BucketInfo{
+		Owner:      owner.String(),
+		BucketName: "versioning-bucket",
+		Id:         sdkmath.NewUint(1),
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	objectInfo := &types.ObjectInfo{
+		Id:           sdkmath.NewUint(1),
+		Owner:        owner.String(),
+		BucketName:   bucketInfo.BucketName,
+		ObjectName:   "versioning-object",
+		ObjectStatus: types.OBJECT_STATUS_SEALED,
+		Version:      2,
+	}
+	s.storageKeeper.StoreObjectInfo(s.ctx, objectInfo)
+
+	// the random operator is granted no account or group policy
+	s.permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyGroupForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+	err := s.storageKeeper.SetBucketVersioning(s.ctx, sample.RandAccAddress(), bucketInfo.BucketName, 3)
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+	err = s.storageKeeper.SetBucketVersioning(s.ctx, owner, bucketInfo.BucketName, 3)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(3), s.storageKeeper.GetBucketMaxObjectVersions(s.ctx, bucketInfo.Id))
+
+	res, err := s.queryClient.ListObjectVersions(s.ctx, &types.QueryListObjectVersionsRequest{
+		BucketName: bucketInfo.BucketName,
+		ObjectName: objectInfo.ObjectName,
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Versions)
+	s.Require().Equal(uint32(3), res.MaxVersions)
+
+	// no prior version is kept yet
+	_, err = s.queryClient.HeadObjectVersion(s.ctx, &types.QueryHeadObjectVersionRequest{
+		BucketName: bucketInfo.BucketName,
+		ObjectName: objectInfo.ObjectName,
+		Version:    1,
+	})
+	s.Require().ErrorIs(err, types.ErrNoSuchObjectVersion)
+	err = s.storageKeeper.RestoreObjectVersion(s.ctx, owner, bucketInfo.BucketName, objectInfo.ObjectName, 1)
+	s.Require().ErrorIs(err, types.ErrNoSuchObjectVersion)
+
+	err = s.storageKeeper.SetBucketVersioning(s.ctx, owner, bucketInfo.BucketName, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), s.storageKeeper.GetBucketMaxObjectVersions(s.ctx, bucketInfo.Id))
+}
+
+func (s *TestSuite) TestDeleteObjectWithVersions() {
+	v := s.setupVersionedObject(3, 100)
+	// the prior version shares the lvg 1 of the object
+	s.updateAndSeal(v, 200, 1)
+	s.Require().Len(s.storageKeeper.GetObjectVersions(s.ctx, v.objectInfo.Id), 1)
+	s.requireStoredSize(v, 300, map[uint32]uint64{1: 300})
+
+	err := s.storageKeeper.DeleteObject(s.ctx, v.owner, v.bucketInfo.BucketName, v.objectInfo.ObjectName,
+		types.DeleteObjectOptions{SourceType: types.SOURCE_TYPE_ORIGIN})
+	s.Require().NoError(err)
+	s.requireStoredSize(v, 0, nil)
+	s.Require().Equal(uint64(0), v.gvgs[1].StoredSize)
+	s.Require().Empty(s.storageKeeper.GetObjectVersions(s.ctx, v.objectInfo.Id))
+}
+
+func (s *TestSuite) TestArchiveObjectVersionOnEmptyUpdate() {
+	v := s.setupVersionedObject(3, 100)
+	err := s.storageKeeper.UpdateObjectContent(s.ctx, v.owner, v.bucketInfo.BucketName, v.objectInfo.ObjectName, 0,
+		types.UpdateObjectOptions{})
+	s.Require().NoError(err)
+
+	objectInfo, found := s.storageKeeper.GetObjectInfo(s.ctx, v.bucketInfo.BucketName, v.objectInfo.ObjectName)
+	s.Require().True(found)
+	s.Require().Equal(int64(2), objectInfo.Version)
+	s.Require().Equal(uint64(0), objectInfo.PayloadSize)
+	versions := s.storageKeeper.GetObjectVersions(s.ctx, v.objectInfo.Id)
+	s.Require().Len(versions, 1)
+	s.Require().Equal(int64(1), versions[0].Version)
+	s.Require().Equal(uint64(100), versions[0].PayloadSize)
+	s.Require().Equal(v.objectInfo.Checksums, versions[0].Checksums)
+
+	// the prior version stays charged and stored
+	s.requireStoredSize(v, 100, map[uint32]uint64{1: 100})
+	s.Require().Equal(uint64(100), v.gvgs[1].StoredSize)
+}
+
+func (s *TestSuite) TestArchiveObjectVersionOnSeal() {
+	v := s.setupVersionedObject(3, 100)
+	err := s.storageKeeper.UpdateObjectContent(s.ctx, v.owner, v.bucketInfo.BucketName, v.objectInfo.ObjectName, 200,
+		types.UpdateObjectOptions{Checksums: [][]byte{sample.Checksum()}})
+	s.Require().NoError(err)
+	// the content is only replaced once the update is sealed
+	s.Require().Empty(s.storageKeeper.GetObjectVersions(s.ctx, v.objectInfo.Id))
+	err = s.storageKeeper.CancelUpdateObjectContent(s.ctx, v.owner, v.bucketInfo.BucketName, v.objectInfo.ObjectName)
+	s.Require().NoError(err)
+
+	s.updateAndSeal(v, 200, 2)
+	objectInfo, found := s.storageKeeper.GetObjectInfo(s.ctx, v.bucketInfo.BucketName, v.objectInfo.ObjectName)
+	s.Require().True(found)
+	s.Require().Equal(int64(2), objectInfo.Version)
+	s.Require().Equal(uint64(200), objectInfo.PayloadSize)
+	s.Require().Equal(uint32(2), objectInfo.LocalVirtualGroupId)
+	versions := s.storageKeeper.GetObjectVersions(s.ctx, v.objectInfo.Id)
+	s.Require().Len(versions, 1)
+	s.Require().Equal(int64(1), versions[0].Version)
+	s.Require().Equal(uint32(1), versions[0].LocalVirtualGroupId)
+
+	s.requireStoredSize(v, 300, map[uint32]uint64{1: 100, 2: 200})
+	s.Require().Equal(uint64(100), v.gvgs[1].StoredSize)
+	s.Require().Equal(uint64(200), v.gvgs[2].StoredSize)
+}
+
+func (s *TestSuite) TestSealObjectWithoutVersioning() {
+	v := s.setupVersionedObject(0, 100)
+	s.updateAndSeal(v, 200, 1)
+
+	s.Require().Empty(s.storageKeeper.GetObjectVersions(s.ctx, v.objectInfo.Id))
+	s.requireStoredSize(v, 200, map[uint32]uint64{1: 200})
+	s.Require().Equal(uint64(200), v.gvgs[1].StoredSize)
+}
+
+func (s *TestSuite) TestPruneObjectVersions() {
+	v := s.setupVersionedObject(1, 100)
+	s.updateAndSeal(v, 200, 2)
+	s.requireStoredSize(v, 300, map[uint32]uint64{1: 100, 2: 200})
+
+	// the version 1 is beyond the bucket limit, it is released along with its lvg
+	s.updateAndSeal(v, 300, 2)
+	versions := s.storageKeeper.GetObjectVersions(s.ctx, v.objectInfo.Id)
+	s.Require().Len(versions, 1)
+	s.Require().Equal(int64(2), versions[0].Version)
+	s.Require().Equal(uint64(200), versions[0].PayloadSize)
+
+	s.requireStoredSize(v, 500, map[uint32]uint64{2: 500})
+	s.Require().Equal(uint64(0), v.gvgs[1].StoredSize)
+	s.Require().Equal(uint64(500), v.gvgs[2].StoredSize)
+}
+
+func (s *TestSuite) TestRestoreObjectVersion() {
+	v := s.setupVersionedObject(2, 100)
+	s.updateAndSeal(v, 200, 2)
+
+	err := s.storageKeeper.RestoreObjectVersion(s.ctx, sample.RandAccAddress(), v.bucketInfo.BucketName,
+		v.objectInfo.ObjectName, 1)
+	s.Require().ErrorIs(err, types.ErrAccessDenied)
+
+	// the restored version moves to the object, the replaced content is kept as a version
+	err = s.storageKeeper.RestoreObjectVersion(s.ctx, v.owner, v.bucketInfo.BucketName, v.objectInfo.ObjectName, 1)
+	s.Require().NoError(err)
+	objectInfo, found := s.storageKeeper.GetObjectInfo(s.ctx, v.bucketInfo.BucketName, v.objectInfo.ObjectName)
+	s.Require().True(found)
+	s.Require().Equal(int64(3), objectInfo.Version)
+	s.Require().Equal(uint64(100), objectInfo.PayloadSize)
+	s.Require().Equal(v.objectInfo.Checksums, objectInfo.Checksums)
+	s.Require().Equal(uint32(1), objectInfo.LocalVirtualGroupId)
+	versions := s.storageKeeper.GetObjectVersions(s.ctx, v.objectInfo.Id)
+	s.Require().Len(versions, 1)
+	s.Require().Equal(int64(2), versions[0].Version)
+	s.requireStoredSize(v, 300, map[uint32]uint64{1: 100, 2: 200})
+
+	// without versioning the replaced content is released along with its lvg
+	s.Require().NoError(s.storageKeeper.SetBucketVersioning(s.ctx, v.owner, v.bucketInfo.BucketName, 0))
+	err = s.storageKeeper.RestoreObjectVersion(s.ctx, v.owner, v.bucketInfo.BucketName, v.objectInfo.ObjectName, 2)
+	s.Require().NoError(err)
+	objectInfo, found = s.storageKeeper.GetObjectInfo(s.ctx, v.bucketInfo.BucketName, v.objectInfo.ObjectName)
+	s.Require().True(found)
+	s.Require().Equal(int64(4), objectInfo.Version)
+	s.Require().Equal(uint64(200), objectInfo.PayloadSize)
+	s.Require().Empty(s.storageKeeper.GetObjectVersions(s.ctx, v.objectInfo.Id))
+	s.requireStoredSize(v, 200, map[uint32]uint64{2: 200})
+	s.Require().Equal(uint64(0), v.gvgs[1].StoredSize)
+	s.Require().Equal(uint64(200), v.gvgs[2].StoredSize)
+}
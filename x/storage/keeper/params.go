@@ -52,6 +52,11 @@ func (k Keeper) DiscontinueDeletionMax(ctx sdk.Context) (res uint64) {
 	return params.DiscontinueDeletionMax
 }
 
+func (k Keeper) LifecycleExpirationMax(ctx sdk.Context) (res uint64) {
+	params := k.GetParams(ctx)
+	return params.LifecycleExpirationMax
+}
+
 func (k Keeper) MaxSegmentSize(ctx sdk.Context, timestamp int64) (res uint64, err error) {
 	params, err := k.GetVersionedParamsWithTS(ctx, timestamp)
 	if err != nil {
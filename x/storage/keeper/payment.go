@@ -36,7 +36,7 @@ func (k Keeper) ChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.Bu
 		ctx.Logger().Error("charge initial read fee failed", "bucket", bucketInfo.BucketName, "err", err.Error())
 		return err
 	}
-	return nil
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo)
 }
 
 func (k Keeper) UnChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
@@ -45,6 +45,7 @@ func (k Keeper) UnChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.
 	if internalBucketInfo.TotalChargeSize > 0 {
 		return fmt.Errorf("unexpected total store charge size: %s, %d", bucketInfo.BucketName, internalBucketInfo.TotalChargeSize)
 	}
+	k.closeBucketBillingPeriod(ctx, bucketInfo.Id)
 
 	// if the bucket's flow rate limit is set to zero, no need to uncharge, since the bucket is already uncharged
 	if k.IsBucketRateLimited(ctx, bucketInfo.BucketName) {
@@ -376,7 +377,7 @@ func (k Keeper) ChargeViaBucketChange(ctx sdk.Context, bucketInfo *storagetypes.
 		}
 	}
 
-	return nil
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo)
 }
 
 func (k Keeper) ChargeViaObjectChange(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
@@ -403,6 +404,9 @@ func (k Keeper) ChargeViaObjectChange(ctx sdk.Context, bucketInfo *storagetypes.
 			break
 		}
 	}
+	if lvg == nil {
+		return nil, fmt.Errorf("get LVG failed: %d, bucket: %s", objectInfo.LocalVirtualGroupId, bucketInfo.BucketName)
+	}
 
 	gvg, found := k.virtualGroupKeeper.GetGVG(ctx, lvg.GlobalVirtualGroupId)
 	if !found {
@@ -462,6 +466,9 @@ func (k Keeper) ChargeViaObjectChange(ctx sdk.Context, bucketInfo *storagetypes.
 				"object", objectInfo.ObjectName, "err", err.Error())
 			return nil, err
 		}
+		if err = k.recordBucketBill(ctx, bucketInfo, internalBucketInfo); err != nil {
+			return nil, err
+		}
 	}
 
 	// merge outflows for early deletion usage
@@ -512,8 +519,6 @@ func (k Keeper) GetBucketReadStoreBill(ctx sdk.Context, bucketInfo *storagetypes
 		return userFlows, nil
 	}
 
-	// calculate read fee & store fee separately, for precision
-	// calculate read fee
 	gvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
 	if !found {
 		return userFlows, fmt.Errorf("get GVG family failed: %d", bucketInfo.GlobalVirtualGroupFamilyId)
@@ -524,6 +529,23 @@ func (k Keeper) GetBucketReadStoreBill(ctx sdk.Context, bucketInfo *storagetypes
 		return userFlows, fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
 	}
 
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
+	if err != nil {
+		return userFlows, fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
+	}
+	return k.getBucketReadStoreBillByPrice(ctx, bucketInfo, internalBucketInfo, gvgFamily, price, versionedParams)
+}
+
+// getBucketReadStoreBillByPrice calculates the read and store bill of the bucket in the family by the global store
+// price and the versioned params of the payment module.
+func (k Keeper) getBucketReadStoreBillByPrice(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
+	internalBucketInfo *storagetypes.InternalBucketInfo, gvgFamily *vgtypes.GlobalVirtualGroupFamily,
+	price sptypes.GlobalSpStorePrice, versionedParams types.VersionedParams,
+) (userFlows types.UserFlows, err error) {
+	userFlows.From = sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)
+
+	// calculate read fee & store fee separately, for precision
+	// calculate read fee
 	primaryReadFlowRate := price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(bucketInfo.ChargedReadQuota)).TruncateInt()
 	if primaryReadFlowRate.IsPositive() {
 		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
@@ -532,10 +554,6 @@ func (k Keeper) GetBucketReadStoreBill(ctx sdk.Context, bucketInfo *storagetypes
 		})
 	}
 
-	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
-	if err != nil {
-		return userFlows, fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
-	}
 	validatorTaxReadFlowRate := versionedParams.ValidatorTaxRate.MulInt(primaryReadFlowRate).TruncateInt()
 	if validatorTaxReadFlowRate.IsPositive() {
 		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
@@ -576,6 +594,7 @@ func (k Keeper) UnChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storaget
 	if err != nil {
 		return fmt.Errorf("apply user flows list failed: %s %w", bucketInfo.BucketName, err)
 	}
+	k.closeBucketBillingPeriod(ctx, bucketInfo.Id)
 	return nil
 }
 
@@ -602,7 +621,7 @@ func (k Keeper) ChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storagetyp
 	if err != nil {
 		return fmt.Errorf("apply user flows list failed: %s %w", bucketInfo.BucketName, err)
 	}
-	return nil
+	return k.recordBucketBill(ctx, bucketInfo, internalBucketInfo)
 }
 
 func (k Keeper) ApplyBillChanges(ctx sdk.Context, prevFlows, currentFlows *types.UserFlows) error {
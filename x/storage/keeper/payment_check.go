@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"encoding/binary"
+	"sort"
+
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/mocachain/moca/v2/internal/sequence"
 	paymenttypes "github.com/mocachain/moca/v2/x/payment/types"
 	"github.com/mocachain/moca/v2/x/storage/types"
@@ -17,21 +21,47 @@ func InitPaymentCheck(k Keeper, enabled bool, interval uint32) {
 }
 
 // RunPaymentCheck checks the payment data of all buckets and objects.
-// It will compare the lock balance, net flow rate of users and gvg families/gvgs/validator tax pool.
+// It will compare the lock balance, net flow rate of users and gvg families/gvgs/validator tax pool,
+// and log every discrepancy found.
 func (k Keeper) RunPaymentCheck(ctx sdk.Context) error {
 	ctx.Logger().Info("start checking payment data")
 
-	type Detail struct {
-		address string
-		amount  sdkmath.Int
+	discrepancies, err := k.AuditPayment(ctx, 0)
+	if err != nil { // if already has error, do not check the following
+		ctx.Logger().Info("stop checking payment data due to error")
+		return err
+	}
+
+	for _, discrepancy := range discrepancies {
+		ctx.Logger().Error(discrepancy.Reason, "address", discrepancy.Address,
+			"expected", discrepancy.Expected, "actual", discrepancy.Actual)
+		for _, detail := range discrepancy.Buckets {
+			ctx.Logger().Error("payment discrepancy detail", "bucket", detail.BucketName, "amount", detail.Amount)
+		}
+	}
+
+	ctx.Logger().Info("finish checking payment data")
+	if len(discrepancies) > 0 {
+		return errors.Errorf("found %d payment discrepancies", len(discrepancies))
 	}
+	return nil
+}
 
-	lockBalanceMap := make(map[string]sdkmath.Int)         // payment address -> lock balance
-	lockBalanceDetailMap := make(map[string][]Detail)      // payment address -> {bucket name, lock balance}
-	userFlowRateMap := make(map[string]sdkmath.Int)        // payment address -> net flow rate
-	userFlowRateDetailMap := make(map[string][]Detail)     // payment address -> {bucket name, net flow rate}
-	receiverFlowRateMap := make(map[string]sdkmath.Int)    // gvg family/gvg/validator tax pool address -> net flow rate
-	receiverFlowRateDetailMap := make(map[string][]Detail) // gvg family/gvg/validator tax pool address -> {bucket name, net flow rate}
+// ErrTooManyBucketsToAudit is returned by AuditPayment when there are more buckets than it is allowed to scan.
+var ErrTooManyBucketsToAudit = errors.New("too many buckets to audit")
+
+// AuditPayment compares the lock balance and net flow rate of all stream records with the ones expected
+// from the buckets, objects and user streams, and returns the discrepancies ordered by address.
+// An error is returned if the expected payment data of any bucket cannot be calculated.
+// Every bucket, object and stream record is visited, so maxBuckets bounds the buckets scanned and
+// ErrTooManyBucketsToAudit is returned past it; 0 means no bound.
+func (k Keeper) AuditPayment(ctx sdk.Context, maxBuckets uint64) ([]types.PaymentDiscrepancy, error) {
+	lockBalanceMap := make(map[string]sdkmath.Int)                            // payment address -> lock balance
+	lockBalanceDetailMap := make(map[string][]types.BucketPaymentDetail)      // payment address -> {bucket name, lock balance}
+	userFlowRateMap := make(map[string]sdkmath.Int)                           // payment address -> net flow rate
+	userFlowRateDetailMap := make(map[string][]types.BucketPaymentDetail)     // payment address -> {bucket name, net flow rate}
+	receiverFlowRateMap := make(map[string]sdkmath.Int)                       // gvg family/gvg/validator tax pool address -> net flow rate
+	receiverFlowRateDetailMap := make(map[string][]types.BucketPaymentDetail) // gvg family/gvg/validator tax pool address -> {bucket name, net flow rate}
 
 	store := ctx.KVStore(k.storeKey)
 	bucketStore := prefix.NewStore(store, types.BucketByIDPrefix)
@@ -45,7 +75,10 @@ func (k Keeper) RunPaymentCheck(ctx sdk.Context) error {
 		streamRecordMap[record.Account] = record
 	}
 
-	var result error
+	var (
+		result  error
+		scanned uint64
+	)
 
 Exit:
 	for ; bucketIt.Valid(); bucketIt.Next() {
@@ -54,6 +87,10 @@ Exit:
 		if bucket.Id.IsZero() {
 			continue
 		}
+		scanned++
+		if maxBuckets > 0 && scanned > maxBuckets {
+			return nil, errors.Wrapf(ErrTooManyBucketsToAudit, "more than %d buckets", maxBuckets)
+		}
 
 		// get net flow rate
 		internalBucketInfo, found := k.GetInternalBucketInfo(ctx, bucket.Id)
@@ -85,11 +122,11 @@ Exit:
 				_, ok := receiverFlowRateMap[flow.ToAddress]
 				if !ok {
 					receiverFlowRateMap[flow.ToAddress] = sdkmath.ZeroInt()
-					receiverFlowRateDetailMap[flow.ToAddress] = []Detail{}
+					receiverFlowRateDetailMap[flow.ToAddress] = []types.BucketPaymentDetail{}
 				}
 				receiverFlowRateMap[flow.ToAddress] = receiverFlowRateMap[flow.ToAddress].Add(flow.Rate)
 				receiverFlowRateDetailMap[flow.ToAddress] = append(receiverFlowRateDetailMap[flow.ToAddress],
-					Detail{bucket.BucketName, flow.Rate})
+					types.BucketPaymentDetail{BucketName: bucket.BucketName, Amount: flow.Rate})
 			}
 
 			// user payment account
@@ -97,52 +134,18 @@ Exit:
 			_, ok := userFlowRateMap[paymentAddress]
 			if !ok {
 				userFlowRateMap[paymentAddress] = sdkmath.ZeroInt()
-				userFlowRateDetailMap[paymentAddress] = []Detail{}
+				userFlowRateDetailMap[paymentAddress] = []types.BucketPaymentDetail{}
 			}
 			userFlowRateMap[paymentAddress] = userFlowRateMap[paymentAddress].Add(expectedNetFlowRate)
 			userFlowRateDetailMap[paymentAddress] = append(userFlowRateDetailMap[paymentAddress],
-				Detail{bucket.BucketName, expectedNetFlowRate})
+				types.BucketPaymentDetail{BucketName: bucket.BucketName, Amount: expectedNetFlowRate})
 		}
 
 		// get lock balance
-		// Computed in a closure so the per-bucket object iterator is released via
-		// defer on every exit path (including the abort/continue-Exit cases below),
-		// rather than leaking one iterator per bucket until RunPaymentCheck returns.
-		expectedLockBalance, abort := func() (sdkmath.Int, bool) {
-			objectPrefixStore := prefix.NewStore(store, types.GetObjectKeyOnlyBucketPrefix(bucket.BucketName))
-			it := objectPrefixStore.Iterator(nil, nil)
-			defer it.Close()
-
-			expectedLockBalance := sdkmath.ZeroInt()
-			for ; it.Valid(); it.Next() {
-				u256Seq := sequence.Sequence[sdkmath.Uint]{}
-				objectInfo, found := k.GetObjectInfoById(ctx, u256Seq.DecodeSequence(it.Value()))
-				if found && (objectInfo.ObjectStatus == types.OBJECT_STATUS_CREATED || objectInfo.IsUpdating) {
-					priceTime := objectInfo.GetLatestUpdatedTime()
-					payloadSize := objectInfo.PayloadSize
-					if objectInfo.IsUpdating {
-						shadowObject, found := k.GetShadowObjectInfo(ctx, bucket.BucketName, objectInfo.ObjectName)
-						if !found {
-							result = errors.New("shadow object not found")
-							ctx.Logger().Error("shadow object not found", "bucket", bucket.BucketName, "object", objectInfo.ObjectName)
-							return expectedLockBalance, true
-						}
-						priceTime = shadowObject.UpdatedAt
-						payloadSize = shadowObject.PayloadSize
-					}
-
-					lockAmount, _, err := k.GetObjectLockFee(ctx, priceTime, payloadSize)
-					if err != nil {
-						result = errors.New("get object lock fee failed")
-						ctx.Logger().Error("get object lock fee failed", "bucket", bucket.BucketName, "object", objectInfo.ObjectName, "error", err)
-						return expectedLockBalance, true
-					}
-					expectedLockBalance = expectedLockBalance.Add(lockAmount)
-				}
-			}
-			return expectedLockBalance, false
-		}()
-		if abort {
+		expectedLockBalance, err := k.getBucketLockBalance(ctx, &bucket)
+		if err != nil {
+			result = err
+			ctx.Logger().Error("fail to get bucket lock balance", "bucket", bucket.BucketName, "error", err)
 			continue Exit
 		}
 
@@ -150,80 +153,133 @@ Exit:
 			_, ok := lockBalanceMap[bucket.PaymentAddress]
 			if !ok {
 				lockBalanceMap[bucket.PaymentAddress] = sdkmath.ZeroInt()
-				lockBalanceDetailMap[bucket.PaymentAddress] = []Detail{}
+				lockBalanceDetailMap[bucket.PaymentAddress] = []types.BucketPaymentDetail{}
 			}
 			lockBalanceMap[bucket.PaymentAddress] = lockBalanceMap[bucket.PaymentAddress].Add(expectedLockBalance)
 			lockBalanceDetailMap[bucket.PaymentAddress] = append(lockBalanceDetailMap[bucket.PaymentAddress],
-				Detail{bucket.BucketName, expectedLockBalance})
+				types.BucketPaymentDetail{BucketName: bucket.BucketName, Amount: expectedLockBalance})
 		}
 	}
 
-	if result != nil { // if already has error, do not check the following
-		ctx.Logger().Info("stop checking payment data due to error")
-		return result
+	if result != nil {
+		return nil, result
+	}
+
+	// user streams are merged into the out flows of their senders. A recipient which pays for buckets or streams
+	// itself, or is frozen, nets the stream off its own flows, the other recipients are checked as receivers.
+	userStreams := k.paymentKeeper.GetAllUserStream(ctx)
+	for _, stream := range userStreams {
+		_, ok := userFlowRateMap[stream.Sender]
+		if !ok {
+			userFlowRateMap[stream.Sender] = sdkmath.ZeroInt()
+			userFlowRateDetailMap[stream.Sender] = []types.BucketPaymentDetail{}
+		}
+		userFlowRateMap[stream.Sender] = userFlowRateMap[stream.Sender].Sub(stream.Rate)
+		userFlowRateDetailMap[stream.Sender] = append(userFlowRateDetailMap[stream.Sender],
+			types.BucketPaymentDetail{StreamId: stream.Id, Amount: stream.Rate.Neg()})
+	}
+	for _, stream := range userStreams {
+		recipient := stream.Recipient
+		detail := types.BucketPaymentDetail{StreamId: stream.Id, Amount: stream.Rate}
+		streamRecord := streamRecordMap[recipient]
+		if _, isPayer := userFlowRateMap[recipient]; isPayer || streamRecord.OutFlowCount > 0 ||
+			streamRecord.Status == paymenttypes.STREAM_ACCOUNT_STATUS_FROZEN {
+			_, ok := userFlowRateMap[recipient]
+			if !ok {
+				userFlowRateMap[recipient] = sdkmath.ZeroInt()
+				userFlowRateDetailMap[recipient] = []types.BucketPaymentDetail{}
+			}
+			userFlowRateMap[recipient] = userFlowRateMap[recipient].Add(stream.Rate)
+			userFlowRateDetailMap[recipient] = append(userFlowRateDetailMap[recipient], detail)
+			continue
+		}
+		_, ok := receiverFlowRateMap[recipient]
+		if !ok {
+			receiverFlowRateMap[recipient] = sdkmath.ZeroInt()
+			receiverFlowRateDetailMap[recipient] = []types.BucketPaymentDetail{}
+		}
+		receiverFlowRateMap[recipient] = receiverFlowRateMap[recipient].Add(stream.Rate)
+		receiverFlowRateDetailMap[recipient] = append(receiverFlowRateDetailMap[recipient], detail)
+	}
+
+	var discrepancies []types.PaymentDiscrepancy
+	report := func(address string, discrepancyType types.PaymentDiscrepancyType, reason string,
+		expected, actual sdkmath.Int, details []types.BucketPaymentDetail,
+	) {
+		discrepancies = append(discrepancies, types.PaymentDiscrepancy{
+			Address:         address,
+			DiscrepancyType: discrepancyType,
+			Reason:          reason,
+			Expected:        expected,
+			Actual:          actual,
+			Buckets:         details,
+		})
 	}
 
 	// compare lock balance: expected -> actual side
 	for address, expectedLockBalance := range lockBalanceMap {
 		streamRecord, found := streamRecordMap[address]
 		if !found {
-			result = errors.New("comparing lock balance - stream record not found")
-			ctx.Logger().Error("comparing lock balance - stream record not found", "address", address)
-			continue // to print all errors if there are any
+			report(address, types.PAYMENT_DISCREPANCY_TYPE_LOCK_BALANCE, "comparing lock balance - stream record not found",
+				expectedLockBalance, sdkmath.ZeroInt(), lockBalanceDetailMap[address])
+			continue // to report all discrepancies if there are any
 		}
 
 		actualLockBalance := streamRecord.LockBalance
 		if !expectedLockBalance.Equal(actualLockBalance) {
-			result = errors.New("lock balance not equal")
-			ctx.Logger().Error("lock balance not equal", "address", address, "expected", expectedLockBalance, "actual", actualLockBalance)
-			details := lockBalanceDetailMap[address]
-			for _, detail := range details {
-				ctx.Logger().Error("lock balance detail", "bucket", detail.address, "amount", detail.amount)
+			report(address, types.PAYMENT_DISCREPANCY_TYPE_LOCK_BALANCE, "lock balance not equal",
+				expectedLockBalance, actualLockBalance, lockBalanceDetailMap[address])
+		}
+	}
+
+	// collect the frozen out flows, which are not in the net flow rates of their receivers
+	frozenReceiverFlowRateMap := make(map[string]sdkmath.Int)
+	for address := range userFlowRateMap {
+		streamRecord, found := streamRecordMap[address]
+		if !found || streamRecord.Status != paymenttypes.STREAM_ACCOUNT_STATUS_FROZEN {
+			continue
+		}
+
+		// be noted, payment outflows can be in different status even if the stream account is frozen,
+		// for we are force settling a stream account in multiple blocks
+		outFlows := k.paymentKeeper.GetOutFlows(ctx, sdk.MustAccAddressFromHex(address))
+		for _, outFlow := range outFlows {
+			if outFlow.Status == paymenttypes.OUT_FLOW_STATUS_FROZEN {
+				_, ok := frozenReceiverFlowRateMap[outFlow.ToAddress]
+				if !ok {
+					frozenReceiverFlowRateMap[outFlow.ToAddress] = sdkmath.ZeroInt()
+				}
+				frozenReceiverFlowRateMap[outFlow.ToAddress] = frozenReceiverFlowRateMap[outFlow.ToAddress].Add(outFlow.Rate)
 			}
 		}
 	}
 
 	// compare user net flow rate: expected -> actual side
-	frozenReceiverFlowRateMap := make(map[string]sdkmath.Int)
 	for address, expectedNetFlowRate := range userFlowRateMap {
 		streamRecord, found := streamRecordMap[address]
 		if !found {
-			result = errors.New("comparing user net flow rate - stream record not found")
-			ctx.Logger().Error("comparing user net flow rate - stream record not found", "address", address)
-			continue // to print all errors if there are any
+			report(address, types.PAYMENT_DISCREPANCY_TYPE_USER_NET_FLOW_RATE, "comparing user net flow rate - stream record not found",
+				expectedNetFlowRate, sdkmath.ZeroInt(), userFlowRateDetailMap[address])
+			continue // to report all discrepancies if there are any
 		}
 
 		actualNetFlowRate := streamRecord.NetflowRate
 		if streamRecord.Status == paymenttypes.STREAM_ACCOUNT_STATUS_FROZEN {
 			actualNetFlowRate = actualNetFlowRate.Add(streamRecord.FrozenNetflowRate)
-
-			// be noted, payment outflows can be in different status even if the stream account is frozen,
-			// for we are force settling a stream account in multiple blocks
-			outFlows := k.paymentKeeper.GetOutFlows(ctx, sdk.MustAccAddressFromHex(address))
-			for _, outFlow := range outFlows {
-				if outFlow.Status == paymenttypes.OUT_FLOW_STATUS_FROZEN {
-					_, ok := frozenReceiverFlowRateMap[outFlow.ToAddress]
-					if !ok {
-						frozenReceiverFlowRateMap[outFlow.ToAddress] = sdkmath.ZeroInt()
-					}
-					frozenReceiverFlowRateMap[outFlow.ToAddress] = frozenReceiverFlowRateMap[outFlow.ToAddress].Add(outFlow.Rate)
-				}
-			}
+		}
+		// the user streams to the account from the frozen senders
+		if frozenRate, ok := frozenReceiverFlowRateMap[address]; ok {
+			actualNetFlowRate = actualNetFlowRate.Add(frozenRate)
 		}
 
 		if actualNetFlowRate.IsNegative() && streamRecord.OutFlowCount <= 0 {
-			result = errors.New("user net flow rate invalid status or out flow count")
-			ctx.Logger().Error("user net flow rate invalid flow rate or out flow count",
-				"address", address, "status", actualNetFlowRate, "outflow count", streamRecord.OutFlowCount)
+			report(address, types.PAYMENT_DISCREPANCY_TYPE_USER_NET_FLOW_RATE, "user net flow rate invalid out flow count",
+				expectedNetFlowRate, actualNetFlowRate, userFlowRateDetailMap[address])
 		}
 
 		if !expectedNetFlowRate.Equal(actualNetFlowRate) {
-			result = errors.New("user net flow rate not equal")
-			ctx.Logger().Error("user net flow rate not equal", "address", address, "expected", expectedNetFlowRate, "actual", actualNetFlowRate)
-			details := userFlowRateDetailMap[address]
-			for _, detail := range details {
-				ctx.Logger().Error("user net flow rate detail", "bucket", detail.address, "amount", detail.amount)
-			}
+			report(address, types.PAYMENT_DISCREPANCY_TYPE_USER_NET_FLOW_RATE, "user net flow rate not equal",
+				expectedNetFlowRate, actualNetFlowRate, userFlowRateDetailMap[address])
 		}
 	}
 
@@ -231,15 +287,9 @@ Exit:
 	for address, expectedNetFlowRate := range receiverFlowRateMap {
 		streamRecord, found := streamRecordMap[address]
 		if !found {
-			result = errors.New("comparing receiver net flow rate - stream record not found")
-			ctx.Logger().Error("comparing receiver net flow rate - stream record not found", "address", address)
-			continue // to print all errors if there are any
-		}
-
-		if streamRecord.Status == paymenttypes.STREAM_ACCOUNT_STATUS_FROZEN || streamRecord.OutFlowCount > 0 {
-			result = errors.New("receiver net flow rate invalid status or out flow count")
-			ctx.Logger().Error("receiver net flow rate invalid status or out flow count",
-				"address", address, "status", streamRecord.Status, "outflow count", streamRecord.OutFlowCount)
+			report(address, types.PAYMENT_DISCREPANCY_TYPE_RECEIVER_NET_FLOW_RATE, "comparing receiver net flow rate - stream record not found",
+				expectedNetFlowRate, sdkmath.ZeroInt(), receiverFlowRateDetailMap[address])
+			continue // to report all discrepancies if there are any
 		}
 
 		actualNetFlowRate := streamRecord.NetflowRate
@@ -248,13 +298,14 @@ Exit:
 			actualNetFlowRate = actualNetFlowRate.Add(frozenRate)
 		}
 
+		if streamRecord.Status == paymenttypes.STREAM_ACCOUNT_STATUS_FROZEN || streamRecord.OutFlowCount > 0 {
+			report(address, types.PAYMENT_DISCREPANCY_TYPE_RECEIVER_NET_FLOW_RATE, "receiver net flow rate invalid status or out flow count",
+				expectedNetFlowRate, actualNetFlowRate, receiverFlowRateDetailMap[address])
+		}
+
 		if !expectedNetFlowRate.Equal(actualNetFlowRate) {
-			result = errors.New("receiver net flow rate not equal")
-			ctx.Logger().Error("receiver net flow rate not equal", "address", address, "expected", expectedNetFlowRate, "actual", actualNetFlowRate)
-			details := receiverFlowRateDetailMap[address]
-			for _, detail := range details {
-				ctx.Logger().Error("receiver net flow rate detail", "bucket", detail.address, "amount", detail.amount)
-			}
+			report(address, types.PAYMENT_DISCREPANCY_TYPE_RECEIVER_NET_FLOW_RATE, "receiver net flow rate not equal",
+				expectedNetFlowRate, actualNetFlowRate, receiverFlowRateDetailMap[address])
 		}
 	}
 
@@ -265,28 +316,113 @@ Exit:
 		if streamRecord.LockBalance.IsPositive() {
 			_, found := lockBalanceMap[streamRecord.Account]
 			if !found {
-				result = errors.New("the stream record has lock balance which is not expected")
-				ctx.Logger().Error("the stream record has lock balance which is not expected", "address", streamRecord.Account)
+				report(streamRecord.Account, types.PAYMENT_DISCREPANCY_TYPE_LOCK_BALANCE, "the stream record has lock balance which is not expected",
+					sdkmath.ZeroInt(), streamRecord.LockBalance, nil)
 			}
 		}
 
 		if streamRecord.NetflowRate.IsNegative() || streamRecord.FrozenNetflowRate.IsNegative() {
 			_, found := userFlowRateMap[streamRecord.Account]
 			if !found {
-				result = errors.New("the stream record has negative flow rate which is not expected")
-				ctx.Logger().Error("the stream record has negative flow rate which is not expected", "address", streamRecord.Account)
+				report(streamRecord.Account, types.PAYMENT_DISCREPANCY_TYPE_USER_NET_FLOW_RATE, "the stream record has negative flow rate which is not expected",
+					sdkmath.ZeroInt(), streamRecord.NetflowRate.Add(streamRecord.FrozenNetflowRate), nil)
 			}
 		}
 
 		if streamRecord.NetflowRate.IsPositive() {
 			_, found := receiverFlowRateMap[streamRecord.Account]
-			if !found {
-				result = errors.New("the stream record has positive flow rate which is not expected")
-				ctx.Logger().Error("the stream record has positive flow rate which is not expected", "address", streamRecord.Account)
+			if _, isPayer := userFlowRateMap[streamRecord.Account]; !found && !isPayer {
+				report(streamRecord.Account, types.PAYMENT_DISCREPANCY_TYPE_RECEIVER_NET_FLOW_RATE, "the stream record has positive flow rate which is not expected",
+					sdkmath.ZeroInt(), streamRecord.NetflowRate, nil)
 			}
 		}
 	}
 
-	ctx.Logger().Info("finish checking payment data")
-	return result
+	// the maps above are iterated in random order, sort the report to make it deterministic
+	sort.Slice(discrepancies, func(i, j int) bool {
+		if discrepancies[i].Address != discrepancies[j].Address {
+			return discrepancies[i].Address < discrepancies[j].Address
+		}
+		if discrepancies[i].DiscrepancyType != discrepancies[j].DiscrepancyType {
+			return discrepancies[i].DiscrepancyType < discrepancies[j].DiscrepancyType
+		}
+		return discrepancies[i].Reason < discrepancies[j].Reason
+	})
+	return discrepancies, nil
+}
+
+// paginatePaymentDiscrepancies returns the page of the discrepancies selected by the page request.
+// The report is calculated on the fly, so the next key is the big endian index of the next discrepancy
+// instead of a store key.
+func paginatePaymentDiscrepancies(discrepancies []types.PaymentDiscrepancy, pageReq *query.PageRequest) (
+	[]types.PaymentDiscrepancy, *query.PageResponse, error,
+) {
+	var (
+		start      uint64
+		limit      = uint64(types.MaxPaginationLimit)
+		countTotal bool
+	)
+	if pageReq != nil {
+		if len(pageReq.Key) > 0 {
+			if len(pageReq.Key) != 8 {
+				return nil, nil, errors.New("invalid pagination key")
+			}
+			start = binary.BigEndian.Uint64(pageReq.Key)
+		}
+		if pageReq.Limit > 0 {
+			limit = pageReq.Limit
+		}
+		countTotal = pageReq.CountTotal
+	}
+
+	total := uint64(len(discrepancies))
+	pageRes := &query.PageResponse{}
+	if countTotal {
+		pageRes.Total = total
+	}
+	if start >= total {
+		return []types.PaymentDiscrepancy{}, pageRes, nil
+	}
+
+	end := start + limit
+	if end < total {
+		pageRes.NextKey = binary.BigEndian.AppendUint64(nil, end)
+	} else {
+		end = total
+	}
+	return discrepancies[start:end], pageRes, nil
+}
+
+// getBucketLockBalance returns the store fee locked by the objects of the bucket which are
+// either created or updating, i.e. the amount expected in the lock balance of its payment account.
+func (k Keeper) getBucketLockBalance(ctx sdk.Context, bucket *types.BucketInfo) (sdkmath.Int, error) {
+	objectPrefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetObjectKeyOnlyBucketPrefix(bucket.BucketName))
+	it := objectPrefixStore.Iterator(nil, nil)
+	defer it.Close()
+
+	expectedLockBalance := sdkmath.ZeroInt()
+	for ; it.Valid(); it.Next() {
+		u256Seq := sequence.Sequence[sdkmath.Uint]{}
+		objectInfo, found := k.GetObjectInfoById(ctx, u256Seq.DecodeSequence(it.Value()))
+		if !found || (objectInfo.ObjectStatus != types.OBJECT_STATUS_CREATED && !objectInfo.IsUpdating) {
+			continue
+		}
+		priceTime := objectInfo.GetLatestUpdatedTime()
+		payloadSize := objectInfo.PayloadSize
+		if objectInfo.IsUpdating {
+			shadowObject, found := k.GetShadowObjectInfo(ctx, bucket.BucketName, objectInfo.ObjectName)
+			if !found {
+				return expectedLockBalance, errors.Errorf("shadow object not found, object: %s", objectInfo.ObjectName)
+			}
+			priceTime = shadowObject.UpdatedAt
+			payloadSize = shadowObject.PayloadSize
+		}
+
+		lockAmount, _, err := k.GetObjectLockFee(ctx, priceTime, payloadSize)
+		if err != nil {
+			return expectedLockBalance, errors.Wrapf(err, "get object lock fee failed, object: %s", objectInfo.ObjectName)
+		}
+		expectedLockBalance = expectedLockBalance.Add(lockAmount)
+	}
+	return expectedLockBalance, nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -49,6 +50,9 @@ type TestSuite struct {
 	ctx         sdk.Context
 	queryClient types.QueryClient
 	msgServer   types.MsgServer
+
+	// frozenTime is the frozen time of the payment accounts, zero unless a test sets it
+	frozenTime map[string]int64
 }
 
 func (s *TestSuite) SetupTest() {
@@ -92,6 +96,10 @@ func (s *TestSuite) SetupTest() {
 	// return a non-failed response so bucket/object/group create/delete succeed.
 	evmKeeper.EXPECT().CallEVMWithData(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&evmtypes.MsgEthereumTxResponse{}, nil).AnyTimes()
 	evmKeeper.EXPECT().CallEVM(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&evmtypes.MsgEthereumTxResponse{}, nil).AnyTimes()
+	s.frozenTime = make(map[string]int64)
+	paymentKeeper.EXPECT().GetFrozenTime(gomock.Any(), gomock.Any()).DoAndReturn(func(_ sdk.Context, addr sdk.AccAddress) int64 {
+		return s.frozenTime[addr.String()]
+	}).AnyTimes()
 
 	s.cdc = encCfg.Codec
 	s.accountKeeper = accountKeeper
@@ -191,6 +199,8 @@ func (s *TestSuite) TestRunPaymentCheck() {
 	}
 	s.paymentKeeper.EXPECT().GetAllStreamRecord(gomock.Any()).
 		Return([]paymenttypes.StreamRecord{streamRecord}).AnyTimes()
+	s.paymentKeeper.EXPECT().GetAllUserStream(gomock.Any()).
+		Return(nil).AnyTimes()
 
 	err = s.storageKeeper.RunPaymentCheck(s.ctx)
 	s.Require().NoError(err)
@@ -290,6 +300,137 @@ func (s *TestSuite) TestRunPaymentCheck_GetObjectLockFeeError() {
 	s.Require().ErrorContains(err, "get object lock fee failed")
 }
 
+// TestQueryPaymentAudit seeds two buckets whose created objects lock store fee while
+// their stream records lock nothing, and pages through the resulting audit report.
+func (s *TestSuite) TestQueryPaymentAudit() {
+	paymentAddrs := []string{
+		"0x4444444444444444444444444444444444444444",
+		"0x5555555555555555555555555555555555555555",
+	}
+	priceTime := s.ctx.BlockTime().Unix() + 1
+
+	var streamRecords []paymenttypes.StreamRecord
+	for i, paymentAddr := range paymentAddrs {
+		bucketInfo := &types.BucketInfo{
+			Owner:                      paymentAddr,
+			BucketName:                 fmt.Sprintf("audit-bucket-%d", i),
+			Id:                         sdkmath.NewUint(uint64(i + 1)),
+			PaymentAddress:             paymentAddr,
+			GlobalVirtualGroupFamilyId: 1,
+		}
+		s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+		s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{})
+		s.storageKeeper.StoreObjectInfo(s.ctx, &types.ObjectInfo{
+			Id:           sdkmath.NewUint(uint64(i + 1)),
+			Owner:        paymentAddr,
+			BucketName:   bucketInfo.BucketName,
+			ObjectName:   "audit-object",
+			PayloadSize:  1024,
+			ObjectStatus: types.OBJECT_STATUS_CREATED,
+			CreateAt:     priceTime,
+		})
+
+		// the stream records lock nothing, so each bucket shows up as a lock balance discrepancy
+		streamRecord := paymenttypes.NewStreamRecord(sdk.MustAccAddressFromHex(paymentAddr), s.ctx.BlockTime().Unix())
+		streamRecord.FrozenNetflowRate = sdkmath.ZeroInt()
+		streamRecords = append(streamRecords, *streamRecord)
+	}
+
+	price := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdkmath.LegacyZeroDec(),
+		PrimaryStorePrice:   sdkmath.LegacyNewDec(1),
+		SecondaryStorePrice: sdkmath.LegacyZeroDec(),
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(price, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(paymenttypes.VersionedParams{ReserveTime: 100, ValidatorTaxRate: sdkmath.LegacyZeroDec()}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetAllStreamRecord(gomock.Any()).
+		Return(streamRecords).AnyTimes()
+	s.paymentKeeper.EXPECT().GetAllUserStream(gomock.Any()).
+		Return(nil).AnyTimes()
+
+	expectedLockBalance, _, err := s.storageKeeper.GetObjectLockFee(s.ctx, priceTime, 1024)
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.QueryPaymentAudit(s.ctx, &types.QueryPaymentAuditRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Discrepancies, 1)
+	s.Require().NotEmpty(res.Pagination.NextKey)
+
+	discrepancy := res.Discrepancies[0]
+	s.Require().Equal(paymentAddrs[0], discrepancy.Address)
+	s.Require().Equal(types.PAYMENT_DISCREPANCY_TYPE_LOCK_BALANCE, discrepancy.DiscrepancyType)
+	s.Require().Equal(expectedLockBalance, discrepancy.Expected)
+	s.Require().True(discrepancy.Actual.IsZero())
+	s.Require().Equal([]types.BucketPaymentDetail{{BucketName: "audit-bucket-0", Amount: expectedLockBalance}}, discrepancy.Buckets)
+
+	res, err = s.queryClient.QueryPaymentAudit(s.ctx, &types.QueryPaymentAuditRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Discrepancies, 1)
+	s.Require().Equal(paymentAddrs[1], res.Discrepancies[0].Address)
+	s.Require().Empty(res.Pagination.NextKey)
+
+	err = s.storageKeeper.RunPaymentCheck(s.ctx)
+	s.Require().ErrorContains(err, "found 2 payment discrepancies")
+
+	// the audit refuses to scan more buckets than it is allowed to
+	_, err = s.storageKeeper.AuditPayment(s.ctx, 1)
+	s.Require().ErrorIs(err, keeper.ErrTooManyBucketsToAudit)
+	discrepancies, err := s.storageKeeper.AuditPayment(s.ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(discrepancies, 2)
+}
+
+// TestAuditPaymentUserStreams audits the net flow rates made up by user streams only, the recipient which pays
+// for its own stream nets the incoming stream off its out flows.
+func (s *TestSuite) TestAuditPaymentUserStreams() {
+	sender := "0x6666666666666666666666666666666666666666"
+	recipient := "0x7777777777777777777777777777777777777777"
+	relay := "0x8888888888888888888888888888888888888888"
+
+	newStreamRecord := func(addr string, netflowRate int64, outFlowCount uint64) paymenttypes.StreamRecord {
+		streamRecord := paymenttypes.NewStreamRecord(sdk.MustAccAddressFromHex(addr), s.ctx.BlockTime().Unix())
+		streamRecord.NetflowRate = sdkmath.NewInt(netflowRate)
+		streamRecord.FrozenNetflowRate = sdkmath.ZeroInt()
+		streamRecord.OutFlowCount = outFlowCount
+		return *streamRecord
+	}
+	userStreams := []paymenttypes.UserStream{
+		{Id: 1, Sender: sender, Recipient: relay, Rate: sdkmath.NewInt(300)},
+		{Id: 2, Sender: relay, Recipient: recipient, Rate: sdkmath.NewInt(100)},
+	}
+	s.paymentKeeper.EXPECT().GetAllUserStream(gomock.Any()).
+		Return(userStreams).AnyTimes()
+	streamRecords := []paymenttypes.StreamRecord{
+		newStreamRecord(sender, -300, 1),
+		newStreamRecord(relay, 200, 1),
+		newStreamRecord(recipient, 50, 0),
+	}
+	s.paymentKeeper.EXPECT().GetAllStreamRecord(gomock.Any()).
+		Return(streamRecords).AnyTimes()
+
+	// the recipient is credited less than its stream
+	discrepancies, err := s.storageKeeper.AuditPayment(s.ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Len(discrepancies, 1)
+	discrepancy := discrepancies[0]
+	s.Require().Equal(recipient, discrepancy.Address)
+	s.Require().Equal(types.PAYMENT_DISCREPANCY_TYPE_RECEIVER_NET_FLOW_RATE, discrepancy.DiscrepancyType)
+	s.Require().Equal(sdkmath.NewInt(100), discrepancy.Expected)
+	s.Require().Equal(sdkmath.NewInt(50), discrepancy.Actual)
+	s.Require().Equal([]types.BucketPaymentDetail{{StreamId: 2, Amount: sdkmath.NewInt(100)}}, discrepancy.Buckets)
+
+	streamRecords[2].NetflowRate = sdkmath.NewInt(100)
+	discrepancies, err = s.storageKeeper.AuditPayment(s.ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Empty(discrepancies)
+}
+
 func (s *TestSuite) TestGetObjectLockFee() {
 	primarySp := &sptypes.StorageProvider{Status: sptypes.STATUS_IN_SERVICE, Id: 100, OperatorAddress: sample.RandAccAddress().String()}
 	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Eq(primarySp.Id)).
@@ -380,6 +521,34 @@ func (s *TestSuite) TestGetBucketReadBill() {
 	s.Require().Equal(flows.Flows[1].Rate, taxPoolRate)
 }
 
+func (s *TestSuite) TestChargeViaObjectChange_LVGNotFound() {
+	gvgFamily := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
+		Id:                    1,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gomock.Any()).
+		Return(gvgFamily, true).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(sptypes.GlobalSpStorePrice{}, nil).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		BucketName:                 "bucket_name",
+		Id:                         sdkmath.NewUint(1),
+		PaymentAddress:             sample.RandAccAddress().String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+	}
+	internalBucketInfo := &types.InternalBucketInfo{
+		LocalVirtualGroups: []*types.LocalVirtualGroup{{Id: 1, GlobalVirtualGroupId: 1}},
+	}
+	objectInfo := &types.ObjectInfo{
+		BucketName:          bucketInfo.BucketName,
+		ObjectName:          "object_name",
+		LocalVirtualGroupId: 2,
+	}
+	_, err := s.storageKeeper.ChargeViaObjectChange(s.ctx, bucketInfo, internalBucketInfo, objectInfo, 100, false)
+	s.Require().ErrorContains(err, "get LVG failed")
+}
+
 func (s *TestSuite) TestGetBucketReadStoreBill() {
 	gvgFamily := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
 		Id:                    1,
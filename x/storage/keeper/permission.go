@@ -37,23 +37,43 @@ var (
 func (k Keeper) VerifyBucketPermission(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, operator sdk.AccAddress,
 	action permtypes.ActionType, options *permtypes.VerifyOptions,
 ) permtypes.Effect {
+	return k.verifyBucketPermission(ctx, bucketInfo, operator, action, options, nil)
+}
+
+// verifyBucketPermission is VerifyBucketPermission recording its steps into the trace.
+func (k Keeper) verifyBucketPermission(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, operator sdk.AccAddress,
+	action permtypes.ActionType, options *permtypes.VerifyOptions, trace *permissionTrace,
+) permtypes.Effect {
+	trace.setResource(evmtypes.NewBucketGRN(bucketInfo.BucketName).String())
 	// if bucket is public, anyone can read but can not write it.
 	if bucketInfo.Visibility == storagetypes.VISIBILITY_TYPE_PUBLIC_READ && PublicReadBucketAllowedActions[action] {
+		trace.add(traceCheckPublicVisibility, permtypes.EFFECT_ALLOW, "the bucket is public and the action is read-only")
 		return permtypes.EFFECT_ALLOW
 	}
 	// if the operator is empty(may anonymous user), don't need check policy
 	if operator.Empty() {
+		trace.add(traceCheckAnonymous, permtypes.EFFECT_DENY, "the operator is empty")
 		return permtypes.EFFECT_DENY
 	}
 	// The owner has full permissions
 	if operator.Equals(sdk.MustAccAddressFromHex(bucketInfo.Owner)) {
+		trace.add(traceCheckOwner, permtypes.EFFECT_ALLOW, "the operator is the owner of the bucket")
 		return permtypes.EFFECT_ALLOW
 	}
-	// verify policy
-	effect := k.VerifyPolicy(ctx, bucketInfo.Id, gnfdresource.RESOURCE_TYPE_BUCKET, operator, action, options)
+	// verify policy, the statement conditions on tags are evaluated with the bucket tags unless an object is given
+	opts := &permtypes.VerifyOptions{}
+	if options != nil {
+		*opts = *options
+	}
+	if opts.Tags == nil {
+		opts.Tags = tagsToMap(bucketInfo.Tags)
+	}
+	effect := k.verifyPolicy(ctx, bucketInfo.Id, gnfdresource.RESOURCE_TYPE_BUCKET, operator, action, opts, trace)
 	if effect == permtypes.EFFECT_ALLOW {
+		trace.add(traceCheckResult, permtypes.EFFECT_ALLOW, "the bucket policies allow the action")
 		return permtypes.EFFECT_ALLOW
 	}
+	trace.add(traceCheckResult, permtypes.EFFECT_DENY, "the bucket policies do not allow the action")
 	return permtypes.EFFECT_DENY
 }
 
@@ -72,6 +92,17 @@ func (k Keeper) VerifyBucketPermission(ctx sdk.Context, bucketInfo *storagetypes
 func (k Keeper) VerifyObjectPermission(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, objectInfo *storagetypes.ObjectInfo,
 	operator sdk.AccAddress, action permtypes.ActionType,
 ) permtypes.Effect {
+	return k.verifyObjectPermission(ctx, bucketInfo, objectInfo, operator, action, objectVerifyOptions(objectInfo), nil)
+}
+
+// verifyObjectPermission is VerifyObjectPermission with the object described by conditionOpts for the statement
+// conditions, e.g. with the new payload size when the content of the object is updated, recording its steps into
+// the trace.
+func (k Keeper) verifyObjectPermission(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo, objectInfo *storagetypes.ObjectInfo,
+	operator sdk.AccAddress, action permtypes.ActionType, conditionOpts *permtypes.VerifyOptions, trace *permissionTrace,
+) permtypes.Effect {
+	objectGRN := evmtypes.NewObjectGRN(objectInfo.BucketName, objectInfo.ObjectName).String()
+	trace.setResource(objectGRN)
 	// anyone can read but can not write it when the following case: 1) object is public 2) object is inherit, only when bucket is public
 	visibility := false
 	if objectInfo.Visibility == storagetypes.VISIBILITY_TYPE_PUBLIC_READ ||
@@ -79,40 +110,73 @@ func (k Keeper) VerifyObjectPermission(ctx sdk.Context, bucketInfo *storagetypes
 		visibility = true
 	}
 	if visibility && PublicReadObjectAllowedActions[action] {
+		trace.add(traceCheckPublicVisibility, permtypes.EFFECT_ALLOW, "the object is public and the action is read-only")
 		return permtypes.EFFECT_ALLOW
 	}
 
 	// if the operator is empty(may anonymous user), don't need check policy
 	if operator.Empty() {
+		trace.add(traceCheckAnonymous, permtypes.EFFECT_DENY, "the operator is empty")
 		return permtypes.EFFECT_DENY
 	}
 	// The owner has full permissions
-	ownerAcc := sdk.MustAccAddressFromHex(objectInfo.Owner)
+	ownerAcc := k.objectOwner(ctx, bucketInfo, objectInfo)
 	if ownerAcc.Equals(operator) {
+		trace.add(traceCheckOwner, permtypes.EFFECT_ALLOW, "the operator is the owner of the object")
 		return permtypes.EFFECT_ALLOW
 	}
 
 	// verify policy
-	opts := &permtypes.VerifyOptions{
-		Resource: evmtypes.NewObjectGRN(objectInfo.BucketName, objectInfo.ObjectName).String(),
-	}
-	bucketEffect := k.VerifyPolicy(ctx, bucketInfo.Id, gnfdresource.RESOURCE_TYPE_BUCKET, operator, action, opts)
+	opts := *conditionOpts
+	opts.Resource = objectGRN
+	trace.setResource(evmtypes.NewBucketGRN(bucketInfo.BucketName).String())
+	bucketEffect := k.verifyPolicy(ctx, bucketInfo.Id, gnfdresource.RESOURCE_TYPE_BUCKET, operator, action, &opts, trace)
 	if bucketEffect == permtypes.EFFECT_DENY {
+		trace.add(traceCheckResult, permtypes.EFFECT_DENY, "the bucket policies deny the action")
 		return permtypes.EFFECT_DENY
 	}
 
-	objectEffect := k.VerifyPolicy(ctx, objectInfo.Id, gnfdresource.RESOURCE_TYPE_OBJECT, operator, action,
-		nil)
+	trace.setResource(objectGRN)
+	objectEffect := permtypes.EFFECT_UNSPECIFIED
+	if k.hasPreviousOwnerPolicies(ctx, bucketInfo, objectInfo) {
+		trace.add(traceCheckAccountPolicy, permtypes.EFFECT_UNSPECIFIED,
+			"the object policies of the previous bucket owner are ignored")
+	} else {
+		objectEffect = k.verifyPolicy(ctx, objectInfo.Id, gnfdresource.RESOURCE_TYPE_OBJECT, operator, action,
+			conditionOpts, trace)
+	}
 	if objectEffect == permtypes.EFFECT_DENY {
+		trace.add(traceCheckResult, permtypes.EFFECT_DENY, "the object policies deny the action")
 		return permtypes.EFFECT_DENY
 	}
 
 	if bucketEffect == permtypes.EFFECT_ALLOW || objectEffect == permtypes.EFFECT_ALLOW {
+		trace.add(traceCheckResult, permtypes.EFFECT_ALLOW, "the bucket or object policies allow the action")
 		return permtypes.EFFECT_ALLOW
 	}
+	trace.add(traceCheckResult, permtypes.EFFECT_DENY, "neither the bucket nor the object policies allow the action")
 	return permtypes.EFFECT_DENY
 }
 
+// objectVerifyOptions describes the object for the statement conditions.
+func objectVerifyOptions(objectInfo *storagetypes.ObjectInfo) *permtypes.VerifyOptions {
+	payloadSize := objectInfo.PayloadSize
+	return &permtypes.VerifyOptions{
+		WantedSize:  &payloadSize,
+		ObjectName:  objectInfo.ObjectName,
+		ContentType: objectInfo.ContentType,
+		Tags:        tagsToMap(objectInfo.Tags),
+	}
+}
+
+func tagsToMap(tags *storagetypes.ResourceTags) map[string]string {
+	m := make(map[string]string, len(tags.GetTags()))
+	for _, tag := range tags.GetTags() {
+		m[tag.Key] = tag.Value
+	}
+	return m
+}
+
 func (k Keeper) VerifyGroupPermission(ctx sdk.Context, groupInfo *storagetypes.GroupInfo, operator sdk.AccAddress,
 	action permtypes.ActionType,
 ) permtypes.Effect {
@@ -122,7 +186,8 @@ func (k Keeper) VerifyGroupPermission(ctx sdk.Context, groupInfo *storagetypes.G
 	}
 
 	// verify policy
-	effect := k.VerifyPolicy(ctx, groupInfo.Id, gnfdresource.RESOURCE_TYPE_GROUP, operator, action, nil)
+	effect := k.VerifyPolicy(ctx, groupInfo.Id, gnfdresource.RESOURCE_TYPE_GROUP, operator, action,
+		&permtypes.VerifyOptions{Tags: tagsToMap(groupInfo.Tags)})
 	if effect == permtypes.EFFECT_ALLOW {
 		return permtypes.EFFECT_ALLOW
 	}
@@ -132,11 +197,21 @@ func (k Keeper) VerifyGroupPermission(ctx sdk.Context, groupInfo *storagetypes.G
 
 func (k Keeper) VerifyPolicy(ctx sdk.Context, resourceID math.Uint, resourceType gnfdresource.ResourceType,
 	operator sdk.AccAddress, action permtypes.ActionType, opts *permtypes.VerifyOptions,
+) permtypes.Effect {
+	return k.verifyPolicy(ctx, resourceID, resourceType, operator, action, opts, nil)
+}
+
+// verifyPolicy is VerifyPolicy recording every policy it considers into the trace.
+func (k Keeper) verifyPolicy(ctx sdk.Context, resourceID math.Uint, resourceType gnfdresource.ResourceType,
+	operator sdk.AccAddress, action permtypes.ActionType, opts *permtypes.VerifyOptions, trace *permissionTrace,
 ) permtypes.Effect {
 	// verify policy which grant permission to account
 	policy, found := k.permKeeper.GetPolicyForAccount(ctx, resourceID, resourceType, operator)
 	if found {
+		statements := trace.traceStatements(policy, action, ctx.BlockTime(), opts)
 		effect, newPolicy := policy.Eval(action, ctx.BlockTime(), opts)
+		trace.addPolicy(traceCheckAccountPolicy, policy.Id, math.ZeroUint(), statements, effect,
+			policyReason(policy, ctx.BlockTime(), effect))
 		if effect != permtypes.EFFECT_UNSPECIFIED {
 			if effect == permtypes.EFFECT_ALLOW && action == permtypes.ACTION_CREATE_OBJECT && newPolicy != nil && ctx.TxBytes() != nil {
 				_, err := k.permKeeper.PutPolicy(ctx, newPolicy)
@@ -146,6 +221,8 @@ func (k Keeper) VerifyPolicy(ctx sdk.Context, resourceID math.Uint, resourceType
 			}
 			return effect
 		}
+	} else {
+		trace.add(traceCheckAccountPolicy, permtypes.EFFECT_UNSPECIFIED, "no policy is granted to the operator")
 	}
 
 	// verify policy which grant permission to group
@@ -155,22 +232,38 @@ func (k Keeper) VerifyPolicy(ctx sdk.Context, resourceID math.Uint, resourceType
 		var allowedPolicy *permtypes.Policy
 		for _, item := range policyGroup.Items {
 			if !k.hasGroup(ctx, item.GroupId) {
+				trace.addPolicy(traceCheckGroupPolicy, item.PolicyId, item.GroupId, nil,
+					permtypes.EFFECT_UNSPECIFIED, "the group is deleted")
 				continue
 			}
 			// check the group has the right permission of this resource
 			p := k.permKeeper.MustGetPolicyByID(ctx, item.PolicyId)
+			statements := trace.traceStatements(p, action, ctx.BlockTime(), opts)
 			effect, newPolicy := p.Eval(action, ctx.BlockTime(), opts)
-			if effect != permtypes.EFFECT_UNSPECIFIED {
-				// check the operator is the member of this group
-				groupMember, memberFound := k.permKeeper.GetGroupMember(ctx, item.GroupId, operator)
-				if memberFound && (groupMember.ExpirationTime == nil || groupMember.ExpirationTime.After(ctx.BlockTime())) {
-					if effect == permtypes.EFFECT_ALLOW {
-						allowed = true
-						allowedPolicy = newPolicy
-					} else if effect == permtypes.EFFECT_DENY {
-						return permtypes.EFFECT_DENY
-					}
-				}
+			if effect == permtypes.EFFECT_UNSPECIFIED {
+				trace.addPolicy(traceCheckGroupPolicy, p.Id, item.GroupId, statements, effect,
+					policyReason(p, ctx.BlockTime(), effect))
+				continue
+			}
+			// check the operator is the member of this group
+			groupMember, memberFound := k.permKeeper.GetGroupMember(ctx, item.GroupId, operator)
+			if !memberFound {
+				trace.addPolicy(traceCheckGroupPolicy, p.Id, item.GroupId, statements, permtypes.EFFECT_UNSPECIFIED,
+					"the operator is not a member of the group")
+				continue
+			}
+			if groupMember.ExpirationTime != nil && !groupMember.ExpirationTime.After(ctx.BlockTime()) {
+				trace.addPolicy(traceCheckGroupPolicy, p.Id, item.GroupId, statements, permtypes.EFFECT_UNSPECIFIED,
+					"the group membership of the operator is expired")
+				continue
+			}
+			trace.addPolicy(traceCheckGroupPolicy, p.Id, item.GroupId, statements, effect,
+				policyReason(p, ctx.BlockTime(), effect)+" for the members of the group")
+			if effect == permtypes.EFFECT_ALLOW {
+				allowed = true
+				allowedPolicy = newPolicy
+			} else if effect == permtypes.EFFECT_DENY {
+				return permtypes.EFFECT_DENY
 			}
 		}
 		if allowed {
@@ -182,6 +275,8 @@ func (k Keeper) VerifyPolicy(ctx sdk.Context, resourceID math.Uint, resourceType
 			}
 			return permtypes.EFFECT_ALLOW
 		}
+	} else {
+		trace.add(traceCheckGroupPolicy, permtypes.EFFECT_UNSPECIFIED, "no policy is granted to any group")
 	}
 	return permtypes.EFFECT_UNSPECIFIED
 }
@@ -293,6 +388,12 @@ func (k Keeper) ValidatePrincipal(ctx sdk.Context, resOwner sdk.AccAddress, prin
 	return nil
 }
 
+// GetResourceIDFromGRN returns the id of the bucket, object or group the GRN names.
+func (k Keeper) GetResourceIDFromGRN(ctx sdk.Context, grn evmtypes.GRN) (math.Uint, error) {
+	_, resID, err := k.getResourceOwnerAndIDFromGRN(ctx, grn)
+	return resID, err
+}
+
 func (k Keeper) getResourceOwnerAndIDFromGRN(ctx sdk.Context, grn evmtypes.GRN) (resOwner sdk.AccAddress, resID math.Uint, err error) {
 	switch grn.ResourceType() {
 	case gnfdresource.RESOURCE_TYPE_BUCKET:
@@ -311,11 +412,15 @@ func (k Keeper) getResourceOwnerAndIDFromGRN(ctx sdk.Context, grn evmtypes.GRN)
 		if grnErr != nil {
 			return resOwner, resID, grnErr
 		}
+		bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+		if !found {
+			return resOwner, resID, storagetypes.ErrNoSuchBucket.Wrapf("bucketName: %s", bucketName)
+		}
 		objectInfo, found := k.GetObjectInfo(ctx, bucketName, objectName)
 		if !found {
 			return resOwner, resID, storagetypes.ErrNoSuchObject.Wrapf("BucketName: %s, objectName: %s", bucketName, objectName)
 		}
-		resOwner = sdk.MustAccAddressFromHex(objectInfo.Owner)
+		resOwner = k.objectOwner(ctx, bucketInfo, objectInfo)
 		resID = objectInfo.Id
 	case gnfdresource.RESOURCE_TYPE_GROUP:
 		groupOwner, groupName, grnErr := grn.GetGroupOwnerAndAccount()
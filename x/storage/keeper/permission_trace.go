@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	permtypes "github.com/mocachain/moca/v2/x/permission/types"
+	storagetypes "github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// The checks recorded in a permission trace.
+const (
+	traceCheckPublicVisibility = "public_visibility"
+	traceCheckAnonymous        = "anonymous"
+	traceCheckOwner            = "owner"
+	traceCheckAccountPolicy    = "account_policy"
+	traceCheckGroupPolicy      = "group_policy"
+	traceCheckResult           = "result"
+)
+
+// permissionTrace records the steps of a permission evaluation for ExplainPermission. A nil trace records nothing,
+// which is how the permissions are verified everywhere else.
+type permissionTrace struct {
+	// resource is the moca resource name of the resource being checked
+	resource string
+	steps    []storagetypes.PermissionTraceStep
+}
+
+func (t *permissionTrace) setResource(resource string) {
+	if t != nil {
+		t.resource = resource
+	}
+}
+
+func (t *permissionTrace) add(check string, effect permtypes.Effect, reason string) {
+	if t == nil {
+		return
+	}
+	t.steps = append(t.steps, storagetypes.PermissionTraceStep{
+		Check:    check,
+		Resource: t.resource,
+		PolicyId: math.ZeroUint(),
+		GroupId:  math.ZeroUint(),
+		Effect:   effect,
+		Reason:   reason,
+	})
+}
+
+func (t *permissionTrace) addPolicy(check string, policyID, groupID math.Uint,
+	statements []storagetypes.StatementTrace, effect permtypes.Effect, reason string,
+) {
+	if t == nil {
+		return
+	}
+	t.steps = append(t.steps, storagetypes.PermissionTraceStep{
+		Check:      check,
+		Resource:   t.resource,
+		PolicyId:   policyID,
+		GroupId:    groupID,
+		Statements: statements,
+		Effect:     effect,
+		Reason:     reason,
+	})
+}
+
+// traceStatements evaluates each statement of the policy on its own. It must be called before the policy itself is
+// evaluated, as evaluating a statement with a limit size consumes the limit.
+func (t *permissionTrace) traceStatements(policy *permtypes.Policy, action permtypes.ActionType, blockTime time.Time,
+	opts *permtypes.VerifyOptions,
+) []storagetypes.StatementTrace {
+	if t == nil {
+		return nil
+	}
+	traces := make([]storagetypes.StatementTrace, 0, len(policy.Statements))
+	for i, s := range policy.Statements {
+		original, evaluated := *s, *s
+		trace := storagetypes.StatementTrace{Index: uint32(i), Statement: &original}
+		switch {
+		case s.ExpirationTime != nil && s.ExpirationTime.Before(blockTime):
+			trace.Reason = "the statement is expired"
+		case !s.ConditionsHold(blockTime, opts):
+			trace.Reason = "the conditions of the statement do not hold"
+		default:
+			trace.Effect, _ = evaluated.Eval(action, opts)
+			switch {
+			case trace.Effect == permtypes.EFFECT_UNSPECIFIED:
+				trace.Reason = "the statement does not cover the resource or the action"
+			case trace.Effect != s.Effect:
+				trace.Reason = "the object size exceeds the limit size of the statement"
+			default:
+				trace.Reason = "the statement matches the action"
+			}
+		}
+		traces = append(traces, trace)
+	}
+	return traces
+}
+
+// policyReason explains the effect of the policy.
+func policyReason(policy *permtypes.Policy, blockTime time.Time, effect permtypes.Effect) string {
+	switch {
+	case policy.ExpirationTime != nil && policy.ExpirationTime.Before(blockTime):
+		return "the policy is expired"
+	case effect == permtypes.EFFECT_ALLOW:
+		return "a statement allows the action"
+	case effect == permtypes.EFFECT_DENY:
+		return "a statement denies the action"
+	default:
+		return "no statement applies to the action"
+	}
+}
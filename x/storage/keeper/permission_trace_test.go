@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	permtypes "github.com/mocachain/moca/v2/x/permission/types"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func (s *TestSuite) TestExplainPermission() {
+	owner := sample.RandAccAddress()
+	operator := sample.RandAccAddress()
+	expiredAt := s.ctx.BlockTime().Add(-time.Hour)
+
+	bucketInfo := &types.BucketInfo{
+		Owner:      owner.String(),
+		BucketName: "explained-bucket",
+		Id:         sdkmath.NewUint(1),
+		Visibility: types.VISIBILITY_TYPE_PRIVATE,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+
+	policy := &permtypes.Policy{
+		Id: sdkmath.NewUint(7),
+		Statements: []*permtypes.Statement{
+			{
+				Effect:         permtypes.EFFECT_ALLOW,
+				Actions:        []permtypes.ActionType{permtypes.ACTION_UPDATE_BUCKET_INFO},
+				ExpirationTime: &expiredAt,
+			},
+			{
+				Effect:  permtypes.EFFECT_DENY,
+				Actions: []permtypes.ActionType{permtypes.ACTION_DELETE_BUCKET},
+			},
+			{
+				Effect:  permtypes.EFFECT_ALLOW,
+				Actions: []permtypes.ActionType{permtypes.ACTION_UPDATE_BUCKET_INFO},
+			},
+		},
+	}
+	s.permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), gomock.Any(), operator).Return(policy, true).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyForAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+	s.permissionKeeper.EXPECT().GetPolicyGroupForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+
+	// the third statement allows the action, the first one is expired
+	res, err := s.queryClient.ExplainPermission(s.ctx, &types.QueryExplainPermissionRequest{
+		Operator:   operator.String(),
+		BucketName: bucketInfo.BucketName,
+		ActionType: permtypes.ACTION_UPDATE_BUCKET_INFO,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(permtypes.EFFECT_ALLOW, res.Effect)
+	s.Require().Len(res.Steps, 2)
+	step := res.Steps[0]
+	s.Require().Equal("account_policy", step.Check)
+	s.Require().Equal("grn:b::explained-bucket", step.Resource)
+	s.Require().Equal(policy.Id, step.PolicyId)
+	s.Require().Equal(permtypes.EFFECT_ALLOW, step.Effect)
+	s.Require().Len(step.Statements, 3)
+	s.Require().Equal("the statement is expired", step.Statements[0].Reason)
+	s.Require().Equal(permtypes.EFFECT_UNSPECIFIED, step.Statements[1].Effect)
+	s.Require().Equal(permtypes.EFFECT_ALLOW, step.Statements[2].Effect)
+	s.Require().Equal("result", res.Steps[1].Check)
+
+	// the operator without any policy falls through to the group policies
+	res, err = s.queryClient.ExplainPermission(s.ctx, &types.QueryExplainPermissionRequest{
+		Operator:   sample.RandAccAddress().String(),
+		BucketName: bucketInfo.BucketName,
+		ActionType: permtypes.ACTION_DELETE_BUCKET,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(permtypes.EFFECT_DENY, res.Effect)
+	s.Require().Len(res.Steps, 3)
+	s.Require().Equal("account_policy", res.Steps[0].Check)
+	s.Require().Equal("group_policy", res.Steps[1].Check)
+	s.Require().Equal(permtypes.EFFECT_DENY, res.Steps[2].Effect)
+
+	// the explained effect is the verified one
+	for _, action := range []permtypes.ActionType{permtypes.ACTION_UPDATE_BUCKET_INFO, permtypes.ACTION_DELETE_BUCKET} {
+		for _, op := range []string{owner.String(), operator.String()} {
+			explained, err := s.queryClient.ExplainPermission(s.ctx, &types.QueryExplainPermissionRequest{
+				Operator: op, BucketName: bucketInfo.BucketName, ActionType: action,
+			})
+			s.Require().NoError(err)
+			verified, err := s.queryClient.VerifyPermission(s.ctx, &types.QueryVerifyPermissionRequest{
+				Operator: op, BucketName: bucketInfo.BucketName, ActionType: action,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(verified.Effect, explained.Effect)
+		}
+	}
+
+	res, err = s.queryClient.ExplainPermission(s.ctx, &types.QueryExplainPermissionRequest{
+		Operator:   owner.String(),
+		BucketName: bucketInfo.BucketName,
+		ActionType: permtypes.ACTION_DELETE_BUCKET,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Steps, 1)
+	s.Require().Equal("owner", res.Steps[0].Check)
+}
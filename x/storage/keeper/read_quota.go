@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	permtypes "github.com/mocachain/moca/v2/x/permission/types"
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// SetBucketReadQuotaAutoTopup sets how the charged read quota of the bucket is raised as the consumption reported by
+// its primary SP grows, a zero max read quota disables the auto topup.
+func (k Keeper) SetBucketReadQuotaAutoTopup(ctx sdk.Context, operator sdk.AccAddress, bucketName string,
+	autoTopup types.ReadQuotaAutoTopup,
+) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return err
+	}
+
+	effect := k.VerifyBucketPermission(ctx, bucketInfo, operator, permtypes.ACTION_UPDATE_BUCKET_INFO, nil)
+	if effect != permtypes.EFFECT_ALLOW {
+		return types.ErrAccessDenied.Wrapf("The operator(%s) has no UpdateBucketInfo permission of the bucket(%s)",
+			operator.String(), bucketName)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if autoTopup.MaxReadQuota == 0 {
+		autoTopup = types.ReadQuotaAutoTopup{}
+		store.Delete(types.GetBucketReadQuotaAutoTopupKey(bucketInfo.Id))
+	} else {
+		store.Set(types.GetBucketReadQuotaAutoTopupKey(bucketInfo.Id), k.cdc.MustMarshal(&autoTopup))
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSetBucketReadQuotaAutoTopup{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		BucketId:   bucketInfo.Id,
+		AutoTopup:  autoTopup,
+	})
+}
+
+// GetBucketReadQuotaAutoTopup returns how the charged read quota of the bucket is raised automatically.
+func (k Keeper) GetBucketReadQuotaAutoTopup(ctx sdk.Context, bucketID sdkmath.Uint) (*types.ReadQuotaAutoTopup, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBucketReadQuotaAutoTopupKey(bucketID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var autoTopup types.ReadQuotaAutoTopup
+	k.cdc.MustUnmarshal(bz, &autoTopup)
+	return &autoTopup, true
+}
+
+// GetBucketReadQuotaConsumption returns the charged read quota consumed by the bucket in the latest reported month.
+func (k Keeper) GetBucketReadQuotaConsumption(ctx sdk.Context, bucketID sdkmath.Uint) (*types.ReadQuotaConsumption, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBucketReadQuotaConsumptionKey(bucketID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var consumption types.ReadQuotaConsumption
+	k.cdc.MustUnmarshal(bz, &consumption)
+	return &consumption, true
+}
+
+// readQuotaMonth returns the UTC month of the block time in the form of yyyymm, the read quota is consumed monthly.
+func readQuotaMonth(ctx sdk.Context) uint32 {
+	t := ctx.BlockTime().UTC()
+	return uint32(t.Year()*100 + int(t.Month()))
+}
+
+// ReportReadQuotaConsumption records the charged read quota consumed by the bucket in the current month, reported by
+// the operator of its primary SP, and tops the quota up if the bucket has the auto topup enabled. The consumption is
+// cumulative within a month, so a report can not be lower than the previous one of the same month.
+func (k Keeper) ReportReadQuotaConsumption(ctx sdk.Context, operator sdk.AccAddress, bucketName string, consumed uint64) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return err
+	}
+
+	sp, found := k.spKeeper.GetStorageProviderByOperatorAddr(ctx, operator)
+	if !found {
+		return types.ErrNoSuchStorageProvider.Wrapf("SP operator address: %s", operator.String())
+	}
+	primarySP, err := k.GetPrimarySPForBucket(ctx, bucketInfo)
+	if err != nil {
+		return err
+	}
+	if sp.Id != primarySP.Id {
+		return types.ErrAccessDenied.Wrapf("The SP(%d) is not the primary SP of the bucket(%s)", sp.Id, bucketName)
+	}
+
+	month := readQuotaMonth(ctx)
+	prev, found := k.GetBucketReadQuotaConsumption(ctx, bucketInfo.Id)
+	if found && prev.Month == month && consumed < prev.ConsumedReadQuota {
+		return types.ErrInvalidReadQuotaConsumption.Wrapf("The consumption %d is lower than the reported %d of the month",
+			consumed, prev.ConsumedReadQuota)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBucketReadQuotaConsumptionKey(bucketInfo.Id), k.cdc.MustMarshal(&types.ReadQuotaConsumption{
+		Month:             month,
+		ConsumedReadQuota: consumed,
+		ReportedAt:        ctx.BlockTime().Unix(),
+		ReportedBy:        operator.String(),
+	}))
+
+	if err = ctx.EventManager().EmitTypedEvents(&types.EventReportReadQuotaConsumption{
+		Operator:          operator.String(),
+		BucketName:        bucketName,
+		BucketId:          bucketInfo.Id,
+		Month:             month,
+		ConsumedReadQuota: consumed,
+	}); err != nil {
+		return err
+	}
+
+	if sp.Status != sptypes.STATUS_IN_SERVICE {
+		return nil
+	}
+	return k.autoTopupReadQuota(ctx, operator, bucketInfo, consumed)
+}
+
+// autoTopupReadQuota raises the charged read quota of the bucket by the topup size, up to the ceiling, once the
+// consumption crosses the threshold. A bucket without charged read quota has no threshold to cross, so its quota is
+// not topped up until its owner charges some. The raised quota is charged as if the quota was updated by the bucket owner, a
+// topup which can not be charged, e.g. for lack of balance or beyond the flow rate limit of the bucket, is skipped
+// so that the consumption is still recorded.
+func (k Keeper) autoTopupReadQuota(ctx sdk.Context, operator sdk.AccAddress, bucketInfo *types.BucketInfo, consumed uint64) error {
+	autoTopup, found := k.GetBucketReadQuotaAutoTopup(ctx, bucketInfo.Id)
+	if !found || bucketInfo.ChargedReadQuota == 0 || bucketInfo.ChargedReadQuota >= autoTopup.MaxReadQuota {
+		return nil
+	}
+	threshold := sdkmath.NewUint(bucketInfo.ChargedReadQuota).MulUint64(uint64(autoTopup.ThresholdPercent))
+	if sdkmath.NewUint(consumed).MulUint64(100).LT(threshold) {
+		return nil
+	}
+
+	prevReadQuota := bucketInfo.ChargedReadQuota
+	newReadQuota := autoTopup.MaxReadQuota
+	if autoTopup.TopupSize < autoTopup.MaxReadQuota-prevReadQuota {
+		newReadQuota = prevReadQuota + autoTopup.TopupSize
+	}
+
+	// charge on a copy in a cached context, so that a failed topup leaves neither the bucket nor the flows changed
+	cacheCtx, write := ctx.CacheContext()
+	toppedUp := *bucketInfo
+	internalBucketInfo := k.MustGetInternalBucketInfo(cacheCtx, bucketInfo.Id)
+	err := k.UpdateBucketInfoAndCharge(cacheCtx, &toppedUp, internalBucketInfo, toppedUp.PaymentAddress, newReadQuota)
+	if err != nil {
+		ctx.Logger().Info("skip the read quota auto topup", "bucket", bucketInfo.BucketName, "err", err.Error())
+		return nil
+	}
+	write()
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBucketByIDKey(toppedUp.Id), k.cdc.MustMarshal(&toppedUp))
+	k.SetInternalBucketInfo(ctx, toppedUp.Id, internalBucketInfo)
+	k.setQuotaUpdateTime(ctx, toppedUp.Id, uint64(ctx.BlockTime().Unix()))
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventReadQuotaAutoTopup{
+		BucketName:        toppedUp.BucketName,
+		BucketId:          toppedUp.Id,
+		PreviousReadQuota: prevReadQuota,
+		ChargedReadQuota:  newReadQuota,
+	}, &types.EventUpdateBucketInfo{
+		Operator:                   operator.String(),
+		BucketName:                 toppedUp.BucketName,
+		BucketId:                   toppedUp.Id,
+		ChargedReadQuota:           toppedUp.ChargedReadQuota,
+		PaymentAddress:             toppedUp.PaymentAddress,
+		Visibility:                 toppedUp.Visibility,
+		GlobalVirtualGroupFamilyId: toppedUp.GlobalVirtualGroupFamilyId,
+	})
+}
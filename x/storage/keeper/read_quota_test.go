@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	paymenttypes "github.com/mocachain/moca/v2/x/payment/types"
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/storage/types"
+	virtualgroupmoduletypes "github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestReportReadQuotaConsumption() {
+	owner := sample.RandAccAddress()
+	gvgFamily := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
+		Id:                    1,
+		PrimarySpId:           100,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	primarySp := &sptypes.StorageProvider{
+		Status:          sptypes.STATUS_IN_SERVICE,
+		Id:              100,
+		OperatorAddress: sample.RandAccAddress().String(),
+		FundingAddress:  sample.RandAccAddress().String(),
+	}
+	spOperator := primarySp.GetOperatorAccAddress()
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gomock.Any()).Return(gvgFamily, true).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), primarySp.Id).Return(primarySp, true).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), spOperator).Return(primarySp, true).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).Return(sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdkmath.LegacyNewDec(100),
+		PrimaryStorePrice:   sdkmath.LegacyNewDec(1000),
+		SecondaryStorePrice: sdkmath.LegacyNewDec(500),
+	}, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(paymenttypes.DefaultParams().VersionedParams, nil).AnyTimes()
+	s.paymentKeeper.EXPECT().IsPaymentAccountOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		Owner:                      owner.String(),
+		BucketName:                 "read-heavy-bucket",
+		Id:                         sdkmath.NewUint(1),
+		PaymentAddress:             owner.String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+		ChargedReadQuota:           1000,
+		BucketStatus:               types.BUCKET_STATUS_CREATED,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{})
+
+	err := s.storageKeeper.SetBucketReadQuotaAutoTopup(s.ctx, owner, bucketInfo.BucketName, types.ReadQuotaAutoTopup{
+		MaxReadQuota:     2000,
+		ThresholdPercent: 80,
+		TopupSize:        500,
+	})
+	s.Require().NoError(err)
+
+	chargedReadQuota := func() uint64 {
+		res, err := s.queryClient.BucketReadQuota(s.ctx, &types.QueryBucketReadQuotaRequest{BucketName: bucketInfo.BucketName})
+		s.Require().NoError(err)
+		return res.ChargedReadQuota
+	}
+
+	// only the primary SP reports the consumption
+	err = s.storageKeeper.ReportReadQuotaConsumption(s.ctx, sample.RandAccAddress(), bucketInfo.BucketName, 900)
+	s.Require().ErrorIs(err, types.ErrNoSuchStorageProvider)
+
+	// below the threshold
+	s.Require().NoError(s.storageKeeper.ReportReadQuotaConsumption(s.ctx, spOperator, bucketInfo.BucketName, 700))
+	s.Require().Equal(uint64(1000), chargedReadQuota())
+
+	// the consumption of a month only grows
+	err = s.storageKeeper.ReportReadQuotaConsumption(s.ctx, spOperator, bucketInfo.BucketName, 500)
+	s.Require().ErrorIs(err, types.ErrInvalidReadQuotaConsumption)
+
+	// crossing the threshold tops the quota up by the topup size, then up to the ceiling
+	s.Require().NoError(s.storageKeeper.ReportReadQuotaConsumption(s.ctx, spOperator, bucketInfo.BucketName, 800))
+	s.Require().Equal(uint64(1500), chargedReadQuota())
+	s.Require().NoError(s.storageKeeper.ReportReadQuotaConsumption(s.ctx, spOperator, bucketInfo.BucketName, 1300))
+	s.Require().Equal(uint64(2000), chargedReadQuota())
+	s.Require().NoError(s.storageKeeper.ReportReadQuotaConsumption(s.ctx, spOperator, bucketInfo.BucketName, 2000))
+	s.Require().Equal(uint64(2000), chargedReadQuota())
+
+	res, err := s.queryClient.BucketReadQuota(s.ctx, &types.QueryBucketReadQuotaRequest{BucketName: bucketInfo.BucketName})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2000), res.Consumption.ConsumedReadQuota)
+	s.Require().Equal(uint64(500), res.AutoTopup.TopupSize)
+
+	// a bucket without charged read quota is not topped up
+	freeBucketInfo := *bucketInfo
+	freeBucketInfo.BucketName = "free-bucket"
+	freeBucketInfo.Id = sdkmath.NewUint(2)
+	freeBucketInfo.ChargedReadQuota = 0
+	s.storageKeeper.StoreBucketInfo(s.ctx, &freeBucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, freeBucketInfo.Id, &types.InternalBucketInfo{})
+	err = s.storageKeeper.SetBucketReadQuotaAutoTopup(s.ctx, owner, freeBucketInfo.BucketName, types.ReadQuotaAutoTopup{
+		MaxReadQuota:     2000,
+		ThresholdPercent: 80,
+		TopupSize:        500,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.storageKeeper.ReportReadQuotaConsumption(s.ctx, spOperator, freeBucketInfo.BucketName, 0))
+	res, err = s.queryClient.BucketReadQuota(s.ctx, &types.QueryBucketReadQuotaRequest{BucketName: freeBucketInfo.BucketName})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), res.ChargedReadQuota)
+}
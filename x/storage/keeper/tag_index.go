@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gnfd "github.com/mocachain/moca/v2/types"
+	"github.com/mocachain/moca/v2/types/resource"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+// updateTagIndex moves the tag index entries of the resource from its old tags to its new tags,
+// nil new tags remove the resource from the index.
+func (k Keeper) updateTagIndex(ctx sdk.Context, resourceType resource.ResourceType, resourceID sdkmath.Uint,
+	oldTags, newTags *types.ResourceTags,
+) {
+	store := ctx.KVStore(k.storeKey)
+	for _, tag := range oldTags.GetTags() {
+		store.Delete(types.GetTagIndexKey(tag.Key, tag.Value, resourceType, resourceID))
+	}
+	for _, tag := range newTags.GetTags() {
+		store.Set(types.GetTagIndexKey(tag.Key, tag.Value, resourceType, resourceID), []byte{byte(resourceType)})
+	}
+}
+
+// indexAllTags adds the tags of all buckets, objects and groups to the tag index.
+func (k Keeper) indexAllTags(ctx sdk.Context) {
+	k.iterateBuckets(ctx, func(bucket *types.BucketInfo) {
+		k.updateTagIndex(ctx, resource.RESOURCE_TYPE_BUCKET, bucket.Id, nil, bucket.Tags)
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	objectIt := prefix.NewStore(store, types.ObjectByIDPrefix).Iterator(nil, nil)
+	defer objectIt.Close()
+	for ; objectIt.Valid(); objectIt.Next() {
+		var objectInfo types.ObjectInfo
+		k.cdc.MustUnmarshal(objectIt.Value(), &objectInfo)
+		k.updateTagIndex(ctx, resource.RESOURCE_TYPE_OBJECT, objectInfo.Id, nil, objectInfo.Tags)
+	}
+
+	groupIt := prefix.NewStore(store, types.GroupByIDPrefix).Iterator(nil, nil)
+	defer groupIt.Close()
+	for ; groupIt.Valid(); groupIt.Next() {
+		var groupInfo types.GroupInfo
+		k.cdc.MustUnmarshal(groupIt.Value(), &groupInfo)
+		k.updateTagIndex(ctx, resource.RESOURCE_TYPE_GROUP, groupInfo.Id, nil, groupInfo.Tags)
+	}
+}
+
+// getTaggedResource returns the indexed resource with its moca resource name.
+func (k Keeper) getTaggedResource(ctx sdk.Context, resourceType resource.ResourceType, resourceID sdkmath.Uint) (*types.TaggedResource, bool) {
+	var grn *gnfd.GRN
+	switch resourceType {
+	case resource.RESOURCE_TYPE_BUCKET:
+		bucketInfo, found := k.GetBucketInfoById(ctx, resourceID)
+		if !found {
+			return nil, false
+		}
+		grn = gnfd.NewBucketGRN(bucketInfo.BucketName)
+	case resource.RESOURCE_TYPE_OBJECT:
+		objectInfo, found := k.GetObjectInfoById(ctx, resourceID)
+		if !found {
+			return nil, false
+		}
+		grn = gnfd.NewObjectGRN(objectInfo.BucketName, objectInfo.ObjectName)
+	case resource.RESOURCE_TYPE_GROUP:
+		groupInfo, found := k.GetGroupInfoById(ctx, resourceID)
+		if !found {
+			return nil, false
+		}
+		grn = gnfd.NewGroupGRN(sdk.MustAccAddressFromHex(groupInfo.Owner), groupInfo.GroupName)
+	default:
+		return nil, false
+	}
+	return &types.TaggedResource{
+		ResourceType: resourceType,
+		ResourceId:   resourceID,
+		Grn:          grn.String(),
+	}, true
+}
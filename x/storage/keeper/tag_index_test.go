@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	gnfd "github.com/mocachain/moca/v2/types"
+	"github.com/mocachain/moca/v2/types/resource"
+	"github.com/mocachain/moca/v2/x/storage/keeper"
+	"github.com/mocachain/moca/v2/x/storage/types"
+)
+
+func (s *TestSuite) TestListResourcesByTag() {
+	owner := sample.RandAccAddress()
+	envProd := &types.ResourceTags{Tags: []types.ResourceTags_Tag{{Key: "env", Value: "prod"}}}
+
+	// the tags set before the index was introduced are indexed by the migration
+	bucketInfo := &types.BucketInfo{
+		Owner:      owner.String(),
+		BucketName: "tagged-bucket",
+		Id:         sdkmath.NewUint(1),
+		Tags:       envProd,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	objectInfo := &types.ObjectInfo{
+		Id:         sdkmath.NewUint(1),
+		Owner:      owner.String(),
+		BucketName: bucketInfo.BucketName,
+		ObjectName: "tagged-object",
+	}
+	s.storageKeeper.StoreObjectInfo(s.ctx, objectInfo)
+	s.Require().NoError(keeper.NewMigrator(*s.storageKeeper).MigrateV1toV2(s.ctx))
+
+	err := s.storageKeeper.SetTag(s.ctx, owner, *gnfd.NewObjectGRN(bucketInfo.BucketName, objectInfo.ObjectName), envProd)
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.ListResourcesByTag(s.ctx, &types.QueryListResourcesByTagRequest{TagKey: "env", TagValue: "prod"})
+	s.Require().NoError(err)
+	s.Require().Len(res.Resources, 2)
+
+	res, err = s.queryClient.ListResourcesByTag(s.ctx, &types.QueryListResourcesByTagRequest{
+		TagKey:       "env",
+		TagValue:     "prod",
+		ResourceType: resource.RESOURCE_TYPE_OBJECT,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Resources, 1)
+	s.Require().Equal(objectInfo.Id, res.Resources[0].ResourceId)
+	s.Require().Equal(gnfd.NewObjectGRN(bucketInfo.BucketName, objectInfo.ObjectName).String(), res.Resources[0].Grn)
+
+	// replacing the tags moves the object to the new tag
+	envDev := &types.ResourceTags{Tags: []types.ResourceTags_Tag{{Key: "env", Value: "dev"}}}
+	err = s.storageKeeper.SetTag(s.ctx, owner, *gnfd.NewObjectGRN(bucketInfo.BucketName, objectInfo.ObjectName), envDev)
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.ListResourcesByTag(s.ctx, &types.QueryListResourcesByTagRequest{TagKey: "env", TagValue: "prod"})
+	s.Require().NoError(err)
+	s.Require().Len(res.Resources, 1)
+	s.Require().Equal(resource.RESOURCE_TYPE_BUCKET, res.Resources[0].ResourceType)
+
+	res, err = s.queryClient.ListResourcesByTag(s.ctx, &types.QueryListResourcesByTagRequest{TagKey: "env", TagValue: "dev"})
+	s.Require().NoError(err)
+	s.Require().Len(res.Resources, 1)
+	s.Require().Equal(resource.RESOURCE_TYPE_OBJECT, res.Resources[0].ResourceType)
+
+	_, err = s.queryClient.ListResourcesByTag(s.ctx, &types.QueryListResourcesByTagRequest{TagValue: "dev"})
+	s.Require().ErrorContains(err, "tag key should not be empty")
+}
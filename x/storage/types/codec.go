@@ -28,6 +28,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelMigrateBucket{}, "storage/CancelMigrateBucket", nil)
 	cdc.RegisterConcrete(&MsgRejectMigrateBucket{}, "storage/RejectMigrateBucket", nil)
 	cdc.RegisterConcrete(&MsgSetBucketFlowRateLimit{}, "storage/SetBucketFlowRateLimit", nil)
+	cdc.RegisterConcrete(&MsgSetBucketLifecycle{}, "storage/SetBucketLifecycle", nil)
+	cdc.RegisterConcrete(&MsgSetBucketVersioning{}, "storage/SetBucketVersioning", nil)
+	cdc.RegisterConcrete(&MsgRestoreObjectVersion{}, "storage/RestoreObjectVersion", nil)
+	cdc.RegisterConcrete(&MsgSetBucketReadQuotaAutoTopup{}, "storage/SetBucketReadQuotaAutoTopup", nil)
+	cdc.RegisterConcrete(&MsgReportReadQuotaConsumption{}, "storage/ReportReadQuotaConsumption", nil)
+	cdc.RegisterConcrete(&MsgSetBucketCallback{}, "storage/SetBucketCallback", nil)
+	cdc.RegisterConcrete(&MsgTransferBucketOwnership{}, "storage/TransferBucketOwnership", nil)
+	cdc.RegisterConcrete(&MsgAcceptBucketOwnership{}, "storage/AcceptBucketOwnership", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -128,6 +136,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetBucketFlowRateLimit{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetBucketLifecycle{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetBucketVersioning{},
+		&MsgRestoreObjectVersion{},
+		&MsgSetBucketReadQuotaAutoTopup{},
+		&MsgReportReadQuotaConsumption{},
+		&MsgSetBucketCallback{},
+		&MsgTransferBucketOwnership{},
+		&MsgAcceptBucketOwnership{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
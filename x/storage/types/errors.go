@@ -36,6 +36,10 @@ var (
 	ErrObjectIsNotUpdating          = errors.Register(ModuleName, 1128, "Object is not being updated")
 	ErrUpdatePaymentAccountFailed   = errors.Register(ModuleName, 1129, "Update payment account failed")
 	ErrObjectChecksumsMissing       = errors.Register(ModuleName, 1130, "Object checksums is missing")
+	ErrNoSuchObjectVersion          = errors.Register(ModuleName, 1131, "No such object version")
+	ErrInvalidReadQuotaConsumption  = errors.Register(ModuleName, 1132, "Invalid read quota consumption")
+	ErrNoSuchOwnershipTransfer      = errors.Register(ModuleName, 1133, "No such bucket ownership transfer")
+	ErrTooManyPolicies              = errors.Register(ModuleName, 1134, "Too many policies")
 
 	ErrInvalidCrossChainPackage = errors.Register(ModuleName, 3000, "invalid cross chain package")
 	ErrAlreadyMirrored          = errors.Register(ModuleName, 3001, "resource is already mirrored")
@@ -2905,723 +2905,1083 @@ func (m *EventToggleSPAsDelegatedAgent) GetSpAsDelegatedAgentDisabled() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*EventCreateBucket)(nil), "moca.storage.EventCreateBucket")
-	proto.RegisterType((*EventDeleteBucket)(nil), "moca.storage.EventDeleteBucket")
-	proto.RegisterType((*EventUpdateBucketInfo)(nil), "moca.storage.EventUpdateBucketInfo")
-	proto.RegisterType((*EventDiscontinueBucket)(nil), "moca.storage.EventDiscontinueBucket")
-	proto.RegisterType((*EventCreateObject)(nil), "moca.storage.EventCreateObject")
-	proto.RegisterType((*EventCancelCreateObject)(nil), "moca.storage.EventCancelCreateObject")
-	proto.RegisterType((*EventSealObject)(nil), "moca.storage.EventSealObject")
-	proto.RegisterType((*EventCopyObject)(nil), "moca.storage.EventCopyObject")
-	proto.RegisterType((*EventDeleteObject)(nil), "moca.storage.EventDeleteObject")
-	proto.RegisterType((*EventRejectSealObject)(nil), "moca.storage.EventRejectSealObject")
-	proto.RegisterType((*EventDiscontinueObject)(nil), "moca.storage.EventDiscontinueObject")
-	proto.RegisterType((*EventUpdateObjectInfo)(nil), "moca.storage.EventUpdateObjectInfo")
-	proto.RegisterType((*EventCreateGroup)(nil), "moca.storage.EventCreateGroup")
-	proto.RegisterType((*EventDeleteGroup)(nil), "moca.storage.EventDeleteGroup")
-	proto.RegisterType((*EventLeaveGroup)(nil), "moca.storage.EventLeaveGroup")
-	proto.RegisterType((*EventUpdateGroupMember)(nil), "moca.storage.EventUpdateGroupMember")
-	proto.RegisterType((*EventRenewGroupMember)(nil), "moca.storage.EventRenewGroupMember")
-	proto.RegisterType((*EventGroupMemberDetail)(nil), "moca.storage.EventGroupMemberDetail")
-	proto.RegisterType((*EventUpdateGroupExtra)(nil), "moca.storage.EventUpdateGroupExtra")
-	proto.RegisterType((*EventMirrorBucket)(nil), "moca.storage.EventMirrorBucket")
-	proto.RegisterType((*EventMirrorBucketResult)(nil), "moca.storage.EventMirrorBucketResult")
-	proto.RegisterType((*EventMirrorObject)(nil), "moca.storage.EventMirrorObject")
-	proto.RegisterType((*EventMirrorObjectResult)(nil), "moca.storage.EventMirrorObjectResult")
-	proto.RegisterType((*EventMirrorGroup)(nil), "moca.storage.EventMirrorGroup")
-	proto.RegisterType((*EventMirrorGroupResult)(nil), "moca.storage.EventMirrorGroupResult")
-	proto.RegisterType((*EventStalePolicyCleanup)(nil), "moca.storage.EventStalePolicyCleanup")
-	proto.RegisterType((*EventMigrationBucket)(nil), "moca.storage.EventMigrationBucket")
-	proto.RegisterType((*EventCancelMigrationBucket)(nil), "moca.storage.EventCancelMigrationBucket")
-	proto.RegisterType((*EventRejectMigrateBucket)(nil), "moca.storage.EventRejectMigrateBucket")
-	proto.RegisterType((*EventCompleteMigrationBucket)(nil), "moca.storage.EventCompleteMigrationBucket")
-	proto.RegisterType((*EventSetTag)(nil), "moca.storage.EventSetTag")
-	proto.RegisterType((*EventUpdateObjectContent)(nil), "moca.storage.EventUpdateObjectContent")
-	proto.RegisterType((*EventUpdateObjectContentSuccess)(nil), "moca.storage.EventUpdateObjectContentSuccess")
-	proto.RegisterType((*EventCancelUpdateObjectContent)(nil), "moca.storage.EventCancelUpdateObjectContent")
-	proto.RegisterType((*EventSetBucketFlowRateLimit)(nil), "moca.storage.EventSetBucketFlowRateLimit")
-	proto.RegisterType((*EventBucketFlowRateLimitStatus)(nil), "moca.storage.EventBucketFlowRateLimitStatus")
-	proto.RegisterType((*EventToggleSPAsDelegatedAgent)(nil), "moca.storage.EventToggleSPAsDelegatedAgent")
+type EventSetBucketLifecycle struct {
+	// operator define the account address of operator who set the bucket lifecycle
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// rules define the lifecycle rules of the bucket
+	Rules []LifecycleRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules"`
 }
 
-func init() { proto.RegisterFile("moca/storage/events.proto", fileDescriptor_7b609fd45b314820) }
-
-var fileDescriptor_7b609fd45b314820 = []byte{
-	// 2117 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcb, 0x6f, 0x24, 0x47,
-	0x19, 0xdf, 0x9e, 0x97, 0x67, 0x6a, 0x3c, 0x33, 0xeb, 0xc6, 0xd9, 0x4c, 0x9c, 0x5d, 0x7b, 0xd2,
-	0x40, 0x70, 0xa2, 0x64, 0x66, 0xe5, 0xc0, 0x01, 0x85, 0x80, 0x6c, 0xef, 0x2e, 0x1a, 0xb4, 0xc9,
-	0x2e, 0x3d, 0x4e, 0x84, 0xb8, 0xb4, 0x6a, 0xba, 0xcb, 0xed, 0x66, 0xbb, 0xbb, 0x9a, 0xae, 0x1a,
-	0x7b, 0x27, 0x77, 0xb8, 0x84, 0x43, 0x2e, 0x1c, 0x81, 0x6b, 0x0e, 0x20, 0xe5, 0x10, 0xfe, 0x01,
-	0x90, 0x50, 0x2e, 0xa0, 0x28, 0x42, 0x09, 0xe2, 0xb0, 0xa0, 0x5d, 0x04, 0x17, 0x1e, 0x17, 0xee,
-	0xa0, 0x7a, 0x74, 0x4f, 0xf7, 0xf4, 0x78, 0x67, 0xda, 0x8e, 0xb3, 0xde, 0xbd, 0x58, 0xae, 0xaa,
-	0xaf, 0xaa, 0xbf, 0xc7, 0xef, 0x7b, 0xd4, 0x57, 0x03, 0x9e, 0xf1, 0xb0, 0x09, 0x7b, 0x84, 0xe2,
-	0x10, 0xda, 0xa8, 0x87, 0x0e, 0x91, 0x4f, 0x49, 0x37, 0x08, 0x31, 0xc5, 0xea, 0x32, 0x5b, 0xea,
-	0xca, 0xa5, 0xb5, 0x15, 0xe8, 0x39, 0x3e, 0xee, 0xf1, 0xbf, 0x82, 0x60, 0xed, 0x19, 0x13, 0x13,
-	0x0f, 0x13, 0x83, 0x8f, 0x7a, 0x62, 0x20, 0x97, 0x56, 0x6d, 0x6c, 0x63, 0x31, 0xcf, 0xfe, 0x93,
-	0xb3, 0x1b, 0x36, 0xc6, 0xb6, 0x8b, 0x7a, 0x7c, 0x34, 0x1c, 0xed, 0xf7, 0xa8, 0xe3, 0x21, 0x42,
-	0xa1, 0x17, 0x44, 0x27, 0x72, 0x6e, 0x42, 0x44, 0xf0, 0x28, 0x34, 0x51, 0x8f, 0x8e, 0x03, 0x44,
-	0x52, 0x4b, 0x11, 0xa3, 0x26, 0xf6, 0x3c, 0xec, 0xcb, 0xa5, 0x76, 0x6a, 0x29, 0xb1, 0x49, 0xfb,
-	0x5d, 0x09, 0xac, 0x5c, 0x67, 0x32, 0xed, 0x86, 0x08, 0x52, 0xb4, 0x33, 0x32, 0xef, 0x20, 0xaa,
-	0x76, 0x41, 0x19, 0x1f, 0xf9, 0x28, 0x6c, 0x2b, 0x1d, 0x65, 0xb3, 0xb6, 0xd3, 0xfe, 0xf8, 0x83,
-	0x97, 0x57, 0x25, 0xf7, 0xdb, 0x96, 0x15, 0x22, 0x42, 0x06, 0x34, 0x74, 0x7c, 0x5b, 0x17, 0x64,
-	0xea, 0x06, 0xa8, 0x0f, 0xf9, 0x4e, 0xc3, 0x87, 0x1e, 0x6a, 0x17, 0xd8, 0x2e, 0x1d, 0x88, 0xa9,
-	0x37, 0xa0, 0x87, 0xd4, 0x6f, 0x00, 0x70, 0xe8, 0x10, 0x67, 0xe8, 0xb8, 0x0e, 0x1d, 0xb7, 0x8b,
-	0x1d, 0x65, 0xb3, 0xb9, 0x75, 0xb9, 0x9b, 0x54, 0x5f, 0xf7, 0xad, 0x78, 0x7d, 0x6f, 0x1c, 0x20,
-	0x3d, 0x41, 0xaf, 0x3e, 0x0b, 0x6a, 0x26, 0x67, 0xcf, 0x80, 0xb4, 0x5d, 0xea, 0x28, 0x9b, 0x45,
-	0xbd, 0x2a, 0x26, 0xb6, 0xa9, 0xfa, 0x1a, 0xa8, 0xc9, 0x6f, 0x3b, 0x56, 0xbb, 0xcc, 0xf9, 0xed,
-	0x7c, 0x78, 0x6f, 0xe3, 0xc2, 0x9f, 0xef, 0x6d, 0x94, 0xde, 0x74, 0x7c, 0xfa, 0xf1, 0x07, 0x2f,
-	0xd7, 0x25, 0xef, 0x6c, 0xf8, 0xde, 0x3f, 0xde, 0x7f, 0x51, 0xd1, 0xab, 0x62, 0x4b, 0xdf, 0x52,
-	0xbf, 0x0e, 0xea, 0x42, 0x97, 0x06, 0x53, 0x4b, 0xbb, 0xc2, 0x59, 0x6b, 0xa7, 0x59, 0x1b, 0x70,
-	0x02, 0xc1, 0x16, 0x89, 0xff, 0x57, 0x5f, 0x02, 0xaa, 0x79, 0x00, 0x43, 0x1b, 0x59, 0x46, 0x88,
-	0xa0, 0x65, 0xfc, 0x70, 0x84, 0x29, 0x6c, 0x2f, 0x75, 0x94, 0xcd, 0x92, 0x7e, 0x51, 0xae, 0xe8,
-	0x08, 0x5a, 0xdf, 0x65, 0xf3, 0xea, 0x36, 0x68, 0x05, 0x70, 0xec, 0x21, 0x9f, 0x1a, 0x50, 0xe8,
-	0xb0, 0x5d, 0x9d, 0xa3, 0xdd, 0xa6, 0xdc, 0x20, 0x67, 0x55, 0x0d, 0x34, 0x82, 0xd0, 0xf1, 0x60,
-	0x38, 0x36, 0x48, 0xc0, 0xc4, 0xad, 0x75, 0x94, 0xcd, 0x86, 0x5e, 0x97, 0x93, 0x83, 0xa0, 0x6f,
-	0xa9, 0x3b, 0x60, 0xdd, 0x76, 0xf1, 0x10, 0xba, 0xc6, 0xa1, 0x13, 0xd2, 0x11, 0x74, 0x0d, 0x3b,
-	0xc4, 0xa3, 0xc0, 0xd8, 0x87, 0x9e, 0xe3, 0x8e, 0xd9, 0x26, 0xc0, 0x37, 0xad, 0x09, 0xaa, 0xb7,
-	0x04, 0xd1, 0xb7, 0x19, 0xcd, 0x0d, 0x4e, 0xd2, 0xb7, 0xd4, 0x2d, 0x50, 0x21, 0x14, 0xd2, 0x11,
-	0x69, 0xd7, 0xb9, 0x3a, 0xd6, 0xd2, 0xea, 0x10, 0x20, 0x19, 0x70, 0x0a, 0x5d, 0x52, 0x6a, 0x3f,
-	0x2b, 0x48, 0x20, 0x5d, 0x43, 0x2e, 0x8a, 0x81, 0xf4, 0x55, 0x50, 0xc5, 0x01, 0x0a, 0x21, 0xc5,
-	0xf3, 0xb1, 0x14, 0x53, 0x4e, 0xe0, 0x57, 0x38, 0x11, 0xfc, 0x8a, 0x19, 0xf8, 0xa5, 0x30, 0x52,
-	0xca, 0x8d, 0x91, 0xf9, 0x3a, 0x2d, 0xcf, 0xd3, 0xa9, 0xf6, 0xe3, 0x22, 0x78, 0x8a, 0xeb, 0xe7,
-	0xcd, 0xc0, 0x8a, 0x1d, 0xad, 0xef, 0xef, 0xe3, 0x13, 0xea, 0x68, 0xae, 0xcb, 0xa5, 0x64, 0x2e,
-	0xe6, 0x96, 0x79, 0x36, 0xb8, 0x4b, 0xc7, 0x80, 0xfb, 0x2b, 0x59, 0x70, 0x73, 0x57, 0xcc, 0x40,
-	0x38, 0x1d, 0x08, 0x2a, 0x39, 0x03, 0xc1, 0x7c, 0x43, 0x2c, 0xcd, 0x35, 0xc4, 0xaf, 0x14, 0x70,
-	0x49, 0x00, 0xd5, 0x21, 0x26, 0xf6, 0xa9, 0xe3, 0x8f, 0x22, 0xb4, 0xa6, 0x54, 0xa6, 0xe4, 0x56,
-	0xd9, 0x5c, 0x93, 0x5c, 0x02, 0x95, 0x10, 0x41, 0x82, 0x7d, 0x09, 0x51, 0x39, 0x62, 0xf1, 0xcd,
-	0xe2, 0x5e, 0x93, 0x88, 0x6f, 0x62, 0x62, 0x9b, 0x6a, 0x3f, 0xaa, 0xa4, 0x22, 0xf4, 0xad, 0xe1,
-	0x0f, 0x90, 0x49, 0xd5, 0x2d, 0xb0, 0xc4, 0x23, 0xe0, 0x02, 0x98, 0x89, 0x08, 0x3f, 0x7b, 0xb7,
-	0xda, 0x00, 0x75, 0xcc, 0xd9, 0x11, 0x04, 0x25, 0x41, 0x20, 0xa6, 0xb2, 0x18, 0xac, 0xe4, 0x56,
-	0xe8, 0x6b, 0xa0, 0x26, 0xcf, 0x97, 0x96, 0x5d, 0x68, 0xbb, 0xd8, 0xd2, 0xb7, 0xb2, 0xe1, 0xb2,
-	0x9a, 0x0d, 0x97, 0xcf, 0x81, 0xe5, 0x00, 0x8e, 0x5d, 0x0c, 0x2d, 0x83, 0x38, 0x6f, 0x23, 0x1e,
-	0x51, 0x4b, 0x7a, 0x5d, 0xce, 0x0d, 0x9c, 0xb7, 0xa7, 0x73, 0x17, 0xc8, 0x09, 0xd9, 0xe7, 0xc0,
-	0x32, 0x43, 0x19, 0xf3, 0x0c, 0x9e, 0x60, 0xea, 0x5c, 0x49, 0x75, 0x39, 0xc7, 0xf3, 0x48, 0x2a,
-	0xbd, 0x2d, 0x4f, 0xa5, 0xb7, 0x49, 0x2c, 0x6e, 0xcc, 0x8a, 0xc5, 0x02, 0x0e, 0xe9, 0x58, 0xac,
-	0x5e, 0x07, 0xad, 0x10, 0x59, 0x23, 0xdf, 0x82, 0xbe, 0x39, 0x16, 0x9f, 0x6d, 0xce, 0x62, 0x5b,
-	0x8f, 0x89, 0x38, 0xdb, 0xcd, 0x30, 0x35, 0x9e, 0x4e, 0x8d, 0xad, 0x1c, 0xa9, 0xf1, 0x32, 0xa8,
-	0x99, 0x07, 0xc8, 0xbc, 0x43, 0x46, 0x1e, 0x69, 0x5f, 0xec, 0x14, 0x37, 0x97, 0xf5, 0xc9, 0x84,
-	0xfa, 0x0a, 0xb8, 0xe4, 0x62, 0x33, 0xe3, 0xc5, 0x8e, 0xd5, 0x5e, 0xe1, 0x16, 0xfa, 0x02, 0x5f,
-	0x4d, 0x7a, 0x6f, 0xdf, 0xd2, 0xfe, 0xab, 0x80, 0xa7, 0x85, 0x1f, 0x40, 0xdf, 0x44, 0x6e, 0xca,
-	0x1b, 0xce, 0x28, 0x84, 0x4e, 0xe1, 0xbb, 0x98, 0xc1, 0x77, 0x06, 0x61, 0xa5, 0x2c, 0xc2, 0x52,
-	0x20, 0xae, 0xe4, 0x05, 0x31, 0xcb, 0x1b, 0x2d, 0x2e, 0xf6, 0x00, 0x41, 0xf7, 0x11, 0x8b, 0x9b,
-	0x12, 0xa5, 0x9c, 0xdb, 0x1f, 0x27, 0x50, 0xae, 0x2c, 0x0c, 0xe5, 0xaf, 0x81, 0xa7, 0x67, 0x46,
-	0xfc, 0x38, 0xd4, 0xaf, 0x66, 0x43, 0x7d, 0xdf, 0x7a, 0x08, 0xc2, 0xaa, 0xc7, 0x22, 0x2c, 0x0d,
-	0xda, 0xda, 0x14, 0x68, 0xb5, 0xf7, 0x22, 0x43, 0xec, 0xe2, 0x60, 0x7c, 0x2a, 0x43, 0x3c, 0x0f,
-	0x5a, 0x24, 0x34, 0x8d, 0xac, 0x31, 0x1a, 0x24, 0x34, 0x77, 0x26, 0xf6, 0x90, 0x74, 0x59, 0x9b,
-	0x30, 0xba, 0x5b, 0x13, 0xb3, 0x3c, 0x0f, 0x5a, 0x16, 0xa1, 0xa9, 0xf3, 0x44, 0x28, 0x6e, 0x58,
-	0x84, 0xa6, 0xcf, 0x63, 0x74, 0xc9, 0xf3, 0xca, 0x31, 0x5d, 0xe2, 0xbc, 0x6b, 0xa0, 0x91, 0xf8,
-	0x6e, 0x0e, 0xd4, 0xd6, 0x63, 0xbe, 0xfa, 0x16, 0x3b, 0x25, 0xf1, 0xb5, 0x1c, 0x01, 0xbc, 0x1e,
-	0x73, 0x73, 0x42, 0x43, 0x6a, 0xff, 0x53, 0x52, 0xb5, 0xe8, 0x79, 0xf2, 0x9a, 0x52, 0x6e, 0xaf,
-	0x39, 0x5e, 0x03, 0xe5, 0xe3, 0x35, 0xf0, 0x2f, 0x45, 0x56, 0x9b, 0x3a, 0xe2, 0x4e, 0x75, 0xce,
-	0x62, 0x47, 0x7e, 0x2d, 0x5c, 0x01, 0x60, 0x1f, 0x87, 0xc6, 0x88, 0x17, 0xcf, 0x5c, 0xf2, 0xaa,
-	0x5e, 0xdb, 0xc7, 0xa1, 0xa8, 0xa6, 0x67, 0x16, 0x75, 0x52, 0xe0, 0x29, 0xd6, 0x95, 0x59, 0x85,
-	0xf2, 0x84, 0xb3, 0x42, 0x6e, 0xce, 0x4e, 0x54, 0xd4, 0xfd, 0xa4, 0x90, 0xba, 0x0d, 0x48, 0xb8,
-	0x9f, 0xe1, 0x6d, 0xe0, 0xac, 0xed, 0x93, 0x2e, 0x92, 0xca, 0xf9, 0x8a, 0x24, 0xed, 0x3f, 0x0a,
-	0xb8, 0x98, 0xa8, 0x71, 0x39, 0x8a, 0x73, 0x37, 0x21, 0xae, 0x00, 0x20, 0x5c, 0x23, 0xa1, 0x82,
-	0x1a, 0x9f, 0xe1, 0x02, 0xbe, 0x0a, 0xaa, 0xb1, 0xe7, 0x2c, 0x7a, 0x1d, 0x5a, 0xb2, 0x65, 0x6a,
-	0x98, 0x2a, 0x85, 0x4a, 0x39, 0x4a, 0xa1, 0x55, 0x50, 0x46, 0x77, 0x69, 0x08, 0x65, 0xac, 0x15,
-	0x03, 0xed, 0xe7, 0x91, 0xc4, 0x22, 0x44, 0x4d, 0x49, 0x5c, 0x38, 0x89, 0xc4, 0xc5, 0x87, 0x49,
-	0x5c, 0xca, 0x29, 0xb1, 0x76, 0x4f, 0x91, 0xe9, 0xee, 0x26, 0x82, 0x87, 0x92, 0xbf, 0x6f, 0x81,
-	0xa6, 0x87, 0xbc, 0x21, 0x0a, 0xe3, 0x4b, 0xde, 0x3c, 0xd3, 0x34, 0x04, 0x7d, 0x74, 0xfb, 0x3b,
-	0x4f, 0x02, 0xfe, 0xb3, 0x20, 0x43, 0x86, 0x70, 0x41, 0x2e, 0xe1, 0xeb, 0x9c, 0xdb, 0xcf, 0xa9,
-	0x6b, 0x71, 0x86, 0xc2, 0xa9, 0xdf, 0x89, 0x2c, 0x45, 0x0c, 0x8a, 0x99, 0xb5, 0xda, 0xe5, 0x4e,
-	0x71, 0xb3, 0xbe, 0xf5, 0xa5, 0x34, 0x64, 0xb9, 0xfc, 0x09, 0xc9, 0xaf, 0x21, 0x0a, 0x1d, 0x57,
-	0x5f, 0x96, 0x7b, 0xf7, 0xf0, 0xb6, 0xc5, 0x12, 0xf9, 0x4a, 0xe2, 0x2c, 0x11, 0xc2, 0xda, 0x95,
-	0x4e, 0xf1, 0xa1, 0x32, 0xb6, 0xe2, 0x23, 0x04, 0xc0, 0xb5, 0x3f, 0x16, 0xe2, 0x8c, 0xe4, 0xa3,
-	0xa3, 0x27, 0x4b, 0xdb, 0x53, 0xd1, 0xa1, 0x9c, 0x23, 0x3a, 0x7c, 0x13, 0x2c, 0x49, 0x4d, 0x71,
-	0x95, 0x2e, 0x6a, 0xa1, 0x68, 0x93, 0xf6, 0xd3, 0x28, 0xf1, 0x65, 0x68, 0xd4, 0xab, 0xa0, 0x22,
-	0xa8, 0xe6, 0x6a, 0x55, 0xd2, 0xa9, 0x7d, 0xd0, 0x42, 0x77, 0x03, 0x27, 0x84, 0xd4, 0xc1, 0xbe,
-	0x41, 0x1d, 0x19, 0x46, 0xeb, 0x5b, 0x6b, 0x5d, 0xd1, 0x97, 0xee, 0x46, 0x7d, 0xe9, 0xee, 0x5e,
-	0xd4, 0x97, 0xde, 0x29, 0xbd, 0xfb, 0x97, 0x0d, 0x45, 0x6f, 0x4e, 0x36, 0xb2, 0x25, 0x16, 0xd1,
-	0x9f, 0x9a, 0xf6, 0xae, 0xeb, 0x2c, 0xf2, 0x3d, 0x01, 0xe6, 0x9e, 0x1d, 0xd1, 0x7f, 0x1f, 0x15,
-	0x9d, 0xaf, 0x3b, 0x61, 0x88, 0xc3, 0x53, 0x35, 0x40, 0xf3, 0x35, 0xf7, 0xf2, 0x37, 0x34, 0x35,
-	0xd0, 0xb0, 0x10, 0xa1, 0x86, 0x79, 0x00, 0x1d, 0x7f, 0x52, 0x4a, 0xd6, 0xd9, 0xe4, 0x2e, 0x9b,
-	0xeb, 0x5b, 0xda, 0xaf, 0xa3, 0xfb, 0x76, 0x52, 0x1e, 0x1d, 0x91, 0x91, 0x4b, 0x59, 0xcd, 0x23,
-	0x6f, 0x72, 0x0a, 0xdf, 0x18, 0xdd, 0xd6, 0xce, 0x03, 0xdf, 0xff, 0x4e, 0xdb, 0xe1, 0xf1, 0x2e,
-	0x7b, 0x17, 0x11, 0xf8, 0x93, 0xb4, 0xa1, 0x84, 0xc0, 0xa7, 0x35, 0xd4, 0x79, 0x10, 0xec, 0x37,
-	0x51, 0x8d, 0x24, 0x04, 0x3b, 0x7f, 0x55, 0x61, 0x46, 0x88, 0x52, 0x56, 0x88, 0xf7, 0xa3, 0x00,
-	0x9d, 0x10, 0x62, 0x8e, 0x71, 0x1e, 0x35, 0xcb, 0x81, 0xc4, 0xd3, 0x80, 0x42, 0x17, 0xdd, 0xc6,
-	0xae, 0x63, 0x8e, 0x77, 0x5d, 0x04, 0xfd, 0x51, 0xa0, 0xae, 0x81, 0xea, 0xd0, 0xc5, 0xe6, 0x9d,
-	0x37, 0x46, 0x1e, 0x67, 0xba, 0xa8, 0xc7, 0x63, 0x96, 0x05, 0xe5, 0x85, 0xc7, 0xf1, 0xf7, 0xb1,
-	0xcc, 0x1c, 0x53, 0x59, 0x50, 0x14, 0x03, 0xec, 0xa2, 0xa3, 0x03, 0x2b, 0xfe, 0x5f, 0x7b, 0xa7,
-	0x00, 0x56, 0xa5, 0x92, 0x6c, 0x91, 0x44, 0x3e, 0xc7, 0xf0, 0x99, 0xff, 0x6d, 0xe4, 0x05, 0xb0,
-	0x62, 0x11, 0x6a, 0xcc, 0x6a, 0xfd, 0x35, 0x2d, 0x42, 0x6f, 0x27, 0xba, 0x7f, 0x93, 0x9e, 0x57,
-	0x79, 0xe1, 0xa7, 0xb4, 0xbf, 0x2b, 0x60, 0x2d, 0xd1, 0xe9, 0x7c, 0x3c, 0x74, 0x32, 0x11, 0xb4,
-	0xb4, 0xb0, 0xa0, 0x7f, 0x53, 0x40, 0x3b, 0xd1, 0xa5, 0x10, 0x82, 0xa2, 0x27, 0x4e, 0xcc, 0x4f,
-	0x0b, 0xe0, 0xb2, 0xec, 0x1c, 0x7a, 0x01, 0xc3, 0xfc, 0xe3, 0x61, 0xd1, 0xf9, 0x8f, 0x6d, 0xa5,
-	0xb9, 0x2f, 0xc9, 0x2f, 0x80, 0x15, 0x12, 0x9a, 0x53, 0x9e, 0x22, 0x42, 0x7d, 0x93, 0x84, 0xe6,
-	0x6c, 0x4f, 0xa9, 0x2c, 0xac, 0xd9, 0x77, 0x14, 0x50, 0x97, 0xcd, 0x71, 0xba, 0x07, 0x6d, 0x16,
-	0x9e, 0xa2, 0x9f, 0x46, 0xc8, 0x46, 0x4f, 0x3c, 0x56, 0xbb, 0xa0, 0x44, 0xa1, 0x4d, 0xe2, 0x8a,
-	0x76, 0xea, 0x25, 0x44, 0xd6, 0xe4, 0xd0, 0x26, 0x3a, 0xa7, 0x53, 0xaf, 0x82, 0x42, 0x8e, 0x2e,
-	0x77, 0xc1, 0xb1, 0xb4, 0x5f, 0x16, 0x24, 0x9c, 0x93, 0x4d, 0x9d, 0x5d, 0xf1, 0xd0, 0x73, 0x42,
-	0x1b, 0x9f, 0xb2, 0x37, 0x75, 0xfa, 0x17, 0xbc, 0xe9, 0xf7, 0xb1, 0x72, 0xf6, 0x7d, 0x2c, 0xd5,
-	0x36, 0xaf, 0x4c, 0xbf, 0xf5, 0xb4, 0xc1, 0xd2, 0x21, 0x0a, 0x89, 0x83, 0x7d, 0xde, 0x00, 0x2e,
-	0xea, 0xd1, 0x50, 0xfb, 0xa4, 0x08, 0x36, 0x8e, 0x53, 0xd7, 0x60, 0x64, 0x9a, 0x88, 0x90, 0xc7,
-	0x58, 0x6b, 0xa9, 0x47, 0xbf, 0x72, 0xf6, 0xd1, 0xef, 0x45, 0xb0, 0x12, 0x84, 0xe8, 0xd0, 0x48,
-	0x69, 0xb7, 0xc2, 0xb5, 0xdb, 0x62, 0x0b, 0xb7, 0x13, 0x1a, 0xde, 0x04, 0x17, 0x7d, 0x74, 0x94,
-	0x26, 0x15, 0x3f, 0x33, 0x69, 0xfa, 0xe8, 0x28, 0x49, 0xf9, 0x65, 0xd0, 0xe4, 0xa7, 0x4e, 0x0c,
-	0x52, 0xe5, 0x06, 0x69, 0xb0, 0xd9, 0xdd, 0xd8, 0x28, 0x5f, 0x04, 0x0d, 0x76, 0xe0, 0xf4, 0x6b,
-	0xc7, 0xb2, 0x8f, 0x8e, 0x76, 0x67, 0x59, 0x0e, 0xa4, 0x2c, 0xc7, 0x0a, 0x14, 0xd1, 0x88, 0xb5,
-	0x0c, 0x48, 0xf9, 0x8b, 0x66, 0x51, 0xaf, 0xc9, 0x99, 0x6d, 0xaa, 0x7d, 0xaa, 0x80, 0xf5, 0x44,
-	0xfe, 0xfa, 0xec, 0xbc, 0xe1, 0x51, 0x57, 0xad, 0xda, 0x1f, 0x0a, 0xe0, 0xd9, 0x28, 0xde, 0x88,
-	0x80, 0x74, 0xc3, 0xc5, 0x47, 0x3a, 0xa4, 0xe8, 0xa6, 0xe3, 0x39, 0x67, 0x26, 0xd6, 0x8c, 0x9f,
-	0x0e, 0x15, 0x73, 0xfe, 0x74, 0xe8, 0x55, 0xb0, 0x2c, 0xbf, 0x21, 0xaa, 0xe7, 0xd2, 0x9c, 0xfd,
-	0x92, 0xa3, 0x5b, 0xbc, 0x86, 0xfe, 0x1e, 0x68, 0xed, 0xbb, 0xf8, 0xc8, 0x60, 0xd9, 0xd9, 0x70,
-	0x99, 0xa4, 0x32, 0x2e, 0x5e, 0x95, 0xba, 0x7b, 0x4a, 0x9c, 0x41, 0xac, 0x3b, 0x5d, 0x07, 0xf7,
-	0x3c, 0x48, 0x0f, 0xba, 0x7d, 0xae, 0x4c, 0x20, 0x0f, 0xef, 0x47, 0xba, 0x6c, 0xec, 0x27, 0x15,
-	0xa6, 0xfd, 0x22, 0x82, 0xca, 0x0c, 0x6d, 0x0e, 0x66, 0x5e, 0x55, 0xb2, 0xfd, 0xfb, 0x2b, 0x00,
-	0x38, 0x44, 0xb0, 0x85, 0x84, 0xbb, 0x57, 0xf5, 0x9a, 0x43, 0x6e, 0x8a, 0x89, 0x53, 0x66, 0x41,
-	0xed, 0xb7, 0x0a, 0xb8, 0xc2, 0x39, 0xdc, 0xc3, 0xb6, 0xed, 0xa2, 0xc1, 0xed, 0x6d, 0xc2, 0x8a,
-	0x58, 0x9b, 0x63, 0xdd, 0x66, 0x58, 0x5e, 0xe4, 0x81, 0x61, 0xc2, 0x41, 0xe1, 0x24, 0x79, 0x98,
-	0x04, 0x06, 0x24, 0xbc, 0xf5, 0x66, 0x0b, 0xaf, 0x63, 0x1f, 0x36, 0x2c, 0x87, 0xc0, 0xa1, 0x8b,
-	0x84, 0x54, 0x55, 0x7d, 0x8d, 0x04, 0xd3, 0xbc, 0x5d, 0x93, 0x14, 0x3b, 0x37, 0x3e, 0xbc, 0xbf,
-	0xae, 0x7c, 0x74, 0x7f, 0x5d, 0xf9, 0xeb, 0xfd, 0x75, 0xe5, 0xdd, 0x07, 0xeb, 0x17, 0x3e, 0x7a,
-	0xb0, 0x7e, 0xe1, 0x4f, 0x0f, 0xd6, 0x2f, 0x7c, 0xff, 0x25, 0xdb, 0xa1, 0x07, 0xa3, 0x61, 0xd7,
-	0xc4, 0x5e, 0x8f, 0xa5, 0x44, 0x7e, 0x31, 0xe0, 0xff, 0xf5, 0x0e, 0xb7, 0x7a, 0x77, 0xd3, 0x3f,
-	0x1a, 0x1c, 0x56, 0x78, 0x03, 0xe8, 0x95, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x47, 0xd4,
-	0x77, 0x15, 0x29, 0x00, 0x00,
+func (m *EventSetBucketLifecycle) Reset()         { *m = EventSetBucketLifecycle{} }
+func (m *EventSetBucketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*EventSetBucketLifecycle) ProtoMessage()    {}
+func (*EventSetBucketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{37}
 }
-
-func (m *EventCreateBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetBucketLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetBucketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetBucketLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventCreateBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSetBucketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetBucketLifecycle.Merge(m, src)
+}
+func (m *EventSetBucketLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetBucketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetBucketLifecycle.DiscardUnknown(m)
 }
 
-func (m *EventCreateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.ChargedReadQuota != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ChargedReadQuota))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.SourceType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.CreateAt != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.CreateAt))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Visibility != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EventSetBucketLifecycle proto.InternalMessageInfo
+
+func (m *EventSetBucketLifecycle) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventSetBucketLifecycle) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDeleteBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetBucketLifecycle) GetRules() []LifecycleRule {
+	if m != nil {
+		return m.Rules
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventDeleteBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventExpireObject struct {
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_id define an u256 id for object
+	ObjectId Uint `protobuf:"bytes,2,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// rule_id define the lifecycle rule the object matched
+	RuleId string `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 }
 
-func (m *EventDeleteBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventExpireObject) Reset()         { *m = EventExpireObject{} }
+func (m *EventExpireObject) String() string { return proto.CompactTextString(m) }
+func (*EventExpireObject) ProtoMessage()    {}
+func (*EventExpireObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{38}
+}
+func (m *EventExpireObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExpireObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExpireObject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventExpireObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpireObject.Merge(m, src)
+}
+func (m *EventExpireObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExpireObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpireObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExpireObject proto.InternalMessageInfo
+
+func (m *EventExpireObject) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventUpdateBucketInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventExpireObject) GetRuleId() string {
+	if m != nil {
+		return m.RuleId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventUpdateBucketInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventSetBucketVersioning struct {
+	// operator define the account address of operator who set the bucket versioning
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// max_versions define how many prior versions are kept for each object, 0 means versioning is disabled
+	MaxVersions uint32 `protobuf:"varint,4,opt,name=max_versions,json=maxVersions,proto3" json:"max_versions,omitempty"`
 }
 
-func (m *EventUpdateBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Visibility != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EventSetBucketVersioning) Reset()         { *m = EventSetBucketVersioning{} }
+func (m *EventSetBucketVersioning) String() string { return proto.CompactTextString(m) }
+func (*EventSetBucketVersioning) ProtoMessage()    {}
+func (*EventSetBucketVersioning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{39}
+}
+func (m *EventSetBucketVersioning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetBucketVersioning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetBucketVersioning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ChargedReadQuota != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ChargedReadQuota))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *EventSetBucketVersioning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetBucketVersioning.Merge(m, src)
+}
+func (m *EventSetBucketVersioning) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetBucketVersioning) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetBucketVersioning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetBucketVersioning proto.InternalMessageInfo
+
+func (m *EventSetBucketVersioning) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *EventSetBucketVersioning) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventSetBucketVersioning) GetMaxVersions() uint32 {
+	if m != nil {
+		return m.MaxVersions
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+type EventRestoreObjectVersion struct {
+	// operator define the account address of operator who restored the object version
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name define the name of the object
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// object_id define an u256 id for object
+	ObjectId Uint `protobuf:"bytes,4,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// restored_version define the prior version whose content is restored
+	RestoredVersion int64 `protobuf:"varint,5,opt,name=restored_version,json=restoredVersion,proto3" json:"restored_version,omitempty"`
+	// version define the new version of the object
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *EventRestoreObjectVersion) Reset()         { *m = EventRestoreObjectVersion{} }
+func (m *EventRestoreObjectVersion) String() string { return proto.CompactTextString(m) }
+func (*EventRestoreObjectVersion) ProtoMessage()    {}
+func (*EventRestoreObjectVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{40}
+}
+func (m *EventRestoreObjectVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRestoreObjectVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRestoreObjectVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventRestoreObjectVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRestoreObjectVersion.Merge(m, src)
+}
+func (m *EventRestoreObjectVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRestoreObjectVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRestoreObjectVersion.DiscardUnknown(m)
 }
 
-func (m *EventDiscontinueBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventRestoreObjectVersion proto.InternalMessageInfo
+
+func (m *EventRestoreObjectVersion) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDiscontinueBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRestoreObjectVersion) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
 }
 
-func (m *EventDiscontinueBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DeleteAt != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DeleteAt))
-		i--
-		dAtA[i] = 0x20
+func (m *EventRestoreObjectVersion) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventRestoreObjectVersion) GetRestoredVersion() int64 {
+	if m != nil {
+		return m.RestoredVersion
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EventRestoreObjectVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+type EventSetBucketReadQuotaAutoTopup struct {
+	// operator define the account address of operator who set the read quota auto topup
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// auto_topup define how the charged read quota is raised, a zero max_read_quota means the auto topup is disabled
+	AutoTopup ReadQuotaAutoTopup `protobuf:"bytes,4,opt,name=auto_topup,json=autoTopup,proto3" json:"auto_topup"`
+}
+
+func (m *EventSetBucketReadQuotaAutoTopup) Reset()         { *m = EventSetBucketReadQuotaAutoTopup{} }
+func (m *EventSetBucketReadQuotaAutoTopup) String() string { return proto.CompactTextString(m) }
+func (*EventSetBucketReadQuotaAutoTopup) ProtoMessage()    {}
+func (*EventSetBucketReadQuotaAutoTopup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{41}
+}
+func (m *EventSetBucketReadQuotaAutoTopup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetBucketReadQuotaAutoTopup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetBucketReadQuotaAutoTopup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EventSetBucketReadQuotaAutoTopup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetBucketReadQuotaAutoTopup.Merge(m, src)
+}
+func (m *EventSetBucketReadQuotaAutoTopup) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetBucketReadQuotaAutoTopup) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetBucketReadQuotaAutoTopup.DiscardUnknown(m)
 }
 
-func (m *EventCreateObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventSetBucketReadQuotaAutoTopup proto.InternalMessageInfo
+
+func (m *EventSetBucketReadQuotaAutoTopup) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventCreateObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSetBucketReadQuotaAutoTopup) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
 }
 
-func (m *EventCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LocalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
+func (m *EventSetBucketReadQuotaAutoTopup) GetAutoTopup() ReadQuotaAutoTopup {
+	if m != nil {
+		return m.AutoTopup
 	}
-	if len(m.Checksums) > 0 {
-		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Checksums[iNdEx])
-			copy(dAtA[i:], m.Checksums[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
+	return ReadQuotaAutoTopup{}
+}
+
+type EventReportReadQuotaConsumption struct {
+	// operator define the operator address of the primary SP which reported the consumption
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// month define the UTC month of the consumption, in the form of yyyymm
+	Month uint32 `protobuf:"varint,4,opt,name=month,proto3" json:"month,omitempty"`
+	// consumed_read_quota define the charged read quota consumed since the beginning of the month
+	ConsumedReadQuota uint64 `protobuf:"varint,5,opt,name=consumed_read_quota,json=consumedReadQuota,proto3" json:"consumed_read_quota,omitempty"`
+}
+
+func (m *EventReportReadQuotaConsumption) Reset()         { *m = EventReportReadQuotaConsumption{} }
+func (m *EventReportReadQuotaConsumption) String() string { return proto.CompactTextString(m) }
+func (*EventReportReadQuotaConsumption) ProtoMessage()    {}
+func (*EventReportReadQuotaConsumption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{42}
+}
+func (m *EventReportReadQuotaConsumption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReportReadQuotaConsumption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReportReadQuotaConsumption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SourceType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
-		i--
-		dAtA[i] = 0x78
+}
+func (m *EventReportReadQuotaConsumption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReportReadQuotaConsumption.Merge(m, src)
+}
+func (m *EventReportReadQuotaConsumption) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReportReadQuotaConsumption) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReportReadQuotaConsumption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReportReadQuotaConsumption proto.InternalMessageInfo
+
+func (m *EventReportReadQuotaConsumption) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if m.RedundancyType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.RedundancyType))
-		i--
-		dAtA[i] = 0x70
+	return ""
+}
+
+func (m *EventReportReadQuotaConsumption) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.CreateAt != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.CreateAt))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Visibility != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *EventReportReadQuotaConsumption) GetMonth() uint32 {
+	if m != nil {
+		return m.Month
 	}
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x40
+	return 0
+}
+
+func (m *EventReportReadQuotaConsumption) GetConsumedReadQuota() uint64 {
+	if m != nil {
+		return m.ConsumedReadQuota
 	}
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+type EventReadQuotaAutoTopup struct {
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,2,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// previous_read_quota define the charged read quota before the topup
+	PreviousReadQuota uint64 `protobuf:"varint,3,opt,name=previous_read_quota,json=previousReadQuota,proto3" json:"previous_read_quota,omitempty"`
+	// charged_read_quota define the charged read quota after the topup
+	ChargedReadQuota uint64 `protobuf:"varint,4,opt,name=charged_read_quota,json=chargedReadQuota,proto3" json:"charged_read_quota,omitempty"`
+}
+
+func (m *EventReadQuotaAutoTopup) Reset()         { *m = EventReadQuotaAutoTopup{} }
+func (m *EventReadQuotaAutoTopup) String() string { return proto.CompactTextString(m) }
+func (*EventReadQuotaAutoTopup) ProtoMessage()    {}
+func (*EventReadQuotaAutoTopup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{43}
+}
+func (m *EventReadQuotaAutoTopup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReadQuotaAutoTopup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReadQuotaAutoTopup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+}
+func (m *EventReadQuotaAutoTopup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReadQuotaAutoTopup.Merge(m, src)
+}
+func (m *EventReadQuotaAutoTopup) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReadQuotaAutoTopup) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReadQuotaAutoTopup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReadQuotaAutoTopup proto.InternalMessageInfo
+
+func (m *EventReadQuotaAutoTopup) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *EventReadQuotaAutoTopup) GetPreviousReadQuota() uint64 {
+	if m != nil {
+		return m.PreviousReadQuota
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *EventReadQuotaAutoTopup) GetChargedReadQuota() uint64 {
+	if m != nil {
+		return m.ChargedReadQuota
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+type EventSetBucketCallback struct {
+	// operator define the account address of the bucket owner who set the callback
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// callback define the contract notified about the objects of the bucket, an empty callback_address means it is removed
+	Callback BucketCallback `protobuf:"bytes,4,opt,name=callback,proto3" json:"callback"`
+}
+
+func (m *EventSetBucketCallback) Reset()         { *m = EventSetBucketCallback{} }
+func (m *EventSetBucketCallback) String() string { return proto.CompactTextString(m) }
+func (*EventSetBucketCallback) ProtoMessage()    {}
+func (*EventSetBucketCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{44}
+}
+func (m *EventSetBucketCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetBucketCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetBucketCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventSetBucketCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetBucketCallback.Merge(m, src)
+}
+func (m *EventSetBucketCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetBucketCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetBucketCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetBucketCallback proto.InternalMessageInfo
+
+func (m *EventSetBucketCallback) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventCancelCreateObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetBucketCallback) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventCancelCreateObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSetBucketCallback) GetCallback() BucketCallback {
+	if m != nil {
+		return m.Callback
+	}
+	return BucketCallback{}
 }
 
-func (m *EventCancelCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+type EventObjectCallback struct {
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name define the name of the object
+	ObjectName string `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// object_id define an u256 id for object
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// callback_address define the address of the callback contract
+	CallbackAddress string `protobuf:"bytes,4,opt,name=callback_address,json=callbackAddress,proto3" json:"callback_address,omitempty"`
+	// method define the callback method called, one of onObjectSealed, onObjectRejected and onObjectDeleted
+	Method string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// success define whether the callback succeeded, a failed callback does not revert the storage operation
+	Success bool `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	// gas_used define the gas used by the callback
+	GasUsed uint64 `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// error define why the callback failed
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventObjectCallback) Reset()         { *m = EventObjectCallback{} }
+func (m *EventObjectCallback) String() string { return proto.CompactTextString(m) }
+func (*EventObjectCallback) ProtoMessage()    {}
+func (*EventObjectCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{45}
+}
+func (m *EventObjectCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObjectCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObjectCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *EventObjectCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObjectCallback.Merge(m, src)
+}
+func (m *EventObjectCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObjectCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObjectCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObjectCallback proto.InternalMessageInfo
+
+func (m *EventObjectCallback) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventObjectCallback) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventObjectCallback) GetCallbackAddress() string {
+	if m != nil {
+		return m.CallbackAddress
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventObjectCallback) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSealObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventObjectCallback) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *EventSealObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventObjectCallback) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
 }
 
-func (m *EventSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Checksums) > 0 {
-		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Checksums[iNdEx])
-			copy(dAtA[i:], m.Checksums[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
+func (m *EventObjectCallback) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type EventTransferBucketOwnership struct {
+	// operator define the account address of the bucket owner who proposed the transfer
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// new_owner define the account address the bucket is transferred to, an empty new_owner means the transfer is canceled
+	NewOwner string `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *EventTransferBucketOwnership) Reset()         { *m = EventTransferBucketOwnership{} }
+func (m *EventTransferBucketOwnership) String() string { return proto.CompactTextString(m) }
+func (*EventTransferBucketOwnership) ProtoMessage()    {}
+func (*EventTransferBucketOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{46}
+}
+func (m *EventTransferBucketOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTransferBucketOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTransferBucketOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.LocalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
-		i--
-		dAtA[i] = 0x40
+}
+func (m *EventTransferBucketOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTransferBucketOwnership.Merge(m, src)
+}
+func (m *EventTransferBucketOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTransferBucketOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTransferBucketOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTransferBucketOwnership proto.InternalMessageInfo
+
+func (m *EventTransferBucketOwnership) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x38
+	return ""
+}
+
+func (m *EventTransferBucketOwnership) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *EventTransferBucketOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+type EventAcceptBucketOwnership struct {
+	// previous_owner define the account address of the owner the bucket is transferred from
+	PreviousOwner string `protobuf:"bytes,1,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	// new_owner define the account address of the owner the bucket is transferred to
+	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,3,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,4,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// payment_address define the payment account the bucket is charged from after the transfer
+	PaymentAddress string `protobuf:"bytes,5,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	// object_count define the number of the objects transferred along with the bucket, the rest of them are
+	// transferred in the following blocks, see EventTransferBucketObjects
+	ObjectCount uint64 `protobuf:"varint,6,opt,name=object_count,json=objectCount,proto3" json:"object_count,omitempty"`
+}
+
+func (m *EventAcceptBucketOwnership) Reset()         { *m = EventAcceptBucketOwnership{} }
+func (m *EventAcceptBucketOwnership) String() string { return proto.CompactTextString(m) }
+func (*EventAcceptBucketOwnership) ProtoMessage()    {}
+func (*EventAcceptBucketOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{47}
+}
+func (m *EventAcceptBucketOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAcceptBucketOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAcceptBucketOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventAcceptBucketOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAcceptBucketOwnership.Merge(m, src)
+}
+func (m *EventAcceptBucketOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAcceptBucketOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAcceptBucketOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAcceptBucketOwnership proto.InternalMessageInfo
+
+func (m *EventAcceptBucketOwnership) GetPreviousOwner() string {
+	if m != nil {
+		return m.PreviousOwner
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventAcceptBucketOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventAcceptBucketOwnership) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventCopyObject) Marshal() (dAtA []byte, err error) {
+func (m *EventAcceptBucketOwnership) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
+func (m *EventAcceptBucketOwnership) GetObjectCount() uint64 {
+	if m != nil {
+		return m.ObjectCount
+	}
+	return 0
+}
+
+// EventTransferBucketObjects is emitted once all the objects of a bucket are transferred to its new owner
+type EventTransferBucketObjects struct {
+	// owner define the account address of the owner the objects are transferred to
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// bucket_id is the unique u256 for bucket. Not global, only unique in buckets.
+	BucketId Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// object_count define the number of the objects transferred since the ownership is accepted
+	ObjectCount uint64 `protobuf:"varint,4,opt,name=object_count,json=objectCount,proto3" json:"object_count,omitempty"`
+}
+
+func (m *EventTransferBucketObjects) Reset()         { *m = EventTransferBucketObjects{} }
+func (m *EventTransferBucketObjects) String() string { return proto.CompactTextString(m) }
+func (*EventTransferBucketObjects) ProtoMessage()    {}
+func (*EventTransferBucketObjects) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b609fd45b314820, []int{48}
+}
+func (m *EventTransferBucketObjects) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTransferBucketObjects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTransferBucketObjects.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTransferBucketObjects) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTransferBucketObjects.Merge(m, src)
+}
+func (m *EventTransferBucketObjects) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTransferBucketObjects) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTransferBucketObjects.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTransferBucketObjects proto.InternalMessageInfo
+
+func (m *EventTransferBucketObjects) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventTransferBucketObjects) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *EventTransferBucketObjects) GetObjectCount() uint64 {
+	if m != nil {
+		return m.ObjectCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventCreateBucket)(nil), "moca.storage.EventCreateBucket")
+	proto.RegisterType((*EventDeleteBucket)(nil), "moca.storage.EventDeleteBucket")
+	proto.RegisterType((*EventUpdateBucketInfo)(nil), "moca.storage.EventUpdateBucketInfo")
+	proto.RegisterType((*EventDiscontinueBucket)(nil), "moca.storage.EventDiscontinueBucket")
+	proto.RegisterType((*EventCreateObject)(nil), "moca.storage.EventCreateObject")
+	proto.RegisterType((*EventCancelCreateObject)(nil), "moca.storage.EventCancelCreateObject")
+	proto.RegisterType((*EventSealObject)(nil), "moca.storage.EventSealObject")
+	proto.RegisterType((*EventCopyObject)(nil), "moca.storage.EventCopyObject")
+	proto.RegisterType((*EventDeleteObject)(nil), "moca.storage.EventDeleteObject")
+	proto.RegisterType((*EventRejectSealObject)(nil), "moca.storage.EventRejectSealObject")
+	proto.RegisterType((*EventDiscontinueObject)(nil), "moca.storage.EventDiscontinueObject")
+	proto.RegisterType((*EventUpdateObjectInfo)(nil), "moca.storage.EventUpdateObjectInfo")
+	proto.RegisterType((*EventCreateGroup)(nil), "moca.storage.EventCreateGroup")
+	proto.RegisterType((*EventDeleteGroup)(nil), "moca.storage.EventDeleteGroup")
+	proto.RegisterType((*EventLeaveGroup)(nil), "moca.storage.EventLeaveGroup")
+	proto.RegisterType((*EventUpdateGroupMember)(nil), "moca.storage.EventUpdateGroupMember")
+	proto.RegisterType((*EventRenewGroupMember)(nil), "moca.storage.EventRenewGroupMember")
+	proto.RegisterType((*EventGroupMemberDetail)(nil), "moca.storage.EventGroupMemberDetail")
+	proto.RegisterType((*EventUpdateGroupExtra)(nil), "moca.storage.EventUpdateGroupExtra")
+	proto.RegisterType((*EventMirrorBucket)(nil), "moca.storage.EventMirrorBucket")
+	proto.RegisterType((*EventMirrorBucketResult)(nil), "moca.storage.EventMirrorBucketResult")
+	proto.RegisterType((*EventMirrorObject)(nil), "moca.storage.EventMirrorObject")
+	proto.RegisterType((*EventMirrorObjectResult)(nil), "moca.storage.EventMirrorObjectResult")
+	proto.RegisterType((*EventMirrorGroup)(nil), "moca.storage.EventMirrorGroup")
+	proto.RegisterType((*EventMirrorGroupResult)(nil), "moca.storage.EventMirrorGroupResult")
+	proto.RegisterType((*EventStalePolicyCleanup)(nil), "moca.storage.EventStalePolicyCleanup")
+	proto.RegisterType((*EventMigrationBucket)(nil), "moca.storage.EventMigrationBucket")
+	proto.RegisterType((*EventCancelMigrationBucket)(nil), "moca.storage.EventCancelMigrationBucket")
+	proto.RegisterType((*EventRejectMigrateBucket)(nil), "moca.storage.EventRejectMigrateBucket")
+	proto.RegisterType((*EventCompleteMigrationBucket)(nil), "moca.storage.EventCompleteMigrationBucket")
+	proto.RegisterType((*EventSetTag)(nil), "moca.storage.EventSetTag")
+	proto.RegisterType((*EventUpdateObjectContent)(nil), "moca.storage.EventUpdateObjectContent")
+	proto.RegisterType((*EventUpdateObjectContentSuccess)(nil), "moca.storage.EventUpdateObjectContentSuccess")
+	proto.RegisterType((*EventCancelUpdateObjectContent)(nil), "moca.storage.EventCancelUpdateObjectContent")
+	proto.RegisterType((*EventSetBucketFlowRateLimit)(nil), "moca.storage.EventSetBucketFlowRateLimit")
+	proto.RegisterType((*EventBucketFlowRateLimitStatus)(nil), "moca.storage.EventBucketFlowRateLimitStatus")
+	proto.RegisterType((*EventToggleSPAsDelegatedAgent)(nil), "moca.storage.EventToggleSPAsDelegatedAgent")
+	proto.RegisterType((*EventSetBucketLifecycle)(nil), "moca.storage.EventSetBucketLifecycle")
+	proto.RegisterType((*EventExpireObject)(nil), "moca.storage.EventExpireObject")
+	proto.RegisterType((*EventSetBucketVersioning)(nil), "moca.storage.EventSetBucketVersioning")
+	proto.RegisterType((*EventRestoreObjectVersion)(nil), "moca.storage.EventRestoreObjectVersion")
+	proto.RegisterType((*EventSetBucketReadQuotaAutoTopup)(nil), "moca.storage.EventSetBucketReadQuotaAutoTopup")
+	proto.RegisterType((*EventReportReadQuotaConsumption)(nil), "moca.storage.EventReportReadQuotaConsumption")
+	proto.RegisterType((*EventReadQuotaAutoTopup)(nil), "moca.storage.EventReadQuotaAutoTopup")
+	proto.RegisterType((*EventSetBucketCallback)(nil), "moca.storage.EventSetBucketCallback")
+	proto.RegisterType((*EventObjectCallback)(nil), "moca.storage.EventObjectCallback")
+	proto.RegisterType((*EventTransferBucketOwnership)(nil), "moca.storage.EventTransferBucketOwnership")
+	proto.RegisterType((*EventAcceptBucketOwnership)(nil), "moca.storage.EventAcceptBucketOwnership")
+	proto.RegisterType((*EventTransferBucketObjects)(nil), "moca.storage.EventTransferBucketObjects")
+}
+
+func init() { proto.RegisterFile("moca/storage/events.proto", fileDescriptor_7b609fd45b314820) }
+
+var fileDescriptor_7b609fd45b314820 = []byte{
+	// 2613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0x4b, 0x6c, 0xdc, 0xc6,
+	0xf9, 0x37, 0xf7, 0xa5, 0xdd, 0x59, 0xad, 0x56, 0xa2, 0x15, 0x7b, 0x2d, 0xdb, 0x92, 0xcc, 0xff,
+	0xbf, 0xa9, 0x1c, 0x24, 0x2b, 0x43, 0x69, 0x0e, 0x45, 0x92, 0x06, 0xd2, 0xda, 0x2e, 0x36, 0x70,
+	0x62, 0x97, 0x92, 0x8d, 0xa2, 0x17, 0x62, 0x44, 0x8e, 0x28, 0xd6, 0x24, 0x87, 0xe5, 0x0c, 0x25,
+	0x2b, 0xe7, 0xb6, 0x97, 0xe4, 0x90, 0x4b, 0xd1, 0x53, 0xdb, 0x6b, 0x0e, 0x2d, 0x90, 0x43, 0x7a,
+	0xed, 0xa1, 0x05, 0x8a, 0x5c, 0xda, 0x06, 0x69, 0x11, 0x17, 0x39, 0xb8, 0x85, 0x5d, 0xb4, 0x87,
+	0xbe, 0x0e, 0xed, 0xb5, 0x68, 0x31, 0x0f, 0x72, 0xc9, 0xdd, 0x95, 0x77, 0x29, 0x59, 0xb6, 0xec,
+	0x8b, 0xa1, 0x99, 0xf9, 0x66, 0xf6, 0x7b, 0xfc, 0xbe, 0xc7, 0x7c, 0x43, 0x83, 0x33, 0x1e, 0x36,
+	0xe1, 0x32, 0xa1, 0x38, 0x84, 0x36, 0x5a, 0x46, 0x3b, 0xc8, 0xa7, 0xa4, 0x1d, 0x84, 0x98, 0x62,
+	0x75, 0x92, 0x2d, 0xb5, 0xe5, 0xd2, 0xdc, 0x0c, 0xf4, 0x1c, 0x1f, 0x2f, 0xf3, 0x7f, 0x05, 0xc1,
+	0xdc, 0x19, 0x13, 0x13, 0x0f, 0x13, 0x83, 0x8f, 0x96, 0xc5, 0x40, 0x2e, 0xcd, 0xda, 0xd8, 0xc6,
+	0x62, 0x9e, 0xfd, 0x25, 0x67, 0x17, 0x6c, 0x8c, 0x6d, 0x17, 0x2d, 0xf3, 0xd1, 0x66, 0xb4, 0xb5,
+	0x4c, 0x1d, 0x0f, 0x11, 0x0a, 0xbd, 0x20, 0x3e, 0x91, 0x73, 0x13, 0x22, 0x82, 0xa3, 0xd0, 0x44,
+	0xcb, 0x74, 0x2f, 0x40, 0x24, 0xb3, 0x14, 0x33, 0x6a, 0x62, 0xcf, 0xc3, 0xbe, 0x5c, 0x6a, 0x65,
+	0x96, 0x52, 0x9b, 0xb4, 0x5f, 0x96, 0xc0, 0xcc, 0x15, 0x26, 0x53, 0x27, 0x44, 0x90, 0xa2, 0xb5,
+	0xc8, 0xbc, 0x8d, 0xa8, 0xda, 0x06, 0x65, 0xbc, 0xeb, 0xa3, 0xb0, 0xa5, 0x2c, 0x2a, 0x4b, 0xb5,
+	0xb5, 0xd6, 0xa7, 0x1f, 0xbd, 0x34, 0x2b, 0xb9, 0x5f, 0xb5, 0xac, 0x10, 0x11, 0xb2, 0x4e, 0x43,
+	0xc7, 0xb7, 0x75, 0x41, 0xa6, 0x2e, 0x80, 0xfa, 0x26, 0xdf, 0x69, 0xf8, 0xd0, 0x43, 0xad, 0x02,
+	0xdb, 0xa5, 0x03, 0x31, 0xf5, 0x36, 0xf4, 0x90, 0xfa, 0x1a, 0x00, 0x3b, 0x0e, 0x71, 0x36, 0x1d,
+	0xd7, 0xa1, 0x7b, 0xad, 0xe2, 0xa2, 0xb2, 0x34, 0xb5, 0x72, 0xae, 0x9d, 0x56, 0x5f, 0xfb, 0x56,
+	0xb2, 0xbe, 0xb1, 0x17, 0x20, 0x3d, 0x45, 0xaf, 0x9e, 0x05, 0x35, 0x93, 0xb3, 0x67, 0x40, 0xda,
+	0x2a, 0x2d, 0x2a, 0x4b, 0x45, 0xbd, 0x2a, 0x26, 0x56, 0xa9, 0xfa, 0x3a, 0xa8, 0xc9, 0xdf, 0x76,
+	0xac, 0x56, 0x99, 0xf3, 0xbb, 0xf8, 0xf1, 0xbd, 0x85, 0x13, 0x9f, 0xdf, 0x5b, 0x28, 0xdd, 0x74,
+	0x7c, 0xfa, 0xe9, 0x47, 0x2f, 0xd5, 0x25, 0xef, 0x6c, 0xf8, 0xc1, 0x5f, 0x3e, 0x7c, 0x41, 0xd1,
+	0xab, 0x62, 0x4b, 0xd7, 0x52, 0xbf, 0x0c, 0xea, 0x42, 0x97, 0x06, 0x53, 0x4b, 0xab, 0xc2, 0x59,
+	0x6b, 0x65, 0x59, 0x5b, 0xe7, 0x04, 0x82, 0x2d, 0x92, 0xfc, 0xad, 0xbe, 0x08, 0x54, 0x73, 0x1b,
+	0x86, 0x36, 0xb2, 0x8c, 0x10, 0x41, 0xcb, 0xf8, 0x56, 0x84, 0x29, 0x6c, 0x4d, 0x2c, 0x2a, 0x4b,
+	0x25, 0x7d, 0x5a, 0xae, 0xe8, 0x08, 0x5a, 0x5f, 0x63, 0xf3, 0xea, 0x2a, 0x68, 0x06, 0x70, 0xcf,
+	0x43, 0x3e, 0x35, 0xa0, 0xd0, 0x61, 0xab, 0x3a, 0x42, 0xbb, 0x53, 0x72, 0x83, 0x9c, 0x55, 0x35,
+	0xd0, 0x08, 0x42, 0xc7, 0x83, 0xe1, 0x9e, 0x41, 0x02, 0x26, 0x6e, 0x6d, 0x51, 0x59, 0x6a, 0xe8,
+	0x75, 0x39, 0xb9, 0x1e, 0x74, 0x2d, 0x75, 0x0d, 0xcc, 0xdb, 0x2e, 0xde, 0x84, 0xae, 0xb1, 0xe3,
+	0x84, 0x34, 0x82, 0xae, 0x61, 0x87, 0x38, 0x0a, 0x8c, 0x2d, 0xe8, 0x39, 0xee, 0x1e, 0xdb, 0x04,
+	0xf8, 0xa6, 0x39, 0x41, 0x75, 0x4b, 0x10, 0x7d, 0x95, 0xd1, 0x5c, 0xe5, 0x24, 0x5d, 0x4b, 0x5d,
+	0x01, 0x15, 0x42, 0x21, 0x8d, 0x48, 0xab, 0xce, 0xd5, 0x31, 0x97, 0x55, 0x87, 0x00, 0xc9, 0x3a,
+	0xa7, 0xd0, 0x25, 0xa5, 0xf6, 0x83, 0x82, 0x04, 0xd2, 0x65, 0xe4, 0xa2, 0x04, 0x48, 0x5f, 0x02,
+	0x55, 0x1c, 0xa0, 0x10, 0x52, 0x3c, 0x1a, 0x4b, 0x09, 0x65, 0x0f, 0x7e, 0x85, 0x03, 0xc1, 0xaf,
+	0x38, 0x00, 0xbf, 0x0c, 0x46, 0x4a, 0xb9, 0x31, 0x32, 0x5a, 0xa7, 0xe5, 0x51, 0x3a, 0xd5, 0xbe,
+	0x5b, 0x04, 0xcf, 0x71, 0xfd, 0xdc, 0x0c, 0xac, 0xc4, 0xd1, 0xba, 0xfe, 0x16, 0x3e, 0xa0, 0x8e,
+	0x46, 0xba, 0x5c, 0x46, 0xe6, 0x62, 0x6e, 0x99, 0x87, 0x83, 0xbb, 0xb4, 0x0f, 0xb8, 0xbf, 0x38,
+	0x08, 0x6e, 0xee, 0x8a, 0x03, 0x10, 0xce, 0x06, 0x82, 0x4a, 0xce, 0x40, 0x30, 0xda, 0x10, 0x13,
+	0x23, 0x0d, 0xf1, 0x13, 0x05, 0x9c, 0x12, 0x40, 0x75, 0x88, 0x89, 0x7d, 0xea, 0xf8, 0x51, 0x8c,
+	0xd6, 0x8c, 0xca, 0x94, 0xdc, 0x2a, 0x1b, 0x69, 0x92, 0x53, 0xa0, 0x12, 0x22, 0x48, 0xb0, 0x2f,
+	0x21, 0x2a, 0x47, 0x2c, 0xbe, 0x59, 0xdc, 0x6b, 0x52, 0xf1, 0x4d, 0x4c, 0xac, 0x52, 0xed, 0x3b,
+	0x95, 0x4c, 0x84, 0xbe, 0xbe, 0xf9, 0x4d, 0x64, 0x52, 0x75, 0x05, 0x4c, 0xf0, 0x08, 0x38, 0x06,
+	0x66, 0x62, 0xc2, 0x47, 0xef, 0x56, 0x0b, 0xa0, 0x8e, 0x39, 0x3b, 0x82, 0xa0, 0x24, 0x08, 0xc4,
+	0xd4, 0x20, 0x06, 0x2b, 0xb9, 0x15, 0xfa, 0x3a, 0xa8, 0xc9, 0xf3, 0xa5, 0x65, 0xc7, 0xda, 0x2e,
+	0xb6, 0x74, 0xad, 0xc1, 0x70, 0x59, 0x1d, 0x0c, 0x97, 0x17, 0xc0, 0x64, 0x00, 0xf7, 0x5c, 0x0c,
+	0x2d, 0x83, 0x38, 0xef, 0x20, 0x1e, 0x51, 0x4b, 0x7a, 0x5d, 0xce, 0xad, 0x3b, 0xef, 0xf4, 0xe7,
+	0x2e, 0x90, 0x13, 0xb2, 0x17, 0xc0, 0x24, 0x43, 0x19, 0xf3, 0x0c, 0x9e, 0x60, 0xea, 0x5c, 0x49,
+	0x75, 0x39, 0xc7, 0xf3, 0x48, 0x26, 0xbd, 0x4d, 0xf6, 0xa5, 0xb7, 0x5e, 0x2c, 0x6e, 0x0c, 0x8b,
+	0xc5, 0x02, 0x0e, 0xd9, 0x58, 0xac, 0x5e, 0x01, 0xcd, 0x10, 0x59, 0x91, 0x6f, 0x41, 0xdf, 0xdc,
+	0x13, 0x3f, 0x3b, 0x35, 0x8c, 0x6d, 0x3d, 0x21, 0xe2, 0x6c, 0x4f, 0x85, 0x99, 0x71, 0x7f, 0x6a,
+	0x6c, 0xe6, 0x48, 0x8d, 0xe7, 0x40, 0xcd, 0xdc, 0x46, 0xe6, 0x6d, 0x12, 0x79, 0xa4, 0x35, 0xbd,
+	0x58, 0x5c, 0x9a, 0xd4, 0x7b, 0x13, 0xea, 0xcb, 0xe0, 0x94, 0x8b, 0xcd, 0x01, 0x2f, 0x76, 0xac,
+	0xd6, 0x0c, 0xb7, 0xd0, 0x49, 0xbe, 0x9a, 0xf6, 0xde, 0xae, 0xa5, 0xfd, 0x5b, 0x01, 0xa7, 0x85,
+	0x1f, 0x40, 0xdf, 0x44, 0x6e, 0xc6, 0x1b, 0x8e, 0x28, 0x84, 0xf6, 0xe1, 0xbb, 0x38, 0x80, 0xef,
+	0x01, 0x84, 0x95, 0x06, 0x11, 0x96, 0x01, 0x71, 0x25, 0x2f, 0x88, 0x59, 0xde, 0x68, 0x72, 0xb1,
+	0xd7, 0x11, 0x74, 0x9f, 0xb0, 0xb8, 0x19, 0x51, 0xca, 0xb9, 0xfd, 0xb1, 0x07, 0xe5, 0xca, 0xd8,
+	0x50, 0x7e, 0x05, 0x9c, 0x1e, 0x1a, 0xf1, 0x93, 0x50, 0x3f, 0x3b, 0x18, 0xea, 0xbb, 0xd6, 0x43,
+	0x10, 0x56, 0xdd, 0x17, 0x61, 0x59, 0xd0, 0xd6, 0xfa, 0x40, 0xab, 0x7d, 0x10, 0x1b, 0xa2, 0x83,
+	0x83, 0xbd, 0x43, 0x19, 0xe2, 0x79, 0xd0, 0x24, 0xa1, 0x69, 0x0c, 0x1a, 0xa3, 0x41, 0x42, 0x73,
+	0xad, 0x67, 0x0f, 0x49, 0x37, 0x68, 0x13, 0x46, 0x77, 0xbd, 0x67, 0x96, 0xe7, 0x41, 0xd3, 0x22,
+	0x34, 0x73, 0x9e, 0x08, 0xc5, 0x0d, 0x8b, 0xd0, 0xec, 0x79, 0x8c, 0x2e, 0x7d, 0x5e, 0x39, 0xa1,
+	0x4b, 0x9d, 0x77, 0x19, 0x34, 0x52, 0xbf, 0x9b, 0x03, 0xb5, 0xf5, 0x84, 0xaf, 0xae, 0xc5, 0x4e,
+	0x49, 0xfd, 0x5a, 0x8e, 0x00, 0x5e, 0x4f, 0xb8, 0x39, 0xa0, 0x21, 0xb5, 0xff, 0x2a, 0x99, 0x5a,
+	0xf4, 0x38, 0x79, 0x4d, 0x29, 0xb7, 0xd7, 0xec, 0xaf, 0x81, 0xf2, 0xfe, 0x1a, 0xf8, 0xbb, 0x22,
+	0xab, 0x4d, 0x1d, 0x71, 0xa7, 0x3a, 0x66, 0xb1, 0x23, 0xbf, 0x16, 0xce, 0x03, 0xb0, 0x85, 0x43,
+	0x23, 0xe2, 0xc5, 0x33, 0x97, 0xbc, 0xaa, 0xd7, 0xb6, 0x70, 0x28, 0xaa, 0xe9, 0xa1, 0x45, 0x9d,
+	0x14, 0xb8, 0x8f, 0x75, 0x65, 0x58, 0xa1, 0xdc, 0xe3, 0xac, 0x90, 0x9b, 0xb3, 0x03, 0x15, 0x75,
+	0xef, 0x15, 0x32, 0xb7, 0x01, 0x09, 0xf7, 0x23, 0xbc, 0x0d, 0x1c, 0xb5, 0x7d, 0xb2, 0x45, 0x52,
+	0x39, 0x5f, 0x91, 0xa4, 0xfd, 0x53, 0x01, 0xd3, 0xa9, 0x1a, 0x97, 0xa3, 0x38, 0x77, 0x13, 0xe2,
+	0x3c, 0x00, 0xc2, 0x35, 0x52, 0x2a, 0xa8, 0xf1, 0x19, 0x2e, 0xe0, 0xab, 0xa0, 0x9a, 0x78, 0xce,
+	0xb8, 0xd7, 0xa1, 0x09, 0x5b, 0xa6, 0x86, 0xbe, 0x52, 0xa8, 0x94, 0xa3, 0x14, 0x9a, 0x05, 0x65,
+	0x74, 0x87, 0x86, 0x50, 0xc6, 0x5a, 0x31, 0xd0, 0x7e, 0x18, 0x4b, 0x2c, 0x42, 0x54, 0x9f, 0xc4,
+	0x85, 0x83, 0x48, 0x5c, 0x7c, 0x98, 0xc4, 0xa5, 0x9c, 0x12, 0x6b, 0xf7, 0x14, 0x99, 0xee, 0xae,
+	0x21, 0xb8, 0x23, 0xf9, 0x7b, 0x03, 0x4c, 0x79, 0xc8, 0xdb, 0x44, 0x61, 0x72, 0xc9, 0x1b, 0x65,
+	0x9a, 0x86, 0xa0, 0x97, 0x93, 0xc7, 0x4a, 0xc0, 0xbf, 0x15, 0xc0, 0xa9, 0x94, 0x0b, 0x72, 0x09,
+	0xdf, 0xe2, 0xdc, 0x3e, 0xa6, 0xae, 0xc5, 0x11, 0x0a, 0xa7, 0xbe, 0x19, 0x5b, 0x8a, 0x18, 0x14,
+	0x33, 0x6b, 0xb5, 0xca, 0x8b, 0xc5, 0xa5, 0xfa, 0xca, 0xff, 0x67, 0x21, 0xcb, 0xe5, 0x4f, 0x49,
+	0x7e, 0x19, 0x51, 0xe8, 0xb8, 0xfa, 0xa4, 0xdc, 0xbb, 0x81, 0x57, 0x2d, 0x96, 0xc8, 0x67, 0x52,
+	0x67, 0x89, 0x10, 0xd6, 0xaa, 0x2c, 0x16, 0x1f, 0x2a, 0x63, 0x33, 0x39, 0x42, 0x00, 0x5c, 0xfb,
+	0x5d, 0x21, 0xc9, 0x48, 0x3e, 0xda, 0x7d, 0xb6, 0xb4, 0xdd, 0x17, 0x1d, 0xca, 0x39, 0xa2, 0xc3,
+	0x57, 0xc0, 0x84, 0xd4, 0x54, 0xab, 0x92, 0xc3, 0x42, 0xf1, 0x26, 0xed, 0x7b, 0x71, 0xe2, 0x1b,
+	0xa0, 0x51, 0x2f, 0x81, 0x8a, 0xa0, 0x1a, 0xa9, 0x55, 0x49, 0xa7, 0x76, 0x41, 0x13, 0xdd, 0x09,
+	0x9c, 0x10, 0x52, 0x07, 0xfb, 0x06, 0x75, 0x64, 0x18, 0xad, 0xaf, 0xcc, 0xb5, 0x45, 0x5f, 0xba,
+	0x1d, 0xf7, 0xa5, 0xdb, 0x1b, 0x71, 0x5f, 0x7a, 0xad, 0xf4, 0xfe, 0x1f, 0x16, 0x14, 0x7d, 0xaa,
+	0xb7, 0x91, 0x2d, 0xb1, 0x88, 0xfe, 0x5c, 0xbf, 0x77, 0x5d, 0x61, 0x91, 0xef, 0x19, 0x30, 0xf7,
+	0xf0, 0x88, 0xfe, 0xab, 0xb8, 0xe8, 0x7c, 0xcb, 0x09, 0x43, 0x1c, 0x1e, 0xaa, 0x01, 0x9a, 0xaf,
+	0xb9, 0x97, 0xbf, 0xa1, 0xa9, 0x81, 0x86, 0x85, 0x08, 0x35, 0xcc, 0x6d, 0xe8, 0xf8, 0xbd, 0x52,
+	0xb2, 0xce, 0x26, 0x3b, 0x6c, 0xae, 0x6b, 0x69, 0x3f, 0x8d, 0xef, 0xdb, 0x69, 0x79, 0x74, 0x44,
+	0x22, 0x97, 0xb2, 0x9a, 0x47, 0xde, 0xe4, 0x14, 0xbe, 0x51, 0x8e, 0x8e, 0x05, 0xdf, 0xff, 0xc8,
+	0xda, 0xe1, 0xe9, 0x2e, 0x7b, 0xc7, 0x11, 0xf8, 0xb3, 0xac, 0xa1, 0x84, 0xc0, 0x87, 0x35, 0xd4,
+	0x71, 0x10, 0xec, 0xe7, 0x71, 0x8d, 0x24, 0x04, 0x3b, 0x7e, 0x55, 0xe1, 0x80, 0x10, 0xa5, 0x41,
+	0x21, 0x3e, 0x8c, 0x03, 0x74, 0x4a, 0x88, 0x11, 0xc6, 0x79, 0xd2, 0x2c, 0x07, 0x12, 0x4f, 0xeb,
+	0x14, 0xba, 0xe8, 0x06, 0x76, 0x1d, 0x73, 0xaf, 0xe3, 0x22, 0xe8, 0x47, 0x81, 0x3a, 0x07, 0xaa,
+	0x9b, 0x2e, 0x36, 0x6f, 0xbf, 0x1d, 0x79, 0x9c, 0xe9, 0xa2, 0x9e, 0x8c, 0x59, 0x16, 0x94, 0x17,
+	0x1e, 0xc7, 0xdf, 0xc2, 0x32, 0x73, 0xf4, 0x65, 0x41, 0x51, 0x0c, 0xb0, 0x8b, 0x8e, 0x0e, 0xac,
+	0xe4, 0x6f, 0xed, 0xdd, 0x02, 0x98, 0x95, 0x4a, 0xb2, 0x45, 0x12, 0x79, 0x8c, 0xe1, 0x33, 0xff,
+	0xdb, 0xc8, 0x45, 0x30, 0xc3, 0x5a, 0x1b, 0xc3, 0x5a, 0x7f, 0x53, 0x16, 0xa1, 0x37, 0x52, 0xdd,
+	0xbf, 0x5e, 0xcf, 0xab, 0x3c, 0xf6, 0x53, 0xda, 0x9f, 0x15, 0x30, 0x97, 0xea, 0x74, 0x3e, 0x1d,
+	0x3a, 0xe9, 0x09, 0x5a, 0x1a, 0x5b, 0xd0, 0x3f, 0x29, 0xa0, 0x95, 0xea, 0x52, 0x08, 0x41, 0xd1,
+	0x33, 0x27, 0xe6, 0xdd, 0x02, 0x38, 0x27, 0xec, 0x89, 0xbd, 0x80, 0x61, 0xfe, 0xe9, 0xb0, 0xe8,
+	0xe8, 0xc7, 0xb6, 0xd2, 0xc8, 0x97, 0xe4, 0x8b, 0x60, 0x86, 0xb5, 0x12, 0xb3, 0x9e, 0x22, 0x42,
+	0xfd, 0x14, 0x09, 0xcd, 0xe1, 0x9e, 0x52, 0x19, 0x5b, 0xb3, 0xef, 0x2a, 0xa0, 0x2e, 0x9b, 0xe3,
+	0x74, 0x03, 0xda, 0x2c, 0x3c, 0xc5, 0x9f, 0x46, 0xc8, 0x46, 0x4f, 0x32, 0x56, 0xdb, 0xa0, 0x44,
+	0xa1, 0x4d, 0x92, 0x8a, 0xb6, 0xef, 0x25, 0x44, 0xd6, 0xe4, 0xd0, 0x26, 0x3a, 0xa7, 0x53, 0x2f,
+	0x81, 0x42, 0x8e, 0x2e, 0x77, 0xc1, 0xb1, 0xb4, 0x1f, 0x17, 0x40, 0x2b, 0x55, 0xf3, 0x8a, 0x44,
+	0xdc, 0x11, 0x0f, 0x3d, 0x07, 0xb4, 0xf1, 0x21, 0x7b, 0x53, 0x87, 0x7f, 0xc1, 0xeb, 0x7f, 0x1f,
+	0x2b, 0x0f, 0xbe, 0x8f, 0x65, 0xda, 0xe6, 0x95, 0xfe, 0xb7, 0x9e, 0x16, 0x98, 0xd8, 0x41, 0x21,
+	0x71, 0xb0, 0xcf, 0x1b, 0xc0, 0x45, 0x3d, 0x1e, 0x6a, 0x9f, 0x15, 0xc1, 0xc2, 0x7e, 0xea, 0x5a,
+	0x8f, 0x4c, 0x93, 0x35, 0x0c, 0x9e, 0x5e, 0xad, 0x65, 0x1e, 0xfd, 0xca, 0x83, 0x8f, 0x7e, 0x2f,
+	0x80, 0x99, 0x20, 0x44, 0x3b, 0x46, 0x46, 0xbb, 0x15, 0xae, 0xdd, 0x26, 0x5b, 0xb8, 0x91, 0xd2,
+	0xf0, 0x12, 0x98, 0xf6, 0xd1, 0x6e, 0x96, 0x54, 0x7c, 0x66, 0x32, 0xe5, 0xa3, 0xdd, 0x34, 0xe5,
+	0x17, 0xc0, 0x14, 0x3f, 0xb5, 0x67, 0x90, 0x2a, 0x37, 0x48, 0x83, 0xcd, 0x76, 0x12, 0xa3, 0xfc,
+	0x1f, 0x68, 0xb0, 0x03, 0xfb, 0x5f, 0x3b, 0x26, 0x7d, 0xb4, 0xdb, 0x19, 0x66, 0x39, 0x90, 0xb1,
+	0x1c, 0x2b, 0x50, 0x44, 0x23, 0xd6, 0x62, 0xbd, 0xcd, 0x3a, 0x5f, 0xac, 0xc9, 0x99, 0x55, 0xaa,
+	0xdd, 0x55, 0xc0, 0x7c, 0x2a, 0x7f, 0x3d, 0x3a, 0x6f, 0x78, 0xd2, 0x55, 0xab, 0xf6, 0xeb, 0x02,
+	0x38, 0x1b, 0xc7, 0x1b, 0x11, 0x90, 0xae, 0xba, 0x78, 0x57, 0x87, 0x14, 0x5d, 0x73, 0x3c, 0xe7,
+	0xc8, 0xc4, 0x1a, 0xf2, 0xe9, 0x50, 0x31, 0xe7, 0xa7, 0x43, 0xaf, 0x82, 0x49, 0xf9, 0x1b, 0xa2,
+	0x7a, 0x2e, 0x8d, 0xd8, 0x2f, 0x39, 0xba, 0xce, 0x88, 0xd5, 0xaf, 0x83, 0xe6, 0x96, 0x8b, 0x77,
+	0x0d, 0x96, 0x9d, 0x0d, 0x97, 0x49, 0x2a, 0xe3, 0xe2, 0x25, 0xa9, 0xbb, 0xe7, 0xc4, 0x19, 0xc4,
+	0xba, 0xdd, 0x76, 0xf0, 0xb2, 0x07, 0xe9, 0x76, 0xbb, 0xcb, 0x95, 0x09, 0xe4, 0xe1, 0xdd, 0x58,
+	0x97, 0x8d, 0xad, 0xb4, 0xc2, 0xb4, 0x1f, 0xc5, 0x50, 0x19, 0xa2, 0xcd, 0xf5, 0xa1, 0x57, 0x95,
+	0xc1, 0xfe, 0xfd, 0x79, 0x00, 0x1c, 0x22, 0xd8, 0x42, 0xc2, 0xdd, 0xab, 0x7a, 0xcd, 0x21, 0xd7,
+	0xc4, 0xc4, 0x21, 0xb3, 0xa0, 0xf6, 0x0b, 0x05, 0x9c, 0xe7, 0x1c, 0x6e, 0x60, 0xdb, 0x76, 0xd1,
+	0xfa, 0x8d, 0x55, 0xc2, 0x8a, 0x58, 0x9b, 0x63, 0xdd, 0x66, 0x58, 0x1e, 0xe7, 0x81, 0xa1, 0xc7,
+	0x41, 0xe1, 0x20, 0x79, 0x98, 0x04, 0x06, 0x24, 0x86, 0x15, 0xff, 0xae, 0x01, 0xd9, 0x0f, 0x1b,
+	0x96, 0x43, 0xe0, 0xa6, 0x8b, 0x84, 0x54, 0x55, 0x7d, 0x8e, 0x04, 0xfd, 0xbc, 0x5d, 0x96, 0x14,
+	0xec, 0x3d, 0xe8, 0x74, 0x16, 0xb8, 0xd7, 0x9c, 0x2d, 0x64, 0xee, 0x99, 0x2e, 0x3a, 0xa6, 0xd5,
+	0xc7, 0x6b, 0xa0, 0x1c, 0x46, 0x2e, 0x62, 0x75, 0x16, 0x6b, 0x8b, 0x9d, 0xcd, 0xe6, 0xeb, 0x84,
+	0x7b, 0x3d, 0x72, 0xd1, 0x5a, 0x8d, 0x9d, 0x2b, 0x0e, 0x10, 0x9b, 0xb4, 0xf7, 0xe2, 0x26, 0xc0,
+	0x15, 0xd6, 0x96, 0x7a, 0x5c, 0x4f, 0x41, 0xa7, 0xc1, 0x04, 0xfb, 0xf9, 0x44, 0x60, 0xbd, 0xc2,
+	0x86, 0x5d, 0x4b, 0xfb, 0x6d, 0x5c, 0xe8, 0x26, 0xea, 0xbf, 0x25, 0x42, 0xa9, 0xe3, 0xdb, 0xc7,
+	0x54, 0xff, 0x17, 0xc0, 0xa4, 0x07, 0xef, 0x18, 0x32, 0xe4, 0x93, 0xf8, 0x9e, 0xe8, 0xc1, 0x3b,
+	0x92, 0x75, 0xa2, 0x7d, 0xbf, 0x00, 0xce, 0xc8, 0xf2, 0x9d, 0xd9, 0x45, 0x6a, 0x59, 0x2e, 0x3f,
+	0xad, 0x1d, 0x97, 0x8b, 0x60, 0x3a, 0x14, 0xe2, 0x58, 0xb1, 0xf0, 0x3c, 0xd8, 0x15, 0xf5, 0x66,
+	0x3c, 0x1f, 0x4b, 0x98, 0x4a, 0x90, 0x95, 0x6c, 0x69, 0xf3, 0xed, 0x02, 0x58, 0xcc, 0xda, 0x3b,
+	0xf9, 0x54, 0x6e, 0x35, 0xa2, 0x78, 0x03, 0x07, 0x51, 0x70, 0x4c, 0xed, 0xfe, 0x26, 0x00, 0x30,
+	0xa2, 0xd8, 0xa0, 0x8c, 0x47, 0xae, 0xbf, 0xfa, 0xca, 0x62, 0x7f, 0xb1, 0xdc, 0x2f, 0x4b, 0xda,
+	0x03, 0x6b, 0x30, 0x9e, 0xd5, 0xfe, 0xa3, 0xc8, 0x0a, 0x4f, 0x47, 0x01, 0x0e, 0x7b, 0x3a, 0xe8,
+	0x60, 0x9f, 0x44, 0x5e, 0x40, 0x8f, 0x10, 0x26, 0x87, 0xd4, 0xc2, 0x2c, 0x28, 0x7b, 0xd8, 0xa7,
+	0xdb, 0x12, 0xf6, 0x62, 0xa0, 0xb6, 0xc1, 0x49, 0x93, 0xb3, 0x9e, 0xfd, 0x28, 0x52, 0xd4, 0xc4,
+	0x33, 0xf1, 0x52, 0x22, 0xa6, 0x76, 0x37, 0x8e, 0xba, 0x43, 0xac, 0x7f, 0xd4, 0x59, 0xa3, 0x0d,
+	0x4e, 0xb2, 0x9a, 0xcf, 0xc1, 0x11, 0x49, 0xf3, 0x5a, 0x14, 0xbc, 0xc6, 0x4b, 0x09, 0x63, 0xf9,
+	0xbe, 0xf7, 0xd4, 0xfe, 0x15, 0x77, 0xb5, 0x12, 0x80, 0x77, 0xa0, 0xeb, 0x6e, 0x42, 0xf3, 0xf6,
+	0x31, 0x35, 0x68, 0x07, 0x54, 0x4d, 0xc9, 0xa1, 0x04, 0xf5, 0xb9, 0x61, 0xf7, 0xcb, 0x58, 0x8a,
+	0x34, 0xa0, 0x93, 0x8d, 0xda, 0xcf, 0x0a, 0xe0, 0x24, 0x97, 0x5a, 0x16, 0xb3, 0xb1, 0xc8, 0x23,
+	0x6d, 0xd9, 0x17, 0xb4, 0x0a, 0x0f, 0x0f, 0x5a, 0xc5, 0xdc, 0x41, 0xab, 0x03, 0xa6, 0x63, 0x26,
+	0x93, 0x0a, 0x71, 0x54, 0x85, 0xd7, 0x8c, 0x77, 0xc8, 0x69, 0xd6, 0x8e, 0xf4, 0x10, 0xdd, 0xc6,
+	0xf2, 0xd2, 0xab, 0xcb, 0x11, 0x0b, 0x73, 0x44, 0x5c, 0xc7, 0x78, 0x98, 0xab, 0xea, 0xf1, 0x50,
+	0x3d, 0x03, 0xaa, 0x36, 0x24, 0x46, 0x44, 0x90, 0x25, 0xef, 0x23, 0x13, 0x36, 0x24, 0x37, 0x09,
+	0x12, 0x6f, 0x24, 0xac, 0xdf, 0x29, 0xbe, 0x71, 0xd7, 0xc5, 0x40, 0xfb, 0xab, 0x22, 0x3b, 0x21,
+	0x1b, 0x21, 0xf4, 0xc9, 0x16, 0x0a, 0xd7, 0x7a, 0x55, 0x26, 0xd9, 0x76, 0x8e, 0x6b, 0x4c, 0x7c,
+	0x05, 0xd4, 0xd8, 0x75, 0x69, 0xbc, 0xca, 0xb9, 0xea, 0xa3, 0x5d, 0x2e, 0x90, 0xf6, 0x79, 0x41,
+	0xf6, 0xf1, 0x56, 0x4d, 0x13, 0x05, 0xb4, 0x5f, 0xd6, 0x37, 0xc0, 0x54, 0xec, 0x86, 0xc6, 0x78,
+	0x2d, 0xed, 0x46, 0x4c, 0x2f, 0xca, 0xf2, 0x0c, 0x5b, 0x85, 0x71, 0xd9, 0x3a, 0xf2, 0xaf, 0xe5,
+	0x57, 0xf7, 0xf9, 0x16, 0x3c, 0xc7, 0x6d, 0xe5, 0x02, 0x98, 0x94, 0xee, 0x60, 0xe2, 0xc8, 0xa7,
+	0xf2, 0x5e, 0x2c, 0x7d, 0xa8, 0xc3, 0xa6, 0xb4, 0xdf, 0xc4, 0x4d, 0xd2, 0x3e, 0x28, 0x71, 0x12,
+	0xf2, 0xe8, 0xff, 0x07, 0xcb, 0xe1, 0xcb, 0xa9, 0x8c, 0x44, 0xa5, 0x01, 0x89, 0xd6, 0xae, 0x7e,
+	0x7c, 0x7f, 0x5e, 0xf9, 0xe4, 0xfe, 0xbc, 0xf2, 0xc7, 0xfb, 0xf3, 0xca, 0xfb, 0x0f, 0xe6, 0x4f,
+	0x7c, 0xf2, 0x60, 0xfe, 0xc4, 0xef, 0x1f, 0xcc, 0x9f, 0xf8, 0xc6, 0x8b, 0xb6, 0x43, 0xb7, 0xa3,
+	0xcd, 0xb6, 0x89, 0xbd, 0x65, 0x16, 0xb4, 0x78, 0xf3, 0x9e, 0xff, 0xb5, 0xbc, 0xb3, 0xb2, 0x7c,
+	0x27, 0xfb, 0x1f, 0x7b, 0x36, 0x2b, 0xfc, 0x91, 0xf6, 0xe5, 0xff, 0x0d, 0x00, 0x85, 0x5f, 0x73,
+	0x6e, 0xb9, 0x34, 0x00, 0x00,
+}
+
+func (m *EventCreateBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3631,80 +3991,86 @@ func (m *EventCopyObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCopyObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCopyObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LocalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x58
 	}
-	{
-		size := m.DstObjectId.Size()
-		i -= size
-		if _, err := m.DstObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x50
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.SrcObjectId.Size()
-		i -= size
-		if _, err := m.SrcObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x48
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.DstObjectName) > 0 {
-		i -= len(m.DstObjectName)
-		copy(dAtA[i:], m.DstObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstObjectName)))
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x42
 	}
-	if len(m.DstBucketName) > 0 {
-		i -= len(m.DstBucketName)
-		copy(dAtA[i:], m.DstBucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstBucketName)))
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChargedReadQuota))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x38
 	}
-	if len(m.SrcObjectName) > 0 {
-		i -= len(m.SrcObjectName)
-		copy(dAtA[i:], m.SrcObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcObjectName)))
+	if m.SourceType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x30
 	}
-	if len(m.SrcBucketName) > 0 {
-		i -= len(m.SrcBucketName)
-		copy(dAtA[i:], m.SrcBucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcBucketName)))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.CreateAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDeleteObject) Marshal() (dAtA []byte, err error) {
+func (m *EventDeleteBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3714,43 +4080,43 @@ func (m *EventDeleteObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDeleteObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDeleteBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDeleteObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDeleteBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LocalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
 		i--
 		dAtA[i] = 0x28
 	}
 	{
-		size := m.ObjectId.Size()
+		size := m.BucketId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.Operator) > 0 {
@@ -3763,7 +4129,7 @@ func (m *EventDeleteObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRejectSealObject) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateBucketInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3773,43 +4139,48 @@ func (m *EventRejectSealObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRejectSealObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateBucketInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRejectSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ForUpdate {
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
 		i--
-		if m.ForUpdate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x38
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x30
+	}
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChargedReadQuota))
+		i--
+		dAtA[i] = 0x20
 	}
 	{
-		size := m.ObjectId.Size()
+		size := m.BucketId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
+	dAtA[i] = 0x1a
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -3827,7 +4198,7 @@ func (m *EventRejectSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDiscontinueObject) Marshal() (dAtA []byte, err error) {
+func (m *EventDiscontinueBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3837,12 +4208,12 @@ func (m *EventDiscontinueObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDiscontinueObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDiscontinueBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDiscontinueObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDiscontinueBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3859,27 +4230,27 @@ func (m *EventDiscontinueObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x1a
 	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size := m.ObjectId.Size()
+		size := m.BucketId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateObjectInfo) Marshal() (dAtA []byte, err error) {
+func (m *EventCreateObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3889,20 +4260,75 @@ func (m *EventUpdateObjectInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateObjectInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.SourceType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.RedundancyType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Visibility != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x50
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x40
 	}
 	{
 		size := m.ObjectId.Size()
@@ -3913,32 +4339,49 @@ func (m *EventUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x3a
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.ObjectName) > 0 {
 		i -= len(m.ObjectName)
 		copy(dAtA[i:], m.ObjectName)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
+func (m *EventCancelCreateObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3948,56 +4391,56 @@ func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCreateGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCancelCreateObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCancelCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Extra) > 0 {
-		i -= len(m.Extra)
-		copy(dAtA[i:], m.Extra)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Extra)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SourceType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
-		i--
-		dAtA[i] = 0x20
-	}
 	{
-		size := m.GroupId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+	dAtA[i] = 0x32
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDeleteGroup) Marshal() (dAtA []byte, err error) {
+func (m *EventSealObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4007,44 +4450,75 @@ func (m *EventDeleteGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDeleteGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSealObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDeleteGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
-		size := m.GroupId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+	dAtA[i] = 0x2a
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventLeaveGroup) Marshal() (dAtA []byte, err error) {
+func (m *EventCopyObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4054,51 +4528,80 @@ func (m *EventLeaveGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCopyObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCopyObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
-		size := m.GroupId.Size()
+		size := m.DstObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DstObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+	dAtA[i] = 0x3a
+	{
+		size := m.SrcObjectId.Size()
+		i -= size
+		if _, err := m.SrcObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DstObjectName) > 0 {
+		i -= len(m.DstObjectName)
+		copy(dAtA[i:], m.DstObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstObjectName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DstBucketName) > 0 {
+		i -= len(m.DstBucketName)
+		copy(dAtA[i:], m.DstBucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DstBucketName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SrcObjectName) > 0 {
+		i -= len(m.SrcObjectName)
+		copy(dAtA[i:], m.SrcObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcObjectName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.SrcBucketName) > 0 {
+		i -= len(m.SrcBucketName)
+		copy(dAtA[i:], m.SrcBucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SrcBucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MemberAddress) > 0 {
-		i -= len(m.MemberAddress)
-		copy(dAtA[i:], m.MemberAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MemberAddress)))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateGroupMember) Marshal() (dAtA []byte, err error) {
+func (m *EventDeleteObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4108,60 +4611,42 @@ func (m *EventUpdateGroupMember) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateGroupMember) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDeleteObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDeleteObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MembersToDelete) > 0 {
-		for iNdEx := len(m.MembersToDelete) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MembersToDelete[iNdEx])
-			copy(dAtA[i:], m.MembersToDelete[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.MembersToDelete[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.MembersToAdd) > 0 {
-		for iNdEx := len(m.MembersToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MembersToAdd[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x28
 	}
 	{
-		size := m.GroupId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4175,7 +4660,7 @@ func (m *EventUpdateGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRenewGroupMember) Marshal() (dAtA []byte, err error) {
+func (m *EventRejectSealObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4185,56 +4670,47 @@ func (m *EventRenewGroupMember) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRenewGroupMember) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRejectSealObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRenewGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRejectSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+	if m.ForUpdate {
+		i--
+		if m.ForUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if m.SourceType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
 		i--
 		dAtA[i] = 0x28
 	}
 	{
-		size := m.GroupId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4248,7 +4724,7 @@ func (m *EventRenewGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventGroupMemberDetail) Marshal() (dAtA []byte, err error) {
+func (m *EventDiscontinueObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4258,37 +4734,49 @@ func (m *EventGroupMemberDetail) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventGroupMemberDetail) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDiscontinueObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventGroupMemberDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDiscontinueObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExpirationTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintEvents(dAtA, i, uint64(n1))
+	if m.DeleteAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DeleteAt))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if len(m.Member) > 0 {
-		i -= len(m.Member)
-		copy(dAtA[i:], m.Member)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Member)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateGroupExtra) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateObjectInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4298,44 +4786,42 @@ func (m *EventUpdateGroupExtra) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateGroupExtra) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateObjectInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateGroupExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Extra) > 0 {
-		i -= len(m.Extra)
-		copy(dAtA[i:], m.Extra)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Extra)))
+	if m.Visibility != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Visibility))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
 	{
-		size := m.GroupId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4349,7 +4835,7 @@ func (m *EventUpdateGroupExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorBucket) Marshal() (dAtA []byte, err error) {
+func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4359,49 +4845,56 @@ func (m *EventMirrorBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
+	if len(m.Extra) > 0 {
+		i -= len(m.Extra)
+		copy(dAtA[i:], m.Extra)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Extra)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
+	}
+	if m.SourceType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
+		i--
+		dAtA[i] = 0x20
 	}
 	{
-		size := m.BucketId.Size()
+		size := m.GroupId.Size()
 		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+	dAtA[i] = 0x1a
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorBucketResult) Marshal() (dAtA []byte, err error) {
+func (m *EventDeleteGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4411,47 +4904,44 @@ func (m *EventMirrorBucketResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorBucketResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDeleteGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorBucketResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDeleteGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
-		i--
-		dAtA[i] = 0x28
-	}
 	{
-		size := m.BucketId.Size()
+		size := m.GroupId.Size()
 		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorObject) Marshal() (dAtA []byte, err error) {
+func (m *EventLeaveGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4461,56 +4951,51 @@ func (m *EventMirrorObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
-		i--
-		dAtA[i] = 0x28
-	}
 	{
-		size := m.ObjectId.Size()
+		size := m.GroupId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if len(m.MemberAddress) > 0 {
+		i -= len(m.MemberAddress)
+		copy(dAtA[i:], m.MemberAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MemberAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorObjectResult) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateGroupMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4520,54 +5005,74 @@ func (m *EventMirrorObjectResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorObjectResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateGroupMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorObjectResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
-		i--
-		dAtA[i] = 0x28
+	if len(m.MembersToDelete) > 0 {
+		for iNdEx := len(m.MembersToDelete) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MembersToDelete[iNdEx])
+			copy(dAtA[i:], m.MembersToDelete[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.MembersToDelete[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MembersToAdd) > 0 {
+		for iNdEx := len(m.MembersToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MembersToAdd[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
 	{
-		size := m.ObjectId.Size()
+		size := m.GroupId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorGroup) Marshal() (dAtA []byte, err error) {
+func (m *EventRenewGroupMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4577,20 +5082,34 @@ func (m *EventMirrorGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRenewGroupMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRenewGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SourceType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceType))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x28
 	}
 	{
 		size := m.GroupId.Size()
@@ -4601,25 +5120,32 @@ func (m *EventMirrorGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
 	if len(m.GroupName) > 0 {
 		i -= len(m.GroupName)
 		copy(dAtA[i:], m.GroupName)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorGroupResult) Marshal() (dAtA []byte, err error) {
+func (m *EventGroupMemberDetail) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4629,47 +5155,37 @@ func (m *EventMirrorGroupResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMirrorGroupResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventGroupMemberDetail) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMirrorGroupResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventGroupMemberDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DestChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.GroupId.Size()
-		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+		i -= n1
+		i = encodeVarintEvents(dAtA, i, uint64(n1))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Member)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventStalePolicyCleanup) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateGroupExtra) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4679,37 +5195,58 @@ func (m *EventStalePolicyCleanup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventStalePolicyCleanup) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateGroupExtra) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventStalePolicyCleanup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateGroupExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DeleteInfo != nil {
-		{
-			size, err := m.DeleteInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	if len(m.Extra) > 0 {
+		i -= len(m.Extra)
+		copy(dAtA[i:], m.Extra)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Extra)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.GroupId.Size()
+		i -= size
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.BlockNum != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockNum))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMigrationBucket) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4719,26 +5256,21 @@ func (m *EventMigrationBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.DstPrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.DstPrimarySpId))
-		i--
-		dAtA[i] = 0x20
-	}
 	{
 		size := m.BucketId.Size()
 		i -= size
@@ -4748,7 +5280,7 @@ func (m *EventMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -4766,7 +5298,7 @@ func (m *EventMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelMigrationBucket) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorBucketResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4776,20 +5308,20 @@ func (m *EventCancelMigrationBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCancelMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorBucketResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCancelMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorBucketResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x28
 	}
 	{
 		size := m.BucketId.Size()
@@ -4800,7 +5332,7 @@ func (m *EventCancelMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -4808,17 +5340,15 @@ func (m *EventCancelMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4828,31 +5358,38 @@ func (m *EventRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventRejectMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x28
 	}
 	{
-		size := m.BucketId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -4870,7 +5407,7 @@ func (m *EventRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCompleteMigrationBucket) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorObjectResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4880,41 +5417,38 @@ func (m *EventCompleteMigrationBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCompleteMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorObjectResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCompleteMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorObjectResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.SrcPrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SrcPrimarySpId))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x20
-	}
 	{
-		size := m.BucketId.Size()
+		size := m.ObjectId.Size()
 		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -4922,17 +5456,15 @@ func (m *EventCompleteMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSetTag) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4942,49 +5474,49 @@ func (m *EventSetTag) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSetTag) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSetTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
-		size := m.Id.Size()
+		size := m.GroupId.Size()
 		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if m.Tags != nil {
-		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	dAtA[i] = 0x1a
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Resource)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+func (m *EventMirrorGroupResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4994,70 +5526,47 @@ func (m *EventUpdateObjectContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMirrorGroupResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMirrorGroupResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Checksums) > 0 {
-		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Checksums[iNdEx])
-			copy(dAtA[i:], m.Checksums[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestChainId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
 	}
 	{
-		size := m.ObjectId.Size()
+		size := m.GroupId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+	dAtA[i] = 0x1a
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateObjectContentSuccess) Marshal() (dAtA []byte, err error) {
+func (m *EventStalePolicyCleanup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5067,85 +5576,83 @@ func (m *EventUpdateObjectContentSuccess) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateObjectContentSuccess) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventStalePolicyCleanup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateObjectContentSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventStalePolicyCleanup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UpdatedAt != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.UpdatedAt))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Version != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.NewChecksums) > 0 {
-		for iNdEx := len(m.NewChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NewChecksums[iNdEx])
-			copy(dAtA[i:], m.NewChecksums[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.NewChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.PrevChecksums) > 0 {
-		for iNdEx := len(m.PrevChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PrevChecksums[iNdEx])
-			copy(dAtA[i:], m.PrevChecksums[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.PrevChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x42
+	if m.DeleteInfo != nil {
+		{
+			size, err := m.DeleteInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
-	}
-	if m.NewPayloadSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewPayloadSize))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x12
 	}
-	if m.PrevPayloadSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrevPayloadSize))
+	if m.BlockNum != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockNum))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x8
 	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMigrationBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+	return dAtA[:n], nil
+}
+
+func (m *EventMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x28
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+	if m.DstPrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DstPrimarySpId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
 	}
 	{
-		size := m.ObjectId.Size()
+		size := m.BucketId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Operator) > 0 {
 		i -= len(m.Operator)
 		copy(dAtA[i:], m.Operator)
@@ -5156,7 +5663,7 @@ func (m *EventUpdateObjectContentSuccess) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+func (m *EventCancelMigrationBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5166,33 +5673,31 @@ func (m *EventCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCancelUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCancelMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCancelUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCancelMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
-		size := m.ObjectId.Size()
+		size := m.BucketId.Size()
 		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
+	dAtA[i] = 0x1a
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -5210,7 +5715,7 @@ func (m *EventCancelUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
+func (m *EventRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5220,40 +5725,31 @@ func (m *EventSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSetBucketFlowRateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRejectMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSetBucketFlowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
-		size := m.FlowRateLimit.Size()
+		size := m.BucketId.Size()
 		i -= size
-		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.BucketOwner) > 0 {
-		i -= len(m.BucketOwner)
-		copy(dAtA[i:], m.BucketOwner)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketOwner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
+	dAtA[i] = 0x1a
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -5271,7 +5767,7 @@ func (m *EventSetBucketFlowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *EventBucketFlowRateLimitStatus) Marshal() (dAtA []byte, err error) {
+func (m *EventCompleteMigrationBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5281,17 +5777,32 @@ func (m *EventBucketFlowRateLimitStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventBucketFlowRateLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCompleteMigrationBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventBucketFlowRateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCompleteMigrationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SrcPrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SrcPrimarySpId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
 		size := m.BucketId.Size()
 		i -= size
 		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
@@ -5301,27 +5812,24 @@ func (m *EventBucketFlowRateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	i--
 	dAtA[i] = 0x1a
-	if m.IsLimited {
-		i--
-		if m.IsLimited {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
+func (m *EventSetTag) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5331,783 +5839,1153 @@ func (m *EventToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventToggleSPAsDelegatedAgent) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSetTag) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventToggleSPAsDelegatedAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSetTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SpAsDelegatedAgentDisabled {
-		i--
-		if m.SpAsDelegatedAgentDisabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
 	{
-		size := m.BucketId.Size()
+		size := m.Id.Size()
 		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+	dAtA[i] = 0x2a
+	if m.Tags != nil {
+		{
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Resource)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovEvents(uint64(m.Visibility))
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovEvents(uint64(m.CreateAt))
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SourceType != 0 {
-		n += 1 + sovEvents(uint64(m.SourceType))
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.ChargedReadQuota != 0 {
-		n += 1 + sovEvents(uint64(m.ChargedReadQuota))
+	if m.PayloadSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDeleteBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+func (m *EventUpdateObjectContentSuccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventUpdateBucketInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventUpdateObjectContentSuccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateObjectContentSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.UpdatedAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.ChargedReadQuota != 0 {
-		n += 1 + sovEvents(uint64(m.ChargedReadQuota))
+	if len(m.NewChecksums) > 0 {
+		for iNdEx := len(m.NewChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewChecksums[iNdEx])
+			copy(dAtA[i:], m.NewChecksums[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.NewChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PrevChecksums) > 0 {
+		for iNdEx := len(m.PrevChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrevChecksums[iNdEx])
+			copy(dAtA[i:], m.PrevChecksums[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PrevChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.Visibility != 0 {
-		n += 1 + sovEvents(uint64(m.Visibility))
+	if m.NewPayloadSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewPayloadSize))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	if m.PrevPayloadSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrevPayloadSize))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *EventDiscontinueBucket) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.DeleteAt != 0 {
-		n += 1 + sovEvents(uint64(m.DeleteAt))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCreateObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
-	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovEvents(uint64(m.PayloadSize))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovEvents(uint64(m.Visibility))
-	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovEvents(uint64(m.CreateAt))
-	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
-	}
-	if m.RedundancyType != 0 {
-		n += 1 + sovEvents(uint64(m.RedundancyType))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.SourceType != 0 {
-		n += 1 + sovEvents(uint64(m.SourceType))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Checksums) > 0 {
-		for _, b := range m.Checksums {
-			l = len(b)
-			n += 2 + l + sovEvents(uint64(l))
-		}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.LocalVirtualGroupId != 0 {
-		n += 2 + sovEvents(uint64(m.LocalVirtualGroupId))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelCreateObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetBucketFlowRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetBucketFlowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.FlowRateLimit.Size()
+		i -= size
+		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.BucketOwner) > 0 {
+		i -= len(m.BucketOwner)
+		copy(dAtA[i:], m.BucketOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketOwner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSealObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventBucketFlowRateLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBucketFlowRateLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBucketFlowRateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
-	}
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
-	}
-	if m.LocalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if len(m.Checksums) > 0 {
-		for _, b := range m.Checksums {
-			l = len(b)
-			n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.IsLimited {
+		i--
+		if m.IsLimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCopyObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventToggleSPAsDelegatedAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventToggleSPAsDelegatedAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SrcBucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SrcObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.DstBucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.SpAsDelegatedAgentDisabled {
+		i--
+		if m.SpAsDelegatedAgentDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.DstObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.SrcObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.DstObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.LocalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	i--
+	dAtA[i] = 0x12
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDeleteObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetBucketLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetBucketLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetBucketLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.LocalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRejectSealObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventExpireObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExpireObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExpireObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.RuleId) > 0 {
+		i -= len(m.RuleId)
+		copy(dAtA[i:], m.RuleId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RuleId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.ForUpdate {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDiscontinueObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetBucketVersioning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetBucketVersioning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetBucketVersioning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MaxVersions != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxVersions))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.DeleteAt != 0 {
-		n += 1 + sovEvents(uint64(m.DeleteAt))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateObjectInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRestoreObjectVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRestoreObjectVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRestoreObjectVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.RestoredVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RestoredVersion))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.Visibility != 0 {
-		n += 1 + sovEvents(uint64(m.Visibility))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetBucketReadQuotaAutoTopup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetBucketReadQuotaAutoTopup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetBucketReadQuotaAutoTopup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.AutoTopup.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SourceType != 0 {
-		n += 1 + sovEvents(uint64(m.SourceType))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Extra)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDeleteGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventReportReadQuotaConsumption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventLeaveGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MemberAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+func (m *EventReportReadQuotaConsumption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateGroupMember) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventReportReadQuotaConsumption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ConsumedReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ConsumedReadQuota))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Month != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Month))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if len(m.MembersToAdd) > 0 {
-		for _, e := range m.MembersToAdd {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if len(m.MembersToDelete) > 0 {
-		for _, s := range m.MembersToDelete {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRenewGroupMember) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventReadQuotaAutoTopup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReadQuotaAutoTopup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReadQuotaAutoTopup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChargedReadQuota))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SourceType != 0 {
-		n += 1 + sovEvents(uint64(m.SourceType))
+	if m.PreviousReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousReadQuota))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventGroupMemberDetail) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Member)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.ExpirationTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventSetBucketCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventUpdateGroupExtra) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventSetBucketCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetBucketCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Callback.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.Extra)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
+func (m *EventObjectCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventMirrorBucketResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventObjectCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObjectCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.GasUsed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *EventMirrorObject) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.CallbackAddress) > 0 {
+		i -= len(m.CallbackAddress)
+		copy(dAtA[i:], m.CallbackAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CallbackAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorObjectResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTransferBucketOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTransferBucketOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTransferBucketOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMirrorGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventAcceptBucketOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAcceptBucketOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAcceptBucketOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ObjectCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ObjectCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *EventMirrorGroupResult) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	i--
+	dAtA[i] = 0x22
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.GroupId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.DestChainId != 0 {
-		n += 1 + sovEvents(uint64(m.DestChainId))
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventStalePolicyCleanup) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventTransferBucketObjects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTransferBucketObjects) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTransferBucketObjects) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockNum != 0 {
-		n += 1 + sovEvents(uint64(m.BlockNum))
+	if m.ObjectCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ObjectCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.DeleteInfo != nil {
-		l = m.DeleteInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMigrationBucket) Size() (n int) {
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateBucket) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Operator)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
@@ -6115,40 +6993,37 @@ func (m *EventMigrationBucket) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.Visibility != 0 {
+		n += 1 + sovEvents(uint64(m.Visibility))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovEvents(uint64(m.CreateAt))
+	}
 	l = m.BucketId.Size()
 	n += 1 + l + sovEvents(uint64(l))
-	if m.DstPrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.DstPrimarySpId))
-	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if m.SourceType != 0 {
+		n += 1 + sovEvents(uint64(m.SourceType))
 	}
-	return n
-}
-
-func (m *EventCancelMigrationBucket) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.ChargedReadQuota))
 	}
-	var l int
-	_ = l
-	l = len(m.Operator)
+	l = len(m.PaymentAddress)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
 	if m.Status != 0 {
 		n += 1 + sovEvents(uint64(m.Status))
 	}
 	return n
 }
 
-func (m *EventRejectMigrateBucket) Size() (n int) {
+func (m *EventDeleteBucket) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6158,19 +7033,23 @@ func (m *EventRejectMigrateBucket) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	l = m.BucketId.Size()
 	n += 1 + l + sovEvents(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
 	}
 	return n
 }
 
-func (m *EventCompleteMigrationBucket) Size() (n int) {
+func (m *EventUpdateBucketInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6186,49 +7065,58 @@ func (m *EventCompleteMigrationBucket) Size() (n int) {
 	}
 	l = m.BucketId.Size()
 	n += 1 + l + sovEvents(uint64(l))
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.ChargedReadQuota))
 	}
-	if m.SrcPrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.SrcPrimarySpId))
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if m.Visibility != 0 {
+		n += 1 + sovEvents(uint64(m.Visibility))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
 	}
 	return n
 }
 
-func (m *EventSetTag) Size() (n int) {
+func (m *EventDiscontinueBucket) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Resource)
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
+	l = len(m.Reason)
+	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = m.Id.Size()
-	n += 1 + l + sovEvents(uint64(l))
+	if m.DeleteAt != 0 {
+		n += 1 + sovEvents(uint64(m.DeleteAt))
+	}
 	return n
 }
 
-func (m *EventUpdateObjectContent) Size() (n int) {
+func (m *EventCreateObject) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Operator)
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
 	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
@@ -6237,22 +7125,48 @@ func (m *EventUpdateObjectContent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
 	if m.PayloadSize != 0 {
 		n += 1 + sovEvents(uint64(m.PayloadSize))
 	}
+	if m.Visibility != 0 {
+		n += 1 + sovEvents(uint64(m.Visibility))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovEvents(uint64(m.CreateAt))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovEvents(uint64(m.RedundancyType))
+	}
+	if m.SourceType != 0 {
+		n += 1 + sovEvents(uint64(m.SourceType))
+	}
 	if len(m.Checksums) > 0 {
 		for _, b := range m.Checksums {
 			l = len(b)
-			n += 1 + l + sovEvents(uint64(l))
+			n += 2 + l + sovEvents(uint64(l))
 		}
 	}
-	if m.Version != 0 {
-		n += 1 + sovEvents(uint64(m.Version))
+	if m.LocalVirtualGroupId != 0 {
+		n += 2 + sovEvents(uint64(m.LocalVirtualGroupId))
 	}
 	return n
 }
 
-func (m *EventUpdateObjectContentSuccess) Size() (n int) {
+func (m *EventCancelCreateObject) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6262,8 +7176,6 @@ func (m *EventUpdateObjectContentSuccess) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
 	l = len(m.BucketName)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
@@ -6272,130 +7184,2956 @@ func (m *EventUpdateObjectContentSuccess) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.ContentType)
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSealObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.PrevPayloadSize != 0 {
-		n += 1 + sovEvents(uint64(m.PrevPayloadSize))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.NewPayloadSize != 0 {
-		n += 1 + sovEvents(uint64(m.NewPayloadSize))
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
 	}
-	if len(m.PrevChecksums) > 0 {
-		for _, b := range m.PrevChecksums {
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
 			l = len(b)
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
-	if len(m.NewChecksums) > 0 {
-		for _, b := range m.NewChecksums {
-			l = len(b)
-			n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCopyObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SrcBucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SrcObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DstBucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DstObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.SrcObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.DstObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *EventDeleteObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.LocalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *EventRejectSealObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ForUpdate {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventDiscontinueObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.DeleteAt != 0 {
+		n += 1 + sovEvents(uint64(m.DeleteAt))
+	}
+	return n
+}
+
+func (m *EventUpdateObjectInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Visibility != 0 {
+		n += 1 + sovEvents(uint64(m.Visibility))
+	}
+	return n
+}
+
+func (m *EventCreateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SourceType != 0 {
+		n += 1 + sovEvents(uint64(m.SourceType))
+	}
+	l = len(m.Extra)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeleteGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventLeaveGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MemberAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUpdateGroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.MembersToAdd) > 0 {
+		for _, e := range m.MembersToAdd {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.MembersToDelete) > 0 {
+		for _, s := range m.MembersToDelete {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRenewGroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SourceType != 0 {
+		n += 1 + sovEvents(uint64(m.SourceType))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventGroupMemberDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateGroupExtra) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Extra)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMirrorBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventMirrorBucketResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventMirrorObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventMirrorObjectResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventMirrorGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventMirrorGroupResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.GroupId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DestChainId != 0 {
+		n += 1 + sovEvents(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *EventStalePolicyCleanup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNum != 0 {
+		n += 1 + sovEvents(uint64(m.BlockNum))
+	}
+	if m.DeleteInfo != nil {
+		l = m.DeleteInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMigrationBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.DstPrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.DstPrimarySpId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *EventCancelMigrationBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *EventRejectMigrateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *EventCompleteMigrationBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.SrcPrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.SrcPrimarySpId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *EventSetTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUpdateObjectContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovEvents(uint64(m.PayloadSize))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *EventUpdateObjectContentSuccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PrevPayloadSize != 0 {
+		n += 1 + sovEvents(uint64(m.PrevPayloadSize))
+	}
+	if m.NewPayloadSize != 0 {
+		n += 1 + sovEvents(uint64(m.NewPayloadSize))
+	}
+	if len(m.PrevChecksums) > 0 {
+		for _, b := range m.PrevChecksums {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.NewChecksums) > 0 {
+		for _, b := range m.NewChecksums {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovEvents(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *EventCancelUpdateObjectContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSetBucketFlowRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.FlowRateLimit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventBucketFlowRateLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsLimited {
+		n += 2
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventToggleSPAsDelegatedAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SpAsDelegatedAgentDisabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventSetBucketLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventExpireObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.RuleId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetBucketVersioning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.MaxVersions != 0 {
+		n += 1 + sovEvents(uint64(m.MaxVersions))
+	}
+	return n
+}
+
+func (m *EventRestoreObjectVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.RestoredVersion != 0 {
+		n += 1 + sovEvents(uint64(m.RestoredVersion))
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *EventSetBucketReadQuotaAutoTopup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.AutoTopup.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventReportReadQuotaConsumption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Month != 0 {
+		n += 1 + sovEvents(uint64(m.Month))
+	}
+	if m.ConsumedReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.ConsumedReadQuota))
+	}
+	return n
+}
+
+func (m *EventReadQuotaAutoTopup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PreviousReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousReadQuota))
+	}
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.ChargedReadQuota))
+	}
+	return n
+}
+
+func (m *EventSetBucketCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Callback.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventObjectCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.CallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvents(uint64(m.GasUsed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTransferBucketOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAcceptBucketOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ObjectCount != 0 {
+		n += 1 + sovEvents(uint64(m.ObjectCount))
+	}
+	return n
+}
+
+func (m *EventTransferBucketObjects) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ObjectCount != 0 {
+		n += 1 + sovEvents(uint64(m.ObjectCount))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			m.SourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceType |= SourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			}
+			m.ChargedReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BucketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeleteBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeleteBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateBucketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			}
+			m.ChargedReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDiscontinueBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDiscontinueBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+			}
+			m.DeleteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ObjectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			m.SourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceType |= SourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+			}
+			m.LocalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelCreateObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSealObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSealObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ObjectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+			}
+			m.LocalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Version != 0 {
-		n += 1 + sovEvents(uint64(m.Version))
-	}
-	if m.UpdatedAt != 0 {
-		n += 1 + sovEvents(uint64(m.UpdatedAt))
-	}
-	return n
-}
-
-func (m *EventCancelUpdateObjectContent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func (m *EventSetBucketFlowRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketOwner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.FlowRateLimit.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func (m *EventBucketFlowRateLimitStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsLimited {
-		n += 2
-	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
 
-func (m *EventToggleSPAsDelegatedAgent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SpAsDelegatedAgentDisabled {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
+func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6418,15 +10156,15 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCopyObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCopyObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6454,11 +10192,11 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcBucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6486,13 +10224,13 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.SrcBucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectName", wireType)
 			}
-			m.Visibility = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6502,33 +10240,27 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstBucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6556,15 +10288,13 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DstBucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectName", wireType)
 			}
-			m.SourceType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6574,33 +10304,27 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.ChargedReadQuota = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChargedReadQuota |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6628,13 +10352,15 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.SrcObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectId", wireType)
 			}
-			m.PrimarySpId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6644,35 +10370,31 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.GlobalVirtualGroupFamilyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 11:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DstObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
 			}
-			m.Status = 0
+			m.LocalVirtualGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6682,7 +10404,7 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BucketStatus(b&0x7F) << shift
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6708,7 +10430,7 @@ func (m *EventCreateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
+func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6731,10 +10453,10 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeleteBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDeleteObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeleteBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDeleteObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6771,7 +10493,7 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6799,11 +10521,11 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6831,11 +10553,11 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6863,15 +10585,15 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
 			}
-			m.GlobalVirtualGroupFamilyId = 0
+			m.LocalVirtualGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6881,7 +10603,7 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6907,7 +10629,7 @@ func (m *EventDeleteBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
+func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6930,10 +10652,10 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateBucketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRejectSealObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRejectSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7002,7 +10724,7 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7030,15 +10752,47 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForUpdate", wireType)
 			}
-			m.ChargedReadQuota = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7048,14 +10802,97 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForUpdate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDiscontinueObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDiscontinueObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7083,13 +10920,15 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Visibility = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7099,16 +10938,29 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
 			}
-			m.GlobalVirtualGroupFamilyId = 0
+			m.DeleteAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7118,7 +10970,7 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				m.DeleteAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7144,7 +10996,7 @@ func (m *EventUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7167,15 +11019,15 @@ func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDiscontinueBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateObjectInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDiscontinueBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7203,9 +11055,7 @@ func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -7241,7 +11091,7 @@ func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7269,13 +11119,47 @@ func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
 			}
-			m.DeleteAt = 0
+			m.Visibility = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7285,7 +11169,7 @@ func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeleteAt |= int64(b&0x7F) << shift
+				m.Visibility |= VisibilityType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7311,7 +11195,7 @@ func (m *EventDiscontinueBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
+func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7334,15 +11218,15 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreateGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7370,11 +11254,11 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7402,11 +11286,11 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7434,13 +11318,15 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
 			}
-			var stringLen uint64
+			m.SourceType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7450,27 +11336,14 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SourceType |= SourceType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7498,13 +11371,61 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Extra = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeleteGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeleteGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7532,15 +11453,13 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
-			m.PrimarySpId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7550,52 +11469,27 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 11:
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7623,51 +11517,65 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ObjectStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.RedundancyType = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLeaveGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7677,16 +11585,29 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.SourceType = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7696,16 +11617,29 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7715,29 +11649,29 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
-			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.LocalVirtualGroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7747,11 +11681,26 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -7773,7 +11722,7 @@ func (m *EventCreateObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7796,10 +11745,10 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelCreateObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateGroupMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7836,7 +11785,7 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7864,11 +11813,11 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7896,13 +11845,13 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.PrimarySpId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -7912,14 +11861,63 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembersToAdd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MembersToAdd = append(m.MembersToAdd, &EventGroupMemberDetail{})
+			if err := m.MembersToAdd[len(m.MembersToAdd)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MembersToDelete", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7947,9 +11945,7 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MembersToDelete = append(m.MembersToDelete, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7972,7 +11968,7 @@ func (m *EventCancelCreateObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSealObject) Unmarshal(dAtA []byte) error {
+func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7995,10 +11991,10 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSealObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRenewGroupMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRenewGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8035,7 +12031,7 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8063,11 +12059,11 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8095,11 +12091,11 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8127,15 +12123,15 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
 			}
-			m.Status = 0
+			m.SourceType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8145,16 +12141,16 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ObjectStatus(b&0x7F) << shift
+				m.SourceType |= SourceType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			m.GlobalVirtualGroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8164,16 +12160,81 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.LocalVirtualGroupId = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &EventGroupMemberDetail{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGroupMemberDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGroupMemberDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8183,16 +12244,29 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8202,23 +12276,27 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
-			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8241,7 +12319,7 @@ func (m *EventSealObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8264,10 +12342,10 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCopyObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateGroupExtra: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCopyObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateGroupExtra: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8304,7 +12382,7 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcBucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8332,11 +12410,11 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcBucketName = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8364,11 +12442,11 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstBucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8396,11 +12474,13 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstBucketName = string(dAtA[iNdEx:postIndex])
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8428,11 +12508,61 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstObjectName = string(dAtA[iNdEx:postIndex])
+			m.Extra = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMirrorBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMirrorBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8460,13 +12590,43 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SrcObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8494,15 +12654,15 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DstObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			m.LocalVirtualGroupId = 0
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8512,7 +12672,7 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8538,7 +12698,7 @@ func (m *EventCopyObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
+func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8561,17 +12721,17 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeleteObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMirrorBucketResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeleteObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMirrorBucketResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8581,24 +12741,11 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
@@ -8631,41 +12778,9 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8693,15 +12808,15 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			m.LocalVirtualGroupId = 0
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8711,7 +12826,7 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8737,7 +12852,7 @@ func (m *EventDeleteObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
+func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8760,10 +12875,10 @@ func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRejectSealObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMirrorObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRejectSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMirrorObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8898,9 +13013,9 @@ func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			var v int
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8910,12 +13025,11 @@ func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForUpdate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -8937,7 +13051,7 @@ func (m *EventRejectSealObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
+func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8960,13 +13074,32 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDiscontinueObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMirrorObjectResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDiscontinueObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMirrorObjectResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -8998,9 +13131,9 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9028,13 +13161,11 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9062,13 +13193,15 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			m.DeleteAt = 0
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9078,7 +13211,7 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeleteAt |= int64(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9104,7 +13237,7 @@ func (m *EventDiscontinueObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9127,15 +13260,15 @@ func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateObjectInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMirrorGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMirrorGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9163,11 +13296,11 @@ func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9195,43 +13328,11 @@ func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9259,15 +13360,15 @@ func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			m.Visibility = 0
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9277,7 +13378,7 @@ func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9303,7 +13404,7 @@ func (m *EventUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
+func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9326,15 +13427,34 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMirrorGroupResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMirrorGroupResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9362,11 +13482,11 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9394,13 +13514,15 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			var stringLen uint64
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9410,31 +13532,66 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStalePolicyCleanup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStalePolicyCleanup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
 			}
-			m.SourceType = 0
+			m.BlockNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9444,16 +13601,16 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
+				m.BlockNum |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9463,23 +13620,27 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extra = string(dAtA[iNdEx:postIndex])
+			if m.DeleteInfo == nil {
+				m.DeleteInfo = &DeleteInfo{}
+			}
+			if err := m.DeleteInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9502,7 +13663,7 @@ func (m *EventCreateGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
+func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9525,15 +13686,15 @@ func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeleteGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMigrationBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeleteGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9561,11 +13722,11 @@ func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9593,11 +13754,11 @@ func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9625,10 +13786,48 @@ func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpId", wireType)
+			}
+			m.DstPrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstPrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BucketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -9650,7 +13849,7 @@ func (m *EventDeleteGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
+func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9673,15 +13872,15 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLeaveGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelMigrationBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9709,11 +13908,11 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemberAddress = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9741,11 +13940,11 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9773,13 +13972,15 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9789,26 +13990,11 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= BucketStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -9830,7 +14016,7 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
+func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9845,87 +14031,23 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateGroupMember: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRejectMigrateBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRejectMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9953,11 +14075,11 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9985,15 +14107,13 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembersToAdd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10003,31 +14123,31 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MembersToAdd = append(m.MembersToAdd, &EventGroupMemberDetail{})
-			if err := m.MembersToAdd[len(m.MembersToAdd)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembersToDelete", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10037,24 +14157,11 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= BucketStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MembersToDelete = append(m.MembersToDelete, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10076,7 +14183,7 @@ func (m *EventUpdateGroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
+func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10099,10 +14206,10 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRenewGroupMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCompleteMigrationBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRenewGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCompleteMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10139,7 +14246,7 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10167,11 +14274,11 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10199,13 +14306,15 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			var stringLen uint64
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10215,31 +14324,16 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPrimarySpId", wireType)
 			}
-			m.SourceType = 0
+			m.SrcPrimarySpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10249,16 +14343,16 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
+				m.SrcPrimarySpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10268,26 +14362,11 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= BucketStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Members = append(m.Members, &EventGroupMemberDetail{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10309,7 +14388,7 @@ func (m *EventRenewGroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
+func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10332,15 +14411,15 @@ func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGroupMemberDetail: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetTag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGroupMemberDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetTag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10368,11 +14447,11 @@ func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Member = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10399,10 +14478,44 @@ func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = new(time.Time)
+			if m.Tags == nil {
+				m.Tags = &ResourceTags{}
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10427,7 +14540,7 @@ func (m *EventGroupMemberDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10450,10 +14563,10 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateGroupExtra: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateObjectContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateGroupExtra: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10490,7 +14603,7 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10518,11 +14631,13 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10550,11 +14665,11 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10582,15 +14697,64 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10600,24 +14764,11 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Extra = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10639,7 +14790,7 @@ func (m *EventUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10662,10 +14813,10 @@ func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateObjectContentSuccess: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateObjectContentSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10701,6 +14852,40 @@ func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -10734,7 +14919,7 @@ func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10762,15 +14947,13 @@ func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			m.DestChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10780,66 +14963,48 @@ func (m *EventMirrorBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevPayloadSize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PrevPayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevPayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorBucketResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorBucketResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPayloadSize", wireType)
 			}
-			m.Status = 0
+			m.NewPayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10849,16 +15014,16 @@ func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= uint32(b&0x7F) << shift
+				m.NewPayloadSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevChecksums", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10868,29 +15033,29 @@ func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.PrevChecksums = append(m.PrevChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.PrevChecksums[len(m.PrevChecksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChecksums", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10900,31 +15065,29 @@ func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewChecksums = append(m.NewChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.NewChecksums[len(m.NewChecksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.DestChainId = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10934,7 +15097,26 @@ func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10960,7 +15142,7 @@ func (m *EventMirrorBucketResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
+func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10983,10 +15165,10 @@ func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelUpdateObjectContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11106,38 +15288,19 @@ func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
 			}
 			intStringLen := int(stringLen)
 			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
-			}
-			m.DestChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11159,7 +15322,7 @@ func (m *EventMirrorObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
+func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11182,17 +15345,17 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorObjectResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetBucketFlowRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorObjectResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetBucketFlowRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11202,11 +15365,24 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
@@ -11241,7 +15417,7 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11269,11 +15445,11 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11301,15 +15477,13 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
 			}
-			m.DestChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11319,11 +15493,26 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11345,7 +15534,7 @@ func (m *EventMirrorObjectResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
+func (m *EventBucketFlowRateLimitStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11368,15 +15557,15 @@ func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBucketFlowRateLimitStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBucketFlowRateLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11404,13 +15593,13 @@ func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLimited", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11420,27 +15609,15 @@ func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsLimited = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11468,29 +15645,10 @@ func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
-			}
-			m.DestChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11512,7 +15670,7 @@ func (m *EventMirrorGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
+func (m *EventToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11535,34 +15693,15 @@ func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMirrorGroupResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventToggleSPAsDelegatedAgent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMirrorGroupResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventToggleSPAsDelegatedAgent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11590,11 +15729,11 @@ func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11622,15 +15761,15 @@ func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAsDelegatedAgentDisabled", wireType)
 			}
-			m.DestChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11640,11 +15779,12 @@ func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SpAsDelegatedAgentDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11666,7 +15806,7 @@ func (m *EventMirrorGroupResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
+func (m *EventSetBucketLifecycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11689,17 +15829,17 @@ func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStalePolicyCleanup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetBucketLifecycle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStalePolicyCleanup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetBucketLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			m.BlockNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11709,14 +15849,93 @@ func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNum |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11743,10 +15962,8 @@ func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteInfo == nil {
-				m.DeleteInfo = &DeleteInfo{}
-			}
-			if err := m.DeleteInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rules = append(m.Rules, LifecycleRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11771,7 +15988,7 @@ func (m *EventStalePolicyCleanup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
+func (m *EventExpireObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11794,15 +16011,15 @@ func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMigrationBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExpireObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExpireObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11830,11 +16047,11 @@ func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11862,11 +16079,13 @@ func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11894,48 +16113,8 @@ func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RuleId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpId", wireType)
-			}
-			m.DstPrimarySpId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DstPrimarySpId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= BucketStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11957,7 +16136,7 @@ func (m *EventMigrationBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
+func (m *EventSetBucketVersioning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11980,10 +16159,10 @@ func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelMigrationBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetBucketVersioning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetBucketVersioning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12086,9 +16265,9 @@ func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVersions", wireType)
 			}
-			m.Status = 0
+			m.MaxVersions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12098,7 +16277,7 @@ func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BucketStatus(b&0x7F) << shift
+				m.MaxVersions |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12124,7 +16303,7 @@ func (m *EventCancelMigrationBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
+func (m *EventRestoreObjectVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12147,10 +16326,10 @@ func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRejectMigrateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRestoreObjectVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRejectMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRestoreObjectVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12219,7 +16398,7 @@ func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12247,15 +16426,47 @@ func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoredVersion", wireType)
 			}
-			m.Status = 0
+			m.RestoredVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12265,7 +16476,26 @@ func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BucketStatus(b&0x7F) << shift
+				m.RestoredVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12291,7 +16521,7 @@ func (m *EventRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
+func (m *EventSetBucketReadQuotaAutoTopup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12314,10 +16544,10 @@ func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCompleteMigrationBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetBucketReadQuotaAutoTopup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCompleteMigrationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetBucketReadQuotaAutoTopup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12419,10 +16649,10 @@ func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoTopup", wireType)
 			}
-			m.GlobalVirtualGroupFamilyId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12432,49 +16662,25 @@ func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPrimarySpId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.SrcPrimarySpId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SrcPrimarySpId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= BucketStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.AutoTopup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -12496,7 +16702,7 @@ func (m *EventCompleteMigrationBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetTag) Unmarshal(dAtA []byte) error {
+func (m *EventReportReadQuotaConsumption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12519,15 +16725,15 @@ func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetTag: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReportReadQuotaConsumption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetTag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReportReadQuotaConsumption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12555,13 +16761,13 @@ func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12571,33 +16777,82 @@ func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &ResourceTags{}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			m.Month = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Month |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumedReadQuota", wireType)
 			}
-			var stringLen uint64
+			m.ConsumedReadQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12607,26 +16862,11 @@ func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ConsumedReadQuota |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -12648,7 +16888,7 @@ func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
+func (m *EventReadQuotaAutoTopup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12671,15 +16911,15 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateObjectContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReadQuotaAutoTopup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReadQuotaAutoTopup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12707,11 +16947,11 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12739,98 +16979,15 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReadQuota", wireType)
 			}
-			var byteLen int
+			m.PreviousReadQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12840,29 +16997,16 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.PreviousReadQuota |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
-			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
 			}
-			m.Version = 0
+			m.ChargedReadQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -12872,7 +17016,7 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12898,7 +17042,7 @@ func (m *EventUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
+func (m *EventSetBucketCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12921,10 +17065,10 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateObjectContentSuccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetBucketCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateObjectContentSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetBucketCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12961,7 +17105,7 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12989,13 +17133,11 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13023,13 +17165,15 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13039,27 +17183,78 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObjectCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventObjectCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventObjectCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13087,13 +17282,13 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevPayloadSize", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
-			m.PrevPayloadSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13103,16 +17298,29 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrevPayloadSize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPayloadSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.NewPayloadSize = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13122,16 +17330,31 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewPayloadSize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13141,29 +17364,29 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrevChecksums = append(m.PrevChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.PrevChecksums[len(m.PrevChecksums)-1], dAtA[iNdEx:postIndex])
+			m.CallbackAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13173,29 +17396,29 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewChecksums = append(m.NewChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.NewChecksums[len(m.NewChecksums)-1], dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.Version = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13205,16 +17428,17 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			m.Success = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			m.UpdatedAt = 0
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13224,11 +17448,43 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -13250,7 +17506,7 @@ func (m *EventUpdateObjectContentSuccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
+func (m *EventTransferBucketOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13273,10 +17529,10 @@ func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelUpdateObjectContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTransferBucketOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTransferBucketOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13345,7 +17601,7 @@ func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13373,11 +17629,13 @@ func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13405,9 +17663,7 @@ func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13430,7 +17686,7 @@ func (m *EventCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
+func (m *EventAcceptBucketOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13453,15 +17709,15 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetBucketFlowRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAcceptBucketOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetBucketFlowRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAcceptBucketOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13489,11 +17745,11 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13521,11 +17777,11 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13553,11 +17809,11 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13585,11 +17841,13 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketOwner = string(dAtA[iNdEx:postIndex])
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13617,10 +17875,27 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectCount", wireType)
+			}
+			m.ObjectCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -13642,7 +17917,7 @@ func (m *EventSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBucketFlowRateLimitStatus) Unmarshal(dAtA []byte) error {
+func (m *EventTransferBucketObjects) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13665,15 +17940,15 @@ func (m *EventBucketFlowRateLimitStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBucketFlowRateLimitStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTransferBucketObjects: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBucketFlowRateLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTransferBucketObjects: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13701,113 +17976,9 @@ func (m *EventBucketFlowRateLimitStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLimited", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsLimited = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventToggleSPAsDelegatedAgent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventToggleSPAsDelegatedAgent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -13839,7 +18010,7 @@ func (m *EventToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
@@ -13873,11 +18044,11 @@ func (m *EventToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAsDelegatedAgentDisabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectCount", wireType)
 			}
-			var v int
+			m.ObjectCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -13887,12 +18058,11 @@ func (m *EventToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ObjectCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SpAsDelegatedAgentDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
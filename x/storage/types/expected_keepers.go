@@ -43,6 +43,7 @@ type SpKeeper interface {
 	GetStorageProviderBySealAddr(ctx sdktypes.Context, sealAddr sdktypes.AccAddress) (sp *sptypes.StorageProvider, found bool)
 	GetStorageProviderByGcAddr(ctx sdktypes.Context, gcAddr sdktypes.AccAddress) (sp *sptypes.StorageProvider, found bool)
 	GetGlobalSpStorePriceByTime(ctx sdktypes.Context, time int64) (val sptypes.GlobalSpStorePrice, err error)
+	GetNextGlobalSpStorePrice(ctx sdktypes.Context) (sptypes.GlobalSpStorePrice, bool)
 }
 
 type PaymentKeeper interface {
@@ -54,6 +55,8 @@ type PaymentKeeper interface {
 	MergeOutFlows(flows []paymenttypes.OutFlow) []paymenttypes.OutFlow
 	GetAllStreamRecord(ctx sdktypes.Context) (list []paymenttypes.StreamRecord)
 	GetOutFlows(ctx sdktypes.Context, addr sdktypes.AccAddress) []paymenttypes.OutFlow
+	GetAllUserStream(ctx sdktypes.Context) (list []paymenttypes.UserStream)
+	GetFrozenTime(ctx sdktypes.Context, addr sdktypes.AccAddress) int64
 }
 
 type PermissionKeeper interface {
@@ -83,9 +86,11 @@ type VirtualGroupKeeper interface {
 	SetGVGAndEmitUpdateEvent(ctx sdktypes.Context, gvg *vgtypes.GlobalVirtualGroup) error
 	GetGVGFamily(ctx sdktypes.Context, familyID uint32) (*vgtypes.GlobalVirtualGroupFamily, bool)
 	GetGVG(ctx sdktypes.Context, gvgID uint32) (*vgtypes.GlobalVirtualGroup, bool)
+	GetAllGVGs(ctx sdktypes.Context) []*vgtypes.GlobalVirtualGroup
 	SettleAndDistributeGVGFamily(ctx sdktypes.Context, sp *sptypes.StorageProvider, family *vgtypes.GlobalVirtualGroupFamily) error
 	SettleAndDistributeGVG(ctx sdktypes.Context, gvg *vgtypes.GlobalVirtualGroup) error
 	GetAndCheckGVGFamilyAvailableForNewBucket(ctx sdktypes.Context, familyID uint32) (*vgtypes.GlobalVirtualGroupFamily, error)
+	PickGVGFamilyForNewBucket(ctx sdktypes.Context, spID uint32, strategy vgtypes.PickVGFStrategy) (*vgtypes.GlobalVirtualGroupFamily, error)
 	GetGlobalVirtualGroupIfAvailable(ctx sdktypes.Context, gvgID uint32, expectedStoreSize uint64) (*vgtypes.GlobalVirtualGroup, error)
 	GetSwapInInfo(ctx sdktypes.Context, familyID, gvgID uint32) (*vgtypes.SwapInInfo, bool)
 }
@@ -213,6 +213,21 @@ func (mr *MockSpKeeperMockRecorder) GetGlobalSpStorePriceByTime(ctx, time any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalSpStorePriceByTime", reflect.TypeOf((*MockSpKeeper)(nil).GetGlobalSpStorePriceByTime), ctx, time)
 }
 
+// GetNextGlobalSpStorePrice mocks base method.
+func (m *MockSpKeeper) GetNextGlobalSpStorePrice(ctx types0.Context) (types4.GlobalSpStorePrice, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextGlobalSpStorePrice", ctx)
+	ret0, _ := ret[0].(types4.GlobalSpStorePrice)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetNextGlobalSpStorePrice indicates an expected call of GetNextGlobalSpStorePrice.
+func (mr *MockSpKeeperMockRecorder) GetNextGlobalSpStorePrice(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextGlobalSpStorePrice", reflect.TypeOf((*MockSpKeeper)(nil).GetNextGlobalSpStorePrice), ctx)
+}
+
 // GetStorageProvider mocks base method.
 func (m *MockSpKeeper) GetStorageProvider(ctx types0.Context, id uint32) (*types4.StorageProvider, bool) {
 	m.ctrl.T.Helper()
@@ -338,6 +353,34 @@ func (mr *MockPaymentKeeperMockRecorder) GetAllStreamRecord(ctx any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStreamRecord", reflect.TypeOf((*MockPaymentKeeper)(nil).GetAllStreamRecord), ctx)
 }
 
+// GetAllUserStream mocks base method.
+func (m *MockPaymentKeeper) GetAllUserStream(ctx types0.Context) []types2.UserStream {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUserStream", ctx)
+	ret0, _ := ret[0].([]types2.UserStream)
+	return ret0
+}
+
+// GetAllUserStream indicates an expected call of GetAllUserStream.
+func (mr *MockPaymentKeeperMockRecorder) GetAllUserStream(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUserStream", reflect.TypeOf((*MockPaymentKeeper)(nil).GetAllUserStream), ctx)
+}
+
+// GetFrozenTime mocks base method.
+func (m *MockPaymentKeeper) GetFrozenTime(ctx types0.Context, addr types0.AccAddress) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFrozenTime", ctx, addr)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetFrozenTime indicates an expected call of GetFrozenTime.
+func (mr *MockPaymentKeeperMockRecorder) GetFrozenTime(ctx, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrozenTime", reflect.TypeOf((*MockPaymentKeeper)(nil).GetFrozenTime), ctx, addr)
+}
+
 // GetOutFlows mocks base method.
 func (m *MockPaymentKeeper) GetOutFlows(ctx types0.Context, addr types0.AccAddress) []types2.OutFlow {
 	m.ctrl.T.Helper()
@@ -732,6 +775,20 @@ func (m *MockVirtualGroupKeeper) EXPECT() *MockVirtualGroupKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetAllGVGs mocks base method.
+func (m *MockVirtualGroupKeeper) GetAllGVGs(ctx types0.Context) []*types5.GlobalVirtualGroup {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllGVGs", ctx)
+	ret0, _ := ret[0].([]*types5.GlobalVirtualGroup)
+	return ret0
+}
+
+// GetAllGVGs indicates an expected call of GetAllGVGs.
+func (mr *MockVirtualGroupKeeperMockRecorder) GetAllGVGs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllGVGs", reflect.TypeOf((*MockVirtualGroupKeeper)(nil).GetAllGVGs), ctx)
+}
+
 // GetAndCheckGVGFamilyAvailableForNewBucket mocks base method.
 func (m *MockVirtualGroupKeeper) GetAndCheckGVGFamilyAvailableForNewBucket(ctx types0.Context, familyID uint32) (*types5.GlobalVirtualGroupFamily, error) {
 	m.ctrl.T.Helper()
@@ -807,6 +864,21 @@ func (mr *MockVirtualGroupKeeperMockRecorder) GetSwapInInfo(ctx, familyID, gvgID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSwapInInfo", reflect.TypeOf((*MockVirtualGroupKeeper)(nil).GetSwapInInfo), ctx, familyID, gvgID)
 }
 
+// PickGVGFamilyForNewBucket mocks base method.
+func (m *MockVirtualGroupKeeper) PickGVGFamilyForNewBucket(ctx types0.Context, spID uint32, strategy types5.PickVGFStrategy) (*types5.GlobalVirtualGroupFamily, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickGVGFamilyForNewBucket", ctx, spID, strategy)
+	ret0, _ := ret[0].(*types5.GlobalVirtualGroupFamily)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PickGVGFamilyForNewBucket indicates an expected call of PickGVGFamilyForNewBucket.
+func (mr *MockVirtualGroupKeeperMockRecorder) PickGVGFamilyForNewBucket(ctx, spID, strategy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickGVGFamilyForNewBucket", reflect.TypeOf((*MockVirtualGroupKeeper)(nil).PickGVGFamilyForNewBucket), ctx, spID, strategy)
+}
+
 // SetGVGAndEmitUpdateEvent mocks base method.
 func (m *MockVirtualGroupKeeper) SetGVGAndEmitUpdateEvent(ctx types0.Context, gvg *types5.GlobalVirtualGroup) error {
 	m.ctrl.T.Helper()
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/mocachain/moca/v2/internal/sequence"
+	"github.com/mocachain/moca/v2/types/resource"
 )
 
 const (
@@ -43,6 +44,8 @@ var (
 	BucketByIDPrefix = []byte{0x21}
 	ObjectByIDPrefix = []byte{0x22}
 	GroupByIDPrefix  = []byte{0x23}
+	// ObjectByNamePrefix indexes the objects of the buckets by their names in lexicographical order
+	ObjectByNamePrefix = []byte{0x24}
 
 	BucketSequencePrefix = []byte{0x31}
 	ObjectSequencePrefix = []byte{0x32}
@@ -67,6 +70,34 @@ var (
 
 	// LOW-015 Fix: Bucket count per owner for enforcing MaxBucketsPerAccount limit
 	BucketCountByOwnerPrefix = []byte{0x73}
+
+	BucketLifecyclePrefix = []byte{0x81}
+	// LifecycleCursorKey keeps the bucket and the object from which the next end block resumes expiring objects
+	LifecycleCursorKey = []byte{0x82}
+
+	BucketVersioningPrefix = []byte{0x91}
+	ObjectVersionsPrefix   = []byte{0x92}
+
+	// TagIndexPrefix indexes the tagged resources by tag key, tag value and resource type
+	TagIndexPrefix = []byte{0xA1}
+
+	BucketReadQuotaAutoTopupPrefix   = []byte{0xB1}
+	BucketReadQuotaConsumptionPrefix = []byte{0xB2}
+
+	// BillingPeriodPrefix keeps the open billing period of the charged buckets
+	BillingPeriodPrefix = []byte{0xC1}
+	// OpenBillingPeriodIndexPrefix indexes the open billing periods by payment account
+	OpenBillingPeriodIndexPrefix = []byte{0xC2}
+	// BillingStatementPrefix keeps the billing statements of the payment accounts ordered by their end time
+	BillingStatementPrefix = []byte{0xC3}
+
+	// BucketCallbackPrefix keeps the contract notified about the objects of the bucket
+	BucketCallbackPrefix = []byte{0xD1}
+	// BucketOwnershipTransferPrefix keeps the pending transfer of the ownership of the bucket
+	BucketOwnershipTransferPrefix = []byte{0xD2}
+	// BucketObjectsTransferPrefix keeps the number of the objects moved so far and the last one of them for the buckets
+	// whose objects are still being moved to the new owner after the ownership is accepted
+	BucketObjectsTransferPrefix = []byte{0xD3}
 )
 
 // GetBucketKey return the bucket name store key
@@ -86,6 +117,15 @@ func GetObjectKeyOnlyBucketPrefix(bucketName string) []byte {
 	return append(ObjectInfoPrefix, crypto.Keccak256([]byte(bucketName))...)
 }
 
+// GetObjectByNameKey return the object name index key, which is ordered by the object name within the bucket
+func GetObjectByNameKey(bucketName string, objectName string) []byte {
+	return append(GetObjectByNameKeyOnlyBucketPrefix(bucketName), []byte(objectName)...)
+}
+
+func GetObjectByNameKeyOnlyBucketPrefix(bucketName string) []byte {
+	return append(ObjectByNamePrefix, crypto.Keccak256([]byte(bucketName))...)
+}
+
 // GetShadowObjectKey return the shadow object name store key
 func GetShadowObjectKey(bucketName string, objectName string) []byte {
 	bucketNameHash := crypto.Keccak256([]byte(bucketName))
@@ -193,3 +233,98 @@ func GetBucketFlowRateLimitStatusKey(bucketName string) []byte {
 func GetBucketCountByOwnerKey(owner sdk.AccAddress) []byte {
 	return append(BucketCountByOwnerPrefix, owner.Bytes()...)
 }
+
+// GetBucketLifecycleKey return the bucket lifecycle store key
+func GetBucketLifecycleKey(bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(BucketLifecyclePrefix, seq.EncodeSequence(bucketID)...)
+}
+
+// GetBucketVersioningKey return the bucket versioning store key
+func GetBucketVersioningKey(bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(BucketVersioningPrefix, seq.EncodeSequence(bucketID)...)
+}
+
+// GetObjectVersionsKey return the object versions store key
+func GetObjectVersionsKey(objectID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(ObjectVersionsPrefix, seq.EncodeSequence(objectID)...)
+}
+
+// GetBucketReadQuotaAutoTopupKey return the bucket read quota auto topup store key
+func GetBucketReadQuotaAutoTopupKey(bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(BucketReadQuotaAutoTopupPrefix, seq.EncodeSequence(bucketID)...)
+}
+
+// GetBucketReadQuotaConsumptionKey return the bucket read quota consumption store key
+func GetBucketReadQuotaConsumptionKey(bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(BucketReadQuotaConsumptionPrefix, seq.EncodeSequence(bucketID)...)
+}
+
+// GetTagIndexPrefix return the tag index store prefix of the resources with the tag,
+// the resources of all types are covered if the resource type is unspecified
+func GetTagIndexPrefix(tagKey, tagValue string, resourceType resource.ResourceType) []byte {
+	key := append(TagIndexPrefix, crypto.Keccak256([]byte(tagKey))...)
+	key = append(key, crypto.Keccak256([]byte(tagValue))...)
+	if resourceType != resource.RESOURCE_TYPE_UNSPECIFIED {
+		key = append(key, byte(resourceType))
+	}
+	return key
+}
+
+// GetTagIndexKey return the tag index store key of the resource
+func GetTagIndexKey(tagKey, tagValue string, resourceType resource.ResourceType, resourceID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(GetTagIndexPrefix(tagKey, tagValue, resourceType), seq.EncodeSequence(resourceID)...)
+}
+
+// GetBillingPeriodKey return the open billing period store key of the bucket
+func GetBillingPeriodKey(bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(BillingPeriodPrefix, seq.EncodeSequence(bucketID)...)
+}
+
+// GetOpenBillingPeriodIndexPrefix return the store prefix of the open billing periods of the payment account
+func GetOpenBillingPeriodIndexPrefix(paymentAccount sdk.AccAddress) []byte {
+	return append(OpenBillingPeriodIndexPrefix, paymentAccount.Bytes()...)
+}
+
+// GetOpenBillingPeriodIndexKey return the open billing period index store key of the bucket
+func GetOpenBillingPeriodIndexKey(paymentAccount sdk.AccAddress, bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(GetOpenBillingPeriodIndexPrefix(paymentAccount), seq.EncodeSequence(bucketID)...)
+}
+
+// GetBillingStatementPrefix return the store prefix of the billing statements of the payment account
+func GetBillingStatementPrefix(paymentAccount sdk.AccAddress) []byte {
+	return append(BillingStatementPrefix, paymentAccount.Bytes()...)
+}
+
+// GetBillingStatementKey return the billing statement store key, ordered by the end time of the billing period
+func GetBillingStatementKey(paymentAccount sdk.AccAddress, endTime int64, bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	key := GetBillingStatementPrefix(paymentAccount)
+	key = binary.BigEndian.AppendUint64(key, uint64(endTime))
+	return append(key, seq.EncodeSequence(bucketID)...)
+}
+
+// GetBucketCallbackKey return the bucket callback store key
+func GetBucketCallbackKey(bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(BucketCallbackPrefix, seq.EncodeSequence(bucketID)...)
+}
+
+// GetBucketOwnershipTransferKey return the bucket ownership transfer store key
+func GetBucketOwnershipTransferKey(bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(BucketOwnershipTransferPrefix, seq.EncodeSequence(bucketID)...)
+}
+
+// GetBucketObjectsTransferKey return the store key of the pending move of the objects of the bucket
+func GetBucketObjectsTransferKey(bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(BucketObjectsTransferPrefix, seq.EncodeSequence(bucketID)...)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/mocachain/moca/v2/types/resource"
 	"github.com/mocachain/moca/v2/types/s3util"
 	permtypes "github.com/mocachain/moca/v2/x/permission/types"
+	vgtypes "github.com/mocachain/moca/v2/x/virtualgroup/types"
 )
 
 const (
@@ -180,6 +181,10 @@ func (msg *MsgCreateBucket) ValidateBasic() error {
 		return errors.Wrapf(ErrInvalidVisibility, "unspecified visibility is not allowed")
 	}
 
+	if _, ok := vgtypes.PickVGFStrategy_name[int32(msg.GvgFamilyStrategy)]; !ok {
+		return errors.Wrapf(vgtypes.ErrInvalidPickVGFStrategy, "unknown gvg family strategy (%d)", msg.GvgFamilyStrategy)
+	}
+
 	err = s3util.CheckValidBucketName(msg.BucketName)
 	if err != nil {
 		return err
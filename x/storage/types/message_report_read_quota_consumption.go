@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mocachain/moca/v2/types/s3util"
+)
+
+const TypeMsgReportReadQuotaConsumption = "report_read_quota_consumption"
+
+var _ sdk.Msg = &MsgReportReadQuotaConsumption{}
+
+func NewMsgReportReadQuotaConsumption(operator sdk.AccAddress, bucketName string, consumedReadQuota uint64) *MsgReportReadQuotaConsumption {
+	return &MsgReportReadQuotaConsumption{
+		Operator:          operator.String(),
+		BucketName:        bucketName,
+		ConsumedReadQuota: consumedReadQuota,
+	}
+}
+
+func (msg *MsgReportReadQuotaConsumption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReportReadQuotaConsumption) Type() string {
+	return TypeMsgReportReadQuotaConsumption
+}
+
+func (msg *MsgReportReadQuotaConsumption) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReportReadQuotaConsumption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReportReadQuotaConsumption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	return s3util.CheckValidBucketName(msg.BucketName)
+}
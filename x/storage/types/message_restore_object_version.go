@@ -0,0 +1,66 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+	"github.com/mocachain/moca/v2/types/s3util"
+)
+
+const TypeMsgRestoreObjectVersion = "restore_object_version"
+
+var _ sdk.Msg = &MsgRestoreObjectVersion{}
+
+func NewMsgRestoreObjectVersion(operator sdk.AccAddress, bucketName, objectName string, version int64) *MsgRestoreObjectVersion {
+	return &MsgRestoreObjectVersion{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		ObjectName: objectName,
+		Version:    version,
+	}
+}
+
+func (msg *MsgRestoreObjectVersion) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRestoreObjectVersion) Type() string {
+	return TypeMsgRestoreObjectVersion
+}
+
+func (msg *MsgRestoreObjectVersion) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRestoreObjectVersion) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRestoreObjectVersion) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	err = s3util.CheckValidObjectName(msg.ObjectName)
+	if err != nil {
+		return err
+	}
+
+	if msg.Version < 0 {
+		return gnfderrors.ErrInvalidParameter.Wrapf("invalid version: %d", msg.Version)
+	}
+
+	return nil
+}
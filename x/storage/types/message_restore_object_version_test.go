@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+)
+
+func TestMsgRestoreObjectVersion_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRestoreObjectVersion
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRestoreObjectVersion{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+				ObjectName: testObjectName,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgRestoreObjectVersion{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+				ObjectName: testObjectName,
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "invalid object name",
+			msg: MsgRestoreObjectVersion{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				ObjectName: "",
+			},
+			err: gnfderrors.ErrInvalidObjectName,
+		}, {
+			name: "invalid version",
+			msg: MsgRestoreObjectVersion{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				ObjectName: testObjectName,
+				Version:    -1,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "valid case",
+			msg: MsgRestoreObjectVersion{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				ObjectName: testObjectName,
+				Version:    1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+	"github.com/mocachain/moca/v2/types/s3util"
+)
+
+const (
+	TypeMsgSetBucketCallback = "set_bucket_callback"
+
+	// MaxBucketCallbackGasLimit is the most gas a bucket callback can use per call, the callback runs within the
+	// transaction of the storage operation and is paid by its sender.
+	MaxBucketCallbackGasLimit = uint64(500_000)
+)
+
+var _ sdk.Msg = &MsgSetBucketCallback{}
+
+func NewMsgSetBucketCallback(operator sdk.AccAddress, bucketName string, callback BucketCallback) *MsgSetBucketCallback {
+	return &MsgSetBucketCallback{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		Callback:   callback,
+	}
+}
+
+func (msg *MsgSetBucketCallback) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetBucketCallback) Type() string {
+	return TypeMsgSetBucketCallback
+}
+
+func (msg *MsgSetBucketCallback) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetBucketCallback) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetBucketCallback) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	// an empty callback address removes the callback, the gas limit is ignored then
+	if msg.Callback.CallbackAddress == "" {
+		return nil
+	}
+	_, err = sdk.AccAddressFromHexUnsafe(msg.Callback.CallbackAddress)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid callback address (%s)", err)
+	}
+	if msg.Callback.GasLimit == 0 || msg.Callback.GasLimit > MaxBucketCallbackGasLimit {
+		return gnfderrors.ErrInvalidParameter.Wrapf("gas limit should be in (0, %d]", MaxBucketCallbackGasLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+)
+
+func TestMsgSetBucketCallback_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetBucketCallback
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetBucketCallback{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgSetBucketCallback{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "invalid callback address",
+			msg: MsgSetBucketCallback{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Callback:   BucketCallback{CallbackAddress: "invalid_address", GasLimit: 100_000},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero gas limit",
+			msg: MsgSetBucketCallback{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Callback:   BucketCallback{CallbackAddress: sample.RandAccAddressHex()},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "gas limit too large",
+			msg: MsgSetBucketCallback{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Callback:   BucketCallback{CallbackAddress: sample.RandAccAddressHex(), GasLimit: MaxBucketCallbackGasLimit + 1},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "valid case",
+			msg: MsgSetBucketCallback{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Callback:   BucketCallback{CallbackAddress: sample.RandAccAddressHex(), GasLimit: 100_000},
+			},
+		}, {
+			name: "valid case, remove callback",
+			msg: MsgSetBucketCallback{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
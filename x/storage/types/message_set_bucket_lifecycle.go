@@ -0,0 +1,121 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+	"github.com/mocachain/moca/v2/types/s3util"
+)
+
+const (
+	TypeMsgSetBucketLifecycle = "set_bucket_lifecycle"
+
+	MaxLifecycleRules        = 16
+	MaxLifecycleRuleIDLength = 64
+
+	// LifecycleScanFactor bounds the objects scanned for the lifecycle rules in each end block to the factor times
+	// the max objects expired in the block
+	LifecycleScanFactor = 10
+)
+
+var _ sdk.Msg = &MsgSetBucketLifecycle{}
+
+func NewMsgSetBucketLifecycle(operator sdk.AccAddress, bucketName string, rules []LifecycleRule) *MsgSetBucketLifecycle {
+	return &MsgSetBucketLifecycle{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		Rules:      rules,
+	}
+}
+
+func (msg *MsgSetBucketLifecycle) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetBucketLifecycle) Type() string {
+	return TypeMsgSetBucketLifecycle
+}
+
+func (msg *MsgSetBucketLifecycle) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetBucketLifecycle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetBucketLifecycle) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	if len(msg.Rules) > MaxLifecycleRules {
+		return gnfderrors.ErrInvalidParameter.Wrapf("lifecycle rules count cannot exceed %d", MaxLifecycleRules)
+	}
+	ruleIDs := make(map[string]struct{}, len(msg.Rules))
+	for _, rule := range msg.Rules {
+		if err = rule.Validate(); err != nil {
+			return err
+		}
+		if _, ok := ruleIDs[rule.Id]; ok {
+			return gnfderrors.ErrInvalidParameter.Wrapf("duplicate lifecycle rule id: %s", rule.Id)
+		}
+		ruleIDs[rule.Id] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate checks the lifecycle rule is well-formed.
+func (r LifecycleRule) Validate() error {
+	if len(r.Id) == 0 || len(r.Id) > MaxLifecycleRuleIDLength {
+		return gnfderrors.ErrInvalidParameter.Wrapf("lifecycle rule id length should be in [1, %d]", MaxLifecycleRuleIDLength)
+	}
+	if r.ExpirationDays == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrapf("expiration days of lifecycle rule %s must be positive", r.Id)
+	}
+	if r.Tag != nil {
+		if len(r.Tag.Key) == 0 || len(r.Tag.Key) > MaxTagKeyLength {
+			return gnfderrors.ErrInvalidParameter.Wrapf("tag key length of lifecycle rule %s should be in [1, %d]", r.Id, MaxTagKeyLength)
+		}
+		if len(r.Tag.Value) > MaxTagValueLength {
+			return gnfderrors.ErrInvalidParameter.Wrapf("tag value length of lifecycle rule %s cannot exceed %d", r.Id, MaxTagValueLength)
+		}
+	}
+	return nil
+}
+
+// Matches returns whether the object falls under the lifecycle rule, regardless of its age.
+func (r LifecycleRule) Matches(objectInfo *ObjectInfo) bool {
+	if !strings.HasPrefix(objectInfo.ObjectName, r.Prefix) {
+		return false
+	}
+	if r.Tag == nil {
+		return true
+	}
+	for _, tag := range objectInfo.Tags.GetTags() {
+		if tag.Key == r.Tag.Key && tag.Value == r.Tag.Value {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpireAt returns the timestamp from which the object created at createAt is expired by the rule.
+func (r LifecycleRule) ExpireAt(createAt int64) int64 {
+	return createAt + int64(r.ExpirationDays)*86400
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+)
+
+func TestMsgSetBucketLifecycle_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetBucketLifecycle
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetBucketLifecycle{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "empty rule id",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Rules:      []LifecycleRule{{Prefix: "logs/", ExpirationDays: 30}},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "zero expiration days",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Rules:      []LifecycleRule{{Id: "logs", Prefix: "logs/"}},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "empty tag key",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Rules:      []LifecycleRule{{Id: "tmp", Tag: &ResourceTags_Tag{Value: "true"}, ExpirationDays: 1}},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "duplicate rule id",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Rules: []LifecycleRule{
+					{Id: "logs", Prefix: "logs/", ExpirationDays: 30},
+					{Id: "logs", Prefix: "audit/", ExpirationDays: 90},
+				},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "valid case",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Rules: []LifecycleRule{
+					{Id: "logs", Prefix: "logs/", ExpirationDays: 30},
+					{Id: "tmp", Tag: &ResourceTags_Tag{Key: "tmp", Value: "true"}, ExpirationDays: 1},
+				},
+			},
+		}, {
+			name: "valid case, clear lifecycle",
+			msg: MsgSetBucketLifecycle{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLifecycleRule_Matches(t *testing.T) {
+	object := &ObjectInfo{
+		ObjectName: "logs/2024/01.log",
+		Tags:       &ResourceTags{Tags: []ResourceTags_Tag{{Key: "tmp", Value: "true"}}},
+	}
+	require.True(t, LifecycleRule{Prefix: "logs/"}.Matches(object))
+	require.True(t, LifecycleRule{}.Matches(object))
+	require.False(t, LifecycleRule{Prefix: "audit/"}.Matches(object))
+	require.True(t, LifecycleRule{Prefix: "logs/", Tag: &ResourceTags_Tag{Key: "tmp", Value: "true"}}.Matches(object))
+	require.False(t, LifecycleRule{Tag: &ResourceTags_Tag{Key: "tmp", Value: "false"}}.Matches(object))
+	require.False(t, LifecycleRule{Tag: &ResourceTags_Tag{Key: "tmp", Value: "true"}}.Matches(&ObjectInfo{ObjectName: "a"}))
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+	"github.com/mocachain/moca/v2/types/s3util"
+)
+
+const TypeMsgSetBucketReadQuotaAutoTopup = "set_bucket_read_quota_auto_topup"
+
+var _ sdk.Msg = &MsgSetBucketReadQuotaAutoTopup{}
+
+func NewMsgSetBucketReadQuotaAutoTopup(operator sdk.AccAddress, bucketName string, autoTopup ReadQuotaAutoTopup) *MsgSetBucketReadQuotaAutoTopup {
+	return &MsgSetBucketReadQuotaAutoTopup{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		AutoTopup:  autoTopup,
+	}
+}
+
+func (msg *MsgSetBucketReadQuotaAutoTopup) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetBucketReadQuotaAutoTopup) Type() string {
+	return TypeMsgSetBucketReadQuotaAutoTopup
+}
+
+func (msg *MsgSetBucketReadQuotaAutoTopup) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetBucketReadQuotaAutoTopup) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetBucketReadQuotaAutoTopup) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	// a zero ceiling disables the auto topup, the other fields are ignored then
+	if msg.AutoTopup.MaxReadQuota == 0 {
+		return nil
+	}
+	if msg.AutoTopup.ThresholdPercent == 0 || msg.AutoTopup.ThresholdPercent > 100 {
+		return gnfderrors.ErrInvalidParameter.Wrapf("threshold percent should be in (0, 100]")
+	}
+	if msg.AutoTopup.TopupSize == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrapf("topup size should not be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+)
+
+func TestMsgSetBucketReadQuotaAutoTopup_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetBucketReadQuotaAutoTopup
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetBucketReadQuotaAutoTopup{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgSetBucketReadQuotaAutoTopup{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "threshold out of range",
+			msg: MsgSetBucketReadQuotaAutoTopup{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				AutoTopup:  ReadQuotaAutoTopup{MaxReadQuota: 1 << 30, ThresholdPercent: 101, TopupSize: 1 << 20},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "zero topup size",
+			msg: MsgSetBucketReadQuotaAutoTopup{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				AutoTopup:  ReadQuotaAutoTopup{MaxReadQuota: 1 << 30, ThresholdPercent: 80},
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "valid case",
+			msg: MsgSetBucketReadQuotaAutoTopup{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				AutoTopup:  ReadQuotaAutoTopup{MaxReadQuota: 1 << 30, ThresholdPercent: 80, TopupSize: 1 << 20},
+			},
+		}, {
+			name: "valid case, disable auto topup",
+			msg: MsgSetBucketReadQuotaAutoTopup{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+	"github.com/mocachain/moca/v2/types/s3util"
+)
+
+const (
+	TypeMsgSetBucketVersioning = "set_bucket_versioning"
+
+	MaxObjectVersions = 32
+)
+
+var _ sdk.Msg = &MsgSetBucketVersioning{}
+
+func NewMsgSetBucketVersioning(operator sdk.AccAddress, bucketName string, maxVersions uint32) *MsgSetBucketVersioning {
+	return &MsgSetBucketVersioning{
+		Operator:    operator.String(),
+		BucketName:  bucketName,
+		MaxVersions: maxVersions,
+	}
+}
+
+func (msg *MsgSetBucketVersioning) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetBucketVersioning) Type() string {
+	return TypeMsgSetBucketVersioning
+}
+
+func (msg *MsgSetBucketVersioning) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetBucketVersioning) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetBucketVersioning) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	if msg.MaxVersions > MaxObjectVersions {
+		return gnfderrors.ErrInvalidParameter.Wrapf("max versions cannot exceed %d", MaxObjectVersions)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+)
+
+func TestMsgSetBucketVersioning_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetBucketVersioning
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetBucketVersioning{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgSetBucketVersioning{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "too many versions",
+			msg: MsgSetBucketVersioning{
+				Operator:    sample.RandAccAddressHex(),
+				BucketName:  testBucketName,
+				MaxVersions: MaxObjectVersions + 1,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "valid case",
+			msg: MsgSetBucketVersioning{
+				Operator:    sample.RandAccAddressHex(),
+				BucketName:  testBucketName,
+				MaxVersions: MaxObjectVersions,
+			},
+		}, {
+			name: "valid case, disable versioning",
+			msg: MsgSetBucketVersioning{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mocachain/moca/v2/types/s3util"
+)
+
+const (
+	TypeMsgTransferBucketOwnership = "transfer_bucket_ownership"
+	TypeMsgAcceptBucketOwnership   = "accept_bucket_ownership"
+
+	// MaxTransferBucketObjects bounds the objects moved to the new owner of a bucket along with the acceptance of the
+	// ownership, and the objects moved in each end block afterwards
+	MaxTransferBucketObjects = 100
+)
+
+var (
+	_ sdk.Msg = &MsgTransferBucketOwnership{}
+	_ sdk.Msg = &MsgAcceptBucketOwnership{}
+)
+
+func NewMsgTransferBucketOwnership(operator sdk.AccAddress, bucketName string, newOwner sdk.AccAddress) *MsgTransferBucketOwnership {
+	msg := &MsgTransferBucketOwnership{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+	}
+	if !newOwner.Empty() {
+		msg.NewOwner = newOwner.String()
+	}
+	return msg
+}
+
+func (msg *MsgTransferBucketOwnership) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferBucketOwnership) Type() string {
+	return TypeMsgTransferBucketOwnership
+}
+
+func (msg *MsgTransferBucketOwnership) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferBucketOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferBucketOwnership) ValidateBasic() error {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	// an empty new owner cancels the pending transfer
+	if msg.NewOwner == "" {
+		return nil
+	}
+	newOwner, err := sdk.AccAddressFromHexUnsafe(msg.NewOwner)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address (%s)", err)
+	}
+	if newOwner.Equals(operator) {
+		return sdkerrors.ErrInvalidAddress.Wrapf("the new owner is the operator itself")
+	}
+
+	return nil
+}
+
+func NewMsgAcceptBucketOwnership(operator sdk.AccAddress, bucketName string, paymentAddress sdk.AccAddress) *MsgAcceptBucketOwnership {
+	msg := &MsgAcceptBucketOwnership{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+	}
+	if !paymentAddress.Empty() {
+		msg.PaymentAddress = paymentAddress.String()
+	}
+	return msg
+}
+
+func (msg *MsgAcceptBucketOwnership) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptBucketOwnership) Type() string {
+	return TypeMsgAcceptBucketOwnership
+}
+
+func (msg *MsgAcceptBucketOwnership) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAcceptBucketOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptBucketOwnership) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	if msg.PaymentAddress != "" {
+		_, err = sdk.AccAddressFromHexUnsafe(msg.PaymentAddress)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid payment address (%s)", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+)
+
+func TestMsgTransferBucketOwnership_ValidateBasic(t *testing.T) {
+	operator := sample.RandAccAddressHex()
+	tests := []struct {
+		name string
+		msg  MsgTransferBucketOwnership
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgTransferBucketOwnership{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+				NewOwner:   sample.RandAccAddressHex(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgTransferBucketOwnership{
+				Operator:   operator,
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+				NewOwner:   sample.RandAccAddressHex(),
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "invalid new owner",
+			msg: MsgTransferBucketOwnership{
+				Operator:   operator,
+				BucketName: testBucketName,
+				NewOwner:   "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "transfer to the operator itself",
+			msg: MsgTransferBucketOwnership{
+				Operator:   operator,
+				BucketName: testBucketName,
+				NewOwner:   operator,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid case",
+			msg: MsgTransferBucketOwnership{
+				Operator:   operator,
+				BucketName: testBucketName,
+				NewOwner:   sample.RandAccAddressHex(),
+			},
+		}, {
+			name: "valid case, cancel transfer",
+			msg: MsgTransferBucketOwnership{
+				Operator:   operator,
+				BucketName: testBucketName,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAcceptBucketOwnership_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAcceptBucketOwnership
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAcceptBucketOwnership{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bucket name",
+			msg: MsgAcceptBucketOwnership{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: string(testInvalidBucketNameWithLongLength[:]),
+			},
+			err: gnfderrors.ErrInvalidBucketName,
+		}, {
+			name: "invalid payment address",
+			msg: MsgAcceptBucketOwnership{
+				Operator:       sample.RandAccAddressHex(),
+				BucketName:     testBucketName,
+				PaymentAddress: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid case",
+			msg: MsgAcceptBucketOwnership{
+				Operator:       sample.RandAccAddressHex(),
+				BucketName:     testBucketName,
+				PaymentAddress: sample.RandAccAddressHex(),
+			},
+		}, {
+			name: "valid case, charged from the new owner",
+			msg: MsgAcceptBucketOwnership{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
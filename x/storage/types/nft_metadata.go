@@ -0,0 +1,110 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// TokenURIPrefix is the prefix of the data uri the tokenURI of the bucket, object and group NFTs resolves to
+	TokenURIPrefix = "data:application/json;base64,"
+	// NFTTagTraitPrefix is the prefix of the trait type of the resource tags in the NFT metadata
+	NFTTagTraitPrefix = "tag:"
+	// NFTMetadataPath is the path of the api server route serving the metadata json of the NFTs, followed by the
+	// resource type and the token id
+	NFTMetadataPath = "/moca/storage/nft_metadata/"
+
+	nftImagePrefix = "data:image/svg+xml;base64,"
+	// the longest resource name the NFT image shows in full
+	nftImageMaxNameLength = 28
+)
+
+// ERC721Metadata is the metadata json of the bucket, object and group NFTs, in the ERC-721 metadata json schema
+// extended by the attributes wallets and explorers show as the traits of the NFT.
+type ERC721Metadata struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	ExternalURL string  `json:"external_url,omitempty"`
+	Image       string  `json:"image"`
+	Attributes  []Trait `json:"attributes"`
+}
+
+func (m *BucketMetaData) ToERC721Metadata() *ERC721Metadata {
+	return &ERC721Metadata{
+		Name:        m.BucketName,
+		Description: m.Description,
+		ExternalURL: m.ExternalUrl,
+		Image:       m.Image,
+		Attributes:  m.Attributes,
+	}
+}
+
+func (m *ObjectMetaData) ToERC721Metadata() *ERC721Metadata {
+	return &ERC721Metadata{
+		Name:        m.ObjectName,
+		Description: m.Description,
+		ExternalURL: m.ExternalUrl,
+		Image:       m.Image,
+		Attributes:  m.Attributes,
+	}
+}
+
+func (m *GroupMetaData) ToERC721Metadata() *ERC721Metadata {
+	return &ERC721Metadata{
+		Name:        m.GroupName,
+		Description: m.Description,
+		ExternalURL: m.ExternalUrl,
+		Image:       m.Image,
+		Attributes:  m.Attributes,
+	}
+}
+
+// TokenURI returns the data uri of the metadata json, which the tokenURI of the NFT resolves to.
+func (m *ERC721Metadata) TokenURI() (string, error) {
+	bz, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return TokenURIPrefix + base64.StdEncoding.EncodeToString(bz), nil
+}
+
+// NFTBaseURI returns the base uri of the NFT contract of the resource type, bucket, object or group, under which the
+// tokenURI resolves to the metadata route of the api server at baseURL.
+func NFTBaseURI(baseURL, resourceType string) string {
+	return strings.TrimSuffix(baseURL, "/") + NFTMetadataPath + resourceType + "/"
+}
+
+// getNFTTagAttributes returns the tags of the resource as the traits of the NFT.
+func getNFTTagAttributes(tags *ResourceTags) []Trait {
+	if tags == nil {
+		return nil
+	}
+	attributes := make([]Trait, 0, len(tags.Tags))
+	for _, tag := range tags.Tags {
+		attributes = append(attributes, Trait{
+			TraitType: NFTTagTraitPrefix + tag.Key,
+			Value:     tag.Value,
+		})
+	}
+	return attributes
+}
+
+// nftImage returns the data uri of the svg image of the NFT, a card showing the type and the name of the resource.
+func nftImage(resourceType, name string) string {
+	if utf8.RuneCountInString(name) > nftImageMaxNameLength {
+		name = string([]rune(name)[:nftImageMaxNameLength-1]) + "…"
+	}
+	var escapedName bytes.Buffer
+	_ = xml.EscapeText(&escapedName, []byte(name))
+
+	svg := `<svg xmlns="http://www.w3.org/2000/svg" width="350" height="350" viewBox="0 0 350 350">` +
+		`<rect width="350" height="350" fill="#16161a"/>` +
+		`<text x="24" y="48" fill="#9a9aa5" font-family="monospace" font-size="16">` + resourceType + `</text>` +
+		`<text x="24" y="92" fill="#ffffff" font-family="monospace" font-size="20">` + escapedName.String() + `</text>` +
+		`</svg>`
+	return nftImagePrefix + base64.StdEncoding.EncodeToString([]byte(svg))
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestERC721Metadata_TokenURI(t *testing.T) {
+	bucketInfo := &BucketInfo{
+		Owner:      "0x0000000000000000000000000000000000000001",
+		BucketName: "<b&b>",
+		Id:         sdkmath.NewUint(1),
+		Tags:       &ResourceTags{Tags: []ResourceTags_Tag{{Key: "env", Value: "prod"}}},
+	}
+
+	tokenURI, err := bucketInfo.ToNFTMetadata().ToERC721Metadata().TokenURI()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(tokenURI, TokenURIPrefix))
+
+	bz, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(tokenURI, TokenURIPrefix))
+	require.NoError(t, err)
+	var metadata ERC721Metadata
+	require.NoError(t, json.Unmarshal(bz, &metadata))
+	require.Equal(t, "<b&b>", metadata.Name)
+	require.Contains(t, metadata.Attributes, Trait{TraitType: NFTTagTraitPrefix + "env", Value: "prod"})
+	for _, attribute := range metadata.Attributes {
+		require.NotEqual(t, "Tags", attribute.TraitType)
+	}
+
+	// the name is escaped in the svg image
+	require.True(t, strings.HasPrefix(metadata.Image, nftImagePrefix))
+	svg, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(metadata.Image, nftImagePrefix))
+	require.NoError(t, err)
+	require.Contains(t, string(svg), "&lt;b&amp;b&gt;")
+}
+
+func TestNFTImage_LongName(t *testing.T) {
+	image := nftImage("OBJECT", strings.Repeat("a", nftImageMaxNameLength+1))
+	svg, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(image, nftImagePrefix))
+	require.NoError(t, err)
+	require.Contains(t, string(svg), strings.Repeat("a", nftImageMaxNameLength-1)+"…")
+	require.NotContains(t, string(svg), strings.Repeat("a", nftImageMaxNameLength))
+}
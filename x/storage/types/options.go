@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/mocachain/moca/v2/types/common"
+	vgtypes "github.com/mocachain/moca/v2/x/virtualgroup/types"
 )
 
 type CreateBucketOptions struct {
@@ -15,6 +16,8 @@ type CreateBucketOptions struct {
 	PaymentAddress    string
 	PrimarySpApproval *common.Approval
 	ApprovalMsgBytes  []byte
+	// GVGFamilyStrategy picks the global virtual group family when the primary sp approval leaves it unspecified.
+	GVGFamilyStrategy vgtypes.PickVGFStrategy
 }
 
 type DeleteBucketOptions struct {
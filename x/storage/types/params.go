@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"math/big"
+	"net/url"
 
 	"gopkg.in/yaml.v2"
 )
@@ -25,6 +26,9 @@ const (
 	DefaultDiscontinueDeletionMax   uint64 = 100
 	DefaultStalePolicyCleanupMax    uint64 = 200
 	DefaultMinUpdateQuotaInterval   uint64 = 2592000 // 30 days (in second)
+	DefaultLifecycleExpirationMax   uint64 = 100
+	// the base uri of the NFT contracts is left as is until the url of a public api server is set
+	DefaultNFTMetadataBaseURL = ""
 
 	// TODO
 	DefaultMaxLocalVirtualGroupNumPerBucket  uint32 = 10
@@ -153,6 +157,8 @@ var (
 	KeyBaseMirrorGroupRelayerFee         = []byte("BaseMirrorGroupRelayerFee")
 	KeyBaseMirrorGroupAckRelayerFee      = []byte("BaseMirrorGroupAckRelayerFee")
 	KeyMaxLocalVirtualGroupNumPerBucket  = []byte("MaxLocalVirtualGroupNumPerBucket")
+	KeyLifecycleExpirationMax            = []byte("LifecycleExpirationMax")
+	KeyNFTMetadataBaseURL                = []byte("NFTMetadataBaseURL")
 )
 
 // NewParams creates a new Params instance
@@ -192,6 +198,8 @@ func NewParams(
 	stalePoliesCleanupMax uint64,
 	minUpdateQuotaInterval uint64,
 	maxLocalVirtualGroupNumPerBucket uint32,
+	lifecycleExpirationMax uint64,
+	nftMetadataBaseURL string,
 ) Params {
 	return Params{
 		VersionedParams: VersionedParams{
@@ -264,6 +272,8 @@ func NewParams(
 		StalePolicyCleanupMax:             stalePoliesCleanupMax,
 		MinQuotaUpdateInterval:            minUpdateQuotaInterval,
 		MaxLocalVirtualGroupNumPerBucket:  maxLocalVirtualGroupNumPerBucket,
+		LifecycleExpirationMax:            lifecycleExpirationMax,
+		NftMetadataBaseUrl:                nftMetadataBaseURL,
 	}
 }
 
@@ -302,6 +312,7 @@ func DefaultParams() Params {
 		DefaultDiscontinueCountingWindow, DefaultDiscontinueObjectMax, DefaultDiscontinueBucketMax,
 		DefaultDiscontinueConfirmPeriod, DefaultDiscontinueDeletionMax, DefaultStalePolicyCleanupMax,
 		DefaultMinUpdateQuotaInterval, DefaultMaxLocalVirtualGroupNumPerBucket,
+		DefaultLifecycleExpirationMax, DefaultNFTMetadataBaseURL,
 	)
 }
 
@@ -511,6 +522,12 @@ func (p Params) Validate() error {
 	if err := validateMaxLocalVirtualGroupNumPerBucket(p.MaxLocalVirtualGroupNumPerBucket); err != nil {
 		return err
 	}
+	if err := validateLifecycleExpirationMax(p.LifecycleExpirationMax); err != nil {
+		return err
+	}
+	if err := validateNFTMetadataBaseURL(p.NftMetadataBaseUrl); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -740,3 +757,33 @@ func validateMaxLocalVirtualGroupNumPerBucket(i interface{}) error {
 
 	return nil
 }
+
+// validateLifecycleExpirationMax accepts zero, which disables expiring objects by bucket lifecycle rules.
+func validateLifecycleExpirationMax(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateNFTMetadataBaseURL accepts empty, which leaves the base uri of the NFT contracts as is.
+func validateNFTMetadataBaseURL(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == "" {
+		return nil
+	}
+
+	u, err := url.Parse(v)
+	if err != nil {
+		return fmt.Errorf("invalid nft metadata base url: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("nft metadata base url must be an http(s) url without query or fragment: %s", v)
+	}
+	return nil
+}
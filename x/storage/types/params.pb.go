@@ -155,6 +155,11 @@ type Params struct {
 	BaseMirrorGroupRelayerFee string `protobuf:"bytes,64,opt,name=base_mirror_group_relayer_fee,json=baseMirrorGroupRelayerFee,proto3" json:"base_mirror_group_relayer_fee,omitempty"`
 	// Relayer fee for the ACK or FAIL_ACK package of the mirror object tx to base chain
 	BaseMirrorGroupAckRelayerFee string `protobuf:"bytes,65,opt,name=base_mirror_group_ack_relayer_fee,json=baseMirrorGroupAckRelayerFee,proto3" json:"base_mirror_group_ack_relayer_fee,omitempty"`
+	// The max objects expired by bucket lifecycle rules in each end block
+	LifecycleExpirationMax uint64 `protobuf:"varint,66,opt,name=lifecycle_expiration_max,json=lifecycleExpirationMax,proto3" json:"lifecycle_expiration_max,omitempty"`
+	// The url of the api server the ERC-721 metadata of the bucket, object and group NFTs is served by, the base uri of
+	// the NFT contracts is set to its nft metadata route. Empty leaves the base uri as is.
+	NftMetadataBaseUrl string `protobuf:"bytes,67,opt,name=nft_metadata_base_url,json=nftMetadataBaseUrl,proto3" json:"nft_metadata_base_url,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -644,6 +649,20 @@ func (m *Params) GetBaseMirrorGroupAckRelayerFee() string {
 	return ""
 }
 
+func (m *Params) GetLifecycleExpirationMax() uint64 {
+	if m != nil {
+		return m.LifecycleExpirationMax
+	}
+	return 0
+}
+
+func (m *Params) GetNftMetadataBaseUrl() string {
+	if m != nil {
+		return m.NftMetadataBaseUrl
+	}
+	return ""
+}
+
 // VersionedParams defines the parameters for the storage module with multi version, each version store with different timestamp.
 type VersionedParams struct {
 	// max_segment_size is the maximum size of a segment. default: 16M
@@ -724,93 +743,96 @@ func init() {
 func init() { proto.RegisterFile("moca/storage/params.proto", fileDescriptor_87f4e810869a423d) }
 
 var fileDescriptor_87f4e810869a423d = []byte{
-	// 1363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x98, 0x4d, 0x53, 0xdb, 0x46,
-	0x18, 0xc7, 0x51, 0x43, 0xd3, 0xb2, 0x09, 0x81, 0xb8, 0x10, 0x84, 0x01, 0x5b, 0x36, 0x2f, 0x75,
-	0x69, 0x6a, 0xb7, 0x24, 0x29, 0x09, 0xa1, 0x94, 0xb7, 0x40, 0x68, 0x4a, 0x70, 0xcc, 0x94, 0xce,
-	0xf4, 0xa2, 0x59, 0xcb, 0x1b, 0xb3, 0x45, 0xd2, 0xaa, 0x7a, 0x21, 0x76, 0x3e, 0x42, 0x4f, 0x3d,
-	0xf6, 0xd8, 0x63, 0x8f, 0xf9, 0x18, 0x39, 0xe6, 0xd2, 0x99, 0x9e, 0xda, 0x0e, 0x1c, 0xf2, 0x35,
-	0x3a, 0xda, 0x15, 0x46, 0xfb, 0x22, 0x73, 0x61, 0x3c, 0x7e, 0x9e, 0xe7, 0xe7, 0x9f, 0xf6, 0xbf,
-	0x6b, 0x0f, 0x0b, 0x26, 0x1d, 0x62, 0xc1, 0x5a, 0x10, 0x12, 0x1f, 0xb6, 0x51, 0xcd, 0x83, 0x3e,
-	0x74, 0x82, 0xaa, 0xe7, 0x93, 0x90, 0xe4, 0x6e, 0xc6, 0xa5, 0x6a, 0x52, 0xca, 0xdf, 0x86, 0x0e,
-	0x76, 0x49, 0x8d, 0xfe, 0x65, 0x0d, 0xf9, 0xb1, 0x36, 0x69, 0x13, 0xfa, 0xb2, 0x16, 0xbf, 0x62,
-	0xef, 0x96, 0xff, 0x9a, 0x03, 0xd7, 0xeb, 0x94, 0x93, 0x3b, 0x04, 0xa3, 0xa7, 0xc8, 0x0f, 0x30,
-	0x71, 0x51, 0xcb, 0x64, 0x6c, 0x5d, 0x33, 0xb4, 0xca, 0x8d, 0xa5, 0x99, 0x6a, 0x1a, 0x5e, 0x3d,
-	0xba, 0xe8, 0x62, 0x83, 0x9b, 0x43, 0x6f, 0xff, 0x29, 0x0e, 0xfc, 0xf9, 0xfe, 0xcd, 0xa2, 0xd6,
-	0x18, 0x39, 0xe5, 0x6b, 0xb9, 0x0a, 0x18, 0x75, 0x60, 0xc7, 0xf4, 0x60, 0xd7, 0x26, 0xb0, 0x65,
-	0x06, 0xf8, 0x35, 0xd2, 0x3f, 0x30, 0xb4, 0xca, 0x60, 0xe3, 0x96, 0x03, 0x3b, 0x75, 0xf6, 0xf6,
-	0x21, 0x7e, 0x8d, 0x72, 0xeb, 0x60, 0xa6, 0x19, 0x58, 0xa6, 0x83, 0x7d, 0x9f, 0xf8, 0x66, 0x33,
-	0xb2, 0x4e, 0x50, 0x68, 0xfa, 0xc8, 0x86, 0x5d, 0xe4, 0x9b, 0x2f, 0x11, 0xd2, 0xaf, 0x19, 0x5a,
-	0x65, 0xa8, 0x31, 0xd9, 0x0c, 0xac, 0x7d, 0xda, 0xb3, 0x49, 0x5b, 0x1a, 0xac, 0x63, 0x07, 0xa1,
-	0xdc, 0x2e, 0x28, 0xc9, 0x04, 0x68, 0x9d, 0x70, 0x94, 0x41, 0x4a, 0x99, 0x16, 0x28, 0x1b, 0xd6,
-	0x49, 0x0a, 0xc4, 0xab, 0x90, 0xe6, 0xcf, 0xc8, 0xe2, 0x55, 0x3e, 0x14, 0x54, 0x0e, 0x68, 0x4b,
-	0xa6, 0x4a, 0x42, 0x10, 0x55, 0xae, 0x0b, 0x2a, 0x8c, 0xc2, 0xab, 0xac, 0x81, 0xe9, 0x14, 0xa8,
-	0xed, 0x93, 0xc8, 0xe3, 0x18, 0x1f, 0x51, 0x86, 0xde, 0x63, 0xec, 0xc6, 0x1d, 0xa9, 0xf9, 0x27,
-	0xc0, 0x90, 0xe6, 0x45, 0x8f, 0x8f, 0x29, 0x63, 0x8a, 0x67, 0xf0, 0x1a, 0x0f, 0xc0, 0x44, 0x1c,
-	0x23, 0x5b, 0xd3, 0xc0, 0xf4, 0x90, 0x6f, 0x42, 0xcb, 0x22, 0x91, 0x1b, 0xea, 0x43, 0x86, 0x56,
-	0x19, 0x6e, 0x8c, 0x39, 0xb0, 0xc3, 0x96, 0x32, 0xa8, 0x23, 0x7f, 0x83, 0xd5, 0x72, 0x6b, 0x60,
-	0xaa, 0x85, 0x03, 0x8b, 0xb8, 0x21, 0x76, 0x23, 0x64, 0xd2, 0x37, 0xb1, 0xdb, 0x36, 0x5f, 0x61,
-	0xb7, 0x45, 0x5e, 0xe9, 0x80, 0x6e, 0x84, 0xc9, 0x54, 0xcb, 0x56, 0xd2, 0xf1, 0x23, 0x6d, 0xc8,
-	0xdd, 0x07, 0x77, 0xd2, 0xf3, 0xc9, 0x3a, 0x3a, 0xb0, 0xa3, 0xdf, 0xa0, 0xa3, 0x63, 0xa9, 0x2a,
-	0x5b, 0xbd, 0x7d, 0xd8, 0x11, 0xa7, 0x92, 0x8d, 0x10, 0x4f, 0xdd, 0x94, 0xa6, 0x98, 0x73, 0x3c,
-	0xb5, 0x0a, 0xf2, 0xbc, 0xab, 0xfb, 0x12, 0xfb, 0x4e, 0xfc, 0xa8, 0x98, 0xb4, 0xf4, 0x61, 0x43,
-	0xab, 0x5c, 0x6b, 0xe8, 0x9c, 0x2a, 0x6d, 0xa8, 0xd3, 0x7a, 0xee, 0x21, 0x48, 0xd7, 0xcc, 0x16,
-	0xb2, 0x51, 0x88, 0x89, 0x4b, 0x3f, 0xf5, 0x16, 0xfd, 0xd4, 0xb4, 0xd3, 0x76, 0x52, 0x8e, 0x3f,
-	0x77, 0x19, 0xe8, 0x41, 0x08, 0x6d, 0x64, 0x7a, 0xc4, 0xc6, 0x56, 0xd7, 0xb4, 0x6c, 0x04, 0xdd,
-	0xc8, 0xa3, 0x93, 0x23, 0x74, 0x72, 0x9c, 0xd6, 0xeb, 0xb4, 0xbc, 0xc5, 0xaa, 0xf1, 0xe0, 0x23,
-	0x30, 0xe9, 0x60, 0xd7, 0xfc, 0x25, 0x22, 0x21, 0x34, 0x23, 0xaf, 0x05, 0x43, 0x64, 0x62, 0x37,
-	0x44, 0xfe, 0x29, 0xb4, 0xf5, 0x51, 0xf6, 0x99, 0x0e, 0x76, 0x5f, 0xc4, 0xf5, 0x1f, 0x68, 0x79,
-	0x2f, 0xa9, 0xe6, 0xea, 0x60, 0x21, 0x8e, 0xd3, 0x26, 0x16, 0xb4, 0xcd, 0x53, 0xec, 0x87, 0x11,
-	0xb4, 0x93, 0xcd, 0xe1, 0x46, 0xf4, 0x99, 0x93, 0x55, 0xd3, 0x6f, 0xd3, 0x74, 0x0d, 0x07, 0x76,
-	0xbe, 0x8f, 0x9b, 0x8f, 0x58, 0x2f, 0xdd, 0x21, 0xcf, 0xa3, 0xf8, 0xe1, 0xd9, 0x02, 0xc6, 0xfb,
-	0x94, 0x78, 0x7d, 0x0e, 0x6f, 0x8e, 0xed, 0x53, 0xe2, 0x65, 0x9c, 0xdd, 0x27, 0xc0, 0x90, 0xe6,
-	0xc5, 0x7d, 0xfa, 0x09, 0xdb, 0xa7, 0x3c, 0x43, 0x3a, 0x2e, 0x97, 0x18, 0xc5, 0xc1, 0x1d, 0xe3,
-	0x35, 0xa4, 0x73, 0xcb, 0x69, 0x64, 0x1c, 0xdb, 0x71, 0x5e, 0x43, 0x75, 0x6a, 0x57, 0xc1, 0xd4,
-	0x25, 0x46, 0x3e, 0xb4, 0x77, 0x28, 0x61, 0xe2, 0x82, 0x20, 0x9e, 0xd9, 0x2d, 0x50, 0x14, 0xa7,
-	0x45, 0x87, 0x09, 0x4a, 0xc8, 0x73, 0x04, 0x5e, 0xe1, 0x29, 0x28, 0x79, 0xc4, 0xee, 0xb6, 0xe3,
-	0x3d, 0x98, 0x99, 0x8a, 0x4e, 0x31, 0x33, 0x49, 0x63, 0x46, 0x34, 0x07, 0x60, 0x5e, 0x4d, 0x12,
-	0xa5, 0x26, 0x29, 0xcd, 0x50, 0xd0, 0xae, 0x52, 0x53, 0x24, 0x95, 0x57, 0xa8, 0x49, 0x71, 0xc9,
-	0x6a, 0x19, 0x99, 0x4d, 0x29, 0xd4, 0x54, 0xc1, 0xed, 0x00, 0x43, 0x00, 0xca, 0xe9, 0x4d, 0xb3,
-	0xaf, 0x6d, 0x8e, 0x25, 0x46, 0xb8, 0x0f, 0xe6, 0x94, 0x1c, 0xd1, 0x6b, 0x86, 0xb2, 0x8a, 0x32,
-	0x4b, 0xd2, 0x0a, 0x2c, 0x9f, 0xd8, 0x76, 0x9f, 0x2c, 0x0b, 0x4c, 0x8b, 0xf5, 0x65, 0x44, 0xb9,
-	0x0f, 0xe6, 0x94, 0x1c, 0x51, 0xab, 0xc8, 0xb4, 0x64, 0xd6, 0x15, 0x5a, 0x8a, 0x1c, 0x0d, 0x59,
-	0x4b, 0x8a, 0x51, 0xd2, 0xca, 0x48, 0xb1, 0x24, 0x6b, 0xa9, 0x42, 0xdc, 0x06, 0x45, 0x1e, 0x27,
-	0x67, 0x58, 0x66, 0x67, 0x38, 0x4d, 0x12, 0x23, 0x7c, 0x06, 0x66, 0x55, 0x14, 0xd1, 0x69, 0x96,
-	0x92, 0x0a, 0x12, 0x49, 0x52, 0xb2, 0xb1, 0x8b, 0x60, 0x9f, 0xfc, 0xe6, 0x98, 0x12, 0x6d, 0xcb,
-	0x88, 0xef, 0x19, 0x98, 0x55, 0x51, 0x44, 0xa5, 0x79, 0xa6, 0x24, 0x91, 0xfa, 0x2b, 0x29, 0xb2,
-	0x5b, 0x90, 0x94, 0xa4, 0xe8, 0x44, 0xa5, 0x8c, 0xe4, 0x3e, 0x95, 0x94, 0x54, 0xc1, 0x6d, 0x82,
-	0x02, 0x07, 0x93, 0x73, 0xab, 0xb0, 0xef, 0xbd, 0x14, 0x47, 0x8c, 0x6d, 0x0f, 0x94, 0x15, 0x0c,
-	0xd1, 0xe7, 0x33, 0xf6, 0xed, 0x22, 0x72, 0xa4, 0xed, 0xed, 0x40, 0x37, 0xb4, 0x51, 0x9f, 0xd4,
-	0x16, 0xd9, 0xf6, 0x66, 0x7d, 0xd9, 0xa7, 0x4e, 0xc9, 0x11, 0xa5, 0x3e, 0x67, 0xdb, 0x5b, 0x66,
-	0x5d, 0xa1, 0xa5, 0x48, 0xee, 0xae, 0xac, 0xa5, 0x3a, 0x75, 0x4a, 0x8e, 0xa8, 0xf5, 0x85, 0xac,
-	0x95, 0x71, 0xea, 0x78, 0x9c, 0x9c, 0x5e, 0x95, 0xed, 0xa7, 0x34, 0x49, 0x71, 0xea, 0x54, 0x14,
-	0xd1, 0xa9, 0xc6, 0xf6, 0x93, 0x44, 0xe2, 0x95, 0xbe, 0x03, 0x65, 0xe8, 0x37, 0x71, 0xe8, 0x47,
-	0x4e, 0x9f, 0x08, 0xbf, 0x64, 0xac, 0x8b, 0xce, 0x8c, 0x10, 0x5f, 0x80, 0x85, 0x0c, 0x96, 0xe8,
-	0xf6, 0x15, 0xe5, 0x95, 0x54, 0xbc, 0x2b, 0xf5, 0x14, 0x51, 0x2e, 0xa9, 0xf4, 0xa4, 0x30, 0x15,
-	0x7a, 0x19, 0x71, 0xde, 0x53, 0xe9, 0xa9, 0x02, 0x7d, 0x0a, 0x4a, 0x22, 0x52, 0x8e, 0xf4, 0x3e,
-	0x3b, 0x48, 0x3c, 0x4d, 0x0c, 0xf5, 0x00, 0xcc, 0xab, 0x49, 0xa2, 0xdb, 0x03, 0xf6, 0x33, 0xad,
-	0xa0, 0x49, 0x2b, 0x47, 0xbc, 0x10, 0x3b, 0x38, 0xe8, 0x17, 0xec, 0xd7, 0x6c, 0xe5, 0x2e, 0x3a,
-	0xb3, 0x83, 0xcd, 0x60, 0x89, 0x76, 0xcb, 0x6c, 0xe5, 0x54, 0xbc, 0x2b, 0xf5, 0x14, 0xc1, 0x3e,
-	0x54, 0xe9, 0xa9, 0x82, 0xcd, 0x60, 0x89, 0x7a, 0x8f, 0x54, 0x7a, 0x19, 0xc1, 0x8a, 0x48, 0x39,
-	0xd8, 0x15, 0x16, 0x2c, 0x4f, 0x53, 0x04, 0xab, 0x26, 0x89, 0x6e, 0x8f, 0x59, 0xb0, 0x0a, 0x9a,
-	0xf4, 0x0b, 0xd0, 0x84, 0x41, 0xbf, 0x2f, 0xdc, 0x55, 0xf6, 0x0b, 0x10, 0x77, 0x65, 0x04, 0xba,
-	0x07, 0xca, 0x0a, 0x86, 0x68, 0xf4, 0x0d, 0x7b, 0x3e, 0x91, 0xd3, 0x57, 0x47, 0x11, 0xe2, 0x9a,
-	0xa8, 0x23, 0x05, 0x28, 0xe8, 0x64, 0x84, 0xf7, 0xad, 0xa8, 0xa3, 0x0a, 0x6e, 0x1d, 0xcc, 0xa4,
-	0x51, 0x72, 0x68, 0xeb, 0xc9, 0xbd, 0x44, 0x8f, 0x22, 0x06, 0xb6, 0x0b, 0x4a, 0x32, 0x41, 0x74,
-	0xd9, 0x48, 0xee, 0x25, 0x78, 0x0a, 0xa7, 0xb2, 0x52, 0xf8, 0xfd, 0x8f, 0xe2, 0xc0, 0xaf, 0xef,
-	0xdf, 0x2c, 0x8e, 0xd3, 0x2b, 0xa9, 0x4e, 0xef, 0x52, 0x8a, 0xdd, 0xfb, 0x94, 0xff, 0xd5, 0xc0,
-	0xc8, 0x91, 0xfa, 0x2e, 0x28, 0x40, 0x6d, 0x07, 0xb9, 0x21, 0xbb, 0x0b, 0xd2, 0x7a, 0x77, 0x41,
-	0x87, 0xec, 0x6d, 0x7a, 0x17, 0xb4, 0x0c, 0x74, 0x1f, 0xb5, 0x22, 0xb7, 0x05, 0xdd, 0xd0, 0x6c,
-	0xc1, 0x10, 0x9a, 0xd6, 0x71, 0xe4, 0x9e, 0xc4, 0xff, 0x9c, 0xd2, 0xdb, 0xa3, 0xe1, 0xc6, 0x78,
-	0xaf, 0xbe, 0x0d, 0x43, 0xb8, 0x15, 0x57, 0x9f, 0x47, 0x4e, 0xee, 0x31, 0xc8, 0x5f, 0x0e, 0x7a,
-	0xd0, 0xc7, 0x61, 0x37, 0x35, 0x7a, 0x8d, 0x8e, 0x4e, 0xf4, 0x3a, 0xea, 0xb4, 0xa1, 0x37, 0xbc,
-	0x00, 0x46, 0xe2, 0x7f, 0xa8, 0xad, 0x63, 0xe8, 0xb7, 0x11, 0xd3, 0x1b, 0xa4, 0x7a, 0xc3, 0x0e,
-	0x76, 0xb7, 0xe8, 0xbb, 0xb1, 0xdd, 0xca, 0x60, 0xfc, 0xec, 0x9b, 0x3b, 0x6f, 0xcf, 0x0a, 0xda,
-	0xbb, 0xb3, 0x82, 0xf6, 0xdf, 0x59, 0x41, 0xfb, 0xed, 0xbc, 0x30, 0xf0, 0xee, 0xbc, 0x30, 0xf0,
-	0xf7, 0x79, 0x61, 0xe0, 0xa7, 0xbb, 0x6d, 0x1c, 0x1e, 0x47, 0xcd, 0xaa, 0x45, 0x9c, 0x5a, 0xbc,
-	0x3a, 0xd6, 0x31, 0xc4, 0x2e, 0x7d, 0x55, 0x3b, 0x5d, 0x4a, 0x2d, 0x55, 0xd8, 0xf5, 0x50, 0xd0,
-	0xbc, 0x4e, 0x2f, 0xe2, 0xee, 0xfd, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x05, 0x0e, 0x93, 0x99, 0xdc,
-	0x13, 0x00, 0x00,
+	// 1417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x98, 0x4d, 0x73, 0xd4, 0x36,
+	0x18, 0xc7, 0xb3, 0x25, 0xa5, 0x45, 0x10, 0x02, 0x6e, 0x42, 0x9c, 0x84, 0x6c, 0x36, 0x81, 0xa4,
+	0x5b, 0x4a, 0xb3, 0xe5, 0xad, 0xbc, 0x96, 0x42, 0x96, 0xd7, 0xd2, 0xc0, 0xb2, 0x0c, 0x74, 0xa6,
+	0x17, 0x8f, 0xd6, 0xab, 0x6c, 0xd4, 0xd8, 0x92, 0x2b, 0xcb, 0x61, 0x97, 0x8f, 0xd0, 0x53, 0x8f,
+	0x3d, 0xf6, 0xd8, 0x23, 0x9f, 0xa1, 0x27, 0x8e, 0x1c, 0x7b, 0x6a, 0x3b, 0x70, 0xe0, 0x6b, 0x74,
+	0x24, 0x39, 0x1b, 0xeb, 0xc5, 0x9b, 0x4b, 0x26, 0xe3, 0xe7, 0xd1, 0xcf, 0x3f, 0xeb, 0x2f, 0x79,
+	0xc7, 0x02, 0xb3, 0x31, 0x0d, 0x61, 0x23, 0xe5, 0x94, 0xc1, 0x1e, 0x6a, 0x24, 0x90, 0xc1, 0x38,
+	0x5d, 0x4b, 0x18, 0xe5, 0xd4, 0x3b, 0x22, 0x4a, 0x6b, 0x79, 0x69, 0xee, 0x38, 0x8c, 0x31, 0xa1,
+	0x0d, 0xf9, 0x57, 0x35, 0xcc, 0x4d, 0xf5, 0x68, 0x8f, 0xca, 0x7f, 0x1b, 0xe2, 0x3f, 0x75, 0x75,
+	0xf9, 0xaf, 0x15, 0x70, 0xb0, 0x25, 0x39, 0xde, 0x33, 0x70, 0x6c, 0x07, 0xb1, 0x14, 0x53, 0x82,
+	0xba, 0x81, 0x62, 0xfb, 0x95, 0x5a, 0xa5, 0x7e, 0xf8, 0xfc, 0xc2, 0x5a, 0x11, 0xbe, 0xf6, 0x62,
+	0xb7, 0x4b, 0x0d, 0x5c, 0x3f, 0xf4, 0xe6, 0x9f, 0xc5, 0xb1, 0x3f, 0x3f, 0xbc, 0x3e, 0x53, 0x69,
+	0x4f, 0xee, 0xe8, 0x35, 0xaf, 0x0e, 0x8e, 0xc5, 0xb0, 0x1f, 0x24, 0x70, 0x10, 0x51, 0xd8, 0x0d,
+	0x52, 0xfc, 0x0a, 0xf9, 0x1f, 0xd5, 0x2a, 0xf5, 0xf1, 0xf6, 0xd1, 0x18, 0xf6, 0x5b, 0xea, 0xf2,
+	0x33, 0xfc, 0x0a, 0x79, 0xb7, 0xc0, 0x42, 0x27, 0x0d, 0x83, 0x18, 0x33, 0x46, 0x59, 0xd0, 0xc9,
+	0xc2, 0x6d, 0xc4, 0x03, 0x86, 0x22, 0x38, 0x40, 0x2c, 0xd8, 0x44, 0xc8, 0x3f, 0x50, 0xab, 0xd4,
+	0x0f, 0xb5, 0x67, 0x3b, 0x69, 0xb8, 0x21, 0x7b, 0xd6, 0x65, 0x4b, 0x5b, 0x75, 0xdc, 0x43, 0xc8,
+	0xbb, 0x0f, 0x96, 0x6c, 0x02, 0x0c, 0xb7, 0x35, 0xca, 0xb8, 0xa4, 0x9c, 0x34, 0x28, 0xb7, 0xc3,
+	0xed, 0x02, 0x48, 0x57, 0xa1, 0x9d, 0x9f, 0x51, 0xa8, 0xab, 0x7c, 0x6c, 0xa8, 0x3c, 0x91, 0x2d,
+	0xa5, 0x2a, 0x39, 0xc1, 0x54, 0x39, 0x68, 0xa8, 0x28, 0x8a, 0xae, 0x72, 0x13, 0x9c, 0x2c, 0x80,
+	0x7a, 0x8c, 0x66, 0x89, 0xc6, 0xf8, 0x44, 0x32, 0xfc, 0x21, 0xe3, 0xbe, 0xe8, 0x28, 0x8c, 0xbf,
+	0x0b, 0x6a, 0xd6, 0x78, 0xd3, 0xe3, 0x53, 0xc9, 0x98, 0xd7, 0x19, 0xba, 0xc6, 0x25, 0x30, 0x23,
+	0x62, 0x54, 0x73, 0x9a, 0x06, 0x09, 0x62, 0x01, 0x0c, 0x43, 0x9a, 0x11, 0xee, 0x1f, 0xaa, 0x55,
+	0xea, 0x13, 0xed, 0xa9, 0x18, 0xf6, 0xd5, 0x54, 0xa6, 0x2d, 0xc4, 0x6e, 0xab, 0x9a, 0x77, 0x13,
+	0xcc, 0x77, 0x71, 0x1a, 0x52, 0xc2, 0x31, 0xc9, 0x50, 0x20, 0x2f, 0x62, 0xd2, 0x0b, 0x5e, 0x62,
+	0xd2, 0xa5, 0x2f, 0x7d, 0x20, 0x17, 0xc2, 0x6c, 0xa1, 0xa5, 0x99, 0x77, 0xfc, 0x28, 0x1b, 0xbc,
+	0x8b, 0xe0, 0x44, 0x71, 0x7c, 0x3e, 0x8f, 0x31, 0xec, 0xfb, 0x87, 0xe5, 0xd0, 0xa9, 0x42, 0x55,
+	0xcd, 0xde, 0x06, 0xec, 0x9b, 0xa3, 0xf2, 0x85, 0x20, 0x46, 0x1d, 0xb1, 0x46, 0x29, 0x67, 0x31,
+	0xea, 0x06, 0x98, 0xd3, 0x5d, 0xc9, 0x26, 0x66, 0xb1, 0x78, 0x54, 0x4c, 0xbb, 0xfe, 0x44, 0xad,
+	0x52, 0x3f, 0xd0, 0xf6, 0x35, 0x55, 0xd9, 0xd0, 0x92, 0x75, 0xef, 0x0a, 0x28, 0xd6, 0x82, 0x2e,
+	0x8a, 0x10, 0xc7, 0x94, 0xc8, 0xbb, 0x1e, 0x95, 0x77, 0x2d, 0x3a, 0xdd, 0xc9, 0xcb, 0xe2, 0xbe,
+	0x97, 0x81, 0x9f, 0x72, 0x18, 0xa1, 0x20, 0xa1, 0x11, 0x0e, 0x07, 0x41, 0x18, 0x21, 0x48, 0xb2,
+	0x44, 0x8e, 0x9c, 0x94, 0x23, 0xa7, 0x65, 0xbd, 0x25, 0xcb, 0x4d, 0x55, 0x15, 0x03, 0xaf, 0x82,
+	0xd9, 0x18, 0x93, 0xe0, 0x97, 0x8c, 0x72, 0x18, 0x64, 0x49, 0x17, 0x72, 0x14, 0x60, 0xc2, 0x11,
+	0xdb, 0x81, 0x91, 0x7f, 0x4c, 0xdd, 0x33, 0xc6, 0xe4, 0xa9, 0xa8, 0x3f, 0x97, 0xe5, 0x87, 0x79,
+	0xd5, 0x6b, 0x81, 0x55, 0x11, 0x67, 0x44, 0x43, 0x18, 0x05, 0x3b, 0x98, 0xf1, 0x0c, 0x46, 0xf9,
+	0xe2, 0x20, 0x99, 0x7c, 0xe6, 0x7c, 0xd6, 0xfc, 0xe3, 0x32, 0xdd, 0x5a, 0x0c, 0xfb, 0x3f, 0x88,
+	0xe6, 0x17, 0xaa, 0x57, 0xae, 0x90, 0xc7, 0x99, 0x78, 0x78, 0x35, 0x81, 0x62, 0x9d, 0xd2, 0x64,
+	0xc4, 0xe6, 0xf5, 0xd4, 0x3a, 0xa5, 0x49, 0xc9, 0xde, 0xbd, 0x0b, 0x6a, 0xd6, 0x78, 0x73, 0x9d,
+	0x7e, 0xa6, 0xd6, 0xa9, 0xce, 0xb0, 0xb6, 0xcb, 0x1e, 0xc6, 0xb1, 0x71, 0xa7, 0x74, 0x0d, 0x6b,
+	0xdf, 0x6a, 0x1a, 0x25, 0xdb, 0x76, 0x5a, 0xd7, 0x70, 0xed, 0xda, 0x1b, 0x60, 0x7e, 0x0f, 0x63,
+	0x6f, 0xda, 0x13, 0x92, 0x30, 0xb3, 0x4b, 0x30, 0xf7, 0x6c, 0x13, 0x2c, 0x9a, 0xa3, 0x4d, 0x87,
+	0x19, 0x49, 0x98, 0xd3, 0x08, 0xba, 0xc2, 0x03, 0xb0, 0x94, 0xd0, 0x68, 0xd0, 0x13, 0x6b, 0xb0,
+	0x34, 0x15, 0x5f, 0x62, 0x16, 0xf2, 0xc6, 0x92, 0x68, 0x9e, 0x80, 0x15, 0x37, 0xc9, 0x94, 0x9a,
+	0x95, 0xb4, 0x9a, 0x83, 0xb6, 0x9f, 0x9a, 0x23, 0xa9, 0x39, 0x87, 0x9a, 0x15, 0x97, 0xad, 0x56,
+	0x92, 0xd9, 0xbc, 0x43, 0xcd, 0x15, 0xdc, 0x3d, 0x50, 0x33, 0x80, 0x76, 0x7a, 0x27, 0xd5, 0x6b,
+	0x5b, 0x63, 0x99, 0x11, 0x6e, 0x80, 0xd3, 0x4e, 0x8e, 0xe9, 0xb5, 0x20, 0x59, 0x8b, 0x36, 0xcb,
+	0xd2, 0x4a, 0x43, 0x46, 0xa3, 0x68, 0x44, 0x96, 0x55, 0xa5, 0xa5, 0xfa, 0x4a, 0xa2, 0xdc, 0x00,
+	0xa7, 0x9d, 0x1c, 0x53, 0x6b, 0x51, 0x69, 0xd9, 0xac, 0x7d, 0xb4, 0x1c, 0x39, 0xd6, 0x6c, 0x2d,
+	0x2b, 0x46, 0x4b, 0xab, 0x24, 0xc5, 0x25, 0x5b, 0xcb, 0x15, 0xe2, 0x1d, 0xb0, 0xa8, 0xe3, 0xec,
+	0x0c, 0x97, 0xd5, 0x1e, 0x2e, 0x92, 0xcc, 0x08, 0x1f, 0x81, 0x53, 0x2e, 0x8a, 0xe9, 0x74, 0x4a,
+	0x92, 0xaa, 0x16, 0xc9, 0x52, 0x8a, 0x30, 0x41, 0x70, 0x44, 0x7e, 0xa7, 0x95, 0x92, 0x6c, 0x2b,
+	0x89, 0xef, 0x11, 0x38, 0xe5, 0xa2, 0x98, 0x4a, 0x2b, 0x4a, 0xc9, 0x22, 0x8d, 0x56, 0x72, 0x64,
+	0xb7, 0x6a, 0x29, 0x59, 0xd1, 0x99, 0x4a, 0x25, 0xc9, 0x7d, 0x6e, 0x29, 0xb9, 0x82, 0x5b, 0x07,
+	0x55, 0x0d, 0x66, 0xe7, 0x56, 0x57, 0xef, 0xbd, 0x02, 0xc7, 0x8c, 0xed, 0x21, 0x58, 0x76, 0x30,
+	0x4c, 0x9f, 0x2f, 0xd4, 0xdb, 0xc5, 0xe4, 0x58, 0xcb, 0x3b, 0x86, 0x84, 0x47, 0x68, 0x44, 0x6a,
+	0x67, 0xd4, 0xf2, 0x56, 0x7d, 0xe5, 0xbb, 0xce, 0xc9, 0x31, 0xa5, 0xbe, 0x54, 0xcb, 0xdb, 0x66,
+	0xed, 0xa3, 0xe5, 0x48, 0xee, 0xac, 0xad, 0xe5, 0xda, 0x75, 0x4e, 0x8e, 0xa9, 0xf5, 0x95, 0xad,
+	0x55, 0xb2, 0xeb, 0x74, 0x9c, 0x9d, 0xde, 0x9a, 0x5a, 0x4f, 0x45, 0x92, 0x63, 0xd7, 0xb9, 0x28,
+	0xa6, 0x53, 0x43, 0xad, 0x27, 0x8b, 0xa4, 0x2b, 0x7d, 0x0f, 0x96, 0x21, 0xeb, 0x60, 0xce, 0xb2,
+	0x78, 0x44, 0x84, 0x5f, 0x2b, 0xd6, 0x6e, 0x67, 0x49, 0x88, 0x4f, 0xc1, 0x6a, 0x09, 0xcb, 0x74,
+	0x3b, 0x27, 0x79, 0x4b, 0x2e, 0xde, 0xbe, 0x7a, 0x8e, 0x28, 0xcf, 0xbb, 0xf4, 0xac, 0x30, 0x1d,
+	0x7a, 0x25, 0x71, 0x5e, 0x70, 0xe9, 0xb9, 0x02, 0x7d, 0x00, 0x96, 0x4c, 0xa4, 0x1d, 0xe9, 0x45,
+	0xb5, 0x91, 0x74, 0x9a, 0x19, 0xea, 0x13, 0xb0, 0xe2, 0x26, 0x99, 0x6e, 0x97, 0xd4, 0xcf, 0xb4,
+	0x83, 0x66, 0xcd, 0x1c, 0x4d, 0x38, 0x8e, 0x71, 0x3a, 0x2a, 0xd8, 0x6f, 0xd4, 0xcc, 0xed, 0x76,
+	0x96, 0x07, 0x5b, 0xc2, 0x32, 0xed, 0x2e, 0xab, 0x99, 0x73, 0xf1, 0xf6, 0xd5, 0x73, 0x04, 0x7b,
+	0xc5, 0xa5, 0xe7, 0x0a, 0xb6, 0x84, 0x65, 0xea, 0x5d, 0x75, 0xe9, 0x95, 0x04, 0x6b, 0x22, 0xed,
+	0x60, 0xaf, 0xa9, 0x60, 0x75, 0x9a, 0x23, 0x58, 0x37, 0xc9, 0x74, 0xbb, 0xae, 0x82, 0x75, 0xd0,
+	0xac, 0x5f, 0x80, 0x0e, 0x4c, 0x47, 0xbd, 0x70, 0x6f, 0xa8, 0x5f, 0x00, 0xd1, 0x55, 0x12, 0xe8,
+	0x43, 0xb0, 0xec, 0x60, 0x98, 0x46, 0xdf, 0xaa, 0xe7, 0x33, 0x39, 0x23, 0x75, 0x1c, 0x21, 0xde,
+	0x34, 0x75, 0xac, 0x00, 0x0d, 0x9d, 0x92, 0xf0, 0xbe, 0x33, 0x75, 0x5c, 0xc1, 0x89, 0x73, 0x89,
+	0x02, 0xca, 0x0e, 0xed, 0x56, 0x7e, 0x2e, 0x31, 0xa4, 0x98, 0x81, 0x89, 0x73, 0x09, 0x8b, 0x60,
+	0xba, 0xdc, 0xce, 0xcf, 0x25, 0x74, 0x8a, 0xae, 0x72, 0x05, 0xf8, 0x11, 0xde, 0x44, 0xe1, 0x20,
+	0x8c, 0x50, 0x80, 0xfa, 0x09, 0x66, 0x70, 0xf8, 0xbd, 0xbb, 0xae, 0xbe, 0x3d, 0x87, 0xf5, 0xbb,
+	0xc3, 0xb2, 0xf8, 0x6c, 0x3d, 0x07, 0xa6, 0xc9, 0x26, 0x0f, 0x62, 0xc4, 0x61, 0x17, 0x72, 0x18,
+	0x48, 0x9f, 0x8c, 0x45, 0x7e, 0x53, 0xde, 0xd6, 0x23, 0x9b, 0x7c, 0x23, 0xaf, 0xad, 0xc3, 0x14,
+	0x3d, 0x67, 0xd1, 0xb5, 0xea, 0xef, 0x7f, 0x2c, 0x8e, 0xfd, 0xfa, 0xe1, 0xf5, 0x99, 0x69, 0x79,
+	0xfe, 0xd5, 0x1f, 0x9e, 0x80, 0xa9, 0x43, 0xa6, 0xe5, 0x7f, 0x2b, 0x60, 0xf2, 0x85, 0xfb, 0xe0,
+	0x29, 0x45, 0xbd, 0x18, 0x11, 0xae, 0x0e, 0x9e, 0x2a, 0xc3, 0x83, 0xa7, 0x67, 0xea, 0xb2, 0x3c,
+	0x78, 0xba, 0x0c, 0x7c, 0x86, 0xba, 0x19, 0xe9, 0x42, 0xc2, 0x03, 0xa9, 0x14, 0x6e, 0x65, 0x64,
+	0x5b, 0x7c, 0x09, 0xcb, 0xa3, 0xaa, 0x89, 0xf6, 0xf4, 0xb0, 0x7e, 0x07, 0x72, 0xd8, 0x14, 0xd5,
+	0xc7, 0x59, 0xec, 0x5d, 0x07, 0x73, 0x7b, 0x03, 0x13, 0xc8, 0x30, 0x1f, 0x14, 0x86, 0x1e, 0x90,
+	0x43, 0x67, 0x86, 0x1d, 0x2d, 0xd9, 0x30, 0x1c, 0xbc, 0x0a, 0x26, 0xc5, 0xd7, 0x7b, 0xb8, 0x05,
+	0x59, 0x0f, 0x29, 0xbd, 0x71, 0xa9, 0x37, 0x11, 0x63, 0xd2, 0x94, 0x57, 0x85, 0xdd, 0xb5, 0x71,
+	0xf1, 0xec, 0xeb, 0xf7, 0xde, 0xbc, 0xab, 0x56, 0xde, 0xbe, 0xab, 0x56, 0xfe, 0x7b, 0x57, 0xad,
+	0xfc, 0xf6, 0xbe, 0x3a, 0xf6, 0xf6, 0x7d, 0x75, 0xec, 0xef, 0xf7, 0xd5, 0xb1, 0x9f, 0xce, 0xf6,
+	0x30, 0xdf, 0xca, 0x3a, 0x6b, 0x21, 0x8d, 0x1b, 0x62, 0x76, 0xc2, 0x2d, 0x88, 0x89, 0xfc, 0xaf,
+	0xb1, 0x73, 0xbe, 0x30, 0x55, 0x7c, 0x90, 0xa0, 0xb4, 0x73, 0x50, 0x9e, 0xfa, 0x5d, 0xf8, 0x7f,
+	0x00, 0x32, 0x04, 0xa8, 0x56, 0x49, 0x14, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -833,6 +855,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NftMetadataBaseUrl) > 0 {
+		i -= len(m.NftMetadataBaseUrl)
+		copy(dAtA[i:], m.NftMetadataBaseUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NftMetadataBaseUrl)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.LifecycleExpirationMax != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LifecycleExpirationMax))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.BaseMirrorGroupAckRelayerFee) > 0 {
 		i -= len(m.BaseMirrorGroupAckRelayerFee)
 		copy(dAtA[i:], m.BaseMirrorGroupAckRelayerFee)
@@ -1682,6 +1720,13 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovParams(uint64(l))
 	}
+	if m.LifecycleExpirationMax != 0 {
+		n += 2 + sovParams(uint64(m.LifecycleExpirationMax))
+	}
+	l = len(m.NftMetadataBaseUrl)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -3692,6 +3737,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BaseMirrorGroupAckRelayerFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 66:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleExpirationMax", wireType)
+			}
+			m.LifecycleExpirationMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LifecycleExpirationMax |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftMetadataBaseUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftMetadataBaseUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -13,7 +13,9 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	resource "github.com/mocachain/moca/v2/types/resource"
 	types1 "github.com/mocachain/moca/v2/x/permission/types"
+	types2 "github.com/mocachain/moca/v2/x/sp/types"
 	types "github.com/mocachain/moca/v2/x/virtualgroup/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -693,6 +695,12 @@ func (m *QueryListBucketsResponse) GetPagination() *query.PageResponse {
 type QueryListObjectsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	BucketName string             `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// prefix limits the response to the objects whose names begin with it.
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// delimiter groups the names which contain it after the prefix into common_prefixes.
+	Delimiter string `protobuf:"bytes,4,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	// start_after limits the response to the names after it in lexicographical order.
+	StartAfter string `protobuf:"bytes,5,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
 }
 
 func (m *QueryListObjectsRequest) Reset()         { *m = QueryListObjectsRequest{} }
@@ -742,6 +750,27 @@ func (m *QueryListObjectsRequest) GetBucketName() string {
 	return ""
 }
 
+func (m *QueryListObjectsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *QueryListObjectsRequest) GetDelimiter() string {
+	if m != nil {
+		return m.Delimiter
+	}
+	return ""
+}
+
+func (m *QueryListObjectsRequest) GetStartAfter() string {
+	if m != nil {
+		return m.StartAfter
+	}
+	return ""
+}
+
 type QueryListObjectsByBucketIdRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	BucketId   string             `protobuf:"bytes,2,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
@@ -797,6 +826,8 @@ func (m *QueryListObjectsByBucketIdRequest) GetBucketId() string {
 type QueryListObjectsResponse struct {
 	ObjectInfos []*ObjectInfo       `protobuf:"bytes,1,rep,name=object_infos,json=objectInfos,proto3" json:"object_infos,omitempty"`
 	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// common_prefixes are the distinct names up to and including the first delimiter after the prefix.
+	CommonPrefixes []string `protobuf:"bytes,3,rep,name=common_prefixes,json=commonPrefixes,proto3" json:"common_prefixes,omitempty"`
 }
 
 func (m *QueryListObjectsResponse) Reset()         { *m = QueryListObjectsResponse{} }
@@ -846,6 +877,13 @@ func (m *QueryListObjectsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryListObjectsResponse) GetCommonPrefixes() []string {
+	if m != nil {
+		return m.CommonPrefixes
+	}
+	return nil
+}
+
 type QueryNFTRequest struct {
 	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
@@ -1022,6 +1060,104 @@ func (m *QueryGroupNFTResponse) GetMetaData() *GroupMetaData {
 	return nil
 }
 
+type QueryNFTTokenURIRequest struct {
+	// resource_type defines the type of the NFT, one of bucket, object and group
+	ResourceType resource.ResourceType `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=moca.resource.ResourceType" json:"resource_type,omitempty"`
+	TokenId      string                `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *QueryNFTTokenURIRequest) Reset()         { *m = QueryNFTTokenURIRequest{} }
+func (m *QueryNFTTokenURIRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTTokenURIRequest) ProtoMessage()    {}
+func (*QueryNFTTokenURIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{21}
+}
+func (m *QueryNFTTokenURIRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTTokenURIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTTokenURIRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNFTTokenURIRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTTokenURIRequest.Merge(m, src)
+}
+func (m *QueryNFTTokenURIRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTTokenURIRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTTokenURIRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTTokenURIRequest proto.InternalMessageInfo
+
+func (m *QueryNFTTokenURIRequest) GetResourceType() resource.ResourceType {
+	if m != nil {
+		return m.ResourceType
+	}
+	return resource.RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (m *QueryNFTTokenURIRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+type QueryNFTTokenURIResponse struct {
+	// token_uri defines the data uri of the ERC-721 metadata json of the NFT
+	TokenUri string `protobuf:"bytes,1,opt,name=token_uri,json=tokenUri,proto3" json:"token_uri,omitempty"`
+}
+
+func (m *QueryNFTTokenURIResponse) Reset()         { *m = QueryNFTTokenURIResponse{} }
+func (m *QueryNFTTokenURIResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTTokenURIResponse) ProtoMessage()    {}
+func (*QueryNFTTokenURIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{22}
+}
+func (m *QueryNFTTokenURIResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTTokenURIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTTokenURIResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNFTTokenURIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTTokenURIResponse.Merge(m, src)
+}
+func (m *QueryNFTTokenURIResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTTokenURIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTTokenURIResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTTokenURIResponse proto.InternalMessageInfo
+
+func (m *QueryNFTTokenURIResponse) GetTokenUri() string {
+	if m != nil {
+		return m.TokenUri
+	}
+	return ""
+}
+
 type QueryPolicyForAccountRequest struct {
 	Resource         string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 	PrincipalAddress string `protobuf:"bytes,2,opt,name=principal_address,json=principalAddress,proto3" json:"principal_address,omitempty"`
@@ -1031,7 +1167,7 @@ func (m *QueryPolicyForAccountRequest) Reset()         { *m = QueryPolicyForAcco
 func (m *QueryPolicyForAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyForAccountRequest) ProtoMessage()    {}
 func (*QueryPolicyForAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{21}
+	return fileDescriptor_056b51fde4497d83, []int{23}
 }
 func (m *QueryPolicyForAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1082,7 +1218,7 @@ func (m *QueryPolicyForAccountResponse) Reset()         { *m = QueryPolicyForAcc
 func (m *QueryPolicyForAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyForAccountResponse) ProtoMessage()    {}
 func (*QueryPolicyForAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{22}
+	return fileDescriptor_056b51fde4497d83, []int{24}
 }
 func (m *QueryPolicyForAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1129,7 +1265,7 @@ func (m *QueryVerifyPermissionRequest) Reset()         { *m = QueryVerifyPermiss
 func (m *QueryVerifyPermissionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyPermissionRequest) ProtoMessage()    {}
 func (*QueryVerifyPermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{23}
+	return fileDescriptor_056b51fde4497d83, []int{25}
 }
 func (m *QueryVerifyPermissionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,7 +1330,7 @@ func (m *QueryVerifyPermissionResponse) Reset()         { *m = QueryVerifyPermis
 func (m *QueryVerifyPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyPermissionResponse) ProtoMessage()    {}
 func (*QueryVerifyPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{24}
+	return fileDescriptor_056b51fde4497d83, []int{26}
 }
 func (m *QueryVerifyPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1230,23 +1366,25 @@ func (m *QueryVerifyPermissionResponse) GetEffect() types1.Effect {
 	return types1.EFFECT_UNSPECIFIED
 }
 
-type QueryHeadGroupRequest struct {
-	GroupOwner string `protobuf:"bytes,1,opt,name=group_owner,json=groupOwner,proto3" json:"group_owner,omitempty"`
-	GroupName  string `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+type QueryExplainPermissionRequest struct {
+	Operator   string            `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	BucketName string            `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	ObjectName string            `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	ActionType types1.ActionType `protobuf:"varint,4,opt,name=action_type,json=actionType,proto3,enum=moca.permission.ActionType" json:"action_type,omitempty"`
 }
 
-func (m *QueryHeadGroupRequest) Reset()         { *m = QueryHeadGroupRequest{} }
-func (m *QueryHeadGroupRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryHeadGroupRequest) ProtoMessage()    {}
-func (*QueryHeadGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{25}
+func (m *QueryExplainPermissionRequest) Reset()         { *m = QueryExplainPermissionRequest{} }
+func (m *QueryExplainPermissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExplainPermissionRequest) ProtoMessage()    {}
+func (*QueryExplainPermissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{27}
 }
-func (m *QueryHeadGroupRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryExplainPermissionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryHeadGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryExplainPermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryHeadGroupRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryExplainPermissionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1256,48 +1394,65 @@ func (m *QueryHeadGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryHeadGroupRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryHeadGroupRequest.Merge(m, src)
+func (m *QueryExplainPermissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExplainPermissionRequest.Merge(m, src)
 }
-func (m *QueryHeadGroupRequest) XXX_Size() int {
+func (m *QueryExplainPermissionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryHeadGroupRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryHeadGroupRequest.DiscardUnknown(m)
+func (m *QueryExplainPermissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExplainPermissionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryHeadGroupRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryExplainPermissionRequest proto.InternalMessageInfo
 
-func (m *QueryHeadGroupRequest) GetGroupOwner() string {
+func (m *QueryExplainPermissionRequest) GetOperator() string {
 	if m != nil {
-		return m.GroupOwner
+		return m.Operator
 	}
 	return ""
 }
 
-func (m *QueryHeadGroupRequest) GetGroupName() string {
+func (m *QueryExplainPermissionRequest) GetBucketName() string {
 	if m != nil {
-		return m.GroupName
+		return m.BucketName
 	}
 	return ""
 }
 
-type QueryHeadGroupResponse struct {
-	GroupInfo *GroupInfo `protobuf:"bytes,1,opt,name=group_info,json=groupInfo,proto3" json:"group_info,omitempty"`
+func (m *QueryExplainPermissionRequest) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
 }
 
-func (m *QueryHeadGroupResponse) Reset()         { *m = QueryHeadGroupResponse{} }
-func (m *QueryHeadGroupResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryHeadGroupResponse) ProtoMessage()    {}
-func (*QueryHeadGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{26}
+func (m *QueryExplainPermissionRequest) GetActionType() types1.ActionType {
+	if m != nil {
+		return m.ActionType
+	}
+	return types1.ACTION_UNSPECIFIED
 }
-func (m *QueryHeadGroupResponse) XXX_Unmarshal(b []byte) error {
+
+type QueryExplainPermissionResponse struct {
+	// effect defines the same effect as the one returned by VerifyPermission
+	Effect types1.Effect `protobuf:"varint,1,opt,name=effect,proto3,enum=moca.permission.Effect" json:"effect,omitempty"`
+	// steps define the checks taken to reach the effect, in order
+	Steps []PermissionTraceStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps"`
+}
+
+func (m *QueryExplainPermissionResponse) Reset()         { *m = QueryExplainPermissionResponse{} }
+func (m *QueryExplainPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExplainPermissionResponse) ProtoMessage()    {}
+func (*QueryExplainPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{28}
+}
+func (m *QueryExplainPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryHeadGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryExplainPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryHeadGroupResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryExplainPermissionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1307,42 +1462,62 @@ func (m *QueryHeadGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryHeadGroupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryHeadGroupResponse.Merge(m, src)
+func (m *QueryExplainPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExplainPermissionResponse.Merge(m, src)
 }
-func (m *QueryHeadGroupResponse) XXX_Size() int {
+func (m *QueryExplainPermissionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryHeadGroupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryHeadGroupResponse.DiscardUnknown(m)
+func (m *QueryExplainPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExplainPermissionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryHeadGroupResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryExplainPermissionResponse proto.InternalMessageInfo
 
-func (m *QueryHeadGroupResponse) GetGroupInfo() *GroupInfo {
+func (m *QueryExplainPermissionResponse) GetEffect() types1.Effect {
 	if m != nil {
-		return m.GroupInfo
+		return m.Effect
 	}
-	return nil
+	return types1.EFFECT_UNSPECIFIED
 }
 
-type QueryListGroupsRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	GroupOwner string             `protobuf:"bytes,2,opt,name=group_owner,json=groupOwner,proto3" json:"group_owner,omitempty"`
+func (m *QueryExplainPermissionResponse) GetSteps() []PermissionTraceStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
 }
 
-func (m *QueryListGroupsRequest) Reset()         { *m = QueryListGroupsRequest{} }
-func (m *QueryListGroupsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryListGroupsRequest) ProtoMessage()    {}
-func (*QueryListGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{27}
+// PermissionTraceStep is one check of a permission evaluation.
+type PermissionTraceStep struct {
+	// check defines what is checked, one of public_visibility, anonymous, owner, account_policy, group_policy and result
+	Check string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	// resource defines the moca resource name of the resource whose visibility, owner or policies are checked
+	Resource string `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	// policy_id defines the evaluated policy, zero if the step does not evaluate a policy
+	PolicyId Uint `protobuf:"bytes,3,opt,name=policy_id,json=policyId,proto3,customtype=Uint" json:"policy_id"`
+	// group_id defines the group the evaluated policy is granted to, zero for the account policy
+	GroupId Uint `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3,customtype=Uint" json:"group_id"`
+	// statements define how each statement of the evaluated policy applies to the action
+	Statements []StatementTrace `protobuf:"bytes,5,rep,name=statements,proto3" json:"statements"`
+	// effect defines the effect of the step, unspecified if the step leaves the decision to the next one
+	Effect types1.Effect `protobuf:"varint,6,opt,name=effect,proto3,enum=moca.permission.Effect" json:"effect,omitempty"`
+	// reason explains the effect of the step
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PermissionTraceStep) Reset()         { *m = PermissionTraceStep{} }
+func (m *PermissionTraceStep) String() string { return proto.CompactTextString(m) }
+func (*PermissionTraceStep) ProtoMessage()    {}
+func (*PermissionTraceStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{29}
 }
-func (m *QueryListGroupsRequest) XXX_Unmarshal(b []byte) error {
+func (m *PermissionTraceStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PermissionTraceStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListGroupsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PermissionTraceStep.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1352,49 +1527,76 @@ func (m *QueryListGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryListGroupsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListGroupsRequest.Merge(m, src)
+func (m *PermissionTraceStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionTraceStep.Merge(m, src)
 }
-func (m *QueryListGroupsRequest) XXX_Size() int {
+func (m *PermissionTraceStep) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListGroupsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListGroupsRequest.DiscardUnknown(m)
+func (m *PermissionTraceStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionTraceStep.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListGroupsRequest proto.InternalMessageInfo
+var xxx_messageInfo_PermissionTraceStep proto.InternalMessageInfo
 
-func (m *QueryListGroupsRequest) GetPagination() *query.PageRequest {
+func (m *PermissionTraceStep) GetCheck() string {
 	if m != nil {
-		return m.Pagination
+		return m.Check
+	}
+	return ""
+}
+
+func (m *PermissionTraceStep) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *PermissionTraceStep) GetStatements() []StatementTrace {
+	if m != nil {
+		return m.Statements
 	}
 	return nil
 }
 
-func (m *QueryListGroupsRequest) GetGroupOwner() string {
+func (m *PermissionTraceStep) GetEffect() types1.Effect {
 	if m != nil {
-		return m.GroupOwner
+		return m.Effect
+	}
+	return types1.EFFECT_UNSPECIFIED
+}
+
+func (m *PermissionTraceStep) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
 	return ""
 }
 
-type QueryListGroupsResponse struct {
-	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	GroupInfos []*GroupInfo        `protobuf:"bytes,2,rep,name=group_infos,json=groupInfos,proto3" json:"group_infos,omitempty"`
+// StatementTrace is the evaluation of one statement of a policy.
+type StatementTrace struct {
+	// index defines the position of the statement in the policy
+	Index     uint32            `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Statement *types1.Statement `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	// effect defines the effect of the statement, unspecified if the statement does not apply
+	Effect types1.Effect `protobuf:"varint,3,opt,name=effect,proto3,enum=moca.permission.Effect" json:"effect,omitempty"`
+	// reason explains why the statement applies or not
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *QueryListGroupsResponse) Reset()         { *m = QueryListGroupsResponse{} }
-func (m *QueryListGroupsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryListGroupsResponse) ProtoMessage()    {}
-func (*QueryListGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{28}
+func (m *StatementTrace) Reset()         { *m = StatementTrace{} }
+func (m *StatementTrace) String() string { return proto.CompactTextString(m) }
+func (*StatementTrace) ProtoMessage()    {}
+func (*StatementTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{30}
 }
-func (m *QueryListGroupsResponse) XXX_Unmarshal(b []byte) error {
+func (m *StatementTrace) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StatementTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListGroupsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StatementTrace.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1404,50 +1606,63 @@ func (m *QueryListGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryListGroupsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListGroupsResponse.Merge(m, src)
+func (m *StatementTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatementTrace.Merge(m, src)
 }
-func (m *QueryListGroupsResponse) XXX_Size() int {
+func (m *StatementTrace) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListGroupsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListGroupsResponse.DiscardUnknown(m)
+func (m *StatementTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatementTrace.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListGroupsResponse proto.InternalMessageInfo
+var xxx_messageInfo_StatementTrace proto.InternalMessageInfo
 
-func (m *QueryListGroupsResponse) GetPagination() *query.PageResponse {
+func (m *StatementTrace) GetIndex() uint32 {
 	if m != nil {
-		return m.Pagination
+		return m.Index
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryListGroupsResponse) GetGroupInfos() []*GroupInfo {
+func (m *StatementTrace) GetStatement() *types1.Statement {
 	if m != nil {
-		return m.GroupInfos
+		return m.Statement
 	}
 	return nil
 }
 
-type QueryHeadGroupMemberRequest struct {
-	Member     string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
-	GroupOwner string `protobuf:"bytes,2,opt,name=group_owner,json=groupOwner,proto3" json:"group_owner,omitempty"`
-	GroupName  string `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+func (m *StatementTrace) GetEffect() types1.Effect {
+	if m != nil {
+		return m.Effect
+	}
+	return types1.EFFECT_UNSPECIFIED
 }
 
-func (m *QueryHeadGroupMemberRequest) Reset()         { *m = QueryHeadGroupMemberRequest{} }
-func (m *QueryHeadGroupMemberRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryHeadGroupMemberRequest) ProtoMessage()    {}
-func (*QueryHeadGroupMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{29}
+func (m *StatementTrace) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
-func (m *QueryHeadGroupMemberRequest) XXX_Unmarshal(b []byte) error {
+
+type QueryHeadGroupRequest struct {
+	GroupOwner string `protobuf:"bytes,1,opt,name=group_owner,json=groupOwner,proto3" json:"group_owner,omitempty"`
+	GroupName  string `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+}
+
+func (m *QueryHeadGroupRequest) Reset()         { *m = QueryHeadGroupRequest{} }
+func (m *QueryHeadGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadGroupRequest) ProtoMessage()    {}
+func (*QueryHeadGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{31}
+}
+func (m *QueryHeadGroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryHeadGroupMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHeadGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryHeadGroupMemberRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHeadGroupRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1457,10 +1672,211 @@ func (m *QueryHeadGroupMemberRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryHeadGroupMemberRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryHeadGroupMemberRequest.Merge(m, src)
+func (m *QueryHeadGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadGroupRequest.Merge(m, src)
 }
-func (m *QueryHeadGroupMemberRequest) XXX_Size() int {
+func (m *QueryHeadGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeadGroupRequest proto.InternalMessageInfo
+
+func (m *QueryHeadGroupRequest) GetGroupOwner() string {
+	if m != nil {
+		return m.GroupOwner
+	}
+	return ""
+}
+
+func (m *QueryHeadGroupRequest) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+type QueryHeadGroupResponse struct {
+	GroupInfo *GroupInfo `protobuf:"bytes,1,opt,name=group_info,json=groupInfo,proto3" json:"group_info,omitempty"`
+}
+
+func (m *QueryHeadGroupResponse) Reset()         { *m = QueryHeadGroupResponse{} }
+func (m *QueryHeadGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadGroupResponse) ProtoMessage()    {}
+func (*QueryHeadGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{32}
+}
+func (m *QueryHeadGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeadGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadGroupResponse.Merge(m, src)
+}
+func (m *QueryHeadGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeadGroupResponse proto.InternalMessageInfo
+
+func (m *QueryHeadGroupResponse) GetGroupInfo() *GroupInfo {
+	if m != nil {
+		return m.GroupInfo
+	}
+	return nil
+}
+
+type QueryListGroupsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	GroupOwner string             `protobuf:"bytes,2,opt,name=group_owner,json=groupOwner,proto3" json:"group_owner,omitempty"`
+}
+
+func (m *QueryListGroupsRequest) Reset()         { *m = QueryListGroupsRequest{} }
+func (m *QueryListGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListGroupsRequest) ProtoMessage()    {}
+func (*QueryListGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{33}
+}
+func (m *QueryListGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListGroupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListGroupsRequest.Merge(m, src)
+}
+func (m *QueryListGroupsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListGroupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListGroupsRequest proto.InternalMessageInfo
+
+func (m *QueryListGroupsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryListGroupsRequest) GetGroupOwner() string {
+	if m != nil {
+		return m.GroupOwner
+	}
+	return ""
+}
+
+type QueryListGroupsResponse struct {
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	GroupInfos []*GroupInfo        `protobuf:"bytes,2,rep,name=group_infos,json=groupInfos,proto3" json:"group_infos,omitempty"`
+}
+
+func (m *QueryListGroupsResponse) Reset()         { *m = QueryListGroupsResponse{} }
+func (m *QueryListGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListGroupsResponse) ProtoMessage()    {}
+func (*QueryListGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{34}
+}
+func (m *QueryListGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListGroupsResponse.Merge(m, src)
+}
+func (m *QueryListGroupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListGroupsResponse proto.InternalMessageInfo
+
+func (m *QueryListGroupsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryListGroupsResponse) GetGroupInfos() []*GroupInfo {
+	if m != nil {
+		return m.GroupInfos
+	}
+	return nil
+}
+
+type QueryHeadGroupMemberRequest struct {
+	Member     string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	GroupOwner string `protobuf:"bytes,2,opt,name=group_owner,json=groupOwner,proto3" json:"group_owner,omitempty"`
+	GroupName  string `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+}
+
+func (m *QueryHeadGroupMemberRequest) Reset()         { *m = QueryHeadGroupMemberRequest{} }
+func (m *QueryHeadGroupMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadGroupMemberRequest) ProtoMessage()    {}
+func (*QueryHeadGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{35}
+}
+func (m *QueryHeadGroupMemberRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadGroupMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadGroupMemberRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeadGroupMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadGroupMemberRequest.Merge(m, src)
+}
+func (m *QueryHeadGroupMemberRequest) XXX_Size() int {
 	return m.Size()
 }
 func (m *QueryHeadGroupMemberRequest) XXX_DiscardUnknown() {
@@ -1498,7 +1914,7 @@ func (m *QueryHeadGroupMemberResponse) Reset()         { *m = QueryHeadGroupMemb
 func (m *QueryHeadGroupMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadGroupMemberResponse) ProtoMessage()    {}
 func (*QueryHeadGroupMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{30}
+	return fileDescriptor_056b51fde4497d83, []int{36}
 }
 func (m *QueryHeadGroupMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1543,7 +1959,7 @@ func (m *QueryPolicyForGroupRequest) Reset()         { *m = QueryPolicyForGroupR
 func (m *QueryPolicyForGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyForGroupRequest) ProtoMessage()    {}
 func (*QueryPolicyForGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{31}
+	return fileDescriptor_056b51fde4497d83, []int{37}
 }
 func (m *QueryPolicyForGroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1594,7 +2010,7 @@ func (m *QueryPolicyForGroupResponse) Reset()         { *m = QueryPolicyForGroup
 func (m *QueryPolicyForGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyForGroupResponse) ProtoMessage()    {}
 func (*QueryPolicyForGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{32}
+	return fileDescriptor_056b51fde4497d83, []int{38}
 }
 func (m *QueryPolicyForGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1638,7 +2054,7 @@ func (m *QueryPolicyByIdRequest) Reset()         { *m = QueryPolicyByIdRequest{}
 func (m *QueryPolicyByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyByIdRequest) ProtoMessage()    {}
 func (*QueryPolicyByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{33}
+	return fileDescriptor_056b51fde4497d83, []int{39}
 }
 func (m *QueryPolicyByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1682,7 +2098,7 @@ func (m *QueryPolicyByIdResponse) Reset()         { *m = QueryPolicyByIdResponse
 func (m *QueryPolicyByIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPolicyByIdResponse) ProtoMessage()    {}
 func (*QueryPolicyByIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{34}
+	return fileDescriptor_056b51fde4497d83, []int{40}
 }
 func (m *QueryPolicyByIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1731,7 +2147,7 @@ func (m *QueryLockFeeRequest) Reset()         { *m = QueryLockFeeRequest{} }
 func (m *QueryLockFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLockFeeRequest) ProtoMessage()    {}
 func (*QueryLockFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{35}
+	return fileDescriptor_056b51fde4497d83, []int{41}
 }
 func (m *QueryLockFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1789,7 +2205,7 @@ func (m *QueryLockFeeResponse) Reset()         { *m = QueryLockFeeResponse{} }
 func (m *QueryLockFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLockFeeResponse) ProtoMessage()    {}
 func (*QueryLockFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{36}
+	return fileDescriptor_056b51fde4497d83, []int{42}
 }
 func (m *QueryLockFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1826,7 +2242,7 @@ func (m *QueryHeadBucketExtraRequest) Reset()         { *m = QueryHeadBucketExtr
 func (m *QueryHeadBucketExtraRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadBucketExtraRequest) ProtoMessage()    {}
 func (*QueryHeadBucketExtraRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{37}
+	return fileDescriptor_056b51fde4497d83, []int{43}
 }
 func (m *QueryHeadBucketExtraRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1870,7 +2286,7 @@ func (m *QueryHeadBucketExtraResponse) Reset()         { *m = QueryHeadBucketExt
 func (m *QueryHeadBucketExtraResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadBucketExtraResponse) ProtoMessage()    {}
 func (*QueryHeadBucketExtraResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{38}
+	return fileDescriptor_056b51fde4497d83, []int{44}
 }
 func (m *QueryHeadBucketExtraResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1914,7 +2330,7 @@ func (m *QueryIsPriceChangedRequest) Reset()         { *m = QueryIsPriceChangedR
 func (m *QueryIsPriceChangedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIsPriceChangedRequest) ProtoMessage()    {}
 func (*QueryIsPriceChangedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{39}
+	return fileDescriptor_056b51fde4497d83, []int{45}
 }
 func (m *QueryIsPriceChangedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1966,7 +2382,7 @@ func (m *QueryIsPriceChangedResponse) Reset()         { *m = QueryIsPriceChanged
 func (m *QueryIsPriceChangedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIsPriceChangedResponse) ProtoMessage()    {}
 func (*QueryIsPriceChangedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{40}
+	return fileDescriptor_056b51fde4497d83, []int{46}
 }
 func (m *QueryIsPriceChangedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2010,7 +2426,7 @@ func (m *QueryQuoteUpdateTimeRequest) Reset()         { *m = QueryQuoteUpdateTim
 func (m *QueryQuoteUpdateTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteUpdateTimeRequest) ProtoMessage()    {}
 func (*QueryQuoteUpdateTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{41}
+	return fileDescriptor_056b51fde4497d83, []int{47}
 }
 func (m *QueryQuoteUpdateTimeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2054,7 +2470,7 @@ func (m *QueryQuoteUpdateTimeResponse) Reset()         { *m = QueryQuoteUpdateTi
 func (m *QueryQuoteUpdateTimeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteUpdateTimeResponse) ProtoMessage()    {}
 func (*QueryQuoteUpdateTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{42}
+	return fileDescriptor_056b51fde4497d83, []int{48}
 }
 func (m *QueryQuoteUpdateTimeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2099,7 +2515,7 @@ func (m *QueryGroupMembersExistRequest) Reset()         { *m = QueryGroupMembers
 func (m *QueryGroupMembersExistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupMembersExistRequest) ProtoMessage()    {}
 func (*QueryGroupMembersExistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{43}
+	return fileDescriptor_056b51fde4497d83, []int{49}
 }
 func (m *QueryGroupMembersExistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2150,7 +2566,7 @@ func (m *QueryGroupMembersExistResponse) Reset()         { *m = QueryGroupMember
 func (m *QueryGroupMembersExistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupMembersExistResponse) ProtoMessage()    {}
 func (*QueryGroupMembersExistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{44}
+	return fileDescriptor_056b51fde4497d83, []int{50}
 }
 func (m *QueryGroupMembersExistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2611,7 @@ func (m *QueryGroupsExistRequest) Reset()         { *m = QueryGroupsExistRequest
 func (m *QueryGroupsExistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistRequest) ProtoMessage()    {}
 func (*QueryGroupsExistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{45}
+	return fileDescriptor_056b51fde4497d83, []int{51}
 }
 func (m *QueryGroupsExistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2246,7 +2662,7 @@ func (m *QueryGroupsExistByIdRequest) Reset()         { *m = QueryGroupsExistByI
 func (m *QueryGroupsExistByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistByIdRequest) ProtoMessage()    {}
 func (*QueryGroupsExistByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{46}
+	return fileDescriptor_056b51fde4497d83, []int{52}
 }
 func (m *QueryGroupsExistByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2290,7 +2706,7 @@ func (m *QueryGroupsExistResponse) Reset()         { *m = QueryGroupsExistRespon
 func (m *QueryGroupsExistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistResponse) ProtoMessage()    {}
 func (*QueryGroupsExistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{47}
+	return fileDescriptor_056b51fde4497d83, []int{53}
 }
 func (m *QueryGroupsExistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2756,7 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) String() string {
 }
 func (*QueryPaymentAccountBucketFlowRateLimitRequest) ProtoMessage() {}
 func (*QueryPaymentAccountBucketFlowRateLimitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{48}
+	return fileDescriptor_056b51fde4497d83, []int{54}
 }
 func (m *QueryPaymentAccountBucketFlowRateLimitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2403,7 +2819,7 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) String() string {
 }
 func (*QueryPaymentAccountBucketFlowRateLimitResponse) ProtoMessage() {}
 func (*QueryPaymentAccountBucketFlowRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_056b51fde4497d83, []int{49}
+	return fileDescriptor_056b51fde4497d83, []int{55}
 }
 func (m *QueryPaymentAccountBucketFlowRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2439,2338 +2855,2850 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) GetIsSet() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "moca.storage.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "moca.storage.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "moca.storage.QueryParamsByTimestampRequest")
-	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "moca.storage.QueryParamsByTimestampResponse")
-	proto.RegisterType((*QueryHeadBucketRequest)(nil), "moca.storage.QueryHeadBucketRequest")
-	proto.RegisterType((*QueryHeadBucketByIdRequest)(nil), "moca.storage.QueryHeadBucketByIdRequest")
-	proto.RegisterType((*QueryHeadBucketResponse)(nil), "moca.storage.QueryHeadBucketResponse")
-	proto.RegisterType((*QueryHeadObjectRequest)(nil), "moca.storage.QueryHeadObjectRequest")
-	proto.RegisterType((*QueryHeadObjectByIdRequest)(nil), "moca.storage.QueryHeadObjectByIdRequest")
-	proto.RegisterType((*QueryHeadShadowObjectRequest)(nil), "moca.storage.QueryHeadShadowObjectRequest")
-	proto.RegisterType((*QueryHeadObjectResponse)(nil), "moca.storage.QueryHeadObjectResponse")
-	proto.RegisterType((*QueryHeadShadowObjectResponse)(nil), "moca.storage.QueryHeadShadowObjectResponse")
-	proto.RegisterType((*QueryListBucketsRequest)(nil), "moca.storage.QueryListBucketsRequest")
-	proto.RegisterType((*QueryListBucketsResponse)(nil), "moca.storage.QueryListBucketsResponse")
-	proto.RegisterType((*QueryListObjectsRequest)(nil), "moca.storage.QueryListObjectsRequest")
-	proto.RegisterType((*QueryListObjectsByBucketIdRequest)(nil), "moca.storage.QueryListObjectsByBucketIdRequest")
-	proto.RegisterType((*QueryListObjectsResponse)(nil), "moca.storage.QueryListObjectsResponse")
-	proto.RegisterType((*QueryNFTRequest)(nil), "moca.storage.QueryNFTRequest")
-	proto.RegisterType((*QueryBucketNFTResponse)(nil), "moca.storage.QueryBucketNFTResponse")
-	proto.RegisterType((*QueryObjectNFTResponse)(nil), "moca.storage.QueryObjectNFTResponse")
-	proto.RegisterType((*QueryGroupNFTResponse)(nil), "moca.storage.QueryGroupNFTResponse")
-	proto.RegisterType((*QueryPolicyForAccountRequest)(nil), "moca.storage.QueryPolicyForAccountRequest")
-	proto.RegisterType((*QueryPolicyForAccountResponse)(nil), "moca.storage.QueryPolicyForAccountResponse")
-	proto.RegisterType((*QueryVerifyPermissionRequest)(nil), "moca.storage.QueryVerifyPermissionRequest")
-	proto.RegisterType((*QueryVerifyPermissionResponse)(nil), "moca.storage.QueryVerifyPermissionResponse")
-	proto.RegisterType((*QueryHeadGroupRequest)(nil), "moca.storage.QueryHeadGroupRequest")
-	proto.RegisterType((*QueryHeadGroupResponse)(nil), "moca.storage.QueryHeadGroupResponse")
-	proto.RegisterType((*QueryListGroupsRequest)(nil), "moca.storage.QueryListGroupsRequest")
-	proto.RegisterType((*QueryListGroupsResponse)(nil), "moca.storage.QueryListGroupsResponse")
-	proto.RegisterType((*QueryHeadGroupMemberRequest)(nil), "moca.storage.QueryHeadGroupMemberRequest")
-	proto.RegisterType((*QueryHeadGroupMemberResponse)(nil), "moca.storage.QueryHeadGroupMemberResponse")
-	proto.RegisterType((*QueryPolicyForGroupRequest)(nil), "moca.storage.QueryPolicyForGroupRequest")
-	proto.RegisterType((*QueryPolicyForGroupResponse)(nil), "moca.storage.QueryPolicyForGroupResponse")
-	proto.RegisterType((*QueryPolicyByIdRequest)(nil), "moca.storage.QueryPolicyByIdRequest")
-	proto.RegisterType((*QueryPolicyByIdResponse)(nil), "moca.storage.QueryPolicyByIdResponse")
-	proto.RegisterType((*QueryLockFeeRequest)(nil), "moca.storage.QueryLockFeeRequest")
-	proto.RegisterType((*QueryLockFeeResponse)(nil), "moca.storage.QueryLockFeeResponse")
-	proto.RegisterType((*QueryHeadBucketExtraRequest)(nil), "moca.storage.QueryHeadBucketExtraRequest")
-	proto.RegisterType((*QueryHeadBucketExtraResponse)(nil), "moca.storage.QueryHeadBucketExtraResponse")
-	proto.RegisterType((*QueryIsPriceChangedRequest)(nil), "moca.storage.QueryIsPriceChangedRequest")
-	proto.RegisterType((*QueryIsPriceChangedResponse)(nil), "moca.storage.QueryIsPriceChangedResponse")
-	proto.RegisterType((*QueryQuoteUpdateTimeRequest)(nil), "moca.storage.QueryQuoteUpdateTimeRequest")
-	proto.RegisterType((*QueryQuoteUpdateTimeResponse)(nil), "moca.storage.QueryQuoteUpdateTimeResponse")
-	proto.RegisterType((*QueryGroupMembersExistRequest)(nil), "moca.storage.QueryGroupMembersExistRequest")
-	proto.RegisterType((*QueryGroupMembersExistResponse)(nil), "moca.storage.QueryGroupMembersExistResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "moca.storage.QueryGroupMembersExistResponse.ExistsEntry")
-	proto.RegisterType((*QueryGroupsExistRequest)(nil), "moca.storage.QueryGroupsExistRequest")
-	proto.RegisterType((*QueryGroupsExistByIdRequest)(nil), "moca.storage.QueryGroupsExistByIdRequest")
-	proto.RegisterType((*QueryGroupsExistResponse)(nil), "moca.storage.QueryGroupsExistResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "moca.storage.QueryGroupsExistResponse.ExistsEntry")
-	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitRequest)(nil), "moca.storage.QueryPaymentAccountBucketFlowRateLimitRequest")
-	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitResponse)(nil), "moca.storage.QueryPaymentAccountBucketFlowRateLimitResponse")
+type QueryPaymentAuditRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("moca/storage/query.proto", fileDescriptor_056b51fde4497d83) }
-
-var fileDescriptor_056b51fde4497d83 = []byte{
-	// 2859 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xdf, 0x6f, 0x15, 0xc7,
-	0xf5, 0x67, 0x6d, 0x30, 0xf6, 0xd8, 0x01, 0x67, 0x62, 0xc0, 0x5c, 0xff, 0x00, 0x36, 0x01, 0xcc,
-	0xaf, 0xbb, 0x04, 0x02, 0x81, 0xf0, 0x23, 0x5f, 0xfb, 0x0b, 0x06, 0x47, 0xc4, 0x31, 0xd7, 0x2e,
-	0x6d, 0xa3, 0x48, 0xcb, 0x78, 0x77, 0x7c, 0xbd, 0xf5, 0xdd, 0xdd, 0xcb, 0xee, 0x5e, 0x9b, 0x1b,
-	0xeb, 0x4a, 0x4d, 0x2a, 0x55, 0x55, 0x1f, 0xaa, 0xb6, 0x91, 0xaa, 0x4a, 0x6d, 0xd4, 0x20, 0xf5,
-	0xa1, 0x7d, 0x40, 0x6a, 0xda, 0xbc, 0x54, 0xfd, 0x07, 0xf2, 0x88, 0xd2, 0x3e, 0x44, 0x7d, 0x88,
-	0x2a, 0xa8, 0xd4, 0x7f, 0xa3, 0xda, 0x99, 0x33, 0x7b, 0x67, 0x77, 0x67, 0xaf, 0x6f, 0x30, 0x7d,
-	0xb1, 0xee, 0xce, 0x9c, 0x33, 0xe7, 0x33, 0xe7, 0x9c, 0x39, 0x33, 0xf3, 0x19, 0xa3, 0x51, 0xd7,
-	0xb7, 0x88, 0x11, 0x46, 0x7e, 0x40, 0xaa, 0xd4, 0x78, 0xd0, 0xa0, 0x41, 0xb3, 0x5c, 0x0f, 0xfc,
-	0xc8, 0xc7, 0x43, 0x71, 0x4f, 0x19, 0x7a, 0x4a, 0x2f, 0x13, 0xd7, 0xf1, 0x7c, 0x83, 0xfd, 0xe5,
-	0x02, 0xa5, 0x93, 0x96, 0x1f, 0xba, 0x7e, 0x68, 0x2c, 0x93, 0x10, 0x34, 0x8d, 0xf5, 0xd7, 0x97,
-	0x69, 0x44, 0x5e, 0x37, 0xea, 0xa4, 0xea, 0x78, 0x24, 0x72, 0x7c, 0x0f, 0x64, 0x0f, 0x72, 0x59,
-	0x93, 0x7d, 0x19, 0xfc, 0x03, 0xba, 0x46, 0xaa, 0x7e, 0xd5, 0xe7, 0xed, 0xf1, 0x2f, 0x68, 0x1d,
-	0xaf, 0xfa, 0x7e, 0xb5, 0x46, 0x0d, 0x52, 0x77, 0x0c, 0xe2, 0x79, 0x7e, 0xc4, 0x46, 0x13, 0x3a,
-	0xe3, 0x0c, 0x75, 0x9d, 0x06, 0xae, 0x13, 0x86, 0x8e, 0xef, 0x19, 0x96, 0xef, 0xba, 0x89, 0xb1,
-	0xb1, 0x6c, 0x6f, 0xd4, 0xac, 0x53, 0xa1, 0x7a, 0x30, 0x35, 0xe1, 0x3a, 0x09, 0x88, 0x2b, 0xba,
-	0xd2, 0xbe, 0x90, 0x95, 0x26, 0x58, 0xcf, 0xba, 0x13, 0x44, 0x0d, 0x52, 0xab, 0x06, 0x7e, 0xa3,
-	0x2e, 0x77, 0xeb, 0x23, 0x08, 0xdf, 0x8d, 0xe7, 0xbf, 0xc0, 0x46, 0xab, 0xd0, 0x07, 0x0d, 0x1a,
-	0x46, 0xfa, 0x3c, 0x7a, 0x25, 0xd5, 0x1a, 0xd6, 0x7d, 0x2f, 0xa4, 0xf8, 0x4d, 0xd4, 0xc7, 0xad,
-	0x8e, 0x6a, 0x87, 0xb5, 0xa9, 0xc1, 0x73, 0x23, 0x65, 0xd9, 0xd1, 0x65, 0x2e, 0x3d, 0x33, 0xf0,
-	0xe5, 0x37, 0x87, 0x76, 0xfc, 0xe1, 0x3f, 0x7f, 0x3a, 0xa9, 0x55, 0x40, 0x5c, 0xbf, 0x86, 0x26,
-	0xa4, 0xf1, 0x66, 0x9a, 0x4b, 0x8e, 0x4b, 0xc3, 0x88, 0xb8, 0x75, 0x30, 0x88, 0xc7, 0xd1, 0x40,
-	0x24, 0xda, 0xd8, 0xe0, 0xbd, 0x95, 0x76, 0x83, 0xfe, 0x7d, 0x34, 0x59, 0xa4, 0xbe, 0x5d, 0x64,
-	0x97, 0xd1, 0x7e, 0x36, 0xf4, 0x6d, 0x4a, 0xec, 0x99, 0x86, 0xb5, 0x46, 0x23, 0x01, 0xe9, 0x10,
-	0x1a, 0x5c, 0x66, 0x0d, 0xa6, 0x47, 0x5c, 0xca, 0xc6, 0x1d, 0xa8, 0x20, 0xde, 0x34, 0x4f, 0x5c,
-	0xaa, 0x5f, 0x46, 0xa5, 0x8c, 0xea, 0x4c, 0x73, 0xce, 0x16, 0xea, 0x63, 0x68, 0x00, 0xd4, 0x1d,
-	0x1b, 0x94, 0xfb, 0x79, 0xc3, 0x9c, 0xad, 0xff, 0x52, 0x43, 0x07, 0x72, 0x66, 0x61, 0x2a, 0x97,
-	0x13, 0xbb, 0x8e, 0xb7, 0xe2, 0xc3, 0x7c, 0x46, 0xd3, 0xf3, 0xe1, 0x2a, 0x73, 0xde, 0x8a, 0x2f,
-	0x10, 0xc5, 0xbf, 0xf1, 0x55, 0x84, 0xe8, 0xc3, 0x28, 0x20, 0x5c, 0xb3, 0x87, 0x69, 0x4e, 0xa8,
-	0x34, 0x6f, 0xc6, 0x52, 0x4c, 0x7d, 0x80, 0x8a, 0x9f, 0xfa, 0xfb, 0x92, 0x2b, 0xde, 0x5b, 0xfe,
-	0x01, 0xb5, 0xba, 0x76, 0x45, 0x2c, 0xe0, 0x33, 0x0d, 0x2e, 0xd0, 0xc3, 0x05, 0x78, 0x53, 0xce,
-	0x57, 0x7c, 0xec, 0x8c, 0xaf, 0x40, 0xbd, 0xed, 0x2b, 0xde, 0x30, 0x67, 0xeb, 0xf7, 0xd1, 0x78,
-	0xa2, 0xba, 0xb8, 0x4a, 0x6c, 0x7f, 0xe3, 0x45, 0x83, 0x7b, 0x2c, 0x47, 0x43, 0x0c, 0xde, 0x8e,
-	0x86, 0x80, 0x56, 0x18, 0x0d, 0xae, 0xc2, 0xa3, 0xe1, 0x27, 0xbf, 0xf1, 0x77, 0xd1, 0x48, 0xb5,
-	0xe6, 0x2f, 0x93, 0x9a, 0x09, 0xab, 0xcf, 0x64, 0xcb, 0x0f, 0xe2, 0x72, 0x94, 0x8f, 0x21, 0x2f,
-	0xcc, 0xf2, 0x2d, 0x26, 0x7e, 0x8f, 0x37, 0xdd, 0x8a, 0x9b, 0x2a, 0xb8, 0x9a, 0x6b, 0xd3, 0xef,
-	0xc3, 0x6a, 0xca, 0x7b, 0x04, 0x40, 0xbf, 0xad, 0x02, 0x3d, 0x99, 0x06, 0x2d, 0x2b, 0x66, 0xa1,
-	0xeb, 0x04, 0x1c, 0x72, 0xc7, 0x09, 0x23, 0x9e, 0x31, 0xa2, 0x34, 0xe0, 0x59, 0x84, 0xda, 0x25,
-	0x12, 0x86, 0x3e, 0x56, 0x86, 0xb2, 0x18, 0xd7, 0xd3, 0x32, 0xaf, 0xc4, 0x50, 0x4f, 0xcb, 0x0b,
-	0xa4, 0x4a, 0x41, 0xb7, 0x22, 0x69, 0xea, 0x9f, 0x69, 0x68, 0x34, 0x6f, 0x03, 0x26, 0x70, 0x05,
-	0x0d, 0x49, 0x6b, 0x20, 0x5e, 0xd4, 0xbd, 0x1d, 0x17, 0xc1, 0x60, 0x7b, 0x11, 0x84, 0xf8, 0x56,
-	0x0a, 0x21, 0xf7, 0xf6, 0xf1, 0x2d, 0x11, 0x72, 0xcb, 0x29, 0x88, 0x1f, 0x6b, 0x92, 0x1b, 0xb8,
-	0xa7, 0x5e, 0xb4, 0x1b, 0xb2, 0xd9, 0xdb, 0x93, 0xab, 0x32, 0x3f, 0xd1, 0xd0, 0x91, 0x2c, 0x88,
-	0x99, 0x26, 0xcc, 0xdd, 0x7e, 0xd1, 0x70, 0x52, 0x55, 0xab, 0x27, 0x53, 0xb5, 0x52, 0x21, 0x4b,
-	0xfc, 0xd1, 0x0e, 0x99, 0x94, 0x73, 0x05, 0x21, 0x93, 0xd2, 0x6d, 0xb0, 0x9d, 0x6e, 0x2f, 0x30,
-	0x64, 0xa7, 0xd1, 0x5e, 0x86, 0x70, 0x7e, 0x76, 0x49, 0xb8, 0xe6, 0x20, 0xea, 0x8f, 0xfc, 0x35,
-	0xea, 0xb5, 0x6b, 0xcb, 0x6e, 0xf6, 0x3d, 0x67, 0xeb, 0x8b, 0x50, 0xf1, 0xb8, 0x37, 0x99, 0x4e,
-	0xb2, 0xec, 0x07, 0x5c, 0x1a, 0x11, 0xd3, 0x26, 0x11, 0x01, 0x77, 0x8e, 0xab, 0xb2, 0xef, 0x5d,
-	0x1a, 0x91, 0x1b, 0x24, 0x22, 0x95, 0x7e, 0x17, 0x7e, 0x25, 0x83, 0xf2, 0xb9, 0x7e, 0xbb, 0x41,
-	0xb9, 0x8e, 0x62, 0xd0, 0xbb, 0x68, 0x1f, 0x1b, 0x94, 0x15, 0x00, 0x79, 0xcc, 0x4b, 0xf9, 0x31,
-	0xc7, 0xd2, 0x63, 0x32, 0x15, 0xc5, 0x90, 0x1f, 0x69, 0x50, 0x58, 0x17, 0xfc, 0x9a, 0x63, 0x35,
-	0x67, 0xfd, 0x60, 0xda, 0xb2, 0xfc, 0x86, 0x97, 0x14, 0xd6, 0x12, 0xea, 0x0f, 0x68, 0xe8, 0x37,
-	0x02, 0x4b, 0x54, 0xd5, 0xe4, 0x1b, 0xdf, 0x44, 0x2f, 0xd7, 0x03, 0xc7, 0xb3, 0x9c, 0x3a, 0xa9,
-	0x99, 0xc4, 0xb6, 0x03, 0x1a, 0x86, 0x3c, 0x5f, 0x66, 0x46, 0xbf, 0xfa, 0xe2, 0xcc, 0x08, 0x84,
-	0x6e, 0x9a, 0xf7, 0x2c, 0x46, 0x81, 0xe3, 0x55, 0x2b, 0xc3, 0x89, 0x0a, 0xb4, 0xeb, 0x0b, 0xe2,
-	0x5c, 0x90, 0x83, 0x00, 0xd3, 0x33, 0x50, 0x5f, 0x9d, 0xf5, 0xc1, 0xdc, 0x0e, 0xf0, 0xb9, 0xb5,
-	0x0f, 0x48, 0x65, 0xae, 0x5a, 0x01, 0x31, 0xfd, 0x1f, 0x62, 0x56, 0xf7, 0x68, 0xe0, 0xac, 0x34,
-	0x17, 0x12, 0x41, 0x31, 0xab, 0x37, 0x50, 0xbf, 0x5f, 0xa7, 0x01, 0x89, 0xfc, 0x80, 0xcf, 0xaa,
-	0x03, 0xe0, 0x44, 0x72, 0xcb, 0x65, 0x9a, 0xdd, 0x64, 0x7a, 0xb3, 0x9b, 0x0c, 0xbe, 0x8a, 0x06,
-	0x89, 0x15, 0xe7, 0xa8, 0x19, 0x1f, 0xbf, 0x46, 0x77, 0x1e, 0xd6, 0xa6, 0xf6, 0x88, 0x50, 0x49,
-	0xd3, 0x99, 0x66, 0x32, 0x4b, 0xcd, 0x3a, 0xad, 0x20, 0x92, 0xfc, 0x4e, 0x1c, 0x95, 0x9f, 0x55,
-	0xdb, 0x51, 0x74, 0x65, 0x85, 0x5a, 0x11, 0x9b, 0xd4, 0x1e, 0x85, 0xa3, 0x6e, 0xb2, 0xee, 0x0a,
-	0x88, 0xe9, 0x0f, 0x20, 0xa3, 0xe2, 0x4d, 0x84, 0x6f, 0x35, 0xe0, 0xa0, 0xcb, 0x68, 0x90, 0xed,
-	0x46, 0xa6, 0xbf, 0xe1, 0xd1, 0xad, 0x7d, 0x84, 0x98, 0xf0, 0x7b, 0xb1, 0x2c, 0x9e, 0x40, 0xfc,
-	0x4b, 0x76, 0xd2, 0x00, 0x6b, 0x61, 0xa5, 0x6c, 0x41, 0x3a, 0x60, 0x80, 0x49, 0x40, 0x7f, 0x51,
-	0x28, 0x4a, 0xfb, 0xd5, 0x01, 0x45, 0x1a, 0xf3, 0x23, 0x4b, 0x55, 0xfc, 0xd4, 0x7f, 0xa3, 0xc1,
-	0x90, 0x71, 0x45, 0x62, 0x12, 0x2f, 0xbc, 0x40, 0x67, 0xdc, 0xd1, 0xd3, 0xbd, 0x3b, 0xf4, 0xdf,
-	0xca, 0xfb, 0x87, 0x40, 0x07, 0x33, 0xbe, 0xa5, 0x80, 0xf7, 0x3c, 0x15, 0x0f, 0x5f, 0x12, 0xf8,
-	0x78, 0xd9, 0xed, 0x61, 0x65, 0xb7, 0xd0, 0x77, 0x28, 0xf1, 0x5d, 0xa8, 0xff, 0x51, 0x43, 0x63,
-	0xe9, 0x78, 0xbc, 0x4b, 0xdd, 0x65, 0x1a, 0x08, 0x0f, 0x9e, 0x45, 0x7d, 0x2e, 0x6b, 0xd8, 0x32,
-	0x07, 0x40, 0x6e, 0x1b, 0xbe, 0xca, 0xa4, 0x4e, 0x6f, 0x36, 0x75, 0x4c, 0xe9, 0x10, 0x98, 0x82,
-	0x9a, 0x9c, 0x78, 0x86, 0xb8, 0xba, 0x84, 0x38, 0xa9, 0xae, 0xd2, 0x22, 0x90, 0x75, 0x39, 0x56,
-	0xfe, 0xa1, 0xaf, 0xc0, 0x01, 0x35, 0xa9, 0x44, 0xa9, 0x35, 0xd1, 0xa9, 0x14, 0x9e, 0x46, 0xb8,
-	0x5d, 0x0a, 0x21, 0x14, 0x62, 0xef, 0x6c, 0x57, 0x3c, 0x1e, 0x02, 0x5b, 0x9f, 0x07, 0x9f, 0x67,
-	0xed, 0x3c, 0x6f, 0xbd, 0xbb, 0x00, 0x0b, 0x80, 0x37, 0x67, 0x0e, 0xd5, 0x5c, 0x46, 0x3a, 0x54,
-	0xf3, 0x86, 0x39, 0x5b, 0x7f, 0x07, 0x32, 0x53, 0x56, 0x7b, 0x5e, 0x08, 0x9f, 0x6a, 0x70, 0x5b,
-	0xbc, 0xe3, 0x5b, 0x6b, 0xb3, 0x94, 0xb6, 0x57, 0x60, 0xec, 0x18, 0x97, 0x04, 0x4d, 0x33, 0xac,
-	0x27, 0x9b, 0x84, 0xd6, 0xc5, 0x26, 0x11, 0xeb, 0x2c, 0xd6, 0xa1, 0x3d, 0x9e, 0x88, 0x15, 0x50,
-	0x12, 0x51, 0x93, 0x44, 0xcc, 0xaf, 0xbd, 0x95, 0x7e, 0xde, 0x30, 0x1d, 0xe1, 0x23, 0x68, 0xa8,
-	0x4e, 0x9a, 0x35, 0x9f, 0xd8, 0x66, 0xe8, 0x7c, 0xc8, 0x33, 0x67, 0x67, 0x65, 0x10, 0xda, 0x16,
-	0x9d, 0x0f, 0xa9, 0x7e, 0x1f, 0x8d, 0xa4, 0xe1, 0xc1, 0x44, 0x6f, 0xa3, 0x3e, 0xe2, 0xc6, 0xbb,
-	0x0d, 0x60, 0x3a, 0x1b, 0xdf, 0x0e, 0xff, 0xf9, 0xcd, 0xa1, 0x7d, 0x1c, 0x57, 0x68, 0xaf, 0x95,
-	0x1d, 0xdf, 0x70, 0x49, 0xb4, 0x5a, 0x9e, 0xf3, 0xa2, 0xaf, 0xbe, 0x38, 0x83, 0x00, 0xf0, 0x9c,
-	0x17, 0xc1, 0x25, 0x92, 0xeb, 0xeb, 0xd7, 0xa5, 0x85, 0x24, 0x5d, 0xb0, 0xba, 0xbe, 0x49, 0xca,
-	0xd9, 0x9d, 0xd2, 0x4f, 0xb2, 0x5b, 0xbe, 0xd7, 0xf1, 0xb0, 0x1c, 0x4e, 0x2f, 0xf1, 0x39, 0x2f,
-	0xa2, 0x81, 0x47, 0x6a, 0xd2, 0xa1, 0x58, 0xba, 0xda, 0x5d, 0x83, 0xec, 0x9e, 0x0b, 0x17, 0x02,
-	0xc7, 0xa2, 0xff, 0xbf, 0x4a, 0xbc, 0x2a, 0xb5, 0xbb, 0xc6, 0xf7, 0xf9, 0x6e, 0x98, 0x60, 0x56,
-	0x1f, 0xf0, 0x8d, 0xa2, 0xdd, 0x16, 0x6f, 0x62, 0xca, 0xfd, 0x15, 0xf1, 0x89, 0x6d, 0x84, 0xad,
-	0x46, 0x10, 0x50, 0x2f, 0x32, 0x03, 0x4a, 0x6c, 0xb3, 0x1e, 0xab, 0x43, 0x61, 0xb8, 0x08, 0xfe,
-	0x1e, 0xcb, 0xfb, 0xfb, 0x0e, 0xad, 0x12, 0xab, 0x79, 0x83, 0x5a, 0x92, 0xd7, 0x6f, 0x50, 0x8b,
-	0x7b, 0x7d, 0x18, 0x46, 0xac, 0x50, 0x62, 0x33, 0x38, 0xb8, 0x81, 0xc6, 0x84, 0x95, 0x24, 0xe3,
-	0x22, 0x3f, 0xa0, 0x60, 0xae, 0x77, 0x5b, 0xe6, 0x46, 0x61, 0xe8, 0x05, 0xc8, 0xcb, 0x78, 0x60,
-	0x6e, 0xb6, 0x89, 0x26, 0x84, 0xd9, 0x90, 0x5a, 0xbe, 0x67, 0x67, 0x0d, 0xef, 0xdc, 0x96, 0xe1,
-	0x12, 0x0c, 0xbe, 0x28, 0xc6, 0x96, 0x4c, 0x87, 0x48, 0xf4, 0x9a, 0xeb, 0xa4, 0xe6, 0xd8, 0xf1,
-	0x21, 0xc5, 0x8c, 0xc8, 0x43, 0x33, 0x20, 0x11, 0x1d, 0xdd, 0xb5, 0x2d, 0xbb, 0x07, 0x60, 0xe4,
-	0x7b, 0x62, 0xe0, 0x25, 0xf2, 0xb0, 0x42, 0x22, 0x8a, 0x3f, 0x40, 0x7b, 0x3c, 0xba, 0x21, 0x07,
-	0xb2, 0x6f, 0x5b, 0x86, 0x86, 0x3c, 0xba, 0xd1, 0x0e, 0xa2, 0x8b, 0x0e, 0xc4, 0xa3, 0xab, 0x02,
-	0xb8, 0x7b, 0x5b, 0x66, 0x46, 0x3c, 0xba, 0x91, 0x0f, 0xde, 0x03, 0x74, 0x30, 0x36, 0xa7, 0x0e,
-	0x5c, 0xff, 0xb6, 0x0c, 0xee, 0xf7, 0xe8, 0x86, 0x2a, 0x68, 0x6b, 0x28, 0xee, 0x51, 0x05, 0x6c,
-	0x60, 0x5b, 0xf6, 0x5e, 0xf1, 0xe8, 0x46, 0x36, 0x58, 0x49, 0x4d, 0xba, 0xdb, 0xf0, 0x23, 0xfa,
-	0x9d, 0xba, 0x4d, 0x22, 0xba, 0xe4, 0xb8, 0xb4, 0xeb, 0x35, 0x7f, 0x05, 0x6a, 0x52, 0x4e, 0x1f,
-	0xd6, 0xfc, 0x18, 0x1a, 0x68, 0xb0, 0xd6, 0xb8, 0x2a, 0xf7, 0xf1, 0xaa, 0xcc, 0x1b, 0xa6, 0x23,
-	0xdd, 0x83, 0xe3, 0xaa, 0xb4, 0xdd, 0x86, 0x37, 0x1f, 0x3a, 0x61, 0x24, 0x5d, 0xca, 0x92, 0xad,
-	0x12, 0x2e, 0x65, 0xfc, 0x64, 0x62, 0xe3, 0x73, 0x68, 0x37, 0xdf, 0xc4, 0xf9, 0x61, 0xa6, 0xd3,
-	0x5e, 0x21, 0x04, 0xf5, 0xc7, 0x1a, 0x30, 0x84, 0x0a, 0x83, 0x80, 0x77, 0x01, 0xf5, 0xd1, 0xb8,
-	0x41, 0xdc, 0x4c, 0x2f, 0xa5, 0xeb, 0x67, 0x67, 0xed, 0x32, 0xfb, 0x0a, 0x6f, 0x7a, 0x51, 0xd0,
-	0xac, 0xc0, 0x38, 0xa5, 0xcb, 0x68, 0x50, 0x6a, 0xc6, 0xc3, 0xa8, 0x77, 0x8d, 0x36, 0x61, 0x36,
-	0xf1, 0x4f, 0x3c, 0x82, 0x76, 0xad, 0x93, 0x5a, 0x83, 0xd7, 0xbb, 0xfe, 0x0a, 0xff, 0x78, 0xab,
-	0xe7, 0x92, 0xa6, 0x37, 0x60, 0xfb, 0xe5, 0x87, 0xc2, 0x94, 0x67, 0xb6, 0x71, 0xfc, 0x3e, 0x24,
-	0x54, 0xe3, 0x90, 0x82, 0xf7, 0x40, 0x20, 0x0e, 0x69, 0xa8, 0xbf, 0x05, 0x39, 0x21, 0x99, 0xcd,
-	0x9c, 0x18, 0x44, 0x50, 0xb8, 0x97, 0x06, 0x2a, 0xfd, 0x10, 0x95, 0x50, 0x7f, 0x24, 0x2e, 0xff,
-	0x29, 0xcc, 0xe0, 0xdc, 0x77, 0x32, 0xce, 0x3d, 0x57, 0xe4, 0xdc, 0xff, 0xad, 0x5b, 0x9f, 0x68,
-	0xe8, 0x0c, 0x10, 0xc5, 0x4d, 0x97, 0x7a, 0x11, 0xdc, 0x26, 0xf9, 0x9e, 0x38, 0x5b, 0xf3, 0x37,
-	0xe2, 0x95, 0x71, 0xc7, 0x71, 0x9d, 0xc4, 0xdb, 0xd3, 0x68, 0x6f, 0x9d, 0xcb, 0x9a, 0x84, 0x0b,
-	0x6f, 0xe9, 0xf1, 0x3d, 0xf5, 0xd4, 0xe0, 0x12, 0x57, 0xd5, 0xdd, 0xa9, 0x17, 0xd6, 0x5d, 0x12,
-	0x32, 0x79, 0x19, 0xf6, 0xe6, 0x96, 0xe1, 0x23, 0x0d, 0x95, 0xbb, 0x9d, 0x12, 0x04, 0x63, 0x1f,
-	0xea, 0x73, 0x42, 0x33, 0xa4, 0x11, 0x6c, 0xc6, 0xbb, 0x9c, 0x70, 0x91, 0x46, 0xf8, 0x7b, 0x68,
-	0xef, 0x4a, 0xcd, 0xdf, 0x60, 0x05, 0xc7, 0xac, 0xc5, 0x1a, 0x00, 0xf5, 0xdb, 0x9f, 0x7b, 0x5e,
-	0x5a, 0x91, 0x0d, 0x9f, 0xfb, 0x5a, 0x47, 0xbb, 0x18, 0x46, 0xbc, 0x86, 0xfa, 0x38, 0xd5, 0x8e,
-	0x0f, 0x2b, 0x32, 0x20, 0xf5, 0xc6, 0x50, 0x3a, 0xd2, 0x41, 0x82, 0xcf, 0x44, 0x1f, 0xff, 0xf8,
-	0xef, 0xff, 0xfe, 0xa4, 0x67, 0x3f, 0x1e, 0x31, 0x14, 0x2f, 0x1f, 0xf8, 0x53, 0x71, 0xf9, 0xcb,
-	0x3d, 0x0b, 0xe0, 0x53, 0x85, 0x63, 0xe7, 0xdf, 0x1e, 0x4a, 0xa7, 0xbb, 0x13, 0x06, 0x4c, 0x53,
-	0x0c, 0x93, 0x8e, 0x0f, 0xab, 0x30, 0x19, 0x9b, 0xc9, 0xa3, 0x45, 0x0b, 0xff, 0x54, 0x43, 0xa8,
-	0x7d, 0xa2, 0xc3, 0xaf, 0x29, 0xcc, 0xe4, 0x5e, 0x1d, 0x4a, 0x47, 0xb7, 0x90, 0x02, 0x14, 0x06,
-	0x43, 0x71, 0x02, 0x1f, 0x4f, 0xa3, 0x58, 0x8d, 0xb7, 0x66, 0x9e, 0x3d, 0xc6, 0xa6, 0x94, 0x58,
-	0x2d, 0xfc, 0x2b, 0x0d, 0xed, 0x49, 0x3f, 0x54, 0xe0, 0xa9, 0x8e, 0xa6, 0xa4, 0xc2, 0xd0, 0x2d,
-	0xa8, 0xf3, 0x0c, 0xd4, 0x19, 0x7c, 0xaa, 0x10, 0x94, 0xb9, 0x1c, 0xdf, 0x46, 0x12, 0x68, 0x8e,
-	0xdd, 0xc2, 0x3f, 0xd6, 0xd0, 0x4b, 0xed, 0xb1, 0xe6, 0x67, 0x97, 0xf0, 0x84, 0xc2, 0x5a, 0x9b,
-	0xcf, 0x2b, 0xa9, 0xfc, 0x98, 0x23, 0xf0, 0xf4, 0xb3, 0x0c, 0xcb, 0x49, 0x3c, 0x55, 0x8c, 0xc5,
-	0x5b, 0x89, 0x8c, 0x4d, 0x41, 0x0d, 0xb6, 0xf0, 0xaf, 0x21, 0x5c, 0x9c, 0x83, 0x2b, 0x0c, 0x57,
-	0xea, 0xf1, 0xa1, 0xd0, 0x33, 0x69, 0x42, 0x5e, 0xbf, 0xc6, 0xd0, 0xbc, 0x89, 0x2f, 0x28, 0xd0,
-	0x70, 0x8e, 0x28, 0x1d, 0x2e, 0x63, 0x53, 0x22, 0x93, 0xda, 0xc1, 0x6b, 0xbf, 0x9c, 0x14, 0x06,
-	0x2f, 0xf7, 0xb8, 0xd2, 0x2d, 0xc4, 0x4e, 0xc1, 0x03, 0x30, 0x10, 0xbc, 0xe4, 0xa9, 0xa6, 0x85,
-	0x3f, 0xd7, 0xd0, 0x70, 0xf6, 0x15, 0x02, 0x9f, 0x2c, 0x30, 0xa8, 0x78, 0xbc, 0x29, 0x9d, 0xea,
-	0x4a, 0x16, 0x20, 0xde, 0x60, 0x10, 0xaf, 0xe3, 0xab, 0x0a, 0x88, 0x21, 0x53, 0xe8, 0xc6, 0x99,
-	0x22, 0xe1, 0x12, 0x7e, 0xf6, 0x79, 0x12, 0x2e, 0x47, 0xee, 0x76, 0x4c, 0x38, 0x61, 0x3f, 0x9d,
-	0x70, 0x3f, 0xd4, 0xd0, 0xa0, 0xf4, 0xf8, 0x81, 0x55, 0x81, 0xca, 0x3f, 0xc0, 0x94, 0x8e, 0x6d,
-	0x25, 0x06, 0x80, 0x74, 0x06, 0x68, 0x1c, 0x97, 0xd2, 0x80, 0x6a, 0x4e, 0x18, 0xc1, 0x0a, 0x08,
-	0xf1, 0xcf, 0x00, 0x02, 0x90, 0xf9, 0x85, 0x10, 0xd2, 0x8f, 0x1f, 0x85, 0x10, 0x32, 0x6f, 0x02,
-	0x45, 0x3e, 0x61, 0x10, 0xb8, 0x4f, 0xc2, 0x4c, 0x99, 0x7a, 0xac, 0xa1, 0x7d, 0xca, 0x87, 0x0e,
-	0x6c, 0x74, 0xb6, 0x99, 0x7b, 0x12, 0xe9, 0x1a, 0xe4, 0x15, 0x06, 0xf2, 0x02, 0x3e, 0x5f, 0x0c,
-	0x32, 0xce, 0xfc, 0xa4, 0x64, 0xa5, 0xaa, 0xd7, 0x8f, 0x34, 0x34, 0x94, 0x70, 0x52, 0x5d, 0xe4,
-	0xd2, 0xab, 0x45, 0x07, 0x23, 0x39, 0x95, 0x3a, 0x15, 0x77, 0x38, 0xe0, 0xa5, 0x33, 0xe9, 0xaf,
-	0x1a, 0x90, 0xb9, 0x59, 0x1e, 0x5d, 0xb9, 0x16, 0x0b, 0xf8, 0x7e, 0xe5, 0x5a, 0x2c, 0x22, 0xe6,
-	0xf5, 0x5b, 0x0c, 0xe3, 0x34, 0x7e, 0x3b, 0xb3, 0x0d, 0x72, 0xc6, 0x69, 0xc5, 0x0f, 0xc4, 0x79,
-	0xca, 0xd8, 0x14, 0x4c, 0x59, 0xcb, 0xd8, 0xcc, 0xbd, 0x19, 0xb4, 0xf0, 0xdf, 0x34, 0x34, 0x9c,
-	0x65, 0xb5, 0x95, 0xb0, 0x0b, 0x08, 0x7d, 0x25, 0xec, 0x22, 0x9a, 0x5c, 0x9f, 0x67, 0xb0, 0x6f,
-	0xe3, 0xd9, 0x34, 0xec, 0x75, 0x26, 0x6f, 0x4a, 0xff, 0x77, 0xb1, 0x29, 0xa8, 0xff, 0x56, 0xb6,
-	0x98, 0x48, 0x2c, 0x7e, 0x0b, 0x7f, 0xa2, 0xa1, 0x81, 0x24, 0xfe, 0xf8, 0xd5, 0x82, 0x6a, 0x26,
-	0x73, 0x89, 0xa5, 0xd7, 0x3a, 0x0b, 0x75, 0xce, 0xca, 0x76, 0x0e, 0x18, 0x9b, 0xd2, 0x35, 0xa1,
-	0x25, 0xbe, 0xf8, 0x2a, 0x8a, 0x4f, 0x1e, 0x6d, 0xce, 0x59, 0xb9, 0x95, 0xe5, 0x08, 0xf3, 0xd2,
-	0xd1, 0x2d, 0xa4, 0x3a, 0x27, 0x27, 0x5b, 0x2e, 0x0c, 0x43, 0x98, 0x46, 0x86, 0x7f, 0xa1, 0xa1,
-	0xbd, 0x19, 0xda, 0x16, 0x9f, 0xe8, 0xe4, 0x83, 0x14, 0x0b, 0x5d, 0x3a, 0xd9, 0x8d, 0x28, 0x60,
-	0x3b, 0xce, 0xb0, 0x1d, 0xc1, 0x87, 0x0a, 0x17, 0x0e, 0x10, 0xd5, 0x7f, 0x16, 0x94, 0x65, 0x9a,
-	0x86, 0x55, 0xee, 0xaa, 0x4a, 0x46, 0xb8, 0x74, 0xa2, 0x0b, 0x49, 0x40, 0x35, 0xcb, 0x50, 0xfd,
-	0x1f, 0xbe, 0x5e, 0xb8, 0x54, 0x20, 0xa0, 0xca, 0x85, 0x22, 0x6e, 0x64, 0xad, 0xb8, 0x58, 0xef,
-	0xcd, 0x90, 0xb6, 0xca, 0xd0, 0xe6, 0xa8, 0x60, 0x65, 0x68, 0xf3, 0xcc, 0xaf, 0x5e, 0x66, 0x40,
-	0xa7, 0xf0, 0x31, 0x25, 0x50, 0xd8, 0xfd, 0x13, 0x4e, 0xb9, 0x85, 0x1b, 0x68, 0x48, 0x26, 0x56,
-	0xb1, 0xea, 0x44, 0x9f, 0xe6, 0x84, 0x4b, 0x7a, 0x27, 0x11, 0x80, 0x31, 0xc9, 0x60, 0x8c, 0xe2,
-	0xfd, 0x99, 0x0c, 0xf3, 0xad, 0x35, 0x73, 0x85, 0x52, 0xfc, 0x3b, 0x48, 0x28, 0x89, 0x29, 0x2d,
-	0x4c, 0xa8, 0x3c, 0x1b, 0x5b, 0x98, 0x50, 0x0a, 0xe2, 0x55, 0xbf, 0xc0, 0xa0, 0x18, 0xf8, 0x4c,
-	0xf1, 0x29, 0x92, 0x91, 0xac, 0x99, 0x5d, 0xec, 0x33, 0x91, 0x5e, 0x69, 0xbe, 0x54, 0x99, 0x5e,
-	0x4a, 0x4a, 0x56, 0x99, 0x5e, 0x6a, 0xf2, 0x55, 0x7f, 0x83, 0x61, 0x2c, 0xe3, 0xd3, 0x69, 0x8c,
-	0x4e, 0xc8, 0xb9, 0x2c, 0x13, 0xa8, 0xd8, 0x0c, 0xc4, 0xdf, 0x6b, 0xc0, 0x8a, 0xdf, 0x6d, 0xf8,
-	0x11, 0x69, 0xf3, 0x3b, 0x4a, 0x4f, 0xaa, 0x39, 0x24, 0xa5, 0x27, 0x0b, 0xe8, 0xa2, 0x22, 0x4f,
-	0x3e, 0x88, 0xad, 0x9b, 0x40, 0x24, 0xc5, 0x77, 0xa7, 0x0c, 0xcc, 0xbf, 0x88, 0x3b, 0x5e, 0x8e,
-	0x9a, 0x51, 0xde, 0xf1, 0x8a, 0xf8, 0x26, 0xe5, 0x1d, 0xaf, 0x90, 0xed, 0xd1, 0xaf, 0x33, 0xb0,
-	0x97, 0xf0, 0xc5, 0x34, 0x58, 0xb9, 0x84, 0x84, 0x26, 0xa3, 0x2b, 0x44, 0xad, 0x73, 0xec, 0x96,
-	0xb1, 0x09, 0x3d, 0x2d, 0xfc, 0x48, 0x43, 0xc3, 0x59, 0xce, 0x43, 0x79, 0xb6, 0xca, 0xf3, 0x3f,
-	0xca, 0x63, 0x8b, 0x82, 0x3a, 0xe9, 0x88, 0x31, 0x03, 0x2e, 0xbf, 0x45, 0x84, 0xad, 0x78, 0x15,
-	0x8d, 0xa8, 0xc8, 0x20, 0x65, 0x02, 0xa8, 0x09, 0xa3, 0xae, 0xb1, 0x16, 0xa4, 0xa8, 0x8c, 0x55,
-	0x94, 0x97, 0x84, 0x80, 0x6a, 0xe1, 0x8f, 0x7a, 0xd0, 0xb1, 0xee, 0xa8, 0x0f, 0x7c, 0x45, 0x79,
-	0x85, 0xef, 0x8e, 0x03, 0x2a, 0x5d, 0x7d, 0x3e, 0x65, 0x98, 0xdb, 0x07, 0x6c, 0x6e, 0xf7, 0xf0,
-	0x52, 0x96, 0x0f, 0x48, 0xb1, 0x4a, 0xa2, 0x5a, 0x64, 0x18, 0x18, 0x63, 0x33, 0x23, 0x97, 0x39,
-	0x6d, 0xcc, 0xcc, 0x7e, 0xf9, 0x74, 0x52, 0x7b, 0xf2, 0x74, 0x52, 0xfb, 0xd7, 0xd3, 0x49, 0xed,
-	0xe7, 0xcf, 0x26, 0x77, 0x3c, 0x79, 0x36, 0xb9, 0xe3, 0xeb, 0x67, 0x93, 0x3b, 0xde, 0x3f, 0x5d,
-	0x75, 0xa2, 0xd5, 0xc6, 0x72, 0xd9, 0xf2, 0x5d, 0x66, 0xd9, 0x5a, 0x25, 0x8e, 0xc7, 0x31, 0xac,
-	0x9f, 0x33, 0x1e, 0xa6, 0xff, 0x17, 0x74, 0xb9, 0x8f, 0xfd, 0xb7, 0xe7, 0xf9, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x6f, 0xbb, 0x5a, 0xeb, 0x34, 0x2b, 0x00, 0x00,
+func (m *QueryPaymentAuditRequest) Reset()         { *m = QueryPaymentAuditRequest{} }
+func (m *QueryPaymentAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentAuditRequest) ProtoMessage()    {}
+func (*QueryPaymentAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{56}
+}
+func (m *QueryPaymentAuditRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentAuditRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentAuditRequest.Merge(m, src)
+}
+func (m *QueryPaymentAuditRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentAuditRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPaymentAuditRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Parameters queries the parameters of the module.
-	QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
-	// Queries a bucket with specify name.
-	HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
-	// Queries a bucket by id
-	HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
-	// Queries a bucket with EIP712 standard metadata info
-	HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error)
-	// Queries a object with specify name.
-	HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
-	// Queries an object by id
-	HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
-	// Queries a shadow object with specify name.
-	HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error)
-	// Queries a object with EIP712 standard metadata info
-	HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error)
-	// Queries a list of bucket items.
-	ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error)
-	// Queries a list of object items under the bucket.
-	ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
-	// Queries a list of object items under the bucket.
-	ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
-	// Queries a group with EIP712 standard metadata info
-	HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error)
-	// Queries a policy which grants permission to account
-	QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error)
-	// Queries a list of VerifyPermission items.
-	VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error)
-	// Queries a group with specify owner and name .
-	HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error)
-	// Queries a list of ListGroup items.
-	ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error)
-	// Queries a list of HeadGroupMember items.
-	HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error)
-	// Queries a policy that grants permission to a group
-	QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error)
-	// Queries a policy by policy id
-	QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error)
-	// Queries lock fee for storing an object
-	QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error)
-	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
-	HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error)
-	// Queries whether read and storage prices changed for the bucket.
-	QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error)
-	// Queries whether read and storage prices changed for the bucket.
-	QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error)
-	// Queries whether some members are in the group.
-	QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error)
-	// Queries whether some groups are exist.
-	QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
-	// Queries whether some groups are exist by id.
-	QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
-	// Queries the flow rate limit of a bucket for a payment account
-	QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+func (m *QueryPaymentAuditRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryPaymentAuditResponse struct {
+	// discrepancies defines the mismatched payment data, ordered by address
+	Discrepancies []PaymentDiscrepancy `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies"`
+	Pagination    *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPaymentAuditResponse) Reset()         { *m = QueryPaymentAuditResponse{} }
+func (m *QueryPaymentAuditResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentAuditResponse) ProtoMessage()    {}
+func (*QueryPaymentAuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{57}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPaymentAuditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentAuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentAuditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPaymentAuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentAuditResponse.Merge(m, src)
+}
+func (m *QueryPaymentAuditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentAuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentAuditResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
-	out := new(QueryParamsByTimestampResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryParamsByTimestamp", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPaymentAuditResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentAuditResponse) GetDiscrepancies() []PaymentDiscrepancy {
+	if m != nil {
+		return m.Discrepancies
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
-	out := new(QueryHeadBucketResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPaymentAuditResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
-	out := new(QueryHeadBucketResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadBucketById", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryHeadObjectVersionRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	ObjectName string `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Version    int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (c *queryClient) HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error) {
-	out := new(QueryBucketNFTResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadBucketNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadObjectVersionRequest) Reset()         { *m = QueryHeadObjectVersionRequest{} }
+func (m *QueryHeadObjectVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadObjectVersionRequest) ProtoMessage()    {}
+func (*QueryHeadObjectVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{58}
+}
+func (m *QueryHeadObjectVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadObjectVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadObjectVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHeadObjectVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadObjectVersionRequest.Merge(m, src)
+}
+func (m *QueryHeadObjectVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadObjectVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadObjectVersionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
-	out := new(QueryHeadObjectResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeadObjectVersionRequest proto.InternalMessageInfo
+
+func (m *QueryHeadObjectVersionRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
-	out := new(QueryHeadObjectResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadObjectById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadObjectVersionRequest) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error) {
-	out := new(QueryHeadShadowObjectResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadShadowObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadObjectVersionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error) {
-	out := new(QueryObjectNFTResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadObjectNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryHeadObjectVersionResponse struct {
+	ObjectVersion      *ObjectVersion            `protobuf:"bytes,1,opt,name=object_version,json=objectVersion,proto3" json:"object_version,omitempty"`
+	GlobalVirtualGroup *types.GlobalVirtualGroup `protobuf:"bytes,2,opt,name=global_virtual_group,json=globalVirtualGroup,proto3" json:"global_virtual_group,omitempty"`
+}
+
+func (m *QueryHeadObjectVersionResponse) Reset()         { *m = QueryHeadObjectVersionResponse{} }
+func (m *QueryHeadObjectVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadObjectVersionResponse) ProtoMessage()    {}
+func (*QueryHeadObjectVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{59}
+}
+func (m *QueryHeadObjectVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadObjectVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadObjectVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHeadObjectVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadObjectVersionResponse.Merge(m, src)
+}
+func (m *QueryHeadObjectVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadObjectVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadObjectVersionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error) {
-	out := new(QueryListBucketsResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/ListBuckets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeadObjectVersionResponse proto.InternalMessageInfo
+
+func (m *QueryHeadObjectVersionResponse) GetObjectVersion() *ObjectVersion {
+	if m != nil {
+		return m.ObjectVersion
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
-	out := new(QueryListObjectsResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/ListObjects", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeadObjectVersionResponse) GetGlobalVirtualGroup() *types.GlobalVirtualGroup {
+	if m != nil {
+		return m.GlobalVirtualGroup
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
-	out := new(QueryListObjectsResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/ListObjectsByBucketId", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryListObjectVersionsRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	ObjectName string `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+}
+
+func (m *QueryListObjectVersionsRequest) Reset()         { *m = QueryListObjectVersionsRequest{} }
+func (m *QueryListObjectVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListObjectVersionsRequest) ProtoMessage()    {}
+func (*QueryListObjectVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{60}
+}
+func (m *QueryListObjectVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListObjectVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListObjectVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListObjectVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListObjectVersionsRequest.Merge(m, src)
+}
+func (m *QueryListObjectVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListObjectVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListObjectVersionsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error) {
-	out := new(QueryGroupNFTResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadGroupNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListObjectVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryListObjectVersionsRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error) {
-	out := new(QueryPolicyForAccountResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryPolicyForAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListObjectVersionsRequest) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error) {
-	out := new(QueryVerifyPermissionResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/VerifyPermission", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryListObjectVersionsResponse struct {
+	// versions defines the prior versions of the object, ordered from the oldest to the latest
+	Versions []ObjectVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+	// max_versions defines how many prior versions the bucket keeps for each object, 0 means versioning is disabled
+	MaxVersions uint32 `protobuf:"varint,2,opt,name=max_versions,json=maxVersions,proto3" json:"max_versions,omitempty"`
+}
+
+func (m *QueryListObjectVersionsResponse) Reset()         { *m = QueryListObjectVersionsResponse{} }
+func (m *QueryListObjectVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListObjectVersionsResponse) ProtoMessage()    {}
+func (*QueryListObjectVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{61}
+}
+func (m *QueryListObjectVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListObjectVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListObjectVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListObjectVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListObjectVersionsResponse.Merge(m, src)
+}
+func (m *QueryListObjectVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListObjectVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListObjectVersionsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error) {
-	out := new(QueryHeadGroupResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListObjectVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryListObjectVersionsResponse) GetVersions() []ObjectVersion {
+	if m != nil {
+		return m.Versions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error) {
-	out := new(QueryListGroupsResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/ListGroups", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListObjectVersionsResponse) GetMaxVersions() uint32 {
+	if m != nil {
+		return m.MaxVersions
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error) {
-	out := new(QueryHeadGroupMemberResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadGroupMember", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryListResourcesByTagRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	TagKey     string             `protobuf:"bytes,2,opt,name=tag_key,json=tagKey,proto3" json:"tag_key,omitempty"`
+	TagValue   string             `protobuf:"bytes,3,opt,name=tag_value,json=tagValue,proto3" json:"tag_value,omitempty"`
+	// resource_type limits the response to one type of resources, all types are listed if it is unspecified
+	ResourceType resource.ResourceType `protobuf:"varint,4,opt,name=resource_type,json=resourceType,proto3,enum=moca.resource.ResourceType" json:"resource_type,omitempty"`
+}
+
+func (m *QueryListResourcesByTagRequest) Reset()         { *m = QueryListResourcesByTagRequest{} }
+func (m *QueryListResourcesByTagRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListResourcesByTagRequest) ProtoMessage()    {}
+func (*QueryListResourcesByTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{62}
+}
+func (m *QueryListResourcesByTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListResourcesByTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListResourcesByTagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListResourcesByTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListResourcesByTagRequest.Merge(m, src)
+}
+func (m *QueryListResourcesByTagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListResourcesByTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListResourcesByTagRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error) {
-	out := new(QueryPolicyForGroupResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryPolicyForGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListResourcesByTagRequest proto.InternalMessageInfo
+
+func (m *QueryListResourcesByTagRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error) {
-	out := new(QueryPolicyByIdResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryPolicyById", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListResourcesByTagRequest) GetTagKey() string {
+	if m != nil {
+		return m.TagKey
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error) {
-	out := new(QueryLockFeeResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryLockFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListResourcesByTagRequest) GetTagValue() string {
+	if m != nil {
+		return m.TagValue
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error) {
-	out := new(QueryHeadBucketExtraResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadBucketExtra", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListResourcesByTagRequest) GetResourceType() resource.ResourceType {
+	if m != nil {
+		return m.ResourceType
 	}
-	return out, nil
+	return resource.RESOURCE_TYPE_UNSPECIFIED
 }
 
-func (c *queryClient) QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error) {
-	out := new(QueryIsPriceChangedResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryIsPriceChanged", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryListResourcesByTagResponse struct {
+	Resources  []*TaggedResource   `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListResourcesByTagResponse) Reset()         { *m = QueryListResourcesByTagResponse{} }
+func (m *QueryListResourcesByTagResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListResourcesByTagResponse) ProtoMessage()    {}
+func (*QueryListResourcesByTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{63}
+}
+func (m *QueryListResourcesByTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListResourcesByTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListResourcesByTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListResourcesByTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListResourcesByTagResponse.Merge(m, src)
+}
+func (m *QueryListResourcesByTagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListResourcesByTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListResourcesByTagResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error) {
-	out := new(QueryQuoteUpdateTimeResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryQuotaUpdateTime", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListResourcesByTagResponse proto.InternalMessageInfo
+
+func (m *QueryListResourcesByTagResponse) GetResources() []*TaggedResource {
+	if m != nil {
+		return m.Resources
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error) {
-	out := new(QueryGroupMembersExistResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryGroupMembersExist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListResourcesByTagResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
-	out := new(QueryGroupsExistResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryGroupsExist", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryBucketReadQuotaRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+}
+
+func (m *QueryBucketReadQuotaRequest) Reset()         { *m = QueryBucketReadQuotaRequest{} }
+func (m *QueryBucketReadQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBucketReadQuotaRequest) ProtoMessage()    {}
+func (*QueryBucketReadQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{64}
+}
+func (m *QueryBucketReadQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBucketReadQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBucketReadQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBucketReadQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBucketReadQuotaRequest.Merge(m, src)
+}
+func (m *QueryBucketReadQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBucketReadQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBucketReadQuotaRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
-	out := new(QueryGroupsExistResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryGroupsExistById", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBucketReadQuotaRequest proto.InternalMessageInfo
+
+func (m *QueryBucketReadQuotaRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
-	out := new(QueryPaymentAccountBucketFlowRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryPaymentAccountBucketFlowRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryBucketReadQuotaResponse struct {
+	// charged_read_quota defines the traffic quota the bucket is charged for, in bytes
+	ChargedReadQuota uint64 `protobuf:"varint,1,opt,name=charged_read_quota,json=chargedReadQuota,proto3" json:"charged_read_quota,omitempty"`
+	// auto_topup defines how the charged read quota is raised automatically, nil if it is not
+	AutoTopup *ReadQuotaAutoTopup `protobuf:"bytes,2,opt,name=auto_topup,json=autoTopup,proto3" json:"auto_topup,omitempty"`
+	// consumption defines the charged read quota consumed in the latest reported month, nil if never reported
+	Consumption *ReadQuotaConsumption `protobuf:"bytes,3,opt,name=consumption,proto3" json:"consumption,omitempty"`
+}
+
+func (m *QueryBucketReadQuotaResponse) Reset()         { *m = QueryBucketReadQuotaResponse{} }
+func (m *QueryBucketReadQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBucketReadQuotaResponse) ProtoMessage()    {}
+func (*QueryBucketReadQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{65}
+}
+func (m *QueryBucketReadQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBucketReadQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBucketReadQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBucketReadQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBucketReadQuotaResponse.Merge(m, src)
+}
+func (m *QueryBucketReadQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBucketReadQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBucketReadQuotaResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Parameters queries the parameters of the module.
-	QueryParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
-	// Queries a bucket with specify name.
-	HeadBucket(context.Context, *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error)
-	// Queries a bucket by id
-	HeadBucketById(context.Context, *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error)
-	// Queries a bucket with EIP712 standard metadata info
-	HeadBucketNFT(context.Context, *QueryNFTRequest) (*QueryBucketNFTResponse, error)
-	// Queries a object with specify name.
-	HeadObject(context.Context, *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error)
-	// Queries an object by id
-	HeadObjectById(context.Context, *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error)
-	// Queries a shadow object with specify name.
-	HeadShadowObject(context.Context, *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error)
-	// Queries a object with EIP712 standard metadata info
-	HeadObjectNFT(context.Context, *QueryNFTRequest) (*QueryObjectNFTResponse, error)
-	// Queries a list of bucket items.
-	ListBuckets(context.Context, *QueryListBucketsRequest) (*QueryListBucketsResponse, error)
-	// Queries a list of object items under the bucket.
-	ListObjects(context.Context, *QueryListObjectsRequest) (*QueryListObjectsResponse, error)
-	// Queries a list of object items under the bucket.
-	ListObjectsByBucketId(context.Context, *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error)
-	// Queries a group with EIP712 standard metadata info
-	HeadGroupNFT(context.Context, *QueryNFTRequest) (*QueryGroupNFTResponse, error)
-	// Queries a policy which grants permission to account
-	QueryPolicyForAccount(context.Context, *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error)
-	// Queries a list of VerifyPermission items.
-	VerifyPermission(context.Context, *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error)
-	// Queries a group with specify owner and name .
-	HeadGroup(context.Context, *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error)
-	// Queries a list of ListGroup items.
-	ListGroups(context.Context, *QueryListGroupsRequest) (*QueryListGroupsResponse, error)
-	// Queries a list of HeadGroupMember items.
-	HeadGroupMember(context.Context, *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error)
-	// Queries a policy that grants permission to a group
-	QueryPolicyForGroup(context.Context, *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error)
-	// Queries a policy by policy id
-	QueryPolicyById(context.Context, *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error)
-	// Queries lock fee for storing an object
-	QueryLockFee(context.Context, *QueryLockFeeRequest) (*QueryLockFeeResponse, error)
-	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
-	HeadBucketExtra(context.Context, *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error)
-	// Queries whether read and storage prices changed for the bucket.
-	QueryIsPriceChanged(context.Context, *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error)
-	// Queries whether read and storage prices changed for the bucket.
-	QueryQuotaUpdateTime(context.Context, *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error)
-	// Queries whether some members are in the group.
-	QueryGroupMembersExist(context.Context, *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error)
-	// Queries whether some groups are exist.
-	QueryGroupsExist(context.Context, *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error)
-	// Queries whether some groups are exist by id.
-	QueryGroupsExistById(context.Context, *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error)
-	// Queries the flow rate limit of a bucket for a payment account
-	QueryPaymentAccountBucketFlowRateLimit(context.Context, *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+var xxx_messageInfo_QueryBucketReadQuotaResponse proto.InternalMessageInfo
+
+func (m *QueryBucketReadQuotaResponse) GetChargedReadQuota() uint64 {
+	if m != nil {
+		return m.ChargedReadQuota
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryBucketReadQuotaResponse) GetAutoTopup() *ReadQuotaAutoTopup {
+	if m != nil {
+		return m.AutoTopup
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryBucketReadQuotaResponse) GetConsumption() *ReadQuotaConsumption {
+	if m != nil {
+		return m.Consumption
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) QueryParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParamsByTimestamp not implemented")
+
+type QueryBillingStatementsRequest struct {
+	PaymentAddress string `protobuf:"bytes,1,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	// start_time defines the inclusive start of the time range
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time defines the exclusive end of the time range, zero means up to the block time
+	EndTime    int64              `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) HeadBucket(ctx context.Context, req *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucket not implemented")
+
+func (m *QueryBillingStatementsRequest) Reset()         { *m = QueryBillingStatementsRequest{} }
+func (m *QueryBillingStatementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBillingStatementsRequest) ProtoMessage()    {}
+func (*QueryBillingStatementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{66}
 }
-func (*UnimplementedQueryServer) HeadBucketById(ctx context.Context, req *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketById not implemented")
+func (m *QueryBillingStatementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) HeadBucketNFT(ctx context.Context, req *QueryNFTRequest) (*QueryBucketNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketNFT not implemented")
+func (m *QueryBillingStatementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBillingStatementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) HeadObject(ctx context.Context, req *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadObject not implemented")
+func (m *QueryBillingStatementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBillingStatementsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) HeadObjectById(ctx context.Context, req *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectById not implemented")
+func (m *QueryBillingStatementsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) HeadShadowObject(ctx context.Context, req *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadShadowObject not implemented")
+func (m *QueryBillingStatementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBillingStatementsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) HeadObjectNFT(ctx context.Context, req *QueryNFTRequest) (*QueryObjectNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectNFT not implemented")
+
+var xxx_messageInfo_QueryBillingStatementsRequest proto.InternalMessageInfo
+
+func (m *QueryBillingStatementsRequest) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) ListBuckets(ctx context.Context, req *QueryListBucketsRequest) (*QueryListBucketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBuckets not implemented")
+
+func (m *QueryBillingStatementsRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ListObjects(ctx context.Context, req *QueryListObjectsRequest) (*QueryListObjectsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
+
+func (m *QueryBillingStatementsRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ListObjectsByBucketId(ctx context.Context, req *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListObjectsByBucketId not implemented")
+
+func (m *QueryBillingStatementsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) HeadGroupNFT(ctx context.Context, req *QueryNFTRequest) (*QueryGroupNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupNFT not implemented")
+
+type QueryBillingStatementsResponse struct {
+	// statements defines the billing statements of the settled billing periods whose last charged second is in the time
+	// range, ordered by their end time
+	Statements []BillingStatement `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements"`
+	// open_statements defines the billing periods not settled yet, charged up to the block time
+	OpenStatements []BillingStatement  `protobuf:"bytes,2,rep,name=open_statements,json=openStatements,proto3" json:"open_statements"`
+	Pagination     *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryPolicyForAccount(ctx context.Context, req *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForAccount not implemented")
+
+func (m *QueryBillingStatementsResponse) Reset()         { *m = QueryBillingStatementsResponse{} }
+func (m *QueryBillingStatementsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBillingStatementsResponse) ProtoMessage()    {}
+func (*QueryBillingStatementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{67}
 }
-func (*UnimplementedQueryServer) VerifyPermission(ctx context.Context, req *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyPermission not implemented")
+func (m *QueryBillingStatementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) HeadGroup(ctx context.Context, req *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadGroup not implemented")
+func (m *QueryBillingStatementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBillingStatementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListGroups(ctx context.Context, req *QueryListGroupsRequest) (*QueryListGroupsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+func (m *QueryBillingStatementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBillingStatementsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) HeadGroupMember(ctx context.Context, req *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupMember not implemented")
+func (m *QueryBillingStatementsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryPolicyForGroup(ctx context.Context, req *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForGroup not implemented")
+func (m *QueryBillingStatementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBillingStatementsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryPolicyById(ctx context.Context, req *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyById not implemented")
+
+var xxx_messageInfo_QueryBillingStatementsResponse proto.InternalMessageInfo
+
+func (m *QueryBillingStatementsResponse) GetStatements() []BillingStatement {
+	if m != nil {
+		return m.Statements
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) QueryLockFee(ctx context.Context, req *QueryLockFeeRequest) (*QueryLockFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryLockFee not implemented")
+
+func (m *QueryBillingStatementsResponse) GetOpenStatements() []BillingStatement {
+	if m != nil {
+		return m.OpenStatements
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) HeadBucketExtra(ctx context.Context, req *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketExtra not implemented")
+
+func (m *QueryBillingStatementsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) QueryIsPriceChanged(ctx context.Context, req *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryIsPriceChanged not implemented")
+
+type QueryBucketBillImpactRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryQuotaUpdateTime(ctx context.Context, req *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryQuotaUpdateTime not implemented")
+
+func (m *QueryBucketBillImpactRequest) Reset()         { *m = QueryBucketBillImpactRequest{} }
+func (m *QueryBucketBillImpactRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBucketBillImpactRequest) ProtoMessage()    {}
+func (*QueryBucketBillImpactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{68}
 }
-func (*UnimplementedQueryServer) QueryGroupMembersExist(ctx context.Context, req *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupMembersExist not implemented")
+func (m *QueryBucketBillImpactRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryGroupsExist(ctx context.Context, req *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExist not implemented")
+func (m *QueryBucketBillImpactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBucketBillImpactRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryGroupsExistById(ctx context.Context, req *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExistById not implemented")
+func (m *QueryBucketBillImpactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBucketBillImpactRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, req *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPaymentAccountBucketFlowRateLimit not implemented")
+func (m *QueryBucketBillImpactRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryBucketBillImpactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBucketBillImpactRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryBucketBillImpactRequest proto.InternalMessageInfo
+
+func (m *QueryBucketBillImpactRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByTimestampRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParamsByTimestamp(ctx, in)
+type QueryBucketBillImpactResponse struct {
+	// effective_time defines the time the next global store price takes effect, in unix seconds
+	EffectiveTime int64 `protobuf:"varint,1,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+	// current_price defines the global store price the bucket is charged by
+	CurrentPrice types2.GlobalSpStorePrice `protobuf:"bytes,2,opt,name=current_price,json=currentPrice,proto3" json:"current_price"`
+	// projected_price defines the global store price taking effect next, including the prices the sps scheduled
+	ProjectedPrice types2.GlobalSpStorePrice `protobuf:"bytes,3,opt,name=projected_price,json=projectedPrice,proto3" json:"projected_price"`
+	// current_flow_rate defines the total flow rate the bucket is charged, in amoca wei per second
+	CurrentFlowRate cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=current_flow_rate,json=currentFlowRate,proto3,customtype=cosmossdk.io/math.Int" json:"current_flow_rate"`
+	// projected_flow_rate defines the total flow rate of the bucket charged by the projected price
+	ProjectedFlowRate cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=projected_flow_rate,json=projectedFlowRate,proto3,customtype=cosmossdk.io/math.Int" json:"projected_flow_rate"`
+}
+
+func (m *QueryBucketBillImpactResponse) Reset()         { *m = QueryBucketBillImpactResponse{} }
+func (m *QueryBucketBillImpactResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBucketBillImpactResponse) ProtoMessage()    {}
+func (*QueryBucketBillImpactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{69}
+}
+func (m *QueryBucketBillImpactResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBucketBillImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBucketBillImpactResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/QueryParamsByTimestamp",
+}
+func (m *QueryBucketBillImpactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBucketBillImpactResponse.Merge(m, src)
+}
+func (m *QueryBucketBillImpactResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBucketBillImpactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBucketBillImpactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBucketBillImpactResponse proto.InternalMessageInfo
+
+func (m *QueryBucketBillImpactResponse) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
+	return 0
+}
+
+func (m *QueryBucketBillImpactResponse) GetCurrentPrice() types2.GlobalSpStorePrice {
+	if m != nil {
+		return m.CurrentPrice
 	}
-	return interceptor(ctx, in, info, handler)
+	return types2.GlobalSpStorePrice{}
 }
 
-func _Query_HeadBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadBucketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryBucketBillImpactResponse) GetProjectedPrice() types2.GlobalSpStorePrice {
+	if m != nil {
+		return m.ProjectedPrice
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/HeadBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucket(ctx, req.(*QueryHeadBucketRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return types2.GlobalSpStorePrice{}
 }
 
-func _Query_HeadBucketById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadBucketByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucketById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/HeadBucketById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucketById(ctx, req.(*QueryHeadBucketByIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryBucketCallbackRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
 }
 
-func _Query_HeadBucketNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucketNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/HeadBucketNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucketNFT(ctx, req.(*QueryNFTRequest))
+func (m *QueryBucketCallbackRequest) Reset()         { *m = QueryBucketCallbackRequest{} }
+func (m *QueryBucketCallbackRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBucketCallbackRequest) ProtoMessage()    {}
+func (*QueryBucketCallbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{70}
+}
+func (m *QueryBucketCallbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBucketCallbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBucketCallbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBucketCallbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBucketCallbackRequest.Merge(m, src)
+}
+func (m *QueryBucketCallbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBucketCallbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBucketCallbackRequest.DiscardUnknown(m)
 }
 
-func _Query_HeadObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadObjectRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/HeadObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadObject(ctx, req.(*QueryHeadObjectRequest))
+var xxx_messageInfo_QueryBucketCallbackRequest proto.InternalMessageInfo
+
+func (m *QueryBucketCallbackRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_HeadObjectById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadObjectByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadObjectById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/HeadObjectById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadObjectById(ctx, req.(*QueryHeadObjectByIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryBucketCallbackResponse struct {
+	// callback defines the contract notified about the objects of the bucket, nil if none is registered
+	Callback *BucketCallback `protobuf:"bytes,1,opt,name=callback,proto3" json:"callback,omitempty"`
 }
 
-func _Query_HeadShadowObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadShadowObjectRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadShadowObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/HeadShadowObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadShadowObject(ctx, req.(*QueryHeadShadowObjectRequest))
+func (m *QueryBucketCallbackResponse) Reset()         { *m = QueryBucketCallbackResponse{} }
+func (m *QueryBucketCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBucketCallbackResponse) ProtoMessage()    {}
+func (*QueryBucketCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{71}
+}
+func (m *QueryBucketCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBucketCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBucketCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBucketCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBucketCallbackResponse.Merge(m, src)
+}
+func (m *QueryBucketCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBucketCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBucketCallbackResponse.DiscardUnknown(m)
 }
 
-func _Query_HeadObjectNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadObjectNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/HeadObjectNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadObjectNFT(ctx, req.(*QueryNFTRequest))
+var xxx_messageInfo_QueryBucketCallbackResponse proto.InternalMessageInfo
+
+func (m *QueryBucketCallbackResponse) GetCallback() *BucketCallback {
+	if m != nil {
+		return m.Callback
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListBucketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListBuckets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/ListBuckets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListBuckets(ctx, req.(*QueryListBucketsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryBucketOwnershipTransferRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
 }
 
-func _Query_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListObjectsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListObjects(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/ListObjects",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListObjects(ctx, req.(*QueryListObjectsRequest))
+func (m *QueryBucketOwnershipTransferRequest) Reset()         { *m = QueryBucketOwnershipTransferRequest{} }
+func (m *QueryBucketOwnershipTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBucketOwnershipTransferRequest) ProtoMessage()    {}
+func (*QueryBucketOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{72}
+}
+func (m *QueryBucketOwnershipTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBucketOwnershipTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBucketOwnershipTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBucketOwnershipTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBucketOwnershipTransferRequest.Merge(m, src)
+}
+func (m *QueryBucketOwnershipTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBucketOwnershipTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBucketOwnershipTransferRequest.DiscardUnknown(m)
 }
 
-func _Query_ListObjectsByBucketId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListObjectsByBucketIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListObjectsByBucketId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/ListObjectsByBucketId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListObjectsByBucketId(ctx, req.(*QueryListObjectsByBucketIdRequest))
+var xxx_messageInfo_QueryBucketOwnershipTransferRequest proto.InternalMessageInfo
+
+func (m *QueryBucketOwnershipTransferRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_HeadGroupNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNFTRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadGroupNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/HeadGroupNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadGroupNFT(ctx, req.(*QueryNFTRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryBucketOwnershipTransferResponse struct {
+	// transfer defines the pending transfer of the ownership of the bucket, nil if there is none
+	Transfer *BucketOwnershipTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
 }
 
-func _Query_QueryPolicyForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPolicyForAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPolicyForAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/QueryPolicyForAccount",
+func (m *QueryBucketOwnershipTransferResponse) Reset()         { *m = QueryBucketOwnershipTransferResponse{} }
+func (m *QueryBucketOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBucketOwnershipTransferResponse) ProtoMessage()    {}
+func (*QueryBucketOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056b51fde4497d83, []int{73}
+}
+func (m *QueryBucketOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBucketOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBucketOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPolicyForAccount(ctx, req.(*QueryPolicyForAccountRequest))
+}
+func (m *QueryBucketOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBucketOwnershipTransferResponse.Merge(m, src)
+}
+func (m *QueryBucketOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBucketOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBucketOwnershipTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBucketOwnershipTransferResponse proto.InternalMessageInfo
+
+func (m *QueryBucketOwnershipTransferResponse) GetTransfer() *BucketOwnershipTransfer {
+	if m != nil {
+		return m.Transfer
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_VerifyPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVerifyPermissionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VerifyPermission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/VerifyPermission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VerifyPermission(ctx, req.(*QueryVerifyPermissionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "moca.storage.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "moca.storage.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "moca.storage.QueryParamsByTimestampRequest")
+	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "moca.storage.QueryParamsByTimestampResponse")
+	proto.RegisterType((*QueryHeadBucketRequest)(nil), "moca.storage.QueryHeadBucketRequest")
+	proto.RegisterType((*QueryHeadBucketByIdRequest)(nil), "moca.storage.QueryHeadBucketByIdRequest")
+	proto.RegisterType((*QueryHeadBucketResponse)(nil), "moca.storage.QueryHeadBucketResponse")
+	proto.RegisterType((*QueryHeadObjectRequest)(nil), "moca.storage.QueryHeadObjectRequest")
+	proto.RegisterType((*QueryHeadObjectByIdRequest)(nil), "moca.storage.QueryHeadObjectByIdRequest")
+	proto.RegisterType((*QueryHeadShadowObjectRequest)(nil), "moca.storage.QueryHeadShadowObjectRequest")
+	proto.RegisterType((*QueryHeadObjectResponse)(nil), "moca.storage.QueryHeadObjectResponse")
+	proto.RegisterType((*QueryHeadShadowObjectResponse)(nil), "moca.storage.QueryHeadShadowObjectResponse")
+	proto.RegisterType((*QueryListBucketsRequest)(nil), "moca.storage.QueryListBucketsRequest")
+	proto.RegisterType((*QueryListBucketsResponse)(nil), "moca.storage.QueryListBucketsResponse")
+	proto.RegisterType((*QueryListObjectsRequest)(nil), "moca.storage.QueryListObjectsRequest")
+	proto.RegisterType((*QueryListObjectsByBucketIdRequest)(nil), "moca.storage.QueryListObjectsByBucketIdRequest")
+	proto.RegisterType((*QueryListObjectsResponse)(nil), "moca.storage.QueryListObjectsResponse")
+	proto.RegisterType((*QueryNFTRequest)(nil), "moca.storage.QueryNFTRequest")
+	proto.RegisterType((*QueryBucketNFTResponse)(nil), "moca.storage.QueryBucketNFTResponse")
+	proto.RegisterType((*QueryObjectNFTResponse)(nil), "moca.storage.QueryObjectNFTResponse")
+	proto.RegisterType((*QueryGroupNFTResponse)(nil), "moca.storage.QueryGroupNFTResponse")
+	proto.RegisterType((*QueryNFTTokenURIRequest)(nil), "moca.storage.QueryNFTTokenURIRequest")
+	proto.RegisterType((*QueryNFTTokenURIResponse)(nil), "moca.storage.QueryNFTTokenURIResponse")
+	proto.RegisterType((*QueryPolicyForAccountRequest)(nil), "moca.storage.QueryPolicyForAccountRequest")
+	proto.RegisterType((*QueryPolicyForAccountResponse)(nil), "moca.storage.QueryPolicyForAccountResponse")
+	proto.RegisterType((*QueryVerifyPermissionRequest)(nil), "moca.storage.QueryVerifyPermissionRequest")
+	proto.RegisterType((*QueryVerifyPermissionResponse)(nil), "moca.storage.QueryVerifyPermissionResponse")
+	proto.RegisterType((*QueryExplainPermissionRequest)(nil), "moca.storage.QueryExplainPermissionRequest")
+	proto.RegisterType((*QueryExplainPermissionResponse)(nil), "moca.storage.QueryExplainPermissionResponse")
+	proto.RegisterType((*PermissionTraceStep)(nil), "moca.storage.PermissionTraceStep")
+	proto.RegisterType((*StatementTrace)(nil), "moca.storage.StatementTrace")
+	proto.RegisterType((*QueryHeadGroupRequest)(nil), "moca.storage.QueryHeadGroupRequest")
+	proto.RegisterType((*QueryHeadGroupResponse)(nil), "moca.storage.QueryHeadGroupResponse")
+	proto.RegisterType((*QueryListGroupsRequest)(nil), "moca.storage.QueryListGroupsRequest")
+	proto.RegisterType((*QueryListGroupsResponse)(nil), "moca.storage.QueryListGroupsResponse")
+	proto.RegisterType((*QueryHeadGroupMemberRequest)(nil), "moca.storage.QueryHeadGroupMemberRequest")
+	proto.RegisterType((*QueryHeadGroupMemberResponse)(nil), "moca.storage.QueryHeadGroupMemberResponse")
+	proto.RegisterType((*QueryPolicyForGroupRequest)(nil), "moca.storage.QueryPolicyForGroupRequest")
+	proto.RegisterType((*QueryPolicyForGroupResponse)(nil), "moca.storage.QueryPolicyForGroupResponse")
+	proto.RegisterType((*QueryPolicyByIdRequest)(nil), "moca.storage.QueryPolicyByIdRequest")
+	proto.RegisterType((*QueryPolicyByIdResponse)(nil), "moca.storage.QueryPolicyByIdResponse")
+	proto.RegisterType((*QueryLockFeeRequest)(nil), "moca.storage.QueryLockFeeRequest")
+	proto.RegisterType((*QueryLockFeeResponse)(nil), "moca.storage.QueryLockFeeResponse")
+	proto.RegisterType((*QueryHeadBucketExtraRequest)(nil), "moca.storage.QueryHeadBucketExtraRequest")
+	proto.RegisterType((*QueryHeadBucketExtraResponse)(nil), "moca.storage.QueryHeadBucketExtraResponse")
+	proto.RegisterType((*QueryIsPriceChangedRequest)(nil), "moca.storage.QueryIsPriceChangedRequest")
+	proto.RegisterType((*QueryIsPriceChangedResponse)(nil), "moca.storage.QueryIsPriceChangedResponse")
+	proto.RegisterType((*QueryQuoteUpdateTimeRequest)(nil), "moca.storage.QueryQuoteUpdateTimeRequest")
+	proto.RegisterType((*QueryQuoteUpdateTimeResponse)(nil), "moca.storage.QueryQuoteUpdateTimeResponse")
+	proto.RegisterType((*QueryGroupMembersExistRequest)(nil), "moca.storage.QueryGroupMembersExistRequest")
+	proto.RegisterType((*QueryGroupMembersExistResponse)(nil), "moca.storage.QueryGroupMembersExistResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "moca.storage.QueryGroupMembersExistResponse.ExistsEntry")
+	proto.RegisterType((*QueryGroupsExistRequest)(nil), "moca.storage.QueryGroupsExistRequest")
+	proto.RegisterType((*QueryGroupsExistByIdRequest)(nil), "moca.storage.QueryGroupsExistByIdRequest")
+	proto.RegisterType((*QueryGroupsExistResponse)(nil), "moca.storage.QueryGroupsExistResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "moca.storage.QueryGroupsExistResponse.ExistsEntry")
+	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitRequest)(nil), "moca.storage.QueryPaymentAccountBucketFlowRateLimitRequest")
+	proto.RegisterType((*QueryPaymentAccountBucketFlowRateLimitResponse)(nil), "moca.storage.QueryPaymentAccountBucketFlowRateLimitResponse")
+	proto.RegisterType((*QueryPaymentAuditRequest)(nil), "moca.storage.QueryPaymentAuditRequest")
+	proto.RegisterType((*QueryPaymentAuditResponse)(nil), "moca.storage.QueryPaymentAuditResponse")
+	proto.RegisterType((*QueryHeadObjectVersionRequest)(nil), "moca.storage.QueryHeadObjectVersionRequest")
+	proto.RegisterType((*QueryHeadObjectVersionResponse)(nil), "moca.storage.QueryHeadObjectVersionResponse")
+	proto.RegisterType((*QueryListObjectVersionsRequest)(nil), "moca.storage.QueryListObjectVersionsRequest")
+	proto.RegisterType((*QueryListObjectVersionsResponse)(nil), "moca.storage.QueryListObjectVersionsResponse")
+	proto.RegisterType((*QueryListResourcesByTagRequest)(nil), "moca.storage.QueryListResourcesByTagRequest")
+	proto.RegisterType((*QueryListResourcesByTagResponse)(nil), "moca.storage.QueryListResourcesByTagResponse")
+	proto.RegisterType((*QueryBucketReadQuotaRequest)(nil), "moca.storage.QueryBucketReadQuotaRequest")
+	proto.RegisterType((*QueryBucketReadQuotaResponse)(nil), "moca.storage.QueryBucketReadQuotaResponse")
+	proto.RegisterType((*QueryBillingStatementsRequest)(nil), "moca.storage.QueryBillingStatementsRequest")
+	proto.RegisterType((*QueryBillingStatementsResponse)(nil), "moca.storage.QueryBillingStatementsResponse")
+	proto.RegisterType((*QueryBucketBillImpactRequest)(nil), "moca.storage.QueryBucketBillImpactRequest")
+	proto.RegisterType((*QueryBucketBillImpactResponse)(nil), "moca.storage.QueryBucketBillImpactResponse")
+	proto.RegisterType((*QueryBucketCallbackRequest)(nil), "moca.storage.QueryBucketCallbackRequest")
+	proto.RegisterType((*QueryBucketCallbackResponse)(nil), "moca.storage.QueryBucketCallbackResponse")
+	proto.RegisterType((*QueryBucketOwnershipTransferRequest)(nil), "moca.storage.QueryBucketOwnershipTransferRequest")
+	proto.RegisterType((*QueryBucketOwnershipTransferResponse)(nil), "moca.storage.QueryBucketOwnershipTransferResponse")
 }
 
-func _Query_HeadGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadGroupRequest)
-	if err := dec(in); err != nil {
+func init() { proto.RegisterFile("moca/storage/query.proto", fileDescriptor_056b51fde4497d83) }
+
+var fileDescriptor_056b51fde4497d83 = []byte{
+	// 4166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5c, 0xdd, 0x6f, 0x1c, 0x47,
+	0x72, 0xf7, 0x70, 0x29, 0x8a, 0x6c, 0x4a, 0xa4, 0xd4, 0xa2, 0xc4, 0xd5, 0xf0, 0x43, 0xd2, 0xd8,
+	0xfa, 0xa6, 0x76, 0x6d, 0xd9, 0x96, 0x25, 0x7f, 0x1e, 0x69, 0x89, 0x32, 0x2f, 0xb6, 0x4c, 0x2f,
+	0x69, 0x39, 0xb9, 0x1c, 0x30, 0x6e, 0xee, 0x34, 0x97, 0x13, 0xee, 0xce, 0x8c, 0x66, 0x66, 0xf9,
+	0x71, 0xc4, 0x02, 0xb9, 0x04, 0x08, 0x2e, 0x79, 0x08, 0x9c, 0x1c, 0x10, 0x04, 0xc9, 0x1d, 0xee,
+	0x8c, 0x04, 0xc1, 0x25, 0x80, 0x81, 0x5c, 0x72, 0x40, 0x10, 0x04, 0xc1, 0x01, 0x79, 0x08, 0x2e,
+	0x79, 0x32, 0xee, 0x12, 0x20, 0xc9, 0xc3, 0xe1, 0x60, 0x07, 0xc8, 0x7f, 0x11, 0x04, 0xdd, 0x5d,
+	0x3d, 0xd3, 0xf3, 0xb5, 0x1c, 0x91, 0xbc, 0x97, 0xbc, 0x08, 0x3b, 0xdd, 0x55, 0xd5, 0xbf, 0xae,
+	0xae, 0xae, 0xae, 0xae, 0x2e, 0x11, 0x55, 0x3b, 0x6e, 0x93, 0xd4, 0x83, 0xd0, 0xf5, 0x49, 0x8b,
+	0xd6, 0x9f, 0x74, 0xa9, 0xbf, 0x5b, 0xf3, 0x7c, 0x37, 0x74, 0xf1, 0x09, 0xd6, 0x53, 0x83, 0x1e,
+	0xfd, 0x34, 0xe9, 0xd8, 0x8e, 0x5b, 0xe7, 0xff, 0x0a, 0x02, 0xfd, 0x46, 0xd3, 0x0d, 0x3a, 0x6e,
+	0x50, 0x5f, 0x23, 0x01, 0x70, 0xd6, 0xb7, 0x5e, 0x58, 0xa3, 0x21, 0x79, 0xa1, 0xee, 0x91, 0x96,
+	0xed, 0x90, 0xd0, 0x76, 0x1d, 0xa0, 0x3d, 0x2f, 0x68, 0x4d, 0xfe, 0x55, 0x17, 0x1f, 0xd0, 0x35,
+	0xd1, 0x72, 0x5b, 0xae, 0x68, 0x67, 0xbf, 0xa0, 0x75, 0xba, 0xe5, 0xba, 0xad, 0x36, 0xad, 0x13,
+	0xcf, 0xae, 0x13, 0xc7, 0x71, 0x43, 0x2e, 0x4d, 0xf2, 0x4c, 0x73, 0xd4, 0x1e, 0xf5, 0x3b, 0x76,
+	0x10, 0xd8, 0xae, 0x53, 0x6f, 0xba, 0x9d, 0x4e, 0x34, 0xd8, 0x54, 0xba, 0x37, 0xdc, 0xf5, 0xa8,
+	0x64, 0x3d, 0xcf, 0x3b, 0x7d, 0x1a, 0xb8, 0x5d, 0xbf, 0x49, 0x13, 0x5d, 0x67, 0x78, 0x57, 0xe0,
+	0xe5, 0xd0, 0x4b, 0x05, 0x79, 0xc4, 0x27, 0x1d, 0xd9, 0x95, 0xd4, 0x9d, 0xca, 0x34, 0xc3, 0x7b,
+	0xb6, 0x6c, 0x3f, 0xec, 0x92, 0x76, 0xcb, 0x77, 0xbb, 0x09, 0x99, 0xc6, 0x04, 0xc2, 0x1f, 0x30,
+	0x7d, 0x2d, 0x73, 0x69, 0x0d, 0xfa, 0xa4, 0x4b, 0x83, 0xd0, 0x78, 0x84, 0xce, 0x24, 0x5a, 0x03,
+	0xcf, 0x75, 0x02, 0x8a, 0x5f, 0x41, 0x43, 0x62, 0xd4, 0xaa, 0x76, 0x51, 0xbb, 0x36, 0x7a, 0x7b,
+	0xa2, 0xa6, 0x2e, 0x4c, 0x4d, 0x50, 0x2f, 0x8c, 0xfc, 0xe4, 0xe7, 0x17, 0x9e, 0xf9, 0xc1, 0xff,
+	0xfc, 0xf5, 0x0d, 0xad, 0x01, 0xe4, 0xc6, 0x1b, 0x68, 0x46, 0x91, 0xb7, 0xb0, 0xbb, 0x6a, 0x77,
+	0x68, 0x10, 0x92, 0x8e, 0x07, 0x03, 0xe2, 0x69, 0x34, 0x12, 0xca, 0x36, 0x2e, 0xbc, 0xd2, 0x88,
+	0x1b, 0x8c, 0x5f, 0x43, 0xb3, 0x45, 0xec, 0x87, 0x45, 0x76, 0x0f, 0x9d, 0xe3, 0xa2, 0xdf, 0xa1,
+	0xc4, 0x5a, 0xe8, 0x36, 0x37, 0x69, 0x28, 0x21, 0x5d, 0x40, 0xa3, 0x6b, 0xbc, 0xc1, 0x74, 0x48,
+	0x87, 0x72, 0xb9, 0x23, 0x0d, 0x24, 0x9a, 0x1e, 0x91, 0x0e, 0x35, 0xee, 0x21, 0x3d, 0xc5, 0xba,
+	0xb0, 0xbb, 0x64, 0x49, 0xf6, 0x29, 0x34, 0x02, 0xec, 0xb6, 0x05, 0xcc, 0xc3, 0xa2, 0x61, 0xc9,
+	0x32, 0xfe, 0x50, 0x43, 0x93, 0x99, 0x61, 0x61, 0x2a, 0xf7, 0xa2, 0x71, 0x6d, 0x67, 0xdd, 0x85,
+	0xf9, 0x54, 0x93, 0xf3, 0x11, 0x2c, 0x4b, 0xce, 0xba, 0x2b, 0x11, 0xb1, 0xdf, 0xf8, 0x75, 0x84,
+	0xe8, 0x4e, 0xe8, 0x13, 0xc1, 0x39, 0xc0, 0x39, 0x67, 0xf2, 0x38, 0x1f, 0x30, 0x2a, 0xce, 0x3e,
+	0x42, 0xe5, 0x4f, 0xe3, 0x6b, 0x8a, 0x2a, 0xde, 0x5f, 0xfb, 0x0d, 0xda, 0x2c, 0xad, 0x0a, 0x46,
+	0xe0, 0x72, 0x0e, 0x41, 0x30, 0x20, 0x08, 0x44, 0x53, 0x46, 0x57, 0x42, 0x76, 0x4a, 0x57, 0xc0,
+	0x1e, 0xeb, 0x4a, 0x34, 0x2c, 0x59, 0xc6, 0xc7, 0x68, 0x3a, 0x62, 0x5d, 0xd9, 0x20, 0x96, 0xbb,
+	0x7d, 0xd4, 0xe0, 0x3e, 0x53, 0x57, 0x43, 0x0a, 0x8f, 0x57, 0x43, 0x42, 0x2b, 0x5c, 0x0d, 0xc1,
+	0x22, 0x56, 0xc3, 0x8d, 0x7e, 0xe3, 0x8f, 0xd0, 0x44, 0xab, 0xed, 0xae, 0x91, 0xb6, 0x09, 0xbb,
+	0xcf, 0xe4, 0xdb, 0x0f, 0xd6, 0xe5, 0xb2, 0x90, 0xa1, 0x6e, 0xcc, 0xda, 0x43, 0x4e, 0xfe, 0x58,
+	0x34, 0x3d, 0x64, 0x4d, 0x0d, 0xdc, 0xca, 0xb4, 0x19, 0x1f, 0xa3, 0x99, 0x08, 0x6e, 0x52, 0x23,
+	0x00, 0xfa, 0xad, 0x3c, 0xd0, 0xb3, 0x49, 0xd0, 0x2a, 0x63, 0x1a, 0xba, 0x41, 0x40, 0x21, 0xef,
+	0xda, 0x41, 0x28, 0x2c, 0x46, 0xba, 0x06, 0xbc, 0x88, 0x50, 0xec, 0x52, 0x41, 0xf4, 0x95, 0x1a,
+	0xb8, 0x51, 0xe6, 0x7f, 0x6b, 0xc2, 0x73, 0x83, 0xff, 0xad, 0x2d, 0x93, 0x16, 0x05, 0xde, 0x86,
+	0xc2, 0x69, 0x7c, 0x5f, 0x43, 0xd5, 0xec, 0x18, 0x30, 0x81, 0xd7, 0xd0, 0x09, 0x65, 0x0f, 0xb0,
+	0x4d, 0x5d, 0xe9, 0xbb, 0x09, 0x46, 0xe3, 0x4d, 0x10, 0xe0, 0x87, 0x09, 0x84, 0x42, 0xdb, 0x57,
+	0xf7, 0x45, 0x28, 0x46, 0x4e, 0x40, 0xfc, 0x4f, 0x4d, 0x51, 0x83, 0xd0, 0xd4, 0x51, 0xab, 0x21,
+	0x6d, 0xbd, 0x03, 0x19, 0xeb, 0x3d, 0x87, 0x86, 0x3c, 0x9f, 0xae, 0xdb, 0x3b, 0xd5, 0x0a, 0xef,
+	0x83, 0x2f, 0xe6, 0x31, 0x2d, 0xda, 0xb6, 0x3b, 0x76, 0x48, 0xfd, 0xea, 0x20, 0xef, 0x8a, 0x1b,
+	0x98, 0xd8, 0x20, 0x24, 0x7e, 0x68, 0x92, 0x75, 0xd6, 0x7f, 0x4c, 0x88, 0xe5, 0x4d, 0xf3, 0xac,
+	0xc5, 0xf8, 0x96, 0x86, 0x2e, 0xa5, 0xe7, 0xb6, 0xb0, 0x0b, 0x2a, 0xb5, 0x8e, 0x7a, 0x96, 0x09,
+	0x67, 0x38, 0x90, 0x72, 0x86, 0xff, 0xa2, 0x5a, 0x42, 0xa4, 0xe6, 0xd8, 0x12, 0x14, 0x53, 0x2e,
+	0xb0, 0x04, 0xc5, 0x8a, 0x47, 0x63, 0x2b, 0x3e, 0x3a, 0x4b, 0xc0, 0x57, 0xd1, 0xb8, 0x38, 0xd6,
+	0x4d, 0xa1, 0x7d, 0x1a, 0x54, 0x2b, 0x17, 0x2b, 0xd7, 0x46, 0x1a, 0x63, 0xa2, 0x79, 0x19, 0x5a,
+	0x8d, 0x39, 0x34, 0xce, 0xa7, 0xf2, 0x68, 0x71, 0x55, 0xea, 0xf0, 0x3c, 0x1a, 0x0e, 0xdd, 0x4d,
+	0xea, 0xc4, 0xbe, 0xed, 0x38, 0xff, 0x5e, 0xb2, 0x8c, 0x15, 0xf0, 0xb8, 0x42, 0xed, 0x9c, 0x27,
+	0x72, 0x3b, 0x23, 0x1d, 0x1a, 0x12, 0xd3, 0x22, 0x21, 0x01, 0xbd, 0x4f, 0xe7, 0x59, 0xff, 0x7b,
+	0x34, 0x24, 0xf7, 0x49, 0x48, 0x1a, 0xc3, 0x1d, 0xf8, 0x15, 0x09, 0x15, 0x4a, 0x79, 0x3a, 0xa1,
+	0x82, 0x27, 0x47, 0xe8, 0x07, 0xe8, 0x2c, 0x17, 0xca, 0x1d, 0x90, 0x2a, 0xf3, 0x6e, 0x56, 0xe6,
+	0x54, 0x52, 0x26, 0x67, 0xc9, 0x11, 0xb9, 0x05, 0x9b, 0xeb, 0xd1, 0xe2, 0xea, 0x2a, 0xd3, 0xc7,
+	0x87, 0x8d, 0x25, 0xa9, 0xb2, 0xaf, 0xa0, 0x93, 0x32, 0x2a, 0x32, 0x59, 0xb0, 0xc2, 0x05, 0x8f,
+	0x49, 0xc1, 0xb2, 0xab, 0xd6, 0x80, 0x1f, 0xab, 0xbb, 0x1e, 0x6d, 0x9c, 0xf0, 0x95, 0xaf, 0x84,
+	0xd2, 0x07, 0x92, 0x4a, 0x7f, 0x05, 0x55, 0xb3, 0xe3, 0xc2, 0x6c, 0xa6, 0xd0, 0x88, 0x60, 0xeb,
+	0xfa, 0xb6, 0x3c, 0x88, 0x78, 0xc3, 0x87, 0xbe, 0x6d, 0x7c, 0x53, 0x83, 0x93, 0x68, 0xd9, 0x6d,
+	0xdb, 0xcd, 0xdd, 0x45, 0xd7, 0x9f, 0x6f, 0x36, 0xdd, 0xae, 0x13, 0x9d, 0x44, 0x3a, 0x1a, 0x96,
+	0x20, 0x24, 0xb3, 0xfc, 0xc6, 0x0f, 0xd0, 0x69, 0xcf, 0xb7, 0x9d, 0xa6, 0xed, 0x91, 0xb6, 0x49,
+	0x2c, 0xcb, 0xa7, 0x41, 0x20, 0x90, 0x2d, 0x54, 0x7f, 0xfa, 0xa3, 0x5b, 0x13, 0x60, 0x94, 0xf3,
+	0xa2, 0x67, 0x25, 0xf4, 0x6d, 0xa7, 0xd5, 0x38, 0x15, 0xb1, 0x40, 0xbb, 0xb1, 0x8c, 0x66, 0x0a,
+	0x20, 0xc0, 0x0c, 0xea, 0x68, 0xc8, 0xe3, 0x7d, 0xb0, 0x18, 0x93, 0x42, 0x67, 0x71, 0x04, 0x5a,
+	0x13, 0xac, 0x0d, 0x20, 0x33, 0xfe, 0x4d, 0xce, 0xea, 0x31, 0xf5, 0xed, 0xf5, 0xdd, 0xe5, 0x88,
+	0x50, 0xce, 0xea, 0x25, 0x34, 0xec, 0x7a, 0xd4, 0x27, 0xa1, 0xeb, 0x57, 0xb5, 0x7d, 0x00, 0x47,
+	0x94, 0xfb, 0xfb, 0xb5, 0xd4, 0xa9, 0x5c, 0x49, 0x9f, 0xca, 0xf8, 0x75, 0x34, 0x4a, 0x9a, 0x6c,
+	0xf7, 0x09, 0x13, 0x18, 0x54, 0x4d, 0x40, 0x99, 0xce, 0x3c, 0xa7, 0xe1, 0x26, 0x80, 0x48, 0xf4,
+	0x3b, 0x52, 0x54, 0x76, 0x56, 0xb1, 0xa2, 0xe8, 0xfa, 0x3a, 0x6d, 0x86, 0x60, 0x5c, 0x59, 0x45,
+	0x3d, 0xe0, 0xdd, 0x0d, 0x20, 0x33, 0xfe, 0x5d, 0x03, 0x91, 0x0f, 0x76, 0xbc, 0x36, 0xb1, 0x9d,
+	0xff, 0x2f, 0x9a, 0xfa, 0x44, 0x43, 0xb3, 0x45, 0xf3, 0x3a, 0xa0, 0xae, 0xf0, 0x1b, 0xe8, 0x58,
+	0x10, 0x52, 0x8f, 0x59, 0x38, 0x73, 0xd7, 0x97, 0x52, 0xd1, 0x78, 0xc4, 0xb7, 0xea, 0x93, 0x26,
+	0x5d, 0x09, 0xa9, 0xb7, 0x30, 0xc8, 0x42, 0xf3, 0x86, 0xe0, 0x32, 0xfe, 0x79, 0x00, 0x9d, 0xc9,
+	0x21, 0xc2, 0x13, 0xe8, 0x58, 0x73, 0x83, 0x36, 0x37, 0x61, 0x77, 0x89, 0x8f, 0xc4, 0xb6, 0x1b,
+	0x48, 0x6d, 0xbb, 0xbb, 0x68, 0x44, 0xd8, 0x39, 0x73, 0x04, 0x5c, 0x73, 0x0b, 0x53, 0x6c, 0xa4,
+	0xff, 0xfa, 0xf9, 0x85, 0xc1, 0x0f, 0x6d, 0x27, 0xfc, 0xe9, 0x8f, 0x6e, 0x8d, 0xc2, 0xfa, 0xb0,
+	0xcf, 0xc6, 0xb0, 0xa0, 0x5e, 0xb2, 0xf0, 0x1d, 0x34, 0xcc, 0x83, 0x32, 0xc6, 0x38, 0xb8, 0x3f,
+	0xe3, 0x71, 0x4e, 0xbc, 0x64, 0xe1, 0x05, 0xc4, 0x8e, 0xd9, 0x90, 0x76, 0xa8, 0x13, 0x06, 0xd5,
+	0x63, 0x17, 0x2b, 0x59, 0x2f, 0xbb, 0x22, 0xfb, 0xf9, 0xcc, 0x60, 0xea, 0x0a, 0x97, 0xa2, 0xef,
+	0xa1, 0x72, 0xfa, 0x3e, 0x87, 0x86, 0x7c, 0x4a, 0x02, 0xd7, 0xa9, 0x1e, 0x17, 0x41, 0x82, 0xf8,
+	0x32, 0xfe, 0x52, 0x43, 0x63, 0xc9, 0xd1, 0x98, 0x0e, 0x6d, 0xc7, 0xa2, 0x3b, 0x5c, 0x87, 0x27,
+	0x1b, 0xe2, 0x83, 0xe9, 0x29, 0x1a, 0x1f, 0x0e, 0x4a, 0x3d, 0x33, 0x68, 0x24, 0xa9, 0x11, 0x13,
+	0x2b, 0x58, 0x2b, 0x4f, 0x8b, 0x75, 0x30, 0x81, 0xf5, 0x09, 0x3a, 0x1b, 0x45, 0xb5, 0x22, 0xf6,
+	0x85, 0x6d, 0x75, 0x0f, 0x8d, 0x8a, 0x95, 0x70, 0xb7, 0x1d, 0xba, 0xff, 0xce, 0x42, 0x9c, 0xf8,
+	0x7d, 0x46, 0x8b, 0x67, 0x90, 0xf8, 0x52, 0xb7, 0xd6, 0x08, 0x6f, 0xe1, 0x81, 0xff, 0x32, 0x3a,
+	0x97, 0x1e, 0x12, 0x2c, 0xfe, 0x8e, 0x64, 0x54, 0x02, 0xe8, 0xc9, 0x9c, 0x73, 0x4d, 0xdc, 0xa1,
+	0x5a, 0xf2, 0xa7, 0xf1, 0xa7, 0x1a, 0x3a, 0x17, 0xc5, 0x32, 0x9c, 0xe2, 0xc8, 0x23, 0xc6, 0x94,
+	0x3a, 0x06, 0xca, 0xab, 0xc3, 0xf8, 0x8e, 0x1a, 0xd0, 0x4a, 0x74, 0x30, 0xe3, 0x87, 0x39, 0xf0,
+	0x0e, 0x14, 0x2b, 0xdd, 0x45, 0xa3, 0xb1, 0xea, 0xa4, 0x07, 0x28, 0xd4, 0x1d, 0x8a, 0x74, 0x17,
+	0x30, 0x6b, 0x9d, 0x4a, 0xae, 0xc7, 0x7b, 0xb4, 0xb3, 0x46, 0x7d, 0xa9, 0xc1, 0xe7, 0xd1, 0x50,
+	0x87, 0x37, 0xec, 0x6b, 0x03, 0x40, 0x77, 0x08, 0x5d, 0xa5, 0x4c, 0xa7, 0x92, 0x36, 0x1d, 0x13,
+	0x4d, 0xe7, 0x43, 0x8d, 0xae, 0x60, 0x27, 0x04, 0xbb, 0x82, 0x38, 0x72, 0x04, 0xca, 0xe6, 0x50,
+	0x79, 0x47, 0x5b, 0xf1, 0x87, 0xb1, 0x0e, 0x37, 0xe6, 0xe8, 0xa4, 0x4f, 0xec, 0x89, 0x7e, 0xa1,
+	0xc6, 0x1c, 0xc2, 0x71, 0xa8, 0x11, 0xf9, 0x30, 0x61, 0xfc, 0x71, 0x44, 0x21, 0x96, 0xc0, 0x32,
+	0x1e, 0xa1, 0xa9, 0xdc, 0x71, 0x0e, 0x1a, 0x4f, 0xbc, 0x0c, 0x1b, 0x40, 0x34, 0xa7, 0x6e, 0xf9,
+	0xb1, 0x2f, 0x06, 0xd0, 0xd2, 0xdd, 0x1a, 0x5f, 0x45, 0x93, 0x19, 0xb6, 0x83, 0x42, 0xf8, 0xae,
+	0x06, 0xe9, 0xab, 0x77, 0xdd, 0xe6, 0xe6, 0x22, 0xa5, 0xf1, 0x0e, 0x64, 0x8a, 0xe9, 0x10, 0x7f,
+	0xd7, 0x0c, 0xbc, 0x28, 0x08, 0xd3, 0x4a, 0x04, 0x61, 0x8c, 0x67, 0xc5, 0x83, 0x76, 0x36, 0x91,
+	0xa6, 0x4f, 0x49, 0x48, 0x4d, 0x22, 0x9c, 0x65, 0xa5, 0x31, 0x2c, 0x1a, 0xe6, 0x43, 0x7c, 0x09,
+	0x9d, 0xf0, 0xc8, 0x6e, 0xdb, 0x25, 0x96, 0x19, 0xd8, 0xdf, 0x10, 0x96, 0x33, 0xd8, 0x18, 0x85,
+	0xb6, 0x15, 0xfb, 0x1b, 0xd4, 0xf8, 0x18, 0x4d, 0x24, 0xe1, 0xc1, 0x44, 0xdf, 0x41, 0x43, 0xa4,
+	0xc3, 0xa2, 0x39, 0xc0, 0xf4, 0x3c, 0x1c, 0x38, 0x67, 0x05, 0xae, 0xc0, 0xda, 0xac, 0xd9, 0x6e,
+	0xbd, 0x43, 0xc2, 0x8d, 0xda, 0x12, 0x3f, 0x81, 0x10, 0x00, 0x5e, 0x72, 0x42, 0xc8, 0x6a, 0x09,
+	0x7e, 0xe3, 0x4d, 0x65, 0x23, 0x29, 0x19, 0x9f, 0xd2, 0xa9, 0x2d, 0xd5, 0xba, 0x13, 0xfc, 0x91,
+	0x75, 0xab, 0x89, 0x26, 0xb1, 0x2c, 0x17, 0x93, 0x5b, 0x7c, 0xc9, 0x09, 0xa9, 0xef, 0x90, 0xb6,
+	0x72, 0x4b, 0x57, 0x72, 0x4d, 0x6f, 0x80, 0x75, 0x2f, 0x05, 0xcb, 0xbe, 0xdd, 0xa4, 0x6f, 0x6f,
+	0x10, 0xa7, 0x45, 0xad, 0xd2, 0xf8, 0x7e, 0x78, 0x1c, 0x4d, 0xe5, 0xf2, 0x03, 0xbe, 0x2a, 0x3a,
+	0xde, 0x14, 0x4d, 0x9c, 0x79, 0xb8, 0x21, 0x3f, 0xb1, 0x85, 0x70, 0xb3, 0xeb, 0xfb, 0xd4, 0x09,
+	0x4d, 0x9f, 0x12, 0xcb, 0xf4, 0x18, 0x3b, 0x38, 0x86, 0x3b, 0xa0, 0xef, 0xa9, 0xac, 0xbe, 0xdf,
+	0xa5, 0x2d, 0xd2, 0xdc, 0xbd, 0x4f, 0x9b, 0x8a, 0xd6, 0xef, 0xd3, 0xa6, 0xd0, 0xfa, 0x29, 0x90,
+	0xd8, 0xa0, 0xc4, 0xe2, 0x70, 0x70, 0x17, 0x4d, 0xc9, 0x51, 0x22, 0x8b, 0x0b, 0x5d, 0x9f, 0xc2,
+	0x70, 0x95, 0x43, 0x0d, 0x57, 0x05, 0xd1, 0xcb, 0x60, 0x97, 0x4c, 0xb0, 0x18, 0x76, 0x17, 0xcd,
+	0xc8, 0x61, 0x03, 0xda, 0x74, 0x1d, 0x2b, 0x3d, 0xf0, 0xe0, 0xa1, 0x06, 0xd6, 0x41, 0xf8, 0x8a,
+	0x94, 0xad, 0x0c, 0x1d, 0x20, 0xd9, 0x6b, 0x6e, 0x91, 0xb6, 0x6d, 0x91, 0xd0, 0xf5, 0xcd, 0x90,
+	0xec, 0x98, 0x3e, 0x09, 0x69, 0xf5, 0xd8, 0xa1, 0xc6, 0x9d, 0x04, 0xc9, 0x8f, 0xa5, 0xe0, 0x55,
+	0xb2, 0xd3, 0x20, 0x21, 0xc5, 0x5f, 0x47, 0x63, 0x0e, 0xdd, 0x56, 0x17, 0x72, 0xe8, 0x50, 0x03,
+	0x9d, 0x70, 0xe8, 0x76, 0xbc, 0x88, 0x1d, 0x34, 0xc9, 0xa4, 0xe7, 0x2d, 0xe0, 0xf1, 0x43, 0x0d,
+	0x33, 0xe1, 0xd0, 0xed, 0xec, 0xe2, 0x3d, 0x41, 0xe7, 0xd9, 0x70, 0xf9, 0x0b, 0x37, 0x7c, 0xa8,
+	0x01, 0xcf, 0x39, 0x74, 0x3b, 0x6f, 0xd1, 0x36, 0x11, 0xeb, 0xc9, 0x5b, 0xb0, 0x91, 0x43, 0x8d,
+	0x77, 0xc6, 0xa1, 0xdb, 0xe9, 0xc5, 0x8a, 0x7c, 0xd2, 0x07, 0x5d, 0x37, 0xa4, 0x1f, 0x7a, 0x16,
+	0x09, 0x29, 0xcb, 0xe3, 0x97, 0xde, 0xf3, 0xaf, 0xa1, 0xe9, 0x7c, 0xfe, 0xf8, 0xee, 0xde, 0xf5,
+	0x2c, 0xf0, 0xca, 0x43, 0xc2, 0x2b, 0x8b, 0x86, 0xf9, 0xd0, 0x70, 0xe0, 0xee, 0xa6, 0x1c, 0xb7,
+	0xc1, 0x83, 0x1d, 0x3b, 0x08, 0x95, 0x2c, 0x4d, 0x74, 0x54, 0x42, 0x96, 0x46, 0x46, 0xf4, 0xb7,
+	0xd1, 0x71, 0x71, 0x88, 0x8b, 0x60, 0xa6, 0xdf, 0x59, 0x21, 0x09, 0x8d, 0xcf, 0xe4, 0xa5, 0x2a,
+	0x67, 0x40, 0xc0, 0xbb, 0x8c, 0x86, 0x28, 0x6b, 0x90, 0x39, 0xad, 0xbb, 0x49, 0xff, 0xd9, 0x9f,
+	0xbb, 0xc6, 0xbf, 0x82, 0x07, 0x4e, 0xe8, 0xef, 0x36, 0x40, 0x8e, 0x7e, 0x0f, 0x8d, 0x2a, 0xcd,
+	0xf8, 0x14, 0xaa, 0x6c, 0xd2, 0x5d, 0x98, 0x0d, 0xfb, 0xc9, 0x62, 0xff, 0x2d, 0xd2, 0xee, 0x0a,
+	0x7f, 0x37, 0xdc, 0x10, 0x1f, 0xaf, 0x0e, 0xdc, 0xd5, 0x8c, 0x2e, 0x9a, 0x8c, 0x07, 0x4c, 0x6a,
+	0xe6, 0x10, 0xe1, 0xf7, 0x05, 0xc9, 0xca, 0x96, 0x14, 0xb4, 0x07, 0x04, 0x6c, 0x49, 0x03, 0xe3,
+	0x55, 0x34, 0x95, 0x1e, 0x36, 0x15, 0x31, 0xc8, 0x45, 0x11, 0x5a, 0x1a, 0x69, 0x0c, 0xc3, 0xaa,
+	0x04, 0xc6, 0xa7, 0x32, 0x6d, 0x98, 0xc0, 0x0c, 0xca, 0xfd, 0x6a, 0x4a, 0xb9, 0xb7, 0x8b, 0x94,
+	0xfb, 0xcb, 0x55, 0xeb, 0xe7, 0x1a, 0xba, 0x05, 0x2f, 0x57, 0xbb, 0x1d, 0xea, 0x84, 0x90, 0xad,
+	0x11, 0x67, 0xe2, 0x62, 0xdb, 0xdd, 0x66, 0x3b, 0xe3, 0x5d, 0x96, 0xb3, 0x95, 0x53, 0x9e, 0x47,
+	0xe3, 0x9e, 0xa0, 0x35, 0x89, 0x20, 0xde, 0x57, 0xe3, 0x63, 0x5e, 0x42, 0xb8, 0x92, 0x3c, 0x2f,
+	0x17, 0xf5, 0xc2, 0xbe, 0x8b, 0x96, 0x4c, 0xdd, 0x86, 0x95, 0xcc, 0x36, 0xfc, 0x54, 0x43, 0xb5,
+	0xb2, 0x53, 0x82, 0xc5, 0x38, 0x8b, 0x86, 0xec, 0xc0, 0x0c, 0x68, 0x08, 0x87, 0xf1, 0x31, 0x3b,
+	0x58, 0xa1, 0x21, 0xfe, 0x55, 0x34, 0xbe, 0xde, 0x76, 0xb7, 0xb9, 0xc3, 0x31, 0x79, 0xe2, 0xba,
+	0x3a, 0x70, 0xc0, 0xb8, 0xe7, 0xe4, 0xba, 0x3a, 0xb0, 0xb1, 0x86, 0xaa, 0x09, 0x88, 0x5d, 0xcb,
+	0x0e, 0x8f, 0xf8, 0x1a, 0x66, 0xfc, 0x9d, 0x86, 0xce, 0xe7, 0x0c, 0x02, 0x53, 0xfe, 0x00, 0x9d,
+	0xb4, 0xec, 0xa0, 0xe9, 0x53, 0x8f, 0x38, 0x4d, 0x9b, 0x4a, 0x33, 0xbc, 0x98, 0x7e, 0x96, 0xe4,
+	0xac, 0xf7, 0x23, 0xca, 0x5d, 0xf5, 0x89, 0x32, 0x29, 0xe1, 0xe8, 0x9e, 0x35, 0xf6, 0x94, 0xe7,
+	0x23, 0x91, 0xf0, 0x7d, 0x4c, 0x7d, 0x35, 0x8f, 0x75, 0xe8, 0x17, 0x35, 0x16, 0x7f, 0x6d, 0x09,
+	0x99, 0xdc, 0x82, 0x2a, 0x0d, 0xf9, 0x69, 0xfc, 0xa3, 0x74, 0x8c, 0x39, 0xa3, 0x83, 0xee, 0x16,
+	0xd0, 0x18, 0x48, 0x97, 0x32, 0x72, 0xf3, 0xca, 0x49, 0xe6, 0x93, 0xae, 0xfa, 0xf9, 0xcb, 0x7b,
+	0x7b, 0x5b, 0x43, 0xb3, 0xd1, 0x0d, 0x3a, 0x81, 0x20, 0x38, 0xba, 0xf7, 0xc8, 0x6f, 0x69, 0xe8,
+	0x42, 0xe1, 0x20, 0x91, 0x92, 0x86, 0x41, 0x3b, 0xd2, 0xb6, 0xfa, 0xa9, 0x47, 0x35, 0xab, 0x88,
+	0x8f, 0x5d, 0x55, 0x3a, 0x64, 0xc7, 0x8c, 0xe4, 0x0c, 0xf0, 0x8c, 0xd0, 0x68, 0x87, 0xec, 0xc8,
+	0xe1, 0x8c, 0x5f, 0x68, 0xca, 0x7c, 0x65, 0xbe, 0x9d, 0xbd, 0xc0, 0x93, 0xd6, 0x51, 0xe7, 0x35,
+	0x26, 0xd1, 0xf1, 0x90, 0xb4, 0x4c, 0xe6, 0x5b, 0x85, 0x4a, 0x86, 0x42, 0xd2, 0xfa, 0x15, 0xba,
+	0xcb, 0x93, 0xf2, 0xa4, 0x65, 0x0a, 0x17, 0x5b, 0x81, 0xa4, 0x3c, 0x69, 0x3d, 0x66, 0xdf, 0xd9,
+	0xa7, 0x82, 0xc1, 0xa7, 0x7c, 0x2a, 0x30, 0xfe, 0x42, 0xd5, 0x76, 0x7a, 0x8a, 0xa0, 0xed, 0x57,
+	0xd1, 0x88, 0xe4, 0x91, 0xea, 0x4e, 0xe5, 0xf4, 0x56, 0x49, 0x4b, 0x5c, 0x40, 0x38, 0x51, 0x23,
+	0x26, 0x3f, 0xba, 0x7d, 0x2b, 0x03, 0x28, 0x59, 0x2f, 0x40, 0x2c, 0x16, 0x0a, 0x95, 0xbf, 0xd4,
+	0xfd, 0x4c, 0x66, 0xfa, 0x33, 0x02, 0x60, 0x96, 0x73, 0x08, 0x37, 0x37, 0x88, 0xdf, 0xa2, 0x96,
+	0x08, 0xa9, 0x9f, 0xb0, 0x5e, 0x2e, 0x68, 0xb0, 0x71, 0x0a, 0x7a, 0x22, 0x2e, 0x76, 0x07, 0x24,
+	0xdd, 0xd0, 0x35, 0x43, 0xd7, 0x8b, 0x36, 0x56, 0xca, 0xbf, 0x45, 0xc4, 0xf3, 0xdd, 0xd0, 0x5d,
+	0x65, 0x74, 0x8d, 0x11, 0x22, 0x7f, 0xe2, 0xfb, 0x68, 0xb4, 0xe9, 0x3a, 0x41, 0xb7, 0xe3, 0x85,
+	0xd2, 0x51, 0x8c, 0xde, 0x36, 0x0a, 0x24, 0xbc, 0x1d, 0x53, 0x36, 0x54, 0x36, 0xe3, 0x5f, 0x65,
+	0x5a, 0x7e, 0xc1, 0x6e, 0xb7, 0x6d, 0xa7, 0x15, 0x25, 0x29, 0xa3, 0x0d, 0x79, 0x55, 0x39, 0x52,
+	0xd5, 0x3b, 0x7f, 0x7c, 0x70, 0x8a, 0x56, 0x96, 0xf2, 0x11, 0x8f, 0xa6, 0xa1, 0x0d, 0xfb, 0xb2,
+	0xc2, 0x33, 0x9d, 0x7e, 0xc8, 0x02, 0x4d, 0x16, 0x22, 0x52, 0xc7, 0x12, 0x9d, 0xe0, 0xd5, 0xa8,
+	0x63, 0xf1, 0xae, 0xe4, 0x1e, 0x18, 0x3c, 0xf0, 0xa1, 0xf2, 0xbf, 0x72, 0xbb, 0xe5, 0x4c, 0x06,
+	0x16, 0xe9, 0x7e, 0x22, 0xbf, 0x2c, 0x6c, 0x31, 0xf5, 0xb4, 0x9f, 0x66, 0xce, 0xc9, 0x30, 0xbf,
+	0x87, 0xc6, 0x5d, 0x8f, 0x3a, 0xa6, 0x22, 0x6a, 0xe0, 0x29, 0x44, 0x8d, 0x31, 0xe6, 0x18, 0x5c,
+	0xca, 0xc6, 0x2b, 0x07, 0xb7, 0xf1, 0xb7, 0x12, 0x26, 0xca, 0x46, 0x5f, 0xea, 0x78, 0xa4, 0x7c,
+	0xb1, 0x87, 0xf1, 0x49, 0x45, 0x9a, 0x43, 0x46, 0x02, 0x28, 0xf0, 0x32, 0x1a, 0x13, 0x99, 0x68,
+	0x7b, 0x8b, 0x8a, 0xc5, 0x14, 0xf5, 0x46, 0x27, 0xa3, 0x56, 0x58, 0xd2, 0x93, 0xca, 0x15, 0x1e,
+	0x72, 0x04, 0xb1, 0x97, 0x95, 0x07, 0xc6, 0x8a, 0x17, 0xdf, 0xa7, 0x40, 0x39, 0x27, 0xe2, 0xfb,
+	0x79, 0x93, 0x45, 0xa2, 0xe3, 0x9e, 0xef, 0x32, 0x5f, 0x4c, 0x2d, 0xe5, 0xfa, 0x5f, 0x4a, 0xd2,
+	0x58, 0xc4, 0x29, 0x64, 0x7d, 0x84, 0x4e, 0x4b, 0x4c, 0x51, 0xe4, 0x04, 0x77, 0xfa, 0x9b, 0x4f,
+	0x11, 0x33, 0x35, 0xc6, 0x41, 0x8a, 0x0c, 0xd7, 0xf0, 0xaf, 0xa3, 0x33, 0x31, 0xc8, 0x58, 0xf4,
+	0xb1, 0xa7, 0x17, 0x7d, 0x3a, 0x92, 0x23, 0x85, 0x47, 0xb9, 0x1e, 0xb1, 0x22, 0x6f, 0x93, 0x76,
+	0x7b, 0x8d, 0x34, 0x37, 0x4b, 0xaf, 0xe8, 0x47, 0x68, 0x2a, 0x97, 0x3d, 0x7a, 0x80, 0x1e, 0x6e,
+	0x42, 0x5b, 0xbf, 0x87, 0xf2, 0x88, 0x2f, 0xa2, 0x36, 0x16, 0xd1, 0xb3, 0x8a, 0x60, 0x1e, 0xfe,
+	0x06, 0x1b, 0xb6, 0xb7, 0xea, 0x13, 0x27, 0x58, 0xa7, 0x7e, 0x69, 0x80, 0x36, 0x7a, 0xae, 0xbf,
+	0x1c, 0x40, 0x3a, 0x8f, 0x86, 0x43, 0x68, 0xab, 0x6a, 0x6a, 0x1c, 0x92, 0x44, 0x9a, 0x15, 0x10,
+	0xb1, 0xdd, 0xfe, 0xa7, 0x17, 0xd0, 0x31, 0x3e, 0x16, 0xde, 0x44, 0x43, 0xa2, 0xa8, 0x0d, 0x5f,
+	0xcc, 0xb9, 0xda, 0x24, 0xaa, 0xf9, 0xf4, 0x4b, 0x7d, 0x28, 0x04, 0x36, 0x63, 0xfa, 0xb7, 0x7e,
+	0xf6, 0xdf, 0xdf, 0x1e, 0x38, 0x87, 0x27, 0xea, 0x39, 0x35, 0x86, 0xf8, 0xbb, 0xf2, 0x55, 0x23,
+	0x53, 0x80, 0x87, 0x6f, 0x16, 0xca, 0xce, 0x56, 0xf9, 0xe9, 0x73, 0xe5, 0x88, 0x01, 0xd3, 0x35,
+	0x8e, 0xc9, 0xc0, 0x17, 0xf3, 0x30, 0xd5, 0xf7, 0xa2, 0xf2, 0xc0, 0x1e, 0xfe, 0x3d, 0x0d, 0xa1,
+	0x38, 0x55, 0x89, 0x9f, 0xcb, 0x19, 0x26, 0x53, 0xdf, 0xa7, 0x5f, 0xde, 0x87, 0x0a, 0x50, 0xd4,
+	0x39, 0x8a, 0xeb, 0xf8, 0x6a, 0x12, 0xc5, 0x06, 0x3b, 0x20, 0x85, 0x11, 0xd4, 0xf7, 0x14, 0xfb,
+	0xe8, 0xe1, 0x3f, 0xd2, 0xd0, 0x58, 0xb2, 0x24, 0x10, 0x5f, 0xeb, 0x3b, 0x94, 0x72, 0xe3, 0x2d,
+	0x0b, 0xea, 0x45, 0x0e, 0xea, 0x16, 0xbe, 0x59, 0x08, 0xca, 0x5c, 0x63, 0x69, 0xf6, 0x08, 0x9a,
+	0x6d, 0xf5, 0xf0, 0xef, 0x68, 0xe8, 0x64, 0x2c, 0xeb, 0xd1, 0xe2, 0x2a, 0x9e, 0xc9, 0x19, 0x2d,
+	0xae, 0x5c, 0xd1, 0xf3, 0xf4, 0x98, 0x29, 0x55, 0x31, 0x9e, 0xe7, 0x58, 0x6e, 0xe0, 0x6b, 0xc5,
+	0x58, 0x9c, 0xf5, 0xb0, 0xbe, 0x27, 0xeb, 0x31, 0x7a, 0xf8, 0x8f, 0x61, 0xb9, 0x44, 0x88, 0x5a,
+	0xb8, 0x5c, 0x89, 0x32, 0x3f, 0xfd, 0xf2, 0x3e, 0x54, 0x80, 0xe6, 0x0d, 0x8e, 0xe6, 0x15, 0xfc,
+	0x72, 0x0e, 0x1a, 0x11, 0x62, 0x27, 0x97, 0xab, 0xbe, 0xa7, 0xc4, 0xe2, 0xf1, 0xe2, 0xc5, 0x35,
+	0x8a, 0x85, 0x8b, 0x97, 0x29, 0x63, 0x2c, 0x0b, 0xb1, 0xdf, 0xe2, 0x01, 0x18, 0x58, 0xbc, 0xa8,
+	0x28, 0xb2, 0x87, 0x7f, 0xa8, 0xa1, 0x53, 0xe9, 0x7a, 0x3f, 0x7c, 0xa3, 0x60, 0xc0, 0x9c, 0x32,
+	0x49, 0xfd, 0x66, 0x29, 0x5a, 0x80, 0x78, 0x9f, 0x43, 0x7c, 0x13, 0xbf, 0x9e, 0x03, 0x31, 0xe0,
+	0x0c, 0x65, 0x94, 0x29, 0x0d, 0x2e, 0xaa, 0x44, 0x3a, 0x88, 0xc1, 0x65, 0xca, 0x98, 0xfa, 0x1a,
+	0x9c, 0x1c, 0x3f, 0x69, 0x70, 0xbf, 0xa9, 0xa1, 0x51, 0xa5, 0xcc, 0x10, 0xe7, 0x2d, 0x54, 0xb6,
+	0xd4, 0x51, 0xbf, 0xb2, 0x1f, 0x19, 0x00, 0x32, 0x38, 0xa0, 0x69, 0xac, 0x27, 0x01, 0xb5, 0xed,
+	0x20, 0x84, 0x1d, 0x10, 0xe0, 0xdf, 0x07, 0x08, 0x62, 0x3a, 0xc5, 0x10, 0x92, 0x65, 0x86, 0xfa,
+	0x95, 0xfd, 0xc8, 0xfa, 0xeb, 0x84, 0x43, 0x10, 0x3a, 0x09, 0x52, 0x6e, 0xea, 0x33, 0x0d, 0x9d,
+	0xcd, 0xad, 0xfd, 0xc3, 0xf5, 0xfe, 0x63, 0x66, 0xaa, 0x04, 0x4b, 0x83, 0x7c, 0x8d, 0x83, 0x7c,
+	0x19, 0xbf, 0x58, 0x0c, 0x92, 0x59, 0x7e, 0xe4, 0xb2, 0x12, 0xde, 0xeb, 0xb7, 0x35, 0x74, 0x22,
+	0x7a, 0x6c, 0x2d, 0x61, 0x4b, 0xcf, 0x16, 0x65, 0xfc, 0x54, 0x53, 0xea, 0xe7, 0xdc, 0x21, 0x73,
+	0x99, 0xb4, 0xa4, 0xef, 0x68, 0x68, 0x54, 0x29, 0x1c, 0xcb, 0x5d, 0xc6, 0x6c, 0x41, 0x9b, 0x7e,
+	0x65, 0x3f, 0x32, 0xc0, 0xf3, 0x26, 0xc7, 0x73, 0x17, 0xdf, 0x49, 0xe2, 0x71, 0xd6, 0x43, 0x33,
+	0xaa, 0x4b, 0xab, 0xef, 0x25, 0x2e, 0xbc, 0x3d, 0x15, 0xde, 0xdf, 0x6b, 0x50, 0x44, 0x91, 0xae,
+	0x0f, 0xcb, 0x75, 0x15, 0x05, 0x75, 0x6c, 0xfa, 0xcd, 0x52, 0xb4, 0x00, 0xf9, 0x21, 0x87, 0x3c,
+	0x8f, 0xdf, 0x4a, 0x9d, 0xd2, 0x9c, 0xde, 0x5c, 0x77, 0x7d, 0x99, 0xc7, 0x8c, 0x71, 0xf7, 0xea,
+	0x7b, 0x99, 0x5a, 0xb8, 0x1e, 0xfe, 0x07, 0x0d, 0x9d, 0x4a, 0x57, 0x6b, 0xe5, 0xc2, 0x2e, 0x28,
+	0x54, 0xd3, 0x6f, 0x96, 0xa2, 0x05, 0xd8, 0x8f, 0x38, 0xec, 0x77, 0xf0, 0x62, 0x12, 0xf6, 0x16,
+	0xa7, 0x37, 0x95, 0xff, 0xb0, 0xb1, 0x27, 0x0b, 0xb5, 0x7a, 0x69, 0x5f, 0xa7, 0xd4, 0x5c, 0xf5,
+	0xf0, 0x8f, 0x35, 0x74, 0x3a, 0x53, 0x40, 0x95, 0x1b, 0x1d, 0x15, 0x95, 0x8f, 0xe9, 0x73, 0xe5,
+	0x88, 0x61, 0x02, 0xef, 0xf3, 0x09, 0x2c, 0xe1, 0x87, 0xc9, 0x09, 0x50, 0xc1, 0x70, 0x80, 0x19,
+	0x7c, 0x5b, 0x43, 0x23, 0xd1, 0x06, 0xc3, 0xcf, 0x16, 0x1c, 0x17, 0x6a, 0x15, 0x82, 0xfe, 0x5c,
+	0x7f, 0xa2, 0xfe, 0xdb, 0x3e, 0xde, 0x64, 0xf5, 0x3d, 0xe5, 0x81, 0xa1, 0x27, 0xbf, 0x84, 0x9b,
+	0x62, 0xa1, 0x5d, 0x5c, 0xad, 0x92, 0x1b, 0x2b, 0x64, 0x4a, 0x6d, 0xf4, 0xcb, 0xfb, 0x50, 0xf5,
+	0xdf, 0xfd, 0xdc, 0x1f, 0x71, 0x0c, 0x41, 0x12, 0x19, 0xfe, 0x03, 0x0d, 0x8d, 0xa7, 0x0a, 0x3e,
+	0xf0, 0xf5, 0x7e, 0x3a, 0x48, 0xd4, 0xaf, 0xe8, 0x37, 0xca, 0x90, 0x02, 0xb6, 0xab, 0x1c, 0xdb,
+	0x25, 0x7c, 0xa1, 0xd0, 0x33, 0x41, 0x89, 0xcb, 0xdf, 0xc8, 0x62, 0x87, 0x64, 0x01, 0x47, 0x6e,
+	0xd8, 0x92, 0x5b, 0x4b, 0xa2, 0x5f, 0x2f, 0x41, 0x09, 0xa8, 0x16, 0x39, 0xaa, 0xaf, 0xe0, 0x37,
+	0x0b, 0x37, 0x3b, 0x2c, 0x68, 0xee, 0x56, 0x97, 0x6f, 0x39, 0x3d, 0x76, 0x1a, 0x8e, 0xa7, 0xca,
+	0x3d, 0x72, 0x97, 0x36, 0x53, 0x44, 0xa2, 0x5f, 0xde, 0x87, 0x0a, 0x80, 0xd6, 0x38, 0xd0, 0x6b,
+	0xf8, 0x4a, 0x2e, 0x50, 0x08, 0xaf, 0xa2, 0x6a, 0x94, 0x1e, 0xee, 0xa2, 0x13, 0x6a, 0x49, 0x06,
+	0xce, 0xbb, 0x32, 0x25, 0xab, 0x49, 0x74, 0xa3, 0x1f, 0x09, 0xc0, 0x98, 0xe5, 0x30, 0xaa, 0xf8,
+	0x5c, 0xca, 0xc2, 0xdc, 0xe6, 0xa6, 0xb9, 0x4e, 0x29, 0xfe, 0x1e, 0x18, 0x94, 0x52, 0x63, 0x51,
+	0x68, 0x50, 0xd9, 0x3a, 0x0e, 0xfd, 0x46, 0x19, 0x52, 0x80, 0xf2, 0x32, 0x87, 0x52, 0xc7, 0xb7,
+	0x8a, 0xc3, 0x74, 0x5e, 0x9e, 0x91, 0x0a, 0x13, 0xbe, 0x2f, 0xcd, 0x2b, 0x59, 0x69, 0x91, 0x6b,
+	0x5e, 0xb9, 0xc5, 0x1c, 0xfa, 0xf5, 0x12, 0x94, 0x80, 0xf1, 0x25, 0x8e, 0xb1, 0x86, 0xe7, 0x92,
+	0x18, 0xed, 0x40, 0x24, 0x4e, 0x4c, 0x28, 0xe2, 0x48, 0x41, 0xfc, 0x73, 0x0d, 0xea, 0x69, 0x78,
+	0xa2, 0x30, 0x7e, 0x19, 0xce, 0xd5, 0x64, 0xfe, 0xeb, 0xb3, 0x7e, 0xa3, 0x0c, 0x69, 0x7f, 0x4d,
+	0xf2, 0x6c, 0xa9, 0x09, 0x4f, 0xd0, 0xa1, 0xdd, 0xa1, 0x29, 0x98, 0x7f, 0x2b, 0x2f, 0xd1, 0x99,
+	0x47, 0xdd, 0xdc, 0x63, 0xa2, 0xe8, 0xa5, 0x5a, 0x9f, 0x2b, 0x47, 0xdc, 0x3f, 0xa2, 0x50, 0x5d,
+	0x48, 0x60, 0xf2, 0x87, 0x4e, 0xe9, 0xeb, 0x6c, 0xab, 0x57, 0xdf, 0x83, 0x9e, 0x1e, 0xfe, 0x54,
+	0x43, 0xa7, 0xd2, 0xaf, 0xa5, 0xb9, 0x51, 0x4f, 0xf6, 0xe5, 0x58, 0xbf, 0xb2, 0x1f, 0x59, 0x09,
+	0x8c, 0x29, 0x70, 0xd9, 0x23, 0x22, 0xe8, 0xe1, 0xef, 0x49, 0x03, 0x48, 0x3d, 0x23, 0xe7, 0x1a,
+	0x40, 0xfe, 0x53, 0x73, 0x69, 0xac, 0x05, 0x26, 0xaa, 0x62, 0x95, 0xee, 0x45, 0xaa, 0x33, 0xe8,
+	0xe1, 0x6f, 0x0e, 0xa0, 0x2b, 0xe5, 0x1e, 0x4d, 0xf1, 0x6b, 0xb9, 0x39, 0x92, 0x72, 0xaf, 0xc7,
+	0xfa, 0xeb, 0x07, 0x63, 0x86, 0xb9, 0x7d, 0x9d, 0xcf, 0xed, 0x31, 0x5e, 0x4d, 0x27, 0x5c, 0x12,
+	0xef, 0xd1, 0xd2, 0x5b, 0xa4, 0xde, 0x6e, 0xeb, 0x7b, 0x29, 0xba, 0x54, 0xb4, 0x81, 0x7f, 0x57,
+	0x43, 0xa7, 0x33, 0x0f, 0xa6, 0xf8, 0x4a, 0x1f, 0xc4, 0xca, 0xb3, 0xad, 0x7e, 0x75, 0x5f, 0x3a,
+	0x98, 0xc4, 0xb3, 0x7c, 0x12, 0x33, 0x78, 0xaa, 0x60, 0x12, 0x7c, 0x54, 0x16, 0xad, 0x65, 0x1e,
+	0x20, 0xf1, 0xcd, 0xbe, 0xf7, 0xf7, 0xe4, 0x23, 0xa9, 0x3e, 0x57, 0x8e, 0xb8, 0x7f, 0xb4, 0xa6,
+	0xde, 0x59, 0xe1, 0x19, 0xae, 0xdf, 0x8d, 0xba, 0xbe, 0x07, 0x44, 0x3c, 0xd2, 0xc7, 0xd9, 0xe7,
+	0x41, 0x3c, 0xd7, 0xf7, 0x2a, 0x96, 0x7a, 0xaa, 0xd4, 0x6f, 0x95, 0xa4, 0xee, 0x7f, 0xfa, 0x2b,
+	0xf7, 0x37, 0x39, 0x89, 0xa0, 0x6f, 0x5e, 0xe0, 0x07, 0x80, 0x3d, 0xf9, 0xd8, 0x56, 0x88, 0x3d,
+	0xf7, 0xd9, 0x51, 0xbf, 0x55, 0x92, 0xba, 0xbf, 0xd3, 0xe6, 0xd8, 0xa3, 0xb7, 0x3a, 0xb6, 0x73,
+	0x43, 0xd2, 0xaa, 0xef, 0xc1, 0x43, 0x24, 0x77, 0x2d, 0xe3, 0xa9, 0xe7, 0xb2, 0x5c, 0xaf, 0x92,
+	0xff, 0x26, 0xa7, 0xdf, 0x28, 0x43, 0xda, 0x1f, 0x21, 0xe8, 0x31, 0x7e, 0x90, 0x4b, 0x6d, 0xab,
+	0x3f, 0xd1, 0xd0, 0x58, 0x32, 0xc5, 0x9d, 0x7b, 0x36, 0xe7, 0x26, 0xdf, 0xf5, 0xeb, 0x25, 0x28,
+	0xfb, 0x67, 0xad, 0x00, 0x8b, 0x4c, 0xaa, 0xa7, 0xc0, 0xfd, 0x58, 0x43, 0x93, 0x05, 0x59, 0x6d,
+	0xfc, 0x42, 0xe1, 0xd8, 0x45, 0xa9, 0x78, 0xfd, 0xf6, 0xd3, 0xb0, 0xf4, 0x4f, 0x08, 0xaa, 0x15,
+	0x32, 0x8c, 0xcf, 0x94, 0x39, 0xf6, 0xd4, 0x0c, 0xfe, 0x4a, 0x43, 0xa7, 0x33, 0x8f, 0x71, 0xb9,
+	0x9e, 0xa2, 0xe8, 0xfd, 0x51, 0x9f, 0x2b, 0x47, 0x0c, 0x78, 0x5f, 0xe5, 0x78, 0x5f, 0xc2, 0xb7,
+	0x53, 0x78, 0x05, 0x83, 0xf2, 0x60, 0xa7, 0x38, 0x5c, 0x79, 0x85, 0xfe, 0x33, 0x0d, 0x9d, 0x4a,
+	0xbf, 0x7b, 0xe1, 0x62, 0x1b, 0xcc, 0x3c, 0xaf, 0xe9, 0x37, 0x4b, 0xd1, 0x02, 0xd2, 0x3b, 0x1c,
+	0xe9, 0xf3, 0xb8, 0x96, 0xab, 0x59, 0x06, 0xd8, 0xb4, 0x39, 0x47, 0x52, 0xa5, 0x0b, 0x8b, 0x3f,
+	0xf9, 0x62, 0x56, 0xfb, 0xfc, 0x8b, 0x59, 0xed, 0x17, 0x5f, 0xcc, 0x6a, 0x9f, 0x7c, 0x39, 0xfb,
+	0xcc, 0xe7, 0x5f, 0xce, 0x3e, 0xf3, 0x1f, 0x5f, 0xce, 0x3e, 0xf3, 0xb5, 0xb9, 0x96, 0x1d, 0x6e,
+	0x74, 0xd7, 0x6a, 0x4d, 0xb7, 0xc3, 0x65, 0x36, 0x37, 0x88, 0xed, 0x08, 0xe9, 0x5b, 0xb7, 0xeb,
+	0x3b, 0xc9, 0xbf, 0x6f, 0xb0, 0x36, 0xc4, 0xff, 0x82, 0xc1, 0x8b, 0xff, 0x37, 0x00, 0x13, 0xfa,
+	0xab, 0x5e, 0x38, 0x42, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Parameters queries the parameters of the module.
+	QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
+	// Queries a bucket with specify name.
+	HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
+	// Queries a bucket by id
+	HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error)
+	// Queries a bucket with EIP712 standard metadata info
+	HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error)
+	// Queries a object with specify name.
+	HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
+	// Queries an object by id
+	HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error)
+	// Queries a shadow object with specify name.
+	HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error)
+	// Queries a object with EIP712 standard metadata info
+	HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error)
+	// Queries a list of bucket items.
+	ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error)
+	// Queries a list of object items under the bucket.
+	ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
+	// Queries a list of object items under the bucket.
+	ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error)
+	// Queries a group with EIP712 standard metadata info
+	HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error)
+	// Queries the ERC-721 token uri of a bucket, object or group NFT, a data uri of the metadata json built from the chain state
+	NFTTokenURI(ctx context.Context, in *QueryNFTTokenURIRequest, opts ...grpc.CallOption) (*QueryNFTTokenURIResponse, error)
+	// Queries a policy which grants permission to account
+	QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error)
+	// Queries a list of VerifyPermission items.
+	VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error)
+	// Queries how the permission of the operator for the bucket/object's action is evaluated.
+	ExplainPermission(ctx context.Context, in *QueryExplainPermissionRequest, opts ...grpc.CallOption) (*QueryExplainPermissionResponse, error)
+	// Queries a group with specify owner and name .
+	HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error)
+	// Queries a list of ListGroup items.
+	ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error)
+	// Queries a list of HeadGroupMember items.
+	HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error)
+	// Queries a policy that grants permission to a group
+	QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error)
+	// Queries a policy by policy id
+	QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error)
+	// Queries lock fee for storing an object
+	QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error)
+	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
+	HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error)
+	// Queries whether read and storage prices changed for the bucket.
+	QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error)
+	// Queries whether read and storage prices changed for the bucket.
+	QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error)
+	// Queries whether some members are in the group.
+	QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error)
+	// Queries whether some groups are exist.
+	QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
+	// Queries whether some groups are exist by id.
+	QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error)
+	// Queries the flow rate limit of a bucket for a payment account
+	QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+	// Queries the payment audit report, i.e. the stream records whose lock balance or net flow rate
+	// do not match the ones expected from the buckets and objects. The whole audit is run for each page,
+	// so it is an expensive query meant for node operators, and it fails on chains with more than 10000 buckets.
+	QueryPaymentAudit(ctx context.Context, in *QueryPaymentAuditRequest, opts ...grpc.CallOption) (*QueryPaymentAuditResponse, error)
+	// Queries a version of an object, either the current or a prior one
+	HeadObjectVersion(ctx context.Context, in *QueryHeadObjectVersionRequest, opts ...grpc.CallOption) (*QueryHeadObjectVersionResponse, error)
+	// Queries the prior versions of an object
+	ListObjectVersions(ctx context.Context, in *QueryListObjectVersionsRequest, opts ...grpc.CallOption) (*QueryListObjectVersionsResponse, error)
+	// Queries the buckets, objects and groups which have the tag
+	ListResourcesByTag(ctx context.Context, in *QueryListResourcesByTagRequest, opts ...grpc.CallOption) (*QueryListResourcesByTagResponse, error)
+	// Queries the charged read quota of a bucket, its auto topup and the consumption reported by its primary SP
+	BucketReadQuota(ctx context.Context, in *QueryBucketReadQuotaRequest, opts ...grpc.CallOption) (*QueryBucketReadQuotaResponse, error)
+	// Queries the contract notified when the objects of a bucket are sealed, rejected or deleted
+	BucketCallback(ctx context.Context, in *QueryBucketCallbackRequest, opts ...grpc.CallOption) (*QueryBucketCallbackResponse, error)
+	// Queries the pending transfer of the ownership of a bucket
+	BucketOwnershipTransfer(ctx context.Context, in *QueryBucketOwnershipTransferRequest, opts ...grpc.CallOption) (*QueryBucketOwnershipTransferResponse, error)
+	// Queries the billing statements of a payment account whose billing periods end in a time range, along with the
+	// open billing periods overlapping it
+	BillingStatements(ctx context.Context, in *QueryBillingStatementsRequest, opts ...grpc.CallOption) (*QueryBillingStatementsResponse, error)
+	// Queries how the bill of a bucket changes once it is charged by the global store price taking effect next
+	BucketBillImpact(ctx context.Context, in *QueryBucketBillImpactRequest, opts ...grpc.CallOption) (*QueryBucketBillImpactResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/HeadGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadGroup(ctx, req.(*QueryHeadGroupRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListGroupsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
+	out := new(QueryParamsByTimestampResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryParamsByTimestamp", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListGroups(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/ListGroups",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListGroups(ctx, req.(*QueryListGroupsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadGroupMemberRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadBucket(ctx context.Context, in *QueryHeadBucketRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
+	out := new(QueryHeadBucketResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadBucket", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadGroupMember(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/HeadGroupMember",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadGroupMember(ctx, req.(*QueryHeadGroupMemberRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryPolicyForGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPolicyForGroupRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadBucketById(ctx context.Context, in *QueryHeadBucketByIdRequest, opts ...grpc.CallOption) (*QueryHeadBucketResponse, error) {
+	out := new(QueryHeadBucketResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadBucketById", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPolicyForGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/QueryPolicyForGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPolicyForGroup(ctx, req.(*QueryPolicyForGroupRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryPolicyById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPolicyByIdRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadBucketNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryBucketNFTResponse, error) {
+	out := new(QueryBucketNFTResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadBucketNFT", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPolicyById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/QueryPolicyById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPolicyById(ctx, req.(*QueryPolicyByIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryLockFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLockFeeRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadObject(ctx context.Context, in *QueryHeadObjectRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
+	out := new(QueryHeadObjectResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadObject", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryLockFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/QueryLockFee",
+	return out, nil
+}
+
+func (c *queryClient) HeadObjectById(ctx context.Context, in *QueryHeadObjectByIdRequest, opts ...grpc.CallOption) (*QueryHeadObjectResponse, error) {
+	out := new(QueryHeadObjectResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadObjectById", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryLockFee(ctx, req.(*QueryLockFeeRequest))
+	return out, nil
+}
+
+func (c *queryClient) HeadShadowObject(ctx context.Context, in *QueryHeadShadowObjectRequest, opts ...grpc.CallOption) (*QueryHeadShadowObjectResponse, error) {
+	out := new(QueryHeadShadowObjectResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadShadowObject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_HeadBucketExtra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeadBucketExtraRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadObjectNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryObjectNFTResponse, error) {
+	out := new(QueryObjectNFTResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadObjectNFT", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeadBucketExtra(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) ListBuckets(ctx context.Context, in *QueryListBucketsRequest, opts ...grpc.CallOption) (*QueryListBucketsResponse, error) {
+	out := new(QueryListBucketsResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/ListBuckets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/HeadBucketExtra",
+	return out, nil
+}
+
+func (c *queryClient) ListObjects(ctx context.Context, in *QueryListObjectsRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
+	out := new(QueryListObjectsResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/ListObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeadBucketExtra(ctx, req.(*QueryHeadBucketExtraRequest))
+	return out, nil
+}
+
+func (c *queryClient) ListObjectsByBucketId(ctx context.Context, in *QueryListObjectsByBucketIdRequest, opts ...grpc.CallOption) (*QueryListObjectsResponse, error) {
+	out := new(QueryListObjectsResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/ListObjectsByBucketId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryIsPriceChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsPriceChangedRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) HeadGroupNFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryGroupNFTResponse, error) {
+	out := new(QueryGroupNFTResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadGroupNFT", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryIsPriceChanged(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) NFTTokenURI(ctx context.Context, in *QueryNFTTokenURIRequest, opts ...grpc.CallOption) (*QueryNFTTokenURIResponse, error) {
+	out := new(QueryNFTTokenURIResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/NFTTokenURI", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/QueryIsPriceChanged",
+	return out, nil
+}
+
+func (c *queryClient) QueryPolicyForAccount(ctx context.Context, in *QueryPolicyForAccountRequest, opts ...grpc.CallOption) (*QueryPolicyForAccountResponse, error) {
+	out := new(QueryPolicyForAccountResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryPolicyForAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryIsPriceChanged(ctx, req.(*QueryIsPriceChangedRequest))
+	return out, nil
+}
+
+func (c *queryClient) VerifyPermission(ctx context.Context, in *QueryVerifyPermissionRequest, opts ...grpc.CallOption) (*QueryVerifyPermissionResponse, error) {
+	out := new(QueryVerifyPermissionResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/VerifyPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryQuotaUpdateTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryQuoteUpdateTimeRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ExplainPermission(ctx context.Context, in *QueryExplainPermissionRequest, opts ...grpc.CallOption) (*QueryExplainPermissionResponse, error) {
+	out := new(QueryExplainPermissionResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/ExplainPermission", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) HeadGroup(ctx context.Context, in *QueryHeadGroupRequest, opts ...grpc.CallOption) (*QueryHeadGroupResponse, error) {
+	out := new(QueryHeadGroupResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/QueryQuotaUpdateTime",
+	return out, nil
+}
+
+func (c *queryClient) ListGroups(ctx context.Context, in *QueryListGroupsRequest, opts ...grpc.CallOption) (*QueryListGroupsResponse, error) {
+	out := new(QueryListGroupsResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/ListGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, req.(*QueryQuoteUpdateTimeRequest))
+	return out, nil
+}
+
+func (c *queryClient) HeadGroupMember(ctx context.Context, in *QueryHeadGroupMemberRequest, opts ...grpc.CallOption) (*QueryHeadGroupMemberResponse, error) {
+	out := new(QueryHeadGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryGroupMembersExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupMembersExistRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryPolicyForGroup(ctx context.Context, in *QueryPolicyForGroupRequest, opts ...grpc.CallOption) (*QueryPolicyForGroupResponse, error) {
+	out := new(QueryPolicyForGroupResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryPolicyForGroup", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryGroupMembersExist(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) QueryPolicyById(ctx context.Context, in *QueryPolicyByIdRequest, opts ...grpc.CallOption) (*QueryPolicyByIdResponse, error) {
+	out := new(QueryPolicyByIdResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryPolicyById", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/QueryGroupMembersExist",
+	return out, nil
+}
+
+func (c *queryClient) QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error) {
+	out := new(QueryLockFeeResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryLockFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryGroupMembersExist(ctx, req.(*QueryGroupMembersExistRequest))
+	return out, nil
+}
+
+func (c *queryClient) HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error) {
+	out := new(QueryHeadBucketExtraResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadBucketExtra", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryGroupsExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupsExistRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error) {
+	out := new(QueryIsPriceChangedResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryIsPriceChanged", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryGroupsExist(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) QueryQuotaUpdateTime(ctx context.Context, in *QueryQuoteUpdateTimeRequest, opts ...grpc.CallOption) (*QueryQuoteUpdateTimeResponse, error) {
+	out := new(QueryQuoteUpdateTimeResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryQuotaUpdateTime", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/QueryGroupsExist",
+	return out, nil
+}
+
+func (c *queryClient) QueryGroupMembersExist(ctx context.Context, in *QueryGroupMembersExistRequest, opts ...grpc.CallOption) (*QueryGroupMembersExistResponse, error) {
+	out := new(QueryGroupMembersExistResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryGroupMembersExist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryGroupsExist(ctx, req.(*QueryGroupsExistRequest))
+	return out, nil
+}
+
+func (c *queryClient) QueryGroupsExist(ctx context.Context, in *QueryGroupsExistRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
+	out := new(QueryGroupsExistResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryGroupsExist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryGroupsExistById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupsExistByIdRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) QueryGroupsExistById(ctx context.Context, in *QueryGroupsExistByIdRequest, opts ...grpc.CallOption) (*QueryGroupsExistResponse, error) {
+	out := new(QueryGroupsExistResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryGroupsExistById", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryGroupsExistById(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, in *QueryPaymentAccountBucketFlowRateLimitRequest, opts ...grpc.CallOption) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
+	out := new(QueryPaymentAccountBucketFlowRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryPaymentAccountBucketFlowRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/QueryGroupsExistById",
+	return out, nil
+}
+
+func (c *queryClient) QueryPaymentAudit(ctx context.Context, in *QueryPaymentAuditRequest, opts ...grpc.CallOption) (*QueryPaymentAuditResponse, error) {
+	out := new(QueryPaymentAuditResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/QueryPaymentAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryGroupsExistById(ctx, req.(*QueryGroupsExistByIdRequest))
+	return out, nil
+}
+
+func (c *queryClient) HeadObjectVersion(ctx context.Context, in *QueryHeadObjectVersionRequest, opts ...grpc.CallOption) (*QueryHeadObjectVersionResponse, error) {
+	out := new(QueryHeadObjectVersionResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/HeadObjectVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_QueryPaymentAccountBucketFlowRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountBucketFlowRateLimitRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ListObjectVersions(ctx context.Context, in *QueryListObjectVersionsRequest, opts ...grpc.CallOption) (*QueryListObjectVersionsResponse, error) {
+	out := new(QueryListObjectVersionsResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/ListObjectVersions", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Query/QueryPaymentAccountBucketFlowRateLimit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, req.(*QueryPaymentAccountBucketFlowRateLimitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "moca.storage.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "QueryParamsByTimestamp",
-			Handler:    _Query_QueryParamsByTimestamp_Handler,
-		},
-		{
-			MethodName: "HeadBucket",
-			Handler:    _Query_HeadBucket_Handler,
-		},
-		{
-			MethodName: "HeadBucketById",
-			Handler:    _Query_HeadBucketById_Handler,
-		},
-		{
-			MethodName: "HeadBucketNFT",
-			Handler:    _Query_HeadBucketNFT_Handler,
-		},
-		{
-			MethodName: "HeadObject",
-			Handler:    _Query_HeadObject_Handler,
-		},
-		{
-			MethodName: "HeadObjectById",
-			Handler:    _Query_HeadObjectById_Handler,
-		},
-		{
-			MethodName: "HeadShadowObject",
-			Handler:    _Query_HeadShadowObject_Handler,
-		},
-		{
-			MethodName: "HeadObjectNFT",
-			Handler:    _Query_HeadObjectNFT_Handler,
-		},
-		{
-			MethodName: "ListBuckets",
-			Handler:    _Query_ListBuckets_Handler,
-		},
-		{
-			MethodName: "ListObjects",
-			Handler:    _Query_ListObjects_Handler,
-		},
-		{
-			MethodName: "ListObjectsByBucketId",
-			Handler:    _Query_ListObjectsByBucketId_Handler,
-		},
-		{
-			MethodName: "HeadGroupNFT",
-			Handler:    _Query_HeadGroupNFT_Handler,
-		},
-		{
-			MethodName: "QueryPolicyForAccount",
-			Handler:    _Query_QueryPolicyForAccount_Handler,
-		},
-		{
-			MethodName: "VerifyPermission",
-			Handler:    _Query_VerifyPermission_Handler,
-		},
-		{
-			MethodName: "HeadGroup",
-			Handler:    _Query_HeadGroup_Handler,
-		},
-		{
-			MethodName: "ListGroups",
-			Handler:    _Query_ListGroups_Handler,
-		},
-		{
-			MethodName: "HeadGroupMember",
-			Handler:    _Query_HeadGroupMember_Handler,
-		},
-		{
-			MethodName: "QueryPolicyForGroup",
-			Handler:    _Query_QueryPolicyForGroup_Handler,
-		},
-		{
-			MethodName: "QueryPolicyById",
-			Handler:    _Query_QueryPolicyById_Handler,
-		},
-		{
-			MethodName: "QueryLockFee",
-			Handler:    _Query_QueryLockFee_Handler,
-		},
-		{
-			MethodName: "HeadBucketExtra",
-			Handler:    _Query_HeadBucketExtra_Handler,
-		},
-		{
-			MethodName: "QueryIsPriceChanged",
-			Handler:    _Query_QueryIsPriceChanged_Handler,
-		},
-		{
-			MethodName: "QueryQuotaUpdateTime",
-			Handler:    _Query_QueryQuotaUpdateTime_Handler,
-		},
-		{
-			MethodName: "QueryGroupMembersExist",
-			Handler:    _Query_QueryGroupMembersExist_Handler,
-		},
-		{
-			MethodName: "QueryGroupsExist",
-			Handler:    _Query_QueryGroupsExist_Handler,
-		},
-		{
-			MethodName: "QueryGroupsExistById",
-			Handler:    _Query_QueryGroupsExistById_Handler,
-		},
-		{
-			MethodName: "QueryPaymentAccountBucketFlowRateLimit",
-			Handler:    _Query_QueryPaymentAccountBucketFlowRateLimit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "moca/storage/query.proto",
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListResourcesByTag(ctx context.Context, in *QueryListResourcesByTagRequest, opts ...grpc.CallOption) (*QueryListResourcesByTagResponse, error) {
+	out := new(QueryListResourcesByTagResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/ListResourcesByTag", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BucketReadQuota(ctx context.Context, in *QueryBucketReadQuotaRequest, opts ...grpc.CallOption) (*QueryBucketReadQuotaResponse, error) {
+	out := new(QueryBucketReadQuotaResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/BucketReadQuota", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BucketCallback(ctx context.Context, in *QueryBucketCallbackRequest, opts ...grpc.CallOption) (*QueryBucketCallbackResponse, error) {
+	out := new(QueryBucketCallbackResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/BucketCallback", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BucketOwnershipTransfer(ctx context.Context, in *QueryBucketOwnershipTransferRequest, opts ...grpc.CallOption) (*QueryBucketOwnershipTransferResponse, error) {
+	out := new(QueryBucketOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/BucketOwnershipTransfer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) BillingStatements(ctx context.Context, in *QueryBillingStatementsRequest, opts ...grpc.CallOption) (*QueryBillingStatementsResponse, error) {
+	out := new(QueryBillingStatementsResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/BillingStatements", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryHeadBucketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BucketBillImpact(ctx context.Context, in *QueryBucketBillImpactRequest, opts ...grpc.CallOption) (*QueryBucketBillImpactResponse, error) {
+	out := new(QueryBucketBillImpactResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Query/BucketBillImpact", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryHeadBucketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryHeadBucketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Parameters queries the parameters of the module.
+	QueryParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
+	// Queries a bucket with specify name.
+	HeadBucket(context.Context, *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error)
+	// Queries a bucket by id
+	HeadBucketById(context.Context, *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error)
+	// Queries a bucket with EIP712 standard metadata info
+	HeadBucketNFT(context.Context, *QueryNFTRequest) (*QueryBucketNFTResponse, error)
+	// Queries a object with specify name.
+	HeadObject(context.Context, *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error)
+	// Queries an object by id
+	HeadObjectById(context.Context, *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error)
+	// Queries a shadow object with specify name.
+	HeadShadowObject(context.Context, *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error)
+	// Queries a object with EIP712 standard metadata info
+	HeadObjectNFT(context.Context, *QueryNFTRequest) (*QueryObjectNFTResponse, error)
+	// Queries a list of bucket items.
+	ListBuckets(context.Context, *QueryListBucketsRequest) (*QueryListBucketsResponse, error)
+	// Queries a list of object items under the bucket.
+	ListObjects(context.Context, *QueryListObjectsRequest) (*QueryListObjectsResponse, error)
+	// Queries a list of object items under the bucket.
+	ListObjectsByBucketId(context.Context, *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error)
+	// Queries a group with EIP712 standard metadata info
+	HeadGroupNFT(context.Context, *QueryNFTRequest) (*QueryGroupNFTResponse, error)
+	// Queries the ERC-721 token uri of a bucket, object or group NFT, a data uri of the metadata json built from the chain state
+	NFTTokenURI(context.Context, *QueryNFTTokenURIRequest) (*QueryNFTTokenURIResponse, error)
+	// Queries a policy which grants permission to account
+	QueryPolicyForAccount(context.Context, *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error)
+	// Queries a list of VerifyPermission items.
+	VerifyPermission(context.Context, *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error)
+	// Queries how the permission of the operator for the bucket/object's action is evaluated.
+	ExplainPermission(context.Context, *QueryExplainPermissionRequest) (*QueryExplainPermissionResponse, error)
+	// Queries a group with specify owner and name .
+	HeadGroup(context.Context, *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error)
+	// Queries a list of ListGroup items.
+	ListGroups(context.Context, *QueryListGroupsRequest) (*QueryListGroupsResponse, error)
+	// Queries a list of HeadGroupMember items.
+	HeadGroupMember(context.Context, *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error)
+	// Queries a policy that grants permission to a group
+	QueryPolicyForGroup(context.Context, *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error)
+	// Queries a policy by policy id
+	QueryPolicyById(context.Context, *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error)
+	// Queries lock fee for storing an object
+	QueryLockFee(context.Context, *QueryLockFeeRequest) (*QueryLockFeeResponse, error)
+	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
+	HeadBucketExtra(context.Context, *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error)
+	// Queries whether read and storage prices changed for the bucket.
+	QueryIsPriceChanged(context.Context, *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error)
+	// Queries whether read and storage prices changed for the bucket.
+	QueryQuotaUpdateTime(context.Context, *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error)
+	// Queries whether some members are in the group.
+	QueryGroupMembersExist(context.Context, *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error)
+	// Queries whether some groups are exist.
+	QueryGroupsExist(context.Context, *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error)
+	// Queries whether some groups are exist by id.
+	QueryGroupsExistById(context.Context, *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error)
+	// Queries the flow rate limit of a bucket for a payment account
+	QueryPaymentAccountBucketFlowRateLimit(context.Context, *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error)
+	// Queries the payment audit report, i.e. the stream records whose lock balance or net flow rate
+	// do not match the ones expected from the buckets and objects. The whole audit is run for each page,
+	// so it is an expensive query meant for node operators, and it fails on chains with more than 10000 buckets.
+	QueryPaymentAudit(context.Context, *QueryPaymentAuditRequest) (*QueryPaymentAuditResponse, error)
+	// Queries a version of an object, either the current or a prior one
+	HeadObjectVersion(context.Context, *QueryHeadObjectVersionRequest) (*QueryHeadObjectVersionResponse, error)
+	// Queries the prior versions of an object
+	ListObjectVersions(context.Context, *QueryListObjectVersionsRequest) (*QueryListObjectVersionsResponse, error)
+	// Queries the buckets, objects and groups which have the tag
+	ListResourcesByTag(context.Context, *QueryListResourcesByTagRequest) (*QueryListResourcesByTagResponse, error)
+	// Queries the charged read quota of a bucket, its auto topup and the consumption reported by its primary SP
+	BucketReadQuota(context.Context, *QueryBucketReadQuotaRequest) (*QueryBucketReadQuotaResponse, error)
+	// Queries the contract notified when the objects of a bucket are sealed, rejected or deleted
+	BucketCallback(context.Context, *QueryBucketCallbackRequest) (*QueryBucketCallbackResponse, error)
+	// Queries the pending transfer of the ownership of a bucket
+	BucketOwnershipTransfer(context.Context, *QueryBucketOwnershipTransferRequest) (*QueryBucketOwnershipTransferResponse, error)
+	// Queries the billing statements of a payment account whose billing periods end in a time range, along with the
+	// open billing periods overlapping it
+	BillingStatements(context.Context, *QueryBillingStatementsRequest) (*QueryBillingStatementsResponse, error)
+	// Queries how the bill of a bucket changes once it is charged by the global store price taking effect next
+	BucketBillImpact(context.Context, *QueryBucketBillImpactRequest) (*QueryBucketBillImpactResponse, error)
 }
 
-func (m *QueryHeadBucketByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryHeadBucketByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryHeadBucketByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketId) > 0 {
-		i -= len(m.BucketId)
-		copy(dAtA[i:], m.BucketId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) QueryParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParamsByTimestamp not implemented")
 }
-
-func (m *QueryHeadBucketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) HeadBucket(ctx context.Context, req *QueryHeadBucketRequest) (*QueryHeadBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucket not implemented")
 }
-
-func (m *QueryHeadBucketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) HeadBucketById(ctx context.Context, req *QueryHeadBucketByIdRequest) (*QueryHeadBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketById not implemented")
 }
-
-func (m *QueryHeadBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExtraInfo != nil {
-		{
-			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BucketInfo != nil {
-		{
-			size, err := m.BucketInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) HeadBucketNFT(ctx context.Context, req *QueryNFTRequest) (*QueryBucketNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketNFT not implemented")
 }
-
-func (m *QueryHeadObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) HeadObject(ctx context.Context, req *QueryHeadObjectRequest) (*QueryHeadObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadObject not implemented")
 }
-
-func (m *QueryHeadObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) HeadObjectById(ctx context.Context, req *QueryHeadObjectByIdRequest) (*QueryHeadObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectById not implemented")
 }
-
-func (m *QueryHeadObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) HeadShadowObject(ctx context.Context, req *QueryHeadShadowObjectRequest) (*QueryHeadShadowObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadShadowObject not implemented")
 }
-
-func (m *QueryHeadObjectByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) HeadObjectNFT(ctx context.Context, req *QueryNFTRequest) (*QueryObjectNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectNFT not implemented")
 }
-
-func (m *QueryHeadObjectByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ListBuckets(ctx context.Context, req *QueryListBucketsRequest) (*QueryListBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBuckets not implemented")
 }
-
-func (m *QueryHeadObjectByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectId) > 0 {
-		i -= len(m.ObjectId)
-		copy(dAtA[i:], m.ObjectId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ListObjects(ctx context.Context, req *QueryListObjectsRequest) (*QueryListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
 }
-
-func (m *QueryHeadShadowObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ListObjectsByBucketId(ctx context.Context, req *QueryListObjectsByBucketIdRequest) (*QueryListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjectsByBucketId not implemented")
 }
-
-func (m *QueryHeadShadowObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) HeadGroupNFT(ctx context.Context, req *QueryNFTRequest) (*QueryGroupNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupNFT not implemented")
 }
-
-func (m *QueryHeadShadowObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) NFTTokenURI(ctx context.Context, req *QueryNFTTokenURIRequest) (*QueryNFTTokenURIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTTokenURI not implemented")
 }
-
-func (m *QueryHeadObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QueryPolicyForAccount(ctx context.Context, req *QueryPolicyForAccountRequest) (*QueryPolicyForAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForAccount not implemented")
+}
+func (*UnimplementedQueryServer) VerifyPermission(ctx context.Context, req *QueryVerifyPermissionRequest) (*QueryVerifyPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPermission not implemented")
+}
+func (*UnimplementedQueryServer) ExplainPermission(ctx context.Context, req *QueryExplainPermissionRequest) (*QueryExplainPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainPermission not implemented")
+}
+func (*UnimplementedQueryServer) HeadGroup(ctx context.Context, req *QueryHeadGroupRequest) (*QueryHeadGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadGroup not implemented")
+}
+func (*UnimplementedQueryServer) ListGroups(ctx context.Context, req *QueryListGroupsRequest) (*QueryListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (*UnimplementedQueryServer) HeadGroupMember(ctx context.Context, req *QueryHeadGroupMemberRequest) (*QueryHeadGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadGroupMember not implemented")
+}
+func (*UnimplementedQueryServer) QueryPolicyForGroup(ctx context.Context, req *QueryPolicyForGroupRequest) (*QueryPolicyForGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyForGroup not implemented")
+}
+func (*UnimplementedQueryServer) QueryPolicyById(ctx context.Context, req *QueryPolicyByIdRequest) (*QueryPolicyByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPolicyById not implemented")
+}
+func (*UnimplementedQueryServer) QueryLockFee(ctx context.Context, req *QueryLockFeeRequest) (*QueryLockFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLockFee not implemented")
+}
+func (*UnimplementedQueryServer) HeadBucketExtra(ctx context.Context, req *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketExtra not implemented")
+}
+func (*UnimplementedQueryServer) QueryIsPriceChanged(ctx context.Context, req *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryIsPriceChanged not implemented")
+}
+func (*UnimplementedQueryServer) QueryQuotaUpdateTime(ctx context.Context, req *QueryQuoteUpdateTimeRequest) (*QueryQuoteUpdateTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryQuotaUpdateTime not implemented")
+}
+func (*UnimplementedQueryServer) QueryGroupMembersExist(ctx context.Context, req *QueryGroupMembersExistRequest) (*QueryGroupMembersExistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupMembersExist not implemented")
+}
+func (*UnimplementedQueryServer) QueryGroupsExist(ctx context.Context, req *QueryGroupsExistRequest) (*QueryGroupsExistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExist not implemented")
+}
+func (*UnimplementedQueryServer) QueryGroupsExistById(ctx context.Context, req *QueryGroupsExistByIdRequest) (*QueryGroupsExistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGroupsExistById not implemented")
+}
+func (*UnimplementedQueryServer) QueryPaymentAccountBucketFlowRateLimit(ctx context.Context, req *QueryPaymentAccountBucketFlowRateLimitRequest) (*QueryPaymentAccountBucketFlowRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPaymentAccountBucketFlowRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) QueryPaymentAudit(ctx context.Context, req *QueryPaymentAuditRequest) (*QueryPaymentAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPaymentAudit not implemented")
+}
+func (*UnimplementedQueryServer) HeadObjectVersion(ctx context.Context, req *QueryHeadObjectVersionRequest) (*QueryHeadObjectVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadObjectVersion not implemented")
+}
+func (*UnimplementedQueryServer) ListObjectVersions(ctx context.Context, req *QueryListObjectVersionsRequest) (*QueryListObjectVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjectVersions not implemented")
+}
+func (*UnimplementedQueryServer) ListResourcesByTag(ctx context.Context, req *QueryListResourcesByTagRequest) (*QueryListResourcesByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourcesByTag not implemented")
+}
+func (*UnimplementedQueryServer) BucketReadQuota(ctx context.Context, req *QueryBucketReadQuotaRequest) (*QueryBucketReadQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BucketReadQuota not implemented")
+}
+func (*UnimplementedQueryServer) BucketCallback(ctx context.Context, req *QueryBucketCallbackRequest) (*QueryBucketCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BucketCallback not implemented")
+}
+func (*UnimplementedQueryServer) BucketOwnershipTransfer(ctx context.Context, req *QueryBucketOwnershipTransferRequest) (*QueryBucketOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BucketOwnershipTransfer not implemented")
+}
+func (*UnimplementedQueryServer) BillingStatements(ctx context.Context, req *QueryBillingStatementsRequest) (*QueryBillingStatementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BillingStatements not implemented")
+}
+func (*UnimplementedQueryServer) BucketBillImpact(ctx context.Context, req *QueryBucketBillImpactRequest) (*QueryBucketBillImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BucketBillImpact not implemented")
 }
 
-func (m *QueryHeadObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryHeadObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroup != nil {
-		{
-			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ObjectInfo != nil {
-		{
-			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadShadowObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByTimestampRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryHeadShadowObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParamsByTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryParamsByTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadShadowObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ObjectInfo != nil {
-		{
-			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_HeadBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadBucket(ctx, req.(*QueryHeadBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListBucketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HeadBucketById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadBucketByIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadBucketById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadBucketById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadBucketById(ctx, req.(*QueryHeadBucketByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HeadBucketNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadBucketNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadBucketNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadBucketNFT(ctx, req.(*QueryNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_HeadObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadObject(ctx, req.(*QueryHeadObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListBucketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HeadObjectById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadObjectByIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadObjectById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadObjectById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadObjectById(ctx, req.(*QueryHeadObjectByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryListBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_HeadShadowObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadShadowObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BucketInfos) > 0 {
-		for iNdEx := len(m.BucketInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BucketInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadShadowObject(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadShadowObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadShadowObject(ctx, req.(*QueryHeadShadowObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HeadObjectNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryListObjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).HeadObjectNFT(ctx, in)
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadObjectNFT",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadObjectNFT(ctx, req.(*QueryNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsByBucketIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListBucketsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryListObjectsByBucketIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ListBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/ListBuckets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListBuckets(ctx, req.(*QueryListBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsByBucketIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketId) > 0 {
-		i -= len(m.BucketId)
-		copy(dAtA[i:], m.BucketId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ListObjects(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/ListObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListObjects(ctx, req.(*QueryListObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListObjectsByBucketId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListObjectsByBucketIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListObjectsByBucketId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/ListObjectsByBucketId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListObjectsByBucketId(ctx, req.(*QueryListObjectsByBucketIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HeadGroupNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadGroupNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadGroupNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadGroupNFT(ctx, req.(*QueryNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_NFTTokenURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTTokenURIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ObjectInfos) > 0 {
-		for iNdEx := len(m.ObjectInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ObjectInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTTokenURI(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/NFTTokenURI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTTokenURI(ctx, req.(*QueryNFTTokenURIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNFTRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPolicyForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyForAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPolicyForAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryPolicyForAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPolicyForAccount(ctx, req.(*QueryPolicyForAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNFTRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TokenId) > 0 {
-		i -= len(m.TokenId)
-		copy(dAtA[i:], m.TokenId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
-		i--
-		dAtA[i] = 0xa
+func _Query_VerifyPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/VerifyPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyPermission(ctx, req.(*QueryVerifyPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBucketNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ExplainPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExplainPermissionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBucketNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBucketNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		{
-			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ExplainPermission(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/ExplainPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExplainPermission(ctx, req.(*QueryExplainPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObjectNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HeadGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryObjectNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryObjectNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		{
-			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).HeadGroup(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadGroup(ctx, req.(*QueryHeadGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGroupNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListGroupsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGroupNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGroupNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		{
-			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ListGroups(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/ListGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListGroups(ctx, req.(*QueryListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPolicyForAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HeadGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadGroupMemberRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPolicyForAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).HeadGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadGroupMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadGroupMember(ctx, req.(*QueryHeadGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPolicyForAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PrincipalAddress) > 0 {
-		i -= len(m.PrincipalAddress)
-		copy(dAtA[i:], m.PrincipalAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalAddress)))
-		i--
-		dAtA[i] = 0x12
+func _Query_QueryPolicyForGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyForGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPolicyForGroup(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryPolicyForGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPolicyForGroup(ctx, req.(*QueryPolicyForGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPolicyForAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPolicyById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyByIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPolicyById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryPolicyById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPolicyById(ctx, req.(*QueryPolicyByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPolicyForAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryLockFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryLockFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryLockFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryLockFee(ctx, req.(*QueryLockFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPolicyForAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Policy != nil {
-		{
-			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_HeadBucketExtra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadBucketExtraRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).HeadBucketExtra(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadBucketExtra",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadBucketExtra(ctx, req.(*QueryHeadBucketExtraRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryIsPriceChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsPriceChangedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryIsPriceChanged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryIsPriceChanged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryIsPriceChanged(ctx, req.(*QueryIsPriceChangedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryQuotaUpdateTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteUpdateTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryQuotaUpdateTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryQuotaUpdateTime(ctx, req.(*QueryQuoteUpdateTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ActionType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ActionType))
-		i--
-		dAtA[i] = 0x20
+func _Query_QueryGroupMembersExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupMembersExistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGroupMembersExist(ctx, in)
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryGroupMembersExist",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGroupMembersExist(ctx, req.(*QueryGroupMembersExistRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryGroupsExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupsExistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGroupsExist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryGroupsExist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGroupsExist(ctx, req.(*QueryGroupsExistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryGroupsExistById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupsExistByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGroupsExistById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryGroupsExistById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGroupsExistById(ctx, req.(*QueryGroupsExistByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVerifyPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Effect != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Effect))
-		i--
-		dAtA[i] = 0x8
+func _Query_QueryPaymentAccountBucketFlowRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountBucketFlowRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryPaymentAccountBucketFlowRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPaymentAccountBucketFlowRateLimit(ctx, req.(*QueryPaymentAccountBucketFlowRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadGroupRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPaymentAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAuditRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPaymentAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/QueryPaymentAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPaymentAudit(ctx, req.(*QueryPaymentAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadGroupRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HeadObjectVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadObjectVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadObjectVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/HeadObjectVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadObjectVersion(ctx, req.(*QueryHeadObjectVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x12
+func _Query_ListObjectVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListObjectVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ListObjectVersions(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/ListObjectVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListObjectVersions(ctx, req.(*QueryListObjectVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListResourcesByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListResourcesByTagRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListResourcesByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/ListResourcesByTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListResourcesByTag(ctx, req.(*QueryListResourcesByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BucketReadQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBucketReadQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BucketReadQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/BucketReadQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BucketReadQuota(ctx, req.(*QueryBucketReadQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeadGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GroupInfo != nil {
-		{
-			size, err := m.GroupInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_BucketCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBucketCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).BucketCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/BucketCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BucketCallback(ctx, req.(*QueryBucketCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListGroupsRequest) Marshal() (dAtA []byte, err error) {
+func _Query_BucketOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBucketOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BucketOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/BucketOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BucketOwnershipTransfer(ctx, req.(*QueryBucketOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BillingStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBillingStatementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BillingStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/BillingStatements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BillingStatements(ctx, req.(*QueryBillingStatementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BucketBillImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBucketBillImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BucketBillImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Query/BucketBillImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BucketBillImpact(ctx, req.(*QueryBucketBillImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "moca.storage.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "QueryParamsByTimestamp",
+			Handler:    _Query_QueryParamsByTimestamp_Handler,
+		},
+		{
+			MethodName: "HeadBucket",
+			Handler:    _Query_HeadBucket_Handler,
+		},
+		{
+			MethodName: "HeadBucketById",
+			Handler:    _Query_HeadBucketById_Handler,
+		},
+		{
+			MethodName: "HeadBucketNFT",
+			Handler:    _Query_HeadBucketNFT_Handler,
+		},
+		{
+			MethodName: "HeadObject",
+			Handler:    _Query_HeadObject_Handler,
+		},
+		{
+			MethodName: "HeadObjectById",
+			Handler:    _Query_HeadObjectById_Handler,
+		},
+		{
+			MethodName: "HeadShadowObject",
+			Handler:    _Query_HeadShadowObject_Handler,
+		},
+		{
+			MethodName: "HeadObjectNFT",
+			Handler:    _Query_HeadObjectNFT_Handler,
+		},
+		{
+			MethodName: "ListBuckets",
+			Handler:    _Query_ListBuckets_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _Query_ListObjects_Handler,
+		},
+		{
+			MethodName: "ListObjectsByBucketId",
+			Handler:    _Query_ListObjectsByBucketId_Handler,
+		},
+		{
+			MethodName: "HeadGroupNFT",
+			Handler:    _Query_HeadGroupNFT_Handler,
+		},
+		{
+			MethodName: "NFTTokenURI",
+			Handler:    _Query_NFTTokenURI_Handler,
+		},
+		{
+			MethodName: "QueryPolicyForAccount",
+			Handler:    _Query_QueryPolicyForAccount_Handler,
+		},
+		{
+			MethodName: "VerifyPermission",
+			Handler:    _Query_VerifyPermission_Handler,
+		},
+		{
+			MethodName: "ExplainPermission",
+			Handler:    _Query_ExplainPermission_Handler,
+		},
+		{
+			MethodName: "HeadGroup",
+			Handler:    _Query_HeadGroup_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _Query_ListGroups_Handler,
+		},
+		{
+			MethodName: "HeadGroupMember",
+			Handler:    _Query_HeadGroupMember_Handler,
+		},
+		{
+			MethodName: "QueryPolicyForGroup",
+			Handler:    _Query_QueryPolicyForGroup_Handler,
+		},
+		{
+			MethodName: "QueryPolicyById",
+			Handler:    _Query_QueryPolicyById_Handler,
+		},
+		{
+			MethodName: "QueryLockFee",
+			Handler:    _Query_QueryLockFee_Handler,
+		},
+		{
+			MethodName: "HeadBucketExtra",
+			Handler:    _Query_HeadBucketExtra_Handler,
+		},
+		{
+			MethodName: "QueryIsPriceChanged",
+			Handler:    _Query_QueryIsPriceChanged_Handler,
+		},
+		{
+			MethodName: "QueryQuotaUpdateTime",
+			Handler:    _Query_QueryQuotaUpdateTime_Handler,
+		},
+		{
+			MethodName: "QueryGroupMembersExist",
+			Handler:    _Query_QueryGroupMembersExist_Handler,
+		},
+		{
+			MethodName: "QueryGroupsExist",
+			Handler:    _Query_QueryGroupsExist_Handler,
+		},
+		{
+			MethodName: "QueryGroupsExistById",
+			Handler:    _Query_QueryGroupsExistById_Handler,
+		},
+		{
+			MethodName: "QueryPaymentAccountBucketFlowRateLimit",
+			Handler:    _Query_QueryPaymentAccountBucketFlowRateLimit_Handler,
+		},
+		{
+			MethodName: "QueryPaymentAudit",
+			Handler:    _Query_QueryPaymentAudit_Handler,
+		},
+		{
+			MethodName: "HeadObjectVersion",
+			Handler:    _Query_HeadObjectVersion_Handler,
+		},
+		{
+			MethodName: "ListObjectVersions",
+			Handler:    _Query_ListObjectVersions_Handler,
+		},
+		{
+			MethodName: "ListResourcesByTag",
+			Handler:    _Query_ListResourcesByTag_Handler,
+		},
+		{
+			MethodName: "BucketReadQuota",
+			Handler:    _Query_BucketReadQuota_Handler,
+		},
+		{
+			MethodName: "BucketCallback",
+			Handler:    _Query_BucketCallback_Handler,
+		},
+		{
+			MethodName: "BucketOwnershipTransfer",
+			Handler:    _Query_BucketOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "BillingStatements",
+			Handler:    _Query_BillingStatements_Handler,
+		},
+		{
+			MethodName: "BucketBillImpact",
+			Handler:    _Query_BucketBillImpact_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "moca/storage/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4780,39 +5708,20 @@ func (m *QueryListGroupsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListGroupsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4822,46 +5731,30 @@ func (m *QueryListGroupsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupInfos) > 0 {
-		for iNdEx := len(m.GroupInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GroupInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupMemberRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4871,41 +5764,25 @@ func (m *QueryHeadGroupMemberRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadGroupMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Member) > 0 {
-		i -= len(m.Member)
-		copy(dAtA[i:], m.Member)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupMemberResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4915,32 +5792,30 @@ func (m *QueryHeadGroupMemberResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupMember != nil {
-		{
-			size, err := m.GroupMember.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForGroupRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadBucketRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4950,34 +5825,27 @@ func (m *QueryPolicyForGroupRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyForGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyForGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PrincipalGroupId) > 0 {
-		i -= len(m.PrincipalGroupId)
-		copy(dAtA[i:], m.PrincipalGroupId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalGroupId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForGroupResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadBucketByIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4987,32 +5855,27 @@ func (m *QueryPolicyForGroupResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyForGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyForGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Policy != nil {
-		{
-			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.BucketId) > 0 {
+		i -= len(m.BucketId)
+		copy(dAtA[i:], m.BucketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyByIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadBucketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5022,49 +5885,31 @@ func (m *QueryPolicyByIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPolicyByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPolicyByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PolicyId) > 0 {
-		i -= len(m.PolicyId)
-		copy(dAtA[i:], m.PolicyId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PolicyId)))
+	if m.ExtraInfo != nil {
+		{
+			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryPolicyByIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x12
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPolicyByIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPolicyByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Policy != nil {
+	if m.BucketInfo != nil {
 		{
-			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BucketInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5077,7 +5922,7 @@ func (m *QueryPolicyByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadObjectRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5087,37 +5932,34 @@ func (m *QueryLockFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLockFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLockFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PayloadSize != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CreateAt != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CreateAt))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.PrimarySpAddress) > 0 {
-		i -= len(m.PrimarySpAddress)
-		copy(dAtA[i:], m.PrimarySpAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrimarySpAddress)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadObjectByIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5127,30 +5969,27 @@ func (m *QueryLockFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLockFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectByIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLockFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadShadowObjectRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5160,16 +5999,23 @@ func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadBucketExtraRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadBucketExtraRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -5180,7 +6026,7 @@ func (m *QueryHeadBucketExtraRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5190,19 +6036,31 @@ func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadBucketExtraResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadBucketExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExtraInfo != nil {
+	if m.GlobalVirtualGroup != nil {
 		{
-			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ObjectInfo != nil {
+		{
+			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5215,7 +6073,7 @@ func (m *QueryHeadBucketExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsPriceChangedRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHeadShadowObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5225,28 +6083,33 @@ func (m *QueryIsPriceChangedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsPriceChangedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsPriceChangedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHeadShadowObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryIsPriceChangedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if m.ObjectInfo != nil {
+		{
+			size, err := m.ObjectInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListBucketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -5255,110 +6118,81 @@ func (m *QueryIsPriceChangedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryIsPriceChangedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsPriceChangedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.NewValidatorTaxRate.Size()
-		i -= size
-		if _, err := m.NewValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.NewSecondaryStorePrice.Size()
-		i -= size
-		if _, err := m.NewSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.NewPrimaryStorePrice.Size()
-		i -= size
-		if _, err := m.NewPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.NewReadPrice.Size()
-		i -= size
-		if _, err := m.NewReadPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.CurrentValidatorTaxRate.Size()
-		i -= size
-		if _, err := m.CurrentValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.CurrentSecondaryStorePrice.Size()
-		i -= size
-		if _, err := m.CurrentSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.CurrentPrimaryStorePrice.Size()
-		i -= size
-		if _, err := m.CurrentPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListBucketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.CurrentReadPrice.Size()
-		i -= size
-		if _, err := m.CurrentReadPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return dAtA[:n], nil
+}
+
+func (m *QueryListBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Changed {
 		i--
-		if m.Changed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketInfos) > 0 {
+		for iNdEx := len(m.BucketInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BucketInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuoteUpdateTimeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListObjectsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5368,27 +6202,60 @@ func (m *QueryQuoteUpdateTimeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryQuoteUpdateTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryQuoteUpdateTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListObjectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.StartAfter) > 0 {
+		i -= len(m.StartAfter)
+		copy(dAtA[i:], m.StartAfter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StartAfter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Delimiter) > 0 {
+		i -= len(m.Delimiter)
+		copy(dAtA[i:], m.Delimiter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delimiter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuoteUpdateTimeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListObjectsByBucketIdRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5398,25 +6265,39 @@ func (m *QueryQuoteUpdateTimeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryQuoteUpdateTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListObjectsByBucketIdRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryQuoteUpdateTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListObjectsByBucketIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UpdateAt != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UpdateAt))
+	if len(m.BucketId) > 0 {
+		i -= len(m.BucketId)
+		copy(dAtA[i:], m.BucketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketId)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupMembersExistRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListObjectsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5426,36 +6307,55 @@ func (m *QueryGroupMembersExistRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupMembersExistRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupMembersExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListObjectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Members[iNdEx])
-			copy(dAtA[i:], m.Members[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+	if len(m.CommonPrefixes) > 0 {
+		for iNdEx := len(m.CommonPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommonPrefixes[iNdEx])
+			copy(dAtA[i:], m.CommonPrefixes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CommonPrefixes[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupId)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.ObjectInfos) > 0 {
+		for iNdEx := len(m.ObjectInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObjectInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupMembersExistResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryNFTRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5465,42 +6365,27 @@ func (m *QueryGroupMembersExistResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupMembersExistResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNFTRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupMembersExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Exists) > 0 {
-		for k := range m.Exists {
-			v := m.Exists[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBucketNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5510,36 +6395,32 @@ func (m *QueryGroupsExistRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsExistRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBucketNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBucketNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupNames) > 0 {
-		for iNdEx := len(m.GroupNames) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GroupNames[iNdEx])
-			copy(dAtA[i:], m.GroupNames[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupNames[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if m.MetaData != nil {
+		{
+			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistByIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryObjectNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5549,29 +6430,32 @@ func (m *QueryGroupsExistByIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsExistByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObjectNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsExistByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObjectNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupIds) > 0 {
-		for iNdEx := len(m.GroupIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GroupIds[iNdEx])
-			copy(dAtA[i:], m.GroupIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.MetaData != nil {
+		{
+			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGroupNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5581,42 +6465,32 @@ func (m *QueryGroupsExistResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsExistResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGroupNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGroupNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Exists) > 0 {
-		for k := range m.Exists {
-			v := m.Exists[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+	if m.MetaData != nil {
+		{
+			size, err := m.MetaData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryNFTTokenURIRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5626,41 +6500,32 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNFTTokenURIRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNFTTokenURIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketOwner) > 0 {
-		i -= len(m.BucketOwner)
-		copy(dAtA[i:], m.BucketOwner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketOwner)))
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.PaymentAccount) > 0 {
-		i -= len(m.PaymentAccount)
-		copy(dAtA[i:], m.PaymentAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccount)))
+	if m.ResourceType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResourceType))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryNFTTokenURIResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5670,823 +6535,6856 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNFTTokenURIResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNFTTokenURIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.FlowRateLimit.Size()
-		i -= size
-		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.IsSet {
-		i--
-		if m.IsSet {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.TokenUri) > 0 {
+		i -= len(m.TokenUri)
+		copy(dAtA[i:], m.TokenUri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenUri)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPolicyForAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPolicyForAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsByTimestampRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPolicyForAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovQuery(uint64(m.Timestamp))
+	if len(m.PrincipalAddress) > 0 {
+		i -= len(m.PrincipalAddress)
+		copy(dAtA[i:], m.PrincipalAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsByTimestampResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPolicyForAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeadBucketRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPolicyForAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadBucketByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPolicyForAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BucketId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BucketInfo != nil {
-		l = m.BucketInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ExtraInfo != nil {
-		l = m.ExtraInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryVerifyPermissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeadObjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVerifyPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ActionType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActionType))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *QueryHeadObjectByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.ObjectId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadShadowObjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVerifyPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Effect != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Effect))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExplainPermissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExplainPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExplainPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ObjectInfo != nil {
-		l = m.ObjectInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ActionType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActionType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.GlobalVirtualGroup != nil {
-		l = m.GlobalVirtualGroup.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *QueryHeadShadowObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.ObjectInfo != nil {
-		l = m.ObjectInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListBucketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryExplainPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListBucketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryExplainPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExplainPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BucketInfos) > 0 {
-		for _, e := range m.BucketInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Effect != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Effect))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListObjectsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PermissionTraceStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionTraceStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionTraceStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Effect != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Effect))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *QueryListObjectsByBucketIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BucketId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryListObjectsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Statements) > 0 {
+		for iNdEx := len(m.Statements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	var l int
-	_ = l
-	if len(m.ObjectInfos) > 0 {
-		for _, e := range m.ObjectInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.GroupId.Size()
+		i -= size
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PolicyId.Size()
+		i -= size
+		if _, err := m.PolicyId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryNFTRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.TokenId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Check) > 0 {
+		i -= len(m.Check)
+		copy(dAtA[i:], m.Check)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Check)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBucketNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		l = m.MetaData.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *StatementTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryObjectNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MetaData != nil {
-		l = m.MetaData.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *StatementTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StatementTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MetaData != nil {
-		l = m.MetaData.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *QueryPolicyForAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Effect != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Effect))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Statement != nil {
+		{
+			size, err := m.Statement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PrincipalAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryHeadGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryVerifyPermissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHeadGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ActionType != 0 {
-		n += 1 + sovQuery(uint64(m.ActionType))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVerifyPermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Effect != 0 {
-		n += 1 + sovQuery(uint64(m.Effect))
+func (m *QueryHeadGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHeadGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryHeadGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHeadGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.GroupInfo != nil {
-		l = m.GroupInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.GroupInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListGroupsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListGroupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListGroupsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if len(m.GroupInfos) > 0 {
-		for _, e := range m.GroupInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.GroupInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupMemberRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHeadGroupMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadGroupMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadGroupMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Member)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadGroupMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHeadGroupMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.GroupMember != nil {
-		l = m.GroupMember.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.GroupMember.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPolicyForGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicyForGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicyForGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PrincipalGroupId) > 0 {
+		i -= len(m.PrincipalGroupId)
+		copy(dAtA[i:], m.PrincipalGroupId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrincipalGroupId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PrincipalGroupId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyForGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPolicyForGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicyForGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicyForGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPolicyByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicyByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicyByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PolicyId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPolicyByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPolicyByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicyByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicyByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLockFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PrimarySpAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PayloadSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.CreateAt != 0 {
-		n += 1 + sovQuery(uint64(m.CreateAt))
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovQuery(uint64(m.PayloadSize))
+	if len(m.PrimarySpAddress) > 0 {
+		i -= len(m.PrimarySpAddress)
+		copy(dAtA[i:], m.PrimarySpAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrimarySpAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLockFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketExtraRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadBucketExtraRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadBucketExtraRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketExtraResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadBucketExtraResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadBucketExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ExtraInfo != nil {
-		l = m.ExtraInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsPriceChangedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsPriceChangedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsPriceChangedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsPriceChangedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsPriceChangedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsPriceChangedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsPriceChangedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsPriceChangedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Changed {
-		n += 2
-	}
-	l = m.CurrentReadPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentPrimaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentSecondaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentValidatorTaxRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewReadPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewPrimaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewSecondaryStorePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NewValidatorTaxRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size := m.NewValidatorTaxRate.Size()
+		i -= size
+		if _, err := m.NewValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.NewSecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.NewSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.NewPrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.NewPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.NewReadPrice.Size()
+		i -= size
+		if _, err := m.NewReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CurrentValidatorTaxRate.Size()
+		i -= size
+		if _, err := m.CurrentValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CurrentSecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.CurrentSecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CurrentPrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.CurrentPrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CurrentReadPrice.Size()
+		i -= size
+		if _, err := m.CurrentReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Changed {
+		i--
+		if m.Changed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuoteUpdateTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryQuoteUpdateTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteUpdateTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteUpdateTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuoteUpdateTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryQuoteUpdateTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteUpdateTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteUpdateTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.UpdateAt != 0 {
-		n += 1 + sovQuery(uint64(m.UpdateAt))
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdateAt))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupMembersExistRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGroupMembersExistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupMembersExistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupMembersExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GroupId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if len(m.Members) > 0 {
-		for _, s := range m.Members {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupMembersExistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGroupMembersExistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupMembersExistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupMembersExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Exists) > 0 {
-		for k, v := range m.Exists {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		for k := range m.Exists {
+			v := m.Exists[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGroupsExistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsExistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsExistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if len(m.GroupNames) > 0 {
-		for _, s := range m.GroupNames {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.GroupNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupNames[iNdEx])
+			copy(dAtA[i:], m.GroupNames[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGroupsExistByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsExistByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsExistByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.GroupIds) > 0 {
-		for _, s := range m.GroupIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.GroupIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupIds[iNdEx])
+			copy(dAtA[i:], m.GroupIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsExistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGroupsExistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsExistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsExistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Exists) > 0 {
-		for k, v := range m.Exists {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		for k := range m.Exists {
+			v := m.Exists[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketOwner) > 0 {
+		i -= len(m.BucketOwner)
+		copy(dAtA[i:], m.BucketOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PaymentAccount) > 0 {
+		i -= len(m.PaymentAccount)
+		copy(dAtA[i:], m.PaymentAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PaymentAccount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.FlowRateLimit.Size()
+		i -= size
+		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.BucketOwner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.IsSet {
+		i--
+		if m.IsSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentAuditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAuditRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAuditRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentAuditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAuditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAuditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Discrepancies) > 0 {
+		for iNdEx := len(m.Discrepancies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Discrepancies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadObjectVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadObjectVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadObjectVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadObjectVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadObjectVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadObjectVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroup != nil {
+		{
+			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ObjectVersion != nil {
+		{
+			size, err := m.ObjectVersion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListObjectVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListObjectVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListObjectVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListObjectVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListObjectVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListObjectVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxVersions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxVersions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListResourcesByTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListResourcesByTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListResourcesByTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResourceType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResourceType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TagValue) > 0 {
+		i -= len(m.TagValue)
+		copy(dAtA[i:], m.TagValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TagValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TagKey) > 0 {
+		i -= len(m.TagKey)
+		copy(dAtA[i:], m.TagKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TagKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListResourcesByTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListResourcesByTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListResourcesByTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBucketReadQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBucketReadQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBucketReadQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBucketReadQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBucketReadQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBucketReadQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Consumption != nil {
+		{
+			size, err := m.Consumption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AutoTopup != nil {
+		{
+			size, err := m.AutoTopup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChargedReadQuota))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBillingStatementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBillingStatementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBillingStatementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBillingStatementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBillingStatementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBillingStatementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OpenStatements) > 0 {
+		for iNdEx := len(m.OpenStatements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OpenStatements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Statements) > 0 {
+		for iNdEx := len(m.Statements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBucketBillImpactRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBucketBillImpactRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBucketBillImpactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBucketBillImpactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBucketBillImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBucketBillImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProjectedFlowRate.Size()
+		i -= size
+		if _, err := m.ProjectedFlowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CurrentFlowRate.Size()
+		i -= size
+		if _, err := m.CurrentFlowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ProjectedPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CurrentPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EffectiveTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EffectiveTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBucketCallbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBucketCallbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBucketCallbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBucketCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBucketCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBucketCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Callback != nil {
+		{
+			size, err := m.Callback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBucketOwnershipTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBucketOwnershipTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBucketOwnershipTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBucketOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBucketOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBucketOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		{
+			size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByTimestampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryParamsByTimestampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHeadBucketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadBucketByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BucketInfo != nil {
+		l = m.BucketInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExtraInfo != nil {
+		l = m.ExtraInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadObjectByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadShadowObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectInfo != nil {
+		l = m.ObjectInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GlobalVirtualGroup != nil {
+		l = m.GlobalVirtualGroup.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadShadowObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectInfo != nil {
+		l = m.ObjectInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListBucketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListBucketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BucketInfos) > 0 {
+		for _, e := range m.BucketInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListObjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Delimiter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StartAfter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListObjectsByBucketIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListObjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObjectInfos) > 0 {
+		for _, e := range m.ObjectInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CommonPrefixes) > 0 {
+		for _, s := range m.CommonPrefixes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNFTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBucketNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetaData != nil {
+		l = m.MetaData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObjectNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetaData != nil {
+		l = m.MetaData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetaData != nil {
+		l = m.MetaData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTTokenURIRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceType != 0 {
+		n += 1 + sovQuery(uint64(m.ResourceType))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTTokenURIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenUri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PrincipalAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyPermissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActionType != 0 {
+		n += 1 + sovQuery(uint64(m.ActionType))
+	}
+	return n
+}
+
+func (m *QueryVerifyPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Effect != 0 {
+		n += 1 + sovQuery(uint64(m.Effect))
+	}
+	return n
+}
+
+func (m *QueryExplainPermissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActionType != 0 {
+		n += 1 + sovQuery(uint64(m.ActionType))
+	}
+	return n
+}
+
+func (m *QueryExplainPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Effect != 0 {
+		n += 1 + sovQuery(uint64(m.Effect))
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PermissionTraceStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Check)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.PolicyId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GroupId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Statements) > 0 {
+		for _, e := range m.Statements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Effect != 0 {
+		n += 1 + sovQuery(uint64(m.Effect))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StatementTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	if m.Statement != nil {
+		l = m.Statement.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Effect != 0 {
+		n += 1 + sovQuery(uint64(m.Effect))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupInfo != nil {
+		l = m.GroupInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.GroupInfos) > 0 {
+		for _, e := range m.GroupInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHeadGroupMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadGroupMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupMember != nil {
+		l = m.GroupMember.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PrincipalGroupId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyForGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrimarySpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovQuery(uint64(m.CreateAt))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovQuery(uint64(m.PayloadSize))
+	}
+	return n
+}
+
+func (m *QueryLockFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHeadBucketExtraRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadBucketExtraResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtraInfo != nil {
+		l = m.ExtraInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsPriceChangedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsPriceChangedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Changed {
+		n += 2
+	}
+	l = m.CurrentReadPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentPrimaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentSecondaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentValidatorTaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewReadPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewPrimaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewSecondaryStorePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NewValidatorTaxRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQuoteUpdateTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteUpdateTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateAt != 0 {
+		n += 1 + sovQuery(uint64(m.UpdateAt))
+	}
+	return n
+}
+
+func (m *QueryGroupMembersExistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupMembersExistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Exists) > 0 {
+		for k, v := range m.Exists {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupsExistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.GroupNames) > 0 {
+		for _, s := range m.GroupNames {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupsExistByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GroupIds) > 0 {
+		for _, s := range m.GroupIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupsExistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Exists) > 0 {
+		for k, v := range m.Exists {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PaymentAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsSet {
+		n += 2
+	}
+	l = m.FlowRateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentAuditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAuditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Discrepancies) > 0 {
+		for _, e := range m.Discrepancies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadObjectVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryHeadObjectVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectVersion != nil {
+		l = m.ObjectVersion.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GlobalVirtualGroup != nil {
+		l = m.GlobalVirtualGroup.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListObjectVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListObjectVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxVersions != 0 {
+		n += 1 + sovQuery(uint64(m.MaxVersions))
+	}
+	return n
+}
+
+func (m *QueryListResourcesByTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TagKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TagValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResourceType != 0 {
+		n += 1 + sovQuery(uint64(m.ResourceType))
+	}
+	return n
+}
+
+func (m *QueryListResourcesByTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBucketReadQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBucketReadQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovQuery(uint64(m.ChargedReadQuota))
+	}
+	if m.AutoTopup != nil {
+		l = m.AutoTopup.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Consumption != nil {
+		l = m.Consumption.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBillingStatementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBillingStatementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statements) > 0 {
+		for _, e := range m.Statements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.OpenStatements) > 0 {
+		for _, e := range m.OpenStatements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBucketBillImpactRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBucketBillImpactResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EffectiveTime != 0 {
+		n += 1 + sovQuery(uint64(m.EffectiveTime))
+	}
+	l = m.CurrentPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentFlowRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedFlowRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBucketCallbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBucketCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Callback != nil {
+		l = m.Callback.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBucketOwnershipTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBucketOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		l = m.Transfer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadBucketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadBucketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BucketInfo == nil {
+				m.BucketInfo = &BucketInfo{}
+			}
+			if err := m.BucketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtraInfo == nil {
+				m.ExtraInfo = &BucketExtraInfo{}
+			}
+			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadObjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObjectInfo == nil {
+				m.ObjectInfo = &ObjectInfo{}
+			}
+			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalVirtualGroup == nil {
+				m.GlobalVirtualGroup = &types.GlobalVirtualGroup{}
+			}
+			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObjectInfo == nil {
+				m.ObjectInfo = &ShadowObjectInfo{}
+			}
+			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBucketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBucketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketInfos = append(m.BucketInfos, &BucketInfo{})
+			if err := m.BucketInfos[len(m.BucketInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListObjectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delimiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListObjectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectInfos = append(m.ObjectInfos, &ObjectInfo{})
+			if err := m.ObjectInfos[len(m.ObjectInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommonPrefixes = append(m.CommonPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBucketNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBucketNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBucketNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetaData == nil {
+				m.MetaData = &BucketMetaData{}
+			}
+			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObjectNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObjectNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObjectNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetaData == nil {
+				m.MetaData = &ObjectMetaData{}
+			}
+			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupNFTResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetaData == nil {
+				m.MetaData = &GroupMetaData{}
+			}
+			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTTokenURIRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTTokenURIRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTTokenURIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+			}
+			m.ResourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceType |= resource.ResourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNFTTokenURIResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNFTTokenURIResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNFTTokenURIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPolicyForAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPolicyForAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrincipalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPolicyForAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPolicyForAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &types1.Policy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyPermissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			m.ActionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionType |= types1.ActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			}
+			m.Effect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Effect |= types1.Effect(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExplainPermissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExplainPermissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExplainPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			m.ActionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionType |= types1.ActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExplainPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExplainPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExplainPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			}
+			m.Effect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Effect |= types1.Effect(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, PermissionTraceStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionTraceStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionTraceStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionTraceStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Check = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PolicyId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statements = append(m.Statements, StatementTrace{})
+			if err := m.Statements[len(m.Statements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			}
+			m.Effect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Effect |= types1.Effect(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsSet {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.FlowRateLimit.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *StatementTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatementTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatementTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Statement == nil {
+				m.Statement = &types1.Statement{}
+			}
+			if err := m.Statement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			}
+			m.Effect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Effect |= types1.Effect(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6509,12 +13407,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6536,7 +13498,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6559,15 +13521,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6594,7 +13556,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GroupInfo == nil {
+				m.GroupInfo = &GroupInfo{}
+			}
+			if err := m.GroupInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6619,7 +13584,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6642,17 +13607,17 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListGroupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6662,11 +13627,60 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6688,7 +13702,7 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6711,15 +13725,15 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListGroupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6746,7 +13760,44 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupInfos = append(m.GroupInfos, &GroupInfo{})
+			if err := m.GroupInfos[len(m.GroupInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6771,7 +13822,7 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6794,15 +13845,15 @@ func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6830,61 +13881,43 @@ func (m *QueryHeadBucketRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Member = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6912,7 +13945,7 @@ func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketId = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6935,7 +13968,7 @@ func (m *QueryHeadBucketByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6958,51 +13991,15 @@ func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BucketInfo == nil {
-				m.BucketInfo = &BucketInfo{}
-			}
-			if err := m.BucketInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMember", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7029,10 +14026,10 @@ func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExtraInfo == nil {
-				m.ExtraInfo = &BucketExtraInfo{}
+			if m.GroupMember == nil {
+				m.GroupMember = &types1.GroupMember{}
 			}
-			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupMember.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7057,7 +14054,7 @@ func (m *QueryHeadBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7080,15 +14077,15 @@ func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadObjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyForGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyForGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7116,11 +14113,11 @@ func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalGroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7148,7 +14145,7 @@ func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.PrincipalGroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7171,7 +14168,7 @@ func (m *QueryHeadObjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7194,17 +14191,17 @@ func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyForGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadObjectByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyForGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7214,23 +14211,27 @@ func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			if m.Policy == nil {
+				m.Policy = &types1.Policy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7253,7 +14254,7 @@ func (m *QueryHeadObjectByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7276,47 +14277,15 @@ func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7344,7 +14313,7 @@ func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7367,7 +14336,7 @@ func (m *QueryHeadShadowObjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7390,51 +14359,15 @@ func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyByIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ObjectInfo == nil {
-				m.ObjectInfo = &ObjectInfo{}
-			}
-			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7461,10 +14394,10 @@ func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GlobalVirtualGroup == nil {
-				m.GlobalVirtualGroup = &types.GlobalVirtualGroup{}
+			if m.Policy == nil {
+				m.Policy = &types1.Policy{}
 			}
-			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7489,7 +14422,7 @@ func (m *QueryHeadObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7512,17 +14445,17 @@ func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadShadowObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7532,28 +14465,62 @@ func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObjectInfo == nil {
-				m.ObjectInfo = &ShadowObjectInfo{}
+			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
 			}
-			if err := m.ObjectInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7575,7 +14542,7 @@ func (m *QueryHeadShadowObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7598,17 +14565,17 @@ func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBucketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7618,25 +14585,23 @@ func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7661,7 +14626,7 @@ func (m *QueryListBucketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7684,51 +14649,17 @@ func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBucketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketInfos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketInfos = append(m.BucketInfos, &BucketInfo{})
-			if err := m.BucketInfos[len(m.BucketInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7738,27 +14669,23 @@ func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7781,7 +14708,7 @@ func (m *QueryListBucketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7804,15 +14731,15 @@ func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListObjectsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7839,45 +14766,13 @@ func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.ExtraInfo == nil {
+				m.ExtraInfo = &InternalBucketInfo{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7899,7 +14794,7 @@ func (m *QueryListObjectsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7922,51 +14817,15 @@ func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsPriceChangedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListObjectsByBucketIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsPriceChangedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7994,7 +14853,7 @@ func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketId = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8017,7 +14876,7 @@ func (m *QueryListObjectsByBucketIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8040,17 +14899,37 @@ func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListObjectsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsPriceChangedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsPriceChangedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Changed = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReadPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8060,31 +14939,31 @@ func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectInfos = append(m.ObjectInfos, &ObjectInfo{})
-			if err := m.ObjectInfos[len(m.ObjectInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrimaryStorePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8094,81 +14973,97 @@ func (m *QueryListObjectsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSecondaryStorePrice", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.CurrentSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValidatorTaxRate", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNFTRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewReadPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8196,63 +15091,15 @@ func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.NewReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBucketNFTResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBucketNFTResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBucketNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPrimaryStorePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8262,83 +15109,31 @@ func (m *QueryBucketNFTResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetaData == nil {
-				m.MetaData = &BucketMetaData{}
-			}
-			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryObjectNFTResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObjectNFTResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObjectNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSecondaryStorePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8348,83 +15143,31 @@ func (m *QueryObjectNFTResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetaData == nil {
-				m.MetaData = &ObjectMetaData{}
-			}
-			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupNFTResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValidatorTaxRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8434,25 +15177,23 @@ func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetaData == nil {
-				m.MetaData = &GroupMetaData{}
-			}
-			if err := m.MetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8477,7 +15218,7 @@ func (m *QueryGroupNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8500,47 +15241,15 @@ func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8568,7 +15277,7 @@ func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrincipalAddress = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8591,7 +15300,7 @@ func (m *QueryPolicyForAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8614,17 +15323,17 @@ func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
 			}
-			var msglen int
+			m.UpdateAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8634,28 +15343,11 @@ func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpdateAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Policy == nil {
-				m.Policy = &types1.Policy{}
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8677,7 +15369,7 @@ func (m *QueryPolicyForAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8700,15 +15392,15 @@ func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyPermissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupMembersExistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupMembersExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8736,43 +15428,11 @@ func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.GroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8800,96 +15460,8 @@ func (m *QueryVerifyPermissionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
-			}
-			m.ActionType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ActionType |= types1.ActionType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyPermissionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
-			}
-			m.Effect = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Effect |= types1.Effect(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8911,7 +15483,7 @@ func (m *QueryVerifyPermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupMembersExistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8934,17 +15506,17 @@ func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupMembersExistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupMembersExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8954,55 +15526,106 @@ func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			if m.Exists == nil {
+				m.Exists = make(map[string]bool)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.Exists[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9025,7 +15648,7 @@ func (m *QueryHeadGroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9048,17 +15671,17 @@ func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsExistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9068,27 +15691,55 @@ func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupInfo == nil {
-				m.GroupInfo = &GroupInfo{}
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupNames", wireType)
 			}
-			if err := m.GroupInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.GroupNames = append(m.GroupNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9111,7 +15762,7 @@ func (m *QueryHeadGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9134,51 +15785,15 @@ func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListGroupsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9206,7 +15821,7 @@ func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			m.GroupIds = append(m.GroupIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9229,7 +15844,7 @@ func (m *QueryListGroupsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9252,15 +15867,15 @@ func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListGroupsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsExistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9287,46 +15902,91 @@ func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupInfos", wireType)
+			if m.Exists == nil {
+				m.Exists = make(map[string]bool)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupInfos = append(m.GroupInfos, &GroupInfo{})
-			if err := m.GroupInfos[len(m.GroupInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Exists[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9349,7 +16009,7 @@ func (m *QueryListGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9372,15 +16032,15 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9408,11 +16068,11 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Member = string(dAtA[iNdEx:postIndex])
+			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9440,11 +16100,11 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			m.BucketOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9472,93 +16132,7 @@ func (m *QueryHeadGroupMemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryHeadGroupMemberResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupMember", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GroupMember == nil {
-				m.GroupMember = &types1.GroupMember{}
-			}
-			if err := m.GroupMember.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9581,7 +16155,7 @@ func (m *QueryHeadGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9604,17 +16178,17 @@ func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForGroupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSet", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9624,27 +16198,15 @@ func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsSet = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrincipalGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9672,7 +16234,9 @@ func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrincipalGroupId = string(dAtA[iNdEx:postIndex])
+			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9695,7 +16259,7 @@ func (m *QueryPolicyForGroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAuditRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9718,15 +16282,15 @@ func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyForGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAuditRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyForGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAuditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9753,10 +16317,10 @@ func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Policy == nil {
-				m.Policy = &types1.Policy{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9781,7 +16345,7 @@ func (m *QueryPolicyForGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAuditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9804,17 +16368,17 @@ func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAuditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAuditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9824,77 +16388,29 @@ func (m *QueryPolicyByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PolicyId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Discrepancies = append(m.Discrepancies, PaymentDiscrepancy{})
+			if err := m.Discrepancies[len(m.Discrepancies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyByIdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9921,10 +16437,10 @@ func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Policy == nil {
-				m.Policy = &types1.Policy{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9949,7 +16465,7 @@ func (m *QueryPolicyByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadObjectVersionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9972,15 +16488,15 @@ func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadObjectVersionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadObjectVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10008,13 +16524,13 @@ func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
-			m.CreateAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10024,16 +16540,29 @@ func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreateAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.PayloadSize = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10043,7 +16572,7 @@ func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10069,7 +16598,7 @@ func (m *QueryLockFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadObjectVersionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10092,17 +16621,17 @@ func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadObjectVersionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadObjectVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectVersion", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10112,23 +16641,61 @@ func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ObjectVersion == nil {
+				m.ObjectVersion = &ObjectVersion{}
+			}
+			if err := m.ObjectVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalVirtualGroup == nil {
+				m.GlobalVirtualGroup = &types.GlobalVirtualGroup{}
+			}
+			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10153,7 +16720,7 @@ func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListObjectVersionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10176,10 +16743,10 @@ func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListObjectVersionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListObjectVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10214,61 +16781,11 @@ func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10278,27 +16795,23 @@ func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExtraInfo == nil {
-				m.ExtraInfo = &InternalBucketInfo{}
-			}
-			if err := m.ExtraInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10321,7 +16834,7 @@ func (m *QueryHeadBucketExtraResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListObjectVersionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10344,17 +16857,17 @@ func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsPriceChangedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListObjectVersionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsPriceChangedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListObjectVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10364,24 +16877,45 @@ func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Versions = append(m.Versions, ObjectVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVersions", wireType)
+			}
+			m.MaxVersions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVersions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10403,7 +16937,7 @@ func (m *QueryIsPriceChangedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListResourcesByTagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10426,17 +16960,17 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsPriceChangedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListResourcesByTagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsPriceChangedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListResourcesByTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10446,15 +16980,31 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Changed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReadPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TagKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10482,13 +17032,11 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TagKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrimaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TagValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10516,15 +17064,82 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TagValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+			}
+			m.ResourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceType |= resource.ResourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListResourcesByTagResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListResourcesByTagResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListResourcesByTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSecondaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10534,31 +17149,31 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Resources = append(m.Resources, &TaggedResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValidatorTaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10568,29 +17183,81 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBucketReadQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBucketReadQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBucketReadQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewReadPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10618,15 +17285,63 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPrimaryStorePrice", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var stringLen uint64
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBucketReadQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBucketReadQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBucketReadQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			}
+			m.ChargedReadQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10636,31 +17351,16 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NewPrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSecondaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoTopup", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10670,31 +17370,33 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewSecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AutoTopup == nil {
+				m.AutoTopup = &ReadQuotaAutoTopup{}
+			}
+			if err := m.AutoTopup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewValidatorTaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumption", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10704,23 +17406,25 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Consumption == nil {
+				m.Consumption = &ReadQuotaConsumption{}
+			}
+			if err := m.Consumption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10745,7 +17449,7 @@ func (m *QueryIsPriceChangedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBillingStatementsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10768,15 +17472,15 @@ func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBillingStatementsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBillingStatementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10804,7 +17508,81 @@ func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10827,7 +17605,7 @@ func (m *QueryQuoteUpdateTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBillingStatementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10850,17 +17628,17 @@ func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBillingStatementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQuoteUpdateTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBillingStatementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statements", wireType)
 			}
-			m.UpdateAt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10870,11 +17648,96 @@ func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateAt |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statements = append(m.Statements, BillingStatement{})
+			if err := m.Statements[len(m.Statements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenStatements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpenStatements = append(m.OpenStatements, BillingStatement{})
+			if err := m.OpenStatements[len(m.OpenStatements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10896,7 +17759,7 @@ func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBucketBillImpactRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10919,47 +17782,15 @@ func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupMembersExistRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBucketBillImpactRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupMembersExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBucketBillImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10987,7 +17818,7 @@ func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11010,7 +17841,7 @@ func (m *QueryGroupMembersExistRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupMembersExistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBucketBillImpactResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11033,15 +17864,34 @@ func (m *QueryGroupMembersExistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupMembersExistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBucketBillImpactResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupMembersExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBucketBillImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			m.EffectiveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11068,145 +17918,46 @@ func (m *QueryGroupMembersExistResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exists == nil {
-				m.Exists = make(map[string]bool)
+			if err := m.CurrentPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Exists[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ProjectedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsExistRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsExistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFlowRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11234,11 +17985,13 @@ func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			if err := m.CurrentFlowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedFlowRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11266,7 +18019,9 @@ func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupNames = append(m.GroupNames, string(dAtA[iNdEx:postIndex]))
+			if err := m.ProjectedFlowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11289,7 +18044,7 @@ func (m *QueryGroupsExistRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBucketCallbackRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11312,15 +18067,15 @@ func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBucketCallbackRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsExistByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBucketCallbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11348,7 +18103,7 @@ func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupIds = append(m.GroupIds, string(dAtA[iNdEx:postIndex]))
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11371,7 +18126,7 @@ func (m *QueryGroupsExistByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBucketCallbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11394,15 +18149,15 @@ func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsExistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBucketCallbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsExistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBucketCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11429,91 +18184,12 @@ func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exists == nil {
-				m.Exists = make(map[string]bool)
+			if m.Callback == nil {
+				m.Callback = &BucketCallback{}
 			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Exists[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11536,7 +18212,7 @@ func (m *QueryGroupsExistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBucketOwnershipTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11559,77 +18235,13 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBucketOwnershipTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBucketOwnershipTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PaymentAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -11682,7 +18294,7 @@ func (m *QueryPaymentAccountBucketFlowRateLimitRequest) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBucketOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11705,37 +18317,17 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBucketOwnershipTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountBucketFlowRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBucketOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsSet", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsSet = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11745,23 +18337,25 @@ func (m *QueryPaymentAccountBucketFlowRateLimitResponse) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Transfer == nil {
+				m.Transfer = &BucketOwnershipTransfer{}
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15,7 +15,8 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	common "github.com/mocachain/moca/v2/types/common"
-	types "github.com/mocachain/moca/v2/x/permission/types"
+	types1 "github.com/mocachain/moca/v2/x/permission/types"
+	types "github.com/mocachain/moca/v2/x/virtualgroup/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -56,6 +57,9 @@ type MsgCreateBucket struct {
 	// The available read data for each user is the sum of the free read data provided by SP and
 	// the ChargeReadQuota specified here.
 	ChargedReadQuota uint64 `protobuf:"varint,7,opt,name=charged_read_quota,json=chargedReadQuota,proto3" json:"charged_read_quota,omitempty"`
+	// gvg_family_strategy defines the strategy the chain picks the global virtual group family of the primary sp by,
+	// which only applies when the primary sp approval leaves the family unspecified.
+	GvgFamilyStrategy types.PickVGFStrategy `protobuf:"varint,8,opt,name=gvg_family_strategy,json=gvgFamilyStrategy,proto3,enum=moca.virtualgroup.PickVGFStrategy" json:"gvg_family_strategy,omitempty"`
 }
 
 func (m *MsgCreateBucket) Reset()         { *m = MsgCreateBucket{} }
@@ -140,6 +144,13 @@ func (m *MsgCreateBucket) GetChargedReadQuota() uint64 {
 	return 0
 }
 
+func (m *MsgCreateBucket) GetGvgFamilyStrategy() types.PickVGFStrategy {
+	if m != nil {
+		return m.GvgFamilyStrategy
+	}
+	return types.Strategy_Maximize_Free_Store_Size
+}
+
 type MsgCreateBucketResponse struct {
 	BucketId Uint `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
 }
@@ -2093,11 +2104,11 @@ type MsgPutPolicy struct {
 	// operator defines the granter who grant the permission to another principal
 	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
 	// Principal defines the roles that can be grant permissions to. Currently, it can be account or group.
-	Principal *types.Principal `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	Principal *types1.Principal `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
 	// resource defines a moca standard resource name that can be generated by GRN structure
 	Resource string `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
 	// statements defines a list of individual statement which describe the detail rules of policy
-	Statements []*types.Statement `protobuf:"bytes,4,rep,name=statements,proto3" json:"statements,omitempty"`
+	Statements []*types1.Statement `protobuf:"bytes,4,rep,name=statements,proto3" json:"statements,omitempty"`
 	// expiration_time defines the whole expiration time of all the statements.
 	// Notices: Its priority is higher than the expiration time inside the Statement
 	ExpirationTime *time.Time `protobuf:"bytes,7,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
@@ -2143,7 +2154,7 @@ func (m *MsgPutPolicy) GetOperator() string {
 	return ""
 }
 
-func (m *MsgPutPolicy) GetPrincipal() *types.Principal {
+func (m *MsgPutPolicy) GetPrincipal() *types1.Principal {
 	if m != nil {
 		return m.Principal
 	}
@@ -2157,7 +2168,7 @@ func (m *MsgPutPolicy) GetResource() string {
 	return ""
 }
 
-func (m *MsgPutPolicy) GetStatements() []*types.Statement {
+func (m *MsgPutPolicy) GetStatements() []*types1.Statement {
 	if m != nil {
 		return m.Statements
 	}
@@ -2212,7 +2223,7 @@ type MsgDeletePolicy struct {
 	// operator defines the granter who grant the permission to another principal
 	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
 	// Principal defines the roles that can grant permissions. Currently, it can be account or group.
-	Principal *types.Principal `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	Principal *types1.Principal `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
 	// resource defines a moca standard resource name that can be generated by GRN structure
 	Resource string `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
 }
@@ -2257,7 +2268,7 @@ func (m *MsgDeletePolicy) GetOperator() string {
 	return ""
 }
 
-func (m *MsgDeletePolicy) GetPrincipal() *types.Principal {
+func (m *MsgDeletePolicy) GetPrincipal() *types1.Principal {
 	if m != nil {
 		return m.Principal
 	}
@@ -3725,2942 +3736,2707 @@ func (m *MsgSetBucketFlowRateLimitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBucketFlowRateLimitResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateBucket)(nil), "moca.storage.MsgCreateBucket")
-	proto.RegisterType((*MsgCreateBucketResponse)(nil), "moca.storage.MsgCreateBucketResponse")
-	proto.RegisterType((*MsgDeleteBucket)(nil), "moca.storage.MsgDeleteBucket")
-	proto.RegisterType((*MsgDeleteBucketResponse)(nil), "moca.storage.MsgDeleteBucketResponse")
-	proto.RegisterType((*MsgDiscontinueBucket)(nil), "moca.storage.MsgDiscontinueBucket")
-	proto.RegisterType((*MsgDiscontinueBucketResponse)(nil), "moca.storage.MsgDiscontinueBucketResponse")
-	proto.RegisterType((*MsgCreateObject)(nil), "moca.storage.MsgCreateObject")
-	proto.RegisterType((*MsgCreateObjectResponse)(nil), "moca.storage.MsgCreateObjectResponse")
-	proto.RegisterType((*MsgSealObject)(nil), "moca.storage.MsgSealObject")
-	proto.RegisterType((*MsgSealObjectResponse)(nil), "moca.storage.MsgSealObjectResponse")
-	proto.RegisterType((*MsgSealObjectV2)(nil), "moca.storage.MsgSealObjectV2")
-	proto.RegisterType((*MsgSealObjectV2Response)(nil), "moca.storage.MsgSealObjectV2Response")
-	proto.RegisterType((*MsgRejectSealObject)(nil), "moca.storage.MsgRejectSealObject")
-	proto.RegisterType((*MsgRejectSealObjectResponse)(nil), "moca.storage.MsgRejectSealObjectResponse")
-	proto.RegisterType((*MsgCopyObject)(nil), "moca.storage.MsgCopyObject")
-	proto.RegisterType((*MsgCopyObjectResponse)(nil), "moca.storage.MsgCopyObjectResponse")
-	proto.RegisterType((*MsgDeleteObject)(nil), "moca.storage.MsgDeleteObject")
-	proto.RegisterType((*MsgDeleteObjectResponse)(nil), "moca.storage.MsgDeleteObjectResponse")
-	proto.RegisterType((*MsgDiscontinueObject)(nil), "moca.storage.MsgDiscontinueObject")
-	proto.RegisterType((*MsgDiscontinueObjectResponse)(nil), "moca.storage.MsgDiscontinueObjectResponse")
-	proto.RegisterType((*MsgCreateGroup)(nil), "moca.storage.MsgCreateGroup")
-	proto.RegisterType((*MsgCreateGroupResponse)(nil), "moca.storage.MsgCreateGroupResponse")
-	proto.RegisterType((*MsgDeleteGroup)(nil), "moca.storage.MsgDeleteGroup")
-	proto.RegisterType((*MsgDeleteGroupResponse)(nil), "moca.storage.MsgDeleteGroupResponse")
-	proto.RegisterType((*MsgUpdateGroupMember)(nil), "moca.storage.MsgUpdateGroupMember")
-	proto.RegisterType((*MsgUpdateGroupMemberResponse)(nil), "moca.storage.MsgUpdateGroupMemberResponse")
-	proto.RegisterType((*MsgRenewGroupMember)(nil), "moca.storage.MsgRenewGroupMember")
-	proto.RegisterType((*MsgRenewGroupMemberResponse)(nil), "moca.storage.MsgRenewGroupMemberResponse")
-	proto.RegisterType((*MsgGroupMember)(nil), "moca.storage.MsgGroupMember")
-	proto.RegisterType((*MsgUpdateGroupExtra)(nil), "moca.storage.MsgUpdateGroupExtra")
-	proto.RegisterType((*MsgUpdateGroupExtraResponse)(nil), "moca.storage.MsgUpdateGroupExtraResponse")
-	proto.RegisterType((*MsgLeaveGroup)(nil), "moca.storage.MsgLeaveGroup")
-	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "moca.storage.MsgLeaveGroupResponse")
-	proto.RegisterType((*MsgUpdateBucketInfo)(nil), "moca.storage.MsgUpdateBucketInfo")
-	proto.RegisterType((*MsgUpdateBucketInfoResponse)(nil), "moca.storage.MsgUpdateBucketInfoResponse")
-	proto.RegisterType((*MsgCancelCreateObject)(nil), "moca.storage.MsgCancelCreateObject")
-	proto.RegisterType((*MsgCancelCreateObjectResponse)(nil), "moca.storage.MsgCancelCreateObjectResponse")
-	proto.RegisterType((*MsgPutPolicy)(nil), "moca.storage.MsgPutPolicy")
-	proto.RegisterType((*MsgPutPolicyResponse)(nil), "moca.storage.MsgPutPolicyResponse")
-	proto.RegisterType((*MsgDeletePolicy)(nil), "moca.storage.MsgDeletePolicy")
-	proto.RegisterType((*MsgDeletePolicyResponse)(nil), "moca.storage.MsgDeletePolicyResponse")
-	proto.RegisterType((*MsgUpdateObjectInfoResponse)(nil), "moca.storage.MsgUpdateObjectInfoResponse")
-	proto.RegisterType((*MsgUpdateObjectInfo)(nil), "moca.storage.MsgUpdateObjectInfo")
-	proto.RegisterType((*MsgUpdateParams)(nil), "moca.storage.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "moca.storage.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgMigrateBucket)(nil), "moca.storage.MsgMigrateBucket")
-	proto.RegisterType((*MsgMigrateBucketResponse)(nil), "moca.storage.MsgMigrateBucketResponse")
-	proto.RegisterType((*MsgCompleteMigrateBucket)(nil), "moca.storage.MsgCompleteMigrateBucket")
-	proto.RegisterType((*MsgCompleteMigrateBucketResponse)(nil), "moca.storage.MsgCompleteMigrateBucketResponse")
-	proto.RegisterType((*MsgCancelMigrateBucket)(nil), "moca.storage.MsgCancelMigrateBucket")
-	proto.RegisterType((*MsgCancelMigrateBucketResponse)(nil), "moca.storage.MsgCancelMigrateBucketResponse")
-	proto.RegisterType((*MsgRejectMigrateBucket)(nil), "moca.storage.MsgRejectMigrateBucket")
-	proto.RegisterType((*MsgRejectMigrateBucketResponse)(nil), "moca.storage.MsgRejectMigrateBucketResponse")
-	proto.RegisterType((*MsgSetTag)(nil), "moca.storage.MsgSetTag")
-	proto.RegisterType((*MsgSetTagResponse)(nil), "moca.storage.MsgSetTagResponse")
-	proto.RegisterType((*MsgUpdateObjectContent)(nil), "moca.storage.MsgUpdateObjectContent")
-	proto.RegisterType((*MsgUpdateObjectContentResponse)(nil), "moca.storage.MsgUpdateObjectContentResponse")
-	proto.RegisterType((*MsgCancelUpdateObjectContent)(nil), "moca.storage.MsgCancelUpdateObjectContent")
-	proto.RegisterType((*MsgCancelUpdateObjectContentResponse)(nil), "moca.storage.MsgCancelUpdateObjectContentResponse")
-	proto.RegisterType((*MsgDelegateCreateObject)(nil), "moca.storage.MsgDelegateCreateObject")
-	proto.RegisterType((*MsgDelegateCreateObjectResponse)(nil), "moca.storage.MsgDelegateCreateObjectResponse")
-	proto.RegisterType((*MsgDelegateUpdateObjectContent)(nil), "moca.storage.MsgDelegateUpdateObjectContent")
-	proto.RegisterType((*MsgDelegateUpdateObjectContentResponse)(nil), "moca.storage.MsgDelegateUpdateObjectContentResponse")
-	proto.RegisterType((*MsgToggleSPAsDelegatedAgent)(nil), "moca.storage.MsgToggleSPAsDelegatedAgent")
-	proto.RegisterType((*MsgToggleSPAsDelegatedAgentResponse)(nil), "moca.storage.MsgToggleSPAsDelegatedAgentResponse")
-	proto.RegisterType((*MsgSetBucketFlowRateLimit)(nil), "moca.storage.MsgSetBucketFlowRateLimit")
-	proto.RegisterType((*MsgSetBucketFlowRateLimitResponse)(nil), "moca.storage.MsgSetBucketFlowRateLimitResponse")
+type MsgSetBucketLifecycle struct {
+	// operator defines the account address of the operator, either the bucket owner or the updater with granted permission.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// rules defines the lifecycle rules of the bucket, an empty list removes the lifecycle of the bucket
+	Rules []LifecycleRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules"`
 }
 
-func init() { proto.RegisterFile("moca/storage/tx.proto", fileDescriptor_dcb66990cac836d3) }
-
-var fileDescriptor_dcb66990cac836d3 = []byte{
-	// 2849 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3b, 0xcd, 0x6f, 0xdc, 0xc6,
-	0xf5, 0xde, 0x0f, 0x49, 0xde, 0xd1, 0x97, 0x45, 0xcb, 0xd1, 0x9a, 0x96, 0xb4, 0x32, 0x2d, 0x2b,
-	0x8a, 0x22, 0xef, 0xc6, 0xfa, 0x39, 0x4e, 0xb2, 0x49, 0x10, 0x48, 0x4e, 0x6c, 0x08, 0x3f, 0x2b,
-	0x56, 0x28, 0x59, 0x35, 0x7a, 0xd9, 0x8c, 0x96, 0x14, 0xc5, 0x7a, 0x97, 0x64, 0x49, 0xae, 0x6c,
-	0xa5, 0x97, 0xa6, 0xa7, 0xa2, 0xa7, 0x00, 0x45, 0x7b, 0xe8, 0x5f, 0x90, 0x1e, 0x0a, 0xb8, 0x85,
-	0x7b, 0xe8, 0xc7, 0xad, 0x87, 0xba, 0xa7, 0x06, 0x41, 0x03, 0x14, 0x3d, 0xb8, 0x85, 0x7d, 0xf0,
-	0xa1, 0xa7, 0x1e, 0xda, 0x5b, 0x8b, 0x82, 0x33, 0xc3, 0xe1, 0x90, 0x33, 0xdc, 0x0f, 0x45, 0xb2,
-	0x05, 0xf4, 0x62, 0x2f, 0xdf, 0x7b, 0xf3, 0x66, 0xde, 0xe7, 0xbc, 0x79, 0x33, 0x02, 0x67, 0x9a,
-	0x76, 0x1d, 0x56, 0x3c, 0xdf, 0x76, 0xa1, 0xa1, 0x57, 0xfc, 0xfb, 0x65, 0xc7, 0xb5, 0x7d, 0x5b,
-	0x1a, 0x0a, 0xc0, 0x65, 0x02, 0x96, 0xc7, 0x60, 0xd3, 0xb4, 0xec, 0x0a, 0xfa, 0x17, 0x13, 0xc8,
-	0x13, 0x75, 0xdb, 0x6b, 0xda, 0x5e, 0xa5, 0xe9, 0x19, 0x95, 0xbd, 0xcb, 0xc1, 0x7f, 0x04, 0x71,
-	0x16, 0x23, 0x6a, 0xe8, 0xab, 0x82, 0x3f, 0x08, 0x6a, 0xdc, 0xb0, 0x0d, 0x1b, 0xc3, 0x83, 0x5f,
-	0x04, 0x5a, 0x32, 0x6c, 0xdb, 0x68, 0xe8, 0x15, 0xf4, 0xb5, 0xdd, 0xda, 0xa9, 0xf8, 0x66, 0x53,
-	0xf7, 0x7c, 0xd8, 0x74, 0x08, 0x81, 0x8c, 0x96, 0x58, 0xb7, 0x9b, 0x4d, 0xdb, 0xaa, 0x40, 0xc7,
-	0x71, 0xed, 0x3d, 0xd8, 0x08, 0x67, 0x63, 0x71, 0xf7, 0x5c, 0xe8, 0x38, 0xba, 0x4b, 0x50, 0x93,
-	0x08, 0xe5, 0xe8, 0x6e, 0xd3, 0xf4, 0x3c, 0xd3, 0xb6, 0x08, 0x55, 0x6c, 0x60, 0x28, 0x77, 0x1b,
-	0x94, 0x03, 0x5d, 0xd8, 0x0c, 0x25, 0x28, 0xc6, 0xb5, 0xb5, 0xef, 0xe8, 0x04, 0xa3, 0x7c, 0x95,
-	0x03, 0xa3, 0x6b, 0x9e, 0x71, 0xcd, 0xd5, 0xa1, 0xaf, 0xaf, 0xb4, 0xea, 0x77, 0x75, 0x5f, 0x5a,
-	0x02, 0x03, 0xf5, 0xe0, 0xdb, 0x76, 0x8b, 0x99, 0x99, 0xcc, 0x7c, 0x61, 0xa5, 0xf8, 0xe5, 0xc3,
-	0x4b, 0xe3, 0x44, 0x25, 0xcb, 0x9a, 0xe6, 0xea, 0x9e, 0xb7, 0xe1, 0xbb, 0xa6, 0x65, 0xa8, 0x21,
-	0xa1, 0x54, 0x02, 0x83, 0xdb, 0x68, 0x74, 0xcd, 0x82, 0x4d, 0xbd, 0x98, 0x0d, 0xc6, 0xa9, 0x00,
-	0x83, 0x3e, 0x84, 0x4d, 0x5d, 0x7a, 0x07, 0x80, 0x3d, 0xd3, 0x33, 0xb7, 0xcd, 0x86, 0xe9, 0xef,
-	0x17, 0x73, 0x33, 0x99, 0xf9, 0x91, 0xa5, 0xc9, 0x32, 0x6b, 0xae, 0xf2, 0x16, 0xc5, 0x6f, 0xee,
-	0x3b, 0xba, 0xca, 0xd0, 0x4b, 0xcb, 0x60, 0xd4, 0x81, 0xfb, 0x4d, 0xdd, 0xf2, 0x6b, 0x10, 0x2f,
-	0xa0, 0x98, 0xef, 0xb0, 0xb4, 0x11, 0x32, 0x80, 0x40, 0xa5, 0xeb, 0x40, 0x72, 0x5c, 0xb3, 0x09,
-	0xdd, 0xfd, 0x9a, 0xe7, 0x50, 0x2e, 0x7d, 0x1d, 0xb8, 0x9c, 0x22, 0x63, 0x36, 0x9c, 0x90, 0xcf,
-	0x07, 0xe0, 0x34, 0xcb, 0x87, 0xd8, 0xb5, 0xd8, 0x3f, 0x93, 0x99, 0x1f, 0x5c, 0x3a, 0x83, 0x25,
-	0x22, 0x76, 0x59, 0x26, 0x48, 0x75, 0x2c, 0xe2, 0x42, 0x40, 0xd2, 0x22, 0x90, 0xea, 0xbb, 0xd0,
-	0x35, 0x74, 0xad, 0xe6, 0xea, 0x50, 0xab, 0x7d, 0xbb, 0x65, 0xfb, 0xb0, 0x38, 0x30, 0x93, 0x99,
-	0xcf, 0xab, 0xa7, 0x08, 0x46, 0xd5, 0xa1, 0xf6, 0x51, 0x00, 0xaf, 0x56, 0xbe, 0xf7, 0xec, 0xc1,
-	0x42, 0xa8, 0xec, 0x1f, 0x3c, 0x7b, 0xb0, 0x30, 0x8d, 0x2c, 0x7a, 0x9f, 0xda, 0x34, 0x61, 0x43,
-	0xe5, 0x0e, 0x98, 0x48, 0x80, 0x54, 0xdd, 0x73, 0x6c, 0xcb, 0xd3, 0xa5, 0x77, 0x41, 0x81, 0x98,
-	0xca, 0xd4, 0x88, 0x81, 0x67, 0x1e, 0x3d, 0x2e, 0x9d, 0xf8, 0xcb, 0xe3, 0x52, 0xfe, 0xb6, 0x69,
-	0xf9, 0x5f, 0x3e, 0xbc, 0x34, 0x48, 0x74, 0x11, 0x7c, 0x7e, 0xfe, 0xec, 0xc1, 0x42, 0x46, 0x3d,
-	0x89, 0x87, 0xac, 0x6a, 0xca, 0x4f, 0x32, 0xc8, 0x63, 0xde, 0xd7, 0x1b, 0x3a, 0xf5, 0x98, 0x2b,
-	0xe0, 0xa4, 0xed, 0xe8, 0x6e, 0x57, 0x2e, 0x43, 0x29, 0x3b, 0xfa, 0x4c, 0xf5, 0xb5, 0x40, 0x6a,
-	0x4a, 0x9f, 0x22, 0x36, 0xbb, 0x10, 0xe5, 0x2c, 0x12, 0x9b, 0x05, 0x85, 0x62, 0x2b, 0xbf, 0xcd,
-	0x80, 0xf1, 0x00, 0x67, 0x7a, 0x75, 0xdb, 0xf2, 0x4d, 0xab, 0x75, 0xb4, 0x8b, 0x97, 0x5e, 0x02,
-	0xfd, 0xae, 0x0e, 0x3d, 0xdb, 0x42, 0xce, 0x5e, 0x50, 0xc9, 0x57, 0xf5, 0x75, 0x4e, 0xa8, 0x0b,
-	0x02, 0xa1, 0x92, 0xab, 0x54, 0xa6, 0xc1, 0xa4, 0x08, 0x4e, 0xc5, 0xfb, 0x27, 0x1b, 0xc8, 0xb7,
-	0xb6, 0xbf, 0xa5, 0xd7, 0x8f, 0x28, 0x90, 0x4b, 0x60, 0xd0, 0x46, 0xec, 0x31, 0x01, 0x16, 0x0e,
-	0x60, 0x10, 0x22, 0x38, 0x0f, 0x86, 0x1c, 0xb8, 0xdf, 0xb0, 0xa1, 0x56, 0xf3, 0xcc, 0x4f, 0x74,
-	0x14, 0xa8, 0x79, 0x75, 0x90, 0xc0, 0x36, 0xcc, 0x4f, 0x92, 0xc9, 0xa0, 0xaf, 0xc7, 0x64, 0x70,
-	0x1e, 0x0c, 0x05, 0x4a, 0x08, 0x92, 0x41, 0x90, 0xca, 0x50, 0xe8, 0x15, 0xd4, 0x41, 0x02, 0x0b,
-	0xc8, 0xd3, 0x82, 0x74, 0xa0, 0xc7, 0x20, 0x7d, 0x05, 0x9c, 0xd2, 0xef, 0x3b, 0x81, 0xac, 0xf5,
-	0x5d, 0xbd, 0x7e, 0xd7, 0x6b, 0x35, 0xbd, 0xe2, 0xc9, 0x99, 0xdc, 0xfc, 0x90, 0x3a, 0x8a, 0xe1,
-	0xd7, 0x42, 0xb0, 0xf4, 0x01, 0x18, 0x75, 0x75, 0xad, 0x65, 0x69, 0xd0, 0xaa, 0xef, 0xe3, 0x75,
-	0x15, 0x44, 0x72, 0xa9, 0x94, 0x08, 0xc9, 0x35, 0xe2, 0xc6, 0xbe, 0x7b, 0x08, 0x74, 0x6c, 0xe3,
-	0x58, 0xa0, 0x63, 0x10, 0x1b, 0xe8, 0xc4, 0x52, 0xbd, 0x04, 0x3a, 0x1e, 0xb2, 0xaa, 0x29, 0x0f,
-	0xb3, 0x60, 0x78, 0xcd, 0x33, 0x36, 0x74, 0xd8, 0x20, 0xfe, 0x74, 0x44, 0x91, 0xd2, 0xd1, 0xa3,
-	0x5e, 0x07, 0x13, 0x46, 0xc3, 0xde, 0x86, 0x8d, 0xda, 0x9e, 0xe9, 0xfa, 0x2d, 0xd8, 0xa8, 0x19,
-	0xae, 0xdd, 0x72, 0x02, 0xb1, 0x02, 0xe7, 0x1a, 0x56, 0xc7, 0x31, 0x7a, 0x0b, 0x63, 0x6f, 0x04,
-	0xc8, 0x55, 0x4d, 0x7a, 0x1f, 0x94, 0x3c, 0xbd, 0x6e, 0x5b, 0x1a, 0x71, 0x83, 0xed, 0x86, 0x57,
-	0x83, 0x86, 0x51, 0xf3, 0x4c, 0xc3, 0x82, 0x7e, 0xcb, 0xd5, 0x71, 0xfa, 0x1f, 0x52, 0xcf, 0x51,
-	0xb2, 0x0d, 0x67, 0xa5, 0xe1, 0x2d, 0x1b, 0xc6, 0x06, 0x25, 0xa9, 0x96, 0xb9, 0x78, 0x9d, 0xe4,
-	0x4d, 0x12, 0x29, 0x49, 0x99, 0x00, 0x67, 0x62, 0x00, 0x1a, 0xa1, 0x4f, 0xb3, 0x28, 0x42, 0x23,
-	0xcc, 0xd6, 0xd2, 0xff, 0xa4, 0x46, 0x85, 0x51, 0xd5, 0x2f, 0x8c, 0xaa, 0xee, 0x76, 0x00, 0x56,
-	0xa3, 0x64, 0x07, 0x60, 0x41, 0xd4, 0x00, 0xbf, 0xcb, 0x80, 0xd3, 0x6b, 0x9e, 0xa1, 0xea, 0x01,
-	0xfc, 0xc5, 0xbb, 0x75, 0xf5, 0x0a, 0x27, 0x9c, 0xc2, 0x0b, 0x97, 0x5c, 0xad, 0x32, 0x05, 0xce,
-	0x09, 0xc0, 0x54, 0xc8, 0x3f, 0xe0, 0xa8, 0xbd, 0x66, 0x3b, 0xfb, 0x44, 0x3c, 0x39, 0x29, 0x1e,
-	0x23, 0xc4, 0x1c, 0x18, 0xf5, 0xdc, 0x7a, 0x8d, 0x17, 0x64, 0xd8, 0x73, 0xeb, 0x2b, 0x91, 0x2c,
-	0x73, 0x60, 0x54, 0xf3, 0xfc, 0x18, 0x1d, 0x96, 0x67, 0x58, 0xf3, 0xfc, 0x38, 0x5d, 0xc0, 0x8f,
-	0x95, 0x3b, 0x4f, 0xf9, 0xdd, 0x8a, 0xfc, 0x8f, 0xf0, 0x63, 0xe9, 0xfa, 0x28, 0x3f, 0x86, 0xee,
-	0x26, 0x98, 0x08, 0xe8, 0x7a, 0x2e, 0xb8, 0xc6, 0x35, 0xcf, 0x5f, 0x4f, 0xa6, 0xf3, 0xee, 0x42,
-	0x39, 0xd2, 0x9c, 0xb2, 0x85, 0x42, 0x39, 0x02, 0x1c, 0x56, 0x66, 0xfd, 0x0d, 0x5b, 0x42, 0xbd,
-	0x60, 0x27, 0xec, 0xa1, 0xc6, 0x22, 0x5a, 0x61, 0x6b, 0xac, 0x84, 0xf3, 0xfd, 0x9b, 0xab, 0xb1,
-	0x8e, 0x56, 0xba, 0xf7, 0x00, 0xa0, 0x76, 0xf0, 0x8a, 0xb9, 0x99, 0x5c, 0x57, 0x86, 0x28, 0x84,
-	0x86, 0xf0, 0x98, 0x22, 0x2d, 0xff, 0xb5, 0x8a, 0x34, 0xa2, 0x1a, 0xae, 0x48, 0x4b, 0xe8, 0xe7,
-	0xa7, 0x19, 0x30, 0x42, 0x77, 0x6b, 0x94, 0x54, 0x0f, 0x54, 0xa3, 0x4d, 0x01, 0x80, 0xd3, 0x35,
-	0xa3, 0x96, 0x02, 0x82, 0x20, 0xad, 0x8c, 0x83, 0x3e, 0xfd, 0xbe, 0xef, 0x42, 0x62, 0x6d, 0xfc,
-	0x81, 0x9d, 0x9f, 0xad, 0x2c, 0xa6, 0xd2, 0x2a, 0x0b, 0xb4, 0x30, 0xe5, 0x36, 0x78, 0x29, 0x0e,
-	0xa1, 0xde, 0xff, 0x36, 0x38, 0x49, 0x77, 0x8b, 0x6e, 0x9d, 0x7f, 0xc0, 0xc0, 0x5b, 0x88, 0xf2,
-	0x63, 0xac, 0x02, 0xec, 0x3e, 0x58, 0x05, 0x07, 0x73, 0x8e, 0xf6, 0x4a, 0xc0, 0x85, 0x54, 0xcc,
-	0x82, 0x53, 0x69, 0x7e, 0x8d, 0xe5, 0x2d, 0x22, 0x79, 0x19, 0x08, 0xb5, 0xda, 0xb3, 0x2c, 0xf2,
-	0xea, 0xdb, 0x8e, 0x16, 0xaa, 0x62, 0x4d, 0x6f, 0x6e, 0xeb, 0xee, 0x01, 0x17, 0xfe, 0x16, 0x18,
-	0xc4, 0x0b, 0xb7, 0xef, 0x59, 0xba, 0x8b, 0x57, 0xde, 0x66, 0x20, 0x96, 0xf2, 0x56, 0x40, 0x9b,
-	0x90, 0x39, 0x97, 0x34, 0xfc, 0x0a, 0x18, 0x69, 0xa2, 0x95, 0x79, 0x35, 0xdf, 0x0e, 0x8e, 0xb8,
-	0xc5, 0xfc, 0x4c, 0x6e, 0x7e, 0x30, 0x59, 0x82, 0xae, 0x79, 0x06, 0x23, 0x85, 0x3a, 0x44, 0xc6,
-	0x6c, 0xda, 0xcb, 0x5a, 0xb0, 0xc5, 0x8f, 0x31, 0x3c, 0x34, 0xa4, 0x8e, 0x62, 0x1f, 0x8a, 0xac,
-	0xf4, 0x35, 0x8e, 0x52, 0x16, 0x58, 0x7f, 0xdd, 0xc5, 0x0f, 0xa7, 0x50, 0x12, 0x3f, 0x1c, 0x9c,
-	0x5a, 0xe2, 0x87, 0x59, 0xb2, 0x83, 0x5b, 0xfa, 0xbd, 0xe3, 0x6c, 0x88, 0xab, 0x60, 0x80, 0x68,
-	0xa4, 0x2b, 0x0b, 0x84, 0xc4, 0xdd, 0x56, 0x04, 0x71, 0xe9, 0x69, 0x45, 0x10, 0x07, 0x53, 0xa5,
-	0xfd, 0x1c, 0x47, 0x1c, 0xab, 0xaf, 0xd7, 0x40, 0x3f, 0x9e, 0xb2, 0xa3, 0xb6, 0x08, 0x9d, 0xb4,
-	0x0a, 0x82, 0xda, 0xcc, 0x74, 0xa1, 0x6f, 0xda, 0x56, 0xcd, 0x37, 0x49, 0xc8, 0x0d, 0x2e, 0xc9,
-	0x65, 0xdc, 0x07, 0x2b, 0x87, 0x7d, 0xb0, 0xf2, 0x66, 0xd8, 0x07, 0x5b, 0xc9, 0x7f, 0xf6, 0xd7,
-	0x52, 0x46, 0x1d, 0x89, 0x06, 0x06, 0xa8, 0xaa, 0x92, 0x12, 0x8c, 0xac, 0x48, 0xff, 0xc0, 0xa5,
-	0x1a, 0xe3, 0x09, 0x1f, 0x04, 0x49, 0xec, 0xd8, 0x19, 0x9a, 0xa6, 0xda, 0x3c, 0x9b, 0x6a, 0xbb,
-	0x32, 0x63, 0x52, 0x36, 0x62, 0xc6, 0x24, 0x38, 0x2a, 0xec, 0x32, 0xa8, 0xb0, 0xbb, 0xa9, 0xc3,
-	0x3d, 0x92, 0x37, 0x7b, 0xb7, 0xe2, 0x91, 0x29, 0xa2, 0xba, 0x18, 0x88, 0x4c, 0xa6, 0x49, 0x29,
-	0xac, 0xa2, 0x95, 0x93, 0x33, 0x52, 0x04, 0xa0, 0x42, 0xfe, 0x3d, 0xcb, 0xd8, 0x1d, 0xd7, 0x95,
-	0xab, 0xd6, 0x8e, 0x7d, 0x54, 0xf5, 0xc3, 0x75, 0x61, 0x13, 0x2e, 0x87, 0x1c, 0xbb, 0x18, 0xab,
-	0x2c, 0x6f, 0xaf, 0x5a, 0xfe, 0xd5, 0x2b, 0x5b, 0xb0, 0xd1, 0xd2, 0xf9, 0xf6, 0xdc, 0x61, 0xb4,
-	0x27, 0xbf, 0x56, 0x4b, 0xa4, 0x17, 0x8f, 0x8b, 0xb4, 0x1a, 0xf3, 0xb8, 0x08, 0x4c, 0x8d, 0xf1,
-	0xfb, 0x0c, 0xae, 0x7f, 0xa1, 0x55, 0xd7, 0x1b, 0xb1, 0xc6, 0xd2, 0x8b, 0x2a, 0x56, 0xaf, 0x72,
-	0x62, 0xce, 0x0a, 0x8a, 0x18, 0x6e, 0xbd, 0x4a, 0x09, 0x4c, 0x09, 0x11, 0x54, 0xd4, 0x3f, 0x65,
-	0xc1, 0xd0, 0x9a, 0x67, 0xac, 0xb7, 0xfc, 0x75, 0xbb, 0x61, 0xd6, 0xf7, 0x0f, 0x28, 0xe1, 0x9b,
-	0xa0, 0xe0, 0xb8, 0xa6, 0x55, 0x37, 0x1d, 0xd8, 0xa0, 0xf9, 0x11, 0xd9, 0x30, 0xea, 0xe7, 0x97,
-	0xd7, 0x43, 0x0a, 0x35, 0x22, 0x0e, 0x0e, 0x69, 0xae, 0xee, 0xd9, 0x2d, 0xb7, 0x1e, 0xca, 0x4d,
-	0xbf, 0xa5, 0x2a, 0x00, 0x9e, 0x0f, 0x7d, 0x3d, 0x70, 0x97, 0x70, 0x43, 0xe1, 0xd9, 0x6e, 0x84,
-	0x24, 0x2a, 0x43, 0x2d, 0xad, 0xf1, 0x79, 0x7b, 0xa0, 0x63, 0xde, 0x3e, 0xf9, 0xe8, 0x71, 0x29,
-	0x23, 0xcc, 0xdd, 0x97, 0x38, 0x03, 0x9c, 0xe3, 0x0d, 0x40, 0xb5, 0xa8, 0xdc, 0x46, 0x85, 0x13,
-	0xfd, 0x66, 0xcf, 0x4f, 0x0e, 0x82, 0x84, 0x0d, 0x87, 0xae, 0xce, 0x4f, 0x78, 0xc8, 0xaa, 0xa6,
-	0xfc, 0x91, 0x3d, 0x3f, 0x1d, 0x3f, 0x83, 0xf5, 0x72, 0xa6, 0x22, 0x8a, 0xba, 0xc3, 0x9c, 0xa9,
-	0x0e, 0x57, 0x57, 0x6c, 0x8c, 0x63, 0xa7, 0x8f, 0xc5, 0xf8, 0xa7, 0x6c, 0xc2, 0x8d, 0xf0, 0x2f,
-	0xac, 0x31, 0x15, 0x4f, 0x83, 0xf9, 0xa3, 0x4b, 0x83, 0x91, 0xac, 0xca, 0x2f, 0xb1, 0x3b, 0x61,
-	0xf8, 0x3a, 0xba, 0x37, 0x93, 0xae, 0x82, 0x02, 0x6c, 0xf9, 0xbb, 0xb6, 0x1b, 0x2c, 0xa3, 0x93,
-	0x02, 0x22, 0x52, 0xe9, 0x0d, 0xd0, 0x8f, 0x6f, 0xde, 0x88, 0x37, 0x8d, 0xc7, 0xd7, 0x8e, 0xb9,
-	0xaf, 0x14, 0x02, 0x03, 0x62, 0x4b, 0x11, 0xf2, 0xea, 0xe5, 0x60, 0xe9, 0x11, 0xa3, 0x14, 0xa7,
-	0x61, 0xd7, 0x48, 0x0e, 0xe2, 0x2c, 0x88, 0x9a, 0xf5, 0x47, 0x59, 0x70, 0x6a, 0xcd, 0x33, 0xd6,
-	0x4c, 0xc3, 0x85, 0x47, 0x7c, 0x4b, 0x23, 0xbd, 0x02, 0xc6, 0x12, 0x3d, 0x1a, 0x53, 0x43, 0x96,
-	0x1d, 0x56, 0x47, 0xd8, 0x36, 0xcc, 0xaa, 0xd6, 0xae, 0x9d, 0x93, 0xef, 0xbd, 0x9d, 0x73, 0x99,
-	0xb3, 0x76, 0x89, 0xd7, 0x58, 0x4c, 0x05, 0x8a, 0x0c, 0x8a, 0x49, 0x18, 0xd5, 0xd9, 0xcf, 0xb2,
-	0x08, 0x79, 0xcd, 0x6e, 0x3a, 0x41, 0x18, 0x3e, 0x17, 0xdd, 0xad, 0x80, 0x69, 0x61, 0x1f, 0x76,
-	0x07, 0x36, 0xcd, 0xc6, 0x7e, 0xa4, 0x48, 0x99, 0x6f, 0xc7, 0x5e, 0x47, 0x24, 0xab, 0x9a, 0xf4,
-	0x36, 0x18, 0x32, 0xf6, 0x8c, 0x5a, 0x13, 0x3a, 0x8e, 0x69, 0x19, 0xe1, 0x06, 0x51, 0x8c, 0x3b,
-	0xde, 0x8d, 0xad, 0x1b, 0x6b, 0x98, 0x40, 0x1d, 0x34, 0xf6, 0x0c, 0xf2, 0xdb, 0xab, 0xbe, 0xc5,
-	0xe9, 0xf0, 0x65, 0x51, 0x4b, 0x4c, 0xa0, 0x12, 0x45, 0x01, 0x33, 0x69, 0x38, 0xaa, 0xd3, 0xcf,
-	0x33, 0xb8, 0x8b, 0x80, 0x76, 0xde, 0xe7, 0xa1, 0xd1, 0xea, 0x1b, 0x9c, 0x40, 0x17, 0xd3, 0x4a,
-	0x84, 0xb8, 0x38, 0x33, 0x60, 0x5a, 0x8c, 0x49, 0x0a, 0x83, 0x5b, 0xaf, 0xc7, 0x47, 0x18, 0xc1,
-	0x7a, 0x88, 0x30, 0x02, 0x0c, 0x15, 0xe6, 0x57, 0x19, 0x50, 0x40, 0x8d, 0x72, 0x7f, 0x13, 0x1a,
-	0x07, 0x5c, 0x3f, 0xbb, 0x07, 0x66, 0x13, 0x45, 0x4b, 0x19, 0xe4, 0x7d, 0x68, 0x78, 0xa4, 0x98,
-	0x96, 0x93, 0x97, 0x60, 0x98, 0x6a, 0x13, 0x1a, 0x9e, 0x8a, 0xe8, 0xaa, 0x0b, 0x9c, 0xa8, 0x45,
-	0x51, 0xa7, 0x3f, 0x58, 0xad, 0x72, 0x1a, 0x8c, 0xd1, 0x0f, 0x2a, 0xd0, 0xaf, 0xb3, 0xc8, 0x3a,
-	0x6c, 0x76, 0xbf, 0x86, 0x6f, 0x04, 0x5f, 0xd8, 0x66, 0xd6, 0xc5, 0x4d, 0x68, 0xf2, 0x2e, 0xb3,
-	0x8f, 0xbf, 0xcb, 0xec, 0xe1, 0xba, 0xa4, 0x2b, 0x7f, 0x11, 0x68, 0x88, 0xf8, 0x8b, 0x00, 0x43,
-	0xd5, 0xfb, 0x55, 0x06, 0xf5, 0x66, 0x70, 0x7c, 0x1c, 0x23, 0x25, 0x57, 0xdf, 0xe5, 0x64, 0x7e,
-	0x35, 0x2d, 0xe0, 0x45, 0x92, 0xcf, 0x81, 0xd9, 0x76, 0x78, 0xf6, 0x7e, 0x3d, 0x2c, 0xd1, 0x0c,
-	0xe8, 0xeb, 0x87, 0x70, 0x1c, 0x62, 0x3a, 0xbf, 0xd9, 0x03, 0xde, 0xce, 0xe7, 0x3a, 0xa9, 0x2b,
-	0xdf, 0xd1, 0x27, 0xfb, 0x3a, 0xfb, 0xa4, 0xe0, 0x7e, 0x3d, 0x5e, 0xa6, 0x0d, 0xf4, 0x78, 0x81,
-	0xff, 0xfc, 0xaf, 0xd5, 0xdf, 0xe4, 0x9c, 0x64, 0x4e, 0x5c, 0x91, 0x27, 0x6d, 0xab, 0x7c, 0x0c,
-	0x4a, 0x29, 0xa8, 0xc3, 0xba, 0x0d, 0xfa, 0x4f, 0x16, 0x05, 0x5f, 0x38, 0xc5, 0xe1, 0xc5, 0xd6,
-	0x12, 0x18, 0x68, 0x21, 0x66, 0x5d, 0x38, 0x18, 0x21, 0x3c, 0x36, 0x0e, 0x26, 0x72, 0x91, 0x01,
-	0x71, 0xd2, 0x7b, 0x8f, 0xb3, 0xed, 0xa5, 0x74, 0xdb, 0x8a, 0x52, 0xc0, 0x3c, 0x98, 0x6b, 0x4f,
-	0x41, 0x93, 0xc0, 0x2f, 0x32, 0xe8, 0x34, 0xb5, 0x69, 0x1b, 0x46, 0x43, 0xdf, 0x58, 0x5f, 0xf6,
-	0xc2, 0x41, 0xda, 0xb2, 0x71, 0x74, 0x39, 0xb0, 0xfa, 0x0e, 0x27, 0xe1, 0x02, 0x2f, 0x61, 0xda,
-	0xa2, 0x94, 0x8b, 0xe0, 0x42, 0x1b, 0x34, 0x95, 0xed, 0xfb, 0x39, 0x70, 0x16, 0xef, 0xaa, 0xb8,
-	0x52, 0xb8, 0xde, 0xb0, 0xef, 0xa9, 0xd0, 0xd7, 0x6f, 0x9a, 0x4d, 0xf3, 0xc8, 0xb2, 0xfb, 0xdb,
-	0x60, 0x88, 0x10, 0xe0, 0x8e, 0x64, 0xae, 0x03, 0x6b, 0xc2, 0x0e, 0xb7, 0x24, 0x0f, 0xa1, 0xeb,
-	0x76, 0x07, 0x8c, 0xee, 0x34, 0xec, 0x7b, 0xb5, 0xa0, 0x40, 0xaa, 0x35, 0x02, 0x49, 0xc9, 0x8b,
-	0xc0, 0xd7, 0x48, 0x00, 0x9f, 0xc1, 0x6c, 0x3c, 0xed, 0x6e, 0xd9, 0xb4, 0x2b, 0x4d, 0xe8, 0xef,
-	0x96, 0x57, 0x51, 0x44, 0x03, 0xc2, 0x7f, 0x35, 0x0c, 0xe8, 0xe1, 0x1d, 0x56, 0x61, 0xd5, 0x2a,
-	0x67, 0xb3, 0x79, 0x61, 0x3d, 0x23, 0x50, 0xb6, 0x72, 0x01, 0x9c, 0x4f, 0x45, 0x86, 0xf6, 0x5a,
-	0xfa, 0x57, 0x11, 0xe4, 0xd6, 0x3c, 0x43, 0xda, 0x04, 0x43, 0xb1, 0xd7, 0x9b, 0x53, 0xdc, 0x55,
-	0x03, 0x8b, 0x96, 0x2f, 0xb6, 0x45, 0xd3, 0x9c, 0xb6, 0x09, 0x86, 0x62, 0x2f, 0xfc, 0x78, 0xae,
-	0x2c, 0x5a, 0xc0, 0x55, 0xf4, 0x06, 0x4f, 0xfa, 0x18, 0x9c, 0xe2, 0x5a, 0xbb, 0xe7, 0xb9, 0xa1,
-	0x49, 0x12, 0xf9, 0x95, 0x8e, 0x24, 0x74, 0x86, 0x3a, 0x18, 0xe3, 0x5f, 0xf8, 0x29, 0xfc, 0xea,
-	0x92, 0x34, 0xf2, 0x42, 0x67, 0x1a, 0x3a, 0xc9, 0x7d, 0x50, 0x4c, 0x4d, 0x01, 0xfc, 0x5a, 0xd3,
-	0x48, 0xe5, 0xcb, 0x5d, 0x93, 0xb2, 0x66, 0x89, 0x55, 0x1e, 0x69, 0xc6, 0xc6, 0xe8, 0x54, 0x63,
-	0x27, 0x36, 0xb0, 0x0f, 0x01, 0x60, 0x9e, 0xc3, 0x9c, 0xe3, 0x06, 0x45, 0x48, 0xf9, 0x42, 0x1b,
-	0x24, 0xbb, 0xca, 0xd8, 0x2b, 0xa7, 0xa9, 0x36, 0x83, 0xb6, 0x96, 0x04, 0xab, 0x14, 0x3d, 0xdf,
-	0x09, 0x9c, 0x87, 0x7b, 0xba, 0xc3, 0x3b, 0x4f, 0x92, 0x44, 0xe0, 0x3c, 0x69, 0x6f, 0x67, 0x02,
-	0x3d, 0x30, 0xef, 0x66, 0x78, 0x3d, 0x44, 0x48, 0x81, 0x1e, 0x04, 0xcf, 0x44, 0x68, 0x10, 0xa5,
-	0x5a, 0x8b, 0x45, 0xa7, 0x06, 0x51, 0x82, 0xeb, 0x0e, 0x90, 0x04, 0x2d, 0x79, 0xc1, 0x82, 0x38,
-	0x22, 0xf9, 0xd5, 0x2e, 0x88, 0x52, 0x42, 0x89, 0x4c, 0xd3, 0x36, 0x94, 0xc8, 0x2c, 0x0b, 0x9d,
-	0x69, 0xf8, 0x8c, 0xc0, 0xf4, 0x1e, 0xd3, 0x32, 0x42, 0x44, 0x92, 0x9a, 0x11, 0xf8, 0x0e, 0xa7,
-	0x64, 0x82, 0xd3, 0xa2, 0x92, 0x6a, 0xb6, 0x2d, 0x07, 0x42, 0x25, 0x2f, 0x76, 0x43, 0x45, 0xa7,
-	0xfa, 0x0e, 0x38, 0x9b, 0x7e, 0x3e, 0x5a, 0x48, 0xd1, 0xbd, 0x68, 0xda, 0xa5, 0xee, 0x69, 0xe9,
-	0xe4, 0x0d, 0x30, 0x2e, 0x3c, 0x9c, 0x88, 0xbd, 0x2a, 0x49, 0x26, 0x5f, 0xea, 0x8a, 0x8c, 0xce,
-	0xf6, 0x69, 0x06, 0x9c, 0x6b, 0x57, 0xb1, 0x2e, 0xa6, 0xb2, 0x13, 0xc9, 0x7b, 0xa5, 0x17, 0x6a,
-	0xba, 0x86, 0x8f, 0xc0, 0x20, 0xfb, 0x92, 0x66, 0x32, 0x25, 0xd9, 0x21, 0xac, 0x3c, 0xdb, 0x0e,
-	0xcb, 0xb2, 0x64, 0x5f, 0xa6, 0x4c, 0xa6, 0x44, 0x64, 0x1a, 0x4b, 0xc1, 0xeb, 0x91, 0x20, 0x8c,
-	0xf8, 0x97, 0x23, 0x4a, 0x8a, 0x5f, 0x31, 0x34, 0x82, 0x30, 0x4a, 0x7d, 0x18, 0x11, 0x85, 0x11,
-	0x73, 0x57, 0x7e, 0xbe, 0xdd, 0x78, 0x44, 0x92, 0x1a, 0x46, 0xfc, 0xf5, 0x73, 0x90, 0x1b, 0x99,
-	0xab, 0x67, 0x3e, 0x37, 0x46, 0x48, 0x41, 0x6e, 0xe4, 0x6f, 0x7a, 0x71, 0x36, 0x4f, 0x3c, 0xe3,
-	0x10, 0x65, 0xf3, 0x38, 0x89, 0x30, 0x9b, 0x8b, 0xdf, 0x3d, 0x48, 0xff, 0x0f, 0x0a, 0xd1, 0x7d,
-	0x9e, 0xcc, 0x8d, 0xa3, 0x38, 0x59, 0x49, 0xc7, 0xf1, 0xa9, 0x9c, 0xf0, 0x4b, 0x4b, 0xe5, 0x84,
-	0xe5, 0xc5, 0xb6, 0x68, 0x96, 0x6b, 0xec, 0xd6, 0x61, 0x2a, 0xc5, 0x1e, 0x18, 0x2d, 0xe0, 0x2a,
-	0x6a, 0xfe, 0x4b, 0xdf, 0x00, 0xc3, 0xf1, 0xee, 0xe4, 0x34, 0x37, 0x2e, 0x86, 0x97, 0xe7, 0xda,
-	0xe3, 0x29, 0x63, 0x1b, 0x9c, 0x11, 0x77, 0xc7, 0xe7, 0x04, 0xbb, 0xa1, 0x80, 0x4e, 0x2e, 0x77,
-	0x47, 0xc7, 0xe6, 0x6e, 0x51, 0xeb, 0x78, 0x36, 0x25, 0x3d, 0xc6, 0x27, 0x5b, 0xec, 0x86, 0x8a,
-	0x9d, 0x4a, 0xd4, 0xd8, 0x9d, 0x4d, 0xa9, 0x1e, 0x3a, 0x4d, 0xd5, 0xa6, 0xf5, 0x2a, 0xad, 0x80,
-	0x7e, 0xd2, 0x76, 0x9d, 0x10, 0x54, 0x3e, 0x01, 0x42, 0x2e, 0xa5, 0x20, 0x28, 0x0f, 0x17, 0xbc,
-	0x94, 0x72, 0x52, 0x7b, 0x59, 0x34, 0x54, 0x40, 0x28, 0x57, 0xba, 0x24, 0x0c, 0xe7, 0x94, 0xfb,
-	0xbe, 0x1b, 0x1c, 0x74, 0x56, 0xae, 0x3f, 0x7a, 0x32, 0x9d, 0xf9, 0xe2, 0xc9, 0x74, 0xe6, 0x6f,
-	0x4f, 0xa6, 0x33, 0x9f, 0x3d, 0x9d, 0x3e, 0xf1, 0xc5, 0xd3, 0xe9, 0x13, 0x7f, 0x7e, 0x3a, 0x7d,
-	0xe2, 0x9b, 0x8b, 0x86, 0xe9, 0xef, 0xb6, 0xb6, 0xcb, 0x75, 0xbb, 0x59, 0x09, 0x78, 0xd7, 0x77,
-	0xa1, 0x69, 0xa1, 0x5f, 0x95, 0xbd, 0x25, 0xe6, 0xe4, 0x83, 0xfe, 0x00, 0x6d, 0xbb, 0x1f, 0xdd,
-	0x3c, 0xff, 0xdf, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x88, 0x82, 0x73, 0xcb, 0x37, 0x00,
-	0x00,
+func (m *MsgSetBucketLifecycle) Reset()         { *m = MsgSetBucketLifecycle{} }
+func (m *MsgSetBucketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketLifecycle) ProtoMessage()    {}
+func (*MsgSetBucketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{67}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// basic operation of bucket
-	CreateBucket(ctx context.Context, in *MsgCreateBucket, opts ...grpc.CallOption) (*MsgCreateBucketResponse, error)
-	DeleteBucket(ctx context.Context, in *MsgDeleteBucket, opts ...grpc.CallOption) (*MsgDeleteBucketResponse, error)
-	UpdateBucketInfo(ctx context.Context, in *MsgUpdateBucketInfo, opts ...grpc.CallOption) (*MsgUpdateBucketInfoResponse, error)
-	DiscontinueBucket(ctx context.Context, in *MsgDiscontinueBucket, opts ...grpc.CallOption) (*MsgDiscontinueBucketResponse, error)
-	ToggleSPAsDelegatedAgent(ctx context.Context, in *MsgToggleSPAsDelegatedAgent, opts ...grpc.CallOption) (*MsgToggleSPAsDelegatedAgentResponse, error)
-	// basic operation of object
-	CreateObject(ctx context.Context, in *MsgCreateObject, opts ...grpc.CallOption) (*MsgCreateObjectResponse, error)
-	SealObject(ctx context.Context, in *MsgSealObject, opts ...grpc.CallOption) (*MsgSealObjectResponse, error)
-	SealObjectV2(ctx context.Context, in *MsgSealObjectV2, opts ...grpc.CallOption) (*MsgSealObjectV2Response, error)
-	RejectSealObject(ctx context.Context, in *MsgRejectSealObject, opts ...grpc.CallOption) (*MsgRejectSealObjectResponse, error)
-	CopyObject(ctx context.Context, in *MsgCopyObject, opts ...grpc.CallOption) (*MsgCopyObjectResponse, error)
-	DeleteObject(ctx context.Context, in *MsgDeleteObject, opts ...grpc.CallOption) (*MsgDeleteObjectResponse, error)
-	CancelCreateObject(ctx context.Context, in *MsgCancelCreateObject, opts ...grpc.CallOption) (*MsgCancelCreateObjectResponse, error)
-	DiscontinueObject(ctx context.Context, in *MsgDiscontinueObject, opts ...grpc.CallOption) (*MsgDiscontinueObjectResponse, error)
-	UpdateObjectInfo(ctx context.Context, in *MsgUpdateObjectInfo, opts ...grpc.CallOption) (*MsgUpdateObjectInfoResponse, error)
-	UpdateObjectContent(ctx context.Context, in *MsgUpdateObjectContent, opts ...grpc.CallOption) (*MsgUpdateObjectContentResponse, error)
-	CancelUpdateObjectContent(ctx context.Context, in *MsgCancelUpdateObjectContent, opts ...grpc.CallOption) (*MsgCancelUpdateObjectContentResponse, error)
-	DelegateCreateObject(ctx context.Context, in *MsgDelegateCreateObject, opts ...grpc.CallOption) (*MsgDelegateCreateObjectResponse, error)
-	DelegateUpdateObjectContent(ctx context.Context, in *MsgDelegateUpdateObjectContent, opts ...grpc.CallOption) (*MsgDelegateUpdateObjectContentResponse, error)
-	// basic operation of group
-	CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error)
-	DeleteGroup(ctx context.Context, in *MsgDeleteGroup, opts ...grpc.CallOption) (*MsgDeleteGroupResponse, error)
-	UpdateGroupMember(ctx context.Context, in *MsgUpdateGroupMember, opts ...grpc.CallOption) (*MsgUpdateGroupMemberResponse, error)
-	UpdateGroupExtra(ctx context.Context, in *MsgUpdateGroupExtra, opts ...grpc.CallOption) (*MsgUpdateGroupExtraResponse, error)
-	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
-	RenewGroupMember(ctx context.Context, in *MsgRenewGroupMember, opts ...grpc.CallOption) (*MsgRenewGroupMemberResponse, error)
-	// basic operation of policy
-	PutPolicy(ctx context.Context, in *MsgPutPolicy, opts ...grpc.CallOption) (*MsgPutPolicyResponse, error)
-	DeletePolicy(ctx context.Context, in *MsgDeletePolicy, opts ...grpc.CallOption) (*MsgDeletePolicyResponse, error)
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	MigrateBucket(ctx context.Context, in *MsgMigrateBucket, opts ...grpc.CallOption) (*MsgMigrateBucketResponse, error)
-	CompleteMigrateBucket(ctx context.Context, in *MsgCompleteMigrateBucket, opts ...grpc.CallOption) (*MsgCompleteMigrateBucketResponse, error)
-	CancelMigrateBucket(ctx context.Context, in *MsgCancelMigrateBucket, opts ...grpc.CallOption) (*MsgCancelMigrateBucketResponse, error)
-	RejectMigrateBucket(ctx context.Context, in *MsgRejectMigrateBucket, opts ...grpc.CallOption) (*MsgRejectMigrateBucketResponse, error)
-	// Since: Manchurian upgrade
-	SetTag(ctx context.Context, in *MsgSetTag, opts ...grpc.CallOption) (*MsgSetTagResponse, error)
-	SetBucketFlowRateLimit(ctx context.Context, in *MsgSetBucketFlowRateLimit, opts ...grpc.CallOption) (*MsgSetBucketFlowRateLimitResponse, error)
+func (m *MsgSetBucketLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetBucketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetBucketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketLifecycle.Merge(m, src)
+}
+func (m *MsgSetBucketLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBucketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketLifecycle.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateBucket(ctx context.Context, in *MsgCreateBucket, opts ...grpc.CallOption) (*MsgCreateBucketResponse, error) {
-	out := new(MsgCreateBucketResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CreateBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetBucketLifecycle proto.InternalMessageInfo
+
+func (m *MsgSetBucketLifecycle) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteBucket(ctx context.Context, in *MsgDeleteBucket, opts ...grpc.CallOption) (*MsgDeleteBucketResponse, error) {
-	out := new(MsgDeleteBucketResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DeleteBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketLifecycle) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateBucketInfo(ctx context.Context, in *MsgUpdateBucketInfo, opts ...grpc.CallOption) (*MsgUpdateBucketInfoResponse, error) {
-	out := new(MsgUpdateBucketInfoResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateBucketInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketLifecycle) GetRules() []LifecycleRule {
+	if m != nil {
+		return m.Rules
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DiscontinueBucket(ctx context.Context, in *MsgDiscontinueBucket, opts ...grpc.CallOption) (*MsgDiscontinueBucketResponse, error) {
-	out := new(MsgDiscontinueBucketResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DiscontinueBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetBucketLifecycleResponse struct {
 }
 
-func (c *msgClient) ToggleSPAsDelegatedAgent(ctx context.Context, in *MsgToggleSPAsDelegatedAgent, opts ...grpc.CallOption) (*MsgToggleSPAsDelegatedAgentResponse, error) {
-	out := new(MsgToggleSPAsDelegatedAgentResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/ToggleSPAsDelegatedAgent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketLifecycleResponse) Reset()         { *m = MsgSetBucketLifecycleResponse{} }
+func (m *MsgSetBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketLifecycleResponse) ProtoMessage()    {}
+func (*MsgSetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{68}
+}
+func (m *MsgSetBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketLifecycleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketLifecycleResponse.Merge(m, src)
+}
+func (m *MsgSetBucketLifecycleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketLifecycleResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateObject(ctx context.Context, in *MsgCreateObject, opts ...grpc.CallOption) (*MsgCreateObjectResponse, error) {
-	out := new(MsgCreateObjectResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CreateObject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetBucketLifecycleResponse proto.InternalMessageInfo
+
+type MsgSetBucketVersioning struct {
+	// operator defines the account address of the operator, either the bucket owner or the updater with granted permission.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// max_versions defines how many prior versions are kept for each object of the bucket, 0 disables versioning
+	MaxVersions uint32 `protobuf:"varint,3,opt,name=max_versions,json=maxVersions,proto3" json:"max_versions,omitempty"`
 }
 
-func (c *msgClient) SealObject(ctx context.Context, in *MsgSealObject, opts ...grpc.CallOption) (*MsgSealObjectResponse, error) {
-	out := new(MsgSealObjectResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SealObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketVersioning) Reset()         { *m = MsgSetBucketVersioning{} }
+func (m *MsgSetBucketVersioning) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketVersioning) ProtoMessage()    {}
+func (*MsgSetBucketVersioning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{69}
+}
+func (m *MsgSetBucketVersioning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBucketVersioning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketVersioning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetBucketVersioning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketVersioning.Merge(m, src)
+}
+func (m *MsgSetBucketVersioning) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBucketVersioning) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketVersioning.DiscardUnknown(m)
 }
 
-func (c *msgClient) SealObjectV2(ctx context.Context, in *MsgSealObjectV2, opts ...grpc.CallOption) (*MsgSealObjectV2Response, error) {
-	out := new(MsgSealObjectV2Response)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SealObjectV2", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetBucketVersioning proto.InternalMessageInfo
+
+func (m *MsgSetBucketVersioning) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RejectSealObject(ctx context.Context, in *MsgRejectSealObject, opts ...grpc.CallOption) (*MsgRejectSealObjectResponse, error) {
-	out := new(MsgRejectSealObjectResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/RejectSealObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketVersioning) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CopyObject(ctx context.Context, in *MsgCopyObject, opts ...grpc.CallOption) (*MsgCopyObjectResponse, error) {
-	out := new(MsgCopyObjectResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CopyObject", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketVersioning) GetMaxVersions() uint32 {
+	if m != nil {
+		return m.MaxVersions
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) DeleteObject(ctx context.Context, in *MsgDeleteObject, opts ...grpc.CallOption) (*MsgDeleteObjectResponse, error) {
-	out := new(MsgDeleteObjectResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DeleteObject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetBucketVersioningResponse struct {
 }
 
-func (c *msgClient) CancelCreateObject(ctx context.Context, in *MsgCancelCreateObject, opts ...grpc.CallOption) (*MsgCancelCreateObjectResponse, error) {
-	out := new(MsgCancelCreateObjectResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CancelCreateObject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetBucketVersioningResponse) Reset()         { *m = MsgSetBucketVersioningResponse{} }
+func (m *MsgSetBucketVersioningResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketVersioningResponse) ProtoMessage()    {}
+func (*MsgSetBucketVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{70}
 }
-
-func (c *msgClient) DiscontinueObject(ctx context.Context, in *MsgDiscontinueObject, opts ...grpc.CallOption) (*MsgDiscontinueObjectResponse, error) {
-	out := new(MsgDiscontinueObjectResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DiscontinueObject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetBucketVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdateObjectInfo(ctx context.Context, in *MsgUpdateObjectInfo, opts ...grpc.CallOption) (*MsgUpdateObjectInfoResponse, error) {
-	out := new(MsgUpdateObjectInfoResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateObjectInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketVersioningResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) UpdateObjectContent(ctx context.Context, in *MsgUpdateObjectContent, opts ...grpc.CallOption) (*MsgUpdateObjectContentResponse, error) {
-	out := new(MsgUpdateObjectContentResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateObjectContent", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetBucketVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketVersioningResponse.Merge(m, src)
 }
-
-func (c *msgClient) CancelUpdateObjectContent(ctx context.Context, in *MsgCancelUpdateObjectContent, opts ...grpc.CallOption) (*MsgCancelUpdateObjectContentResponse, error) {
-	out := new(MsgCancelUpdateObjectContentResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CancelUpdateObjectContent", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetBucketVersioningResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) DelegateCreateObject(ctx context.Context, in *MsgDelegateCreateObject, opts ...grpc.CallOption) (*MsgDelegateCreateObjectResponse, error) {
-	out := new(MsgDelegateCreateObjectResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DelegateCreateObject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetBucketVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketVersioningResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DelegateUpdateObjectContent(ctx context.Context, in *MsgDelegateUpdateObjectContent, opts ...grpc.CallOption) (*MsgDelegateUpdateObjectContentResponse, error) {
-	out := new(MsgDelegateUpdateObjectContentResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DelegateUpdateObjectContent", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetBucketVersioningResponse proto.InternalMessageInfo
+
+type MsgRestoreObjectVersion struct {
+	// operator defines the account address of the operator, either the object owner or the updater with granted permission.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name defines the name of the object
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// version defines the prior version to restore
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (c *msgClient) CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error) {
-	out := new(MsgCreateGroupResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CreateGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRestoreObjectVersion) Reset()         { *m = MsgRestoreObjectVersion{} }
+func (m *MsgRestoreObjectVersion) String() string { return proto.CompactTextString(m) }
+func (*MsgRestoreObjectVersion) ProtoMessage()    {}
+func (*MsgRestoreObjectVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{71}
+}
+func (m *MsgRestoreObjectVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestoreObjectVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestoreObjectVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRestoreObjectVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreObjectVersion.Merge(m, src)
+}
+func (m *MsgRestoreObjectVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestoreObjectVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreObjectVersion.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteGroup(ctx context.Context, in *MsgDeleteGroup, opts ...grpc.CallOption) (*MsgDeleteGroupResponse, error) {
-	out := new(MsgDeleteGroupResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DeleteGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRestoreObjectVersion proto.InternalMessageInfo
+
+func (m *MsgRestoreObjectVersion) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateGroupMember(ctx context.Context, in *MsgUpdateGroupMember, opts ...grpc.CallOption) (*MsgUpdateGroupMemberResponse, error) {
-	out := new(MsgUpdateGroupMemberResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateGroupMember", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRestoreObjectVersion) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateGroupExtra(ctx context.Context, in *MsgUpdateGroupExtra, opts ...grpc.CallOption) (*MsgUpdateGroupExtraResponse, error) {
-	out := new(MsgUpdateGroupExtraResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateGroupExtra", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRestoreObjectVersion) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
-	out := new(MsgLeaveGroupResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/LeaveGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRestoreObjectVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RenewGroupMember(ctx context.Context, in *MsgRenewGroupMember, opts ...grpc.CallOption) (*MsgRenewGroupMemberResponse, error) {
-	out := new(MsgRenewGroupMemberResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/RenewGroupMember", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRestoreObjectVersionResponse struct {
 }
 
-func (c *msgClient) PutPolicy(ctx context.Context, in *MsgPutPolicy, opts ...grpc.CallOption) (*MsgPutPolicyResponse, error) {
-	out := new(MsgPutPolicyResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/PutPolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRestoreObjectVersionResponse) Reset()         { *m = MsgRestoreObjectVersionResponse{} }
+func (m *MsgRestoreObjectVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRestoreObjectVersionResponse) ProtoMessage()    {}
+func (*MsgRestoreObjectVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{72}
+}
+func (m *MsgRestoreObjectVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestoreObjectVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestoreObjectVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRestoreObjectVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreObjectVersionResponse.Merge(m, src)
+}
+func (m *MsgRestoreObjectVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestoreObjectVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreObjectVersionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeletePolicy(ctx context.Context, in *MsgDeletePolicy, opts ...grpc.CallOption) (*MsgDeletePolicyResponse, error) {
-	out := new(MsgDeletePolicyResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DeletePolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRestoreObjectVersionResponse proto.InternalMessageInfo
+
+type MsgSetBucketReadQuotaAutoTopup struct {
+	// operator defines the account address of the operator, either the bucket owner or the updater with granted permission.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// auto_topup defines how the charged read quota is raised, a zero max_read_quota disables the auto topup
+	AutoTopup ReadQuotaAutoTopup `protobuf:"bytes,3,opt,name=auto_topup,json=autoTopup,proto3" json:"auto_topup"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketReadQuotaAutoTopup) Reset()         { *m = MsgSetBucketReadQuotaAutoTopup{} }
+func (m *MsgSetBucketReadQuotaAutoTopup) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketReadQuotaAutoTopup) ProtoMessage()    {}
+func (*MsgSetBucketReadQuotaAutoTopup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{73}
+}
+func (m *MsgSetBucketReadQuotaAutoTopup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBucketReadQuotaAutoTopup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketReadQuotaAutoTopup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetBucketReadQuotaAutoTopup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketReadQuotaAutoTopup.Merge(m, src)
+}
+func (m *MsgSetBucketReadQuotaAutoTopup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBucketReadQuotaAutoTopup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketReadQuotaAutoTopup.DiscardUnknown(m)
 }
 
-func (c *msgClient) MigrateBucket(ctx context.Context, in *MsgMigrateBucket, opts ...grpc.CallOption) (*MsgMigrateBucketResponse, error) {
-	out := new(MsgMigrateBucketResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/MigrateBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetBucketReadQuotaAutoTopup proto.InternalMessageInfo
+
+func (m *MsgSetBucketReadQuotaAutoTopup) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CompleteMigrateBucket(ctx context.Context, in *MsgCompleteMigrateBucket, opts ...grpc.CallOption) (*MsgCompleteMigrateBucketResponse, error) {
-	out := new(MsgCompleteMigrateBucketResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CompleteMigrateBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketReadQuotaAutoTopup) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelMigrateBucket(ctx context.Context, in *MsgCancelMigrateBucket, opts ...grpc.CallOption) (*MsgCancelMigrateBucketResponse, error) {
-	out := new(MsgCancelMigrateBucketResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CancelMigrateBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketReadQuotaAutoTopup) GetAutoTopup() ReadQuotaAutoTopup {
+	if m != nil {
+		return m.AutoTopup
 	}
-	return out, nil
+	return ReadQuotaAutoTopup{}
 }
 
-func (c *msgClient) RejectMigrateBucket(ctx context.Context, in *MsgRejectMigrateBucket, opts ...grpc.CallOption) (*MsgRejectMigrateBucketResponse, error) {
-	out := new(MsgRejectMigrateBucketResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/RejectMigrateBucket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetBucketReadQuotaAutoTopupResponse struct {
 }
 
-func (c *msgClient) SetTag(ctx context.Context, in *MsgSetTag, opts ...grpc.CallOption) (*MsgSetTagResponse, error) {
-	out := new(MsgSetTagResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SetTag", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) Reset() {
+	*m = MsgSetBucketReadQuotaAutoTopupResponse{}
+}
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketReadQuotaAutoTopupResponse) ProtoMessage()    {}
+func (*MsgSetBucketReadQuotaAutoTopupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{74}
+}
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketReadQuotaAutoTopupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketReadQuotaAutoTopupResponse.Merge(m, src)
+}
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketReadQuotaAutoTopupResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetBucketFlowRateLimit(ctx context.Context, in *MsgSetBucketFlowRateLimit, opts ...grpc.CallOption) (*MsgSetBucketFlowRateLimitResponse, error) {
-	out := new(MsgSetBucketFlowRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SetBucketFlowRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetBucketReadQuotaAutoTopupResponse proto.InternalMessageInfo
+
+type MsgReportReadQuotaConsumption struct {
+	// operator defines the operator account address of the primary SP of the bucket.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// consumed_read_quota defines the charged read quota consumed since the beginning of the current UTC month, in bytes
+	ConsumedReadQuota uint64 `protobuf:"varint,3,opt,name=consumed_read_quota,json=consumedReadQuota,proto3" json:"consumed_read_quota,omitempty"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// basic operation of bucket
-	CreateBucket(context.Context, *MsgCreateBucket) (*MsgCreateBucketResponse, error)
-	DeleteBucket(context.Context, *MsgDeleteBucket) (*MsgDeleteBucketResponse, error)
-	UpdateBucketInfo(context.Context, *MsgUpdateBucketInfo) (*MsgUpdateBucketInfoResponse, error)
-	DiscontinueBucket(context.Context, *MsgDiscontinueBucket) (*MsgDiscontinueBucketResponse, error)
-	ToggleSPAsDelegatedAgent(context.Context, *MsgToggleSPAsDelegatedAgent) (*MsgToggleSPAsDelegatedAgentResponse, error)
-	// basic operation of object
-	CreateObject(context.Context, *MsgCreateObject) (*MsgCreateObjectResponse, error)
-	SealObject(context.Context, *MsgSealObject) (*MsgSealObjectResponse, error)
-	SealObjectV2(context.Context, *MsgSealObjectV2) (*MsgSealObjectV2Response, error)
-	RejectSealObject(context.Context, *MsgRejectSealObject) (*MsgRejectSealObjectResponse, error)
-	CopyObject(context.Context, *MsgCopyObject) (*MsgCopyObjectResponse, error)
-	DeleteObject(context.Context, *MsgDeleteObject) (*MsgDeleteObjectResponse, error)
-	CancelCreateObject(context.Context, *MsgCancelCreateObject) (*MsgCancelCreateObjectResponse, error)
-	DiscontinueObject(context.Context, *MsgDiscontinueObject) (*MsgDiscontinueObjectResponse, error)
-	UpdateObjectInfo(context.Context, *MsgUpdateObjectInfo) (*MsgUpdateObjectInfoResponse, error)
-	UpdateObjectContent(context.Context, *MsgUpdateObjectContent) (*MsgUpdateObjectContentResponse, error)
-	CancelUpdateObjectContent(context.Context, *MsgCancelUpdateObjectContent) (*MsgCancelUpdateObjectContentResponse, error)
-	DelegateCreateObject(context.Context, *MsgDelegateCreateObject) (*MsgDelegateCreateObjectResponse, error)
-	DelegateUpdateObjectContent(context.Context, *MsgDelegateUpdateObjectContent) (*MsgDelegateUpdateObjectContentResponse, error)
-	// basic operation of group
-	CreateGroup(context.Context, *MsgCreateGroup) (*MsgCreateGroupResponse, error)
-	DeleteGroup(context.Context, *MsgDeleteGroup) (*MsgDeleteGroupResponse, error)
-	UpdateGroupMember(context.Context, *MsgUpdateGroupMember) (*MsgUpdateGroupMemberResponse, error)
-	UpdateGroupExtra(context.Context, *MsgUpdateGroupExtra) (*MsgUpdateGroupExtraResponse, error)
-	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
-	RenewGroupMember(context.Context, *MsgRenewGroupMember) (*MsgRenewGroupMemberResponse, error)
-	// basic operation of policy
-	PutPolicy(context.Context, *MsgPutPolicy) (*MsgPutPolicyResponse, error)
-	DeletePolicy(context.Context, *MsgDeletePolicy) (*MsgDeletePolicyResponse, error)
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	MigrateBucket(context.Context, *MsgMigrateBucket) (*MsgMigrateBucketResponse, error)
-	CompleteMigrateBucket(context.Context, *MsgCompleteMigrateBucket) (*MsgCompleteMigrateBucketResponse, error)
-	CancelMigrateBucket(context.Context, *MsgCancelMigrateBucket) (*MsgCancelMigrateBucketResponse, error)
-	RejectMigrateBucket(context.Context, *MsgRejectMigrateBucket) (*MsgRejectMigrateBucketResponse, error)
-	// Since: Manchurian upgrade
-	SetTag(context.Context, *MsgSetTag) (*MsgSetTagResponse, error)
-	SetBucketFlowRateLimit(context.Context, *MsgSetBucketFlowRateLimit) (*MsgSetBucketFlowRateLimitResponse, error)
+func (m *MsgReportReadQuotaConsumption) Reset()         { *m = MsgReportReadQuotaConsumption{} }
+func (m *MsgReportReadQuotaConsumption) String() string { return proto.CompactTextString(m) }
+func (*MsgReportReadQuotaConsumption) ProtoMessage()    {}
+func (*MsgReportReadQuotaConsumption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{75}
+}
+func (m *MsgReportReadQuotaConsumption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportReadQuotaConsumption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportReadQuotaConsumption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportReadQuotaConsumption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportReadQuotaConsumption.Merge(m, src)
+}
+func (m *MsgReportReadQuotaConsumption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportReadQuotaConsumption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportReadQuotaConsumption.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgReportReadQuotaConsumption proto.InternalMessageInfo
+
+func (m *MsgReportReadQuotaConsumption) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) CreateBucket(ctx context.Context, req *MsgCreateBucket) (*MsgCreateBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBucket not implemented")
+func (m *MsgReportReadQuotaConsumption) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) DeleteBucket(ctx context.Context, req *MsgDeleteBucket) (*MsgDeleteBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBucket not implemented")
+
+func (m *MsgReportReadQuotaConsumption) GetConsumedReadQuota() uint64 {
+	if m != nil {
+		return m.ConsumedReadQuota
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) UpdateBucketInfo(ctx context.Context, req *MsgUpdateBucketInfo) (*MsgUpdateBucketInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBucketInfo not implemented")
+
+type MsgReportReadQuotaConsumptionResponse struct {
 }
-func (*UnimplementedMsgServer) DiscontinueBucket(ctx context.Context, req *MsgDiscontinueBucket) (*MsgDiscontinueBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DiscontinueBucket not implemented")
+
+func (m *MsgReportReadQuotaConsumptionResponse) Reset()         { *m = MsgReportReadQuotaConsumptionResponse{} }
+func (m *MsgReportReadQuotaConsumptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportReadQuotaConsumptionResponse) ProtoMessage()    {}
+func (*MsgReportReadQuotaConsumptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{76}
 }
-func (*UnimplementedMsgServer) ToggleSPAsDelegatedAgent(ctx context.Context, req *MsgToggleSPAsDelegatedAgent) (*MsgToggleSPAsDelegatedAgentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ToggleSPAsDelegatedAgent not implemented")
+func (m *MsgReportReadQuotaConsumptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateObject(ctx context.Context, req *MsgCreateObject) (*MsgCreateObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateObject not implemented")
+func (m *MsgReportReadQuotaConsumptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportReadQuotaConsumptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SealObject(ctx context.Context, req *MsgSealObject) (*MsgSealObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SealObject not implemented")
+func (m *MsgReportReadQuotaConsumptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportReadQuotaConsumptionResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SealObjectV2(ctx context.Context, req *MsgSealObjectV2) (*MsgSealObjectV2Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SealObjectV2 not implemented")
+func (m *MsgReportReadQuotaConsumptionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RejectSealObject(ctx context.Context, req *MsgRejectSealObject) (*MsgRejectSealObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectSealObject not implemented")
+func (m *MsgReportReadQuotaConsumptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportReadQuotaConsumptionResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CopyObject(ctx context.Context, req *MsgCopyObject) (*MsgCopyObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CopyObject not implemented")
+
+var xxx_messageInfo_MsgReportReadQuotaConsumptionResponse proto.InternalMessageInfo
+
+type MsgSetBucketCallback struct {
+	// operator defines the account address of the bucket owner.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// callback defines the contract notified about the objects of the bucket, an empty callback_address removes the callback
+	Callback BucketCallback `protobuf:"bytes,3,opt,name=callback,proto3" json:"callback"`
 }
-func (*UnimplementedMsgServer) DeleteObject(ctx context.Context, req *MsgDeleteObject) (*MsgDeleteObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteObject not implemented")
+
+func (m *MsgSetBucketCallback) Reset()         { *m = MsgSetBucketCallback{} }
+func (m *MsgSetBucketCallback) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketCallback) ProtoMessage()    {}
+func (*MsgSetBucketCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{77}
 }
-func (*UnimplementedMsgServer) CancelCreateObject(ctx context.Context, req *MsgCancelCreateObject) (*MsgCancelCreateObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelCreateObject not implemented")
+func (m *MsgSetBucketCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DiscontinueObject(ctx context.Context, req *MsgDiscontinueObject) (*MsgDiscontinueObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DiscontinueObject not implemented")
+func (m *MsgSetBucketCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateObjectInfo(ctx context.Context, req *MsgUpdateObjectInfo) (*MsgUpdateObjectInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateObjectInfo not implemented")
+func (m *MsgSetBucketCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketCallback.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateObjectContent(ctx context.Context, req *MsgUpdateObjectContent) (*MsgUpdateObjectContentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateObjectContent not implemented")
+func (m *MsgSetBucketCallback) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CancelUpdateObjectContent(ctx context.Context, req *MsgCancelUpdateObjectContent) (*MsgCancelUpdateObjectContentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelUpdateObjectContent not implemented")
+func (m *MsgSetBucketCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketCallback.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DelegateCreateObject(ctx context.Context, req *MsgDelegateCreateObject) (*MsgDelegateCreateObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateCreateObject not implemented")
+
+var xxx_messageInfo_MsgSetBucketCallback proto.InternalMessageInfo
+
+func (m *MsgSetBucketCallback) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) DelegateUpdateObjectContent(ctx context.Context, req *MsgDelegateUpdateObjectContent) (*MsgDelegateUpdateObjectContentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateUpdateObjectContent not implemented")
+
+func (m *MsgSetBucketCallback) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) CreateGroup(ctx context.Context, req *MsgCreateGroup) (*MsgCreateGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+
+func (m *MsgSetBucketCallback) GetCallback() BucketCallback {
+	if m != nil {
+		return m.Callback
+	}
+	return BucketCallback{}
 }
-func (*UnimplementedMsgServer) DeleteGroup(ctx context.Context, req *MsgDeleteGroup) (*MsgDeleteGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+
+type MsgSetBucketCallbackResponse struct {
 }
-func (*UnimplementedMsgServer) UpdateGroupMember(ctx context.Context, req *MsgUpdateGroupMember) (*MsgUpdateGroupMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupMember not implemented")
+
+func (m *MsgSetBucketCallbackResponse) Reset()         { *m = MsgSetBucketCallbackResponse{} }
+func (m *MsgSetBucketCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBucketCallbackResponse) ProtoMessage()    {}
+func (*MsgSetBucketCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{78}
 }
-func (*UnimplementedMsgServer) UpdateGroupExtra(ctx context.Context, req *MsgUpdateGroupExtra) (*MsgUpdateGroupExtraResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupExtra not implemented")
+func (m *MsgSetBucketCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+func (m *MsgSetBucketCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBucketCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RenewGroupMember(ctx context.Context, req *MsgRenewGroupMember) (*MsgRenewGroupMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewGroupMember not implemented")
+func (m *MsgSetBucketCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBucketCallbackResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) PutPolicy(ctx context.Context, req *MsgPutPolicy) (*MsgPutPolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PutPolicy not implemented")
+func (m *MsgSetBucketCallbackResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DeletePolicy(ctx context.Context, req *MsgDeletePolicy) (*MsgDeletePolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+func (m *MsgSetBucketCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBucketCallbackResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+
+var xxx_messageInfo_MsgSetBucketCallbackResponse proto.InternalMessageInfo
+
+type MsgTransferBucketOwnership struct {
+	// operator defines the account address of the bucket owner.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// new_owner defines the account address the bucket is transferred to once it accepts the transfer, an empty
+	// new_owner cancels the pending transfer
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
-func (*UnimplementedMsgServer) MigrateBucket(ctx context.Context, req *MsgMigrateBucket) (*MsgMigrateBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MigrateBucket not implemented")
+
+func (m *MsgTransferBucketOwnership) Reset()         { *m = MsgTransferBucketOwnership{} }
+func (m *MsgTransferBucketOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferBucketOwnership) ProtoMessage()    {}
+func (*MsgTransferBucketOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{79}
 }
-func (*UnimplementedMsgServer) CompleteMigrateBucket(ctx context.Context, req *MsgCompleteMigrateBucket) (*MsgCompleteMigrateBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteMigrateBucket not implemented")
+func (m *MsgTransferBucketOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CancelMigrateBucket(ctx context.Context, req *MsgCancelMigrateBucket) (*MsgCancelMigrateBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelMigrateBucket not implemented")
+func (m *MsgTransferBucketOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferBucketOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RejectMigrateBucket(ctx context.Context, req *MsgRejectMigrateBucket) (*MsgRejectMigrateBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectMigrateBucket not implemented")
+func (m *MsgTransferBucketOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferBucketOwnership.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetTag(ctx context.Context, req *MsgSetTag) (*MsgSetTagResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetTag not implemented")
+func (m *MsgTransferBucketOwnership) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetBucketFlowRateLimit(ctx context.Context, req *MsgSetBucketFlowRateLimit) (*MsgSetBucketFlowRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBucketFlowRateLimit not implemented")
+func (m *MsgTransferBucketOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferBucketOwnership.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgTransferBucketOwnership proto.InternalMessageInfo
 
-func _Msg_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBucket)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/CreateBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBucket(ctx, req.(*MsgCreateBucket))
+func (m *MsgTransferBucketOwnership) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteBucket)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgTransferBucketOwnership) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteBucket(ctx, in)
+	return ""
+}
+
+func (m *MsgTransferBucketOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/DeleteBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteBucket(ctx, req.(*MsgDeleteBucket))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateBucketInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateBucketInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateBucketInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/UpdateBucketInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateBucketInfo(ctx, req.(*MsgUpdateBucketInfo))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgTransferBucketOwnershipResponse struct {
 }
 
-func _Msg_DiscontinueBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDiscontinueBucket)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DiscontinueBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/DiscontinueBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DiscontinueBucket(ctx, req.(*MsgDiscontinueBucket))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgTransferBucketOwnershipResponse) Reset()         { *m = MsgTransferBucketOwnershipResponse{} }
+func (m *MsgTransferBucketOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferBucketOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferBucketOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{80}
 }
-
-func _Msg_ToggleSPAsDelegatedAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgToggleSPAsDelegatedAgent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ToggleSPAsDelegatedAgent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/ToggleSPAsDelegatedAgent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ToggleSPAsDelegatedAgent(ctx, req.(*MsgToggleSPAsDelegatedAgent))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgTransferBucketOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_CreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/CreateObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateObject(ctx, req.(*MsgCreateObject))
+func (m *MsgTransferBucketOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferBucketOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_SealObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSealObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SealObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/SealObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SealObject(ctx, req.(*MsgSealObject))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgTransferBucketOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferBucketOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferBucketOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferBucketOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferBucketOwnershipResponse.DiscardUnknown(m)
 }
 
-func _Msg_SealObjectV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSealObjectV2)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SealObjectV2(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/SealObjectV2",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SealObjectV2(ctx, req.(*MsgSealObjectV2))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgTransferBucketOwnershipResponse proto.InternalMessageInfo
+
+type MsgAcceptBucketOwnership struct {
+	// operator defines the account address of the new owner of the bucket.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// payment_address defines the payment account the bucket is charged from after the transfer, the new owner
+	// itself if empty
+	PaymentAddress string `protobuf:"bytes,3,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
 }
 
-func _Msg_RejectSealObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectSealObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectSealObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/RejectSealObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectSealObject(ctx, req.(*MsgRejectSealObject))
+func (m *MsgAcceptBucketOwnership) Reset()         { *m = MsgAcceptBucketOwnership{} }
+func (m *MsgAcceptBucketOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptBucketOwnership) ProtoMessage()    {}
+func (*MsgAcceptBucketOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{81}
+}
+func (m *MsgAcceptBucketOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptBucketOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptBucketOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAcceptBucketOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptBucketOwnership.Merge(m, src)
+}
+func (m *MsgAcceptBucketOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptBucketOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptBucketOwnership.DiscardUnknown(m)
 }
 
-func _Msg_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCopyObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CopyObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/CopyObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CopyObject(ctx, req.(*MsgCopyObject))
+var xxx_messageInfo_MsgAcceptBucketOwnership proto.InternalMessageInfo
+
+func (m *MsgAcceptBucketOwnership) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/DeleteObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteObject(ctx, req.(*MsgDeleteObject))
+func (m *MsgAcceptBucketOwnership) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelCreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelCreateObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelCreateObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/CancelCreateObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelCreateObject(ctx, req.(*MsgCancelCreateObject))
+func (m *MsgAcceptBucketOwnership) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DiscontinueObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDiscontinueObject)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DiscontinueObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/DiscontinueObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DiscontinueObject(ctx, req.(*MsgDiscontinueObject))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgAcceptBucketOwnershipResponse struct {
 }
 
-func _Msg_UpdateObjectInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateObjectInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateObjectInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/UpdateObjectInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateObjectInfo(ctx, req.(*MsgUpdateObjectInfo))
+func (m *MsgAcceptBucketOwnershipResponse) Reset()         { *m = MsgAcceptBucketOwnershipResponse{} }
+func (m *MsgAcceptBucketOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptBucketOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptBucketOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb66990cac836d3, []int{82}
+}
+func (m *MsgAcceptBucketOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptBucketOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptBucketOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAcceptBucketOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptBucketOwnershipResponse.Merge(m, src)
+}
+func (m *MsgAcceptBucketOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptBucketOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptBucketOwnershipResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateObjectContent)
-	if err := dec(in); err != nil {
+var xxx_messageInfo_MsgAcceptBucketOwnershipResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateBucket)(nil), "moca.storage.MsgCreateBucket")
+	proto.RegisterType((*MsgCreateBucketResponse)(nil), "moca.storage.MsgCreateBucketResponse")
+	proto.RegisterType((*MsgDeleteBucket)(nil), "moca.storage.MsgDeleteBucket")
+	proto.RegisterType((*MsgDeleteBucketResponse)(nil), "moca.storage.MsgDeleteBucketResponse")
+	proto.RegisterType((*MsgDiscontinueBucket)(nil), "moca.storage.MsgDiscontinueBucket")
+	proto.RegisterType((*MsgDiscontinueBucketResponse)(nil), "moca.storage.MsgDiscontinueBucketResponse")
+	proto.RegisterType((*MsgCreateObject)(nil), "moca.storage.MsgCreateObject")
+	proto.RegisterType((*MsgCreateObjectResponse)(nil), "moca.storage.MsgCreateObjectResponse")
+	proto.RegisterType((*MsgSealObject)(nil), "moca.storage.MsgSealObject")
+	proto.RegisterType((*MsgSealObjectResponse)(nil), "moca.storage.MsgSealObjectResponse")
+	proto.RegisterType((*MsgSealObjectV2)(nil), "moca.storage.MsgSealObjectV2")
+	proto.RegisterType((*MsgSealObjectV2Response)(nil), "moca.storage.MsgSealObjectV2Response")
+	proto.RegisterType((*MsgRejectSealObject)(nil), "moca.storage.MsgRejectSealObject")
+	proto.RegisterType((*MsgRejectSealObjectResponse)(nil), "moca.storage.MsgRejectSealObjectResponse")
+	proto.RegisterType((*MsgCopyObject)(nil), "moca.storage.MsgCopyObject")
+	proto.RegisterType((*MsgCopyObjectResponse)(nil), "moca.storage.MsgCopyObjectResponse")
+	proto.RegisterType((*MsgDeleteObject)(nil), "moca.storage.MsgDeleteObject")
+	proto.RegisterType((*MsgDeleteObjectResponse)(nil), "moca.storage.MsgDeleteObjectResponse")
+	proto.RegisterType((*MsgDiscontinueObject)(nil), "moca.storage.MsgDiscontinueObject")
+	proto.RegisterType((*MsgDiscontinueObjectResponse)(nil), "moca.storage.MsgDiscontinueObjectResponse")
+	proto.RegisterType((*MsgCreateGroup)(nil), "moca.storage.MsgCreateGroup")
+	proto.RegisterType((*MsgCreateGroupResponse)(nil), "moca.storage.MsgCreateGroupResponse")
+	proto.RegisterType((*MsgDeleteGroup)(nil), "moca.storage.MsgDeleteGroup")
+	proto.RegisterType((*MsgDeleteGroupResponse)(nil), "moca.storage.MsgDeleteGroupResponse")
+	proto.RegisterType((*MsgUpdateGroupMember)(nil), "moca.storage.MsgUpdateGroupMember")
+	proto.RegisterType((*MsgUpdateGroupMemberResponse)(nil), "moca.storage.MsgUpdateGroupMemberResponse")
+	proto.RegisterType((*MsgRenewGroupMember)(nil), "moca.storage.MsgRenewGroupMember")
+	proto.RegisterType((*MsgRenewGroupMemberResponse)(nil), "moca.storage.MsgRenewGroupMemberResponse")
+	proto.RegisterType((*MsgGroupMember)(nil), "moca.storage.MsgGroupMember")
+	proto.RegisterType((*MsgUpdateGroupExtra)(nil), "moca.storage.MsgUpdateGroupExtra")
+	proto.RegisterType((*MsgUpdateGroupExtraResponse)(nil), "moca.storage.MsgUpdateGroupExtraResponse")
+	proto.RegisterType((*MsgLeaveGroup)(nil), "moca.storage.MsgLeaveGroup")
+	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "moca.storage.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgUpdateBucketInfo)(nil), "moca.storage.MsgUpdateBucketInfo")
+	proto.RegisterType((*MsgUpdateBucketInfoResponse)(nil), "moca.storage.MsgUpdateBucketInfoResponse")
+	proto.RegisterType((*MsgCancelCreateObject)(nil), "moca.storage.MsgCancelCreateObject")
+	proto.RegisterType((*MsgCancelCreateObjectResponse)(nil), "moca.storage.MsgCancelCreateObjectResponse")
+	proto.RegisterType((*MsgPutPolicy)(nil), "moca.storage.MsgPutPolicy")
+	proto.RegisterType((*MsgPutPolicyResponse)(nil), "moca.storage.MsgPutPolicyResponse")
+	proto.RegisterType((*MsgDeletePolicy)(nil), "moca.storage.MsgDeletePolicy")
+	proto.RegisterType((*MsgDeletePolicyResponse)(nil), "moca.storage.MsgDeletePolicyResponse")
+	proto.RegisterType((*MsgUpdateObjectInfoResponse)(nil), "moca.storage.MsgUpdateObjectInfoResponse")
+	proto.RegisterType((*MsgUpdateObjectInfo)(nil), "moca.storage.MsgUpdateObjectInfo")
+	proto.RegisterType((*MsgUpdateParams)(nil), "moca.storage.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "moca.storage.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgMigrateBucket)(nil), "moca.storage.MsgMigrateBucket")
+	proto.RegisterType((*MsgMigrateBucketResponse)(nil), "moca.storage.MsgMigrateBucketResponse")
+	proto.RegisterType((*MsgCompleteMigrateBucket)(nil), "moca.storage.MsgCompleteMigrateBucket")
+	proto.RegisterType((*MsgCompleteMigrateBucketResponse)(nil), "moca.storage.MsgCompleteMigrateBucketResponse")
+	proto.RegisterType((*MsgCancelMigrateBucket)(nil), "moca.storage.MsgCancelMigrateBucket")
+	proto.RegisterType((*MsgCancelMigrateBucketResponse)(nil), "moca.storage.MsgCancelMigrateBucketResponse")
+	proto.RegisterType((*MsgRejectMigrateBucket)(nil), "moca.storage.MsgRejectMigrateBucket")
+	proto.RegisterType((*MsgRejectMigrateBucketResponse)(nil), "moca.storage.MsgRejectMigrateBucketResponse")
+	proto.RegisterType((*MsgSetTag)(nil), "moca.storage.MsgSetTag")
+	proto.RegisterType((*MsgSetTagResponse)(nil), "moca.storage.MsgSetTagResponse")
+	proto.RegisterType((*MsgUpdateObjectContent)(nil), "moca.storage.MsgUpdateObjectContent")
+	proto.RegisterType((*MsgUpdateObjectContentResponse)(nil), "moca.storage.MsgUpdateObjectContentResponse")
+	proto.RegisterType((*MsgCancelUpdateObjectContent)(nil), "moca.storage.MsgCancelUpdateObjectContent")
+	proto.RegisterType((*MsgCancelUpdateObjectContentResponse)(nil), "moca.storage.MsgCancelUpdateObjectContentResponse")
+	proto.RegisterType((*MsgDelegateCreateObject)(nil), "moca.storage.MsgDelegateCreateObject")
+	proto.RegisterType((*MsgDelegateCreateObjectResponse)(nil), "moca.storage.MsgDelegateCreateObjectResponse")
+	proto.RegisterType((*MsgDelegateUpdateObjectContent)(nil), "moca.storage.MsgDelegateUpdateObjectContent")
+	proto.RegisterType((*MsgDelegateUpdateObjectContentResponse)(nil), "moca.storage.MsgDelegateUpdateObjectContentResponse")
+	proto.RegisterType((*MsgToggleSPAsDelegatedAgent)(nil), "moca.storage.MsgToggleSPAsDelegatedAgent")
+	proto.RegisterType((*MsgToggleSPAsDelegatedAgentResponse)(nil), "moca.storage.MsgToggleSPAsDelegatedAgentResponse")
+	proto.RegisterType((*MsgSetBucketFlowRateLimit)(nil), "moca.storage.MsgSetBucketFlowRateLimit")
+	proto.RegisterType((*MsgSetBucketFlowRateLimitResponse)(nil), "moca.storage.MsgSetBucketFlowRateLimitResponse")
+	proto.RegisterType((*MsgSetBucketLifecycle)(nil), "moca.storage.MsgSetBucketLifecycle")
+	proto.RegisterType((*MsgSetBucketLifecycleResponse)(nil), "moca.storage.MsgSetBucketLifecycleResponse")
+	proto.RegisterType((*MsgSetBucketVersioning)(nil), "moca.storage.MsgSetBucketVersioning")
+	proto.RegisterType((*MsgSetBucketVersioningResponse)(nil), "moca.storage.MsgSetBucketVersioningResponse")
+	proto.RegisterType((*MsgRestoreObjectVersion)(nil), "moca.storage.MsgRestoreObjectVersion")
+	proto.RegisterType((*MsgRestoreObjectVersionResponse)(nil), "moca.storage.MsgRestoreObjectVersionResponse")
+	proto.RegisterType((*MsgSetBucketReadQuotaAutoTopup)(nil), "moca.storage.MsgSetBucketReadQuotaAutoTopup")
+	proto.RegisterType((*MsgSetBucketReadQuotaAutoTopupResponse)(nil), "moca.storage.MsgSetBucketReadQuotaAutoTopupResponse")
+	proto.RegisterType((*MsgReportReadQuotaConsumption)(nil), "moca.storage.MsgReportReadQuotaConsumption")
+	proto.RegisterType((*MsgReportReadQuotaConsumptionResponse)(nil), "moca.storage.MsgReportReadQuotaConsumptionResponse")
+	proto.RegisterType((*MsgSetBucketCallback)(nil), "moca.storage.MsgSetBucketCallback")
+	proto.RegisterType((*MsgSetBucketCallbackResponse)(nil), "moca.storage.MsgSetBucketCallbackResponse")
+	proto.RegisterType((*MsgTransferBucketOwnership)(nil), "moca.storage.MsgTransferBucketOwnership")
+	proto.RegisterType((*MsgTransferBucketOwnershipResponse)(nil), "moca.storage.MsgTransferBucketOwnershipResponse")
+	proto.RegisterType((*MsgAcceptBucketOwnership)(nil), "moca.storage.MsgAcceptBucketOwnership")
+	proto.RegisterType((*MsgAcceptBucketOwnershipResponse)(nil), "moca.storage.MsgAcceptBucketOwnershipResponse")
+}
+
+func init() { proto.RegisterFile("moca/storage/tx.proto", fileDescriptor_dcb66990cac836d3) }
+
+var fileDescriptor_dcb66990cac836d3 = []byte{
+	// 3378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5c, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0xf7, 0x7e, 0xc8, 0x92, 0x9e, 0x64, 0xcb, 0x5a, 0xcb, 0xd6, 0x9a, 0xb6, 0x3e, 0x4c, 0x4b,
+	0x8e, 0x24, 0xcb, 0x2b, 0x5b, 0x71, 0x9c, 0x64, 0x9d, 0x34, 0x90, 0x94, 0xd8, 0x50, 0x6b, 0x25,
+	0x0e, 0x25, 0xab, 0x41, 0x2f, 0x1b, 0x8a, 0x3b, 0xa2, 0x59, 0xef, 0x92, 0x2c, 0xc9, 0x95, 0xad,
+	0x14, 0x28, 0x9a, 0xa2, 0x87, 0xa0, 0xa7, 0x00, 0x45, 0x7b, 0xe8, 0x5f, 0x90, 0x1c, 0x0a, 0xa4,
+	0x45, 0x7a, 0xe8, 0xc7, 0xad, 0x87, 0xa6, 0xe8, 0xa1, 0x41, 0xd0, 0x02, 0x45, 0x0f, 0x69, 0x11,
+	0xb7, 0x35, 0x8a, 0x1e, 0x8a, 0x1e, 0x7a, 0x6c, 0x51, 0x90, 0x33, 0x9c, 0x1d, 0x72, 0x86, 0x5c,
+	0xae, 0xe3, 0xb5, 0x0d, 0xf4, 0x62, 0x8b, 0xf3, 0x7e, 0xf3, 0x66, 0xde, 0xe7, 0x3c, 0xbe, 0xa1,
+	0x04, 0xc7, 0x9a, 0x96, 0xa6, 0x2e, 0xb9, 0x9e, 0xe5, 0xa8, 0x3a, 0x5a, 0xf2, 0xee, 0x56, 0x6c,
+	0xc7, 0xf2, 0xac, 0xd2, 0xb0, 0x3f, 0x5c, 0x21, 0xc3, 0xd2, 0xa8, 0xda, 0x34, 0x4c, 0x6b, 0x29,
+	0xf8, 0x17, 0x03, 0xa4, 0x71, 0xcd, 0x72, 0x9b, 0x96, 0xbb, 0xd4, 0x74, 0xf5, 0xa5, 0xbd, 0x8b,
+	0xfe, 0x7f, 0x84, 0x70, 0x02, 0x13, 0x6a, 0xc1, 0xd3, 0x12, 0x7e, 0x20, 0xa4, 0x31, 0xdd, 0xd2,
+	0x2d, 0x3c, 0xee, 0xff, 0x44, 0x46, 0xa7, 0x74, 0xcb, 0xd2, 0x1b, 0x68, 0x29, 0x78, 0xda, 0x69,
+	0xed, 0x2e, 0x79, 0x46, 0x13, 0xb9, 0x9e, 0xda, 0xb4, 0x09, 0x40, 0x0a, 0xb6, 0xa8, 0x59, 0xcd,
+	0xa6, 0x65, 0x2e, 0xa9, 0xb6, 0xed, 0x58, 0x7b, 0x6a, 0x23, 0x5c, 0x8d, 0xa5, 0xdd, 0x71, 0x54,
+	0xdb, 0x46, 0x0e, 0x21, 0x9d, 0x0a, 0x48, 0x36, 0x72, 0x9a, 0x86, 0xeb, 0x1a, 0x96, 0x49, 0x50,
+	0x91, 0x89, 0xa1, 0xdc, 0x29, 0x24, 0x5b, 0x75, 0xd4, 0x66, 0x28, 0x41, 0x39, 0x42, 0xf2, 0xf6,
+	0x6d, 0x14, 0x52, 0x26, 0x03, 0xca, 0x9e, 0xe1, 0x78, 0x2d, 0xb5, 0xa1, 0x3b, 0x56, 0xcb, 0x8e,
+	0x30, 0x95, 0xdf, 0x2f, 0xc2, 0xc8, 0x86, 0xab, 0xaf, 0x39, 0x48, 0xf5, 0xd0, 0x6a, 0x4b, 0xbb,
+	0x8d, 0xbc, 0xd2, 0x32, 0xf4, 0x6b, 0xfe, 0xb3, 0xe5, 0x94, 0x73, 0xd3, 0xb9, 0xb9, 0xc1, 0xd5,
+	0xf2, 0x27, 0x1f, 0x9e, 0x1f, 0x23, 0x2a, 0x5b, 0xa9, 0xd7, 0x1d, 0xe4, 0xba, 0x9b, 0x9e, 0x63,
+	0x98, 0xba, 0x12, 0x02, 0x4b, 0x53, 0x30, 0xb4, 0x13, 0xcc, 0xae, 0x99, 0x6a, 0x13, 0x95, 0xf3,
+	0xfe, 0x3c, 0x05, 0xf0, 0xd0, 0xab, 0x6a, 0x13, 0x95, 0x5e, 0x00, 0xd8, 0x33, 0x5c, 0x63, 0xc7,
+	0x68, 0x18, 0xde, 0x7e, 0xb9, 0x30, 0x9d, 0x9b, 0x3b, 0xbc, 0x7c, 0xaa, 0xc2, 0x9a, 0xb3, 0xb2,
+	0x4d, 0xe9, 0x5b, 0xfb, 0x36, 0x52, 0x18, 0x7c, 0x69, 0x05, 0x46, 0x6c, 0x75, 0xbf, 0x89, 0x4c,
+	0xaf, 0xa6, 0xe2, 0x0d, 0x94, 0x8b, 0x1d, 0xb6, 0x76, 0x98, 0x4c, 0x20, 0xa3, 0xa5, 0xab, 0x50,
+	0xb2, 0x1d, 0xa3, 0xa9, 0x3a, 0xfb, 0x35, 0xd7, 0xa6, 0x5c, 0xfa, 0x3a, 0x70, 0x39, 0x42, 0xe6,
+	0x6c, 0xda, 0x21, 0x9f, 0x57, 0xe0, 0x28, 0xcb, 0x87, 0xd8, 0xbd, 0x7c, 0x70, 0x3a, 0x37, 0x37,
+	0xb4, 0x7c, 0x0c, 0x4b, 0x44, 0x54, 0xbc, 0x42, 0x88, 0xca, 0x68, 0x9b, 0x0b, 0x19, 0x2a, 0x2d,
+	0x42, 0x49, 0xbb, 0xa5, 0x3a, 0x3a, 0xaa, 0xd7, 0x1c, 0xa4, 0xd6, 0x6b, 0x5f, 0x6b, 0x59, 0x9e,
+	0x5a, 0xee, 0x9f, 0xce, 0xcd, 0x15, 0x95, 0x23, 0x84, 0xa2, 0x20, 0xb5, 0xfe, 0xba, 0x3f, 0x5e,
+	0x52, 0xe0, 0xa8, 0xbe, 0xa7, 0xd7, 0x76, 0xd5, 0xa6, 0xd1, 0xd8, 0xaf, 0xb9, 0x9e, 0xa3, 0x7a,
+	0x48, 0xdf, 0x2f, 0x0f, 0x04, 0x6a, 0x94, 0xf1, 0xa2, 0xac, 0x91, 0x2b, 0x37, 0x0c, 0xed, 0xf6,
+	0xf6, 0xb5, 0xab, 0x9b, 0x04, 0xa9, 0x8c, 0xea, 0x7b, 0xfa, 0xd5, 0x60, 0x76, 0x38, 0x54, 0x5d,
+	0xfa, 0xd6, 0xfd, 0x0f, 0x16, 0x42, 0x03, 0x7e, 0xe7, 0xfe, 0x07, 0x0b, 0xd8, 0x57, 0xee, 0x52,
+	0x3f, 0x8a, 0xf9, 0x85, 0xfc, 0x06, 0x8c, 0xc7, 0x86, 0x14, 0xe4, 0xda, 0x96, 0xe9, 0xa2, 0xd2,
+	0x8b, 0x30, 0x48, 0xcc, 0x6f, 0xd4, 0x89, 0xd3, 0x4c, 0x7f, 0xf4, 0xe9, 0xd4, 0x81, 0x3f, 0x7e,
+	0x3a, 0x55, 0xbc, 0x69, 0x98, 0xde, 0x27, 0x1f, 0x9e, 0x1f, 0x22, 0xfa, 0xf5, 0x1f, 0xdf, 0xbb,
+	0xff, 0xc1, 0x42, 0x4e, 0x19, 0xc0, 0x53, 0xd6, 0xeb, 0xf2, 0x0f, 0x72, 0x81, 0x17, 0xbe, 0x8c,
+	0x1a, 0x88, 0x7a, 0xe1, 0x25, 0x18, 0xb0, 0x6c, 0xe4, 0x64, 0x72, 0x43, 0x8a, 0xec, 0xe8, 0x87,
+	0xd5, 0x0b, 0xbe, 0xd4, 0x14, 0x9f, 0x20, 0x36, 0xbb, 0x11, 0xf9, 0x04, 0x8c, 0xc7, 0x86, 0x42,
+	0xb1, 0xe5, 0x5f, 0xe4, 0x60, 0xcc, 0xa7, 0x19, 0xae, 0x66, 0x99, 0x9e, 0x61, 0xb6, 0x7a, 0xbb,
+	0xf9, 0xd2, 0x71, 0x38, 0xe8, 0x20, 0xd5, 0xb5, 0xcc, 0x20, 0x80, 0x06, 0x15, 0xf2, 0x54, 0x7d,
+	0x86, 0x13, 0xea, 0x8c, 0x40, 0xa8, 0xf8, 0x2e, 0xe5, 0x49, 0x38, 0x25, 0x1a, 0xa7, 0xe2, 0xfd,
+	0xbb, 0xc0, 0x24, 0x87, 0xd7, 0x76, 0xbe, 0x8a, 0xb4, 0x1e, 0x25, 0x87, 0x29, 0x18, 0xb2, 0x02,
+	0xf6, 0x18, 0x80, 0x85, 0x03, 0x3c, 0x14, 0x00, 0x4e, 0xc3, 0xb0, 0xad, 0xee, 0x37, 0x2c, 0xb5,
+	0x5e, 0x73, 0x8d, 0xb7, 0x50, 0x10, 0xfc, 0x45, 0x65, 0x88, 0x8c, 0x6d, 0x1a, 0x6f, 0xc5, 0x13,
+	0x4c, 0x5f, 0x97, 0x09, 0xe6, 0x34, 0x0c, 0xfb, 0x4a, 0xf0, 0x13, 0x8c, 0x9f, 0x3e, 0x83, 0x70,
+	0x1e, 0x54, 0x86, 0xc8, 0x98, 0x0f, 0x4f, 0x0a, 0xfc, 0xfe, 0x2e, 0x03, 0x7f, 0x1e, 0x8e, 0xa0,
+	0xbb, 0xb6, 0x2f, 0xab, 0x76, 0x0b, 0x69, 0xb7, 0xdd, 0x56, 0xd3, 0x2d, 0x0f, 0x4c, 0x17, 0xe6,
+	0x86, 0x95, 0x11, 0x3c, 0xbe, 0x16, 0x0e, 0x97, 0x5e, 0x81, 0x11, 0x07, 0xd5, 0x5b, 0x66, 0x5d,
+	0x35, 0xb5, 0x7d, 0xbc, 0xaf, 0x41, 0x91, 0x5c, 0x0a, 0x05, 0x05, 0x72, 0x1d, 0x76, 0x22, 0xcf,
+	0x5d, 0x04, 0x3a, 0xb6, 0x71, 0x24, 0xd0, 0xf1, 0x10, 0x1b, 0xe8, 0xc4, 0x52, 0xdd, 0x04, 0x3a,
+	0x9e, 0xb2, 0x5e, 0x97, 0x3f, 0xcc, 0xc3, 0xa1, 0x0d, 0x57, 0xdf, 0x44, 0x6a, 0x83, 0xf8, 0x53,
+	0x8f, 0x22, 0xa5, 0xa3, 0x47, 0x3d, 0x03, 0xe3, 0x7a, 0xc3, 0xda, 0x51, 0x1b, 0x35, 0x92, 0x37,
+	0x6b, 0x41, 0xe2, 0xf4, 0xc5, 0xf2, 0x9d, 0xeb, 0x90, 0x32, 0x86, 0xc9, 0xdb, 0x98, 0x7a, 0xcd,
+	0x27, 0xae, 0xd7, 0x4b, 0x2f, 0xc3, 0x94, 0x8b, 0x34, 0xcb, 0xac, 0x13, 0x37, 0xd8, 0x69, 0xb8,
+	0x35, 0x55, 0xd7, 0x6b, 0xae, 0xa1, 0x9b, 0xaa, 0xd7, 0x72, 0x10, 0x3e, 0x52, 0x86, 0x95, 0x93,
+	0x14, 0xb6, 0x69, 0xaf, 0x36, 0xdc, 0x15, 0x5d, 0xdf, 0xa4, 0x90, 0x6a, 0x85, 0x8b, 0xd7, 0x53,
+	0xbc, 0x49, 0xda, 0x4a, 0x92, 0xc7, 0xe1, 0x58, 0x64, 0x80, 0x46, 0xe8, 0xbd, 0x3c, 0x8c, 0x44,
+	0x28, 0xdb, 0xcb, 0xff, 0x97, 0x1a, 0x15, 0x46, 0xd5, 0x41, 0x61, 0x54, 0x65, 0x3b, 0x01, 0x58,
+	0x8d, 0x92, 0x13, 0x80, 0x1d, 0xa2, 0x06, 0xf8, 0x65, 0x0e, 0x8e, 0x6e, 0xb8, 0xba, 0x82, 0xfc,
+	0xf1, 0xc7, 0xef, 0xd6, 0xd5, 0x4b, 0x9c, 0x70, 0x32, 0x2f, 0x5c, 0x7c, 0xb7, 0xf2, 0x04, 0x9c,
+	0x14, 0x0c, 0x53, 0x21, 0x7f, 0x8d, 0xa3, 0x76, 0xcd, 0xb2, 0xf7, 0x89, 0x78, 0x52, 0x5c, 0x3c,
+	0x46, 0x88, 0xb3, 0x30, 0xe2, 0x3a, 0x5a, 0x8d, 0x17, 0xe4, 0x90, 0xeb, 0x68, 0xab, 0x6d, 0x59,
+	0xce, 0xc2, 0x48, 0xdd, 0xf5, 0x22, 0x38, 0x2c, 0xcf, 0xa1, 0xba, 0xeb, 0x45, 0x71, 0x3e, 0x3f,
+	0x56, 0xee, 0x22, 0xe5, 0xf7, 0x5a, 0xdb, 0xff, 0x08, 0x3f, 0x16, 0xd7, 0x47, 0xf9, 0x31, 0xb8,
+	0xeb, 0x30, 0xee, 0xe3, 0xba, 0x2e, 0xe2, 0xc6, 0xea, 0xae, 0x77, 0x23, 0x9e, 0xce, 0xb3, 0x85,
+	0x72, 0x5b, 0x73, 0xf2, 0x36, 0x1c, 0x8b, 0x0c, 0x3c, 0xac, 0xcc, 0xfa, 0x73, 0xb6, 0x84, 0x7a,
+	0xcc, 0x4e, 0xd8, 0x45, 0x8d, 0x45, 0xb4, 0xc2, 0xd6, 0x58, 0x31, 0xe7, 0xfb, 0x0f, 0x57, 0x63,
+	0xf5, 0x56, 0xba, 0x97, 0x00, 0xa8, 0x1d, 0xdc, 0x72, 0x61, 0xba, 0x90, 0xc9, 0x10, 0x83, 0xa1,
+	0x21, 0x5c, 0xa6, 0x48, 0x2b, 0x7e, 0xae, 0x22, 0x8d, 0xa8, 0x86, 0x2b, 0xd2, 0x62, 0xfa, 0x79,
+	0x3f, 0x07, 0x87, 0xe9, 0x69, 0x1d, 0x24, 0xd5, 0x07, 0xaa, 0xd1, 0x26, 0x00, 0x70, 0xba, 0x66,
+	0xd4, 0x32, 0x18, 0x8c, 0x04, 0x5a, 0x19, 0x83, 0x3e, 0x74, 0xd7, 0x73, 0x54, 0x62, 0x6d, 0xfc,
+	0x80, 0x9d, 0x9f, 0xad, 0x2c, 0x26, 0x92, 0x2a, 0x8b, 0x60, 0x63, 0xf2, 0x4d, 0x38, 0x1e, 0x1d,
+	0xa1, 0xde, 0x7f, 0x05, 0x06, 0xe8, 0x69, 0x91, 0xd5, 0xf9, 0xfb, 0x75, 0x7c, 0x84, 0xc8, 0xdf,
+	0xc7, 0x2a, 0xc0, 0xee, 0x83, 0x55, 0xf0, 0x60, 0xce, 0x91, 0xae, 0x04, 0x5c, 0x48, 0x45, 0x2c,
+	0x38, 0x91, 0xe4, 0xd7, 0x58, 0xde, 0x32, 0x1c, 0x8f, 0x8e, 0x50, 0xab, 0xdd, 0xcf, 0x07, 0x5e,
+	0x7d, 0xd3, 0xae, 0x87, 0xaa, 0xd8, 0x40, 0xcd, 0x1d, 0xe4, 0x3c, 0xe0, 0xc6, 0x9f, 0x87, 0x21,
+	0xbc, 0x71, 0xeb, 0x8e, 0x89, 0x9c, 0x72, 0xbe, 0xc3, 0x44, 0x2c, 0xe5, 0x6b, 0x3e, 0x36, 0x26,
+	0x73, 0x21, 0x6e, 0xf8, 0x55, 0x38, 0xdc, 0x0c, 0x76, 0xe6, 0xd6, 0x3c, 0xcb, 0x7f, 0x6d, 0x2e,
+	0x17, 0xa7, 0x0b, 0x73, 0x43, 0xf1, 0x12, 0x74, 0xc3, 0xd5, 0x19, 0x29, 0x94, 0x61, 0x32, 0x67,
+	0xcb, 0x5a, 0xa9, 0xfb, 0x47, 0xfc, 0x28, 0xc3, 0xa3, 0x1e, 0xa8, 0xa3, 0xdc, 0x37, 0x5d, 0x48,
+	0xdd, 0xe3, 0x08, 0x65, 0x81, 0xf5, 0x97, 0x2d, 0x7e, 0x38, 0x85, 0x92, 0xf8, 0xe1, 0xc6, 0xa9,
+	0x25, 0xbe, 0x9b, 0x27, 0x27, 0xb8, 0x89, 0xee, 0x3c, 0xc9, 0x86, 0xb8, 0x0c, 0xfd, 0x44, 0x23,
+	0x99, 0x2c, 0x10, 0x82, 0xb3, 0x56, 0x04, 0x51, 0xe9, 0x69, 0x45, 0x10, 0x1d, 0xa6, 0x4a, 0xfb,
+	0x11, 0x8e, 0x38, 0x56, 0x5f, 0x17, 0xe0, 0x20, 0x5e, 0xb2, 0xa3, 0xb6, 0x08, 0xae, 0xb4, 0x0e,
+	0x7e, 0x6d, 0x66, 0x38, 0xaa, 0x67, 0x58, 0x66, 0xcd, 0x6f, 0xaf, 0x05, 0xfa, 0x1a, 0x5a, 0x96,
+	0x2a, 0xb8, 0xf7, 0x56, 0x09, 0x7b, 0x6f, 0x95, 0xad, 0xb0, 0xf7, 0xb6, 0x5a, 0x7c, 0xf7, 0x4f,
+	0x53, 0x39, 0xe5, 0x70, 0x7b, 0xa2, 0x4f, 0xaa, 0xca, 0x09, 0xc1, 0xc8, 0x8a, 0xf4, 0x2f, 0x5c,
+	0xaa, 0x31, 0x9e, 0xf0, 0x8a, 0x9f, 0xc4, 0x9e, 0x38, 0x43, 0xd3, 0x54, 0x5b, 0x64, 0x53, 0x6d,
+	0x26, 0x33, 0xc6, 0x65, 0x23, 0x66, 0x8c, 0x0f, 0xb7, 0x0b, 0xbb, 0x5c, 0x50, 0xd8, 0x5d, 0x47,
+	0xea, 0x1e, 0x26, 0x3f, 0x80, 0x15, 0x7b, 0xa6, 0x88, 0xea, 0xa2, 0x2f, 0x32, 0x59, 0x26, 0xa1,
+	0xb0, 0x6a, 0xef, 0x9c, 0xbc, 0x23, 0xb5, 0x07, 0xa8, 0x90, 0xff, 0xc8, 0x33, 0x76, 0xc7, 0x75,
+	0xe5, 0xba, 0xb9, 0x6b, 0xf5, 0xaa, 0x7e, 0xb8, 0x2a, 0x6c, 0xec, 0x15, 0x02, 0xc7, 0x2e, 0x47,
+	0x2a, 0xcb, 0x9b, 0xeb, 0xa6, 0x77, 0xf9, 0xd2, 0xb6, 0xda, 0x68, 0x21, 0x41, 0xcb, 0xef, 0x21,
+	0xb4, 0x3c, 0x3f, 0x57, 0x4b, 0xa4, 0x1b, 0x8f, 0x6b, 0x6b, 0x35, 0xe2, 0x71, 0xed, 0x61, 0x6a,
+	0x8c, 0x5f, 0xe5, 0x70, 0xfd, 0xab, 0x9a, 0x1a, 0x6a, 0x44, 0x1a, 0x4b, 0x8f, 0xab, 0x58, 0xbd,
+	0xcc, 0x89, 0x39, 0x23, 0x28, 0x62, 0xb8, 0xfd, 0xca, 0x53, 0x30, 0x21, 0x24, 0x50, 0x51, 0x7f,
+	0x97, 0x87, 0xe1, 0x0d, 0x57, 0xbf, 0xd1, 0xf2, 0x6e, 0x58, 0x0d, 0x43, 0xdb, 0x7f, 0x40, 0x09,
+	0x9f, 0x83, 0x41, 0xdb, 0x31, 0x4c, 0xcd, 0xb0, 0xd5, 0x06, 0xcd, 0x8f, 0x81, 0x0d, 0xdb, 0x77,
+	0x08, 0x95, 0x1b, 0x21, 0x42, 0x69, 0x83, 0xfd, 0x97, 0x34, 0x07, 0xb9, 0x56, 0xcb, 0xd1, 0x42,
+	0xb9, 0xe9, 0x73, 0xa9, 0x0a, 0xe0, 0x7a, 0xaa, 0x87, 0x7c, 0x77, 0x09, 0x0f, 0x14, 0x9e, 0xed,
+	0x66, 0x08, 0x51, 0x18, 0x74, 0x69, 0x83, 0xcf, 0xdb, 0xfd, 0x1d, 0xf3, 0xf6, 0xc0, 0x47, 0x9f,
+	0x4e, 0xe5, 0x84, 0xb9, 0xfb, 0x3c, 0x67, 0x80, 0x93, 0xbc, 0x01, 0xa8, 0x16, 0xe5, 0x9b, 0x30,
+	0xc6, 0x3e, 0xb3, 0xef, 0x4f, 0x76, 0x30, 0x12, 0x36, 0x1c, 0x32, 0xbd, 0x3f, 0xe1, 0x29, 0xeb,
+	0x75, 0xf9, 0xb7, 0xec, 0xfb, 0xd3, 0x93, 0x67, 0xb0, 0x6e, 0xde, 0xa9, 0x88, 0xa2, 0xde, 0x80,
+	0xf1, 0xd8, 0xd0, 0xc3, 0xd2, 0x15, 0x1b, 0xe3, 0xd8, 0xe9, 0x23, 0x31, 0xfe, 0x36, 0x9b, 0x70,
+	0xdb, 0xf4, 0xc7, 0xd6, 0x98, 0x8a, 0xa6, 0xc1, 0x62, 0xef, 0xd2, 0x60, 0x5b, 0x56, 0xf9, 0x27,
+	0xd8, 0x9d, 0xf0, 0xf8, 0x8d, 0xe0, 0xae, 0xae, 0x74, 0x19, 0x06, 0xd5, 0x96, 0x77, 0xcb, 0x72,
+	0xfc, 0x6d, 0x74, 0x52, 0x40, 0x1b, 0x5a, 0x7a, 0x16, 0x0e, 0xe2, 0xdb, 0x3e, 0xe2, 0x4d, 0x63,
+	0xd1, 0xbd, 0x63, 0xee, 0xab, 0x83, 0xbe, 0x01, 0xb1, 0xa5, 0x08, 0xbc, 0x7a, 0xd1, 0xdf, 0x7a,
+	0x9b, 0x51, 0x82, 0xd3, 0xb0, 0x7b, 0x24, 0x2f, 0xe2, 0xec, 0x10, 0x35, 0xeb, 0xf7, 0xf2, 0x70,
+	0x64, 0xc3, 0xd5, 0x37, 0x0c, 0xdd, 0x51, 0x7b, 0x7c, 0x4b, 0x53, 0x9a, 0x87, 0xd1, 0x58, 0x8f,
+	0xc6, 0xa8, 0x07, 0x96, 0x3d, 0xa4, 0x1c, 0x66, 0xdb, 0x30, 0xeb, 0xf5, 0xb4, 0x76, 0x4e, 0xb1,
+	0xfb, 0x76, 0xce, 0x45, 0xce, 0xda, 0x53, 0xbc, 0xc6, 0x22, 0x2a, 0x90, 0x25, 0x28, 0xc7, 0xc7,
+	0xa8, 0xce, 0x7e, 0x98, 0x0f, 0x88, 0x6b, 0x56, 0xd3, 0xf6, 0xc3, 0xf0, 0x91, 0xe8, 0x6e, 0x15,
+	0x26, 0x85, 0x7d, 0x58, 0x72, 0x79, 0x48, 0x15, 0x29, 0xf1, 0xed, 0x58, 0x7c, 0x43, 0xb8, 0x5e,
+	0x2f, 0x5d, 0x81, 0x61, 0xff, 0xbe, 0xb1, 0xa9, 0xda, 0xb6, 0x61, 0xea, 0xe1, 0x01, 0x51, 0x8e,
+	0x3a, 0xde, 0xb5, 0xed, 0x6b, 0x1b, 0x18, 0xa0, 0x0c, 0xe9, 0x7b, 0x3a, 0xf9, 0xd9, 0xad, 0x3e,
+	0xcf, 0xe9, 0xf0, 0x29, 0x51, 0x4b, 0x4c, 0xa0, 0x12, 0x59, 0x86, 0xe9, 0x24, 0x1a, 0xd5, 0xe9,
+	0x7b, 0x39, 0x38, 0x4e, 0x4f, 0xde, 0x47, 0xa1, 0xd1, 0xea, 0xb3, 0x9c, 0x40, 0xb3, 0x49, 0x25,
+	0x42, 0x54, 0x9c, 0x69, 0x98, 0x14, 0x53, 0xe2, 0xc2, 0xe0, 0xd6, 0xeb, 0x93, 0x23, 0x8c, 0x60,
+	0x3f, 0x44, 0x18, 0x01, 0x85, 0x0a, 0xf3, 0xd3, 0x1c, 0x0c, 0x06, 0x8d, 0x72, 0x6f, 0x4b, 0xd5,
+	0x1f, 0x70, 0xff, 0xec, 0x19, 0x98, 0x8f, 0x15, 0x2d, 0x15, 0x28, 0x7a, 0xaa, 0xee, 0x96, 0x0b,
+	0xec, 0xa1, 0xda, 0xbe, 0x04, 0xc3, 0xa8, 0x2d, 0x55, 0x77, 0x95, 0x00, 0x57, 0x5d, 0xe0, 0x44,
+	0x2d, 0x8b, 0x3a, 0xfd, 0xfe, 0x6e, 0xe5, 0xa3, 0x30, 0x4a, 0x1f, 0xa8, 0x40, 0x3f, 0xcb, 0xc3,
+	0x71, 0x9a, 0x0e, 0x71, 0x76, 0x5f, 0xc3, 0x37, 0x82, 0x8f, 0xed, 0x30, 0xcb, 0x70, 0x13, 0x1a,
+	0xbf, 0xcb, 0xec, 0xe3, 0xef, 0x32, 0xbb, 0xb8, 0x2e, 0xc9, 0xe4, 0x2f, 0x02, 0x0d, 0x11, 0x7f,
+	0x11, 0x50, 0xa8, 0x7a, 0x7f, 0x9f, 0x83, 0x53, 0x34, 0x3e, 0x9e, 0x20, 0x25, 0x57, 0x5f, 0xe4,
+	0x64, 0x3e, 0x97, 0x14, 0xf0, 0x22, 0xc9, 0xcf, 0xc2, 0x4c, 0x1a, 0x9d, 0xbd, 0x5f, 0x0f, 0x4b,
+	0x34, 0x5d, 0xf5, 0xd0, 0x43, 0x78, 0x1d, 0x62, 0x3a, 0xbf, 0xf9, 0x07, 0xbc, 0x9d, 0x2f, 0x74,
+	0x52, 0x57, 0xb1, 0xa3, 0x4f, 0xf6, 0x75, 0xf6, 0x49, 0xc1, 0xfd, 0x7a, 0xb4, 0x4c, 0xeb, 0xef,
+	0xf2, 0x02, 0xff, 0xd1, 0x5f, 0xab, 0x3f, 0xc7, 0x39, 0xc9, 0x59, 0x71, 0x45, 0x1e, 0xb7, 0xad,
+	0xfc, 0x26, 0x4c, 0x25, 0x90, 0x1e, 0xd6, 0x6d, 0xd0, 0x7f, 0xf3, 0x30, 0xc9, 0x2c, 0xf1, 0xf0,
+	0x62, 0x6b, 0x19, 0xfa, 0x5b, 0x01, 0xb3, 0x0c, 0x0e, 0x46, 0x80, 0x4f, 0x8c, 0x83, 0x89, 0x5c,
+	0xa4, 0x5f, 0x9c, 0xf4, 0x5e, 0xe2, 0x6c, 0x7b, 0x3e, 0xd9, 0xb6, 0xa2, 0x14, 0x30, 0x07, 0x67,
+	0xd3, 0x11, 0x34, 0x09, 0xfc, 0x38, 0x17, 0xbc, 0x4d, 0x6d, 0x59, 0xba, 0xde, 0x40, 0x9b, 0x37,
+	0x56, 0xdc, 0x70, 0x52, 0x7d, 0x45, 0xef, 0x5d, 0x0e, 0xac, 0xbe, 0xc0, 0x49, 0xb8, 0xc0, 0x4b,
+	0x98, 0xb4, 0x29, 0x79, 0x16, 0xce, 0xa4, 0x90, 0xa9, 0x6c, 0xef, 0x14, 0xe0, 0x04, 0x3e, 0x55,
+	0x71, 0xa5, 0x70, 0xb5, 0x61, 0xdd, 0x51, 0x54, 0x0f, 0x5d, 0x37, 0x9a, 0x46, 0xcf, 0xb2, 0xfb,
+	0x15, 0x18, 0x26, 0x00, 0xdc, 0x91, 0x2c, 0x74, 0x60, 0x4d, 0xd8, 0xe1, 0x96, 0xe4, 0x43, 0xe8,
+	0xba, 0xbd, 0x01, 0x23, 0xbb, 0x0d, 0xeb, 0x4e, 0xcd, 0x51, 0x3d, 0x54, 0x6b, 0xf8, 0x92, 0x92,
+	0xaf, 0x0c, 0x2f, 0x90, 0x00, 0x3e, 0x86, 0xd9, 0xb8, 0xf5, 0xdb, 0x15, 0xc3, 0x5a, 0x6a, 0xaa,
+	0xde, 0xad, 0xca, 0x7a, 0x10, 0xd1, 0x40, 0xf8, 0xaf, 0x87, 0x01, 0x7d, 0x68, 0x97, 0x55, 0x58,
+	0xb5, 0xca, 0xd9, 0x6c, 0x4e, 0x58, 0xcf, 0x08, 0x94, 0x2d, 0x9f, 0x81, 0xd3, 0x89, 0x44, 0x6a,
+	0xaf, 0xbf, 0xe4, 0xe0, 0x18, 0x8b, 0xba, 0x6e, 0xec, 0x22, 0x6d, 0x5f, 0x6b, 0xa0, 0x5e, 0xd9,
+	0xea, 0x05, 0xe8, 0x73, 0x5a, 0x0d, 0x84, 0xef, 0x59, 0x87, 0x96, 0x4f, 0x46, 0x13, 0x30, 0x5d,
+	0x5e, 0x69, 0x35, 0x10, 0xfb, 0x82, 0x8b, 0x27, 0x65, 0x6b, 0xdd, 0xf1, 0xc2, 0x90, 0xd6, 0x1d,
+	0x4f, 0xa0, 0x7a, 0xf8, 0x0d, 0xae, 0xca, 0x29, 0x62, 0x1b, 0x39, 0x7e, 0xd7, 0xc6, 0x30, 0xf5,
+	0x5e, 0x29, 0xe2, 0x34, 0x0c, 0x37, 0xd5, 0xbb, 0xb5, 0x3d, 0xbc, 0x90, 0x4b, 0x5e, 0xd1, 0x86,
+	0x9a, 0xea, 0x5d, 0xb2, 0x76, 0xc6, 0x42, 0x4c, 0xb0, 0x65, 0x52, 0x88, 0x09, 0x28, 0x54, 0xde,
+	0xbf, 0xe6, 0x82, 0x42, 0x44, 0x41, 0x3e, 0x27, 0x92, 0xa7, 0x08, 0xec, 0xb1, 0x15, 0xba, 0x65,
+	0xe8, 0x27, 0xda, 0x08, 0x22, 0xb0, 0xa0, 0x84, 0x8f, 0xd9, 0x0e, 0x5e, 0x91, 0x2c, 0xf2, 0x69,
+	0x98, 0x4a, 0x20, 0x51, 0x55, 0x7c, 0x3b, 0x1f, 0xd5, 0x16, 0x6d, 0xc8, 0xaf, 0xb4, 0x3c, 0x6b,
+	0xcb, 0xb2, 0x5b, 0x76, 0xaf, 0x34, 0xf2, 0x45, 0x00, 0xb5, 0xe5, 0x59, 0x35, 0xcf, 0x5f, 0x84,
+	0xbc, 0xe3, 0x4c, 0xc7, 0x2b, 0x92, 0xf8, 0x66, 0xd8, 0xa8, 0x18, 0x54, 0xc3, 0xd1, 0x6c, 0xe7,
+	0x57, 0x8a, 0x8c, 0xe4, 0xfc, 0x4a, 0x41, 0x50, 0x85, 0xfd, 0x2d, 0x17, 0x44, 0x93, 0x82, 0x6c,
+	0xcb, 0x69, 0xe3, 0xd6, 0x2c, 0xd3, 0x6d, 0x35, 0x6d, 0xaf, 0x87, 0x1e, 0x54, 0x81, 0xa3, 0x5a,
+	0xb0, 0x0a, 0x7f, 0xd3, 0x52, 0x54, 0x46, 0x43, 0x12, 0xdd, 0x51, 0xf5, 0x0b, 0x9c, 0x4e, 0x16,
+	0x45, 0x6e, 0x93, 0x24, 0x86, 0xfc, 0x14, 0xcc, 0xa6, 0x02, 0xa8, 0x46, 0xfe, 0x8e, 0xbf, 0x58,
+	0xa1, 0xca, 0x5b, 0x53, 0x1b, 0x8d, 0x1d, 0x55, 0xbb, 0xdd, 0x2b, 0x45, 0xac, 0xc1, 0x80, 0x46,
+	0x96, 0x20, 0x6e, 0x13, 0x2b, 0x64, 0xa3, 0xdb, 0x60, 0x5d, 0x86, 0x4e, 0xcc, 0x76, 0xbb, 0xce,
+	0x89, 0x44, 0x6e, 0xd7, 0xb9, 0xf1, 0xf6, 0x77, 0x0e, 0x39, 0x90, 0xfc, 0x4a, 0xc1, 0x51, 0x4d,
+	0x77, 0x17, 0x39, 0xab, 0xed, 0xa3, 0xd6, 0xbd, 0x65, 0xf4, 0x2c, 0x94, 0x9e, 0x81, 0x41, 0x13,
+	0xdd, 0xc9, 0x78, 0xfe, 0x0f, 0x98, 0xe8, 0x4e, 0xb0, 0xa3, 0xea, 0x15, 0x4e, 0x07, 0xf3, 0x82,
+	0x9a, 0x48, 0x2c, 0x8a, 0x3c, 0x03, 0x72, 0x32, 0x95, 0xea, 0xe3, 0x9f, 0xb9, 0xa0, 0x21, 0xb8,
+	0xa2, 0x69, 0xc8, 0xf6, 0x1e, 0x91, 0x36, 0x04, 0x35, 0x4d, 0xa1, 0xbb, 0x9a, 0x26, 0x5b, 0x4b,
+	0x4f, 0x28, 0x14, 0x69, 0xe9, 0x09, 0x69, 0xa1, 0x56, 0x96, 0xdf, 0x99, 0x86, 0xc2, 0x86, 0xab,
+	0x97, 0xb6, 0x60, 0x38, 0xf2, 0x9b, 0x28, 0x13, 0xdc, 0x27, 0x0e, 0x2c, 0x59, 0x9a, 0x4d, 0x25,
+	0xd3, 0x77, 0xa9, 0x2d, 0x18, 0x8e, 0xfc, 0x66, 0x01, 0xcf, 0x95, 0x25, 0x4b, 0xb3, 0xa9, 0x64,
+	0xca, 0xf5, 0x4d, 0x38, 0xc2, 0x5d, 0x29, 0x9f, 0xe6, 0xa6, 0xc6, 0x21, 0xd2, 0x7c, 0x47, 0x08,
+	0x5d, 0x41, 0x83, 0x51, 0xfe, 0x37, 0x0b, 0x64, 0x7e, 0x77, 0x71, 0x8c, 0xb4, 0xd0, 0x19, 0x43,
+	0x17, 0xb9, 0x0b, 0xe5, 0xc4, 0x57, 0x0f, 0x7e, 0xaf, 0x49, 0x50, 0xe9, 0x62, 0x66, 0x28, 0x6b,
+	0x96, 0x48, 0xc7, 0x23, 0xc9, 0xd8, 0x98, 0x2c, 0xcd, 0xa6, 0x92, 0x29, 0xd7, 0x57, 0x01, 0x98,
+	0xcf, 0x70, 0x4f, 0x72, 0x93, 0xda, 0x44, 0xe9, 0x4c, 0x0a, 0x91, 0xdd, 0x65, 0xe4, 0xeb, 0xea,
+	0x89, 0x94, 0x49, 0xdb, 0xcb, 0xd2, 0x6c, 0x2a, 0x99, 0x75, 0x1e, 0xee, 0x93, 0x61, 0xde, 0x79,
+	0xe2, 0x10, 0x69, 0xbe, 0x23, 0x84, 0xd5, 0x03, 0xf3, 0xbd, 0x2e, 0xaf, 0x87, 0x36, 0x51, 0x3a,
+	0x93, 0x42, 0xe4, 0x83, 0x28, 0xd1, 0x5a, 0x2c, 0x59, 0x9a, 0x4d, 0x25, 0x53, 0xae, 0xbb, 0x50,
+	0x12, 0x7c, 0x0a, 0x20, 0xd8, 0x10, 0x07, 0x92, 0xce, 0x65, 0x00, 0x25, 0x84, 0x12, 0x59, 0x26,
+	0x35, 0x94, 0xc8, 0x2a, 0x0b, 0x9d, 0x31, 0x7c, 0x46, 0x60, 0xee, 0x3c, 0x93, 0x32, 0x42, 0x1b,
+	0x22, 0xcd, 0x77, 0x84, 0xd0, 0x15, 0x0c, 0x38, 0x2a, 0x6a, 0xe5, 0xcc, 0xa4, 0x72, 0x20, 0x28,
+	0x69, 0x31, 0x0b, 0x8a, 0x2e, 0xf5, 0x75, 0x38, 0x91, 0xdc, 0x97, 0x5d, 0x48, 0xd0, 0xbd, 0x68,
+	0xd9, 0xe5, 0xec, 0x58, 0xba, 0x78, 0x03, 0xc6, 0x84, 0x4d, 0x51, 0xb1, 0x57, 0xc5, 0x61, 0xd2,
+	0xf9, 0x4c, 0x30, 0xba, 0xda, 0xdb, 0x39, 0x38, 0x99, 0xd6, 0x29, 0x5b, 0x4c, 0x64, 0x27, 0x92,
+	0xf7, 0x52, 0x37, 0x68, 0xba, 0x87, 0xd7, 0x61, 0x88, 0xfd, 0x82, 0xf7, 0x54, 0x42, 0xb2, 0x0b,
+	0xa8, 0xd2, 0x4c, 0x1a, 0x95, 0x65, 0xc9, 0x7e, 0x11, 0x7b, 0x2a, 0x21, 0x22, 0x93, 0x58, 0x0a,
+	0xbe, 0x5a, 0xf5, 0xc3, 0x88, 0xff, 0x62, 0x55, 0x4e, 0xf0, 0x2b, 0x06, 0x23, 0x2d, 0x74, 0xc6,
+	0xf0, 0x61, 0xc4, 0x7c, 0xa3, 0x77, 0x3a, 0x6d, 0x7e, 0x00, 0x91, 0xe6, 0x3b, 0x42, 0xd8, 0xdc,
+	0xc8, 0x7c, 0xf2, 0xc6, 0xe7, 0xc6, 0x36, 0x51, 0x3a, 0x93, 0x42, 0x8c, 0x66, 0xf3, 0xd8, 0xe7,
+	0xa3, 0xa2, 0x6c, 0x1e, 0x85, 0x48, 0xf3, 0x1d, 0x21, 0x74, 0x85, 0x2f, 0xc1, 0x60, 0xfb, 0x3b,
+	0x22, 0x89, 0x9b, 0x47, 0x69, 0x92, 0x9c, 0x4c, 0xe3, 0x53, 0x39, 0xe1, 0x97, 0x94, 0xca, 0x09,
+	0xcb, 0xd9, 0x54, 0x32, 0xcb, 0x35, 0xf2, 0xb5, 0xc3, 0x44, 0x82, 0x3d, 0x30, 0x59, 0x9a, 0x4d,
+	0x25, 0x53, 0xae, 0x5f, 0x86, 0x43, 0xd1, 0x5b, 0xd1, 0x49, 0x6e, 0x5e, 0x84, 0x2e, 0x9d, 0x4d,
+	0xa7, 0x53, 0xc6, 0x16, 0x1c, 0x13, 0xdf, 0xca, 0x9f, 0x15, 0x9c, 0x86, 0x02, 0x9c, 0x54, 0xc9,
+	0x86, 0x63, 0x73, 0xb7, 0xe8, 0xca, 0x7a, 0x26, 0x21, 0x3d, 0x46, 0x17, 0x5b, 0xcc, 0x82, 0x62,
+	0x97, 0x12, 0x5d, 0x28, 0xcf, 0x24, 0x54, 0x0f, 0x9d, 0x96, 0x4a, 0xb9, 0xf2, 0x2d, 0xad, 0xc2,
+	0x41, 0x72, 0xdd, 0x3b, 0x2e, 0xa8, 0x7c, 0x7c, 0x82, 0x34, 0x95, 0x40, 0xa0, 0x3c, 0x1c, 0x38,
+	0x9e, 0xd0, 0x21, 0x7e, 0x4a, 0x34, 0x55, 0x00, 0x94, 0x96, 0x32, 0x02, 0xd9, 0xc2, 0x43, 0xd0,
+	0xe5, 0x3c, 0x93, 0xcc, 0x86, 0x82, 0xa4, 0x73, 0x19, 0x40, 0xac, 0x29, 0x44, 0x5d, 0xc4, 0x99,
+	0x64, 0x1e, 0x6d, 0x94, 0xb4, 0x98, 0x05, 0xc5, 0x1e, 0x9a, 0xc2, 0x06, 0xde, 0xac, 0xc0, 0xa0,
+	0x3c, 0x4c, 0x3a, 0x9f, 0x09, 0x16, 0x39, 0x34, 0xd3, 0x9a, 0x64, 0x29, 0x7b, 0xe7, 0xd1, 0xd2,
+	0xa5, 0x6e, 0xd0, 0x74, 0x0f, 0xdf, 0x00, 0x29, 0xa5, 0xed, 0x74, 0x4e, 0x20, 0x50, 0x12, 0x58,
+	0x7a, 0xba, 0x0b, 0x30, 0x7b, 0x1c, 0xf2, 0x4d, 0x1e, 0x39, 0x59, 0x94, 0x10, 0x23, 0x2d, 0x74,
+	0xc6, 0xd0, 0x45, 0x5a, 0x30, 0x9e, 0xd4, 0x3d, 0x99, 0xe3, 0x5f, 0xba, 0xc4, 0x48, 0xe9, 0x42,
+	0x56, 0x24, 0x9b, 0x1f, 0xc5, 0x4d, 0x0a, 0x3e, 0x3f, 0x0a, 0x71, 0x52, 0x25, 0x1b, 0x2e, 0x5c,
+	0x50, 0xea, 0xfb, 0xa6, 0xdf, 0x91, 0x5a, 0xbd, 0xfa, 0xd1, 0x67, 0x93, 0xb9, 0x8f, 0x3f, 0x9b,
+	0xcc, 0xfd, 0xf9, 0xb3, 0xc9, 0xdc, 0xbb, 0xf7, 0x26, 0x0f, 0x7c, 0x7c, 0x6f, 0xf2, 0xc0, 0x1f,
+	0xee, 0x4d, 0x1e, 0xf8, 0xca, 0xa2, 0x6e, 0x78, 0xb7, 0x5a, 0x3b, 0x15, 0xcd, 0x6a, 0x2e, 0xf9,
+	0xac, 0xb5, 0x5b, 0xaa, 0x61, 0x2e, 0xe1, 0xbf, 0x6f, 0xb1, 0xcc, 0x74, 0x22, 0x82, 0x3f, 0x7f,
+	0xb1, 0x73, 0x30, 0xf8, 0x06, 0xf5, 0xe9, 0xff, 0x0d, 0x00, 0x07, 0xb6, 0x2a, 0x97, 0x49, 0x44,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// basic operation of bucket
+	CreateBucket(ctx context.Context, in *MsgCreateBucket, opts ...grpc.CallOption) (*MsgCreateBucketResponse, error)
+	DeleteBucket(ctx context.Context, in *MsgDeleteBucket, opts ...grpc.CallOption) (*MsgDeleteBucketResponse, error)
+	UpdateBucketInfo(ctx context.Context, in *MsgUpdateBucketInfo, opts ...grpc.CallOption) (*MsgUpdateBucketInfoResponse, error)
+	DiscontinueBucket(ctx context.Context, in *MsgDiscontinueBucket, opts ...grpc.CallOption) (*MsgDiscontinueBucketResponse, error)
+	ToggleSPAsDelegatedAgent(ctx context.Context, in *MsgToggleSPAsDelegatedAgent, opts ...grpc.CallOption) (*MsgToggleSPAsDelegatedAgentResponse, error)
+	// basic operation of object
+	CreateObject(ctx context.Context, in *MsgCreateObject, opts ...grpc.CallOption) (*MsgCreateObjectResponse, error)
+	SealObject(ctx context.Context, in *MsgSealObject, opts ...grpc.CallOption) (*MsgSealObjectResponse, error)
+	SealObjectV2(ctx context.Context, in *MsgSealObjectV2, opts ...grpc.CallOption) (*MsgSealObjectV2Response, error)
+	RejectSealObject(ctx context.Context, in *MsgRejectSealObject, opts ...grpc.CallOption) (*MsgRejectSealObjectResponse, error)
+	CopyObject(ctx context.Context, in *MsgCopyObject, opts ...grpc.CallOption) (*MsgCopyObjectResponse, error)
+	DeleteObject(ctx context.Context, in *MsgDeleteObject, opts ...grpc.CallOption) (*MsgDeleteObjectResponse, error)
+	CancelCreateObject(ctx context.Context, in *MsgCancelCreateObject, opts ...grpc.CallOption) (*MsgCancelCreateObjectResponse, error)
+	DiscontinueObject(ctx context.Context, in *MsgDiscontinueObject, opts ...grpc.CallOption) (*MsgDiscontinueObjectResponse, error)
+	UpdateObjectInfo(ctx context.Context, in *MsgUpdateObjectInfo, opts ...grpc.CallOption) (*MsgUpdateObjectInfoResponse, error)
+	UpdateObjectContent(ctx context.Context, in *MsgUpdateObjectContent, opts ...grpc.CallOption) (*MsgUpdateObjectContentResponse, error)
+	CancelUpdateObjectContent(ctx context.Context, in *MsgCancelUpdateObjectContent, opts ...grpc.CallOption) (*MsgCancelUpdateObjectContentResponse, error)
+	DelegateCreateObject(ctx context.Context, in *MsgDelegateCreateObject, opts ...grpc.CallOption) (*MsgDelegateCreateObjectResponse, error)
+	DelegateUpdateObjectContent(ctx context.Context, in *MsgDelegateUpdateObjectContent, opts ...grpc.CallOption) (*MsgDelegateUpdateObjectContentResponse, error)
+	// basic operation of group
+	CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error)
+	DeleteGroup(ctx context.Context, in *MsgDeleteGroup, opts ...grpc.CallOption) (*MsgDeleteGroupResponse, error)
+	UpdateGroupMember(ctx context.Context, in *MsgUpdateGroupMember, opts ...grpc.CallOption) (*MsgUpdateGroupMemberResponse, error)
+	UpdateGroupExtra(ctx context.Context, in *MsgUpdateGroupExtra, opts ...grpc.CallOption) (*MsgUpdateGroupExtraResponse, error)
+	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	RenewGroupMember(ctx context.Context, in *MsgRenewGroupMember, opts ...grpc.CallOption) (*MsgRenewGroupMemberResponse, error)
+	// basic operation of policy
+	PutPolicy(ctx context.Context, in *MsgPutPolicy, opts ...grpc.CallOption) (*MsgPutPolicyResponse, error)
+	DeletePolicy(ctx context.Context, in *MsgDeletePolicy, opts ...grpc.CallOption) (*MsgDeletePolicyResponse, error)
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	MigrateBucket(ctx context.Context, in *MsgMigrateBucket, opts ...grpc.CallOption) (*MsgMigrateBucketResponse, error)
+	CompleteMigrateBucket(ctx context.Context, in *MsgCompleteMigrateBucket, opts ...grpc.CallOption) (*MsgCompleteMigrateBucketResponse, error)
+	CancelMigrateBucket(ctx context.Context, in *MsgCancelMigrateBucket, opts ...grpc.CallOption) (*MsgCancelMigrateBucketResponse, error)
+	RejectMigrateBucket(ctx context.Context, in *MsgRejectMigrateBucket, opts ...grpc.CallOption) (*MsgRejectMigrateBucketResponse, error)
+	// Since: Manchurian upgrade
+	SetTag(ctx context.Context, in *MsgSetTag, opts ...grpc.CallOption) (*MsgSetTagResponse, error)
+	SetBucketFlowRateLimit(ctx context.Context, in *MsgSetBucketFlowRateLimit, opts ...grpc.CallOption) (*MsgSetBucketFlowRateLimitResponse, error)
+	SetBucketLifecycle(ctx context.Context, in *MsgSetBucketLifecycle, opts ...grpc.CallOption) (*MsgSetBucketLifecycleResponse, error)
+	SetBucketVersioning(ctx context.Context, in *MsgSetBucketVersioning, opts ...grpc.CallOption) (*MsgSetBucketVersioningResponse, error)
+	RestoreObjectVersion(ctx context.Context, in *MsgRestoreObjectVersion, opts ...grpc.CallOption) (*MsgRestoreObjectVersionResponse, error)
+	SetBucketReadQuotaAutoTopup(ctx context.Context, in *MsgSetBucketReadQuotaAutoTopup, opts ...grpc.CallOption) (*MsgSetBucketReadQuotaAutoTopupResponse, error)
+	ReportReadQuotaConsumption(ctx context.Context, in *MsgReportReadQuotaConsumption, opts ...grpc.CallOption) (*MsgReportReadQuotaConsumptionResponse, error)
+	SetBucketCallback(ctx context.Context, in *MsgSetBucketCallback, opts ...grpc.CallOption) (*MsgSetBucketCallbackResponse, error)
+	TransferBucketOwnership(ctx context.Context, in *MsgTransferBucketOwnership, opts ...grpc.CallOption) (*MsgTransferBucketOwnershipResponse, error)
+	AcceptBucketOwnership(ctx context.Context, in *MsgAcceptBucketOwnership, opts ...grpc.CallOption) (*MsgAcceptBucketOwnershipResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateBucket(ctx context.Context, in *MsgCreateBucket, opts ...grpc.CallOption) (*MsgCreateBucketResponse, error) {
+	out := new(MsgCreateBucketResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CreateBucket", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateObjectContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/UpdateObjectContent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateObjectContent(ctx, req.(*MsgUpdateObjectContent))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CancelUpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelUpdateObjectContent)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteBucket(ctx context.Context, in *MsgDeleteBucket, opts ...grpc.CallOption) (*MsgDeleteBucketResponse, error) {
+	out := new(MsgDeleteBucketResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DeleteBucket", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelUpdateObjectContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/CancelUpdateObjectContent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelUpdateObjectContent(ctx, req.(*MsgCancelUpdateObjectContent))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DelegateCreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateCreateObject)
-	if err := dec(in); err != nil {
+func (c *msgClient) UpdateBucketInfo(ctx context.Context, in *MsgUpdateBucketInfo, opts ...grpc.CallOption) (*MsgUpdateBucketInfoResponse, error) {
+	out := new(MsgUpdateBucketInfoResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateBucketInfo", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DelegateCreateObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/DelegateCreateObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegateCreateObject(ctx, req.(*MsgDelegateCreateObject))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DelegateUpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateUpdateObjectContent)
-	if err := dec(in); err != nil {
+func (c *msgClient) DiscontinueBucket(ctx context.Context, in *MsgDiscontinueBucket, opts ...grpc.CallOption) (*MsgDiscontinueBucketResponse, error) {
+	out := new(MsgDiscontinueBucketResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DiscontinueBucket", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DelegateUpdateObjectContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/DelegateUpdateObjectContent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegateUpdateObjectContent(ctx, req.(*MsgDelegateUpdateObjectContent))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateGroup)
-	if err := dec(in); err != nil {
+func (c *msgClient) ToggleSPAsDelegatedAgent(ctx context.Context, in *MsgToggleSPAsDelegatedAgent, opts ...grpc.CallOption) (*MsgToggleSPAsDelegatedAgentResponse, error) {
+	out := new(MsgToggleSPAsDelegatedAgentResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/ToggleSPAsDelegatedAgent", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/CreateGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateGroup(ctx, req.(*MsgCreateGroup))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteGroup)
-	if err := dec(in); err != nil {
+func (c *msgClient) CreateObject(ctx context.Context, in *MsgCreateObject, opts ...grpc.CallOption) (*MsgCreateObjectResponse, error) {
+	out := new(MsgCreateObjectResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CreateObject", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/DeleteGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteGroup(ctx, req.(*MsgDeleteGroup))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateGroupMember)
-	if err := dec(in); err != nil {
+func (c *msgClient) SealObject(ctx context.Context, in *MsgSealObject, opts ...grpc.CallOption) (*MsgSealObjectResponse, error) {
+	out := new(MsgSealObjectResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SealObject", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateGroupMember(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/UpdateGroupMember",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateGroupMember(ctx, req.(*MsgUpdateGroupMember))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateGroupExtra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateGroupExtra)
-	if err := dec(in); err != nil {
+func (c *msgClient) SealObjectV2(ctx context.Context, in *MsgSealObjectV2, opts ...grpc.CallOption) (*MsgSealObjectV2Response, error) {
+	out := new(MsgSealObjectV2Response)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SealObjectV2", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateGroupExtra(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/UpdateGroupExtra",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateGroupExtra(ctx, req.(*MsgUpdateGroupExtra))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLeaveGroup)
-	if err := dec(in); err != nil {
+func (c *msgClient) RejectSealObject(ctx context.Context, in *MsgRejectSealObject, opts ...grpc.CallOption) (*MsgRejectSealObjectResponse, error) {
+	out := new(MsgRejectSealObjectResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/RejectSealObject", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).LeaveGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/LeaveGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LeaveGroup(ctx, req.(*MsgLeaveGroup))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RenewGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRenewGroupMember)
-	if err := dec(in); err != nil {
+func (c *msgClient) CopyObject(ctx context.Context, in *MsgCopyObject, opts ...grpc.CallOption) (*MsgCopyObjectResponse, error) {
+	out := new(MsgCopyObjectResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CopyObject", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RenewGroupMember(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/RenewGroupMember",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RenewGroupMember(ctx, req.(*MsgRenewGroupMember))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_PutPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPutPolicy)
-	if err := dec(in); err != nil {
+func (c *msgClient) DeleteObject(ctx context.Context, in *MsgDeleteObject, opts ...grpc.CallOption) (*MsgDeleteObjectResponse, error) {
+	out := new(MsgDeleteObjectResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DeleteObject", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).PutPolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/PutPolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PutPolicy(ctx, req.(*MsgPutPolicy))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeletePolicy)
-	if err := dec(in); err != nil {
+func (c *msgClient) CancelCreateObject(ctx context.Context, in *MsgCancelCreateObject, opts ...grpc.CallOption) (*MsgCancelCreateObjectResponse, error) {
+	out := new(MsgCancelCreateObjectResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CancelCreateObject", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeletePolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/DeletePolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeletePolicy(ctx, req.(*MsgDeletePolicy))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
+func (c *msgClient) DiscontinueObject(ctx context.Context, in *MsgDiscontinueObject, opts ...grpc.CallOption) (*MsgDiscontinueObjectResponse, error) {
+	out := new(MsgDiscontinueObjectResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DiscontinueObject", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_MigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMigrateBucket)
-	if err := dec(in); err != nil {
+func (c *msgClient) UpdateObjectInfo(ctx context.Context, in *MsgUpdateObjectInfo, opts ...grpc.CallOption) (*MsgUpdateObjectInfoResponse, error) {
+	out := new(MsgUpdateObjectInfoResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateObjectInfo", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).MigrateBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/MigrateBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MigrateBucket(ctx, req.(*MsgMigrateBucket))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CompleteMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteMigrateBucket)
-	if err := dec(in); err != nil {
+func (c *msgClient) UpdateObjectContent(ctx context.Context, in *MsgUpdateObjectContent, opts ...grpc.CallOption) (*MsgUpdateObjectContentResponse, error) {
+	out := new(MsgUpdateObjectContentResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateObjectContent", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteMigrateBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/CompleteMigrateBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteMigrateBucket(ctx, req.(*MsgCompleteMigrateBucket))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CancelMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelMigrateBucket)
-	if err := dec(in); err != nil {
+func (c *msgClient) CancelUpdateObjectContent(ctx context.Context, in *MsgCancelUpdateObjectContent, opts ...grpc.CallOption) (*MsgCancelUpdateObjectContentResponse, error) {
+	out := new(MsgCancelUpdateObjectContentResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CancelUpdateObjectContent", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelMigrateBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/CancelMigrateBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelMigrateBucket(ctx, req.(*MsgCancelMigrateBucket))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RejectMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectMigrateBucket)
-	if err := dec(in); err != nil {
+func (c *msgClient) DelegateCreateObject(ctx context.Context, in *MsgDelegateCreateObject, opts ...grpc.CallOption) (*MsgDelegateCreateObjectResponse, error) {
+	out := new(MsgDelegateCreateObjectResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DelegateCreateObject", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectMigrateBucket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/RejectMigrateBucket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectMigrateBucket(ctx, req.(*MsgRejectMigrateBucket))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetTag)
-	if err := dec(in); err != nil {
+func (c *msgClient) DelegateUpdateObjectContent(ctx context.Context, in *MsgDelegateUpdateObjectContent, opts ...grpc.CallOption) (*MsgDelegateUpdateObjectContentResponse, error) {
+	out := new(MsgDelegateUpdateObjectContentResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DelegateUpdateObjectContent", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetTag(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/SetTag",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetTag(ctx, req.(*MsgSetTag))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SetBucketFlowRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBucketFlowRateLimit)
-	if err := dec(in); err != nil {
+func (c *msgClient) CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error) {
+	out := new(MsgCreateGroupResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CreateGroup", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBucketFlowRateLimit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.storage.Msg/SetBucketFlowRateLimit",
+	return out, nil
+}
+
+func (c *msgClient) DeleteGroup(ctx context.Context, in *MsgDeleteGroup, opts ...grpc.CallOption) (*MsgDeleteGroupResponse, error) {
+	out := new(MsgDeleteGroupResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DeleteGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBucketFlowRateLimit(ctx, req.(*MsgSetBucketFlowRateLimit))
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroupMember(ctx context.Context, in *MsgUpdateGroupMember, opts ...grpc.CallOption) (*MsgUpdateGroupMemberResponse, error) {
+	out := new(MsgUpdateGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "moca.storage.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateBucket",
-			Handler:    _Msg_CreateBucket_Handler,
-		},
-		{
-			MethodName: "DeleteBucket",
-			Handler:    _Msg_DeleteBucket_Handler,
-		},
-		{
-			MethodName: "UpdateBucketInfo",
-			Handler:    _Msg_UpdateBucketInfo_Handler,
-		},
-		{
-			MethodName: "DiscontinueBucket",
-			Handler:    _Msg_DiscontinueBucket_Handler,
-		},
-		{
-			MethodName: "ToggleSPAsDelegatedAgent",
-			Handler:    _Msg_ToggleSPAsDelegatedAgent_Handler,
-		},
-		{
-			MethodName: "CreateObject",
-			Handler:    _Msg_CreateObject_Handler,
-		},
-		{
-			MethodName: "SealObject",
-			Handler:    _Msg_SealObject_Handler,
-		},
-		{
-			MethodName: "SealObjectV2",
-			Handler:    _Msg_SealObjectV2_Handler,
-		},
-		{
-			MethodName: "RejectSealObject",
-			Handler:    _Msg_RejectSealObject_Handler,
-		},
-		{
-			MethodName: "CopyObject",
-			Handler:    _Msg_CopyObject_Handler,
-		},
-		{
-			MethodName: "DeleteObject",
-			Handler:    _Msg_DeleteObject_Handler,
-		},
-		{
-			MethodName: "CancelCreateObject",
-			Handler:    _Msg_CancelCreateObject_Handler,
-		},
-		{
-			MethodName: "DiscontinueObject",
-			Handler:    _Msg_DiscontinueObject_Handler,
-		},
-		{
-			MethodName: "UpdateObjectInfo",
-			Handler:    _Msg_UpdateObjectInfo_Handler,
-		},
-		{
-			MethodName: "UpdateObjectContent",
-			Handler:    _Msg_UpdateObjectContent_Handler,
-		},
-		{
-			MethodName: "CancelUpdateObjectContent",
-			Handler:    _Msg_CancelUpdateObjectContent_Handler,
-		},
-		{
-			MethodName: "DelegateCreateObject",
-			Handler:    _Msg_DelegateCreateObject_Handler,
-		},
-		{
-			MethodName: "DelegateUpdateObjectContent",
-			Handler:    _Msg_DelegateUpdateObjectContent_Handler,
-		},
-		{
-			MethodName: "CreateGroup",
-			Handler:    _Msg_CreateGroup_Handler,
-		},
-		{
-			MethodName: "DeleteGroup",
-			Handler:    _Msg_DeleteGroup_Handler,
-		},
-		{
-			MethodName: "UpdateGroupMember",
-			Handler:    _Msg_UpdateGroupMember_Handler,
-		},
-		{
-			MethodName: "UpdateGroupExtra",
-			Handler:    _Msg_UpdateGroupExtra_Handler,
-		},
-		{
-			MethodName: "LeaveGroup",
-			Handler:    _Msg_LeaveGroup_Handler,
-		},
-		{
-			MethodName: "RenewGroupMember",
-			Handler:    _Msg_RenewGroupMember_Handler,
-		},
-		{
-			MethodName: "PutPolicy",
-			Handler:    _Msg_PutPolicy_Handler,
-		},
-		{
-			MethodName: "DeletePolicy",
-			Handler:    _Msg_DeletePolicy_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "MigrateBucket",
-			Handler:    _Msg_MigrateBucket_Handler,
-		},
-		{
-			MethodName: "CompleteMigrateBucket",
-			Handler:    _Msg_CompleteMigrateBucket_Handler,
-		},
-		{
-			MethodName: "CancelMigrateBucket",
-			Handler:    _Msg_CancelMigrateBucket_Handler,
-		},
-		{
-			MethodName: "RejectMigrateBucket",
-			Handler:    _Msg_RejectMigrateBucket_Handler,
-		},
-		{
-			MethodName: "SetTag",
-			Handler:    _Msg_SetTag_Handler,
-		},
-		{
-			MethodName: "SetBucketFlowRateLimit",
-			Handler:    _Msg_SetBucketFlowRateLimit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "moca/storage/tx.proto",
+func (c *msgClient) UpdateGroupExtra(ctx context.Context, in *MsgUpdateGroupExtra, opts ...grpc.CallOption) (*MsgUpdateGroupExtraResponse, error) {
+	out := new(MsgUpdateGroupExtraResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateGroupExtra", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
+	out := new(MsgLeaveGroupResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/LeaveGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RenewGroupMember(ctx context.Context, in *MsgRenewGroupMember, opts ...grpc.CallOption) (*MsgRenewGroupMemberResponse, error) {
+	out := new(MsgRenewGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/RenewGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChargedReadQuota != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChargedReadQuota))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.PrimarySpApproval != nil {
-		{
-			size, err := m.PrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.PrimarySpAddress) > 0 {
-		i -= len(m.PrimarySpAddress)
-		copy(dAtA[i:], m.PrimarySpAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PrimarySpAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Visibility != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) PutPolicy(ctx context.Context, in *MsgPutPolicy, opts ...grpc.CallOption) (*MsgPutPolicyResponse, error) {
+	out := new(MsgPutPolicyResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/PutPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) DeletePolicy(ctx context.Context, in *MsgDeletePolicy, opts ...grpc.CallOption) (*MsgDeletePolicyResponse, error) {
+	out := new(MsgDeletePolicyResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/DeletePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateBucketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MigrateBucket(ctx context.Context, in *MsgMigrateBucket, opts ...grpc.CallOption) (*MsgMigrateBucketResponse, error) {
+	out := new(MsgMigrateBucketResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/MigrateBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) CompleteMigrateBucket(ctx context.Context, in *MsgCompleteMigrateBucket, opts ...grpc.CallOption) (*MsgCompleteMigrateBucketResponse, error) {
+	out := new(MsgCompleteMigrateBucketResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CompleteMigrateBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelMigrateBucket(ctx context.Context, in *MsgCancelMigrateBucket, opts ...grpc.CallOption) (*MsgCancelMigrateBucketResponse, error) {
+	out := new(MsgCancelMigrateBucketResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/CancelMigrateBucket", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgDeleteBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RejectMigrateBucket(ctx context.Context, in *MsgRejectMigrateBucket, opts ...grpc.CallOption) (*MsgRejectMigrateBucketResponse, error) {
+	out := new(MsgRejectMigrateBucketResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/RejectMigrateBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteBucketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetTag(ctx context.Context, in *MsgSetTag, opts ...grpc.CallOption) (*MsgSetTagResponse, error) {
+	out := new(MsgSetTagResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SetTag", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteBucketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDiscontinueBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetBucketFlowRateLimit(ctx context.Context, in *MsgSetBucketFlowRateLimit, opts ...grpc.CallOption) (*MsgSetBucketFlowRateLimitResponse, error) {
+	out := new(MsgSetBucketFlowRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SetBucketFlowRateLimit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDiscontinueBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgDiscontinueBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetBucketLifecycle(ctx context.Context, in *MsgSetBucketLifecycle, opts ...grpc.CallOption) (*MsgSetBucketLifecycleResponse, error) {
+	out := new(MsgSetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SetBucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDiscontinueBucketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetBucketVersioning(ctx context.Context, in *MsgSetBucketVersioning, opts ...grpc.CallOption) (*MsgSetBucketVersioningResponse, error) {
+	out := new(MsgSetBucketVersioningResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SetBucketVersioning", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDiscontinueBucketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDiscontinueBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RestoreObjectVersion(ctx context.Context, in *MsgRestoreObjectVersion, opts ...grpc.CallOption) (*MsgRestoreObjectVersionResponse, error) {
+	out := new(MsgRestoreObjectVersionResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/RestoreObjectVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RedundancyType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RedundancyType))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.ExpectChecksums) > 0 {
-		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExpectChecksums[iNdEx])
-			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if m.PrimarySpApproval != nil {
-		{
-			size, err := m.PrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Visibility != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetBucketReadQuotaAutoTopup(ctx context.Context, in *MsgSetBucketReadQuotaAutoTopup, opts ...grpc.CallOption) (*MsgSetBucketReadQuotaAutoTopupResponse, error) {
+	out := new(MsgSetBucketReadQuotaAutoTopupResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SetBucketReadQuotaAutoTopup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReportReadQuotaConsumption(ctx context.Context, in *MsgReportReadQuotaConsumption, opts ...grpc.CallOption) (*MsgReportReadQuotaConsumptionResponse, error) {
+	out := new(MsgReportReadQuotaConsumptionResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/ReportReadQuotaConsumption", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) SetBucketCallback(ctx context.Context, in *MsgSetBucketCallback, opts ...grpc.CallOption) (*MsgSetBucketCallbackResponse, error) {
+	out := new(MsgSetBucketCallbackResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/SetBucketCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSealObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferBucketOwnership(ctx context.Context, in *MsgTransferBucketOwnership, opts ...grpc.CallOption) (*MsgTransferBucketOwnershipResponse, error) {
+	out := new(MsgTransferBucketOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/TransferBucketOwnership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSealObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SecondarySpBlsAggSignatures) > 0 {
-		i -= len(m.SecondarySpBlsAggSignatures)
-		copy(dAtA[i:], m.SecondarySpBlsAggSignatures)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SecondarySpBlsAggSignatures)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSealObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptBucketOwnership(ctx context.Context, in *MsgAcceptBucketOwnership, opts ...grpc.CallOption) (*MsgAcceptBucketOwnershipResponse, error) {
+	out := new(MsgAcceptBucketOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/moca.storage.Msg/AcceptBucketOwnership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSealObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// basic operation of bucket
+	CreateBucket(context.Context, *MsgCreateBucket) (*MsgCreateBucketResponse, error)
+	DeleteBucket(context.Context, *MsgDeleteBucket) (*MsgDeleteBucketResponse, error)
+	UpdateBucketInfo(context.Context, *MsgUpdateBucketInfo) (*MsgUpdateBucketInfoResponse, error)
+	DiscontinueBucket(context.Context, *MsgDiscontinueBucket) (*MsgDiscontinueBucketResponse, error)
+	ToggleSPAsDelegatedAgent(context.Context, *MsgToggleSPAsDelegatedAgent) (*MsgToggleSPAsDelegatedAgentResponse, error)
+	// basic operation of object
+	CreateObject(context.Context, *MsgCreateObject) (*MsgCreateObjectResponse, error)
+	SealObject(context.Context, *MsgSealObject) (*MsgSealObjectResponse, error)
+	SealObjectV2(context.Context, *MsgSealObjectV2) (*MsgSealObjectV2Response, error)
+	RejectSealObject(context.Context, *MsgRejectSealObject) (*MsgRejectSealObjectResponse, error)
+	CopyObject(context.Context, *MsgCopyObject) (*MsgCopyObjectResponse, error)
+	DeleteObject(context.Context, *MsgDeleteObject) (*MsgDeleteObjectResponse, error)
+	CancelCreateObject(context.Context, *MsgCancelCreateObject) (*MsgCancelCreateObjectResponse, error)
+	DiscontinueObject(context.Context, *MsgDiscontinueObject) (*MsgDiscontinueObjectResponse, error)
+	UpdateObjectInfo(context.Context, *MsgUpdateObjectInfo) (*MsgUpdateObjectInfoResponse, error)
+	UpdateObjectContent(context.Context, *MsgUpdateObjectContent) (*MsgUpdateObjectContentResponse, error)
+	CancelUpdateObjectContent(context.Context, *MsgCancelUpdateObjectContent) (*MsgCancelUpdateObjectContentResponse, error)
+	DelegateCreateObject(context.Context, *MsgDelegateCreateObject) (*MsgDelegateCreateObjectResponse, error)
+	DelegateUpdateObjectContent(context.Context, *MsgDelegateUpdateObjectContent) (*MsgDelegateUpdateObjectContentResponse, error)
+	// basic operation of group
+	CreateGroup(context.Context, *MsgCreateGroup) (*MsgCreateGroupResponse, error)
+	DeleteGroup(context.Context, *MsgDeleteGroup) (*MsgDeleteGroupResponse, error)
+	UpdateGroupMember(context.Context, *MsgUpdateGroupMember) (*MsgUpdateGroupMemberResponse, error)
+	UpdateGroupExtra(context.Context, *MsgUpdateGroupExtra) (*MsgUpdateGroupExtraResponse, error)
+	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	RenewGroupMember(context.Context, *MsgRenewGroupMember) (*MsgRenewGroupMemberResponse, error)
+	// basic operation of policy
+	PutPolicy(context.Context, *MsgPutPolicy) (*MsgPutPolicyResponse, error)
+	DeletePolicy(context.Context, *MsgDeletePolicy) (*MsgDeletePolicyResponse, error)
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	MigrateBucket(context.Context, *MsgMigrateBucket) (*MsgMigrateBucketResponse, error)
+	CompleteMigrateBucket(context.Context, *MsgCompleteMigrateBucket) (*MsgCompleteMigrateBucketResponse, error)
+	CancelMigrateBucket(context.Context, *MsgCancelMigrateBucket) (*MsgCancelMigrateBucketResponse, error)
+	RejectMigrateBucket(context.Context, *MsgRejectMigrateBucket) (*MsgRejectMigrateBucketResponse, error)
+	// Since: Manchurian upgrade
+	SetTag(context.Context, *MsgSetTag) (*MsgSetTagResponse, error)
+	SetBucketFlowRateLimit(context.Context, *MsgSetBucketFlowRateLimit) (*MsgSetBucketFlowRateLimitResponse, error)
+	SetBucketLifecycle(context.Context, *MsgSetBucketLifecycle) (*MsgSetBucketLifecycleResponse, error)
+	SetBucketVersioning(context.Context, *MsgSetBucketVersioning) (*MsgSetBucketVersioningResponse, error)
+	RestoreObjectVersion(context.Context, *MsgRestoreObjectVersion) (*MsgRestoreObjectVersionResponse, error)
+	SetBucketReadQuotaAutoTopup(context.Context, *MsgSetBucketReadQuotaAutoTopup) (*MsgSetBucketReadQuotaAutoTopupResponse, error)
+	ReportReadQuotaConsumption(context.Context, *MsgReportReadQuotaConsumption) (*MsgReportReadQuotaConsumptionResponse, error)
+	SetBucketCallback(context.Context, *MsgSetBucketCallback) (*MsgSetBucketCallbackResponse, error)
+	TransferBucketOwnership(context.Context, *MsgTransferBucketOwnership) (*MsgTransferBucketOwnershipResponse, error)
+	AcceptBucketOwnership(context.Context, *MsgAcceptBucketOwnership) (*MsgAcceptBucketOwnershipResponse, error)
 }
 
-func (m *MsgSealObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgSealObjectV2) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateBucket(ctx context.Context, req *MsgCreateBucket) (*MsgCreateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBucket not implemented")
 }
-
-func (m *MsgSealObjectV2) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) DeleteBucket(ctx context.Context, req *MsgDeleteBucket) (*MsgDeleteBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBucket not implemented")
 }
-
-func (m *MsgSealObjectV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExpectChecksums) > 0 {
-		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExpectChecksums[iNdEx])
-			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.SecondarySpBlsAggSignatures) > 0 {
-		i -= len(m.SecondarySpBlsAggSignatures)
-		copy(dAtA[i:], m.SecondarySpBlsAggSignatures)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SecondarySpBlsAggSignatures)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) UpdateBucketInfo(ctx context.Context, req *MsgUpdateBucketInfo) (*MsgUpdateBucketInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBucketInfo not implemented")
 }
-
-func (m *MsgSealObjectV2Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) DiscontinueBucket(ctx context.Context, req *MsgDiscontinueBucket) (*MsgDiscontinueBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscontinueBucket not implemented")
 }
-
-func (m *MsgSealObjectV2Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) ToggleSPAsDelegatedAgent(ctx context.Context, req *MsgToggleSPAsDelegatedAgent) (*MsgToggleSPAsDelegatedAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleSPAsDelegatedAgent not implemented")
 }
-
-func (m *MsgSealObjectV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) CreateObject(ctx context.Context, req *MsgCreateObject) (*MsgCreateObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateObject not implemented")
 }
-
-func (m *MsgRejectSealObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SealObject(ctx context.Context, req *MsgSealObject) (*MsgSealObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealObject not implemented")
 }
-
-func (m *MsgRejectSealObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) SealObjectV2(ctx context.Context, req *MsgSealObjectV2) (*MsgSealObjectV2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealObjectV2 not implemented")
 }
-
-func (m *MsgRejectSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) RejectSealObject(ctx context.Context, req *MsgRejectSealObject) (*MsgRejectSealObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSealObject not implemented")
 }
-
-func (m *MsgRejectSealObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CopyObject(ctx context.Context, req *MsgCopyObject) (*MsgCopyObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyObject not implemented")
 }
-
-func (m *MsgRejectSealObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) DeleteObject(ctx context.Context, req *MsgDeleteObject) (*MsgDeleteObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteObject not implemented")
 }
-
-func (m *MsgRejectSealObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) CancelCreateObject(ctx context.Context, req *MsgCancelCreateObject) (*MsgCancelCreateObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCreateObject not implemented")
 }
-
-func (m *MsgCopyObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) DiscontinueObject(ctx context.Context, req *MsgDiscontinueObject) (*MsgDiscontinueObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscontinueObject not implemented")
+}
+func (*UnimplementedMsgServer) UpdateObjectInfo(ctx context.Context, req *MsgUpdateObjectInfo) (*MsgUpdateObjectInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateObjectInfo not implemented")
+}
+func (*UnimplementedMsgServer) UpdateObjectContent(ctx context.Context, req *MsgUpdateObjectContent) (*MsgUpdateObjectContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateObjectContent not implemented")
+}
+func (*UnimplementedMsgServer) CancelUpdateObjectContent(ctx context.Context, req *MsgCancelUpdateObjectContent) (*MsgCancelUpdateObjectContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUpdateObjectContent not implemented")
+}
+func (*UnimplementedMsgServer) DelegateCreateObject(ctx context.Context, req *MsgDelegateCreateObject) (*MsgDelegateCreateObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateCreateObject not implemented")
+}
+func (*UnimplementedMsgServer) DelegateUpdateObjectContent(ctx context.Context, req *MsgDelegateUpdateObjectContent) (*MsgDelegateUpdateObjectContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateUpdateObjectContent not implemented")
+}
+func (*UnimplementedMsgServer) CreateGroup(ctx context.Context, req *MsgCreateGroup) (*MsgCreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (*UnimplementedMsgServer) DeleteGroup(ctx context.Context, req *MsgDeleteGroup) (*MsgDeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupMember(ctx context.Context, req *MsgUpdateGroupMember) (*MsgUpdateGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupMember not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupExtra(ctx context.Context, req *MsgUpdateGroupExtra) (*MsgUpdateGroupExtraResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupExtra not implemented")
+}
+func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+}
+func (*UnimplementedMsgServer) RenewGroupMember(ctx context.Context, req *MsgRenewGroupMember) (*MsgRenewGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewGroupMember not implemented")
+}
+func (*UnimplementedMsgServer) PutPolicy(ctx context.Context, req *MsgPutPolicy) (*MsgPutPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutPolicy not implemented")
+}
+func (*UnimplementedMsgServer) DeletePolicy(ctx context.Context, req *MsgDeletePolicy) (*MsgDeletePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) MigrateBucket(ctx context.Context, req *MsgMigrateBucket) (*MsgMigrateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateBucket not implemented")
+}
+func (*UnimplementedMsgServer) CompleteMigrateBucket(ctx context.Context, req *MsgCompleteMigrateBucket) (*MsgCompleteMigrateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMigrateBucket not implemented")
+}
+func (*UnimplementedMsgServer) CancelMigrateBucket(ctx context.Context, req *MsgCancelMigrateBucket) (*MsgCancelMigrateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMigrateBucket not implemented")
+}
+func (*UnimplementedMsgServer) RejectMigrateBucket(ctx context.Context, req *MsgRejectMigrateBucket) (*MsgRejectMigrateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectMigrateBucket not implemented")
+}
+func (*UnimplementedMsgServer) SetTag(ctx context.Context, req *MsgSetTag) (*MsgSetTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTag not implemented")
+}
+func (*UnimplementedMsgServer) SetBucketFlowRateLimit(ctx context.Context, req *MsgSetBucketFlowRateLimit) (*MsgSetBucketFlowRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketFlowRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) SetBucketLifecycle(ctx context.Context, req *MsgSetBucketLifecycle) (*MsgSetBucketLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketLifecycle not implemented")
+}
+func (*UnimplementedMsgServer) SetBucketVersioning(ctx context.Context, req *MsgSetBucketVersioning) (*MsgSetBucketVersioningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketVersioning not implemented")
+}
+func (*UnimplementedMsgServer) RestoreObjectVersion(ctx context.Context, req *MsgRestoreObjectVersion) (*MsgRestoreObjectVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreObjectVersion not implemented")
+}
+func (*UnimplementedMsgServer) SetBucketReadQuotaAutoTopup(ctx context.Context, req *MsgSetBucketReadQuotaAutoTopup) (*MsgSetBucketReadQuotaAutoTopupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketReadQuotaAutoTopup not implemented")
+}
+func (*UnimplementedMsgServer) ReportReadQuotaConsumption(ctx context.Context, req *MsgReportReadQuotaConsumption) (*MsgReportReadQuotaConsumptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportReadQuotaConsumption not implemented")
+}
+func (*UnimplementedMsgServer) SetBucketCallback(ctx context.Context, req *MsgSetBucketCallback) (*MsgSetBucketCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketCallback not implemented")
+}
+func (*UnimplementedMsgServer) TransferBucketOwnership(ctx context.Context, req *MsgTransferBucketOwnership) (*MsgTransferBucketOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferBucketOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptBucketOwnership(ctx context.Context, req *MsgAcceptBucketOwnership) (*MsgAcceptBucketOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptBucketOwnership not implemented")
 }
 
-func (m *MsgCopyObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgCopyObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DstPrimarySpApproval != nil {
-		{
-			size, err := m.DstPrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.DstObjectName) > 0 {
-		i -= len(m.DstObjectName)
-		copy(dAtA[i:], m.DstObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DstObjectName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SrcObjectName) > 0 {
-		i -= len(m.SrcObjectName)
-		copy(dAtA[i:], m.SrcObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcObjectName)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBucket)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DstBucketName) > 0 {
-		i -= len(m.DstBucketName)
-		copy(dAtA[i:], m.DstBucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DstBucketName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBucket(ctx, in)
 	}
-	if len(m.SrcBucketName) > 0 {
-		i -= len(m.SrcBucketName)
-		copy(dAtA[i:], m.SrcBucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcBucketName)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/CreateBucket",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBucket(ctx, req.(*MsgCreateBucket))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCopyObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteBucket)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCopyObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCopyObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteBucket(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/DeleteBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteBucket(ctx, req.(*MsgDeleteBucket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateBucketInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBucketInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBucketInfo(ctx, in)
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/UpdateBucketInfo",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBucketInfo(ctx, req.(*MsgUpdateBucketInfo))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DiscontinueBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDiscontinueBucket)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).DiscontinueBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/DiscontinueBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DiscontinueBucket(ctx, req.(*MsgDiscontinueBucket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDiscontinueObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ToggleSPAsDelegatedAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgToggleSPAsDelegatedAgent)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDiscontinueObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).ToggleSPAsDelegatedAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/ToggleSPAsDelegatedAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ToggleSPAsDelegatedAgent(ctx, req.(*MsgToggleSPAsDelegatedAgent))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDiscontinueObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_CreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateObject)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ObjectIds) > 0 {
-		for iNdEx := len(m.ObjectIds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.ObjectIds[iNdEx].Size()
-				i -= size
-				if _, err := m.ObjectIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateObject(ctx, in)
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/CreateObject",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateObject(ctx, req.(*MsgCreateObject))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDiscontinueObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SealObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSealObject)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SealObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/SealObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SealObject(ctx, req.(*MsgSealObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDiscontinueObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SealObjectV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSealObjectV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SealObjectV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/SealObjectV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SealObjectV2(ctx, req.(*MsgSealObjectV2))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDiscontinueObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_RejectSealObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectSealObject)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectSealObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/RejectSealObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectSealObject(ctx, req.(*MsgRejectSealObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCopyObject)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CopyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/CopyObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CopyObject(ctx, req.(*MsgCopyObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteObject)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/DeleteObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteObject(ctx, req.(*MsgDeleteObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Extra) > 0 {
-		i -= len(m.Extra)
-		copy(dAtA[i:], m.Extra)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Extra)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_CancelCreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelCreateObject)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).CancelCreateObject(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/CancelCreateObject",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelCreateObject(ctx, req.(*MsgCancelCreateObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DiscontinueObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDiscontinueObject)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DiscontinueObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/DiscontinueObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DiscontinueObject(ctx, req.(*MsgDiscontinueObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.GroupId.Size()
-		i -= size
-		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_UpdateObjectInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateObjectInfo)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateObjectInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/UpdateObjectInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateObjectInfo(ctx, req.(*MsgUpdateObjectInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateObjectContent)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateObjectContent(ctx, in)
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/UpdateObjectContent",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateObjectContent(ctx, req.(*MsgUpdateObjectContent))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelUpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUpdateObjectContent)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUpdateObjectContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/CancelUpdateObjectContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUpdateObjectContent(ctx, req.(*MsgCancelUpdateObjectContent))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DelegateCreateObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateCreateObject)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateCreateObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/DelegateCreateObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateCreateObject(ctx, req.(*MsgDelegateCreateObject))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_DelegateUpdateObjectContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateUpdateObjectContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateUpdateObjectContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/DelegateUpdateObjectContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateUpdateObjectContent(ctx, req.(*MsgDelegateUpdateObjectContent))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGroup)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGroup(ctx, req.(*MsgCreateGroup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/DeleteGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteGroup(ctx, req.(*MsgDeleteGroup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MembersToDelete) > 0 {
-		for iNdEx := len(m.MembersToDelete) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.MembersToDelete[iNdEx])
-			copy(dAtA[i:], m.MembersToDelete[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.MembersToDelete[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.MembersToAdd) > 0 {
-		for iNdEx := len(m.MembersToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MembersToAdd[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_UpdateGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupMember)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupMember(ctx, in)
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/UpdateGroupMember",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupMember(ctx, req.(*MsgUpdateGroupMember))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupMemberResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateGroupExtra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupExtra)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupExtra(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/UpdateGroupExtra",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupExtra(ctx, req.(*MsgUpdateGroupExtra))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRenewGroupMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveGroup)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRenewGroupMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/LeaveGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveGroup(ctx, req.(*MsgLeaveGroup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRenewGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_RenewGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewGroupMember)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).RenewGroupMember(ctx, in)
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/RenewGroupMember",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewGroupMember(ctx, req.(*MsgRenewGroupMember))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRenewGroupMemberResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_PutPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPutPolicy)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRenewGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRenewGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).PutPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/PutPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PutPolicy(ctx, req.(*MsgPutPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGroupMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeletePolicy)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgGroupMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpirationTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintTx(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).DeletePolicy(ctx, in)
 	}
-	if len(m.Member) > 0 {
-		i -= len(m.Member)
-		copy(dAtA[i:], m.Member)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/DeletePolicy",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeletePolicy(ctx, req.(*MsgDeletePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupExtra) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateGroupExtra) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Extra) > 0 {
-		i -= len(m.Extra)
-		copy(dAtA[i:], m.Extra)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Extra)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_MigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateBucket)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateBucket(ctx, in)
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/MigrateBucket",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateBucket(ctx, req.(*MsgMigrateBucket))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupExtraResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CompleteMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteMigrateBucket)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteMigrateBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/CompleteMigrateBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteMigrateBucket(ctx, req.(*MsgCompleteMigrateBucket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateGroupExtraResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateGroupExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMigrateBucket)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMigrateBucket(ctx, in)
 	}
-	if len(m.GroupOwner) > 0 {
-		i -= len(m.GroupOwner)
-		copy(dAtA[i:], m.GroupOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/CancelMigrateBucket",
 	}
-	if len(m.Member) > 0 {
-		i -= len(m.Member)
-		copy(dAtA[i:], m.Member)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMigrateBucket(ctx, req.(*MsgCancelMigrateBucket))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLeaveGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RejectMigrateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectMigrateBucket)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RejectMigrateBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/RejectMigrateBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectMigrateBucket(ctx, req.(*MsgRejectMigrateBucket))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLeaveGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTag)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/SetTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTag(ctx, req.(*MsgSetTag))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetBucketFlowRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBucketFlowRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBucketFlowRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/SetBucketFlowRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBucketFlowRateLimit(ctx, req.(*MsgSetBucketFlowRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBucketInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBucketLifecycle)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/SetBucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBucketLifecycle(ctx, req.(*MsgSetBucketLifecycle))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBucketInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetBucketVersioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBucketVersioning)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBucketVersioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/SetBucketVersioning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBucketVersioning(ctx, req.(*MsgSetBucketVersioning))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Visibility != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_RestoreObjectVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRestoreObjectVersion)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChargedReadQuota != nil {
-		{
-			size, err := m.ChargedReadQuota.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).RestoreObjectVersion(ctx, in)
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/RestoreObjectVersion",
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RestoreObjectVersion(ctx, req.(*MsgRestoreObjectVersion))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBucketInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetBucketReadQuotaAutoTopup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBucketReadQuotaAutoTopup)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetBucketReadQuotaAutoTopup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/SetBucketReadQuotaAutoTopup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBucketReadQuotaAutoTopup(ctx, req.(*MsgSetBucketReadQuotaAutoTopup))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBucketInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ReportReadQuotaConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportReadQuotaConsumption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportReadQuotaConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/ReportReadQuotaConsumption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportReadQuotaConsumption(ctx, req.(*MsgReportReadQuotaConsumption))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateBucketInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetBucketCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBucketCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBucketCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/SetBucketCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBucketCallback(ctx, req.(*MsgSetBucketCallback))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelCreateObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_TransferBucketOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferBucketOwnership)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).TransferBucketOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/TransferBucketOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferBucketOwnership(ctx, req.(*MsgTransferBucketOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelCreateObject) MarshalTo(dAtA []byte) (int, error) {
+func _Msg_AcceptBucketOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptBucketOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptBucketOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.storage.Msg/AcceptBucketOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptBucketOwnership(ctx, req.(*MsgAcceptBucketOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "moca.storage.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBucket",
+			Handler:    _Msg_CreateBucket_Handler,
+		},
+		{
+			MethodName: "DeleteBucket",
+			Handler:    _Msg_DeleteBucket_Handler,
+		},
+		{
+			MethodName: "UpdateBucketInfo",
+			Handler:    _Msg_UpdateBucketInfo_Handler,
+		},
+		{
+			MethodName: "DiscontinueBucket",
+			Handler:    _Msg_DiscontinueBucket_Handler,
+		},
+		{
+			MethodName: "ToggleSPAsDelegatedAgent",
+			Handler:    _Msg_ToggleSPAsDelegatedAgent_Handler,
+		},
+		{
+			MethodName: "CreateObject",
+			Handler:    _Msg_CreateObject_Handler,
+		},
+		{
+			MethodName: "SealObject",
+			Handler:    _Msg_SealObject_Handler,
+		},
+		{
+			MethodName: "SealObjectV2",
+			Handler:    _Msg_SealObjectV2_Handler,
+		},
+		{
+			MethodName: "RejectSealObject",
+			Handler:    _Msg_RejectSealObject_Handler,
+		},
+		{
+			MethodName: "CopyObject",
+			Handler:    _Msg_CopyObject_Handler,
+		},
+		{
+			MethodName: "DeleteObject",
+			Handler:    _Msg_DeleteObject_Handler,
+		},
+		{
+			MethodName: "CancelCreateObject",
+			Handler:    _Msg_CancelCreateObject_Handler,
+		},
+		{
+			MethodName: "DiscontinueObject",
+			Handler:    _Msg_DiscontinueObject_Handler,
+		},
+		{
+			MethodName: "UpdateObjectInfo",
+			Handler:    _Msg_UpdateObjectInfo_Handler,
+		},
+		{
+			MethodName: "UpdateObjectContent",
+			Handler:    _Msg_UpdateObjectContent_Handler,
+		},
+		{
+			MethodName: "CancelUpdateObjectContent",
+			Handler:    _Msg_CancelUpdateObjectContent_Handler,
+		},
+		{
+			MethodName: "DelegateCreateObject",
+			Handler:    _Msg_DelegateCreateObject_Handler,
+		},
+		{
+			MethodName: "DelegateUpdateObjectContent",
+			Handler:    _Msg_DelegateUpdateObjectContent_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _Msg_CreateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _Msg_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroupMember",
+			Handler:    _Msg_UpdateGroupMember_Handler,
+		},
+		{
+			MethodName: "UpdateGroupExtra",
+			Handler:    _Msg_UpdateGroupExtra_Handler,
+		},
+		{
+			MethodName: "LeaveGroup",
+			Handler:    _Msg_LeaveGroup_Handler,
+		},
+		{
+			MethodName: "RenewGroupMember",
+			Handler:    _Msg_RenewGroupMember_Handler,
+		},
+		{
+			MethodName: "PutPolicy",
+			Handler:    _Msg_PutPolicy_Handler,
+		},
+		{
+			MethodName: "DeletePolicy",
+			Handler:    _Msg_DeletePolicy_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "MigrateBucket",
+			Handler:    _Msg_MigrateBucket_Handler,
+		},
+		{
+			MethodName: "CompleteMigrateBucket",
+			Handler:    _Msg_CompleteMigrateBucket_Handler,
+		},
+		{
+			MethodName: "CancelMigrateBucket",
+			Handler:    _Msg_CancelMigrateBucket_Handler,
+		},
+		{
+			MethodName: "RejectMigrateBucket",
+			Handler:    _Msg_RejectMigrateBucket_Handler,
+		},
+		{
+			MethodName: "SetTag",
+			Handler:    _Msg_SetTag_Handler,
+		},
+		{
+			MethodName: "SetBucketFlowRateLimit",
+			Handler:    _Msg_SetBucketFlowRateLimit_Handler,
+		},
+		{
+			MethodName: "SetBucketLifecycle",
+			Handler:    _Msg_SetBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "SetBucketVersioning",
+			Handler:    _Msg_SetBucketVersioning_Handler,
+		},
+		{
+			MethodName: "RestoreObjectVersion",
+			Handler:    _Msg_RestoreObjectVersion_Handler,
+		},
+		{
+			MethodName: "SetBucketReadQuotaAutoTopup",
+			Handler:    _Msg_SetBucketReadQuotaAutoTopup_Handler,
+		},
+		{
+			MethodName: "ReportReadQuotaConsumption",
+			Handler:    _Msg_ReportReadQuotaConsumption_Handler,
+		},
+		{
+			MethodName: "SetBucketCallback",
+			Handler:    _Msg_SetBucketCallback_Handler,
+		},
+		{
+			MethodName: "TransferBucketOwnership",
+			Handler:    _Msg_TransferBucketOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptBucketOwnership",
+			Handler:    _Msg_AcceptBucketOwnership_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "moca/storage/tx.proto",
+}
+
+func (m *MsgCreateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+	if m.GvgFamilyStrategy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GvgFamilyStrategy))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x40
+	}
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChargedReadQuota))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PrimarySpApproval != nil {
+		{
+			size, err := m.PrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PrimarySpAddress) > 0 {
+		i -= len(m.PrimarySpAddress)
+		copy(dAtA[i:], m.PrimarySpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PrimarySpAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
@@ -6669,17 +6445,17 @@ func (m *MsgCancelCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelCreateObjectResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBucketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6689,20 +6465,30 @@ func (m *MsgCancelCreateObjectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPutPolicy) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6712,56 +6498,20 @@ func (m *MsgPutPolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgPutPolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExpirationTime != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintTx(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Statements) > 0 {
-		for iNdEx := len(m.Statements) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Statements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Principal != nil {
-		{
-			size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6775,7 +6525,7 @@ func (m *MsgPutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPutPolicyResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteBucketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6785,30 +6535,20 @@ func (m *MsgPutPolicyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgPutPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteBucketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPutPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.PolicyId.Size()
-		i -= size
-		if _, err := m.PolicyId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeletePolicy) Marshal() (dAtA []byte, err error) {
+func (m *MsgDiscontinueBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6818,32 +6558,27 @@ func (m *MsgDeletePolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeletePolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeletePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Principal != nil {
-		{
-			size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6857,7 +6592,7 @@ func (m *MsgDeletePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeletePolicyResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDiscontinueBucketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6867,30 +6602,20 @@ func (m *MsgDeletePolicyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeletePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueBucketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeletePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.PolicyId.Size()
-		i -= size
-		if _, err := m.PolicyId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6900,42 +6625,57 @@ func (m *MsgUpdateObjectInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateObjectInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateObjectInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateObjectInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RedundancyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectChecksums[iNdEx])
+			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PrimarySpApproval != nil {
+		{
+			size, err := m.PrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateObjectInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	if m.Visibility != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
 		i--
+		dAtA[i] = 0x28
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
+		i--
 		dAtA[i] = 0x20
 	}
 	if len(m.ObjectName) > 0 {
@@ -6952,17 +6692,17 @@ func (m *MsgUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6972,37 +6712,30 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSealObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7012,55 +6745,34 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSealObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgMigrateBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.SecondarySpBlsAggSignatures) > 0 {
+		i -= len(m.SecondarySpBlsAggSignatures)
+		copy(dAtA[i:], m.SecondarySpBlsAggSignatures)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SecondarySpBlsAggSignatures)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DstPrimarySpApproval != nil {
-		{
-			size, err := m.DstPrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if m.DstPrimarySpId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DstPrimarySpId))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
@@ -7079,7 +6791,7 @@ func (m *MsgMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSealObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7089,12 +6801,12 @@ func (m *MsgMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSealObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSealObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7102,7 +6814,7 @@ func (m *MsgMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCompleteMigrateBucket) Marshal() (dAtA []byte, err error) {
+func (m *MsgSealObjectV2) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7112,34 +6824,43 @@ func (m *MsgCompleteMigrateBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCompleteMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSealObjectV2) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCompleteMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSealObjectV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GvgMappings) > 0 {
-		for iNdEx := len(m.GvgMappings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GvgMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
+	if len(m.ExpectChecksums) > 0 {
+		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectChecksums[iNdEx])
+			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x32
 		}
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+	if len(m.SecondarySpBlsAggSignatures) > 0 {
+		i -= len(m.SecondarySpBlsAggSignatures)
+		copy(dAtA[i:], m.SecondarySpBlsAggSignatures)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SecondarySpBlsAggSignatures)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x2a
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
@@ -7158,7 +6879,7 @@ func (m *MsgCompleteMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCompleteMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSealObjectV2Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7168,12 +6889,12 @@ func (m *MsgCompleteMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCompleteMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSealObjectV2Response) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCompleteMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSealObjectV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7181,7 +6902,7 @@ func (m *MsgCompleteMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelMigrateBucket) Marshal() (dAtA []byte, err error) {
+func (m *MsgRejectSealObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7191,16 +6912,23 @@ func (m *MsgCancelMigrateBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRejectSealObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRejectSealObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
 		copy(dAtA[i:], m.BucketName)
@@ -7218,7 +6946,7 @@ func (m *MsgCancelMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRejectSealObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7228,12 +6956,12 @@ func (m *MsgCancelMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRejectSealObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRejectSealObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7241,7 +6969,7 @@ func (m *MsgCancelMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
+func (m *MsgCopyObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7251,20 +6979,53 @@ func (m *MsgRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRejectMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCopyObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCopyObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+	if m.DstPrimarySpApproval != nil {
+		{
+			size, err := m.DstPrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DstObjectName) > 0 {
+		i -= len(m.DstObjectName)
+		copy(dAtA[i:], m.DstObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstObjectName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SrcObjectName) > 0 {
+		i -= len(m.SrcObjectName)
+		copy(dAtA[i:], m.SrcObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcObjectName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DstBucketName) > 0 {
+		i -= len(m.DstBucketName)
+		copy(dAtA[i:], m.DstBucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstBucketName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcBucketName) > 0 {
+		i -= len(m.SrcBucketName)
+		copy(dAtA[i:], m.SrcBucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcBucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7278,7 +7039,7 @@ func (m *MsgRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRejectMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCopyObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7288,20 +7049,30 @@ func (m *MsgRejectMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRejectMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCopyObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRejectMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCopyObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTag) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7311,32 +7082,27 @@ func (m *MsgSetTag) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTag) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Tags != nil {
-		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Resource) > 0 {
-		i -= len(m.Resource)
-		copy(dAtA[i:], m.Resource)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7350,7 +7116,7 @@ func (m *MsgSetTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTagResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7360,12 +7126,12 @@ func (m *MsgSetTagResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTagResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7373,7 +7139,7 @@ func (m *MsgSetTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+func (m *MsgDiscontinueObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7383,43 +7149,36 @@ func (m *MsgUpdateObjectContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExpectChecksums) > 0 {
-		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExpectChecksums[iNdEx])
-			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.ObjectIds) > 0 {
+		for iNdEx := len(m.ObjectIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ObjectIds[iNdEx].Size()
+				i -= size
+				if _, err := m.ObjectIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.BucketName) > 0 {
 		i -= len(m.BucketName)
@@ -7438,7 +7197,7 @@ func (m *MsgUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDiscontinueObjectResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7448,12 +7207,12 @@ func (m *MsgUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueObjectResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDiscontinueObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7461,7 +7220,7 @@ func (m *MsgUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7471,41 +7230,41 @@ func (m *MsgCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+	if len(m.Extra) > 0 {
+		i -= len(m.Extra)
+		copy(dAtA[i:], m.Extra)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Extra)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateGroupResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7515,20 +7274,30 @@ func (m *MsgCancelUpdateObjectContentResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateGroupResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.GroupId.Size()
+		i -= size
+		if _, err := m.GroupId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateCreateObject) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7538,65 +7307,20 @@ func (m *MsgDelegateCreateObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateCreateObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RedundancyType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RedundancyType))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.ExpectChecksums) > 0 {
-		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExpectChecksums[iNdEx])
-			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if m.Visibility != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7610,7 +7334,7 @@ func (m *MsgDelegateCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateCreateObjectResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteGroupResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7620,30 +7344,20 @@ func (m *MsgDelegateCreateObjectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteGroupResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateGroupMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7653,55 +7367,50 @@ func (m *MsgDelegateUpdateObjectContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExpectChecksums) > 0 {
-		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExpectChecksums[iNdEx])
-			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
+	if len(m.MembersToDelete) > 0 {
+		for iNdEx := len(m.MembersToDelete) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MembersToDelete[iNdEx])
+			copy(dAtA[i:], m.MembersToDelete[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MembersToDelete[iNdEx])))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x22
+	if len(m.MembersToAdd) > 0 {
+		for iNdEx := len(m.MembersToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MembersToAdd[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Updater) > 0 {
-		i -= len(m.Updater)
-		copy(dAtA[i:], m.Updater)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Updater)))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7715,7 +7424,7 @@ func (m *MsgDelegateUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateGroupMemberResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7725,12 +7434,12 @@ func (m *MsgDelegateUpdateObjectContentResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7738,7 +7447,7 @@ func (m *MsgDelegateUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
+func (m *MsgRenewGroupMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7748,20 +7457,41 @@ func (m *MsgToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRenewGroupMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgToggleSPAsDelegatedAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRenewGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7775,7 +7505,7 @@ func (m *MsgToggleSPAsDelegatedAgent) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRenewGroupMemberResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7785,12 +7515,12 @@ func (m *MsgToggleSPAsDelegatedAgentResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRenewGroupMemberResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgToggleSPAsDelegatedAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRenewGroupMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7798,7 +7528,7 @@ func (m *MsgToggleSPAsDelegatedAgentResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
+func (m *MsgGroupMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7808,58 +7538,37 @@ func (m *MsgSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetBucketFlowRateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgGroupMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBucketFlowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.FlowRateLimit.Size()
-		i -= size
-		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ExpirationTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.BucketOwner) > 0 {
-		i -= len(m.BucketOwner)
-		copy(dAtA[i:], m.BucketOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketOwner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateGroupExtra) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7869,1216 +7578,5089 @@ func (m *MsgSetBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetBucketFlowRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupExtra) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBucketFlowRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
-	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Extra) > 0 {
+		i -= len(m.Extra)
+		copy(dAtA[i:], m.Extra)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Extra)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.PrimarySpAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PrimarySpApproval != nil {
-		l = m.PrimarySpApproval.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ChargedReadQuota != 0 {
-		n += 1 + sovTx(uint64(m.ChargedReadQuota))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateGroupExtraResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BucketId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateGroupExtraResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateGroupExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDiscontinueBucket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GroupOwner) > 0 {
+		i -= len(m.GroupOwner)
+		copy(dAtA[i:], m.GroupOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDiscontinueBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgLeaveGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateBucketInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBucketInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovTx(uint64(m.PayloadSize))
-	}
 	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
-	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.PrimarySpApproval != nil {
-		l = m.PrimarySpApproval.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.ExpectChecksums) > 0 {
-		for _, b := range m.ExpectChecksums {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	if m.ChargedReadQuota != nil {
+		{
+			size, err := m.ChargedReadQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RedundancyType != 0 {
-		n += 1 + sovTx(uint64(m.RedundancyType))
-	}
-	return n
-}
-
-func (m *MsgCreateObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = m.ObjectId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSealObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovTx(uint64(m.GlobalVirtualGroupId))
-	}
-	l = len(m.SecondarySpBlsAggSignatures)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateBucketInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSealObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUpdateBucketInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSealObjectV2) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateBucketInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovTx(uint64(m.GlobalVirtualGroupId))
-	}
-	l = len(m.SecondarySpBlsAggSignatures)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ExpectChecksums) > 0 {
-		for _, b := range m.ExpectChecksums {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSealObjectV2Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelCreateObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRejectSealObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelCreateObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRejectSealObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelCreateObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCopyObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SrcBucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DstBucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SrcObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DstObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DstPrimarySpApproval != nil {
-		l = m.DstPrimarySpApproval.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCancelCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCopyObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgPutPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgPutPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDiscontinueObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgPutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ExpirationTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Statements) > 0 {
+		for iNdEx := len(m.Statements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.ObjectIds) > 0 {
-		for _, e := range m.ObjectIds {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Principal != nil {
+		{
+			size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDiscontinueObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPutPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Extra)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgPutPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgPutPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.GroupId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	{
+		size := m.PolicyId.Size()
+		i -= size
+		if _, err := m.PolicyId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDeletePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgDeletePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateGroupMember) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeletePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.MembersToAdd) > 0 {
-		for _, e := range m.MembersToAdd {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.Principal != nil {
+		{
+			size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.MembersToDelete) > 0 {
-		for _, s := range m.MembersToDelete {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeletePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRenewGroupMember) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeletePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeletePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.PolicyId.Size()
+		i -= size
+		if _, err := m.PolicyId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRenewGroupMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateObjectInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgGroupMember) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Member)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ExpirationTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateObjectInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateGroupExtra) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateObjectInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateObjectInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateObjectInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Visibility != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Extra)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupExtraResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgLeaveGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Member)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.GroupOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLeaveGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBucketInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMigrateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DstPrimarySpApproval != nil {
+		{
+			size, err := m.DstPrimarySpApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ChargedReadQuota != nil {
-		l = m.ChargedReadQuota.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.DstPrimarySpId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DstPrimarySpId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBucketInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelCreateObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCompleteMigrateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GvgMappings) > 0 {
+		for iNdEx := len(m.GvgMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GvgMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelCreateObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCompleteMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPutPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Principal != nil {
-		l = m.Principal.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Statements) > 0 {
-		for _, e := range m.Statements {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.ExpirationTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCompleteMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPutPolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCompleteMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.PolicyId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeletePolicy) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelMigrateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Principal != nil {
-		l = m.Principal.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeletePolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PolicyId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateObjectInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRejectMigrateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectMigrateBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectMigrateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRejectMigrateBucketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRejectMigrateBucketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMigrateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRejectMigrateBucketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DstPrimarySpId != 0 {
-		n += 1 + sovTx(uint64(m.DstPrimarySpId))
-	}
-	if m.DstPrimarySpApproval != nil {
-		l = m.DstPrimarySpApproval.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCompleteMigrateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Tags != nil {
+		{
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovTx(uint64(m.GlobalVirtualGroupFamilyId))
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.GvgMappings) > 0 {
-		for _, e := range m.GvgMappings {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCompleteMigrateBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelMigrateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelMigrateBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRejectMigrateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRejectMigrateBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTag) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ExpectChecksums) > 0 {
+		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectChecksums[iNdEx])
+			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.Resource)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.PayloadSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTagResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectContent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovTx(uint64(m.PayloadSize))
-	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ExpectChecksums) > 0 {
-		for _, b := range m.ExpectChecksums {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObjectContentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelUpdateObjectContent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCancelUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelUpdateObjectContentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateCreateObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateCreateObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateCreateObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateCreateObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RedundancyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ExpectChecksums) > 0 {
+		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectChecksums[iNdEx])
+			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Visibility != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.PayloadSize != 0 {
-		n += 1 + sovTx(uint64(m.PayloadSize))
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ExpectChecksums) > 0 {
-		for _, b := range m.ExpectChecksums {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.RedundancyType != 0 {
-		n += 1 + sovTx(uint64(m.RedundancyType))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateCreateObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateCreateObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateUpdateObjectContent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelegateCreateObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateCreateObjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Updater)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovTx(uint64(m.PayloadSize))
-	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ExpectChecksums) > 0 {
-		for _, b := range m.ExpectChecksums {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateUpdateObjectContentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateUpdateObjectContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDelegateUpdateObjectContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateUpdateObjectContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectChecksums) > 0 {
+		for iNdEx := len(m.ExpectChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpectChecksums[iNdEx])
+			copy(dAtA[i:], m.ExpectChecksums[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	if m.PayloadSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Updater) > 0 {
+		i -= len(m.Updater)
+		copy(dAtA[i:], m.Updater)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Updater)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgToggleSPAsDelegatedAgentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateUpdateObjectContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateUpdateObjectContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateUpdateObjectContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBucketFlowRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgToggleSPAsDelegatedAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleSPAsDelegatedAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleSPAsDelegatedAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.BucketOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleSPAsDelegatedAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleSPAsDelegatedAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleSPAsDelegatedAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketFlowRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketFlowRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketFlowRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FlowRateLimit.Size()
+		i -= size
+		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BucketOwner) > 0 {
+		i -= len(m.BucketOwner)
+		copy(dAtA[i:], m.BucketOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketFlowRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketFlowRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketFlowRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketLifecycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketLifecycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketLifecycleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketVersioning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketVersioning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketVersioning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxVersions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxVersions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketVersioningResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketVersioningResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketVersioningResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRestoreObjectVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreObjectVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreObjectVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRestoreObjectVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreObjectVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreObjectVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketReadQuotaAutoTopup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketReadQuotaAutoTopup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketReadQuotaAutoTopup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AutoTopup.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportReadQuotaConsumption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportReadQuotaConsumption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportReadQuotaConsumption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumedReadQuota != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ConsumedReadQuota))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportReadQuotaConsumptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportReadQuotaConsumptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportReadQuotaConsumptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Callback.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBucketCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBucketCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBucketCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferBucketOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferBucketOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferBucketOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferBucketOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferBucketOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferBucketOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptBucketOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptBucketOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptBucketOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptBucketOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptBucketOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptBucketOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PrimarySpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PrimarySpApproval != nil {
+		l = m.PrimarySpApproval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovTx(uint64(m.ChargedReadQuota))
+	}
+	if m.GvgFamilyStrategy != 0 {
+		n += 1 + sovTx(uint64(m.GvgFamilyStrategy))
+	}
+	return n
+}
+
+func (m *MsgCreateBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BucketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDiscontinueBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDiscontinueBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTx(uint64(m.PayloadSize))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PrimarySpApproval != nil {
+		l = m.PrimarySpApproval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovTx(uint64(m.RedundancyType))
+	}
+	return n
+}
+
+func (m *MsgCreateObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSealObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTx(uint64(m.GlobalVirtualGroupId))
+	}
+	l = len(m.SecondarySpBlsAggSignatures)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSealObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSealObjectV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTx(uint64(m.GlobalVirtualGroupId))
+	}
+	l = len(m.SecondarySpBlsAggSignatures)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSealObjectV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectSealObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectSealObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCopyObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SrcBucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstBucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SrcObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DstPrimarySpApproval != nil {
+		l = m.DstPrimarySpApproval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCopyObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDiscontinueObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ObjectIds) > 0 {
+		for _, e := range m.ObjectIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDiscontinueObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Extra)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GroupId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MembersToAdd) > 0 {
+		for _, e := range m.MembersToAdd {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MembersToDelete) > 0 {
+		for _, s := range m.MembersToDelete {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewGroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRenewGroupMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupExtra) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Extra)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupExtraResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLeaveGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLeaveGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBucketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChargedReadQuota != nil {
+		l = m.ChargedReadQuota.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	return n
+}
+
+func (m *MsgUpdateBucketInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelCreateObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelCreateObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPutPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Principal != nil {
+		l = m.Principal.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Statements) > 0 {
+		for _, e := range m.Statements {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPutPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PolicyId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeletePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Principal != nil {
+		l = m.Principal.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeletePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PolicyId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateObjectInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateObjectInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DstPrimarySpId != 0 {
+		n += 1 + sovTx(uint64(m.DstPrimarySpId))
+	}
+	if m.DstPrimarySpApproval != nil {
+		l = m.DstPrimarySpApproval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteMigrateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTx(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if len(m.GvgMappings) > 0 {
+		for _, e := range m.GvgMappings {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCompleteMigrateBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelMigrateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelMigrateBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectMigrateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectMigrateBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateObjectContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTx(uint64(m.PayloadSize))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateObjectContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelUpdateObjectContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelUpdateObjectContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateCreateObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTx(uint64(m.PayloadSize))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovTx(uint64(m.RedundancyType))
+	}
+	return n
+}
+
+func (m *MsgDelegateCreateObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateUpdateObjectContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Updater)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTx(uint64(m.PayloadSize))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDelegateUpdateObjectContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgToggleSPAsDelegatedAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgToggleSPAsDelegatedAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBucketFlowRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FlowRateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetBucketFlowRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBucketLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetBucketLifecycleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBucketVersioning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxVersions != 0 {
+		n += 1 + sovTx(uint64(m.MaxVersions))
+	}
+	return n
+}
+
+func (m *MsgSetBucketVersioningResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRestoreObjectVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgRestoreObjectVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBucketReadQuotaAutoTopup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AutoTopup.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportReadQuotaConsumption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ConsumedReadQuota != 0 {
+		n += 1 + sovTx(uint64(m.ConsumedReadQuota))
+	}
+	return n
+}
+
+func (m *MsgReportReadQuotaConsumptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBucketCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Callback.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetBucketCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferBucketOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferBucketOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptBucketOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptBucketOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpApproval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrimarySpApproval == nil {
+				m.PrimarySpApproval = &common.Approval{}
+			}
+			if err := m.PrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			}
+			m.ChargedReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GvgFamilyStrategy", wireType)
+			}
+			m.GvgFamilyStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GvgFamilyStrategy |= types.PickVGFStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDiscontinueBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDiscontinueBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDiscontinueBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDiscontinueBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDiscontinueBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDiscontinueBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpApproval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrimarySpApproval == nil {
+				m.PrimarySpApproval = &common.Approval{}
+			}
+			if err := m.PrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSealObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpBlsAggSignatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondarySpBlsAggSignatures = append(m.SecondarySpBlsAggSignatures[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecondarySpBlsAggSignatures == nil {
+				m.SecondarySpBlsAggSignatures = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSealObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSealObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSealObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSealObjectV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSealObjectV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpBlsAggSignatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondarySpBlsAggSignatures = append(m.SecondarySpBlsAggSignatures[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecondarySpBlsAggSignatures == nil {
+				m.SecondarySpBlsAggSignatures = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSealObjectV2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSealObjectV2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSealObjectV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.FlowRateLimit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgSetBucketFlowRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectSealObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9101,15 +12683,15 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectSealObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9137,7 +12719,7 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -9172,27 +12754,8 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
-			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9220,11 +12783,111 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectSealObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectSealObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectSealObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCopyObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCopyObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9252,13 +12915,13 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpApproval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcBucketName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9268,33 +12931,29 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrimarySpApproval == nil {
-				m.PrimarySpApproval = &common.Approval{}
-			}
-			if err := m.PrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SrcBucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstBucketName", wireType)
 			}
-			m.ChargedReadQuota = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9304,64 +12963,27 @@ func (m *MsgCreateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateBucketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBucketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DstBucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9389,63 +13011,11 @@ func (m *MsgCreateBucketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SrcObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteBucket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteBucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteBucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9473,13 +13043,13 @@ func (m *MsgDeleteBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.DstObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpApproval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9489,23 +13059,27 @@ func (m *MsgDeleteBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if m.DstPrimarySpApproval == nil {
+				m.DstPrimarySpApproval = &common.Approval{}
+			}
+			if err := m.DstPrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9528,7 +13102,7 @@ func (m *MsgDeleteBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCopyObjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9551,12 +13125,46 @@ func (m *MsgDeleteBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCopyObjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCopyObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9578,7 +13186,7 @@ func (m *MsgDeleteBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDiscontinueBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9601,10 +13209,10 @@ func (m *MsgDiscontinueBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDiscontinueBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDiscontinueBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9673,7 +13281,7 @@ func (m *MsgDiscontinueBucket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9701,7 +13309,7 @@ func (m *MsgDiscontinueBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9724,7 +13332,7 @@ func (m *MsgDiscontinueBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDiscontinueBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteObjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9747,10 +13355,10 @@ func (m *MsgDiscontinueBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDiscontinueBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteObjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDiscontinueBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9774,7 +13382,7 @@ func (m *MsgDiscontinueBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
+func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9797,15 +13405,15 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDiscontinueObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDiscontinueObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9833,7 +13441,7 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -9869,7 +13477,7 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9897,13 +13505,17 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			var v Uint
+			m.ObjectIds = append(m.ObjectIds, v)
+			if err := m.ObjectIds[len(m.ObjectIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.PayloadSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9913,33 +13525,127 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDiscontinueObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDiscontinueObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDiscontinueObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9967,13 +13673,13 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpApproval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9983,33 +13689,29 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrimarySpApproval == nil {
-				m.PrimarySpApproval = &common.Approval{}
-			}
-			if err := m.PrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10019,43 +13721,24 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			m.Extra = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
-			}
-			m.RedundancyType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RedundancyType |= RedundancyType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10077,7 +13760,7 @@ func (m *MsgCreateObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10100,15 +13783,15 @@ func (m *MsgCreateObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10136,7 +13819,7 @@ func (m *MsgCreateObjectResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10161,7 +13844,7 @@ func (m *MsgCreateObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10184,10 +13867,10 @@ func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSealObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10224,39 +13907,7 @@ func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10284,60 +13935,7 @@ func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
-			}
-			m.GlobalVirtualGroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpBlsAggSignatures", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SecondarySpBlsAggSignatures = append(m.SecondarySpBlsAggSignatures[:0], dAtA[iNdEx:postIndex]...)
-			if m.SecondarySpBlsAggSignatures == nil {
-				m.SecondarySpBlsAggSignatures = []byte{}
-			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10360,7 +13958,7 @@ func (m *MsgSealObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSealObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10383,10 +13981,10 @@ func (m *MsgSealObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSealObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSealObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10410,7 +14008,7 @@ func (m *MsgSealObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10433,10 +14031,10 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSealObjectV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGroupMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSealObjectV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10473,7 +14071,7 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10501,11 +14099,11 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10533,32 +14131,13 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
-			}
-			m.GlobalVirtualGroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpBlsAggSignatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MembersToAdd", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10568,31 +14147,31 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecondarySpBlsAggSignatures = append(m.SecondarySpBlsAggSignatures[:0], dAtA[iNdEx:postIndex]...)
-			if m.SecondarySpBlsAggSignatures == nil {
-				m.SecondarySpBlsAggSignatures = []byte{}
+			m.MembersToAdd = append(m.MembersToAdd, &MsgGroupMember{})
+			if err := m.MembersToAdd[len(m.MembersToAdd)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MembersToDelete", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10602,23 +14181,23 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			m.MembersToDelete = append(m.MembersToDelete, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10641,7 +14220,7 @@ func (m *MsgSealObjectV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSealObjectV2Response) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10664,10 +14243,10 @@ func (m *MsgSealObjectV2Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSealObjectV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGroupMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSealObjectV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10691,7 +14270,7 @@ func (m *MsgSealObjectV2Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectSealObject) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10714,10 +14293,10 @@ func (m *MsgRejectSealObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectSealObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewGroupMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectSealObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10754,7 +14333,7 @@ func (m *MsgRejectSealObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10782,11 +14361,11 @@ func (m *MsgRejectSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10814,7 +14393,41 @@ func (m *MsgRejectSealObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &MsgGroupMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10837,7 +14450,7 @@ func (m *MsgRejectSealObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectSealObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10860,10 +14473,10 @@ func (m *MsgRejectSealObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectSealObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewGroupMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectSealObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10887,7 +14500,7 @@ func (m *MsgRejectSealObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
+func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10910,15 +14523,15 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCopyObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGroupMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCopyObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10946,13 +14559,13 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Member = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcBucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10962,27 +14575,81 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcBucketName = string(dAtA[iNdEx:postIndex])
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupExtra: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupExtra: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstBucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11010,11 +14677,11 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstBucketName = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11042,11 +14709,11 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11074,13 +14741,13 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpApproval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11090,27 +14757,23 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DstPrimarySpApproval == nil {
-				m.DstPrimarySpApproval = &common.Approval{}
-			}
-			if err := m.DstPrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Extra = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11133,7 +14796,7 @@ func (m *MsgCopyObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCopyObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGroupExtraResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11153,49 +14816,15 @@ func (m *MsgCopyObjectResponse) Unmarshal(dAtA []byte) error {
 				break
 			}
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCopyObjectResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCopyObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupExtraResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupExtraResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11217,7 +14846,7 @@ func (m *MsgCopyObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11240,15 +14869,15 @@ func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11276,11 +14905,11 @@ func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Member = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11308,11 +14937,11 @@ func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.GroupOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11340,7 +14969,7 @@ func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11363,7 +14992,7 @@ func (m *MsgDeleteObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11386,10 +15015,10 @@ func (m *MsgDeleteObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11413,7 +15042,7 @@ func (m *MsgDeleteObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11436,10 +15065,10 @@ func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDiscontinueObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBucketInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDiscontinueObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11508,9 +15137,9 @@ func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11520,31 +15149,31 @@ func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v Uint
-			m.ObjectIds = append(m.ObjectIds, v)
-			if err := m.ObjectIds[len(m.ObjectIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ChargedReadQuota == nil {
+				m.ChargedReadQuota = &common.UInt64Value{}
+			}
+			if err := m.ChargedReadQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11572,8 +15201,27 @@ func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11595,7 +15243,7 @@ func (m *MsgDiscontinueObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDiscontinueObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBucketInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11618,10 +15266,10 @@ func (m *MsgDiscontinueObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDiscontinueObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBucketInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDiscontinueObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBucketInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11645,7 +15293,7 @@ func (m *MsgDiscontinueObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11668,15 +15316,15 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelCreateObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11704,11 +15352,11 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11736,11 +15384,11 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11768,7 +15416,7 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extra = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11791,7 +15439,7 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelCreateObjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11814,46 +15462,12 @@ func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelCreateObjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GroupId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11875,7 +15489,7 @@ func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11898,10 +15512,10 @@ func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPutPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPutPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11938,7 +15552,43 @@ func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Principal == nil {
+				m.Principal = &types1.Principal{}
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11963,10 +15613,80 @@ func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statements = append(m.Statements, &types1.Statement{})
+			if err := m.Statements[len(m.Statements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11989,7 +15709,7 @@ func (m *MsgDeleteGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPutPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12012,12 +15732,46 @@ func (m *MsgDeleteGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPutPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPutPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PolicyId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12039,7 +15793,7 @@ func (m *MsgDeleteGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
+func (m *MsgDeletePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12062,10 +15816,10 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeletePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeletePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12102,9 +15856,9 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12114,27 +15868,31 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			if m.Principal == nil {
+				m.Principal = &types1.Principal{}
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12162,45 +15920,61 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembersToAdd", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeletePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MembersToAdd = append(m.MembersToAdd, &MsgGroupMember{})
-			if err := m.MembersToAdd[len(m.MembersToAdd)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeletePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeletePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembersToDelete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12228,7 +16002,9 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MembersToDelete = append(m.MembersToDelete, string(dAtA[iNdEx:postIndex]))
+			if err := m.PolicyId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12251,7 +16027,7 @@ func (m *MsgUpdateGroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateObjectInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12274,10 +16050,10 @@ func (m *MsgUpdateGroupMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateObjectInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateObjectInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12301,7 +16077,7 @@ func (m *MsgUpdateGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12324,10 +16100,10 @@ func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewGroupMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateObjectInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12364,7 +16140,39 @@ func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12392,11 +16200,80 @@ func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12424,11 +16301,11 @@ func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12455,8 +16332,7 @@ func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &MsgGroupMember{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12481,7 +16357,7 @@ func (m *MsgRenewGroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewGroupMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12504,10 +16380,10 @@ func (m *MsgRenewGroupMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewGroupMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewGroupMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12531,7 +16407,7 @@ func (m *MsgRenewGroupMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12554,15 +16430,15 @@ func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGroupMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12590,11 +16466,62 @@ func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Member = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpId", wireType)
+			}
+			m.DstPrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstPrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpApproval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12621,10 +16548,10 @@ func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = new(time.Time)
+			if m.DstPrimarySpApproval == nil {
+				m.DstPrimarySpApproval = &common.Approval{}
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DstPrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12649,7 +16576,7 @@ func (m *MsgGroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12672,10 +16599,60 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupExtra: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateBucketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupExtra: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteMigrateBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12712,7 +16689,7 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12740,13 +16717,13 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			var stringLen uint64
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12756,29 +16733,16 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GvgMappings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12788,23 +16752,25 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extra = string(dAtA[iNdEx:postIndex])
+			m.GvgMappings = append(m.GvgMappings, &GVGMapping{})
+			if err := m.GvgMappings[len(m.GvgMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12827,7 +16793,7 @@ func (m *MsgUpdateGroupExtra) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupExtraResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12850,10 +16816,10 @@ func (m *MsgUpdateGroupExtraResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupExtraResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteMigrateBucketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupExtraResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12877,7 +16843,7 @@ func (m *MsgUpdateGroupExtraResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMigrateBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12900,15 +16866,15 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMigrateBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12936,43 +16902,11 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Member = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13000,7 +16934,7 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13023,7 +16957,7 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13046,10 +16980,10 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMigrateBucketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13073,7 +17007,7 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13096,10 +17030,10 @@ func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBucketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectMigrateBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13166,93 +17100,6 @@ func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChargedReadQuota == nil {
-				m.ChargedReadQuota = &common.UInt64Value{}
-			}
-			if err := m.ChargedReadQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
-			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13274,7 +17121,7 @@ func (m *MsgUpdateBucketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBucketInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13297,10 +17144,10 @@ func (m *MsgUpdateBucketInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBucketInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectMigrateBucketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBucketInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13324,7 +17171,7 @@ func (m *MsgUpdateBucketInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13347,10 +17194,10 @@ func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelCreateObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13387,7 +17234,7 @@ func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13415,13 +17262,13 @@ func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13431,23 +17278,27 @@ func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			if m.Tags == nil {
+				m.Tags = &ResourceTags{}
+			}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13470,7 +17321,7 @@ func (m *MsgCancelCreateObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelCreateObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTagResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13493,10 +17344,10 @@ func (m *MsgCancelCreateObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelCreateObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTagResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13520,7 +17371,7 @@ func (m *MsgCancelCreateObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13543,10 +17394,10 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPutPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateObjectContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPutPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13583,9 +17434,9 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13595,31 +17446,27 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Principal == nil {
-				m.Principal = &types.Principal{}
-			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13647,13 +17494,32 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13663,31 +17529,29 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Statements = append(m.Statements, &types.Statement{})
-			if err := m.Statements[len(m.Statements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13697,27 +17561,23 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13740,7 +17600,7 @@ func (m *MsgPutPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPutPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13763,46 +17623,12 @@ func (m *MsgPutPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPutPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateObjectContentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPutPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PolicyId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13824,7 +17650,7 @@ func (m *MsgPutPolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeletePolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13847,10 +17673,10 @@ func (m *MsgDeletePolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeletePolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUpdateObjectContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeletePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13887,43 +17713,7 @@ func (m *MsgDeletePolicy) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Principal == nil {
-				m.Principal = &types.Principal{}
-			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13951,61 +17741,11 @@ func (m *MsgDeletePolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeletePolicyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeletePolicyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeletePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14033,9 +17773,7 @@ func (m *MsgDeletePolicyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PolicyId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14058,7 +17796,7 @@ func (m *MsgDeletePolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObjectInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14081,10 +17819,10 @@ func (m *MsgUpdateObjectInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObjectInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUpdateObjectContentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObjectInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14108,7 +17846,7 @@ func (m *MsgUpdateObjectInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14131,10 +17869,10 @@ func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObjectInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateCreateObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14171,7 +17909,7 @@ func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14199,11 +17937,11 @@ func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14231,13 +17969,13 @@ func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
-			m.Visibility = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14247,64 +17985,46 @@ func (m *MsgUpdateObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14332,13 +18052,64 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
 			}
-			var msglen int
+			m.RedundancyType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14348,25 +18119,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14388,7 +18145,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateCreateObjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14411,12 +18168,46 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateCreateObjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14438,7 +18229,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14461,10 +18252,10 @@ func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateUpdateObjectContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14500,6 +18291,38 @@ func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updater", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updater = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -14531,11 +18354,43 @@ func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
 			}
-			m.DstPrimarySpId = 0
+			m.PayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14545,16 +18400,16 @@ func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DstPrimarySpId |= uint32(b&0x7F) << shift
+				m.PayloadSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPrimarySpApproval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14564,27 +18419,55 @@ func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DstPrimarySpApproval == nil {
-				m.DstPrimarySpApproval = &common.Approval{}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
 			}
-			if err := m.DstPrimarySpApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14607,7 +18490,7 @@ func (m *MsgMigrateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14630,10 +18513,10 @@ func (m *MsgMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateUpdateObjectContentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14657,7 +18540,7 @@ func (m *MsgMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14680,10 +18563,10 @@ func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteMigrateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14750,59 +18633,6 @@ func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
-			}
-			m.GlobalVirtualGroupFamilyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GvgMappings", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GvgMappings = append(m.GvgMappings, &GVGMapping{})
-			if err := m.GvgMappings[len(m.GvgMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14824,7 +18654,7 @@ func (m *MsgCompleteMigrateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteMigrateBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleSPAsDelegatedAgentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14847,10 +18677,10 @@ func (m *MsgCompleteMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteMigrateBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14874,7 +18704,7 @@ func (m *MsgCompleteMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelMigrateBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14897,10 +18727,10 @@ func (m *MsgCancelMigrateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelMigrateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketFlowRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketFlowRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14967,6 +18797,104 @@ func (m *MsgCancelMigrateBucket) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14988,7 +18916,7 @@ func (m *MsgCancelMigrateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelMigrateBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15011,10 +18939,10 @@ func (m *MsgCancelMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelMigrateBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketFlowRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketFlowRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15038,7 +18966,7 @@ func (m *MsgCancelMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectMigrateBucket) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketLifecycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15061,10 +18989,10 @@ func (m *MsgRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectMigrateBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketLifecycle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectMigrateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15131,6 +19059,40 @@ func (m *MsgRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, LifecycleRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15152,7 +19114,7 @@ func (m *MsgRejectMigrateBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectMigrateBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketLifecycleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15175,10 +19137,10 @@ func (m *MsgRejectMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectMigrateBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketLifecycleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15202,7 +19164,7 @@ func (m *MsgRejectMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketVersioning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15225,10 +19187,10 @@ func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTag: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketVersioning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketVersioning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15265,7 +19227,7 @@ func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15293,13 +19255,13 @@ func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVersions", wireType)
 			}
-			var msglen int
+			m.MaxVersions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15309,28 +19271,11 @@ func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxVersions |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Tags == nil {
-				m.Tags = &ResourceTags{}
-			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15352,7 +19297,7 @@ func (m *MsgSetTag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetTagResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketVersioningResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15375,10 +19320,10 @@ func (m *MsgSetTagResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTagResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketVersioningResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketVersioningResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15402,7 +19347,7 @@ func (m *MsgSetTagResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreObjectVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15425,10 +19370,10 @@ func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObjectContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreObjectVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreObjectVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15529,87 +19474,23 @@ func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var byteLen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15631,7 +19512,7 @@ func (m *MsgUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreObjectVersionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15654,10 +19535,10 @@ func (m *MsgUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObjectContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreObjectVersionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreObjectVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15681,7 +19562,7 @@ func (m *MsgUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketReadQuotaAutoTopup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15704,10 +19585,10 @@ func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelUpdateObjectContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketReadQuotaAutoTopup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketReadQuotaAutoTopup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15776,9 +19657,9 @@ func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoTopup", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15788,23 +19669,24 @@ func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			if err := m.AutoTopup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15827,7 +19709,7 @@ func (m *MsgCancelUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketReadQuotaAutoTopupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15850,10 +19732,10 @@ func (m *MsgCancelUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelUpdateObjectContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketReadQuotaAutoTopupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketReadQuotaAutoTopupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15877,7 +19759,7 @@ func (m *MsgCancelUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
+func (m *MsgReportReadQuotaConsumption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15900,10 +19782,10 @@ func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateCreateObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportReadQuotaConsumption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateCreateObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportReadQuotaConsumption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15939,38 +19821,6 @@ func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -16002,145 +19852,11 @@ func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
-			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumedReadQuota", wireType)
 			}
-			m.RedundancyType = 0
+			m.ConsumedReadQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -16150,7 +19866,7 @@ func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				m.ConsumedReadQuota |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16176,7 +19892,7 @@ func (m *MsgDelegateCreateObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateCreateObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportReadQuotaConsumptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16189,56 +19905,22 @@ func (m *MsgDelegateCreateObjectResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateCreateObjectResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateCreateObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportReadQuotaConsumptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportReadQuotaConsumptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16260,7 +19942,7 @@ func (m *MsgDelegateCreateObjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16283,10 +19965,10 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateUpdateObjectContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateUpdateObjectContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16322,38 +20004,6 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updater", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Updater = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
@@ -16385,62 +20035,11 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -16450,55 +20049,24 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectChecksums", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ExpectChecksums = append(m.ExpectChecksums, make([]byte, postIndex-iNdEx))
-			copy(m.ExpectChecksums[len(m.ExpectChecksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16521,7 +20089,7 @@ func (m *MsgDelegateUpdateObjectContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBucketCallbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16544,10 +20112,10 @@ func (m *MsgDelegateUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateUpdateObjectContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBucketCallbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateUpdateObjectContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBucketCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16571,7 +20139,7 @@ func (m *MsgDelegateUpdateObjectContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferBucketOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16594,10 +20162,10 @@ func (m *MsgToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferBucketOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferBucketOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16664,6 +20232,38 @@ func (m *MsgToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 			}
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16685,7 +20285,7 @@ func (m *MsgToggleSPAsDelegatedAgent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleSPAsDelegatedAgentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferBucketOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16708,10 +20308,10 @@ func (m *MsgToggleSPAsDelegatedAgentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferBucketOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleSPAsDelegatedAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferBucketOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16735,7 +20335,7 @@ func (m *MsgToggleSPAsDelegatedAgentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptBucketOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16758,10 +20358,10 @@ func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBucketFlowRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptBucketOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBucketFlowRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptBucketOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16829,38 +20429,6 @@ func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BucketOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
 			}
@@ -16892,40 +20460,6 @@ func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 			}
 			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16947,7 +20481,7 @@ func (m *MsgSetBucketFlowRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptBucketOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16970,10 +20504,10 @@ func (m *MsgSetBucketFlowRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBucketFlowRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptBucketOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBucketFlowRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptBucketOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
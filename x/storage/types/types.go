@@ -16,12 +16,23 @@ const (
 	TagKeyTraits       = "traits"
 	TagValueOmit       = "omit"
 	MaxPaginationLimit = 200 // the default limit is 200 if pagination parameters is not provided
+
+	// BillingStatementRetention is how long the billing statements are kept after their periods end, in seconds
+	BillingStatementRetention = 400 * 24 * 60 * 60
+	// MaxPrunedBillingStatements bounds the expired billing statements of a payment account pruned each time one of
+	// its billing periods is settled
+	MaxPrunedBillingStatements = 10
+	// MaxPaymentAuditBuckets bounds the buckets scanned by the payment audit query, the chains with more buckets
+	// are left to the payment check run by the node
+	MaxPaymentAuditBuckets = 10000
 )
 
 func (m *BucketInfo) ToNFTMetadata() *BucketMetaData {
 	return &BucketMetaData{
-		BucketName: m.BucketName,
-		Attributes: getNFTAttributes(*m),
+		Description: fmt.Sprintf("Bucket %s on Moca storage", m.BucketName),
+		BucketName:  m.BucketName,
+		Image:       nftImage("BUCKET", m.BucketName),
+		Attributes:  append(getNFTAttributes(*m), getNFTTagAttributes(m.Tags)...),
 	}
 }
 
@@ -36,15 +47,19 @@ func (m *BucketInfo) CheckBucketStatus() error {
 
 func (m *ObjectInfo) ToNFTMetadata() *ObjectMetaData {
 	return &ObjectMetaData{
-		ObjectName: m.ObjectName,
-		Attributes: getNFTAttributes(*m),
+		Description: fmt.Sprintf("Object %s in bucket %s on Moca storage", m.ObjectName, m.BucketName),
+		ObjectName:  m.ObjectName,
+		Image:       nftImage("OBJECT", m.ObjectName),
+		Attributes:  append(getNFTAttributes(*m), getNFTTagAttributes(m.Tags)...),
 	}
 }
 
 func (m *GroupInfo) ToNFTMetadata() *GroupMetaData {
 	return &GroupMetaData{
-		GroupName:  m.GroupName,
-		Attributes: getNFTAttributes(*m),
+		Description: fmt.Sprintf("Group %s on Moca storage", m.GroupName),
+		GroupName:   m.GroupName,
+		Image:       nftImage("GROUP", m.GroupName),
+		Attributes:  append(getNFTAttributes(*m), getNFTTagAttributes(m.Tags)...),
 	}
 }
 
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	resource "github.com/mocachain/moca/v2/types/resource"
 	_ "github.com/mocachain/moca/v2/x/payment/types"
 	io "io"
 	math "math"
@@ -28,6 +29,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PaymentDiscrepancyType is the kind of payment data which does not match the buckets and objects.
+type PaymentDiscrepancyType int32
+
+const (
+	PAYMENT_DISCREPANCY_TYPE_LOCK_BALANCE           PaymentDiscrepancyType = 0
+	PAYMENT_DISCREPANCY_TYPE_USER_NET_FLOW_RATE     PaymentDiscrepancyType = 1
+	PAYMENT_DISCREPANCY_TYPE_RECEIVER_NET_FLOW_RATE PaymentDiscrepancyType = 2
+)
+
+var PaymentDiscrepancyType_name = map[int32]string{
+	0: "PAYMENT_DISCREPANCY_TYPE_LOCK_BALANCE",
+	1: "PAYMENT_DISCREPANCY_TYPE_USER_NET_FLOW_RATE",
+	2: "PAYMENT_DISCREPANCY_TYPE_RECEIVER_NET_FLOW_RATE",
+}
+
+var PaymentDiscrepancyType_value = map[string]int32{
+	"PAYMENT_DISCREPANCY_TYPE_LOCK_BALANCE":           0,
+	"PAYMENT_DISCREPANCY_TYPE_USER_NET_FLOW_RATE":     1,
+	"PAYMENT_DISCREPANCY_TYPE_RECEIVER_NET_FLOW_RATE": 2,
+}
+
+func (x PaymentDiscrepancyType) String() string {
+	return proto.EnumName(PaymentDiscrepancyType_name, int32(x))
+}
+
+func (PaymentDiscrepancyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{0}
+}
+
 type BucketInfo struct {
 	// owner is the account address of bucket creator, it is also the bucket owner.
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -52,7 +82,7 @@ type BucketInfo struct {
 	// bucket_status define the status of the bucket.
 	BucketStatus BucketStatus `protobuf:"varint,10,opt,name=bucket_status,json=bucketStatus,proto3,enum=moca.storage.BucketStatus" json:"bucket_status,omitempty"`
 	// tags defines a list of tags the bucket has
-	Tags *ResourceTags `protobuf:"bytes,11,opt,name=tags,proto3" json:"tags,omitempty"`
+	Tags *ResourceTags `protobuf:"bytes,11,opt,name=tags,proto3" json:"tags,omitempty" traits:"omit"`
 	// sp_as_delegated_agent_disabled indicates that whether bucket owner disable SP as the upload agent.
 	// when a bucket is created, by default, this is false, means SP is allowed to create object for delegator
 	SpAsDelegatedAgentDisabled bool `protobuf:"varint,12,opt,name=sp_as_delegated_agent_disabled,json=spAsDelegatedAgentDisabled,proto3" json:"sp_as_delegated_agent_disabled,omitempty"`
@@ -270,7 +300,7 @@ type ObjectInfo struct {
 	// add omit tag to omit the field when converting to NFT metadata
 	Checksums [][]byte `protobuf:"bytes,14,rep,name=checksums,proto3" json:"checksums,omitempty" traits:"omit"`
 	// tags defines a list of tags the object has
-	Tags *ResourceTags `protobuf:"bytes,15,opt,name=tags,proto3" json:"tags,omitempty"`
+	Tags *ResourceTags `protobuf:"bytes,15,opt,name=tags,proto3" json:"tags,omitempty" traits:"omit"`
 	// is_updating indicates whether a object is being updated.
 	IsUpdating bool `protobuf:"varint,16,opt,name=is_updating,json=isUpdating,proto3" json:"is_updating,omitempty"`
 	// updated_at define the block timestamp when the object is updated. Will not be visible until object is re-sealed.
@@ -452,7 +482,7 @@ type GroupInfo struct {
 	// extra is used to store extra info for the group
 	Extra string `protobuf:"bytes,5,opt,name=extra,proto3" json:"extra,omitempty"`
 	// tags defines a list of tags the group has
-	Tags *ResourceTags `protobuf:"bytes,6,opt,name=tags,proto3" json:"tags,omitempty"`
+	Tags *ResourceTags `protobuf:"bytes,6,opt,name=tags,proto3" json:"tags,omitempty" traits:"omit"`
 }
 
 func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
@@ -1213,681 +1243,1031 @@ func (m *BucketExtraInfo) GetIsRateLimited() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*BucketInfo)(nil), "moca.storage.BucketInfo")
-	proto.RegisterType((*InternalBucketInfo)(nil), "moca.storage.InternalBucketInfo")
-	proto.RegisterType((*ObjectInfo)(nil), "moca.storage.ObjectInfo")
-	proto.RegisterType((*GroupInfo)(nil), "moca.storage.GroupInfo")
-	proto.RegisterType((*Trait)(nil), "moca.storage.Trait")
-	proto.RegisterType((*BucketMetaData)(nil), "moca.storage.BucketMetaData")
-	proto.RegisterType((*ObjectMetaData)(nil), "moca.storage.ObjectMetaData")
-	proto.RegisterType((*GroupMetaData)(nil), "moca.storage.GroupMetaData")
-	proto.RegisterType((*Ids)(nil), "moca.storage.Ids")
-	proto.RegisterType((*DeleteInfo)(nil), "moca.storage.DeleteInfo")
-	proto.RegisterType((*MigrationBucketInfo)(nil), "moca.storage.MigrationBucketInfo")
-	proto.RegisterType((*ResourceTags)(nil), "moca.storage.ResourceTags")
-	proto.RegisterType((*ResourceTags_Tag)(nil), "moca.storage.ResourceTags.Tag")
-	proto.RegisterType((*ShadowObjectInfo)(nil), "moca.storage.ShadowObjectInfo")
-	proto.RegisterType((*BucketExtraInfo)(nil), "moca.storage.BucketExtraInfo")
+// BucketPaymentDetail is the amount a bucket or a user stream contributes to the expected payment data of an account.
+type BucketPaymentDetail struct {
+	// bucket_name is the name of the bucket, empty for a user stream
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// amount is the lock balance or net flow rate expected from the bucket or the user stream
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// stream_id is the id of the user stream, zero for a bucket
+	StreamId uint64 `protobuf:"varint,3,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("moca/storage/types.proto", fileDescriptor_fa698cfb0287bc18) }
-
-var fileDescriptor_fa698cfb0287bc18 = []byte{
-	// 1514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5f, 0x6f, 0x13, 0xc7,
-	0x16, 0xcf, 0x7a, 0xe3, 0xc4, 0x3e, 0xb6, 0xe3, 0x64, 0x93, 0x7b, 0xef, 0x5e, 0x73, 0x71, 0x8c,
-	0x1f, 0xae, 0x2c, 0x04, 0x36, 0x84, 0x4a, 0xa8, 0x15, 0x14, 0x25, 0xe5, 0x8f, 0x2c, 0x41, 0x2b,
-	0x36, 0x01, 0x55, 0x55, 0xa5, 0xd5, 0x78, 0x77, 0xd8, 0x4c, 0xb3, 0xbb, 0xe3, 0xce, 0x8c, 0x43,
-	0x8c, 0xfa, 0x15, 0xaa, 0xf6, 0x23, 0xf4, 0xb1, 0xea, 0x53, 0x1f, 0xf8, 0x10, 0x54, 0xea, 0x03,
-	0xe2, 0xa5, 0x55, 0x1f, 0x50, 0x05, 0x0f, 0x7d, 0x2d, 0xfd, 0x04, 0xd5, 0xfc, 0xb1, 0x59, 0x3b,
-	0x49, 0x93, 0x20, 0x78, 0x89, 0x76, 0xce, 0x9f, 0x99, 0x39, 0xbf, 0x73, 0xce, 0xef, 0x8c, 0x03,
-	0x6e, 0x42, 0x03, 0xd4, 0xe1, 0x82, 0x32, 0x14, 0xe1, 0x8e, 0x18, 0xf6, 0x31, 0x6f, 0xf7, 0x19,
-	0x15, 0xd4, 0x29, 0x4b, 0x4d, 0xdb, 0x68, 0x6a, 0x4b, 0x28, 0x21, 0x29, 0xed, 0xa8, 0xbf, 0xda,
-	0xa0, 0x56, 0x0f, 0x28, 0x4f, 0x28, 0xef, 0xf4, 0x10, 0xc7, 0x9d, 0xdd, 0x8b, 0x3d, 0x2c, 0xd0,
-	0xc5, 0x4e, 0x40, 0x49, 0x6a, 0xf4, 0xff, 0xd5, 0x7a, 0x5f, 0xad, 0x3a, 0x7a, 0x61, 0x54, 0x2b,
-	0x11, 0x8d, 0xa8, 0x96, 0xcb, 0x2f, 0x23, 0x3d, 0xa5, 0xee, 0xd2, 0x47, 0xc3, 0x04, 0xa7, 0xa2,
-	0x43, 0x07, 0xc2, 0x7f, 0x10, 0xd3, 0x87, 0x46, 0xd9, 0x98, 0x50, 0x72, 0xc1, 0x30, 0x4a, 0x7c,
-	0x86, 0x03, 0xca, 0xc2, 0xd1, 0x79, 0x13, 0xa1, 0x04, 0x34, 0x49, 0xa8, 0xb9, 0x4a, 0xf3, 0x9b,
-	0x3c, 0xc0, 0xc6, 0x20, 0xd8, 0xc1, 0xa2, 0x9b, 0x3e, 0xa0, 0x4e, 0x1b, 0xf2, 0xf4, 0x61, 0x8a,
-	0x99, 0x6b, 0x35, 0xac, 0x56, 0x71, 0xc3, 0x7d, 0xf6, 0xf8, 0xfc, 0x8a, 0xb9, 0xdf, 0x7a, 0x18,
-	0x32, 0xcc, 0xf9, 0xa6, 0x60, 0x24, 0x8d, 0x3c, 0x6d, 0xe6, 0xac, 0x42, 0xa9, 0xa7, 0xbc, 0xfd,
-	0x14, 0x25, 0xd8, 0xcd, 0x49, 0x2f, 0x0f, 0xb4, 0xe8, 0x63, 0x94, 0x60, 0xe7, 0x0a, 0xc0, 0x2e,
-	0xe1, 0xa4, 0x47, 0x62, 0x22, 0x86, 0xae, 0xdd, 0xb0, 0x5a, 0x0b, 0x6b, 0xff, 0x6b, 0x67, 0x01,
-	0x6c, 0xdf, 0x1f, 0xeb, 0xb7, 0x86, 0x7d, 0xec, 0x65, 0xec, 0x9d, 0x0b, 0x90, 0x23, 0xa1, 0x3b,
-	0xab, 0xee, 0xd2, 0x78, 0xf2, 0x7c, 0x75, 0xe6, 0xb7, 0xe7, 0xab, 0xb3, 0xf7, 0x48, 0x2a, 0x9e,
-	0x3d, 0x3e, 0x5f, 0x32, 0xf7, 0x92, 0xcb, 0xef, 0xff, 0xf8, 0xf1, 0xac, 0xe5, 0xe5, 0x48, 0xe8,
-	0xbc, 0x0f, 0x25, 0x4e, 0x07, 0x2c, 0xc0, 0xbe, 0xcc, 0x98, 0x9b, 0x57, 0x07, 0xba, 0x93, 0x07,
-	0x6e, 0x2a, 0x03, 0x7d, 0x18, 0x1f, 0x7f, 0x3b, 0xa7, 0xa0, 0x18, 0x30, 0x8c, 0x04, 0xf6, 0x91,
-	0x70, 0xe7, 0x1a, 0x56, 0xcb, 0xf6, 0x0a, 0x5a, 0xb0, 0x2e, 0x9c, 0x75, 0xa8, 0x1a, 0x84, 0x7d,
-	0xa4, 0x81, 0x70, 0xe7, 0x8f, 0x80, 0x68, 0xc1, 0x38, 0x18, 0xa9, 0xb3, 0x01, 0xf5, 0x28, 0xa6,
-	0x3d, 0x14, 0xfb, 0xbb, 0x84, 0x89, 0x01, 0x8a, 0xfd, 0x88, 0xd1, 0x41, 0xdf, 0x7f, 0x80, 0x12,
-	0x12, 0x0f, 0x7d, 0x12, 0xba, 0x85, 0x86, 0xd5, 0xaa, 0x78, 0x35, 0x6d, 0x75, 0x5f, 0x1b, 0xdd,
-	0x92, 0x36, 0x37, 0x95, 0x49, 0x37, 0x74, 0xce, 0x81, 0x13, 0x6c, 0x23, 0x16, 0xe1, 0xd0, 0x67,
-	0x18, 0x85, 0xfe, 0x97, 0x03, 0x2a, 0x90, 0x5b, 0x6c, 0x58, 0xad, 0x59, 0x6f, 0xd1, 0x68, 0x3c,
-	0x8c, 0xc2, 0xbb, 0x52, 0xee, 0x5c, 0x83, 0x8a, 0xc9, 0x0e, 0x17, 0x48, 0x0c, 0xb8, 0x0b, 0x0a,
-	0x8e, 0xda, 0x24, 0x1c, 0x3a, 0xfd, 0x9b, 0xca, 0xc2, 0x2b, 0xf7, 0x32, 0x2b, 0xa7, 0x0d, 0xb3,
-	0x02, 0x45, 0xdc, 0x2d, 0x35, 0xac, 0x56, 0x69, 0xda, 0xcf, 0xc3, 0x06, 0x3c, 0x14, 0x71, 0x4f,
-	0xd9, 0xc9, 0x10, 0x79, 0xdf, 0x47, 0xdc, 0x0f, 0x71, 0x8c, 0x23, 0x24, 0x70, 0xe8, 0xa3, 0x48,
-	0x62, 0x16, 0x12, 0x8e, 0x7a, 0x31, 0x0e, 0xdd, 0x72, 0xc3, 0x6a, 0x15, 0xbc, 0x1a, 0xef, 0xaf,
-	0xf3, 0xeb, 0x23, 0x9b, 0x75, 0x69, 0x72, 0xdd, 0x58, 0x34, 0xff, 0xb4, 0xc0, 0xe9, 0xa6, 0x02,
-	0xb3, 0x14, 0xc5, 0x99, 0xca, 0x3c, 0x0d, 0xd0, 0x67, 0x44, 0xe6, 0x95, 0x24, 0x58, 0x95, 0xa7,
-	0xed, 0x15, 0x95, 0x64, 0x8b, 0x24, 0xd8, 0x39, 0x0b, 0x4b, 0x82, 0x0a, 0x14, 0xfb, 0x1a, 0x04,
-	0x9f, 0x93, 0x47, 0xba, 0x1c, 0x67, 0xbd, 0xaa, 0x52, 0x7c, 0xa4, 0xe4, 0x9b, 0xe4, 0x11, 0x76,
-	0xee, 0xc2, 0x4a, 0x4c, 0x83, 0xe9, 0x3c, 0x70, 0xd7, 0x6e, 0xd8, 0xad, 0xd2, 0xda, 0xea, 0x64,
-	0x94, 0xb7, 0xa5, 0x65, 0x36, 0x17, 0x9e, 0x13, 0x4f, 0x8b, 0xb8, 0x73, 0x05, 0x4e, 0xa5, 0x78,
-	0x4f, 0xf8, 0x07, 0xec, 0xeb, 0x9b, 0x0a, 0xae, 0x78, 0xff, 0x91, 0x26, 0xfb, 0xf6, 0xeb, 0x86,
-	0xcd, 0x57, 0x73, 0x00, 0x9f, 0xf4, 0xbe, 0xc0, 0xc1, 0x9b, 0x35, 0xe1, 0x1a, 0xcc, 0xab, 0x3a,
-	0xa5, 0x4c, 0x37, 0xe0, 0x3f, 0x78, 0x8c, 0x0c, 0xa7, 0x1b, 0xd7, 0xde, 0xd7, 0xb8, 0xab, 0x50,
-	0xa2, 0xea, 0x4a, 0xda, 0x60, 0x56, 0x1b, 0x68, 0x91, 0x32, 0xd0, 0xbd, 0x99, 0x3f, 0x41, 0x6f,
-	0x5e, 0x82, 0x7f, 0x1f, 0x82, 0xcf, 0x9c, 0xc2, 0x67, 0x39, 0xde, 0x8f, 0x8d, 0x73, 0x06, 0xca,
-	0x7d, 0x34, 0x8c, 0x29, 0x0a, 0x75, 0x4e, 0xe7, 0x55, 0x4e, 0x4b, 0x46, 0xa6, 0xf2, 0x39, 0xc9,
-	0x31, 0x85, 0x13, 0x72, 0xcc, 0x19, 0x28, 0x07, 0x34, 0x15, 0xb2, 0x4a, 0x15, 0x65, 0x14, 0x55,
-	0xa4, 0x25, 0x23, 0xdb, 0xcf, 0x0c, 0x30, 0xc5, 0x0c, 0xd7, 0xa0, 0x62, 0x80, 0x32, 0x4d, 0x56,
-	0x3a, 0xa8, 0xc9, 0x74, 0x7a, 0x47, 0x4d, 0x46, 0x33, 0x2b, 0xe7, 0x06, 0x54, 0x19, 0x0e, 0x07,
-	0x69, 0x88, 0xd2, 0x60, 0xa8, 0xef, 0x50, 0x3e, 0x28, 0x06, 0x6f, 0x6c, 0xa4, 0x62, 0x58, 0x60,
-	0x13, 0xeb, 0x69, 0xe6, 0xab, 0x9c, 0x80, 0xf9, 0x3a, 0x50, 0x0c, 0xb6, 0x71, 0xb0, 0xc3, 0x07,
-	0x09, 0x77, 0x17, 0x1a, 0x76, 0xab, 0xbc, 0xb1, 0xf4, 0xd7, 0xf3, 0xd5, 0x8a, 0x60, 0x88, 0x08,
-	0xfe, 0x41, 0x93, 0x26, 0x44, 0x34, 0xbd, 0xd7, 0x36, 0x63, 0x5e, 0xa8, 0x1e, 0x93, 0x17, 0x56,
-	0xa1, 0x44, 0xb8, 0x3f, 0xe8, 0x87, 0x48, 0x90, 0x34, 0x72, 0x17, 0x15, 0x09, 0x00, 0xe1, 0xf7,
-	0x8c, 0x44, 0x76, 0xb7, 0xd2, 0x4a, 0xc2, 0x10, 0xee, 0x92, 0xee, 0x6e, 0x23, 0x59, 0x17, 0xce,
-	0xe5, 0xd7, 0xea, 0xde, 0xd0, 0x75, 0x8e, 0x28, 0xf2, 0x91, 0xe3, 0xc6, 0xd0, 0x71, 0x61, 0x7e,
-	0x17, 0x33, 0x4e, 0x68, 0xea, 0x2e, 0xab, 0x4d, 0x47, 0xcb, 0xe6, 0xd7, 0x39, 0x28, 0xea, 0x1a,
-	0x7b, 0x93, 0x96, 0x3b, 0x0d, 0xa0, 0x8b, 0x37, 0x33, 0xf6, 0x8a, 0x4a, 0xa2, 0x7a, 0x63, 0x2a,
-	0x17, 0xf6, 0x09, 0x72, 0x71, 0xf2, 0x91, 0xb7, 0x02, 0x79, 0xbc, 0x27, 0x18, 0xd2, 0xbd, 0xe8,
-	0xe9, 0xc5, 0x38, 0x45, 0x73, 0xc7, 0x4b, 0x51, 0xf3, 0x0a, 0xe4, 0xb7, 0x64, 0xba, 0x65, 0x68,
-	0x2a, 0xef, 0xfa, 0xea, 0x96, 0x0e, 0x4d, 0x49, 0xd4, 0xfd, 0x56, 0x20, 0xbf, 0x8b, 0xe2, 0xc1,
-	0x28, 0x68, 0xbd, 0x68, 0xfe, 0x6c, 0xc1, 0x82, 0x26, 0xeb, 0x3b, 0x58, 0xa0, 0xeb, 0x48, 0x20,
-	0xa7, 0x01, 0xa5, 0x10, 0xf3, 0x80, 0x91, 0xbe, 0x90, 0xf0, 0xeb, 0x8d, 0xb2, 0x22, 0xd9, 0x79,
-	0x78, 0x4f, 0x13, 0xbd, 0x3f, 0x60, 0xb1, 0xd9, 0xb1, 0x34, 0x92, 0xdd, 0x63, 0xf1, 0xd1, 0x34,
-	0xb5, 0x02, 0x79, 0x92, 0xa0, 0x68, 0x44, 0x50, 0x7a, 0xe1, 0x7c, 0x08, 0x80, 0x84, 0x60, 0xa4,
-	0x37, 0x10, 0x98, 0xbb, 0x79, 0xc5, 0xeb, 0xcb, 0x93, 0x10, 0xa8, 0x60, 0x37, 0x8a, 0x12, 0x61,
-	0x0d, 0x65, 0xc6, 0x43, 0x85, 0xa3, 0x3b, 0xf6, 0xad, 0x87, 0x93, 0x25, 0x55, 0x7b, 0x1f, 0xa9,
-	0xbe, 0x9b, 0x70, 0x7e, 0xb2, 0xa0, 0xa2, 0x6a, 0xfd, 0xed, 0x46, 0x33, 0xd9, 0x04, 0xf6, 0x74,
-	0x13, 0xbc, 0x9b, 0x58, 0x2e, 0x83, 0xdd, 0x0d, 0xb9, 0x69, 0x13, 0xab, 0x61, 0x1f, 0xb7, 0x4d,
-	0x9a, 0xdf, 0x59, 0x00, 0xf2, 0xc9, 0x21, 0xb0, 0xea, 0xf8, 0x0b, 0x60, 0xca, 0xc8, 0x27, 0x21,
-	0x57, 0x00, 0x94, 0xd6, 0x96, 0x26, 0xef, 0xd1, 0x0d, 0xb9, 0x57, 0xd4, 0x46, 0xfa, 0x48, 0x93,
-	0x29, 0xe5, 0x91, 0x3b, 0xd4, 0x43, 0x1b, 0x49, 0x8f, 0x36, 0x14, 0x47, 0x23, 0x8e, 0x2b, 0x7c,
-	0x0e, 0x74, 0x28, 0x44, 0x7a, 0xd4, 0xf1, 0xe6, 0x2f, 0x16, 0x2c, 0xdf, 0x21, 0x11, 0x43, 0x32,
-	0x03, 0x99, 0xb7, 0x4f, 0x0d, 0x8a, 0x9c, 0x05, 0x3e, 0x57, 0xb3, 0xd2, 0x52, 0xb3, 0x72, 0x9e,
-	0xb3, 0x60, 0x53, 0xce, 0xc7, 0x2e, 0x34, 0xa5, 0xee, 0x88, 0x97, 0x65, 0x4e, 0x39, 0x9d, 0xe6,
-	0x2c, 0xb8, 0x75, 0xf8, 0xe3, 0xb2, 0x06, 0xc5, 0x90, 0x0b, 0x73, 0x8c, 0xad, 0x8f, 0x09, 0xb9,
-	0x50, 0xc7, 0x5c, 0x85, 0xe2, 0x18, 0xae, 0x63, 0xb3, 0x53, 0x61, 0x04, 0x5e, 0xf3, 0x2b, 0x28,
-	0x67, 0x39, 0xc7, 0xb9, 0x6a, 0xd8, 0xc9, 0x52, 0xf9, 0xaf, 0x1f, 0xce, 0x4e, 0xed, 0x2d, 0x14,
-	0x65, 0x4b, 0x41, 0xb9, 0xd5, 0xce, 0x83, 0xbd, 0x85, 0x22, 0x67, 0x11, 0xec, 0x1d, 0x3c, 0x34,
-	0xd5, 0x2b, 0x3f, 0x0f, 0x61, 0xa7, 0x1f, 0x72, 0xb0, 0xb8, 0xb9, 0x8d, 0x42, 0xfa, 0x30, 0xf3,
-	0xca, 0x7a, 0x0f, 0x0a, 0xb4, 0x8f, 0x99, 0x7a, 0x36, 0x1d, 0xc5, 0xfa, 0x63, 0x4b, 0x53, 0x77,
-	0xb9, 0x13, 0xd0, 0xf3, 0xf4, 0xfb, 0xc2, 0xde, 0xff, 0xbe, 0x98, 0x7e, 0xe3, 0xcc, 0xee, 0x7f,
-	0xe3, 0x4c, 0x8c, 0xe8, 0xfc, 0x31, 0x46, 0xf4, 0xe4, 0x44, 0x9d, 0x9b, 0x9e, 0xa8, 0x99, 0xc1,
-	0x38, 0x3f, 0x39, 0x18, 0x5f, 0x59, 0x50, 0xd5, 0xb5, 0x77, 0x43, 0x0e, 0x12, 0x85, 0xd5, 0xff,
-	0xa1, 0x4a, 0xb8, 0xcf, 0xe4, 0x0b, 0x28, 0x26, 0x09, 0x11, 0x58, 0x97, 0x61, 0xc1, 0xab, 0x10,
-	0xee, 0x21, 0x81, 0x6f, 0x6b, 0xa1, 0xf3, 0x29, 0x54, 0xe5, 0x0f, 0xd3, 0x8c, 0xa5, 0x81, 0xea,
-	0x82, 0x81, 0xea, 0x5f, 0x1a, 0x22, 0x1e, 0xee, 0xb4, 0x09, 0xed, 0x24, 0x48, 0x6c, 0xb7, 0xbb,
-	0x0a, 0x3b, 0x30, 0xd8, 0x75, 0x47, 0xd0, 0x55, 0xe4, 0x46, 0xe3, 0xbd, 0x9d, 0xcf, 0x61, 0x29,
-	0x18, 0x30, 0x26, 0x51, 0x1c, 0x9f, 0xa0, 0xa1, 0x7c, 0x83, 0xbd, 0xab, 0x66, 0xab, 0x9b, 0xe6,
-	0x88, 0x8d, 0x9b, 0x4f, 0x5e, 0xd4, 0xad, 0xa7, 0x2f, 0xea, 0xd6, 0xef, 0x2f, 0xea, 0xd6, 0xb7,
-	0x2f, 0xeb, 0x33, 0x4f, 0x5f, 0xd6, 0x67, 0x7e, 0x7d, 0x59, 0x9f, 0xf9, 0xec, 0x5c, 0x44, 0xc4,
-	0xf6, 0xa0, 0xd7, 0x0e, 0x68, 0xd2, 0x91, 0x45, 0x1a, 0x6c, 0x23, 0x92, 0xaa, 0xaf, 0xce, 0xee,
-	0x5a, 0x67, 0x6f, 0xf2, 0xff, 0x03, 0xbd, 0x39, 0xf5, 0xa3, 0xfa, 0xd2, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x8a, 0x2e, 0xea, 0x3f, 0x3c, 0x10, 0x00, 0x00,
+func (m *BucketPaymentDetail) Reset()         { *m = BucketPaymentDetail{} }
+func (m *BucketPaymentDetail) String() string { return proto.CompactTextString(m) }
+func (*BucketPaymentDetail) ProtoMessage()    {}
+func (*BucketPaymentDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{14}
 }
-
-func (m *BucketInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BucketPaymentDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BucketPaymentDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BucketPaymentDetail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BucketInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BucketPaymentDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketPaymentDetail.Merge(m, src)
+}
+func (m *BucketPaymentDetail) XXX_Size() int {
+	return m.Size()
+}
+func (m *BucketPaymentDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketPaymentDetail.DiscardUnknown(m)
 }
 
-func (m *BucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SpAsDelegatedAgentDisabled {
-		i--
-		if m.SpAsDelegatedAgentDisabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.Tags != nil {
-		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.BucketStatus != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BucketStatus))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.ChargedReadQuota != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ChargedReadQuota))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.PaymentAddress) > 0 {
-		i -= len(m.PaymentAddress)
-		copy(dAtA[i:], m.PaymentAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PaymentAddress)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.CreateAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CreateAt))
-		i--
-		dAtA[i] = 0x30
+var xxx_messageInfo_BucketPaymentDetail proto.InternalMessageInfo
+
+func (m *BucketPaymentDetail) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	if m.SourceType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *BucketPaymentDetail) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
 	}
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// PaymentDiscrepancy is a mismatch between the stream record of an account and
+// the payment data expected from the buckets and objects.
+type PaymentDiscrepancy struct {
+	// address is the payment account, gvg family, gvg or validator tax pool address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// discrepancy_type defines which payment data mismatches
+	DiscrepancyType PaymentDiscrepancyType `protobuf:"varint,2,opt,name=discrepancy_type,json=discrepancyType,proto3,enum=moca.storage.PaymentDiscrepancyType" json:"discrepancy_type,omitempty"`
+	// reason describes the mismatch
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// expected is the lock balance or net flow rate expected from the buckets
+	Expected cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=expected,proto3,customtype=cosmossdk.io/math.Int" json:"expected"`
+	// actual is the lock balance or net flow rate of the stream record
+	Actual cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=actual,proto3,customtype=cosmossdk.io/math.Int" json:"actual"`
+	// buckets are the buckets behind the expected amount
+	Buckets []BucketPaymentDetail `protobuf:"bytes,6,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *PaymentDiscrepancy) Reset()         { *m = PaymentDiscrepancy{} }
+func (m *PaymentDiscrepancy) String() string { return proto.CompactTextString(m) }
+func (*PaymentDiscrepancy) ProtoMessage()    {}
+func (*PaymentDiscrepancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{15}
+}
+func (m *PaymentDiscrepancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentDiscrepancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentDiscrepancy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.Visibility != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *PaymentDiscrepancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentDiscrepancy.Merge(m, src)
+}
+func (m *PaymentDiscrepancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentDiscrepancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentDiscrepancy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentDiscrepancy proto.InternalMessageInfo
+
+func (m *PaymentDiscrepancy) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *PaymentDiscrepancy) GetDiscrepancyType() PaymentDiscrepancyType {
+	if m != nil {
+		return m.DiscrepancyType
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return PAYMENT_DISCREPANCY_TYPE_LOCK_BALANCE
+}
+
+func (m *PaymentDiscrepancy) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *InternalBucketInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PaymentDiscrepancy) GetBuckets() []BucketPaymentDetail {
+	if m != nil {
+		return m.Buckets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *InternalBucketInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LifecycleRule defines which objects of a bucket expire and when.
+type LifecycleRule struct {
+	// id is the identifier of the rule, unique within the bucket
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// prefix restricts the rule to the objects whose name starts with it, empty matches all objects
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// tag restricts the rule to the objects carrying the tag, a nil tag matches all objects
+	Tag *ResourceTags_Tag `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	// expiration_days is the number of days after creation the matching objects are deleted
+	ExpirationDays uint32 `protobuf:"varint,4,opt,name=expiration_days,json=expirationDays,proto3" json:"expiration_days,omitempty"`
 }
 
-func (m *InternalBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextLocalVirtualGroupId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.NextLocalVirtualGroupId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.LocalVirtualGroups) > 0 {
-		for iNdEx := len(m.LocalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LocalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *LifecycleRule) Reset()         { *m = LifecycleRule{} }
+func (m *LifecycleRule) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule) ProtoMessage()    {}
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{16}
+}
+func (m *LifecycleRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LifecycleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LifecycleRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TotalChargeSize != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TotalChargeSize))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *LifecycleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule.Merge(m, src)
+}
+func (m *LifecycleRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *LifecycleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleRule proto.InternalMessageInfo
+
+func (m *LifecycleRule) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.PriceTime != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PriceTime))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *LifecycleRule) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LifecycleRule) GetTag() *ResourceTags_Tag {
+	if m != nil {
+		return m.Tag
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ObjectInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LifecycleRule) GetExpirationDays() uint32 {
+	if m != nil {
+		return m.ExpirationDays
+	}
+	return 0
 }
 
-func (m *ObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
-	}
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
-	}
-	if m.UpdatedAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedAt))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	if m.IsUpdating {
-		i--
-		if m.IsUpdating {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// BucketLifecycle is the set of lifecycle rules attached to a bucket.
+type BucketLifecycle struct {
+	// rules are the lifecycle rules of the bucket
+	Rules []LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+}
+
+func (m *BucketLifecycle) Reset()         { *m = BucketLifecycle{} }
+func (m *BucketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*BucketLifecycle) ProtoMessage()    {}
+func (*BucketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{17}
+}
+func (m *BucketLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BucketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BucketLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+		return b[:n], nil
 	}
-	if m.Tags != nil {
-		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
+}
+func (m *BucketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketLifecycle.Merge(m, src)
+}
+func (m *BucketLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *BucketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketLifecycle proto.InternalMessageInfo
+
+func (m *BucketLifecycle) GetRules() []LifecycleRule {
+	if m != nil {
+		return m.Rules
 	}
-	if len(m.Checksums) > 0 {
-		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Checksums[iNdEx])
-			copy(dAtA[i:], m.Checksums[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksums[iNdEx])))
-			i--
-			dAtA[i] = 0x72
+	return nil
+}
+
+// ObjectVersion is a prior content of an object kept by a bucket with versioning enabled.
+type ObjectVersion struct {
+	// version is the version of the object content
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// payload_size is the total size of the object payload
+	PayloadSize uint64 `protobuf:"varint,2,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	// checksums define the root hash of the pieces which stored in a SP.
+	Checksums [][]byte `protobuf:"bytes,3,rep,name=checksums,proto3" json:"checksums,omitempty" traits:"omit"`
+	// content_type define the content type of the payload data
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// updated_at define the block timestamp when the content was uploaded
+	UpdatedAt int64 `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// updated_by defines the account address of the uploader of the content
+	UpdatedBy string `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// local_virtual_group_id defines the local virtual group the content is stored and charged on
+	LocalVirtualGroupId uint32 `protobuf:"varint,7,opt,name=local_virtual_group_id,json=localVirtualGroupId,proto3" json:"local_virtual_group_id,omitempty"`
+}
+
+func (m *ObjectVersion) Reset()         { *m = ObjectVersion{} }
+func (m *ObjectVersion) String() string { return proto.CompactTextString(m) }
+func (*ObjectVersion) ProtoMessage()    {}
+func (*ObjectVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{18}
+}
+func (m *ObjectVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SourceType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.RedundancyType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyType))
-		i--
-		dAtA[i] = 0x60
+}
+func (m *ObjectVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectVersion.Merge(m, src)
+}
+func (m *ObjectVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectVersion proto.InternalMessageInfo
+
+func (m *ObjectVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	if m.ObjectStatus != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ObjectStatus))
-		i--
-		dAtA[i] = 0x58
+	return 0
+}
+
+func (m *ObjectVersion) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
 	}
-	if m.CreateAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CreateAt))
-		i--
-		dAtA[i] = 0x50
+	return 0
+}
+
+func (m *ObjectVersion) GetChecksums() [][]byte {
+	if m != nil {
+		return m.Checksums
 	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0x4a
+	return nil
+}
+
+func (m *ObjectVersion) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
-	if m.Visibility != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Visibility))
-		i--
-		dAtA[i] = 0x40
+	return ""
+}
+
+func (m *ObjectVersion) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PayloadSize))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *ObjectVersion) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	if m.LocalVirtualGroupId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.LocalVirtualGroupId))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *ObjectVersion) GetLocalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.LocalVirtualGroupId
 	}
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// ObjectVersions is the version history of an object, ordered from the oldest to the latest.
+type ObjectVersions struct {
+	Versions []ObjectVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+}
+
+func (m *ObjectVersions) Reset()         { *m = ObjectVersions{} }
+func (m *ObjectVersions) String() string { return proto.CompactTextString(m) }
+func (*ObjectVersions) ProtoMessage()    {}
+func (*ObjectVersions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{19}
+}
+func (m *ObjectVersions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectVersions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectVersions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *ObjectVersions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectVersions.Merge(m, src)
+}
+func (m *ObjectVersions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectVersions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectVersions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectVersions proto.InternalMessageInfo
+
+func (m *ObjectVersions) GetVersions() []ObjectVersion {
+	if m != nil {
+		return m.Versions
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+// TaggedResource is a bucket, object or group found by one of its tags.
+type TaggedResource struct {
+	ResourceType resource.ResourceType `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=moca.resource.ResourceType" json:"resource_type,omitempty"`
+	ResourceId   Uint                  `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3,customtype=Uint" json:"resource_id"`
+	// grn defines the moca resource name of the resource
+	Grn string `protobuf:"bytes,3,opt,name=grn,proto3" json:"grn,omitempty"`
+}
+
+func (m *TaggedResource) Reset()         { *m = TaggedResource{} }
+func (m *TaggedResource) String() string { return proto.CompactTextString(m) }
+func (*TaggedResource) ProtoMessage()    {}
+func (*TaggedResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{20}
+}
+func (m *TaggedResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaggedResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaggedResource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TaggedResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaggedResource.Merge(m, src)
+}
+func (m *TaggedResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaggedResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaggedResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaggedResource proto.InternalMessageInfo
+
+func (m *TaggedResource) GetResourceType() resource.ResourceType {
+	if m != nil {
+		return m.ResourceType
 	}
-	return len(dAtA) - i, nil
+	return resource.RESOURCE_TYPE_UNSPECIFIED
 }
 
-func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaggedResource) GetGrn() string {
+	if m != nil {
+		return m.Grn
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ReadQuotaAutoTopup defines how the charged read quota of a bucket is raised as it is consumed.
+type ReadQuotaAutoTopup struct {
+	// max_read_quota defines the ceiling the charged read quota is raised up to, in bytes
+	MaxReadQuota uint64 `protobuf:"varint,1,opt,name=max_read_quota,json=maxReadQuota,proto3" json:"max_read_quota,omitempty"`
+	// threshold_percent defines the percentage of the charged read quota whose consumption triggers a topup, a bucket
+	// without charged read quota is not topped up
+	ThresholdPercent uint32 `protobuf:"varint,2,opt,name=threshold_percent,json=thresholdPercent,proto3" json:"threshold_percent,omitempty"`
+	// topup_size defines how much the charged read quota is raised by at a time, in bytes
+	TopupSize uint64 `protobuf:"varint,3,opt,name=topup_size,json=topupSize,proto3" json:"topup_size,omitempty"`
 }
 
-func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Tags != nil {
-		{
-			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Extra) > 0 {
-		i -= len(m.Extra)
-		copy(dAtA[i:], m.Extra)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extra)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ReadQuotaAutoTopup) Reset()         { *m = ReadQuotaAutoTopup{} }
+func (m *ReadQuotaAutoTopup) String() string { return proto.CompactTextString(m) }
+func (*ReadQuotaAutoTopup) ProtoMessage()    {}
+func (*ReadQuotaAutoTopup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{21}
+}
+func (m *ReadQuotaAutoTopup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadQuotaAutoTopup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadQuotaAutoTopup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.SourceType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ReadQuotaAutoTopup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadQuotaAutoTopup.Merge(m, src)
+}
+func (m *ReadQuotaAutoTopup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadQuotaAutoTopup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadQuotaAutoTopup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadQuotaAutoTopup proto.InternalMessageInfo
+
+func (m *ReadQuotaAutoTopup) GetMaxReadQuota() uint64 {
+	if m != nil {
+		return m.MaxReadQuota
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ReadQuotaAutoTopup) GetThresholdPercent() uint32 {
+	if m != nil {
+		return m.ThresholdPercent
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Trait) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReadQuotaAutoTopup) GetTopupSize() uint64 {
+	if m != nil {
+		return m.TopupSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Trait) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ReadQuotaConsumption is the charged read quota consumed by a bucket in a month, as reported by its primary SP.
+type ReadQuotaConsumption struct {
+	// month defines the UTC month of the consumption, in the form of yyyymm
+	Month uint32 `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	// consumed_read_quota defines the charged read quota consumed since the beginning of the month, in bytes
+	ConsumedReadQuota uint64 `protobuf:"varint,2,opt,name=consumed_read_quota,json=consumedReadQuota,proto3" json:"consumed_read_quota,omitempty"`
+	// reported_at defines the block timestamp of the latest report
+	ReportedAt int64 `protobuf:"varint,3,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	// reported_by defines the operator address of the primary SP which reported the consumption
+	ReportedBy string `protobuf:"bytes,4,opt,name=reported_by,json=reportedBy,proto3" json:"reported_by,omitempty"`
 }
 
-func (m *Trait) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+func (m *ReadQuotaConsumption) Reset()         { *m = ReadQuotaConsumption{} }
+func (m *ReadQuotaConsumption) String() string { return proto.CompactTextString(m) }
+func (*ReadQuotaConsumption) ProtoMessage()    {}
+func (*ReadQuotaConsumption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{22}
+}
+func (m *ReadQuotaConsumption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadQuotaConsumption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadQuotaConsumption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.TraitType) > 0 {
-		i -= len(m.TraitType)
-		copy(dAtA[i:], m.TraitType)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TraitType)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ReadQuotaConsumption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadQuotaConsumption.Merge(m, src)
+}
+func (m *ReadQuotaConsumption) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadQuotaConsumption) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadQuotaConsumption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadQuotaConsumption proto.InternalMessageInfo
+
+func (m *ReadQuotaConsumption) GetMonth() uint32 {
+	if m != nil {
+		return m.Month
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BucketMetaData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReadQuotaConsumption) GetConsumedReadQuota() uint64 {
+	if m != nil {
+		return m.ConsumedReadQuota
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BucketMetaData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReadQuotaConsumption) GetReportedAt() int64 {
+	if m != nil {
+		return m.ReportedAt
+	}
+	return 0
 }
 
-func (m *BucketMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Attributes) > 0 {
-		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+func (m *ReadQuotaConsumption) GetReportedBy() string {
+	if m != nil {
+		return m.ReportedBy
+	}
+	return ""
+}
+
+// BillingStatement is the charge of a bucket to its payment account over a billing period, during which the bill of
+// the bucket is unchanged. A period ends whenever the bill of the bucket changes, as the payment account is settled.
+type BillingStatement struct {
+	// payment_address defines the payment account charged for the bucket
+	PaymentAddress string `protobuf:"bytes,1,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	// bucket_id defines the id of the bucket
+	BucketId Uint `protobuf:"bytes,2,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// bucket_name defines the name of the bucket
+	BucketName string `protobuf:"bytes,3,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// start_time defines the timestamp the billing period starts at
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time defines the timestamp the billing period ends at, it is the block time for an open period
+	EndTime int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// charge_size defines the total charge size of the objects in the bucket, in bytes
+	ChargeSize uint64 `protobuf:"varint,6,opt,name=charge_size,json=chargeSize,proto3" json:"charge_size,omitempty"`
+	// charged_read_quota defines the read quota the bucket is charged for, in bytes
+	ChargedReadQuota uint64 `protobuf:"varint,7,opt,name=charged_read_quota,json=chargedReadQuota,proto3" json:"charged_read_quota,omitempty"`
+	// primary_store_price defines the primary SP store price in effect, in amoca wei per charge byte per second
+	PrimaryStorePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=primary_store_price,json=primaryStorePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"primary_store_price"`
+	// secondary_store_price defines the secondary SP store price in effect, in amoca wei per charge byte per second
+	SecondaryStorePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=secondary_store_price,json=secondaryStorePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"secondary_store_price"`
+	// read_price defines the read price in effect, in amoca wei per charge byte per second
+	ReadPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=read_price,json=readPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"read_price"`
+	// validator_tax_rate defines the validator tax rate in effect
+	ValidatorTaxRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=validator_tax_rate,json=validatorTaxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_tax_rate"`
+	// store_flow_rate defines the flow rate paid to the primary and secondary SPs for storing the objects
+	StoreFlowRate cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=store_flow_rate,json=storeFlowRate,proto3,customtype=cosmossdk.io/math.Int" json:"store_flow_rate"`
+	// read_flow_rate defines the flow rate paid to the primary SP for the charged read quota
+	ReadFlowRate cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=read_flow_rate,json=readFlowRate,proto3,customtype=cosmossdk.io/math.Int" json:"read_flow_rate"`
+	// validator_tax_flow_rate defines the flow rate paid to the validator tax pool
+	ValidatorTaxFlowRate cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=validator_tax_flow_rate,json=validatorTaxFlowRate,proto3,customtype=cosmossdk.io/math.Int" json:"validator_tax_flow_rate"`
+	// store_fee defines the amount paid for storing the objects during the period
+	StoreFee cosmossdk_io_math.Int `protobuf:"bytes,15,opt,name=store_fee,json=storeFee,proto3,customtype=cosmossdk.io/math.Int" json:"store_fee"`
+	// read_fee defines the amount paid for the charged read quota during the period
+	ReadFee cosmossdk_io_math.Int `protobuf:"bytes,16,opt,name=read_fee,json=readFee,proto3,customtype=cosmossdk.io/math.Int" json:"read_fee"`
+	// validator_tax defines the amount paid to the validator tax pool during the period
+	ValidatorTax cosmossdk_io_math.Int `protobuf:"bytes,17,opt,name=validator_tax,json=validatorTax,proto3,customtype=cosmossdk.io/math.Int" json:"validator_tax"`
+	// total defines the total amount charged during the period
+	Total cosmossdk_io_math.Int `protobuf:"bytes,18,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
+	// frozen_time defines the seconds the payment account is frozen for during the period, which are not charged
+	FrozenTime int64 `protobuf:"varint,19,opt,name=frozen_time,json=frozenTime,proto3" json:"frozen_time,omitempty"`
+}
+
+func (m *BillingStatement) Reset()         { *m = BillingStatement{} }
+func (m *BillingStatement) String() string { return proto.CompactTextString(m) }
+func (*BillingStatement) ProtoMessage()    {}
+func (*BillingStatement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{23}
+}
+func (m *BillingStatement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BillingStatement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BillingStatement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *BillingStatement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BillingStatement.Merge(m, src)
+}
+func (m *BillingStatement) XXX_Size() int {
+	return m.Size()
+}
+func (m *BillingStatement) XXX_DiscardUnknown() {
+	xxx_messageInfo_BillingStatement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BillingStatement proto.InternalMessageInfo
+
+func (m *BillingStatement) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
 	}
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *BillingStatement) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
 	}
-	if len(m.ExternalUrl) > 0 {
-		i -= len(m.ExternalUrl)
-		copy(dAtA[i:], m.ExternalUrl)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *BillingStatement) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *BillingStatement) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ObjectMetaData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BillingStatement) GetChargeSize() uint64 {
+	if m != nil {
+		return m.ChargeSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ObjectMetaData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BillingStatement) GetChargedReadQuota() uint64 {
+	if m != nil {
+		return m.ChargedReadQuota
+	}
+	return 0
 }
 
-func (m *ObjectMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Attributes) > 0 {
-		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+func (m *BillingStatement) GetFrozenTime() int64 {
+	if m != nil {
+		return m.FrozenTime
 	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+// BucketCallback defines the contract notified when the objects of a bucket are sealed, rejected or deleted.
+type BucketCallback struct {
+	// callback_address defines the address of the contract implementing IObjectCallback
+	CallbackAddress string `protobuf:"bytes,1,opt,name=callback_address,json=callbackAddress,proto3" json:"callback_address,omitempty"`
+	// gas_limit defines the gas the callback contract is allowed to use per call, it is charged to the storage
+	// operation and the callback is skipped if the operation has less gas left
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *BucketCallback) Reset()         { *m = BucketCallback{} }
+func (m *BucketCallback) String() string { return proto.CompactTextString(m) }
+func (*BucketCallback) ProtoMessage()    {}
+func (*BucketCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{24}
+}
+func (m *BucketCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BucketCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BucketCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ObjectName) > 0 {
-		i -= len(m.ObjectName)
-		copy(dAtA[i:], m.ObjectName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectName)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *BucketCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketCallback.Merge(m, src)
+}
+func (m *BucketCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *BucketCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketCallback proto.InternalMessageInfo
+
+func (m *BucketCallback) GetCallbackAddress() string {
+	if m != nil {
+		return m.CallbackAddress
 	}
-	if len(m.ExternalUrl) > 0 {
-		i -= len(m.ExternalUrl)
-		copy(dAtA[i:], m.ExternalUrl)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *BucketCallback) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+// BucketOwnershipTransfer is a transfer of the ownership of a bucket proposed by its owner, pending until the new
+// owner accepts it.
+type BucketOwnershipTransfer struct {
+	// new_owner defines the account address the bucket is transferred to
+	NewOwner string `protobuf:"bytes,1,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// proposed_at defines the block time the transfer was proposed at
+	ProposedAt int64 `protobuf:"varint,2,opt,name=proposed_at,json=proposedAt,proto3" json:"proposed_at,omitempty"`
+}
+
+func (m *BucketOwnershipTransfer) Reset()         { *m = BucketOwnershipTransfer{} }
+func (m *BucketOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*BucketOwnershipTransfer) ProtoMessage()    {}
+func (*BucketOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa698cfb0287bc18, []int{25}
+}
+func (m *BucketOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BucketOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BucketOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BucketOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketOwnershipTransfer.Merge(m, src)
+}
+func (m *BucketOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *BucketOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketOwnershipTransfer.DiscardUnknown(m)
 }
 
-func (m *GroupMetaData) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_BucketOwnershipTransfer proto.InternalMessageInfo
+
+func (m *BucketOwnershipTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *BucketOwnershipTransfer) GetProposedAt() int64 {
+	if m != nil {
+		return m.ProposedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("moca.storage.PaymentDiscrepancyType", PaymentDiscrepancyType_name, PaymentDiscrepancyType_value)
+	proto.RegisterType((*BucketInfo)(nil), "moca.storage.BucketInfo")
+	proto.RegisterType((*InternalBucketInfo)(nil), "moca.storage.InternalBucketInfo")
+	proto.RegisterType((*ObjectInfo)(nil), "moca.storage.ObjectInfo")
+	proto.RegisterType((*GroupInfo)(nil), "moca.storage.GroupInfo")
+	proto.RegisterType((*Trait)(nil), "moca.storage.Trait")
+	proto.RegisterType((*BucketMetaData)(nil), "moca.storage.BucketMetaData")
+	proto.RegisterType((*ObjectMetaData)(nil), "moca.storage.ObjectMetaData")
+	proto.RegisterType((*GroupMetaData)(nil), "moca.storage.GroupMetaData")
+	proto.RegisterType((*Ids)(nil), "moca.storage.Ids")
+	proto.RegisterType((*DeleteInfo)(nil), "moca.storage.DeleteInfo")
+	proto.RegisterType((*MigrationBucketInfo)(nil), "moca.storage.MigrationBucketInfo")
+	proto.RegisterType((*ResourceTags)(nil), "moca.storage.ResourceTags")
+	proto.RegisterType((*ResourceTags_Tag)(nil), "moca.storage.ResourceTags.Tag")
+	proto.RegisterType((*ShadowObjectInfo)(nil), "moca.storage.ShadowObjectInfo")
+	proto.RegisterType((*BucketExtraInfo)(nil), "moca.storage.BucketExtraInfo")
+	proto.RegisterType((*BucketPaymentDetail)(nil), "moca.storage.BucketPaymentDetail")
+	proto.RegisterType((*PaymentDiscrepancy)(nil), "moca.storage.PaymentDiscrepancy")
+	proto.RegisterType((*LifecycleRule)(nil), "moca.storage.LifecycleRule")
+	proto.RegisterType((*BucketLifecycle)(nil), "moca.storage.BucketLifecycle")
+	proto.RegisterType((*ObjectVersion)(nil), "moca.storage.ObjectVersion")
+	proto.RegisterType((*ObjectVersions)(nil), "moca.storage.ObjectVersions")
+	proto.RegisterType((*TaggedResource)(nil), "moca.storage.TaggedResource")
+	proto.RegisterType((*ReadQuotaAutoTopup)(nil), "moca.storage.ReadQuotaAutoTopup")
+	proto.RegisterType((*ReadQuotaConsumption)(nil), "moca.storage.ReadQuotaConsumption")
+	proto.RegisterType((*BillingStatement)(nil), "moca.storage.BillingStatement")
+	proto.RegisterType((*BucketCallback)(nil), "moca.storage.BucketCallback")
+	proto.RegisterType((*BucketOwnershipTransfer)(nil), "moca.storage.BucketOwnershipTransfer")
+}
+
+func init() { proto.RegisterFile("moca/storage/types.proto", fileDescriptor_fa698cfb0287bc18) }
+
+var fileDescriptor_fa698cfb0287bc18 = []byte{
+	// 2519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x6f, 0x24, 0x47,
+	0xf5, 0xdf, 0x9e, 0x1f, 0xf6, 0xcc, 0x9b, 0x1f, 0xb6, 0xcb, 0xce, 0xa6, 0xe3, 0xfd, 0xae, 0x3d,
+	0x19, 0xe5, 0x0b, 0x26, 0x3f, 0x3c, 0x9b, 0x0d, 0x10, 0x81, 0x36, 0x84, 0xf1, 0x8f, 0x0d, 0xa3,
+	0x78, 0xd7, 0x4e, 0x7b, 0x76, 0x21, 0x08, 0xa9, 0x55, 0xd3, 0x5d, 0x6e, 0x77, 0xb6, 0xa7, 0xbb,
+	0x53, 0x55, 0xe3, 0xf5, 0x44, 0x1c, 0x11, 0xe2, 0x08, 0xdc, 0x91, 0x22, 0x38, 0x80, 0x90, 0x10,
+	0x1c, 0x72, 0xe0, 0x2f, 0x40, 0x41, 0xe2, 0x10, 0xe5, 0x02, 0xe2, 0x10, 0xa1, 0xe4, 0xc0, 0x15,
+	0xf8, 0x0b, 0x50, 0xfd, 0xe8, 0x71, 0xf7, 0x8c, 0x07, 0xdb, 0xb3, 0xc9, 0xc5, 0x9a, 0x7a, 0xf5,
+	0xde, 0xab, 0x7e, 0xaf, 0xde, 0xfb, 0xbc, 0x57, 0xcf, 0x60, 0xf6, 0x23, 0x07, 0xb7, 0x18, 0x8f,
+	0x28, 0xf6, 0x48, 0x8b, 0x0f, 0x63, 0xc2, 0x36, 0x63, 0x1a, 0xf1, 0x08, 0x55, 0xc5, 0xce, 0xa6,
+	0xde, 0x59, 0x5d, 0xc2, 0x7d, 0x3f, 0x8c, 0x5a, 0xf2, 0xaf, 0x62, 0x58, 0x5d, 0x73, 0x22, 0xd6,
+	0x8f, 0x58, 0xab, 0x87, 0x19, 0x69, 0x9d, 0xbc, 0xdc, 0x23, 0x1c, 0xbf, 0xdc, 0x72, 0x22, 0x3f,
+	0xd4, 0xfb, 0xcf, 0xa8, 0x7d, 0x5b, 0xae, 0x5a, 0x6a, 0xa1, 0xb7, 0x56, 0xbc, 0xc8, 0x8b, 0x14,
+	0x5d, 0xfc, 0xd2, 0xd4, 0x1b, 0xf2, 0x5b, 0x62, 0x3c, 0xec, 0x93, 0x90, 0xb7, 0xa2, 0x01, 0xb7,
+	0x8f, 0x82, 0xe8, 0xb1, 0xde, 0x6c, 0x64, 0x36, 0x19, 0xa7, 0x04, 0xf7, 0x6d, 0x4a, 0x9c, 0x88,
+	0xba, 0xc9, 0x79, 0x92, 0x83, 0x12, 0x16, 0x0d, 0xa8, 0x93, 0xb1, 0x45, 0x6f, 0x25, 0x56, 0x3a,
+	0x51, 0xbf, 0x1f, 0xe9, 0xaf, 0x6c, 0xfe, 0xb2, 0x08, 0xb0, 0x35, 0x70, 0x1e, 0x11, 0xde, 0x09,
+	0x8f, 0x22, 0xb4, 0x09, 0xc5, 0xe8, 0x71, 0x48, 0xa8, 0x69, 0x34, 0x8c, 0x8d, 0xf2, 0x96, 0xf9,
+	0xf1, 0x07, 0x2f, 0xad, 0xe8, 0x4f, 0x6f, 0xbb, 0x2e, 0x25, 0x8c, 0x1d, 0x72, 0xea, 0x87, 0x9e,
+	0xa5, 0xd8, 0xd0, 0x3a, 0x54, 0x7a, 0x52, 0xda, 0x0e, 0x71, 0x9f, 0x98, 0x39, 0x21, 0x65, 0x81,
+	0x22, 0xdd, 0xc7, 0x7d, 0x82, 0xee, 0x00, 0x9c, 0xf8, 0xcc, 0xef, 0xf9, 0x81, 0xcf, 0x87, 0x66,
+	0xbe, 0x61, 0x6c, 0xd4, 0x6f, 0xff, 0xdf, 0x66, 0xda, 0xb7, 0x9b, 0x0f, 0x47, 0xfb, 0xdd, 0x61,
+	0x4c, 0xac, 0x14, 0x3f, 0xba, 0x05, 0x39, 0xdf, 0x35, 0x0b, 0xf2, 0x5b, 0x1a, 0x1f, 0x7e, 0xb2,
+	0x7e, 0xed, 0xef, 0x9f, 0xac, 0x17, 0x1e, 0xf8, 0x21, 0xff, 0xf8, 0x83, 0x97, 0x2a, 0xfa, 0xbb,
+	0xc4, 0xf2, 0x37, 0xff, 0xfc, 0xc3, 0xf3, 0x86, 0x95, 0xf3, 0x5d, 0xf4, 0x0d, 0xa8, 0x28, 0x07,
+	0xd8, 0xc2, 0x01, 0x66, 0x51, 0x1e, 0x68, 0x66, 0x0f, 0x3c, 0x94, 0x0c, 0xea, 0x30, 0x36, 0xfa,
+	0x8d, 0x6e, 0x40, 0xd9, 0xa1, 0x04, 0x73, 0x62, 0x63, 0x6e, 0xce, 0x35, 0x8c, 0x8d, 0xbc, 0x55,
+	0x52, 0x84, 0x36, 0x47, 0x6d, 0x58, 0xd0, 0xce, 0xb7, 0xb1, 0x72, 0x84, 0x39, 0x7f, 0x81, 0x8b,
+	0xea, 0x5a, 0x40, 0x53, 0xd1, 0x16, 0xac, 0x79, 0x41, 0xd4, 0xc3, 0x81, 0x7d, 0xe2, 0x53, 0x3e,
+	0xc0, 0x81, 0xed, 0xd1, 0x68, 0x10, 0xdb, 0x47, 0xb8, 0xef, 0x07, 0x43, 0xdb, 0x77, 0xcd, 0x52,
+	0xc3, 0xd8, 0xa8, 0x59, 0xab, 0x8a, 0xeb, 0xa1, 0x62, 0x7a, 0x43, 0xf0, 0xdc, 0x95, 0x2c, 0x1d,
+	0x17, 0xbd, 0x08, 0xc8, 0x39, 0xc6, 0xd4, 0x23, 0xae, 0x4d, 0x09, 0x76, 0xed, 0x77, 0x07, 0x11,
+	0xc7, 0x66, 0xb9, 0x61, 0x6c, 0x14, 0xac, 0x45, 0xbd, 0x63, 0x11, 0xec, 0xbe, 0x25, 0xe8, 0xe8,
+	0x75, 0xa8, 0xe9, 0xdb, 0x61, 0x1c, 0xf3, 0x01, 0x33, 0x41, 0xba, 0x63, 0x35, 0xeb, 0x0e, 0x75,
+	0xfd, 0x87, 0x92, 0xc3, 0xaa, 0xf6, 0x52, 0x2b, 0xd4, 0x86, 0x02, 0xc7, 0x1e, 0x33, 0x2b, 0x0d,
+	0x63, 0xa3, 0x32, 0x2e, 0x67, 0xe9, 0x50, 0xeb, 0x62, 0x8f, 0x6d, 0x2d, 0xfd, 0xe7, 0x93, 0xf5,
+	0x1a, 0xa7, 0xd8, 0xe7, 0xec, 0x9b, 0xcd, 0xa8, 0xef, 0xf3, 0xa6, 0x25, 0x45, 0x85, 0xd5, 0x2c,
+	0xb6, 0x31, 0xb3, 0x5d, 0x12, 0x10, 0x0f, 0x73, 0xe2, 0xda, 0xd8, 0x13, 0x6e, 0x74, 0x7d, 0x86,
+	0x7b, 0x01, 0x71, 0xcd, 0x6a, 0xc3, 0xd8, 0x28, 0x59, 0xab, 0x2c, 0x6e, 0xb3, 0x9d, 0x84, 0xa7,
+	0x2d, 0x58, 0x76, 0x34, 0x47, 0xf3, 0x5f, 0x06, 0xa0, 0x4e, 0xc8, 0x09, 0x0d, 0x71, 0x90, 0x0a,
+	0xd6, 0x9b, 0x00, 0x31, 0xf5, 0xc5, 0x55, 0xfb, 0x7d, 0x22, 0x23, 0x36, 0x6f, 0x95, 0x25, 0xa5,
+	0xeb, 0xf7, 0x09, 0x7a, 0x1e, 0x96, 0x78, 0xc4, 0x71, 0x60, 0x2b, 0xbf, 0xd8, 0xcc, 0x7f, 0x4f,
+	0x45, 0x68, 0xc1, 0x5a, 0x90, 0x1b, 0xdb, 0x92, 0x7e, 0xe8, 0xbf, 0x47, 0xd0, 0x5b, 0xb0, 0x12,
+	0x44, 0xce, 0xf8, 0xd5, 0x30, 0x33, 0xdf, 0xc8, 0x6f, 0x54, 0x6e, 0xaf, 0x67, 0x0d, 0xdf, 0x8b,
+	0x9c, 0xec, 0xf5, 0x58, 0x28, 0x18, 0x27, 0x31, 0x74, 0x07, 0x6e, 0x84, 0xe4, 0x94, 0xdb, 0xe7,
+	0xe8, 0xb5, 0x75, 0x50, 0xd7, 0xac, 0xa7, 0x05, 0xcb, 0x84, 0xbe, 0x8e, 0xdb, 0xfc, 0xd1, 0x3c,
+	0xc0, 0x7e, 0xef, 0x1d, 0xe2, 0xcc, 0x96, 0x97, 0xb7, 0x61, 0x5e, 0x86, 0x6e, 0x44, 0xcd, 0xdc,
+	0x05, 0x12, 0x09, 0xe3, 0x78, 0x2e, 0xe7, 0x27, 0x72, 0x79, 0x1d, 0x2a, 0x91, 0xfc, 0x24, 0xc5,
+	0x50, 0x50, 0x0c, 0x8a, 0x24, 0x19, 0x54, 0xba, 0x16, 0xaf, 0x90, 0xae, 0xaf, 0xc0, 0xf5, 0x29,
+	0xfe, 0x99, 0x93, 0xfe, 0x59, 0x0e, 0x26, 0x7d, 0x83, 0x9e, 0x85, 0x6a, 0x8c, 0x87, 0x41, 0x84,
+	0x5d, 0x75, 0xa7, 0xf3, 0xf2, 0x4e, 0x2b, 0x9a, 0x26, 0xef, 0x33, 0x0b, 0x3b, 0xa5, 0x2b, 0xc2,
+	0xce, 0xb3, 0x50, 0x75, 0xa2, 0x90, 0x8b, 0x28, 0x95, 0x28, 0x52, 0x96, 0x96, 0x56, 0x34, 0x6d,
+	0x12, 0x2c, 0x60, 0x0c, 0x2c, 0x5e, 0x87, 0x9a, 0x76, 0x94, 0xce, 0xbb, 0xca, 0x79, 0x79, 0xa7,
+	0xae, 0x37, 0xc9, 0xbb, 0x28, 0xb5, 0x42, 0xbb, 0xb0, 0x40, 0x89, 0x3b, 0x08, 0x5d, 0x1c, 0x3a,
+	0x43, 0xf5, 0x0d, 0xd5, 0xf3, 0x6c, 0xb0, 0x46, 0x4c, 0xd2, 0x86, 0x3a, 0xcd, 0xac, 0xc7, 0xc1,
+	0xb0, 0x76, 0x05, 0x30, 0x6c, 0x41, 0xd9, 0x39, 0x26, 0xce, 0x23, 0x36, 0xe8, 0x33, 0xb3, 0xde,
+	0xc8, 0x6f, 0x54, 0xcf, 0x4b, 0xf1, 0x33, 0x9e, 0x11, 0x54, 0x2c, 0xcc, 0x0e, 0x15, 0xeb, 0x50,
+	0xf1, 0x99, 0x3d, 0x88, 0x5d, 0xcc, 0xfd, 0xd0, 0x33, 0x17, 0x25, 0x2e, 0x80, 0xcf, 0x1e, 0x68,
+	0x8a, 0x48, 0x78, 0xb9, 0x2b, 0x30, 0x84, 0x9b, 0x4b, 0x2a, 0xe1, 0x35, 0xa5, 0xcd, 0xd1, 0xab,
+	0x67, 0xdb, 0xbd, 0xa1, 0x89, 0x2e, 0x88, 0xfb, 0x44, 0x70, 0x6b, 0x88, 0x4c, 0x98, 0x3f, 0x21,
+	0x94, 0xf9, 0x51, 0x68, 0x2e, 0x4b, 0xa5, 0xc9, 0xb2, 0xf9, 0x7e, 0x0e, 0xca, 0x2a, 0xec, 0x66,
+	0xc9, 0xc2, 0x9b, 0x00, 0x2a, 0x9e, 0x53, 0xc5, 0xb1, 0x2c, 0x29, 0x32, 0x5d, 0xc6, 0xae, 0x27,
+	0x7f, 0x85, 0xeb, 0xb9, 0x7a, 0x61, 0x5c, 0x81, 0x22, 0x39, 0xe5, 0x14, 0xab, 0xf4, 0xb4, 0xd4,
+	0x62, 0x74, 0x6b, 0x73, 0x33, 0xdf, 0x5a, 0xf3, 0x0e, 0x14, 0xbb, 0x82, 0x2c, 0xac, 0x95, 0xfb,
+	0xca, 0x1a, 0x43, 0x59, 0x2b, 0x29, 0xf2, 0x93, 0x57, 0xa0, 0x78, 0x82, 0x83, 0x41, 0xe2, 0x07,
+	0xb5, 0x68, 0xfe, 0xc5, 0x80, 0xba, 0x82, 0xf4, 0x7b, 0x84, 0xe3, 0x1d, 0xcc, 0x31, 0x6a, 0x40,
+	0xc5, 0x25, 0xcc, 0xa1, 0x7e, 0xcc, 0xc5, 0x8d, 0x28, 0x45, 0x69, 0x92, 0xc8, 0x4f, 0x72, 0xaa,
+	0xca, 0x81, 0x3d, 0xa0, 0x81, 0xd6, 0x58, 0x49, 0x68, 0x0f, 0x68, 0x70, 0x31, 0x98, 0xad, 0x40,
+	0xd1, 0xef, 0x63, 0x2f, 0x81, 0x31, 0xb5, 0x40, 0xdf, 0x02, 0xc0, 0x9c, 0x53, 0xbf, 0x37, 0xe0,
+	0x84, 0x99, 0x45, 0x89, 0xfe, 0xcb, 0x59, 0xaf, 0x48, 0x63, 0xb7, 0xca, 0xc2, 0xe9, 0xca, 0xbb,
+	0x29, 0x09, 0x69, 0x8e, 0xca, 0xeb, 0xcf, 0xdd, 0x9c, 0x34, 0xf4, 0xe6, 0x27, 0xa0, 0xf7, 0x8b,
+	0x31, 0xe7, 0xcf, 0x06, 0xd4, 0x64, 0xf8, 0x7f, 0xbe, 0xd6, 0x64, 0xf3, 0x22, 0x3f, 0x9e, 0x17,
+	0x5f, 0x8c, 0x2d, 0xaf, 0x42, 0xbe, 0xe3, 0x32, 0x9d, 0x39, 0x46, 0x23, 0x7f, 0xd9, 0xcc, 0x69,
+	0xbe, 0x6f, 0x00, 0x88, 0xc6, 0x84, 0x13, 0x09, 0x02, 0xb7, 0x40, 0x87, 0x91, 0xed, 0xbb, 0x4c,
+	0x3a, 0xa0, 0x72, 0x7b, 0x29, 0xfb, 0x1d, 0x1d, 0x97, 0x59, 0x65, 0xc5, 0xa4, 0x8e, 0xd4, 0x37,
+	0x25, 0x25, 0x72, 0x53, 0x25, 0x14, 0x93, 0x90, 0xd8, 0x84, 0x72, 0x52, 0x08, 0x99, 0x99, 0x9f,
+	0x26, 0x50, 0xf2, 0x54, 0x41, 0x64, 0xcd, 0xbf, 0x1a, 0xb0, 0x7c, 0xcf, 0xf7, 0x28, 0x16, 0x37,
+	0x90, 0xea, 0x90, 0x56, 0xa1, 0xcc, 0xa8, 0x63, 0x33, 0x59, 0x51, 0x0d, 0x59, 0x51, 0xe7, 0x19,
+	0x75, 0x0e, 0x45, 0x15, 0xed, 0x40, 0x53, 0xec, 0x5d, 0xd0, 0x92, 0xe6, 0xa4, 0xd0, 0x4d, 0x46,
+	0x9d, 0x37, 0xa6, 0x77, 0xa5, 0xab, 0x50, 0x76, 0x19, 0xd7, 0xc7, 0xe4, 0xd5, 0x31, 0x2e, 0xe3,
+	0xf2, 0x98, 0xd7, 0xa0, 0x3c, 0x72, 0xd7, 0xa5, 0x01, 0xab, 0x94, 0x38, 0xaf, 0xf9, 0x43, 0xa8,
+	0xa6, 0x61, 0x08, 0xbd, 0xa6, 0x01, 0xcb, 0x90, 0xf7, 0xbf, 0x36, 0x1d, 0xb0, 0x36, 0xbb, 0xd8,
+	0x4b, 0x87, 0x82, 0x14, 0x5b, 0x7d, 0x09, 0xf2, 0x5d, 0xec, 0xa1, 0x45, 0xc8, 0x3f, 0x22, 0x43,
+	0x1d, 0xbd, 0xe2, 0xe7, 0x14, 0x74, 0xfa, 0x6d, 0x0e, 0x16, 0x0f, 0x8f, 0xb1, 0x1b, 0x3d, 0x4e,
+	0xf5, 0x62, 0x5f, 0x85, 0x52, 0x14, 0x13, 0x2a, 0x9b, 0xab, 0x8b, 0x0a, 0xc1, 0x88, 0x53, 0xc7,
+	0x5d, 0xee, 0x0a, 0x88, 0x3d, 0xde, 0x85, 0xe4, 0x27, 0xbb, 0x90, 0xf1, 0x4e, 0xa8, 0x30, 0xd9,
+	0x09, 0x65, 0x0a, 0x79, 0xf1, 0x12, 0x85, 0x3c, 0x5b, 0x64, 0xe7, 0xc6, 0x8b, 0x6c, 0xaa, 0x56,
+	0xce, 0x67, 0x6b, 0xe5, 0xbf, 0x0d, 0x58, 0x50, 0xb1, 0xb7, 0x2b, 0x6a, 0x8b, 0xf4, 0xd5, 0x97,
+	0x60, 0xc1, 0x67, 0x36, 0x15, 0x7d, 0x52, 0xe0, 0xf7, 0x7d, 0x4e, 0x54, 0x18, 0x96, 0xac, 0x9a,
+	0xcf, 0x2c, 0xcc, 0xc9, 0x9e, 0x22, 0xa2, 0xef, 0xc1, 0x82, 0x78, 0xec, 0xa6, 0x38, 0xb5, 0xab,
+	0x6e, 0x69, 0x57, 0x3d, 0xa5, 0x5c, 0xc4, 0xdc, 0x47, 0x9b, 0x7e, 0xd4, 0xea, 0x63, 0x7e, 0xbc,
+	0xd9, 0x91, 0xbe, 0x03, 0xed, 0xbb, 0x4e, 0xe2, 0xba, 0x9a, 0x50, 0x34, 0xd2, 0x8d, 0x7e, 0x00,
+	0x4b, 0xce, 0x80, 0x52, 0xe1, 0xc5, 0xd1, 0x09, 0x66, 0x7e, 0x46, 0xdd, 0x0b, 0x5a, 0xd5, 0x5d,
+	0x7d, 0x44, 0xf3, 0x17, 0x06, 0x2c, 0x2b, 0x9b, 0x0f, 0xd4, 0x63, 0x6f, 0x87, 0x70, 0xec, 0x4f,
+	0x94, 0x1f, 0x63, 0xa2, 0xfc, 0x7c, 0x07, 0xe6, 0x70, 0x3f, 0x1a, 0x84, 0xb3, 0xdb, 0xa9, 0xe5,
+	0x45, 0x27, 0xaa, 0xc7, 0x01, 0x3a, 0xf9, 0x0a, 0x56, 0x49, 0x11, 0x3a, 0x6e, 0xf3, 0x67, 0x79,
+	0x40, 0xc9, 0x97, 0xf9, 0xcc, 0xa1, 0x24, 0x16, 0xcd, 0xa1, 0x78, 0x1e, 0x24, 0xaf, 0xd8, 0x8b,
+	0x22, 0x38, 0x61, 0x44, 0xfb, 0xb0, 0xe8, 0x9e, 0xa9, 0x50, 0x21, 0x99, 0x93, 0x2d, 0xcb, 0x73,
+	0xd9, 0x2c, 0x9c, 0x3c, 0x4f, 0xb6, 0x2f, 0x0b, 0x6e, 0x96, 0x80, 0xae, 0xc3, 0x1c, 0x25, 0x98,
+	0x45, 0xa1, 0x8e, 0x6c, 0xbd, 0x42, 0x7b, 0x50, 0x22, 0xa7, 0x31, 0x71, 0x44, 0xb0, 0x14, 0x66,
+	0x74, 0xce, 0x48, 0x83, 0x74, 0xb4, 0x23, 0x20, 0xcb, 0x2c, 0xce, 0xa8, 0x4b, 0xcb, 0xa3, 0xbb,
+	0x30, 0xaf, 0x2e, 0x50, 0xb4, 0x4b, 0x02, 0x7d, 0x9e, 0x3d, 0xef, 0x1d, 0x9d, 0x89, 0x83, 0x34,
+	0x00, 0x25, 0xc2, 0xcd, 0x9f, 0x1b, 0x50, 0xdb, 0xf3, 0x8f, 0x88, 0x33, 0x74, 0x02, 0x62, 0x0d,
+	0x02, 0x82, 0xea, 0xba, 0x26, 0x09, 0x2f, 0x88, 0xcc, 0xbf, 0x0e, 0x73, 0x31, 0x25, 0x47, 0xfe,
+	0xa9, 0x46, 0x23, 0xbd, 0x42, 0xb7, 0x20, 0xcf, 0xb1, 0xa7, 0x0b, 0xc2, 0x05, 0xd8, 0x67, 0x09,
+	0x56, 0xf4, 0x65, 0x58, 0x20, 0xa7, 0xb1, 0xaf, 0x0a, 0x83, 0xed, 0xe2, 0x21, 0xd3, 0x0f, 0xcf,
+	0xfa, 0x19, 0x79, 0x07, 0x0f, 0x59, 0x73, 0x3f, 0xc9, 0xdd, 0xd1, 0x97, 0xa1, 0x3b, 0x50, 0xa4,
+	0x83, 0x80, 0x24, 0x58, 0x7b, 0x63, 0xec, 0x11, 0x9c, 0xb6, 0x20, 0x6d, 0xa7, 0x12, 0x6a, 0xfe,
+	0x2e, 0x07, 0x35, 0x05, 0x9a, 0x0f, 0x15, 0x3e, 0xa4, 0x91, 0xc3, 0xc8, 0x20, 0xc7, 0x04, 0x8c,
+	0xe5, 0x2e, 0x80, 0xb1, 0xfc, 0x25, 0x60, 0x6c, 0x1c, 0x3d, 0x0b, 0x93, 0xe8, 0x99, 0x45, 0xba,
+	0xe2, 0xff, 0x7e, 0x4e, 0xcc, 0x5d, 0xfe, 0x39, 0x31, 0xfd, 0x51, 0x3b, 0x3f, 0xf5, 0x51, 0xdb,
+	0xec, 0x42, 0x3d, 0xe3, 0x2e, 0x31, 0x39, 0x29, 0x69, 0x07, 0x4d, 0xb9, 0x82, 0x0c, 0x7f, 0xfa,
+	0x0a, 0x46, 0x72, 0xcd, 0x5f, 0x1b, 0x50, 0xef, 0x62, 0x4f, 0x4e, 0x85, 0x54, 0x7c, 0xa0, 0x6f,
+	0x43, 0x2d, 0x19, 0x12, 0x9e, 0x75, 0xea, 0xf5, 0x44, 0x77, 0xb2, 0x75, 0x16, 0x4f, 0x22, 0x77,
+	0xab, 0x34, 0xb5, 0x42, 0x6d, 0xa8, 0x8c, 0x34, 0x5c, 0xa1, 0xa6, 0x41, 0x22, 0xd4, 0x71, 0x45,
+	0x01, 0xf6, 0x68, 0x92, 0xf8, 0xe2, 0x67, 0xf3, 0xc7, 0x06, 0xa0, 0xd1, 0xe4, 0xaa, 0x3d, 0xe0,
+	0x51, 0x37, 0x8a, 0x07, 0x31, 0x7a, 0x0e, 0xea, 0x7d, 0x7c, 0x9a, 0x1e, 0x76, 0x19, 0x32, 0x38,
+	0xaa, 0x7d, 0x7c, 0x3a, 0x62, 0x47, 0x2f, 0xc0, 0x12, 0x3f, 0xa6, 0x84, 0x1d, 0x47, 0x81, 0x6b,
+	0xc7, 0x84, 0x3a, 0x44, 0x03, 0x6b, 0xcd, 0x5a, 0x1c, 0x6d, 0x1c, 0x28, 0xba, 0x7c, 0xa7, 0x08,
+	0xdd, 0x2a, 0xd6, 0x14, 0x62, 0x96, 0x25, 0x45, 0x44, 0x5a, 0xf3, 0x8f, 0x06, 0xac, 0x8c, 0x34,
+	0x6f, 0x47, 0x21, 0x1b, 0xf4, 0x55, 0x63, 0xbb, 0x02, 0xc5, 0x7e, 0x14, 0xf2, 0x63, 0xdd, 0x48,
+	0xa9, 0x05, 0xda, 0x84, 0x65, 0x47, 0x32, 0x65, 0x47, 0x72, 0x2a, 0x84, 0x97, 0x92, 0xad, 0xb3,
+	0x4f, 0x5d, 0x17, 0xce, 0x8b, 0x23, 0xaa, 0xa3, 0x2e, 0x2f, 0xa3, 0x0e, 0x12, 0x52, 0x9b, 0x8b,
+	0x57, 0xe1, 0x88, 0xa1, 0x37, 0x34, 0x0b, 0x17, 0xc4, 0xdd, 0x48, 0x74, 0x6b, 0xd8, 0xfc, 0x13,
+	0xc0, 0xe2, 0x96, 0x1f, 0x04, 0x7e, 0xe8, 0x89, 0x41, 0x02, 0x11, 0x40, 0x74, 0xde, 0xe4, 0xd2,
+	0xb8, 0xe2, 0xe4, 0x32, 0xd3, 0xc3, 0xe5, 0xae, 0xda, 0xc3, 0x5d, 0xfc, 0x16, 0xbb, 0x09, 0xc0,
+	0x38, 0xa6, 0x5c, 0x0d, 0xf2, 0x0a, 0x2a, 0x11, 0x25, 0x45, 0x0e, 0xf2, 0x9e, 0x81, 0x12, 0x09,
+	0x5d, 0xb5, 0xa9, 0xb2, 0x74, 0x9e, 0x84, 0xae, 0xdc, 0x5a, 0x87, 0x4a, 0x7a, 0xba, 0x37, 0x27,
+	0xbd, 0x0e, 0xce, 0xd9, 0x60, 0xef, 0xfc, 0x81, 0xe9, 0xfc, 0x94, 0x81, 0xe9, 0x11, 0x2c, 0xc7,
+	0xd4, 0xef, 0x63, 0x3a, 0xb4, 0x45, 0x92, 0x11, 0x5b, 0x4e, 0x13, 0xe5, 0xfc, 0xa8, 0xbc, 0xf5,
+	0x75, 0x6d, 0xf2, 0x8d, 0xc9, 0xca, 0xb1, 0x47, 0x3c, 0xec, 0x0c, 0x77, 0x88, 0x93, 0xaa, 0x1f,
+	0x3b, 0xc4, 0x51, 0x8e, 0x58, 0xd2, 0x2a, 0x0f, 0x85, 0xc6, 0x03, 0xa1, 0x10, 0xbd, 0x03, 0x4f,
+	0x31, 0xe2, 0x44, 0xa1, 0x3b, 0x7e, 0x52, 0xf9, 0x89, 0x4e, 0x5a, 0x1e, 0x29, 0x4d, 0x9d, 0xf5,
+	0x00, 0x40, 0x5a, 0xae, 0x0e, 0x80, 0x27, 0x3a, 0xa0, 0x2c, 0x34, 0x29, 0xb5, 0x2e, 0xa0, 0x13,
+	0x1c, 0xf8, 0x2e, 0xe6, 0x11, 0xb5, 0x39, 0x3e, 0x55, 0x8d, 0x55, 0xe5, 0x89, 0xd4, 0x2f, 0x8e,
+	0x34, 0x76, 0xf1, 0xa9, 0xe8, 0xaf, 0x44, 0x5f, 0xa8, 0xdc, 0x73, 0xd6, 0xbb, 0x55, 0x67, 0xed,
+	0x0b, 0xa5, 0xa2, 0xa4, 0x73, 0x43, 0x0f, 0xa1, 0x2e, 0xdd, 0x72, 0xa6, 0xb8, 0x36, 0xa3, 0xe2,
+	0xaa, 0xd0, 0x33, 0xd2, 0xeb, 0xc1, 0xd3, 0x59, 0xbf, 0x9c, 0x1d, 0x50, 0x9f, 0xf1, 0x80, 0x95,
+	0xb4, 0x5b, 0x46, 0x07, 0xdd, 0x83, 0xb2, 0x76, 0x0d, 0x21, 0xe6, 0xc2, 0x8c, 0xaa, 0x4b, 0xca,
+	0x29, 0x84, 0xa0, 0x37, 0xa1, 0xa4, 0xfc, 0x41, 0x88, 0xb9, 0x38, 0xa3, 0xb6, 0x79, 0xe9, 0x09,
+	0x22, 0x62, 0xae, 0x96, 0x71, 0x82, 0xb9, 0x34, 0xa3, 0xc6, 0x6a, 0xda, 0x74, 0x74, 0x17, 0x8a,
+	0x72, 0x70, 0x6f, 0xa2, 0x19, 0xd5, 0x29, 0x71, 0x81, 0x1a, 0x47, 0x34, 0x7a, 0x8f, 0x84, 0x0a,
+	0x53, 0xd4, 0xcc, 0x0f, 0x14, 0x49, 0xc0, 0x4a, 0x93, 0x26, 0x43, 0xa9, 0x6d, 0x1c, 0x04, 0x3d,
+	0xec, 0x3c, 0x42, 0xdb, 0xb0, 0xe8, 0xe8, 0xdf, 0x97, 0x86, 0xd1, 0x85, 0x44, 0x42, 0x93, 0x45,
+	0xab, 0xee, 0x61, 0x96, 0x7a, 0xdf, 0x14, 0xac, 0x92, 0x87, 0x99, 0x7c, 0xa8, 0x34, 0xdf, 0x85,
+	0xa7, 0xd5, 0x99, 0xfb, 0x62, 0x76, 0xc8, 0x8e, 0xfd, 0xb8, 0x4b, 0x71, 0xc8, 0x8e, 0x08, 0x45,
+	0x5f, 0x83, 0x72, 0x48, 0x1e, 0xdb, 0x97, 0x9b, 0x3d, 0x96, 0x42, 0xf2, 0x78, 0x3f, 0xf9, 0xe7,
+	0x5c, 0x4c, 0xa3, 0x38, 0x62, 0xaa, 0xd4, 0xe4, 0x94, 0x99, 0x09, 0xa9, 0xcd, 0x9f, 0xff, 0xbd,
+	0x01, 0xd7, 0xcf, 0xef, 0xd6, 0xd1, 0x57, 0xe0, 0xff, 0x0f, 0xda, 0x6f, 0xdf, 0xdb, 0xbd, 0xdf,
+	0xb5, 0x77, 0x3a, 0x87, 0xdb, 0xd6, 0xee, 0x41, 0xfb, 0xfe, 0xf6, 0xdb, 0x76, 0xf7, 0xed, 0x83,
+	0x5d, 0x7b, 0x6f, 0x7f, 0xfb, 0x4d, 0x7b, 0xab, 0xbd, 0xd7, 0xbe, 0xbf, 0xbd, 0xbb, 0x78, 0x0d,
+	0xb5, 0xe0, 0x85, 0xa9, 0xac, 0x0f, 0x0e, 0x77, 0x2d, 0xfb, 0xfe, 0x6e, 0xd7, 0xbe, 0xbb, 0xb7,
+	0xff, 0x5d, 0xdb, 0x6a, 0x77, 0x77, 0x17, 0x0d, 0xf4, 0x0a, 0xb4, 0xa6, 0x0a, 0x58, 0xbb, 0xdb,
+	0xbb, 0x9d, 0x87, 0x13, 0x42, 0xb9, 0xd5, 0xc2, 0x4f, 0x7e, 0xb5, 0x76, 0x6d, 0xeb, 0xee, 0x87,
+	0x9f, 0xae, 0x19, 0x1f, 0x7d, 0xba, 0x66, 0xfc, 0xe3, 0xd3, 0x35, 0xe3, 0xa7, 0x9f, 0xad, 0x5d,
+	0xfb, 0xe8, 0xb3, 0xb5, 0x6b, 0x7f, 0xfb, 0x6c, 0xed, 0xda, 0xf7, 0x5f, 0xf4, 0x7c, 0x7e, 0x3c,
+	0xe8, 0x6d, 0x3a, 0x51, 0xbf, 0x25, 0x3a, 0x19, 0xe7, 0x18, 0xfb, 0xa1, 0xfc, 0xd5, 0x3a, 0xb9,
+	0xdd, 0x3a, 0xcd, 0xfe, 0x8f, 0xb7, 0x37, 0x27, 0xff, 0xfb, 0xf9, 0xca, 0x7f, 0x07, 0x00, 0xbd,
+	0xd4, 0xd1, 0x76, 0x00, 0x1e, 0x00, 0x00,
+}
+
+func (m *BucketInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1897,62 +2277,103 @@ func (m *GroupMetaData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupMetaData) MarshalTo(dAtA []byte) (int, error) {
+func (m *BucketInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Attributes) > 0 {
-		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
+	if m.SpAsDelegatedAgentDisabled {
+		i--
+		if m.SpAsDelegatedAgentDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Tags != nil {
+		{
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x2a
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
+	if m.BucketStatus != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BucketStatus))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x50
 	}
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupName)))
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChargedReadQuota))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x48
 	}
-	if len(m.ExternalUrl) > 0 {
-		i -= len(m.ExternalUrl)
-		copy(dAtA[i:], m.ExternalUrl)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SourceType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Visibility != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Ids) Marshal() (dAtA []byte, err error) {
+func (m *InternalBucketInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1962,34 +2383,49 @@ func (m *Ids) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Ids) MarshalTo(dAtA []byte) (int, error) {
+func (m *InternalBucketInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Ids) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InternalBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		for iNdEx := len(m.Id) - 1; iNdEx >= 0; iNdEx-- {
+	if m.NextLocalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextLocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LocalVirtualGroups) > 0 {
+		for iNdEx := len(m.LocalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size := m.Id[iNdEx].Size()
-				i -= size
-				if _, err := m.Id[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+				size, err := m.LocalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
 					return 0, err
 				}
+				i -= size
 				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
+	if m.TotalChargeSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalChargeSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PriceTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PriceTime))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteInfo) Marshal() (dAtA []byte, err error) {
+func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1999,31 +2435,54 @@ func (m *DeleteInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ObjectInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupIds != nil {
-		{
-			size, err := m.GroupIds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.IsUpdating {
+		i--
+		if m.IsUpdating {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if m.ObjectIds != nil {
+	if m.Tags != nil {
 		{
-			size, err := m.ObjectIds.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2031,72 +2490,101 @@ func (m *DeleteInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x7a
 	}
-	if m.BucketIds != nil {
-		{
-			size, err := m.BucketIds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x72
 		}
+	}
+	if m.SourceType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x68
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MigrationBucketInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RedundancyType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ObjectStatus != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ObjectStatus))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Visibility != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Visibility))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x30
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MigrationBucketInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MigrationBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size := m.BucketId.Size()
+		size := m.Id.Size()
 		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if m.DstSpId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.DstSpId))
+	dAtA[i] = 0x2a
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectName)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
-	if m.SrcGlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SrcGlobalVirtualGroupFamilyId))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.SrcSpId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SrcSpId))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResourceTags) Marshal() (dAtA []byte, err error) {
+func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2106,34 +2594,68 @@ func (m *ResourceTags) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceTags) MarshalTo(dAtA []byte) (int, error) {
+func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourceTags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tags) > 0 {
-		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
+	if m.Tags != nil {
+		{
+			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Extra) > 0 {
+		i -= len(m.Extra)
+		copy(dAtA[i:], m.Extra)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extra)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SourceType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SourceType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResourceTags_Tag) Marshal() (dAtA []byte, err error) {
+func (m *Trait) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2143,12 +2665,12 @@ func (m *ResourceTags_Tag) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceTags_Tag) MarshalTo(dAtA []byte) (int, error) {
+func (m *Trait) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourceTags_Tag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Trait) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2160,17 +2682,17 @@ func (m *ResourceTags_Tag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+	if len(m.TraitType) > 0 {
+		i -= len(m.TraitType)
+		copy(dAtA[i:], m.TraitType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TraitType)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ShadowObjectInfo) Marshal() (dAtA []byte, err error) {
+func (m *BucketMetaData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2180,68 +2702,62 @@ func (m *ShadowObjectInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ShadowObjectInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *BucketMetaData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ShadowObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BucketMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x38
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.UpdatedAt != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedAt))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Checksums) > 0 {
-		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Checksums[iNdEx])
-			copy(dAtA[i:], m.Checksums[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksums[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.PayloadSize != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PayloadSize))
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContentType)))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	{
-		size := m.Id.Size()
-		i -= size
-		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if len(m.ExternalUrl) > 0 {
+		i -= len(m.ExternalUrl)
+		copy(dAtA[i:], m.ExternalUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BucketExtraInfo) Marshal() (dAtA []byte, err error) {
+func (m *ObjectMetaData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2251,492 +2767,3696 @@ func (m *BucketExtraInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BucketExtraInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ObjectMetaData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BucketExtraInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ObjectMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.CurrentFlowRate.Size()
-		i -= size
-		if _, err := m.CurrentFlowRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.FlowRateLimit.Size()
-		i -= size
-		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.IsRateLimited {
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ObjectName)))
 		i--
-		if m.IsRateLimited {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExternalUrl) > 0 {
+		i -= len(m.ExternalUrl)
+		copy(dAtA[i:], m.ExternalUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GroupMetaData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BucketInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GroupMetaData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovTypes(uint64(m.Visibility))
-	}
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.SourceType != 0 {
-		n += 1 + sovTypes(uint64(m.SourceType))
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovTypes(uint64(m.CreateAt))
-	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupFamilyId))
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.ChargedReadQuota != 0 {
-		n += 1 + sovTypes(uint64(m.ChargedReadQuota))
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.BucketStatus != 0 {
-		n += 1 + sovTypes(uint64(m.BucketStatus))
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.ExternalUrl) > 0 {
+		i -= len(m.ExternalUrl)
+		copy(dAtA[i:], m.ExternalUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalUrl)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SpAsDelegatedAgentDisabled {
-		n += 2
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *InternalBucketInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Ids) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Ids) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ids) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PriceTime != 0 {
-		n += 1 + sovTypes(uint64(m.PriceTime))
-	}
-	if m.TotalChargeSize != 0 {
-		n += 1 + sovTypes(uint64(m.TotalChargeSize))
+	if len(m.Id) > 0 {
+		for iNdEx := len(m.Id) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Id[iNdEx].Size()
+				i -= size
+				if _, err := m.Id[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if len(m.LocalVirtualGroups) > 0 {
-		for _, e := range m.LocalVirtualGroups {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	if m.NextLocalVirtualGroupId != 0 {
-		n += 1 + sovTypes(uint64(m.NextLocalVirtualGroupId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ObjectInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.LocalVirtualGroupId != 0 {
-		n += 1 + sovTypes(uint64(m.LocalVirtualGroupId))
-	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovTypes(uint64(m.PayloadSize))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovTypes(uint64(m.Visibility))
-	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovTypes(uint64(m.CreateAt))
-	}
-	if m.ObjectStatus != 0 {
-		n += 1 + sovTypes(uint64(m.ObjectStatus))
-	}
-	if m.RedundancyType != 0 {
-		n += 1 + sovTypes(uint64(m.RedundancyType))
-	}
-	if m.SourceType != 0 {
-		n += 1 + sovTypes(uint64(m.SourceType))
-	}
-	if len(m.Checksums) > 0 {
-		for _, b := range m.Checksums {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
+	if m.GroupIds != nil {
+		{
+			size, err := m.GroupIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.IsUpdating {
-		n += 3
-	}
-	if m.UpdatedAt != 0 {
-		n += 2 + sovTypes(uint64(m.UpdatedAt))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 2 + l + sovTypes(uint64(l))
+	if m.ObjectIds != nil {
+		{
+			size, err := m.ObjectIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Version != 0 {
-		n += 2 + sovTypes(uint64(m.Version))
+	if m.BucketIds != nil {
+		{
+			size, err := m.BucketIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GroupInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MigrationBucketInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationBucketInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.SourceType != 0 {
-		n += 1 + sovTypes(uint64(m.SourceType))
+	i--
+	dAtA[i] = 0x22
+	if m.DstSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DstSpId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Extra)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.SrcGlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SrcGlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Tags != nil {
-		l = m.Tags.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.SrcSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SrcSpId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Trait) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TraitType)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (m *ResourceTags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BucketMetaData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ResourceTags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceTags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.ExternalUrl)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ObjectMetaData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResourceTags_Tag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceTags_Tag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceTags_Tag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.ExternalUrl)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GroupMetaData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShadowObjectInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShadowObjectInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShadowObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.ExternalUrl)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.UpdatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.PayloadSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Ids) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		for _, e := range m.Id {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+func (m *BucketExtraInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeleteInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BucketIds != nil {
-		l = m.BucketIds.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.ObjectIds != nil {
-		l = m.ObjectIds.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.GroupIds != nil {
-		l = m.GroupIds.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func (m *BucketExtraInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MigrationBucketInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BucketExtraInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SrcSpId != 0 {
-		n += 1 + sovTypes(uint64(m.SrcSpId))
+	{
+		size := m.CurrentFlowRate.Size()
+		i -= size
+		if _, err := m.CurrentFlowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.SrcGlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovTypes(uint64(m.SrcGlobalVirtualGroupFamilyId))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FlowRateLimit.Size()
+		i -= size
+		if _, err := m.FlowRateLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.DstSpId != 0 {
-		n += 1 + sovTypes(uint64(m.DstSpId))
+	i--
+	dAtA[i] = 0x12
+	if m.IsRateLimited {
+		i--
+		if m.IsRateLimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResourceTags) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BucketPaymentDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketPaymentDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketPaymentDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if m.StreamId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ResourceTags_Tag) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PaymentDiscrepancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentDiscrepancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentDiscrepancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size := m.Actual.Size()
+		i -= size
+		if _, err := m.Actual.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Expected.Size()
+		i -= size
+		if _, err := m.Expected.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DiscrepancyType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DiscrepancyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ShadowObjectInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LifecycleRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LifecycleRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LifecycleRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.ExpirationDays != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpirationDays))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.Id.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Tag != nil {
+		{
+			size, err := m.Tag.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovTypes(uint64(m.PayloadSize))
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BucketLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObjectVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LocalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LocalVirtualGroupId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.Checksums) > 0 {
-		for _, b := range m.Checksums {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObjectVersions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectVersions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectVersions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaggedResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaggedResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaggedResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grn) > 0 {
+		i -= len(m.Grn)
+		copy(dAtA[i:], m.Grn)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Grn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.ResourceId.Size()
+		i -= size
+		if _, err := m.ResourceId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ResourceType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResourceType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadQuotaAutoTopup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadQuotaAutoTopup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadQuotaAutoTopup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopupSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TopupSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ThresholdPercent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ThresholdPercent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxReadQuota != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxReadQuota))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadQuotaConsumption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadQuotaConsumption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadQuotaConsumption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReportedBy) > 0 {
+		i -= len(m.ReportedBy)
+		copy(dAtA[i:], m.ReportedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReportedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ReportedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReportedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsumedReadQuota != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConsumedReadQuota))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Month != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Month))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BillingStatement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BillingStatement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BillingStatement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FrozenTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FrozenTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.ValidatorTax.Size()
+		i -= size
+		if _, err := m.ValidatorTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.ReadFee.Size()
+		i -= size
+		if _, err := m.ReadFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.StoreFee.Size()
+		i -= size
+		if _, err := m.StoreFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.ValidatorTaxFlowRate.Size()
+		i -= size
+		if _, err := m.ValidatorTaxFlowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.ReadFlowRate.Size()
+		i -= size
+		if _, err := m.ReadFlowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.StoreFlowRate.Size()
+		i -= size
+		if _, err := m.StoreFlowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.ValidatorTaxRate.Size()
+		i -= size
+		if _, err := m.ValidatorTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ReadPrice.Size()
+		i -= size
+		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.SecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.PrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.PrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.ChargedReadQuota != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChargedReadQuota))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ChargeSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChargeSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BucketCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CallbackAddress) > 0 {
+		i -= len(m.CallbackAddress)
+		copy(dAtA[i:], m.CallbackAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CallbackAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BucketOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BucketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTypes(uint64(m.Visibility))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SourceType != 0 {
+		n += 1 + sovTypes(uint64(m.SourceType))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovTypes(uint64(m.CreateAt))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovTypes(uint64(m.ChargedReadQuota))
+	}
+	if m.BucketStatus != 0 {
+		n += 1 + sovTypes(uint64(m.BucketStatus))
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SpAsDelegatedAgentDisabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *InternalBucketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriceTime != 0 {
+		n += 1 + sovTypes(uint64(m.PriceTime))
+	}
+	if m.TotalChargeSize != 0 {
+		n += 1 + sovTypes(uint64(m.TotalChargeSize))
+	}
+	if len(m.LocalVirtualGroups) > 0 {
+		for _, e := range m.LocalVirtualGroups {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.NextLocalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.NextLocalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *ObjectInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.LocalVirtualGroupId))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTypes(uint64(m.PayloadSize))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTypes(uint64(m.Visibility))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovTypes(uint64(m.CreateAt))
+	}
+	if m.ObjectStatus != 0 {
+		n += 1 + sovTypes(uint64(m.ObjectStatus))
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyType))
+	}
+	if m.SourceType != 0 {
+		n += 1 + sovTypes(uint64(m.SourceType))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.IsUpdating {
+		n += 3
+	}
+	if m.UpdatedAt != 0 {
+		n += 2 + sovTypes(uint64(m.UpdatedAt))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 2 + sovTypes(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *GroupInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SourceType != 0 {
+		n += 1 + sovTypes(uint64(m.SourceType))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Extra)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Tags != nil {
+		l = m.Tags.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Trait) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraitType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *BucketMetaData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExternalUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ObjectMetaData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExternalUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GroupMetaData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExternalUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Ids) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		for _, e := range m.Id {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BucketIds != nil {
+		l = m.BucketIds.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ObjectIds != nil {
+		l = m.ObjectIds.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GroupIds != nil {
+		l = m.GroupIds.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MigrationBucketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SrcSpId != 0 {
+		n += 1 + sovTypes(uint64(m.SrcSpId))
+	}
+	if m.SrcGlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.SrcGlobalVirtualGroupFamilyId))
+	}
+	if m.DstSpId != 0 {
+		n += 1 + sovTypes(uint64(m.DstSpId))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ResourceTags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResourceTags_Tag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ShadowObjectInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTypes(uint64(m.PayloadSize))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.UpdatedAt))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *BucketExtraInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsRateLimited {
+		n += 2
+	}
+	l = m.FlowRateLimit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CurrentFlowRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *BucketPaymentDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.StreamId != 0 {
+		n += 1 + sovTypes(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *PaymentDiscrepancy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DiscrepancyType != 0 {
+		n += 1 + sovTypes(uint64(m.DiscrepancyType))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Expected.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Actual.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LifecycleRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Tag != nil {
+		l = m.Tag.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpirationDays != 0 {
+		n += 1 + sovTypes(uint64(m.ExpirationDays))
+	}
+	return n
+}
+
+func (m *BucketLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ObjectVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTypes(uint64(m.PayloadSize))
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.UpdatedAt))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LocalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.LocalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *ObjectVersions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaggedResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceType != 0 {
+		n += 1 + sovTypes(uint64(m.ResourceType))
+	}
+	l = m.ResourceId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Grn)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ReadQuotaAutoTopup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxReadQuota != 0 {
+		n += 1 + sovTypes(uint64(m.MaxReadQuota))
+	}
+	if m.ThresholdPercent != 0 {
+		n += 1 + sovTypes(uint64(m.ThresholdPercent))
+	}
+	if m.TopupSize != 0 {
+		n += 1 + sovTypes(uint64(m.TopupSize))
+	}
+	return n
+}
+
+func (m *ReadQuotaConsumption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Month != 0 {
+		n += 1 + sovTypes(uint64(m.Month))
+	}
+	if m.ConsumedReadQuota != 0 {
+		n += 1 + sovTypes(uint64(m.ConsumedReadQuota))
+	}
+	if m.ReportedAt != 0 {
+		n += 1 + sovTypes(uint64(m.ReportedAt))
+	}
+	l = len(m.ReportedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *BillingStatement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTypes(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTypes(uint64(m.EndTime))
+	}
+	if m.ChargeSize != 0 {
+		n += 1 + sovTypes(uint64(m.ChargeSize))
+	}
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovTypes(uint64(m.ChargedReadQuota))
+	}
+	l = m.PrimaryStorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SecondaryStorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReadPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ValidatorTaxRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.StoreFlowRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReadFlowRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ValidatorTaxFlowRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.StoreFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReadFee.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	l = m.ValidatorTax.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	l = m.Total.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	if m.FrozenTime != 0 {
+		n += 2 + sovTypes(uint64(m.FrozenTime))
+	}
+	return n
+}
+
+func (m *BucketCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *BucketOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ProposedAt != 0 {
+		n += 1 + sovTypes(uint64(m.ProposedAt))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BucketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			m.SourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceType |= SourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			}
+			m.ChargedReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketStatus", wireType)
+			}
+			m.BucketStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketStatus |= BucketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = &ResourceTags{}
+			}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAsDelegatedAgentDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SpAsDelegatedAgentDisabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InternalBucketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InternalBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTime", wireType)
+			}
+			m.PriceTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalChargeSize", wireType)
+			}
+			m.TotalChargeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalChargeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalVirtualGroups = append(m.LocalVirtualGroups, &LocalVirtualGroup{})
+			if err := m.LocalVirtualGroups[len(m.LocalVirtualGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLocalVirtualGroupId", wireType)
+			}
+			m.NextLocalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLocalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
+			}
+			m.LocalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			m.Visibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Visibility |= VisibilityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectStatus", wireType)
+			}
+			m.ObjectStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectStatus |= ObjectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			m.SourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceType |= SourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = &ResourceTags{}
+			}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsUpdating", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsUpdating = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			m.SourceType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceType |= SourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extra = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = &ResourceTags{}
+			}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trait) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trait: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trait: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraitType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraitType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketMetaData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Trait{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectMetaData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Trait{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.UpdatedAt != 0 {
-		n += 1 + sovTypes(uint64(m.UpdatedAt))
-	}
-	if m.Version != 0 {
-		n += 1 + sovTypes(uint64(m.Version))
-	}
-	return n
-}
 
-func (m *BucketExtraInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsRateLimited {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.FlowRateLimit.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.CurrentFlowRate.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BucketInfo) Unmarshal(dAtA []byte) error {
+func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2759,15 +6479,15 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BucketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupMetaData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2795,11 +6515,11 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2827,13 +6547,13 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
-			m.Visibility = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2843,14 +6563,27 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2878,15 +6611,13 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			m.SourceType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2896,33 +6627,79 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Trait{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ids) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ids: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ids: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2950,51 +6727,67 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			var v Uint
+			m.Id = append(m.Id, v)
+			if err := m.Id[len(m.Id)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.GlobalVirtualGroupFamilyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ChargedReadQuota = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChargedReadQuota |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketStatus", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BucketStatus = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketIds", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3004,14 +6797,31 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BucketStatus |= BucketStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BucketIds == nil {
+				m.BucketIds = &Ids{}
+			}
+			if err := m.BucketIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3038,18 +6848,18 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &ResourceTags{}
+			if m.ObjectIds == nil {
+				m.ObjectIds = &Ids{}
 			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAsDelegatedAgentDisabled", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupIds", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3059,12 +6869,28 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SpAsDelegatedAgentDisabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupIds == nil {
+				m.GroupIds = &Ids{}
+			}
+			if err := m.GroupIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3086,7 +6912,7 @@ func (m *BucketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
+func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3109,17 +6935,17 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InternalBucketInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrationBucketInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InternalBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrationBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcSpId", wireType)
 			}
-			m.PriceTime = 0
+			m.SrcSpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3129,16 +6955,16 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PriceTime |= int64(b&0x7F) << shift
+				m.SrcSpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalChargeSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcGlobalVirtualGroupFamilyId", wireType)
 			}
-			m.TotalChargeSize = 0
+			m.SrcGlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3148,14 +6974,117 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalChargeSize |= uint64(b&0x7F) << shift
+				m.SrcGlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstSpId", wireType)
+			}
+			m.DstSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceTags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceTags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceTags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3182,30 +7111,11 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalVirtualGroups = append(m.LocalVirtualGroups, &LocalVirtualGroup{})
-			if err := m.LocalVirtualGroups[len(m.LocalVirtualGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tags = append(m.Tags, ResourceTags_Tag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextLocalVirtualGroupId", wireType)
-			}
-			m.NextLocalVirtualGroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextLocalVirtualGroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3227,7 +7137,7 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *ResourceTags_Tag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3250,15 +7160,15 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3286,11 +7196,11 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3318,43 +7228,61 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShadowObjectInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShadowObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3382,9 +7310,9 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -3418,119 +7346,11 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
-			}
-			m.LocalVirtualGroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
-			}
-			m.Visibility = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Visibility |= VisibilityType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
-			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectStatus", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			m.ObjectStatus = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3540,35 +7360,29 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObjectStatus |= ObjectStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.RedundancyType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RedundancyType |= RedundancyType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 13:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
 			}
-			m.SourceType = 0
+			m.PayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3578,12 +7392,12 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
+				m.PayloadSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
 			}
@@ -3615,11 +7429,11 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
 			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var msglen int
+			m.UpdatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3629,33 +7443,16 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpdatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Tags == nil {
-				m.Tags = &ResourceTags{}
-			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsUpdating", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3665,17 +7462,66 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsUpdating = bool(v != 0)
-		case 17:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketExtraInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketExtraInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRateLimited", wireType)
 			}
-			m.UpdatedAt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3685,14 +7531,15 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+			m.IsRateLimited = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3720,13 +7567,15 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFlowRate", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3736,11 +7585,26 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentFlowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3762,7 +7626,7 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupInfo) Unmarshal(dAtA []byte) error {
+func (m *BucketPaymentDetail) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3785,15 +7649,15 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: BucketPaymentDetail: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BucketPaymentDetail: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3821,11 +7685,11 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3853,13 +7717,15 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			m.SourceType = 0
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3869,14 +7735,64 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceType |= SourceType(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentDiscrepancy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentDiscrepancy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentDiscrepancy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3904,13 +7820,30 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscrepancyType", wireType)
+			}
+			m.DiscrepancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscrepancyType |= PaymentDiscrepancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3938,13 +7871,13 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extra = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3954,81 +7887,29 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &ResourceTags{}
-			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Expected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Trait) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Trait: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trait: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraitType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actual", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4056,13 +7937,15 @@ func (m *Trait) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraitType = string(dAtA[iNdEx:postIndex])
+			if err := m.Actual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4072,23 +7955,25 @@ func (m *Trait) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Buckets = append(m.Buckets, BucketPaymentDetail{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4111,7 +7996,7 @@ func (m *Trait) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
+func (m *LifecycleRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4134,15 +8019,15 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BucketMetaData: wiretype end group for non-group")
+			return fmt.Errorf("proto: LifecycleRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BucketMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4170,11 +8055,11 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4202,13 +8087,13 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4218,29 +8103,33 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BucketName = string(dAtA[iNdEx:postIndex])
+			if m.Tag == nil {
+				m.Tag = &ResourceTags_Tag{}
+			}
+			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDays", wireType)
 			}
-			var stringLen uint64
+			m.ExpirationDays = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4250,27 +8139,64 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpirationDays |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BucketLifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4297,8 +8223,8 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Trait{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rules = append(m.Rules, LifecycleRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4323,7 +8249,7 @@ func (m *BucketMetaData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
+func (m *ObjectVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4346,17 +8272,55 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectMetaData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4366,27 +8330,27 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4414,11 +8378,30 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4446,13 +8429,13 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupId", wireType)
 			}
-			var stringLen uint64
+			m.LocalVirtualGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4462,27 +8445,64 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LocalVirtualGroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectVersions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectVersions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectVersions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4509,8 +8529,8 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Trait{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Versions = append(m.Versions, ObjectVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4535,7 +8555,7 @@ func (m *ObjectMetaData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
+func (m *TaggedResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4558,17 +8578,17 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupMetaData: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaggedResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaggedResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
 			}
-			var stringLen uint64
+			m.ResourceType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4578,27 +8598,14 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ResourceType |= resource.ResourceType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4626,11 +8633,13 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalUrl = string(dAtA[iNdEx:postIndex])
+			if err := m.ResourceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4658,13 +8667,63 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			m.Grn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadQuotaAutoTopup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadQuotaAutoTopup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadQuotaAutoTopup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReadQuota", wireType)
+			}
+			m.MaxReadQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4674,29 +8733,16 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxReadQuota |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPercent", wireType)
 			}
-			var msglen int
+			m.ThresholdPercent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4706,26 +8752,30 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ThresholdPercent |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopupSize", wireType)
 			}
-			m.Attributes = append(m.Attributes, Trait{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TopupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopupSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4747,7 +8797,7 @@ func (m *GroupMetaData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Ids) Unmarshal(dAtA []byte) error {
+func (m *ReadQuotaConsumption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4770,15 +8820,72 @@ func (m *Ids) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Ids: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadQuotaConsumption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Ids: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadQuotaConsumption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			m.Month = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Month |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumedReadQuota", wireType)
+			}
+			m.ConsumedReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsumedReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedAt", wireType)
+			}
+			m.ReportedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4806,11 +8913,7 @@ func (m *Ids) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v Uint
-			m.Id = append(m.Id, v)
-			if err := m.Id[len(m.Id)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReportedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4833,7 +8936,7 @@ func (m *Ids) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
+func (m *BillingStatement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4856,17 +8959,17 @@ func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: BillingStatement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BillingStatement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4876,33 +8979,29 @@ func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BucketIds == nil {
-				m.BucketIds = &Ids{}
-			}
-			if err := m.BucketIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4912,33 +9011,31 @@ func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObjectIds == nil {
-				m.ObjectIds = &Ids{}
-			}
-			if err := m.ObjectIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4948,83 +9045,48 @@ func (m *DeleteInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupIds == nil {
-				m.GroupIds = &Ids{}
-			}
-			if err := m.GroupIds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MigrationBucketInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MigrationBucketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcSpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.SrcSpId = 0
+			m.EndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5034,16 +9096,16 @@ func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcSpId |= uint32(b&0x7F) << shift
+				m.EndTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcGlobalVirtualGroupFamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeSize", wireType)
 			}
-			m.SrcGlobalVirtualGroupFamilyId = 0
+			m.ChargeSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5053,16 +9115,16 @@ func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcGlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				m.ChargeSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstSpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedReadQuota", wireType)
 			}
-			m.DstSpId = 0
+			m.ChargedReadQuota = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5072,14 +9134,14 @@ func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DstSpId |= uint32(b&0x7F) << shift
+				m.ChargedReadQuota |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5107,65 +9169,15 @@ func (m *MigrationBucketInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceTags) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceTags: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceTags: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5175,79 +9187,63 @@ func (m *ResourceTags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, ResourceTags_Tag{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceTags_Tag) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5275,11 +9271,13 @@ func (m *ResourceTags_Tag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidatorTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreFlowRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5307,61 +9305,13 @@ func (m *ResourceTags_Tag) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.StoreFlowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ShadowObjectInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShadowObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadFlowRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5389,11 +9339,13 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			if err := m.ReadFlowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTaxFlowRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5421,13 +9373,13 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorTaxFlowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5455,13 +9407,15 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			if err := m.StoreFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadFee", wireType)
 			}
-			m.PayloadSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5471,16 +9425,31 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PayloadSize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTax", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5490,29 +9459,31 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
-			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
+			if err := m.ValidatorTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.UpdatedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5522,16 +9493,31 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenTime", wireType)
 			}
-			m.Version = 0
+			m.FrozenTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5541,7 +9527,7 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.FrozenTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5567,7 +9553,7 @@ func (m *ShadowObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
+func (m *BucketCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5590,17 +9576,17 @@ func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BucketExtraInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: BucketCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BucketExtraInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BucketCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRateLimited", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5610,17 +9596,29 @@ func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsRateLimited = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateLimit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			var stringLen uint64
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5630,29 +9628,64 @@ func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FlowRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BucketOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFlowRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5680,10 +9713,27 @@ func (m *BucketExtraInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentFlowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAt", wireType)
+			}
+			m.ProposedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,14 @@
+package virtualgroup
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/virtualgroup/keeper"
+)
+
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	if err := k.SettleSwapInAuctions(ctx); err != nil {
+		return err
+	}
+	return k.ExpireRebalancePlans(ctx)
+}
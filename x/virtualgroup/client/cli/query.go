@@ -26,6 +26,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGlobalVirtualGroupByFamilyID())
 	cmd.AddCommand(CmdGlobalVirtualGroupFamily())
 	cmd.AddCommand(CmdGlobalVirtualGroupFamilies())
+	cmd.AddCommand(CmdSpExitPlan())
+	cmd.AddCommand(CmdSwapInAuction())
+	cmd.AddCommand(CmdSwapInAuctions())
+	cmd.AddCommand(CmdRebalanceProposal())
+	cmd.AddCommand(CmdRebalancePlan())
+	cmd.AddCommand(CmdRebalancePlans())
 	cmd.AddCommand(CmdQueryParams())
 
 	return cmd
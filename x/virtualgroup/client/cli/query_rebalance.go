@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func CmdRebalanceProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance-proposal [max-moves]",
+		Short: "propose the moves which even out the secondary sp load.",
+		Long: `Compute the number of GVGs every in service storage provider serves as a secondary SP, and propose the moves of
+GVGs from the most loaded SPs to the least loaded ones which can take them over, together with the load once the moves
+complete. Zero max moves proposes as many moves as a rebalance plan can carry.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			maxMoves, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid max moves %s", args[0])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RebalanceProposal(cmd.Context(), &types.QueryRebalanceProposalRequest{
+				MaxMoves: uint32(maxMoves),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRebalancePlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance-plan [plan-id]",
+		Short: "query a rebalance plan and the progress of its moves.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			planID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil || planID == 0 {
+				return fmt.Errorf("invalid plan id %s", args[0])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RebalancePlan(cmd.Context(), &types.QueryRebalancePlanRequest{
+				PlanId: planID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRebalancePlans() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance-plans",
+		Short: "query all rebalance plans.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RebalancePlans(cmd.Context(), &types.QueryRebalancePlansRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rebalance-plans")
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func CmdSpExitPlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sp-exit-plan [sp-id]",
+		Short: "dry run the exit of a storage provider.",
+		Long: `Dry run the exit of a storage provider. The plan lists the families and GVGs it must swap out,
+the candidate successor SPs, the pending swap ins, the migrating buckets, the deposit released and the blockers of
+completing the exit.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			spID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil || spID == 0 {
+				return fmt.Errorf("invalid sp id %s", args[0])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SpExitPlan(cmd.Context(), &types.QuerySpExitPlanRequest{
+				SpId: uint32(spID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func CmdSwapInAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-in-auction [gvg family id] [gvg id]",
+		Short: "query the swap in auction of a GVG family or GVG.",
+		Long: `Query the swap in auction opened for a GVG family or GVG of a forced exiting storage provider.
+If none zero is provided for GVG family, then the auction of the GVG family is queried and the GVG id is ignored.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			gvgFamilyID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid GVG family id %s", args[0])
+			}
+			gvgID, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid GVG id %s", args[1])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SwapInAuction(cmd.Context(), &types.QuerySwapInAuctionRequest{
+				GlobalVirtualGroupFamilyId: uint32(gvgFamilyID),
+				GlobalVirtualGroupId:       uint32(gvgID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSwapInAuctions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-in-auctions",
+		Short: "query all swap in auctions of the forced exiting storage providers.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SwapInAuctions(cmd.Context(), &types.QuerySwapInAuctionsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "swap-in-auctions")
+
+	return cmd
+}
@@ -24,6 +24,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdSettle())
+	cmd.AddCommand(CmdBidSwapIn())
+	cmd.AddCommand(CmdSubmitRebalancePlan())
 
 	return cmd
 }
@@ -72,3 +74,104 @@ If none zero is provided for GVG family, then the provided GVG family will be se
 
 	return cmd
 }
+
+func CmdBidSwapIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid-swap-in [target sp id] [gvg family id] [gvg id] [capacity]",
+		Short: "Broadcast message bid swap in",
+		Long: `Bid for the swap in auction of a GVG family or GVG of a forced exiting storage provider, committing the capacity in bytes.
+If none zero is provided for GVG family, then the bid is for the primary SP of the GVG family and the GVG id is ignored.
+If zero is provided for GVG family, then the bid is for the secondary SP of the provided GVG.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			targetSPID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil || targetSPID == 0 {
+				return fmt.Errorf("invalid target sp id %s", args[0])
+			}
+			gvgFamilyID, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid GVG family id %s", args[1])
+			}
+			gvgID, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid GVG id %s", args[2])
+			}
+			capacity, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid capacity %s", args[3])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBidSwapIn(
+				clientCtx.GetFromAddress(),
+				uint32(targetSPID),
+				uint32(gvgFamilyID),
+				uint32(gvgID),
+				capacity,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitRebalancePlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-rebalance-plan [moves]",
+		Short: "Broadcast message submit rebalance plan",
+		Long: `Submit a rebalance plan of comma separated moves, each in the form of gvg-id:from-sp-id:to-sp-id.
+The swap in of every move is reserved for the SP to move to, which completes it by complete swap in.
+A storage provider can only submit the moves out of itself.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			moves := make([]types.RebalanceMove, 0)
+			for _, split := range strings.Split(args[0], ",") {
+				ids := strings.Split(split, ":")
+				if len(ids) != 3 {
+					return fmt.Errorf("invalid move %s", split)
+				}
+				parsed := make([]uint32, 0, len(ids))
+				for _, id := range ids {
+					v, err := strconv.ParseUint(id, 10, 32)
+					if err != nil {
+						return fmt.Errorf("invalid move %s", split)
+					}
+					parsed = append(parsed, uint32(v))
+				}
+				moves = append(moves, types.RebalanceMove{
+					GlobalVirtualGroupId: parsed[0],
+					FromSpId:             parsed[1],
+					ToSpId:               parsed[2],
+				})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitRebalancePlan(
+				clientCtx.GetFromAddress(),
+				moves,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
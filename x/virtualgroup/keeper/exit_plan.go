@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+// GetSpExitPlan dry runs the exit of the sp without changing any state. The plan lists the families and global
+// virtual groups the sp must swap out, the sps which can succeed it, the reserved swap ins, the buckets migrating
+// from or to it and the deposit released, and flags everything which blocks MsgStorageProviderExit or
+// MsgCompleteStorageProviderExit now.
+func (k Keeper) GetSpExitPlan(ctx sdk.Context, spID uint32) (*types.SpExitPlan, error) {
+	sp, found := k.spKeeper.GetStorageProvider(ctx, spID)
+	if !found {
+		return nil, sptypes.ErrStorageProviderNotFound.Wrapf("sp(ID: %d) not found", spID)
+	}
+
+	plan := &types.SpExitPlan{
+		SpId:               sp.Id,
+		Status:             sp.Status.String(),
+		ReleasedDeposit:    sdkmath.ZeroInt(),
+		DepositLockedUntil: k.spKeeper.GetDepositLockUntil(ctx, sp.Id),
+	}
+
+	switch sp.Status {
+	case sptypes.STATUS_GRACEFUL_EXITING, sptypes.STATUS_FORCED_EXITING:
+	case sptypes.STATUS_IN_SERVICE:
+		plan.Blockers = append(plan.Blockers, "the sp has not started the exit by MsgStorageProviderExit")
+		if stat, found := k.GetGVGStatisticsWithinSP(ctx, sp.Id); found && stat.BreakRedundancyReqmtGvgCount != 0 {
+			plan.Blockers = append(plan.Blockers, fmt.Sprintf("%d gvgs of the sp break the redundancy requirement", stat.BreakRedundancyReqmtGvgCount))
+		}
+		exitingSPNum := uint32(0)
+		for _, curSP := range k.spKeeper.GetAllStorageProviders(ctx) {
+			if curSP.Status == sptypes.STATUS_GRACEFUL_EXITING || curSP.Status == sptypes.STATUS_FORCED_EXITING {
+				exitingSPNum++
+			}
+		}
+		if maxSPExitingNum := k.SpConcurrentExitNum(ctx); exitingSPNum >= maxSPExitingNum {
+			plan.Blockers = append(plan.Blockers, fmt.Sprintf("%d sps are exiting, only %d sps can exit concurrently", exitingSPNum, maxSPExitingNum))
+		}
+	default:
+		plan.Blockers = append(plan.Blockers, fmt.Sprintf("the sp in %s can not exit", sp.Status))
+	}
+
+	// the global virtual groups the sp serves in, which the successors must not serve in already
+	servedGVGs := make([]*types.GlobalVirtualGroup, 0)
+
+	if familyStats, found := k.GetGVGFamilyStatisticsWithinSP(ctx, sp.Id); found {
+		for _, familyID := range familyStats.GlobalVirtualGroupFamilyIds {
+			family, found := k.GetGVGFamily(ctx, familyID)
+			if !found {
+				return nil, types.ErrGVGFamilyNotExist
+			}
+			planFamily := types.ExitPlanFamily{
+				GlobalVirtualGroupFamilyId: family.Id,
+				GlobalVirtualGroupIds:      family.GlobalVirtualGroupIds,
+				TotalDeposit:               sdkmath.ZeroInt(),
+			}
+			for _, gvgID := range family.GlobalVirtualGroupIds {
+				gvg, found := k.GetGVG(ctx, gvgID)
+				if !found {
+					return nil, types.ErrGVGNotExist
+				}
+				planFamily.StoredSize += gvg.StoredSize
+				planFamily.TotalDeposit = planFamily.TotalDeposit.Add(gvg.TotalDeposit)
+				servedGVGs = append(servedGVGs, gvg)
+			}
+			if swapOutInfo, found := k.getSwapOutInfo(ctx, types.GetSwapOutFamilyKey(family.Id)); found {
+				planFamily.SwapOutSuccessorSpId = swapOutInfo.SuccessorSpId
+			}
+			if swapInInfo, found := k.GetSwapInInfo(ctx, family.Id, types.NoSpecifiedGVGId); found && swapInInfo.TargetSpId == sp.Id {
+				plan.PendingSwapIns = append(plan.PendingSwapIns, k.newExitPlanSwapIn(ctx, family.Id, types.NoSpecifiedGVGId, swapInInfo))
+			}
+			plan.ReleasedDeposit = plan.ReleasedDeposit.Add(planFamily.TotalDeposit)
+			plan.Families = append(plan.Families, planFamily)
+			plan.Blockers = append(plan.Blockers, fmt.Sprintf("the sp is still the primary sp of family %d", family.Id))
+		}
+	}
+
+	for _, gvg := range k.GetAllGVGs(ctx) {
+		isSecondary := false
+		for _, secondarySPID := range gvg.SecondarySpIds {
+			if secondarySPID == sp.Id {
+				isSecondary = true
+				break
+			}
+		}
+		if !isSecondary {
+			continue
+		}
+		planGVG := types.ExitPlanGVG{
+			GlobalVirtualGroupId:       gvg.Id,
+			GlobalVirtualGroupFamilyId: gvg.FamilyId,
+			PrimarySpId:                gvg.PrimarySpId,
+			StoredSize:                 gvg.StoredSize,
+		}
+		if swapOutInfo, found := k.getSwapOutInfo(ctx, types.GetSwapOutGVGKey(gvg.Id)); found && swapOutInfo.SpId == sp.Id {
+			planGVG.SwapOutSuccessorSpId = swapOutInfo.SuccessorSpId
+		}
+		if swapInInfo, found := k.GetSwapInInfo(ctx, types.NoSpecifiedFamilyID, gvg.Id); found && swapInInfo.TargetSpId == sp.Id {
+			plan.PendingSwapIns = append(plan.PendingSwapIns, k.newExitPlanSwapIn(ctx, types.NoSpecifiedFamilyID, gvg.Id, swapInInfo))
+		}
+		servedGVGs = append(servedGVGs, gvg)
+		plan.SecondaryGvgs = append(plan.SecondaryGvgs, planGVG)
+		plan.Blockers = append(plan.Blockers, fmt.Sprintf("the sp is still a secondary sp of gvg %d", gvg.Id))
+	}
+
+	plan.MigratingBucketIds = k.storageKeeper.GetMigratingBucketIDsBySP(ctx, sp.Id)
+	if len(plan.MigratingBucketIds) != 0 {
+		plan.Blockers = append(plan.Blockers, fmt.Sprintf("%d buckets are migrating from or to the sp", len(plan.MigratingBucketIds)))
+	}
+
+	//nolint:gosec // block height is never negative
+	if uint64(ctx.BlockHeight()) < plan.DepositLockedUntil {
+		plan.Blockers = append(plan.Blockers, fmt.Sprintf("the deposit is locked for pending challenges until height %d", plan.DepositLockedUntil))
+	}
+	if sp.Status != sptypes.STATUS_FORCED_EXITING {
+		plan.ReleasedDeposit = plan.ReleasedDeposit.Add(sp.TotalDeposit)
+	}
+
+	candidates, err := k.getExitSuccessorCandidates(ctx, sp.Id, servedGVGs)
+	if err != nil {
+		return nil, err
+	}
+	plan.SuccessorCandidates = candidates
+	plan.Exitable = len(plan.Blockers) == 0
+	return plan, nil
+}
+
+// getExitSuccessorCandidates returns the in service sps other than the exiting one, ranked by the free store size
+// left by the staking of their families from the largest.
+func (k Keeper) getExitSuccessorCandidates(ctx sdk.Context, exitingSPID uint32, servedGVGs []*types.GlobalVirtualGroup) ([]types.ExitSuccessorCandidate, error) {
+	maxStoreSizePerFamily := k.MaxStoreSizePerFamily(ctx)
+	candidates := make([]types.ExitSuccessorCandidate, 0)
+	for _, sp := range k.spKeeper.GetAllStorageProviders(ctx) {
+		if sp.Id == exitingSPID || sp.Status != sptypes.STATUS_IN_SERVICE {
+			continue
+		}
+		candidate := types.ExitSuccessorCandidate{SpId: sp.Id}
+		if familyStats, found := k.GetGVGFamilyStatisticsWithinSP(ctx, sp.Id); found {
+			for _, familyID := range familyStats.GlobalVirtualGroupFamilyIds {
+				family, found := k.GetGVGFamily(ctx, familyID)
+				if !found {
+					return nil, types.ErrGVGFamilyNotExist
+				}
+				totalStakingSize, stored, err := k.GetGlobalVirtualFamilyTotalStakingAndStoredSize(ctx, family)
+				if err != nil {
+					return nil, err
+				}
+				if capacity := min(totalStakingSize, maxStoreSizePerFamily); capacity > stored {
+					candidate.FreeStoreSize += capacity - stored
+				}
+			}
+		}
+		for _, gvg := range servedGVGs {
+			if gvg.PrimarySpId == sp.Id {
+				candidate.ConflictingGvgIds = append(candidate.ConflictingGvgIds, gvg.Id)
+				continue
+			}
+			for _, secondarySPID := range gvg.SecondarySpIds {
+				if secondarySPID == sp.Id {
+					candidate.ConflictingGvgIds = append(candidate.ConflictingGvgIds, gvg.Id)
+					break
+				}
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].FreeStoreSize != candidates[j].FreeStoreSize {
+			return candidates[i].FreeStoreSize > candidates[j].FreeStoreSize
+		}
+		return candidates[i].SpId < candidates[j].SpId
+	})
+	return candidates, nil
+}
+
+func (k Keeper) newExitPlanSwapIn(ctx sdk.Context, gvgFamilyID, gvgID uint32, swapInInfo *types.SwapInInfo) types.ExitPlanSwapIn {
+	return types.ExitPlanSwapIn{
+		GlobalVirtualGroupFamilyId: gvgFamilyID,
+		GlobalVirtualGroupId:       gvgID,
+		SwapInInfo:                 *swapInInfo,
+		//nolint:gosec // block time is never negative
+		Expired: uint64(ctx.BlockTime().Unix()) >= swapInInfo.ExpirationTime,
+	}
+}
+
+func (k Keeper) getSwapOutInfo(ctx sdk.Context, key []byte) (*types.SwapOutInfo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return nil, false
+	}
+	swapOutInfo := &types.SwapOutInfo{}
+	k.cdc.MustUnmarshal(bz, swapOutInfo)
+	return swapOutInfo, true
+}
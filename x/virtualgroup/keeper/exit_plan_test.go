@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestGetSpExitPlan() {
+	ctx := s.ctx.WithBlockTime(time.Unix(10000, 0))
+	ctrl := gomock.NewController(s.T())
+	storageKeeper := types.NewMockStorageKeeper(ctrl)
+	s.virtualgroupKeeper.SetStorageKeeper(storageKeeper)
+	storageKeeper.EXPECT().GetMigratingBucketIDsBySP(gomock.Any(), uint32(1)).Return([]math.Uint{math.NewUint(7)}).AnyTimes()
+	storageKeeper.EXPECT().GetMigratingBucketIDsBySP(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// sp 1 is exiting, sp 2 and 3 are in service, sp 4 is jailed
+	sps := []sptypes.StorageProvider{
+		{Id: 1, Status: sptypes.STATUS_GRACEFUL_EXITING, TotalDeposit: math.NewInt(1000)},
+		{Id: 2, Status: sptypes.STATUS_IN_SERVICE, TotalDeposit: math.NewInt(1000)},
+		{Id: 3, Status: sptypes.STATUS_IN_SERVICE, TotalDeposit: math.NewInt(1000)},
+		{Id: 4, Status: sptypes.STATUS_IN_JAILED, TotalDeposit: math.NewInt(1000)},
+	}
+	s.spKeeper.EXPECT().GetAllStorageProviders(gomock.Any()).Return(sps).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, spID uint32) (*sptypes.StorageProvider, bool) {
+			for i := range sps {
+				if sps[i].Id == spID {
+					return &sps[i], true
+				}
+			}
+			return nil, false
+		}).AnyTimes()
+
+	// sp 1 is the primary sp of family 1 and a secondary sp of gvg 2 in family 2 of sp 2, which has room for 1000 bytes
+	s.virtualgroupKeeper.SetGVGFamilyStatisticsWithinSP(ctx, &types.GVGFamilyStatisticsWithinSP{SpId: 1, GlobalVirtualGroupFamilyIds: []uint32{1}})
+	s.virtualgroupKeeper.SetGVGFamilyStatisticsWithinSP(ctx, &types.GVGFamilyStatisticsWithinSP{SpId: 2, GlobalVirtualGroupFamilyIds: []uint32{2}})
+	s.virtualgroupKeeper.SetGVGFamily(ctx, &types.GlobalVirtualGroupFamily{Id: 1, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{1}})
+	s.virtualgroupKeeper.SetGVGFamily(ctx, &types.GlobalVirtualGroupFamily{Id: 2, PrimarySpId: 2, GlobalVirtualGroupIds: []uint32{2}})
+	s.virtualgroupKeeper.SetGVG(ctx, &types.GlobalVirtualGroup{
+		Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3}, StoredSize: 100, TotalDeposit: math.NewInt(500),
+	})
+	s.virtualgroupKeeper.SetGVG(ctx, &types.GlobalVirtualGroup{
+		Id: 2, FamilyId: 2, PrimarySpId: 2, SecondarySpIds: []uint32{1, 3}, TotalDeposit: types.DefaultGVGStakingPerBytes.MulRaw(1000),
+	})
+
+	// sp 2 is taking over family 1 by swap out, and sp 3 reserved the swap in of it too
+	s.Require().NoError(s.virtualgroupKeeper.SetSwapOutInfo(ctx, 1, nil, 1, 2))
+	s.Require().NoError(s.virtualgroupKeeper.SwapIn(ctx, 1, types.NoSpecifiedGVGId, 3, &sps[0], ctx.BlockTime().Unix()+100))
+
+	plan, err := s.virtualgroupKeeper.GetSpExitPlan(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().False(plan.Exitable)
+	s.Require().Len(plan.Blockers, 3)
+	s.Require().Len(plan.Families, 1)
+	s.Require().Equal(uint64(100), plan.Families[0].StoredSize)
+	s.Require().Equal(math.NewInt(500), plan.Families[0].TotalDeposit)
+	s.Require().Equal(uint32(2), plan.Families[0].SwapOutSuccessorSpId)
+	s.Require().Len(plan.SecondaryGvgs, 1)
+	s.Require().Equal(uint32(2), plan.SecondaryGvgs[0].GlobalVirtualGroupId)
+	s.Require().Len(plan.PendingSwapIns, 1)
+	s.Require().Equal(uint32(3), plan.PendingSwapIns[0].SwapInInfo.SuccessorSpId)
+	s.Require().False(plan.PendingSwapIns[0].Expired)
+	s.Require().Equal([]math.Uint{math.NewUint(7)}, plan.MigratingBucketIds)
+	// the gvg deposit paid back by the successor plus the sp deposit
+	s.Require().Equal(math.NewInt(1500), plan.ReleasedDeposit)
+
+	// the jailed sp can't succeed, and both in service sps already serve in the gvgs of sp 1
+	s.Require().Len(plan.SuccessorCandidates, 2)
+	s.Require().Equal(uint32(2), plan.SuccessorCandidates[0].SpId)
+	s.Require().Equal(uint64(1000), plan.SuccessorCandidates[0].FreeStoreSize)
+	s.Require().Equal([]uint32{1, 2}, plan.SuccessorCandidates[0].ConflictingGvgIds)
+	s.Require().Equal(uint32(3), plan.SuccessorCandidates[1].SpId)
+	s.Require().Equal([]uint32{1, 2}, plan.SuccessorCandidates[1].ConflictingGvgIds)
+
+	// sp 3 has to start the exit, which waits for sp 1 to complete its exit
+	plan, err = s.virtualgroupKeeper.GetSpExitPlan(ctx, 3)
+	s.Require().NoError(err)
+	s.Require().False(plan.Exitable)
+	s.Require().Len(plan.Blockers, 4)
+	s.Require().Len(plan.SecondaryGvgs, 2)
+
+	_, err = s.virtualgroupKeeper.GetSpExitPlan(ctx, 5)
+	s.Require().ErrorIs(err, sptypes.ErrStorageProviderNotFound)
+}
@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+// PickGVGFamily picks the global virtual group family of the sp for a new bucket by the strategy. It returns the
+// picked family, which is NoSpecifiedFamilyID if none fits, the explanation of the choice and, for the reputation
+// aware strategies, the candidate families ranked from the best to the worst.
+func (k Keeper) PickGVGFamily(ctx sdk.Context, spID uint32, strategy types.PickVGFStrategy) (uint32, string, []types.GVGFamilyCandidate, error) {
+	stats, found := k.GetGVGFamilyStatisticsWithinSP(ctx, spID)
+	if !found {
+		return 0, "", nil, types.ErrGVGFamilyStatisticsNotExist
+	}
+
+	var familyID uint32
+	var freeStoreSize uint64
+	switch strategy {
+	case types.Strategy_Maximize_Free_Store_Size:
+		for _, gvgfID := range stats.GlobalVirtualGroupFamilyIds {
+			gvgFamily, found := k.GetGVGFamily(ctx, gvgfID)
+			if !found {
+				return 0, "", nil, types.ErrGVGFamilyNotExist
+			}
+			currentFreeStoreSize, err := k.gvgFamilyFreeStoreSize(ctx, gvgFamily)
+			if err != nil {
+				return 0, "", nil, err
+			}
+			if currentFreeStoreSize > freeStoreSize {
+				familyID = gvgFamily.Id
+				freeStoreSize = currentFreeStoreSize
+			}
+		}
+		if familyID == types.NoSpecifiedFamilyID {
+			return familyID, fmt.Sprintf("no family of sp %d has free store size", spID), nil, nil
+		}
+		return familyID, fmt.Sprintf("family %d has the most free store size %d among the families of sp %d",
+			familyID, freeStoreSize, spID), nil, nil
+	case types.Strategy_Minimal_Free_Store_Size:
+		freeStoreSize = math.MaxUint64
+		for _, gvgfID := range stats.GlobalVirtualGroupFamilyIds {
+			gvgFamily, found := k.GetGVGFamily(ctx, gvgfID)
+			if !found {
+				return 0, "", nil, types.ErrGVGFamilyNotExist
+			}
+			currentFreeStoreSize, err := k.gvgFamilyFreeStoreSize(ctx, gvgFamily)
+			if err != nil {
+				return 0, "", nil, err
+			}
+			// the families which can not serve a new bucket are left out
+			if currentFreeStoreSize > 0 && currentFreeStoreSize < freeStoreSize {
+				familyID = gvgFamily.Id
+				freeStoreSize = currentFreeStoreSize
+			}
+		}
+		if familyID == types.NoSpecifiedFamilyID {
+			return familyID, fmt.Sprintf("no family of sp %d has free store size", spID), nil, nil
+		}
+		return familyID, fmt.Sprintf("family %d has the least free store size %d among the families of sp %d",
+			familyID, freeStoreSize, spID), nil, nil
+	case types.Strategy_Oldest_Create_Time:
+		if len(stats.GlobalVirtualGroupFamilyIds) == 0 {
+			return familyID, fmt.Sprintf("sp %d has no family", spID), nil, nil
+		}
+		familyID = stats.GlobalVirtualGroupFamilyIds[0]
+		return familyID, fmt.Sprintf("family %d is the oldest family of sp %d", familyID, spID), nil, nil
+	case types.Strategy_Recentest_Create_Time:
+		if len(stats.GlobalVirtualGroupFamilyIds) == 0 {
+			return familyID, fmt.Sprintf("sp %d has no family", spID), nil, nil
+		}
+		familyID = stats.GlobalVirtualGroupFamilyIds[len(stats.GlobalVirtualGroupFamilyIds)-1]
+		return familyID, fmt.Sprintf("family %d is the most recent family of sp %d", familyID, spID), nil, nil
+	case types.Strategy_Cheapest, types.Strategy_Most_Reliable, types.Strategy_Balanced:
+		candidates := k.rankGVGFamilies(ctx, stats.GlobalVirtualGroupFamilyIds, strategy)
+		if len(candidates) == 0 {
+			return familyID, fmt.Sprintf("no family of sp %d with global virtual groups can serve a new bucket", spID), candidates, nil
+		}
+		best := candidates[0]
+		var explanation string
+		switch strategy {
+		case types.Strategy_Cheapest:
+			explanation = fmt.Sprintf("family %d has the lowest average store price %s of its secondary sps among %d candidate families of sp %d",
+				best.GlobalVirtualGroupFamilyId, best.AvgStorePrice, len(candidates), spID)
+		case types.Strategy_Most_Reliable:
+			explanation = fmt.Sprintf("family %d has the least recent challenge slashes %s and maintenance time %ds of its secondary sps among %d candidate families of sp %d",
+				best.GlobalVirtualGroupFamilyId, best.SlashedAmount, best.MaintenanceDuration, len(candidates), spID)
+		default:
+			explanation = fmt.Sprintf("family %d has the best balanced score %s of the store price, challenge slashes and maintenance time of its secondary sps among %d candidate families of sp %d",
+				best.GlobalVirtualGroupFamilyId, best.Score, len(candidates), spID)
+		}
+		return best.GlobalVirtualGroupFamilyId, explanation, candidates, nil
+	default:
+		return 0, "", nil, types.ErrInvalidPickVGFStrategy
+	}
+}
+
+// PickGVGFamilyForNewBucket picks the global virtual group family of the sp for a new bucket by the strategy, and
+// checks that it can serve the new bucket.
+func (k Keeper) PickGVGFamilyForNewBucket(ctx sdk.Context, spID uint32, strategy types.PickVGFStrategy) (*types.GlobalVirtualGroupFamily, error) {
+	familyID, explanation, candidates, err := k.PickGVGFamily(ctx, spID, strategy)
+	if err != nil {
+		return nil, err
+	}
+	if familyID == types.NoSpecifiedFamilyID {
+		return nil, types.ErrGVGFamilyNotExist.Wrap(explanation)
+	}
+	gvgFamily, err := k.GetAndCheckGVGFamilyAvailableForNewBucket(ctx, familyID)
+	// fall through to the next ranked candidate if the picked one can't serve the new bucket
+	for i := 1; err != nil && i < len(candidates); i++ {
+		familyID = candidates[i].GlobalVirtualGroupFamilyId
+		explanation = fmt.Sprintf("family %d is the candidate %d of sp %d, the better ones can't serve a new bucket",
+			familyID, i+1, spID)
+		gvgFamily, err = k.GetAndCheckGVGFamilyAvailableForNewBucket(ctx, familyID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvents(&types.EventPickGlobalVirtualGroupFamily{
+		PrimarySpId:                spID,
+		GlobalVirtualGroupFamilyId: familyID,
+		Strategy:                   strategy,
+		Explanation:                explanation,
+	}); err != nil {
+		return nil, err
+	}
+	return gvgFamily, nil
+}
+
+// isGVGFamilyAvailable reports whether the family has free store size within both its staking and the max store size
+// per family, and room for more global virtual groups, the same as QuerySpAvailableGlobalVirtualGroupFamilies.
+func (k Keeper) isGVGFamilyAvailable(ctx sdk.Context, gvgFamily *types.GlobalVirtualGroupFamily) bool {
+	freeStoreSize, err := k.gvgFamilyFreeStoreSize(ctx, gvgFamily)
+	return err == nil && freeStoreSize > 0
+}
+
+// gvgFamilyFreeStoreSize returns the store size the family can still take, bounded by its staking and the max store
+// size per family, zero if it is full or has the max number of global virtual groups.
+func (k Keeper) gvgFamilyFreeStoreSize(ctx sdk.Context, gvgFamily *types.GlobalVirtualGroupFamily) (uint64, error) {
+	totalStakingSize, stored, err := k.GetGlobalVirtualFamilyTotalStakingAndStoredSize(ctx, gvgFamily)
+	if err != nil {
+		return 0, err
+	}
+	capacity := min(totalStakingSize, k.MaxStoreSizePerFamily(ctx))
+	if stored >= capacity || uint32(len(gvgFamily.GlobalVirtualGroupIds)) >= k.MaxGlobalVirtualGroupNumPerFamily(ctx) {
+		return 0, nil
+	}
+	return capacity - stored, nil
+}
+
+// rankGVGFamilies ranks the families which can serve a new bucket and have global virtual groups by the store price,
+// the recent challenge slashes and the maintenance time of their secondary sps under the strategy, from the best to
+// the worst. Each measure is normalized to the largest one of the candidates before they are summed.
+func (k Keeper) rankGVGFamilies(ctx sdk.Context, familyIDs []uint32, strategy types.PickVGFStrategy) []types.GVGFamilyCandidate {
+	candidates := make([]types.GVGFamilyCandidate, 0, len(familyIDs))
+	maxPrice, maxSlashed, maxMaintenance := sdkmath.LegacyZeroDec(), sdkmath.ZeroInt(), int64(0)
+	for _, familyID := range familyIDs {
+		gvgFamily, found := k.GetGVGFamily(ctx, familyID)
+		if !found || len(gvgFamily.GlobalVirtualGroupIds) == 0 || !k.isGVGFamilyAvailable(ctx, gvgFamily) {
+			continue
+		}
+
+		candidate := k.newGVGFamilyCandidate(ctx, gvgFamily)
+		maxPrice = sdkmath.LegacyMaxDec(maxPrice, candidate.AvgStorePrice)
+		maxSlashed = sdkmath.MaxInt(maxSlashed, candidate.SlashedAmount)
+		maxMaintenance = max(maxMaintenance, candidate.MaintenanceDuration)
+		candidates = append(candidates, candidate)
+	}
+
+	for i := range candidates {
+		c := &candidates[i]
+		price := normalize(c.AvgStorePrice, maxPrice)
+		slashed := normalize(sdkmath.LegacyNewDecFromInt(c.SlashedAmount), sdkmath.LegacyNewDecFromInt(maxSlashed))
+		maintenance := normalize(sdkmath.LegacyNewDec(c.MaintenanceDuration), sdkmath.LegacyNewDec(maxMaintenance))
+		switch strategy {
+		case types.Strategy_Cheapest:
+			c.Score = c.AvgStorePrice
+		case types.Strategy_Most_Reliable:
+			c.Score = slashed.Add(maintenance)
+		default:
+			c.Score = price.Add(slashed).Add(maintenance)
+		}
+	}
+	// the families are in the order they are created, which breaks the ties
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score.LT(candidates[j].Score)
+	})
+	return candidates
+}
+
+// newGVGFamilyCandidate collects the reputation of the distinct secondary sps of the global virtual groups in the family.
+func (k Keeper) newGVGFamilyCandidate(ctx sdk.Context, gvgFamily *types.GlobalVirtualGroupFamily) types.GVGFamilyCandidate {
+	candidate := types.GVGFamilyCandidate{
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+		AvgStorePrice:              sdkmath.LegacyZeroDec(),
+		SlashedAmount:              sdkmath.ZeroInt(),
+		Score:                      sdkmath.LegacyZeroDec(),
+	}
+
+	seen := make(map[uint32]struct{})
+	for _, gvgID := range gvgFamily.GlobalVirtualGroupIds {
+		gvg, found := k.GetGVG(ctx, gvgID)
+		if !found {
+			continue
+		}
+		for _, spID := range gvg.SecondarySpIds {
+			if _, ok := seen[spID]; ok {
+				continue
+			}
+			seen[spID] = struct{}{}
+			candidate.SecondarySpIds = append(candidate.SecondarySpIds, spID)
+		}
+	}
+
+	priced := int64(0)
+	for _, spID := range candidate.SecondarySpIds {
+		if price, found := k.spKeeper.GetSpStoragePrice(ctx, spID); found {
+			candidate.AvgStorePrice = candidate.AvgStorePrice.Add(price.StorePrice)
+			priced++
+		}
+		candidate.SlashedAmount = candidate.SlashedAmount.Add(k.challengeKeeper.GetSpSlashAmount(ctx, spID))
+		if sp, found := k.spKeeper.GetStorageProvider(ctx, spID); found {
+			candidate.MaintenanceDuration += k.spKeeper.GetMaintenanceDuration(ctx, sp)
+		}
+	}
+	if priced > 0 {
+		candidate.AvgStorePrice = candidate.AvgStorePrice.QuoInt64(priced)
+	}
+	return candidate
+}
+
+// normalize returns the share of the value in the max value, zero if the max value is zero.
+func normalize(value, maxValue sdkmath.LegacyDec) sdkmath.LegacyDec {
+	if maxValue.IsZero() {
+		return sdkmath.LegacyZeroDec()
+	}
+	return value.Quo(maxValue)
+}
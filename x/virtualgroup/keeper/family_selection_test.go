@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestPickGVGFamily() {
+	// family 1 is served by sp 2 and 3, family 2 by sp 4 and 5, family 3 has no gvg yet, and family 4 by sp 6 and 7
+	// has used up its staking
+	s.virtualgroupKeeper.SetGVGFamilyStatisticsWithinSP(s.ctx, &types.GVGFamilyStatisticsWithinSP{
+		SpId:                        1,
+		GlobalVirtualGroupFamilyIds: []uint32{1, 2, 3, 4},
+	})
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{Id: 1, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{1}})
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{Id: 2, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{2}})
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{Id: 3, PrimarySpId: 1})
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{Id: 4, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{3}})
+	deposit := types.DefaultGVGStakingPerBytes.MulRaw(1000)
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3}, TotalDeposit: deposit})
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 2, FamilyId: 2, PrimarySpId: 1, SecondarySpIds: []uint32{4, 5}, TotalDeposit: deposit})
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 3, FamilyId: 4, PrimarySpId: 1, SecondarySpIds: []uint32{6, 7}, TotalDeposit: deposit, StoredSize: 1000})
+
+	prices := map[uint32]int64{2: 10, 3: 30, 4: 15, 5: 15, 6: 1, 7: 1}
+	slashes := map[uint32]int64{4: 100}
+	maintenances := map[uint32]int64{2: 50, 5: 10}
+	s.spKeeper.EXPECT().GetSpStoragePrice(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, spID uint32) (sptypes.SpStoragePrice, bool) {
+			return sptypes.SpStoragePrice{SpId: spID, StorePrice: math.LegacyNewDec(prices[spID])}, true
+		}).AnyTimes()
+	s.challengeKeeper.EXPECT().GetSpSlashAmount(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, spID uint32) math.Int {
+			return math.NewInt(slashes[spID])
+		}).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, spID uint32) (*sptypes.StorageProvider, bool) {
+			return &sptypes.StorageProvider{Id: spID}, true
+		}).AnyTimes()
+	s.spKeeper.EXPECT().GetMaintenanceDuration(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, sp *sptypes.StorageProvider) int64 {
+			return maintenances[sp.Id]
+		}).AnyTimes()
+
+	// family 2 has the lower average price, 15 against 20, family 4 is cheaper but has no free store size
+	familyID, explanation, candidates, err := s.virtualgroupKeeper.PickGVGFamily(s.ctx, 1, types.Strategy_Cheapest)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(2), familyID)
+	s.Require().NotEmpty(explanation)
+	s.Require().Len(candidates, 2)
+	s.Require().Equal([]uint32{4, 5}, candidates[0].SecondarySpIds)
+	s.Require().Equal(math.LegacyNewDec(20), candidates[1].AvgStorePrice)
+
+	// family 1 scores 0 + 1 against 1 + 0.2 of family 2
+	familyID, _, candidates, err = s.virtualgroupKeeper.PickGVGFamily(s.ctx, 1, types.Strategy_Most_Reliable)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(1), familyID)
+	s.Require().Equal(math.LegacyNewDec(1), candidates[0].Score)
+	s.Require().Equal(int64(60), candidates[0].MaintenanceDuration+candidates[1].MaintenanceDuration)
+
+	// family 2 scores 0.75 + 1 + 0.2 against 1 + 0 + 1 of family 1
+	familyID, _, candidates, err = s.virtualgroupKeeper.PickGVGFamily(s.ctx, 1, types.Strategy_Balanced)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(2), familyID)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("1.95"), candidates[0].Score)
+
+	_, _, _, err = s.virtualgroupKeeper.PickGVGFamily(s.ctx, 1, types.PickVGFStrategy(100))
+	s.Require().ErrorIs(err, types.ErrInvalidPickVGFStrategy)
+
+	// family 2 has the most free store size, 1000 against 600, and family 1 the least among the families which can
+	// serve a new bucket, families 3 and 4 have none
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3}, TotalDeposit: deposit, StoredSize: 400})
+	familyID, explanation, _, err = s.virtualgroupKeeper.PickGVGFamily(s.ctx, 1, types.Strategy_Maximize_Free_Store_Size)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(2), familyID)
+	s.Require().Contains(explanation, "free store size 1000")
+	familyID, explanation, _, err = s.virtualgroupKeeper.PickGVGFamily(s.ctx, 1, types.Strategy_Minimal_Free_Store_Size)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(1), familyID)
+	s.Require().Contains(explanation, "free store size 600")
+
+	// the picked family serves the new bucket
+	gvgFamily, err := s.virtualgroupKeeper.PickGVGFamilyForNewBucket(s.ctx, 1, types.Strategy_Cheapest)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(2), gvgFamily.Id)
+
+	// a family without free store size is not a candidate
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{Id: 2, FamilyId: 2, PrimarySpId: 1, SecondarySpIds: []uint32{4, 5}, TotalDeposit: deposit, StoredSize: 1000})
+	gvgFamily, err = s.virtualgroupKeeper.PickGVGFamilyForNewBucket(s.ctx, 1, types.Strategy_Cheapest)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(1), gvgFamily.Id)
+
+	// an sp without family can't serve new buckets
+	s.virtualgroupKeeper.SetGVGFamilyStatisticsWithinSP(s.ctx, &types.GVGFamilyStatisticsWithinSP{SpId: 6})
+	_, err = s.virtualgroupKeeper.PickGVGFamilyForNewBucket(s.ctx, 6, types.Strategy_Balanced)
+	s.Require().ErrorIs(err, types.ErrGVGFamilyNotExist)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"math"
 
 	"cosmossdk.io/store/prefix"
@@ -203,63 +204,109 @@ func (k Keeper) QuerySpOptimalGlobalVirtualGroupFamily(goCtx context.Context, re
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	stats, found := k.GetGVGFamilyStatisticsWithinSP(ctx, req.GetSpId())
-	if !found {
-		return nil, types.ErrGVGFamilyStatisticsNotExist
-	}
-
-	var familyID uint32
-	var freeStoreSize float64
-	var currentFreeStoreSize float64
-	switch req.PickVgfStrategy {
-	case types.Strategy_Maximize_Free_Store_Size:
-		for _, gvgfID := range stats.GlobalVirtualGroupFamilyIds {
-			gvgFamily, found := k.GetGVGFamily(ctx, gvgfID)
-			if !found {
-				return nil, types.ErrGVGFamilyNotExist
-			}
-			totalStakingSize, stored, err := k.GetGlobalVirtualFamilyTotalStakingAndStoredSize(ctx, gvgFamily)
-			if err != nil {
-				return nil, err
-			}
-			if float64(stored) < math.Min(float64(totalStakingSize), float64(k.MaxStoreSizePerFamily(ctx))) && uint32(len(gvgFamily.GlobalVirtualGroupIds)) < k.MaxGlobalVirtualGroupNumPerFamily(ctx) {
-				currentFreeStoreSize = math.Min(float64(totalStakingSize), float64(k.MaxStoreSizePerFamily(ctx))) - float64(stored)
-				if currentFreeStoreSize > freeStoreSize {
-					familyID = gvgFamily.Id
-					freeStoreSize = currentFreeStoreSize
-				}
-			}
-		}
-	case types.Strategy_Minimal_Free_Store_Size:
-		freeStoreSize = math.MaxFloat64
-		for _, gvgfID := range stats.GlobalVirtualGroupFamilyIds {
-			gvgFamily, found := k.GetGVGFamily(ctx, gvgfID)
-			if !found {
-				return nil, types.ErrGVGFamilyNotExist
-			}
-			totalStakingSize, stored, err := k.GetGlobalVirtualFamilyTotalStakingAndStoredSize(ctx, gvgFamily)
-			if err != nil {
-				return nil, err
-			}
-			currentFreeStoreSize = math.Min(float64(totalStakingSize), float64(k.MaxStoreSizePerFamily(ctx))) - float64(stored)
-			if currentFreeStoreSize < freeStoreSize {
-				familyID = gvgFamily.Id
-				freeStoreSize = currentFreeStoreSize
-			}
-		}
-	case types.Strategy_Oldest_Create_Time:
-		if len(stats.GlobalVirtualGroupFamilyIds) != 0 {
-			familyID = stats.GlobalVirtualGroupFamilyIds[0]
-		}
-	case types.Strategy_Recentest_Create_Time:
-		if len(stats.GlobalVirtualGroupFamilyIds) != 0 {
-			familyID = stats.GlobalVirtualGroupFamilyIds[len(stats.GlobalVirtualGroupFamilyIds)-1]
+	familyID, explanation, candidates, err := k.PickGVGFamily(ctx, req.GetSpId(), req.PickVgfStrategy)
+	if err != nil {
+		if errors.Is(err, types.ErrInvalidPickVGFStrategy) {
+			return nil, status.Error(codes.InvalidArgument, "invalid pick vgf strategy")
 		}
-	default:
-		return nil, status.Error(codes.InvalidArgument, "invalid pick vgf strategy")
+		return nil, err
 	}
 
 	return &types.QuerySpOptimalGlobalVirtualGroupFamilyResponse{
 		GlobalVirtualGroupFamilyId: familyID,
+		Explanation:                explanation,
+		Candidates:                 candidates,
 	}, nil
 }
+
+func (k Keeper) SpExitPlan(goCtx context.Context, req *types.QuerySpExitPlanRequest) (*types.QuerySpExitPlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	plan, err := k.GetSpExitPlan(ctx, req.GetSpId())
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySpExitPlanResponse{
+		Plan: *plan,
+	}, nil
+}
+
+func (k Keeper) SwapInAuction(goCtx context.Context, req *types.QuerySwapInAuctionRequest) (*types.QuerySwapInAuctionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, found := k.GetSwapInAuction(ctx, req.GetGlobalVirtualGroupFamilyId(), req.GetGlobalVirtualGroupId())
+	if !found {
+		return nil, types.ErrSwapInAuctionNotExist
+	}
+	return &types.QuerySwapInAuctionResponse{
+		Auction: auction,
+	}, nil
+}
+
+func (k Keeper) SwapInAuctions(goCtx context.Context, req *types.QuerySwapInAuctionsRequest) (*types.QuerySwapInAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var auctions []*types.SwapInAuction
+	auctionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SwapInAuctionKey)
+	pageRes, err := query.Paginate(auctionStore, req.Pagination, func(_ []byte, value []byte) error {
+		var auction types.SwapInAuction
+		k.cdc.MustUnmarshal(value, &auction)
+		auctions = append(auctions, &auction)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySwapInAuctionsResponse{Auctions: auctions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RebalanceProposal(goCtx context.Context, req *types.QueryRebalanceProposalRequest) (*types.QueryRebalanceProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return k.GetRebalanceProposal(ctx, req.MaxMoves)
+}
+
+func (k Keeper) RebalancePlan(goCtx context.Context, req *types.QueryRebalancePlanRequest) (*types.QueryRebalancePlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	plan, found := k.GetRebalancePlan(ctx, req.PlanId)
+	if !found {
+		return nil, types.ErrRebalancePlanNotExist
+	}
+	return &types.QueryRebalancePlanResponse{Plan: plan}, nil
+}
+
+func (k Keeper) RebalancePlans(goCtx context.Context, req *types.QueryRebalancePlansRequest) (*types.QueryRebalancePlansResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var plans []*types.RebalancePlan
+	planStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RebalancePlanKey)
+	pageRes, err := query.Paginate(planStore, req.Pagination, func(_ []byte, value []byte) error {
+		var plan types.RebalancePlan
+		k.cdc.MustUnmarshal(value, &plan)
+		plans = append(plans, &plan)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRebalancePlansResponse{Plans: plans, Pagination: pageRes}, nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+// RegisterInvariants registers all virtualgroup invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "gvg-staking", GVGStakingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "deposit-pool", DepositPoolInvariant(k))
+}
+
+// AllInvariants runs all invariants of the virtualgroup module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := GVGStakingInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return DepositPoolInvariant(k)(ctx)
+	}
+}
+
+// GVGStakingInvariant checks that the deposit of every global virtual group covers the
+// staking required by the data stored on it.
+func GVGStakingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		for _, gvg := range k.GetAllGVGs(ctx) {
+			if k.GetAvailableStakingTokens(ctx, gvg).IsNegative() {
+				count++
+				msg += fmt.Sprintf("\tgvg %d stores %d bytes with only %s deposited\n", gvg.Id, gvg.StoredSize, gvg.TotalDeposit)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "gvg staking",
+			fmt.Sprintf("found %d under-staked global virtual groups\n%s", count, msg)), broken
+	}
+}
+
+// DepositPoolInvariant checks that the virtualgroup module account holds at least the sum of
+// the deposits of all global virtual groups.
+func DepositPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalDeposit := math.ZeroInt()
+		for _, gvg := range k.GetAllGVGs(ctx) {
+			totalDeposit = totalDeposit.Add(gvg.TotalDeposit)
+		}
+
+		poolBalance := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), k.DepositDenomForGVG(ctx))
+
+		broken := poolBalance.Amount.LT(totalDeposit)
+		return sdk.FormatInvariant(types.ModuleName, "deposit pool",
+			fmt.Sprintf("\tgvg deposit pool balance: %s\n\tsum of gvg deposits: %s\n", poolBalance.Amount, totalDeposit)), broken
+	}
+}
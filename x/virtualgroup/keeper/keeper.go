@@ -24,11 +24,12 @@ type (
 		authority string
 
 		// Keepers
-		spKeeper      types.SpKeeper
-		accountKeeper types.AccountKeeper
-		bankKeeper    types.BankKeeper
-		paymentKeeper types.PaymentKeeper
-		storageKeeper types.StorageKeeper
+		spKeeper        types.SpKeeper
+		accountKeeper   types.AccountKeeper
+		bankKeeper      types.BankKeeper
+		paymentKeeper   types.PaymentKeeper
+		storageKeeper   types.StorageKeeper
+		challengeKeeper types.ChallengeKeeper
 		// sequence
 		gvgSequence       sequence.Sequence[uint32]
 		gvgFamilySequence sequence.Sequence[uint32]
@@ -64,6 +65,11 @@ func (k *Keeper) SetStorageKeeper(storageKeeper types.StorageKeeper) {
 	k.storageKeeper = storageKeeper
 }
 
+// SetChallengeKeeper sets the challenge keeper, which is created after the virtual group keeper.
+func (k *Keeper) SetChallengeKeeper(challengeKeeper types.ChallengeKeeper) {
+	k.challengeKeeper = challengeKeeper
+}
+
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
@@ -172,6 +178,7 @@ func (k Keeper) DeleteGVG(ctx sdk.Context, primarySp *sptypes.StorageProvider, g
 	}
 
 	store.Delete(types.GetGVGKey(gvg.Id))
+	store.Delete(types.GetGVGCreateTimeKey(gvg.Id))
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventDeleteGlobalVirtualGroup{
 		Id:          gvg.Id,
 		PrimarySpId: gvg.PrimarySpId,
@@ -198,6 +205,58 @@ func (k Keeper) GetGVG(ctx sdk.Context, gvgID uint32) (*types.GlobalVirtualGroup
 	return &gvg, true
 }
 
+// GetAllGVGs returns all global virtual groups
+// setGVGCreateTime records the block time as the creation time of the GVG
+func (k Keeper) setGVGCreateTime(ctx sdk.Context, gvgID uint32) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockTime().Unix()))
+	store.Set(types.GetGVGCreateTimeKey(gvgID), bz)
+}
+
+// GetGVGCreateTime returns the block time the GVG was created at, it is not found for the GVGs created before it
+// was recorded.
+func (k Keeper) GetGVGCreateTime(ctx sdk.Context, gvgID uint32) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGVGCreateTimeKey(gvgID))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// setSpSwapInTime records the block time as the latest time the SP took over a family or GVG from another SP
+func (k Keeper) setSpSwapInTime(ctx sdk.Context, spID uint32) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockTime().Unix()))
+	store.Set(types.GetSpSwapInTimeKey(spID), bz)
+}
+
+// GetSpSwapInTime returns the latest block time the SP took over a family or GVG from another SP, by either
+// completing a swap in or a swap out.
+func (k Keeper) GetSpSwapInTime(ctx sdk.Context, spID uint32) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSpSwapInTimeKey(spID))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+func (k Keeper) GetAllGVGs(ctx sdk.Context) (gvgs []*types.GlobalVirtualGroup) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GVGKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var gvg types.GlobalVirtualGroup
+		k.cdc.MustUnmarshal(iterator.Value(), &gvg)
+		gvgs = append(gvgs, &gvg)
+	}
+	return gvgs
+}
+
 func (k Keeper) SetGVGFamilyAndEmitUpdateEvent(ctx sdk.Context, gvgFamily *types.GlobalVirtualGroupFamily) error {
 	k.SetGVGFamily(ctx, gvgFamily)
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventUpdateGlobalVirtualGroupFamily{
@@ -766,6 +825,7 @@ func (k Keeper) CompleteSwapOut(ctx sdk.Context, gvgFamilyID uint32, gvgIDs []ui
 			store.Delete(key)
 		}
 	}
+	k.setSpSwapInTime(ctx, successorSP.Id)
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventCompleteSwapOut{
 		StorageProviderId:          successorSP.Id,
 		SrcStorageProviderId:       swapOutInfo.SpId,
@@ -881,6 +941,9 @@ func (k Keeper) DeleteSwapInInfo(ctx sdk.Context, gvgFamilyID, gvgID uint32, suc
 		if err := deleteSwapInfo(types.GetSwapInGVGKey(gvgID)); err != nil {
 			return err
 		}
+		if err := k.updateRebalanceMove(ctx, gvgID, successorSPID, swapInInfo.TargetSpId, types.REBALANCE_MOVE_STATUS_CANCELED); err != nil {
+			return err
+		}
 	}
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventCancelSwapIn{
@@ -934,7 +997,13 @@ func (k Keeper) CompleteSwapIn(ctx sdk.Context, gvgFamilyID uint32, gvgID uint32
 			return err
 		}
 		store.Delete(key)
+		if err := k.updateRebalanceMove(ctx, gvgID, successorSP.Id, targetSecondarySP.Id, types.REBALANCE_MOVE_STATUS_COMPLETED); err != nil {
+			return err
+		}
 	}
+	k.setSpSwapInTime(ctx, successorSP.Id)
+	// the auction for the swap in, if any, ends along with it
+	k.DeleteSwapInAuction(ctx, gvgFamilyID, gvgID)
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventCompleteSwapIn{
 		StorageProviderId:          successorSP.Id,
 		TargetStorageProviderId:    swapInInfo.TargetSpId,
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v2 "github.com/mocachain/moca/v2/x/virtualgroup/keeper/v2"
+	v3 "github.com/mocachain/moca/v2/x/virtualgroup/keeper/v3"
 )
 
 type Migrator struct {
@@ -17,3 +18,7 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) MigrateV1toV2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+func (m Migrator) MigrateV2toV3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
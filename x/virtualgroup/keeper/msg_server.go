@@ -150,6 +150,7 @@ func (k msgServer) CreateGlobalVirtualGroup(goCtx context.Context, req *types.Ms
 	gvgFamily.AppendGVG(gvg.Id)
 
 	k.SetGVG(ctx, gvg)
+	k.setGVGCreateTime(ctx, gvg.Id)
 	k.SetGVGFamily(ctx, gvgFamily)
 	k.BatchSetGVGStatisticsWithinSP(ctx, gvgStatisticsWithinSPs)
 
@@ -623,5 +624,45 @@ func (k msgServer) StorageProviderForcedExit(goCtx context.Context, msg *types.M
 	}); err != nil {
 		return nil, err
 	}
+
+	// the successors of the families and GVGs of the sp are assigned by the swap in auctions
+	if err := k.StartSwapInAuctions(ctx, sp); err != nil {
+		return nil, err
+	}
 	return &types.MsgStorageProviderForcedExitResponse{}, nil
 }
+
+func (k msgServer) BidSwapIn(goCtx context.Context, msg *types.MsgBidSwapIn) (*types.MsgBidSwapInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.StorageProvider)
+	bidder, found := k.spKeeper.GetStorageProviderByOperatorAddr(ctx, operatorAddr)
+	if !found {
+		return nil, sptypes.ErrStorageProviderNotFound.Wrapf("The address must be the operator address of sp.")
+	}
+	bid, err := k.Keeper.BidSwapIn(ctx, bidder, msg.TargetSpId, msg.GlobalVirtualGroupFamilyId, msg.GlobalVirtualGroupId, msg.Capacity)
+	if err != nil {
+		return nil, err
+	}
+	if err = ctx.EventManager().EmitTypedEvents(&types.EventBidSwapIn{
+		StorageProviderId:          bidder.Id,
+		GlobalVirtualGroupFamilyId: msg.GlobalVirtualGroupFamilyId,
+		GlobalVirtualGroupId:       msg.GlobalVirtualGroupId,
+		TargetSpId:                 msg.TargetSpId,
+		StorePrice:                 bid.StorePrice,
+		Capacity:                   bid.Capacity,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgBidSwapInResponse{}, nil
+}
+
+func (k msgServer) SubmitRebalancePlan(goCtx context.Context, msg *types.MsgSubmitRebalancePlan) (*types.MsgSubmitRebalancePlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	plan, err := k.Keeper.SubmitRebalancePlan(ctx, msg.Submitter, msg.Moves)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitRebalancePlanResponse{PlanId: plan.Id}, nil
+}
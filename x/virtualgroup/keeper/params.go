@@ -37,6 +37,16 @@ func (k Keeper) SpConcurrentExitNum(ctx sdk.Context) (res uint32) {
 	return uint32(params.SpConcurrentExitNum.Uint64())
 }
 
+func (k Keeper) SwapInAuctionBiddingPeriod(ctx sdk.Context) (res uint64) {
+	params := k.GetParams(ctx)
+	return params.SwapInAuctionBiddingPeriod
+}
+
+func (k Keeper) MaxSwapInAuctionBids(ctx sdk.Context) (res uint32) {
+	params := k.GetParams(ctx)
+	return params.MaxSwapInAuctionBids
+}
+
 // GetParams returns the current sp module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
 	store := ctx.KVStore(k.storeKey)
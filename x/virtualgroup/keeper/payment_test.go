@@ -30,10 +30,11 @@ type TestSuite struct {
 	cdc                codec.Codec
 	virtualgroupKeeper *keeper.Keeper
 
-	bankKeeper    *types.MockBankKeeper
-	accountKeeper *types.MockAccountKeeper
-	spKeeper      *types.MockSpKeeper
-	paymentKeeper *types.MockPaymentKeeper
+	bankKeeper      *types.MockBankKeeper
+	accountKeeper   *types.MockAccountKeeper
+	spKeeper        *types.MockSpKeeper
+	paymentKeeper   *types.MockPaymentKeeper
+	challengeKeeper *types.MockChallengeKeeper
 
 	ctx sdk.Context
 }
@@ -49,6 +50,7 @@ func (s *TestSuite) SetupTest() {
 	accountKeeper := types.NewMockAccountKeeper(ctrl)
 	spKeeper := types.NewMockSpKeeper(ctrl)
 	paymentKeeper := types.NewMockPaymentKeeper(ctrl)
+	challengeKeeper := types.NewMockChallengeKeeper(ctrl)
 
 	s.ctx = testCtx.Ctx
 	s.virtualgroupKeeper = keeper.NewKeeper(
@@ -60,12 +62,14 @@ func (s *TestSuite) SetupTest() {
 		bankKeeper,
 		paymentKeeper,
 	)
+	s.virtualgroupKeeper.SetChallengeKeeper(challengeKeeper)
 
 	s.cdc = encCfg.Codec
 	s.bankKeeper = bankKeeper
 	s.accountKeeper = accountKeeper
 	s.spKeeper = spKeeper
 	s.paymentKeeper = paymentKeeper
+	s.challengeKeeper = challengeKeeper
 
 	// no pending challenge locks the deposit unless a test says otherwise
 	spKeeper.EXPECT().GetDepositLockUntil(gomock.Any(), gomock.Any()).Return(uint64(0)).AnyTimes()
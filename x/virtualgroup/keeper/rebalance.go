@@ -0,0 +1,362 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+// rebalanceState is the secondary sp load of the in service sps, which the moves of a rebalance plan update as they
+// are planned.
+type rebalanceState struct {
+	loads map[uint32]*types.SpLoad
+	// the in service sps ordered by the id
+	spIDs []uint32
+	gvgs  []*types.GlobalVirtualGroup
+}
+
+func (k Keeper) newRebalanceState(ctx sdk.Context) *rebalanceState {
+	state := &rebalanceState{
+		loads: make(map[uint32]*types.SpLoad),
+		gvgs:  k.GetAllGVGs(ctx),
+	}
+	stakingPerBytes := k.GVGStakingPerBytes(ctx)
+	for _, sp := range k.spKeeper.GetAllStorageProviders(ctx) {
+		if sp.Status != sptypes.STATUS_IN_SERVICE {
+			continue
+		}
+		load := &types.SpLoad{SpId: sp.Id, StakedCapacity: math.MaxUint64}
+		if stat, found := k.GetGVGStatisticsWithinSP(ctx, sp.Id); found {
+			load.SecondaryCount = stat.SecondaryCount
+		}
+		if capacity := sp.TotalDeposit.Quo(stakingPerBytes); capacity.IsUint64() {
+			load.StakedCapacity = capacity.Uint64()
+		}
+		state.loads[sp.Id] = load
+		state.spIDs = append(state.spIDs, sp.Id)
+	}
+	sort.Slice(state.spIDs, func(i, j int) bool { return state.spIDs[i] < state.spIDs[j] })
+
+	for _, gvg := range state.gvgs {
+		for _, secondarySPID := range gvg.SecondarySpIds {
+			if load, found := state.loads[secondarySPID]; found {
+				load.SecondaryStoredSize += gvg.StoredSize
+			}
+		}
+	}
+	return state
+}
+
+func (s *rebalanceState) metrics() types.RebalanceMetrics {
+	metrics := types.RebalanceMetrics{
+		Loads:              make([]types.SpLoad, 0, len(s.spIDs)),
+		MeanSecondaryCount: sdkmath.LegacyZeroDec(),
+	}
+	if len(s.spIDs) == 0 {
+		return metrics
+	}
+	total := uint64(0)
+	metrics.MinSecondaryCount = math.MaxUint32
+	for _, spID := range s.spIDs {
+		load := s.loads[spID]
+		metrics.Loads = append(metrics.Loads, *load)
+		metrics.MaxSecondaryCount = max(metrics.MaxSecondaryCount, load.SecondaryCount)
+		metrics.MinSecondaryCount = min(metrics.MinSecondaryCount, load.SecondaryCount)
+		total += uint64(load.SecondaryCount)
+	}
+	metrics.MeanSecondaryCount = sdkmath.LegacyNewDec(int64(total)).QuoInt64(int64(len(s.spIDs))) //nolint:gosec // the sum of uint32 counts
+	metrics.Imbalance = metrics.MaxSecondaryCount - metrics.MinSecondaryCount
+	return metrics
+}
+
+func (s *rebalanceState) apply(move *types.RebalanceMove) {
+	from, to := s.loads[move.FromSpId], s.loads[move.ToSpId]
+	from.SecondaryCount--
+	from.SecondaryStoredSize -= move.StoredSize
+	to.SecondaryCount++
+	to.SecondaryStoredSize += move.StoredSize
+}
+
+// checkRebalanceMove checks the global virtual group can move from the secondary sp to the other in service sp,
+// whose deposit must back the stored size it serves as a secondary sp after the move.
+func (k Keeper) checkRebalanceMove(ctx sdk.Context, state *rebalanceState, gvg *types.GlobalVirtualGroup, fromSPID, toSPID uint32) error {
+	if _, found := state.loads[fromSPID]; !found {
+		return types.ErrInvalidRebalanceMove.Wrapf("the sp(ID: %d) to move from is not in service", fromSPID)
+	}
+	toLoad, found := state.loads[toSPID]
+	if !found {
+		return types.ErrInvalidRebalanceMove.Wrapf("the sp(ID: %d) to move to is not in service", toSPID)
+	}
+	if gvg.PrimarySpId == toSPID {
+		return types.ErrInvalidRebalanceMove.Wrapf("the sp(ID: %d) is the primary sp of the GVG(ID: %d)", toSPID, gvg.Id)
+	}
+	isSecondary := false
+	for _, secondarySPID := range gvg.SecondarySpIds {
+		if secondarySPID == toSPID {
+			return types.ErrInvalidRebalanceMove.Wrapf("the sp(ID: %d) is already a secondary sp of the GVG(ID: %d)", toSPID, gvg.Id)
+		}
+		if secondarySPID == fromSPID {
+			isSecondary = true
+		}
+	}
+	if !isSecondary {
+		return types.ErrInvalidRebalanceMove.Wrapf("the sp(ID: %d) is not a secondary sp of the GVG(ID: %d)", fromSPID, gvg.Id)
+	}
+	if toLoad.SecondaryStoredSize+gvg.StoredSize > toLoad.StakedCapacity {
+		return types.ErrInvalidRebalanceMove.Wrapf("the deposit of the sp(ID: %d) backs %d bytes, can not serve %d more bytes over %d bytes",
+			toSPID, toLoad.StakedCapacity, gvg.StoredSize, toLoad.SecondaryStoredSize)
+	}
+	if ctx.KVStore(k.storeKey).Has(types.GetRebalancePendingMoveKey(gvg.Id)) {
+		return types.ErrInvalidRebalanceMove.Wrapf("the GVG(ID: %d) is moving by another rebalance plan", gvg.Id)
+	}
+	if _, found := k.getSwapOutInfo(ctx, types.GetSwapOutGVGKey(gvg.Id)); found {
+		return types.ErrInvalidRebalanceMove.Wrapf("the GVG(ID: %d) is swapping out", gvg.Id)
+	}
+	//nolint:gosec // block time is never negative
+	if swapInInfo, found := k.GetSwapInInfo(ctx, types.NoSpecifiedFamilyID, gvg.Id); found && uint64(ctx.BlockTime().Unix()) < swapInInfo.ExpirationTime {
+		return types.ErrInvalidRebalanceMove.Wrapf("the SP(ID: %d) reserved the swap in of the GVG(ID: %d)", swapInInfo.SuccessorSpId, gvg.Id)
+	}
+	return nil
+}
+
+// GetRebalanceProposal computes the secondary sp load of the in service sps and proposes the moves which even it out:
+// one by one, a global virtual group of the most loaded sp moves to the least loaded sp which can take it over, until
+// the gap between them is at most one or no more move is possible. The proposal does not change any state.
+func (k Keeper) GetRebalanceProposal(ctx sdk.Context, maxMoves uint32) (*types.QueryRebalanceProposalResponse, error) {
+	if maxMoves == 0 || maxMoves > types.MaxRebalanceMovesPerPlan {
+		maxMoves = types.MaxRebalanceMovesPerPlan
+	}
+	state := k.newRebalanceState(ctx)
+	res := &types.QueryRebalanceProposalResponse{
+		Metrics: state.metrics(),
+		Moves:   make([]types.RebalanceMove, 0),
+	}
+
+	moved := make(map[uint32]bool)
+	// the sps which have no global virtual group to move out anymore
+	exhausted := make(map[uint32]bool)
+	for uint32(len(res.Moves)) < maxMoves {
+		move := k.proposeRebalanceMove(ctx, state, moved, exhausted)
+		if move == nil {
+			break
+		}
+		state.apply(move)
+		moved[move.GlobalVirtualGroupId] = true
+		res.Moves = append(res.Moves, *move)
+	}
+	res.ProjectedMetrics = state.metrics()
+	return res, nil
+}
+
+func (k Keeper) proposeRebalanceMove(ctx sdk.Context, state *rebalanceState, moved, exhausted map[uint32]bool) *types.RebalanceMove {
+	for {
+		// the most loaded sp, the smaller id first
+		var fromLoad *types.SpLoad
+		for _, spID := range state.spIDs {
+			if load := state.loads[spID]; !exhausted[spID] && (fromLoad == nil || load.SecondaryCount > fromLoad.SecondaryCount) {
+				fromLoad = load
+			}
+		}
+		if fromLoad == nil {
+			return nil
+		}
+
+		// the sps less loaded by two at least, from the least loaded
+		toSPIDs := make([]uint32, 0)
+		for _, spID := range state.spIDs {
+			if state.loads[spID].SecondaryCount+1 < fromLoad.SecondaryCount {
+				toSPIDs = append(toSPIDs, spID)
+			}
+		}
+		if len(toSPIDs) == 0 {
+			// the load of the most loaded sp is even with the others already
+			return nil
+		}
+		sort.SliceStable(toSPIDs, func(i, j int) bool {
+			return state.loads[toSPIDs[i]].SecondaryCount < state.loads[toSPIDs[j]].SecondaryCount
+		})
+
+		for _, toSPID := range toSPIDs {
+			for _, gvg := range state.gvgs {
+				if moved[gvg.Id] {
+					continue
+				}
+				if err := k.checkRebalanceMove(ctx, state, gvg, fromLoad.SpId, toSPID); err != nil {
+					continue
+				}
+				return &types.RebalanceMove{
+					GlobalVirtualGroupId: gvg.Id,
+					FromSpId:             fromLoad.SpId,
+					ToSpId:               toSPID,
+					StoredSize:           gvg.StoredSize,
+				}
+			}
+		}
+		exhausted[fromLoad.SpId] = true
+	}
+}
+
+// SubmitRebalancePlan reserves the swap in of every move for the sp to move to, so that the moves are done by the
+// usual MsgCompleteSwapIn. The authority can submit any moves, and an sp only the moves out of itself, so that an sp
+// can't take over the secondary slot of another healthy sp on its own.
+func (k Keeper) SubmitRebalancePlan(ctx sdk.Context, submitter string, moves []types.RebalanceMove) (*types.RebalancePlan, error) {
+	if submitter != k.authority {
+		sp, found := k.spKeeper.GetStorageProviderByOperatorAddr(ctx, sdk.MustAccAddressFromHex(submitter))
+		if !found {
+			return nil, sptypes.ErrStorageProviderNotFound.Wrapf("The submitter must be the operator address of sp or the authority.")
+		}
+		for _, move := range moves {
+			if move.FromSpId != sp.Id {
+				return nil, types.ErrInvalidRebalanceMove.Wrapf("the sp(ID: %d) can only move itself out of the GVG(ID: %d), not the sp(ID: %d)",
+					sp.Id, move.GlobalVirtualGroupId, move.FromSpId)
+			}
+		}
+	}
+
+	//nolint:gosec // the validity period is bounded by the params
+	expirationTime := ctx.BlockTime().Unix() + int64(k.SwapInValidityPeriod(ctx))
+	state := k.newRebalanceState(ctx)
+	plan := &types.RebalancePlan{
+		Id:             k.genNextRebalancePlanID(ctx),
+		Submitter:      submitter,
+		Moves:          make([]types.RebalanceMove, 0, len(moves)),
+		CreateTime:     ctx.BlockTime().Unix(),
+		ExpirationTime: expirationTime,
+	}
+	for _, move := range moves {
+		gvg, found := k.GetGVG(ctx, move.GlobalVirtualGroupId)
+		if !found {
+			return nil, types.ErrGVGNotExist.Wrapf("GVG(ID: %d) not found", move.GlobalVirtualGroupId)
+		}
+		if err := k.checkRebalanceMove(ctx, state, gvg, move.FromSpId, move.ToSpId); err != nil {
+			return nil, err
+		}
+		if err := k.setSwapInInfo(ctx, types.GetSwapInGVGKey(gvg.Id), move.ToSpId, move.FromSpId, expirationTime); err != nil {
+			return nil, err
+		}
+		move.StoredSize = gvg.StoredSize
+		move.Status = types.REBALANCE_MOVE_STATUS_PENDING
+		state.apply(&move)
+		plan.Moves = append(plan.Moves, move)
+		ctx.KVStore(k.storeKey).Set(types.GetRebalancePendingMoveKey(gvg.Id), sdk.Uint64ToBigEndian(plan.Id))
+	}
+	k.SetRebalancePlan(ctx, plan)
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventSubmitRebalancePlan{
+		PlanId:         plan.Id,
+		Submitter:      submitter,
+		Moves:          plan.Moves,
+		ExpirationTime: expirationTime,
+	}); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (k Keeper) genNextRebalancePlanID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	planID := sdk.BigEndianToUint64(store.Get(types.RebalancePlanSequenceKey)) + 1
+	store.Set(types.RebalancePlanSequenceKey, sdk.Uint64ToBigEndian(planID))
+	return planID
+}
+
+func (k Keeper) SetRebalancePlan(ctx sdk.Context, plan *types.RebalancePlan) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRebalancePlanKey(plan.Id), k.cdc.MustMarshal(plan))
+}
+
+func (k Keeper) GetRebalancePlan(ctx sdk.Context, planID uint64) (*types.RebalancePlan, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRebalancePlanKey(planID))
+	if bz == nil {
+		return nil, false
+	}
+	plan := &types.RebalancePlan{}
+	k.cdc.MustUnmarshal(bz, plan)
+	return plan, true
+}
+
+// updateRebalanceMove updates the status of the pending move of the global virtual group, if the swap in from the
+// sp to the successor sp is reserved by a rebalance plan.
+func (k Keeper) updateRebalanceMove(ctx sdk.Context, gvgID, successorSPID, targetSPID uint32, status types.RebalanceMoveStatus) error {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRebalancePendingMoveKey(gvgID))
+	if bz == nil {
+		return nil
+	}
+	plan, found := k.GetRebalancePlan(ctx, sdk.BigEndianToUint64(bz))
+	if !found {
+		return types.ErrRebalancePlanNotExist
+	}
+	for i := range plan.Moves {
+		move := &plan.Moves[i]
+		if move.GlobalVirtualGroupId != gvgID || move.Status != types.REBALANCE_MOVE_STATUS_PENDING ||
+			move.ToSpId != successorSPID || move.FromSpId != targetSPID {
+			continue
+		}
+		move.Status = status
+		k.SetRebalancePlan(ctx, plan)
+		store.Delete(types.GetRebalancePendingMoveKey(gvgID))
+		return ctx.EventManager().EmitTypedEvents(&types.EventUpdateRebalanceMove{
+			PlanId:               plan.Id,
+			GlobalVirtualGroupId: gvgID,
+			FromSpId:             move.FromSpId,
+			ToSpId:               move.ToSpId,
+			Status:               status,
+		})
+	}
+	return nil
+}
+
+// ExpireRebalancePlans runs in the EndBlocker. The pending moves of the expired plans are marked expired, and the
+// swap ins reserved for them are released.
+func (k Keeper) ExpireRebalancePlans(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.RebalancePendingMoveKey)
+	type pendingMove struct {
+		gvgID uint32
+		plan  *types.RebalancePlan
+	}
+	expired := make([]pendingMove, 0)
+	plans := make(map[uint64]*types.RebalancePlan)
+	for ; iterator.Valid(); iterator.Next() {
+		planID := sdk.BigEndianToUint64(iterator.Value())
+		plan, found := plans[planID]
+		if !found {
+			if plan, found = k.GetRebalancePlan(ctx, planID); !found {
+				iterator.Close()
+				return types.ErrRebalancePlanNotExist
+			}
+			plans[planID] = plan
+		}
+		if ctx.BlockTime().Unix() >= plan.ExpirationTime {
+			gvgID := binary.BigEndian.Uint32(iterator.Key()[len(types.RebalancePendingMoveKey):])
+			expired = append(expired, pendingMove{gvgID: gvgID, plan: plan})
+		}
+	}
+	iterator.Close()
+
+	for _, pending := range expired {
+		for _, move := range pending.plan.Moves {
+			if move.GlobalVirtualGroupId != pending.gvgID {
+				continue
+			}
+			if swapInInfo, found := k.GetSwapInInfo(ctx, types.NoSpecifiedFamilyID, move.GlobalVirtualGroupId); found &&
+				swapInInfo.SuccessorSpId == move.ToSpId && swapInInfo.TargetSpId == move.FromSpId {
+				store.Delete(types.GetSwapInGVGKey(move.GlobalVirtualGroupId))
+			}
+			if err := k.updateRebalanceMove(ctx, move.GlobalVirtualGroupId, move.ToSpId, move.FromSpId, types.REBALANCE_MOVE_STATUS_EXPIRED); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	return nil
+}
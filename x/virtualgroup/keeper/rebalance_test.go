@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestRebalancePlan() {
+	ctx := s.ctx.WithBlockTime(time.Unix(10000, 0))
+	validityPeriod := s.virtualgroupKeeper.GetParams(ctx).SwapInValidityPeriod.Int64()
+
+	// sp 2, 3 and 4 are in service and their deposit backs 1000 bytes, sp 5 is in maintenance
+	deposit := types.DefaultGVGStakingPerBytes.MulRaw(1000)
+	sps := []sptypes.StorageProvider{
+		{Id: 2, Status: sptypes.STATUS_IN_SERVICE, TotalDeposit: deposit, OperatorAddress: sample.RandAccAddress().String()},
+		{Id: 3, Status: sptypes.STATUS_IN_SERVICE, TotalDeposit: deposit, OperatorAddress: sample.RandAccAddress().String()},
+		{Id: 4, Status: sptypes.STATUS_IN_SERVICE, TotalDeposit: deposit, OperatorAddress: sample.RandAccAddress().String()},
+		{Id: 5, Status: sptypes.STATUS_IN_MAINTENANCE, TotalDeposit: deposit, OperatorAddress: sample.RandAccAddress().String()},
+	}
+	s.spKeeper.EXPECT().GetAllStorageProviders(gomock.Any()).Return(sps).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, operator sdk.AccAddress) (*sptypes.StorageProvider, bool) {
+			for i := range sps {
+				if sps[i].OperatorAddress == operator.String() {
+					return &sps[i], true
+				}
+			}
+			return nil, false
+		}).AnyTimes()
+
+	// sp 2 and 3 are the secondary sps of all the gvgs of sp 5, sp 4 serves none
+	for _, gvgID := range []uint32{1, 2, 3} {
+		s.virtualgroupKeeper.SetGVG(ctx, &types.GlobalVirtualGroup{
+			Id: gvgID, FamilyId: 1, PrimarySpId: 5, SecondarySpIds: []uint32{2, 3}, StoredSize: 100, TotalDeposit: math.NewInt(1),
+		})
+	}
+	s.virtualgroupKeeper.SetGVGStatisticsWithSP(ctx, &types.GVGStatisticsWithinSP{StorageProviderId: 2, SecondaryCount: 3})
+	s.virtualgroupKeeper.SetGVGStatisticsWithSP(ctx, &types.GVGStatisticsWithinSP{StorageProviderId: 3, SecondaryCount: 3})
+
+	proposal, err := s.virtualgroupKeeper.GetRebalanceProposal(ctx, 0)
+	s.Require().NoError(err)
+	s.Require().Len(proposal.Metrics.Loads, 3)
+	s.Require().Equal(uint32(3), proposal.Metrics.Imbalance)
+	s.Require().True(math.LegacyNewDec(2).Equal(proposal.Metrics.MeanSecondaryCount))
+	s.Require().Equal([]types.RebalanceMove{
+		{GlobalVirtualGroupId: 1, FromSpId: 2, ToSpId: 4, StoredSize: 100},
+		{GlobalVirtualGroupId: 2, FromSpId: 3, ToSpId: 4, StoredSize: 100},
+	}, proposal.Moves)
+	s.Require().Equal(uint32(0), proposal.ProjectedMetrics.Imbalance)
+	s.Require().Equal(uint64(200), proposal.ProjectedMetrics.Loads[2].SecondaryStoredSize)
+
+	proposal, err = s.virtualgroupKeeper.GetRebalanceProposal(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Len(proposal.Moves, 1)
+	s.Require().Equal(uint32(2), proposal.ProjectedMetrics.Imbalance)
+
+	// an sp can only move itself out, to the in service sps
+	_, err = s.virtualgroupKeeper.SubmitRebalancePlan(ctx, sps[0].OperatorAddress, []types.RebalanceMove{
+		{GlobalVirtualGroupId: 2, FromSpId: 3, ToSpId: 4},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidRebalanceMove)
+	_, err = s.virtualgroupKeeper.SubmitRebalancePlan(ctx, sps[0].OperatorAddress, []types.RebalanceMove{
+		{GlobalVirtualGroupId: 1, FromSpId: 2, ToSpId: 5},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidRebalanceMove)
+
+	// the sp to move to can't evict a healthy sp on its own
+	_, err = s.virtualgroupKeeper.SubmitRebalancePlan(ctx, sps[2].OperatorAddress, proposal.Moves)
+	s.Require().ErrorIs(err, types.ErrInvalidRebalanceMove)
+
+	plan, err := s.virtualgroupKeeper.SubmitRebalancePlan(ctx, sps[0].OperatorAddress, proposal.Moves)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), plan.Id)
+	swapInInfo, found := s.virtualgroupKeeper.GetSwapInInfo(ctx, types.NoSpecifiedFamilyID, 1)
+	s.Require().True(found)
+	s.Require().Equal(uint32(4), swapInInfo.SuccessorSpId)
+	s.Require().Equal(uint32(2), swapInInfo.TargetSpId)
+
+	// a gvg moves by one plan at a time
+	_, err = s.virtualgroupKeeper.SubmitRebalancePlan(ctx, s.virtualgroupKeeper.GetAuthority(), []types.RebalanceMove{
+		{GlobalVirtualGroupId: 1, FromSpId: 3, ToSpId: 4},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidRebalanceMove)
+
+	// the authority can submit any moves
+	plan, err = s.virtualgroupKeeper.SubmitRebalancePlan(ctx, s.virtualgroupKeeper.GetAuthority(), []types.RebalanceMove{
+		{GlobalVirtualGroupId: 2, FromSpId: 3, ToSpId: 4},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), plan.Id)
+
+	// the move is canceled along with the swap in
+	s.Require().NoError(s.virtualgroupKeeper.DeleteSwapInInfo(ctx, types.NoSpecifiedFamilyID, 1, 4))
+	plan, found = s.virtualgroupKeeper.GetRebalancePlan(ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(types.REBALANCE_MOVE_STATUS_CANCELED, plan.Moves[0].Status)
+	plan, found = s.virtualgroupKeeper.GetRebalancePlan(ctx, 2)
+	s.Require().True(found)
+	s.Require().Equal(types.REBALANCE_MOVE_STATUS_PENDING, plan.Moves[0].Status)
+
+	// the pending moves expire along with the plan
+	ctx = ctx.WithBlockTime(time.Unix(10000+validityPeriod, 0))
+	s.Require().NoError(s.virtualgroupKeeper.ExpireRebalancePlans(ctx))
+	plan, found = s.virtualgroupKeeper.GetRebalancePlan(ctx, 2)
+	s.Require().True(found)
+	s.Require().Equal(types.REBALANCE_MOVE_STATUS_EXPIRED, plan.Moves[0].Status)
+	_, found = s.virtualgroupKeeper.GetSwapInInfo(ctx, types.NoSpecifiedFamilyID, 2)
+	s.Require().False(found)
+}
@@ -0,0 +1,367 @@
+package keeper
+
+import (
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func getSwapInAuctionKey(gvgFamilyID, gvgID uint32) []byte {
+	if gvgFamilyID != types.NoSpecifiedFamilyID {
+		return types.GetSwapInAuctionFamilyKey(gvgFamilyID)
+	}
+	return types.GetSwapInAuctionGVGKey(gvgID)
+}
+
+// swapInAuctionDueTime returns the time the auction is settled next: the deadline of the swap in assigned to or
+// reserved by an sp if any, the time the bidding window closes otherwise.
+func swapInAuctionDueTime(auction *types.SwapInAuction) int64 {
+	return max(auction.BiddingEndTime, auction.Deadline)
+}
+
+// SetSwapInAuction keeps the auction and queues it by the time it is settled next.
+func (k Keeper) SetSwapInAuction(ctx sdk.Context, auction *types.SwapInAuction) {
+	store := ctx.KVStore(k.storeKey)
+	key := getSwapInAuctionKey(auction.GlobalVirtualGroupFamilyId, auction.GlobalVirtualGroupId)
+	if previous, found := k.GetSwapInAuction(ctx, auction.GlobalVirtualGroupFamilyId, auction.GlobalVirtualGroupId); found {
+		store.Delete(types.GetSwapInAuctionQueueKey(swapInAuctionDueTime(previous), key))
+	}
+	store.Set(key, k.cdc.MustMarshal(auction))
+	store.Set(types.GetSwapInAuctionQueueKey(swapInAuctionDueTime(auction), key), key)
+}
+
+func (k Keeper) GetSwapInAuction(ctx sdk.Context, gvgFamilyID, gvgID uint32) (*types.SwapInAuction, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(getSwapInAuctionKey(gvgFamilyID, gvgID))
+	if bz == nil {
+		return nil, false
+	}
+	auction := &types.SwapInAuction{}
+	k.cdc.MustUnmarshal(bz, auction)
+	return auction, true
+}
+
+func (k Keeper) DeleteSwapInAuction(ctx sdk.Context, gvgFamilyID, gvgID uint32) {
+	auction, found := k.GetSwapInAuction(ctx, gvgFamilyID, gvgID)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := getSwapInAuctionKey(gvgFamilyID, gvgID)
+	store.Delete(types.GetSwapInAuctionQueueKey(swapInAuctionDueTime(auction), key))
+	store.Delete(key)
+}
+
+// GetAllSwapInAuctions returns the swap in auctions of the families first, then the ones of the global virtual groups.
+func (k Keeper) GetAllSwapInAuctions(ctx sdk.Context) []*types.SwapInAuction {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SwapInAuctionKey)
+	defer iterator.Close()
+
+	auctions := make([]*types.SwapInAuction, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		auction := &types.SwapInAuction{}
+		k.cdc.MustUnmarshal(iterator.Value(), auction)
+		auctions = append(auctions, auction)
+	}
+	return auctions
+}
+
+// getDueSwapInAuctions returns at most limit auctions due to be settled by the block time, the earliest due first.
+func (k Keeper) getDueSwapInAuctions(ctx sdk.Context, limit int) []*types.SwapInAuction {
+	store := ctx.KVStore(k.storeKey)
+	end := types.GetSwapInAuctionQueueKey(ctx.BlockTime().Unix()+1, types.SwapInAuctionKey)
+	iterator := store.Iterator(types.SwapInAuctionQueueKey, end)
+	defer iterator.Close()
+
+	auctions := make([]*types.SwapInAuction, 0)
+	for ; iterator.Valid() && len(auctions) < limit; iterator.Next() {
+		auction := &types.SwapInAuction{}
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), auction)
+		auctions = append(auctions, auction)
+	}
+	return auctions
+}
+
+// StartSwapInAuctions opens a swap in auction for every family the forced exiting sp serves as the primary sp and
+// every global virtual group it serves as a secondary sp, so that the in service sps bid to succeed it instead of
+// volunteering one by one. Nothing is opened if the auction is disabled by the params.
+func (k Keeper) StartSwapInAuctions(ctx sdk.Context, sp *sptypes.StorageProvider) error {
+	biddingPeriod := k.SwapInAuctionBiddingPeriod(ctx)
+	if biddingPeriod == 0 {
+		return nil
+	}
+	//nolint:gosec // the bidding period is bounded by the params
+	biddingEndTime := ctx.BlockTime().Unix() + int64(biddingPeriod)
+
+	if familyStats, found := k.GetGVGFamilyStatisticsWithinSP(ctx, sp.Id); found {
+		for _, familyID := range familyStats.GlobalVirtualGroupFamilyIds {
+			family, found := k.GetGVGFamily(ctx, familyID)
+			if !found {
+				return types.ErrGVGFamilyNotExist
+			}
+			if err := k.startSwapInAuction(ctx, family.Id, types.NoSpecifiedGVGId, sp.Id, k.GetStoreSizeOfFamily(ctx, family), biddingEndTime); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the global virtual groups are only scanned until the ones the sp serves as a secondary sp are all found
+	gvgStats, found := k.GetGVGStatisticsWithinSP(ctx, sp.Id)
+	if !found || gvgStats.SecondaryCount == 0 {
+		return nil
+	}
+	var secondaryGVGs []*types.GlobalVirtualGroup
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GVGKey)
+	for ; iterator.Valid() && uint32(len(secondaryGVGs)) < gvgStats.SecondaryCount; iterator.Next() {
+		var gvg types.GlobalVirtualGroup
+		k.cdc.MustUnmarshal(iterator.Value(), &gvg)
+		for _, secondarySPID := range gvg.SecondarySpIds {
+			if secondarySPID == sp.Id {
+				secondaryGVGs = append(secondaryGVGs, &gvg)
+				break
+			}
+		}
+	}
+	iterator.Close()
+
+	for _, gvg := range secondaryGVGs {
+		if err := k.startSwapInAuction(ctx, types.NoSpecifiedFamilyID, gvg.Id, sp.Id, gvg.StoredSize, biddingEndTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) startSwapInAuction(ctx sdk.Context, gvgFamilyID, gvgID, targetSPID uint32, storedSize uint64, biddingEndTime int64) error {
+	if _, found := k.GetSwapInAuction(ctx, gvgFamilyID, gvgID); found {
+		return nil
+	}
+	k.SetSwapInAuction(ctx, &types.SwapInAuction{
+		GlobalVirtualGroupFamilyId: gvgFamilyID,
+		GlobalVirtualGroupId:       gvgID,
+		TargetSpId:                 targetSPID,
+		StoredSize:                 storedSize,
+		BiddingEndTime:             biddingEndTime,
+	})
+	return ctx.EventManager().EmitTypedEvents(&types.EventStartSwapInAuction{
+		GlobalVirtualGroupFamilyId: gvgFamilyID,
+		GlobalVirtualGroupId:       gvgID,
+		TargetSpId:                 targetSPID,
+		BiddingEndTime:             biddingEndTime,
+	})
+}
+
+// BidSwapIn places or replaces the bid of the sp for the swap in auction of the family or global virtual group of
+// the target sp. The bid carries the current store price of the sp and the capacity it commits, which must cover
+// the stored size to take over.
+func (k Keeper) BidSwapIn(ctx sdk.Context, bidder *sptypes.StorageProvider, targetSPID, gvgFamilyID, gvgID uint32, capacity uint64) (*types.SwapInBid, error) {
+	auction, found := k.GetSwapInAuction(ctx, gvgFamilyID, gvgID)
+	if !found {
+		return nil, types.ErrSwapInAuctionNotExist
+	}
+	if auction.TargetSpId != targetSPID {
+		return nil, types.ErrInvalidSwapInBid.Wrapf("the auction is for the sp(ID: %d), not the sp(ID: %d)", auction.TargetSpId, targetSPID)
+	}
+	if ctx.BlockTime().Unix() >= auction.BiddingEndTime {
+		return nil, types.ErrInvalidSwapInBid.Wrapf("the bidding window closed at %d", auction.BiddingEndTime)
+	}
+	if bidder.Id == targetSPID {
+		return nil, types.ErrInvalidSwapInBid.Wrapf("The SP(ID=%d) can not swap itself", bidder.Id)
+	}
+	if bidder.Status != sptypes.STATUS_IN_SERVICE {
+		return nil, sptypes.ErrStorageProviderWrongStatus.Wrapf("The sp is not in service, status: %s", bidder.Status)
+	}
+	if capacity < auction.StoredSize {
+		return nil, types.ErrInvalidSwapInBid.Wrapf("the capacity %d can not cover the stored size %d", capacity, auction.StoredSize)
+	}
+	if gvgFamilyID == types.NoSpecifiedFamilyID {
+		gvg, found := k.GetGVG(ctx, gvgID)
+		if !found {
+			return nil, types.ErrGVGNotExist
+		}
+		if gvg.PrimarySpId == bidder.Id {
+			return nil, types.ErrInvalidSwapInBid.Wrapf("The SP(ID=%d) is already the primary SP of GVG(ID=%d)", bidder.Id, gvgID)
+		}
+		for _, secondarySPID := range gvg.SecondarySpIds {
+			if secondarySPID == bidder.Id {
+				return nil, types.ErrInvalidSwapInBid.Wrapf("The sp(ID: %d) is already one of the secondary in this GVG(ID:%d)", bidder.Id, gvgID)
+			}
+		}
+	}
+	price, found := k.spKeeper.GetSpStoragePrice(ctx, bidder.Id)
+	if !found {
+		return nil, types.ErrInvalidSwapInBid.Wrapf("the sp(ID: %d) has no storage price", bidder.Id)
+	}
+
+	bid := types.SwapInBid{
+		SpId:       bidder.Id,
+		StorePrice: price.StorePrice,
+		Capacity:   capacity,
+		BidTime:    ctx.BlockTime().Unix(),
+	}
+	replaced := false
+	for i := range auction.Bids {
+		if auction.Bids[i].SpId == bidder.Id {
+			auction.Bids[i] = bid
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		if uint32(len(auction.Bids)) >= k.MaxSwapInAuctionBids(ctx) {
+			return nil, types.ErrInvalidSwapInBid.Wrapf("the auction already has %d bids", len(auction.Bids))
+		}
+		auction.Bids = append(auction.Bids, bid)
+	}
+	sortSwapInBids(auction.Bids)
+	k.SetSwapInAuction(ctx, auction)
+	return &bid, nil
+}
+
+// sortSwapInBids sorts the bids from the best to the worst: the lower store price wins, then the larger capacity,
+// the earlier bid and the smaller sp id.
+func sortSwapInBids(bids []types.SwapInBid) {
+	sort.SliceStable(bids, func(i, j int) bool {
+		if !bids[i].StorePrice.Equal(bids[j].StorePrice) {
+			return bids[i].StorePrice.LT(bids[j].StorePrice)
+		}
+		if bids[i].Capacity != bids[j].Capacity {
+			return bids[i].Capacity > bids[j].Capacity
+		}
+		if bids[i].BidTime != bids[j].BidTime {
+			return bids[i].BidTime < bids[j].BidTime
+		}
+		return bids[i].SpId < bids[j].SpId
+	})
+}
+
+// SettleSwapInAuctions runs in the EndBlocker. When the bidding window of an auction closes, the best bidder is
+// assigned the swap in, with the swap in validity period as the deadline to complete it. A bidder who misses the
+// deadline or cancels the swap in is dropped and the next one is assigned, and the bidding window opens again if no
+// bidder is left. The auction ends once the swap in is completed, or at its next due time once the target sp is
+// swapped out of the family or global virtual group otherwise. Only the due auctions are visited, at most
+// types.MaxSettleSwapInAuctions of them per block.
+func (k Keeper) SettleSwapInAuctions(ctx sdk.Context) error {
+	for _, auction := range k.getDueSwapInAuctions(ctx, types.MaxSettleSwapInAuctions) {
+		if err := k.settleSwapInAuction(ctx, auction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) settleSwapInAuction(ctx sdk.Context, auction *types.SwapInAuction) error {
+	now := ctx.BlockTime().Unix()
+	if now < auction.BiddingEndTime {
+		return nil
+	}
+	familyID, gvgID := auction.GlobalVirtualGroupFamilyId, auction.GlobalVirtualGroupId
+	targetSP, found := k.spKeeper.GetStorageProvider(ctx, auction.TargetSpId)
+	if !found || !k.isSwapInTargetServing(ctx, auction) {
+		k.DeleteSwapInAuction(ctx, familyID, gvgID)
+		return nil
+	}
+
+	swapInInfo, found := k.GetSwapInInfo(ctx, familyID, gvgID)
+	if auction.AssignedSpId != 0 {
+		if found && swapInInfo.SuccessorSpId == auction.AssignedSpId {
+			//nolint:gosec // the expiration time is a block time
+			if now < int64(swapInInfo.ExpirationTime) {
+				auction.Deadline = int64(swapInInfo.ExpirationTime) //nolint:gosec // the expiration time is a block time
+				k.SetSwapInAuction(ctx, auction)
+				return nil
+			}
+			// clear the stale reservation, so that the next bidder can reserve the swap in
+			ctx.KVStore(k.storeKey).Delete(getSwapInInfoKey(familyID, gvgID))
+			found = false
+		}
+		if err := ctx.EventManager().EmitTypedEvents(&types.EventFailSwapIn{
+			StorageProviderId:          auction.AssignedSpId,
+			GlobalVirtualGroupFamilyId: familyID,
+			GlobalVirtualGroupId:       gvgID,
+			TargetSpId:                 auction.TargetSpId,
+		}); err != nil {
+			return err
+		}
+		auction.AssignedSpId = 0
+		auction.Deadline = 0
+	}
+	//nolint:gosec // the expiration time is a block time
+	if found && now < int64(swapInInfo.ExpirationTime) {
+		// an sp reserved the swap in by itself, wait for it
+		auction.Deadline = int64(swapInInfo.ExpirationTime) //nolint:gosec // the expiration time is a block time
+		k.SetSwapInAuction(ctx, auction)
+		return nil
+	}
+
+	//nolint:gosec // the validity period is bounded by the params
+	deadline := now + int64(k.SwapInValidityPeriod(ctx))
+	for len(auction.Bids) > 0 {
+		bid := auction.Bids[0]
+		auction.Bids = auction.Bids[1:]
+		bidder, found := k.spKeeper.GetStorageProvider(ctx, bid.SpId)
+		if !found || bidder.Status != sptypes.STATUS_IN_SERVICE {
+			continue
+		}
+		if err := k.SwapIn(ctx, familyID, gvgID, bidder.Id, targetSP, deadline); err != nil {
+			ctx.Logger().Info("skip the swap in bid", "sp", bidder.Id, "family", familyID, "gvg", gvgID, "err", err)
+			continue
+		}
+		auction.AssignedSpId = bidder.Id
+		auction.Deadline = deadline
+		k.SetSwapInAuction(ctx, auction)
+		return ctx.EventManager().EmitTypedEvents(&types.EventAssignSwapIn{
+			StorageProviderId:          bidder.Id,
+			GlobalVirtualGroupFamilyId: familyID,
+			GlobalVirtualGroupId:       gvgID,
+			TargetSpId:                 auction.TargetSpId,
+			Deadline:                   deadline,
+		})
+	}
+
+	// no bidder is left, open the bidding window again
+	biddingPeriod := k.SwapInAuctionBiddingPeriod(ctx)
+	if biddingPeriod == 0 {
+		k.DeleteSwapInAuction(ctx, familyID, gvgID)
+		return nil
+	}
+	//nolint:gosec // the bidding period is bounded by the params
+	auction.BiddingEndTime = now + int64(biddingPeriod)
+	auction.Deadline = 0
+	k.SetSwapInAuction(ctx, auction)
+	return ctx.EventManager().EmitTypedEvents(&types.EventStartSwapInAuction{
+		GlobalVirtualGroupFamilyId: familyID,
+		GlobalVirtualGroupId:       gvgID,
+		TargetSpId:                 auction.TargetSpId,
+		BiddingEndTime:             auction.BiddingEndTime,
+	})
+}
+
+// isSwapInTargetServing returns whether the target sp of the auction still serves in the family or global virtual group.
+func (k Keeper) isSwapInTargetServing(ctx sdk.Context, auction *types.SwapInAuction) bool {
+	if auction.GlobalVirtualGroupFamilyId != types.NoSpecifiedFamilyID {
+		family, found := k.GetGVGFamily(ctx, auction.GlobalVirtualGroupFamilyId)
+		return found && family.PrimarySpId == auction.TargetSpId
+	}
+	gvg, found := k.GetGVG(ctx, auction.GlobalVirtualGroupId)
+	if !found {
+		return false
+	}
+	for _, secondarySPID := range gvg.SecondarySpIds {
+		if secondarySPID == auction.TargetSpId {
+			return true
+		}
+	}
+	return false
+}
+
+func getSwapInInfoKey(gvgFamilyID, gvgID uint32) []byte {
+	if gvgFamilyID != types.NoSpecifiedFamilyID {
+		return types.GetSwapInFamilyKey(gvgFamilyID)
+	}
+	return types.GetSwapInGVGKey(gvgID)
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+
+	sptypes "github.com/mocachain/moca/v2/x/sp/types"
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestSwapInAuction() {
+	ctx := s.ctx.WithBlockTime(time.Unix(10000, 0))
+	params := s.virtualgroupKeeper.GetParams(ctx)
+	biddingPeriod := int64(params.SwapInAuctionBiddingPeriod)
+	validityPeriod := params.SwapInValidityPeriod.Int64()
+
+	// sp 1 is forced exiting, sp 2, 3 and 4 are in service
+	sps := []sptypes.StorageProvider{
+		{Id: 1, Status: sptypes.STATUS_FORCED_EXITING},
+		{Id: 2, Status: sptypes.STATUS_IN_SERVICE},
+		{Id: 3, Status: sptypes.STATUS_IN_SERVICE},
+		{Id: 4, Status: sptypes.STATUS_IN_SERVICE},
+	}
+	prices := map[uint32]int64{2: 20, 3: 10, 4: 10}
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, spID uint32) (*sptypes.StorageProvider, bool) {
+			for i := range sps {
+				if sps[i].Id == spID {
+					return &sps[i], true
+				}
+			}
+			return nil, false
+		}).AnyTimes()
+	s.spKeeper.EXPECT().GetSpStoragePrice(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, spID uint32) (sptypes.SpStoragePrice, bool) {
+			return sptypes.SpStoragePrice{SpId: spID, StorePrice: math.LegacyNewDec(prices[spID])}, true
+		}).AnyTimes()
+
+	// sp 1 is the primary sp of family 1 and a secondary sp of gvg 2
+	s.virtualgroupKeeper.SetGVGFamilyStatisticsWithinSP(ctx, &types.GVGFamilyStatisticsWithinSP{SpId: 1, GlobalVirtualGroupFamilyIds: []uint32{1}})
+	s.virtualgroupKeeper.SetGVGStatisticsWithSP(ctx, &types.GVGStatisticsWithinSP{StorageProviderId: 1, PrimaryCount: 1, SecondaryCount: 1})
+	s.virtualgroupKeeper.SetGVGFamily(ctx, &types.GlobalVirtualGroupFamily{Id: 1, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{1}})
+	s.virtualgroupKeeper.SetGVGFamily(ctx, &types.GlobalVirtualGroupFamily{Id: 2, PrimarySpId: 2, GlobalVirtualGroupIds: []uint32{2}})
+	s.virtualgroupKeeper.SetGVG(ctx, &types.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3}, StoredSize: 100})
+	s.virtualgroupKeeper.SetGVG(ctx, &types.GlobalVirtualGroup{Id: 2, FamilyId: 2, PrimarySpId: 2, SecondarySpIds: []uint32{1, 3}, StoredSize: 50})
+
+	s.Require().NoError(s.virtualgroupKeeper.StartSwapInAuctions(ctx, &sps[0]))
+	auctions := s.virtualgroupKeeper.GetAllSwapInAuctions(ctx)
+	s.Require().Len(auctions, 2)
+	s.Require().Equal(uint32(1), auctions[0].GlobalVirtualGroupFamilyId)
+	s.Require().Equal(uint64(100), auctions[0].StoredSize)
+	s.Require().Equal(uint32(2), auctions[1].GlobalVirtualGroupId)
+	s.Require().Equal(ctx.BlockTime().Unix()+biddingPeriod, auctions[1].BiddingEndTime)
+
+	// the bids which can not take over the gvg are rejected
+	_, err := s.virtualgroupKeeper.BidSwapIn(ctx, &sps[2], 1, types.NoSpecifiedFamilyID, 2, 50)
+	s.Require().ErrorIs(err, types.ErrInvalidSwapInBid)
+	_, err = s.virtualgroupKeeper.BidSwapIn(ctx, &sps[3], 1, types.NoSpecifiedFamilyID, 2, 49)
+	s.Require().ErrorIs(err, types.ErrInvalidSwapInBid)
+	_, err = s.virtualgroupKeeper.BidSwapIn(ctx, &sps[3], 2, types.NoSpecifiedFamilyID, 2, 50)
+	s.Require().ErrorIs(err, types.ErrInvalidSwapInBid)
+
+	// sp 3 and 4 offer the same price, sp 4 commits more capacity
+	_, err = s.virtualgroupKeeper.BidSwapIn(ctx, &sps[1], 1, 1, types.NoSpecifiedGVGId, 100)
+	s.Require().NoError(err)
+	_, err = s.virtualgroupKeeper.BidSwapIn(ctx, &sps[2], 1, 1, types.NoSpecifiedGVGId, 100)
+	s.Require().NoError(err)
+	_, err = s.virtualgroupKeeper.BidSwapIn(ctx, &sps[3], 1, 1, types.NoSpecifiedGVGId, 200)
+	s.Require().NoError(err)
+	auction, found := s.virtualgroupKeeper.GetSwapInAuction(ctx, 1, types.NoSpecifiedGVGId)
+	s.Require().True(found)
+	s.Require().Len(auction.Bids, 3)
+	s.Require().Equal(uint32(4), auction.Bids[0].SpId)
+	s.Require().Equal(uint32(3), auction.Bids[1].SpId)
+	s.Require().Equal(uint32(2), auction.Bids[2].SpId)
+
+	// nothing is settled while the bidding window is open
+	s.Require().NoError(s.virtualgroupKeeper.SettleSwapInAuctions(ctx))
+	_, found = s.virtualgroupKeeper.GetSwapInInfo(ctx, 1, types.NoSpecifiedGVGId)
+	s.Require().False(found)
+
+	// the best bidder is assigned once the window closes
+	ctx = ctx.WithBlockTime(time.Unix(10000+biddingPeriod, 0))
+	_, err = s.virtualgroupKeeper.BidSwapIn(ctx, &sps[1], 1, 1, types.NoSpecifiedGVGId, 1000)
+	s.Require().ErrorIs(err, types.ErrInvalidSwapInBid)
+	s.Require().NoError(s.virtualgroupKeeper.SettleSwapInAuctions(ctx))
+	auction, found = s.virtualgroupKeeper.GetSwapInAuction(ctx, 1, types.NoSpecifiedGVGId)
+	s.Require().True(found)
+	s.Require().Equal(uint32(4), auction.AssignedSpId)
+	s.Require().Equal(ctx.BlockTime().Unix()+validityPeriod, auction.Deadline)
+	swapInInfo, found := s.virtualgroupKeeper.GetSwapInInfo(ctx, 1, types.NoSpecifiedGVGId)
+	s.Require().True(found)
+	s.Require().Equal(uint32(4), swapInInfo.SuccessorSpId)
+
+	// the gvg auction has no bid, its bidding window opens again
+	auction, found = s.virtualgroupKeeper.GetSwapInAuction(ctx, types.NoSpecifiedFamilyID, 2)
+	s.Require().True(found)
+	s.Require().Equal(ctx.BlockTime().Unix()+biddingPeriod, auction.BiddingEndTime)
+
+	// sp 4 misses the deadline, the next bidder takes over
+	ctx = ctx.WithBlockTime(time.Unix(10000+biddingPeriod+validityPeriod, 0))
+	s.Require().NoError(s.virtualgroupKeeper.SettleSwapInAuctions(ctx))
+	auction, found = s.virtualgroupKeeper.GetSwapInAuction(ctx, 1, types.NoSpecifiedGVGId)
+	s.Require().True(found)
+	s.Require().Equal(uint32(3), auction.AssignedSpId)
+	s.Require().Len(auction.Bids, 1)
+	swapInInfo, found = s.virtualgroupKeeper.GetSwapInInfo(ctx, 1, types.NoSpecifiedGVGId)
+	s.Require().True(found)
+	s.Require().Equal(uint32(3), swapInInfo.SuccessorSpId)
+
+	// the auction ends at its next due time once sp 1 is swapped out of the family
+	s.virtualgroupKeeper.SetGVGFamily(ctx, &types.GlobalVirtualGroupFamily{Id: 1, PrimarySpId: 3, GlobalVirtualGroupIds: []uint32{1}})
+	s.Require().NoError(s.virtualgroupKeeper.SettleSwapInAuctions(ctx))
+	_, found = s.virtualgroupKeeper.GetSwapInAuction(ctx, 1, types.NoSpecifiedGVGId)
+	s.Require().True(found, "the auction is not visited before it is due")
+	ctx = ctx.WithBlockTime(time.Unix(auction.Deadline, 0))
+	s.Require().NoError(s.virtualgroupKeeper.SettleSwapInAuctions(ctx))
+	_, found = s.virtualgroupKeeper.GetSwapInAuction(ctx, 1, types.NoSpecifiedGVGId)
+	s.Require().False(found)
+}
+
+func (s *TestSuite) TestSettleSwapInAuctions_Due() {
+	ctx := s.ctx.WithBlockTime(time.Unix(10000, 0))
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).Return(
+		&sptypes.StorageProvider{Id: 1, Status: sptypes.STATUS_FORCED_EXITING}, true).AnyTimes()
+
+	// the target sp serves none of the gvgs, so every due auction ends once it is settled
+	dueCount := types.MaxSettleSwapInAuctions + 5
+	for i := 1; i <= dueCount; i++ {
+		s.virtualgroupKeeper.SetSwapInAuction(ctx, &types.SwapInAuction{
+			GlobalVirtualGroupId: uint32(i),
+			TargetSpId:           1,
+			BiddingEndTime:       ctx.BlockTime().Unix() - int64(i),
+		})
+	}
+	s.virtualgroupKeeper.SetSwapInAuction(ctx, &types.SwapInAuction{
+		GlobalVirtualGroupId: uint32(dueCount + 1),
+		TargetSpId:           1,
+		BiddingEndTime:       ctx.BlockTime().Unix() + 1,
+	})
+
+	// at most MaxSettleSwapInAuctions are settled per block, the earliest due first
+	s.Require().NoError(s.virtualgroupKeeper.SettleSwapInAuctions(ctx))
+	s.Require().Len(s.virtualgroupKeeper.GetAllSwapInAuctions(ctx), 6)
+	for i := 1; i <= 5; i++ {
+		_, found := s.virtualgroupKeeper.GetSwapInAuction(ctx, types.NoSpecifiedFamilyID, uint32(i))
+		s.Require().True(found)
+	}
+
+	// the auction not due yet is left
+	s.Require().NoError(s.virtualgroupKeeper.SettleSwapInAuctions(ctx))
+	auctions := s.virtualgroupKeeper.GetAllSwapInAuctions(ctx)
+	s.Require().Len(auctions, 1)
+	s.Require().Equal(uint32(dueCount+1), auctions[0].GlobalVirtualGroupId)
+}
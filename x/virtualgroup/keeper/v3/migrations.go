@@ -0,0 +1,23 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mocachain/moca/v2/x/virtualgroup/types"
+)
+
+// MigrateStore sets the swap in auction params, which read zero from the params stored before they were introduced.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.ParamsKey)
+	params := &types.Params{}
+	cdc.MustUnmarshal(bz, params)
+
+	params.SwapInAuctionBiddingPeriod = types.DefaultSwapInAuctionBiddingPeriod
+	params.MaxSwapInAuctionBids = types.DefaultMaxSwapInAuctionBids
+	store.Set(types.ParamsKey, cdc.MustMarshal(params))
+
+	return nil
+}
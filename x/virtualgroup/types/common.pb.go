@@ -29,6 +29,12 @@ const (
 	Strategy_Minimal_Free_Store_Size  PickVGFStrategy = 1
 	Strategy_Oldest_Create_Time       PickVGFStrategy = 2
 	Strategy_Recentest_Create_Time    PickVGFStrategy = 3
+	// Pick the family whose secondary sps have the lowest average store price.
+	Strategy_Cheapest PickVGFStrategy = 4
+	// Pick the family whose secondary sps have the least recent challenge slashes and maintenance time.
+	Strategy_Most_Reliable PickVGFStrategy = 5
+	// Pick the family with the best sum of the price, slash and maintenance scores, each normalized to the candidates.
+	Strategy_Balanced PickVGFStrategy = 6
 )
 
 var PickVGFStrategy_name = map[int32]string{
@@ -36,6 +42,9 @@ var PickVGFStrategy_name = map[int32]string{
 	1: "Strategy_Minimal_Free_Store_Size",
 	2: "Strategy_Oldest_Create_Time",
 	3: "Strategy_Recentest_Create_Time",
+	4: "Strategy_Cheapest",
+	5: "Strategy_Most_Reliable",
+	6: "Strategy_Balanced",
 }
 
 var PickVGFStrategy_value = map[string]int32{
@@ -43,6 +52,9 @@ var PickVGFStrategy_value = map[string]int32{
 	"Strategy_Minimal_Free_Store_Size":  1,
 	"Strategy_Oldest_Create_Time":       2,
 	"Strategy_Recentest_Create_Time":    3,
+	"Strategy_Cheapest":                 4,
+	"Strategy_Most_Reliable":            5,
+	"Strategy_Balanced":                 6,
 }
 
 func (x PickVGFStrategy) String() string {
@@ -60,22 +72,24 @@ func init() {
 func init() { proto.RegisterFile("moca/virtualgroup/common.proto", fileDescriptor_d5965adbe5242978) }
 
 var fileDescriptor_d5965adbe5242978 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0xcd, 0x4f, 0x4e,
-	0xd4, 0x2f, 0xcb, 0x2c, 0x2a, 0x29, 0x4d, 0xcc, 0x49, 0x2f, 0xca, 0x2f, 0x2d, 0xd0, 0x4f, 0xce,
-	0xcf, 0xcd, 0xcd, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0xc9, 0xeb, 0x21,
-	0xcb, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x65, 0xf5, 0x41, 0x2c, 0x88, 0x42, 0xad, 0x95,
-	0x8c, 0x5c, 0xfc, 0x01, 0x99, 0xc9, 0xd9, 0x61, 0xee, 0x6e, 0xc1, 0x25, 0x45, 0x89, 0x25, 0xa9,
-	0xe9, 0x95, 0x42, 0xaa, 0x5c, 0x8a, 0x30, 0x76, 0xbc, 0x6f, 0x62, 0x45, 0x66, 0x6e, 0x66, 0x55,
-	0x6a, 0xbc, 0x5b, 0x51, 0x6a, 0x6a, 0x7c, 0x70, 0x49, 0x7e, 0x51, 0x6a, 0x7c, 0x70, 0x66, 0x55,
-	0xaa, 0x00, 0x83, 0x90, 0x0a, 0x97, 0x02, 0x42, 0x59, 0x66, 0x5e, 0x66, 0x6e, 0x62, 0x0e, 0x86,
-	0x2a, 0x46, 0x21, 0x79, 0x2e, 0x69, 0xb8, 0x2a, 0xff, 0x9c, 0x94, 0xd4, 0xe2, 0x92, 0x78, 0xe7,
-	0xa2, 0xd4, 0xc4, 0x92, 0xd4, 0xf8, 0x90, 0xcc, 0xdc, 0x54, 0x01, 0x26, 0x21, 0x25, 0x2e, 0x39,
-	0xb8, 0x82, 0xa0, 0xd4, 0xe4, 0xd4, 0xbc, 0x12, 0x74, 0x35, 0xcc, 0x52, 0x2c, 0x1d, 0x8b, 0xe5,
-	0x18, 0x9c, 0xbc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x30, 0x3d,
-	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xe4, 0xf3, 0xe4, 0x8c, 0xc4, 0xcc,
-	0x3c, 0x7d, 0x48, 0x18, 0x19, 0xe9, 0x57, 0xa0, 0x06, 0x54, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12,
-	0x1b, 0xd8, 0xff, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x9e, 0x9f, 0x1c, 0x4a, 0x01,
-	0x00, 0x00,
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0xd0, 0x4f, 0x4a, 0x03, 0x31,
+	0x14, 0xc7, 0xf1, 0x19, 0xad, 0x5d, 0x64, 0xe3, 0x34, 0xa8, 0x8b, 0x0a, 0xf1, 0x0f, 0xba, 0x71,
+	0xd1, 0xa0, 0xde, 0xa0, 0x85, 0xba, 0x10, 0x51, 0x5a, 0x71, 0xe1, 0x26, 0xa4, 0xe9, 0x63, 0x1a,
+	0x4c, 0x26, 0x43, 0xe6, 0x55, 0xda, 0x9e, 0xc0, 0xa5, 0x77, 0xf0, 0x32, 0x2e, 0xbb, 0x74, 0x29,
+	0x33, 0x47, 0xf0, 0x02, 0x32, 0x2d, 0x4e, 0xad, 0xee, 0x1e, 0xfc, 0x3e, 0x81, 0xf0, 0x25, 0xcc,
+	0x3a, 0x25, 0xf9, 0xb3, 0xf6, 0x38, 0x96, 0x26, 0xf6, 0x6e, 0x9c, 0x72, 0xe5, 0xac, 0x75, 0x49,
+	0x2b, 0xf5, 0x0e, 0x1d, 0x6d, 0x94, 0x7b, 0xeb, 0xf7, 0xde, 0xdc, 0x89, 0x5d, 0xec, 0x16, 0x2b,
+	0x2f, 0xaf, 0x25, 0x3c, 0xfb, 0x0a, 0xc9, 0xf6, 0x9d, 0x56, 0x4f, 0x0f, 0x57, 0xdd, 0x3e, 0x7a,
+	0x89, 0x10, 0x4f, 0xe9, 0x29, 0x39, 0xfa, 0xb9, 0xc5, 0x8d, 0x9c, 0x68, 0xab, 0x67, 0x20, 0xba,
+	0x1e, 0x40, 0xf4, 0xd1, 0x79, 0x10, 0x7d, 0x3d, 0x83, 0x28, 0xa0, 0x27, 0xe4, 0x70, 0xc5, 0x74,
+	0xa2, 0xad, 0x34, 0xff, 0x54, 0x48, 0x0f, 0xc8, 0x7e, 0xa5, 0x6e, 0xcd, 0x10, 0x32, 0x14, 0x1d,
+	0x0f, 0x12, 0x41, 0xdc, 0x6b, 0x0b, 0xd1, 0x06, 0x3d, 0x26, 0xac, 0x02, 0x3d, 0x50, 0x90, 0xe0,
+	0x5f, 0xb3, 0x49, 0x77, 0x49, 0xa3, 0x32, 0x9d, 0x11, 0xc8, 0x14, 0x32, 0x8c, 0x6a, 0xb4, 0x49,
+	0xf6, 0x56, 0x3f, 0x70, 0x19, 0x8a, 0x1e, 0x18, 0x2d, 0x07, 0x06, 0xa2, 0xad, 0xb5, 0x27, 0x6d,
+	0x69, 0x64, 0xa2, 0x60, 0x18, 0xd5, 0x9b, 0xb5, 0x97, 0x37, 0x16, 0xb4, 0xaf, 0xdf, 0x73, 0x16,
+	0xce, 0x73, 0x16, 0x7e, 0xe6, 0x2c, 0x7c, 0x2d, 0x58, 0x30, 0x2f, 0x58, 0xf0, 0x51, 0xb0, 0xe0,
+	0xf1, 0x3c, 0xd6, 0x38, 0x1a, 0x0f, 0x5a, 0xca, 0x59, 0x5e, 0x36, 0x54, 0x23, 0xa9, 0x13, 0xbe,
+	0xac, 0x7d, 0xc1, 0x27, 0xeb, 0xc9, 0x71, 0x9a, 0x42, 0x36, 0xa8, 0x2f, 0x4a, 0x5e, 0x7e, 0x0f,
+	0x00, 0x51, 0x6a, 0xdb, 0xce, 0x94, 0x01, 0x00, 0x00,
 }
@@ -29,6 +29,11 @@ var (
 	ErrGVGFamilyStatisticsNotExist = errors.Register(ModuleName, 1130, "global virtual group family statistics not exist.")
 	ErrNotPrimarySP                = errors.Register(ModuleName, 1131, "the storage provider is not the primary sp of the global virtual group.")
 	ErrGVGFamilyNotOwned           = errors.Register(ModuleName, 1132, "the global virtual group family is not owned by the storage provider.")
+	ErrInvalidPickVGFStrategy      = errors.Register(ModuleName, 1133, "invalid pick vgf strategy.")
+	ErrSwapInAuctionNotExist       = errors.Register(ModuleName, 1134, "swap in auction not exist.")
+	ErrInvalidSwapInBid            = errors.Register(ModuleName, 1135, "invalid swap in bid.")
+	ErrRebalancePlanNotExist       = errors.Register(ModuleName, 1136, "rebalance plan not exist.")
+	ErrInvalidRebalanceMove        = errors.Register(ModuleName, 1137, "invalid rebalance move.")
 
 	ErrInvalidDenom = errors.Register(ModuleName, 2000, "Invalid denom.")
 )
@@ -1359,516 +1359,663 @@ func (m *EventSettleGlobalVirtualGroup) GetSpFundingAddresses() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*EventCreateGlobalVirtualGroup)(nil), "moca.virtualgroup.EventCreateGlobalVirtualGroup")
-	proto.RegisterType((*EventUpdateGlobalVirtualGroup)(nil), "moca.virtualgroup.EventUpdateGlobalVirtualGroup")
-	proto.RegisterType((*EventDeleteGlobalVirtualGroup)(nil), "moca.virtualgroup.EventDeleteGlobalVirtualGroup")
-	proto.RegisterType((*EventCreateGlobalVirtualGroupFamily)(nil), "moca.virtualgroup.EventCreateGlobalVirtualGroupFamily")
-	proto.RegisterType((*EventUpdateGlobalVirtualGroupFamily)(nil), "moca.virtualgroup.EventUpdateGlobalVirtualGroupFamily")
-	proto.RegisterType((*EventDeleteGlobalVirtualGroupFamily)(nil), "moca.virtualgroup.EventDeleteGlobalVirtualGroupFamily")
-	proto.RegisterType((*EventCreateLocalVirtualGroup)(nil), "moca.virtualgroup.EventCreateLocalVirtualGroup")
-	proto.RegisterType((*EventUpdateLocalVirtualGroup)(nil), "moca.virtualgroup.EventUpdateLocalVirtualGroup")
-	proto.RegisterType((*EventDeleteLocalVirtualGroup)(nil), "moca.virtualgroup.EventDeleteLocalVirtualGroup")
-	proto.RegisterType((*EventSwapOut)(nil), "moca.virtualgroup.EventSwapOut")
-	proto.RegisterType((*EventCompleteSwapOut)(nil), "moca.virtualgroup.EventCompleteSwapOut")
-	proto.RegisterType((*EventCancelSwapOut)(nil), "moca.virtualgroup.EventCancelSwapOut")
-	proto.RegisterType((*EventStorageProviderExit)(nil), "moca.virtualgroup.EventStorageProviderExit")
-	proto.RegisterType((*EventCompleteStorageProviderExit)(nil), "moca.virtualgroup.EventCompleteStorageProviderExit")
-	proto.RegisterType((*EventReserveSwapIn)(nil), "moca.virtualgroup.EventReserveSwapIn")
-	proto.RegisterType((*EventCompleteSwapIn)(nil), "moca.virtualgroup.EventCompleteSwapIn")
-	proto.RegisterType((*EventCancelSwapIn)(nil), "moca.virtualgroup.EventCancelSwapIn")
-	proto.RegisterType((*EventStorageProviderForcedExit)(nil), "moca.virtualgroup.EventStorageProviderForcedExit")
-	proto.RegisterType((*EventSettleGlobalVirtualGroupFamily)(nil), "moca.virtualgroup.EventSettleGlobalVirtualGroupFamily")
-	proto.RegisterType((*EventSettleGlobalVirtualGroup)(nil), "moca.virtualgroup.EventSettleGlobalVirtualGroup")
+type EventPickGlobalVirtualGroupFamily struct {
+	// The id of the primary sp the family belongs to
+	PrimarySpId uint32 `protobuf:"varint,1,opt,name=primary_sp_id,json=primarySpId,proto3" json:"primary_sp_id,omitempty"`
+	// The id of the global virtual group family picked for a new bucket
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The strategy the family is picked by
+	Strategy PickVGFStrategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=moca.virtualgroup.PickVGFStrategy" json:"strategy,omitempty"`
+	// The explanation of the choice
+	Explanation string `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`
 }
 
-func init() { proto.RegisterFile("moca/virtualgroup/events.proto", fileDescriptor_9023c6ceb1678bd1) }
-
-var fileDescriptor_9023c6ceb1678bd1 = []byte{
-	// 1029 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xae, 0x9d, 0x10, 0xbf, 0xc4, 0xf9, 0xb3, 0x71, 0xc8, 0x12, 0xc8, 0xc6, 0x5a, 0x24,
-	0xb0, 0x90, 0x6a, 0xf3, 0x47, 0x15, 0x07, 0xc4, 0x81, 0xb4, 0x4d, 0x31, 0x20, 0x11, 0xd9, 0x04,
-	0x09, 0x2e, 0xab, 0xc9, 0xee, 0x64, 0x33, 0xaa, 0x77, 0x67, 0x35, 0x33, 0x0e, 0x49, 0x3f, 0x02,
-	0x17, 0x10, 0x9f, 0x82, 0x23, 0x87, 0x7e, 0x05, 0xa4, 0x1e, 0xab, 0x9e, 0x10, 0x42, 0x55, 0x15,
-	0x57, 0x42, 0x9c, 0xb9, 0x21, 0x21, 0xa1, 0x9d, 0x19, 0xbb, 0xb1, 0xd7, 0x76, 0x5d, 0x27, 0x15,
-	0x90, 0x4b, 0x94, 0x7d, 0xf3, 0xe7, 0xbd, 0xdf, 0xef, 0xfd, 0xf6, 0xbd, 0xe7, 0x05, 0x27, 0xa2,
-	0x3e, 0xaa, 0x1d, 0x13, 0x26, 0xda, 0xa8, 0x15, 0x32, 0xda, 0x4e, 0x6a, 0xf8, 0x18, 0xc7, 0x82,
-	0x57, 0x13, 0x46, 0x05, 0xb5, 0x56, 0xd3, 0xf5, 0xea, 0xf9, 0xf5, 0xcd, 0x55, 0x14, 0x91, 0x98,
-	0xd6, 0xe4, 0x5f, 0xb5, 0x6b, 0xf3, 0x15, 0x9f, 0xf2, 0x88, 0x72, 0x4f, 0x3e, 0xd5, 0xd4, 0x83,
-	0x5e, 0x2a, 0x85, 0x34, 0xa4, 0xca, 0x9e, 0xfe, 0xa7, 0xac, 0xee, 0x13, 0x13, 0xb6, 0x6e, 0xa5,
-	0x7e, 0x6e, 0x30, 0x8c, 0x04, 0xbe, 0xdd, 0xa2, 0x07, 0xa8, 0xf5, 0xa5, 0xf2, 0x72, 0x3b, 0xf5,
-	0x62, 0x2d, 0x81, 0x49, 0x02, 0xdb, 0x28, 0x1b, 0x95, 0x62, 0xc3, 0x24, 0x81, 0xf5, 0x2a, 0x14,
-	0x0e, 0x51, 0x44, 0x5a, 0xa7, 0x1e, 0x09, 0x6c, 0x53, 0x9a, 0xe7, 0x95, 0xa1, 0x1e, 0x58, 0x2e,
-	0x14, 0x13, 0x46, 0x22, 0xc4, 0x4e, 0x3d, 0x9e, 0xa4, 0x1b, 0x72, 0x72, 0xc3, 0x82, 0x36, 0x36,
-	0x93, 0x7a, 0x60, 0x55, 0x60, 0x85, 0x63, 0x9f, 0xc6, 0x41, 0x6f, 0x17, 0xb7, 0xf3, 0xe5, 0x5c,
-	0xa5, 0xd8, 0x58, 0xea, 0xd9, 0xd3, 0x8d, 0xdc, 0xda, 0x86, 0x05, 0x2e, 0x28, 0xc3, 0x81, 0xc7,
-	0xc9, 0x5d, 0x6c, 0xcf, 0x96, 0x8d, 0x4a, 0xbe, 0x01, 0xca, 0xd4, 0x24, 0x77, 0xb1, 0xb5, 0x07,
-	0x1b, 0x9a, 0x11, 0x2f, 0x41, 0xa7, 0x11, 0x8e, 0x85, 0x87, 0x82, 0x80, 0x61, 0xce, 0xed, 0xb9,
-	0xb2, 0x51, 0x29, 0xec, 0xd8, 0x0f, 0xef, 0x5d, 0x2b, 0x69, 0x1a, 0x3e, 0x52, 0x2b, 0x4d, 0xc1,
-	0x48, 0x1c, 0x36, 0xd6, 0xf5, 0xc1, 0x3d, 0x75, 0x4e, 0x2f, 0x5a, 0xfb, 0x50, 0x14, 0x54, 0xa0,
-	0x96, 0x17, 0xe0, 0x84, 0x72, 0x22, 0xec, 0x97, 0xe4, 0x3d, 0x6f, 0xdf, 0x7f, 0xb4, 0x3d, 0xf3,
-	0xeb, 0xa3, 0xed, 0x75, 0x75, 0x17, 0x0f, 0xee, 0x54, 0x09, 0xad, 0x45, 0x48, 0x1c, 0x55, 0xeb,
-	0xb1, 0x78, 0x78, 0xef, 0x1a, 0x68, 0x27, 0xf5, 0x58, 0xfc, 0xf8, 0xfb, 0x4f, 0x6f, 0x19, 0x8d,
-	0x45, 0x79, 0xcd, 0x4d, 0x75, 0x8b, 0xfb, 0xa7, 0xa1, 0x69, 0xde, 0x4f, 0x82, 0xc9, 0x68, 0xde,
-	0x02, 0x05, 0x54, 0x41, 0x37, 0x25, 0xf4, 0x82, 0xb4, 0x48, 0xe4, 0x99, 0x38, 0x73, 0x97, 0x11,
-	0x67, 0x36, 0x7f, 0xf9, 0xc9, 0xf2, 0x37, 0x3b, 0x2c, 0x7f, 0x6e, 0x53, 0x83, 0xbe, 0x89, 0x5b,
-	0x78, 0x22, 0xd0, 0x19, 0xf7, 0x66, 0xc6, 0xbd, 0xfb, 0xc4, 0x80, 0xd7, 0xc7, 0x2a, 0x76, 0x57,
-	0x8a, 0x71, 0x9a, 0xbb, 0xc7, 0xe9, 0x29, 0x37, 0x9d, 0x9e, 0xde, 0x07, 0x3b, 0x94, 0x11, 0x7a,
-	0xdd, 0x8b, 0xe5, 0xbb, 0x7b, 0x4e, 0xf4, 0xeb, 0x61, 0x06, 0x41, 0xca, 0xdd, 0x0f, 0x5d, 0x98,
-	0xa3, 0x14, 0x73, 0x01, 0x98, 0xe3, 0x82, 0xca, 0x8d, 0x0b, 0xea, 0x2b, 0x1d, 0xd3, 0xa8, 0x84,
-	0x4e, 0x1f, 0x93, 0xfb, 0xb3, 0x01, 0xaf, 0x9d, 0x4b, 0xeb, 0x67, 0xd4, 0x7f, 0x86, 0x56, 0x3e,
-	0x84, 0xc2, 0x41, 0xdb, 0xbf, 0x83, 0x45, 0xf7, 0xc2, 0xc2, 0x4e, 0x59, 0xab, 0x3f, 0xbf, 0x4f,
-	0xa4, 0xd8, 0x17, 0x74, 0xa6, 0xd2, 0x47, 0xa5, 0xf6, 0x79, 0x75, 0xa4, 0x1e, 0x58, 0xd7, 0x61,
-	0x63, 0x04, 0x07, 0xba, 0x66, 0x95, 0x86, 0x51, 0x30, 0x58, 0x92, 0xf2, 0x83, 0x25, 0xe9, 0x29,
-	0x0e, 0x95, 0xb7, 0xff, 0x2d, 0x8e, 0x48, 0xc3, 0x50, 0xa9, 0x7e, 0xd1, 0x30, 0xdc, 0x8e, 0x01,
-	0x8b, 0xd2, 0x5f, 0xf3, 0x1b, 0x94, 0x7c, 0xde, 0x16, 0x56, 0x15, 0xd6, 0xd2, 0x68, 0x50, 0x88,
-	0xd3, 0x66, 0x76, 0x4c, 0x02, 0xcc, 0xbc, 0x9e, 0xc3, 0x55, 0xbd, 0xb4, 0xa7, 0x57, 0xea, 0x81,
-	0xb5, 0x03, 0xce, 0x50, 0x1e, 0x06, 0x7b, 0xd5, 0x66, 0x38, 0x42, 0xb5, 0x17, 0x78, 0x2f, 0xac,
-	0x37, 0x60, 0x99, 0xb7, 0x7d, 0x1f, 0x73, 0x4e, 0x59, 0x5f, 0xe1, 0x2c, 0xf6, 0xcc, 0x52, 0xe4,
-	0x7f, 0x19, 0x50, 0x52, 0x22, 0xa7, 0x51, 0x92, 0xf2, 0x3a, 0x2d, 0xda, 0xeb, 0xb0, 0xc1, 0x99,
-	0xef, 0x0d, 0x3b, 0xa3, 0x60, 0x96, 0x38, 0xf3, 0x9b, 0x53, 0x90, 0x94, 0xbb, 0x10, 0x49, 0x63,
-	0x2b, 0xda, 0x1f, 0x06, 0x58, 0x0a, 0x3c, 0x8a, 0x7d, 0xdc, 0xba, 0xd2, 0x89, 0xfe, 0xce, 0x00,
-	0x5b, 0xc9, 0xb9, 0x3f, 0xfe, 0x5b, 0x27, 0xe4, 0xf9, 0x11, 0xdf, 0x80, 0x15, 0x9a, 0x60, 0x86,
-	0x04, 0x65, 0xbd, 0x76, 0x64, 0x3e, 0xa3, 0x1d, 0x2d, 0x77, 0x4f, 0x68, 0xb3, 0xdb, 0x31, 0xa1,
-	0xdc, 0x2f, 0xbd, 0xff, 0x48, 0x64, 0x56, 0x03, 0xec, 0x8c, 0xd3, 0x49, 0xbb, 0xee, 0xcb, 0x03,
-	0x31, 0x8d, 0x1c, 0xe3, 0xf2, 0x97, 0x32, 0x1e, 0x6d, 0xc3, 0xc2, 0x21, 0x65, 0x3e, 0x0e, 0x3c,
-	0x7c, 0x42, 0x84, 0x1c, 0x48, 0xe7, 0x1b, 0xa0, 0x4c, 0x29, 0x81, 0xee, 0xb7, 0xa6, 0xd6, 0x78,
-	0x03, 0x73, 0xcc, 0x8e, 0xe5, 0xfb, 0x5d, 0x8f, 0xff, 0x15, 0x8d, 0x4f, 0xd9, 0x18, 0xca, 0xb0,
-	0x28, 0x10, 0x0b, 0xb1, 0xe8, 0x93, 0x37, 0x28, 0x9b, 0x9c, 0x1e, 0xde, 0x84, 0x65, 0x7c, 0x92,
-	0x10, 0x86, 0x04, 0xa1, 0xb1, 0x27, 0x48, 0xd4, 0x9d, 0xcc, 0x97, 0x9e, 0x9a, 0xbf, 0x20, 0x11,
-	0x76, 0xff, 0x36, 0x60, 0x2d, 0x53, 0xed, 0xa6, 0x60, 0xe3, 0x03, 0xd8, 0xec, 0x86, 0x34, 0xb2,
-	0xde, 0x6d, 0xe8, 0x00, 0x5f, 0x48, 0xc9, 0x1b, 0x43, 0x65, 0x7e, 0x34, 0x95, 0xee, 0x63, 0x03,
-	0x56, 0x07, 0x0a, 0xde, 0x15, 0xd3, 0x82, 0xbb, 0x07, 0xce, 0xb0, 0x32, 0xb7, 0xdb, 0x7b, 0x23,
-	0x9e, 0x17, 0xae, 0xfb, 0x5b, 0x77, 0xee, 0x6d, 0x62, 0x21, 0x5a, 0x93, 0xcf, 0x98, 0x6b, 0x30,
-	0x7b, 0x7e, 0xb6, 0xcc, 0xf3, 0x14, 0xc0, 0x2e, 0x58, 0x3c, 0xf1, 0x0e, 0xdb, 0x71, 0x40, 0xe2,
-	0x70, 0xe2, 0xa2, 0xb2, 0xc2, 0x93, 0x5d, 0x75, 0xa4, 0x5b, 0x4e, 0x3e, 0x86, 0x39, 0x14, 0xd1,
-	0x76, 0x3c, 0x7d, 0x1d, 0xd1, 0xe7, 0x53, 0x78, 0x5b, 0x63, 0xe1, 0x65, 0x80, 0xad, 0xc3, 0x9c,
-	0xfe, 0x91, 0x65, 0xca, 0xce, 0x34, 0xcb, 0x65, 0x27, 0xfa, 0x04, 0x4a, 0x59, 0x68, 0x58, 0xb5,
-	0xaf, 0x71, 0xe0, 0xac, 0x41, 0x70, 0xf8, 0x12, 0xe1, 0xed, 0x7c, 0x7a, 0xff, 0xcc, 0x31, 0x1e,
-	0x9c, 0x39, 0xc6, 0xe3, 0x33, 0xc7, 0xf8, 0xbe, 0xe3, 0xcc, 0x3c, 0xe8, 0x38, 0x33, 0xbf, 0x74,
-	0x9c, 0x99, 0xaf, 0xdf, 0x09, 0x89, 0x38, 0x6a, 0x1f, 0x54, 0x7d, 0x1a, 0xd5, 0x22, 0xea, 0x23,
-	0xff, 0x08, 0x91, 0xb8, 0xa6, 0x3e, 0x7a, 0xbc, 0x5b, 0x3b, 0xe9, 0xff, 0xf2, 0x21, 0x4e, 0x13,
-	0xcc, 0x0f, 0xe6, 0xe4, 0x27, 0x8a, 0xf7, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x23, 0x31,
-	0x2d, 0x1b, 0x11, 0x00, 0x00,
+func (m *EventPickGlobalVirtualGroupFamily) Reset()         { *m = EventPickGlobalVirtualGroupFamily{} }
+func (m *EventPickGlobalVirtualGroupFamily) String() string { return proto.CompactTextString(m) }
+func (*EventPickGlobalVirtualGroupFamily) ProtoMessage()    {}
+func (*EventPickGlobalVirtualGroupFamily) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9023c6ceb1678bd1, []int{20}
 }
-
-func (m *EventCreateGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPickGlobalVirtualGroupFamily) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPickGlobalVirtualGroupFamily) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPickGlobalVirtualGroupFamily.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventCreateGlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventPickGlobalVirtualGroupFamily) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPickGlobalVirtualGroupFamily.Merge(m, src)
+}
+func (m *EventPickGlobalVirtualGroupFamily) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPickGlobalVirtualGroupFamily) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPickGlobalVirtualGroupFamily.DiscardUnknown(m)
 }
 
-func (m *EventCreateGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalDeposit.Size()
-		i -= size
-		if _, err := m.TotalDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.VirtualPaymentAddress) > 0 {
-		i -= len(m.VirtualPaymentAddress)
-		copy(dAtA[i:], m.VirtualPaymentAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.VirtualPaymentAddress)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.StoredSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StoredSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.SecondarySpIds) > 0 {
-		dAtA2 := make([]byte, len(m.SecondarySpIds)*10)
-		var j1 int
-		for _, num := range m.SecondarySpIds {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintEvents(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_EventPickGlobalVirtualGroupFamily proto.InternalMessageInfo
+
+func (m *EventPickGlobalVirtualGroupFamily) GetPrimarySpId() uint32 {
+	if m != nil {
+		return m.PrimarySpId
 	}
-	if m.FamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FamilyId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *EventPickGlobalVirtualGroupFamily) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
 	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventPickGlobalVirtualGroupFamily) GetStrategy() PickVGFStrategy {
+	if m != nil {
+		return m.Strategy
 	}
-	return len(dAtA) - i, nil
+	return Strategy_Maximize_Free_Store_Size
 }
 
-func (m *EventUpdateGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPickGlobalVirtualGroupFamily) GetExplanation() string {
+	if m != nil {
+		return m.Explanation
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventUpdateGlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventStartSwapInAuction struct {
+	// The id of the gvg family to be swapped in, zero if the auction is for a gvg
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The id of the gvg to be swapped in, zero if the auction is for a gvg family
+	GlobalVirtualGroupId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// The id of the forced exiting sp who will be swapped
+	TargetSpId uint32 `protobuf:"varint,3,opt,name=target_sp_id,json=targetSpId,proto3" json:"target_sp_id,omitempty"`
+	// The time the bidding window closes
+	BiddingEndTime int64 `protobuf:"varint,4,opt,name=bidding_end_time,json=biddingEndTime,proto3" json:"bidding_end_time,omitempty"`
 }
 
-func (m *EventUpdateGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SecondarySpIds) > 0 {
-		dAtA4 := make([]byte, len(m.SecondarySpIds)*10)
-		var j3 int
-		for _, num := range m.SecondarySpIds {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintEvents(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.TotalDeposit.Size()
-		i -= size
-		if _, err := m.TotalDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventStartSwapInAuction) Reset()         { *m = EventStartSwapInAuction{} }
+func (m *EventStartSwapInAuction) String() string { return proto.CompactTextString(m) }
+func (*EventStartSwapInAuction) ProtoMessage()    {}
+func (*EventStartSwapInAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9023c6ceb1678bd1, []int{21}
+}
+func (m *EventStartSwapInAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStartSwapInAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStartSwapInAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.StoreSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StoreSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventStartSwapInAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStartSwapInAuction.Merge(m, src)
+}
+func (m *EventStartSwapInAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStartSwapInAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStartSwapInAuction.DiscardUnknown(m)
 }
 
-func (m *EventDeleteGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventStartSwapInAuction proto.InternalMessageInfo
+
+func (m *EventStartSwapInAuction) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventDeleteGlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventStartSwapInAuction) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
 }
 
-func (m *EventDeleteGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *EventStartSwapInAuction) GetTargetSpId() uint32 {
+	if m != nil {
+		return m.TargetSpId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventCreateGlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventStartSwapInAuction) GetBiddingEndTime() int64 {
+	if m != nil {
+		return m.BiddingEndTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventCreateGlobalVirtualGroupFamily) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventBidSwapIn struct {
+	// The id of the storage provider who bids
+	StorageProviderId uint32 `protobuf:"varint,1,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// The id of the gvg family which the storage provider bids to swap in as primary sp
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The id of the gvg which the storage provider bids to swap in as secondary sp
+	GlobalVirtualGroupId uint32 `protobuf:"varint,3,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// The id of the target sp who will be swapped
+	TargetSpId uint32 `protobuf:"varint,4,opt,name=target_sp_id,json=targetSpId,proto3" json:"target_sp_id,omitempty"`
+	// The store price of the storage provider, in amoca wei per charge byte
+	StorePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=store_price,json=storePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"store_price"`
+	// The free store size in bytes the storage provider commits
+	Capacity uint64 `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
 }
 
-func (m *EventCreateGlobalVirtualGroupFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		dAtA6 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
-		var j5 int
-		for _, num := range m.GlobalVirtualGroupIds {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
+func (m *EventBidSwapIn) Reset()         { *m = EventBidSwapIn{} }
+func (m *EventBidSwapIn) String() string { return proto.CompactTextString(m) }
+func (*EventBidSwapIn) ProtoMessage()    {}
+func (*EventBidSwapIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9023c6ceb1678bd1, []int{22}
+}
+func (m *EventBidSwapIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBidSwapIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBidSwapIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintEvents(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.VirtualPaymentAddress) > 0 {
-		i -= len(m.VirtualPaymentAddress)
-		copy(dAtA[i:], m.VirtualPaymentAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.VirtualPaymentAddress)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventBidSwapIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBidSwapIn.Merge(m, src)
+}
+func (m *EventBidSwapIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBidSwapIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBidSwapIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBidSwapIn proto.InternalMessageInfo
+
+func (m *EventBidSwapIn) GetStorageProviderId() uint32 {
+	if m != nil {
+		return m.StorageProviderId
 	}
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *EventBidSwapIn) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
 	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventBidSwapIn) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventUpdateGlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventBidSwapIn) GetTargetSpId() uint32 {
+	if m != nil {
+		return m.TargetSpId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventUpdateGlobalVirtualGroupFamily) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventBidSwapIn) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
 }
 
-func (m *EventUpdateGlobalVirtualGroupFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		dAtA8 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
-		var j7 int
-		for _, num := range m.GlobalVirtualGroupIds {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
+type EventAssignSwapIn struct {
+	// The id of the storage provider who is assigned the swap in
+	StorageProviderId uint32 `protobuf:"varint,1,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// The id of the gvg family to be swapped in
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The id of the gvg to be swapped in
+	GlobalVirtualGroupId uint32 `protobuf:"varint,3,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// The id of the target sp who will be swapped
+	TargetSpId uint32 `protobuf:"varint,4,opt,name=target_sp_id,json=targetSpId,proto3" json:"target_sp_id,omitempty"`
+	// The deadline to complete the swap in
+	Deadline int64 `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *EventAssignSwapIn) Reset()         { *m = EventAssignSwapIn{} }
+func (m *EventAssignSwapIn) String() string { return proto.CompactTextString(m) }
+func (*EventAssignSwapIn) ProtoMessage()    {}
+func (*EventAssignSwapIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9023c6ceb1678bd1, []int{23}
+}
+func (m *EventAssignSwapIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAssignSwapIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAssignSwapIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintEvents(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventAssignSwapIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAssignSwapIn.Merge(m, src)
+}
+func (m *EventAssignSwapIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAssignSwapIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAssignSwapIn.DiscardUnknown(m)
 }
 
-func (m *EventDeleteGlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventAssignSwapIn proto.InternalMessageInfo
+
+func (m *EventAssignSwapIn) GetStorageProviderId() uint32 {
+	if m != nil {
+		return m.StorageProviderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventDeleteGlobalVirtualGroupFamily) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventAssignSwapIn) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
+	}
+	return 0
 }
 
-func (m *EventDeleteGlobalVirtualGroupFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrimarySpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x10
+func (m *EventAssignSwapIn) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
 	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventAssignSwapIn) GetTargetSpId() uint32 {
+	if m != nil {
+		return m.TargetSpId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventCreateLocalVirtualGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAssignSwapIn) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventCreateLocalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventFailSwapIn struct {
+	// The id of the storage provider who failed to complete the assigned swap in
+	StorageProviderId uint32 `protobuf:"varint,1,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// The id of the gvg family to be swapped in
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The id of the gvg to be swapped in
+	GlobalVirtualGroupId uint32 `protobuf:"varint,3,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// The id of the target sp who will be swapped
+	TargetSpId uint32 `protobuf:"varint,4,opt,name=target_sp_id,json=targetSpId,proto3" json:"target_sp_id,omitempty"`
 }
 
-func (m *EventCreateLocalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StoredSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StoredSize))
-		i--
-		dAtA[i] = 0x20
+func (m *EventFailSwapIn) Reset()         { *m = EventFailSwapIn{} }
+func (m *EventFailSwapIn) String() string { return proto.CompactTextString(m) }
+func (*EventFailSwapIn) ProtoMessage()    {}
+func (*EventFailSwapIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9023c6ceb1678bd1, []int{24}
+}
+func (m *EventFailSwapIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFailSwapIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFailSwapIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *EventFailSwapIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFailSwapIn.Merge(m, src)
+}
+func (m *EventFailSwapIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFailSwapIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFailSwapIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFailSwapIn proto.InternalMessageInfo
+
+func (m *EventFailSwapIn) GetStorageProviderId() uint32 {
+	if m != nil {
+		return m.StorageProviderId
 	}
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EventFailSwapIn) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventFailSwapIn) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventUpdateLocalVirtualGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventFailSwapIn) GetTargetSpId() uint32 {
+	if m != nil {
+		return m.TargetSpId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventUpdateLocalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventSubmitRebalancePlan struct {
+	// The id of the rebalance plan
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The account who submitted the plan
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// The moves of the plan
+	Moves []RebalanceMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves"`
+	// The time the pending moves expire
+	ExpirationTime int64 `protobuf:"varint,4,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
 }
 
-func (m *EventUpdateLocalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StoredSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StoredSize))
-		i--
-		dAtA[i] = 0x20
+func (m *EventSubmitRebalancePlan) Reset()         { *m = EventSubmitRebalancePlan{} }
+func (m *EventSubmitRebalancePlan) String() string { return proto.CompactTextString(m) }
+func (*EventSubmitRebalancePlan) ProtoMessage()    {}
+func (*EventSubmitRebalancePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9023c6ceb1678bd1, []int{25}
+}
+func (m *EventSubmitRebalancePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSubmitRebalancePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSubmitRebalancePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *EventSubmitRebalancePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubmitRebalancePlan.Merge(m, src)
+}
+func (m *EventSubmitRebalancePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSubmitRebalancePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubmitRebalancePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSubmitRebalancePlan proto.InternalMessageInfo
+
+func (m *EventSubmitRebalancePlan) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
 	}
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+func (m *EventSubmitRebalancePlan) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *EventSubmitRebalancePlan) GetMoves() []RebalanceMove {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+func (m *EventSubmitRebalancePlan) GetExpirationTime() int64 {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return 0
+}
+
+type EventUpdateRebalanceMove struct {
+	// The id of the rebalance plan
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The id of the gvg to move
+	GlobalVirtualGroupId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// The id of the sp the gvg moves from
+	FromSpId uint32 `protobuf:"varint,3,opt,name=from_sp_id,json=fromSpId,proto3" json:"from_sp_id,omitempty"`
+	// The id of the sp the gvg moves to
+	ToSpId uint32 `protobuf:"varint,4,opt,name=to_sp_id,json=toSpId,proto3" json:"to_sp_id,omitempty"`
+	// The new status of the move
+	Status RebalanceMoveStatus `protobuf:"varint,5,opt,name=status,proto3,enum=moca.virtualgroup.RebalanceMoveStatus" json:"status,omitempty"`
+}
+
+func (m *EventUpdateRebalanceMove) Reset()         { *m = EventUpdateRebalanceMove{} }
+func (m *EventUpdateRebalanceMove) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateRebalanceMove) ProtoMessage()    {}
+func (*EventUpdateRebalanceMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9023c6ceb1678bd1, []int{26}
+}
+func (m *EventUpdateRebalanceMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateRebalanceMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateRebalanceMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventUpdateRebalanceMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateRebalanceMove.Merge(m, src)
+}
+func (m *EventUpdateRebalanceMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateRebalanceMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateRebalanceMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateRebalanceMove proto.InternalMessageInfo
+
+func (m *EventUpdateRebalanceMove) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventDeleteLocalVirtualGroup) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateRebalanceMove) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
+}
+
+func (m *EventUpdateRebalanceMove) GetFromSpId() uint32 {
+	if m != nil {
+		return m.FromSpId
+	}
+	return 0
+}
+
+func (m *EventUpdateRebalanceMove) GetToSpId() uint32 {
+	if m != nil {
+		return m.ToSpId
+	}
+	return 0
+}
+
+func (m *EventUpdateRebalanceMove) GetStatus() RebalanceMoveStatus {
+	if m != nil {
+		return m.Status
+	}
+	return REBALANCE_MOVE_STATUS_PENDING
+}
+
+func init() {
+	proto.RegisterType((*EventCreateGlobalVirtualGroup)(nil), "moca.virtualgroup.EventCreateGlobalVirtualGroup")
+	proto.RegisterType((*EventUpdateGlobalVirtualGroup)(nil), "moca.virtualgroup.EventUpdateGlobalVirtualGroup")
+	proto.RegisterType((*EventDeleteGlobalVirtualGroup)(nil), "moca.virtualgroup.EventDeleteGlobalVirtualGroup")
+	proto.RegisterType((*EventCreateGlobalVirtualGroupFamily)(nil), "moca.virtualgroup.EventCreateGlobalVirtualGroupFamily")
+	proto.RegisterType((*EventUpdateGlobalVirtualGroupFamily)(nil), "moca.virtualgroup.EventUpdateGlobalVirtualGroupFamily")
+	proto.RegisterType((*EventDeleteGlobalVirtualGroupFamily)(nil), "moca.virtualgroup.EventDeleteGlobalVirtualGroupFamily")
+	proto.RegisterType((*EventCreateLocalVirtualGroup)(nil), "moca.virtualgroup.EventCreateLocalVirtualGroup")
+	proto.RegisterType((*EventUpdateLocalVirtualGroup)(nil), "moca.virtualgroup.EventUpdateLocalVirtualGroup")
+	proto.RegisterType((*EventDeleteLocalVirtualGroup)(nil), "moca.virtualgroup.EventDeleteLocalVirtualGroup")
+	proto.RegisterType((*EventSwapOut)(nil), "moca.virtualgroup.EventSwapOut")
+	proto.RegisterType((*EventCompleteSwapOut)(nil), "moca.virtualgroup.EventCompleteSwapOut")
+	proto.RegisterType((*EventCancelSwapOut)(nil), "moca.virtualgroup.EventCancelSwapOut")
+	proto.RegisterType((*EventStorageProviderExit)(nil), "moca.virtualgroup.EventStorageProviderExit")
+	proto.RegisterType((*EventCompleteStorageProviderExit)(nil), "moca.virtualgroup.EventCompleteStorageProviderExit")
+	proto.RegisterType((*EventReserveSwapIn)(nil), "moca.virtualgroup.EventReserveSwapIn")
+	proto.RegisterType((*EventCompleteSwapIn)(nil), "moca.virtualgroup.EventCompleteSwapIn")
+	proto.RegisterType((*EventCancelSwapIn)(nil), "moca.virtualgroup.EventCancelSwapIn")
+	proto.RegisterType((*EventStorageProviderForcedExit)(nil), "moca.virtualgroup.EventStorageProviderForcedExit")
+	proto.RegisterType((*EventSettleGlobalVirtualGroupFamily)(nil), "moca.virtualgroup.EventSettleGlobalVirtualGroupFamily")
+	proto.RegisterType((*EventSettleGlobalVirtualGroup)(nil), "moca.virtualgroup.EventSettleGlobalVirtualGroup")
+	proto.RegisterType((*EventPickGlobalVirtualGroupFamily)(nil), "moca.virtualgroup.EventPickGlobalVirtualGroupFamily")
+	proto.RegisterType((*EventStartSwapInAuction)(nil), "moca.virtualgroup.EventStartSwapInAuction")
+	proto.RegisterType((*EventBidSwapIn)(nil), "moca.virtualgroup.EventBidSwapIn")
+	proto.RegisterType((*EventAssignSwapIn)(nil), "moca.virtualgroup.EventAssignSwapIn")
+	proto.RegisterType((*EventFailSwapIn)(nil), "moca.virtualgroup.EventFailSwapIn")
+	proto.RegisterType((*EventSubmitRebalancePlan)(nil), "moca.virtualgroup.EventSubmitRebalancePlan")
+	proto.RegisterType((*EventUpdateRebalanceMove)(nil), "moca.virtualgroup.EventUpdateRebalanceMove")
+}
+
+func init() { proto.RegisterFile("moca/virtualgroup/events.proto", fileDescriptor_9023c6ceb1678bd1) }
+
+var fileDescriptor_9023c6ceb1678bd1 = []byte{
+	// 1389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x5f, 0x6f, 0x1b, 0xc5,
+	0x16, 0xcf, 0xda, 0x8e, 0x6b, 0x9f, 0x34, 0x4e, 0xb2, 0x4d, 0x6e, 0x7c, 0xd3, 0xc4, 0xf1, 0xdd,
+	0x4a, 0xbd, 0xd1, 0x95, 0x6a, 0xdf, 0x06, 0x55, 0x3c, 0x00, 0x95, 0xea, 0xa6, 0x29, 0x86, 0x22,
+	0xac, 0x35, 0xa9, 0x04, 0x2f, 0xab, 0xf1, 0xee, 0x64, 0x3b, 0xaa, 0x77, 0x67, 0xb5, 0x33, 0x0e,
+	0x71, 0x3f, 0x02, 0x2f, 0x20, 0x3e, 0x05, 0x8f, 0x3c, 0xf4, 0x81, 0x2f, 0x80, 0xd4, 0x17, 0xa4,
+	0xaa, 0x4f, 0x08, 0xa1, 0xaa, 0x4a, 0x2a, 0x21, 0x1e, 0x78, 0xe2, 0x0d, 0x81, 0x84, 0x76, 0x66,
+	0xec, 0xda, 0x5e, 0xdb, 0x75, 0x9d, 0x54, 0x40, 0x5e, 0x2c, 0xef, 0x99, 0x99, 0x33, 0xe7, 0x77,
+	0xce, 0xef, 0xfc, 0xd9, 0x85, 0x82, 0x47, 0x6d, 0x54, 0x3e, 0x20, 0x21, 0x6f, 0xa1, 0xa6, 0x1b,
+	0xd2, 0x56, 0x50, 0xc6, 0x07, 0xd8, 0xe7, 0xac, 0x14, 0x84, 0x94, 0x53, 0x7d, 0x29, 0x5a, 0x2f,
+	0xf5, 0xae, 0xaf, 0x2d, 0x21, 0x8f, 0xf8, 0xb4, 0x2c, 0x7e, 0xe5, 0xae, 0xb5, 0x7f, 0xdb, 0x94,
+	0x79, 0x94, 0x59, 0xe2, 0xa9, 0x2c, 0x1f, 0xd4, 0xd2, 0xb2, 0x4b, 0x5d, 0x2a, 0xe5, 0xd1, 0x3f,
+	0x25, 0x1d, 0x72, 0xad, 0x4d, 0x3d, 0x8f, 0xfa, 0x6a, 0x7d, 0x23, 0xbe, 0xce, 0xdb, 0x01, 0x56,
+	0x4a, 0x8d, 0xe7, 0x09, 0xd8, 0xb8, 0x15, 0x99, 0x79, 0x33, 0xc4, 0x88, 0xe3, 0xdb, 0x4d, 0xda,
+	0x40, 0xcd, 0xbb, 0x72, 0xf7, 0xed, 0x68, 0xb7, 0x9e, 0x83, 0x04, 0x71, 0xf2, 0x5a, 0x51, 0xdb,
+	0x9a, 0x37, 0x13, 0xc4, 0xd1, 0x2f, 0x42, 0x76, 0x1f, 0x79, 0xa4, 0xd9, 0xb6, 0x88, 0x93, 0x4f,
+	0x08, 0x71, 0x46, 0x0a, 0xaa, 0x8e, 0x6e, 0xc0, 0x7c, 0x10, 0x12, 0x0f, 0x85, 0x6d, 0x8b, 0x05,
+	0xd1, 0x86, 0xa4, 0xd8, 0x30, 0xa7, 0x84, 0xf5, 0xa0, 0xea, 0xe8, 0x5b, 0xb0, 0xc8, 0xb0, 0x4d,
+	0x7d, 0xa7, 0xbb, 0x8b, 0xe5, 0x53, 0xc5, 0xe4, 0xd6, 0xbc, 0x99, 0xeb, 0xca, 0xa3, 0x8d, 0x4c,
+	0xdf, 0x84, 0x39, 0xc6, 0x69, 0x88, 0x1d, 0x8b, 0x91, 0x07, 0x38, 0x3f, 0x5b, 0xd4, 0xb6, 0x52,
+	0x26, 0x48, 0x51, 0x9d, 0x3c, 0xc0, 0x7a, 0x0d, 0x56, 0x15, 0x32, 0x2b, 0x40, 0x6d, 0x0f, 0xfb,
+	0xdc, 0x42, 0x8e, 0x13, 0x62, 0xc6, 0xf2, 0xe9, 0xa2, 0xb6, 0x95, 0xad, 0xe4, 0x9f, 0x3c, 0xbc,
+	0xb2, 0xac, 0xbc, 0x78, 0x43, 0xae, 0xd4, 0x79, 0x48, 0x7c, 0xd7, 0x5c, 0x51, 0x07, 0x6b, 0xf2,
+	0x9c, 0x5a, 0xd4, 0xf7, 0x60, 0x9e, 0x53, 0x8e, 0x9a, 0x96, 0x83, 0x03, 0xca, 0x08, 0xcf, 0x9f,
+	0x13, 0x7a, 0xfe, 0xff, 0xe8, 0xe9, 0xe6, 0xcc, 0x0f, 0x4f, 0x37, 0x57, 0xa4, 0x2e, 0xe6, 0xdc,
+	0x2f, 0x11, 0x5a, 0xf6, 0x10, 0xbf, 0x57, 0xaa, 0xfa, 0xfc, 0xc9, 0xc3, 0x2b, 0xa0, 0x2e, 0xa9,
+	0xfa, 0xfc, 0xab, 0x9f, 0xbe, 0xfe, 0x9f, 0x66, 0x9e, 0x17, 0x6a, 0x76, 0xa4, 0x16, 0xe3, 0x57,
+	0x4d, 0xb9, 0x79, 0x2f, 0x70, 0x26, 0x73, 0xf3, 0x06, 0x48, 0xa0, 0x12, 0x7a, 0x42, 0x40, 0xcf,
+	0x0a, 0x89, 0x40, 0x1e, 0xb3, 0x33, 0x79, 0x1a, 0x76, 0xc6, 0xe3, 0x97, 0x9a, 0x2c, 0x7e, 0xb3,
+	0xc3, 0xe2, 0x67, 0xd4, 0x15, 0xe8, 0x1d, 0xdc, 0xc4, 0x13, 0x81, 0x8e, 0x5d, 0x9f, 0x88, 0x5d,
+	0x6f, 0x3c, 0xd7, 0xe0, 0xd2, 0x58, 0xc6, 0xee, 0x0a, 0x32, 0x4e, 0xa3, 0x7b, 0x1c, 0x9f, 0x92,
+	0xd3, 0xf1, 0xe9, 0x4d, 0xc8, 0xbb, 0xc2, 0x42, 0xab, 0xa3, 0x58, 0xe4, 0x60, 0x0f, 0xe9, 0x57,
+	0xdc, 0x18, 0x82, 0xc8, 0x77, 0x5f, 0x76, 0x60, 0x8e, 0x62, 0xcc, 0x09, 0x60, 0x8e, 0x33, 0x2a,
+	0x39, 0xce, 0xa8, 0x8f, 0xe1, 0xd2, 0xd8, 0x80, 0x4e, 0x6f, 0x93, 0xf1, 0xad, 0x06, 0xeb, 0x3d,
+	0x61, 0xbd, 0x43, 0xed, 0x97, 0x70, 0xe5, 0x1d, 0xc8, 0x36, 0x5a, 0xf6, 0x7d, 0xcc, 0x3b, 0x0a,
+	0xb3, 0x95, 0xa2, 0x62, 0x7f, 0x6a, 0x8f, 0x08, 0xb2, 0xcf, 0xa9, 0x48, 0xed, 0x91, 0x0e, 0xdb,
+	0x33, 0xf2, 0x48, 0xd5, 0xd1, 0xaf, 0xc1, 0xea, 0x08, 0x1f, 0xa8, 0x9a, 0xb5, 0x3c, 0xcc, 0x05,
+	0x83, 0x25, 0x29, 0x35, 0x58, 0x92, 0x5e, 0xe0, 0x90, 0x71, 0xfb, 0xc7, 0xe2, 0xf0, 0x60, 0xbd,
+	0x27, 0xd4, 0xaf, 0x1b, 0x86, 0x71, 0xac, 0xc1, 0x79, 0x71, 0x5f, 0xfd, 0x53, 0x14, 0x7c, 0xd8,
+	0xe2, 0x7a, 0x09, 0x2e, 0x44, 0xd6, 0x20, 0x17, 0x47, 0xbd, 0xf0, 0x80, 0x38, 0x38, 0xb4, 0xba,
+	0x17, 0x2e, 0xa9, 0xa5, 0x9a, 0x5a, 0xa9, 0x3a, 0x7a, 0x05, 0x0a, 0x43, 0xfd, 0x30, 0xd8, 0xab,
+	0xd6, 0xdc, 0x11, 0xac, 0x3d, 0x41, 0x5e, 0xe8, 0x97, 0x61, 0x81, 0xb5, 0x6c, 0x1b, 0x33, 0x46,
+	0xc3, 0xbe, 0xc2, 0x39, 0xdf, 0x15, 0x0b, 0x92, 0xff, 0xa6, 0xc1, 0xb2, 0x24, 0x39, 0xf5, 0x82,
+	0xc8, 0xaf, 0xd3, 0xa2, 0xbd, 0x06, 0xab, 0x2c, 0xb4, 0xad, 0x61, 0x67, 0x24, 0xcc, 0x65, 0x16,
+	0xda, 0xf5, 0x29, 0x9c, 0x94, 0x3c, 0x91, 0x93, 0xc6, 0x56, 0xb4, 0x9f, 0x35, 0xd0, 0x25, 0x78,
+	0xe4, 0xdb, 0xb8, 0x79, 0xa6, 0x03, 0xfd, 0xb9, 0x06, 0x79, 0x49, 0xe7, 0x7e, 0xfb, 0x6f, 0x1d,
+	0x92, 0x57, 0x47, 0x7c, 0x13, 0x16, 0x69, 0x80, 0x43, 0xc4, 0x69, 0xd8, 0x6d, 0x47, 0x89, 0x97,
+	0xb4, 0xa3, 0x85, 0xce, 0x09, 0x25, 0x36, 0x8e, 0x13, 0x50, 0xec, 0xa7, 0xde, 0xdf, 0xc4, 0x32,
+	0xdd, 0x84, 0x7c, 0xec, 0xd2, 0x49, 0xbb, 0xee, 0xbf, 0x06, 0x6c, 0x1a, 0x39, 0xc6, 0xa5, 0x4e,
+	0x65, 0x3c, 0xda, 0x84, 0xb9, 0x7d, 0x1a, 0xda, 0xd8, 0xb1, 0xf0, 0x21, 0xe1, 0x62, 0x20, 0xcd,
+	0x98, 0x20, 0x45, 0x91, 0x03, 0x8d, 0xcf, 0x12, 0x8a, 0xe3, 0x26, 0x66, 0x38, 0x3c, 0x10, 0xf9,
+	0x5d, 0xf5, 0xff, 0x12, 0x8e, 0x4f, 0xd9, 0x18, 0x8a, 0x70, 0x9e, 0xa3, 0xd0, 0xc5, 0xbc, 0x8f,
+	0xde, 0x20, 0x65, 0x62, 0x7a, 0xf8, 0x2f, 0x2c, 0xe0, 0xc3, 0x80, 0x84, 0x88, 0x13, 0xea, 0x5b,
+	0x9c, 0x78, 0x9d, 0xc9, 0x3c, 0xf7, 0x42, 0xfc, 0x11, 0xf1, 0xb0, 0xf1, 0x87, 0x06, 0x17, 0x62,
+	0xd5, 0x6e, 0x0a, 0x6f, 0xbc, 0x05, 0x6b, 0x1d, 0x93, 0x46, 0xd6, 0xbb, 0x55, 0x65, 0xe0, 0x6b,
+	0x29, 0x79, 0x63, 0x5c, 0x99, 0x1a, 0xed, 0x4a, 0xe3, 0x99, 0x06, 0x4b, 0x03, 0x05, 0xef, 0x8c,
+	0x71, 0xc1, 0xa8, 0x41, 0x61, 0x58, 0x99, 0xdb, 0xed, 0x66, 0xc4, 0xab, 0xc2, 0x35, 0x7e, 0xec,
+	0xcc, 0xbd, 0x75, 0xcc, 0x79, 0x73, 0xf2, 0x19, 0xf3, 0x02, 0xcc, 0xf6, 0xce, 0x96, 0x29, 0x16,
+	0x01, 0xd8, 0x05, 0x9d, 0x05, 0xd6, 0x7e, 0xcb, 0x77, 0x88, 0xef, 0x4e, 0x5c, 0x54, 0x16, 0x59,
+	0xb0, 0x2b, 0x8f, 0x28, 0xb9, 0xfe, 0x2e, 0xa4, 0x91, 0x47, 0x5b, 0xfe, 0xf4, 0x75, 0x44, 0x9d,
+	0x8f, 0xe0, 0x6d, 0x8c, 0x85, 0x17, 0x03, 0xb6, 0x02, 0x69, 0xf5, 0x92, 0x95, 0x10, 0x9d, 0x69,
+	0x96, 0x89, 0x4e, 0xf4, 0x1e, 0x2c, 0xc7, 0xa1, 0x61, 0xd9, 0xbe, 0xc6, 0x81, 0xd3, 0x07, 0xc1,
+	0xe1, 0xd3, 0x84, 0xf7, 0x8b, 0x06, 0xff, 0x11, 0xf0, 0x6a, 0xc4, 0xbe, 0x3f, 0x32, 0x76, 0xb1,
+	0xf7, 0x01, 0x2d, 0xfe, 0x8e, 0x72, 0x1a, 0xb4, 0xbf, 0x0e, 0x19, 0xc6, 0x43, 0xc4, 0xb1, 0xdb,
+	0x16, 0x41, 0xcf, 0x6d, 0x1b, 0xa5, 0xd8, 0x57, 0x98, 0x52, 0x64, 0xea, 0xdd, 0xdb, 0xbb, 0x75,
+	0xb5, 0xd3, 0xec, 0x9e, 0xd1, 0x8b, 0x30, 0x87, 0x0f, 0x83, 0x26, 0xf2, 0x45, 0x4d, 0x93, 0xce,
+	0x31, 0x7b, 0x45, 0x51, 0x8a, 0xaf, 0xaa, 0x04, 0x40, 0x21, 0x97, 0x19, 0x7e, 0xa3, 0x65, 0x47,
+	0x6b, 0x13, 0x20, 0xd0, 0x4e, 0x92, 0xb8, 0x89, 0x57, 0x48, 0xdc, 0x64, 0xac, 0x88, 0x6f, 0xc1,
+	0x62, 0x83, 0x38, 0x82, 0x3b, 0xd8, 0x77, 0x64, 0x15, 0x8f, 0xf0, 0x25, 0xcd, 0x9c, 0x92, 0xdf,
+	0xf2, 0x1d, 0x51, 0xc5, 0xbf, 0x4b, 0x40, 0x4e, 0x40, 0xac, 0x10, 0xe7, 0x2c, 0xb6, 0x33, 0x53,
+	0xbd, 0x09, 0x59, 0x41, 0x48, 0x6c, 0xd9, 0xca, 0xb2, 0x95, 0xab, 0x2a, 0x03, 0x2e, 0xc6, 0x33,
+	0xe0, 0x0e, 0x76, 0x91, 0xdd, 0xde, 0xc1, 0x76, 0x4f, 0x1e, 0xec, 0x60, 0x5b, 0xbd, 0x3c, 0xd5,
+	0x22, 0x25, 0xfa, 0x1a, 0x64, 0x6c, 0x14, 0x20, 0x9b, 0xf0, 0xb6, 0xf8, 0x10, 0x95, 0x32, 0xbb,
+	0xcf, 0xc6, 0xef, 0x9d, 0xae, 0x70, 0x83, 0x31, 0xe2, 0xfa, 0x67, 0xd1, 0xa5, 0x6b, 0x90, 0x71,
+	0x30, 0x72, 0x9a, 0xc4, 0x97, 0xfe, 0x4c, 0x9a, 0xdd, 0x67, 0xe3, 0xa9, 0x06, 0x0b, 0x02, 0xfe,
+	0x2e, 0x22, 0x67, 0xb2, 0x25, 0x7e, 0xd3, 0x1d, 0xfd, 0x5b, 0x0d, 0x8f, 0x70, 0x13, 0x37, 0x50,
+	0x33, 0xea, 0xff, 0xb5, 0x26, 0xf2, 0xf5, 0x55, 0x38, 0x17, 0x15, 0x8f, 0x0e, 0xba, 0x94, 0x99,
+	0x8e, 0x1e, 0xab, 0x8e, 0xbe, 0x0e, 0x59, 0x26, 0xf6, 0x73, 0x1c, 0xca, 0x11, 0xda, 0x7c, 0x21,
+	0xd0, 0xdf, 0x86, 0x59, 0x8f, 0x1e, 0xa8, 0xea, 0x3e, 0xb7, 0x5d, 0x1c, 0x52, 0xc5, 0xba, 0xf7,
+	0x7c, 0x40, 0x0f, 0x70, 0x25, 0x15, 0xf1, 0xd7, 0x94, 0x87, 0x86, 0x0d, 0x6c, 0x2a, 0xd5, 0x07,
+	0x06, 0xb6, 0xa3, 0x8e, 0xe9, 0xf2, 0xdb, 0x45, 0x9f, 0xca, 0xd1, 0xa6, 0x4f, 0x59, 0xa3, 0xd6,
+	0x01, 0xf6, 0x43, 0xea, 0xf5, 0x55, 0xa8, 0x4c, 0x24, 0x11, 0x14, 0xca, 0x43, 0x86, 0xd3, 0x3e,
+	0x1f, 0xa7, 0x39, 0x15, 0x2b, 0xd7, 0x21, 0xcd, 0x38, 0xe2, 0x2d, 0x26, 0xa8, 0x95, 0xdb, 0xbe,
+	0xfc, 0x32, 0x67, 0xd4, 0xc5, 0x6e, 0x53, 0x9d, 0xaa, 0xbc, 0xff, 0xe8, 0xa8, 0xa0, 0x3d, 0x3e,
+	0x2a, 0x68, 0xcf, 0x8e, 0x0a, 0xda, 0x17, 0xc7, 0x85, 0x99, 0xc7, 0xc7, 0x85, 0x99, 0xef, 0x8f,
+	0x0b, 0x33, 0x9f, 0x5c, 0x75, 0x09, 0xbf, 0xd7, 0x6a, 0x94, 0x6c, 0xea, 0x95, 0x23, 0x9d, 0xf6,
+	0x3d, 0x44, 0xfc, 0xb2, 0xfc, 0x7e, 0xbe, 0x5d, 0x3e, 0x1c, 0xf2, 0x11, 0xbd, 0x91, 0x16, 0x5f,
+	0xd1, 0xdf, 0xf8, 0x73, 0x00, 0x67, 0xab, 0x48, 0x45, 0xfd, 0x17, 0x00, 0x00,
+}
+
+func (m *EventCreateGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1878,26 +2025,66 @@ func (m *EventDeleteLocalVirtualGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDeleteLocalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateGlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDeleteLocalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.BucketId.Size()
+		size := m.TotalDeposit.Size()
 		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TotalDeposit.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x3a
+	if len(m.VirtualPaymentAddress) > 0 {
+		i -= len(m.VirtualPaymentAddress)
+		copy(dAtA[i:], m.VirtualPaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VirtualPaymentAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StoredSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StoredSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SecondarySpIds) > 0 {
+		dAtA2 := make([]byte, len(m.SecondarySpIds)*10)
+		var j1 int
+		for _, num := range m.SecondarySpIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvents(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FamilyId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Id != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
 		i--
@@ -1906,7 +2093,7 @@ func (m *EventDeleteLocalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSwapOut) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1916,53 +2103,63 @@ func (m *EventSwapOut) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSwapOut) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateGlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SuccessorSpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SuccessorSpId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		dAtA10 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
-		var j9 int
-		for _, num := range m.GlobalVirtualGroupIds {
+	if len(m.SecondarySpIds) > 0 {
+		dAtA4 := make([]byte, len(m.SecondarySpIds)*10)
+		var j3 int
+		for _, num := range m.SecondarySpIds {
 			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j9++
+				j3++
 			}
-			dAtA10[j9] = uint8(num)
-			j9++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintEvents(dAtA, i, uint64(j9))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvents(dAtA, i, uint64(j3))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TotalDeposit.Size()
+		i -= size
+		if _, err := m.TotalDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StoreSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StoreSize))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCompleteSwapOut) Marshal() (dAtA []byte, err error) {
+func (m *EventDeleteGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1972,53 +2169,30 @@ func (m *EventCompleteSwapOut) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCompleteSwapOut) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDeleteGlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCompleteSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDeleteGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		dAtA12 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
-		var j11 int
-		for _, num := range m.GlobalVirtualGroupIds {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintEvents(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SrcStorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SrcStorageProviderId))
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelSwapOut) Marshal() (dAtA []byte, err error) {
+func (m *EventCreateGlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2028,53 +2202,55 @@ func (m *EventCancelSwapOut) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCancelSwapOut) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateGlobalVirtualGroupFamily) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCancelSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateGlobalVirtualGroupFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SuccessorSpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SuccessorSpId))
-		i--
-		dAtA[i] = 0x20
-	}
 	if len(m.GlobalVirtualGroupIds) > 0 {
-		dAtA14 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
-		var j13 int
+		dAtA6 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j5 int
 		for _, num := range m.GlobalVirtualGroupIds {
 			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j13++
+				j5++
 			}
-			dAtA14[j13] = uint8(num)
-			j13++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintEvents(dAtA, i, uint64(j13))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintEvents(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VirtualPaymentAddress) > 0 {
+		i -= len(m.VirtualPaymentAddress)
+		copy(dAtA[i:], m.VirtualPaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VirtualPaymentAddress)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventStorageProviderExit) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateGlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2084,32 +2260,48 @@ func (m *EventStorageProviderExit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventStorageProviderExit) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateGlobalVirtualGroupFamily) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventStorageProviderExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateGlobalVirtualGroupFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorAddress)))
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		dAtA8 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j7 int
+		for _, num := range m.GlobalVirtualGroupIds {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintEvents(dAtA, i, uint64(j7))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCompleteStorageProviderExit) Marshal() (dAtA []byte, err error) {
+func (m *EventDeleteGlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2119,59 +2311,30 @@ func (m *EventCompleteStorageProviderExit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCompleteStorageProviderExit) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDeleteGlobalVirtualGroupFamily) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCompleteStorageProviderExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDeleteGlobalVirtualGroupFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ForcedExit {
-		i--
-		if m.ForcedExit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.TotalDeposit.Size()
-		i -= size
-		if _, err := m.TotalDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.StorageProviderAddress) > 0 {
-		i -= len(m.StorageProviderAddress)
-		copy(dAtA[i:], m.StorageProviderAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StorageProviderAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorAddress)))
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventReserveSwapIn) Marshal() (dAtA []byte, err error) {
+func (m *EventCreateLocalVirtualGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2181,23 +2344,18 @@ func (m *EventReserveSwapIn) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventReserveSwapIn) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCreateLocalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventReserveSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCreateLocalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExpirationTime != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ExpirationTime))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.TargetSpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TargetSpId))
+	if m.StoredSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StoredSize))
 		i--
 		dAtA[i] = 0x20
 	}
@@ -2206,20 +2364,25 @@ func (m *EventReserveSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCompleteSwapIn) Marshal() (dAtA []byte, err error) {
+func (m *EventUpdateLocalVirtualGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2229,40 +2392,45 @@ func (m *EventCompleteSwapIn) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCompleteSwapIn) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventUpdateLocalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCompleteSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventUpdateLocalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+	if m.StoredSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StoredSize))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.TargetStorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TargetStorageProviderId))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelSwapIn) Marshal() (dAtA []byte, err error) {
+func (m *EventDeleteLocalVirtualGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2272,40 +2440,35 @@ func (m *EventCancelSwapIn) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCancelSwapIn) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDeleteLocalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCancelSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDeleteLocalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TargetSpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TargetSpId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventStorageProviderForcedExit) Marshal() (dAtA []byte, err error) {
+func (m *EventSwapOut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2315,25 +2478,53 @@ func (m *EventStorageProviderForcedExit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventStorageProviderForcedExit) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSwapOut) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventStorageProviderForcedExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StorageProviderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+	if m.SuccessorSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SuccessorSpId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x20
 	}
-	return len(dAtA) - i, nil
-}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		dAtA10 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j9 int
+		for _, num := range m.GlobalVirtualGroupIds {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintEvents(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *EventSettleGlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error) {
+func (m *EventCompleteSwapOut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2343,47 +2534,53 @@ func (m *EventSettleGlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *EventSettleGlobalVirtualGroupFamily) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCompleteSwapOut) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSettleGlobalVirtualGroupFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCompleteSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		dAtA12 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j11 int
+		for _, num := range m.GlobalVirtualGroupIds {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintEvents(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.SpFundingAddress) > 0 {
-		i -= len(m.SpFundingAddress)
-		copy(dAtA[i:], m.SpFundingAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpFundingAddress)))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.SpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+	if m.SrcStorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SrcStorageProviderId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSettleGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
+func (m *EventCancelSwapOut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2393,460 +2590,777 @@ func (m *EventSettleGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSettleGlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCancelSwapOut) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSettleGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCancelSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.SpFundingAddresses) > 0 {
-		for iNdEx := len(m.SpFundingAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SpFundingAddresses[iNdEx])
-			copy(dAtA[i:], m.SpFundingAddresses[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.SpFundingAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.SuccessorSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SuccessorSpId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.SpIds) > 0 {
-		dAtA16 := make([]byte, len(m.SpIds)*10)
-		var j15 int
-		for _, num := range m.SpIds {
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		dAtA14 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j13 int
+		for _, num := range m.GlobalVirtualGroupIds {
 			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j15++
+				j13++
 			}
-			dAtA16[j15] = uint8(num)
-			j15++
+			dAtA14[j13] = uint8(num)
+			j13++
 		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintEvents(dAtA, i, uint64(j15))
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintEvents(dAtA, i, uint64(j13))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventStorageProviderExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreateGlobalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
-	}
-	if m.FamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.FamilyId))
-	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
-	}
-	if len(m.SecondarySpIds) > 0 {
-		l = 0
-		for _, e := range m.SecondarySpIds {
-			l += sovEvents(uint64(e))
-		}
-		n += 1 + sovEvents(uint64(l)) + l
-	}
-	if m.StoredSize != 0 {
-		n += 1 + sovEvents(uint64(m.StoredSize))
-	}
-	l = len(m.VirtualPaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.TotalDeposit.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+
+func (m *EventStorageProviderExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateGlobalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventStorageProviderExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
-	}
-	if m.StoreSize != 0 {
-		n += 1 + sovEvents(uint64(m.StoreSize))
-	}
-	l = m.TotalDeposit.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.SecondarySpIds) > 0 {
-		l = 0
-		for _, e := range m.SecondarySpIds {
-			l += sovEvents(uint64(e))
-		}
-		n += 1 + sovEvents(uint64(l)) + l
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDeleteGlobalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
-	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
+func (m *EventCompleteStorageProviderExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventCreateGlobalVirtualGroupFamily) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventCompleteStorageProviderExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCompleteStorageProviderExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
-	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
-	}
-	l = len(m.VirtualPaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupIds {
-			l += sovEvents(uint64(e))
+	if m.ForcedExit {
+		i--
+		if m.ForcedExit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovEvents(uint64(l)) + l
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *EventUpdateGlobalVirtualGroupFamily) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.TotalDeposit.Size()
+		i -= size
+		if _, err := m.TotalDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+	i--
+	dAtA[i] = 0x22
+	if len(m.StorageProviderAddress) > 0 {
+		i -= len(m.StorageProviderAddress)
+		copy(dAtA[i:], m.StorageProviderAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StorageProviderAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupIds {
-			l += sovEvents(uint64(e))
-		}
-		n += 1 + sovEvents(uint64(l)) + l
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDeleteGlobalVirtualGroupFamily) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
-	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
+func (m *EventReserveSwapIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventCreateLocalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
-	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
-	}
-	if m.StoredSize != 0 {
-		n += 1 + sovEvents(uint64(m.StoredSize))
-	}
-	return n
+func (m *EventReserveSwapIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateLocalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventReserveSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+	if m.ExpirationTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpirationTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TargetSpId))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
 	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.StoredSize != 0 {
-		n += 1 + sovEvents(uint64(m.StoredSize))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDeleteLocalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+func (m *EventCompleteSwapIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSwapOut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventCompleteSwapIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCompleteSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupIds {
-			l += sovEvents(uint64(e))
-		}
-		n += 1 + sovEvents(uint64(l)) + l
+	if m.TargetStorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TargetStorageProviderId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.SuccessorSpId != 0 {
-		n += 1 + sovEvents(uint64(m.SuccessorSpId))
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCompleteSwapOut) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCancelSwapIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelSwapIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	if m.TargetSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TargetSpId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.SrcStorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.SrcStorageProviderId))
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupIds {
-			l += sovEvents(uint64(e))
-		}
-		n += 1 + sovEvents(uint64(l)) + l
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelSwapOut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.StorageProviderId))
-	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
-	}
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupIds {
-			l += sovEvents(uint64(e))
-		}
-		n += 1 + sovEvents(uint64(l)) + l
-	}
-	if m.SuccessorSpId != 0 {
-		n += 1 + sovEvents(uint64(m.SuccessorSpId))
+func (m *EventStorageProviderForcedExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventStorageProviderExit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventStorageProviderForcedExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStorageProviderForcedExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.StorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.StorageProviderId))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCompleteStorageProviderExit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSettleGlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSettleGlobalVirtualGroupFamily) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSettleGlobalVirtualGroupFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.SpFundingAddress) > 0 {
+		i -= len(m.SpFundingAddress)
+		copy(dAtA[i:], m.SpFundingAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpFundingAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.StorageProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.TotalDeposit.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.ForcedExit {
-		n += 2
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventReserveSwapIn) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSettleGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSettleGlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSettleGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.StorageProviderId))
-	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	i--
+	dAtA[i] = 0x22
+	if len(m.SpFundingAddresses) > 0 {
+		for iNdEx := len(m.SpFundingAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpFundingAddresses[iNdEx])
+			copy(dAtA[i:], m.SpFundingAddresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.SpFundingAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.TargetSpId != 0 {
-		n += 1 + sovEvents(uint64(m.TargetSpId))
+	if len(m.SpIds) > 0 {
+		dAtA16 := make([]byte, len(m.SpIds)*10)
+		var j15 int
+		for _, num := range m.SpIds {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintEvents(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ExpirationTime != 0 {
-		n += 1 + sovEvents(uint64(m.ExpirationTime))
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCompleteSwapIn) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPickGlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPickGlobalVirtualGroupFamily) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPickGlobalVirtualGroupFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	if len(m.Explanation) > 0 {
+		i -= len(m.Explanation)
+		copy(dAtA[i:], m.Explanation)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Explanation)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.TargetStorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.TargetStorageProviderId))
+	if m.Strategy != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	if m.PrimarySpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCancelSwapIn) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventStartSwapInAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStartSwapInAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStartSwapInAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	if m.BiddingEndTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BiddingEndTime))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	if m.TargetSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TargetSpId))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.TargetSpId != 0 {
-		n += 1 + sovEvents(uint64(m.TargetSpId))
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventStorageProviderForcedExit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageProviderId != 0 {
-		n += 1 + sovEvents(uint64(m.StorageProviderId))
+func (m *EventBidSwapIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSettleGlobalVirtualGroupFamily) Size() (n int) {
+func (m *EventBidSwapIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBidSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Capacity != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.StorePrice.Size()
+		i -= size
+		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TargetSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TargetSpId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAssignSwapIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAssignSwapIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAssignSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TargetSpId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFailSwapIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFailSwapIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFailSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TargetSpId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSubmitRebalancePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubmitRebalancePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubmitRebalancePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpirationTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Moves) > 0 {
+		for iNdEx := len(m.Moves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Moves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateRebalanceMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateRebalanceMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateRebalanceMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ToSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ToSpId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FromSpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateGlobalVirtualGroup) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2855,19 +3369,32 @@ func (m *EventSettleGlobalVirtualGroupFamily) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovEvents(uint64(m.Id))
 	}
-	if m.SpId != 0 {
-		n += 1 + sovEvents(uint64(m.SpId))
+	if m.FamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.FamilyId))
 	}
-	l = len(m.SpFundingAddress)
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	if len(m.SecondarySpIds) > 0 {
+		l = 0
+		for _, e := range m.SecondarySpIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if m.StoredSize != 0 {
+		n += 1 + sovEvents(uint64(m.StoredSize))
+	}
+	l = len(m.VirtualPaymentAddress)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = m.Amount.Size()
+	l = m.TotalDeposit.Size()
 	n += 1 + l + sovEvents(uint64(l))
 	return n
 }
 
-func (m *EventSettleGlobalVirtualGroup) Size() (n int) {
+func (m *EventUpdateGlobalVirtualGroup) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2876,31 +3403,1730 @@ func (m *EventSettleGlobalVirtualGroup) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovEvents(uint64(m.Id))
 	}
-	if len(m.SpIds) > 0 {
+	if m.StoreSize != 0 {
+		n += 1 + sovEvents(uint64(m.StoreSize))
+	}
+	l = m.TotalDeposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	if len(m.SecondarySpIds) > 0 {
 		l = 0
-		for _, e := range m.SpIds {
+		for _, e := range m.SecondarySpIds {
 			l += sovEvents(uint64(e))
 		}
 		n += 1 + sovEvents(uint64(l)) + l
 	}
-	if len(m.SpFundingAddresses) > 0 {
-		for _, s := range m.SpFundingAddresses {
-			l = len(s)
+	return n
+}
+
+func (m *EventDeleteGlobalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	return n
+}
+
+func (m *EventCreateGlobalVirtualGroupFamily) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	l = len(m.VirtualPaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventUpdateGlobalVirtualGroupFamily) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventDeleteGlobalVirtualGroupFamily) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	return n
+}
+
+func (m *EventCreateLocalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.StoredSize != 0 {
+		n += 1 + sovEvents(uint64(m.StoredSize))
+	}
+	return n
+}
+
+func (m *EventUpdateLocalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.StoredSize != 0 {
+		n += 1 + sovEvents(uint64(m.StoredSize))
+	}
+	return n
+}
+
+func (m *EventDeleteLocalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSwapOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if m.SuccessorSpId != 0 {
+		n += 1 + sovEvents(uint64(m.SuccessorSpId))
+	}
+	return n
+}
+
+func (m *EventCompleteSwapOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.SrcStorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.SrcStorageProviderId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventCancelSwapOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if m.SuccessorSpId != 0 {
+		n += 1 + sovEvents(uint64(m.SuccessorSpId))
+	}
+	return n
+}
+
+func (m *EventStorageProviderExit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCompleteStorageProviderExit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StorageProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.TotalDeposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ForcedExit {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventReserveSwapIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.TargetSpId != 0 {
+		n += 1 + sovEvents(uint64(m.TargetSpId))
+	}
+	if m.ExpirationTime != 0 {
+		n += 1 + sovEvents(uint64(m.ExpirationTime))
+	}
+	return n
+}
+
+func (m *EventCompleteSwapIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.TargetStorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.TargetStorageProviderId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *EventCancelSwapIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.TargetSpId != 0 {
+		n += 1 + sovEvents(uint64(m.TargetSpId))
+	}
+	return n
+}
+
+func (m *EventStorageProviderForcedExit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	return n
+}
+
+func (m *EventSettleGlobalVirtualGroupFamily) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SpFundingAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSettleGlobalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if len(m.SpIds) > 0 {
+		l = 0
+		for _, e := range m.SpIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if len(m.SpFundingAddresses) > 0 {
+		for _, s := range m.SpFundingAddresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventPickGlobalVirtualGroupFamily) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.Strategy != 0 {
+		n += 1 + sovEvents(uint64(m.Strategy))
+	}
+	l = len(m.Explanation)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventStartSwapInAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.TargetSpId != 0 {
+		n += 1 + sovEvents(uint64(m.TargetSpId))
+	}
+	if m.BiddingEndTime != 0 {
+		n += 1 + sovEvents(uint64(m.BiddingEndTime))
+	}
+	return n
+}
+
+func (m *EventBidSwapIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.TargetSpId != 0 {
+		n += 1 + sovEvents(uint64(m.TargetSpId))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Capacity != 0 {
+		n += 1 + sovEvents(uint64(m.Capacity))
+	}
+	return n
+}
+
+func (m *EventAssignSwapIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.TargetSpId != 0 {
+		n += 1 + sovEvents(uint64(m.TargetSpId))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovEvents(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *EventFailSwapIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.TargetSpId != 0 {
+		n += 1 + sovEvents(uint64(m.TargetSpId))
+	}
+	return n
+}
+
+func (m *EventSubmitRebalancePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovEvents(uint64(m.PlanId))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
+	if m.ExpirationTime != 0 {
+		n += 1 + sovEvents(uint64(m.ExpirationTime))
+	}
+	return n
+}
+
+func (m *EventUpdateRebalanceMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovEvents(uint64(m.PlanId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.FromSpId != 0 {
+		n += 1 + sovEvents(uint64(m.FromSpId))
+	}
+	if m.ToSpId != 0 {
+		n += 1 + sovEvents(uint64(m.ToSpId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateGlobalVirtualGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
+			}
+			m.FamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SecondarySpIds = append(m.SecondarySpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SecondarySpIds) == 0 {
+					m.SecondarySpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SecondarySpIds = append(m.SecondarySpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpIds", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredSize", wireType)
+			}
+			m.StoredSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualPaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VirtualPaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateGlobalVirtualGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreSize", wireType)
+			}
+			m.StoreSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoreSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SecondarySpIds = append(m.SecondarySpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SecondarySpIds) == 0 {
+					m.SecondarySpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SecondarySpIds = append(m.SecondarySpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeleteGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeleteGlobalVirtualGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeleteGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateGlobalVirtualGroupFamily: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateGlobalVirtualGroupFamily: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualPaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VirtualPaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
+					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateGlobalVirtualGroupFamily: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateGlobalVirtualGroupFamily: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
+					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeleteGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeleteGlobalVirtualGroupFamily: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeleteGlobalVirtualGroupFamily: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateLocalVirtualGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateLocalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredSize", wireType)
+			}
+			m.StoredSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2923,10 +5149,10 @@ func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateGlobalVirtualGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateLocalVirtualGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateLocalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2949,10 +5175,10 @@ func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
-			m.FamilyId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2962,16 +5188,31 @@ func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FamilyId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
 			}
-			m.PrimarySpId = 0
+			m.GlobalVirtualGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2981,88 +5222,12 @@ func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.SecondarySpIds = append(m.SecondarySpIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.SecondarySpIds) == 0 {
-					m.SecondarySpIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.SecondarySpIds = append(m.SecondarySpIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpIds", wireType)
-			}
-		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StoredSize", wireType)
 			}
@@ -3081,11 +5246,61 @@ func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualPaymentAddress", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeleteLocalVirtualGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeleteLocalVirtualGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeleteLocalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3095,27 +5310,14 @@ func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VirtualPaymentAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3143,7 +5345,7 @@ func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3168,7 +5370,7 @@ func (m *EventCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+func (m *EventSwapOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3191,17 +5393,17 @@ func (m *EventUpdateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateGlobalVirtualGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSwapOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			m.Id = 0
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3211,16 +5413,16 @@ func (m *EventUpdateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.StorageProviderId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoreSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			m.StoreSize = 0
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3230,65 +5432,12 @@ func (m *EventUpdateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StoreSize |= uint64(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
-			}
-			m.PrimarySpId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType == 0 {
 				var v uint32
 				for shift := uint(0); ; shift += 7 {
@@ -3305,7 +5454,7 @@ func (m *EventUpdateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.SecondarySpIds = append(m.SecondarySpIds, v)
+				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -3340,8 +5489,8 @@ func (m *EventUpdateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.SecondarySpIds) == 0 {
-					m.SecondarySpIds = make([]uint32, 0, elementCount)
+				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
+					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint32
@@ -3359,85 +5508,16 @@ func (m *EventUpdateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.SecondarySpIds = append(m.SecondarySpIds, v)
+					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpIds", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventDeleteGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeleteGlobalVirtualGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeleteGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
 			}
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorSpId", wireType)
 			}
-			m.PrimarySpId = 0
+			m.SuccessorSpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3447,7 +5527,7 @@ func (m *EventDeleteGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				m.SuccessorSpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3473,7 +5553,7 @@ func (m *EventDeleteGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
+func (m *EventCompleteSwapOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3496,17 +5576,17 @@ func (m *EventCreateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateGlobalVirtualGroupFamily: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCompleteSwapOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateGlobalVirtualGroupFamily: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCompleteSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			m.Id = 0
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3516,16 +5596,16 @@ func (m *EventCreateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.StorageProviderId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcStorageProviderId", wireType)
 			}
-			m.PrimarySpId = 0
+			m.SrcStorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3535,16 +5615,16 @@ func (m *EventCreateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				m.SrcStorageProviderId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualPaymentAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			var stringLen uint64
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3554,24 +5634,11 @@ func (m *EventCreateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VirtualPaymentAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType == 0 {
 				var v uint32
@@ -3669,7 +5736,7 @@ func (m *EventCreateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
+func (m *EventCancelSwapOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3692,17 +5759,17 @@ func (m *EventUpdateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateGlobalVirtualGroupFamily: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelSwapOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateGlobalVirtualGroupFamily: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			m.Id = 0
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3712,16 +5779,16 @@ func (m *EventUpdateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.StorageProviderId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			m.PrimarySpId = 0
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3731,7 +5798,7 @@ func (m *EventUpdateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3812,6 +5879,25 @@ func (m *EventUpdateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorSpId", wireType)
+			}
+			m.SuccessorSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessorSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3833,7 +5919,7 @@ func (m *EventUpdateGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeleteGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
+func (m *EventStorageProviderExit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3856,17 +5942,17 @@ func (m *EventDeleteGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeleteGlobalVirtualGroupFamily: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventStorageProviderExit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeleteGlobalVirtualGroupFamily: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventStorageProviderExit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			m.Id = 0
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3876,16 +5962,16 @@ func (m *EventDeleteGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.StorageProviderId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			m.PrimarySpId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3895,11 +5981,24 @@ func (m *EventDeleteGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3921,7 +6020,7 @@ func (m *EventDeleteGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
+func (m *EventCompleteStorageProviderExit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3944,17 +6043,17 @@ func (m *EventCreateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateLocalVirtualGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCompleteStorageProviderExit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateLocalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCompleteStorageProviderExit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			m.Id = 0
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3964,14 +6063,14 @@ func (m *EventCreateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.StorageProviderId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3999,15 +6098,206 @@ func (m *EventCreateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcedExit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForcedExit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReserveSwapIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReserveSwapIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReserveSwapIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
 			}
-			m.GlobalVirtualGroupId = 0
+			m.TargetSpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4017,16 +6307,16 @@ func (m *EventCreateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				m.TargetSpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
-			m.StoredSize = 0
+			m.ExpirationTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4036,7 +6326,7 @@ func (m *EventCreateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StoredSize |= uint64(b&0x7F) << shift
+				m.ExpirationTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4062,7 +6352,7 @@ func (m *EventCreateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
+func (m *EventCompleteSwapIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4085,17 +6375,17 @@ func (m *EventUpdateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateLocalVirtualGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCompleteSwapIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateLocalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCompleteSwapIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			m.Id = 0
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4105,16 +6395,16 @@ func (m *EventUpdateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.StorageProviderId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetStorageProviderId", wireType)
 			}
-			var stringLen uint64
+			m.TargetStorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4124,31 +6414,16 @@ func (m *EventUpdateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TargetStorageProviderId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			m.GlobalVirtualGroupId = 0
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4158,16 +6433,16 @@ func (m *EventUpdateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
 			}
-			m.StoredSize = 0
+			m.GlobalVirtualGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4177,7 +6452,7 @@ func (m *EventUpdateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StoredSize |= uint64(b&0x7F) << shift
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4203,7 +6478,7 @@ func (m *EventUpdateLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeleteLocalVirtualGroup) Unmarshal(dAtA []byte) error {
+func (m *EventCancelSwapIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4226,17 +6501,17 @@ func (m *EventDeleteLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeleteLocalVirtualGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelSwapIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeleteLocalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelSwapIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			m.Id = 0
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4246,16 +6521,16 @@ func (m *EventDeleteLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.StorageProviderId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			var stringLen uint64
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4265,26 +6540,49 @@ func (m *EventDeleteLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
 			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TargetSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4306,7 +6604,7 @@ func (m *EventDeleteLocalVirtualGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSwapOut) Unmarshal(dAtA []byte) error {
+func (m *EventStorageProviderForcedExit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4329,10 +6627,10 @@ func (m *EventSwapOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSwapOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventStorageProviderForcedExit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventStorageProviderForcedExit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4349,121 +6647,7 @@ func (m *EventSwapOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageProviderId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
-			}
-			m.GlobalVirtualGroupFamilyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
-					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorSpId", wireType)
-			}
-			m.SuccessorSpId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SuccessorSpId |= uint32(b&0x7F) << shift
+				m.StorageProviderId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4489,7 +6673,7 @@ func (m *EventSwapOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCompleteSwapOut) Unmarshal(dAtA []byte) error {
+func (m *EventSettleGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4512,17 +6696,17 @@ func (m *EventCompleteSwapOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCompleteSwapOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSettleGlobalVirtualGroupFamily: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCompleteSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSettleGlobalVirtualGroupFamily: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.StorageProviderId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4532,16 +6716,16 @@ func (m *EventCompleteSwapOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageProviderId |= uint32(b&0x7F) << shift
+				m.Id |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcStorageProviderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.SrcStorageProviderId = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4551,16 +6735,16 @@ func (m *EventCompleteSwapOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcStorageProviderId |= uint32(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpFundingAddress", wireType)
 			}
-			m.GlobalVirtualGroupFamilyId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4570,87 +6754,58 @@ func (m *EventCompleteSwapOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpFundingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
-					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4672,7 +6827,7 @@ func (m *EventCompleteSwapOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelSwapOut) Unmarshal(dAtA []byte) error {
+func (m *EventSettleGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4695,17 +6850,17 @@ func (m *EventCancelSwapOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelSwapOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSettleGlobalVirtualGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSettleGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.StorageProviderId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4715,31 +6870,12 @@ func (m *EventCancelSwapOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageProviderId |= uint32(b&0x7F) << shift
+				m.Id |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
-			}
-			m.GlobalVirtualGroupFamilyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType == 0 {
 				var v uint32
 				for shift := uint(0); ; shift += 7 {
@@ -4756,7 +6892,7 @@ func (m *EventCancelSwapOut) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+				m.SpIds = append(m.SpIds, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -4791,104 +6927,35 @@ func (m *EventCancelSwapOut) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
-					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorSpId", wireType)
-			}
-			m.SuccessorSpId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SuccessorSpId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventStorageProviderExit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+				if elementCount != 0 && len(m.SpIds) == 0 {
+					m.SpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SpIds = append(m.SpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpIds", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventStorageProviderExit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStorageProviderExit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpFundingAddresses", wireType)
 			}
-			m.StorageProviderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4898,14 +6965,27 @@ func (m *EventStorageProviderExit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageProviderId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpFundingAddresses = append(m.SpFundingAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4933,7 +7013,9 @@ func (m *EventStorageProviderExit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4956,7 +7038,7 @@ func (m *EventStorageProviderExit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCompleteStorageProviderExit) Unmarshal(dAtA []byte) error {
+func (m *EventPickGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4979,17 +7061,17 @@ func (m *EventCompleteStorageProviderExit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCompleteStorageProviderExit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPickGlobalVirtualGroupFamily: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCompleteStorageProviderExit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPickGlobalVirtualGroupFamily: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
 			}
-			m.StorageProviderId = 0
+			m.PrimarySpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4999,16 +7081,16 @@ func (m *EventCompleteStorageProviderExit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageProviderId |= uint32(b&0x7F) << shift
+				m.PrimarySpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			var stringLen uint64
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5018,29 +7100,16 @@ func (m *EventCompleteStorageProviderExit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var stringLen uint64
+			m.Strategy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5050,27 +7119,14 @@ func (m *EventCompleteStorageProviderExit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Strategy |= PickVGFStrategy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StorageProviderAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Explanation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5098,30 +7154,8 @@ func (m *EventCompleteStorageProviderExit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Explanation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForcedExit", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ForcedExit = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5143,7 +7177,7 @@ func (m *EventCompleteStorageProviderExit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventReserveSwapIn) Unmarshal(dAtA []byte) error {
+func (m *EventStartSwapInAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5166,32 +7200,13 @@ func (m *EventReserveSwapIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventReserveSwapIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventStartSwapInAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventReserveSwapIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventStartSwapInAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
-			}
-			m.StorageProviderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StorageProviderId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
@@ -5210,7 +7225,7 @@ func (m *EventReserveSwapIn) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
 			}
@@ -5229,7 +7244,7 @@ func (m *EventReserveSwapIn) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
 			}
@@ -5248,11 +7263,11 @@ func (m *EventReserveSwapIn) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingEndTime", wireType)
 			}
-			m.ExpirationTime = 0
+			m.BiddingEndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5262,7 +7277,7 @@ func (m *EventReserveSwapIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpirationTime |= uint64(b&0x7F) << shift
+				m.BiddingEndTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5288,7 +7303,7 @@ func (m *EventReserveSwapIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCompleteSwapIn) Unmarshal(dAtA []byte) error {
+func (m *EventBidSwapIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5311,10 +7326,10 @@ func (m *EventCompleteSwapIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCompleteSwapIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBidSwapIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCompleteSwapIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBidSwapIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5338,9 +7353,9 @@ func (m *EventCompleteSwapIn) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetStorageProviderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			m.TargetStorageProviderId = 0
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5350,16 +7365,16 @@ func (m *EventCompleteSwapIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetStorageProviderId |= uint32(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
 			}
-			m.GlobalVirtualGroupFamilyId = 0
+			m.GlobalVirtualGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5369,16 +7384,16 @@ func (m *EventCompleteSwapIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
 			}
-			m.GlobalVirtualGroupId = 0
+			m.TargetSpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5388,7 +7403,60 @@ func (m *EventCompleteSwapIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				m.TargetSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5414,7 +7482,7 @@ func (m *EventCompleteSwapIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelSwapIn) Unmarshal(dAtA []byte) error {
+func (m *EventAssignSwapIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5437,10 +7505,10 @@ func (m *EventCancelSwapIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelSwapIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAssignSwapIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelSwapIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAssignSwapIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5502,9 +7570,28 @@ func (m *EventCancelSwapIn) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
+			}
+			m.TargetSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			m.TargetSpId = 0
+			m.Deadline = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5514,7 +7601,7 @@ func (m *EventCancelSwapIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetSpId |= uint32(b&0x7F) << shift
+				m.Deadline |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5540,7 +7627,7 @@ func (m *EventCancelSwapIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStorageProviderForcedExit) Unmarshal(dAtA []byte) error {
+func (m *EventFailSwapIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5563,10 +7650,10 @@ func (m *EventStorageProviderForcedExit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStorageProviderForcedExit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFailSwapIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStorageProviderForcedExit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFailSwapIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5588,6 +7675,63 @@ func (m *EventStorageProviderForcedExit) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
+			}
+			m.TargetSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5609,7 +7753,7 @@ func (m *EventStorageProviderForcedExit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSettleGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
+func (m *EventSubmitRebalancePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5632,17 +7776,17 @@ func (m *EventSettleGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSettleGlobalVirtualGroupFamily: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSubmitRebalancePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSettleGlobalVirtualGroupFamily: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSubmitRebalancePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			m.Id = 0
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5652,33 +7796,14 @@ func (m *EventSettleGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
-			}
-			m.SpId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SpId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpFundingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5706,13 +7831,13 @@ func (m *EventSettleGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpFundingAddress = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5722,26 +7847,45 @@ func (m *EventSettleGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Moves = append(m.Moves, RebalanceMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			m.ExpirationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5763,7 +7907,7 @@ func (m *EventSettleGlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSettleGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateRebalanceMove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5786,17 +7930,17 @@ func (m *EventSettleGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSettleGlobalVirtualGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateRebalanceMove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSettleGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateRebalanceMove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			m.Id = 0
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5806,92 +7950,35 @@ func (m *EventSettleGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.SpIds = append(m.SpIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.SpIds) == 0 {
-					m.SpIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.SpIds = append(m.SpIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpIds", wireType)
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpFundingAddresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSpId", wireType)
 			}
-			var stringLen uint64
+			m.FromSpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5901,29 +7988,16 @@ func (m *EventSettleGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FromSpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpFundingAddresses = append(m.SpFundingAddresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSpId", wireType)
 			}
-			var stringLen uint64
+			m.ToSpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5933,26 +8007,30 @@ func (m *EventSettleGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ToSpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RebalanceMoveStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
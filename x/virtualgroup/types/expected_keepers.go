@@ -18,6 +18,8 @@ type SpKeeper interface {
 	DepositDenomForSP(ctx sdk.Context) (res string)
 	GetAllStorageProviders(ctx sdk.Context) (sps []sptypes.StorageProvider)
 	GetDepositLockUntil(ctx sdk.Context, spID uint32) uint64
+	GetSpStoragePrice(ctx sdk.Context, spID uint32) (val sptypes.SpStoragePrice, found bool)
+	GetMaintenanceDuration(ctx sdk.Context, sp *sptypes.StorageProvider) int64
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -50,4 +52,9 @@ type PaymentKeeper interface {
 
 type StorageKeeper interface {
 	GetExpectSecondarySPNumForECObject(ctx sdk.Context, time int64) (res uint32)
+	GetMigratingBucketIDsBySP(ctx sdk.Context, spID uint32) []sdkmath.Uint
+}
+
+type ChallengeKeeper interface {
+	GetSpSlashAmount(ctx sdk.Context, spID uint32) sdkmath.Int
 }
@@ -99,6 +99,35 @@ func (mr *MockSpKeeperMockRecorder) GetDepositLockUntil(ctx, spID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositLockUntil", reflect.TypeOf((*MockSpKeeper)(nil).GetDepositLockUntil), ctx, spID)
 }
 
+// GetMaintenanceDuration mocks base method.
+func (m *MockSpKeeper) GetMaintenanceDuration(ctx types.Context, sp *types0.StorageProvider) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceDuration", ctx, sp)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetMaintenanceDuration indicates an expected call of GetMaintenanceDuration.
+func (mr *MockSpKeeperMockRecorder) GetMaintenanceDuration(ctx, sp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceDuration", reflect.TypeOf((*MockSpKeeper)(nil).GetMaintenanceDuration), ctx, sp)
+}
+
+// GetSpStoragePrice mocks base method.
+func (m *MockSpKeeper) GetSpStoragePrice(ctx types.Context, spID uint32) (types0.SpStoragePrice, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpStoragePrice", ctx, spID)
+	ret0, _ := ret[0].(types0.SpStoragePrice)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSpStoragePrice indicates an expected call of GetSpStoragePrice.
+func (mr *MockSpKeeperMockRecorder) GetSpStoragePrice(ctx, spID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpStoragePrice", reflect.TypeOf((*MockSpKeeper)(nil).GetSpStoragePrice), ctx, spID)
+}
+
 // GetStorageProvider mocks base method.
 func (m *MockSpKeeper) GetStorageProvider(ctx types.Context, id uint32) (*types0.StorageProvider, bool) {
 	m.ctrl.T.Helper()
@@ -472,3 +501,55 @@ func (mr *MockStorageKeeperMockRecorder) GetExpectSecondarySPNumForECObject(ctx,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpectSecondarySPNumForECObject", reflect.TypeOf((*MockStorageKeeper)(nil).GetExpectSecondarySPNumForECObject), ctx, time)
 }
+
+// GetMigratingBucketIDsBySP mocks base method.
+func (m *MockStorageKeeper) GetMigratingBucketIDsBySP(ctx types.Context, spID uint32) []math.Uint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigratingBucketIDsBySP", ctx, spID)
+	ret0, _ := ret[0].([]math.Uint)
+	return ret0
+}
+
+// GetMigratingBucketIDsBySP indicates an expected call of GetMigratingBucketIDsBySP.
+func (mr *MockStorageKeeperMockRecorder) GetMigratingBucketIDsBySP(ctx, spID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigratingBucketIDsBySP", reflect.TypeOf((*MockStorageKeeper)(nil).GetMigratingBucketIDsBySP), ctx, spID)
+}
+
+// MockChallengeKeeper is a mock of ChallengeKeeper interface.
+type MockChallengeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockChallengeKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockChallengeKeeperMockRecorder is the mock recorder for MockChallengeKeeper.
+type MockChallengeKeeperMockRecorder struct {
+	mock *MockChallengeKeeper
+}
+
+// NewMockChallengeKeeper creates a new mock instance.
+func NewMockChallengeKeeper(ctrl *gomock.Controller) *MockChallengeKeeper {
+	mock := &MockChallengeKeeper{ctrl: ctrl}
+	mock.recorder = &MockChallengeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChallengeKeeper) EXPECT() *MockChallengeKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetSpSlashAmount mocks base method.
+func (m *MockChallengeKeeper) GetSpSlashAmount(ctx types.Context, spID uint32) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpSlashAmount", ctx, spID)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetSpSlashAmount indicates an expected call of GetSpSlashAmount.
+func (mr *MockChallengeKeeperMockRecorder) GetSpSlashAmount(ctx, spID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpSlashAmount", reflect.TypeOf((*MockChallengeKeeper)(nil).GetSpSlashAmount), ctx, spID)
+}
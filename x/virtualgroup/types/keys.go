@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/mocachain/moca/v2/internal/sequence"
 )
 
@@ -37,6 +39,8 @@ var (
 
 	GVGSequencePrefix       = []byte{0x32}
 	GVGFamilySequencePrefix = []byte{0x33}
+	// RebalancePlanSequenceKey keeps the last id of the rebalance plans
+	RebalancePlanSequenceKey = []byte{0x34}
 
 	GVGStatisticsWithinSPKey       = []byte{0x41}
 	GVGFamilyStatisticsWithinSPKey = []byte{0x42}
@@ -46,6 +50,22 @@ var (
 
 	SwapInFamilyKey = []byte{0x52}
 	SwapInGVGKey    = []byte{0x62}
+
+	// SwapInAuctionKey keeps the swap in auctions of the forced exiting SPs, the ones of the families before the
+	// ones of the GVGs
+	SwapInAuctionKey = []byte{0x53}
+	// SwapInAuctionQueueKey queues the swap in auctions by the time they are settled next
+	SwapInAuctionQueueKey = []byte{0x54}
+
+	// GVGCreateTimeKey keeps the block time the GVGs are created at
+	GVGCreateTimeKey = []byte{0x71}
+	// SpSwapInTimeKey keeps the block time the SPs last took over a family or GVG by swap in or swap out
+	SpSwapInTimeKey = []byte{0x72}
+
+	// RebalancePlanKey keeps the submitted rebalance plans
+	RebalancePlanKey = []byte{0x81}
+	// RebalancePendingMoveKey indexes the plan ids of the pending rebalance moves by the GVG ids
+	RebalancePendingMoveKey = []byte{0x82}
 )
 
 func GetGVGKey(gvgID uint32) []byte {
@@ -87,3 +107,40 @@ func GetSwapInGVGKey(globalVirtualGroupID uint32) []byte {
 	var uint32Seq sequence.Sequence[uint32]
 	return append(SwapInGVGKey, uint32Seq.EncodeSequence(globalVirtualGroupID)...)
 }
+
+func GetSwapInAuctionFamilyKey(globalVirtualGroupFamilyID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(append([]byte{}, SwapInAuctionKey[0], 0x01), uint32Seq.EncodeSequence(globalVirtualGroupFamilyID)...)
+}
+
+func GetSwapInAuctionGVGKey(globalVirtualGroupID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(append([]byte{}, SwapInAuctionKey[0], 0x02), uint32Seq.EncodeSequence(globalVirtualGroupID)...)
+}
+
+// GetSwapInAuctionQueueKey returns the key queueing the swap in auction of the auction key to be settled at the due
+// time.
+func GetSwapInAuctionQueueKey(dueTime int64, auctionKey []byte) []byte {
+	//nolint:gosec // the due time is a block time
+	key := append(append([]byte{}, SwapInAuctionQueueKey...), sdk.Uint64ToBigEndian(uint64(dueTime))...)
+	return append(key, auctionKey[len(SwapInAuctionKey):]...)
+}
+
+func GetGVGCreateTimeKey(gvgID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(GVGCreateTimeKey, uint32Seq.EncodeSequence(gvgID)...)
+}
+
+func GetSpSwapInTimeKey(spID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(SpSwapInTimeKey, uint32Seq.EncodeSequence(spID)...)
+}
+
+func GetRebalancePlanKey(planID uint64) []byte {
+	return append(RebalancePlanKey, sdk.Uint64ToBigEndian(planID)...)
+}
+
+func GetRebalancePendingMoveKey(gvgID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(RebalancePendingMoveKey, uint32Seq.EncodeSequence(gvgID)...)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+)
+
+const TypeMsgBidSwapIn = "bid_swap_in"
+
+var _ sdk.Msg = &MsgBidSwapIn{}
+
+func NewMsgBidSwapIn(storageProvider sdk.AccAddress, targetSPID, globalVirtualGroupFamilyID, globalVirtualGroupID uint32, capacity uint64) *MsgBidSwapIn {
+	return &MsgBidSwapIn{
+		StorageProvider:            storageProvider.String(),
+		TargetSpId:                 targetSPID,
+		GlobalVirtualGroupFamilyId: globalVirtualGroupFamilyID,
+		GlobalVirtualGroupId:       globalVirtualGroupID,
+		Capacity:                   capacity,
+	}
+}
+
+func (msg *MsgBidSwapIn) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBidSwapIn) Type() string {
+	return TypeMsgBidSwapIn
+}
+
+func (msg *MsgBidSwapIn) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.StorageProvider)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgBidSwapIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgBidSwapIn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.StorageProvider); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid storage provider address (%s)", err)
+	}
+	if msg.GlobalVirtualGroupFamilyId == NoSpecifiedFamilyID {
+		if msg.GlobalVirtualGroupId == NoSpecifiedGVGId {
+			return gnfderrors.ErrInvalidMessage.Wrap("The gvg id need to be specified when familyID is not specified.")
+		}
+	} else if msg.GlobalVirtualGroupId != NoSpecifiedGVGId {
+		return gnfderrors.ErrInvalidMessage.Wrap("The gvg id need to be empty(0) when familyID is specified.")
+	}
+	if msg.TargetSpId == 0 {
+		return gnfderrors.ErrInvalidMessage.Wrap("The target sp id is not specified.")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+)
+
+func TestMsgBidSwapIn_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBidSwapIn
+		err  error
+	}{
+		{
+			name: "valid family bid",
+			msg:  *NewMsgBidSwapIn(sample.RandAccAddress(), 1, 1, 0, 1024),
+		},
+		{
+			name: "valid gvg bid",
+			msg:  *NewMsgBidSwapIn(sample.RandAccAddress(), 1, 0, 1, 0),
+		},
+		{
+			name: "invalid address",
+			msg: MsgBidSwapIn{
+				StorageProvider:      "invalid_address",
+				TargetSpId:           1,
+				GlobalVirtualGroupId: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "neither family nor gvg",
+			msg:  *NewMsgBidSwapIn(sample.RandAccAddress(), 1, 0, 0, 1024),
+			err:  gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "both family and gvg",
+			msg:  *NewMsgBidSwapIn(sample.RandAccAddress(), 1, 1, 1, 1024),
+			err:  gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "no target sp",
+			msg:  *NewMsgBidSwapIn(sample.RandAccAddress(), 0, 1, 0, 1024),
+			err:  gnfderrors.ErrInvalidMessage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+)
+
+const (
+	TypeMsgSubmitRebalancePlan = "submit_rebalance_plan"
+
+	// MaxRebalanceMovesPerPlan is the max number of moves a rebalance plan carries
+	MaxRebalanceMovesPerPlan = 50
+)
+
+var _ sdk.Msg = &MsgSubmitRebalancePlan{}
+
+func NewMsgSubmitRebalancePlan(submitter sdk.AccAddress, moves []RebalanceMove) *MsgSubmitRebalancePlan {
+	return &MsgSubmitRebalancePlan{
+		Submitter: submitter.String(),
+		Moves:     moves,
+	}
+}
+
+func (msg *MsgSubmitRebalancePlan) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitRebalancePlan) Type() string {
+	return TypeMsgSubmitRebalancePlan
+}
+
+func (msg *MsgSubmitRebalancePlan) GetSigners() []sdk.AccAddress {
+	submitter, err := sdk.AccAddressFromHexUnsafe(msg.Submitter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{submitter}
+}
+
+func (msg *MsgSubmitRebalancePlan) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgSubmitRebalancePlan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Submitter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid submitter address (%s)", err)
+	}
+	if len(msg.Moves) == 0 {
+		return gnfderrors.ErrInvalidMessage.Wrap("The rebalance plan has no move.")
+	}
+	if len(msg.Moves) > MaxRebalanceMovesPerPlan {
+		return gnfderrors.ErrInvalidMessage.Wrapf("The rebalance plan has more than %d moves.", MaxRebalanceMovesPerPlan)
+	}
+	gvgIDs := make(map[uint32]bool, len(msg.Moves))
+	for _, move := range msg.Moves {
+		if move.GlobalVirtualGroupId == NoSpecifiedGVGId || move.FromSpId == 0 || move.ToSpId == 0 {
+			return gnfderrors.ErrInvalidMessage.Wrapf("The gvg id, from sp id and to sp id of the move need to be specified, move: %s", move.String())
+		}
+		if move.FromSpId == move.ToSpId {
+			return gnfderrors.ErrInvalidMessage.Wrapf("The GVG(ID=%d) can not move to the same SP(ID=%d).", move.GlobalVirtualGroupId, move.FromSpId)
+		}
+		if gvgIDs[move.GlobalVirtualGroupId] {
+			return gnfderrors.ErrInvalidMessage.Wrapf("The GVG(ID=%d) moves more than once.", move.GlobalVirtualGroupId)
+		}
+		gvgIDs[move.GlobalVirtualGroupId] = true
+	}
+	return nil
+}
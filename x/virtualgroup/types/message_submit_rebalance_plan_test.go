@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mocachain/moca/v2/testutil/sample"
+	gnfderrors "github.com/mocachain/moca/v2/types/errors"
+)
+
+func TestMsgSubmitRebalancePlan_ValidateBasic(t *testing.T) {
+	tooManyMoves := make([]RebalanceMove, 0, MaxRebalanceMovesPerPlan+1)
+	for i := 1; i <= MaxRebalanceMovesPerPlan+1; i++ {
+		tooManyMoves = append(tooManyMoves, RebalanceMove{GlobalVirtualGroupId: uint32(i), FromSpId: 1, ToSpId: 2})
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgSubmitRebalancePlan
+		err  error
+	}{
+		{
+			name: "valid plan",
+			msg: *NewMsgSubmitRebalancePlan(sample.RandAccAddress(), []RebalanceMove{
+				{GlobalVirtualGroupId: 1, FromSpId: 1, ToSpId: 2},
+				{GlobalVirtualGroupId: 2, FromSpId: 1, ToSpId: 3},
+			}),
+		},
+		{
+			name: "invalid address",
+			msg: MsgSubmitRebalancePlan{
+				Submitter: "invalid_address",
+				Moves:     []RebalanceMove{{GlobalVirtualGroupId: 1, FromSpId: 1, ToSpId: 2}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no move",
+			msg:  *NewMsgSubmitRebalancePlan(sample.RandAccAddress(), nil),
+			err:  gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "too many moves",
+			msg:  *NewMsgSubmitRebalancePlan(sample.RandAccAddress(), tooManyMoves),
+			err:  gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "no gvg",
+			msg:  *NewMsgSubmitRebalancePlan(sample.RandAccAddress(), []RebalanceMove{{FromSpId: 1, ToSpId: 2}}),
+			err:  gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "move to the same sp",
+			msg:  *NewMsgSubmitRebalancePlan(sample.RandAccAddress(), []RebalanceMove{{GlobalVirtualGroupId: 1, FromSpId: 1, ToSpId: 1}}),
+			err:  gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "duplicate gvg",
+			msg: *NewMsgSubmitRebalancePlan(sample.RandAccAddress(), []RebalanceMove{
+				{GlobalVirtualGroupId: 1, FromSpId: 1, ToSpId: 2},
+				{GlobalVirtualGroupId: 1, FromSpId: 3, ToSpId: 4},
+			}),
+			err: gnfderrors.ErrInvalidMessage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -14,6 +14,12 @@ import (
 const (
 	// DefaultDepositDenom Dafault deposit denom
 	DefaultDepositDenom = "amoca"
+
+	// MaxSwapInAuctionBidsLimit is the upper bound of the max number of bids a swap in auction keeps
+	MaxSwapInAuctionBidsLimit = uint32(100)
+	// MaxSettleSwapInAuctions is the max number of the due swap in auctions settled in an end block, the rest are
+	// settled in the following ones
+	MaxSettleSwapInAuctions = 100
 )
 
 var (
@@ -23,6 +29,8 @@ var (
 	DefaultMaxStoreSizePerFamily             = uint64(64) * 1024 * 1024 * 1024 * 1024 // 64T
 	DefaultSwapInValidityPeriod              = math.NewInt(60 * 60 * 24 * 7)          // 7 days
 	DefaultSPConcurrentExitNum               = math.NewInt(1)
+	DefaultSwapInAuctionBiddingPeriod        = uint64(60 * 60) // 1 hour
+	DefaultMaxSwapInAuctionBids              = uint32(10)
 
 	KeyDepositDenom                      = []byte("DepositDenom")
 	KeyGVGStakingPerBytes                = []byte("GVGStakingPerBytes")
@@ -30,6 +38,8 @@ var (
 	KeyMaxStoreSizePerFamily             = []byte("MaxStoreSizePerFamily")
 	KeySwapInValidityPeriod              = []byte("SwapInValidityPeriod")
 	KeySPConcurrentExitNum               = []byte("SPConcurrentExitNum")
+	KeySwapInAuctionBiddingPeriod        = []byte("SwapInAuctionBiddingPeriod")
+	KeyMaxSwapInAuctionBids              = []byte("MaxSwapInAuctionBids")
 )
 
 // NewParams creates a new Params instance
@@ -48,12 +58,15 @@ func NewParams(depositDenom string, gvgStakingPerBytes math.Int, maxGlobalVirtua
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultDepositDenom,
+	params := NewParams(DefaultDepositDenom,
 		DefaultGVGStakingPerBytes,
 		DefaultMaxGlobalVirtualGroupNumPerFamily,
 		DefaultMaxStoreSizePerFamily,
 		DefaultSwapInValidityPeriod,
 		DefaultSPConcurrentExitNum)
+	params.SwapInAuctionBiddingPeriod = DefaultSwapInAuctionBiddingPeriod
+	params.MaxSwapInAuctionBids = DefaultMaxSwapInAuctionBids
+	return params
 }
 
 // Validate validates the set of params
@@ -76,6 +89,12 @@ func (p Params) Validate() error {
 	if err := validateSPConcurrentExitNum(p.SpConcurrentExitNum); err != nil {
 		return err
 	}
+	if err := validateMaxSwapInAuctionBids(p.MaxSwapInAuctionBids); err != nil {
+		return err
+	}
+	if p.SwapInAuctionBiddingPeriod != 0 && p.MaxSwapInAuctionBids == 0 {
+		return errors.New("max swap in auction bids must be positive when the swap in auction is enabled")
+	}
 
 	return nil
 }
@@ -172,3 +191,15 @@ func validateSPConcurrentExitNum(i interface{}) error {
 	}
 	return nil
 }
+
+func validateMaxSwapInAuctionBids(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// every auction keeps its bids in a single store entry
+	if v > MaxSwapInAuctionBidsLimit {
+		return fmt.Errorf("max swap in auction bids too large: %d", v)
+	}
+	return nil
+}
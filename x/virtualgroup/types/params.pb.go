@@ -42,6 +42,11 @@ type Params struct {
 	SwapInValidityPeriod *cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=swap_in_validity_period,json=swapInValidityPeriod,proto3,customtype=cosmossdk.io/math.Int" json:"swap_in_validity_period,omitempty"`
 	// the the number of sp allowed to exit concurrently.
 	SpConcurrentExitNum *cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=sp_concurrent_exit_num,json=spConcurrentExitNum,proto3,customtype=cosmossdk.io/math.Int" json:"sp_concurrent_exit_num,omitempty"`
+	// the bidding period in seconds of the swap in auctions opened for the families and gvgs of a forced exiting sp,
+	// zero disables the auctions
+	SwapInAuctionBiddingPeriod uint64 `protobuf:"varint,8,opt,name=swap_in_auction_bidding_period,json=swapInAuctionBiddingPeriod,proto3" json:"swap_in_auction_bidding_period,omitempty"`
+	// the max number of bids a swap in auction keeps
+	MaxSwapInAuctionBids uint32 `protobuf:"varint,9,opt,name=max_swap_in_auction_bids,json=maxSwapInAuctionBids,proto3" json:"max_swap_in_auction_bids,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -104,6 +109,20 @@ func (m *Params) GetMaxStoreSizePerFamily() uint64 {
 	return 0
 }
 
+func (m *Params) GetSwapInAuctionBiddingPeriod() uint64 {
+	if m != nil {
+		return m.SwapInAuctionBiddingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSwapInAuctionBids() uint32 {
+	if m != nil {
+		return m.MaxSwapInAuctionBids
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "moca.virtualgroup.Params")
 }
@@ -111,39 +130,42 @@ func init() {
 func init() { proto.RegisterFile("moca/virtualgroup/params.proto", fileDescriptor_a267591e1dc290b0) }
 
 var fileDescriptor_a267591e1dc290b0 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xc0, 0x6d, 0x08, 0x41, 0x9c, 0xe8, 0x50, 0xd3, 0x80, 0x9b, 0xc1, 0x09, 0x20, 0x91, 0xa8,
-	0x12, 0x36, 0x7f, 0x16, 0xc4, 0x18, 0xfe, 0x54, 0x11, 0xa8, 0x8a, 0x1c, 0xa9, 0x03, 0x42, 0x9c,
-	0x2e, 0xf6, 0x71, 0x39, 0xc5, 0x77, 0x67, 0xf9, 0xce, 0xc1, 0xe9, 0x47, 0x60, 0x62, 0x64, 0xec,
-	0xc8, 0xd8, 0x81, 0x0f, 0xd1, 0xb1, 0x62, 0x02, 0x86, 0x0a, 0x25, 0x43, 0xf9, 0x18, 0xe8, 0xee,
-	0x2c, 0x28, 0x20, 0x24, 0xba, 0x58, 0xbe, 0xf7, 0x7e, 0xf7, 0x7b, 0x7e, 0x4f, 0xcf, 0x20, 0x60,
-	0x22, 0x41, 0xd1, 0x9c, 0x16, 0xaa, 0x44, 0x19, 0x29, 0x44, 0x99, 0x47, 0x39, 0x2a, 0x10, 0x93,
-	0x61, 0x5e, 0x08, 0x25, 0xbc, 0x75, 0x9d, 0x0f, 0x4f, 0xe7, 0xdb, 0xeb, 0x88, 0x51, 0x2e, 0x22,
-	0xf3, 0xb4, 0x54, 0x7b, 0x33, 0x11, 0x92, 0x09, 0x09, 0xcd, 0x29, 0xb2, 0x87, 0x3a, 0xb5, 0x41,
-	0x04, 0x11, 0x36, 0xae, 0xdf, 0x6c, 0xf4, 0xc6, 0x97, 0x06, 0x68, 0x8e, 0x4c, 0x1d, 0xef, 0x26,
-	0x58, 0x4b, 0x71, 0x2e, 0x24, 0x55, 0x30, 0xc5, 0x5c, 0x30, 0xdf, 0xed, 0xba, 0xfd, 0x4b, 0xf1,
-	0xe5, 0x3a, 0xf8, 0x58, 0xc7, 0xbc, 0x04, 0xb4, 0xc8, 0x9c, 0x40, 0xa9, 0xd0, 0x8c, 0x72, 0x02,
-	0x73, 0x5c, 0xc0, 0xc9, 0x42, 0x61, 0xe9, 0x9f, 0xd3, 0xf0, 0xe0, 0xce, 0xe1, 0x71, 0xc7, 0xf9,
-	0x7a, 0xdc, 0x69, 0xd9, 0xd2, 0x32, 0x9d, 0x85, 0x54, 0x44, 0x0c, 0xa9, 0x69, 0x38, 0xe4, 0xea,
-	0xd3, 0xc7, 0xdb, 0xa0, 0xfe, 0xa6, 0x21, 0x57, 0x1f, 0x4e, 0x0e, 0xb6, 0xdc, 0xd8, 0x23, 0x73,
-	0x32, 0xb6, 0xb6, 0x11, 0x2e, 0x06, 0xda, 0xe5, 0x8d, 0xc0, 0x2d, 0x86, 0x2a, 0x98, 0x89, 0x04,
-	0x65, 0xb0, 0x6e, 0x19, 0x9a, 0x9e, 0x21, 0x2f, 0x99, 0x2d, 0x5a, 0x26, 0x33, 0xac, 0xfc, 0xf3,
-	0x5d, 0xb7, 0xbf, 0x16, 0x77, 0x19, 0xaa, 0x9e, 0x6b, 0x78, 0xd7, 0xb2, 0xdb, 0x1a, 0xdd, 0x29,
-	0x99, 0x16, 0x1a, 0xce, 0x8b, 0x41, 0x4f, 0x1b, 0x49, 0x26, 0x26, 0xff, 0x54, 0xbe, 0x46, 0x8c,
-	0x66, 0x0b, 0xbf, 0x61, 0x94, 0xd7, 0x19, 0xaa, 0xb6, 0x0d, 0xfd, 0xb7, 0xf3, 0xa9, 0x01, 0xbd,
-	0x07, 0x60, 0x53, 0x3b, 0xa5, 0x12, 0x05, 0x86, 0x92, 0xee, 0xe1, 0xd3, 0x96, 0x0b, 0x5d, 0xb7,
-	0xdf, 0x88, 0x5b, 0x0c, 0x55, 0x63, 0x9d, 0x1f, 0xd3, 0x3d, 0xfc, 0xeb, 0xe6, 0x2b, 0x70, 0x4d,
-	0xbe, 0x41, 0x39, 0xa4, 0x1c, 0xce, 0x51, 0x46, 0x53, 0xaa, 0x16, 0xfa, 0x2e, 0x15, 0xa9, 0xdf,
-	0x34, 0x63, 0xec, 0xfd, 0xe7, 0x08, 0xe3, 0x0d, 0xed, 0x19, 0xf2, 0xdd, 0xda, 0x32, 0x32, 0x12,
-	0xef, 0x25, 0xb8, 0x2a, 0x73, 0x98, 0x08, 0x9e, 0x94, 0x45, 0x81, 0xb9, 0x82, 0xb8, 0xa2, 0x4a,
-	0xf7, 0xe9, 0x5f, 0x3c, 0x9b, 0xfe, 0x8a, 0xcc, 0x1f, 0xfd, 0xb4, 0x3c, 0xa9, 0xa8, 0xda, 0x29,
-	0xd9, 0xc3, 0xde, 0xfb, 0xfd, 0x8e, 0xf3, 0x7d, 0xbf, 0xe3, 0xbe, 0x3d, 0x39, 0xd8, 0x6a, 0x9b,
-	0xb5, 0xad, 0x7e, 0x5f, 0x5c, 0xbb, 0x50, 0x83, 0x67, 0x87, 0xcb, 0xc0, 0x3d, 0x5a, 0x06, 0xee,
-	0xb7, 0x65, 0xe0, 0xbe, 0x5b, 0x05, 0xce, 0xd1, 0x2a, 0x70, 0x3e, 0xaf, 0x02, 0xe7, 0xc5, 0x5d,
-	0x42, 0xd5, 0xb4, 0x9c, 0x84, 0x89, 0x60, 0x91, 0x16, 0x24, 0x53, 0x44, 0x79, 0x64, 0xff, 0x80,
-	0x7b, 0x7f, 0xda, 0xd4, 0x22, 0xc7, 0x72, 0xd2, 0x34, 0xfb, 0x7a, 0xff, 0x47, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x27, 0xee, 0x34, 0x58, 0x28, 0x03, 0x00, 0x00,
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0xda, 0x46, 0x3b, 0xd8, 0x43, 0xd7, 0x44, 0xb7, 0x39, 0x6c, 0xa2, 0x82, 0x09,
+	0x05, 0xb3, 0xfe, 0x00, 0x11, 0x6f, 0xc6, 0x1f, 0x25, 0x28, 0x25, 0x6c, 0xa0, 0x07, 0x11, 0x87,
+	0xc9, 0xee, 0x38, 0x19, 0xb2, 0x33, 0xb3, 0xec, 0xcc, 0xc6, 0x4d, 0xff, 0x04, 0x4f, 0x1e, 0x3d,
+	0xf6, 0xe8, 0xb1, 0x07, 0xff, 0x88, 0x1e, 0x8b, 0x20, 0x88, 0x87, 0x22, 0xc9, 0xa1, 0xfe, 0x19,
+	0x32, 0x33, 0xab, 0xd6, 0x16, 0xc1, 0x5e, 0x42, 0xe6, 0xbd, 0xef, 0x7c, 0xde, 0xfb, 0xbe, 0x9d,
+	0x07, 0x7c, 0x26, 0x22, 0x14, 0x4c, 0x69, 0xa6, 0x72, 0x94, 0x90, 0x4c, 0xe4, 0x69, 0x90, 0xa2,
+	0x0c, 0x31, 0xd9, 0x4d, 0x33, 0xa1, 0x84, 0xbb, 0xa6, 0xf3, 0xdd, 0xe3, 0xf9, 0xc6, 0x1a, 0x62,
+	0x94, 0x8b, 0xc0, 0xfc, 0x5a, 0x55, 0x63, 0x3d, 0x12, 0x92, 0x09, 0x09, 0xcd, 0x29, 0xb0, 0x87,
+	0x32, 0x55, 0x23, 0x82, 0x08, 0x1b, 0xd7, 0xff, 0x6c, 0xf4, 0xfa, 0x97, 0x65, 0x50, 0x1d, 0x98,
+	0x3a, 0xee, 0x0d, 0xb0, 0x1a, 0xe3, 0x54, 0x48, 0xaa, 0x60, 0x8c, 0xb9, 0x60, 0x9e, 0xd3, 0x72,
+	0x3a, 0x2b, 0xe1, 0xa5, 0x32, 0xf8, 0x44, 0xc7, 0xdc, 0x08, 0xd4, 0xc9, 0x94, 0x40, 0xa9, 0xd0,
+	0x84, 0x72, 0x02, 0x53, 0x9c, 0xc1, 0xd1, 0x4c, 0x61, 0xe9, 0x9d, 0xd3, 0xe2, 0xde, 0xed, 0xfd,
+	0xc3, 0x66, 0xe5, 0xdb, 0x61, 0xb3, 0x6e, 0x4b, 0xcb, 0x78, 0xd2, 0xa5, 0x22, 0x60, 0x48, 0x8d,
+	0xbb, 0x7d, 0xae, 0x3e, 0x7f, 0xba, 0x05, 0xca, 0x9e, 0xfa, 0x5c, 0x7d, 0x3c, 0xda, 0xdb, 0x70,
+	0x42, 0x97, 0x4c, 0xc9, 0xd0, 0xd2, 0x06, 0x38, 0xeb, 0x69, 0x96, 0x3b, 0x00, 0x37, 0x19, 0x2a,
+	0x60, 0x22, 0x22, 0x94, 0xc0, 0xd2, 0x32, 0x34, 0x9e, 0x21, 0xcf, 0x99, 0x2d, 0x9a, 0x47, 0x13,
+	0xac, 0xbc, 0xf3, 0x2d, 0xa7, 0xb3, 0x1a, 0xb6, 0x18, 0x2a, 0x5e, 0x68, 0xf1, 0xb6, 0xd5, 0x6e,
+	0x6a, 0xe9, 0x56, 0xce, 0x34, 0xd0, 0xe8, 0xdc, 0x10, 0xb4, 0x35, 0x91, 0x24, 0x62, 0xf4, 0x4f,
+	0xe4, 0x1b, 0xc4, 0x68, 0x32, 0xf3, 0x96, 0x0c, 0xf2, 0x1a, 0x43, 0xc5, 0xa6, 0x51, 0x9f, 0x66,
+	0x3e, 0x33, 0x42, 0xf7, 0x01, 0x58, 0xd7, 0x4c, 0xa9, 0x44, 0x86, 0xa1, 0xa4, 0x3b, 0xf8, 0x38,
+	0x65, 0xb9, 0xe5, 0x74, 0x96, 0xc2, 0x3a, 0x43, 0xc5, 0x50, 0xe7, 0x87, 0x74, 0x07, 0xff, 0xb9,
+	0xf9, 0x1a, 0x5c, 0x95, 0x6f, 0x51, 0x0a, 0x29, 0x87, 0x53, 0x94, 0xd0, 0x98, 0xaa, 0x99, 0xbe,
+	0x4b, 0x45, 0xec, 0x55, 0xcd, 0x18, 0xdb, 0xff, 0x39, 0xc2, 0xb0, 0xa6, 0x39, 0x7d, 0xbe, 0x5d,
+	0x52, 0x06, 0x06, 0xe2, 0xbe, 0x02, 0x57, 0x64, 0x0a, 0x23, 0xc1, 0xa3, 0x3c, 0xcb, 0x30, 0x57,
+	0x10, 0x17, 0x54, 0x69, 0x9f, 0xde, 0x85, 0xb3, 0xe1, 0x2f, 0xcb, 0xf4, 0xf1, 0x6f, 0xca, 0xd3,
+	0x82, 0xaa, 0xad, 0x9c, 0xb9, 0x3d, 0xe0, 0xff, 0xea, 0x1e, 0xe5, 0x91, 0xa2, 0x82, 0xc3, 0x11,
+	0x8d, 0xe3, 0xf2, 0x39, 0x68, 0x13, 0x17, 0x8d, 0xf9, 0x86, 0xed, 0xed, 0x91, 0xd5, 0xf4, 0xac,
+	0xa4, 0xec, 0xf0, 0x3e, 0xf0, 0xcc, 0xec, 0x4e, 0x73, 0xa4, 0xb7, 0x62, 0x3e, 0x40, 0x4d, 0x8f,
+	0xee, 0x04, 0x40, 0x3e, 0x6c, 0x7f, 0xd8, 0x6d, 0x56, 0x7e, 0xec, 0x36, 0x9d, 0x77, 0x47, 0x7b,
+	0x1b, 0x0d, 0xb3, 0x32, 0xc5, 0xdf, 0x4b, 0x63, 0x1f, 0x73, 0xef, 0xf9, 0xfe, 0xdc, 0x77, 0x0e,
+	0xe6, 0xbe, 0xf3, 0x7d, 0xee, 0x3b, 0xef, 0x17, 0x7e, 0xe5, 0x60, 0xe1, 0x57, 0xbe, 0x2e, 0xfc,
+	0xca, 0xcb, 0x3b, 0x84, 0xaa, 0x71, 0x3e, 0xea, 0x46, 0x82, 0x05, 0x1a, 0x10, 0x8d, 0x11, 0xe5,
+	0x81, 0xdd, 0xbe, 0xbb, 0x27, 0x69, 0x6a, 0x96, 0x62, 0x39, 0xaa, 0x9a, 0x5d, 0xb9, 0xf7, 0x73,
+	0x00, 0x29, 0x98, 0x04, 0x97, 0xa4, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -194,6 +216,12 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if !this.SpConcurrentExitNum.Equal(*that1.SpConcurrentExitNum) {
 		return false
 	}
+	if this.SwapInAuctionBiddingPeriod != that1.SwapInAuctionBiddingPeriod {
+		return false
+	}
+	if this.MaxSwapInAuctionBids != that1.MaxSwapInAuctionBids {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -216,6 +244,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSwapInAuctionBids != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSwapInAuctionBids))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SwapInAuctionBiddingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SwapInAuctionBiddingPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.SpConcurrentExitNum != nil {
 		{
 			size := m.SpConcurrentExitNum.Size()
@@ -315,6 +353,12 @@ func (m *Params) Size() (n int) {
 		l = m.SpConcurrentExitNum.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.SwapInAuctionBiddingPeriod != 0 {
+		n += 1 + sovParams(uint64(m.SwapInAuctionBiddingPeriod))
+	}
+	if m.MaxSwapInAuctionBids != 0 {
+		n += 1 + sovParams(uint64(m.MaxSwapInAuctionBids))
+	}
 	return n
 }
 
@@ -548,6 +592,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapInAuctionBiddingPeriod", wireType)
+			}
+			m.SwapInAuctionBiddingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapInAuctionBiddingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapInAuctionBids", wireType)
+			}
+			m.MaxSwapInAuctionBids = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSwapInAuctionBids |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -918,6 +918,10 @@ func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) GetPickVgfStrategy() Pic
 
 type QuerySpOptimalGlobalVirtualGroupFamilyResponse struct {
 	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The explanation of the choice.
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// The candidate families ranked by the reputation aware strategies, from the best to the worst.
+	Candidates []GVGFamilyCandidate `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates"`
 }
 
 func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) Reset() {
@@ -964,1491 +968,4223 @@ func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) GetGlobalVirtualGroupFa
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "moca.virtualgroup.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "moca.virtualgroup.QueryParamsResponse")
-	proto.RegisterType((*QueryGlobalVirtualGroupRequest)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupRequest")
-	proto.RegisterType((*QueryGlobalVirtualGroupResponse)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupResponse")
-	proto.RegisterType((*QueryGlobalVirtualGroupByFamilyIDRequest)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupByFamilyIDRequest")
-	proto.RegisterType((*QueryGlobalVirtualGroupByFamilyIDResponse)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupByFamilyIDResponse")
-	proto.RegisterType((*QueryGlobalVirtualGroupFamilyRequest)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupFamilyRequest")
-	proto.RegisterType((*QueryGlobalVirtualGroupFamilyResponse)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupFamilyResponse")
-	proto.RegisterType((*QueryGlobalVirtualGroupFamiliesRequest)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupFamiliesRequest")
-	proto.RegisterType((*QueryGlobalVirtualGroupFamiliesResponse)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupFamiliesResponse")
-	proto.RegisterType((*AvailableGlobalVirtualGroupFamiliesRequest)(nil), "moca.virtualgroup.AvailableGlobalVirtualGroupFamiliesRequest")
-	proto.RegisterType((*AvailableGlobalVirtualGroupFamiliesResponse)(nil), "moca.virtualgroup.AvailableGlobalVirtualGroupFamiliesResponse")
-	proto.RegisterType((*QuerySwapInInfoRequest)(nil), "moca.virtualgroup.QuerySwapInInfoRequest")
-	proto.RegisterType((*QuerySwapInInfoResponse)(nil), "moca.virtualgroup.QuerySwapInInfoResponse")
-	proto.RegisterType((*QuerySPGVGStatisticsRequest)(nil), "moca.virtualgroup.QuerySPGVGStatisticsRequest")
-	proto.RegisterType((*QuerySPGVGStatisticsResponse)(nil), "moca.virtualgroup.QuerySPGVGStatisticsResponse")
-	proto.RegisterType((*QuerySPAvailableGlobalVirtualGroupFamiliesRequest)(nil), "moca.virtualgroup.QuerySPAvailableGlobalVirtualGroupFamiliesRequest")
-	proto.RegisterType((*QuerySPAvailableGlobalVirtualGroupFamiliesResponse)(nil), "moca.virtualgroup.QuerySPAvailableGlobalVirtualGroupFamiliesResponse")
-	proto.RegisterType((*QuerySpOptimalGlobalVirtualGroupFamilyRequest)(nil), "moca.virtualgroup.QuerySpOptimalGlobalVirtualGroupFamilyRequest")
-	proto.RegisterType((*QuerySpOptimalGlobalVirtualGroupFamilyResponse)(nil), "moca.virtualgroup.QuerySpOptimalGlobalVirtualGroupFamilyResponse")
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) GetExplanation() string {
+	if m != nil {
+		return m.Explanation
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("moca/virtualgroup/query.proto", fileDescriptor_1c7f0467e0fe3f9a) }
-
-var fileDescriptor_1c7f0467e0fe3f9a = []byte{
-	// 1125 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xb3, 0x81, 0x46, 0xcd, 0x6b, 0x43, 0x95, 0x49, 0xa0, 0xe9, 0x26, 0x75, 0xe8, 0xd2,
-	0xe6, 0x57, 0x61, 0xb7, 0x31, 0x50, 0xfa, 0x23, 0xa5, 0xc4, 0x24, 0x31, 0x11, 0x52, 0x09, 0xb6,
-	0x94, 0x88, 0x5e, 0x56, 0x63, 0x67, 0xbd, 0x19, 0x6a, 0xef, 0x6c, 0xbd, 0x6b, 0x17, 0xe7, 0x84,
-	0x38, 0x70, 0xe2, 0x80, 0x14, 0xce, 0x9c, 0x39, 0x70, 0xe0, 0xc2, 0x3f, 0x80, 0x84, 0xd4, 0x0b,
-	0x52, 0x25, 0x0e, 0x70, 0x42, 0x28, 0x41, 0x82, 0x23, 0x7f, 0x02, 0xda, 0xd9, 0xb7, 0xb1, 0xcd,
-	0xce, 0xda, 0xeb, 0x34, 0x97, 0x68, 0xb5, 0x33, 0xdf, 0xf7, 0xbe, 0x9f, 0xd9, 0x37, 0x6f, 0x26,
-	0x86, 0xcb, 0x35, 0x5e, 0xa6, 0x46, 0x93, 0xd5, 0xfd, 0x06, 0xad, 0xda, 0x75, 0xde, 0x70, 0x8d,
-	0xc7, 0x0d, 0xab, 0xde, 0xd2, 0xdd, 0x3a, 0xf7, 0x39, 0x19, 0x0f, 0x86, 0xf5, 0xce, 0x61, 0x75,
-	0x9c, 0xd6, 0x98, 0xc3, 0x0d, 0xf1, 0x37, 0x9c, 0xa5, 0x2e, 0x95, 0xb9, 0x57, 0xe3, 0x9e, 0x51,
-	0xa2, 0x9e, 0x15, 0xca, 0x8d, 0xe6, 0x72, 0xc9, 0xf2, 0xe9, 0xb2, 0xe1, 0x52, 0x9b, 0x39, 0xd4,
-	0x67, 0xdc, 0xc1, 0xb9, 0x93, 0x36, 0xb7, 0xb9, 0x78, 0x34, 0x82, 0x27, 0x7c, 0x3b, 0x63, 0x73,
-	0x6e, 0x57, 0x2d, 0x83, 0xba, 0xcc, 0xa0, 0x8e, 0xc3, 0x7d, 0x21, 0xf1, 0x70, 0x34, 0x13, 0x37,
-	0x59, 0xe6, 0xb5, 0xda, 0x71, 0x4c, 0xc9, 0xb8, 0x4b, 0xeb, 0xb4, 0x16, 0xe9, 0x25, 0x90, 0x7e,
-	0xcb, 0xb5, 0x70, 0x58, 0x9b, 0x04, 0xf2, 0x71, 0x60, 0x7a, 0x4b, 0x68, 0x0a, 0xd6, 0xe3, 0x86,
-	0xe5, 0xf9, 0x5a, 0x11, 0x26, 0xba, 0xde, 0x7a, 0x2e, 0x77, 0x3c, 0x8b, 0xac, 0xc0, 0x48, 0x18,
-	0x7b, 0x4a, 0x79, 0x55, 0x59, 0x38, 0x97, 0xbd, 0xa4, 0xc7, 0x96, 0x48, 0x0f, 0x25, 0xb9, 0xd1,
-	0xa7, 0x7f, 0xcc, 0x0e, 0x7d, 0xf7, 0xf7, 0x0f, 0x4b, 0x4a, 0x01, 0x35, 0xda, 0x0e, 0x64, 0x44,
-	0xd0, 0x7c, 0x95, 0x97, 0x68, 0x75, 0x3b, 0x14, 0xe5, 0x03, 0x11, 0xa6, 0x25, 0x6f, 0xc3, 0x45,
-	0x5b, 0x0c, 0x9a, 0x18, 0xd2, 0x14, 0x31, 0x4d, 0xb6, 0x2b, 0x12, 0x8e, 0x15, 0x26, 0xed, 0x98,
-	0x76, 0x73, 0x57, 0xdb, 0x87, 0xd9, 0xc4, 0xc0, 0xe8, 0x7c, 0x07, 0x26, 0x65, 0x91, 0x91, 0xe3,
-	0x9a, 0x84, 0x43, 0x12, 0x8c, 0xc4, 0xb3, 0x6b, 0x0e, 0x2c, 0x24, 0xe4, 0xce, 0xb5, 0x36, 0x68,
-	0x8d, 0x55, 0x5b, 0x9b, 0x6b, 0x11, 0x5e, 0x0e, 0x32, 0x52, 0xbc, 0x8a, 0x98, 0xd7, 0xa6, 0x54,
-	0xe3, 0x79, 0x30, 0xd4, 0xae, 0xf6, 0xa5, 0x02, 0x8b, 0x29, 0x12, 0x22, 0xf6, 0x27, 0xf0, 0xb2,
-	0x2c, 0x63, 0xf0, 0xfd, 0x5e, 0x48, 0xcf, 0x3d, 0x11, 0xf7, 0xe3, 0x69, 0xef, 0xc3, 0xd5, 0x04,
-	0x1f, 0xa1, 0x8b, 0x08, 0x7a, 0x1a, 0x46, 0xff, 0xcf, 0x77, 0xb6, 0x12, 0xd1, 0x1c, 0x28, 0x70,
-	0xad, 0x4f, 0x14, 0x24, 0xf9, 0x14, 0xa6, 0x7b, 0xac, 0x1d, 0x7e, 0xc7, 0xeb, 0xa9, 0x78, 0x30,
-	0xf2, 0x54, 0xd2, 0x2a, 0x6b, 0x2e, 0xcc, 0xf5, 0x32, 0xc5, 0xac, 0x68, 0x9f, 0x90, 0x0d, 0x80,
-	0xf6, 0x26, 0x47, 0x13, 0x73, 0x7a, 0xd8, 0x11, 0xf4, 0xa0, 0x23, 0xe8, 0x61, 0x43, 0xc1, 0x8e,
-	0xa0, 0x6f, 0x51, 0xdb, 0x42, 0x6d, 0xa1, 0x43, 0xa9, 0xfd, 0xa4, 0xc0, 0x7c, 0xdf, 0x94, 0xb8,
-	0x12, 0x0f, 0xe0, 0xbc, 0xdd, 0xb4, 0x43, 0x70, 0x66, 0x45, 0x9f, 0x72, 0x20, 0xf4, 0x73, 0x76,
-	0xd3, 0x8e, 0xe2, 0x92, 0x7c, 0x17, 0xc3, 0xb0, 0x60, 0x98, 0xef, 0xcb, 0x10, 0x9a, 0xe9, 0x82,
-	0xa8, 0xc3, 0xd2, 0x6a, 0x93, 0xb2, 0x2a, 0x2d, 0x55, 0xad, 0xfe, 0x4b, 0xb7, 0x06, 0xb3, 0xbd,
-	0x37, 0x43, 0x48, 0x36, 0x56, 0x98, 0x4e, 0xde, 0x0d, 0x9e, 0xe6, 0xc1, 0xf5, 0x54, 0x39, 0x71,
-	0xed, 0x4e, 0x27, 0xe9, 0x81, 0x02, 0xaf, 0x88, 0xaf, 0x55, 0x7c, 0x42, 0xdd, 0x4d, 0x67, 0xd3,
-	0xa9, 0xf0, 0x53, 0xdc, 0xe2, 0xbd, 0xba, 0xe0, 0x70, 0x8f, 0x2e, 0xf8, 0x10, 0x2e, 0xc6, 0x4c,
-	0x21, 0xf6, 0x7d, 0x38, 0xef, 0x3d, 0xa1, 0xae, 0xc9, 0x1c, 0x93, 0x39, 0x15, 0x8e, 0x85, 0x7a,
-	0x59, 0x52, 0x32, 0x1d, 0x62, 0xf0, 0x8e, 0x9f, 0xb5, 0x2c, 0x4c, 0x87, 0xb1, 0xb7, 0xf2, 0xdb,
-	0xf9, 0x62, 0x70, 0x40, 0x79, 0x3e, 0x2b, 0x1f, 0x7f, 0xcb, 0x09, 0x38, 0xe3, 0x75, 0x74, 0xe9,
-	0x17, 0xbd, 0xc0, 0x8f, 0x05, 0x33, 0x72, 0x0d, 0x9a, 0x5a, 0x87, 0xd1, 0xa0, 0x8e, 0x3d, 0x9f,
-	0xfa, 0xd1, 0x79, 0xb2, 0x20, 0x2b, 0xe2, 0x4e, 0xf1, 0x0e, 0xf3, 0xf7, 0x98, 0x53, 0xdc, 0x2a,
-	0x9c, 0xb5, 0x9b, 0x76, 0xf0, 0xda, 0xd3, 0x3e, 0x80, 0x65, 0x4c, 0x33, 0x40, 0xf1, 0x49, 0x0d,
-	0xef, 0x43, 0x76, 0x90, 0x48, 0xa7, 0x5a, 0x52, 0xdf, 0x28, 0xf0, 0x46, 0x98, 0xdc, 0xfd, 0xc8,
-	0xf5, 0x59, 0x8d, 0x56, 0xfb, 0xf5, 0x55, 0x19, 0x02, 0x79, 0x00, 0xe3, 0x2e, 0x2b, 0x3f, 0x32,
-	0x9b, 0x76, 0xc5, 0xf4, 0xfc, 0x3a, 0xf5, 0x2d, 0xbb, 0x25, 0x8a, 0xe6, 0xa5, 0xac, 0x26, 0x3b,
-	0xab, 0x59, 0xf9, 0xd1, 0x76, 0x7e, 0xa3, 0x88, 0x33, 0x0b, 0x17, 0x02, 0xf1, 0xb6, 0x5d, 0x89,
-	0x5e, 0x68, 0x3e, 0xe8, 0x69, 0x5d, 0xe1, 0x72, 0x9c, 0xc2, 0x06, 0xc8, 0xfe, 0x78, 0x01, 0xce,
-	0x88, 0xb4, 0x64, 0x1f, 0x46, 0xc2, 0xfb, 0x04, 0x91, 0x1d, 0x55, 0xf1, 0x8b, 0x8b, 0x3a, 0xd7,
-	0x6f, 0x5a, 0x68, 0x53, 0xbb, 0xf2, 0xc5, 0xaf, 0x7f, 0x1d, 0x0c, 0x4f, 0x93, 0x4b, 0x46, 0xd2,
-	0xf5, 0x89, 0x7c, 0xaf, 0x00, 0x89, 0xe3, 0x92, 0xe5, 0xa4, 0x0c, 0x89, 0xd7, 0x1a, 0x35, 0x3b,
-	0x88, 0x04, 0x0d, 0x1a, 0xc2, 0xe0, 0x22, 0x99, 0x97, 0x18, 0x94, 0x2d, 0x30, 0xf9, 0x4d, 0x81,
-	0x99, 0x5e, 0x77, 0x02, 0x72, 0x37, 0xbd, 0x8b, 0xd8, 0xd5, 0x45, 0x5d, 0x39, 0x99, 0x18, 0x61,
-	0x56, 0x04, 0xcc, 0x4d, 0xf2, 0x56, 0x4a, 0x18, 0xb3, 0xd4, 0x6a, 0x17, 0x0c, 0xf9, 0x59, 0x81,
-	0xa9, 0xa4, 0xba, 0x23, 0xef, 0xa4, 0x37, 0xd6, 0xb5, 0x7f, 0xd4, 0x5b, 0x83, 0x0b, 0x91, 0xe6,
-	0xa6, 0xa0, 0xb9, 0x41, 0xf4, 0xb4, 0x34, 0x21, 0x0a, 0xf9, 0x45, 0x01, 0x35, 0xb9, 0xa1, 0x90,
-	0xdb, 0x03, 0x1a, 0x6a, 0xb7, 0x33, 0xf5, 0xce, 0x49, 0xa4, 0x48, 0x73, 0x4b, 0xd0, 0x64, 0xc9,
-	0x8d, 0x81, 0x68, 0x02, 0xc3, 0xff, 0x28, 0xf0, 0x5a, 0x8a, 0x4e, 0x49, 0xee, 0x49, 0xdc, 0xa5,
-	0xef, 0xd5, 0xea, 0xbb, 0x27, 0x95, 0x23, 0x60, 0x4e, 0x00, 0xae, 0x90, 0x3b, 0x12, 0x40, 0x1a,
-	0xc5, 0x31, 0x7b, 0xa3, 0x7e, 0xa5, 0x00, 0xb4, 0x8f, 0x46, 0xb2, 0x98, 0xb4, 0xde, 0xb1, 0x0b,
-	0x81, 0xba, 0x94, 0x66, 0x2a, 0x3a, 0x9d, 0x17, 0x4e, 0xaf, 0x90, 0x59, 0x89, 0xd3, 0xce, 0xf3,
-	0x9b, 0x7c, 0xab, 0xc0, 0x58, 0xd7, 0xb9, 0x48, 0xf4, 0xc4, 0x34, 0xd2, 0x13, 0x5b, 0x35, 0x52,
-	0xcf, 0x47, 0x6f, 0xaf, 0x0b, 0x6f, 0x73, 0xe4, 0xaa, 0xcc, 0x9b, 0x6b, 0x46, 0x27, 0x39, 0xda,
-	0xf9, 0x7c, 0x18, 0x96, 0xf0, 0xe0, 0x48, 0x53, 0x21, 0x6b, 0xc9, 0x6e, 0x06, 0x28, 0x94, 0xf5,
-	0xe7, 0x8c, 0x82, 0xa4, 0xeb, 0x82, 0xf4, 0x3e, 0xb9, 0x27, 0x27, 0x4d, 0x5b, 0x32, 0xff, 0x2a,
-	0xf8, 0x5f, 0x44, 0xdf, 0xb3, 0x93, 0xbc, 0x97, 0x68, 0x3c, 0xe5, 0x65, 0x40, 0x5d, 0x7d, 0x8e,
-	0x08, 0x88, 0xbd, 0x2a, 0xb0, 0xef, 0x92, 0xdb, 0x72, 0x6c, 0x1e, 0x86, 0xe9, 0x01, 0xdd, 0xca,
-	0x7d, 0xf8, 0xf4, 0x30, 0xa3, 0x3c, 0x3b, 0xcc, 0x28, 0x7f, 0x1e, 0x66, 0x94, 0xaf, 0x8f, 0x32,
-	0x43, 0xcf, 0x8e, 0x32, 0x43, 0xbf, 0x1f, 0x65, 0x86, 0x1e, 0x2e, 0xdb, 0xcc, 0xdf, 0x6b, 0x94,
-	0xf4, 0x32, 0xaf, 0x89, 0xf0, 0xe5, 0x3d, 0xca, 0x1c, 0x4c, 0x94, 0x35, 0x3e, 0x93, 0xfc, 0x3c,
-	0x51, 0x1a, 0x11, 0xbf, 0x4f, 0xbc, 0xf9, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x14, 0x94,
-	0xa8, 0xa5, 0x11, 0x00, 0x00,
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) GetCandidates() []GVGFamilyCandidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a global virtual group by its id.
-	GlobalVirtualGroup(ctx context.Context, in *QueryGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupResponse, error)
-	// Queries a list of global virtual groups by family id.
-	GlobalVirtualGroupByFamilyID(ctx context.Context, in *QueryGlobalVirtualGroupByFamilyIDRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupByFamilyIDResponse, error)
-	// Queries a global virtual group family by its id.
-	GlobalVirtualGroupFamily(ctx context.Context, in *QueryGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamilyResponse, error)
-	// Queries a list of GlobalVirtualGroupFamilies items.
-	GlobalVirtualGroupFamilies(ctx context.Context, in *QueryGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamiliesResponse, error)
-	// AvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies ID which are qualified to create bucket on
-	AvailableGlobalVirtualGroupFamilies(ctx context.Context, in *AvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*AvailableGlobalVirtualGroupFamiliesResponse, error)
-	// SwapInInfo gets reserved swapIn info for a specific global virtual group family or global virtual group
-	SwapInInfo(ctx context.Context, in *QuerySwapInInfoRequest, opts ...grpc.CallOption) (*QuerySwapInInfoResponse, error)
-	// GVGStatistics gets gvg statistics for a SP
-	GVGStatistics(ctx context.Context, in *QuerySPGVGStatisticsRequest, opts ...grpc.CallOption) (*QuerySPGVGStatisticsResponse, error)
-	// QuerySpAvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies IDs under a certain SP that are qualified to create a bucket on
-	QuerySpAvailableGlobalVirtualGroupFamilies(ctx context.Context, in *QuerySPAvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QuerySPAvailableGlobalVirtualGroupFamiliesResponse, error)
-	// QuerySpOptimalGlobalVirtualGroupFamily filters the optimal GlobalVirtualGroupFamily under a certain SP that is qualified to create a bucket on
-	QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context, in *QuerySpOptimalGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QuerySpOptimalGlobalVirtualGroupFamilyResponse, error)
+type QuerySpExitPlanRequest struct {
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySpExitPlanRequest) Reset()         { *m = QuerySpExitPlanRequest{} }
+func (m *QuerySpExitPlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpExitPlanRequest) ProtoMessage()    {}
+func (*QuerySpExitPlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{20}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySpExitPlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySpExitPlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpExitPlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GlobalVirtualGroup(ctx context.Context, in *QueryGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupResponse, error) {
-	out := new(QueryGlobalVirtualGroupResponse)
-	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/GlobalVirtualGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySpExitPlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpExitPlanRequest.Merge(m, src)
 }
-
-func (c *queryClient) GlobalVirtualGroupByFamilyID(ctx context.Context, in *QueryGlobalVirtualGroupByFamilyIDRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupByFamilyIDResponse, error) {
-	out := new(QueryGlobalVirtualGroupByFamilyIDResponse)
-	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/GlobalVirtualGroupByFamilyID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySpExitPlanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpExitPlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpExitPlanRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GlobalVirtualGroupFamily(ctx context.Context, in *QueryGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamilyResponse, error) {
-	out := new(QueryGlobalVirtualGroupFamilyResponse)
-	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/GlobalVirtualGroupFamily", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpExitPlanRequest proto.InternalMessageInfo
+
+func (m *QuerySpExitPlanRequest) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GlobalVirtualGroupFamilies(ctx context.Context, in *QueryGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamiliesResponse, error) {
-	out := new(QueryGlobalVirtualGroupFamiliesResponse)
-	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/GlobalVirtualGroupFamilies", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySpExitPlanResponse struct {
+	Plan SpExitPlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan"`
 }
 
-func (c *queryClient) AvailableGlobalVirtualGroupFamilies(ctx context.Context, in *AvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*AvailableGlobalVirtualGroupFamiliesResponse, error) {
-	out := new(AvailableGlobalVirtualGroupFamiliesResponse)
-	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/AvailableGlobalVirtualGroupFamilies", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySpExitPlanResponse) Reset()         { *m = QuerySpExitPlanResponse{} }
+func (m *QuerySpExitPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpExitPlanResponse) ProtoMessage()    {}
+func (*QuerySpExitPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{21}
+}
+func (m *QuerySpExitPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpExitPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpExitPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySpExitPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpExitPlanResponse.Merge(m, src)
+}
+func (m *QuerySpExitPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpExitPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpExitPlanResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) SwapInInfo(ctx context.Context, in *QuerySwapInInfoRequest, opts ...grpc.CallOption) (*QuerySwapInInfoResponse, error) {
-	out := new(QuerySwapInInfoResponse)
-	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/SwapInInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpExitPlanResponse proto.InternalMessageInfo
+
+func (m *QuerySpExitPlanResponse) GetPlan() SpExitPlan {
+	if m != nil {
+		return m.Plan
 	}
-	return out, nil
+	return SpExitPlan{}
 }
 
-func (c *queryClient) GVGStatistics(ctx context.Context, in *QuerySPGVGStatisticsRequest, opts ...grpc.CallOption) (*QuerySPGVGStatisticsResponse, error) {
-	out := new(QuerySPGVGStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/GVGStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySwapInAuctionRequest struct {
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	GlobalVirtualGroupId       uint32 `protobuf:"varint,2,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
 }
 
-func (c *queryClient) QuerySpAvailableGlobalVirtualGroupFamilies(ctx context.Context, in *QuerySPAvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QuerySPAvailableGlobalVirtualGroupFamiliesResponse, error) {
-	out := new(QuerySPAvailableGlobalVirtualGroupFamiliesResponse)
-	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/QuerySpAvailableGlobalVirtualGroupFamilies", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySwapInAuctionRequest) Reset()         { *m = QuerySwapInAuctionRequest{} }
+func (m *QuerySwapInAuctionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapInAuctionRequest) ProtoMessage()    {}
+func (*QuerySwapInAuctionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{22}
+}
+func (m *QuerySwapInAuctionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapInAuctionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapInAuctionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySwapInAuctionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapInAuctionRequest.Merge(m, src)
+}
+func (m *QuerySwapInAuctionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapInAuctionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapInAuctionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context, in *QuerySpOptimalGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QuerySpOptimalGlobalVirtualGroupFamilyResponse, error) {
-	out := new(QuerySpOptimalGlobalVirtualGroupFamilyResponse)
-	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/QuerySpOptimalGlobalVirtualGroupFamily", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySwapInAuctionRequest proto.InternalMessageInfo
+
+func (m *QuerySwapInAuctionRequest) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a global virtual group by its id.
-	GlobalVirtualGroup(context.Context, *QueryGlobalVirtualGroupRequest) (*QueryGlobalVirtualGroupResponse, error)
-	// Queries a list of global virtual groups by family id.
-	GlobalVirtualGroupByFamilyID(context.Context, *QueryGlobalVirtualGroupByFamilyIDRequest) (*QueryGlobalVirtualGroupByFamilyIDResponse, error)
-	// Queries a global virtual group family by its id.
-	GlobalVirtualGroupFamily(context.Context, *QueryGlobalVirtualGroupFamilyRequest) (*QueryGlobalVirtualGroupFamilyResponse, error)
-	// Queries a list of GlobalVirtualGroupFamilies items.
-	GlobalVirtualGroupFamilies(context.Context, *QueryGlobalVirtualGroupFamiliesRequest) (*QueryGlobalVirtualGroupFamiliesResponse, error)
-	// AvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies ID which are qualified to create bucket on
-	AvailableGlobalVirtualGroupFamilies(context.Context, *AvailableGlobalVirtualGroupFamiliesRequest) (*AvailableGlobalVirtualGroupFamiliesResponse, error)
-	// SwapInInfo gets reserved swapIn info for a specific global virtual group family or global virtual group
-	SwapInInfo(context.Context, *QuerySwapInInfoRequest) (*QuerySwapInInfoResponse, error)
-	// GVGStatistics gets gvg statistics for a SP
-	GVGStatistics(context.Context, *QuerySPGVGStatisticsRequest) (*QuerySPGVGStatisticsResponse, error)
-	// QuerySpAvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies IDs under a certain SP that are qualified to create a bucket on
-	QuerySpAvailableGlobalVirtualGroupFamilies(context.Context, *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) (*QuerySPAvailableGlobalVirtualGroupFamiliesResponse, error)
-	// QuerySpOptimalGlobalVirtualGroupFamily filters the optimal GlobalVirtualGroupFamily under a certain SP that is qualified to create a bucket on
-	QuerySpOptimalGlobalVirtualGroupFamily(context.Context, *QuerySpOptimalGlobalVirtualGroupFamilyRequest) (*QuerySpOptimalGlobalVirtualGroupFamilyResponse, error)
+func (m *QuerySwapInAuctionRequest) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QuerySwapInAuctionResponse struct {
+	Auction *SwapInAuction `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySwapInAuctionResponse) Reset()         { *m = QuerySwapInAuctionResponse{} }
+func (m *QuerySwapInAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapInAuctionResponse) ProtoMessage()    {}
+func (*QuerySwapInAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{23}
 }
-func (*UnimplementedQueryServer) GlobalVirtualGroup(ctx context.Context, req *QueryGlobalVirtualGroupRequest) (*QueryGlobalVirtualGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroup not implemented")
+func (m *QuerySwapInAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GlobalVirtualGroupByFamilyID(ctx context.Context, req *QueryGlobalVirtualGroupByFamilyIDRequest) (*QueryGlobalVirtualGroupByFamilyIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupByFamilyID not implemented")
+func (m *QuerySwapInAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapInAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GlobalVirtualGroupFamily(ctx context.Context, req *QueryGlobalVirtualGroupFamilyRequest) (*QueryGlobalVirtualGroupFamilyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupFamily not implemented")
+func (m *QuerySwapInAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapInAuctionResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GlobalVirtualGroupFamilies(ctx context.Context, req *QueryGlobalVirtualGroupFamiliesRequest) (*QueryGlobalVirtualGroupFamiliesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupFamilies not implemented")
+func (m *QuerySwapInAuctionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AvailableGlobalVirtualGroupFamilies(ctx context.Context, req *AvailableGlobalVirtualGroupFamiliesRequest) (*AvailableGlobalVirtualGroupFamiliesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AvailableGlobalVirtualGroupFamilies not implemented")
+func (m *QuerySwapInAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapInAuctionResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) SwapInInfo(ctx context.Context, req *QuerySwapInInfoRequest) (*QuerySwapInInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SwapInInfo not implemented")
+
+var xxx_messageInfo_QuerySwapInAuctionResponse proto.InternalMessageInfo
+
+func (m *QuerySwapInAuctionResponse) GetAuction() *SwapInAuction {
+	if m != nil {
+		return m.Auction
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GVGStatistics(ctx context.Context, req *QuerySPGVGStatisticsRequest) (*QuerySPGVGStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GVGStatistics not implemented")
+
+type QuerySwapInAuctionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) QuerySpAvailableGlobalVirtualGroupFamilies(ctx context.Context, req *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) (*QuerySPAvailableGlobalVirtualGroupFamiliesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySpAvailableGlobalVirtualGroupFamilies not implemented")
+
+func (m *QuerySwapInAuctionsRequest) Reset()         { *m = QuerySwapInAuctionsRequest{} }
+func (m *QuerySwapInAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapInAuctionsRequest) ProtoMessage()    {}
+func (*QuerySwapInAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{24}
 }
-func (*UnimplementedQueryServer) QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context, req *QuerySpOptimalGlobalVirtualGroupFamilyRequest) (*QuerySpOptimalGlobalVirtualGroupFamilyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySpOptimalGlobalVirtualGroupFamily not implemented")
+func (m *QuerySwapInAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QuerySwapInAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapInAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapInAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapInAuctionsRequest.Merge(m, src)
+}
+func (m *QuerySwapInAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapInAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapInAuctionsRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.virtualgroup.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QuerySwapInAuctionsRequest proto.InternalMessageInfo
+
+func (m *QuerySwapInAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GlobalVirtualGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGlobalVirtualGroupRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GlobalVirtualGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.virtualgroup.Query/GlobalVirtualGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GlobalVirtualGroup(ctx, req.(*QueryGlobalVirtualGroupRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QuerySwapInAuctionsResponse struct {
+	Auctions   []*SwapInAuction    `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GlobalVirtualGroupByFamilyID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGlobalVirtualGroupByFamilyIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GlobalVirtualGroupByFamilyID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.virtualgroup.Query/GlobalVirtualGroupByFamilyID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GlobalVirtualGroupByFamilyID(ctx, req.(*QueryGlobalVirtualGroupByFamilyIDRequest))
+func (m *QuerySwapInAuctionsResponse) Reset()         { *m = QuerySwapInAuctionsResponse{} }
+func (m *QuerySwapInAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapInAuctionsResponse) ProtoMessage()    {}
+func (*QuerySwapInAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{25}
+}
+func (m *QuerySwapInAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapInAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapInAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySwapInAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapInAuctionsResponse.Merge(m, src)
+}
+func (m *QuerySwapInAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapInAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapInAuctionsResponse.DiscardUnknown(m)
 }
 
-func _Query_GlobalVirtualGroupFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGlobalVirtualGroupFamilyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GlobalVirtualGroupFamily(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.virtualgroup.Query/GlobalVirtualGroupFamily",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GlobalVirtualGroupFamily(ctx, req.(*QueryGlobalVirtualGroupFamilyRequest))
+var xxx_messageInfo_QuerySwapInAuctionsResponse proto.InternalMessageInfo
+
+func (m *QuerySwapInAuctionsResponse) GetAuctions() []*SwapInAuction {
+	if m != nil {
+		return m.Auctions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GlobalVirtualGroupFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGlobalVirtualGroupFamiliesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GlobalVirtualGroupFamilies(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.virtualgroup.Query/GlobalVirtualGroupFamilies",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GlobalVirtualGroupFamilies(ctx, req.(*QueryGlobalVirtualGroupFamiliesRequest))
+func (m *QuerySwapInAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AvailableGlobalVirtualGroupFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AvailableGlobalVirtualGroupFamiliesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AvailableGlobalVirtualGroupFamilies(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.virtualgroup.Query/AvailableGlobalVirtualGroupFamilies",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AvailableGlobalVirtualGroupFamilies(ctx, req.(*AvailableGlobalVirtualGroupFamiliesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRebalanceProposalRequest struct {
+	// The max number of moves to propose, zero for the max number of moves of a plan.
+	MaxMoves uint32 `protobuf:"varint,1,opt,name=max_moves,json=maxMoves,proto3" json:"max_moves,omitempty"`
 }
 
-func _Query_SwapInInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapInInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SwapInInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.virtualgroup.Query/SwapInInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SwapInInfo(ctx, req.(*QuerySwapInInfoRequest))
+func (m *QueryRebalanceProposalRequest) Reset()         { *m = QueryRebalanceProposalRequest{} }
+func (m *QueryRebalanceProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalanceProposalRequest) ProtoMessage()    {}
+func (*QueryRebalanceProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{26}
+}
+func (m *QueryRebalanceProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalanceProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalanceProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRebalanceProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalanceProposalRequest.Merge(m, src)
+}
+func (m *QueryRebalanceProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebalanceProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalanceProposalRequest.DiscardUnknown(m)
 }
 
-func _Query_GVGStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySPGVGStatisticsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GVGStatistics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.virtualgroup.Query/GVGStatistics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GVGStatistics(ctx, req.(*QuerySPGVGStatisticsRequest))
+var xxx_messageInfo_QueryRebalanceProposalRequest proto.InternalMessageInfo
+
+func (m *QueryRebalanceProposalRequest) GetMaxMoves() uint32 {
+	if m != nil {
+		return m.MaxMoves
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QuerySpAvailableGlobalVirtualGroupFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySPAvailableGlobalVirtualGroupFamiliesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySpAvailableGlobalVirtualGroupFamilies(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.virtualgroup.Query/QuerySpAvailableGlobalVirtualGroupFamilies",
+type QueryRebalanceProposalResponse struct {
+	// The current load of the in service sps.
+	Metrics RebalanceMetrics `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics"`
+	// The proposed moves, which can be submitted by MsgSubmitRebalancePlan.
+	Moves []RebalanceMove `protobuf:"bytes,2,rep,name=moves,proto3" json:"moves"`
+	// The load of the in service sps once all the moves complete.
+	ProjectedMetrics RebalanceMetrics `protobuf:"bytes,3,opt,name=projected_metrics,json=projectedMetrics,proto3" json:"projected_metrics"`
+}
+
+func (m *QueryRebalanceProposalResponse) Reset()         { *m = QueryRebalanceProposalResponse{} }
+func (m *QueryRebalanceProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalanceProposalResponse) ProtoMessage()    {}
+func (*QueryRebalanceProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{27}
+}
+func (m *QueryRebalanceProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalanceProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalanceProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySpAvailableGlobalVirtualGroupFamilies(ctx, req.(*QuerySPAvailableGlobalVirtualGroupFamiliesRequest))
+}
+func (m *QueryRebalanceProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalanceProposalResponse.Merge(m, src)
+}
+func (m *QueryRebalanceProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebalanceProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalanceProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRebalanceProposalResponse proto.InternalMessageInfo
+
+func (m *QueryRebalanceProposalResponse) GetMetrics() RebalanceMetrics {
+	if m != nil {
+		return m.Metrics
 	}
-	return interceptor(ctx, in, info, handler)
+	return RebalanceMetrics{}
 }
 
-func _Query_QuerySpOptimalGlobalVirtualGroupFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpOptimalGlobalVirtualGroupFamilyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryRebalanceProposalResponse) GetMoves() []RebalanceMove {
+	if m != nil {
+		return m.Moves
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySpOptimalGlobalVirtualGroupFamily(ctx, in)
+	return nil
+}
+
+func (m *QueryRebalanceProposalResponse) GetProjectedMetrics() RebalanceMetrics {
+	if m != nil {
+		return m.ProjectedMetrics
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/moca.virtualgroup.Query/QuerySpOptimalGlobalVirtualGroupFamily",
+	return RebalanceMetrics{}
+}
+
+type QueryRebalancePlanRequest struct {
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *QueryRebalancePlanRequest) Reset()         { *m = QueryRebalancePlanRequest{} }
+func (m *QueryRebalancePlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancePlanRequest) ProtoMessage()    {}
+func (*QueryRebalancePlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{28}
+}
+func (m *QueryRebalancePlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalancePlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancePlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySpOptimalGlobalVirtualGroupFamily(ctx, req.(*QuerySpOptimalGlobalVirtualGroupFamilyRequest))
+}
+func (m *QueryRebalancePlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancePlanRequest.Merge(m, src)
+}
+func (m *QueryRebalancePlanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebalancePlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancePlanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRebalancePlanRequest proto.InternalMessageInfo
+
+func (m *QueryRebalancePlanRequest) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "moca.virtualgroup.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GlobalVirtualGroup",
-			Handler:    _Query_GlobalVirtualGroup_Handler,
-		},
-		{
-			MethodName: "GlobalVirtualGroupByFamilyID",
-			Handler:    _Query_GlobalVirtualGroupByFamilyID_Handler,
-		},
-		{
-			MethodName: "GlobalVirtualGroupFamily",
-			Handler:    _Query_GlobalVirtualGroupFamily_Handler,
-		},
-		{
-			MethodName: "GlobalVirtualGroupFamilies",
-			Handler:    _Query_GlobalVirtualGroupFamilies_Handler,
-		},
-		{
-			MethodName: "AvailableGlobalVirtualGroupFamilies",
-			Handler:    _Query_AvailableGlobalVirtualGroupFamilies_Handler,
-		},
-		{
-			MethodName: "SwapInInfo",
-			Handler:    _Query_SwapInInfo_Handler,
-		},
-		{
-			MethodName: "GVGStatistics",
-			Handler:    _Query_GVGStatistics_Handler,
-		},
-		{
-			MethodName: "QuerySpAvailableGlobalVirtualGroupFamilies",
-			Handler:    _Query_QuerySpAvailableGlobalVirtualGroupFamilies_Handler,
-		},
-		{
-			MethodName: "QuerySpOptimalGlobalVirtualGroupFamily",
-			Handler:    _Query_QuerySpOptimalGlobalVirtualGroupFamily_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "moca/virtualgroup/query.proto",
+type QueryRebalancePlanResponse struct {
+	Plan *RebalancePlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlanResponse) Reset()         { *m = QueryRebalancePlanResponse{} }
+func (m *QueryRebalancePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancePlanResponse) ProtoMessage()    {}
+func (*QueryRebalancePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{29}
+}
+func (m *QueryRebalancePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalancePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRebalancePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancePlanResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRebalancePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebalancePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancePlanResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRebalancePlanResponse proto.InternalMessageInfo
+
+func (m *QueryRebalancePlanResponse) GetPlan() *RebalancePlan {
+	if m != nil {
+		return m.Plan
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRebalancePlansRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRebalancePlansRequest) Reset()         { *m = QueryRebalancePlansRequest{} }
+func (m *QueryRebalancePlansRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancePlansRequest) ProtoMessage()    {}
+func (*QueryRebalancePlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{30}
+}
+func (m *QueryRebalancePlansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalancePlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancePlansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGlobalVirtualGroupRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRebalancePlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancePlansRequest.Merge(m, src)
 }
-
-func (m *QueryGlobalVirtualGroupRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRebalancePlansRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGlobalVirtualGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRebalancePlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancePlansRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGlobalVirtualGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRebalancePlansRequest proto.InternalMessageInfo
+
+func (m *QueryRebalancePlansRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGlobalVirtualGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRebalancePlansResponse struct {
+	Plans      []*RebalancePlan    `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGlobalVirtualGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroup != nil {
-		{
-			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRebalancePlansResponse) Reset()         { *m = QueryRebalancePlansResponse{} }
+func (m *QueryRebalancePlansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancePlansResponse) ProtoMessage()    {}
+func (*QueryRebalancePlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c7f0467e0fe3f9a, []int{31}
 }
-
-func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalancePlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancePlansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGlobalVirtualGroupByFamilyIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRebalancePlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancePlansResponse.Merge(m, src)
 }
-
-func (m *QueryGlobalVirtualGroupByFamilyIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRebalancePlansResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRebalancePlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancePlansResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryRebalancePlansResponse proto.InternalMessageInfo
 
-func (m *QueryGlobalVirtualGroupByFamilyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroups) > 0 {
-		for iNdEx := len(m.GlobalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GlobalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryRebalancePlansResponse) GetPlans() []*RebalancePlan {
+	if m != nil {
+		return m.Plans
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGlobalVirtualGroupFamilyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlansResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGlobalVirtualGroupFamilyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *QueryGlobalVirtualGroupFamilyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FamilyId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FamilyId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "moca.virtualgroup.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "moca.virtualgroup.QueryParamsResponse")
+	proto.RegisterType((*QueryGlobalVirtualGroupRequest)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupRequest")
+	proto.RegisterType((*QueryGlobalVirtualGroupResponse)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupResponse")
+	proto.RegisterType((*QueryGlobalVirtualGroupByFamilyIDRequest)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupByFamilyIDRequest")
+	proto.RegisterType((*QueryGlobalVirtualGroupByFamilyIDResponse)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupByFamilyIDResponse")
+	proto.RegisterType((*QueryGlobalVirtualGroupFamilyRequest)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupFamilyRequest")
+	proto.RegisterType((*QueryGlobalVirtualGroupFamilyResponse)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupFamilyResponse")
+	proto.RegisterType((*QueryGlobalVirtualGroupFamiliesRequest)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupFamiliesRequest")
+	proto.RegisterType((*QueryGlobalVirtualGroupFamiliesResponse)(nil), "moca.virtualgroup.QueryGlobalVirtualGroupFamiliesResponse")
+	proto.RegisterType((*AvailableGlobalVirtualGroupFamiliesRequest)(nil), "moca.virtualgroup.AvailableGlobalVirtualGroupFamiliesRequest")
+	proto.RegisterType((*AvailableGlobalVirtualGroupFamiliesResponse)(nil), "moca.virtualgroup.AvailableGlobalVirtualGroupFamiliesResponse")
+	proto.RegisterType((*QuerySwapInInfoRequest)(nil), "moca.virtualgroup.QuerySwapInInfoRequest")
+	proto.RegisterType((*QuerySwapInInfoResponse)(nil), "moca.virtualgroup.QuerySwapInInfoResponse")
+	proto.RegisterType((*QuerySPGVGStatisticsRequest)(nil), "moca.virtualgroup.QuerySPGVGStatisticsRequest")
+	proto.RegisterType((*QuerySPGVGStatisticsResponse)(nil), "moca.virtualgroup.QuerySPGVGStatisticsResponse")
+	proto.RegisterType((*QuerySPAvailableGlobalVirtualGroupFamiliesRequest)(nil), "moca.virtualgroup.QuerySPAvailableGlobalVirtualGroupFamiliesRequest")
+	proto.RegisterType((*QuerySPAvailableGlobalVirtualGroupFamiliesResponse)(nil), "moca.virtualgroup.QuerySPAvailableGlobalVirtualGroupFamiliesResponse")
+	proto.RegisterType((*QuerySpOptimalGlobalVirtualGroupFamilyRequest)(nil), "moca.virtualgroup.QuerySpOptimalGlobalVirtualGroupFamilyRequest")
+	proto.RegisterType((*QuerySpOptimalGlobalVirtualGroupFamilyResponse)(nil), "moca.virtualgroup.QuerySpOptimalGlobalVirtualGroupFamilyResponse")
+	proto.RegisterType((*QuerySpExitPlanRequest)(nil), "moca.virtualgroup.QuerySpExitPlanRequest")
+	proto.RegisterType((*QuerySpExitPlanResponse)(nil), "moca.virtualgroup.QuerySpExitPlanResponse")
+	proto.RegisterType((*QuerySwapInAuctionRequest)(nil), "moca.virtualgroup.QuerySwapInAuctionRequest")
+	proto.RegisterType((*QuerySwapInAuctionResponse)(nil), "moca.virtualgroup.QuerySwapInAuctionResponse")
+	proto.RegisterType((*QuerySwapInAuctionsRequest)(nil), "moca.virtualgroup.QuerySwapInAuctionsRequest")
+	proto.RegisterType((*QuerySwapInAuctionsResponse)(nil), "moca.virtualgroup.QuerySwapInAuctionsResponse")
+	proto.RegisterType((*QueryRebalanceProposalRequest)(nil), "moca.virtualgroup.QueryRebalanceProposalRequest")
+	proto.RegisterType((*QueryRebalanceProposalResponse)(nil), "moca.virtualgroup.QueryRebalanceProposalResponse")
+	proto.RegisterType((*QueryRebalancePlanRequest)(nil), "moca.virtualgroup.QueryRebalancePlanRequest")
+	proto.RegisterType((*QueryRebalancePlanResponse)(nil), "moca.virtualgroup.QueryRebalancePlanResponse")
+	proto.RegisterType((*QueryRebalancePlansRequest)(nil), "moca.virtualgroup.QueryRebalancePlansRequest")
+	proto.RegisterType((*QueryRebalancePlansResponse)(nil), "moca.virtualgroup.QueryRebalancePlansResponse")
 }
 
-func (m *QueryGlobalVirtualGroupFamilyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("moca/virtualgroup/query.proto", fileDescriptor_1c7f0467e0fe3f9a) }
+
+var fileDescriptor_1c7f0467e0fe3f9a = []byte{
+	// 1598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0xd4, 0xd6,
+	0x16, 0xce, 0x0d, 0x49, 0x20, 0x07, 0xc2, 0x7b, 0xb9, 0xe4, 0x91, 0xe0, 0xc0, 0x04, 0x1c, 0x92,
+	0x90, 0x81, 0x78, 0xc8, 0x3c, 0xca, 0xcf, 0x50, 0x9a, 0x40, 0x98, 0x46, 0x08, 0x9a, 0x4e, 0xa4,
+	0xd0, 0xb2, 0xb1, 0xee, 0xcc, 0x38, 0xc6, 0x30, 0x63, 0x9b, 0xb1, 0x33, 0x24, 0xac, 0xaa, 0x2e,
+	0xba, 0xea, 0xa2, 0x12, 0x55, 0x5b, 0xa9, 0x15, 0xab, 0x4a, 0xed, 0xa2, 0x8b, 0xfe, 0x0d, 0x95,
+	0x2a, 0xb1, 0xa9, 0x84, 0xd4, 0x45, 0xbb, 0xaa, 0x10, 0xa9, 0xd4, 0x2e, 0xbb, 0xef, 0xa6, 0xf2,
+	0xf5, 0xf1, 0xfc, 0xc0, 0xd7, 0x1e, 0x0f, 0xcc, 0xa2, 0x1b, 0x34, 0xd8, 0xe7, 0x3b, 0xe7, 0xfb,
+	0xae, 0xcf, 0x39, 0xf7, 0x1c, 0x05, 0x8e, 0x54, 0xac, 0x22, 0xcb, 0xd4, 0x8c, 0xaa, 0xbb, 0xc9,
+	0xca, 0x7a, 0xd5, 0xda, 0xb4, 0x33, 0x0f, 0x36, 0xb5, 0xea, 0xb6, 0x62, 0x57, 0x2d, 0xd7, 0xa2,
+	0xc3, 0xde, 0x6b, 0xa5, 0xf9, 0xb5, 0x34, 0xcc, 0x2a, 0x86, 0x69, 0x65, 0xf8, 0xbf, 0xbe, 0x95,
+	0x94, 0x2e, 0x5a, 0x4e, 0xc5, 0x72, 0x32, 0x05, 0xe6, 0x68, 0x3e, 0x3c, 0x53, 0x9b, 0x2f, 0x68,
+	0x2e, 0x9b, 0xcf, 0xd8, 0x4c, 0x37, 0x4c, 0xe6, 0x1a, 0x96, 0x89, 0xb6, 0x23, 0xba, 0xa5, 0x5b,
+	0xfc, 0x67, 0xc6, 0xfb, 0x85, 0x4f, 0x0f, 0xeb, 0x96, 0xa5, 0x97, 0xb5, 0x0c, 0xb3, 0x8d, 0x0c,
+	0x33, 0x4d, 0xcb, 0xe5, 0x10, 0x07, 0xdf, 0xa6, 0xc2, 0x24, 0x8b, 0x56, 0xa5, 0x62, 0x99, 0xd1,
+	0xef, 0x6d, 0x56, 0x65, 0x95, 0x00, 0x2f, 0x10, 0xe9, 0x6e, 0xdb, 0x1a, 0xbe, 0x96, 0x47, 0x80,
+	0xbe, 0xeb, 0x91, 0x5e, 0xe5, 0x98, 0xbc, 0xf6, 0x60, 0x53, 0x73, 0x5c, 0x79, 0x0d, 0x0e, 0xb4,
+	0x3c, 0x75, 0x6c, 0xcb, 0x74, 0x34, 0xba, 0x00, 0x03, 0xbe, 0xef, 0x31, 0x72, 0x94, 0x9c, 0xd8,
+	0x9b, 0x3d, 0xa4, 0x84, 0x8e, 0x48, 0xf1, 0x21, 0x4b, 0x83, 0x4f, 0x7f, 0x9b, 0xe8, 0xf9, 0xf6,
+	0x8f, 0xef, 0xd3, 0x24, 0x8f, 0x18, 0xf9, 0x36, 0xa4, 0xb8, 0xd3, 0x5c, 0xd9, 0x2a, 0xb0, 0xf2,
+	0xba, 0x0f, 0xca, 0x79, 0x20, 0x0c, 0x4b, 0xdf, 0x80, 0x51, 0x9d, 0xbf, 0x54, 0xd1, 0xa5, 0xca,
+	0x7d, 0xaa, 0x46, 0x89, 0x07, 0x1c, 0xca, 0x8f, 0xe8, 0x21, 0xec, 0x4a, 0x49, 0x7e, 0x04, 0x13,
+	0x91, 0x8e, 0x91, 0xf9, 0x6d, 0x18, 0x11, 0x79, 0x46, 0x1d, 0x53, 0x02, 0x1d, 0x02, 0x67, 0x34,
+	0x1c, 0x5d, 0x36, 0xe1, 0x44, 0x44, 0xec, 0xa5, 0xed, 0xeb, 0xac, 0x62, 0x94, 0xb7, 0x57, 0xae,
+	0x05, 0xf2, 0x96, 0x20, 0x25, 0x94, 0xb7, 0xc1, 0xed, 0x1a, 0x2a, 0xa5, 0x70, 0x1c, 0x74, 0x55,
+	0x92, 0x3f, 0x22, 0x30, 0x9b, 0x20, 0x20, 0xca, 0x7e, 0x1f, 0xfe, 0x27, 0x8a, 0xe8, 0x7d, 0xbf,
+	0x5d, 0xc9, 0x75, 0x1f, 0x08, 0xf3, 0x71, 0xe4, 0xab, 0x70, 0x3c, 0x82, 0x87, 0xcf, 0x22, 0x10,
+	0x3d, 0x0e, 0x83, 0x2f, 0xeb, 0xdb, 0xb3, 0x11, 0xa8, 0x79, 0x4c, 0x60, 0xaa, 0x8d, 0x17, 0x54,
+	0x72, 0x0f, 0xc6, 0x63, 0xce, 0x0e, 0xbf, 0xe3, 0xc9, 0x44, 0x7a, 0xd0, 0xf3, 0x58, 0xd4, 0x29,
+	0xcb, 0x36, 0x4c, 0xc7, 0x91, 0x32, 0xb4, 0xa0, 0x4e, 0xe8, 0x75, 0x80, 0x46, 0x91, 0x23, 0x89,
+	0x69, 0xc5, 0xef, 0x08, 0x8a, 0xd7, 0x11, 0x14, 0xbf, 0xa1, 0x60, 0x47, 0x50, 0x56, 0x99, 0xae,
+	0x21, 0x36, 0xdf, 0x84, 0x94, 0x7f, 0x20, 0x30, 0xd3, 0x36, 0x24, 0x9e, 0xc4, 0x2d, 0xd8, 0xa7,
+	0xd7, 0x74, 0x5f, 0xb8, 0xa1, 0x05, 0x9f, 0xb2, 0x23, 0xe9, 0x7b, 0xf5, 0x9a, 0x1e, 0xf8, 0xa5,
+	0xb9, 0x16, 0x0d, 0xbd, 0x5c, 0xc3, 0x4c, 0x5b, 0x0d, 0x3e, 0x99, 0x16, 0x11, 0x55, 0x48, 0x2f,
+	0xd6, 0x98, 0x51, 0x66, 0x85, 0xb2, 0xd6, 0xfe, 0xe8, 0xae, 0xc1, 0x44, 0x7c, 0x31, 0xf8, 0xca,
+	0x86, 0xf2, 0xe3, 0xd1, 0xd5, 0xe0, 0xc8, 0x0e, 0x9c, 0x4c, 0x14, 0x13, 0xcf, 0xae, 0x3b, 0x41,
+	0x1f, 0x13, 0x38, 0xc8, 0xbf, 0xd6, 0xda, 0x43, 0x66, 0xaf, 0x98, 0x2b, 0xe6, 0x86, 0xd5, 0xc5,
+	0x12, 0x8f, 0xeb, 0x82, 0xbd, 0x31, 0x5d, 0xf0, 0x0e, 0x8c, 0x86, 0x48, 0xa1, 0xec, 0x2b, 0xb0,
+	0xcf, 0x79, 0xc8, 0x6c, 0xd5, 0x30, 0x55, 0xc3, 0xdc, 0xb0, 0x30, 0x51, 0x8f, 0x08, 0x52, 0xa6,
+	0x09, 0x0c, 0x4e, 0xfd, 0xb7, 0x9c, 0x85, 0x71, 0xdf, 0xf7, 0x6a, 0x6e, 0x3d, 0xb7, 0xe6, 0x5d,
+	0x50, 0x8e, 0x6b, 0x14, 0xeb, 0xdf, 0xf2, 0x00, 0xf4, 0x3b, 0x4d, 0x5d, 0xba, 0xcf, 0xf1, 0xf8,
+	0x68, 0x70, 0x58, 0x8c, 0x41, 0x52, 0xcb, 0x30, 0xe8, 0xe5, 0xb1, 0xe3, 0x32, 0x37, 0xb8, 0x4f,
+	0x4e, 0x88, 0x92, 0xb8, 0x19, 0x7c, 0xdb, 0x70, 0xef, 0x1a, 0xe6, 0xda, 0x6a, 0x7e, 0x8f, 0x5e,
+	0xd3, 0xbd, 0xc7, 0x8e, 0xfc, 0x36, 0xcc, 0x63, 0x98, 0x0e, 0x92, 0x4f, 0x48, 0xf8, 0x11, 0x64,
+	0x3b, 0xf1, 0xd4, 0xd5, 0x94, 0xfa, 0x94, 0xc0, 0x9c, 0x1f, 0xdc, 0x7e, 0xc7, 0x76, 0x8d, 0x0a,
+	0x2b, 0xb7, 0xeb, 0xab, 0x22, 0x09, 0xf4, 0x16, 0x0c, 0xdb, 0x46, 0xf1, 0xbe, 0x5a, 0xd3, 0x37,
+	0x54, 0xc7, 0xad, 0x32, 0x57, 0xd3, 0xb7, 0x79, 0xd2, 0xec, 0xcf, 0xca, 0xa2, 0xbb, 0xda, 0x28,
+	0xde, 0x5f, 0xcf, 0x5d, 0x5f, 0x43, 0xcb, 0xfc, 0x7f, 0x3c, 0xf0, 0xba, 0xbe, 0x11, 0x3c, 0x90,
+	0x77, 0x08, 0x28, 0x49, 0x69, 0xe1, 0x79, 0x74, 0xa3, 0x02, 0x8e, 0xc2, 0x5e, 0x6d, 0xcb, 0x2e,
+	0xb3, 0xa6, 0x9e, 0x34, 0x98, 0x6f, 0x7e, 0x44, 0x6f, 0x00, 0x14, 0x99, 0x59, 0x32, 0x4a, 0xcc,
+	0xd5, 0x9c, 0xb1, 0x5d, 0xd1, 0xb7, 0xd9, 0x7a, 0xce, 0xf7, 0x7a, 0x35, 0xb0, 0x5e, 0xea, 0xf3,
+	0x26, 0x93, 0x7c, 0x13, 0x5c, 0x9e, 0x0b, 0xca, 0xd9, 0x5e, 0xde, 0x32, 0xdc, 0xd5, 0x32, 0x33,
+	0x63, 0xf3, 0x24, 0x0f, 0xa3, 0x21, 0x73, 0x14, 0x7f, 0x0e, 0xfa, 0x3c, 0x8e, 0x71, 0x05, 0x56,
+	0x07, 0x21, 0x11, 0x0e, 0x90, 0x3f, 0x23, 0x70, 0xa8, 0xa9, 0x7a, 0x17, 0x37, 0x8b, 0x9e, 0xcc,
+	0x7f, 0x41, 0x57, 0x79, 0x0f, 0x24, 0x11, 0x2f, 0xd4, 0x7b, 0x11, 0x76, 0x33, 0xff, 0x11, 0x4a,
+	0x3e, 0x1a, 0xd9, 0x53, 0x02, 0x68, 0x00, 0x90, 0x4b, 0x22, 0xcf, 0x5d, 0xbf, 0x59, 0xbf, 0x26,
+	0x30, 0x2e, 0x0c, 0x53, 0x1f, 0x69, 0xf7, 0x20, 0xa1, 0xe0, 0x26, 0x6d, 0x2f, 0xa1, 0x8e, 0xe8,
+	0xde, 0xdd, 0xb9, 0x00, 0x47, 0x38, 0xcb, 0xbc, 0x56, 0x60, 0x65, 0x66, 0x16, 0xb5, 0xd5, 0xaa,
+	0x65, 0x5b, 0x0e, 0x2b, 0x37, 0x8d, 0x51, 0x15, 0xb6, 0xa5, 0x56, 0xac, 0x9a, 0xe6, 0x04, 0x63,
+	0x54, 0x85, 0x6d, 0xdd, 0xf4, 0xfe, 0x2f, 0xff, 0x4d, 0x20, 0x15, 0x05, 0x47, 0x9d, 0x57, 0x61,
+	0x77, 0x45, 0x73, 0xab, 0x46, 0x31, 0xe8, 0xb5, 0x93, 0x02, 0x99, 0x75, 0xf8, 0x4d, 0xdf, 0x14,
+	0x53, 0x34, 0x40, 0xd2, 0x05, 0xe8, 0xf7, 0x09, 0xf4, 0x46, 0x9e, 0x54, 0xc3, 0x85, 0x55, 0x0b,
+	0x6a, 0xcd, 0x07, 0xd1, 0x75, 0x18, 0xb6, 0xab, 0xd6, 0x3d, 0xad, 0xe8, 0x6a, 0x25, 0x35, 0x20,
+	0xb3, 0xab, 0x53, 0x32, 0xff, 0xad, 0xfb, 0xc0, 0xe7, 0xf2, 0x19, 0x2c, 0x9d, 0x86, 0xf8, 0xa6,
+	0x0a, 0x1e, 0x85, 0xdd, 0x5e, 0x81, 0x05, 0x35, 0xd2, 0x97, 0x1f, 0xf0, 0xfe, 0xcb, 0xab, 0x58,
+	0x12, 0xa1, 0xf0, 0xb8, 0xce, 0xb4, 0x14, 0x72, 0xac, 0x50, 0x8e, 0xf3, 0xab, 0xb8, 0x24, 0xf2,
+	0xd9, 0xf5, 0x94, 0x7e, 0x12, 0xa4, 0xf4, 0xcb, 0x61, 0x90, 0xfb, 0x59, 0xe8, 0xf7, 0xd8, 0xc4,
+	0xe5, 0x73, 0x2b, 0x79, 0xdf, 0xbc, 0x6b, 0xc9, 0x9c, 0x7d, 0x7e, 0x10, 0xfa, 0x39, 0x41, 0xfa,
+	0x08, 0x06, 0xfc, 0x7d, 0x90, 0x8a, 0x9a, 0x73, 0x78, 0xf1, 0x94, 0xa6, 0xdb, 0x99, 0xf9, 0xe1,
+	0xe4, 0x63, 0x1f, 0xfe, 0xfc, 0xfb, 0xe3, 0xde, 0x71, 0x7a, 0x28, 0x13, 0xb5, 0xfe, 0xd2, 0xef,
+	0x08, 0xd0, 0xf0, 0x6d, 0x45, 0xe7, 0xa3, 0x22, 0x44, 0xae, 0xa5, 0x52, 0xb6, 0x13, 0x08, 0x12,
+	0xcc, 0x70, 0x82, 0xb3, 0x74, 0x46, 0x40, 0x50, 0xd4, 0x87, 0xe9, 0x2f, 0x04, 0x0e, 0xc7, 0xed,
+	0x74, 0xf4, 0x52, 0x72, 0x16, 0xa1, 0xd5, 0x53, 0x5a, 0x78, 0x35, 0x30, 0x8a, 0x59, 0xe0, 0x62,
+	0xce, 0xd2, 0x33, 0x09, 0xc5, 0xa8, 0x85, 0xed, 0xc6, 0xdd, 0x44, 0x7f, 0x24, 0x30, 0x16, 0x35,
+	0x36, 0xd0, 0x73, 0xc9, 0x89, 0xb5, 0xcc, 0x3f, 0xd2, 0xf9, 0xce, 0x81, 0xa8, 0xe6, 0x2c, 0x57,
+	0x73, 0x9a, 0x2a, 0x49, 0xd5, 0xf8, 0x52, 0xe8, 0x4f, 0x04, 0xa4, 0xe8, 0x81, 0x90, 0x5e, 0xe8,
+	0x90, 0x50, 0x63, 0x1c, 0x95, 0x2e, 0xbe, 0x0a, 0x14, 0xd5, 0x9c, 0xe7, 0x6a, 0xb2, 0xf4, 0x74,
+	0x47, 0x6a, 0x3c, 0xc2, 0x7f, 0x12, 0x98, 0x4c, 0x30, 0xe9, 0xd2, 0xcb, 0x02, 0x76, 0xc9, 0x67,
+	0x6d, 0xe9, 0xcd, 0x57, 0x85, 0xa3, 0xc0, 0x25, 0x2e, 0x70, 0x81, 0x5e, 0x14, 0x08, 0x64, 0x81,
+	0x1f, 0x35, 0x5e, 0xea, 0xc7, 0x04, 0xa0, 0xb1, 0xda, 0xd0, 0xd9, 0xa8, 0xf3, 0x0e, 0x2d, 0x74,
+	0x52, 0x3a, 0x89, 0x29, 0x32, 0x9d, 0xe1, 0x4c, 0x8f, 0xd1, 0x09, 0x01, 0xd3, 0xe6, 0xfd, 0x8b,
+	0x3e, 0x21, 0x30, 0xd4, 0xb2, 0xd7, 0x50, 0x25, 0x32, 0x8c, 0x70, 0xe3, 0x92, 0x32, 0x89, 0xed,
+	0x91, 0xdb, 0x29, 0xce, 0x6d, 0x9a, 0x1e, 0x17, 0x71, 0xb3, 0xd5, 0x60, 0x13, 0x43, 0x3a, 0x1f,
+	0xf4, 0x42, 0xda, 0x77, 0x67, 0x27, 0xc9, 0x90, 0x6b, 0xd1, 0x6c, 0x3a, 0x48, 0x94, 0xe5, 0xd7,
+	0xf4, 0x82, 0x4a, 0x97, 0xb9, 0xd2, 0x2b, 0xf4, 0xb2, 0x58, 0x69, 0xd2, 0x94, 0xf9, 0x8b, 0xc0,
+	0x74, 0xb2, 0xd5, 0x87, 0xbe, 0x15, 0x49, 0x3c, 0xe1, 0x32, 0x27, 0x2d, 0xbe, 0x86, 0x07, 0x94,
+	0xbd, 0xc8, 0x65, 0x5f, 0xa2, 0x17, 0xc4, 0xb2, 0x2d, 0xdf, 0x8d, 0x1a, 0xd7, 0xe0, 0x78, 0x95,
+	0xd4, 0xf7, 0x93, 0x98, 0x2a, 0x79, 0x79, 0x4f, 0x92, 0xd2, 0x49, 0x4c, 0x93, 0x54, 0x89, 0xad,
+	0x6a, 0x5b, 0x86, 0xab, 0x7a, 0x03, 0x09, 0xfd, 0x82, 0xc0, 0x50, 0xcb, 0xe0, 0x4d, 0x4f, 0xc5,
+	0x17, 0x63, 0xeb, 0xd6, 0x24, 0xcd, 0x25, 0xb4, 0x46, 0x5e, 0x69, 0xce, 0xeb, 0x38, 0x95, 0x63,
+	0xaa, 0x17, 0x07, 0x7f, 0xfa, 0x15, 0x81, 0xfd, 0x2d, 0x5e, 0x1c, 0x9a, 0x2c, 0x5a, 0x3d, 0xd9,
+	0x95, 0xa4, 0xe6, 0xc8, 0xee, 0x24, 0x67, 0x37, 0x45, 0x27, 0xdb, 0xb3, 0x73, 0xe8, 0x37, 0x04,
+	0x86, 0x43, 0xab, 0x00, 0x3d, 0x1d, 0x15, 0x32, 0x6a, 0xe9, 0x90, 0xe6, 0x3b, 0x40, 0x20, 0xcf,
+	0x39, 0xce, 0x73, 0x86, 0x4e, 0x09, 0x78, 0x56, 0x03, 0x94, 0x6a, 0x07, 0x9c, 0x3e, 0x27, 0x30,
+	0xd4, 0x32, 0x8c, 0x46, 0x7f, 0x63, 0xd1, 0x78, 0x2f, 0xcd, 0x25, 0xb4, 0x46, 0x76, 0xb3, 0x9c,
+	0xdd, 0x24, 0x3d, 0x16, 0xcf, 0xce, 0xe3, 0xf1, 0x25, 0x81, 0xfd, 0x2d, 0x4e, 0x62, 0x3e, 0xb1,
+	0x70, 0xde, 0x97, 0x94, 0xa4, 0xe6, 0x09, 0x12, 0xb0, 0x95, 0x9c, 0xb3, 0x74, 0xe3, 0xe9, 0x8b,
+	0x14, 0x79, 0xf6, 0x22, 0x45, 0x9e, 0xbf, 0x48, 0x91, 0x4f, 0x76, 0x52, 0x3d, 0xcf, 0x76, 0x52,
+	0x3d, 0xbf, 0xee, 0xa4, 0x7a, 0xee, 0xcc, 0xeb, 0x86, 0x7b, 0x77, 0xb3, 0xa0, 0x14, 0xad, 0x0a,
+	0xf7, 0x53, 0xbc, 0xcb, 0x0c, 0x13, 0x3d, 0x66, 0x33, 0x5b, 0x82, 0xbf, 0x04, 0x15, 0x06, 0xf8,
+	0x9f, 0x82, 0xfe, 0xff, 0xcf, 0x00, 0x03, 0xe1, 0xac, 0xf1, 0x10, 0x1b, 0x00, 0x00,
 }
 
-func (m *QueryGlobalVirtualGroupFamilyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a global virtual group by its id.
+	GlobalVirtualGroup(ctx context.Context, in *QueryGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupResponse, error)
+	// Queries a list of global virtual groups by family id.
+	GlobalVirtualGroupByFamilyID(ctx context.Context, in *QueryGlobalVirtualGroupByFamilyIDRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupByFamilyIDResponse, error)
+	// Queries a global virtual group family by its id.
+	GlobalVirtualGroupFamily(ctx context.Context, in *QueryGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamilyResponse, error)
+	// Queries a list of GlobalVirtualGroupFamilies items.
+	GlobalVirtualGroupFamilies(ctx context.Context, in *QueryGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamiliesResponse, error)
+	// AvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies ID which are qualified to create bucket on
+	AvailableGlobalVirtualGroupFamilies(ctx context.Context, in *AvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*AvailableGlobalVirtualGroupFamiliesResponse, error)
+	// SwapInInfo gets reserved swapIn info for a specific global virtual group family or global virtual group
+	SwapInInfo(ctx context.Context, in *QuerySwapInInfoRequest, opts ...grpc.CallOption) (*QuerySwapInInfoResponse, error)
+	// GVGStatistics gets gvg statistics for a SP
+	GVGStatistics(ctx context.Context, in *QuerySPGVGStatisticsRequest, opts ...grpc.CallOption) (*QuerySPGVGStatisticsResponse, error)
+	// QuerySpAvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies IDs under a certain SP that are qualified to create a bucket on
+	QuerySpAvailableGlobalVirtualGroupFamilies(ctx context.Context, in *QuerySPAvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QuerySPAvailableGlobalVirtualGroupFamiliesResponse, error)
+	// QuerySpOptimalGlobalVirtualGroupFamily filters the optimal GlobalVirtualGroupFamily under a certain SP that is qualified to create a bucket on
+	QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context, in *QuerySpOptimalGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QuerySpOptimalGlobalVirtualGroupFamilyResponse, error)
+	// SpExitPlan dry runs the exit of a SP, listing its families, secondary GVGs, successor candidates, pending swap ins
+	// and the blockers of completing the exit
+	SpExitPlan(ctx context.Context, in *QuerySpExitPlanRequest, opts ...grpc.CallOption) (*QuerySpExitPlanResponse, error)
+	// SwapInAuction gets the swap in auction for a specific global virtual group family or global virtual group
+	SwapInAuction(ctx context.Context, in *QuerySwapInAuctionRequest, opts ...grpc.CallOption) (*QuerySwapInAuctionResponse, error)
+	// SwapInAuctions gets the swap in auctions of the forced exiting SPs
+	SwapInAuctions(ctx context.Context, in *QuerySwapInAuctionsRequest, opts ...grpc.CallOption) (*QuerySwapInAuctionsResponse, error)
+	// RebalanceProposal computes the imbalance of the secondary sp load and the moves which even it out
+	RebalanceProposal(ctx context.Context, in *QueryRebalanceProposalRequest, opts ...grpc.CallOption) (*QueryRebalanceProposalResponse, error)
+	// RebalancePlan gets a submitted rebalance plan and the progress of its moves
+	RebalancePlan(ctx context.Context, in *QueryRebalancePlanRequest, opts ...grpc.CallOption) (*QueryRebalancePlanResponse, error)
+	// RebalancePlans gets the submitted rebalance plans
+	RebalancePlans(ctx context.Context, in *QueryRebalancePlansRequest, opts ...grpc.CallOption) (*QueryRebalancePlansResponse, error)
 }
 
-func (m *QueryGlobalVirtualGroupFamilyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamily != nil {
-		{
-			size, err := m.GlobalVirtualGroupFamily.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGlobalVirtualGroupFamiliesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GlobalVirtualGroup(ctx context.Context, in *QueryGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupResponse, error) {
+	out := new(QueryGlobalVirtualGroupResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/GlobalVirtualGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GlobalVirtualGroupByFamilyID(ctx context.Context, in *QueryGlobalVirtualGroupByFamilyIDRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupByFamilyIDResponse, error) {
+	out := new(QueryGlobalVirtualGroupByFamilyIDResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/GlobalVirtualGroupByFamilyID", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GlobalVirtualGroupFamily(ctx context.Context, in *QueryGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamilyResponse, error) {
+	out := new(QueryGlobalVirtualGroupFamilyResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/GlobalVirtualGroupFamily", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GvgFamilies) > 0 {
-		for iNdEx := len(m.GvgFamilies) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GvgFamilies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GlobalVirtualGroupFamilies(ctx context.Context, in *QueryGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamiliesResponse, error) {
+	out := new(QueryGlobalVirtualGroupFamiliesResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/GlobalVirtualGroupFamilies", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AvailableGlobalVirtualGroupFamilies(ctx context.Context, in *AvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*AvailableGlobalVirtualGroupFamiliesResponse, error) {
+	out := new(AvailableGlobalVirtualGroupFamiliesResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/AvailableGlobalVirtualGroupFamilies", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SwapInInfo(ctx context.Context, in *QuerySwapInInfoRequest, opts ...grpc.CallOption) (*QuerySwapInInfoResponse, error) {
+	out := new(QuerySwapInInfoResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/SwapInInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		dAtA7 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
-		var j6 int
-		for _, num := range m.GlobalVirtualGroupFamilyIds {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
-		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintQuery(dAtA, i, uint64(j6))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GVGStatistics(ctx context.Context, in *QuerySPGVGStatisticsRequest, opts ...grpc.CallOption) (*QuerySPGVGStatisticsResponse, error) {
+	out := new(QuerySPGVGStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/GVGStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuerySpAvailableGlobalVirtualGroupFamilies(ctx context.Context, in *QuerySPAvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QuerySPAvailableGlobalVirtualGroupFamiliesResponse, error) {
+	out := new(QuerySPAvailableGlobalVirtualGroupFamiliesResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/QuerySpAvailableGlobalVirtualGroupFamilies", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AvailableGlobalVirtualGroupFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		dAtA9 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
-		var j8 int
-		for _, num := range m.GlobalVirtualGroupFamilyIds {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintQuery(dAtA, i, uint64(j8))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context, in *QuerySpOptimalGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QuerySpOptimalGlobalVirtualGroupFamilyResponse, error) {
+	out := new(QuerySpOptimalGlobalVirtualGroupFamilyResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/QuerySpOptimalGlobalVirtualGroupFamily", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySwapInInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SpExitPlan(ctx context.Context, in *QuerySpExitPlanRequest, opts ...grpc.CallOption) (*QuerySpExitPlanResponse, error) {
+	out := new(QuerySpExitPlanResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/SpExitPlan", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySwapInInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QuerySwapInInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) SwapInAuction(ctx context.Context, in *QuerySwapInAuctionRequest, opts ...grpc.CallOption) (*QuerySwapInAuctionResponse, error) {
+	out := new(QuerySwapInAuctionResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/SwapInAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySwapInInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SwapInAuctions(ctx context.Context, in *QuerySwapInAuctionsRequest, opts ...grpc.CallOption) (*QuerySwapInAuctionsResponse, error) {
+	out := new(QuerySwapInAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/SwapInAuctions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySwapInInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RebalanceProposal(ctx context.Context, in *QueryRebalanceProposalRequest, opts ...grpc.CallOption) (*QueryRebalanceProposalResponse, error) {
+	out := new(QueryRebalanceProposalResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/RebalanceProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySwapInInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SwapInInfo != nil {
-		{
-			size, err := m.SwapInInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) RebalancePlan(ctx context.Context, in *QueryRebalancePlanRequest, opts ...grpc.CallOption) (*QueryRebalancePlanResponse, error) {
+	out := new(QueryRebalancePlanResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/RebalancePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySPGVGStatisticsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RebalancePlans(ctx context.Context, in *QueryRebalancePlansRequest, opts ...grpc.CallOption) (*QueryRebalancePlansResponse, error) {
+	out := new(QueryRebalancePlansResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Query/RebalancePlans", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySPGVGStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a global virtual group by its id.
+	GlobalVirtualGroup(context.Context, *QueryGlobalVirtualGroupRequest) (*QueryGlobalVirtualGroupResponse, error)
+	// Queries a list of global virtual groups by family id.
+	GlobalVirtualGroupByFamilyID(context.Context, *QueryGlobalVirtualGroupByFamilyIDRequest) (*QueryGlobalVirtualGroupByFamilyIDResponse, error)
+	// Queries a global virtual group family by its id.
+	GlobalVirtualGroupFamily(context.Context, *QueryGlobalVirtualGroupFamilyRequest) (*QueryGlobalVirtualGroupFamilyResponse, error)
+	// Queries a list of GlobalVirtualGroupFamilies items.
+	GlobalVirtualGroupFamilies(context.Context, *QueryGlobalVirtualGroupFamiliesRequest) (*QueryGlobalVirtualGroupFamiliesResponse, error)
+	// AvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies ID which are qualified to create bucket on
+	AvailableGlobalVirtualGroupFamilies(context.Context, *AvailableGlobalVirtualGroupFamiliesRequest) (*AvailableGlobalVirtualGroupFamiliesResponse, error)
+	// SwapInInfo gets reserved swapIn info for a specific global virtual group family or global virtual group
+	SwapInInfo(context.Context, *QuerySwapInInfoRequest) (*QuerySwapInInfoResponse, error)
+	// GVGStatistics gets gvg statistics for a SP
+	GVGStatistics(context.Context, *QuerySPGVGStatisticsRequest) (*QuerySPGVGStatisticsResponse, error)
+	// QuerySpAvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies IDs under a certain SP that are qualified to create a bucket on
+	QuerySpAvailableGlobalVirtualGroupFamilies(context.Context, *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) (*QuerySPAvailableGlobalVirtualGroupFamiliesResponse, error)
+	// QuerySpOptimalGlobalVirtualGroupFamily filters the optimal GlobalVirtualGroupFamily under a certain SP that is qualified to create a bucket on
+	QuerySpOptimalGlobalVirtualGroupFamily(context.Context, *QuerySpOptimalGlobalVirtualGroupFamilyRequest) (*QuerySpOptimalGlobalVirtualGroupFamilyResponse, error)
+	// SpExitPlan dry runs the exit of a SP, listing its families, secondary GVGs, successor candidates, pending swap ins
+	// and the blockers of completing the exit
+	SpExitPlan(context.Context, *QuerySpExitPlanRequest) (*QuerySpExitPlanResponse, error)
+	// SwapInAuction gets the swap in auction for a specific global virtual group family or global virtual group
+	SwapInAuction(context.Context, *QuerySwapInAuctionRequest) (*QuerySwapInAuctionResponse, error)
+	// SwapInAuctions gets the swap in auctions of the forced exiting SPs
+	SwapInAuctions(context.Context, *QuerySwapInAuctionsRequest) (*QuerySwapInAuctionsResponse, error)
+	// RebalanceProposal computes the imbalance of the secondary sp load and the moves which even it out
+	RebalanceProposal(context.Context, *QueryRebalanceProposalRequest) (*QueryRebalanceProposalResponse, error)
+	// RebalancePlan gets a submitted rebalance plan and the progress of its moves
+	RebalancePlan(context.Context, *QueryRebalancePlanRequest) (*QueryRebalancePlanResponse, error)
+	// RebalancePlans gets the submitted rebalance plans
+	RebalancePlans(context.Context, *QueryRebalancePlansRequest) (*QueryRebalancePlansResponse, error)
 }
 
-func (m *QuerySPGVGStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SpId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QuerySPGVGStatisticsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GlobalVirtualGroup(ctx context.Context, req *QueryGlobalVirtualGroupRequest) (*QueryGlobalVirtualGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroup not implemented")
+}
+func (*UnimplementedQueryServer) GlobalVirtualGroupByFamilyID(ctx context.Context, req *QueryGlobalVirtualGroupByFamilyIDRequest) (*QueryGlobalVirtualGroupByFamilyIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupByFamilyID not implemented")
+}
+func (*UnimplementedQueryServer) GlobalVirtualGroupFamily(ctx context.Context, req *QueryGlobalVirtualGroupFamilyRequest) (*QueryGlobalVirtualGroupFamilyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupFamily not implemented")
+}
+func (*UnimplementedQueryServer) GlobalVirtualGroupFamilies(ctx context.Context, req *QueryGlobalVirtualGroupFamiliesRequest) (*QueryGlobalVirtualGroupFamiliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupFamilies not implemented")
+}
+func (*UnimplementedQueryServer) AvailableGlobalVirtualGroupFamilies(ctx context.Context, req *AvailableGlobalVirtualGroupFamiliesRequest) (*AvailableGlobalVirtualGroupFamiliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvailableGlobalVirtualGroupFamilies not implemented")
+}
+func (*UnimplementedQueryServer) SwapInInfo(ctx context.Context, req *QuerySwapInInfoRequest) (*QuerySwapInInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapInInfo not implemented")
+}
+func (*UnimplementedQueryServer) GVGStatistics(ctx context.Context, req *QuerySPGVGStatisticsRequest) (*QuerySPGVGStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GVGStatistics not implemented")
+}
+func (*UnimplementedQueryServer) QuerySpAvailableGlobalVirtualGroupFamilies(ctx context.Context, req *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) (*QuerySPAvailableGlobalVirtualGroupFamiliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySpAvailableGlobalVirtualGroupFamilies not implemented")
+}
+func (*UnimplementedQueryServer) QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context, req *QuerySpOptimalGlobalVirtualGroupFamilyRequest) (*QuerySpOptimalGlobalVirtualGroupFamilyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySpOptimalGlobalVirtualGroupFamily not implemented")
+}
+func (*UnimplementedQueryServer) SpExitPlan(ctx context.Context, req *QuerySpExitPlanRequest) (*QuerySpExitPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpExitPlan not implemented")
+}
+func (*UnimplementedQueryServer) SwapInAuction(ctx context.Context, req *QuerySwapInAuctionRequest) (*QuerySwapInAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapInAuction not implemented")
+}
+func (*UnimplementedQueryServer) SwapInAuctions(ctx context.Context, req *QuerySwapInAuctionsRequest) (*QuerySwapInAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapInAuctions not implemented")
+}
+func (*UnimplementedQueryServer) RebalanceProposal(ctx context.Context, req *QueryRebalanceProposalRequest) (*QueryRebalanceProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceProposal not implemented")
+}
+func (*UnimplementedQueryServer) RebalancePlan(ctx context.Context, req *QueryRebalancePlanRequest) (*QueryRebalancePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalancePlan not implemented")
+}
+func (*UnimplementedQueryServer) RebalancePlans(ctx context.Context, req *QueryRebalancePlansRequest) (*QueryRebalancePlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalancePlans not implemented")
 }
 
-func (m *QuerySPGVGStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QuerySPGVGStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GvgStats != nil {
-		{
-			size, err := m.GvgStats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GlobalVirtualGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalVirtualGroupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalVirtualGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/GlobalVirtualGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalVirtualGroup(ctx, req.(*QueryGlobalVirtualGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GlobalVirtualGroupByFamilyID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalVirtualGroupByFamilyIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalVirtualGroupByFamilyID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/GlobalVirtualGroupByFamilyID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalVirtualGroupByFamilyID(ctx, req.(*QueryGlobalVirtualGroupByFamilyIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SpId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x8
+func _Query_GlobalVirtualGroupFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalVirtualGroupFamilyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalVirtualGroupFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/GlobalVirtualGroupFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalVirtualGroupFamily(ctx, req.(*QueryGlobalVirtualGroupFamilyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySPAvailableGlobalVirtualGroupFamiliesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GlobalVirtualGroupFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalVirtualGroupFamiliesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySPAvailableGlobalVirtualGroupFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySPAvailableGlobalVirtualGroupFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		dAtA13 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
-		var j12 int
-		for _, num := range m.GlobalVirtualGroupFamilyIds {
-			for num >= 1<<7 {
-				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j12++
-			}
-			dAtA13[j12] = uint8(num)
-			j12++
-		}
-		i -= j12
-		copy(dAtA[i:], dAtA13[:j12])
-		i = encodeVarintQuery(dAtA, i, uint64(j12))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalVirtualGroupFamilies(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/GlobalVirtualGroupFamilies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalVirtualGroupFamilies(ctx, req.(*QueryGlobalVirtualGroupFamiliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AvailableGlobalVirtualGroupFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvailableGlobalVirtualGroupFamiliesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PickVgfStrategy != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PickVgfStrategy))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).AvailableGlobalVirtualGroupFamilies(ctx, in)
 	}
-	if m.SpId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/AvailableGlobalVirtualGroupFamilies",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AvailableGlobalVirtualGroupFamilies(ctx, req.(*AvailableGlobalVirtualGroupFamiliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SwapInInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapInInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).SwapInInfo(ctx, in)
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/SwapInInfo",
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapInInfo(ctx, req.(*QuerySwapInInfoRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GVGStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySPGVGStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGlobalVirtualGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).GVGStatistics(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/GVGStatistics",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GVGStatistics(ctx, req.(*QuerySPGVGStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGlobalVirtualGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QuerySpAvailableGlobalVirtualGroupFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySPAvailableGlobalVirtualGroupFamiliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroup != nil {
-		l = m.GlobalVirtualGroup.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySpAvailableGlobalVirtualGroupFamilies(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/QuerySpAvailableGlobalVirtualGroupFamilies",
 	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySpAvailableGlobalVirtualGroupFamilies(ctx, req.(*QuerySPAvailableGlobalVirtualGroupFamiliesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QuerySpOptimalGlobalVirtualGroupFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpOptimalGlobalVirtualGroupFamilyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroups) > 0 {
-		for _, e := range m.GlobalVirtualGroups {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySpOptimalGlobalVirtualGroupFamily(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/QuerySpOptimalGlobalVirtualGroupFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySpOptimalGlobalVirtualGroupFamily(ctx, req.(*QuerySpOptimalGlobalVirtualGroupFamilyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGlobalVirtualGroupFamilyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SpExitPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpExitPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.FamilyId != 0 {
-		n += 1 + sovQuery(uint64(m.FamilyId))
+	if interceptor == nil {
+		return srv.(QueryServer).SpExitPlan(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGlobalVirtualGroupFamilyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/SpExitPlan",
 	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamily != nil {
-		l = m.GlobalVirtualGroupFamily.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpExitPlan(ctx, req.(*QuerySpExitPlanRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SwapInAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapInAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).SwapInAuction(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/SwapInAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapInAuction(ctx, req.(*QuerySwapInAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SwapInAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapInAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.GvgFamilies) > 0 {
-		for _, e := range m.GvgFamilies {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapInAuctions(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/SwapInAuctions",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapInAuctions(ctx, req.(*QuerySwapInAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RebalanceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRebalanceProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupFamilyIds {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(QueryServer).RebalanceProposal(ctx, in)
 	}
-	return n
-}
-
-func (m *AvailableGlobalVirtualGroupFamiliesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/RebalanceProposal",
 	}
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupFamilyIds {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RebalanceProposal(ctx, req.(*QueryRebalanceProposalRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapInInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RebalancePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRebalancePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
+	if interceptor == nil {
+		return srv.(QueryServer).RebalancePlan(ctx, in)
 	}
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/RebalancePlan",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RebalancePlan(ctx, req.(*QueryRebalancePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapInInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RebalancePlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRebalancePlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SwapInInfo != nil {
-		l = m.SwapInInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).RebalancePlans(ctx, in)
 	}
-	return n
-}
-
-func (m *QuerySPGVGStatisticsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Query/RebalancePlans",
 	}
-	var l int
-	_ = l
-	if m.SpId != 0 {
-		n += 1 + sovQuery(uint64(m.SpId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RebalancePlans(ctx, req.(*QueryRebalancePlansRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySPGVGStatisticsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GvgStats != nil {
-		l = m.GvgStats.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "moca.virtualgroup.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GlobalVirtualGroup",
+			Handler:    _Query_GlobalVirtualGroup_Handler,
+		},
+		{
+			MethodName: "GlobalVirtualGroupByFamilyID",
+			Handler:    _Query_GlobalVirtualGroupByFamilyID_Handler,
+		},
+		{
+			MethodName: "GlobalVirtualGroupFamily",
+			Handler:    _Query_GlobalVirtualGroupFamily_Handler,
+		},
+		{
+			MethodName: "GlobalVirtualGroupFamilies",
+			Handler:    _Query_GlobalVirtualGroupFamilies_Handler,
+		},
+		{
+			MethodName: "AvailableGlobalVirtualGroupFamilies",
+			Handler:    _Query_AvailableGlobalVirtualGroupFamilies_Handler,
+		},
+		{
+			MethodName: "SwapInInfo",
+			Handler:    _Query_SwapInInfo_Handler,
+		},
+		{
+			MethodName: "GVGStatistics",
+			Handler:    _Query_GVGStatistics_Handler,
+		},
+		{
+			MethodName: "QuerySpAvailableGlobalVirtualGroupFamilies",
+			Handler:    _Query_QuerySpAvailableGlobalVirtualGroupFamilies_Handler,
+		},
+		{
+			MethodName: "QuerySpOptimalGlobalVirtualGroupFamily",
+			Handler:    _Query_QuerySpOptimalGlobalVirtualGroupFamily_Handler,
+		},
+		{
+			MethodName: "SpExitPlan",
+			Handler:    _Query_SpExitPlan_Handler,
+		},
+		{
+			MethodName: "SwapInAuction",
+			Handler:    _Query_SwapInAuction_Handler,
+		},
+		{
+			MethodName: "SwapInAuctions",
+			Handler:    _Query_SwapInAuctions_Handler,
+		},
+		{
+			MethodName: "RebalanceProposal",
+			Handler:    _Query_RebalanceProposal_Handler,
+		},
+		{
+			MethodName: "RebalancePlan",
+			Handler:    _Query_RebalancePlan_Handler,
+		},
+		{
+			MethodName: "RebalancePlans",
+			Handler:    _Query_RebalancePlans_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "moca/virtualgroup/query.proto",
 }
 
-func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SpId != 0 {
-		n += 1 + sovQuery(uint64(m.SpId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySPAvailableGlobalVirtualGroupFamiliesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupFamilyIds {
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroup != nil {
+		{
+			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroups) > 0 {
+		for iNdEx := len(m.GlobalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupFamilyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupFamilyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupFamilyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FamilyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupFamilyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupFamilyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupFamilyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamily != nil {
+		{
+			size, err := m.GlobalVirtualGroupFamily.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GvgFamilies) > 0 {
+		for iNdEx := len(m.GvgFamilies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GvgFamilies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		dAtA7 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
+		var j6 int
+		for _, num := range m.GlobalVirtualGroupFamilyIds {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		dAtA9 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
+		var j8 int
+		for _, num := range m.GlobalVirtualGroupFamilyIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapInInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapInInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapInInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapInInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapInInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapInInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapInInfo != nil {
+		{
+			size, err := m.SwapInInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySPGVGStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySPGVGStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySPGVGStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySPGVGStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySPGVGStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySPGVGStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GvgStats != nil {
+		{
+			size, err := m.GvgStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySPAvailableGlobalVirtualGroupFamiliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySPAvailableGlobalVirtualGroupFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySPAvailableGlobalVirtualGroupFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		dAtA13 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
+		var j12 int
+		for _, num := range m.GlobalVirtualGroupFamilyIds {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintQuery(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PickVgfStrategy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PickVgfStrategy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Explanation) > 0 {
+		i -= len(m.Explanation)
+		copy(dAtA[i:], m.Explanation)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Explanation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpExitPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpExitPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpExitPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpExitPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpExitPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpExitPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapInAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapInAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapInAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapInAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapInAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapInAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Auction != nil {
+		{
+			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapInAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapInAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapInAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapInAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapInAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapInAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalanceProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalanceProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalanceProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMoves != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxMoves))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalanceProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalanceProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalanceProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProjectedMetrics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Moves) > 0 {
+		for iNdEx := len(m.Moves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Moves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalancePlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalancePlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancePlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalancePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalancePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Plan != nil {
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalancePlansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalancePlansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancePlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalancePlansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalancePlansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancePlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroup != nil {
+		l = m.GlobalVirtualGroup.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroups) > 0 {
+		for _, e := range m.GlobalVirtualGroups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupFamilyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FamilyId != 0 {
+		n += 1 + sovQuery(uint64(m.FamilyId))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupFamilyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamily != nil {
+		l = m.GlobalVirtualGroupFamily.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GvgFamilies) > 0 {
+		for _, e := range m.GvgFamilies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupFamilyIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupFamilyIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QuerySwapInInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *QuerySwapInInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SwapInInfo != nil {
+		l = m.SwapInInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySPGVGStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	return n
+}
+
+func (m *QuerySPGVGStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GvgStats != nil {
+		l = m.GvgStats.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	return n
+}
+
+func (m *QuerySPAvailableGlobalVirtualGroupFamiliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupFamilyIds {
 			l += sovQuery(uint64(e))
 		}
-		n += 1 + sovQuery(uint64(l)) + l
-	}
-	return n
-}
-
-func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SpId != 0 {
-		n += 1 + sovQuery(uint64(m.SpId))
-	}
-	if m.PickVgfStrategy != 0 {
-		n += 1 + sovQuery(uint64(m.PickVgfStrategy))
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	if m.PickVgfStrategy != 0 {
+		n += 1 + sovQuery(uint64(m.PickVgfStrategy))
+	}
+	return n
+}
+
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	l = len(m.Explanation)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySpExitPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	return n
+}
+
+func (m *QuerySpExitPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Plan.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapInAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *QuerySwapInAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Auction != nil {
+		l = m.Auction.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapInAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapInAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRebalanceProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMoves != 0 {
+		n += 1 + sovQuery(uint64(m.MaxMoves))
+	}
+	return n
+}
+
+func (m *QueryRebalanceProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metrics.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.ProjectedMetrics.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRebalancePlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovQuery(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *QueryRebalancePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRebalancePlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRebalancePlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalVirtualGroup == nil {
+				m.GlobalVirtualGroup = &GlobalVirtualGroup{}
+			}
+			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalVirtualGroups = append(m.GlobalVirtualGroups, &GlobalVirtualGroup{})
+			if err := m.GlobalVirtualGroups[len(m.GlobalVirtualGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
+			}
+			m.FamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamily", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalVirtualGroupFamily == nil {
+				m.GlobalVirtualGroupFamily = &GlobalVirtualGroupFamily{}
+			}
+			if err := m.GlobalVirtualGroupFamily.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GvgFamilies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GvgFamilies = append(m.GvgFamilies, &GlobalVirtualGroupFamily{})
+			if err := m.GvgFamilies[len(m.GvgFamilies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
+					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
+					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapInInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapInInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapInInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapInInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapInInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapInInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapInInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapInInfo == nil {
+				m.SwapInInfo = &SwapInInfo{}
+			}
+			if err := m.SwapInInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySPGVGStatisticsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2471,12 +5207,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySPGVGStatisticsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySPGVGStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2498,7 +5253,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySPGVGStatisticsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2521,15 +5276,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySPGVGStatisticsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySPGVGStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GvgStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2556,7 +5311,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GvgStats == nil {
+				m.GvgStats = &GVGStatisticsWithinSP{}
+			}
+			if err := m.GvgStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2581,7 +5339,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2604,17 +5362,17 @@ func (m *QueryGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySPAvailableGlobalVirtualGroupFamiliesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySPAvailableGlobalVirtualGroupFamiliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.GlobalVirtualGroupId = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2624,7 +5382,7 @@ func (m *QueryGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2650,7 +5408,7 @@ func (m *QueryGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySPAvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2673,17 +5431,143 @@ func (m *QueryGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySPAvailableGlobalVirtualGroupFamiliesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySPAvailableGlobalVirtualGroupFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
+					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
 			}
-			var msglen int
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpOptimalGlobalVirtualGroupFamilyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpOptimalGlobalVirtualGroupFamilyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2693,28 +5577,30 @@ func (m *QueryGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GlobalVirtualGroup == nil {
-				m.GlobalVirtualGroup = &GlobalVirtualGroup{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PickVgfStrategy", wireType)
 			}
-			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PickVgfStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PickVgfStrategy |= PickVGFStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2736,7 +5622,7 @@ func (m *QueryGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2759,10 +5645,10 @@ func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpOptimalGlobalVirtualGroupFamilyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpOptimalGlobalVirtualGroupFamilyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2784,59 +5670,41 @@ func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Unmarshal(dAtA []byte) error
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explanation", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Explanation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2863,8 +5731,8 @@ func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalVirtualGroups = append(m.GlobalVirtualGroups, &GlobalVirtualGroup{})
-			if err := m.GlobalVirtualGroups[len(m.GlobalVirtualGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candidates = append(m.Candidates, GVGFamilyCandidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2889,7 +5757,7 @@ func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpExitPlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2912,17 +5780,17 @@ func (m *QueryGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpExitPlanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpExitPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.FamilyId = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2932,7 +5800,7 @@ func (m *QueryGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FamilyId |= uint32(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2958,7 +5826,7 @@ func (m *QueryGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpExitPlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2981,15 +5849,15 @@ func (m *QueryGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpExitPlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpExitPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamily", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3016,10 +5884,7 @@ func (m *QueryGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GlobalVirtualGroupFamily == nil {
-				m.GlobalVirtualGroupFamily = &GlobalVirtualGroupFamily{}
-			}
-			if err := m.GlobalVirtualGroupFamily.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3044,7 +5909,7 @@ func (m *QueryGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapInAuctionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3067,17 +5932,17 @@ func (m *QueryGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapInAuctionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapInAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			var msglen int
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3087,28 +5952,30 @@ func (m *QueryGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3130,7 +5997,7 @@ func (m *QueryGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapInAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3153,49 +6020,15 @@ func (m *QueryGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapInAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapInAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GvgFamilies", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GvgFamilies = append(m.GvgFamilies, &GlobalVirtualGroupFamily{})
-			if err := m.GvgFamilies[len(m.GvgFamilies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3222,10 +6055,10 @@ func (m *QueryGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Auction == nil {
+				m.Auction = &SwapInAuction{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3250,7 +6083,7 @@ func (m *QueryGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapInAuctionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3273,88 +6106,48 @@ func (m *AvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapInAuctionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapInAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
-					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3376,7 +6169,7 @@ func (m *AvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *AvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapInAuctionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3399,88 +6192,82 @@ func (m *AvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapInAuctionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapInAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &SwapInAuction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
-					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3502,7 +6289,7 @@ func (m *AvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QuerySwapInInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRebalanceProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3525,36 +6312,17 @@ func (m *QuerySwapInInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapInInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebalanceProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapInInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebalanceProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
-			}
-			m.GlobalVirtualGroupFamilyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMoves", wireType)
 			}
-			m.GlobalVirtualGroupId = 0
+			m.MaxMoves = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3564,7 +6332,7 @@ func (m *QuerySwapInInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				m.MaxMoves |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3590,7 +6358,7 @@ func (m *QuerySwapInInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapInInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRebalanceProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3613,15 +6381,15 @@ func (m *QuerySwapInInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapInInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebalanceProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapInInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebalanceProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapInInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3648,10 +6416,74 @@ func (m *QuerySwapInInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SwapInInfo == nil {
-				m.SwapInInfo = &SwapInInfo{}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.SwapInInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, RebalanceMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3676,7 +6508,7 @@ func (m *QuerySwapInInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySPGVGStatisticsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRebalancePlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3699,17 +6531,17 @@ func (m *QuerySPGVGStatisticsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySPGVGStatisticsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebalancePlanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySPGVGStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebalancePlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			m.SpId = 0
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3719,7 +6551,7 @@ func (m *QuerySPGVGStatisticsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpId |= uint32(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3745,7 +6577,7 @@ func (m *QuerySPGVGStatisticsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySPGVGStatisticsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRebalancePlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3768,15 +6600,15 @@ func (m *QuerySPGVGStatisticsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySPGVGStatisticsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebalancePlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySPGVGStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebalancePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GvgStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3803,10 +6635,10 @@ func (m *QuerySPGVGStatisticsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GvgStats == nil {
-				m.GvgStats = &GVGStatisticsWithinSP{}
+			if m.Plan == nil {
+				m.Plan = &RebalancePlan{}
 			}
-			if err := m.GvgStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3831,7 +6663,7 @@ func (m *QuerySPGVGStatisticsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRebalancePlansRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3854,17 +6686,17 @@ func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySPAvailableGlobalVirtualGroupFamiliesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebalancePlansRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySPAvailableGlobalVirtualGroupFamiliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebalancePlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.SpId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3874,137 +6706,28 @@ func (m *QuerySPAvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byt
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySPAvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySPAvailableGlobalVirtualGroupFamiliesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySPAvailableGlobalVirtualGroupFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
-					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4026,7 +6749,7 @@ func (m *QuerySPAvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []by
 	}
 	return nil
 }
-func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRebalancePlansResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4049,17 +6772,17 @@ func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpOptimalGlobalVirtualGroupFamilyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebalancePlansResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpOptimalGlobalVirtualGroupFamilyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebalancePlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
 			}
-			m.SpId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4069,16 +6792,31 @@ func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, &RebalancePlan{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PickVgfStrategy", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.PickVgfStrategy = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4088,80 +6826,28 @@ func (m *QuerySpOptimalGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PickVgfStrategy |= PickVGFStrategy(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySpOptimalGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpOptimalGlobalVirtualGroupFamilyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpOptimalGlobalVirtualGroupFamilyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			m.GlobalVirtualGroupFamilyId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
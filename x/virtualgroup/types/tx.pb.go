@@ -1441,6 +1441,225 @@ func (m *MsgCancelSwapInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelSwapInResponse proto.InternalMessageInfo
 
+type MsgBidSwapIn struct {
+	// storage_provider defines the operator account address of the storage provider who bids for the swap in.
+	StorageProvider string `protobuf:"bytes,1,opt,name=storage_provider,json=storageProvider,proto3" json:"storage_provider,omitempty"`
+	// target_sp_id defines the id of the forced exiting storage provider to be replaced by the successor sp.
+	TargetSpId uint32 `protobuf:"varint,2,opt,name=target_sp_id,json=targetSpId,proto3" json:"target_sp_id,omitempty"`
+	// virtual_group_family_id is the identifier of the virtual group family.
+	// if it set to non-zero, it represents that the operator bids to swap in as the primary storage provider
+	// it it set to zero, it represents that the operator bids to swap in as the secondary storage provider.
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,3,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// global_virtual_group_id is a global virtual group ID associated with the swap in.
+	// It allows to be empty only when the operator bids to be the successor primary storage provider in a family.
+	GlobalVirtualGroupId uint32 `protobuf:"varint,4,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// capacity defines the free store size in bytes the storage provider commits to the swap in.
+	Capacity uint64 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (m *MsgBidSwapIn) Reset()         { *m = MsgBidSwapIn{} }
+func (m *MsgBidSwapIn) String() string { return proto.CompactTextString(m) }
+func (*MsgBidSwapIn) ProtoMessage()    {}
+func (*MsgBidSwapIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74455bb8cd100476, []int{28}
+}
+func (m *MsgBidSwapIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBidSwapIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBidSwapIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBidSwapIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBidSwapIn.Merge(m, src)
+}
+func (m *MsgBidSwapIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBidSwapIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBidSwapIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBidSwapIn proto.InternalMessageInfo
+
+func (m *MsgBidSwapIn) GetStorageProvider() string {
+	if m != nil {
+		return m.StorageProvider
+	}
+	return ""
+}
+
+func (m *MsgBidSwapIn) GetTargetSpId() uint32 {
+	if m != nil {
+		return m.TargetSpId
+	}
+	return 0
+}
+
+func (m *MsgBidSwapIn) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
+	}
+	return 0
+}
+
+func (m *MsgBidSwapIn) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
+}
+
+func (m *MsgBidSwapIn) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+type MsgBidSwapInResponse struct {
+}
+
+func (m *MsgBidSwapInResponse) Reset()         { *m = MsgBidSwapInResponse{} }
+func (m *MsgBidSwapInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBidSwapInResponse) ProtoMessage()    {}
+func (*MsgBidSwapInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74455bb8cd100476, []int{29}
+}
+func (m *MsgBidSwapInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBidSwapInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBidSwapInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBidSwapInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBidSwapInResponse.Merge(m, src)
+}
+func (m *MsgBidSwapInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBidSwapInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBidSwapInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBidSwapInResponse proto.InternalMessageInfo
+
+type MsgSubmitRebalancePlan struct {
+	// submitter defines the operator account address of the storage provider moved out by every move, or the authority
+	// which can submit any moves.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// moves defines the global virtual groups to move, the stored size and the status of the moves are ignored.
+	Moves []RebalanceMove `protobuf:"bytes,2,rep,name=moves,proto3" json:"moves"`
+}
+
+func (m *MsgSubmitRebalancePlan) Reset()         { *m = MsgSubmitRebalancePlan{} }
+func (m *MsgSubmitRebalancePlan) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitRebalancePlan) ProtoMessage()    {}
+func (*MsgSubmitRebalancePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74455bb8cd100476, []int{30}
+}
+func (m *MsgSubmitRebalancePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitRebalancePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitRebalancePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitRebalancePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitRebalancePlan.Merge(m, src)
+}
+func (m *MsgSubmitRebalancePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitRebalancePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitRebalancePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitRebalancePlan proto.InternalMessageInfo
+
+func (m *MsgSubmitRebalancePlan) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgSubmitRebalancePlan) GetMoves() []RebalanceMove {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+type MsgSubmitRebalancePlanResponse struct {
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *MsgSubmitRebalancePlanResponse) Reset()         { *m = MsgSubmitRebalancePlanResponse{} }
+func (m *MsgSubmitRebalancePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitRebalancePlanResponse) ProtoMessage()    {}
+func (*MsgSubmitRebalancePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74455bb8cd100476, []int{31}
+}
+func (m *MsgSubmitRebalancePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitRebalancePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitRebalancePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitRebalancePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitRebalancePlanResponse.Merge(m, src)
+}
+func (m *MsgSubmitRebalancePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitRebalancePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitRebalancePlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitRebalancePlanResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitRebalancePlanResponse) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
 // this line is used by starport scaffolding # proto/tx/message
 type MsgStorageProviderForcedExit struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -1453,7 +1672,7 @@ func (m *MsgStorageProviderForcedExit) Reset()         { *m = MsgStorageProvider
 func (m *MsgStorageProviderForcedExit) String() string { return proto.CompactTextString(m) }
 func (*MsgStorageProviderForcedExit) ProtoMessage()    {}
 func (*MsgStorageProviderForcedExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74455bb8cd100476, []int{28}
+	return fileDescriptor_74455bb8cd100476, []int{32}
 }
 func (m *MsgStorageProviderForcedExit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1503,7 +1722,7 @@ func (m *MsgStorageProviderForcedExitResponse) Reset()         { *m = MsgStorage
 func (m *MsgStorageProviderForcedExitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgStorageProviderForcedExitResponse) ProtoMessage()    {}
 func (*MsgStorageProviderForcedExitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74455bb8cd100476, []int{29}
+	return fileDescriptor_74455bb8cd100476, []int{33}
 }
 func (m *MsgStorageProviderForcedExitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1561,6 +1780,10 @@ func init() {
 	proto.RegisterType((*MsgCompleteSwapInResponse)(nil), "moca.virtualgroup.MsgCompleteSwapInResponse")
 	proto.RegisterType((*MsgCancelSwapIn)(nil), "moca.virtualgroup.MsgCancelSwapIn")
 	proto.RegisterType((*MsgCancelSwapInResponse)(nil), "moca.virtualgroup.MsgCancelSwapInResponse")
+	proto.RegisterType((*MsgBidSwapIn)(nil), "moca.virtualgroup.MsgBidSwapIn")
+	proto.RegisterType((*MsgBidSwapInResponse)(nil), "moca.virtualgroup.MsgBidSwapInResponse")
+	proto.RegisterType((*MsgSubmitRebalancePlan)(nil), "moca.virtualgroup.MsgSubmitRebalancePlan")
+	proto.RegisterType((*MsgSubmitRebalancePlanResponse)(nil), "moca.virtualgroup.MsgSubmitRebalancePlanResponse")
 	proto.RegisterType((*MsgStorageProviderForcedExit)(nil), "moca.virtualgroup.MsgStorageProviderForcedExit")
 	proto.RegisterType((*MsgStorageProviderForcedExitResponse)(nil), "moca.virtualgroup.MsgStorageProviderForcedExitResponse")
 }
@@ -1568,89 +1791,99 @@ func init() {
 func init() { proto.RegisterFile("moca/virtualgroup/tx.proto", fileDescriptor_74455bb8cd100476) }
 
 var fileDescriptor_74455bb8cd100476 = []byte{
-	// 1303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x98, 0xcd, 0x4f, 0x1b, 0x47,
-	0x14, 0xc0, 0x59, 0x3b, 0x1f, 0xf0, 0x12, 0x02, 0x2c, 0x50, 0xcc, 0x42, 0x1c, 0xb4, 0x7c, 0x88,
-	0xba, 0x8d, 0x5d, 0x68, 0x0b, 0x8d, 0xd5, 0xa6, 0x09, 0xa4, 0x89, 0xac, 0x06, 0x25, 0x32, 0x6a,
-	0x2b, 0xf5, 0x50, 0x6b, 0xf0, 0x4e, 0x97, 0x95, 0xec, 0x9d, 0xd5, 0xce, 0xda, 0xc0, 0xad, 0x42,
-	0x6a, 0x0f, 0xed, 0x25, 0x87, 0x1e, 0x7a, 0xef, 0xa5, 0x47, 0x0e, 0x39, 0xf5, 0xd2, 0x6b, 0x8e,
-	0x51, 0x4f, 0x3d, 0x55, 0x11, 0x54, 0x42, 0xfd, 0x1b, 0x7a, 0xa9, 0x76, 0x76, 0x76, 0xbc, 0xfe,
-	0x98, 0xb5, 0x8d, 0x88, 0x22, 0x71, 0x01, 0xef, 0xfb, 0x98, 0x79, 0xf3, 0xdb, 0xb7, 0xef, 0xcd,
-	0x0c, 0x68, 0x55, 0x52, 0x46, 0xb9, 0xba, 0xe5, 0x7a, 0x35, 0x54, 0x31, 0x5d, 0x52, 0x73, 0x72,
-	0xde, 0x7e, 0xd6, 0x71, 0x89, 0x47, 0xd4, 0x31, 0x5f, 0x97, 0x8d, 0xea, 0xb4, 0x31, 0x54, 0xb5,
-	0x6c, 0x92, 0x63, 0x7f, 0x03, 0x2b, 0x2d, 0x5d, 0x26, 0xb4, 0x4a, 0x68, 0x6e, 0x07, 0x51, 0x9c,
-	0xab, 0xaf, 0xec, 0x60, 0x0f, 0xad, 0xe4, 0xca, 0xc4, 0xb2, 0xb9, 0x7e, 0x8a, 0xeb, 0xab, 0xd4,
-	0xcc, 0xd5, 0x57, 0xfc, 0x7f, 0x5c, 0x31, 0x1d, 0x28, 0x4a, 0xec, 0x29, 0x17, 0x3c, 0x70, 0xd5,
-	0x84, 0x49, 0x4c, 0x12, 0xc8, 0xfd, 0x5f, 0x5c, 0x1a, 0xc4, 0x5a, 0x26, 0xd5, 0x2a, 0xb1, 0x73,
-	0xc8, 0x71, 0x5c, 0x52, 0x47, 0x95, 0x30, 0x8a, 0xf6, 0x75, 0x38, 0xc8, 0x45, 0x55, 0x3e, 0xa2,
-	0xfe, 0x87, 0x02, 0x23, 0x5b, 0xd4, 0xfc, 0xc2, 0x31, 0x90, 0x87, 0x9f, 0x32, 0x8d, 0xba, 0x06,
-	0x43, 0xa8, 0xe6, 0xed, 0x12, 0xd7, 0xf2, 0x0e, 0x52, 0xca, 0x9c, 0xb2, 0x3c, 0xb4, 0x91, 0xfa,
-	0xf3, 0xf9, 0xed, 0x09, 0x1e, 0xca, 0x7d, 0xc3, 0x70, 0x31, 0xa5, 0xdb, 0x9e, 0x6b, 0xd9, 0x66,
-	0xb1, 0x61, 0xaa, 0x7e, 0x0c, 0x57, 0x82, 0xb1, 0x53, 0x89, 0x39, 0x65, 0xf9, 0xda, 0xea, 0x74,
-	0xb6, 0x0d, 0x54, 0x36, 0x98, 0x62, 0x63, 0xe8, 0xc5, 0xdf, 0xb7, 0x06, 0x7e, 0x3b, 0x3d, 0xca,
-	0x28, 0x45, 0xee, 0x93, 0x5f, 0x3b, 0x3c, 0x3d, 0xca, 0x34, 0x46, 0xfb, 0xf1, 0xf4, 0x28, 0x33,
-	0xcf, 0x82, 0xdf, 0x6f, 0x0e, 0xbf, 0x25, 0x5a, 0x7d, 0x1a, 0xa6, 0x5a, 0x44, 0x45, 0x4c, 0x1d,
-	0x62, 0x53, 0xac, 0x3f, 0x4f, 0xc0, 0xcc, 0x16, 0x35, 0x37, 0x5d, 0x8c, 0x3c, 0xfc, 0xa8, 0x42,
-	0x76, 0x50, 0xe5, 0xcb, 0x60, 0xa8, 0x47, 0xfe, 0x50, 0xea, 0x26, 0x8c, 0x52, 0x8f, 0xb8, 0xc8,
-	0xc4, 0x3e, 0xec, 0xba, 0x65, 0x60, 0xb7, 0xeb, 0x7a, 0x47, 0xb8, 0xc7, 0x53, 0xee, 0xa0, 0xce,
-	0xc0, 0xd0, 0xb7, 0xa8, 0x6a, 0x55, 0x0e, 0x4a, 0x96, 0xc1, 0x16, 0x3e, 0x5c, 0x1c, 0x0c, 0x04,
-	0x05, 0x43, 0x5d, 0x86, 0x51, 0x8a, 0xcb, 0xc4, 0x36, 0x90, 0x7b, 0x50, 0xa2, 0x4e, 0xc9, 0x32,
-	0x68, 0x2a, 0x39, 0x97, 0x5c, 0x1e, 0x2e, 0xde, 0x10, 0xf2, 0x6d, 0xa7, 0x60, 0x50, 0xf5, 0x2e,
-	0x5c, 0x35, 0xb0, 0x43, 0xa8, 0xe5, 0xa5, 0x2e, 0x71, 0x7a, 0x7c, 0x7e, 0x3f, 0x81, 0xb2, 0x3c,
-	0x81, 0xb2, 0x9b, 0xc4, 0xb2, 0xa3, 0xf4, 0x42, 0xa7, 0x7c, 0xc1, 0xc7, 0xd7, 0xb6, 0x1c, 0x9f,
-	0x62, 0x4e, 0x42, 0x51, 0x86, 0x45, 0x5f, 0x84, 0xf9, 0x18, 0xb5, 0xa0, 0xfb, 0x8f, 0xc2, 0xe8,
-	0x3e, 0xc0, 0x15, 0xfc, 0xfa, 0xe8, 0x7e, 0x08, 0x53, 0x26, 0x1b, 0xba, 0xc4, 0xc3, 0x2f, 0xb1,
-	0xf8, 0x1b, 0xac, 0x27, 0xcc, 0xb6, 0x99, 0x0b, 0xc6, 0x19, 0x68, 0xc8, 0x96, 0xc1, 0x69, 0xc8,
-	0xd4, 0x82, 0xc6, 0x61, 0x02, 0x80, 0xd9, 0xb1, 0xd7, 0xf1, 0x26, 0x17, 0x1f, 0x4d, 0xa5, 0xe4,
-	0x59, 0x52, 0xe9, 0x23, 0x29, 0xbc, 0xb4, 0x14, 0x1e, 0xf3, 0xd4, 0x27, 0x40, 0x6d, 0x3c, 0x09,
-	0x34, 0x3f, 0x24, 0xe0, 0xda, 0x16, 0x35, 0xbf, 0xb2, 0xbc, 0x5d, 0xc3, 0x45, 0x7b, 0x6f, 0x94,
-	0xcd, 0x3d, 0x18, 0xdc, 0xe3, 0x71, 0xf4, 0x05, 0x47, 0x78, 0xe5, 0xef, 0x48, 0xe9, 0xdc, 0x92,
-	0xd0, 0x09, 0x17, 0xae, 0x4f, 0xc2, 0x78, 0xe4, 0x51, 0xf0, 0xf9, 0x2f, 0x48, 0x9d, 0xed, 0x3d,
-	0xe4, 0x3c, 0xa9, 0x9d, 0x53, 0xea, 0x6c, 0x40, 0xba, 0x23, 0x9e, 0xd6, 0x52, 0xa5, 0xb5, 0x53,
-	0x7a, 0x18, 0x16, 0xaf, 0x75, 0x48, 0x49, 0x10, 0x87, 0x45, 0x6c, 0xb2, 0x13, 0x63, 0xaa, 0x2e,
-	0xc1, 0x08, 0xad, 0x95, 0xcb, 0x98, 0x52, 0xe2, 0x06, 0x55, 0x8f, 0xd5, 0xb4, 0xe1, 0xe2, 0xb0,
-	0x10, 0xfb, 0x45, 0x4f, 0x2d, 0xc0, 0x64, 0x93, 0x5d, 0xd8, 0xbb, 0x52, 0x97, 0xd9, 0x9b, 0x99,
-	0x0c, 0xfa, 0x47, 0xd0, 0xd8, 0xb2, 0xf7, 0xb9, 0xb2, 0x38, 0x1e, 0x19, 0x24, 0x14, 0x9e, 0x21,
-	0x67, 0x39, 0x6e, 0x9e, 0xb3, 0xfc, 0x49, 0xbc, 0x93, 0x5f, 0x12, 0x4c, 0xbc, 0x49, 0xaa, 0x8e,
-	0xff, 0xe1, 0x5f, 0x98, 0x77, 0x93, 0xbf, 0x27, 0x05, 0xb5, 0x24, 0xeb, 0x13, 0xcd, 0x0c, 0xf4,
-	0x59, 0xd0, 0xda, 0xa5, 0x02, 0xdc, 0xcf, 0x09, 0x18, 0xf5, 0xd5, 0xc8, 0x2e, 0xe3, 0xca, 0xc5,
-	0xc1, 0x76, 0x57, 0x8a, 0x6d, 0x41, 0x86, 0x2d, 0x4a, 0x40, 0xd7, 0x20, 0xd5, 0x2a, 0x8b, 0xd6,
-	0xc7, 0x21, 0x3f, 0x05, 0xb1, 0xe7, 0x55, 0xf0, 0x05, 0x60, 0xb5, 0x2e, 0x65, 0x75, 0x53, 0xf6,
-	0x2d, 0xb2, 0xa5, 0xeb, 0xe3, 0x30, 0x26, 0x1e, 0x04, 0x9d, 0x5f, 0x15, 0x78, 0xcb, 0x97, 0x36,
-	0xaf, 0xf0, 0xb3, 0xfd, 0x73, 0x6a, 0xb2, 0xf9, 0x07, 0xd2, 0x68, 0x33, 0xb2, 0x68, 0xdb, 0x43,
-	0xd1, 0xe7, 0x20, 0xdd, 0x59, 0x23, 0xd6, 0xf1, 0xaf, 0xc2, 0x4c, 0xc4, 0x77, 0xf3, 0x9a, 0xd6,
-	0xa3, 0x7e, 0x00, 0x83, 0xc4, 0xc1, 0x2e, 0xf2, 0x88, 0xcb, 0x5e, 0x72, 0x9c, 0xb3, 0xb0, 0xcc,
-	0x3f, 0x96, 0x52, 0x58, 0xed, 0x56, 0x16, 0x3a, 0xd0, 0x58, 0x86, 0xa5, 0x78, 0x8b, 0xe8, 0x16,
-	0xdd, 0x2f, 0x17, 0x45, 0x4c, 0xb1, 0x5b, 0x67, 0xc5, 0xa4, 0x60, 0x9f, 0x0f, 0x87, 0x39, 0xb8,
-	0xee, 0x21, 0xd7, 0xc4, 0x1e, 0xef, 0x40, 0x41, 0xc2, 0x43, 0x20, 0x63, 0xed, 0xa7, 0xfb, 0x47,
-	0x92, 0xec, 0xfa, 0x91, 0xc4, 0x6c, 0x43, 0x2e, 0xc5, 0xec, 0x4f, 0xfb, 0x2f, 0x27, 0x4d, 0x84,
-	0x78, 0x39, 0x69, 0x92, 0x45, 0x2b, 0xf0, 0x58, 0x4b, 0x81, 0x3e, 0x2f, 0xa6, 0xe7, 0x51, 0x56,
-	0x62, 0x88, 0x25, 0x63, 0x88, 0x7d, 0x2a, 0x25, 0xb6, 0xd8, 0x43, 0xdf, 0x2a, 0xd8, 0xfa, 0x0c,
-	0x4c, 0xb7, 0x09, 0x05, 0xb3, 0x67, 0x09, 0x76, 0x0c, 0x6e, 0xd4, 0xe7, 0x0b, 0x40, 0xec, 0x13,
-	0x29, 0xb1, 0xf9, 0xae, 0x2d, 0xab, 0x60, 0xf3, 0x73, 0x75, 0x54, 0x24, 0x68, 0xbd, 0x52, 0x60,
-	0xb6, 0xbd, 0xda, 0x3d, 0x24, 0x6e, 0x19, 0x1b, 0xac, 0x90, 0x9d, 0xf5, 0x06, 0xa1, 0x13, 0xf2,
-	0x44, 0xbf, 0x05, 0x7d, 0xb3, 0xfd, 0x22, 0xe1, 0xbd, 0xde, 0x2a, 0x79, 0x63, 0x05, 0xfa, 0x12,
-	0x2c, 0xc4, 0xe9, 0x43, 0x14, 0xab, 0xbf, 0x5f, 0x87, 0xe4, 0x16, 0x35, 0xd5, 0x43, 0x05, 0x52,
-	0xd2, 0x7b, 0x86, 0x6c, 0x87, 0x8b, 0x90, 0x98, 0x13, 0xb6, 0xb6, 0xd6, 0x9f, 0x7d, 0x18, 0x0c,
-	0x0b, 0x42, 0x7a, 0x1c, 0x97, 0x04, 0x21, 0xb3, 0x97, 0x05, 0xd1, 0xed, 0x20, 0xac, 0x3e, 0x81,
-	0xab, 0xe1, 0x21, 0xf8, 0xa6, 0x6c, 0x08, 0xa6, 0xd6, 0x16, 0x63, 0xd5, 0x62, 0xc0, 0x22, 0x0c,
-	0x8a, 0xa3, 0x63, 0xba, 0xb3, 0x4b, 0xa8, 0xd7, 0x96, 0xe2, 0xf5, 0xd1, 0x20, 0xc3, 0xbd, 0xa9,
-	0x24, 0x48, 0xae, 0x96, 0x05, 0xd9, 0xb2, 0x87, 0x53, 0x1f, 0xc3, 0x15, 0xbe, 0x7f, 0x9b, 0x95,
-	0x38, 0x30, 0xad, 0xb6, 0x10, 0xa7, 0x15, 0xa3, 0x7d, 0x03, 0xd7, 0x9b, 0x6e, 0xe4, 0xf4, 0xce,
-	0x5e, 0x51, 0x1b, 0x2d, 0xd3, 0xdd, 0x46, 0x8c, 0x4f, 0x61, 0xbc, 0xd3, 0xfe, 0xe3, 0x6d, 0x49,
-	0x70, 0xed, 0xa6, 0xda, 0x4a, 0xcf, 0xa6, 0x62, 0xd2, 0x9f, 0x14, 0x98, 0x89, 0xdb, 0xfd, 0x48,
-	0x86, 0x8c, 0x71, 0xd1, 0xee, 0xf4, 0xed, 0x22, 0xa2, 0x31, 0x61, 0xa4, 0xf5, 0x70, 0xb7, 0xd8,
-	0x65, 0x34, 0x9e, 0x11, 0xb7, 0x7b, 0x32, 0x13, 0x13, 0x21, 0x18, 0x6e, 0x3e, 0x0c, 0xcd, 0x4b,
-	0xfc, 0xa3, 0x46, 0xda, 0x3b, 0x3d, 0x18, 0x45, 0xa7, 0x68, 0xde, 0x40, 0x49, 0xa6, 0x68, 0x32,
-	0x92, 0x4d, 0xd1, 0x71, 0x53, 0xe1, 0x67, 0x64, 0x53, 0x73, 0xd4, 0xbb, 0xc5, 0x57, 0xb0, 0x65,
-	0x19, 0xd9, 0xa9, 0xa5, 0xa8, 0x06, 0xdc, 0x68, 0xd9, 0xb0, 0x2c, 0x74, 0xc7, 0x5c, 0xb0, 0xb5,
-	0x77, 0x7b, 0xb1, 0x12, 0xb3, 0x7c, 0xaf, 0xc0, 0xb4, 0xbc, 0x6b, 0xe5, 0x7a, 0xca, 0xe9, 0x86,
-	0x83, 0xb6, 0xde, 0xa7, 0x43, 0x18, 0x87, 0x76, 0xf9, 0xbb, 0xd3, 0xa3, 0x8c, 0xb2, 0xf1, 0xf9,
-	0x8b, 0xe3, 0xb4, 0xf2, 0xf2, 0x38, 0xad, 0xbc, 0x3a, 0x4e, 0x2b, 0xcf, 0x4e, 0xd2, 0x03, 0x2f,
-	0x4f, 0xd2, 0x03, 0x7f, 0x9d, 0xa4, 0x07, 0xbe, 0x5e, 0x31, 0x2d, 0x6f, 0xb7, 0xb6, 0x93, 0x2d,
-	0x93, 0x2a, 0xbb, 0xb0, 0x2c, 0xef, 0x22, 0xcb, 0x0e, 0xae, 0x2e, 0xeb, 0xab, 0xad, 0x8d, 0xcc,
-	0x3b, 0x70, 0x30, 0xdd, 0xb9, 0xc2, 0x2e, 0xf4, 0xdf, 0xff, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xc4,
-	0x44, 0xe2, 0x87, 0xba, 0x18, 0x00, 0x00,
+	// 1465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x99, 0xcd, 0x6f, 0xd4, 0x46,
+	0x14, 0xc0, 0xe3, 0x0d, 0x84, 0xe4, 0x41, 0x08, 0x71, 0x12, 0xb2, 0x71, 0x60, 0x59, 0x39, 0x1f,
+	0x4d, 0x43, 0x59, 0x37, 0x69, 0x1b, 0xca, 0xaa, 0xa5, 0x90, 0x50, 0xd0, 0xaa, 0x44, 0xa0, 0x45,
+	0xb4, 0x52, 0x0f, 0x8d, 0x66, 0xd7, 0x53, 0xc7, 0xd2, 0xae, 0xc7, 0xf2, 0x78, 0x97, 0xe4, 0x56,
+	0x21, 0x95, 0x43, 0x7b, 0xe1, 0xd0, 0x43, 0xef, 0xbd, 0xf4, 0xc8, 0x81, 0x7b, 0xaf, 0x1c, 0x11,
+	0xa7, 0x9e, 0x2a, 0x04, 0x95, 0x50, 0xff, 0x86, 0x5e, 0x2a, 0xdb, 0xe3, 0x59, 0x7b, 0xed, 0xf1,
+	0xee, 0x46, 0x41, 0x48, 0xe9, 0x05, 0x32, 0xef, 0x63, 0xe6, 0xcd, 0x6f, 0x66, 0xde, 0x8c, 0xdf,
+	0x82, 0xd2, 0x24, 0x75, 0xa4, 0xb5, 0x4d, 0xc7, 0x6d, 0xa1, 0x86, 0xe1, 0x90, 0x96, 0xad, 0xb9,
+	0x7b, 0x25, 0xdb, 0x21, 0x2e, 0x91, 0x27, 0x3d, 0x5d, 0x29, 0xaa, 0x53, 0x26, 0x51, 0xd3, 0xb4,
+	0x88, 0xe6, 0xff, 0x1b, 0x58, 0x29, 0x85, 0x3a, 0xa1, 0x4d, 0x42, 0xb5, 0x1a, 0xa2, 0x58, 0x6b,
+	0xaf, 0xd5, 0xb0, 0x8b, 0xd6, 0xb4, 0x3a, 0x31, 0x2d, 0xa6, 0x9f, 0x65, 0xfa, 0x26, 0x35, 0xb4,
+	0xf6, 0x9a, 0xf7, 0x1f, 0x53, 0xcc, 0x05, 0x8a, 0x1d, 0xbf, 0xa5, 0x05, 0x0d, 0xa6, 0x9a, 0x36,
+	0x88, 0x41, 0x02, 0xb9, 0xf7, 0x17, 0x93, 0x06, 0xb1, 0xd6, 0x49, 0xb3, 0x49, 0x2c, 0x0d, 0xd9,
+	0xb6, 0x43, 0xda, 0xa8, 0x11, 0x46, 0x91, 0x9c, 0x87, 0x8d, 0x1c, 0xd4, 0x0c, 0x7b, 0x3c, 0x9f,
+	0x32, 0xcf, 0x7d, 0x1b, 0x33, 0xb5, 0xfa, 0x87, 0x04, 0x13, 0xdb, 0xd4, 0xb8, 0x6f, 0xeb, 0xc8,
+	0xc5, 0x77, 0x7d, 0x47, 0x79, 0x03, 0xc6, 0x50, 0xcb, 0xdd, 0x25, 0x8e, 0xe9, 0xee, 0xe7, 0xa5,
+	0xa2, 0xb4, 0x32, 0xb6, 0x99, 0x7f, 0xf1, 0xf4, 0xd2, 0x34, 0x8b, 0xf4, 0xba, 0xae, 0x3b, 0x98,
+	0xd2, 0x7b, 0xae, 0x63, 0x5a, 0x46, 0xb5, 0x63, 0x2a, 0x7f, 0x06, 0x23, 0xc1, 0xd0, 0xf9, 0x5c,
+	0x51, 0x5a, 0x39, 0xb9, 0x3e, 0x57, 0x4a, 0x70, 0x2c, 0x05, 0x43, 0x6c, 0x8e, 0x3d, 0xfb, 0xeb,
+	0xc2, 0xd0, 0xef, 0x6f, 0x9e, 0xac, 0x4a, 0x55, 0xe6, 0x53, 0xde, 0x78, 0xf8, 0xe6, 0xc9, 0x6a,
+	0xa7, 0xb7, 0x9f, 0xde, 0x3c, 0x59, 0x5d, 0xf0, 0x63, 0xdf, 0x8b, 0x47, 0xdf, 0x15, 0xad, 0x3a,
+	0x07, 0xb3, 0x5d, 0xa2, 0x2a, 0xa6, 0x36, 0xb1, 0x28, 0x56, 0x9f, 0xe6, 0x60, 0x7e, 0x9b, 0x1a,
+	0x5b, 0x0e, 0x46, 0x2e, 0xbe, 0xd5, 0x20, 0x35, 0xd4, 0xf8, 0x3a, 0xe8, 0xea, 0x96, 0xd7, 0x95,
+	0xbc, 0x05, 0x67, 0xa8, 0x4b, 0x1c, 0x64, 0x60, 0x6f, 0x2d, 0xda, 0xa6, 0x8e, 0x9d, 0x9e, 0xf3,
+	0x9d, 0x60, 0x1e, 0x77, 0x99, 0x83, 0x3c, 0x0f, 0x63, 0xdf, 0xa3, 0xa6, 0xd9, 0xd8, 0xdf, 0x31,
+	0x75, 0x7f, 0xe2, 0xe3, 0xd5, 0xd1, 0x40, 0x50, 0xd1, 0xe5, 0x15, 0x38, 0x43, 0x71, 0x9d, 0x58,
+	0x3a, 0x72, 0xf6, 0x77, 0xa8, 0xbd, 0x63, 0xea, 0x34, 0x3f, 0x5c, 0x1c, 0x5e, 0x19, 0xaf, 0x9e,
+	0xe6, 0xf2, 0x7b, 0x76, 0x45, 0xa7, 0xf2, 0x55, 0x38, 0xa1, 0x63, 0x9b, 0x50, 0xd3, 0xcd, 0x1f,
+	0x63, 0xf4, 0xd8, 0xf8, 0xde, 0xfe, 0x2a, 0xb1, 0xfd, 0x55, 0xda, 0x22, 0xa6, 0x15, 0xa5, 0x17,
+	0x3a, 0x95, 0x2b, 0x1e, 0xbe, 0xc4, 0x74, 0x3c, 0x8a, 0x9a, 0x80, 0xa2, 0x08, 0x8b, 0xba, 0x04,
+	0x0b, 0x19, 0x6a, 0x4e, 0xf7, 0x6f, 0xc9, 0xa7, 0x7b, 0x03, 0x37, 0xf0, 0xdb, 0xa3, 0xfb, 0x09,
+	0xcc, 0x1a, 0x7e, 0xd7, 0x3b, 0x2c, 0xfc, 0x1d, 0x3f, 0xfe, 0x0e, 0xeb, 0x69, 0x23, 0x31, 0x72,
+	0x45, 0x3f, 0x00, 0x0d, 0xd1, 0x34, 0x18, 0x0d, 0x91, 0x9a, 0xd3, 0x78, 0x98, 0x03, 0xf0, 0xed,
+	0xfc, 0xe5, 0x78, 0x97, 0x93, 0x8f, 0x6e, 0xa5, 0xe1, 0x83, 0x6c, 0xa5, 0x4f, 0x85, 0xf0, 0x0a,
+	0x42, 0x78, 0xbe, 0xa7, 0x3a, 0x0d, 0x72, 0xa7, 0xc5, 0xd1, 0x3c, 0xca, 0xc1, 0xc9, 0x6d, 0x6a,
+	0x7c, 0x63, 0xba, 0xbb, 0xba, 0x83, 0x1e, 0xbc, 0x53, 0x36, 0xd7, 0x60, 0xf4, 0x01, 0x8b, 0x63,
+	0x20, 0x38, 0xdc, 0xab, 0x7c, 0x45, 0x48, 0xe7, 0x82, 0x80, 0x4e, 0x38, 0x71, 0x75, 0x06, 0xa6,
+	0x22, 0x4d, 0xce, 0xe7, 0xdf, 0x60, 0xeb, 0xdc, 0x7b, 0x80, 0xec, 0x3b, 0xad, 0x43, 0xda, 0x3a,
+	0x9b, 0x50, 0x48, 0xc5, 0xd3, 0x9d, 0xaa, 0x94, 0x24, 0xa5, 0x9b, 0x61, 0xf2, 0xba, 0x0c, 0x79,
+	0x01, 0xe2, 0x30, 0x89, 0xcd, 0xa4, 0x31, 0xa6, 0xf2, 0x32, 0x4c, 0xd0, 0x56, 0xbd, 0x8e, 0x29,
+	0x25, 0x4e, 0x90, 0xf5, 0xfc, 0x9c, 0x36, 0x5e, 0x1d, 0xe7, 0x62, 0x2f, 0xe9, 0xc9, 0x15, 0x98,
+	0x89, 0xd9, 0x85, 0x57, 0x5b, 0xfe, 0xb8, 0xbf, 0x32, 0x33, 0xc1, 0xfd, 0x11, 0xdc, 0x7b, 0xa5,
+	0xeb, 0x4c, 0x59, 0x9d, 0x8a, 0x74, 0x12, 0x0a, 0x0f, 0xb0, 0x67, 0x19, 0x6e, 0xb6, 0x67, 0x59,
+	0x8b, 0xaf, 0xc9, 0xaf, 0x39, 0x5f, 0xbc, 0x45, 0x9a, 0xb6, 0x77, 0xf0, 0x8f, 0xcc, 0xda, 0x94,
+	0xaf, 0x09, 0x41, 0x2d, 0x8b, 0xee, 0x89, 0x38, 0x03, 0xf5, 0x1c, 0x28, 0x49, 0x29, 0x07, 0xf7,
+	0x4b, 0x0e, 0xce, 0x78, 0x6a, 0x64, 0xd5, 0x71, 0xe3, 0xe8, 0x60, 0xbb, 0x2a, 0xc4, 0xb6, 0x28,
+	0xc2, 0x16, 0x25, 0xa0, 0x2a, 0x90, 0xef, 0x96, 0x45, 0xf3, 0xe3, 0x98, 0xb7, 0x05, 0xb1, 0xeb,
+	0x36, 0xf0, 0x11, 0x60, 0x75, 0x59, 0xc8, 0xea, 0xbc, 0xe8, 0x2c, 0xfa, 0x53, 0x57, 0xa7, 0x60,
+	0x92, 0x37, 0x38, 0x9d, 0xdf, 0x24, 0x38, 0xeb, 0x49, 0xe3, 0x33, 0xfc, 0x72, 0xef, 0x90, 0x2e,
+	0xd9, 0xf2, 0x0d, 0x61, 0xb4, 0xab, 0xa2, 0x68, 0x93, 0xa1, 0xa8, 0x45, 0x28, 0xa4, 0x6b, 0xf8,
+	0x3c, 0xfe, 0x91, 0xa0, 0x10, 0x3d, 0x37, 0x6f, 0x69, 0x3e, 0xf2, 0xc7, 0x30, 0x4a, 0x6c, 0xec,
+	0x20, 0x97, 0x38, 0xf9, 0x5c, 0x0f, 0x67, 0x6e, 0x59, 0xbe, 0x2d, 0xa4, 0xb0, 0xde, 0x2b, 0x2d,
+	0xa4, 0xd0, 0x58, 0x81, 0xe5, 0x6c, 0x8b, 0xe8, 0x13, 0xdd, 0x4b, 0x17, 0x55, 0x4c, 0xb1, 0xd3,
+	0xf6, 0x93, 0x49, 0xc5, 0x3a, 0x1c, 0x0e, 0x45, 0x38, 0xe5, 0x22, 0xc7, 0xc0, 0x2e, 0xbb, 0x81,
+	0x82, 0x0d, 0x0f, 0x81, 0xcc, 0xbf, 0x7e, 0x7a, 0x1f, 0x92, 0xe1, 0x9e, 0x87, 0x24, 0xe3, 0x19,
+	0x72, 0x2c, 0xe3, 0x7d, 0x3a, 0x78, 0x3a, 0x89, 0x11, 0x62, 0xe9, 0x24, 0x26, 0x8b, 0x66, 0xe0,
+	0xc9, 0xae, 0x04, 0x7d, 0x58, 0x4c, 0x0f, 0x23, 0xad, 0x64, 0x10, 0x1b, 0xce, 0x20, 0xf6, 0x85,
+	0x90, 0xd8, 0x52, 0x1f, 0xf7, 0x56, 0xc5, 0x52, 0xe7, 0x61, 0x2e, 0x21, 0xe4, 0xcc, 0x1e, 0xe7,
+	0x60, 0x22, 0x96, 0x9f, 0x8f, 0x00, 0xb1, 0xcf, 0x85, 0xc4, 0x16, 0x7a, 0x5e, 0x59, 0x15, 0x8b,
+	0x7d, 0x57, 0x47, 0x45, 0x9c, 0xd6, 0xb3, 0x1c, 0x9c, 0xda, 0xa6, 0xc6, 0xa6, 0xa9, 0xff, 0x5f,
+	0x0e, 0xac, 0xac, 0xc0, 0x68, 0x1d, 0xd9, 0xa8, 0xee, 0x95, 0x44, 0xbc, 0xd7, 0xe9, 0xb1, 0x2a,
+	0x6f, 0x97, 0xcb, 0x42, 0xd0, 0x45, 0x01, 0x68, 0x4e, 0x4e, 0x3d, 0x0b, 0xd3, 0xd1, 0x36, 0x47,
+	0xfc, 0x82, 0xdd, 0x7a, 0xad, 0x5a, 0xd3, 0xcb, 0x96, 0x35, 0xd4, 0xf0, 0x16, 0xe2, 0x6e, 0x03,
+	0x59, 0x5e, 0x79, 0x86, 0xfa, 0x62, 0xb7, 0x0f, 0xca, 0x1d, 0x53, 0xf9, 0x3a, 0x1c, 0x6f, 0x92,
+	0x36, 0xf6, 0xaa, 0x33, 0xc3, 0x2b, 0x27, 0xd7, 0x8b, 0x29, 0xd5, 0x19, 0x3e, 0xd0, 0x36, 0x69,
+	0xe3, 0xe8, 0xe7, 0x4f, 0xe0, 0x19, 0x6c, 0xa9, 0x4e, 0x97, 0x99, 0x97, 0x64, 0x32, 0x72, 0xf5,
+	0x0a, 0x14, 0xd2, 0x35, 0xe1, 0xb4, 0xe5, 0x59, 0x38, 0x61, 0x37, 0x90, 0xe5, 0xad, 0x86, 0xe4,
+	0x53, 0x1e, 0xf1, 0x9a, 0x15, 0x5d, 0x7d, 0x29, 0xc1, 0xb9, 0xe4, 0x05, 0x7b, 0x93, 0x38, 0x75,
+	0xac, 0xfb, 0x77, 0xe7, 0x41, 0x8b, 0x56, 0x69, 0x5b, 0x37, 0x37, 0xe8, 0x1b, 0x62, 0x2b, 0x59,
+	0xbb, 0xfa, 0xb0, 0xbf, 0xc7, 0x43, 0x67, 0x06, 0xea, 0x32, 0x2c, 0x66, 0xe9, 0x43, 0x46, 0xeb,
+	0x8f, 0x4e, 0xc3, 0xf0, 0x36, 0x35, 0xe4, 0x87, 0x12, 0xe4, 0x85, 0xa5, 0xad, 0x52, 0xca, 0xea,
+	0x66, 0x14, 0x75, 0x94, 0x8d, 0xc1, 0xec, 0xf9, 0x82, 0x79, 0x41, 0x08, 0x2b, 0x40, 0x82, 0x20,
+	0x44, 0xf6, 0xca, 0xc6, 0x60, 0xf6, 0x3c, 0x88, 0x3b, 0x70, 0x22, 0xac, 0xbb, 0x9c, 0x17, 0x75,
+	0xe1, 0xab, 0x95, 0xa5, 0x4c, 0x35, 0xef, 0xb0, 0x0a, 0xa3, 0xbc, 0x5a, 0x51, 0x48, 0x77, 0x09,
+	0xf5, 0xca, 0x72, 0xb6, 0x3e, 0x1a, 0x64, 0xf8, 0x39, 0x24, 0x08, 0x92, 0xa9, 0x95, 0xa5, 0x4c,
+	0x35, 0xef, 0xf0, 0x36, 0x8c, 0xb0, 0x4f, 0x86, 0x73, 0x02, 0x07, 0x5f, 0xab, 0x2c, 0x66, 0x69,
+	0x79, 0x6f, 0xdf, 0xc1, 0xa9, 0x58, 0x11, 0x58, 0x4d, 0xf7, 0x8a, 0xda, 0x28, 0xab, 0xbd, 0x6d,
+	0x78, 0xff, 0x14, 0xa6, 0xd2, 0x9e, 0xbc, 0xef, 0x0b, 0x82, 0x4b, 0x9a, 0x2a, 0x6b, 0x7d, 0x9b,
+	0xf2, 0x41, 0x7f, 0x96, 0x60, 0x3e, 0xeb, 0xc1, 0x2d, 0xe8, 0x32, 0xc3, 0x45, 0xb9, 0x32, 0xb0,
+	0x0b, 0x8f, 0xc6, 0x80, 0x89, 0xee, 0x7a, 0xc2, 0x52, 0x8f, 0xde, 0xd8, 0x8e, 0xb8, 0xd4, 0x97,
+	0x19, 0x1f, 0x08, 0xc1, 0x78, 0xfc, 0xfb, 0x7b, 0x41, 0xe0, 0x1f, 0x35, 0x52, 0x2e, 0xf6, 0x61,
+	0x14, 0x1d, 0x22, 0xfe, 0x66, 0x17, 0x0c, 0x11, 0x33, 0x52, 0x2e, 0xf6, 0x61, 0x14, 0xdd, 0x91,
+	0xb1, 0xf7, 0x98, 0xda, 0x2b, 0xbe, 0x8a, 0xa5, 0xac, 0xf6, 0xb6, 0xe1, 0xfd, 0xeb, 0x70, 0xba,
+	0xeb, 0x8d, 0xbc, 0xd8, 0x1b, 0x73, 0xc5, 0x52, 0x3e, 0xe8, 0xc7, 0x8a, 0x8f, 0x72, 0x1f, 0xc6,
+	0x3a, 0xef, 0xa4, 0x0b, 0xe9, 0xae, 0xdc, 0x40, 0x79, 0xaf, 0x87, 0x41, 0xec, 0x38, 0xa5, 0xbc,
+	0x0d, 0x44, 0xc7, 0x29, 0x69, 0xaa, 0xac, 0xf5, 0x6d, 0xca, 0x07, 0xfd, 0x51, 0x82, 0x39, 0xf1,
+	0x0d, 0xac, 0xf5, 0x75, 0x3e, 0x3b, 0x0e, 0xca, 0xe5, 0x01, 0x1d, 0xc2, 0x38, 0x94, 0xe3, 0x3f,
+	0x78, 0x8f, 0x92, 0xcd, 0xaf, 0x9e, 0xbd, 0x2a, 0x48, 0xcf, 0x5f, 0x15, 0xa4, 0x97, 0xaf, 0x0a,
+	0xd2, 0xe3, 0xd7, 0x85, 0xa1, 0xe7, 0xaf, 0x0b, 0x43, 0x7f, 0xbe, 0x2e, 0x0c, 0x7d, 0xbb, 0x66,
+	0x98, 0xee, 0x6e, 0xab, 0xe6, 0x95, 0x0e, 0xfd, 0x7a, 0x7f, 0x7d, 0x17, 0x99, 0x96, 0x16, 0xfc,
+	0x12, 0xb6, 0xae, 0xed, 0xa5, 0xfc, 0x1c, 0x56, 0x1b, 0xf1, 0x7f, 0x0f, 0xfb, 0xe8, 0xbf, 0x01,
+	0x00, 0xb0, 0x83, 0x57, 0xc4, 0x18, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1680,6 +1913,8 @@ type MsgClient interface {
 	ReserveSwapIn(ctx context.Context, in *MsgReserveSwapIn, opts ...grpc.CallOption) (*MsgReserveSwapInResponse, error)
 	CancelSwapIn(ctx context.Context, in *MsgCancelSwapIn, opts ...grpc.CallOption) (*MsgCancelSwapInResponse, error)
 	CompleteSwapIn(ctx context.Context, in *MsgCompleteSwapIn, opts ...grpc.CallOption) (*MsgCompleteSwapInResponse, error)
+	BidSwapIn(ctx context.Context, in *MsgBidSwapIn, opts ...grpc.CallOption) (*MsgBidSwapInResponse, error)
+	SubmitRebalancePlan(ctx context.Context, in *MsgSubmitRebalancePlan, opts ...grpc.CallOption) (*MsgSubmitRebalancePlanResponse, error)
 	// StorageProviderForcedExit defines a governance operation for a SP to be forced to exit
 	// The authority is defined in the keeper.
 	StorageProviderForcedExit(ctx context.Context, in *MsgStorageProviderForcedExit, opts ...grpc.CallOption) (*MsgStorageProviderForcedExitResponse, error)
@@ -1819,6 +2054,24 @@ func (c *msgClient) CompleteSwapIn(ctx context.Context, in *MsgCompleteSwapIn, o
 	return out, nil
 }
 
+func (c *msgClient) BidSwapIn(ctx context.Context, in *MsgBidSwapIn, opts ...grpc.CallOption) (*MsgBidSwapInResponse, error) {
+	out := new(MsgBidSwapInResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Msg/BidSwapIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitRebalancePlan(ctx context.Context, in *MsgSubmitRebalancePlan, opts ...grpc.CallOption) (*MsgSubmitRebalancePlanResponse, error) {
+	out := new(MsgSubmitRebalancePlanResponse)
+	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Msg/SubmitRebalancePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) StorageProviderForcedExit(ctx context.Context, in *MsgStorageProviderForcedExit, opts ...grpc.CallOption) (*MsgStorageProviderForcedExitResponse, error) {
 	out := new(MsgStorageProviderForcedExitResponse)
 	err := c.cc.Invoke(ctx, "/moca.virtualgroup.Msg/StorageProviderForcedExit", in, out, opts...)
@@ -1845,6 +2098,8 @@ type MsgServer interface {
 	ReserveSwapIn(context.Context, *MsgReserveSwapIn) (*MsgReserveSwapInResponse, error)
 	CancelSwapIn(context.Context, *MsgCancelSwapIn) (*MsgCancelSwapInResponse, error)
 	CompleteSwapIn(context.Context, *MsgCompleteSwapIn) (*MsgCompleteSwapInResponse, error)
+	BidSwapIn(context.Context, *MsgBidSwapIn) (*MsgBidSwapInResponse, error)
+	SubmitRebalancePlan(context.Context, *MsgSubmitRebalancePlan) (*MsgSubmitRebalancePlanResponse, error)
 	// StorageProviderForcedExit defines a governance operation for a SP to be forced to exit
 	// The authority is defined in the keeper.
 	StorageProviderForcedExit(context.Context, *MsgStorageProviderForcedExit) (*MsgStorageProviderForcedExitResponse, error)
@@ -1896,6 +2151,12 @@ func (*UnimplementedMsgServer) CancelSwapIn(ctx context.Context, req *MsgCancelS
 func (*UnimplementedMsgServer) CompleteSwapIn(ctx context.Context, req *MsgCompleteSwapIn) (*MsgCompleteSwapInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompleteSwapIn not implemented")
 }
+func (*UnimplementedMsgServer) BidSwapIn(ctx context.Context, req *MsgBidSwapIn) (*MsgBidSwapInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidSwapIn not implemented")
+}
+func (*UnimplementedMsgServer) SubmitRebalancePlan(ctx context.Context, req *MsgSubmitRebalancePlan) (*MsgSubmitRebalancePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRebalancePlan not implemented")
+}
 func (*UnimplementedMsgServer) StorageProviderForcedExit(ctx context.Context, req *MsgStorageProviderForcedExit) (*MsgStorageProviderForcedExitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderForcedExit not implemented")
 }
@@ -2156,6 +2417,42 @@ func _Msg_CompleteSwapIn_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BidSwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBidSwapIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BidSwapIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Msg/BidSwapIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BidSwapIn(ctx, req.(*MsgBidSwapIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitRebalancePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitRebalancePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitRebalancePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moca.virtualgroup.Msg/SubmitRebalancePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitRebalancePlan(ctx, req.(*MsgSubmitRebalancePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_StorageProviderForcedExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgStorageProviderForcedExit)
 	if err := dec(in); err != nil {
@@ -2234,6 +2531,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CompleteSwapIn",
 			Handler:    _Msg_CompleteSwapIn_Handler,
 		},
+		{
+			MethodName: "BidSwapIn",
+			Handler:    _Msg_BidSwapIn_Handler,
+		},
+		{
+			MethodName: "SubmitRebalancePlan",
+			Handler:    _Msg_SubmitRebalancePlan_Handler,
+		},
 		{
 			MethodName: "StorageProviderForcedExit",
 			Handler:    _Msg_StorageProviderForcedExit_Handler,
@@ -3214,7 +3519,7 @@ func (m *MsgCancelSwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgStorageProviderForcedExit) Marshal() (dAtA []byte, err error) {
+func (m *MsgBidSwapIn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3224,34 +3529,47 @@ func (m *MsgStorageProviderForcedExit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgStorageProviderForcedExit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBidSwapIn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStorageProviderForcedExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBidSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Capacity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TargetSpId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetSpId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.StorageProvider) > 0 {
 		i -= len(m.StorageProvider)
 		copy(dAtA[i:], m.StorageProvider)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageProvider)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgStorageProviderForcedExitResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBidSwapInResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3261,12 +3579,12 @@ func (m *MsgStorageProviderForcedExitResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgStorageProviderForcedExitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBidSwapInResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStorageProviderForcedExitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBidSwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3274,45 +3592,177 @@ func (m *MsgStorageProviderForcedExitResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSubmitRebalancePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSubmitRebalancePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateGlobalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubmitRebalancePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Moves) > 0 {
+		for iNdEx := len(m.Moves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Moves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitRebalancePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitRebalancePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitRebalancePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageProviderForcedExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageProviderForcedExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageProviderForcedExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageProvider) > 0 {
+		i -= len(m.StorageProvider)
+		copy(dAtA[i:], m.StorageProvider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageProvider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageProviderForcedExitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageProviderForcedExitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageProviderForcedExitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateGlobalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.StorageProvider)
@@ -3692,6 +4142,71 @@ func (m *MsgCancelSwapInResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgBidSwapIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageProvider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TargetSpId != 0 {
+		n += 1 + sovTx(uint64(m.TargetSpId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTx(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTx(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovTx(uint64(m.Capacity))
+	}
+	return n
+}
+
+func (m *MsgBidSwapInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitRebalancePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitRebalancePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	return n
+}
+
 func (m *MsgStorageProviderForcedExit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6456,6 +6971,399 @@ func (m *MsgCancelSwapInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgBidSwapIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBidSwapIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBidSwapIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
+			}
+			m.TargetSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBidSwapInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBidSwapInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBidSwapInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitRebalancePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitRebalancePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitRebalancePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, RebalanceMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitRebalancePlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitRebalancePlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitRebalancePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgStorageProviderForcedExit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
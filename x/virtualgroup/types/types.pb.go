@@ -26,6 +26,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RebalanceMoveStatus is the status of a move of a rebalance plan.
+type RebalanceMoveStatus int32
+
+const (
+	// The successor sp reserved the swap in and has not completed it yet.
+	REBALANCE_MOVE_STATUS_PENDING RebalanceMoveStatus = 0
+	// The successor sp completed the swap in.
+	REBALANCE_MOVE_STATUS_COMPLETED RebalanceMoveStatus = 1
+	// The successor sp canceled the swap in.
+	REBALANCE_MOVE_STATUS_CANCELED RebalanceMoveStatus = 2
+	// The swap in was not completed before the plan expired.
+	REBALANCE_MOVE_STATUS_EXPIRED RebalanceMoveStatus = 3
+)
+
+var RebalanceMoveStatus_name = map[int32]string{
+	0: "REBALANCE_MOVE_STATUS_PENDING",
+	1: "REBALANCE_MOVE_STATUS_COMPLETED",
+	2: "REBALANCE_MOVE_STATUS_CANCELED",
+	3: "REBALANCE_MOVE_STATUS_EXPIRED",
+}
+
+var RebalanceMoveStatus_value = map[string]int32{
+	"REBALANCE_MOVE_STATUS_PENDING":   0,
+	"REBALANCE_MOVE_STATUS_COMPLETED": 1,
+	"REBALANCE_MOVE_STATUS_CANCELED":  2,
+	"REBALANCE_MOVE_STATUS_EXPIRED":   3,
+}
+
+func (x RebalanceMoveStatus) String() string {
+	return proto.EnumName(RebalanceMoveStatus_name, int32(x))
+}
+
+func (RebalanceMoveStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{0}
+}
+
 // A global virtual group consists of one primary SP (SP) and multiple secondary SP.
 // Every global virtual group must belong to a GVG family, and the objects of each
 // bucket must be stored in a GVG within a group family.
@@ -500,611 +536,5253 @@ func (m *SwapInInfo) GetExpirationTime() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*GlobalVirtualGroup)(nil), "moca.virtualgroup.GlobalVirtualGroup")
-	proto.RegisterType((*GlobalVirtualGroupFamily)(nil), "moca.virtualgroup.GlobalVirtualGroupFamily")
-	proto.RegisterType((*GlobalVirtualGroupsBindingOnBucket)(nil), "moca.virtualgroup.GlobalVirtualGroupsBindingOnBucket")
-	proto.RegisterType((*GVGStatisticsWithinSP)(nil), "moca.virtualgroup.GVGStatisticsWithinSP")
-	proto.RegisterType((*GVGFamilyStatisticsWithinSP)(nil), "moca.virtualgroup.GVGFamilyStatisticsWithinSP")
-	proto.RegisterType((*SwapOutInfo)(nil), "moca.virtualgroup.SwapOutInfo")
-	proto.RegisterType((*SwapInInfo)(nil), "moca.virtualgroup.SwapInInfo")
+// GVGFamilyCandidate is a global virtual group family ranked by the reputation of its secondary sps when picking a
+// family for a new bucket.
+type GVGFamilyCandidate struct {
+	// The id of the global virtual group family.
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The ids of the distinct secondary sps of the global virtual groups in the family.
+	SecondarySpIds []uint32 `protobuf:"varint,2,rep,packed,name=secondary_sp_ids,json=secondarySpIds,proto3" json:"secondary_sp_ids,omitempty"`
+	// The average store price of the secondary sps, in amoca wei per charge byte.
+	AvgStorePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=avg_store_price,json=avgStorePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"avg_store_price"`
+	// The total amount the secondary sps are slashed by the challenges in the current slash counting window.
+	SlashedAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=slashed_amount,json=slashedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"slashed_amount"`
+	// The total time in seconds the secondary sps are in maintenance within the kept maintenance records.
+	MaintenanceDuration int64 `protobuf:"varint,5,opt,name=maintenance_duration,json=maintenanceDuration,proto3" json:"maintenance_duration,omitempty"`
+	// The score of the family under the strategy, the lower the better.
+	Score cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=score,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"score"`
 }
 
-func init() { proto.RegisterFile("moca/virtualgroup/types.proto", fileDescriptor_8a50d5581183bdc7) }
+func (m *GVGFamilyCandidate) Reset()         { *m = GVGFamilyCandidate{} }
+func (m *GVGFamilyCandidate) String() string { return proto.CompactTextString(m) }
+func (*GVGFamilyCandidate) ProtoMessage()    {}
+func (*GVGFamilyCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{7}
+}
+func (m *GVGFamilyCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GVGFamilyCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GVGFamilyCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GVGFamilyCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GVGFamilyCandidate.Merge(m, src)
+}
+func (m *GVGFamilyCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *GVGFamilyCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GVGFamilyCandidate.DiscardUnknown(m)
+}
 
-var fileDescriptor_8a50d5581183bdc7 = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0xdb, 0x48,
-	0x14, 0x8f, 0x43, 0x60, 0xc9, 0x84, 0xc0, 0x62, 0x88, 0xf0, 0xc2, 0x6e, 0x12, 0x79, 0xa5, 0x25,
-	0x5a, 0x89, 0x78, 0xff, 0xa8, 0xea, 0xa9, 0x87, 0xa6, 0x88, 0x28, 0xed, 0x81, 0xc8, 0x29, 0x54,
-	0xea, 0xc5, 0x9a, 0x78, 0x06, 0x67, 0x44, 0x3c, 0xe3, 0x7a, 0xc6, 0x29, 0xe1, 0xd2, 0xaf, 0xd0,
-	0x8f, 0xd1, 0x63, 0x0f, 0x7c, 0x08, 0x8e, 0x88, 0x5e, 0x4a, 0xa5, 0xa2, 0x0a, 0x0e, 0xfd, 0x1a,
-	0xd5, 0xcc, 0x98, 0xa4, 0x34, 0x29, 0x95, 0x7a, 0xb1, 0x3c, 0xbf, 0xf7, 0x7b, 0xbf, 0xd1, 0xfb,
-	0xbd, 0xf7, 0x06, 0xfc, 0x11, 0x32, 0x1f, 0x3a, 0x03, 0x12, 0x8b, 0x04, 0xf6, 0x83, 0x98, 0x25,
-	0x91, 0x23, 0x86, 0x11, 0xe6, 0xf5, 0x28, 0x66, 0x82, 0x99, 0xcb, 0x32, 0x5c, 0xff, 0x3a, 0xbc,
-	0xbe, 0x0c, 0x43, 0x42, 0x99, 0xa3, 0xbe, 0x9a, 0xb5, 0xfe, 0x9b, 0xcf, 0x78, 0xc8, 0xb8, 0xa7,
-	0x4e, 0x8e, 0x3e, 0xa4, 0xa1, 0xd5, 0x80, 0x05, 0x4c, 0xe3, 0xf2, 0x4f, 0xa3, 0xf6, 0x45, 0x16,
-	0x98, 0xcd, 0x3e, 0xeb, 0xc2, 0xfe, 0xbe, 0x96, 0x6e, 0x4a, 0x69, 0x73, 0x11, 0x64, 0x09, 0xb2,
-	0x8c, 0xaa, 0x51, 0x2b, 0xba, 0x59, 0x82, 0xcc, 0x0d, 0x90, 0x3f, 0x80, 0x21, 0xe9, 0x0f, 0x3d,
-	0x82, 0xac, 0xac, 0x82, 0xe7, 0x35, 0xd0, 0x42, 0xa6, 0x0d, 0x8a, 0x51, 0x4c, 0x42, 0x18, 0x0f,
-	0x3d, 0x1e, 0x49, 0xc2, 0x8c, 0x22, 0x14, 0x52, 0xb0, 0x13, 0xb5, 0x90, 0x59, 0x03, 0xbf, 0x72,
-	0xec, 0x33, 0x8a, 0x46, 0x2c, 0x6e, 0xe5, 0xaa, 0x33, 0xb5, 0xa2, 0xbb, 0x38, 0xc2, 0x25, 0x91,
-	0x9b, 0x15, 0x50, 0xe0, 0x82, 0xc5, 0x18, 0x79, 0x9c, 0x1c, 0x63, 0x6b, 0xb6, 0x6a, 0xd4, 0x72,
-	0x2e, 0xd0, 0x50, 0x87, 0x1c, 0x63, 0xb3, 0x0d, 0xd6, 0x52, 0x1b, 0xbc, 0x08, 0x0e, 0x43, 0x4c,
-	0x85, 0x07, 0x11, 0x8a, 0x31, 0xe7, 0xd6, 0x5c, 0xd5, 0xa8, 0xe5, 0x1b, 0xd6, 0xf9, 0xc9, 0xd6,
-	0x6a, 0x5a, 0xfb, 0x43, 0x1d, 0xe9, 0x88, 0x98, 0xd0, 0xc0, 0x2d, 0xa5, 0x89, 0x6d, 0x9d, 0x97,
-	0x06, 0xcd, 0x3d, 0x50, 0x14, 0x4c, 0xc0, 0xbe, 0x87, 0x70, 0xc4, 0x38, 0x11, 0xd6, 0x2f, 0x4a,
-	0xe7, 0x9f, 0xd3, 0xcb, 0x4a, 0xe6, 0xc3, 0x65, 0xa5, 0xa4, 0xb5, 0x38, 0x3a, 0xac, 0x13, 0xe6,
-	0x84, 0x50, 0xf4, 0xea, 0x2d, 0x2a, 0xce, 0x4f, 0xb6, 0x40, 0x7a, 0x49, 0x8b, 0x8a, 0x37, 0x9f,
-	0xdf, 0xfe, 0x6d, 0xb8, 0x0b, 0x4a, 0x66, 0x5b, 0xab, 0xd8, 0x17, 0x06, 0xb0, 0x26, 0xbd, 0xdd,
-	0x51, 0xb6, 0x4d, 0x38, 0x3c, 0x61, 0x62, 0x76, 0xd2, 0xc4, 0xfb, 0xc0, 0x0a, 0x94, 0x9e, 0x77,
-	0x63, 0x80, 0x1a, 0x04, 0x65, 0xe6, 0x8c, 0x32, 0xb3, 0x14, 0x4c, 0xdc, 0x27, 0x3d, 0xbd, 0xc3,
-	0xb2, 0xdc, 0x4f, 0x59, 0x66, 0xbf, 0x33, 0x80, 0x3d, 0x59, 0x1b, 0x6f, 0x10, 0x8a, 0x08, 0x0d,
-	0x76, 0x69, 0x23, 0xf1, 0x0f, 0xb1, 0x30, 0x1f, 0x80, 0x7c, 0x57, 0xfd, 0x79, 0x69, 0xb1, 0xf9,
-	0x46, 0x35, 0x75, 0x35, 0xb7, 0x47, 0x94, 0x89, 0x85, 0xf4, 0x5a, 0x79, 0xd4, 0x2e, 0xce, 0xeb,
-	0x94, 0x1f, 0x14, 0x9c, 0xbd, 0xab, 0xe0, 0x7b, 0x60, 0xad, 0xcf, 0xfc, 0x3b, 0x8c, 0x5a, 0x55,
-	0xe1, 0x6f, 0xd2, 0xec, 0x8f, 0x06, 0x28, 0x35, 0xf7, 0x9b, 0x1d, 0x01, 0x05, 0xe1, 0x82, 0xf8,
-	0xfc, 0x19, 0x11, 0x3d, 0x42, 0x3b, 0x6d, 0xb3, 0x0e, 0x56, 0xe4, 0x08, 0xc2, 0x00, 0xcb, 0xdd,
-	0x1a, 0x10, 0x84, 0x63, 0x6f, 0xd4, 0xbf, 0xe5, 0x34, 0xd4, 0x4e, 0x23, 0x2d, 0x64, 0xfe, 0x39,
-	0x6e, 0xa7, 0xcf, 0x12, 0x2a, 0xd2, 0x76, 0x2e, 0xa4, 0xe0, 0x23, 0x89, 0x99, 0x9b, 0x60, 0x69,
-	0xbc, 0x14, 0x9a, 0xa6, 0x57, 0x67, 0xbc, 0x13, 0x9a, 0xb8, 0x03, 0xaa, 0xdd, 0x18, 0xc3, 0x43,
-	0x2f, 0xc6, 0x28, 0xa1, 0x08, 0x52, 0x7f, 0xe8, 0xc5, 0xf8, 0x45, 0x28, 0xbc, 0x60, 0x10, 0xa4,
-	0x99, 0x39, 0x95, 0xf9, 0xbb, 0xe2, 0xb9, 0x23, 0x9a, 0x2b, 0x59, 0xcd, 0x41, 0xa0, 0x74, 0xec,
-	0x23, 0xb0, 0xd1, 0xdc, 0x6f, 0xea, 0x09, 0x9c, 0x52, 0xe4, 0x0a, 0x98, 0xd5, 0xb3, 0xa7, 0xcb,
-	0xca, 0x71, 0x39, 0x74, 0xdb, 0xa0, 0x32, 0xb5, 0x07, 0xa3, 0xf7, 0xe0, 0xa6, 0x15, 0x1b, 0xc1,
-	0x77, 0x66, 0x5d, 0x3a, 0xfb, 0x18, 0x14, 0x3a, 0x2f, 0x61, 0xb4, 0x9b, 0x88, 0x16, 0x3d, 0x60,
-	0xd3, 0x6f, 0xfa, 0x0b, 0x2c, 0xf1, 0xc4, 0xf7, 0x31, 0xe7, 0x2c, 0xbe, 0xb5, 0x04, 0xc5, 0x11,
-	0x2c, 0xd7, 0xc0, 0x7e, 0x05, 0x80, 0xd4, 0x6a, 0x51, 0x25, 0x35, 0x25, 0xcb, 0x98, 0x92, 0x65,
-	0x56, 0xc1, 0x82, 0x80, 0x71, 0x80, 0xc5, 0x2d, 0x69, 0xa0, 0x31, 0xc5, 0xd8, 0x04, 0x4b, 0xf8,
-	0x28, 0x22, 0x31, 0x14, 0x84, 0x51, 0x4f, 0x90, 0x10, 0xab, 0x76, 0xe4, 0xdc, 0xc5, 0x31, 0xfc,
-	0x94, 0x84, 0xb8, 0xf1, 0xe4, 0xf4, 0xaa, 0x6c, 0x9c, 0x5d, 0x95, 0x8d, 0x4f, 0x57, 0x65, 0xe3,
-	0xf5, 0x75, 0x39, 0x73, 0x76, 0x5d, 0xce, 0xbc, 0xbf, 0x2e, 0x67, 0x9e, 0xff, 0x1b, 0x10, 0xd1,
-	0x4b, 0xba, 0x75, 0x9f, 0x85, 0x8e, 0x7c, 0xb0, 0xfd, 0x1e, 0x24, 0xd4, 0xd1, 0x2f, 0xfb, 0x7f,
-	0xce, 0xd1, 0x94, 0xe7, 0xbd, 0x3b, 0xa7, 0x1e, 0xe2, 0xff, 0xbf, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x0e, 0x3f, 0xc5, 0xb6, 0x00, 0x06, 0x00, 0x00,
+var xxx_messageInfo_GVGFamilyCandidate proto.InternalMessageInfo
+
+func (m *GVGFamilyCandidate) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
+	}
+	return 0
 }
 
-func (m *GlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GVGFamilyCandidate) GetSecondarySpIds() []uint32 {
+	if m != nil {
+		return m.SecondarySpIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GVGFamilyCandidate) GetMaintenanceDuration() int64 {
+	if m != nil {
+		return m.MaintenanceDuration
+	}
+	return 0
 }
 
-func (m *GlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalDeposit.Size()
-		i -= size
-		if _, err := m.TotalDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+// SpExitPlan is the dry run of the exit of a storage provider. It lists everything the sp must hand over to the
+// successor sps before CompleteStorageProviderExit goes through, and flags whatever blocks it now.
+type SpExitPlan struct {
+	// The id of the exiting sp.
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The status of the sp.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Whether the sp can complete the exit now, which holds when there is no blocker.
+	Exitable bool `protobuf:"varint,3,opt,name=exitable,proto3" json:"exitable,omitempty"`
+	// The reasons the sp can not exit or complete the exit now.
+	Blockers []string `protobuf:"bytes,4,rep,name=blockers,proto3" json:"blockers,omitempty"`
+	// The families the sp serves as the primary sp, which need to be swapped out to the successor sps.
+	Families []ExitPlanFamily `protobuf:"bytes,5,rep,name=families,proto3" json:"families"`
+	// The global virtual groups the sp serves as a secondary sp, which need to be swapped out to the successor sps.
+	SecondaryGvgs []ExitPlanGVG `protobuf:"bytes,6,rep,name=secondary_gvgs,json=secondaryGvgs,proto3" json:"secondary_gvgs"`
+	// The in service sps which can succeed the sp, ranked by their free store size from the largest.
+	SuccessorCandidates []ExitSuccessorCandidate `protobuf:"bytes,7,rep,name=successor_candidates,json=successorCandidates,proto3" json:"successor_candidates"`
+	// The swap ins reserved by the successor sps for the families and global virtual groups of the sp.
+	PendingSwapIns []ExitPlanSwapIn `protobuf:"bytes,8,rep,name=pending_swap_ins,json=pendingSwapIns,proto3" json:"pending_swap_ins"`
+	// The ids of the buckets migrating from or to the sp.
+	MigratingBucketIds []cosmossdk_io_math.Uint `protobuf:"bytes,9,rep,name=migrating_bucket_ids,json=migratingBucketIds,proto3,customtype=cosmossdk.io/math.Uint" json:"migrating_bucket_ids"`
+	// The estimated deposit released to the funding address of the sp, which is the deposit of the global virtual
+	// groups in its families paid back by the successor sps, plus the sp deposit unless the sp is forced to exit.
+	ReleasedDeposit cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=released_deposit,json=releasedDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"released_deposit"`
+	// The block height the sp deposit is locked until for the pending challenges.
+	DepositLockedUntil uint64 `protobuf:"varint,11,opt,name=deposit_locked_until,json=depositLockedUntil,proto3" json:"deposit_locked_until,omitempty"`
+}
+
+func (m *SpExitPlan) Reset()         { *m = SpExitPlan{} }
+func (m *SpExitPlan) String() string { return proto.CompactTextString(m) }
+func (*SpExitPlan) ProtoMessage()    {}
+func (*SpExitPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{8}
+}
+func (m *SpExitPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpExitPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpExitPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.VirtualPaymentAddress) > 0 {
-		i -= len(m.VirtualPaymentAddress)
-		copy(dAtA[i:], m.VirtualPaymentAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.VirtualPaymentAddress)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *SpExitPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpExitPlan.Merge(m, src)
+}
+func (m *SpExitPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpExitPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpExitPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpExitPlan proto.InternalMessageInfo
+
+func (m *SpExitPlan) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	if m.StoredSize != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StoredSize))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *SpExitPlan) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.SecondarySpIds) > 0 {
-		dAtA2 := make([]byte, len(m.SecondarySpIds)*10)
-		var j1 int
-		for _, num := range m.SecondarySpIds {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTypes(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *SpExitPlan) GetExitable() bool {
+	if m != nil {
+		return m.Exitable
 	}
-	if m.PrimarySpId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x18
+	return false
+}
+
+func (m *SpExitPlan) GetBlockers() []string {
+	if m != nil {
+		return m.Blockers
 	}
-	if m.FamilyId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.FamilyId))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *SpExitPlan) GetFamilies() []ExitPlanFamily {
+	if m != nil {
+		return m.Families
 	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *SpExitPlan) GetSecondaryGvgs() []ExitPlanGVG {
+	if m != nil {
+		return m.SecondaryGvgs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpExitPlan) GetSuccessorCandidates() []ExitSuccessorCandidate {
+	if m != nil {
+		return m.SuccessorCandidates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GlobalVirtualGroupFamily) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpExitPlan) GetPendingSwapIns() []ExitPlanSwapIn {
+	if m != nil {
+		return m.PendingSwapIns
+	}
+	return nil
 }
 
-func (m *GlobalVirtualGroupFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VirtualPaymentAddress) > 0 {
-		i -= len(m.VirtualPaymentAddress)
-		copy(dAtA[i:], m.VirtualPaymentAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.VirtualPaymentAddress)))
-		i--
-		dAtA[i] = 0x22
+func (m *SpExitPlan) GetDepositLockedUntil() uint64 {
+	if m != nil {
+		return m.DepositLockedUntil
 	}
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		dAtA4 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
-		var j3 int
-		for _, num := range m.GlobalVirtualGroupIds {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+	return 0
+}
+
+// ExitPlanFamily is a family the exiting sp serves as the primary sp.
+type ExitPlanFamily struct {
+	// The id of the global virtual group family.
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The ids of the global virtual groups in the family.
+	GlobalVirtualGroupIds []uint32 `protobuf:"varint,2,rep,packed,name=global_virtual_group_ids,json=globalVirtualGroupIds,proto3" json:"global_virtual_group_ids,omitempty"`
+	// The total stored size of the global virtual groups in the family.
+	StoredSize uint64 `protobuf:"varint,3,opt,name=stored_size,json=storedSize,proto3" json:"stored_size,omitempty"`
+	// The total deposit of the global virtual groups in the family, paid back by the successor sp.
+	TotalDeposit cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_deposit,json=totalDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"total_deposit"`
+	// The id of the successor sp the family is being swapped out to, zero if none.
+	SwapOutSuccessorSpId uint32 `protobuf:"varint,5,opt,name=swap_out_successor_sp_id,json=swapOutSuccessorSpId,proto3" json:"swap_out_successor_sp_id,omitempty"`
+}
+
+func (m *ExitPlanFamily) Reset()         { *m = ExitPlanFamily{} }
+func (m *ExitPlanFamily) String() string { return proto.CompactTextString(m) }
+func (*ExitPlanFamily) ProtoMessage()    {}
+func (*ExitPlanFamily) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{9}
+}
+func (m *ExitPlanFamily) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitPlanFamily) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitPlanFamily.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTypes(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.PrimarySpId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PrimarySpId))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ExitPlanFamily) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitPlanFamily.Merge(m, src)
+}
+func (m *ExitPlanFamily) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitPlanFamily) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitPlanFamily.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitPlanFamily proto.InternalMessageInfo
+
+func (m *ExitPlanFamily) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
 	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ExitPlanFamily) GetGlobalVirtualGroupIds() []uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupIds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GlobalVirtualGroupsBindingOnBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExitPlanFamily) GetStoredSize() uint64 {
+	if m != nil {
+		return m.StoredSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GlobalVirtualGroupsBindingOnBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExitPlanFamily) GetSwapOutSuccessorSpId() uint32 {
+	if m != nil {
+		return m.SwapOutSuccessorSpId
+	}
+	return 0
 }
 
-func (m *GlobalVirtualGroupsBindingOnBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LocalVirtualGroupIds) > 0 {
-		dAtA6 := make([]byte, len(m.LocalVirtualGroupIds)*10)
-		var j5 int
-		for _, num := range m.LocalVirtualGroupIds {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTypes(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		dAtA8 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
-		var j7 int
-		for _, num := range m.GlobalVirtualGroupIds {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintTypes(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.BucketId.Size()
-		i -= size
-		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+// ExitPlanGVG is a global virtual group the exiting sp serves as a secondary sp.
+type ExitPlanGVG struct {
+	// The id of the global virtual group.
+	GlobalVirtualGroupId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// The id of the family the global virtual group belongs to.
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The id of the primary sp of the global virtual group.
+	PrimarySpId uint32 `protobuf:"varint,3,opt,name=primary_sp_id,json=primarySpId,proto3" json:"primary_sp_id,omitempty"`
+	// The stored size of the global virtual group.
+	StoredSize uint64 `protobuf:"varint,4,opt,name=stored_size,json=storedSize,proto3" json:"stored_size,omitempty"`
+	// The id of the successor sp the global virtual group is being swapped out to, zero if none.
+	SwapOutSuccessorSpId uint32 `protobuf:"varint,5,opt,name=swap_out_successor_sp_id,json=swapOutSuccessorSpId,proto3" json:"swap_out_successor_sp_id,omitempty"`
+}
+
+func (m *ExitPlanGVG) Reset()         { *m = ExitPlanGVG{} }
+func (m *ExitPlanGVG) String() string { return proto.CompactTextString(m) }
+func (*ExitPlanGVG) ProtoMessage()    {}
+func (*ExitPlanGVG) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{10}
+}
+func (m *ExitPlanGVG) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitPlanGVG) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitPlanGVG.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ExitPlanGVG) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitPlanGVG.Merge(m, src)
+}
+func (m *ExitPlanGVG) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitPlanGVG) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitPlanGVG.DiscardUnknown(m)
 }
 
-func (m *GVGStatisticsWithinSP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExitPlanGVG proto.InternalMessageInfo
+
+func (m *ExitPlanGVG) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GVGStatisticsWithinSP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExitPlanGVG) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
+	}
+	return 0
 }
 
-func (m *GVGStatisticsWithinSP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BreakRedundancyReqmtGvgCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BreakRedundancyReqmtGvgCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.SecondaryCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SecondaryCount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PrimaryCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PrimaryCount))
-		i--
-		dAtA[i] = 0x10
+func (m *ExitPlanGVG) GetPrimarySpId() uint32 {
+	if m != nil {
+		return m.PrimarySpId
 	}
-	if m.StorageProviderId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StorageProviderId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ExitPlanGVG) GetStoredSize() uint64 {
+	if m != nil {
+		return m.StoredSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GVGFamilyStatisticsWithinSP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExitPlanGVG) GetSwapOutSuccessorSpId() uint32 {
+	if m != nil {
+		return m.SwapOutSuccessorSpId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GVGFamilyStatisticsWithinSP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ExitPlanSwapIn is a swap in reserved for a family or a global virtual group of the exiting sp.
+type ExitPlanSwapIn struct {
+	// The id of the family, zero if the swap in is for a global virtual group.
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The id of the global virtual group, zero if the swap in is for a family.
+	GlobalVirtualGroupId uint32     `protobuf:"varint,2,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	SwapInInfo           SwapInInfo `protobuf:"bytes,3,opt,name=swap_in_info,json=swapInInfo,proto3" json:"swap_in_info"`
+	// Whether the reservation has expired, so that another sp can reserve the swap in.
+	Expired bool `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
 }
 
-func (m *GVGFamilyStatisticsWithinSP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		dAtA10 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
-		var j9 int
-		for _, num := range m.GlobalVirtualGroupFamilyIds {
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
+func (m *ExitPlanSwapIn) Reset()         { *m = ExitPlanSwapIn{} }
+func (m *ExitPlanSwapIn) String() string { return proto.CompactTextString(m) }
+func (*ExitPlanSwapIn) ProtoMessage()    {}
+func (*ExitPlanSwapIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{11}
+}
+func (m *ExitPlanSwapIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitPlanSwapIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitPlanSwapIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintTypes(dAtA, i, uint64(j9))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SpId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ExitPlanSwapIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitPlanSwapIn.Merge(m, src)
+}
+func (m *ExitPlanSwapIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitPlanSwapIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitPlanSwapIn.DiscardUnknown(m)
 }
 
-func (m *SwapOutInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExitPlanSwapIn proto.InternalMessageInfo
+
+func (m *ExitPlanSwapIn) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SwapOutInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExitPlanSwapIn) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
 }
 
-func (m *SwapOutInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SuccessorSpId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SuccessorSpId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SpId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x8
+func (m *ExitPlanSwapIn) GetSwapInInfo() SwapInInfo {
+	if m != nil {
+		return m.SwapInInfo
 	}
-	return len(dAtA) - i, nil
+	return SwapInInfo{}
 }
 
-func (m *SwapInInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExitPlanSwapIn) GetExpired() bool {
+	if m != nil {
+		return m.Expired
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *SwapInInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ExitSuccessorCandidate is an in service sp which can succeed the exiting sp.
+type ExitSuccessorCandidate struct {
+	// The id of the sp.
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The free store size left by the staking of the families of the sp.
+	FreeStoreSize uint64 `protobuf:"varint,2,opt,name=free_store_size,json=freeStoreSize,proto3" json:"free_store_size,omitempty"`
+	// The ids of the global virtual groups of the exiting sp the candidate already serves in, which it can not take
+	// over as a secondary sp.
+	ConflictingGvgIds []uint32 `protobuf:"varint,3,rep,packed,name=conflicting_gvg_ids,json=conflictingGvgIds,proto3" json:"conflicting_gvg_ids,omitempty"`
 }
 
-func (m *SwapInInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpirationTime != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ExpirationTime))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TargetSpId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TargetSpId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SuccessorSpId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SuccessorSpId))
-		i--
-		dAtA[i] = 0x8
+func (m *ExitSuccessorCandidate) Reset()         { *m = ExitSuccessorCandidate{} }
+func (m *ExitSuccessorCandidate) String() string { return proto.CompactTextString(m) }
+func (*ExitSuccessorCandidate) ProtoMessage()    {}
+func (*ExitSuccessorCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{12}
+}
+func (m *ExitSuccessorCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitSuccessorCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitSuccessorCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ExitSuccessorCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitSuccessorCandidate.Merge(m, src)
+}
+func (m *ExitSuccessorCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitSuccessorCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitSuccessorCandidate.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ExitSuccessorCandidate proto.InternalMessageInfo
+
+func (m *ExitSuccessorCandidate) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *GlobalVirtualGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
-	}
-	if m.FamilyId != 0 {
-		n += 1 + sovTypes(uint64(m.FamilyId))
+
+func (m *ExitSuccessorCandidate) GetFreeStoreSize() uint64 {
+	if m != nil {
+		return m.FreeStoreSize
 	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovTypes(uint64(m.PrimarySpId))
+	return 0
+}
+
+func (m *ExitSuccessorCandidate) GetConflictingGvgIds() []uint32 {
+	if m != nil {
+		return m.ConflictingGvgIds
 	}
-	if len(m.SecondarySpIds) > 0 {
-		l = 0
-		for _, e := range m.SecondarySpIds {
-			l += sovTypes(uint64(e))
+	return nil
+}
+
+// SwapInAuction is the auction of the swap in of a family or a global virtual group of a forced exiting sp. The in
+// service sps bid in the bidding window, and the best bidder is assigned the swap in when the window closes. If the
+// assigned sp does not complete the swap in before the deadline, the next best bidder is assigned.
+type SwapInAuction struct {
+	// The id of the family, zero if the auction is for a global virtual group.
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// The id of the global virtual group, zero if the auction is for a family.
+	GlobalVirtualGroupId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// The id of the forced exiting sp to be swapped.
+	TargetSpId uint32 `protobuf:"varint,3,opt,name=target_sp_id,json=targetSpId,proto3" json:"target_sp_id,omitempty"`
+	// The stored size the successor sp takes over, which the capacity of the bids must cover.
+	StoredSize uint64 `protobuf:"varint,4,opt,name=stored_size,json=storedSize,proto3" json:"stored_size,omitempty"`
+	// The time the bidding window closes.
+	BiddingEndTime int64 `protobuf:"varint,5,opt,name=bidding_end_time,json=biddingEndTime,proto3" json:"bidding_end_time,omitempty"`
+	// The bids not assigned yet, from the best to the worst.
+	Bids []SwapInBid `protobuf:"bytes,6,rep,name=bids,proto3" json:"bids"`
+	// The id of the sp assigned the swap in, zero if none.
+	AssignedSpId uint32 `protobuf:"varint,7,opt,name=assigned_sp_id,json=assignedSpId,proto3" json:"assigned_sp_id,omitempty"`
+	// The time the assigned sp must complete the swap in before, or the one the sp reserving the swap in by itself must
+	// if none is assigned, zero if none.
+	Deadline int64 `protobuf:"varint,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *SwapInAuction) Reset()         { *m = SwapInAuction{} }
+func (m *SwapInAuction) String() string { return proto.CompactTextString(m) }
+func (*SwapInAuction) ProtoMessage()    {}
+func (*SwapInAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{13}
+}
+func (m *SwapInAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapInAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapInAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTypes(uint64(l)) + l
-	}
-	if m.StoredSize != 0 {
-		n += 1 + sovTypes(uint64(m.StoredSize))
+		return b[:n], nil
 	}
-	l = len(m.VirtualPaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *SwapInAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapInAuction.Merge(m, src)
+}
+func (m *SwapInAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapInAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapInAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapInAuction proto.InternalMessageInfo
+
+func (m *SwapInAuction) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
 	}
-	l = m.TotalDeposit.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return 0
 }
 
-func (m *GlobalVirtualGroupFamily) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SwapInAuction) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
+	return 0
+}
+
+func (m *SwapInAuction) GetTargetSpId() uint32 {
+	if m != nil {
+		return m.TargetSpId
 	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovTypes(uint64(m.PrimarySpId))
+	return 0
+}
+
+func (m *SwapInAuction) GetStoredSize() uint64 {
+	if m != nil {
+		return m.StoredSize
 	}
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupIds {
-			l += sovTypes(uint64(e))
-		}
-		n += 1 + sovTypes(uint64(l)) + l
+	return 0
+}
+
+func (m *SwapInAuction) GetBiddingEndTime() int64 {
+	if m != nil {
+		return m.BiddingEndTime
 	}
-	l = len(m.VirtualPaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *SwapInAuction) GetBids() []SwapInBid {
+	if m != nil {
+		return m.Bids
 	}
-	return n
+	return nil
 }
 
-func (m *GlobalVirtualGroupsBindingOnBucket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SwapInAuction) GetAssignedSpId() uint32 {
+	if m != nil {
+		return m.AssignedSpId
 	}
-	var l int
-	_ = l
-	l = m.BucketId.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupIds {
-			l += sovTypes(uint64(e))
-		}
-		n += 1 + sovTypes(uint64(l)) + l
+	return 0
+}
+
+func (m *SwapInAuction) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	if len(m.LocalVirtualGroupIds) > 0 {
-		l = 0
-		for _, e := range m.LocalVirtualGroupIds {
-			l += sovTypes(uint64(e))
+	return 0
+}
+
+// SwapInBid is a bid of an sp for a swap in auction. The bids with the lower store price win, then the ones with the
+// larger capacity and the earlier ones.
+type SwapInBid struct {
+	// The id of the sp.
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The store price of the sp when it bids, in amoca wei per charge byte.
+	StorePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=store_price,json=storePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"store_price"`
+	// The free store size in bytes the sp commits to the swap in.
+	Capacity uint64 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// The time the sp bids.
+	BidTime int64 `protobuf:"varint,4,opt,name=bid_time,json=bidTime,proto3" json:"bid_time,omitempty"`
+}
+
+func (m *SwapInBid) Reset()         { *m = SwapInBid{} }
+func (m *SwapInBid) String() string { return proto.CompactTextString(m) }
+func (*SwapInBid) ProtoMessage()    {}
+func (*SwapInBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{14}
+}
+func (m *SwapInBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapInBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapInBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTypes(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SwapInBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapInBid.Merge(m, src)
+}
+func (m *SwapInBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapInBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapInBid.DiscardUnknown(m)
 }
 
-func (m *GVGStatisticsWithinSP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageProviderId != 0 {
-		n += 1 + sovTypes(uint64(m.StorageProviderId))
-	}
-	if m.PrimaryCount != 0 {
-		n += 1 + sovTypes(uint64(m.PrimaryCount))
-	}
-	if m.SecondaryCount != 0 {
-		n += 1 + sovTypes(uint64(m.SecondaryCount))
-	}
-	if m.BreakRedundancyReqmtGvgCount != 0 {
-		n += 1 + sovTypes(uint64(m.BreakRedundancyReqmtGvgCount))
+var xxx_messageInfo_SwapInBid proto.InternalMessageInfo
+
+func (m *SwapInBid) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	return n
+	return 0
 }
 
-func (m *GVGFamilyStatisticsWithinSP) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SwapInBid) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
 	}
-	var l int
-	_ = l
-	if m.SpId != 0 {
-		n += 1 + sovTypes(uint64(m.SpId))
+	return 0
+}
+
+func (m *SwapInBid) GetBidTime() int64 {
+	if m != nil {
+		return m.BidTime
 	}
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupFamilyIds {
-			l += sovTypes(uint64(e))
+	return 0
+}
+
+// RebalanceMove moves a global virtual group from a secondary sp to another sp, by the swap in of the other sp.
+type RebalanceMove struct {
+	// The id of the global virtual group.
+	GlobalVirtualGroupId uint32 `protobuf:"varint,1,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	// The id of the secondary sp the global virtual group moves from.
+	FromSpId uint32 `protobuf:"varint,2,opt,name=from_sp_id,json=fromSpId,proto3" json:"from_sp_id,omitempty"`
+	// The id of the sp the global virtual group moves to.
+	ToSpId uint32 `protobuf:"varint,3,opt,name=to_sp_id,json=toSpId,proto3" json:"to_sp_id,omitempty"`
+	// The stored size of the global virtual group.
+	StoredSize uint64 `protobuf:"varint,4,opt,name=stored_size,json=storedSize,proto3" json:"stored_size,omitempty"`
+	// The status of the move.
+	Status RebalanceMoveStatus `protobuf:"varint,5,opt,name=status,proto3,enum=moca.virtualgroup.RebalanceMoveStatus" json:"status,omitempty"`
+}
+
+func (m *RebalanceMove) Reset()         { *m = RebalanceMove{} }
+func (m *RebalanceMove) String() string { return proto.CompactTextString(m) }
+func (*RebalanceMove) ProtoMessage()    {}
+func (*RebalanceMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{15}
+}
+func (m *RebalanceMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebalanceMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebalanceMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTypes(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RebalanceMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceMove.Merge(m, src)
+}
+func (m *RebalanceMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebalanceMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceMove.DiscardUnknown(m)
 }
 
-func (m *SwapOutInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SpId != 0 {
-		n += 1 + sovTypes(uint64(m.SpId))
+var xxx_messageInfo_RebalanceMove proto.InternalMessageInfo
+
+func (m *RebalanceMove) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
 	}
-	if m.SuccessorSpId != 0 {
-		n += 1 + sovTypes(uint64(m.SuccessorSpId))
+	return 0
+}
+
+func (m *RebalanceMove) GetFromSpId() uint32 {
+	if m != nil {
+		return m.FromSpId
 	}
-	return n
+	return 0
 }
 
-func (m *SwapInInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RebalanceMove) GetToSpId() uint32 {
+	if m != nil {
+		return m.ToSpId
 	}
-	var l int
-	_ = l
-	if m.SuccessorSpId != 0 {
-		n += 1 + sovTypes(uint64(m.SuccessorSpId))
+	return 0
+}
+
+func (m *RebalanceMove) GetStoredSize() uint64 {
+	if m != nil {
+		return m.StoredSize
 	}
-	if m.TargetSpId != 0 {
-		n += 1 + sovTypes(uint64(m.TargetSpId))
+	return 0
+}
+
+func (m *RebalanceMove) GetStatus() RebalanceMoveStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.ExpirationTime != 0 {
-		n += 1 + sovTypes(uint64(m.ExpirationTime))
+	return REBALANCE_MOVE_STATUS_PENDING
+}
+
+// RebalancePlan is a batch of coordinated moves submitted by an sp or governance to even out the load of the sps.
+type RebalancePlan struct {
+	// The id of the plan.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The account who submitted the plan.
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// The moves of the plan.
+	Moves []RebalanceMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves"`
+	// The time the plan was submitted.
+	CreateTime int64 `protobuf:"varint,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time the pending moves expire.
+	ExpirationTime int64 `protobuf:"varint,5,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+}
+
+func (m *RebalancePlan) Reset()         { *m = RebalancePlan{} }
+func (m *RebalancePlan) String() string { return proto.CompactTextString(m) }
+func (*RebalancePlan) ProtoMessage()    {}
+func (*RebalancePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{16}
+}
+func (m *RebalancePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebalancePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebalancePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
+func (m *RebalancePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalancePlan.Merge(m, src)
+}
+func (m *RebalancePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebalancePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalancePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalancePlan proto.InternalMessageInfo
+
+func (m *RebalancePlan) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RebalancePlan) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *RebalancePlan) GetMoves() []RebalanceMove {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+func (m *RebalancePlan) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *RebalancePlan) GetExpirationTime() int64 {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return 0
+}
+
+// SpLoad is the secondary sp load of an in service sp.
+type SpLoad struct {
+	// The id of the sp.
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The number of global virtual groups the sp serves as a secondary sp.
+	SecondaryCount uint32 `protobuf:"varint,2,opt,name=secondary_count,json=secondaryCount,proto3" json:"secondary_count,omitempty"`
+	// The stored size of the global virtual groups the sp serves as a secondary sp.
+	SecondaryStoredSize uint64 `protobuf:"varint,3,opt,name=secondary_stored_size,json=secondaryStoredSize,proto3" json:"secondary_stored_size,omitempty"`
+	// The store size backed by the deposit of the sp at the gvg staking price.
+	StakedCapacity uint64 `protobuf:"varint,4,opt,name=staked_capacity,json=stakedCapacity,proto3" json:"staked_capacity,omitempty"`
+}
+
+func (m *SpLoad) Reset()         { *m = SpLoad{} }
+func (m *SpLoad) String() string { return proto.CompactTextString(m) }
+func (*SpLoad) ProtoMessage()    {}
+func (*SpLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{17}
+}
+func (m *SpLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpLoad.Merge(m, src)
+}
+func (m *SpLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpLoad proto.InternalMessageInfo
+
+func (m *SpLoad) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *SpLoad) GetSecondaryCount() uint32 {
+	if m != nil {
+		return m.SecondaryCount
+	}
+	return 0
+}
+
+func (m *SpLoad) GetSecondaryStoredSize() uint64 {
+	if m != nil {
+		return m.SecondaryStoredSize
+	}
+	return 0
+}
+
+func (m *SpLoad) GetStakedCapacity() uint64 {
+	if m != nil {
+		return m.StakedCapacity
+	}
+	return 0
+}
+
+// RebalanceMetrics measures how even the secondary sp load of the in service sps is.
+type RebalanceMetrics struct {
+	// The loads of the in service sps, ordered by the sp id.
+	Loads []SpLoad `protobuf:"bytes,1,rep,name=loads,proto3" json:"loads"`
+	// The largest number of global virtual groups an sp serves as a secondary sp.
+	MaxSecondaryCount uint32 `protobuf:"varint,2,opt,name=max_secondary_count,json=maxSecondaryCount,proto3" json:"max_secondary_count,omitempty"`
+	// The smallest number of global virtual groups an sp serves as a secondary sp.
+	MinSecondaryCount uint32 `protobuf:"varint,3,opt,name=min_secondary_count,json=minSecondaryCount,proto3" json:"min_secondary_count,omitempty"`
+	// The average number of global virtual groups an sp serves as a secondary sp.
+	MeanSecondaryCount cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=mean_secondary_count,json=meanSecondaryCount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"mean_secondary_count"`
+	// The gap between the largest and the smallest number, which the rebalancing narrows to at most one.
+	Imbalance uint32 `protobuf:"varint,5,opt,name=imbalance,proto3" json:"imbalance,omitempty"`
+}
+
+func (m *RebalanceMetrics) Reset()         { *m = RebalanceMetrics{} }
+func (m *RebalanceMetrics) String() string { return proto.CompactTextString(m) }
+func (*RebalanceMetrics) ProtoMessage()    {}
+func (*RebalanceMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a50d5581183bdc7, []int{18}
+}
+func (m *RebalanceMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebalanceMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebalanceMetrics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RebalanceMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceMetrics.Merge(m, src)
+}
+func (m *RebalanceMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebalanceMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceMetrics proto.InternalMessageInfo
+
+func (m *RebalanceMetrics) GetLoads() []SpLoad {
+	if m != nil {
+		return m.Loads
+	}
+	return nil
+}
+
+func (m *RebalanceMetrics) GetMaxSecondaryCount() uint32 {
+	if m != nil {
+		return m.MaxSecondaryCount
+	}
+	return 0
+}
+
+func (m *RebalanceMetrics) GetMinSecondaryCount() uint32 {
+	if m != nil {
+		return m.MinSecondaryCount
+	}
+	return 0
+}
+
+func (m *RebalanceMetrics) GetImbalance() uint32 {
+	if m != nil {
+		return m.Imbalance
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("moca.virtualgroup.RebalanceMoveStatus", RebalanceMoveStatus_name, RebalanceMoveStatus_value)
+	proto.RegisterType((*GlobalVirtualGroup)(nil), "moca.virtualgroup.GlobalVirtualGroup")
+	proto.RegisterType((*GlobalVirtualGroupFamily)(nil), "moca.virtualgroup.GlobalVirtualGroupFamily")
+	proto.RegisterType((*GlobalVirtualGroupsBindingOnBucket)(nil), "moca.virtualgroup.GlobalVirtualGroupsBindingOnBucket")
+	proto.RegisterType((*GVGStatisticsWithinSP)(nil), "moca.virtualgroup.GVGStatisticsWithinSP")
+	proto.RegisterType((*GVGFamilyStatisticsWithinSP)(nil), "moca.virtualgroup.GVGFamilyStatisticsWithinSP")
+	proto.RegisterType((*SwapOutInfo)(nil), "moca.virtualgroup.SwapOutInfo")
+	proto.RegisterType((*SwapInInfo)(nil), "moca.virtualgroup.SwapInInfo")
+	proto.RegisterType((*GVGFamilyCandidate)(nil), "moca.virtualgroup.GVGFamilyCandidate")
+	proto.RegisterType((*SpExitPlan)(nil), "moca.virtualgroup.SpExitPlan")
+	proto.RegisterType((*ExitPlanFamily)(nil), "moca.virtualgroup.ExitPlanFamily")
+	proto.RegisterType((*ExitPlanGVG)(nil), "moca.virtualgroup.ExitPlanGVG")
+	proto.RegisterType((*ExitPlanSwapIn)(nil), "moca.virtualgroup.ExitPlanSwapIn")
+	proto.RegisterType((*ExitSuccessorCandidate)(nil), "moca.virtualgroup.ExitSuccessorCandidate")
+	proto.RegisterType((*SwapInAuction)(nil), "moca.virtualgroup.SwapInAuction")
+	proto.RegisterType((*SwapInBid)(nil), "moca.virtualgroup.SwapInBid")
+	proto.RegisterType((*RebalanceMove)(nil), "moca.virtualgroup.RebalanceMove")
+	proto.RegisterType((*RebalancePlan)(nil), "moca.virtualgroup.RebalancePlan")
+	proto.RegisterType((*SpLoad)(nil), "moca.virtualgroup.SpLoad")
+	proto.RegisterType((*RebalanceMetrics)(nil), "moca.virtualgroup.RebalanceMetrics")
+}
+
+func init() { proto.RegisterFile("moca/virtualgroup/types.proto", fileDescriptor_8a50d5581183bdc7) }
+
+var fileDescriptor_8a50d5581183bdc7 = []byte{
+	// 1815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x2e, 0x29, 0x89, 0x7c, 0x34, 0x29, 0x6a, 0x44, 0x39, 0x6b, 0xd9, 0xa6, 0x18, 0xa6,
+	0x48, 0xd4, 0x00, 0xa1, 0x12, 0x15, 0x75, 0x2f, 0x6d, 0x01, 0x51, 0x64, 0x08, 0x36, 0xb2, 0xcd,
+	0x92, 0x96, 0x5c, 0xb4, 0x40, 0x17, 0xc3, 0xdd, 0xd1, 0x6a, 0x60, 0xee, 0x2e, 0xbb, 0x33, 0x64,
+	0xa4, 0x5c, 0x7a, 0x0a, 0xd0, 0x63, 0xbf, 0x43, 0x2e, 0x45, 0x2f, 0x4d, 0x81, 0x1c, 0xdb, 0x7b,
+	0x8e, 0x41, 0x7a, 0x69, 0x0a, 0x34, 0x2d, 0xec, 0x43, 0x2f, 0xbd, 0xf4, 0x1b, 0x14, 0xf3, 0x87,
+	0x4b, 0x51, 0x5c, 0x4a, 0x86, 0x6c, 0x20, 0x17, 0x41, 0xfb, 0xde, 0x9b, 0xdf, 0xee, 0xfb, 0xbd,
+	0xbf, 0x43, 0xb8, 0xef, 0x87, 0x0e, 0xde, 0x1d, 0xd3, 0x88, 0x8f, 0xf0, 0xc0, 0x8b, 0xc2, 0xd1,
+	0x70, 0x97, 0x9f, 0x0f, 0x09, 0xab, 0x0d, 0xa3, 0x90, 0x87, 0x68, 0x5d, 0xa8, 0x6b, 0x17, 0xd5,
+	0x5b, 0xeb, 0xd8, 0xa7, 0x41, 0xb8, 0x2b, 0xff, 0x2a, 0xab, 0xad, 0x3b, 0x4e, 0xc8, 0xfc, 0x90,
+	0xd9, 0xf2, 0x69, 0x57, 0x3d, 0x68, 0x55, 0xc9, 0x0b, 0xbd, 0x50, 0xc9, 0xc5, 0x7f, 0x4a, 0x5a,
+	0xfd, 0xc6, 0x04, 0xd4, 0x1a, 0x84, 0x7d, 0x3c, 0x38, 0x56, 0xd0, 0x2d, 0x01, 0x8d, 0x0a, 0x60,
+	0x52, 0xd7, 0x32, 0x2a, 0xc6, 0x4e, 0xbe, 0x6b, 0x52, 0x17, 0xdd, 0x85, 0xec, 0x09, 0xf6, 0xe9,
+	0xe0, 0xdc, 0xa6, 0xae, 0x65, 0x4a, 0x71, 0x46, 0x09, 0xda, 0x2e, 0xaa, 0x42, 0x7e, 0x18, 0x51,
+	0x1f, 0x47, 0xe7, 0x36, 0x1b, 0x0a, 0x83, 0x94, 0x34, 0xc8, 0x69, 0x61, 0x6f, 0xd8, 0x76, 0xd1,
+	0x0e, 0x14, 0x19, 0x71, 0xc2, 0xc0, 0x8d, 0xad, 0x98, 0x95, 0xae, 0xa4, 0x76, 0xf2, 0xdd, 0x42,
+	0x2c, 0x17, 0x86, 0x0c, 0x6d, 0x43, 0x8e, 0xf1, 0x30, 0x22, 0xae, 0xcd, 0xe8, 0x27, 0xc4, 0x5a,
+	0xae, 0x18, 0x3b, 0xe9, 0x2e, 0x28, 0x51, 0x8f, 0x7e, 0x42, 0x50, 0x07, 0xde, 0xd0, 0x34, 0xd8,
+	0x43, 0x7c, 0xee, 0x93, 0x80, 0xdb, 0xd8, 0x75, 0x23, 0xc2, 0x98, 0xb5, 0x52, 0x31, 0x76, 0xb2,
+	0x75, 0xeb, 0xeb, 0x2f, 0xde, 0x2b, 0x69, 0xdf, 0xf7, 0x95, 0xa6, 0xc7, 0x23, 0x1a, 0x78, 0xdd,
+	0x4d, 0x7d, 0xb0, 0xa3, 0xce, 0x69, 0x25, 0x3a, 0x82, 0x3c, 0x0f, 0x39, 0x1e, 0xd8, 0x2e, 0x19,
+	0x86, 0x8c, 0x72, 0x6b, 0x55, 0xe2, 0xbc, 0xff, 0xe5, 0xb7, 0xdb, 0x4b, 0xff, 0xf8, 0x76, 0x7b,
+	0x53, 0x61, 0x31, 0xf7, 0x59, 0x8d, 0x86, 0xbb, 0x3e, 0xe6, 0xa7, 0xb5, 0x76, 0xc0, 0xbf, 0xfe,
+	0xe2, 0x3d, 0xd0, 0x2f, 0x69, 0x07, 0xfc, 0x0f, 0xff, 0xf9, 0xfc, 0x5d, 0xa3, 0x7b, 0x4b, 0xc2,
+	0x34, 0x14, 0x4a, 0xf5, 0x1b, 0x03, 0xac, 0x79, 0x6e, 0x3f, 0x94, 0xb4, 0xcd, 0x31, 0x3c, 0x47,
+	0xa2, 0x39, 0x4f, 0xe2, 0x8f, 0xc0, 0xf2, 0x24, 0x9e, 0x3d, 0x21, 0x40, 0x26, 0x82, 0x24, 0x33,
+	0x25, 0xc9, 0xdc, 0xf4, 0xe6, 0xde, 0x27, 0x38, 0xbd, 0x82, 0xb2, 0xf4, 0x8d, 0x28, 0xab, 0xfe,
+	0xcd, 0x80, 0xea, 0xbc, 0x6f, 0xac, 0x4e, 0x03, 0x97, 0x06, 0xde, 0xe3, 0xa0, 0x3e, 0x72, 0x9e,
+	0x11, 0x8e, 0x7e, 0x02, 0xd9, 0xbe, 0xfc, 0xcf, 0xd6, 0xce, 0x66, 0xeb, 0x15, 0xcd, 0x6a, 0xfa,
+	0x88, 0x4a, 0x12, 0x73, 0xfa, 0xb5, 0x47, 0x74, 0xc2, 0x62, 0x46, 0x1d, 0xb9, 0xc6, 0x61, 0xf3,
+	0x2a, 0x87, 0x7f, 0x08, 0x6f, 0x0c, 0x42, 0xe7, 0x0a, 0xa2, 0x4a, 0x52, 0x7d, 0xe9, 0x58, 0xf5,
+	0x9f, 0x06, 0x6c, 0xb6, 0x8e, 0x5b, 0x3d, 0x8e, 0x39, 0x65, 0x9c, 0x3a, 0xec, 0x29, 0xe5, 0xa7,
+	0x34, 0xe8, 0x75, 0x50, 0x0d, 0x36, 0x44, 0x0a, 0x62, 0x8f, 0x88, 0xda, 0x1a, 0x53, 0x97, 0x44,
+	0x76, 0x1c, 0xbf, 0x75, 0xad, 0xea, 0x68, 0x4d, 0xdb, 0x45, 0x6f, 0x4d, 0xc3, 0xe9, 0x84, 0xa3,
+	0x80, 0xeb, 0x70, 0xde, 0xd2, 0xc2, 0x03, 0x21, 0x43, 0xef, 0xc0, 0xda, 0xb4, 0x28, 0x94, 0x99,
+	0x2a, 0x9d, 0x69, 0x4d, 0x28, 0xc3, 0x0f, 0xa1, 0xd2, 0x8f, 0x08, 0x7e, 0x66, 0x47, 0xc4, 0x1d,
+	0x05, 0x2e, 0x0e, 0x9c, 0x73, 0x3b, 0x22, 0xbf, 0xf1, 0xb9, 0xed, 0x8d, 0x3d, 0x7d, 0x32, 0x2d,
+	0x4f, 0xde, 0x93, 0x76, 0xdd, 0xd8, 0xac, 0x2b, 0xac, 0x5a, 0x63, 0x4f, 0xe2, 0x54, 0xcf, 0xe0,
+	0x6e, 0xeb, 0xb8, 0xa5, 0x32, 0x30, 0xc1, 0xc9, 0x0d, 0x58, 0x66, 0xc3, 0xa9, 0x5b, 0x69, 0x26,
+	0x92, 0xae, 0x01, 0xdb, 0x89, 0x31, 0x88, 0xfb, 0xc1, 0x24, 0x14, 0x77, 0xbd, 0x05, 0xb9, 0x2e,
+	0x98, 0xfd, 0x19, 0xe4, 0x7a, 0x1f, 0xe3, 0xe1, 0xe3, 0x11, 0x6f, 0x07, 0x27, 0x61, 0xf2, 0x9b,
+	0xde, 0x86, 0x35, 0x36, 0x72, 0x1c, 0xc2, 0x58, 0x18, 0xcd, 0x14, 0x41, 0x3e, 0x16, 0x8b, 0x32,
+	0xa8, 0xfe, 0x16, 0x40, 0x60, 0xb5, 0x03, 0x09, 0x95, 0x70, 0xca, 0x48, 0x38, 0x85, 0x2a, 0x70,
+	0x8b, 0xe3, 0xc8, 0x23, 0x7c, 0x06, 0x1a, 0x94, 0x4c, 0x5a, 0xbc, 0x03, 0x6b, 0xe4, 0x6c, 0x48,
+	0x23, 0xcc, 0x69, 0x18, 0xd8, 0x9c, 0xfa, 0x44, 0x86, 0x23, 0xdd, 0x2d, 0x4c, 0xc5, 0x4f, 0xa8,
+	0x4f, 0xaa, 0x7f, 0x49, 0x01, 0x8a, 0x79, 0x3c, 0xc0, 0x81, 0x4b, 0x5d, 0xcc, 0x09, 0xaa, 0x43,
+	0xf9, 0x6a, 0xa6, 0xf4, 0x87, 0x6d, 0x2d, 0x26, 0x2a, 0xb1, 0x4f, 0x9a, 0x89, 0x7d, 0xf2, 0xd7,
+	0xb0, 0x86, 0xc7, 0x9e, 0x2d, 0x1b, 0xa3, 0x3d, 0x8c, 0xa8, 0xa3, 0xbe, 0x36, 0x5b, 0x7f, 0xa0,
+	0x0b, 0xec, 0xee, 0x7c, 0xdb, 0x3a, 0x24, 0x1e, 0x76, 0xce, 0x1b, 0xc4, 0xb9, 0xd0, 0xbc, 0x1a,
+	0xc4, 0x51, 0x65, 0x97, 0xc7, 0x63, 0xaf, 0x27, 0xd0, 0x3a, 0x02, 0x0c, 0x3d, 0x85, 0x02, 0x1b,
+	0x60, 0x76, 0x4a, 0x5c, 0x1b, 0xfb, 0x71, 0x86, 0xdd, 0xa4, 0x2b, 0xe6, 0x35, 0xce, 0xbe, 0x84,
+	0x41, 0x1f, 0x40, 0xc9, 0xc7, 0x34, 0xe0, 0x24, 0xc0, 0x81, 0x43, 0x6c, 0x77, 0xa4, 0x98, 0x95,
+	0x9d, 0x3e, 0xd5, 0xdd, 0xb8, 0xa0, 0x6b, 0x68, 0x15, 0x3a, 0x84, 0x65, 0xe6, 0x84, 0x11, 0xb1,
+	0x56, 0x5e, 0xc9, 0x43, 0x05, 0x52, 0xfd, 0xd3, 0x32, 0x40, 0x6f, 0xd8, 0x3c, 0xa3, 0xbc, 0x33,
+	0xc0, 0x41, 0x72, 0x2e, 0xde, 0x86, 0x15, 0xc6, 0x31, 0x1f, 0x31, 0x99, 0x27, 0xd9, 0xae, 0x7e,
+	0x42, 0x5b, 0x90, 0x21, 0x67, 0x94, 0xe3, 0xfe, 0x40, 0xd1, 0x9d, 0xe9, 0xc6, 0xcf, 0x42, 0xd7,
+	0x1f, 0x84, 0xce, 0x33, 0x12, 0xa9, 0xd9, 0x96, 0xed, 0xc6, 0xcf, 0xe8, 0x00, 0xd4, 0xbc, 0xa4,
+	0x84, 0x59, 0xcb, 0x95, 0xd4, 0x4e, 0x6e, 0xef, 0xcd, 0xda, 0xdc, 0x44, 0xaf, 0x4d, 0xbe, 0x49,
+	0xa5, 0x43, 0x3d, 0x2d, 0xfc, 0xec, 0xc6, 0x07, 0xd1, 0x47, 0x30, 0x4d, 0x02, 0x51, 0xf9, 0x62,
+	0xe0, 0x09, 0xa8, 0xf2, 0x15, 0x50, 0xad, 0xe3, 0x96, 0xc6, 0xc9, 0xc7, 0x67, 0x5b, 0x63, 0x8f,
+	0xa1, 0x3e, 0x94, 0xa6, 0x75, 0xe3, 0x4c, 0x92, 0x98, 0x59, 0xab, 0x12, 0xf2, 0xfb, 0x0b, 0x20,
+	0x7b, 0x93, 0x23, 0x71, 0xda, 0x6b, 0xf4, 0x0d, 0x36, 0xa7, 0x61, 0xe8, 0xe7, 0x50, 0x1c, 0x12,
+	0x39, 0x11, 0x6c, 0xf6, 0x31, 0x1e, 0xda, 0x34, 0x60, 0x56, 0xe6, 0x5a, 0xef, 0x55, 0x71, 0x6b,
+	0xdc, 0x82, 0x06, 0x50, 0x42, 0x86, 0x5c, 0x28, 0xf9, 0xd4, 0x13, 0x79, 0x11, 0x78, 0x76, 0x3c,
+	0x5b, 0x98, 0x95, 0x15, 0x84, 0xd7, 0xf7, 0x74, 0x66, 0xdc, 0x9e, 0xcf, 0x8c, 0x85, 0xe3, 0x06,
+	0xc5, 0x78, 0x75, 0x3d, 0x77, 0x18, 0xfa, 0x15, 0x14, 0x23, 0x32, 0x20, 0x98, 0x11, 0x37, 0x5e,
+	0x0a, 0xe0, 0x86, 0xe9, 0xbf, 0x36, 0x41, 0xd2, 0x7b, 0x01, 0x7a, 0x1f, 0x4a, 0x1a, 0xd3, 0x96,
+	0xe9, 0xe1, 0xda, 0xa3, 0x80, 0xd3, 0x81, 0x95, 0x93, 0xcd, 0x06, 0x69, 0xdd, 0xa1, 0x54, 0x1d,
+	0x09, 0x4d, 0xf5, 0xaf, 0x26, 0x14, 0x66, 0x73, 0xe3, 0xb5, 0x34, 0x9b, 0x1b, 0x8f, 0xd7, 0x4b,
+	0x3b, 0x5a, 0x6a, 0x6e, 0x47, 0x9b, 0xdb, 0xa8, 0xd2, 0xaf, 0x63, 0xa3, 0x42, 0x0f, 0xc0, 0x92,
+	0x79, 0x14, 0x8e, 0xb8, 0x7d, 0xb9, 0xe9, 0x2f, 0x4b, 0x77, 0x4b, 0x4c, 0x4d, 0x99, 0xde, 0xcc,
+	0xc4, 0xf8, 0xd4, 0x84, 0xdc, 0x85, 0x82, 0x10, 0xeb, 0xc1, 0x02, 0xc7, 0x35, 0x6b, 0xa5, 0x24,
+	0xbf, 0x5f, 0x82, 0x73, 0xf3, 0x5a, 0xce, 0x5f, 0x66, 0x59, 0xbe, 0x44, 0x6f, 0x7a, 0x8e, 0xde,
+	0x9b, 0xf2, 0xf0, 0x5f, 0x63, 0x9a, 0x47, 0xaa, 0xa0, 0x5e, 0x4b, 0x1e, 0x5d, 0x41, 0xa7, 0x79,
+	0x05, 0x9d, 0x4d, 0xb8, 0xa5, 0xbb, 0x82, 0x4d, 0x83, 0x93, 0x50, 0x32, 0x91, 0xdb, 0xbb, 0x9f,
+	0xd0, 0x19, 0xa6, 0xe3, 0x5e, 0x77, 0x05, 0x60, 0xb1, 0x04, 0x59, 0xb0, 0x2a, 0xe7, 0x33, 0x71,
+	0x25, 0x53, 0x99, 0xee, 0xe4, 0xb1, 0xfa, 0xa9, 0x01, 0xb7, 0x93, 0x9b, 0xd6, 0xc2, 0x05, 0xe4,
+	0x24, 0x22, 0x44, 0xcf, 0x54, 0xc9, 0xbd, 0x29, 0xb9, 0xcf, 0x0b, 0xb1, 0x9c, 0x8d, 0x92, 0xfe,
+	0x1a, 0x6c, 0x38, 0x61, 0x70, 0x32, 0xa0, 0x8e, 0xec, 0x42, 0x62, 0x07, 0x9b, 0x6e, 0x96, 0xeb,
+	0x17, 0x54, 0xad, 0xb1, 0x27, 0x96, 0x9f, 0xff, 0x99, 0x90, 0x57, 0x2e, 0xec, 0x8f, 0x1c, 0x39,
+	0xd0, 0xbe, 0x43, 0xd6, 0x2f, 0xef, 0x41, 0xa9, 0xb9, 0x3d, 0xe8, 0xda, 0xf4, 0xdb, 0x81, 0x62,
+	0x9f, 0xba, 0xb2, 0xad, 0x93, 0xc0, 0x55, 0x9b, 0x92, 0x9a, 0xde, 0x05, 0x2d, 0x6f, 0x06, 0xae,
+	0xd8, 0x94, 0xd0, 0x03, 0x48, 0xf7, 0xa9, 0x3b, 0x99, 0x53, 0xf7, 0x16, 0x86, 0xb6, 0x4e, 0x5d,
+	0x1d, 0x59, 0x69, 0x8f, 0xbe, 0x07, 0x05, 0xcc, 0x18, 0xf5, 0x02, 0xe2, 0xea, 0xcf, 0x5c, 0x55,
+	0xfb, 0xf3, 0x44, 0x2a, 0x3f, 0x74, 0x0b, 0x32, 0x2e, 0xc1, 0xee, 0x80, 0x06, 0xc4, 0xca, 0xc8,
+	0xf7, 0xc7, 0xcf, 0xd5, 0xcf, 0x0d, 0xc8, 0xc6, 0xd8, 0xc9, 0xe1, 0x7e, 0x0a, 0xb9, 0x8b, 0xdb,
+	0x93, 0xf9, 0x4a, 0xbb, 0x05, 0xb0, 0xe9, 0xea, 0xb4, 0x05, 0x19, 0x07, 0x0f, 0xb1, 0x43, 0xf9,
+	0xb9, 0xee, 0x8d, 0xf1, 0x33, 0xba, 0x03, 0x99, 0x3e, 0xd5, 0x9c, 0xa5, 0xe5, 0x37, 0xaf, 0xf6,
+	0xa9, 0x24, 0xab, 0xfa, 0x2f, 0x03, 0xf2, 0x5d, 0xd2, 0xc7, 0x03, 0xb1, 0xfb, 0x3c, 0x0c, 0xc7,
+	0xe4, 0xa6, 0x7d, 0xea, 0x1e, 0xc0, 0x49, 0x14, 0xfa, 0x33, 0x8b, 0x6e, 0x46, 0x48, 0x24, 0x6b,
+	0x16, 0x64, 0x78, 0x38, 0x13, 0xfc, 0x15, 0x1e, 0xbe, 0x5c, 0xe0, 0x7f, 0x1a, 0x6f, 0x45, 0x22,
+	0xdc, 0x85, 0xbd, 0xb7, 0x13, 0x02, 0x3a, 0xe3, 0x41, 0x4f, 0x5a, 0x4f, 0xb6, 0xa7, 0x59, 0x0f,
+	0xe5, 0xf2, 0x35, 0xbd, 0x06, 0xa7, 0xe5, 0x35, 0xf8, 0x01, 0x64, 0xd9, 0xa8, 0xef, 0x53, 0xce,
+	0x49, 0x64, 0x99, 0xd7, 0xdc, 0x4d, 0xa7, 0xa6, 0xe8, 0xc7, 0xb0, 0xec, 0x87, 0x63, 0xa2, 0x8a,
+	0x30, 0xb7, 0x57, 0xb9, 0xee, 0xc3, 0x74, 0xb6, 0xa9, 0x43, 0xc2, 0x71, 0x27, 0x22, 0x98, 0x93,
+	0x8b, 0x71, 0x01, 0x25, 0x92, 0x79, 0x9c, 0x70, 0x35, 0xd0, 0x09, 0x7f, 0xe9, 0x6a, 0xf0, 0x99,
+	0x01, 0x2b, 0xbd, 0xe1, 0x61, 0x88, 0x17, 0xe4, 0x5c, 0xc2, 0x95, 0xcf, 0x4c, 0xbc, 0xf2, 0xed,
+	0xc1, 0xe6, 0xd4, 0x70, 0x7e, 0xd8, 0x6e, 0xc4, 0xca, 0xde, 0x34, 0x3c, 0x02, 0x9c, 0x63, 0xb1,
+	0x50, 0xc4, 0xe9, 0xa7, 0x62, 0x58, 0x50, 0xe2, 0x03, 0x2d, 0xad, 0xfe, 0xd9, 0x84, 0xe2, 0x94,
+	0x0e, 0xc2, 0x23, 0xea, 0x88, 0x3b, 0xf3, 0xf2, 0x20, 0xc4, 0x2e, 0xb3, 0x0c, 0x49, 0xe1, 0x9d,
+	0xa4, 0x62, 0x95, 0x9e, 0x4d, 0xb8, 0x93, 0xd6, 0xa2, 0x19, 0xfa, 0xf8, 0xcc, 0x4e, 0xf6, 0x6a,
+	0xdd, 0xc7, 0x67, 0xbd, 0x59, 0xc7, 0x84, 0x3d, 0x0d, 0xec, 0xe4, 0x8b, 0xef, 0xba, 0x4f, 0x83,
+	0x4b, 0xf6, 0xa7, 0x50, 0xf2, 0x09, 0x9e, 0x3f, 0x90, 0x7e, 0xa5, 0x72, 0x45, 0x02, 0xf3, 0xd2,
+	0x9b, 0xee, 0x41, 0x96, 0xfa, 0x9a, 0x14, 0x3d, 0x46, 0xa7, 0x82, 0x77, 0xff, 0x68, 0xc0, 0x46,
+	0x42, 0x6e, 0xa3, 0x37, 0xe1, 0x7e, 0xb7, 0x59, 0xdf, 0x3f, 0xdc, 0x7f, 0x74, 0xd0, 0xb4, 0x1f,
+	0x3e, 0x3e, 0x6e, 0xda, 0xbd, 0x27, 0xfb, 0x4f, 0x8e, 0x7a, 0x76, 0xa7, 0xf9, 0xa8, 0xd1, 0x7e,
+	0xd4, 0x2a, 0x2e, 0xa1, 0xb7, 0x60, 0x3b, 0xd9, 0xe4, 0xe0, 0xf1, 0xc3, 0xce, 0x61, 0xf3, 0x49,
+	0xb3, 0x51, 0x34, 0x50, 0x15, 0xca, 0x0b, 0x8c, 0x84, 0xe4, 0xb0, 0xd9, 0x28, 0x9a, 0x8b, 0xdf,
+	0xd5, 0xfc, 0x45, 0xa7, 0xdd, 0x6d, 0x36, 0x8a, 0xa9, 0xad, 0xf4, 0xef, 0x3e, 0x2b, 0x2f, 0xd5,
+	0x3f, 0xfa, 0xf2, 0x79, 0xd9, 0xf8, 0xea, 0x79, 0xd9, 0xf8, 0xf7, 0xf3, 0xb2, 0xf1, 0xfb, 0x17,
+	0xe5, 0xa5, 0xaf, 0x5e, 0x94, 0x97, 0xfe, 0xfe, 0xa2, 0xbc, 0xf4, 0xcb, 0x0f, 0x3c, 0xca, 0x4f,
+	0x47, 0xfd, 0x9a, 0x13, 0xfa, 0xbb, 0x22, 0xc0, 0xce, 0x29, 0xa6, 0xc1, 0xae, 0xfa, 0xed, 0x71,
+	0x6f, 0xf7, 0x2c, 0xe1, 0x07, 0xc8, 0xfe, 0x8a, 0xfc, 0xa9, 0xf0, 0x07, 0xff, 0x1f, 0x00, 0xd3,
+	0xb6, 0xfa, 0x62, 0xa2, 0x14, 0x00, 0x00,
+}
+
+func (m *GlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalDeposit.Size()
+		i -= size
+		if _, err := m.TotalDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.VirtualPaymentAddress) > 0 {
+		i -= len(m.VirtualPaymentAddress)
+		copy(dAtA[i:], m.VirtualPaymentAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VirtualPaymentAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StoredSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StoredSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SecondarySpIds) > 0 {
+		dAtA2 := make([]byte, len(m.SecondarySpIds)*10)
+		var j1 int
+		for _, num := range m.SecondarySpIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FamilyId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FamilyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalVirtualGroupFamily) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalVirtualGroupFamily) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalVirtualGroupFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VirtualPaymentAddress) > 0 {
+		i -= len(m.VirtualPaymentAddress)
+		copy(dAtA[i:], m.VirtualPaymentAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VirtualPaymentAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		dAtA4 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j3 int
+		for _, num := range m.GlobalVirtualGroupIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTypes(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalVirtualGroupsBindingOnBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalVirtualGroupsBindingOnBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalVirtualGroupsBindingOnBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LocalVirtualGroupIds) > 0 {
+		dAtA6 := make([]byte, len(m.LocalVirtualGroupIds)*10)
+		var j5 int
+		for _, num := range m.LocalVirtualGroupIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTypes(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		dAtA8 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j7 int
+		for _, num := range m.GlobalVirtualGroupIds {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTypes(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GVGStatisticsWithinSP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GVGStatisticsWithinSP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GVGStatisticsWithinSP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BreakRedundancyReqmtGvgCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BreakRedundancyReqmtGvgCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SecondaryCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SecondaryCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrimaryCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PrimaryCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GVGFamilyStatisticsWithinSP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GVGFamilyStatisticsWithinSP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GVGFamilyStatisticsWithinSP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		dAtA10 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
+		var j9 int
+		for _, num := range m.GlobalVirtualGroupFamilyIds {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTypes(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapOutInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapOutInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapOutInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuccessorSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SuccessorSpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapInInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapInInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapInInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpirationTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TargetSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetSpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SuccessorSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SuccessorSpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GVGFamilyCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GVGFamilyCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GVGFamilyCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaintenanceDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaintenanceDuration))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AvgStorePrice.Size()
+		i -= size
+		if _, err := m.AvgStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SecondarySpIds) > 0 {
+		dAtA12 := make([]byte, len(m.SecondarySpIds)*10)
+		var j11 int
+		for _, num := range m.SecondarySpIds {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTypes(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpExitPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpExitPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpExitPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DepositLockedUntil != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DepositLockedUntil))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.ReleasedDeposit.Size()
+		i -= size
+		if _, err := m.ReleasedDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.MigratingBucketIds) > 0 {
+		for iNdEx := len(m.MigratingBucketIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MigratingBucketIds[iNdEx].Size()
+				i -= size
+				if _, err := m.MigratingBucketIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PendingSwapIns) > 0 {
+		for iNdEx := len(m.PendingSwapIns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSwapIns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SuccessorCandidates) > 0 {
+		for iNdEx := len(m.SuccessorCandidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuccessorCandidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SecondaryGvgs) > 0 {
+		for iNdEx := len(m.SecondaryGvgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SecondaryGvgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Families) > 0 {
+		for iNdEx := len(m.Families) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Families[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Blockers) > 0 {
+		for iNdEx := len(m.Blockers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blockers[iNdEx])
+			copy(dAtA[i:], m.Blockers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Blockers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Exitable {
+		i--
+		if m.Exitable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitPlanFamily) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitPlanFamily) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitPlanFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapOutSuccessorSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SwapOutSuccessorSpId))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TotalDeposit.Size()
+		i -= size
+		if _, err := m.TotalDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.StoredSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StoredSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		dAtA14 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j13 int
+		for _, num := range m.GlobalVirtualGroupIds {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTypes(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitPlanGVG) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitPlanGVG) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitPlanGVG) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapOutSuccessorSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SwapOutSuccessorSpId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StoredSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StoredSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitPlanSwapIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitPlanSwapIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitPlanSwapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.SwapInInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitSuccessorCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitSuccessorCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitSuccessorCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConflictingGvgIds) > 0 {
+		dAtA17 := make([]byte, len(m.ConflictingGvgIds)*10)
+		var j16 int
+		for _, num := range m.ConflictingGvgIds {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintTypes(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FreeStoreSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FreeStoreSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapInAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapInAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapInAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AssignedSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AssignedSpId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BiddingEndTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BiddingEndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StoredSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StoredSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetSpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapInBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapInBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapInBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BidTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Capacity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.StorePrice.Size()
+		i -= size
+		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebalanceMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalanceMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebalanceMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StoredSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StoredSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ToSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ToSpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FromSpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebalancePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalancePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebalancePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpirationTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreateTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreateTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Moves) > 0 {
+		for iNdEx := len(m.Moves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Moves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StakedCapacity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StakedCapacity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SecondaryStoredSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SecondaryStoredSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SecondaryCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SecondaryCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebalanceMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalanceMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebalanceMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Imbalance != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Imbalance))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MeanSecondaryCount.Size()
+		i -= size
+		if _, err := m.MeanSecondaryCount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MinSecondaryCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinSecondaryCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxSecondaryCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxSecondaryCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Loads) > 0 {
+		for iNdEx := len(m.Loads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Loads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GlobalVirtualGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.FamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.FamilyId))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovTypes(uint64(m.PrimarySpId))
+	}
+	if len(m.SecondarySpIds) > 0 {
+		l = 0
+		for _, e := range m.SecondarySpIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.StoredSize != 0 {
+		n += 1 + sovTypes(uint64(m.StoredSize))
+	}
+	l = len(m.VirtualPaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.TotalDeposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GlobalVirtualGroupFamily) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovTypes(uint64(m.PrimarySpId))
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	l = len(m.VirtualPaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GlobalVirtualGroupsBindingOnBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BucketId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.LocalVirtualGroupIds) > 0 {
+		l = 0
+		for _, e := range m.LocalVirtualGroupIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GVGStatisticsWithinSP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovTypes(uint64(m.StorageProviderId))
+	}
+	if m.PrimaryCount != 0 {
+		n += 1 + sovTypes(uint64(m.PrimaryCount))
+	}
+	if m.SecondaryCount != 0 {
+		n += 1 + sovTypes(uint64(m.SecondaryCount))
+	}
+	if m.BreakRedundancyReqmtGvgCount != 0 {
+		n += 1 + sovTypes(uint64(m.BreakRedundancyReqmtGvgCount))
+	}
+	return n
+}
+
+func (m *GVGFamilyStatisticsWithinSP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupFamilyIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SwapOutInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.SuccessorSpId != 0 {
+		n += 1 + sovTypes(uint64(m.SuccessorSpId))
+	}
+	return n
+}
+
+func (m *SwapInInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SuccessorSpId != 0 {
+		n += 1 + sovTypes(uint64(m.SuccessorSpId))
+	}
+	if m.TargetSpId != 0 {
+		n += 1 + sovTypes(uint64(m.TargetSpId))
+	}
+	if m.ExpirationTime != 0 {
+		n += 1 + sovTypes(uint64(m.ExpirationTime))
+	}
+	return n
+}
+
+func (m *GVGFamilyCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if len(m.SecondarySpIds) > 0 {
+		l = 0
+		for _, e := range m.SecondarySpIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	l = m.AvgStorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.MaintenanceDuration != 0 {
+		n += 1 + sovTypes(uint64(m.MaintenanceDuration))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *SpExitPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Exitable {
+		n += 2
+	}
+	if len(m.Blockers) > 0 {
+		for _, s := range m.Blockers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Families) > 0 {
+		for _, e := range m.Families {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.SecondaryGvgs) > 0 {
+		for _, e := range m.SecondaryGvgs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.SuccessorCandidates) > 0 {
+		for _, e := range m.SuccessorCandidates {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.PendingSwapIns) > 0 {
+		for _, e := range m.PendingSwapIns {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.MigratingBucketIds) > 0 {
+		for _, e := range m.MigratingBucketIds {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.ReleasedDeposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.DepositLockedUntil != 0 {
+		n += 1 + sovTypes(uint64(m.DepositLockedUntil))
+	}
+	return n
+}
+
+func (m *ExitPlanFamily) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.StoredSize != 0 {
+		n += 1 + sovTypes(uint64(m.StoredSize))
+	}
+	l = m.TotalDeposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SwapOutSuccessorSpId != 0 {
+		n += 1 + sovTypes(uint64(m.SwapOutSuccessorSpId))
+	}
+	return n
+}
+
+func (m *ExitPlanGVG) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovTypes(uint64(m.PrimarySpId))
+	}
+	if m.StoredSize != 0 {
+		n += 1 + sovTypes(uint64(m.StoredSize))
+	}
+	if m.SwapOutSuccessorSpId != 0 {
+		n += 1 + sovTypes(uint64(m.SwapOutSuccessorSpId))
+	}
+	return n
+}
+
+func (m *ExitPlanSwapIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupId))
+	}
+	l = m.SwapInInfo.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExitSuccessorCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.FreeStoreSize != 0 {
+		n += 1 + sovTypes(uint64(m.FreeStoreSize))
+	}
+	if len(m.ConflictingGvgIds) > 0 {
+		l = 0
+		for _, e := range m.ConflictingGvgIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SwapInAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.TargetSpId != 0 {
+		n += 1 + sovTypes(uint64(m.TargetSpId))
+	}
+	if m.StoredSize != 0 {
+		n += 1 + sovTypes(uint64(m.StoredSize))
+	}
+	if m.BiddingEndTime != 0 {
+		n += 1 + sovTypes(uint64(m.BiddingEndTime))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.AssignedSpId != 0 {
+		n += 1 + sovTypes(uint64(m.AssignedSpId))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTypes(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *SwapInBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Capacity != 0 {
+		n += 1 + sovTypes(uint64(m.Capacity))
+	}
+	if m.BidTime != 0 {
+		n += 1 + sovTypes(uint64(m.BidTime))
+	}
+	return n
+}
+
+func (m *RebalanceMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.FromSpId != 0 {
+		n += 1 + sovTypes(uint64(m.FromSpId))
+	}
+	if m.ToSpId != 0 {
+		n += 1 + sovTypes(uint64(m.ToSpId))
+	}
+	if m.StoredSize != 0 {
+		n += 1 + sovTypes(uint64(m.StoredSize))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *RebalancePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.CreateTime != 0 {
+		n += 1 + sovTypes(uint64(m.CreateTime))
+	}
+	if m.ExpirationTime != 0 {
+		n += 1 + sovTypes(uint64(m.ExpirationTime))
+	}
+	return n
+}
+
+func (m *SpLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.SecondaryCount != 0 {
+		n += 1 + sovTypes(uint64(m.SecondaryCount))
+	}
+	if m.SecondaryStoredSize != 0 {
+		n += 1 + sovTypes(uint64(m.SecondaryStoredSize))
+	}
+	if m.StakedCapacity != 0 {
+		n += 1 + sovTypes(uint64(m.StakedCapacity))
+	}
+	return n
+}
+
+func (m *RebalanceMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Loads) > 0 {
+		for _, e := range m.Loads {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxSecondaryCount != 0 {
+		n += 1 + sovTypes(uint64(m.MaxSecondaryCount))
+	}
+	if m.MinSecondaryCount != 0 {
+		n += 1 + sovTypes(uint64(m.MinSecondaryCount))
+	}
+	l = m.MeanSecondaryCount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Imbalance != 0 {
+		n += 1 + sovTypes(uint64(m.Imbalance))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalVirtualGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
+			}
+			m.FamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SecondarySpIds = append(m.SecondarySpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SecondarySpIds) == 0 {
+					m.SecondarySpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SecondarySpIds = append(m.SecondarySpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpIds", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredSize", wireType)
+			}
+			m.StoredSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualPaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VirtualPaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalVirtualGroupFamily: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalVirtualGroupFamily: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
+					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualPaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VirtualPaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalVirtualGroupsBindingOnBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalVirtualGroupsBindingOnBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalVirtualGroupsBindingOnBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
+					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LocalVirtualGroupIds = append(m.LocalVirtualGroupIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LocalVirtualGroupIds) == 0 {
+					m.LocalVirtualGroupIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LocalVirtualGroupIds = append(m.LocalVirtualGroupIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GVGStatisticsWithinSP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GVGStatisticsWithinSP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GVGStatisticsWithinSP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryCount", wireType)
+			}
+			m.PrimaryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryCount", wireType)
+			}
+			m.SecondaryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondaryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakRedundancyReqmtGvgCount", wireType)
+			}
+			m.BreakRedundancyReqmtGvgCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BreakRedundancyReqmtGvgCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GVGFamilyStatisticsWithinSP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GVGFamilyStatisticsWithinSP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GVGFamilyStatisticsWithinSP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
+					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapOutInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapOutInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapOutInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorSpId", wireType)
+			}
+			m.SuccessorSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessorSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapInInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapInInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapInInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorSpId", wireType)
+			}
+			m.SuccessorSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessorSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
+			}
+			m.TargetSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			m.ExpirationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GVGFamilyCandidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GVGFamilyCandidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GVGFamilyCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SecondarySpIds = append(m.SecondarySpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SecondarySpIds) == 0 {
+					m.SecondarySpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SecondarySpIds = append(m.SecondarySpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgStorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AvgStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceDuration", wireType)
+			}
+			m.MaintenanceDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpExitPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpExitPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpExitPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exitable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exitable = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockers = append(m.Blockers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Families", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Families = append(m.Families, ExitPlanFamily{})
+			if err := m.Families[len(m.Families)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryGvgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondaryGvgs = append(m.SecondaryGvgs, ExitPlanGVG{})
+			if err := m.SecondaryGvgs[len(m.SecondaryGvgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorCandidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessorCandidates = append(m.SuccessorCandidates, ExitSuccessorCandidate{})
+			if err := m.SuccessorCandidates[len(m.SuccessorCandidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwapIns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSwapIns = append(m.PendingSwapIns, ExitPlanSwapIn{})
+			if err := m.PendingSwapIns[len(m.PendingSwapIns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratingBucketIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.MigratingBucketIds = append(m.MigratingBucketIds, v)
+			if err := m.MigratingBucketIds[len(m.MigratingBucketIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleasedDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositLockedUntil", wireType)
+			}
+			m.DepositLockedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositLockedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitPlanFamily) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitPlanFamily: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitPlanFamily: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
+					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredSize", wireType)
+			}
+			m.StoredSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOutSuccessorSpId", wireType)
+			}
+			m.SwapOutSuccessorSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapOutSuccessorSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitPlanGVG) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitPlanGVG: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitPlanGVG: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredSize", wireType)
+			}
+			m.StoredSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOutSuccessorSpId", wireType)
+			}
+			m.SwapOutSuccessorSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapOutSuccessorSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitPlanSwapIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitPlanSwapIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitPlanSwapIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapInInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapInInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitSuccessorCandidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitSuccessorCandidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitSuccessorCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeStoreSize", wireType)
+			}
+			m.FreeStoreSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeStoreSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConflictingGvgIds = append(m.ConflictingGvgIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ConflictingGvgIds) == 0 {
+					m.ConflictingGvgIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConflictingGvgIds = append(m.ConflictingGvgIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingGvgIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+func (m *SwapInAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1127,17 +5805,17 @@ func (m *GlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalVirtualGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapInAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapInAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
 			}
-			m.Id = 0
+			m.GlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1147,16 +5825,16 @@ func (m *GlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
 			}
-			m.FamilyId = 0
+			m.GlobalVirtualGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1166,16 +5844,16 @@ func (m *GlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FamilyId |= uint32(b&0x7F) << shift
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
 			}
-			m.PrimarySpId = 0
+			m.TargetSpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1185,92 +5863,35 @@ func (m *GlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				m.TargetSpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.SecondarySpIds = append(m.SecondarySpIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredSize", wireType)
+			}
+			m.StoredSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.SecondarySpIds) == 0 {
-					m.SecondarySpIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.SecondarySpIds = append(m.SecondarySpIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpIds", wireType)
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingEndTime", wireType)
 			}
-			m.StoredSize = 0
+			m.BiddingEndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1280,16 +5901,16 @@ func (m *GlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StoredSize |= uint64(b&0x7F) << shift
+				m.BiddingEndTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualPaymentAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1299,29 +5920,31 @@ func (m *GlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VirtualPaymentAddress = string(dAtA[iNdEx:postIndex])
+			m.Bids = append(m.Bids, SwapInBid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedSpId", wireType)
 			}
-			var stringLen uint64
+			m.AssignedSpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1331,26 +5954,30 @@ func (m *GlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssignedSpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1372,7 +5999,7 @@ func (m *GlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
+func (m *SwapInBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1395,17 +6022,17 @@ func (m *GlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalVirtualGroupFamily: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapInBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalVirtualGroupFamily: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapInBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.Id = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1415,16 +6042,16 @@ func (m *GlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
 			}
-			m.PrimarySpId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1434,92 +6061,50 @@ func (m *GlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimarySpId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
-					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualPaymentAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidTime", wireType)
 			}
-			var stringLen uint64
+			m.BidTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1529,24 +6114,11 @@ func (m *GlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BidTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VirtualPaymentAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1568,7 +6140,7 @@ func (m *GlobalVirtualGroupFamily) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalVirtualGroupsBindingOnBucket) Unmarshal(dAtA []byte) error {
+func (m *RebalanceMove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1591,17 +6163,17 @@ func (m *GlobalVirtualGroupsBindingOnBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalVirtualGroupsBindingOnBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebalanceMove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalVirtualGroupsBindingOnBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebalanceMove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
 			}
-			var stringLen uint64
+			m.GlobalVirtualGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1611,177 +6183,86 @@ func (m *GlobalVirtualGroupsBindingOnBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSpId", wireType)
+			}
+			m.FromSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
-					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
 			}
 		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSpId", wireType)
+			}
+			m.ToSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				m.LocalVirtualGroupIds = append(m.LocalVirtualGroupIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredSize", wireType)
+			}
+			m.StoredSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.LocalVirtualGroupIds) == 0 {
-					m.LocalVirtualGroupIds = make([]uint32, 0, elementCount)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.LocalVirtualGroupIds = append(m.LocalVirtualGroupIds, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RebalanceMoveStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalVirtualGroupIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -1804,7 +6285,7 @@ func (m *GlobalVirtualGroupsBindingOnBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GVGStatisticsWithinSP) Unmarshal(dAtA []byte) error {
+func (m *RebalancePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1827,17 +6308,17 @@ func (m *GVGStatisticsWithinSP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GVGStatisticsWithinSP: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebalancePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GVGStatisticsWithinSP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebalancePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.StorageProviderId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1847,16 +6328,48 @@ func (m *GVGStatisticsWithinSP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageProviderId |= uint32(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryCount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.PrimaryCount = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1866,16 +6379,31 @@ func (m *GVGStatisticsWithinSP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrimaryCount |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, RebalanceMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
 			}
-			m.SecondaryCount = 0
+			m.CreateTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1885,16 +6413,16 @@ func (m *GVGStatisticsWithinSP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SecondaryCount |= uint32(b&0x7F) << shift
+				m.CreateTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BreakRedundancyReqmtGvgCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
-			m.BreakRedundancyReqmtGvgCount = 0
+			m.ExpirationTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1904,7 +6432,7 @@ func (m *GVGStatisticsWithinSP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BreakRedundancyReqmtGvgCount |= uint32(b&0x7F) << shift
+				m.ExpirationTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1930,7 +6458,7 @@ func (m *GVGStatisticsWithinSP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GVGFamilyStatisticsWithinSP) Unmarshal(dAtA []byte) error {
+func (m *SpLoad) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1953,10 +6481,10 @@ func (m *GVGFamilyStatisticsWithinSP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GVGFamilyStatisticsWithinSP: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpLoad: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GVGFamilyStatisticsWithinSP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpLoad: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1979,80 +6507,61 @@ func (m *GVGFamilyStatisticsWithinSP) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryCount", wireType)
+			}
+			m.SecondaryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondaryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStoredSize", wireType)
+			}
+			m.SecondaryStoredSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondaryStoredSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
-					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedCapacity", wireType)
+			}
+			m.StakedCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakedCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -2075,7 +6584,7 @@ func (m *GVGFamilyStatisticsWithinSP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapOutInfo) Unmarshal(dAtA []byte) error {
+func (m *RebalanceMetrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2098,17 +6607,17 @@ func (m *SwapOutInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapOutInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebalanceMetrics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapOutInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebalanceMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loads", wireType)
 			}
-			m.SpId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2118,16 +6627,31 @@ func (m *SwapOutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Loads = append(m.Loads, SpLoad{})
+			if err := m.Loads[len(m.Loads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorSpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSecondaryCount", wireType)
 			}
-			m.SuccessorSpId = 0
+			m.MaxSecondaryCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2137,66 +6661,16 @@ func (m *SwapOutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SuccessorSpId |= uint32(b&0x7F) << shift
+				m.MaxSecondaryCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SwapInInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SwapInInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapInInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorSpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSecondaryCount", wireType)
 			}
-			m.SuccessorSpId = 0
+			m.MinSecondaryCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2206,16 +6680,16 @@ func (m *SwapInInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SuccessorSpId |= uint32(b&0x7F) << shift
+				m.MinSecondaryCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetSpId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeanSecondaryCount", wireType)
 			}
-			m.TargetSpId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2225,16 +6699,31 @@ func (m *SwapInInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetSpId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MeanSecondaryCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Imbalance", wireType)
 			}
-			m.ExpirationTime = 0
+			m.Imbalance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2244,7 +6733,7 @@ func (m *SwapInInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpirationTime |= uint64(b&0x7F) << shift
+				m.Imbalance |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}